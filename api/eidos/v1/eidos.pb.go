@@ -0,0 +1,894 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: eidos/v1/eidos.proto
+
+package eidosv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Criteria are the recipe criteria. Unset fields mean any.
+type Criteria struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service is the Kubernetes service type, e.g. eks or gke.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Accelerator is the GPU type, e.g. h100 or gb200.
+	Accelerator string `protobuf:"bytes,2,opt,name=accelerator,proto3" json:"accelerator,omitempty"`
+	// Intent is the workload intent, training or inference.
+	Intent string `protobuf:"bytes,3,opt,name=intent,proto3" json:"intent,omitempty"`
+	// OS is the worker node operating system, e.g. ubuntu.
+	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// Nodes is the number of worker nodes, 0 for any.
+	Nodes int32 `protobuf:"varint,5,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	// Scale is the cluster scale tier, e.g. small or large. Derived from
+	// nodes when unset.
+	Scale string `protobuf:"bytes,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Partitioning is the GPU partitioning mode, e.g. mig-single or vgpu.
+	Partitioning  string `protobuf:"bytes,7,opt,name=partitioning,proto3" json:"partitioning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Criteria) Reset() {
+	*x = Criteria{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Criteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Criteria) ProtoMessage() {}
+
+func (x *Criteria) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Criteria.ProtoReflect.Descriptor instead.
+func (*Criteria) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Criteria) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *Criteria) GetAccelerator() string {
+	if x != nil {
+		return x.Accelerator
+	}
+	return ""
+}
+
+func (x *Criteria) GetIntent() string {
+	if x != nil {
+		return x.Intent
+	}
+	return ""
+}
+
+func (x *Criteria) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *Criteria) GetNodes() int32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *Criteria) GetScale() string {
+	if x != nil {
+		return x.Scale
+	}
+	return ""
+}
+
+func (x *Criteria) GetPartitioning() string {
+	if x != nil {
+		return x.Partitioning
+	}
+	return ""
+}
+
+type SnapshotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types restricts the snapshot to these measurement types, e.g. GPU.
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// Measurement subtypes to drop, as Type.subtype or subtype.
+	ExcludeSubtypes []string `protobuf:"bytes,2,rep,name=exclude_subtypes,json=excludeSubtypes,proto3" json:"exclude_subtypes,omitempty"`
+	// Redact masks hostnames, IP addresses and cloud account IDs.
+	Redact bool `protobuf:"varint,3,opt,name=redact,proto3" json:"redact,omitempty"`
+	// Regular expressions of additional values to mask. Setting any implies
+	// redact.
+	RedactPatterns []string `protobuf:"bytes,4,rep,name=redact_patterns,json=redactPatterns,proto3" json:"redact_patterns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotRequest) ProtoMessage() {}
+
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SnapshotRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SnapshotRequest) GetExcludeSubtypes() []string {
+	if x != nil {
+		return x.ExcludeSubtypes
+	}
+	return nil
+}
+
+func (x *SnapshotRequest) GetRedact() bool {
+	if x != nil {
+		return x.Redact
+	}
+	return false
+}
+
+func (x *SnapshotRequest) GetRedactPatterns() []string {
+	if x != nil {
+		return x.RedactPatterns
+	}
+	return nil
+}
+
+type SnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Snapshot is the JSON snapshot document.
+	Snapshot      []byte `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotResponse) Reset() {
+	*x = SnapshotResponse{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotResponse) ProtoMessage() {}
+
+func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SnapshotResponse) GetSnapshot() []byte {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type RecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*RecipeRequest_Criteria
+	//	*RecipeRequest_Snapshot
+	//	*RecipeRequest_SnapshotRef
+	Source isRecipeRequest_Source `protobuf_oneof:"source"`
+	// Intent is the workload intent for recipes built from a snapshot, which
+	// does not record it.
+	Intent        string `protobuf:"bytes,4,opt,name=intent,proto3" json:"intent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeRequest) Reset() {
+	*x = RecipeRequest{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRequest) ProtoMessage() {}
+
+func (x *RecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRequest.ProtoReflect.Descriptor instead.
+func (*RecipeRequest) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecipeRequest) GetSource() isRecipeRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *RecipeRequest) GetCriteria() *Criteria {
+	if x != nil {
+		if x, ok := x.Source.(*RecipeRequest_Criteria); ok {
+			return x.Criteria
+		}
+	}
+	return nil
+}
+
+func (x *RecipeRequest) GetSnapshot() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*RecipeRequest_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *RecipeRequest) GetSnapshotRef() string {
+	if x != nil {
+		if x, ok := x.Source.(*RecipeRequest_SnapshotRef); ok {
+			return x.SnapshotRef
+		}
+	}
+	return ""
+}
+
+func (x *RecipeRequest) GetIntent() string {
+	if x != nil {
+		return x.Intent
+	}
+	return ""
+}
+
+type isRecipeRequest_Source interface {
+	isRecipeRequest_Source()
+}
+
+type RecipeRequest_Criteria struct {
+	// Criteria select the recipe directly.
+	Criteria *Criteria `protobuf:"bytes,1,opt,name=criteria,proto3,oneof"`
+}
+
+type RecipeRequest_Snapshot struct {
+	// Snapshot is a JSON snapshot document the criteria are detected from.
+	Snapshot []byte `protobuf:"bytes,2,opt,name=snapshot,proto3,oneof"`
+}
+
+type RecipeRequest_SnapshotRef struct {
+	// Not supported by the server, which rejects it: ConfigMap and Secret
+	// snapshot URIs are only read by the CLI. Send snapshot instead.
+	SnapshotRef string `protobuf:"bytes,3,opt,name=snapshot_ref,json=snapshotRef,proto3,oneof"`
+}
+
+func (*RecipeRequest_Criteria) isRecipeRequest_Source() {}
+
+func (*RecipeRequest_Snapshot) isRecipeRequest_Source() {}
+
+func (*RecipeRequest_SnapshotRef) isRecipeRequest_Source() {}
+
+type RecipeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recipe is the JSON recipe document. Recipes built from a snapshot list
+	// where each criteria field came from in metadata.criteriaDetection.
+	Recipe        []byte `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeResponse) Reset() {
+	*x = RecipeResponse{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeResponse) ProtoMessage() {}
+
+func (x *RecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeResponse.ProtoReflect.Descriptor instead.
+func (*RecipeResponse) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecipeResponse) GetRecipe() []byte {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+// BundleRequest carries a recipe and the options of the POST /v1/bundle
+// query parameters with the same names.
+type BundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recipe is the JSON recipe document.
+	Recipe []byte `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// Value overrides, as bundler:path.to.field=value.
+	Set []string `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty"`
+	// Node selectors for system components, as key=value.
+	SystemNodeSelector []string `protobuf:"bytes,3,rep,name=system_node_selector,json=systemNodeSelector,proto3" json:"system_node_selector,omitempty"`
+	// Tolerations for system components, as key=value:effect or key:effect.
+	SystemNodeToleration []string `protobuf:"bytes,4,rep,name=system_node_toleration,json=systemNodeToleration,proto3" json:"system_node_toleration,omitempty"`
+	// Node selectors for GPU nodes, as key=value.
+	AcceleratedNodeSelector []string `protobuf:"bytes,5,rep,name=accelerated_node_selector,json=acceleratedNodeSelector,proto3" json:"accelerated_node_selector,omitempty"`
+	// Tolerations for GPU nodes, as key=value:effect or key:effect.
+	AcceleratedNodeToleration []string `protobuf:"bytes,6,rep,name=accelerated_node_toleration,json=acceleratedNodeToleration,proto3" json:"accelerated_node_toleration,omitempty"`
+	// Deployer is helm (default), argocd, argo-workflows or terraform.
+	Deployer string `protobuf:"bytes,7,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// Repo is the Git repository URL of Argo CD bundles.
+	Repo string `protobuf:"bytes,8,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Target Kubernetes version, e.g. 1.30.
+	KubernetesVersion string `protobuf:"bytes,9,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
+	// Capacity templates to generate: auto, karpenter or cluster-api.
+	CapacityTemplate string `protobuf:"bytes,10,opt,name=capacity_template,json=capacityTemplate,proto3" json:"capacity_template,omitempty"`
+	// Runbook adds runbook.md to the bundle.
+	Runbook bool `protobuf:"varint,11,opt,name=runbook,proto3" json:"runbook,omitempty"`
+	// Format is the archive format, zip (default) or tgz.
+	Format string `protobuf:"bytes,12,opt,name=format,proto3" json:"format,omitempty"`
+	// NodeBootstrap adds bootstrap/ with node bootstrap artifacts to the bundle.
+	NodeBootstrap bool `protobuf:"varint,13,opt,name=node_bootstrap,json=nodeBootstrap,proto3" json:"node_bootstrap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleRequest) Reset() {
+	*x = BundleRequest{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleRequest) ProtoMessage() {}
+
+func (x *BundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleRequest.ProtoReflect.Descriptor instead.
+func (*BundleRequest) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BundleRequest) GetRecipe() []byte {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetSystemNodeSelector() []string {
+	if x != nil {
+		return x.SystemNodeSelector
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetSystemNodeToleration() []string {
+	if x != nil {
+		return x.SystemNodeToleration
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetAcceleratedNodeSelector() []string {
+	if x != nil {
+		return x.AcceleratedNodeSelector
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetAcceleratedNodeToleration() []string {
+	if x != nil {
+		return x.AcceleratedNodeToleration
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetDeployer() string {
+	if x != nil {
+		return x.Deployer
+	}
+	return ""
+}
+
+func (x *BundleRequest) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *BundleRequest) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
+}
+
+func (x *BundleRequest) GetCapacityTemplate() string {
+	if x != nil {
+		return x.CapacityTemplate
+	}
+	return ""
+}
+
+func (x *BundleRequest) GetRunbook() bool {
+	if x != nil {
+		return x.Runbook
+	}
+	return false
+}
+
+func (x *BundleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *BundleRequest) GetNodeBootstrap() bool {
+	if x != nil {
+		return x.NodeBootstrap
+	}
+	return false
+}
+
+type BundleChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Data is the next part of the archive.
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleChunk) Reset() {
+	*x = BundleChunk{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleChunk) ProtoMessage() {}
+
+func (x *BundleChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleChunk.ProtoReflect.Descriptor instead.
+func (*BundleChunk) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BundleChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recipe is the JSON recipe document holding the constraints.
+	Recipe []byte `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// Types that are valid to be assigned to SnapshotSource:
+	//
+	//	*ValidateRequest_Snapshot
+	//	*ValidateRequest_SnapshotRef
+	SnapshotSource isValidateRequest_SnapshotSource `protobuf_oneof:"snapshot_source"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateRequest) GetRecipe() []byte {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetSnapshotSource() isValidateRequest_SnapshotSource {
+	if x != nil {
+		return x.SnapshotSource
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetSnapshot() []byte {
+	if x != nil {
+		if x, ok := x.SnapshotSource.(*ValidateRequest_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetSnapshotRef() string {
+	if x != nil {
+		if x, ok := x.SnapshotSource.(*ValidateRequest_SnapshotRef); ok {
+			return x.SnapshotRef
+		}
+	}
+	return ""
+}
+
+type isValidateRequest_SnapshotSource interface {
+	isValidateRequest_SnapshotSource()
+}
+
+type ValidateRequest_Snapshot struct {
+	// Snapshot is the JSON snapshot document to validate.
+	Snapshot []byte `protobuf:"bytes,2,opt,name=snapshot,proto3,oneof"`
+}
+
+type ValidateRequest_SnapshotRef struct {
+	// Not supported by the server, which rejects it: ConfigMap and Secret
+	// snapshot URIs are only read by the CLI. Send snapshot instead.
+	SnapshotRef string `protobuf:"bytes,3,opt,name=snapshot_ref,json=snapshotRef,proto3,oneof"`
+}
+
+func (*ValidateRequest_Snapshot) isValidateRequest_SnapshotSource() {}
+
+func (*ValidateRequest_SnapshotRef) isValidateRequest_SnapshotSource() {}
+
+type ValidateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Result is the JSON validation result.
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// Status is the overall status: pass, fail or partial.
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_eidos_v1_eidos_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eidos_v1_eidos_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_eidos_v1_eidos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateResponse) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_eidos_v1_eidos_proto protoreflect.FileDescriptor
+
+const file_eidos_v1_eidos_proto_rawDesc = "" +
+	"\n" +
+	"\x14eidos/v1/eidos.proto\x12\beidos.v1\"\xbe\x01\n" +
+	"\bCriteria\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12 \n" +
+	"\vaccelerator\x18\x02 \x01(\tR\vaccelerator\x12\x16\n" +
+	"\x06intent\x18\x03 \x01(\tR\x06intent\x12\x0e\n" +
+	"\x02os\x18\x04 \x01(\tR\x02os\x12\x14\n" +
+	"\x05nodes\x18\x05 \x01(\x05R\x05nodes\x12\x14\n" +
+	"\x05scale\x18\x06 \x01(\tR\x05scale\x12\"\n" +
+	"\fpartitioning\x18\a \x01(\tR\fpartitioning\"\x93\x01\n" +
+	"\x0fSnapshotRequest\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12)\n" +
+	"\x10exclude_subtypes\x18\x02 \x03(\tR\x0fexcludeSubtypes\x12\x16\n" +
+	"\x06redact\x18\x03 \x01(\bR\x06redact\x12'\n" +
+	"\x0fredact_patterns\x18\x04 \x03(\tR\x0eredactPatterns\".\n" +
+	"\x10SnapshotResponse\x12\x1a\n" +
+	"\bsnapshot\x18\x01 \x01(\fR\bsnapshot\"\xa6\x01\n" +
+	"\rRecipeRequest\x120\n" +
+	"\bcriteria\x18\x01 \x01(\v2\x12.eidos.v1.CriteriaH\x00R\bcriteria\x12\x1c\n" +
+	"\bsnapshot\x18\x02 \x01(\fH\x00R\bsnapshot\x12#\n" +
+	"\fsnapshot_ref\x18\x03 \x01(\tH\x00R\vsnapshotRef\x12\x16\n" +
+	"\x06intent\x18\x04 \x01(\tR\x06intentB\b\n" +
+	"\x06source\"(\n" +
+	"\x0eRecipeResponse\x12\x16\n" +
+	"\x06recipe\x18\x01 \x01(\fR\x06recipe\"\x82\x04\n" +
+	"\rBundleRequest\x12\x16\n" +
+	"\x06recipe\x18\x01 \x01(\fR\x06recipe\x12\x10\n" +
+	"\x03set\x18\x02 \x03(\tR\x03set\x120\n" +
+	"\x14system_node_selector\x18\x03 \x03(\tR\x12systemNodeSelector\x124\n" +
+	"\x16system_node_toleration\x18\x04 \x03(\tR\x14systemNodeToleration\x12:\n" +
+	"\x19accelerated_node_selector\x18\x05 \x03(\tR\x17acceleratedNodeSelector\x12>\n" +
+	"\x1baccelerated_node_toleration\x18\x06 \x03(\tR\x19acceleratedNodeToleration\x12\x1a\n" +
+	"\bdeployer\x18\a \x01(\tR\bdeployer\x12\x12\n" +
+	"\x04repo\x18\b \x01(\tR\x04repo\x12-\n" +
+	"\x12kubernetes_version\x18\t \x01(\tR\x11kubernetesVersion\x12+\n" +
+	"\x11capacity_template\x18\n" +
+	" \x01(\tR\x10capacityTemplate\x12\x18\n" +
+	"\arunbook\x18\v \x01(\bR\arunbook\x12\x16\n" +
+	"\x06format\x18\f \x01(\tR\x06format\x12%\n" +
+	"\x0enode_bootstrap\x18\r \x01(\bR\rnodeBootstrap\"!\n" +
+	"\vBundleChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x7f\n" +
+	"\x0fValidateRequest\x12\x16\n" +
+	"\x06recipe\x18\x01 \x01(\fR\x06recipe\x12\x1c\n" +
+	"\bsnapshot\x18\x02 \x01(\fH\x00R\bsnapshot\x12#\n" +
+	"\fsnapshot_ref\x18\x03 \x01(\tH\x00R\vsnapshotRefB\x11\n" +
+	"\x0fsnapshot_source\"B\n" +
+	"\x10ValidateResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\fR\x06result\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status2\x86\x02\n" +
+	"\x05Eidos\x12A\n" +
+	"\bSnapshot\x12\x19.eidos.v1.SnapshotRequest\x1a\x1a.eidos.v1.SnapshotResponse\x12;\n" +
+	"\x06Recipe\x12\x17.eidos.v1.RecipeRequest\x1a\x18.eidos.v1.RecipeResponse\x12:\n" +
+	"\x06Bundle\x12\x17.eidos.v1.BundleRequest\x1a\x15.eidos.v1.BundleChunk0\x01\x12A\n" +
+	"\bValidate\x12\x19.eidos.v1.ValidateRequest\x1a\x1a.eidos.v1.ValidateResponseB.Z,github.com/NVIDIA/eidos/api/eidos/v1;eidosv1b\x06proto3"
+
+var (
+	file_eidos_v1_eidos_proto_rawDescOnce sync.Once
+	file_eidos_v1_eidos_proto_rawDescData []byte
+)
+
+func file_eidos_v1_eidos_proto_rawDescGZIP() []byte {
+	file_eidos_v1_eidos_proto_rawDescOnce.Do(func() {
+		file_eidos_v1_eidos_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eidos_v1_eidos_proto_rawDesc), len(file_eidos_v1_eidos_proto_rawDesc)))
+	})
+	return file_eidos_v1_eidos_proto_rawDescData
+}
+
+var file_eidos_v1_eidos_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_eidos_v1_eidos_proto_goTypes = []any{
+	(*Criteria)(nil),         // 0: eidos.v1.Criteria
+	(*SnapshotRequest)(nil),  // 1: eidos.v1.SnapshotRequest
+	(*SnapshotResponse)(nil), // 2: eidos.v1.SnapshotResponse
+	(*RecipeRequest)(nil),    // 3: eidos.v1.RecipeRequest
+	(*RecipeResponse)(nil),   // 4: eidos.v1.RecipeResponse
+	(*BundleRequest)(nil),    // 5: eidos.v1.BundleRequest
+	(*BundleChunk)(nil),      // 6: eidos.v1.BundleChunk
+	(*ValidateRequest)(nil),  // 7: eidos.v1.ValidateRequest
+	(*ValidateResponse)(nil), // 8: eidos.v1.ValidateResponse
+}
+var file_eidos_v1_eidos_proto_depIdxs = []int32{
+	0, // 0: eidos.v1.RecipeRequest.criteria:type_name -> eidos.v1.Criteria
+	1, // 1: eidos.v1.Eidos.Snapshot:input_type -> eidos.v1.SnapshotRequest
+	3, // 2: eidos.v1.Eidos.Recipe:input_type -> eidos.v1.RecipeRequest
+	5, // 3: eidos.v1.Eidos.Bundle:input_type -> eidos.v1.BundleRequest
+	7, // 4: eidos.v1.Eidos.Validate:input_type -> eidos.v1.ValidateRequest
+	2, // 5: eidos.v1.Eidos.Snapshot:output_type -> eidos.v1.SnapshotResponse
+	4, // 6: eidos.v1.Eidos.Recipe:output_type -> eidos.v1.RecipeResponse
+	6, // 7: eidos.v1.Eidos.Bundle:output_type -> eidos.v1.BundleChunk
+	8, // 8: eidos.v1.Eidos.Validate:output_type -> eidos.v1.ValidateResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_eidos_v1_eidos_proto_init() }
+func file_eidos_v1_eidos_proto_init() {
+	if File_eidos_v1_eidos_proto != nil {
+		return
+	}
+	file_eidos_v1_eidos_proto_msgTypes[3].OneofWrappers = []any{
+		(*RecipeRequest_Criteria)(nil),
+		(*RecipeRequest_Snapshot)(nil),
+		(*RecipeRequest_SnapshotRef)(nil),
+	}
+	file_eidos_v1_eidos_proto_msgTypes[7].OneofWrappers = []any{
+		(*ValidateRequest_Snapshot)(nil),
+		(*ValidateRequest_SnapshotRef)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eidos_v1_eidos_proto_rawDesc), len(file_eidos_v1_eidos_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_eidos_v1_eidos_proto_goTypes,
+		DependencyIndexes: file_eidos_v1_eidos_proto_depIdxs,
+		MessageInfos:      file_eidos_v1_eidos_proto_msgTypes,
+	}.Build()
+	File_eidos_v1_eidos_proto = out.File
+	file_eidos_v1_eidos_proto_goTypes = nil
+	file_eidos_v1_eidos_proto_depIdxs = nil
+}
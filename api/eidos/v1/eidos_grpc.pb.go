@@ -0,0 +1,279 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: eidos/v1/eidos.proto
+
+package eidosv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Eidos_Snapshot_FullMethodName = "/eidos.v1.Eidos/Snapshot"
+	Eidos_Recipe_FullMethodName   = "/eidos.v1.Eidos/Recipe"
+	Eidos_Bundle_FullMethodName   = "/eidos.v1.Eidos/Bundle"
+	Eidos_Validate_FullMethodName = "/eidos.v1.Eidos/Validate"
+)
+
+// EidosClient is the client API for Eidos service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Eidos mirrors the eidosd HTTP API (server.yaml) over gRPC. Recipes,
+// snapshots and validation results are JSON documents with the same schema
+// as the HTTP API, so clients can reuse the types they already decode.
+type EidosClient interface {
+	// Snapshot captures the configuration of the node eidosd runs on, like
+	// `eidos snapshot` without --deploy-agent.
+	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error)
+	// Recipe builds a recipe from criteria (GET /v1/recipe) or from a
+	// snapshot (POST /v1/recipe/from-snapshot).
+	Recipe(ctx context.Context, in *RecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error)
+	// Bundle generates a bundle from a recipe (POST /v1/bundle) and streams
+	// the archive in chunks. The x-bundle-files, x-bundle-size and
+	// x-bundle-duration trailers summarize the bundle.
+	Bundle(ctx context.Context, in *BundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BundleChunk], error)
+	// Validate checks a snapshot against the constraints of a recipe, like
+	// `eidos validate`.
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+}
+
+type eidosClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEidosClient(cc grpc.ClientConnInterface) EidosClient {
+	return &eidosClient{cc}
+}
+
+func (c *eidosClient) Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotResponse)
+	err := c.cc.Invoke(ctx, Eidos_Snapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eidosClient) Recipe(ctx context.Context, in *RecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeResponse)
+	err := c.cc.Invoke(ctx, Eidos_Recipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eidosClient) Bundle(ctx context.Context, in *BundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BundleChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Eidos_ServiceDesc.Streams[0], Eidos_Bundle_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BundleRequest, BundleChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Eidos_BundleClient = grpc.ServerStreamingClient[BundleChunk]
+
+func (c *eidosClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, Eidos_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EidosServer is the server API for Eidos service.
+// All implementations must embed UnimplementedEidosServer
+// for forward compatibility.
+//
+// Eidos mirrors the eidosd HTTP API (server.yaml) over gRPC. Recipes,
+// snapshots and validation results are JSON documents with the same schema
+// as the HTTP API, so clients can reuse the types they already decode.
+type EidosServer interface {
+	// Snapshot captures the configuration of the node eidosd runs on, like
+	// `eidos snapshot` without --deploy-agent.
+	Snapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error)
+	// Recipe builds a recipe from criteria (GET /v1/recipe) or from a
+	// snapshot (POST /v1/recipe/from-snapshot).
+	Recipe(context.Context, *RecipeRequest) (*RecipeResponse, error)
+	// Bundle generates a bundle from a recipe (POST /v1/bundle) and streams
+	// the archive in chunks. The x-bundle-files, x-bundle-size and
+	// x-bundle-duration trailers summarize the bundle.
+	Bundle(*BundleRequest, grpc.ServerStreamingServer[BundleChunk]) error
+	// Validate checks a snapshot against the constraints of a recipe, like
+	// `eidos validate`.
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	mustEmbedUnimplementedEidosServer()
+}
+
+// UnimplementedEidosServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEidosServer struct{}
+
+func (UnimplementedEidosServer) Snapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (UnimplementedEidosServer) Recipe(context.Context, *RecipeRequest) (*RecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recipe not implemented")
+}
+func (UnimplementedEidosServer) Bundle(*BundleRequest, grpc.ServerStreamingServer[BundleChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method Bundle not implemented")
+}
+func (UnimplementedEidosServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedEidosServer) mustEmbedUnimplementedEidosServer() {}
+func (UnimplementedEidosServer) testEmbeddedByValue()               {}
+
+// UnsafeEidosServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EidosServer will
+// result in compilation errors.
+type UnsafeEidosServer interface {
+	mustEmbedUnimplementedEidosServer()
+}
+
+func RegisterEidosServer(s grpc.ServiceRegistrar, srv EidosServer) {
+	// If the following call pancis, it indicates UnimplementedEidosServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Eidos_ServiceDesc, srv)
+}
+
+func _Eidos_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EidosServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Eidos_Snapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EidosServer).Snapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Eidos_Recipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EidosServer).Recipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Eidos_Recipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EidosServer).Recipe(ctx, req.(*RecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Eidos_Bundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EidosServer).Bundle(m, &grpc.GenericServerStream[BundleRequest, BundleChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Eidos_BundleServer = grpc.ServerStreamingServer[BundleChunk]
+
+func _Eidos_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EidosServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Eidos_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EidosServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Eidos_ServiceDesc is the grpc.ServiceDesc for Eidos service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Eidos_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eidos.v1.Eidos",
+	HandlerType: (*EidosServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Snapshot",
+			Handler:    _Eidos_Snapshot_Handler,
+		},
+		{
+			MethodName: "Recipe",
+			Handler:    _Eidos_Recipe_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _Eidos_Validate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Bundle",
+			Handler:       _Eidos_Bundle_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "eidos/v1/eidos.proto",
+}
@@ -17,8 +17,8 @@
 // This package acts as a thin wrapper around the reusable pkg/server package,
 // configuring it with application-specific routes and handlers. It exposes the
 // recipe generation functionality (Step 2 of the four-stage workflow) via REST API.
-// Note: The API server does not support snapshot capture (Step 1) or validation (Step 3);
-// use the CLI for these operations.
+// Note: The REST API does not support snapshot capture (Step 1) or validation (Step 3);
+// use the CLI or the gRPC API for these operations.
 //
 // # Usage
 //
@@ -55,12 +55,30 @@
 // Application Endpoints (with rate limiting):
 //   - GET /v1/recipe  - Generate configuration recipe based on query parameters
 //   - POST /v1/recipe - Generate configuration recipe from criteria body (JSON/YAML)
+//   - POST /v1/recipe/from-snapshot - Generate configuration recipe from a snapshot
+//   - GET /v1/recipe/overlays - List overlays with their criteria, components and constraints
+//   - GET /v1/version - Server version, recipe data digest and component versions
+//   - GET|POST /v1/admin/reload - Reload recipe data without a restart
 //
 // System Endpoints (no rate limiting):
 //   - GET /health  - Health check (liveness probe)
 //   - GET /ready   - Readiness check
 //   - GET /metrics - Prometheus metrics
 //
+// # gRPC API
+//
+// When GRPC_PORT is set, the eidos.v1.Eidos service of pkg/rpc is served on
+// that port alongside HTTP, sharing the recipe builder, allowlists and
+// bundler. It is stopped gracefully when the HTTP server shuts down.
+//
+// # Recipe Data Reload
+//
+// When Eidos_DATA_DIR is set, that directory is layered over the embedded
+// recipe data. Recipe data is reloaded on SIGHUP, on /v1/admin/reload and,
+// when Eidos_DATA_WATCH_INTERVAL is set, whenever a file in the data
+// directory changes. New data is validated before it replaces the data in
+// use, so an invalid update is logged and the previous data keeps serving.
+//
 // # Query Parameters (GET /v1/recipe)
 //
 // The /v1/recipe endpoint accepts these query parameters for GET requests:
@@ -101,8 +119,10 @@
 // The server is configured via environment variables:
 //   - PORT: HTTP server port (default: 8080)
 //   - LOG_LEVEL: Logging level (debug, info, warn, error)
+//   - Eidos_DATA_DIR: External recipe data directory layered over embedded data
+//   - Eidos_DATA_WATCH_INTERVAL: Scan interval for reloading changed data (e.g., 30s)
 //
 // Version information is set at build time using ldflags:
 //
-//	go build -ldflags="-X 'github.com/NVIDIA/eidos/pkg/api.version=1.0.0'"
+//	go build -ldflags="-X 'github.com/NVIDIA/eidos/pkg/buildinfo.version=1.0.0'"
 package api
@@ -0,0 +1,35 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// buildInfo is always 1; the build metadata of the server is in its labels.
+var buildInfo = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "eidos_build_info",
+		Help: "Build metadata of the running server, with a constant value of 1",
+	},
+	[]string{"version", "commit", "go_version", "platform"},
+)
+
+// recordBuildInfo exports the build metadata of the running server.
+func recordBuildInfo(info buildinfo.Info) {
+	buildInfo.WithLabelValues(info.Version, info.Commit, info.GoVersion, info.Platform).Set(1)
+}
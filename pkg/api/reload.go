@@ -0,0 +1,172 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/server"
+)
+
+// dataReloader reloads the recipe data served by the API, either from the
+// embedded data alone or with an external data directory layered over it.
+type dataReloader struct {
+	// dir is the external data directory, or empty for embedded data only.
+	dir string
+
+	// mu serializes reloads triggered by the endpoint, signals and the watcher.
+	mu sync.Mutex
+}
+
+// Reload loads the recipe data again and replaces the data in use. A new
+// layered provider is built so files added to or removed from the data
+// directory are picked up. On error the previous data stays in use.
+func (d *dataReloader) Reload(ctx context.Context) (*recipe.ReloadResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var provider recipe.DataProvider
+	if d.dir != "" {
+		layered, err := recipe.NewLayeredDataProvider(
+			recipe.NewEmbeddedDataProvider(recipe.GetEmbeddedFS(), "data"),
+			recipe.LayeredProviderConfig{ExternalDir: d.dir},
+		)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("failed to load recipe data from %s", d.dir), err)
+		}
+		provider = layered
+	}
+
+	return recipe.ReloadData(ctx, provider)
+}
+
+// HandleReload reloads the recipe data and returns a recipe.ReloadResult.
+// GET and POST are allowed.
+func (d *dataReloader) HandleReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		allowed := []string{http.MethodGet, http.MethodPost}
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		server.WriteError(w, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Method not allowed", false, map[string]any{
+				"method":  r.Method,
+				"allowed": allowed,
+			})
+		return
+	}
+
+	result, err := d.Reload(r.Context())
+	if err != nil {
+		slog.Error("recipe data reload failed", "trigger", "api", "error", err)
+		server.WriteErrorFromErr(w, r, err, "Failed to reload recipe data", nil)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	serializer.RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleSignals reloads the recipe data on every SIGHUP until ctx is done.
+func (d *dataReloader) HandleSignals(ctx context.Context) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGHUP)
+	defer signal.Stop(sig)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sig:
+			d.reloadAndLog(ctx, "signal")
+		}
+	}
+}
+
+// Watch reloads the recipe data whenever a file in the data directory is
+// added, removed or modified. The directory is scanned on every interval.
+func (d *dataReloader) Watch(ctx context.Context, interval time.Duration) {
+	last, err := dirFingerprint(d.dir)
+	if err != nil {
+		slog.Warn("failed to scan recipe data directory", "dir", d.dir, "error", err)
+	}
+	slog.Info("watching recipe data directory", "dir", d.dir, "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current, scanErr := dirFingerprint(d.dir)
+			if scanErr != nil {
+				slog.Warn("failed to scan recipe data directory", "dir", d.dir, "error", scanErr)
+				continue
+			}
+			if current == last {
+				continue
+			}
+			last = current
+			d.reloadAndLog(ctx, "watch")
+		}
+	}
+}
+
+func (d *dataReloader) reloadAndLog(ctx context.Context, trigger string) {
+	if _, err := d.Reload(ctx); err != nil {
+		slog.Error("recipe data reload failed, keeping previous data",
+			"trigger", trigger, "error", err)
+	}
+}
+
+// dirFingerprint returns a digest of the paths, sizes and modification
+// times of the files under dir.
+func dirFingerprint(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const testRegistry = `apiVersion: eidos.nvidia.com/v1alpha1
+kind: ComponentRegistry
+components: []
+`
+
+// restoreRecipeData puts the current recipe data back after the test.
+func restoreRecipeData(t *testing.T) {
+	t.Helper()
+	provider := recipe.GetDataProvider()
+	t.Cleanup(func() {
+		if _, err := recipe.ReloadData(context.Background(), provider); err != nil {
+			t.Errorf("failed to restore recipe data: %v", err)
+		}
+	})
+}
+
+func writeDataFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
+
+func TestHandleReload(t *testing.T) {
+	restoreRecipeData(t)
+	dir := t.TempDir()
+	writeDataFile(t, dir, "registry.yaml", testRegistry)
+	reloader := &dataReloader{dir: dir}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		w := httptest.NewRecorder()
+		reloader.HandleReload(w, httptest.NewRequest(method, "/v1/admin/reload", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s status = %d, want %d: %s", method, w.Code, http.StatusOK, w.Body.String())
+		}
+		var result recipe.ReloadResult
+		if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if result.Components == 0 || result.Overlays == 0 {
+			t.Errorf("unexpected reload result: %+v", result)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	reloader.HandleReload(w, httptest.NewRequest(http.MethodDelete, "/v1/admin/reload", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("DELETE status = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestDataReloader_KeepsPreviousDataOnError(t *testing.T) {
+	restoreRecipeData(t)
+	dir := t.TempDir()
+	writeDataFile(t, dir, "registry.yaml", testRegistry)
+	reloader := &dataReloader{dir: dir}
+
+	if _, err := reloader.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	previous := recipe.GetDataProvider()
+
+	writeDataFile(t, dir, "registry.yaml", "components: [\n")
+	w := httptest.NewRecorder()
+	reloader.HandleReload(w, httptest.NewRequest(http.MethodGet, "/v1/admin/reload", nil))
+	if w.Code == http.StatusOK {
+		t.Fatal("reload of invalid data should fail")
+	}
+	if recipe.GetDataProvider() != previous {
+		t.Error("data provider should not change when reload fails")
+	}
+}
+
+func TestDirFingerprint(t *testing.T) {
+	dir := t.TempDir()
+	writeDataFile(t, dir, "registry.yaml", testRegistry)
+
+	first, err := dirFingerprint(dir)
+	if err != nil {
+		t.Fatalf("dirFingerprint() error = %v", err)
+	}
+	again, err := dirFingerprint(dir)
+	if err != nil {
+		t.Fatalf("dirFingerprint() error = %v", err)
+	}
+	if first != again {
+		t.Error("fingerprint should be stable when nothing changes")
+	}
+
+	writeDataFile(t, dir, "overlays/custom.yaml", "kind: RecipeMetadata\n")
+	changed, err := dirFingerprint(dir)
+	if err != nil {
+		t.Fatalf("dirFingerprint() error = %v", err)
+	}
+	if changed == first {
+		t.Error("fingerprint should change when a file is added")
+	}
+}
+
+func TestDataReloaderFromEnv(t *testing.T) {
+	restoreRecipeData(t)
+	dir := t.TempDir()
+	writeDataFile(t, dir, "registry.yaml", testRegistry)
+
+	t.Run("embedded only", func(t *testing.T) {
+		t.Setenv(dataDirEnv, "")
+		reloader, interval, err := dataReloaderFromEnv(context.Background())
+		if err != nil {
+			t.Fatalf("dataReloaderFromEnv() error = %v", err)
+		}
+		if reloader.dir != "" || interval != 0 {
+			t.Errorf("got dir %q interval %v, want embedded data without watching", reloader.dir, interval)
+		}
+	})
+
+	t.Run("watch interval", func(t *testing.T) {
+		t.Setenv(dataDirEnv, dir)
+		t.Setenv(dataWatchIntervalEnv, "5s")
+		_, interval, err := dataReloaderFromEnv(context.Background())
+		if err != nil {
+			t.Fatalf("dataReloaderFromEnv() error = %v", err)
+		}
+		if interval.String() != "5s" {
+			t.Errorf("interval = %v, want 5s", interval)
+		}
+	})
+
+	t.Run("invalid watch interval", func(t *testing.T) {
+		t.Setenv(dataDirEnv, dir)
+		t.Setenv(dataWatchIntervalEnv, "soon")
+		if _, _, err := dataReloaderFromEnv(context.Background()); err == nil {
+			t.Error("expected error for invalid watch interval")
+		}
+	})
+}
+
+func TestBundleMaxConcurrentFromEnv(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		t.Setenv(bundleMaxConcurrentEnv, "")
+		n, err := bundleMaxConcurrentFromEnv()
+		if err != nil || n != defaultBundleMaxConcurrent {
+			t.Errorf("bundleMaxConcurrentFromEnv() = %d, %v, want %d", n, err, defaultBundleMaxConcurrent)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv(bundleMaxConcurrentEnv, "0")
+		n, err := bundleMaxConcurrentFromEnv()
+		if err != nil || n != 0 {
+			t.Errorf("bundleMaxConcurrentFromEnv() = %d, %v, want 0", n, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv(bundleMaxConcurrentEnv, "-1")
+		if _, err := bundleMaxConcurrentFromEnv(); err == nil {
+			t.Error("expected error for negative limit")
+		}
+	})
+}
@@ -18,25 +18,51 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"strconv"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
 	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/bundler/jobs"
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/janitor"
 	"github.com/NVIDIA/eidos/pkg/logging"
 	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/rpc"
 	"github.com/NVIDIA/eidos/pkg/server"
 )
 
 const (
-	name           = "eidosd"
-	versionDefault = "dev"
-)
+	name = "eidosd"
+
+	// bundleJobDirEnv selects a directory for persistent async bundle jobs.
+	bundleJobDirEnv = "Eidos_BUNDLE_JOB_DIR"
+
+	// grpcPortEnv enables the gRPC API on the given port.
+	grpcPortEnv = "GRPC_PORT"
 
-var (
-	// overridden during build with ldflags to reflect actual version info
-	// e.g., -X "github.com/NVIDIA/eidos/pkg/api.version=1.0.0"
-	version = versionDefault
-	commit  = "unknown"
-	date    = "unknown"
+	// dataDirEnv layers an external recipe data directory over the embedded data.
+	dataDirEnv = "Eidos_DATA_DIR"
+
+	// dataWatchIntervalEnv enables reloading recipe data when files in the
+	// data directory change, scanning it on the given interval.
+	dataWatchIntervalEnv = "Eidos_DATA_WATCH_INTERVAL"
+
+	// bundleMaxConcurrentEnv limits the bundles generated at once by
+	// synchronous requests, async jobs and gRPC calls together.
+	bundleMaxConcurrentEnv = "Eidos_BUNDLE_MAX_CONCURRENT"
+
+	// defaultBundleMaxConcurrent bounds the memory and disk used by bundles
+	// generated at once.
+	defaultBundleMaxConcurrent = 4
 )
 
 // Serve starts the API server and blocks until shutdown.
@@ -44,14 +70,16 @@ var (
 // Returns an error if the server fails to start or encounters a fatal error.
 func Serve() error {
 	ctx := context.Background()
+	info := buildinfo.Get()
 
-	logging.SetDefaultStructuredLogger(name, version)
+	logging.SetDefaultStructuredLogger(name, info.Version)
 	slog.Debug("starting",
 		"name", name,
-		"version", version,
-		"commit", commit,
-		"date", date,
+		"version", info.Version,
+		"commit", info.Commit,
+		"date", info.Date,
 	)
+	recordBuildInfo(info)
 
 	// Parse allowlists from environment variables
 	allowLists, err := recipe.ParseAllowListsFromEnv()
@@ -74,32 +102,81 @@ func Serve() error {
 		)
 	}
 
+	// Load recipe data, layering the external data directory if configured
+	reloader, watchInterval, err := dataReloaderFromEnv(ctx)
+	if err != nil {
+		return err
+	}
+	reloadCtx, stopReload := context.WithCancel(ctx)
+	defer stopReload()
+	go reloader.HandleSignals(reloadCtx)
+	if watchInterval > 0 {
+		go reloader.Watch(reloadCtx, watchInterval)
+	}
+
 	// Setup recipe handler
 	rb := recipe.NewBuilder(
-		recipe.WithVersion(version),
+		recipe.WithVersion(info.Version),
 		recipe.WithAllowLists(allowLists),
 	)
 
+	// Reclaim bundle scratch directories abandoned by crashed or killed requests
+	janitorOpts, err := janitor.OptionsFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to parse janitor configuration from environment: %w", err)
+	}
+	j := janitor.New(janitorOpts...)
+	janitorCtx, stopJanitor := context.WithCancel(ctx)
+	defer stopJanitor()
+	go j.Run(janitorCtx)
+
+	// Async bundle jobs are kept in memory unless a persistent store is configured
+	jobStore, err := jobStoreFromEnv()
+	if err != nil {
+		return err
+	}
+	bundleMaxConcurrent, err := bundleMaxConcurrentFromEnv()
+	if err != nil {
+		return err
+	}
+
 	// Setup bundle handler
 	bb, err := bundler.New(
 		bundler.WithAllowLists(allowLists),
+		bundler.WithJanitor(j),
+		bundler.WithJobStore(jobStore),
+		bundler.WithMaxConcurrent(bundleMaxConcurrent),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create bundler: %w", err)
 	}
+	go bb.RunJobPruner(janitorCtx, defaults.BundleJobPruneInterval)
 
 	r := map[string]http.HandlerFunc{
-		"/v1/recipe": rb.HandleRecipes,
-		"/v1/bundle": bb.HandleBundles,
+		"/v1/recipe":               rb.HandleRecipes,
+		"/v1/recipe/from-snapshot": rb.HandleRecipeFromSnapshot,
+		"/v1/recipe/overlays":      rb.HandleOverlays,
+		"/v1/bundle":               bb.HandleBundles,
+		"/v1/bundle/{id}/status":   bb.HandleBundleJobStatus,
+		"/v1/bundle/{id}/download": bb.HandleBundleJobDownload,
+		"/v1/version":              HandleVersion,
+		"/v1/admin/reload":         reloader.HandleReload,
 	}
 
 	// Create and run server
 	s := server.New(
 		server.WithName(name),
-		server.WithVersion(version),
+		server.WithVersion(info.Version),
 		server.WithHandler(r),
 	)
 
+	// Serve the gRPC API alongside HTTP when a port is configured
+	stopGRPC, err := serveGRPC(info.Version, rb, bb, s)
+	if err != nil {
+		return err
+	}
+	defer stopGRPC()
+
 	if err := s.Run(ctx); err != nil {
 		slog.Error("server exited with error", "error", err)
 		return err
@@ -107,3 +184,116 @@ func Serve() error {
 
 	return nil
 }
+
+// jobStoreFromEnv returns a file-backed bundle job store when
+// Eidos_BUNDLE_JOB_DIR is set, or nil to use the in-memory default.
+func jobStoreFromEnv() (jobs.Store, error) {
+	dir := os.Getenv(bundleJobDirEnv)
+	if dir == "" {
+		return nil, nil
+	}
+
+	store, err := jobs.NewFileStore(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bundle job store: %w", err)
+	}
+	slog.Info("bundle job store configured", "dir", dir)
+	return store, nil
+}
+
+// bundleMaxConcurrentFromEnv returns the limit of bundles generated at once
+// from Eidos_BUNDLE_MAX_CONCURRENT, where 0 disables it.
+func bundleMaxConcurrentFromEnv() (int, error) {
+	v := os.Getenv(bundleMaxConcurrentEnv)
+	if v == "" {
+		return defaultBundleMaxConcurrent, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %q", bundleMaxConcurrentEnv, v)
+	}
+	return n, nil
+}
+
+// dataReloaderFromEnv returns the recipe data reloader and the data
+// directory watch interval (zero when watching is disabled). When
+// Eidos_DATA_DIR is set, its data is loaded and validated before the
+// server starts.
+func dataReloaderFromEnv(ctx context.Context) (*dataReloader, time.Duration, error) {
+	reloader := &dataReloader{dir: os.Getenv(dataDirEnv)}
+	if reloader.dir == "" {
+		return reloader, 0, nil
+	}
+
+	if _, err := reloader.Reload(ctx); err != nil {
+		return nil, 0, fmt.Errorf("failed to load recipe data: %w", err)
+	}
+	slog.Info("external recipe data configured", "dir", reloader.dir)
+
+	v := os.Getenv(dataWatchIntervalEnv)
+	if v == "" {
+		return reloader, 0, nil
+	}
+	interval, err := time.ParseDuration(v)
+	if err != nil || interval <= 0 {
+		return nil, 0, fmt.Errorf("invalid %s %q", dataWatchIntervalEnv, v)
+	}
+	return reloader, interval, nil
+}
+
+// serveGRPC starts the gRPC API on GRPC_PORT, if set, with the recipe
+// builder and bundler of the HTTP API. Calls are admitted by hs with the
+// rate limits and authentication of the HTTP API, over the same TLS
+// configuration, and messages are limited to its request body size. The
+// returned function stops it gracefully.
+func serveGRPC(version string, rb *recipe.Builder, bb *bundler.DefaultBundler, hs *server.Server) (func(), error) {
+	portStr := os.Getenv(grpcPortEnv)
+	if portStr == "" {
+		return func() {}, nil
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 {
+		return nil, fmt.Errorf("invalid %s %q", grpcPortEnv, portStr)
+	}
+
+	srv, err := rpc.New(
+		rpc.WithVersion(version),
+		rpc.WithRecipeBuilder(rb),
+		rpc.WithBundler(bb),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC server: %w", err)
+	}
+
+	tlsConfig, err := hs.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(rpc.UnaryLogger(), rpc.UnaryAdmission(hs)),
+		grpc.ChainStreamInterceptor(rpc.StreamLogger(), rpc.StreamAdmission(hs)),
+	}
+	if n := hs.MaxRequestBodyBytes(); n > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(int(n)))
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on gRPC port %d: %w", port, err)
+	}
+
+	gs := grpc.NewServer(opts...)
+	srv.Register(gs)
+	healthpb.RegisterHealthServer(gs, health.NewServer())
+
+	go func() {
+		slog.Info("gRPC server listening", "address", lis.Addr().String())
+		if err := gs.Serve(lis); err != nil {
+			slog.Error("gRPC server exited with error", "error", err)
+		}
+	}()
+	return gs.GracefulStop, nil
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
 	"github.com/NVIDIA/eidos/pkg/bundler"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 )
@@ -39,7 +40,7 @@ import (
 // - It integrates with the pkg/server package
 //
 // Instead, these tests verify:
-// - Package constants and build variables are correct
+// - Package constants and build metadata are correct
 // - Route configuration structure is valid
 // - Recipe builder integration works correctly
 // - HTTP handlers respond properly to various inputs
@@ -56,18 +57,15 @@ func TestConstants(t *testing.T) {
 		t.Errorf("name = %q, want %q", name, "eidosd")
 	}
 
-	if versionDefault != "dev" {
-		t.Errorf("versionDefault = %q, want %q", versionDefault, "dev")
-	}
-
-	// Verify buildtime variables exist (they may have default values)
-	if version == "" {
+	// Build metadata comes from pkg/buildinfo (it may have default values)
+	info := buildinfo.Get()
+	if info.Version == "" {
 		t.Error("version should not be empty")
 	}
-	if commit == "" {
+	if info.Commit == "" {
 		t.Error("commit should not be empty")
 	}
-	if date == "" {
+	if info.Date == "" {
 		t.Error("date should not be empty")
 	}
 }
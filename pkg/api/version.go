@@ -0,0 +1,48 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/server"
+)
+
+// HandleVersion returns the server's buildinfo.Report: its version and git
+// commit, the recipe data digest, and the registered bundler, deployer and
+// collector versions. Only GET is allowed.
+func HandleVersion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		server.WriteError(w, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Method not allowed", false, map[string]any{
+				"method":  r.Method,
+				"allowed": []string{http.MethodGet},
+			})
+		return
+	}
+
+	report, err := buildinfo.NewReport(r.Context())
+	if err != nil {
+		server.WriteErrorFromErr(w, r, err, "Failed to build version report", nil)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	serializer.RespondJSON(w, http.StatusOK, report)
+}
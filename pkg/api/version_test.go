@@ -0,0 +1,69 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
+)
+
+// TestVersionEndpoint tests the /v1/version endpoint
+func TestVersionEndpoint(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/version", nil)
+	w := httptest.NewRecorder()
+
+	HandleVersion(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var report buildinfo.Report
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if report.Info != buildinfo.Get() {
+		t.Errorf("build info = %+v, want %+v", report.Info, buildinfo.Get())
+	}
+	if report.RecipeData == nil || report.RecipeData.Digest == "" {
+		t.Error("expected recipe data digest in response")
+	}
+	if len(report.Bundlers) == 0 || len(report.Deployers) == 0 || len(report.Collectors.Types) == 0 {
+		t.Errorf("expected bundler, deployer and collector versions, got %+v", report)
+	}
+}
+
+// TestVersionEndpointMethods verifies only GET is allowed
+func TestVersionEndpointMethods(t *testing.T) {
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			req := httptest.NewRequest(method, "/v1/version", nil)
+			w := httptest.NewRecorder()
+
+			HandleVersion(w, req)
+
+			if w.Code != http.StatusMethodNotAllowed {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+			}
+			if allow := w.Header().Get("Allow"); allow != http.MethodGet {
+				t.Errorf("Allow = %q, want %q", allow, http.MethodGet)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildinfo
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+const (
+	// versionDefault is the version of builds without release metadata.
+	versionDefault = "dev"
+
+	// unknown is reported for build metadata that is not available.
+	unknown = "unknown"
+)
+
+var (
+	// overridden during build with ldflags to reflect actual version info
+	// e.g., -X "github.com/NVIDIA/eidos/pkg/buildinfo.version=1.0.0"
+	version = versionDefault
+	commit  = unknown
+	date    = unknown
+)
+
+// Info is the build metadata of the running binary.
+type Info struct {
+	// Version is the release version, or "dev" for local builds.
+	Version string `json:"version" yaml:"version"`
+
+	// Commit is the git commit the binary was built from.
+	Commit string `json:"commit" yaml:"commit"`
+
+	// Date is the build or commit date.
+	Date string `json:"date" yaml:"date"`
+
+	// GoVersion is the Go toolchain the binary was built with.
+	GoVersion string `json:"goVersion" yaml:"goVersion"`
+
+	// Platform is the OS and architecture the binary was built for.
+	Platform string `json:"platform" yaml:"platform"`
+}
+
+var (
+	infoOnce sync.Once
+	info     Info
+)
+
+// Get returns the build metadata of the running binary. Values not set with
+// ldflags fall back to the module version and VCS stamping recorded by the
+// Go toolchain, so `go install` and `go build` binaries report their commit.
+func Get() Info {
+	infoOnce.Do(func() {
+		info = Info{
+			Version:   version,
+			Commit:    commit,
+			Date:      date,
+			GoVersion: runtime.Version(),
+			Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		}
+		if bi, ok := debug.ReadBuildInfo(); ok {
+			applyBuildInfo(&info, bi)
+		}
+	})
+	return info
+}
+
+// applyBuildInfo fills metadata missing from info from bi.
+func applyBuildInfo(info *Info, bi *debug.BuildInfo) {
+	if info.Version == versionDefault && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		info.Version = bi.Main.Version
+	}
+
+	var fromVCS, modified bool
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			if info.Commit == unknown {
+				info.Commit = shortCommit(s.Value)
+				fromVCS = true
+			}
+		case "vcs.time":
+			if info.Date == unknown {
+				info.Date = s.Value
+			}
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if fromVCS && modified {
+		info.Commit += "-dirty"
+	}
+}
+
+// shortCommit abbreviates a commit hash the way release builds record it.
+func shortCommit(rev string) string {
+	if len(rev) > 7 {
+		return rev[:7]
+	}
+	return rev
+}
+
+// Version returns the release version of the running binary.
+func Version() string {
+	return Get().Version
+}
+
+// String returns the version with its commit and date, as printed by --version.
+func (i Info) String() string {
+	return fmt.Sprintf("%s (commit: %s, date: %s)", i.Version, i.Commit, i.Date)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+)
+
+func TestApplyBuildInfo(t *testing.T) {
+	vcs := func(modified string) []debug.BuildSetting {
+		return []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "0123456789abcdef"},
+			{Key: "vcs.time", Value: "2025-01-02T03:04:05Z"},
+			{Key: "vcs.modified", Value: modified},
+		}
+	}
+
+	tests := []struct {
+		name string
+		info Info
+		bi   debug.BuildInfo
+		want Info
+	}{
+		{
+			name: "ldflags take precedence",
+			info: Info{Version: "v1.2.3", Commit: "abc1234", Date: "2025-01-01"},
+			bi:   debug.BuildInfo{Main: debug.Module{Version: "v9.9.9"}, Settings: vcs("true")},
+			want: Info{Version: "v1.2.3", Commit: "abc1234", Date: "2025-01-01"},
+		},
+		{
+			name: "vcs fallback",
+			info: Info{Version: versionDefault, Commit: unknown, Date: unknown},
+			bi:   debug.BuildInfo{Main: debug.Module{Version: "(devel)"}, Settings: vcs("false")},
+			want: Info{Version: versionDefault, Commit: "0123456", Date: "2025-01-02T03:04:05Z"},
+		},
+		{
+			name: "modified tree",
+			info: Info{Version: versionDefault, Commit: unknown, Date: unknown},
+			bi:   debug.BuildInfo{Settings: vcs("true")},
+			want: Info{Version: versionDefault, Commit: "0123456-dirty", Date: "2025-01-02T03:04:05Z"},
+		},
+		{
+			name: "module version from go install",
+			info: Info{Version: versionDefault, Commit: unknown, Date: unknown},
+			bi:   debug.BuildInfo{Main: debug.Module{Version: "v0.5.0"}},
+			want: Info{Version: "v0.5.0", Commit: unknown, Date: unknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.info
+			applyBuildInfo(&got, &tt.bi)
+			if got != tt.want {
+				t.Errorf("applyBuildInfo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	info := Get()
+	if info.Version == "" || info.Commit == "" || info.Date == "" {
+		t.Errorf("Get() has empty fields: %+v", info)
+	}
+	if info.GoVersion != runtime.Version() {
+		t.Errorf("GoVersion = %q, want %q", info.GoVersion, runtime.Version())
+	}
+	if want := runtime.GOOS + "/" + runtime.GOARCH; info.Platform != want {
+		t.Errorf("Platform = %q, want %q", info.Platform, want)
+	}
+	if Version() != info.Version {
+		t.Errorf("Version() = %q, want %q", Version(), info.Version)
+	}
+}
+
+func TestInfoString(t *testing.T) {
+	info := Info{Version: "v1.0.0", Commit: "abc1234", Date: "2025-01-01"}
+	want := "v1.0.0 (commit: abc1234, date: 2025-01-01)"
+	if got := info.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
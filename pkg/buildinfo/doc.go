@@ -0,0 +1,34 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package buildinfo provides the build metadata of eidos binaries.
+//
+// Release builds stamp the version, commit and build date with ldflags:
+//
+//	-X github.com/NVIDIA/eidos/pkg/buildinfo.version=v1.0.0
+//	-X github.com/NVIDIA/eidos/pkg/buildinfo.commit=abc1234
+//	-X github.com/NVIDIA/eidos/pkg/buildinfo.date=2025-01-01T00:00:00Z
+//
+// Values not stamped fall back to the module version and VCS information the
+// Go toolchain records, so local builds still report their commit.
+//
+// Both the CLI and the API server read their version from this package:
+//
+//	info := buildinfo.Get()
+//	fmt.Println(info.String()) // v1.0.0 (commit: abc1234, date: 2025-01-01T00:00:00Z)
+//
+// NewReport extends the build metadata with the recipe data digest and the
+// registered bundlers, deployers and collectors. It backs the `eidos version`
+// command and the GET /v1/version endpoint.
+package buildinfo
@@ -0,0 +1,123 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildinfo
+
+import (
+	"context"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+// Report describes a binary and everything it was built with: its build
+// metadata, the recipe data it serves, and the versions of the registered
+// bundlers, deployers and collectors. It is returned by GET /v1/version and
+// printed by `eidos version`.
+type Report struct {
+	Info `yaml:",inline"`
+
+	// RecipeData identifies the recipe data in use.
+	RecipeData *recipe.DataInfo `json:"recipeData" yaml:"recipeData"`
+
+	// Bundlers lists the components the bundler can generate, with the
+	// chart version or tag the base recipe pins for each one.
+	Bundlers []BundlerVersion `json:"bundlers" yaml:"bundlers"`
+
+	// Deployers lists the supported deployment methods.
+	Deployers []string `json:"deployers" yaml:"deployers"`
+
+	// Collectors describes the snapshot collectors.
+	Collectors CollectorVersions `json:"collectors" yaml:"collectors"`
+}
+
+// BundlerVersion is a component registered with the bundler.
+type BundlerVersion struct {
+	// Name is the component name used in recipes.
+	Name string `json:"name" yaml:"name"`
+
+	// Source is the Helm repository or Kustomize source of the component.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+
+	// Chart is the Helm chart name, empty for Kustomize components.
+	Chart string `json:"chart,omitempty" yaml:"chart,omitempty"`
+
+	// Version is the chart version or Kustomize tag of the base recipe,
+	// falling back to the registry default.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+// CollectorVersions describes the snapshot collectors.
+type CollectorVersions struct {
+	// SchemaVersion is the snapshot schema version the collectors write.
+	SchemaVersion int `json:"schemaVersion" yaml:"schemaVersion"`
+
+	// Types lists the measurement types collected.
+	Types []measurement.Type `json:"types" yaml:"types"`
+}
+
+// NewReport returns the Report of the running binary, reading the recipe
+// data from the global data provider.
+func NewReport(ctx context.Context) (*Report, error) {
+	data, err := recipe.GetDataInfo(recipe.GetDataProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := recipe.NewBuilder(recipe.WithVersion(Version())).BuildFromCriteria(ctx, recipe.NewCriteria())
+	if err != nil {
+		return nil, err
+	}
+	pinned := make(map[string]string, len(base.ComponentRefs))
+	for _, ref := range base.ComponentRefs {
+		if v := ref.Version + ref.Tag; v != "" {
+			pinned[ref.ComponentName()] = v
+		}
+	}
+
+	report := &Report{
+		Info:       Get(),
+		RecipeData: data,
+		Bundlers:   make([]BundlerVersion, 0, len(registry.Components)),
+		Deployers:  config.GetDeployerTypes(),
+		Collectors: CollectorVersions{
+			SchemaVersion: snapshotter.SchemaVersion,
+			Types:         measurement.Types,
+		},
+	}
+	for _, c := range registry.Components {
+		bv := BundlerVersion{Name: c.Name}
+		if c.Helm.DefaultChart != "" || c.Helm.DefaultRepository != "" {
+			bv.Source = c.Helm.DefaultRepository
+			bv.Chart = c.Helm.DefaultChart
+			bv.Version = c.Helm.DefaultVersion
+		} else {
+			bv.Source = c.Kustomize.DefaultSource
+			bv.Version = c.Kustomize.DefaultTag
+		}
+		if v, ok := pinned[c.Name]; ok {
+			bv.Version = v
+		}
+		report.Bundlers = append(report.Bundlers, bv)
+	}
+
+	return report, nil
+}
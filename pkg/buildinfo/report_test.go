@@ -0,0 +1,71 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildinfo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+func TestNewReport(t *testing.T) {
+	report, err := NewReport(context.Background())
+	if err != nil {
+		t.Fatalf("NewReport() error = %v", err)
+	}
+
+	if report.Info != Get() {
+		t.Errorf("Info = %+v, want %+v", report.Info, Get())
+	}
+	if report.RecipeData == nil || report.RecipeData.Digest == "" {
+		t.Errorf("RecipeData = %+v, want a digest", report.RecipeData)
+	}
+	if len(report.Deployers) == 0 {
+		t.Error("Deployers should list the deployment methods")
+	}
+	if report.Collectors.SchemaVersion != snapshotter.SchemaVersion || len(report.Collectors.Types) == 0 {
+		t.Errorf("Collectors = %+v", report.Collectors)
+	}
+
+	var gpuOperator *BundlerVersion
+	for i := range report.Bundlers {
+		if report.Bundlers[i].Name == "gpu-operator" {
+			gpuOperator = &report.Bundlers[i]
+		}
+	}
+	if gpuOperator == nil {
+		t.Fatal("Bundlers should include gpu-operator")
+	}
+	if gpuOperator.Chart == "" || gpuOperator.Version == "" {
+		t.Errorf("gpu-operator bundler = %+v, want chart and version", gpuOperator)
+	}
+
+	// Build metadata is flattened into the top level of the JSON document.
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"version", "commit", "date", "recipeData", "bundlers", "deployers", "collectors"} {
+		if _, ok := doc[key]; !ok {
+			t.Errorf("JSON report missing %q", key)
+		}
+	}
+}
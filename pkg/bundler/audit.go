@@ -0,0 +1,136 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/audit"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// writeAuditLog writes audit.json recording who generated the bundle, the
+// recipe, the effective config and the provenance of the component values.
+// Like bundle.yaml it records the generation rather than the deployable
+// content, so checksums.txt does not list it and stays reproducible; the
+// bundle manifest records its digest instead. It returns the file path and
+// size.
+func (b *DefaultBundler) writeAuditLog(sourceRecipe, recipeResult *recipe.RecipeResult, recipeDigest string, provenance map[string]*audit.Tracker, dir string) (string, int64, error) {
+	l := &audit.Log{
+		GeneratedAt: time.Now().UTC(),
+		Generator:   audit.CurrentGenerator(b.Config.Version()),
+		Recipe: audit.Recipe{
+			Version: sourceRecipe.GetVersion(),
+			Digest:  recipeDigest,
+		},
+		Config:     b.auditConfig(),
+		Components: make([]audit.Component, 0, len(recipeResult.ComponentRefs)),
+	}
+	for _, ref := range recipeResult.ComponentRefs {
+		l.Components = append(l.Components, audit.Component{
+			Name:      ref.Name,
+			Namespace: b.componentNamespace(ref),
+			Version:   ref.Version,
+			Values:    provenance[ref.Name].Values(),
+		})
+	}
+
+	path, size, err := l.Write(dir)
+	if err != nil {
+		return "", 0, err
+	}
+
+	slog.Debug("wrote audit log", "path", path, "components", len(l.Components))
+	return path, size, nil
+}
+
+// auditConfig returns the effective config recorded in the audit log.
+func (b *DefaultBundler) auditConfig() audit.Config {
+	cfg := b.Config
+	c := audit.Config{
+		Deployer:                string(cfg.Deployer()),
+		Profile:                 cfg.Profile(),
+		RepoURL:                 cfg.RepoURL(),
+		ApplicationSetGenerator: string(cfg.ApplicationSet()),
+		ClusterSelector:         cfg.ClusterSelector(),
+		KubernetesVersion:       cfg.KubernetesVersion(),
+		ValueOverrides:          cfg.ValueOverrides(),
+		StrictOverrides:         cfg.StrictOverrides(),
+		ExtraManifests:          cfg.ExtraManifests(),
+		NodeScheduling: audit.NodeScheduling{
+			System: audit.NodePlacement{
+				NodeSelector: cfg.SystemNodeSelector(),
+				Tolerations:  cfg.SystemNodeTolerations(),
+			},
+			Accelerated: audit.NodePlacement{
+				NodeSelector: cfg.AcceleratedNodeSelector(),
+				Tolerations:  cfg.AcceleratedNodeTolerations(),
+			},
+		},
+		ImageRegistryMirror: cfg.ImageRegistryMirror(),
+		SecretBackend:       string(cfg.SecretBackend()),
+		SecretStore:         cfg.SecretStore(),
+		HTTPProxy:           redactURL(cfg.HTTPProxy()),
+		NoProxy:             cfg.NoProxy(),
+		VersionPolicy:       cfg.VersionPolicy(),
+		SchemaValidation:    string(cfg.SchemaValidation()),
+		SchemaDir:           cfg.SchemaDir(),
+		CapacityTemplate:    string(cfg.CapacityTemplate()),
+		PluginDir:           cfg.PluginDir(),
+		PreviousBundle:      cfg.PreviousBundle(),
+		LockedFile:          cfg.LockedFile(),
+		ResolveImageDigests: cfg.ResolveImageDigests(),
+		IncludeReadme:       cfg.IncludeReadme(),
+		IncludeChecksums:    cfg.IncludeChecksums(),
+		Runbook:             cfg.Runbook(),
+		NodeBootstrap:       cfg.NodeBootstrap(),
+		NodeLabels:          cfg.NodeLabels(),
+	}
+	if c.SecretBackend == "" {
+		c.SecretStore = ""
+	}
+
+	if patches := cfg.ValuePatches(); len(patches) > 0 {
+		c.ValuePatches = make(map[string]int, len(patches))
+		for component, list := range patches {
+			c.ValuePatches[component] = len(list)
+		}
+	}
+	if scopes := cfg.InstallScopes(); len(scopes) > 0 {
+		c.InstallScopes = make(map[string]string, len(scopes))
+		for component, scope := range scopes {
+			c.InstallScopes[component] = string(scope)
+		}
+	}
+	if ca := cfg.CABundle(); len(ca) > 0 {
+		sum := sha256.Sum256(ca)
+		c.CABundleSHA256 = hex.EncodeToString(sum[:])
+	}
+	return c
+}
+
+// redactURL returns raw with any password in it masked, or raw unchanged
+// when it does not parse as a URL.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return u.Redacted()
+}
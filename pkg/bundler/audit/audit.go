@@ -0,0 +1,380 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+	"github.com/NVIDIA/eidos/pkg/policy"
+)
+
+// FileName is the name of the audit log written at the bundle root.
+const FileName = "audit.json"
+
+// SchemaVersion is the version of the audit log layout written by Write.
+const SchemaVersion = "v1"
+
+// GeneratorName identifies eidos as the tool that generated a bundle.
+const GeneratorName = "eidos"
+
+// Source is the bundle generation step that set a component value.
+type Source string
+
+const (
+	// SourceRecipe marks values taken from the recipe and its overlays.
+	SourceRecipe Source = "recipe"
+
+	// SourcePlugin marks values provided by a bundler plugin.
+	SourcePlugin Source = "plugin"
+
+	// SourceInstallScope marks the namespace scope values of components
+	// installed with the namespace install scope.
+	SourceInstallScope Source = "install-scope"
+
+	// SourceProfile marks values preset by the bundle profile.
+	SourceProfile Source = "profile"
+
+	// SourceOverride marks values set by --set overrides.
+	SourceOverride Source = "override"
+
+	// SourceNodeScheduling marks the node selectors and tolerations.
+	SourceNodeScheduling Source = "node-scheduling"
+
+	// SourceProxy marks the proxy env vars and CA bundle.
+	SourceProxy Source = "proxy"
+
+	// SourceValuesPatch marks values changed by --values-patch files.
+	SourceValuesPatch Source = "values-patch"
+
+	// SourceImageMirror marks image repositories rewritten to the registry
+	// mirror.
+	SourceImageMirror Source = "image-mirror"
+
+	// SourceExistingBundle marks values kept from the bundle being updated.
+	SourceExistingBundle Source = "existing-bundle"
+)
+
+// Log is the audit log of a bundle generation.
+type Log struct {
+	// SchemaVersion is the audit log layout version (see SchemaVersion).
+	SchemaVersion string `json:"schema_version"`
+
+	// GeneratedAt is when the bundle was generated, in UTC.
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Generator describes who and what generated the bundle.
+	Generator Generator `json:"generator"`
+
+	// Recipe identifies the recipe the bundle was generated from.
+	Recipe Recipe `json:"recipe"`
+
+	// Config is the effective generation config.
+	Config Config `json:"config"`
+
+	// Components lists the bundled components in recipe order.
+	Components []Component `json:"components"`
+}
+
+// Generator describes who and what generated a bundle.
+type Generator struct {
+	// Name is the generating tool (see GeneratorName).
+	Name string `json:"name"`
+
+	// Version is the version of the generating tool.
+	Version string `json:"version,omitempty"`
+
+	// Commit is the git commit the generating tool was built from.
+	Commit string `json:"commit,omitempty"`
+
+	// User is the OS user that ran the generation.
+	User string `json:"user,omitempty"`
+
+	// Host is the host name of the machine that ran the generation.
+	Host string `json:"host,omitempty"`
+}
+
+// Recipe identifies the recipe a bundle was generated from.
+type Recipe struct {
+	// Version is the version of the tool that generated the recipe.
+	Version string `json:"version,omitempty"`
+
+	// Digest is the recipe content digest (see recipe.RecipeResult.Digest).
+	Digest string `json:"digest,omitempty"`
+}
+
+// Config is the effective generation config of a bundle. Maps keyed by
+// component use the component or instance name given on the command line.
+type Config struct {
+	// Deployer is the deployer the bundle targets.
+	Deployer string `json:"deployer"`
+
+	// Profile is the bundle profile whose value presets were applied.
+	Profile string `json:"profile,omitempty"`
+
+	// RepoURL is the Git repository URL of the Argo CD applications.
+	RepoURL string `json:"repo_url,omitempty"`
+
+	// ApplicationSetGenerator is the Argo CD ApplicationSet generator.
+	ApplicationSetGenerator string `json:"applicationset_generator,omitempty"`
+
+	// ClusterSelector holds the cluster labels the cluster generator selects.
+	ClusterSelector map[string]string `json:"cluster_selector,omitempty"`
+
+	// KubernetesVersion is the target Kubernetes version components were
+	// checked against.
+	KubernetesVersion string `json:"kubernetes_version,omitempty"`
+
+	// ValueOverrides holds the --set overrides by component and value path.
+	ValueOverrides map[string]map[string]string `json:"value_overrides,omitempty"`
+
+	// ValuePatches holds the number of --values-patch files by component.
+	ValuePatches map[string]int `json:"value_patches,omitempty"`
+
+	// StrictOverrides is whether unapplied overrides fail generation.
+	StrictOverrides bool `json:"strict_overrides,omitempty"`
+
+	// InstallScopes holds the install scope by component.
+	InstallScopes map[string]string `json:"install_scopes,omitempty"`
+
+	// ExtraManifests holds the extra manifest directories by component.
+	ExtraManifests map[string][]string `json:"extra_manifests,omitempty"`
+
+	// NodeScheduling holds the node selectors and tolerations of system and
+	// GPU components.
+	NodeScheduling NodeScheduling `json:"node_scheduling"`
+
+	// ImageRegistryMirror is the registry image repositories were rewritten to.
+	ImageRegistryMirror string `json:"image_registry_mirror,omitempty"`
+
+	// SecretBackend is the backend the component Secrets were generated for.
+	SecretBackend string `json:"secret_backend,omitempty"`
+
+	// SecretStore is the ClusterSecretStore of the ExternalSecrets.
+	SecretStore string `json:"secret_store,omitempty"`
+
+	// HTTPProxy is the proxy URL, with any password redacted.
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// NoProxy lists the hosts, domains and CIDRs reached without the proxy.
+	NoProxy []string `json:"no_proxy,omitempty"`
+
+	// CABundleSHA256 is the hex encoded SHA256 digest of the CA bundle
+	// components trust, rather than the certificates themselves.
+	CABundleSHA256 string `json:"ca_bundle_sha256,omitempty"`
+
+	// VersionPolicy is the version policy components were checked against.
+	VersionPolicy *policy.VersionPolicy `json:"version_policy,omitempty"`
+
+	// SchemaValidation is how values were checked against chart schemas.
+	SchemaValidation string `json:"schema_validation,omitempty"`
+
+	// SchemaDir is the directory of vendored chart schemas.
+	SchemaDir string `json:"schema_dir,omitempty"`
+
+	// CapacityTemplate is the node provisioning template generated.
+	CapacityTemplate string `json:"capacity_template,omitempty"`
+
+	// PluginDir is the directory of bundler plugin executables.
+	PluginDir string `json:"plugin_dir,omitempty"`
+
+	// PreviousBundle is the bundle CHANGES.md was computed against.
+	PreviousBundle string `json:"previous_bundle,omitempty"`
+
+	// LockedFile is the lockfile the bundle resolution was checked against.
+	LockedFile string `json:"locked_file,omitempty"`
+
+	// ResolveImageDigests is whether image tags were resolved to digests.
+	ResolveImageDigests bool `json:"resolve_image_digests,omitempty"`
+
+	// IncludeReadme is whether READMEs were generated.
+	IncludeReadme bool `json:"include_readme"`
+
+	// IncludeChecksums is whether checksums.txt was generated.
+	IncludeChecksums bool `json:"include_checksums"`
+
+	// Runbook is whether runbook.md was generated.
+	Runbook bool `json:"runbook,omitempty"`
+
+	// NodeBootstrap is whether the node bootstrap artifacts were generated.
+	NodeBootstrap bool `json:"node_bootstrap,omitempty"`
+
+	// NodeLabels is whether the node labels and taints were generated.
+	NodeLabels bool `json:"node_labels,omitempty"`
+}
+
+// NodeScheduling holds the node placement of system and GPU components.
+type NodeScheduling struct {
+	// System is the placement of system components.
+	System NodePlacement `json:"system"`
+
+	// Accelerated is the placement of GPU components.
+	Accelerated NodePlacement `json:"accelerated"`
+}
+
+// NodePlacement holds a node selector and tolerations.
+type NodePlacement struct {
+	// NodeSelector holds the node selector labels.
+	NodeSelector map[string]string `json:"node_selector,omitempty"`
+
+	// Tolerations holds the tolerations.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// Component is a bundled component and the provenance of its values.
+type Component struct {
+	// Name is the component name from the recipe.
+	Name string `json:"name"`
+
+	// Namespace is the namespace the component is installed into.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Version is the chart version or Kustomize tag.
+	Version string `json:"version,omitempty"`
+
+	// Values lists the provenance of every component value, sorted by path.
+	Values []Value `json:"values"`
+}
+
+// Value is the provenance of a single component value.
+type Value struct {
+	// Path is the dotted path of the value. Lists are a single value.
+	Path string `json:"path"`
+
+	// Source is the generation step that last set the value.
+	Source Source `json:"source"`
+}
+
+// CurrentGenerator returns the Generator of this process: eidos at version,
+// with the build commit, the current user and the host name. User and host
+// are left empty when they cannot be determined.
+func CurrentGenerator(version string) Generator {
+	g := Generator{
+		Name:    GeneratorName,
+		Version: version,
+		Commit:  buildinfo.Get().Commit,
+	}
+	if u, err := user.Current(); err == nil {
+		g.User = u.Username
+	} else {
+		g.User = os.Getenv("USER")
+	}
+	if host, err := os.Hostname(); err == nil {
+		g.Host = host
+	}
+	return g
+}
+
+// Write writes the audit log to audit.json in dir. It returns the file path
+// and size.
+func (l *Log) Write(dir string) (string, int64, error) {
+	if l.SchemaVersion == "" {
+		l.SchemaVersion = SchemaVersion
+	}
+	if l.Generator.Name == "" {
+		l.Generator.Name = GeneratorName
+	}
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to serialize audit log: %w", err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join(dir, FileName)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", 0, fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return path, int64(len(data)), nil
+}
+
+// Load reads the audit log from audit.json in dir.
+func Load(dir string) (*Log, error) {
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	var l Log
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, fmt.Errorf("failed to parse audit log: %w", err)
+	}
+	return &l, nil
+}
+
+// Tracker records which generation step last set each value of a
+// component. A nil Tracker records nothing.
+type Tracker struct {
+	values  map[string]string
+	sources map[string]Source
+}
+
+// NewTracker returns a Tracker with no values recorded.
+func NewTracker() *Tracker {
+	return &Tracker{
+		values:  make(map[string]string),
+		sources: make(map[string]Source),
+	}
+}
+
+// Record attributes the values that were added or changed since the
+// previous call to source, and forgets the values that were removed.
+func (t *Tracker) Record(source Source, values map[string]any) {
+	if t == nil {
+		return
+	}
+	current := make(map[string]string)
+	for path, value := range valuepath.Flatten(values) {
+		data, err := json.Marshal(value)
+		if err != nil {
+			data = fmt.Appendf(nil, "%v", value)
+		}
+		current[path] = string(data)
+	}
+
+	for path, value := range current {
+		if previous, ok := t.values[path]; !ok || previous != value {
+			t.sources[path] = source
+		}
+	}
+	for path := range t.values {
+		if _, ok := current[path]; !ok {
+			delete(t.sources, path)
+		}
+	}
+	t.values = current
+}
+
+// Values returns the provenance of the values last recorded, sorted by path.
+func (t *Tracker) Values() []Value {
+	if t == nil {
+		return nil
+	}
+	values := make([]Value, 0, len(t.sources))
+	for _, path := range slices.Sorted(maps.Keys(t.sources)) {
+		values = append(values, Value{Path: path, Source: t.sources[path]})
+	}
+	return values
+}
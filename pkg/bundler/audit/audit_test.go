@@ -0,0 +1,117 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTracker(t *testing.T) {
+	tr := NewTracker()
+	values := map[string]any{
+		"driver": map[string]any{"version": "570.0", "enabled": true},
+		"args":   []any{"--a"},
+		"legacy": "x",
+	}
+	tr.Record(SourceRecipe, values)
+
+	// An unchanged value keeps its source; changed, added and removed
+	// values are attributed to the step or forgotten.
+	values["driver"].(map[string]any)["version"] = "580.82.07"
+	values["args"] = []any{"--a", "--b"}
+	values["nodeSelector"] = map[string]any{"nodeGroup": "gpu"}
+	delete(values, "legacy")
+	tr.Record(SourceOverride, values)
+	tr.Record(SourceProfile, values)
+
+	want := []Value{
+		{Path: "args", Source: SourceOverride},
+		{Path: "driver.enabled", Source: SourceRecipe},
+		{Path: "driver.version", Source: SourceOverride},
+		{Path: "nodeSelector.nodeGroup", Source: SourceOverride},
+	}
+	if got := tr.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %+v, want %+v", got, want)
+	}
+}
+
+func TestTracker_Nil(t *testing.T) {
+	var tr *Tracker
+	tr.Record(SourceRecipe, map[string]any{"a": 1})
+	if got := tr.Values(); got != nil {
+		t.Errorf("Values() = %v, want nil", got)
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	dir := t.TempDir()
+	l := &Log{
+		GeneratedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Generator:   Generator{Version: "v1.0.0", User: "alice", Host: "build-1"},
+		Recipe:      Recipe{Version: "v1.0.0", Digest: "sha256:abc"},
+		Config: Config{
+			Deployer:       "helm",
+			ValueOverrides: map[string]map[string]string{"gpu-operator": {"driver.version": "580.82.07"}},
+		},
+		Components: []Component{{
+			Name:   "gpu-operator",
+			Values: []Value{{Path: "driver.version", Source: SourceOverride}},
+		}},
+	}
+
+	path, size, err := l.Write(dir)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if path != filepath.Join(dir, FileName) {
+		t.Errorf("path = %q", path)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() != size {
+		t.Errorf("size = %d, file has %d", size, info.Size())
+	}
+
+	got, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got.SchemaVersion != SchemaVersion || got.Generator.Name != GeneratorName {
+		t.Errorf("defaults not set: %q %q", got.SchemaVersion, got.Generator.Name)
+	}
+	if !reflect.DeepEqual(got, l) {
+		t.Errorf("Load() = %+v, want %+v", got, l)
+	}
+
+	if _, err := Load(t.TempDir()); err == nil {
+		t.Error("Load() of a directory without audit.json should fail")
+	}
+}
+
+func TestCurrentGenerator(t *testing.T) {
+	g := CurrentGenerator("v1.2.3")
+	if g.Name != GeneratorName || g.Version != "v1.2.3" {
+		t.Errorf("CurrentGenerator() = %+v", g)
+	}
+	if host, err := os.Hostname(); err == nil && g.Host != host {
+		t.Errorf("Host = %q, want %q", g.Host, host)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit writes the audit log of a bundle generation.
+//
+// Every bundle carries an audit.json at its root recording who generated it
+// (user, host, eidos version and commit), the recipe version and digest, the
+// effective generation config (deployer, --set overrides, node selectors and
+// tolerations, install scopes, policies and other options) and, for every
+// component value, the generation step that set it. Compliance reviews can
+// answer why a value is in a bundle without re-running generation:
+//
+//	l, err := audit.Load("./bundle")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, c := range l.Components {
+//	    for _, v := range c.Values {
+//	        fmt.Println(c.Name, v.Path, v.Source)
+//	    }
+//	}
+//
+// A Tracker records the provenance of the values of one component while the
+// bundler resolves them. Record is called after each step with the current
+// values, and attributes every value that changed to that step:
+//
+//	t := audit.NewTracker()
+//	t.Record(audit.SourceRecipe, values)
+//	component.ApplyMapOverrides(values, overrides)
+//	t.Record(audit.SourceOverride, values)
+//	provenance := t.Values()
+//
+// The schema is versioned by SchemaVersion; fields are only added within a
+// version.
+package audit
@@ -0,0 +1,109 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// AKSLinuxOSConfigFileName is the AKS custom node configuration.
+const AKSLinuxOSConfigFileName = "aks-linux-os-config.json"
+
+// aksUsage describes how to apply the AKS custom node configuration.
+const aksUsage = "`" + AKSLinuxOSConfigFileName + "` is the Linux OS part of an AKS custom node configuration:\n\n" +
+	"```bash\naz aks nodepool add --cluster-name \"$CLUSTER_NAME\" --resource-group \"$RESOURCE_GROUP\" \\\n" +
+	"  --name gpupool --linux-os-config " + AKSLinuxOSConfigFileName + "\n```"
+
+// aksSysctls maps the sysctls an AKS custom node configuration accepts to
+// their field names.
+var aksSysctls = map[string]string{
+	"fs.aio-max-nr":                      "fsAioMaxNr",
+	"fs.file-max":                        "fsFileMax",
+	"fs.inotify.max_user_watches":        "fsInotifyMaxUserWatches",
+	"fs.nr_open":                         "fsNrOpen",
+	"kernel.threads-max":                 "kernelThreadsMax",
+	"net.core.netdev_max_backlog":        "netCoreNetdevMaxBacklog",
+	"net.core.optmem_max":                "netCoreOptmemMax",
+	"net.core.rmem_default":              "netCoreRmemDefault",
+	"net.core.rmem_max":                  "netCoreRmemMax",
+	"net.core.somaxconn":                 "netCoreSomaxconn",
+	"net.core.wmem_default":              "netCoreWmemDefault",
+	"net.core.wmem_max":                  "netCoreWmemMax",
+	"net.ipv4.ip_local_port_range":       "netIpv4IpLocalPortRange",
+	"net.ipv4.neigh.default.gc_thresh1":  "netIpv4NeighDefaultGcThresh1",
+	"net.ipv4.neigh.default.gc_thresh2":  "netIpv4NeighDefaultGcThresh2",
+	"net.ipv4.neigh.default.gc_thresh3":  "netIpv4NeighDefaultGcThresh3",
+	"net.ipv4.tcp_fin_timeout":           "netIpv4TcpFinTimeout",
+	"net.ipv4.tcp_keepalive_probes":      "netIpv4TcpKeepaliveProbes",
+	"net.ipv4.tcp_keepalive_time":        "netIpv4TcpKeepaliveTime",
+	"net.ipv4.tcp_max_syn_backlog":       "netIpv4TcpMaxSynBacklog",
+	"net.ipv4.tcp_max_tw_buckets":        "netIpv4TcpMaxTwBuckets",
+	"net.ipv4.tcp_tw_reuse":              "netIpv4TcpTwReuse",
+	"net.ipv4.tcp_keepalive_intvl":       "netIpv4TcpkeepaliveIntvl",
+	"net.netfilter.nf_conntrack_buckets": "netNetfilterNfConntrackBuckets",
+	"net.netfilter.nf_conntrack_max":     "netNetfilterNfConntrackMax",
+	"vm.max_map_count":                   "vmMaxMapCount",
+	"vm.swappiness":                      "vmSwappiness",
+	"vm.vfs_cache_pressure":              "vmVfsCachePressure",
+}
+
+// aksArtifact renders the AKS custom node configuration. Sysctls AKS accepts
+// are set directly and the transparent_hugepage kernel parameter maps to
+// transparentHugePageEnabled. Other kernel parameters and kernel modules are
+// not supported by AKS.
+func aksArtifact(settings *Settings) (*artifact, error) {
+	a := &artifact{
+		FileName: AKSLinuxOSConfigFileName,
+		Title:    "AKS",
+		Usage:    aksUsage,
+	}
+
+	config := make(map[string]any)
+	sysctls := make(map[string]any)
+	for _, s := range settings.Sysctl {
+		field, ok := aksSysctls[s.Name]
+		if !ok {
+			a.Unsupported = append(a.Unsupported, s.Name+"="+s.Value)
+			continue
+		}
+		if n, err := strconv.ParseInt(s.Value, 10, 64); err == nil {
+			sysctls[field] = n
+		} else {
+			sysctls[field] = s.Value
+		}
+	}
+	if len(sysctls) > 0 {
+		config["sysctls"] = sysctls
+	}
+
+	for _, p := range settings.KernelParameters {
+		if p.Name == "transparent_hugepage" {
+			config["transparentHugePageEnabled"] = p.Value
+			continue
+		}
+		a.Unsupported = append(a.Unsupported, p.Name+"="+p.Value)
+	}
+	a.Unsupported = append(a.Unsupported, settings.KernelModules...)
+
+	content, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to encode AKS linux OS config", err)
+	}
+	a.Content = append(content, '\n')
+	return a, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// DirName is the bundle subdirectory bootstrap artifacts are written to.
+	DirName = "bootstrap"
+
+	// ReadmeFileName describes the settings and how to apply each artifact.
+	ReadmeFileName = "README.md"
+)
+
+// GeneratorInput contains all data needed to generate bootstrap artifacts.
+type GeneratorInput struct {
+	// RecipeResult provides the criteria selecting the cloud and the OS
+	// constraints the settings are taken from.
+	RecipeResult *recipe.RecipeResult
+
+	// Version is the bundler version.
+	Version string
+}
+
+// GeneratorOutput contains the result of bootstrap artifact generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the artifacts.
+	Duration time.Duration
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// artifact is a generated file for one cloud, with the settings the cloud
+// cannot apply.
+type artifact struct {
+	FileName    string
+	Content     []byte
+	Title       string
+	Usage       string
+	Unsupported []string
+}
+
+// readmeData is the data rendered into the README template.
+type readmeData struct {
+	BundlerVersion string
+	RecipeVersion  string
+	Settings       *Settings
+	Providers      []*artifact
+}
+
+// Generator creates node bootstrap artifacts from recipe results.
+type Generator struct{}
+
+// NewGenerator creates a new bootstrap artifact generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes the bootstrap artifacts for the recipe's service to the
+// bootstrap subdirectory of outputDir: EKS launch template user data, a GKE
+// node system configuration or an AKS custom node configuration. Recipes for
+// any service get all three. Nothing is written when the recipe recommends
+// no node settings or targets another service.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || input.RecipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and recipe result are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	output := &GeneratorOutput{}
+	settings := SettingsFromConstraints(input.RecipeResult.Constraints)
+	if settings.IsEmpty() {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			"The recipe recommends no sysctl, GRUB or kernel module settings; no node bootstrap artifacts were generated")
+		output.Duration = time.Since(start)
+		return output, nil
+	}
+
+	service := recipe.CriteriaServiceAny
+	if input.RecipeResult.Criteria != nil && input.RecipeResult.Criteria.Service != "" {
+		service = input.RecipeResult.Criteria.Service
+	}
+
+	var artifacts []*artifact
+	if service == recipe.CriteriaServiceEKS || service == recipe.CriteriaServiceAny {
+		a, err := eksArtifact(settings, input)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, a)
+	}
+	if service == recipe.CriteriaServiceGKE || service == recipe.CriteriaServiceAny {
+		a, err := gkeArtifact(settings)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, a)
+	}
+	if service == recipe.CriteriaServiceAKS || service == recipe.CriteriaServiceAny {
+		a, err := aksArtifact(settings)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, a)
+	}
+	if len(artifacts) == 0 {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			fmt.Sprintf("No node bootstrap artifacts are available for service %s", service))
+		output.Duration = time.Since(start)
+		return output, nil
+	}
+
+	dir := filepath.Join(outputDir, DirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create bootstrap directory", err)
+	}
+
+	for _, a := range artifacts {
+		if err := writeFile(output, filepath.Join(dir, a.FileName), a.Content); err != nil {
+			return nil, err
+		}
+		if len(a.Unsupported) > 0 {
+			output.DeploymentNotes = append(output.DeploymentNotes,
+				fmt.Sprintf("%s does not apply %s; see %s/%s", a.Title, strings.Join(a.Unsupported, ", "), DirName, ReadmeFileName))
+		}
+	}
+
+	readme, err := renderTemplate(readmeTemplate, &readmeData{
+		BundlerVersion: input.Version,
+		RecipeVersion:  input.RecipeResult.Metadata.Version,
+		Settings:       settings,
+		Providers:      artifacts,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render bootstrap README", err)
+	}
+	if err := writeFile(output, filepath.Join(dir, ReadmeFileName), readme); err != nil {
+		return nil, err
+	}
+
+	output.DeploymentNotes = append([]string{
+		fmt.Sprintf("%s/ holds node bootstrap artifacts applying the recipe's sysctl, GRUB and kernel module settings to new GPU nodes", DirName),
+	}, output.DeploymentNotes...)
+	output.Duration = time.Since(start)
+
+	slog.Debug("node bootstrap artifacts generated",
+		"service", service,
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+	)
+
+	return output, nil
+}
+
+// renderTemplate renders a template with data.
+func renderTemplate(tmplContent string, data any) ([]byte, error) {
+	tmpl, err := template.New("template").Parse(tmplContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+	return []byte(buf.String()), nil
+}
+
+// writeFile writes content to path and records it in output.
+func writeFile(output *GeneratorOutput, path string, content []byte) error {
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to write %s", filepath.Base(path)), err)
+	}
+	output.Files = append(output.Files, path)
+	output.TotalSize += int64(len(content))
+	return nil
+}
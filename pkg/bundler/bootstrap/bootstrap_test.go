@@ -0,0 +1,197 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func testRecipe(service recipe.CriteriaServiceType) *recipe.RecipeResult {
+	r := &recipe.RecipeResult{
+		Criteria: &recipe.Criteria{Service: service},
+		Constraints: []recipe.Constraint{
+			{Name: "OS.sysctl./proc/sys/vm/max_map_count", Value: "262144"},
+			{Name: "OS.sysctl./proc/sys/vm/overcommit_memory", Value: "1"},
+			{Name: "OS.grub.hugepagesz", Value: "2M"},
+			{Name: "OS.grub.hugepages", Value: "4096"},
+			{Name: "OS.grub.transparent_hugepage", Value: "madvise"},
+			{Name: "OS.kmod.nvidia_peermem", Value: "true"},
+		},
+	}
+	r.Metadata.Version = "v0.9.0"
+	return r
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		service   recipe.CriteriaServiceType
+		wantFiles []string
+	}{
+		{"eks", recipe.CriteriaServiceEKS, []string{EKSUserDataFileName}},
+		{"gke", recipe.CriteriaServiceGKE, []string{GKESystemConfigFileName}},
+		{"aks", recipe.CriteriaServiceAKS, []string{AKSLinuxOSConfigFileName}},
+		{"any", recipe.CriteriaServiceAny, []string{EKSUserDataFileName, GKESystemConfigFileName, AKSLinuxOSConfigFileName}},
+		{"oke", recipe.CriteriaServiceOKE, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			out, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+				RecipeResult: testRecipe(tt.service),
+				Version:      "v1.0.0",
+			}, dir)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if len(out.DeploymentNotes) == 0 {
+				t.Error("expected deployment notes")
+			}
+			if tt.wantFiles == nil {
+				if len(out.Files) != 0 {
+					t.Errorf("got files %v, want none", out.Files)
+				}
+				return
+			}
+
+			if len(out.Files) != len(tt.wantFiles)+1 {
+				t.Fatalf("got %d files, want %d", len(out.Files), len(tt.wantFiles)+1)
+			}
+			for _, name := range append(tt.wantFiles, ReadmeFileName) {
+				if _, err := os.Stat(filepath.Join(dir, DirName, name)); err != nil {
+					t.Errorf("missing %s: %v", name, err)
+				}
+			}
+			readme, err := os.ReadFile(filepath.Join(dir, DirName, ReadmeFileName))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(readme), "`vm.max_map_count`") {
+				t.Error("README does not list the sysctl settings")
+			}
+		})
+	}
+}
+
+func TestGenerateNoSettings(t *testing.T) {
+	dir := t.TempDir()
+	r := testRecipe(recipe.CriteriaServiceEKS)
+	r.Constraints = []recipe.Constraint{{Name: "OS.sysctl./proc/sys/kernel/osrelease", Value: ">= 6.8"}}
+
+	out, err := NewGenerator().Generate(context.Background(), &GeneratorInput{RecipeResult: r}, dir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(out.Files) != 0 {
+		t.Errorf("got files %v, want none", out.Files)
+	}
+	if _, err := os.Stat(filepath.Join(dir, DirName)); !os.IsNotExist(err) {
+		t.Error("bootstrap directory should not be created")
+	}
+}
+
+func TestGenerateInvalidInput(t *testing.T) {
+	if _, err := NewGenerator().Generate(context.Background(), &GeneratorInput{}, t.TempDir()); err == nil {
+		t.Error("expected error for missing recipe result")
+	}
+}
+
+func TestEKSArtifact(t *testing.T) {
+	input := &GeneratorInput{RecipeResult: testRecipe(recipe.CriteriaServiceEKS), Version: "v1.0.0"}
+	a, err := eksArtifact(SettingsFromConstraints(input.RecipeResult.Constraints), input)
+	if err != nil {
+		t.Fatalf("eksArtifact() error = %v", err)
+	}
+	content := string(a.Content)
+	for _, want := range []string{
+		"Content-Type: multipart/mixed",
+		"vm.max_map_count = 262144",
+		"modprobe nvidia_peermem",
+		"hugepages=4096 hugepagesz=2M transparent_hugepage=madvise",
+		"--//--",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("user data missing %q", want)
+		}
+	}
+	if len(a.Unsupported) != 0 {
+		t.Errorf("Unsupported = %v, want none", a.Unsupported)
+	}
+}
+
+func TestGKEArtifact(t *testing.T) {
+	a, err := gkeArtifact(SettingsFromConstraints(testRecipe(recipe.CriteriaServiceGKE).Constraints))
+	if err != nil {
+		t.Fatalf("gkeArtifact() error = %v", err)
+	}
+
+	var got struct {
+		LinuxConfig struct {
+			Sysctl                     map[string]string `yaml:"sysctl"`
+			HugepageConfig             map[string]int    `yaml:"hugepageConfig"`
+			TransparentHugepageEnabled string            `yaml:"transparentHugepageEnabled"`
+		} `yaml:"linuxConfig"`
+	}
+	if err := yaml.Unmarshal(a.Content, &got); err != nil {
+		t.Fatalf("invalid YAML: %v", err)
+	}
+	if got.LinuxConfig.Sysctl["vm.max_map_count"] != "262144" {
+		t.Errorf("sysctl = %v", got.LinuxConfig.Sysctl)
+	}
+	if got.LinuxConfig.HugepageConfig["hugepage_size2m"] != 4096 {
+		t.Errorf("hugepageConfig = %v", got.LinuxConfig.HugepageConfig)
+	}
+	if got.LinuxConfig.TransparentHugepageEnabled != "TRANSPARENT_HUGEPAGE_ENABLED_MADVISE" {
+		t.Errorf("transparentHugepageEnabled = %q", got.LinuxConfig.TransparentHugepageEnabled)
+	}
+	if strings.Join(a.Unsupported, ",") != "nvidia_peermem" {
+		t.Errorf("Unsupported = %v, want [nvidia_peermem]", a.Unsupported)
+	}
+}
+
+func TestAKSArtifact(t *testing.T) {
+	a, err := aksArtifact(SettingsFromConstraints(testRecipe(recipe.CriteriaServiceAKS).Constraints))
+	if err != nil {
+		t.Fatalf("aksArtifact() error = %v", err)
+	}
+
+	var got struct {
+		Sysctls                    map[string]any `json:"sysctls"`
+		TransparentHugePageEnabled string         `json:"transparentHugePageEnabled"`
+	}
+	if err := json.Unmarshal(a.Content, &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if got.Sysctls["vmMaxMapCount"] != float64(262144) {
+		t.Errorf("sysctls = %v", got.Sysctls)
+	}
+	if got.TransparentHugePageEnabled != "madvise" {
+		t.Errorf("transparentHugePageEnabled = %q", got.TransparentHugePageEnabled)
+	}
+	want := "vm.overcommit_memory=1,hugepages=4096,hugepagesz=2M,nvidia_peermem"
+	if got := strings.Join(a.Unsupported, ","); got != want {
+		t.Errorf("Unsupported = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package bootstrap generates cloud-specific node bootstrap artifacts for the node settings a recipe recommends.
+
+Recipes describe node-level tuning as OS constraints that require an exact
+value: OS.sysctl.<path> for kernel runtime parameters, OS.grub.<param> for
+kernel command line parameters and OS.kmod.<module> set to "true" for kernel
+modules. The same constraints are checked against snapshots by the validator;
+this package applies them when GPU nodes are created, so node tuning is not a
+manual step separate from the Helm bundle.
+
+# Artifacts
+
+Artifacts are written to the bootstrap subdirectory for the recipe's service,
+or for every service when the recipe targets any:
+  - eks-user-data.mime: MIME multi-part launch template user data that writes
+    the sysctls and kernel modules, adds the kernel parameters through GRUB
+    and reboots once before the node joins
+  - gke-system-config.yaml: a node system configuration for
+    gcloud container node-pools create --system-config-from-file
+  - aks-linux-os-config.json: the Linux OS part of a custom node
+    configuration for az aks nodepool add --linux-os-config
+  - README.md: the settings and how to apply each artifact
+
+GKE and AKS accept a fixed set of sysctls and no kernel modules or arbitrary
+kernel parameters; settings they cannot apply are listed in the README and
+the deployment notes.
+
+# Usage
+
+	generator := bootstrap.NewGenerator()
+
+	input := &bootstrap.GeneratorInput{
+		RecipeResult: recipeResult,
+		Version:      "v1.0.0",
+	}
+
+	output, err := generator.Generate(ctx, input, "/path/to/bundle")
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package bootstrap
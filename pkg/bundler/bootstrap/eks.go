@@ -0,0 +1,62 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	_ "embed"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+//go:embed templates/eks-user-data.tmpl
+var eksUserDataTemplate string
+
+// EKSUserDataFileName is the EKS launch template user data.
+const EKSUserDataFileName = "eks-user-data.mime"
+
+// eksUsage describes how to apply the EKS user data.
+const eksUsage = "`" + EKSUserDataFileName + "` is MIME multi-part user data for the launch template of a\n" +
+	"managed node group, or the `spec.userData` of a Karpenter EC2NodeClass. It runs on\n" +
+	"Amazon Linux 2023 and Ubuntu EKS AMIs before the node joins the cluster. EKS merges it\n" +
+	"with the bootstrap user data it adds to managed node groups. Launch templates take the\n" +
+	"user data base64-encoded:\n\n" +
+	"```bash\nbase64 -w0 " + EKSUserDataFileName + "\n```"
+
+// eksData is the data rendered into the EKS user data template.
+type eksData struct {
+	BundlerVersion string
+	RecipeVersion  string
+	Settings       *Settings
+}
+
+// eksArtifact renders the EKS launch template user data. It applies every
+// setting: sysctls and kernel modules directly, and kernel parameters through
+// GRUB followed by a single reboot.
+func eksArtifact(settings *Settings, input *GeneratorInput) (*artifact, error) {
+	content, err := renderTemplate(eksUserDataTemplate, &eksData{
+		BundlerVersion: input.Version,
+		RecipeVersion:  input.RecipeResult.Metadata.Version,
+		Settings:       settings,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render EKS user data", err)
+	}
+	return &artifact{
+		FileName: EKSUserDataFileName,
+		Content:  content,
+		Title:    "EKS",
+		Usage:    eksUsage,
+	}, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// GKESystemConfigFileName is the GKE node system configuration.
+const GKESystemConfigFileName = "gke-system-config.yaml"
+
+// gkeUsage describes how to apply the GKE node system configuration.
+const gkeUsage = "`" + GKESystemConfigFileName + "` is a node system configuration for a GKE node pool:\n\n" +
+	"```bash\ngcloud container node-pools create gpu-pool --cluster \"$CLUSTER_NAME\" \\\n" +
+	"  --system-config-from-file " + GKESystemConfigFileName + "\n```"
+
+// gkeSysctls are the sysctls a GKE node system configuration accepts.
+var gkeSysctls = map[string]bool{
+	"fs.aio-max-nr":                  true,
+	"fs.file-max":                    true,
+	"fs.inotify.max_user_instances":  true,
+	"fs.inotify.max_user_watches":    true,
+	"fs.nr_open":                     true,
+	"kernel.shmall":                  true,
+	"kernel.shmmax":                  true,
+	"kernel.shmmni":                  true,
+	"net.core.netdev_max_backlog":    true,
+	"net.core.optmem_max":            true,
+	"net.core.rmem_default":          true,
+	"net.core.rmem_max":              true,
+	"net.core.somaxconn":             true,
+	"net.core.wmem_default":          true,
+	"net.core.wmem_max":              true,
+	"net.ipv4.tcp_rmem":              true,
+	"net.ipv4.tcp_tw_reuse":          true,
+	"net.ipv4.tcp_wmem":              true,
+	"net.netfilter.nf_conntrack_max": true,
+	"vm.dirty_background_ratio":      true,
+	"vm.dirty_ratio":                 true,
+	"vm.max_map_count":               true,
+	"vm.min_free_kbytes":             true,
+	"vm.overcommit_memory":           true,
+	"vm.overcommit_ratio":            true,
+	"vm.swappiness":                  true,
+	"vm.vfs_cache_pressure":          true,
+	"vm.watermark_scale_factor":      true,
+}
+
+// gkeHugepageSizes maps the hugepagesz kernel parameter to the GKE hugepage
+// configuration field holding the page count.
+var gkeHugepageSizes = map[string]string{
+	"2M": "hugepage_size2m",
+	"1G": "hugepage_size1g",
+}
+
+// gkeArtifact renders the GKE node system configuration. Sysctls GKE accepts
+// are set directly; the hugepages and transparent_hugepage kernel parameters
+// map to their linuxConfig fields. Other kernel parameters and kernel modules
+// are not supported by GKE.
+func gkeArtifact(settings *Settings) (*artifact, error) {
+	a := &artifact{
+		FileName: GKESystemConfigFileName,
+		Title:    "GKE",
+		Usage:    gkeUsage,
+	}
+
+	linuxConfig := make(map[string]any)
+	sysctls := make(map[string]string)
+	for _, s := range settings.Sysctl {
+		if gkeSysctls[s.Name] {
+			sysctls[s.Name] = s.Value
+			continue
+		}
+		a.Unsupported = append(a.Unsupported, s.Name+"="+s.Value)
+	}
+	if len(sysctls) > 0 {
+		linuxConfig["sysctl"] = sysctls
+	}
+
+	params := make(map[string]string, len(settings.KernelParameters))
+	for _, p := range settings.KernelParameters {
+		params[p.Name] = p.Value
+	}
+	for _, p := range settings.KernelParameters {
+		switch p.Name {
+		case "hugepagesz":
+			field, ok := gkeHugepageSizes[p.Value]
+			count, err := strconv.Atoi(params["hugepages"])
+			if !ok || err != nil {
+				a.Unsupported = append(a.Unsupported, p.Name+"="+p.Value)
+				continue
+			}
+			linuxConfig["hugepageConfig"] = map[string]int{field: count}
+		case "hugepages":
+			if _, ok := gkeHugepageSizes[params["hugepagesz"]]; !ok {
+				a.Unsupported = append(a.Unsupported, p.Name+"="+p.Value)
+			}
+		case "transparent_hugepage":
+			linuxConfig["transparentHugepageEnabled"] = "TRANSPARENT_HUGEPAGE_ENABLED_" + strings.ToUpper(p.Value)
+		default:
+			a.Unsupported = append(a.Unsupported, p.Name+"="+p.Value)
+		}
+	}
+	a.Unsupported = append(a.Unsupported, settings.KernelModules...)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(map[string]any{"linuxConfig": linuxConfig}); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to encode GKE system config", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to encode GKE system config", err)
+	}
+	a.Content = buf.Bytes()
+	return a, nil
+}
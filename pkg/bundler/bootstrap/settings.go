@@ -0,0 +1,134 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+// OS measurement subtypes node settings are taken from.
+const (
+	subtypeSysctl = "sysctl"
+	subtypeGrub   = "grub"
+	subtypeKmod   = "kmod"
+)
+
+// sysctlPathPrefix prefixes sysctl keys in OS measurements.
+const sysctlPathPrefix = "/proc/sys/"
+
+// Setting is a single named node setting.
+type Setting struct {
+	Name  string
+	Value string
+}
+
+// Settings are the node-level settings a recipe recommends.
+type Settings struct {
+	// Sysctl holds kernel runtime parameters by sysctl name, e.g.
+	// vm.max_map_count.
+	Sysctl []Setting
+
+	// KernelParameters holds kernel command line parameters set through GRUB.
+	KernelParameters []Setting
+
+	// KernelModules lists the kernel modules to load.
+	KernelModules []string
+}
+
+// IsEmpty reports whether there are no settings.
+func (s *Settings) IsEmpty() bool {
+	return len(s.Sysctl) == 0 && len(s.KernelParameters) == 0 && len(s.KernelModules) == 0
+}
+
+// CommandLine returns the kernel parameters as they appear on the kernel
+// command line.
+func (s *Settings) CommandLine() string {
+	params := make([]string, 0, len(s.KernelParameters))
+	for _, p := range s.KernelParameters {
+		params = append(params, p.Name+"="+p.Value)
+	}
+	return strings.Join(params, " ")
+}
+
+// SettingsFromConstraints returns the node settings recommended by the OS
+// constraints of a recipe. Only constraints requiring an exact value are
+// settings: OS.sysctl.<path> sets a sysctl, OS.grub.<param> a kernel
+// parameter and OS.kmod.<module> set to "true" loads a module. Range
+// constraints such as a minimum kernel version describe the node image and
+// are skipped. Settings are sorted by name.
+func SettingsFromConstraints(constraints []recipe.Constraint) *Settings {
+	settings := &Settings{}
+	for _, c := range constraints {
+		path, err := validator.ParseConstraintPath(c.Name)
+		if err != nil || path.Type != measurement.TypeOS {
+			continue
+		}
+		value, ok := exactValue(c.Value)
+		if !ok {
+			continue
+		}
+
+		switch path.Subtype {
+		case subtypeSysctl:
+			settings.Sysctl = append(settings.Sysctl, Setting{Name: sysctlName(path.Key), Value: value})
+		case subtypeGrub:
+			settings.KernelParameters = append(settings.KernelParameters, Setting{Name: path.Key, Value: value})
+		case subtypeKmod:
+			if value == "true" {
+				settings.KernelModules = append(settings.KernelModules, path.Key)
+			}
+		}
+	}
+
+	bySettingName := func(s []Setting) {
+		sort.SliceStable(s, func(i, j int) bool { return s[i].Name < s[j].Name })
+	}
+	bySettingName(settings.Sysctl)
+	bySettingName(settings.KernelParameters)
+	sort.Strings(settings.KernelModules)
+	settings.KernelModules = slices.Compact(settings.KernelModules)
+
+	return settings
+}
+
+// exactValue returns the value of a constraint expression that requires a
+// single exact value.
+func exactValue(expr string) (string, bool) {
+	parsed, err := validator.ParseExpression(expr)
+	if err != nil || len(parsed.Alternatives) != 1 || len(parsed.Alternatives[0]) != 1 {
+		return "", false
+	}
+	term := parsed.Alternatives[0][0]
+	if term.Operator != validator.OperatorExact && term.Operator != validator.OperatorEQ {
+		return "", false
+	}
+	return term.Value, true
+}
+
+// sysctlName converts a /proc/sys path to its sysctl name, e.g.
+// /proc/sys/vm/max_map_count to vm.max_map_count. Keys already in sysctl
+// form are returned unchanged.
+func sysctlName(key string) string {
+	if !strings.HasPrefix(key, sysctlPathPrefix) {
+		return key
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(key, sysctlPathPrefix), "/", ".")
+}
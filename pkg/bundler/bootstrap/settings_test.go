@@ -0,0 +1,65 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestSettingsFromConstraints(t *testing.T) {
+	constraints := []recipe.Constraint{
+		{Name: "OS.sysctl./proc/sys/vm/max_map_count", Value: "262144"},
+		{Name: "OS.sysctl./proc/sys/kernel/osrelease", Value: ">= 6.8"},
+		{Name: "OS.sysctl./proc/sys/fs/inotify/max_user_watches", Value: "== 524288"},
+		{Name: "OS.grub.hugepagesz", Value: "2M"},
+		{Name: "OS.grub.hugepages", Value: "4096"},
+		{Name: "OS.kmod.nvidia_peermem", Value: "true"},
+		{Name: "OS.kmod.nouveau", Value: "false"},
+		{Name: "OS.release.ID", Value: "ubuntu"},
+		{Name: "K8s.server.version", Value: ">= 1.32"},
+		{Name: "invalid", Value: "1"},
+	}
+
+	got := SettingsFromConstraints(constraints)
+	want := &Settings{
+		Sysctl: []Setting{
+			{Name: "fs.inotify.max_user_watches", Value: "524288"},
+			{Name: "vm.max_map_count", Value: "262144"},
+		},
+		KernelParameters: []Setting{
+			{Name: "hugepages", Value: "4096"},
+			{Name: "hugepagesz", Value: "2M"},
+		},
+		KernelModules: []string{"nvidia_peermem"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SettingsFromConstraints() = %+v, want %+v", got, want)
+	}
+	if cl := got.CommandLine(); cl != "hugepages=4096 hugepagesz=2M" {
+		t.Errorf("CommandLine() = %q", cl)
+	}
+}
+
+func TestSettingsIsEmpty(t *testing.T) {
+	if !SettingsFromConstraints(nil).IsEmpty() {
+		t.Error("IsEmpty() = false for no constraints")
+	}
+	if SettingsFromConstraints([]recipe.Constraint{{Name: "OS.kmod.ib_umad", Value: "true"}}).IsEmpty() {
+		t.Error("IsEmpty() = true with a kernel module")
+	}
+}
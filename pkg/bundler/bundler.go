@@ -15,21 +15,50 @@
 package bundler
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/NVIDIA/eidos/pkg/bundler/audit"
+	"github.com/NVIDIA/eidos/pkg/bundler/bootstrap"
+	"github.com/NVIDIA/eidos/pkg/bundler/capacity"
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
 	"github.com/NVIDIA/eidos/pkg/bundler/config"
 	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argocd"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argoworkflows"
 	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/terraform"
+	"github.com/NVIDIA/eidos/pkg/bundler/diff"
+	"github.com/NVIDIA/eidos/pkg/bundler/jobs"
+	"github.com/NVIDIA/eidos/pkg/bundler/manifest"
+	"github.com/NVIDIA/eidos/pkg/bundler/mirror"
+	"github.com/NVIDIA/eidos/pkg/bundler/nodelabels"
+	"github.com/NVIDIA/eidos/pkg/bundler/plugin"
+	"github.com/NVIDIA/eidos/pkg/bundler/postinstall"
+	"github.com/NVIDIA/eidos/pkg/bundler/proxy"
+	"github.com/NVIDIA/eidos/pkg/bundler/registry"
 	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/bundler/runbook"
+	"github.com/NVIDIA/eidos/pkg/bundler/schema"
+	"github.com/NVIDIA/eidos/pkg/bundler/secrets"
+	"github.com/NVIDIA/eidos/pkg/bundler/skyhook"
+	"github.com/NVIDIA/eidos/pkg/bundler/types"
+	"github.com/NVIDIA/eidos/pkg/compat"
 	"github.com/NVIDIA/eidos/pkg/component"
 	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/janitor"
+	"github.com/NVIDIA/eidos/pkg/policy"
 	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/version"
 )
 
 // DefaultBundler generates Helm umbrella charts from recipes.
@@ -48,6 +77,25 @@ type DefaultBundler struct {
 	// AllowLists defines which criteria values are permitted for bundle requests.
 	// When set, the bundler validates that the recipe's criteria are within the allowed values.
 	AllowLists *recipe.AllowLists
+
+	// Janitor, when set, creates the scratch directories used by HandleBundles
+	// so background cleanup never reclaims a directory mid-generation.
+	Janitor *janitor.Janitor
+
+	// Jobs stores asynchronous bundle jobs submitted to HandleBundles.
+	Jobs jobs.Store
+
+	// Plugins holds the component bundlers loaded from the configured
+	// plugin directory. Empty when no plugin directory is configured.
+	Plugins *registry.Registry
+
+	// ResolveImageDigest resolves image tags to manifest digests for the
+	// lockfile. Nil disables digest resolution.
+	ResolveImageDigest ImageDigestResolver
+
+	// slots bounds the bundles generated at once by HandleBundles, its
+	// asynchronous jobs and MakeArchive. Nil means no limit.
+	slots chan struct{}
 }
 
 // Option defines a functional option for configuring DefaultBundler.
@@ -63,6 +111,35 @@ func WithConfig(cfg *config.Config) Option {
 	}
 }
 
+// WithJanitor sets the janitor that owns HandleBundles scratch directories.
+func WithJanitor(j *janitor.Janitor) Option {
+	return func(db *DefaultBundler) {
+		db.Janitor = j
+	}
+}
+
+// WithJobStore sets the store for asynchronous bundle jobs.
+// Defaults to an in-memory store.
+func WithJobStore(store jobs.Store) Option {
+	return func(db *DefaultBundler) {
+		if store != nil {
+			db.Jobs = store
+		}
+	}
+}
+
+// WithMaxConcurrent limits the bundles generated at once by HandleBundles,
+// its asynchronous jobs and MakeArchive to n; further requests are rejected
+// as rate limited. Zero or less means no limit.
+func WithMaxConcurrent(n int) Option {
+	return func(db *DefaultBundler) {
+		db.slots = nil
+		if n > 0 {
+			db.slots = make(chan struct{}, n)
+		}
+	}
+}
+
 // WithAllowLists sets the criteria allowlists for the bundler.
 // When configured, the bundler validates that recipe criteria are within allowed values.
 func WithAllowLists(al *recipe.AllowLists) Option {
@@ -71,6 +148,15 @@ func WithAllowLists(al *recipe.AllowLists) Option {
 	}
 }
 
+// WithImageDigestResolver sets the function resolving image tags to their
+// manifest digests, used when the config enables digest resolution or the
+// lockfile pins digests.
+func WithImageDigestResolver(resolve ImageDigestResolver) Option {
+	return func(db *DefaultBundler) {
+		db.ResolveImageDigest = resolve
+	}
+}
+
 // New creates a new DefaultBundler with the given options.
 //
 // Example:
@@ -80,15 +166,29 @@ func WithAllowLists(al *recipe.AllowLists) Option {
 //	        config.WithValueOverrides(overrides),
 //	    )),
 //	)
+//
+// When the config sets a plugin directory, the plugins in it are loaded into
+// Plugins; a plugin that cannot be loaded is an error.
 func New(opts ...Option) (*DefaultBundler, error) {
 	db := &DefaultBundler{
-		Config: config.NewConfig(),
+		Config:  config.NewConfig(),
+		Jobs:    jobs.NewMemoryStore(),
+		Plugins: registry.NewRegistry(),
 	}
 
 	for _, opt := range opts {
 		opt(db)
 	}
 
+	if dir := db.Config.PluginDir(); dir != "" {
+		loaded, err := db.Plugins.LoadPlugins(context.Background(), dir, db.Config.PluginTimeout())
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInvalidRequest,
+				"failed to load bundler plugins", err)
+		}
+		slog.Info("loaded bundler plugins", "dir", dir, "components", loaded)
+	}
+
 	return db, nil
 }
 
@@ -100,7 +200,9 @@ func NewWithConfig(cfg *config.Config) (*DefaultBundler, error) {
 
 // Make generates a deployment bundle from the given recipe.
 // By default, generates a Helm umbrella chart. If deployer is set to "argocd",
-// generates ArgoCD Application manifests.
+// generates ArgoCD Application manifests. If deployer is set to "argo-workflows",
+// generates an Argo Workflows install pipeline, and if set to "terraform", a
+// Terraform module.
 //
 // For umbrella chart output:
 //   - Chart.yaml: Helm chart metadata with dependencies
@@ -115,10 +217,80 @@ func NewWithConfig(cfg *config.Config) (*DefaultBundler, error) {
 //   - <component>/values.yaml: Values for each component
 //   - README.md: Deployment instructions
 //
+// For Argo Workflows output:
+//   - workflow.yaml: Workflow installing components in deployment order
+//   - rbac.yaml: Installer ServiceAccount and ClusterRoleBinding
+//   - <component>/values.yaml: Values for each component
+//   - README.md: Deployment instructions
+//
+// For Terraform output:
+//   - versions.tf, main.tf, variables.tf, outputs.tf: Module with a
+//     helm_release per component, chained in deployment order
+//   - <component>/values.yaml: Values for each component
+//   - README.md: Deployment instructions
+//
+// Components bundled by plugins get their values from the plugin, and any
+// files the plugin adds are written to plugins/<component>/.
+//
+// Per-component work runs on config.Concurrency() workers. Components whose
+// plugin files fail are listed in the returned output's Errors while the
+// others are still bundled; callers must check Output.HasErrors.
+//
+// When a component has post-install checks in the component registry,
+// verify.sh runs them and README.md describes them.
+//
+// When capacity templates are configured, capacity/ holds a Karpenter
+// NodePool and EC2NodeClass or a Cluster API MachineDeployment that provision
+// GPU nodes matching the recipe criteria and accelerated node scheduling.
+//
+// When a runbook is configured, runbook.md describes the pre-upgrade snapshot
+// capture, health checks between the upgrade waves in deployment order and
+// the rollback commands per component.
+//
+// When node bootstrap is configured, bootstrap/ holds the EKS launch template
+// user data, GKE node system configuration or AKS custom node configuration
+// applying the recipe's sysctl, GRUB and kernel module settings to new nodes.
+//
+// When node labels are configured, node-labels/ holds the labels and taints
+// system and GPU nodes need to match the bundle's node selectors and
+// tolerations, with a script applying them. GPU components without configured
+// scheduling are scheduled with the proposed labels and taint.
+//
+// When an image registry mirror is configured, the image repositories of the
+// component values point to the mirror and mirror-images.sh copies the
+// upstream images there.
+//
+// When a secret backend is configured, secrets/ holds the Secrets the
+// components read, such as registry credentials and license tokens, as plain
+// Secrets, ExternalSecrets or a script sealing them into SealedSecrets.
+//
+// When a proxy is configured, the component values and mirror-images.sh set
+// the proxy env vars. When a CA bundle is configured, components trust it
+// and proxy/ca-bundle.yaml holds it as a ConfigMap per component namespace.
+//
+// When the output directory already holds a bundle, or a previous bundle is
+// configured, CHANGES.md summarizes the version bumps and values changes per
+// component since that bundle.
+//
+// Every bundle carries bundle.yaml, a machine-readable manifest recording the
+// eidos version and deployer, the recipe version and digest, the components
+// with their chart versions and every file with its size and SHA256 digest.
+//
+// Every bundle also carries audit.json, recording who generated it, the
+// effective config and which generation step set each component value.
+//
+// When schema validation is configured, the final component values are
+// checked against the values.schema.json of their charts before anything is
+// written; violations fail generation or are listed in the output's
+// SchemaWarnings.
+//
+// The output's Overrides records which --set overrides replaced an existing
+// value and which matched no existing value or no component. With strict
+// overrides configured, any unapplied override fails generation before
+// anything is written.
+//
 // Returns a result.Output summarizing the generation results.
 func (b *DefaultBundler) Make(ctx context.Context, input recipe.RecipeInput, dir string) (*result.Output, error) {
-	start := time.Now()
-
 	// Validate input
 	if input == nil {
 		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe input cannot be nil")
@@ -131,16 +303,125 @@ func (b *DefaultBundler) Make(ctx context.Context, input recipe.RecipeInput, dir
 			"bundle generation requires RecipeResult format")
 	}
 
+	return b.generate(ctx, recipeResult, dir, nil)
+}
+
+// generate writes the bundle for recipeResult into dir. When update is set,
+// only its components are regenerated within the existing bundle.
+func (b *DefaultBundler) generate(ctx context.Context, recipeResult *recipe.RecipeResult, dir string, update *bundleUpdate) (*result.Output, error) {
+	start := time.Now()
+
 	if len(recipeResult.ComponentRefs) == 0 {
 		return nil, errors.New(errors.ErrCodeInvalidRequest,
 			"recipe must contain at least one component reference")
 	}
+	b = b.withNodeLabelScheduling(recipeResult)
+
+	// Record the recipe digest so bundles can be traced to the recipe content
+	recipeDigest, err := recipeResult.Digest()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to compute recipe digest", err)
+	}
+
+	// Drop disabled components so deployers only see what gets deployed.
+	// The input recipe is still what gets copied into the bundle.
+	sourceRecipe := recipeResult
+	recipeResult, err = recipeResult.WithoutDisabledComponents()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInvalidRequest,
+			"invalid component dependencies", err)
+	}
+	skipped := recipeResult.Metadata.DisabledComponents
+	if len(skipped) > 0 {
+		slog.Info("skipping disabled components", "components", skipped)
+	}
+	if len(recipeResult.ComponentRefs) == 0 {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			"recipe must contain at least one enabled component")
+	}
+
+	// Flag components incompatible with the target Kubernetes version so the
+	// warnings end up in the generated README.
+	if err := b.checkCompatibility(recipeResult); err != nil {
+		return nil, err
+	}
+	if err := b.checkInstallScopes(recipeResult); err != nil {
+		return nil, err
+	}
+	extraManifests, err := b.loadExtraManifests(recipeResult)
+	if err != nil {
+		return nil, err
+	}
+	extraManifests, err = b.addOpenShiftSCC(recipeResult, extraManifests)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract values for each component from the recipe
+	componentValues, overrideReports, provenance, err := b.extractComponentValues(ctx, recipeResult)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to extract component values", err)
+	}
+	mirrored, err := b.mirrorImages(recipeResult, componentValues)
+	if err != nil {
+		return nil, err
+	}
+	for name, tracker := range provenance {
+		tracker.Record(audit.SourceImageMirror, componentValues[name])
+	}
+	if update != nil {
+		maps.Copy(componentValues, update.values)
+		for name, values := range update.values {
+			provenance[name] = audit.NewTracker()
+			provenance[name].Record(audit.SourceExistingBundle, values)
+		}
+	}
+	overrides, err := b.checkOverrides(recipeResult, overrideReports, update)
+	if err != nil {
+		return nil, err
+	}
+	manifestValues, err := splitManifestValues(recipeResult, componentValues)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check resolved versions against the version policy before writing
+	// anything, so a denied bundle leaves no partial output behind.
+	policyWarnings, err := b.enforceVersionPolicy(recipeResult, componentValues)
+	if err != nil {
+		return nil, err
+	}
+	schemaWarnings, err := b.validateValuesSchemas(ctx, recipeResult, componentValues)
+	if err != nil {
+		return nil, err
+	}
+	recipeLock, err := b.resolveLock(ctx, sourceRecipe, recipeResult, recipeDigest, componentValues)
+	if err != nil {
+		return nil, err
+	}
 
 	// Set default output directory
 	if dir == "" {
 		dir = "."
 	}
 
+	// Load the bundle being replaced before it is overwritten, so the new
+	// bundle can describe what changed since.
+	previous, err := b.loadPreviousBundle(dir)
+	if err != nil {
+		return nil, err
+	}
+	if update != nil {
+		if b.Config.PreviousBundle() == "" {
+			previous = update.existing
+		}
+		if err := update.removeComponentDirs(dir); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create output directory
 	if dir != "." {
 		if err := os.MkdirAll(dir, 0755); err != nil {
@@ -149,23 +430,217 @@ func (b *DefaultBundler) Make(ctx context.Context, input recipe.RecipeInput, dir
 		}
 	}
 
-	// Extract values for each component from the recipe
-	componentValues, err := b.extractComponentValues(ctx, recipeResult)
+	// Route based on deployer
+	var output *result.Output
+	deployer := b.Config.Deployer()
+	if deployer == config.DeployerArgoCD {
+		output, err = b.makeArgoCD(ctx, recipeResult, componentValues, extraManifests, dir, start)
+	} else if deployer == config.DeployerArgoWorkflows {
+		output, err = b.makeArgoWorkflows(ctx, recipeResult, componentValues, dir, start)
+	} else if deployer == config.DeployerTerraform {
+		output, err = b.makeTerraform(ctx, recipeResult, componentValues, dir, start)
+	} else {
+		output, err = b.makeUmbrellaChart(ctx, recipeResult, sourceRecipe, componentValues, manifestValues, extraManifests, dir, start)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.writeLockfile(ctx, recipeLock, dir, output); err != nil {
+		return nil, err
+	}
+
+	if err := b.makePluginFiles(ctx, recipeResult, componentValues, dir, output); err != nil {
+		return nil, err
+	}
+
+	if err := b.makePostInstallChecks(ctx, recipeResult, componentValues, dir, output); err != nil {
+		return nil, err
+	}
+
+	if b.Config.CapacityTemplate() != config.CapacityTemplateNone {
+		if err := b.makeCapacityTemplates(ctx, recipeResult, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Config.Runbook() {
+		if err := b.makeRunbook(ctx, recipeResult, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Config.NodeBootstrap() {
+		if err := b.makeNodeBootstrap(ctx, recipeResult, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Config.NodeLabels() {
+		if err := b.makeNodeLabels(ctx, recipeResult, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Config.ImageRegistryMirror() != "" {
+		if err := b.makeImageMirror(ctx, mirrored, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Config.SecretBackend() != config.SecretBackendNone {
+		if err := b.makeSecrets(ctx, recipeResult, componentValues, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(b.Config.CABundle()) > 0 {
+		if err := b.makeCABundle(ctx, recipeResult, dir, output); err != nil {
+			return nil, err
+		}
+	}
+
+	if previous != nil {
+		changesPath, changesSize, err := b.writeChangesFile(previous, dir)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				"failed to write changes file", err)
+		}
+		output.ChangesFile = changesPath
+		output.TotalFiles++
+		output.TotalSize += changesSize
+	}
+
+	auditPath, auditSize, err := b.writeAuditLog(sourceRecipe, recipeResult, recipeDigest, provenance, dir)
 	if err != nil {
 		return nil, errors.Wrap(errors.ErrCodeInternal,
-			"failed to extract component values", err)
+			"failed to write audit log", err)
 	}
+	output.AuditFile = auditPath
+	output.TotalFiles++
+	output.TotalSize += auditSize
 
-	// Route based on deployer
-	deployer := b.Config.Deployer()
-	if deployer == config.DeployerArgoCD {
-		return b.makeArgoCD(ctx, recipeResult, componentValues, dir, start)
+	manifestPath, manifestSize, err := b.writeManifest(ctx, sourceRecipe, recipeDigest, dir, output)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to write bundle manifest", err)
+	}
+	output.ManifestFile = manifestPath
+	output.TotalFiles++
+	output.TotalSize += manifestSize
+
+	output.RecipeDigest = recipeDigest
+	output.SkippedComponents = skipped
+	output.PolicyWarnings = policyWarnings
+	output.SchemaWarnings = schemaWarnings
+	output.Overrides = overrides
+	return output, nil
+}
+
+// checkCompatibility checks the recipe components against the configured
+// target Kubernetes version and records any issues as constraint warnings.
+// It does nothing when no target version is configured.
+func (b *DefaultBundler) checkCompatibility(recipeResult *recipe.RecipeResult) error {
+	if b.Config.KubernetesVersion() == "" {
+		return nil
+	}
+
+	target, err := version.ParseVersion(b.Config.KubernetesVersion())
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid Kubernetes version %q", b.Config.KubernetesVersion()), err)
+	}
+
+	issues, err := compat.NewChecker().Check(recipeResult, target)
+	if err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		slog.Warn("component incompatible with target Kubernetes version",
+			"component", issue.Component,
+			"kubernetes", issue.Actual,
+			"expected", issue.Expected,
+			"reason", issue.Reason)
+	}
+	compat.Annotate(recipeResult, issues)
+
+	return nil
+}
+
+// enforceVersionPolicy checks the resolved component versions and values
+// against the configured version policy. Violations with the deny action fail
+// bundle generation; the others are logged and returned as warnings. It does
+// nothing when no policy is configured.
+func (b *DefaultBundler) enforceVersionPolicy(recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any) ([]string, error) {
+	p := b.Config.VersionPolicy()
+	if p == nil {
+		return nil, nil
+	}
+
+	violations, err := p.Evaluate(recipeResult, componentValues)
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Enforce(violations); err != nil {
+		return nil, err
+	}
+
+	warnings := make([]string, 0, len(violations))
+	for _, v := range violations {
+		slog.Warn("component version not approved by version policy",
+			"component", v.Component,
+			"path", v.Path,
+			"actual", v.Actual,
+			"allowed", v.Allowed)
+		warnings = append(warnings, v.String())
+	}
+	return warnings, nil
+}
+
+// validateValuesSchemas checks the resolved component values against the
+// values.schema.json of their charts, taken from the vendored schema
+// directory if configured and from the charts otherwise. Violations fail
+// bundle generation in error mode; in warn mode they are logged and returned
+// as warnings. It does nothing when schema validation is disabled.
+func (b *DefaultBundler) validateValuesSchemas(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any) ([]string, error) {
+	mode := b.Config.SchemaValidation()
+	if mode == config.SchemaValidationNone {
+		return nil, nil
+	}
+
+	var loader schema.Loader = schema.NewChartLoader()
+	if dir := b.Config.SchemaDir(); dir != "" {
+		loader = schema.NewDirLoader(dir)
+	}
+	validator := schema.NewValidator(loader)
+
+	var violations []string
+	for _, ref := range recipeResult.ComponentRefs {
+		found, err := validator.Validate(ctx, ref, componentValues[ref.Name])
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range found {
+			violations = append(violations, v.String())
+		}
+	}
+
+	if len(violations) > 0 && mode == config.SchemaValidationError {
+		return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("values schema violated: %s", strings.Join(violations, "; ")),
+			map[string]any{"violations": violations})
+	}
+	for _, v := range violations {
+		slog.Warn("component values do not match chart schema", "violation", v)
 	}
-	return b.makeUmbrellaChart(ctx, recipeResult, componentValues, dir, start)
+	return violations, nil
 }
 
-// makeUmbrellaChart generates a Helm umbrella chart.
-func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, start time.Time) (*result.Output, error) {
+// makeUmbrellaChart generates a Helm umbrella chart from recipeResult and
+// writes sourceRecipe, the unfiltered input recipe, as recipe.yaml. The extra
+// manifests, keyed by component reference, are added to its templates, and
+// the manifest values to the values they read.
+func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult, sourceRecipe *recipe.RecipeResult, componentValues, manifestValues map[string]map[string]any, extraManifests map[string]map[string][]byte, dir string, start time.Time) (*result.Output, error) {
 	slog.Debug("generating umbrella chart",
 		"component_count", len(recipeResult.ComponentRefs),
 		"output_dir", dir,
@@ -177,6 +652,9 @@ func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult *re
 		return nil, errors.Wrap(errors.ErrCodeInternal,
 			"failed to collect manifest contents", err)
 	}
+	if err := addExtraManifests(manifestContents, recipeResult, extraManifests); err != nil {
+		return nil, err
+	}
 
 	// Generate umbrella chart
 	generator := helm.NewGenerator()
@@ -186,6 +664,7 @@ func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult *re
 		Version:          b.Config.Version(),
 		IncludeChecksums: b.Config.IncludeChecksums(),
 		ManifestContents: manifestContents,
+		ManifestValues:   manifestValues,
 	}
 
 	output, err := generator.Generate(ctx, generatorInput, dir)
@@ -195,7 +674,7 @@ func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult *re
 	}
 
 	// Write recipe file
-	recipeSize, err := b.writeRecipeFile(recipeResult, dir)
+	recipeSize, err := b.writeRecipeFile(sourceRecipe, dir)
 	if err != nil {
 		return nil, errors.Wrap(errors.ErrCodeInternal,
 			"failed to write recipe file", err)
@@ -215,12 +694,21 @@ func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult *re
 	umbrellaResult := &result.Result{
 		Type:     "umbrella-chart",
 		Success:  true,
-		Files:    output.Files,
-		Size:     output.TotalSize,
+		Files:    append(output.Files, filepath.Join(dir, "recipe.yaml")),
+		Size:     output.TotalSize + recipeSize,
 		Duration: output.Duration,
 	}
 	resultOutput.Results = append(resultOutput.Results, umbrellaResult)
 
+	// The chart's checksums.txt was written before recipe.yaml; re-write it
+	// so it covers recipe.yaml too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, resultOutput, nil); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
 	// Populate deployment info from generator output
 	resultOutput.Deployment = &result.DeploymentInfo{
 		Type:  "Helm umbrella chart",
@@ -236,8 +724,9 @@ func (b *DefaultBundler) makeUmbrellaChart(ctx context.Context, recipeResult *re
 	return resultOutput, nil
 }
 
-// makeArgoCD generates ArgoCD Application manifests.
-func (b *DefaultBundler) makeArgoCD(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, start time.Time) (*result.Output, error) {
+// makeArgoCD generates ArgoCD Application manifests. The extra manifests,
+// keyed by component reference, are synced with their component.
+func (b *DefaultBundler) makeArgoCD(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, extraManifests map[string]map[string][]byte, dir string, start time.Time) (*result.Output, error) {
 	slog.Debug("generating argocd applications",
 		"component_count", len(recipeResult.ComponentRefs),
 		"output_dir", dir,
@@ -251,6 +740,10 @@ func (b *DefaultBundler) makeArgoCD(ctx context.Context, recipeResult *recipe.Re
 		Version:          b.Config.Version(),
 		RepoURL:          b.Config.RepoURL(),
 		IncludeChecksums: b.Config.IncludeChecksums(),
+		NamespaceScoped:  b.namespaceScoped(recipeResult),
+		ApplicationSet:   b.Config.ApplicationSet(),
+		ClusterSelector:  b.Config.ClusterSelector(),
+		ExtraManifests:   extraManifests,
 	}
 
 	output, err := generator.Generate(ctx, generatorInput, dir)
@@ -295,60 +788,1008 @@ func (b *DefaultBundler) makeArgoCD(ctx context.Context, recipeResult *recipe.Re
 	return resultOutput, nil
 }
 
-// extractComponentValues extracts and processes values for each component in the recipe.
-// It loads base values from the recipe, applies user overrides, and applies node selectors.
-func (b *DefaultBundler) extractComponentValues(ctx context.Context, recipeResult *recipe.RecipeResult) (map[string]map[string]any, error) {
-	componentValues := make(map[string]map[string]any)
+// makeArgoWorkflows generates an Argo Workflows install pipeline.
+func (b *DefaultBundler) makeArgoWorkflows(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, start time.Time) (*result.Output, error) {
+	slog.Debug("generating argo workflow",
+		"component_count", len(recipeResult.ComponentRefs),
+		"output_dir", dir,
+	)
 
-	for _, ref := range recipeResult.ComponentRefs {
-		if err := ctx.Err(); err != nil {
-			return nil, err
-		}
+	generator := argoworkflows.NewGenerator()
+	generatorInput := &argoworkflows.GeneratorInput{
+		RecipeResult:     recipeResult,
+		ComponentValues:  componentValues,
+		Version:          b.Config.Version(),
+		IncludeChecksums: b.Config.IncludeChecksums(),
+		NamespaceScoped:  b.namespaceScoped(recipeResult),
+	}
 
-		// Get base values from recipe
-		values, err := recipeResult.GetValuesForComponent(ref.Name)
-		if err != nil {
-			slog.Warn("failed to get values for component, using empty map",
-				"component", ref.Name,
-				"error", err,
-			)
-			values = make(map[string]any)
-		}
+	output, err := generator.Generate(ctx, generatorInput, dir)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to generate argo workflow", err)
+	}
 
-		// Apply user value overrides from --set flags
-		if overrides := b.getValueOverridesForComponent(ref.Name); len(overrides) > 0 {
-			if applyErr := component.ApplyMapOverrides(values, overrides); applyErr != nil {
-				slog.Warn("failed to apply some value overrides",
-					"component", ref.Name,
-					"error", applyErr,
-				)
-			}
-		}
+	resultOutput := &result.Output{
+		Results:       make([]*result.Result, 0),
+		Errors:        make([]result.BundleError, 0),
+		TotalDuration: time.Since(start),
+		TotalSize:     output.TotalSize,
+		TotalFiles:    len(output.Files),
+		OutputDir:     dir,
+	}
 
-		// Apply node selectors and tolerations based on component type
-		b.applyNodeSchedulingOverrides(ref.Name, values)
+	resultOutput.Results = append(resultOutput.Results, &result.Result{
+		Type:     "argo-workflow",
+		Success:  true,
+		Files:    output.Files,
+		Size:     output.TotalSize,
+		Duration: output.Duration,
+	})
 
-		componentValues[ref.Name] = values
+	resultOutput.Deployment = &result.DeploymentInfo{
+		Type:  "Argo Workflows install pipeline",
+		Steps: output.DeploymentSteps,
+		Notes: output.DeploymentNotes,
 	}
 
-	return componentValues, nil
+	slog.Debug("argo workflow generation complete",
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+		"duration", output.Duration,
+	)
+
+	return resultOutput, nil
 }
 
-// getValueOverridesForComponent returns value overrides for a specific component.
-// Uses the component registry to match both exact names and alternative override keys.
-func (b *DefaultBundler) getValueOverridesForComponent(componentName string) map[string]string {
-	if b.Config == nil {
-		return nil
-	}
+// makeTerraform generates a Terraform module of helm_release resources.
+func (b *DefaultBundler) makeTerraform(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, start time.Time) (*result.Output, error) {
+	slog.Debug("generating terraform module",
+		"component_count", len(recipeResult.ComponentRefs),
+		"output_dir", dir,
+	)
 
-	allOverrides := b.Config.ValueOverrides()
-	if allOverrides == nil {
-		return nil
+	generator := terraform.NewGenerator()
+	generatorInput := &terraform.GeneratorInput{
+		RecipeResult:               recipeResult,
+		ComponentValues:            componentValues,
+		Version:                    b.Config.Version(),
+		IncludeChecksums:           b.Config.IncludeChecksums(),
+		NamespaceScoped:            b.namespaceScoped(recipeResult),
+		SystemNodeSelector:         b.Config.SystemNodeSelector(),
+		SystemNodeTolerations:      b.Config.SystemNodeTolerations(),
+		AcceleratedNodeSelector:    b.Config.AcceleratedNodeSelector(),
+		AcceleratedNodeTolerations: b.Config.AcceleratedNodeTolerations(),
 	}
 
-	// Check exact name first
-	if overrides, ok := allOverrides[componentName]; ok {
-		return overrides
+	output, err := generator.Generate(ctx, generatorInput, dir)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to generate terraform module", err)
+	}
+
+	resultOutput := &result.Output{
+		Results:       make([]*result.Result, 0),
+		Errors:        make([]result.BundleError, 0),
+		TotalDuration: time.Since(start),
+		TotalSize:     output.TotalSize,
+		TotalFiles:    len(output.Files),
+		OutputDir:     dir,
+	}
+
+	resultOutput.Results = append(resultOutput.Results, &result.Result{
+		Type:     "terraform-module",
+		Success:  true,
+		Files:    output.Files,
+		Size:     output.TotalSize,
+		Duration: output.Duration,
+	})
+
+	resultOutput.Deployment = &result.DeploymentInfo{
+		Type:  "Terraform module",
+		Steps: output.DeploymentSteps,
+		Notes: output.DeploymentNotes,
+	}
+
+	slog.Debug("terraform module generation complete",
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+		"duration", output.Duration,
+	)
+
+	return resultOutput, nil
+}
+
+// makeCapacityTemplates generates node provisioning templates for GPU capacity
+// into dir and adds them to output. The steps to apply them come first, since
+// the GPU components need the nodes they provision.
+func (b *DefaultBundler) makeCapacityTemplates(ctx context.Context, recipeResult *recipe.RecipeResult, dir string, output *result.Output) error {
+	input := &capacity.GeneratorInput{
+		Criteria:     recipeResult.Criteria,
+		NodeSelector: b.Config.AcceleratedNodeSelector(),
+		Tolerations:  b.Config.AcceleratedNodeTolerations(),
+	}
+
+	templateType := b.Config.CapacityTemplate()
+	if templateType == config.CapacityTemplateAuto {
+		templateType = config.CapacityTemplateClusterAPI
+		if recipeResult.Criteria == nil || recipeResult.Criteria.Service == recipe.CriteriaServiceEKS {
+			templateType = config.CapacityTemplateKarpenter
+		}
+	}
+
+	generator := capacity.NewGenerator()
+	var generated *capacity.GeneratorOutput
+	var err error
+	if templateType == config.CapacityTemplateKarpenter {
+		generated, err = generator.GenerateKarpenter(ctx, input, dir)
+	} else {
+		generated, err = generator.GenerateClusterAPI(ctx, input, dir)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the capacity templates too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "capacity-templates",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Steps = append(generated.DeploymentSteps, output.Deployment.Steps...)
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+
+	slog.Debug("capacity templates generated",
+		"type", templateType,
+		"files", len(generated.Files),
+		"size_bytes", generated.TotalSize,
+	)
+
+	return nil
+}
+
+// makePostInstallChecks writes verify.sh with the registry's post-install
+// checks of the components into dir, describes them in README.md and adds
+// the script to output. It does nothing when no component has checks.
+func (b *DefaultBundler) makePostInstallChecks(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, output *result.Output) error {
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var components []postinstall.Component
+	for _, name := range recipeResult.DeploymentOrder {
+		ref := recipeResult.GetComponentRef(name)
+		if ref == nil {
+			continue
+		}
+		comp := registry.Get(ref.ComponentName())
+		if comp == nil {
+			continue
+		}
+		checks := postinstall.Resolve(b.componentNamespace(*ref), comp.Verify, componentValues[ref.Name])
+		if len(checks) > 0 {
+			components = append(components, postinstall.Component{Name: ref.Name, Checks: checks})
+		}
+	}
+	if len(components) == 0 {
+		slog.Debug("no component has post-install checks, skipping verify script")
+		return nil
+	}
+
+	generated, err := postinstall.NewGenerator().Generate(ctx, &postinstall.GeneratorInput{
+		Components:    components,
+		NodeSelector:  b.Config.AcceleratedNodeSelector(),
+		Tolerations:   b.Config.AcceleratedNodeTolerations(),
+		RecipeVersion: recipeResult.Metadata.Version,
+		Version:       b.Config.Version(),
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the script and the README section.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "post-install",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// makeRunbook writes the upgrade and rollback runbook into dir and adds it
+// to output.
+func (b *DefaultBundler) makeRunbook(ctx context.Context, recipeResult *recipe.RecipeResult, dir string, output *result.Output) error {
+	generated, err := runbook.NewGenerator().Generate(ctx, &runbook.GeneratorInput{
+		RecipeResult: recipeResult,
+		Deployer:     b.Config.Deployer(),
+		Version:      b.Config.Version(),
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the runbook too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "runbook",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// mirrorImages rewrites the image repositories of the component values to
+// the configured registry mirror and returns the images to copy there. It
+// does nothing when no mirror is configured.
+func (b *DefaultBundler) mirrorImages(recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any) ([]mirror.Image, error) {
+	registryMirror := b.Config.ImageRegistryMirror()
+	if registryMirror == "" {
+		return nil, nil
+	}
+
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var images []mirror.Image
+	for _, ref := range recipeResult.ComponentRefs {
+		values, ok := componentValues[ref.Name]
+		if !ok {
+			continue
+		}
+		comp := registry.Get(ref.ComponentName())
+		if comp == nil {
+			continue
+		}
+		images = append(images, mirror.Rewrite(ref.Name, comp.Images, values, registryMirror)...)
+	}
+	return images, nil
+}
+
+// makeImageMirror writes the script copying images to the registry mirror
+// into dir and adds it to output.
+func (b *DefaultBundler) makeImageMirror(ctx context.Context, images []mirror.Image, dir string, output *result.Output) error {
+	generated, err := mirror.NewGenerator().Generate(ctx, &mirror.GeneratorInput{
+		Mirror:   b.Config.ImageRegistryMirror(),
+		Images:   images,
+		ProxyEnv: proxy.Env(b.Config.HTTPProxy(), b.Config.NoProxy()),
+		Version:  b.Config.Version(),
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the mirror script too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "image-mirror",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// makeSecrets writes the Secrets the components read, for the configured
+// secret backend, into dir and adds them to output.
+func (b *DefaultBundler) makeSecrets(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, output *result.Output) error {
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var componentSecrets []secrets.Secret
+	for _, ref := range recipeResult.ComponentRefs {
+		comp := registry.Get(ref.ComponentName())
+		if comp == nil {
+			continue
+		}
+		componentSecrets = append(componentSecrets,
+			secrets.Resolve(ref.Name, b.componentNamespace(ref), comp.Secrets, componentValues[ref.Name])...)
+	}
+	if len(componentSecrets) == 0 {
+		slog.Debug("no component reads a secret, skipping secret manifests")
+		return nil
+	}
+
+	generated, err := secrets.NewGenerator().Generate(ctx, &secrets.GeneratorInput{
+		Backend: b.Config.SecretBackend(),
+		Store:   b.Config.SecretStore(),
+		Secrets: componentSecrets,
+		Version: b.Config.Version(),
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the secret manifests too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "secrets",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// makeCABundle writes the CA bundle ConfigMaps for the namespaces of the
+// components taking it into dir and adds them to output.
+func (b *DefaultBundler) makeCABundle(ctx context.Context, recipeResult *recipe.RecipeResult, dir string, output *result.Output) error {
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var namespaces []string
+	for _, ref := range recipeResult.ComponentRefs {
+		if comp := registry.Get(ref.ComponentName()); comp != nil && comp.Proxy.TakesCABundle() {
+			namespaces = append(namespaces, b.componentNamespace(ref))
+		}
+	}
+	if len(namespaces) == 0 {
+		slog.Debug("no component takes a CA bundle, skipping CA bundle manifests")
+		return nil
+	}
+
+	generated, err := proxy.NewGenerator().Generate(ctx, &proxy.GeneratorInput{
+		CABundle:   b.Config.CABundle(),
+		Namespaces: namespaces,
+		HTTPProxy:  b.Config.HTTPProxy(),
+		NoProxy:    b.Config.NoProxy(),
+		Version:    b.Config.Version(),
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the CA bundle manifests too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "ca-bundle",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// componentNamespace returns the namespace the configured deployer installs
+// a component into.
+func (b *DefaultBundler) componentNamespace(ref recipe.ComponentRef) string {
+	switch b.Config.Deployer() {
+	case config.DeployerArgoCD:
+		return argocd.ComponentNamespace(ref)
+	case config.DeployerArgoWorkflows:
+		return argoworkflows.ComponentNamespace(ref)
+	case config.DeployerTerraform:
+		return terraform.ComponentNamespace(ref)
+	default:
+		return helm.ReleaseNamespace
+	}
+}
+
+// makeNodeBootstrap writes the node bootstrap artifacts into dir and adds
+// them to output.
+func (b *DefaultBundler) makeNodeBootstrap(ctx context.Context, recipeResult *recipe.RecipeResult, dir string, output *result.Output) error {
+	generated, err := bootstrap.NewGenerator().Generate(ctx, &bootstrap.GeneratorInput{
+		RecipeResult: recipeResult,
+		Version:      b.Config.Version(),
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the bootstrap artifacts too.
+	if b.Config.IncludeChecksums() && len(generated.Files) > 0 {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "node-bootstrap",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// makeNodeLabels writes the labels and taints proposed for system and GPU
+// nodes into dir and adds them to output.
+func (b *DefaultBundler) makeNodeLabels(ctx context.Context, recipeResult *recipe.RecipeResult, dir string, output *result.Output) error {
+	generated, err := nodelabels.NewGenerator().Generate(ctx, &nodelabels.GeneratorInput{
+		RecipeResult: recipeResult,
+		Version:      b.Config.Version(),
+		Accelerated: nodelabels.Scheduling{
+			NodeSelector: b.Config.AcceleratedNodeSelector(),
+			Tolerations:  b.Config.AcceleratedNodeTolerations(),
+		},
+		System: nodelabels.Scheduling{
+			NodeSelector: b.Config.SystemNodeSelector(),
+			Tolerations:  b.Config.SystemNodeTolerations(),
+		},
+	}, dir)
+	if err != nil {
+		return err
+	}
+
+	// Re-write checksums.txt so it covers the node label artifacts too.
+	if b.Config.IncludeChecksums() && len(generated.Files) > 0 {
+		if err := b.updateChecksums(ctx, dir, output, generated.Files); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:     "node-labels",
+		Success:  true,
+		Files:    generated.Files,
+		Size:     generated.TotalSize,
+		Duration: generated.Duration,
+	})
+	output.TotalFiles += len(generated.Files)
+	output.TotalSize += generated.TotalSize
+	output.TotalDuration += generated.Duration
+
+	if output.Deployment == nil {
+		output.Deployment = &result.DeploymentInfo{}
+	}
+	output.Deployment.Notes = append(output.Deployment.Notes, generated.DeploymentNotes...)
+	return nil
+}
+
+// withNodeLabelScheduling returns the bundler to generate recipeResult with.
+// When node labels are requested without accelerated node selectors or
+// tolerations of specific taints, it is a copy whose config schedules GPU
+// components with the labels and taint the node-labels output proposes, so
+// both agree.
+func (b *DefaultBundler) withNodeLabelScheduling(recipeResult *recipe.RecipeResult) *DefaultBundler {
+	if !b.Config.NodeLabels() {
+		return b
+	}
+	defaults := nodelabels.DefaultAccelerated(recipeResult.Criteria)
+	var opts []config.Option
+	if len(b.Config.AcceleratedNodeSelector()) == 0 {
+		opts = append(opts, config.WithAcceleratedNodeSelector(defaults.NodeSelector))
+	}
+	if len(nodelabels.Taints(b.Config.AcceleratedNodeTolerations())) == 0 {
+		opts = append(opts, config.WithAcceleratedNodeTolerations(defaults.Tolerations))
+	}
+	if len(opts) == 0 {
+		return b
+	}
+	derived := *b
+	derived.Config = b.Config.With(opts...)
+	return &derived
+}
+
+// componentBundler returns the plugin bundler registered for ref's
+// component, or nil if the component is not bundled by a plugin.
+func (b *DefaultBundler) componentBundler(ref recipe.ComponentRef) registry.ComponentBundler {
+	if b.Plugins == nil {
+		return nil
+	}
+	bundler, ok := b.Plugins.Get(types.BundleType(ref.ComponentName()))
+	if !ok {
+		return nil
+	}
+	cb, _ := bundler.(registry.ComponentBundler)
+	return cb
+}
+
+// makePluginFiles asks the plugin bundling each component for its files,
+// writes them under plugins/<component>/ and adds them to output. Components
+// are bundled in parallel up to the configured concurrency; a component whose
+// plugin fails is recorded in output.Errors without stopping the others.
+func (b *DefaultBundler) makePluginFiles(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, dir string, output *result.Output) error {
+	refs := recipeResult.ComponentRefs
+	made := make([]*result.Result, len(refs))
+
+	errs := b.forEachComponent(ctx, refs, func(ctx context.Context, i int, ref recipe.ComponentRef) error {
+		cb := b.componentBundler(ref)
+		if cb == nil {
+			return nil
+		}
+		res, err := cb.MakeComponent(ctx, recipeResult, ref, componentValues[ref.Name],
+			filepath.Join(dir, plugin.DirName, ref.Name))
+		if err != nil {
+			return fmt.Errorf("failed to bundle component with plugin: %w", err)
+		}
+		made[i] = res
+		return nil
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	output.Errors = append(output.Errors, componentErrors(refs, errs)...)
+
+	written := false
+	for i, ref := range refs {
+		if made[i] == nil || len(made[i].Files) == 0 {
+			continue
+		}
+
+		output.Results = append(output.Results, made[i])
+		output.TotalFiles += len(made[i].Files)
+		output.TotalSize += made[i].Size
+		output.TotalDuration += made[i].Duration
+		written = true
+
+		if output.Deployment == nil {
+			output.Deployment = &result.DeploymentInfo{}
+		}
+		output.Deployment.Notes = append(output.Deployment.Notes,
+			fmt.Sprintf("%s/%s/ holds files generated by the %s bundler plugin", plugin.DirName, ref.Name, ref.Name))
+	}
+
+	// Re-write checksums.txt so it covers the plugin files too.
+	if written && b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, nil); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+	return nil
+}
+
+// updateChecksums re-writes the bundle's checksums.txt over the files already
+// listed in output plus extra, adjusting the output size accordingly.
+func (b *DefaultBundler) updateChecksums(ctx context.Context, dir string, output *result.Output, extra []string) error {
+	checksumPath := checksum.GetChecksumFilePath(dir)
+
+	var files []string
+	for _, r := range output.Results {
+		for _, f := range r.Files {
+			if f != checksumPath {
+				files = append(files, f)
+			}
+		}
+	}
+	files = append(files, extra...)
+
+	var before int64
+	if info, err := os.Stat(checksumPath); err == nil {
+		before = info.Size()
+	}
+	if err := checksum.GenerateChecksums(ctx, dir, files); err != nil {
+		return err
+	}
+	info, err := os.Stat(checksumPath)
+	if err != nil {
+		return err
+	}
+	output.TotalSize += info.Size() - before
+	return nil
+}
+
+// ComponentValues returns the values Make would use for each component of
+// recipeResult, with --set overrides and node scheduling applied. It lets
+// callers such as the live deployer install components without writing a bundle.
+func (b *DefaultBundler) ComponentValues(ctx context.Context, recipeResult *recipe.RecipeResult) (map[string]map[string]any, error) {
+	if recipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe result cannot be nil")
+	}
+	values, _, _, err := b.withNodeLabelScheduling(recipeResult).extractComponentValues(ctx, recipeResult)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to extract component values", err)
+	}
+	if _, err := splitManifestValues(recipeResult, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// splitManifestValues removes the values the registry lists as read only by
+// a component's manifests (manifestValues) from componentValues, so they
+// never reach the chart, and returns them keyed by component name.
+func splitManifestValues(recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any) (map[string]map[string]any, error) {
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var split map[string]map[string]any
+	for _, ref := range recipeResult.ComponentRefs {
+		comp := registry.Get(ref.ComponentName())
+		values := componentValues[ref.Name]
+		if comp == nil || len(comp.ManifestValues) == 0 || len(values) == 0 {
+			continue
+		}
+		var kept map[string]any
+		for _, key := range comp.ManifestValues {
+			v, ok := values[key]
+			if !ok {
+				continue
+			}
+			if kept == nil {
+				kept = maps.Clone(values)
+			}
+			delete(kept, key)
+			if split == nil {
+				split = make(map[string]map[string]any)
+			}
+			if split[ref.Name] == nil {
+				split[ref.Name] = make(map[string]any)
+			}
+			split[ref.Name][key] = v
+		}
+		if kept != nil {
+			componentValues[ref.Name] = kept
+		}
+	}
+	return split, nil
+}
+
+// extractComponentValues extracts and processes values for each component in the recipe.
+// It loads base values from the recipe, applies user overrides, and applies node selectors.
+// It also returns how the user overrides of each component were applied, in
+// recipe order, and the provenance of the values of each component.
+// Components are processed in parallel up to the configured concurrency; the
+// error lists every component that failed.
+func (b *DefaultBundler) extractComponentValues(ctx context.Context, recipeResult *recipe.RecipeResult) (map[string]map[string]any, []result.OverrideReport, map[string]*audit.Tracker, error) {
+	refs := recipeResult.ComponentRefs
+	values := make([]map[string]any, len(refs))
+	reports := make([]result.OverrideReport, len(refs))
+	trackers := make([]*audit.Tracker, len(refs))
+
+	// Initialize the data provider before the workers read values through it.
+	recipe.GetDataProvider()
+
+	profile, err := b.loadProfile()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	errs := b.forEachComponent(ctx, refs, func(ctx context.Context, i int, ref recipe.ComponentRef) error {
+		trackers[i] = audit.NewTracker()
+		v, report, err := b.resolveComponentValues(ctx, recipeResult, ref, profile, trackers[i])
+		values[i] = v
+		reports[i] = report
+		return err
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := joinComponentErrors(componentErrors(refs, errs)); err != nil {
+		return nil, nil, nil, err
+	}
+
+	componentValues := make(map[string]map[string]any, len(refs))
+	provenance := make(map[string]*audit.Tracker, len(refs))
+	for i, ref := range refs {
+		componentValues[ref.Name] = values[i]
+		provenance[ref.Name] = trackers[i]
+	}
+	return componentValues, reports, provenance, nil
+}
+
+// resolveComponentValues returns the values of a single component: the recipe
+// values, replaced by a bundler plugin if one handles the component, with
+// the namespace scope values, profile presets, --set overrides, node
+// scheduling and values patches applied. The report
+// records which --set overrides replaced an existing value, and tracker
+// which step set each value.
+func (b *DefaultBundler) resolveComponentValues(ctx context.Context, recipeResult *recipe.RecipeResult, ref recipe.ComponentRef, profile *recipe.BundleProfile, tracker *audit.Tracker) (map[string]any, result.OverrideReport, error) {
+	report := result.OverrideReport{Component: ref.Name}
+
+	// Get base values from recipe
+	values, err := recipeResult.GetValuesForComponent(ref.Name)
+	if err != nil {
+		slog.Warn("failed to get values for component, using empty map",
+			"component", ref.Name,
+			"error", err,
+		)
+		values = make(map[string]any)
+	}
+	tracker.Record(audit.SourceRecipe, values)
+
+	// Let a plugin bundling the component provide its values
+	if cb := b.componentBundler(ref); cb != nil {
+		values, err = cb.ComponentValues(ctx, recipeResult, ref, values)
+		if err != nil {
+			return nil, report, err
+		}
+		tracker.Record(audit.SourcePlugin, values)
+	}
+
+	// Switch namespace-scoped components to their namespace scope values
+	if b.installScope(ref) == config.InstallScopeNamespace {
+		registry, regErr := recipe.GetComponentRegistry()
+		if regErr != nil {
+			return nil, report, errors.Wrap(errors.ErrCodeInternal,
+				"failed to load component registry", regErr)
+		}
+		registry.Get(ref.ComponentName()).ApplyNamespaceScope(values)
+		tracker.Record(audit.SourceInstallScope, values)
+	}
+
+	// Apply the bundle profile presets under the user overrides
+	applyProfile(profile, ref, values)
+	tracker.Record(audit.SourceProfile, values)
+
+	// Apply user value overrides from --set flags, noting first which
+	// ones replace an existing value
+	if overrides := b.getValueOverridesForComponent(ref); len(overrides) > 0 {
+		for _, path := range slices.Sorted(maps.Keys(overrides)) {
+			if component.HasMapPath(values, path) {
+				report.Applied = append(report.Applied, path)
+			} else {
+				report.Unapplied = append(report.Unapplied, path)
+			}
+		}
+		if applyErr := component.ApplyMapOverrides(values, overrides); applyErr != nil {
+			slog.Warn("failed to apply some value overrides",
+				"component", ref.Name,
+				"error", applyErr,
+			)
+		}
+		tracker.Record(audit.SourceOverride, values)
+	}
+
+	// Apply node selectors and tolerations based on component type
+	b.applyNodeSchedulingOverrides(ref.ComponentName(), values)
+	tracker.Record(audit.SourceNodeScheduling, values)
+
+	// Apply proxy env vars and the CA bundle
+	b.applyProxyOverrides(ref.ComponentName(), values)
+	tracker.Record(audit.SourceProxy, values)
+
+	// Apply --values-patch files last, so they see the final lists
+	for _, patch := range b.getValuePatchesForComponent(ref) {
+		values, err = patch.Apply(values)
+		if err != nil {
+			return nil, report, errors.Wrap(errors.ErrCodeInvalidRequest,
+				fmt.Sprintf("failed to patch values of %s", ref.Name), err)
+		}
+		tracker.Record(audit.SourceValuesPatch, values)
+	}
+
+	return values, report, nil
+}
+
+// loadProfile loads the configured bundle profile, or returns nil when none
+// is configured.
+func (b *DefaultBundler) loadProfile() (*recipe.BundleProfile, error) {
+	if b.Config == nil || b.Config.Profile() == "" {
+		return nil, nil
+	}
+	profile, err := recipe.LoadBundleProfile(b.Config.Profile())
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("bundle profile loaded", "profile", profile.Metadata.Name)
+	return profile, nil
+}
+
+// applyProfile merges the presets profile has for ref over values: those for
+// the registry component, matched like value overrides, followed by those
+// keyed by the instance name.
+func applyProfile(profile *recipe.BundleProfile, ref recipe.ComponentRef, values map[string]any) {
+	if profile == nil {
+		return
+	}
+	if _, key := lookupComponentKey(profile.Spec.Components, ref.ComponentName()); key != "" {
+		profile.Apply(key, values)
+	}
+	if ref.Name != ref.ComponentName() {
+		profile.Apply(ref.Name, values)
+	}
+}
+
+// checkOverrides summarizes how the --set overrides were applied, given the
+// reports of each component of recipeResult in order, and finds the override
+// keys no component used. When update is set, only its components count,
+// since the others keep their values from the existing bundle. Unapplied
+// overrides fail bundle generation when strict overrides are configured and
+// are logged otherwise. It returns nil when there are no overrides.
+func (b *DefaultBundler) checkOverrides(recipeResult *recipe.RecipeResult, reports []result.OverrideReport, update *bundleUpdate) (*result.Overrides, error) {
+	all := b.Config.ValueOverrides()
+	if len(all) == 0 {
+		return nil, nil
+	}
+
+	summary := &result.Overrides{}
+	used := make(map[string]bool, len(all))
+	for i, ref := range recipeResult.ComponentRefs {
+		if update != nil && !slices.Contains(update.components, ref.Name) {
+			continue
+		}
+		if _, key := lookupComponentKey(all, ref.ComponentName()); key != "" {
+			used[key] = true
+		}
+		if _, ok := all[ref.Name]; ok {
+			used[ref.Name] = true
+		}
+		if r := reports[i]; len(r.Applied) > 0 || len(r.Unapplied) > 0 {
+			summary.Components = append(summary.Components, r)
+		}
+	}
+	for key := range all {
+		if !used[key] {
+			summary.Unused = append(summary.Unused, key)
+		}
+	}
+	slices.Sort(summary.Unused)
+
+	unapplied := summary.Unapplied()
+	if len(unapplied) > 0 && b.Config.StrictOverrides() {
+		return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("value overrides not applied: %s", strings.Join(unapplied, "; ")),
+			map[string]any{"overrides": unapplied})
+	}
+	for _, u := range unapplied {
+		slog.Warn("value override not applied", "override", u)
+	}
+	return summary, nil
+}
+
+// getValueOverridesForComponent returns value overrides for a component reference.
+// Overrides for the registry component apply to every instance of it; overrides
+// keyed by the instance name are layered on top and win on conflicts.
+func (b *DefaultBundler) getValueOverridesForComponent(ref recipe.ComponentRef) map[string]string {
+	overrides := b.getValueOverridesForComponentName(ref.ComponentName())
+	if ref.Name == ref.ComponentName() || b.Config == nil {
+		return overrides
+	}
+
+	instance, ok := b.Config.ValueOverrides()[ref.Name]
+	if !ok {
+		return overrides
+	}
+	merged := make(map[string]string, len(overrides)+len(instance))
+	maps.Copy(merged, overrides)
+	maps.Copy(merged, instance)
+	return merged
+}
+
+// getValueOverridesForComponentName returns value overrides for a registry component.
+// Uses the component registry to match both exact names and alternative override keys.
+func (b *DefaultBundler) getValueOverridesForComponentName(componentName string) map[string]string {
+	if b.Config == nil {
+		return nil
+	}
+	overrides, _ := lookupComponentKey(b.Config.ValueOverrides(), componentName)
+	return overrides
+}
+
+// getValuePatchesForComponent returns the values patches for a component
+// reference: those for the registry component, matched like value overrides,
+// followed by those keyed by the instance name.
+func (b *DefaultBundler) getValuePatchesForComponent(ref recipe.ComponentRef) []*recipe.ValuesPatch {
+	if b.Config == nil {
+		return nil
+	}
+	all := b.Config.ValuePatches()
+	patches, _ := lookupComponentKey(all, ref.ComponentName())
+	if ref.Name != ref.ComponentName() {
+		patches = append(patches, all[ref.Name]...)
+	}
+	return patches
+}
+
+// lookupComponentKey returns the entry of byKey for a registry component,
+// keyed by its name or one of its alternative value override keys, and the
+// key it was found under. It returns the zero value and an empty key if
+// there is none.
+func lookupComponentKey[T any](byKey map[string]T, componentName string) (T, string) {
+	var zero T
+	if len(byKey) == 0 {
+		return zero, ""
+	}
+
+	// Check exact name first
+	if v, ok := byKey[componentName]; ok {
+		return v, componentName
 	}
 
 	// Use component registry to find component by any override key
@@ -357,27 +1798,27 @@ func (b *DefaultBundler) getValueOverridesForComponent(componentName string) map
 		// Fall back to non-hyphenated check if registry fails
 		nonHyphenated := removeHyphens(componentName)
 		if nonHyphenated != componentName {
-			if overrides, ok := allOverrides[nonHyphenated]; ok {
-				return overrides
+			if v, ok := byKey[nonHyphenated]; ok {
+				return v, nonHyphenated
 			}
 		}
-		return nil
+		return zero, ""
 	}
 
 	// Get the component config to access its value override keys
 	comp := registry.Get(componentName)
 	if comp == nil {
-		return nil
+		return zero, ""
 	}
 
 	// Check each alternative override key
 	for _, key := range comp.ValueOverrideKeys {
-		if overrides, ok := allOverrides[key]; ok {
-			return overrides
+		if v, ok := byKey[key]; ok {
+			return v, key
 		}
 	}
 
-	return nil
+	return zero, ""
 }
 
 // applyNodeSchedulingOverrides applies node selectors and tolerations to component values.
@@ -431,6 +1872,32 @@ func (b *DefaultBundler) applyNodeSchedulingOverrides(componentName string, valu
 	}
 }
 
+// applyProxyOverrides injects the configured proxy env vars and CA bundle
+// into component values, at the paths the component registry lists.
+func (b *DefaultBundler) applyProxyOverrides(componentName string, values map[string]any) {
+	if b.Config == nil || (b.Config.HTTPProxy() == "" && len(b.Config.CABundle()) == 0) {
+		return
+	}
+
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		slog.Debug("failed to load component registry for proxy settings",
+			"error", err,
+			"component", componentName,
+		)
+		return
+	}
+
+	comp := registry.Get(componentName)
+	if comp == nil {
+		return // Unknown component, skip
+	}
+
+	proxy.Apply(comp.Proxy, values,
+		proxy.Env(b.Config.HTTPProxy(), b.Config.NoProxy()),
+		len(b.Config.CABundle()) > 0)
+}
+
 // writeRecipeFile serializes the recipe to the bundle directory.
 func (b *DefaultBundler) writeRecipeFile(recipeResult *recipe.RecipeResult, dir string) (int64, error) {
 	recipeData, err := yaml.Marshal(recipeResult)
@@ -447,6 +1914,107 @@ func (b *DefaultBundler) writeRecipeFile(recipeResult *recipe.RecipeResult, dir
 	return int64(len(recipeData)), nil
 }
 
+// loadPreviousBundle loads the configured previous bundle or, when none is
+// configured, the bundle already in dir. It returns nil when there is no
+// previous bundle. An unreadable bundle in dir is logged and ignored, since
+// it is about to be overwritten.
+func (b *DefaultBundler) loadPreviousBundle(dir string) (*diff.Bundle, error) {
+	if prev := b.Config.PreviousBundle(); prev != "" {
+		previous, err := diff.Load(prev)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInvalidRequest,
+				"failed to load previous bundle", err)
+		}
+		return previous, nil
+	}
+
+	previous, err := diff.LoadExisting(dir)
+	if err != nil {
+		slog.Warn("ignoring unreadable bundle in output directory",
+			"dir", dir,
+			"error", err,
+		)
+		return nil, nil
+	}
+	return previous, nil
+}
+
+// writeChangesFile compares the generated bundle in dir with previous and
+// writes the differences to CHANGES.md. It returns the file path and size.
+func (b *DefaultBundler) writeChangesFile(previous *diff.Bundle, dir string) (string, int64, error) {
+	current, err := diff.Load(dir)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var buf bytes.Buffer
+	if err := diff.Compare(previous, current).WriteMarkdown(&buf); err != nil {
+		return "", 0, fmt.Errorf("failed to render changes: %w", err)
+	}
+
+	changesPath := filepath.Join(dir, diff.ChangesFileName)
+	if err := os.WriteFile(changesPath, buf.Bytes(), 0600); err != nil {
+		return "", 0, fmt.Errorf("failed to write changes file: %w", err)
+	}
+
+	slog.Debug("wrote changes file", "path", changesPath)
+	return changesPath, int64(buf.Len()), nil
+}
+
+// writeManifest writes bundle.yaml listing the generator, recipe, components
+// and every file generated into dir. It returns the file path and size.
+func (b *DefaultBundler) writeManifest(ctx context.Context, recipeResult *recipe.RecipeResult, recipeDigest, dir string, output *result.Output) (string, int64, error) {
+	p, err := b.Plan(ctx, recipeResult)
+	if err != nil {
+		return "", 0, err
+	}
+
+	m := &manifest.Manifest{
+		GeneratedAt: time.Now().UTC(),
+		Generator: manifest.Generator{
+			Version:  b.Config.Version(),
+			Deployer: p.Deployer,
+			Profile:  b.Config.Profile(),
+		},
+		Recipe: manifest.Recipe{
+			Version: recipeResult.GetVersion(),
+			Digest:  recipeDigest,
+		},
+		Components: make([]manifest.Component, 0, len(p.Components)),
+	}
+	for _, c := range p.Components {
+		m.Components = append(m.Components, manifest.Component{
+			Name:      c.Name,
+			Type:      c.Type,
+			Namespace: c.Namespace,
+			Chart:     c.Chart,
+			Version:   c.Version,
+			Source:    c.Source,
+		})
+	}
+
+	var files []string
+	for _, r := range output.Results {
+		files = append(files, r.Files...)
+	}
+	if b.Config.IncludeChecksums() {
+		files = append(files, checksum.GetChecksumFilePath(dir))
+	}
+	if output.ChangesFile != "" {
+		files = append(files, output.ChangesFile)
+	}
+	if output.AuditFile != "" {
+		files = append(files, output.AuditFile)
+	}
+
+	path, size, err := m.Write(ctx, dir, files)
+	if err != nil {
+		return "", 0, err
+	}
+	slog.Debug("wrote bundle manifest", "path", path, "files", len(m.Files))
+	return path, size, nil
+}
+
 // removeHyphens removes hyphens from a string.
 func removeHyphens(s string) string {
 	result := make([]byte, 0, len(s))
@@ -458,11 +2026,32 @@ func removeHyphens(s string) string {
 	return string(result)
 }
 
-// collectManifestContents gathers manifest file contents from all components.
+// customManifestFunc generates manifests for a component from the recipe,
+// keyed by manifest path like the component's manifest files.
+type customManifestFunc func(recipeResult *recipe.RecipeResult, ref recipe.ComponentRef, version string) (map[string][]byte, error)
+
+// customManifestFuncs maps registry components to the function generating
+// their custom manifests.
+var customManifestFuncs = map[string]customManifestFunc{
+	skyhook.ComponentName: skyhook.TuningManifests,
+}
+
+// collectManifestContents gathers manifest file contents from all components,
+// along with the manifests generated by their custom manifest functions.
 func (b *DefaultBundler) collectManifestContents(recipeResult *recipe.RecipeResult) (map[string][]byte, error) {
 	contents := make(map[string][]byte)
 
 	for _, ref := range recipeResult.ComponentRefs {
+		if fn, ok := customManifestFuncs[ref.ComponentName()]; ok {
+			generated, err := fn(recipeResult, ref, b.Config.Version())
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate manifests for component %s: %w", ref.Name, err)
+			}
+			for path, content := range generated {
+				contents[path] = content
+			}
+		}
+
 		for _, manifestPath := range ref.ManifestFiles {
 			if _, exists := contents[manifestPath]; exists {
 				continue // Already loaded (could be shared across components)
@@ -0,0 +1,358 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	// DirName is the bundle subdirectory capacity templates are written to.
+	DirName = "capacity"
+
+	// ClusterNamePlaceholder is substituted with the cluster name before the
+	// templates are applied, e.g. with envsubst.
+	ClusterNamePlaceholder = "${CLUSTER_NAME}"
+
+	// KubernetesVersionPlaceholder is substituted with the worker Kubernetes
+	// version in Cluster API templates.
+	KubernetesVersionPlaceholder = "${KUBERNETES_VERSION}"
+
+	// InstanceTypePlaceholder is written when no instance type is known for
+	// the recipe's service and accelerator.
+	InstanceTypePlaceholder = "${INSTANCE_TYPE}"
+
+	// poolNamePrefix prefixes the names of generated pools.
+	poolNamePrefix = "eidos-gpu"
+
+	// gpuResourceName is the extended resource advertised by the device plugin.
+	gpuResourceName = "nvidia.com/gpu"
+
+	// defaultRootVolumeSize is the root volume size of Karpenter GPU nodes,
+	// sized for large container images and model caches.
+	defaultRootVolumeSize = "500Gi"
+)
+
+// GeneratorInput contains all data needed to generate capacity templates.
+type GeneratorInput struct {
+	// Criteria are the recipe criteria the capacity must match.
+	Criteria *recipe.Criteria
+
+	// NodeSelector holds the accelerated node selector labels the bundle's
+	// GPU components are scheduled with. They are applied to the new nodes.
+	NodeSelector map[string]string
+
+	// Tolerations holds the accelerated node tolerations the bundle's GPU
+	// components carry. They are turned into taints on the new nodes.
+	Tolerations []corev1.Toleration
+}
+
+// GeneratorOutput contains the result of capacity template generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the templates.
+	Duration time.Duration
+
+	// DeploymentSteps contains ordered instructions for applying the templates.
+	DeploymentSteps []string
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// Generator creates node provisioning templates for GPU capacity.
+type Generator struct{}
+
+// NewGenerator creates a new capacity template generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateKarpenter writes a Karpenter NodePool and EC2NodeClass for the
+// input criteria to the capacity subdirectory of outputDir.
+//
+// Karpenter templates target EKS; the service criteria must be eks or any.
+func (g *Generator) GenerateKarpenter(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	criteria, err := validateInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if criteria.Service != recipe.CriteriaServiceEKS && criteria.Service != recipe.CriteriaServiceAny {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("karpenter capacity templates require service eks, got %q", criteria.Service))
+	}
+
+	name := poolName(criteria)
+	profile, known := LookupInstanceProfile(recipe.CriteriaServiceEKS, criteria.Accelerator)
+	output := &GeneratorOutput{}
+
+	nodePool := newNodePool(name, criteria, profile, known, input)
+	nodeClass, note := newEC2NodeClass(name, criteria)
+	if note != "" {
+		output.DeploymentNotes = append(output.DeploymentNotes, note)
+	}
+	if !known && criteria.Accelerator != recipe.CriteriaAcceleratorAny {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			fmt.Sprintf("No EKS instance type is known for accelerator %s; the NodePool allows any NVIDIA GPU instance", criteria.Accelerator))
+	}
+
+	dir := filepath.Join(outputDir, DirName)
+	nodePoolPath := filepath.Join(dir, "nodepool.yaml")
+	nodeClassPath := filepath.Join(dir, "ec2nodeclass.yaml")
+	if err := g.writeFiles(output, dir, map[string][]any{
+		nodePoolPath:  {nodePool},
+		nodeClassPath: {nodeClass},
+	}); err != nil {
+		return nil, err
+	}
+
+	output.DeploymentSteps = []string{
+		fmt.Sprintf("export CLUSTER_NAME=<cluster-name> && for f in %s %s; do envsubst < $f | kubectl apply -f -; done",
+			nodeClassPath, nodePoolPath),
+	}
+	output.DeploymentNotes = append(output.DeploymentNotes,
+		"Karpenter templates expect the KarpenterNodeRole-${CLUSTER_NAME} role and karpenter.sh/discovery tags from the Karpenter getting started guide")
+	output.Duration = time.Since(start)
+
+	slog.Debug("karpenter capacity templates generated",
+		"name", name,
+		"instance_types", profile.InstanceTypes,
+		"files", len(output.Files),
+	)
+
+	return output, nil
+}
+
+// GenerateClusterAPI writes a Cluster API MachineDeployment with its
+// infrastructure machine template and kubeadm bootstrap template for the
+// input criteria to the capacity subdirectory of outputDir.
+//
+// The infrastructure provider follows the service criteria, which must name
+// a specific service.
+func (g *Generator) GenerateClusterAPI(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	criteria, err := validateInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := infrastructureProviders[criteria.Service]
+	if !ok {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("cluster-api capacity templates require service eks, gke, aks or oke, got %q", criteria.Service))
+	}
+
+	name := poolName(criteria)
+	output := &GeneratorOutput{}
+
+	instanceType := InstanceTypePlaceholder
+	profile, known := LookupInstanceProfile(criteria.Service, criteria.Accelerator)
+	if known {
+		instanceType = profile.InstanceTypes[0]
+	} else {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			fmt.Sprintf("No %s instance type is known for accelerator %s; set INSTANCE_TYPE before applying", criteria.Service, criteria.Accelerator))
+	}
+
+	replicas := criteria.Nodes
+	if replicas <= 0 {
+		replicas = 1
+	}
+
+	dir := filepath.Join(outputDir, DirName)
+	path := filepath.Join(dir, "machinedeployment.yaml")
+	if err := g.writeFiles(output, dir, map[string][]any{
+		path: {
+			newMachineDeployment(name, replicas, provider, input.NodeSelector),
+			provider.machineTemplate(name, instanceType),
+			newKubeadmConfigTemplate(name, input),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	output.DeploymentSteps = []string{
+		fmt.Sprintf("export CLUSTER_NAME=<cluster-name> KUBERNETES_VERSION=<version> && envsubst < %s | kubectl apply -f -", path),
+	}
+	output.DeploymentNotes = append(output.DeploymentNotes,
+		fmt.Sprintf("Cluster API templates use the %s infrastructure provider and kubeadm bootstrap; managed control planes may need the provider's machine pool types instead", provider.name))
+	output.Duration = time.Since(start)
+
+	slog.Debug("cluster-api capacity templates generated",
+		"name", name,
+		"provider", provider.name,
+		"instance_type", instanceType,
+		"replicas", replicas,
+	)
+
+	return output, nil
+}
+
+// validateInput checks the input and returns its criteria with unset fields
+// defaulted to "any".
+func validateInput(ctx context.Context, input *GeneratorInput) (*recipe.Criteria, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+	if input == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "capacity generator input cannot be nil")
+	}
+
+	criteria := recipe.NewCriteria()
+	if input.Criteria != nil {
+		c := *input.Criteria
+		if c.Service != "" {
+			criteria.Service = c.Service
+		}
+		if c.Accelerator != "" {
+			criteria.Accelerator = c.Accelerator
+		}
+		if c.Intent != "" {
+			criteria.Intent = c.Intent
+		}
+		if c.OS != "" {
+			criteria.OS = c.OS
+		}
+		criteria.Nodes = c.Nodes
+	}
+	return criteria, nil
+}
+
+// poolName returns the name of the generated pool for the criteria.
+func poolName(criteria *recipe.Criteria) string {
+	if criteria.Accelerator == recipe.CriteriaAcceleratorAny {
+		return poolNamePrefix
+	}
+	return poolNamePrefix + "-" + string(criteria.Accelerator)
+}
+
+// writeFiles writes each file as a stream of YAML documents and records it in output.
+func (g *Generator) writeFiles(output *GeneratorOutput, dir string, files map[string][]any) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to create capacity directory", err)
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		for _, doc := range files[path] {
+			if err := enc.Encode(doc); err != nil {
+				return errors.Wrap(errors.ErrCodeInternal,
+					fmt.Sprintf("failed to encode %s", filepath.Base(path)), err)
+			}
+		}
+		if err := enc.Close(); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to encode %s", filepath.Base(path)), err)
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to write %s", filepath.Base(path)), err)
+		}
+		output.Files = append(output.Files, path)
+		output.TotalSize += int64(buf.Len())
+	}
+	return nil
+}
+
+// taint is a node taint in the form used by Karpenter and kubeadm.
+type taint struct {
+	Key    string `yaml:"key"`
+	Value  string `yaml:"value,omitempty"`
+	Effect string `yaml:"effect"`
+}
+
+// taintsFromTolerations returns the taints tolerated by tolerations, so new
+// nodes only accept the pods the bundle schedules onto accelerated nodes.
+// Tolerations without a key tolerate every taint and are skipped; those
+// without an effect become NoSchedule taints.
+func taintsFromTolerations(tolerations []corev1.Toleration) []taint {
+	var taints []taint
+	seen := make(map[taint]bool)
+	for _, tol := range tolerations {
+		if tol.Key == "" {
+			continue
+		}
+		t := taint{Key: tol.Key, Effect: string(tol.Effect)}
+		if tol.Operator != corev1.TolerationOpExists {
+			t.Value = tol.Value
+		}
+		if t.Effect == "" {
+			t.Effect = string(corev1.TaintEffectNoSchedule)
+		}
+		if !seen[t] {
+			seen[t] = true
+			taints = append(taints, t)
+		}
+	}
+	return taints
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// nodeLabelsArg formats labels as the value of the kubelet --node-labels flag.
+func nodeLabelsArg(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for _, k := range sortedKeys(labels) {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// gpuLimit returns the NodePool GPU limit for the criteria, or an empty
+// string when the node count or GPUs per node is unknown.
+func gpuLimit(criteria *recipe.Criteria, profile InstanceProfile) string {
+	if criteria.Nodes <= 0 || profile.GPUsPerNode <= 0 {
+		return ""
+	}
+	return strconv.Itoa(criteria.Nodes * profile.GPUsPerNode)
+}
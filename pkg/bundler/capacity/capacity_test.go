@@ -0,0 +1,264 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+var testTolerations = []corev1.Toleration{
+	{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpEqual, Value: "present", Effect: corev1.TaintEffectNoSchedule},
+	{Key: "dedicated", Operator: corev1.TolerationOpExists},
+	{Operator: corev1.TolerationOpExists},
+}
+
+func TestGenerateKarpenter(t *testing.T) {
+	dir := t.TempDir()
+	input := &GeneratorInput{
+		Criteria: &recipe.Criteria{
+			Service:     recipe.CriteriaServiceEKS,
+			Accelerator: recipe.CriteriaAcceleratorGB200,
+			Nodes:       4,
+		},
+		NodeSelector: map[string]string{
+			"nodeGroup":                   "gpu-nodes",
+			"topology.kubernetes.io/zone": "us-east-1a",
+		},
+		Tolerations: testTolerations,
+	}
+
+	output, err := NewGenerator().GenerateKarpenter(context.Background(), input, dir)
+	if err != nil {
+		t.Fatalf("GenerateKarpenter() error = %v", err)
+	}
+	if len(output.Files) != 2 || len(output.DeploymentSteps) == 0 {
+		t.Fatalf("unexpected output: %+v", output)
+	}
+
+	var pool nodePool
+	readYAML(t, filepath.Join(dir, DirName, "nodepool.yaml"), &pool)
+	if pool.Kind != "NodePool" || pool.Metadata.Name != "eidos-gpu-gb200" {
+		t.Errorf("unexpected NodePool metadata: %s %s", pool.Kind, pool.Metadata.Name)
+	}
+	spec := pool.Spec.Template.Spec
+	wantRequirements := map[string]string{
+		"node.kubernetes.io/instance-type": "p6e-gb200.36xlarge",
+		"kubernetes.io/arch":               archARM64,
+		"karpenter.sh/capacity-type":       "on-demand",
+		"topology.kubernetes.io/zone":      "us-east-1a",
+	}
+	for _, r := range spec.Requirements {
+		if want, ok := wantRequirements[r.Key]; ok {
+			if len(r.Values) != 1 || r.Values[0] != want {
+				t.Errorf("requirement %s = %v, want %s", r.Key, r.Values, want)
+			}
+			delete(wantRequirements, r.Key)
+		}
+	}
+	if len(wantRequirements) > 0 {
+		t.Errorf("missing requirements: %v", wantRequirements)
+	}
+	if pool.Spec.Template.Metadata == nil || pool.Spec.Template.Metadata.Labels["nodeGroup"] != "gpu-nodes" {
+		t.Errorf("expected nodeGroup label, got %+v", pool.Spec.Template.Metadata)
+	}
+	wantTaints := []taint{
+		{Key: "nvidia.com/gpu", Value: "present", Effect: "NoSchedule"},
+		{Key: "dedicated", Effect: "NoSchedule"},
+	}
+	if len(spec.Taints) != len(wantTaints) || spec.Taints[0] != wantTaints[0] || spec.Taints[1] != wantTaints[1] {
+		t.Errorf("taints = %+v, want %+v", spec.Taints, wantTaints)
+	}
+	if got := pool.Spec.Limits[gpuResourceName]; got != "16" {
+		t.Errorf("GPU limit = %q, want 16", got)
+	}
+
+	var nodeClass ec2NodeClass
+	readYAML(t, filepath.Join(dir, DirName, "ec2nodeclass.yaml"), &nodeClass)
+	if nodeClass.Spec.AMISelectorTerms[0].Alias != karpenterDefaultAMIAlias {
+		t.Errorf("AMI alias = %q", nodeClass.Spec.AMISelectorTerms[0].Alias)
+	}
+	if nodeClass.Spec.SubnetSelectorTerms[0].Tags[karpenterDiscoveryTag] != ClusterNamePlaceholder {
+		t.Errorf("unexpected subnet selector: %+v", nodeClass.Spec.SubnetSelectorTerms)
+	}
+}
+
+func TestGenerateKarpenter_UnknownAccelerator(t *testing.T) {
+	dir := t.TempDir()
+	input := &GeneratorInput{Criteria: &recipe.Criteria{OS: recipe.CriteriaOSUbuntu}}
+
+	output, err := NewGenerator().GenerateKarpenter(context.Background(), input, dir)
+	if err != nil {
+		t.Fatalf("GenerateKarpenter() error = %v", err)
+	}
+	if !strings.Contains(strings.Join(output.DeploymentNotes, "\n"), "no AMI alias for ubuntu") {
+		t.Errorf("expected AMI note, got %v", output.DeploymentNotes)
+	}
+
+	var pool nodePool
+	readYAML(t, filepath.Join(dir, DirName, "nodepool.yaml"), &pool)
+	if pool.Metadata.Name != poolNamePrefix {
+		t.Errorf("name = %q, want %q", pool.Metadata.Name, poolNamePrefix)
+	}
+	if r := pool.Spec.Template.Spec.Requirements[0]; r.Key != "karpenter.k8s.aws/instance-gpu-manufacturer" {
+		t.Errorf("first requirement = %+v, want GPU manufacturer", r)
+	}
+	if pool.Spec.Limits != nil || pool.Spec.Template.Spec.Taints != nil {
+		t.Errorf("expected no limits or taints: %+v", pool.Spec)
+	}
+}
+
+func TestGenerateKarpenter_RejectsOtherServices(t *testing.T) {
+	input := &GeneratorInput{Criteria: &recipe.Criteria{Service: recipe.CriteriaServiceGKE}}
+	if _, err := NewGenerator().GenerateKarpenter(context.Background(), input, t.TempDir()); err == nil {
+		t.Error("expected error for gke")
+	}
+}
+
+func TestGenerateClusterAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		criteria     *recipe.Criteria
+		wantKind     string
+		wantField    string
+		wantInstance string
+		wantReplicas int
+		wantErr      bool
+	}{
+		{
+			name:         "aks h100",
+			criteria:     &recipe.Criteria{Service: recipe.CriteriaServiceAKS, Accelerator: recipe.CriteriaAcceleratorH100, Nodes: 3},
+			wantKind:     "AzureMachineTemplate",
+			wantField:    "vmSize",
+			wantInstance: "Standard_ND96isr_H100_v5",
+			wantReplicas: 3,
+		},
+		{
+			name:         "gke l40 unknown",
+			criteria:     &recipe.Criteria{Service: recipe.CriteriaServiceGKE, Accelerator: recipe.CriteriaAcceleratorL40},
+			wantKind:     "GCPMachineTemplate",
+			wantField:    "instanceType",
+			wantInstance: InstanceTypePlaceholder,
+			wantReplicas: 1,
+		},
+		{
+			name:     "any service",
+			criteria: &recipe.Criteria{Accelerator: recipe.CriteriaAcceleratorH100},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			input := &GeneratorInput{
+				Criteria:     tt.criteria,
+				NodeSelector: map[string]string{"nodeGroup": "gpu-nodes", "tier": "gpu"},
+				Tolerations:  testTolerations[:1],
+			}
+
+			_, err := NewGenerator().GenerateClusterAPI(context.Background(), input, dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GenerateClusterAPI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, DirName, "machinedeployment.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			docs := decodeAll(t, data)
+			if len(docs) != 3 {
+				t.Fatalf("expected 3 documents, got %d", len(docs))
+			}
+
+			md := docs[0]
+			if md["kind"] != "MachineDeployment" {
+				t.Errorf("first document kind = %v", md["kind"])
+			}
+			spec := md["spec"].(map[string]any)
+			if spec["replicas"] != tt.wantReplicas {
+				t.Errorf("replicas = %v, want %d", spec["replicas"], tt.wantReplicas)
+			}
+
+			infra := docs[1]
+			if infra["kind"] != tt.wantKind {
+				t.Errorf("infrastructure kind = %v, want %s", infra["kind"], tt.wantKind)
+			}
+			machine := infra["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)
+			if machine[tt.wantField] != tt.wantInstance {
+				t.Errorf("%s = %v, want %s", tt.wantField, machine[tt.wantField], tt.wantInstance)
+			}
+
+			if !strings.Contains(string(data), "node-labels: nodeGroup=gpu-nodes,tier=gpu") {
+				t.Errorf("expected kubelet node labels:\n%s", data)
+			}
+			if !strings.Contains(string(data), "key: nvidia.com/gpu") {
+				t.Errorf("expected GPU taint:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestIsRestrictedLabel(t *testing.T) {
+	tests := map[string]bool{
+		"nodeGroup":                        false,
+		"nvidia.com/gpu.present":           false,
+		"node.kubernetes.io/instance-type": true,
+		"kubernetes.io/arch":               true,
+		"karpenter.sh/nodepool":            true,
+		"cloud.google.com/gke-nodepool":    false,
+	}
+	for key, want := range tests {
+		if got := isRestrictedLabel(key); got != want {
+			t.Errorf("isRestrictedLabel(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func readYAML(t *testing.T, path string, out any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		t.Fatalf("failed to parse %s: %v", filepath.Base(path), err)
+	}
+}
+
+func decodeAll(t *testing.T, data []byte) []map[string]any {
+	t.Helper()
+	var docs []map[string]any
+	dec := yaml.NewDecoder(strings.NewReader(string(data)))
+	for {
+		var doc map[string]any
+		if err := dec.Decode(&doc); err != nil {
+			break
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
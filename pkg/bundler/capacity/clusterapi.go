@@ -0,0 +1,218 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	clusterAPIVersion   = "cluster.x-k8s.io/v1beta1"
+	kubeadmAPIVersion   = "bootstrap.cluster.x-k8s.io/v1beta1"
+	clusterNameLabelKey = "cluster.x-k8s.io/cluster-name"
+	deploymentNameLabel = "cluster.x-k8s.io/deployment-name"
+)
+
+// infrastructureProvider describes the Cluster API infrastructure machine
+// template of a service.
+type infrastructureProvider struct {
+	// name is the Cluster API provider name.
+	name string
+
+	// apiVersion and kind identify the machine template resource.
+	apiVersion string
+	kind       string
+
+	// instanceField is the machine spec field holding the instance type.
+	instanceField string
+
+	// extra holds additional machine spec fields.
+	extra map[string]any
+}
+
+// infrastructureProviders maps services to their Cluster API infrastructure provider.
+var infrastructureProviders = map[recipe.CriteriaServiceType]infrastructureProvider{
+	recipe.CriteriaServiceEKS: {
+		name:          "aws",
+		apiVersion:    "infrastructure.cluster.x-k8s.io/v1beta2",
+		kind:          "AWSMachineTemplate",
+		instanceField: "instanceType",
+		extra:         map[string]any{"iamInstanceProfile": "nodes.cluster-api-provider-aws.sigs.k8s.io"},
+	},
+	recipe.CriteriaServiceGKE: {
+		name:          "gcp",
+		apiVersion:    "infrastructure.cluster.x-k8s.io/v1beta1",
+		kind:          "GCPMachineTemplate",
+		instanceField: "instanceType",
+	},
+	recipe.CriteriaServiceAKS: {
+		name:          "azure",
+		apiVersion:    "infrastructure.cluster.x-k8s.io/v1beta1",
+		kind:          "AzureMachineTemplate",
+		instanceField: "vmSize",
+		extra:         map[string]any{"osDisk": map[string]any{"osType": "Linux", "diskSizeGB": 512}},
+	},
+	recipe.CriteriaServiceOKE: {
+		name:          "oci",
+		apiVersion:    "infrastructure.cluster.x-k8s.io/v1beta2",
+		kind:          "OCIMachineTemplate",
+		instanceField: "shape",
+	},
+}
+
+type objectRef struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Name       string `yaml:"name"`
+}
+
+type machineDeployment struct {
+	APIVersion string                `yaml:"apiVersion"`
+	Kind       string                `yaml:"kind"`
+	Metadata   objectMeta            `yaml:"metadata"`
+	Spec       machineDeploymentSpec `yaml:"spec"`
+}
+
+type machineDeploymentSpec struct {
+	ClusterName string          `yaml:"clusterName"`
+	Replicas    int             `yaml:"replicas"`
+	Selector    labelSelector   `yaml:"selector"`
+	Template    machineTemplate `yaml:"template"`
+}
+
+type labelSelector struct {
+	MatchLabels map[string]string `yaml:"matchLabels"`
+}
+
+type machineTemplate struct {
+	Metadata templateMeta `yaml:"metadata"`
+	Spec     machineSpec  `yaml:"spec"`
+}
+
+type machineSpec struct {
+	ClusterName       string           `yaml:"clusterName"`
+	Version           string           `yaml:"version"`
+	Bootstrap         machineBootstrap `yaml:"bootstrap"`
+	InfrastructureRef objectRef        `yaml:"infrastructureRef"`
+}
+
+type machineBootstrap struct {
+	ConfigRef objectRef `yaml:"configRef"`
+}
+
+type infrastructureTemplate struct {
+	APIVersion string         `yaml:"apiVersion"`
+	Kind       string         `yaml:"kind"`
+	Metadata   objectMeta     `yaml:"metadata"`
+	Spec       map[string]any `yaml:"spec"`
+}
+
+type kubeadmConfigTemplate struct {
+	APIVersion string                    `yaml:"apiVersion"`
+	Kind       string                    `yaml:"kind"`
+	Metadata   objectMeta                `yaml:"metadata"`
+	Spec       kubeadmConfigTemplateSpec `yaml:"spec"`
+}
+
+type kubeadmConfigTemplateSpec struct {
+	Template struct {
+		Spec struct {
+			JoinConfiguration struct {
+				NodeRegistration nodeRegistration `yaml:"nodeRegistration"`
+			} `yaml:"joinConfiguration"`
+		} `yaml:"spec"`
+	} `yaml:"template"`
+}
+
+type nodeRegistration struct {
+	KubeletExtraArgs map[string]string `yaml:"kubeletExtraArgs,omitempty"`
+	Taints           []taint           `yaml:"taints"`
+}
+
+// newMachineDeployment builds the MachineDeployment. Node selector labels are
+// also set on the Machines so they can be matched from the management cluster.
+func newMachineDeployment(name string, replicas int, provider infrastructureProvider, selector map[string]string) *machineDeployment {
+	matchLabels := map[string]string{
+		clusterNameLabelKey: ClusterNamePlaceholder,
+		deploymentNameLabel: name,
+	}
+	labels := make(map[string]string, len(matchLabels)+len(selector))
+	for k, v := range selector {
+		labels[k] = v
+	}
+	for k, v := range matchLabels {
+		labels[k] = v
+	}
+
+	return &machineDeployment{
+		APIVersion: clusterAPIVersion,
+		Kind:       "MachineDeployment",
+		Metadata: objectMeta{
+			Name:   name,
+			Labels: map[string]string{clusterNameLabelKey: ClusterNamePlaceholder},
+		},
+		Spec: machineDeploymentSpec{
+			ClusterName: ClusterNamePlaceholder,
+			Replicas:    replicas,
+			Selector:    labelSelector{MatchLabels: matchLabels},
+			Template: machineTemplate{
+				Metadata: templateMeta{Labels: labels},
+				Spec: machineSpec{
+					ClusterName: ClusterNamePlaceholder,
+					Version:     KubernetesVersionPlaceholder,
+					Bootstrap: machineBootstrap{
+						ConfigRef: objectRef{APIVersion: kubeadmAPIVersion, Kind: "KubeadmConfigTemplate", Name: name},
+					},
+					InfrastructureRef: objectRef{APIVersion: provider.apiVersion, Kind: provider.kind, Name: name},
+				},
+			},
+		},
+	}
+}
+
+// machineTemplate builds the provider's infrastructure machine template.
+func (p infrastructureProvider) machineTemplate(name, instanceType string) *infrastructureTemplate {
+	spec := map[string]any{p.instanceField: instanceType}
+	for k, v := range p.extra {
+		spec[k] = v
+	}
+	return &infrastructureTemplate{
+		APIVersion: p.apiVersion,
+		Kind:       p.kind,
+		Metadata:   objectMeta{Name: name},
+		Spec:       map[string]any{"template": map[string]any{"spec": spec}},
+	}
+}
+
+// newKubeadmConfigTemplate builds the bootstrap template that registers new
+// nodes with the accelerated node selector labels and matching taints.
+// Taints are always written, since kubeadm otherwise defaults them.
+func newKubeadmConfigTemplate(name string, input *GeneratorInput) *kubeadmConfigTemplate {
+	tmpl := &kubeadmConfigTemplate{
+		APIVersion: kubeadmAPIVersion,
+		Kind:       "KubeadmConfigTemplate",
+		Metadata:   objectMeta{Name: name},
+	}
+
+	reg := nodeRegistration{Taints: taintsFromTolerations(input.Tolerations)}
+	if reg.Taints == nil {
+		reg.Taints = []taint{}
+	}
+	if len(input.NodeSelector) > 0 {
+		reg.KubeletExtraArgs = map[string]string{"node-labels": nodeLabelsArg(input.NodeSelector)}
+	}
+	tmpl.Spec.Template.Spec.JoinConfiguration.NodeRegistration = reg
+	return tmpl
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package capacity generates node provisioning templates for the GPU capacity a bundle expects.
+
+The templates provision nodes of the instance type that provides the recipe's
+accelerator on its service, labeled with the accelerated node selector and
+tainted to match the accelerated node tolerations, so the bundle's GPU
+components land on them and other workloads stay off.
+
+# Formats
+
+Karpenter (EKS):
+  - nodepool.yaml: NodePool restricted to the accelerator's instance types,
+    architecture and on-demand capacity, with the GPU limit derived from the
+    node count criteria
+  - ec2nodeclass.yaml: EC2NodeClass using the AMI alias for the OS criteria
+    and karpenter.sh/discovery tags for subnets and security groups
+
+Cluster API (EKS, GKE, AKS, OKE):
+  - machinedeployment.yaml: MachineDeployment sized by the node count
+    criteria, the provider machine template (AWSMachineTemplate,
+    GCPMachineTemplate, AzureMachineTemplate or OCIMachineTemplate) and a
+    KubeadmConfigTemplate registering the labels and taints
+
+Cluster-specific values are left as ${CLUSTER_NAME} and ${KUBERNETES_VERSION}
+placeholders for envsubst. Accelerators without a known instance type get a
+NodePool allowing any NVIDIA GPU instance, or an ${INSTANCE_TYPE} placeholder.
+
+# Usage
+
+	generator := capacity.NewGenerator()
+
+	input := &capacity.GeneratorInput{
+		Criteria:     recipeResult.Criteria,
+		NodeSelector: map[string]string{"nodeGroup": "gpu-nodes"},
+		Tolerations:  tolerations,
+	}
+
+	output, err := generator.GenerateKarpenter(ctx, input, "/path/to/bundle")
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package capacity
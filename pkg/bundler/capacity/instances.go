@@ -0,0 +1,72 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// Node architectures, as reported by the kubernetes.io/arch label.
+const (
+	archAMD64 = "amd64"
+	archARM64 = "arm64"
+)
+
+// InstanceProfile describes the cloud instance types that provide an accelerator.
+type InstanceProfile struct {
+	// InstanceTypes are the instance types (or VM sizes, or shapes) to provision.
+	InstanceTypes []string
+
+	// GPUsPerNode is the number of GPUs on each instance.
+	GPUsPerNode int
+
+	// Arch is the CPU architecture of the instances.
+	Arch string
+}
+
+// instanceProfiles maps each service and accelerator to the instance types
+// that provide it. Accelerators without an entry are not offered by the service.
+var instanceProfiles = map[recipe.CriteriaServiceType]map[recipe.CriteriaAcceleratorType]InstanceProfile{
+	recipe.CriteriaServiceEKS: {
+		recipe.CriteriaAcceleratorH100:  {InstanceTypes: []string{"p5.48xlarge"}, GPUsPerNode: 8, Arch: archAMD64},
+		recipe.CriteriaAcceleratorGB200: {InstanceTypes: []string{"p6e-gb200.36xlarge"}, GPUsPerNode: 4, Arch: archARM64},
+		recipe.CriteriaAcceleratorA100:  {InstanceTypes: []string{"p4d.24xlarge", "p4de.24xlarge"}, GPUsPerNode: 8, Arch: archAMD64},
+		recipe.CriteriaAcceleratorL40:   {InstanceTypes: []string{"g6e.48xlarge"}, GPUsPerNode: 8, Arch: archAMD64},
+	},
+	recipe.CriteriaServiceGKE: {
+		recipe.CriteriaAcceleratorH100:  {InstanceTypes: []string{"a3-highgpu-8g"}, GPUsPerNode: 8, Arch: archAMD64},
+		recipe.CriteriaAcceleratorGB200: {InstanceTypes: []string{"a4x-highgpu-4g"}, GPUsPerNode: 4, Arch: archARM64},
+		recipe.CriteriaAcceleratorA100:  {InstanceTypes: []string{"a2-highgpu-8g"}, GPUsPerNode: 8, Arch: archAMD64},
+	},
+	recipe.CriteriaServiceAKS: {
+		recipe.CriteriaAcceleratorH100:  {InstanceTypes: []string{"Standard_ND96isr_H100_v5"}, GPUsPerNode: 8, Arch: archAMD64},
+		recipe.CriteriaAcceleratorGB200: {InstanceTypes: []string{"Standard_ND128isr_NDR_GB200_v6"}, GPUsPerNode: 4, Arch: archARM64},
+		recipe.CriteriaAcceleratorA100:  {InstanceTypes: []string{"Standard_ND96asr_v4"}, GPUsPerNode: 8, Arch: archAMD64},
+	},
+	recipe.CriteriaServiceOKE: {
+		recipe.CriteriaAcceleratorH100:  {InstanceTypes: []string{"BM.GPU.H100.8"}, GPUsPerNode: 8, Arch: archAMD64},
+		recipe.CriteriaAcceleratorGB200: {InstanceTypes: []string{"BM.GPU.GB200.4"}, GPUsPerNode: 4, Arch: archARM64},
+		recipe.CriteriaAcceleratorA100:  {InstanceTypes: []string{"BM.GPU.A100-v2.8"}, GPUsPerNode: 8, Arch: archAMD64},
+		recipe.CriteriaAcceleratorL40:   {InstanceTypes: []string{"BM.GPU.L40S.4"}, GPUsPerNode: 4, Arch: archAMD64},
+	},
+}
+
+// LookupInstanceProfile returns the instance types that provide accelerator on
+// service. It returns false when the service does not offer the accelerator
+// or either criteria value is "any".
+func LookupInstanceProfile(service recipe.CriteriaServiceType, accelerator recipe.CriteriaAcceleratorType) (InstanceProfile, bool) {
+	profile, ok := instanceProfiles[service][accelerator]
+	return profile, ok
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	karpenterAPIVersion         = "karpenter.sh/v1"
+	karpenterAWSGroup           = "karpenter.k8s.aws"
+	karpenterAWSAPIVersion      = karpenterAWSGroup + "/v1"
+	karpenterDiscoveryTag       = "karpenter.sh/discovery"
+	karpenterDefaultAMIAlias    = "al2023@latest"
+	karpenterBottlerocketAlias  = "bottlerocket@latest"
+	karpenterConsolidateAfter   = "1h"
+	karpenterConsolidationEmpty = "WhenEmpty"
+)
+
+type objectMeta struct {
+	Name   string            `yaml:"name"`
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+type nodePool struct {
+	APIVersion string       `yaml:"apiVersion"`
+	Kind       string       `yaml:"kind"`
+	Metadata   objectMeta   `yaml:"metadata"`
+	Spec       nodePoolSpec `yaml:"spec"`
+}
+
+type nodePoolSpec struct {
+	Template   nodeClaimTemplate  `yaml:"template"`
+	Limits     map[string]string  `yaml:"limits,omitempty"`
+	Disruption nodePoolDisruption `yaml:"disruption"`
+}
+
+type nodeClaimTemplate struct {
+	Metadata *templateMeta `yaml:"metadata,omitempty"`
+	Spec     nodeClaimSpec `yaml:"spec"`
+}
+
+type templateMeta struct {
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+type nodeClaimSpec struct {
+	NodeClassRef nodeClassRef  `yaml:"nodeClassRef"`
+	Requirements []requirement `yaml:"requirements"`
+	Taints       []taint       `yaml:"taints,omitempty"`
+}
+
+type nodeClassRef struct {
+	Group string `yaml:"group"`
+	Kind  string `yaml:"kind"`
+	Name  string `yaml:"name"`
+}
+
+type requirement struct {
+	Key      string   `yaml:"key"`
+	Operator string   `yaml:"operator"`
+	Values   []string `yaml:"values"`
+}
+
+type nodePoolDisruption struct {
+	ConsolidationPolicy string `yaml:"consolidationPolicy"`
+	ConsolidateAfter    string `yaml:"consolidateAfter"`
+}
+
+type ec2NodeClass struct {
+	APIVersion string           `yaml:"apiVersion"`
+	Kind       string           `yaml:"kind"`
+	Metadata   objectMeta       `yaml:"metadata"`
+	Spec       ec2NodeClassSpec `yaml:"spec"`
+}
+
+type ec2NodeClassSpec struct {
+	Role                       string               `yaml:"role"`
+	AMISelectorTerms           []amiSelectorTerm    `yaml:"amiSelectorTerms"`
+	SubnetSelectorTerms        []tagSelectorTerm    `yaml:"subnetSelectorTerms"`
+	SecurityGroupSelectorTerms []tagSelectorTerm    `yaml:"securityGroupSelectorTerms"`
+	BlockDeviceMappings        []blockDeviceMapping `yaml:"blockDeviceMappings"`
+}
+
+type amiSelectorTerm struct {
+	Alias string `yaml:"alias"`
+}
+
+type tagSelectorTerm struct {
+	Tags map[string]string `yaml:"tags"`
+}
+
+type blockDeviceMapping struct {
+	DeviceName string    `yaml:"deviceName"`
+	EBS        ebsVolume `yaml:"ebs"`
+}
+
+type ebsVolume struct {
+	VolumeSize string `yaml:"volumeSize"`
+	VolumeType string `yaml:"volumeType"`
+	Encrypted  bool   `yaml:"encrypted"`
+}
+
+// newNodePool builds the NodePool for the criteria. Instance types come from
+// profile when known; otherwise any NVIDIA GPU instance is allowed.
+func newNodePool(name string, criteria *recipe.Criteria, profile InstanceProfile, known bool, input *GeneratorInput) *nodePool {
+	labels, selectorRequirements := splitNodeSelector(input.NodeSelector)
+
+	var requirements []requirement
+	if known {
+		requirements = append(requirements,
+			requirement{Key: "node.kubernetes.io/instance-type", Operator: "In", Values: profile.InstanceTypes},
+			requirement{Key: "kubernetes.io/arch", Operator: "In", Values: []string{profile.Arch}},
+		)
+	} else {
+		requirements = append(requirements,
+			requirement{Key: "karpenter.k8s.aws/instance-gpu-manufacturer", Operator: "In", Values: []string{"nvidia"}},
+		)
+	}
+	requirements = append(requirements,
+		requirement{Key: "karpenter.sh/capacity-type", Operator: "In", Values: []string{"on-demand"}},
+	)
+	requirements = append(requirements, selectorRequirements...)
+
+	pool := &nodePool{
+		APIVersion: karpenterAPIVersion,
+		Kind:       "NodePool",
+		Metadata:   objectMeta{Name: name},
+		Spec: nodePoolSpec{
+			Template: nodeClaimTemplate{
+				Spec: nodeClaimSpec{
+					NodeClassRef: nodeClassRef{Group: karpenterAWSGroup, Kind: "EC2NodeClass", Name: name},
+					Requirements: requirements,
+					Taints:       taintsFromTolerations(input.Tolerations),
+				},
+			},
+			Disruption: nodePoolDisruption{
+				ConsolidationPolicy: karpenterConsolidationEmpty,
+				ConsolidateAfter:    karpenterConsolidateAfter,
+			},
+		},
+	}
+	if len(labels) > 0 {
+		pool.Spec.Template.Metadata = &templateMeta{Labels: labels}
+	}
+	if limit := gpuLimit(criteria, profile); limit != "" {
+		pool.Spec.Limits = map[string]string{gpuResourceName: limit}
+	}
+	return pool
+}
+
+// newEC2NodeClass builds the EC2NodeClass for the criteria. The returned note
+// is non-empty when the OS criteria has no Karpenter AMI alias.
+func newEC2NodeClass(name string, criteria *recipe.Criteria) (*ec2NodeClass, string) {
+	alias := karpenterDefaultAMIAlias
+	var note string
+	switch criteria.OS {
+	case recipe.CriteriaOSAny, recipe.CriteriaOSAmazonLinux:
+	case recipe.CriteriaOSCOS:
+		alias = karpenterBottlerocketAlias
+		note = "Container-optimized OS maps to Bottlerocket on EKS; review amiSelectorTerms in the EC2NodeClass"
+	default:
+		note = fmt.Sprintf("Karpenter has no AMI alias for %s; replace amiSelectorTerms in the EC2NodeClass with your AMI", criteria.OS)
+	}
+
+	rootDevice := "/dev/xvda"
+	if alias == karpenterBottlerocketAlias {
+		rootDevice = "/dev/xvdb"
+	}
+
+	discovery := []tagSelectorTerm{{Tags: map[string]string{karpenterDiscoveryTag: ClusterNamePlaceholder}}}
+	return &ec2NodeClass{
+		APIVersion: karpenterAWSAPIVersion,
+		Kind:       "EC2NodeClass",
+		Metadata:   objectMeta{Name: name},
+		Spec: ec2NodeClassSpec{
+			Role:                       "KarpenterNodeRole-" + ClusterNamePlaceholder,
+			AMISelectorTerms:           []amiSelectorTerm{{Alias: alias}},
+			SubnetSelectorTerms:        discovery,
+			SecurityGroupSelectorTerms: discovery,
+			BlockDeviceMappings: []blockDeviceMapping{{
+				DeviceName: rootDevice,
+				EBS:        ebsVolume{VolumeSize: defaultRootVolumeSize, VolumeType: "gp3", Encrypted: true},
+			}},
+		},
+	}, note
+}
+
+// splitNodeSelector separates node selector labels Karpenter can set on new
+// nodes from those in Kubernetes-reserved domains, which Karpenter only
+// accepts as requirements.
+func splitNodeSelector(selector map[string]string) (map[string]string, []requirement) {
+	var labels map[string]string
+	var requirements []requirement
+	for _, key := range sortedKeys(selector) {
+		if isRestrictedLabel(key) {
+			requirements = append(requirements, requirement{Key: key, Operator: "In", Values: []string{selector[key]}})
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = selector[key]
+	}
+	return labels, requirements
+}
+
+// isRestrictedLabel reports whether key is in a domain reserved for
+// Kubernetes or Karpenter.
+func isRestrictedLabel(key string) bool {
+	prefix, _, ok := strings.Cut(key, "/")
+	if !ok {
+		return false
+	}
+	for _, domain := range []string{"kubernetes.io", "k8s.io", "karpenter.sh", karpenterAWSGroup} {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
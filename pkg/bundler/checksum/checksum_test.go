@@ -18,6 +18,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -170,3 +171,179 @@ func TestGetChecksumFilePath(t *testing.T) {
 		t.Errorf("GetChecksumFilePath() = %s, want %s", path, expected)
 	}
 }
+
+func TestVerifyChecksums(t *testing.T) {
+	t.Parallel()
+
+	newBundle := func(t *testing.T) string {
+		t.Helper()
+		tmpDir := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(tmpDir, "subdir"), 0755); err != nil {
+			t.Fatalf("failed to create subdir: %v", err)
+		}
+		files := []string{filepath.Join(tmpDir, "file1.txt"), filepath.Join(tmpDir, "subdir", "file2.txt")}
+		for _, f := range files {
+			if err := os.WriteFile(f, []byte(filepath.Base(f)), 0644); err != nil {
+				t.Fatalf("failed to create %s: %v", f, err)
+			}
+		}
+		if err := GenerateChecksums(context.Background(), tmpDir, files); err != nil {
+			t.Fatalf("GenerateChecksums() error = %v", err)
+		}
+		return tmpDir
+	}
+
+	t.Run("valid bundle", func(t *testing.T) {
+		t.Parallel()
+
+		if err := VerifyChecksums(context.Background(), newBundle(t)); err != nil {
+			t.Errorf("VerifyChecksums() error = %v", err)
+		}
+	})
+
+	t.Run("modified file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newBundle(t)
+		if err := os.WriteFile(filepath.Join(dir, "subdir", "file2.txt"), []byte("tampered"), 0644); err != nil {
+			t.Fatalf("failed to modify file: %v", err)
+		}
+		err := VerifyChecksums(context.Background(), dir)
+		if err == nil || !strings.Contains(err.Error(), "checksum mismatch for subdir/file2.txt") {
+			t.Errorf("VerifyChecksums() error = %v, want mismatch", err)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := newBundle(t)
+		if err := os.Remove(filepath.Join(dir, "file1.txt")); err != nil {
+			t.Fatalf("failed to remove file: %v", err)
+		}
+		if err := VerifyChecksums(context.Background(), dir); err == nil {
+			t.Error("VerifyChecksums() expected error for missing file")
+		}
+	})
+
+	t.Run("missing checksums file", func(t *testing.T) {
+		t.Parallel()
+
+		if err := VerifyChecksums(context.Background(), t.TempDir()); err == nil {
+			t.Error("VerifyChecksums() expected error without checksums.txt")
+		}
+	})
+
+	t.Run("rejects path traversal", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		entry := strings.Repeat("0", 64) + "  ../outside.txt\n"
+		if err := os.WriteFile(GetChecksumFilePath(dir), []byte(entry), 0644); err != nil {
+			t.Fatalf("failed to write checksums: %v", err)
+		}
+		err := VerifyChecksums(context.Background(), dir)
+		if err == nil || !strings.Contains(err.Error(), "escapes the bundle") {
+			t.Errorf("VerifyChecksums() error = %v, want traversal error", err)
+		}
+	})
+
+	t.Run("malformed entry", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		if err := os.WriteFile(GetChecksumFilePath(dir), []byte("not-a-checksum\n"), 0644); err != nil {
+			t.Fatalf("failed to write checksums: %v", err)
+		}
+		if err := VerifyChecksums(context.Background(), dir); err == nil {
+			t.Error("VerifyChecksums() expected error for malformed entry")
+		}
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := []string{filepath.Join(dir, "file1.txt"), filepath.Join(dir, "subdir", "file2.txt"), filepath.Join(dir, "file3.txt")}
+	if err := os.MkdirAll(filepath.Join(dir, "subdir"), 0755); err != nil {
+		t.Fatalf("failed to create subdir: %v", err)
+	}
+	for _, f := range files {
+		if err := os.WriteFile(f, []byte(filepath.Base(f)), 0644); err != nil {
+			t.Fatalf("failed to create %s: %v", f, err)
+		}
+	}
+	if err := GenerateChecksums(context.Background(), dir, files); err != nil {
+		t.Fatalf("GenerateChecksums() error = %v", err)
+	}
+	// Files written after checksums.txt by design are not reported
+	for _, name := range []string{"bundle.yaml", "checksums.txt.sig", filepath.Join(".git", "HEAD")} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := Verify(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !report.OKStrict() || report.Files != 3 || report.Verified != 3 || len(report.Extra) != 0 {
+		t.Fatalf("Verify() = %+v, want 3 verified files", report)
+	}
+
+	if err := os.Remove(files[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(files[1], []byte("tampered"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "subdir", "added.yaml"), []byte("added"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = Verify(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if report.OK() {
+		t.Error("OK() = true for a modified bundle")
+	}
+	if report.Verified != 1 {
+		t.Errorf("Verified = %d, want 1", report.Verified)
+	}
+	if want := []string{"file1.txt"}; !slices.Equal(report.Missing, want) {
+		t.Errorf("Missing = %v, want %v", report.Missing, want)
+	}
+	if len(report.Modified) != 1 || report.Modified[0].Path != "subdir/file2.txt" || report.Modified[0].Actual == report.Modified[0].Expected {
+		t.Errorf("Modified = %+v, want subdir/file2.txt", report.Modified)
+	}
+	if want := []string{"subdir/added.yaml"}; !slices.Equal(report.Extra, want) {
+		t.Errorf("Extra = %v, want %v", report.Extra, want)
+	}
+
+	// Only the strict check fails on unlisted files
+	extraOnly := &Report{Extra: []string{"subdir/added.yaml"}}
+	if !extraOnly.OK() || extraOnly.OKStrict() {
+		t.Errorf("OK() = %v, OKStrict() = %v, want true, false", extraOnly.OK(), extraOnly.OKStrict())
+	}
+	if err := extraOnly.Err(false); err != nil {
+		t.Errorf("Err(false) = %v, want nil", err)
+	}
+	if err := extraOnly.Err(true); err == nil || !strings.Contains(err.Error(), "subdir/added.yaml") {
+		t.Errorf("Err(true) = %v, want unlisted file", err)
+	}
+
+	var b strings.Builder
+	if err := report.WriteText(&b); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	for _, want := range []string{"1 of 3 files verified", "- file1.txt", "~ subdir/file2.txt", "+ subdir/added.yaml"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("WriteText() missing %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -24,6 +24,23 @@
 //	    return err
 //	}
 //
+// Bundles can be verified in-process before deployment:
+//
+//	if err := checksum.VerifyChecksums(ctx, "/path/to/bundle"); err != nil {
+//	    return err
+//	}
+//
+// Verify checks every file instead of stopping at the first failure, and
+// returns a report of the missing, modified and unlisted files for CI:
+//
+//	report, err := checksum.Verify(ctx, "/path/to/bundle")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, m := range report.Modified {
+//	    fmt.Println("modified:", m.Path)
+//	}
+//
 // The checksums.txt file format is compatible with sha256sum:
 //
 //	sha256sum -c checksums.txt
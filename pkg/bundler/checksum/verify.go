@@ -0,0 +1,271 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checksum
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// unlistedFiles are the bundle root files that checksums.txt never lists:
+// itself, its signatures, and the bundle.yaml inventory and audit.json
+// generation record written after it.
+var unlistedFiles = map[string]bool{
+	ChecksumFileName:                    true,
+	ChecksumFileName + ".sig":           true,
+	ChecksumFileName + ".sigstore.json": true,
+	"bundle.yaml":                       true,
+	"audit.json":                        true,
+}
+
+// Report is the result of checking a bundle directory against its
+// checksums.txt.
+type Report struct {
+	// Dir is the bundle directory.
+	Dir string `json:"dir" yaml:"dir"`
+
+	// Files is the number of files listed in checksums.txt.
+	Files int `json:"files" yaml:"files"`
+
+	// Verified is the number of listed files whose digest matches.
+	Verified int `json:"verified" yaml:"verified"`
+
+	// Missing lists the listed files that do not exist.
+	Missing []string `json:"missing,omitempty" yaml:"missing,omitempty"`
+
+	// Modified lists the listed files whose digest does not match.
+	Modified []Mismatch `json:"modified,omitempty" yaml:"modified,omitempty"`
+
+	// Extra lists the files in the bundle that checksums.txt does not list,
+	// other than checksums.txt, its signatures and bundle.yaml.
+	Extra []string `json:"extra,omitempty" yaml:"extra,omitempty"`
+}
+
+// Mismatch is a file whose digest does not match checksums.txt.
+type Mismatch struct {
+	Path     string `json:"path" yaml:"path"`
+	Expected string `json:"expected" yaml:"expected"`
+	Actual   string `json:"actual" yaml:"actual"`
+}
+
+// OK returns whether every listed file exists and matches its digest.
+// Extra files do not affect the result, which suits local checks of
+// unsigned bundles; use OKStrict when the bundle must match checksums.txt
+// exactly.
+func (r *Report) OK() bool {
+	return len(r.Missing) == 0 && len(r.Modified) == 0
+}
+
+// OKStrict returns whether OK holds and the bundle contains no files that
+// checksums.txt does not list.
+func (r *Report) OKStrict() bool {
+	return r.OK() && len(r.Extra) == 0
+}
+
+// Err returns an error describing the first missing or modified file, or,
+// when strict is set, the first file checksums.txt does not list. It returns
+// nil when the report passes.
+func (r *Report) Err(strict bool) error {
+	if len(r.Missing) > 0 {
+		return fmt.Errorf("failed to read %s for verification: file is missing", r.Missing[0])
+	}
+	if len(r.Modified) > 0 {
+		m := r.Modified[0]
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", m.Path, m.Expected, m.Actual)
+	}
+	if strict && len(r.Extra) > 0 {
+		return fmt.Errorf("%s is not listed in %s", r.Extra[0], ChecksumFileName)
+	}
+	return nil
+}
+
+// WriteText writes a human-readable rendering of the report to w.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Checksums of %s: %d of %d files verified\n", r.Dir, r.Verified, r.Files)
+	if len(r.Missing) > 0 {
+		b.WriteString("\nMissing:\n")
+		for _, path := range r.Missing {
+			fmt.Fprintf(&b, "  - %s\n", path)
+		}
+	}
+	if len(r.Modified) > 0 {
+		b.WriteString("\nModified:\n")
+		for _, m := range r.Modified {
+			fmt.Fprintf(&b, "  ~ %s (expected %s, got %s)\n", m.Path, m.Expected, m.Actual)
+		}
+	}
+	if len(r.Extra) > 0 {
+		b.WriteString("\nNot listed in " + ChecksumFileName + ":\n")
+		for _, path := range r.Extra {
+			fmt.Fprintf(&b, "  + %s\n", path)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// VerifyChecksums re-hashes every file listed in the bundle's checksums.txt
+// and compares the result against the recorded digest.
+//
+// Entries must be relative paths inside bundleDir; absolute paths and paths
+// escaping the bundle are rejected. Returns an error describing the first
+// missing or mismatched file. Use Verify for a report of every file.
+func VerifyChecksums(ctx context.Context, bundleDir string) error {
+	report, err := Verify(ctx, bundleDir)
+	if err != nil {
+		return err
+	}
+	return report.Err(false)
+}
+
+// Verify re-hashes every file listed in the bundle's checksums.txt and
+// reports the missing and modified files, along with the files of the bundle
+// that are not listed.
+//
+// Entries must be relative paths inside bundleDir; absolute paths and paths
+// escaping the bundle are rejected. An error is returned only when the
+// bundle cannot be checked, e.g. when checksums.txt is missing, malformed or
+// empty; failed checks are recorded in the report.
+func Verify(ctx context.Context, bundleDir string) (*Report, error) {
+	checksumPath := GetChecksumFilePath(bundleDir)
+	data, err := os.ReadFile(checksumPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checksums: %w", err)
+	}
+
+	root, err := filepath.Abs(bundleDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve bundle directory: %w", err)
+	}
+
+	report := &Report{Dir: bundleDir}
+	listed := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("context cancelled: %w", err)
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		want, relPath, ok := strings.Cut(line, "  ")
+		if !ok || len(want) != sha256.Size*2 {
+			return nil, fmt.Errorf("invalid checksum entry on line %d: %q", lineNum, line)
+		}
+
+		if filepath.IsAbs(relPath) {
+			return nil, fmt.Errorf("checksum entry %q must be relative to the bundle", relPath)
+		}
+		path := filepath.Join(root, filepath.FromSlash(relPath))
+		rel, relErr := filepath.Rel(root, path)
+		if relErr != nil || strings.HasPrefix(rel, "..") {
+			return nil, fmt.Errorf("checksum entry %q escapes the bundle directory", relPath)
+		}
+		listed[filepath.ToSlash(rel)] = true
+		report.Files++
+
+		content, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			report.Missing = append(report.Missing, relPath)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s for verification: %w", relPath, err)
+		}
+
+		hash := sha256.Sum256(content)
+		if got := hex.EncodeToString(hash[:]); !strings.EqualFold(got, want) {
+			report.Modified = append(report.Modified, Mismatch{Path: relPath, Expected: want, Actual: got})
+			continue
+		}
+		report.Verified++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse checksums: %w", err)
+	}
+
+	if report.Files == 0 {
+		return nil, fmt.Errorf("no entries found in %s", checksumPath)
+	}
+
+	report.Extra, err = unlistedBundleFiles(root, listed)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Debug("checksums verified",
+		"file_count", report.Files,
+		"missing", len(report.Missing),
+		"modified", len(report.Modified),
+		"extra", len(report.Extra),
+		"path", checksumPath,
+	)
+
+	return report, nil
+}
+
+// unlistedBundleFiles returns the sorted slash-separated paths of the
+// regular files under root that are not listed. Hidden directories, such as
+// .git of a GitOps checkout, are skipped.
+func unlistedBundleFiles(root string, listed map[string]bool) ([]string, error) {
+	var extra []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if listed[rel] || unlistedFiles[rel] {
+			return nil
+		}
+		extra = append(extra, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle files: %w", err)
+	}
+	sort.Strings(extra)
+	return extra, nil
+}
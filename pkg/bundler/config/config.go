@@ -15,11 +15,18 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/policy"
+	"github.com/NVIDIA/eidos/pkg/recipe"
 )
 
 // DeployerType represents the type of deployment method used for generated bundles.
@@ -31,6 +38,10 @@ const (
 	DeployerHelm DeployerType = "helm"
 	// DeployerArgoCD generates ArgoCD App of Apps manifests.
 	DeployerArgoCD DeployerType = "argocd"
+	// DeployerArgoWorkflows generates an Argo Workflows install pipeline.
+	DeployerArgoWorkflows DeployerType = "argo-workflows"
+	// DeployerTerraform generates a Terraform module of helm_release resources.
+	DeployerTerraform DeployerType = "terraform"
 )
 
 // ParseDeployerType parses a string into a DeployerType.
@@ -41,6 +52,10 @@ func ParseDeployerType(s string) (DeployerType, error) {
 		return DeployerHelm, nil
 	case string(DeployerArgoCD):
 		return DeployerArgoCD, nil
+	case string(DeployerArgoWorkflows):
+		return DeployerArgoWorkflows, nil
+	case string(DeployerTerraform):
+		return DeployerTerraform, nil
 	default:
 		return "", fmt.Errorf("invalid deployer type %q: must be one of %v", s, GetDeployerTypes())
 	}
@@ -52,6 +67,8 @@ func GetDeployerTypes() []string {
 	types := []string{
 		string(DeployerHelm),
 		string(DeployerArgoCD),
+		string(DeployerArgoWorkflows),
+		string(DeployerTerraform),
 	}
 	sort.Strings(types)
 	return types
@@ -62,6 +79,243 @@ func (d DeployerType) String() string {
 	return string(d)
 }
 
+// CapacityTemplateType selects the node provisioning templates generated for
+// GPU capacity alongside the bundle.
+type CapacityTemplateType string
+
+// Supported capacity template types.
+const (
+	// CapacityTemplateNone generates no capacity templates (default).
+	CapacityTemplateNone CapacityTemplateType = ""
+	// CapacityTemplateAuto picks Karpenter for EKS recipes and Cluster API otherwise.
+	CapacityTemplateAuto CapacityTemplateType = "auto"
+	// CapacityTemplateKarpenter generates a Karpenter NodePool and EC2NodeClass.
+	CapacityTemplateKarpenter CapacityTemplateType = "karpenter"
+	// CapacityTemplateClusterAPI generates a Cluster API MachineDeployment
+	// with its infrastructure and bootstrap templates.
+	CapacityTemplateClusterAPI CapacityTemplateType = "cluster-api"
+)
+
+// ParseCapacityTemplateType parses a string into a CapacityTemplateType.
+// An empty string or "none" disables capacity templates.
+func ParseCapacityTemplateType(s string) (CapacityTemplateType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none":
+		return CapacityTemplateNone, nil
+	case string(CapacityTemplateAuto):
+		return CapacityTemplateAuto, nil
+	case string(CapacityTemplateKarpenter):
+		return CapacityTemplateKarpenter, nil
+	case string(CapacityTemplateClusterAPI), "capi":
+		return CapacityTemplateClusterAPI, nil
+	default:
+		return "", fmt.Errorf("invalid capacity template type %q: must be one of %v", s, GetCapacityTemplateTypes())
+	}
+}
+
+// GetCapacityTemplateTypes returns a sorted slice of all supported capacity template types.
+func GetCapacityTemplateTypes() []string {
+	types := []string{
+		string(CapacityTemplateAuto),
+		string(CapacityTemplateKarpenter),
+		string(CapacityTemplateClusterAPI),
+	}
+	sort.Strings(types)
+	return types
+}
+
+// String returns the string representation of the CapacityTemplateType.
+func (t CapacityTemplateType) String() string {
+	return string(t)
+}
+
+// ApplicationSetGenerator selects the Argo CD ApplicationSet generator the
+// argocd deployer fans component Applications out to clusters with.
+type ApplicationSetGenerator string
+
+// Supported ApplicationSet generators.
+const (
+	// ApplicationSetNone generates one Application per component (default).
+	ApplicationSetNone ApplicationSetGenerator = ""
+	// ApplicationSetList generates ApplicationSets with a list generator
+	// whose cluster elements are edited in place.
+	ApplicationSetList ApplicationSetGenerator = "list"
+	// ApplicationSetCluster generates ApplicationSets with a cluster
+	// generator selecting the Argo CD clusters by label.
+	ApplicationSetCluster ApplicationSetGenerator = "cluster"
+)
+
+// ParseApplicationSetGenerator parses a string into an ApplicationSetGenerator.
+// An empty string or "none" generates plain Applications.
+func ParseApplicationSetGenerator(s string) (ApplicationSetGenerator, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none":
+		return ApplicationSetNone, nil
+	case string(ApplicationSetList):
+		return ApplicationSetList, nil
+	case string(ApplicationSetCluster), "clusters":
+		return ApplicationSetCluster, nil
+	default:
+		return "", fmt.Errorf("invalid applicationset generator %q: must be one of %v", s, GetApplicationSetGenerators())
+	}
+}
+
+// GetApplicationSetGenerators returns a sorted slice of all supported ApplicationSet generators.
+func GetApplicationSetGenerators() []string {
+	generators := []string{
+		string(ApplicationSetList),
+		string(ApplicationSetCluster),
+	}
+	sort.Strings(generators)
+	return generators
+}
+
+// String returns the string representation of the ApplicationSetGenerator.
+func (g ApplicationSetGenerator) String() string {
+	return string(g)
+}
+
+// SecretBackend selects how the Secrets components read are generated
+// alongside the bundle.
+type SecretBackend string
+
+// Supported secret backends.
+const (
+	// SecretBackendNone generates no Secret manifests (default).
+	SecretBackendNone SecretBackend = ""
+	// SecretBackendPlain generates Secrets with empty values to fill in.
+	SecretBackendPlain SecretBackend = "plain"
+	// SecretBackendESO generates External Secrets Operator ExternalSecrets
+	// reading the values from a ClusterSecretStore.
+	SecretBackendESO SecretBackend = "eso"
+	// SecretBackendSealed generates a script sealing the values into
+	// SealedSecrets with kubeseal.
+	SecretBackendSealed SecretBackend = "sealed"
+)
+
+// DefaultSecretStore is the ClusterSecretStore ExternalSecrets read from
+// when none is set.
+const DefaultSecretStore = "eidos"
+
+// ParseSecretBackend parses a string into a SecretBackend.
+// An empty string or "none" disables Secret generation.
+func ParseSecretBackend(s string) (SecretBackend, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none":
+		return SecretBackendNone, nil
+	case string(SecretBackendPlain):
+		return SecretBackendPlain, nil
+	case string(SecretBackendESO), "external-secrets":
+		return SecretBackendESO, nil
+	case string(SecretBackendSealed), "sealed-secrets":
+		return SecretBackendSealed, nil
+	default:
+		return "", fmt.Errorf("invalid secret backend %q: must be one of %v", s, GetSecretBackends())
+	}
+}
+
+// GetSecretBackends returns a sorted slice of all supported secret backends.
+func GetSecretBackends() []string {
+	backends := []string{
+		string(SecretBackendPlain),
+		string(SecretBackendESO),
+		string(SecretBackendSealed),
+	}
+	sort.Strings(backends)
+	return backends
+}
+
+// String returns the string representation of the SecretBackend.
+func (s SecretBackend) String() string {
+	return string(s)
+}
+
+// SchemaValidationMode selects how component values are checked against the
+// values.schema.json of their charts.
+type SchemaValidationMode string
+
+// Supported schema validation modes.
+const (
+	// SchemaValidationNone skips schema validation (default).
+	SchemaValidationNone SchemaValidationMode = ""
+	// SchemaValidationWarn reports schema violations as warnings.
+	SchemaValidationWarn SchemaValidationMode = "warn"
+	// SchemaValidationError fails bundle generation on schema violations.
+	SchemaValidationError SchemaValidationMode = "error"
+)
+
+// ParseSchemaValidationMode parses a string into a SchemaValidationMode.
+// An empty string or "none" disables schema validation.
+func ParseSchemaValidationMode(s string) (SchemaValidationMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none":
+		return SchemaValidationNone, nil
+	case string(SchemaValidationWarn):
+		return SchemaValidationWarn, nil
+	case string(SchemaValidationError):
+		return SchemaValidationError, nil
+	default:
+		return "", fmt.Errorf("invalid schema validation mode %q: must be one of %v", s, GetSchemaValidationModes())
+	}
+}
+
+// GetSchemaValidationModes returns a sorted slice of all supported schema validation modes.
+func GetSchemaValidationModes() []string {
+	modes := []string{
+		"none",
+		string(SchemaValidationWarn),
+		string(SchemaValidationError),
+	}
+	sort.Strings(modes)
+	return modes
+}
+
+// String returns the string representation of the SchemaValidationMode.
+func (m SchemaValidationMode) String() string {
+	return string(m)
+}
+
+// InstallScope is the scope of the RBAC and resources a component install
+// may create.
+type InstallScope string
+
+// Supported install scopes.
+const (
+	// InstallScopeCluster allows cluster-wide RBAC and resources (default).
+	InstallScopeCluster InstallScope = "cluster"
+	// InstallScopeNamespace limits the install to namespaced RBAC and
+	// resources, for charts that support it.
+	InstallScopeNamespace InstallScope = "namespace"
+)
+
+// ParseInstallScope parses a string into an InstallScope.
+// An empty string defaults to InstallScopeCluster.
+func ParseInstallScope(s string) (InstallScope, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", string(InstallScopeCluster):
+		return InstallScopeCluster, nil
+	case string(InstallScopeNamespace):
+		return InstallScopeNamespace, nil
+	default:
+		return "", fmt.Errorf("invalid install scope %q: must be one of %v", s, GetInstallScopes())
+	}
+}
+
+// GetInstallScopes returns a sorted slice of all supported install scopes.
+func GetInstallScopes() []string {
+	scopes := []string{
+		string(InstallScopeCluster),
+		string(InstallScopeNamespace),
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+// String returns the string representation of the InstallScope.
+func (s InstallScope) String() string {
+	return string(s)
+}
+
 // Config provides immutable configuration options for bundlers.
 // All fields are read-only after creation to prevent accidental modifications.
 // Use Clone() to create a modified copy or Merge() to combine configurations.
@@ -82,6 +336,57 @@ type Config struct {
 	// Map structure: bundler_name -> (path -> value)
 	valueOverrides map[string]map[string]string
 
+	// valuePatches contains values patches applied after the value
+	// overrides, keyed like valueOverrides.
+	valuePatches map[string][]*recipe.ValuesPatch
+
+	// profile is the name of the bundle profile whose component value
+	// presets are applied before the value overrides. Empty applies none.
+	profile string
+
+	// installScopes contains the install scope per component, keyed like
+	// valueOverrides. Components not listed use InstallScopeCluster.
+	installScopes map[string]InstallScope
+
+	// extraManifests contains the directories of user-provided manifests
+	// shipped with a component, keyed like valueOverrides.
+	extraManifests map[string][]string
+
+	// strictOverrides fails bundle generation when a value override matches
+	// no component or no existing value.
+	strictOverrides bool
+
+	// runbook adds an upgrade and rollback runbook to the bundle.
+	runbook bool
+
+	// nodeBootstrap adds cloud-specific node bootstrap artifacts to the bundle.
+	nodeBootstrap bool
+
+	// nodeLabels adds the proposed node labels and taints to the bundle.
+	nodeLabels bool
+
+	// imageRegistryMirror is the registry image repositories are rewritten
+	// to. Empty keeps the upstream registries.
+	imageRegistryMirror string
+
+	// secretBackend selects how the Secrets components read are generated.
+	// Empty generates none.
+	secretBackend SecretBackend
+
+	// secretStore is the ClusterSecretStore ExternalSecrets read from.
+	secretStore string
+
+	// httpProxy is the proxy URL components and scripts reach the internet
+	// through. Empty configures no proxy.
+	httpProxy string
+
+	// noProxy lists hosts, domains and CIDRs reached without the proxy.
+	noProxy []string
+
+	// caBundle holds PEM certificates components trust in addition to the
+	// system CAs.
+	caBundle []byte
+
 	// systemNodeSelector contains node selector labels for system components.
 	systemNodeSelector map[string]string
 
@@ -99,6 +404,59 @@ type Config struct {
 
 	// repoURL specifies the Git repository URL for ArgoCD applications.
 	repoURL string
+
+	// applicationSet selects the ApplicationSet generator of the argocd
+	// deployer. Empty generates plain Applications.
+	applicationSet ApplicationSetGenerator
+
+	// clusterSelector holds the Argo CD cluster labels the cluster
+	// generator selects.
+	clusterSelector map[string]string
+
+	// kubernetesVersion is the target Kubernetes version used to check
+	// component compatibility. Empty disables the check.
+	kubernetesVersion string
+
+	// versionPolicy is the allowlist of approved component versions checked
+	// during bundle generation. Nil disables the check.
+	versionPolicy *policy.VersionPolicy
+
+	// schemaValidation selects how component values are checked against
+	// their chart schemas. Empty disables the check.
+	schemaValidation SchemaValidationMode
+
+	// schemaDir is the directory of vendored chart schemas. Empty means
+	// schemas are taken from the charts themselves.
+	schemaDir string
+
+	// previousBundle is the directory of the bundle this one replaces, used
+	// to write CHANGES.md. Empty means the output directory is checked for
+	// an existing bundle instead.
+	previousBundle string
+
+	// lockedFile is the lockfile the bundle resolution must match. Empty
+	// means the bundle is not locked.
+	lockedFile string
+
+	// resolveImageDigests resolves the tag of every image in the lockfile
+	// to its manifest digest.
+	resolveImageDigests bool
+
+	// capacityTemplate selects the node provisioning templates generated for
+	// GPU capacity. Empty generates none.
+	capacityTemplate CapacityTemplateType
+
+	// pluginDir is the directory of bundler plugin executables. Empty
+	// disables plugins.
+	pluginDir string
+
+	// pluginTimeout bounds each bundler plugin call. Zero uses the plugin
+	// default.
+	pluginTimeout time.Duration
+
+	// concurrency is the number of components processed in parallel during
+	// bundle generation (default: 1, sequential).
+	concurrency int
 }
 
 // Getter methods for read-only access
@@ -138,6 +496,103 @@ func (c *Config) ValueOverrides() map[string]map[string]string {
 	return overrides
 }
 
+// ValuePatches returns a copy of the values patches, keyed by component.
+func (c *Config) ValuePatches() map[string][]*recipe.ValuesPatch {
+	if c.valuePatches == nil {
+		return nil
+	}
+	patches := make(map[string][]*recipe.ValuesPatch, len(c.valuePatches))
+	for component, list := range c.valuePatches {
+		patches[component] = slices.Clone(list)
+	}
+	return patches
+}
+
+// Profile returns the bundle profile name.
+func (c *Config) Profile() string {
+	return c.profile
+}
+
+// InstallScopes returns a copy of the install scopes, keyed by component.
+func (c *Config) InstallScopes() map[string]InstallScope {
+	if c.installScopes == nil {
+		return nil
+	}
+	return maps.Clone(c.installScopes)
+}
+
+// ExtraManifests returns a copy of the extra manifest directories, keyed by
+// component.
+func (c *Config) ExtraManifests() map[string][]string {
+	if c.extraManifests == nil {
+		return nil
+	}
+	dirs := make(map[string][]string, len(c.extraManifests))
+	for component, list := range c.extraManifests {
+		dirs[component] = slices.Clone(list)
+	}
+	return dirs
+}
+
+// StrictOverrides returns whether unapplied value overrides fail bundle
+// generation.
+func (c *Config) StrictOverrides() bool {
+	return c.strictOverrides
+}
+
+// Runbook returns whether an upgrade and rollback runbook is added to the
+// bundle.
+func (c *Config) Runbook() bool {
+	return c.runbook
+}
+
+// NodeBootstrap returns whether node bootstrap artifacts applying the
+// recipe's OS settings are added to the bundle.
+func (c *Config) NodeBootstrap() bool {
+	return c.nodeBootstrap
+}
+
+// NodeLabels returns whether the node labels and taints matching the
+// bundle's node selectors and tolerations are added to the bundle.
+func (c *Config) NodeLabels() bool {
+	return c.nodeLabels
+}
+
+// ImageRegistryMirror returns the registry image repositories in component
+// values are rewritten to, or "" when images are pulled from upstream.
+func (c *Config) ImageRegistryMirror() string {
+	return c.imageRegistryMirror
+}
+
+// SecretBackend returns the secret backend, or SecretBackendNone if Secret
+// generation is disabled.
+func (c *Config) SecretBackend() SecretBackend {
+	return c.secretBackend
+}
+
+// SecretStore returns the ClusterSecretStore ExternalSecrets read from.
+func (c *Config) SecretStore() string {
+	return c.secretStore
+}
+
+// HTTPProxy returns the proxy URL components reach the internet through, or
+// "" when no proxy is configured.
+func (c *Config) HTTPProxy() string {
+	return c.httpProxy
+}
+
+// NoProxy returns a copy of the hosts, domains and CIDRs reached without the
+// proxy.
+func (c *Config) NoProxy() []string {
+	return slices.Clone(c.noProxy)
+}
+
+// CABundle returns the PEM certificates components trust in addition to the
+// system CAs, or nil when none are configured.
+func (c *Config) CABundle() []byte {
+	return bytes.Clone(c.caBundle)
+}
+
 // SystemNodeSelector returns a copy of the system node selector map.
 func (c *Config) SystemNodeSelector() map[string]string {
 	if c.systemNodeSelector == nil {
@@ -182,7 +637,8 @@ func (c *Config) AcceleratedNodeTolerations() []corev1.Toleration {
 	return result
 }
 
-// Deployer returns the deployment method (DeployerHelm or DeployerArgoCD).
+// Deployer returns the deployment method (DeployerHelm, DeployerArgoCD,
+// DeployerArgoWorkflows or DeployerTerraform).
 func (c *Config) Deployer() DeployerType {
 	return c.deployer
 }
@@ -192,6 +648,82 @@ func (c *Config) RepoURL() string {
 	return c.repoURL
 }
 
+// ApplicationSet returns the ApplicationSet generator of the argocd deployer,
+// or ApplicationSetNone for plain Applications.
+func (c *Config) ApplicationSet() ApplicationSetGenerator {
+	return c.applicationSet
+}
+
+// ClusterSelector returns the Argo CD cluster labels the ApplicationSet
+// cluster generator selects.
+func (c *Config) ClusterSelector() map[string]string {
+	return c.clusterSelector
+}
+
+// KubernetesVersion returns the target Kubernetes version, or an empty string
+// if none was set.
+func (c *Config) KubernetesVersion() string {
+	return c.kubernetesVersion
+}
+
+// VersionPolicy returns the version policy, or nil if none was set.
+func (c *Config) VersionPolicy() *policy.VersionPolicy {
+	return c.versionPolicy
+}
+
+// SchemaValidation returns the schema validation mode, or
+// SchemaValidationNone if values are not checked against chart schemas.
+func (c *Config) SchemaValidation() SchemaValidationMode {
+	return c.schemaValidation
+}
+
+// SchemaDir returns the directory of vendored chart schemas, or an empty
+// string if schemas are taken from the charts.
+func (c *Config) SchemaDir() string {
+	return c.schemaDir
+}
+
+// PreviousBundle returns the directory of the previous bundle, or an empty
+// string if none was set.
+func (c *Config) PreviousBundle() string {
+	return c.previousBundle
+}
+
+// LockedFile returns the lockfile the bundle resolution must match, or an
+// empty string if the bundle is not locked.
+func (c *Config) LockedFile() string {
+	return c.lockedFile
+}
+
+// ResolveImageDigests returns whether image tags are resolved to digests in
+// the lockfile.
+func (c *Config) ResolveImageDigests() bool {
+	return c.resolveImageDigests
+}
+
+// CapacityTemplate returns the capacity template type, or CapacityTemplateNone
+// if capacity templates are disabled.
+func (c *Config) CapacityTemplate() CapacityTemplateType {
+	return c.capacityTemplate
+}
+
+// PluginDir returns the bundler plugin directory, or an empty string if
+// plugins are disabled.
+func (c *Config) PluginDir() string {
+	return c.pluginDir
+}
+
+// PluginTimeout returns the timeout for each bundler plugin call.
+func (c *Config) PluginTimeout() time.Duration {
+	return c.pluginTimeout
+}
+
+// Concurrency returns the number of components processed in parallel during
+// bundle generation. It is always at least 1.
+func (c *Config) Concurrency() int {
+	return max(c.concurrency, 1)
+}
+
 // Validate checks if the Config has valid settings.
 func (c *Config) Validate() error {
 	return nil
@@ -245,6 +777,158 @@ func WithValueOverrides(overrides map[string]map[string]string) Option {
 	}
 }
 
+// WithValuePatches adds values patches, keyed by component, applied in order
+// after the value overrides.
+func WithValuePatches(patches map[string][]*recipe.ValuesPatch) Option {
+	return func(c *Config) {
+		if len(patches) == 0 {
+			return
+		}
+		if c.valuePatches == nil {
+			c.valuePatches = make(map[string][]*recipe.ValuesPatch, len(patches))
+		}
+		for component, list := range patches {
+			c.valuePatches[component] = append(c.valuePatches[component], list...)
+		}
+	}
+}
+
+// WithProfile selects the bundle profile (e.g., "dev", "staging",
+// "production") whose component value presets, loaded from the recipe data,
+// are applied under the value overrides and patches.
+func WithProfile(name string) Option {
+	return func(c *Config) {
+		c.profile = strings.TrimSpace(name)
+	}
+}
+
+// WithInstallScopes sets the install scope of components, keyed like value
+// overrides by component or instance name.
+func WithInstallScopes(scopes map[string]InstallScope) Option {
+	return func(c *Config) {
+		if len(scopes) == 0 {
+			return
+		}
+		if c.installScopes == nil {
+			c.installScopes = make(map[string]InstallScope, len(scopes))
+		}
+		maps.Copy(c.installScopes, scopes)
+	}
+}
+
+// WithExtraManifests adds a directory of raw manifests (*.yaml, *.yml) to
+// ship in the manifests of a component, keyed like value overrides by
+// component or instance name, e.g. cluster-specific NetworkPolicies or
+// PriorityClasses. Supported by the helm and argocd deployers.
+func WithExtraManifests(component, dir string) Option {
+	return func(c *Config) {
+		component = strings.TrimSpace(component)
+		if component == "" || dir == "" {
+			return
+		}
+		if c.extraManifests == nil {
+			c.extraManifests = make(map[string][]string)
+		}
+		c.extraManifests[component] = append(c.extraManifests[component], dir)
+	}
+}
+
+// WithStrictOverrides makes bundle generation fail when a value override
+// matches no component or no existing value, instead of only reporting it.
+func WithStrictOverrides(strict bool) Option {
+	return func(c *Config) {
+		c.strictOverrides = strict
+	}
+}
+
+// WithRunbook sets whether runbook.md, describing pre-upgrade snapshots,
+// health checks between waves and rollback per component, is added to the
+// bundle.
+func WithRunbook(enabled bool) Option {
+	return func(c *Config) {
+		c.runbook = enabled
+	}
+}
+
+// WithNodeBootstrap sets whether bootstrap/, holding EKS launch template user
+// data, a GKE node system configuration or an AKS custom node configuration
+// with the recipe's sysctl, GRUB and kernel module settings, is added to the
+// bundle.
+func WithNodeBootstrap(enabled bool) Option {
+	return func(c *Config) {
+		c.nodeBootstrap = enabled
+	}
+}
+
+// WithNodeLabels sets whether node-labels/, proposing the labels and taints
+// for system and GPU nodes with a script applying them, is added to the
+// bundle. Without explicit accelerated node selectors and tolerations, the
+// proposed GPU node labels and taint are also injected into component values.
+func WithNodeLabels(enabled bool) Option {
+	return func(c *Config) {
+		c.nodeLabels = enabled
+	}
+}
+
+// WithImageRegistryMirror sets the private registry (e.g.,
+// "my.registry.local" or "my.registry.local/mirror") that the image
+// repositories of component values are rewritten to. The bundle then lists
+// the images to copy there in mirror-images.sh.
+func WithImageRegistryMirror(mirror string) Option {
+	return func(c *Config) {
+		c.imageRegistryMirror = strings.TrimSuffix(mirror, "/")
+	}
+}
+
+// WithSecretBackend sets how the Secrets components read, such as registry
+// credentials and license tokens, are generated into the bundle.
+func WithSecretBackend(backend SecretBackend) Option {
+	return func(c *Config) {
+		c.secretBackend = backend
+	}
+}
+
+// WithSecretStore sets the ClusterSecretStore that ExternalSecrets generated
+// with SecretBackendESO read from. Empty keeps DefaultSecretStore.
+func WithSecretStore(store string) Option {
+	return func(c *Config) {
+		if store != "" {
+			c.secretStore = store
+		}
+	}
+}
+
+// WithHTTPProxy sets the proxy URL (e.g., "http://proxy.corp:3128") that
+// components and the bundle scripts reach the internet through, for both
+// HTTP and HTTPS.
+func WithHTTPProxy(proxy string) Option {
+	return func(c *Config) {
+		c.httpProxy = strings.TrimSpace(proxy)
+	}
+}
+
+// WithNoProxy sets the hosts, domains and CIDRs reached without the proxy,
+// in addition to the in-cluster addresses that always bypass it.
+func WithNoProxy(hosts []string) Option {
+	return func(c *Config) {
+		c.noProxy = nil
+		for _, host := range hosts {
+			if host = strings.TrimSpace(host); host != "" {
+				c.noProxy = append(c.noProxy, host)
+			}
+		}
+	}
+}
+
+// WithCABundle sets PEM certificates, such as the CA of a TLS-inspecting
+// proxy or a private registry, that components trust in addition to the
+// system CAs.
+func WithCABundle(pem []byte) Option {
+	return func(c *Config) {
+		c.caBundle = bytes.Clone(pem)
+	}
+}
+
 // WithSystemNodeSelector sets the node selector for system components.
 func WithSystemNodeSelector(selector map[string]string) Option {
 	return func(c *Config) {
@@ -307,12 +991,121 @@ func WithRepoURL(repoURL string) Option {
 	}
 }
 
+// WithApplicationSet makes the argocd deployer generate ApplicationSets with
+// the given generator instead of plain Applications.
+func WithApplicationSet(generator ApplicationSetGenerator) Option {
+	return func(c *Config) {
+		c.applicationSet = generator
+	}
+}
+
+// WithClusterSelector sets the Argo CD cluster labels the ApplicationSet
+// cluster generator selects. Empty selects every cluster.
+func WithClusterSelector(selector map[string]string) Option {
+	return func(c *Config) {
+		if selector == nil {
+			return
+		}
+		c.clusterSelector = make(map[string]string, len(selector))
+		for k, v := range selector {
+			c.clusterSelector[k] = v
+		}
+	}
+}
+
+// WithKubernetesVersion sets the target Kubernetes version used to flag
+// incompatible components.
+func WithKubernetesVersion(v string) Option {
+	return func(c *Config) {
+		c.kubernetesVersion = v
+	}
+}
+
+// WithVersionPolicy sets the allowlist of approved component versions.
+func WithVersionPolicy(p *policy.VersionPolicy) Option {
+	return func(c *Config) {
+		c.versionPolicy = p
+	}
+}
+
+// WithSchemaValidation sets how component values are checked against the
+// values.schema.json of their charts.
+func WithSchemaValidation(mode SchemaValidationMode) Option {
+	return func(c *Config) {
+		c.schemaValidation = mode
+	}
+}
+
+// WithSchemaDir sets the directory of vendored chart schemas, used instead
+// of downloading the charts.
+func WithSchemaDir(dir string) Option {
+	return func(c *Config) {
+		c.schemaDir = dir
+	}
+}
+
+// WithPreviousBundle sets the directory of the bundle being replaced, whose
+// components are compared with the new bundle to write CHANGES.md.
+func WithPreviousBundle(dir string) Option {
+	return func(c *Config) {
+		c.previousBundle = dir
+	}
+}
+
+// WithLockedFile sets the lockfile (recipe.lock.yaml) the bundle resolution
+// must match. Generation fails without writing anything when the recipe,
+// recipe data, chart versions or images differ from it.
+func WithLockedFile(path string) Option {
+	return func(c *Config) {
+		c.lockedFile = path
+	}
+}
+
+// WithResolveImageDigests enables resolving image tags to their manifest
+// digests in the registries, so the lockfile pins image content.
+func WithResolveImageDigests(enabled bool) Option {
+	return func(c *Config) {
+		c.resolveImageDigests = enabled
+	}
+}
+
+// WithCapacityTemplate sets the node provisioning templates generated for GPU capacity.
+func WithCapacityTemplate(t CapacityTemplateType) Option {
+	return func(c *Config) {
+		c.capacityTemplate = t
+	}
+}
+
+// WithPluginDir sets the directory of bundler plugin executables.
+func WithPluginDir(dir string) Option {
+	return func(c *Config) {
+		c.pluginDir = dir
+	}
+}
+
+// WithPluginTimeout sets the timeout for each bundler plugin call.
+func WithPluginTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.pluginTimeout = timeout
+	}
+}
+
+// WithConcurrency sets the number of components processed in parallel during
+// bundle generation. Values below 1 mean sequential generation.
+func WithConcurrency(n int) Option {
+	return func(c *Config) {
+		c.concurrency = n
+	}
+}
+
 // NewConfig returns a Config with default values.
 func NewConfig(options ...Option) *Config {
 	c := &Config{
+		concurrency:      1,
 		deployer:         DeployerHelm,
 		includeChecksums: true,
 		includeReadme:    true,
+		secretStore:      DefaultSecretStore,
 		valueOverrides:   make(map[string]map[string]string),
 		verbose:          false,
 		version:          "dev",
@@ -323,6 +1116,16 @@ func NewConfig(options ...Option) *Config {
 	return c
 }
 
+// With returns a copy of the config with options applied. The receiver is
+// not modified.
+func (c *Config) With(options ...Option) *Config {
+	derived := *c
+	for _, opt := range options {
+		opt(&derived)
+	}
+	return &derived
+}
+
 // ParseValueOverrides parses value override strings in format "bundler:path.to.field=value".
 // Returns a map of bundler -> (path -> value).
 // This function is used by both CLI and API handlers to parse --set flags and query parameters.
@@ -339,15 +1142,13 @@ func ParseValueOverrides(overrides []string) (map[string]map[string]string, erro
 		bundlerName := parts[0]
 		pathValue := parts[1]
 
-		// Split on first '=' to get path and value
-		kvParts := strings.SplitN(pathValue, "=", 2)
-		if len(kvParts) != 2 {
+		// Split on the first '=' outside list selectors such as
+		// env[name=FOO] to get path and value
+		path, value, ok := cutOverridePath(pathValue)
+		if !ok {
 			return nil, fmt.Errorf("invalid format '%s': expected 'bundler:path=value'", override)
 		}
 
-		path := kvParts[0]
-		value := kvParts[1]
-
 		if path == "" || value == "" {
 			return nil, fmt.Errorf("invalid format '%s': path and value cannot be empty", override)
 		}
@@ -362,3 +1163,24 @@ func ParseValueOverrides(overrides []string) (map[string]map[string]string, erro
 
 	return result, nil
 }
+
+// cutOverridePath splits "path=value" at the first '=' outside list item
+// selectors, so "env[name=FOO].value=bar" splits after "value".
+func cutOverridePath(s string) (path, value string, ok bool) {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case '=':
+			if depth == 0 {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+	return "", "", false
+}
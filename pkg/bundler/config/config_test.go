@@ -15,9 +15,12 @@
 package config
 
 import (
+	"slices"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
 )
 
 // testValueTrue is used as a consistent value string for test assertions.
@@ -90,6 +93,15 @@ func TestAllGetters(t *testing.T) {
 		WithIncludeReadme(true),
 		WithIncludeChecksums(false),
 		WithVerbose(true),
+		WithStrictOverrides(true),
+		WithRunbook(true),
+		WithNodeBootstrap(true),
+		WithNodeLabels(true),
+		WithImageRegistryMirror("my.registry.local/"),
+		WithSecretBackend(SecretBackendESO),
+		WithSecretStore(""),
+		WithHTTPProxy(" http://proxy.corp:3128 "),
+		WithProfile(" production "),
 	)
 
 	tests := []struct {
@@ -101,6 +113,15 @@ func TestAllGetters(t *testing.T) {
 		{"IncludeReadme", cfg.IncludeReadme(), true, "IncludeReadme()"},
 		{"IncludeChecksums", cfg.IncludeChecksums(), false, "IncludeChecksums()"},
 		{"Verbose", cfg.Verbose(), true, "Verbose()"},
+		{"StrictOverrides", cfg.StrictOverrides(), true, "StrictOverrides()"},
+		{"Runbook", cfg.Runbook(), true, "Runbook()"},
+		{"NodeBootstrap", cfg.NodeBootstrap(), true, "NodeBootstrap()"},
+		{"NodeLabels", cfg.NodeLabels(), true, "NodeLabels()"},
+		{"ImageRegistryMirror", cfg.ImageRegistryMirror(), "my.registry.local", "ImageRegistryMirror()"},
+		{"SecretBackend", cfg.SecretBackend(), SecretBackendESO, "SecretBackend()"},
+		{"SecretStore", cfg.SecretStore(), DefaultSecretStore, "SecretStore()"},
+		{"HTTPProxy", cfg.HTTPProxy(), "http://proxy.corp:3128", "HTTPProxy()"},
+		{"Profile", cfg.Profile(), "production", "Profile()"},
 	}
 
 	for _, tt := range tests {
@@ -112,6 +133,31 @@ func TestAllGetters(t *testing.T) {
 	}
 }
 
+func TestProxyOptions(t *testing.T) {
+	pem := []byte("-----BEGIN CERTIFICATE-----\n")
+	cfg := NewConfig(
+		WithNoProxy([]string{" .corp.example.com", "", "10.0.0.0/8"}),
+		WithCABundle(pem),
+	)
+
+	if got := cfg.NoProxy(); !slices.Equal(got, []string{".corp.example.com", "10.0.0.0/8"}) {
+		t.Errorf("NoProxy() = %v", got)
+	}
+	cfg.NoProxy()[0] = "modified"
+	if cfg.NoProxy()[0] != ".corp.example.com" {
+		t.Error("modifying NoProxy() result affected config - not immutable")
+	}
+
+	pem[0] = 'x'
+	if got := string(cfg.CABundle()); got != "-----BEGIN CERTIFICATE-----\n" {
+		t.Errorf("CABundle() = %q, want the bundle as set", got)
+	}
+
+	if cfg := NewConfig(); cfg.HTTPProxy() != "" || cfg.NoProxy() != nil || cfg.CABundle() != nil {
+		t.Error("default config should have no proxy settings")
+	}
+}
+
 func TestVersionOption(t *testing.T) {
 	// Test WithVersion sets the version
 	cfg := NewConfig(WithVersion("v1.2.3"))
@@ -192,6 +238,43 @@ func TestValueOverridesNil(t *testing.T) {
 	}
 }
 
+func TestValuePatchesOption(t *testing.T) {
+	first, err := recipe.ParseValuesPatch([]byte("- op: remove\n  path: /gds\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := recipe.ParseValuesPatch([]byte("gds:\n  enabled: true\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := NewConfig(
+		WithValuePatches(map[string][]*recipe.ValuesPatch{"gpu-operator": {first}}),
+		WithValuePatches(map[string][]*recipe.ValuesPatch{"gpu-operator": {second}}),
+		WithValuePatches(nil),
+	)
+
+	got := cfg.ValuePatches()
+	if len(got["gpu-operator"]) != 2 || got["gpu-operator"][0] != first || got["gpu-operator"][1] != second {
+		t.Fatalf("ValuePatches() = %v, want both patches in order", got)
+	}
+
+	// Modifying the returned map must not affect the config
+	got["gpu-operator"][0] = nil
+	got["cert-manager"] = []*recipe.ValuesPatch{first}
+	fresh := cfg.ValuePatches()
+	if fresh["gpu-operator"][0] != first {
+		t.Error("modifying returned slice affected config - not immutable")
+	}
+	if _, exists := fresh["cert-manager"]; exists {
+		t.Error("adding key to returned map affected config - not immutable")
+	}
+
+	if NewConfig().ValuePatches() != nil {
+		t.Error("ValuePatches() on empty config should be nil")
+	}
+}
+
 func TestNodeSelectorOptions(t *testing.T) {
 	t.Run("SystemNodeSelector with valid values", func(t *testing.T) {
 		selectors := map[string]string{
@@ -346,6 +429,27 @@ func TestDeployerOptions(t *testing.T) {
 	})
 }
 
+func TestConcurrencyOption(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want int
+	}{
+		{"default is sequential", nil, 1},
+		{"sets concurrency", []Option{WithConcurrency(8)}, 8},
+		{"zero is sequential", []Option{WithConcurrency(0)}, 1},
+		{"negative is sequential", []Option{WithConcurrency(-2)}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewConfig(tt.opts...).Concurrency(); got != tt.want {
+				t.Errorf("Concurrency() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseValueOverrides(t *testing.T) {
 	t.Run("valid single override", func(t *testing.T) {
 		result, err := ParseValueOverrides([]string{"gpuoperator:gds.enabled=true"})
@@ -436,6 +540,16 @@ func TestParseValueOverrides(t *testing.T) {
 			t.Errorf("result[bundler][path] = %s, want value=with=equals", result["bundler"]["path"])
 		}
 	})
+
+	t.Run("path with list selector", func(t *testing.T) {
+		result, err := ParseValueOverrides([]string{"gpuoperator:driver.env[name=LOG_LEVEL].value=debug"})
+		if err != nil {
+			t.Fatalf("ParseValueOverrides() error = %v", err)
+		}
+		if result["gpuoperator"]["driver.env[name=LOG_LEVEL].value"] != "debug" {
+			t.Errorf("result[gpuoperator] = %v, want driver.env[name=LOG_LEVEL].value=debug", result["gpuoperator"])
+		}
+	})
 }
 
 func TestParseDeployerType(t *testing.T) {
@@ -451,6 +565,9 @@ func TestParseDeployerType(t *testing.T) {
 		{"argocd lowercase", "argocd", DeployerArgoCD, false},
 		{"argocd uppercase", "ARGOCD", DeployerArgoCD, false},
 		{"argocd mixed case", "ArgoCD", DeployerArgoCD, false},
+		{"argo-workflows lowercase", "argo-workflows", DeployerArgoWorkflows, false},
+		{"argo-workflows uppercase", "ARGO-WORKFLOWS", DeployerArgoWorkflows, false},
+		{"terraform lowercase", "terraform", DeployerTerraform, false},
 		{"helm with spaces", "  helm  ", DeployerHelm, false},
 		{"invalid type", "invalid", "", true},
 		{"empty string", "", "", true},
@@ -471,12 +588,197 @@ func TestParseDeployerType(t *testing.T) {
 	}
 }
 
+func TestParseCapacityTemplateType(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    CapacityTemplateType
+		wantErr bool
+	}{
+		{"empty disables", "", CapacityTemplateNone, false},
+		{"none disables", "none", CapacityTemplateNone, false},
+		{"auto", "auto", CapacityTemplateAuto, false},
+		{"karpenter uppercase", "KARPENTER", CapacityTemplateKarpenter, false},
+		{"cluster-api", "cluster-api", CapacityTemplateClusterAPI, false},
+		{"capi alias", " capi ", CapacityTemplateClusterAPI, false},
+		{"invalid type", "eksctl", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCapacityTemplateType(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCapacityTemplateType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCapacityTemplateType(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseApplicationSetGenerator(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    ApplicationSetGenerator
+		wantErr bool
+	}{
+		{"empty disables", "", ApplicationSetNone, false},
+		{"none disables", "none", ApplicationSetNone, false},
+		{"list", "list", ApplicationSetList, false},
+		{"cluster uppercase", " CLUSTER ", ApplicationSetCluster, false},
+		{"clusters alias", "clusters", ApplicationSetCluster, false},
+		{"invalid generator", "git", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseApplicationSetGenerator(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseApplicationSetGenerator(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseApplicationSetGenerator(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSecretBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    SecretBackend
+		wantErr bool
+	}{
+		{"empty disables", "", SecretBackendNone, false},
+		{"none disables", "none", SecretBackendNone, false},
+		{"plain", "plain", SecretBackendPlain, false},
+		{"eso uppercase", "ESO", SecretBackendESO, false},
+		{"external-secrets alias", "external-secrets", SecretBackendESO, false},
+		{"sealed-secrets alias", " sealed-secrets ", SecretBackendSealed, false},
+		{"invalid backend", "vault", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSecretBackend(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSecretBackend(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseSecretBackend(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInstallScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    InstallScope
+		wantErr bool
+	}{
+		{"empty defaults to cluster", "", InstallScopeCluster, false},
+		{"cluster", "cluster", InstallScopeCluster, false},
+		{"namespace uppercase", " Namespace ", InstallScopeNamespace, false},
+		{"invalid scope", "tenant", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInstallScope(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInstallScope(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseInstallScope(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstallScopesOption(t *testing.T) {
+	cfg := NewConfig(
+		WithInstallScopes(map[string]InstallScope{"nim": InstallScopeNamespace}),
+		WithInstallScopes(map[string]InstallScope{"nim-b": InstallScopeCluster}),
+		WithInstallScopes(nil),
+	)
+
+	got := cfg.InstallScopes()
+	if len(got) != 2 || got["nim"] != InstallScopeNamespace || got["nim-b"] != InstallScopeCluster {
+		t.Fatalf("InstallScopes() = %v", got)
+	}
+	got["nim"] = InstallScopeCluster
+	if cfg.InstallScopes()["nim"] != InstallScopeNamespace {
+		t.Error("modifying returned map affected config - not immutable")
+	}
+	if NewConfig().InstallScopes() != nil {
+		t.Error("InstallScopes() on empty config should be nil")
+	}
+}
+
+func TestExtraManifestsOption(t *testing.T) {
+	cfg := NewConfig(
+		WithExtraManifests("gpu-operator", "netpol"),
+		WithExtraManifests(" gpu-operator ", "priority"),
+		WithExtraManifests("nim", ""),
+		WithExtraManifests("", "ignored"),
+	)
+
+	got := cfg.ExtraManifests()
+	if len(got) != 1 || !slices.Equal(got["gpu-operator"], []string{"netpol", "priority"}) {
+		t.Fatalf("ExtraManifests() = %v", got)
+	}
+	got["gpu-operator"][0] = "changed"
+	if cfg.ExtraManifests()["gpu-operator"][0] != "netpol" {
+		t.Error("modifying returned map affected config - not immutable")
+	}
+	if NewConfig().ExtraManifests() != nil {
+		t.Error("ExtraManifests() on empty config should be nil")
+	}
+}
+
+func TestParseSchemaValidationMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    SchemaValidationMode
+		wantErr bool
+	}{
+		{"empty disables", "", SchemaValidationNone, false},
+		{"none disables", "none", SchemaValidationNone, false},
+		{"warn", "warn", SchemaValidationWarn, false},
+		{"error uppercase", " ERROR ", SchemaValidationError, false},
+		{"invalid mode", "strict", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSchemaValidationMode(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSchemaValidationMode(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseSchemaValidationMode(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetDeployerTypes(t *testing.T) {
 	types := GetDeployerTypes()
 
 	// Verify we get the expected types
-	if len(types) != 2 {
-		t.Errorf("GetDeployerTypes() returned %d types, want 2", len(types))
+	if len(types) != 4 {
+		t.Errorf("GetDeployerTypes() returned %d types, want 4", len(types))
 	}
 
 	// Verify types are sorted alphabetically
@@ -498,6 +800,12 @@ func TestGetDeployerTypes(t *testing.T) {
 	if !found[string(DeployerHelm)] {
 		t.Error("GetDeployerTypes() missing 'helm'")
 	}
+	if !found[string(DeployerArgoWorkflows)] {
+		t.Error("GetDeployerTypes() missing 'argo-workflows'")
+	}
+	if !found[string(DeployerTerraform)] {
+		t.Error("GetDeployerTypes() missing 'terraform'")
+	}
 }
 
 func TestDeployerTypeString(t *testing.T) {
@@ -20,7 +20,7 @@
 //
 // # Configuration Options
 //
-//   - Deployer: Deployment method (DeployerHelm or DeployerArgoCD)
+//   - Deployer: Deployment method (DeployerHelm, DeployerArgoCD, DeployerArgoWorkflows or DeployerTerraform)
 //   - IncludeReadme: Generate deployment documentation
 //   - IncludeChecksums: Generate SHA256 checksums.txt file
 //   - Version: Bundler version string
@@ -32,6 +32,8 @@
 // DeployerType constants define supported deployment methods:
 //   - DeployerHelm: Generates Helm umbrella charts (default)
 //   - DeployerArgoCD: Generates ArgoCD App of Apps manifests
+//   - DeployerArgoWorkflows: Generates an Argo Workflows install pipeline
+//   - DeployerTerraform: Generates a Terraform module of helm_release resources
 //
 // Use ParseDeployerType() to parse user input and GetDeployerTypes() for CLI help.
 //
@@ -21,15 +21,18 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
-	"text/template"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/component"
 	"github.com/NVIDIA/eidos/pkg/errors"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 )
@@ -37,6 +40,9 @@ import (
 //go:embed templates/application.yaml.tmpl
 var applicationTemplate string
 
+//go:embed templates/applicationset.yaml.tmpl
+var applicationSetTemplate string
+
 //go:embed templates/app-of-apps.yaml.tmpl
 var appOfAppsTemplate string
 
@@ -46,14 +52,61 @@ var readmeTemplate string
 // defaultNamespace is the default namespace for component deployment.
 const defaultNamespace = "nvidia-system"
 
+const (
+	// ApplicationFileName is the per-component Argo CD Application.
+	ApplicationFileName = "application.yaml"
+
+	// ApplicationSetFileName is the per-component Argo CD ApplicationSet
+	// generated instead of the Application with an ApplicationSet generator.
+	ApplicationSetFileName = "applicationset.yaml"
+
+	// ManifestsDir holds a component's extra manifests, synced by its
+	// Application along with the chart.
+	ManifestsDir = "manifests"
+
+	// ClustersDir holds the per-cluster values overrides read by the
+	// ApplicationSets, as clusters/<cluster>/<component>/values.yaml.
+	ClustersDir = "clusters"
+)
+
 // ApplicationData contains data for rendering an ArgoCD Application.
 type ApplicationData struct {
-	Name       string
-	Namespace  string
-	Repository string
-	Chart      string
-	Version    string
-	SyncWave   int
+	Name        string
+	Namespace   string
+	Repository  string
+	Chart       string
+	ReleaseName string
+	Version     string
+	SyncWave    int
+
+	// CreateNamespace lets Argo CD create the destination namespace. Unset
+	// for namespace-scoped components, whose namespace must already exist.
+	CreateNamespace bool
+
+	// Manifests is the bundle path of the component's extra manifests,
+	// synced as an additional source. Empty if it has none.
+	Manifests string
+}
+
+// ApplicationSetData contains data for rendering an Argo CD ApplicationSet.
+type ApplicationSetData struct {
+	ApplicationData
+
+	// RepoURL is the Git repository the values files are read from.
+	RepoURL string
+
+	// Generator is the ApplicationSet generator ("list" or "cluster").
+	Generator string
+
+	// ClusterSelector holds the cluster labels the cluster generator
+	// selects, sorted by key.
+	ClusterSelector []Label
+}
+
+// Label is a label key and value.
+type Label struct {
+	Key   string
+	Value string
 }
 
 // AppOfAppsData contains data for rendering the App of Apps manifest.
@@ -61,13 +114,22 @@ type AppOfAppsData struct {
 	RepoURL        string
 	TargetRevision string
 	Path           string
+
+	// Include is the file name of the per-component manifests the App of
+	// Apps syncs.
+	Include string
 }
 
 // ReadmeData contains data for rendering the README.
 type ReadmeData struct {
-	RecipeVersion  string
-	BundlerVersion string
-	Components     []ApplicationData
+	RecipeVersion         string
+	BundlerVersion        string
+	ApplicationFile       string
+	ApplicationSet        string
+	ClusterSelector       []Label
+	Components            []ApplicationData
+	Docs                  []recipe.ComponentDocs
+	CompatibilityWarnings []recipe.ConstraintWarning
 }
 
 // GeneratorInput contains all data needed to generate ArgoCD Applications.
@@ -87,6 +149,24 @@ type GeneratorInput struct {
 
 	// IncludeChecksums indicates whether to generate a checksums.txt file.
 	IncludeChecksums bool
+
+	// NamespaceScoped marks the component references installed with
+	// namespace scope. Their Applications do not create the namespace.
+	NamespaceScoped map[string]bool
+
+	// ApplicationSet generates an ApplicationSet per component with this
+	// generator instead of an Application, deploying the component to every
+	// cluster the generator yields. Empty generates Applications.
+	ApplicationSet config.ApplicationSetGenerator
+
+	// ClusterSelector holds the Argo CD cluster labels the cluster
+	// generator selects. Empty selects every cluster.
+	ClusterSelector map[string]string
+
+	// ExtraManifests holds user-provided manifests keyed by component name,
+	// then by file name. They are written to <component>/manifests/ and
+	// synced by the component's Application.
+	ExtraManifests map[string]map[string][]byte
 }
 
 // GeneratorOutput contains the result of ArgoCD Application generation.
@@ -133,6 +213,16 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 			"failed to create output directory", err)
 	}
 
+	repoURL := input.RepoURL
+	if repoURL == "" {
+		repoURL = "https://github.com/YOUR-ORG/YOUR-REPO.git"
+	}
+	appFile := ApplicationFileName
+	if input.ApplicationSet != config.ApplicationSetNone {
+		appFile = ApplicationSetFileName
+	}
+	selector := sortedLabels(input.ClusterSelector)
+
 	// Sort components by deployment order
 	components := sortComponentsByDeploymentOrder(
 		input.RecipeResult.ComponentRefs,
@@ -143,12 +233,18 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 	appDataList := make([]ApplicationData, 0, len(components))
 	for i, comp := range components {
 		appData := ApplicationData{
-			Name:       comp.Name,
-			Namespace:  getNamespace(comp),
-			Repository: comp.Source,
-			Chart:      comp.Name,
-			Version:    normalizeVersion(comp.Version),
-			SyncWave:   i, // Use index as sync wave
+			Name:        comp.Name,
+			Namespace:   ComponentNamespace(comp),
+			Repository:  comp.Source,
+			Chart:       helm.ResolveChartName(comp.ComponentName()),
+			ReleaseName: comp.HelmReleaseName(),
+			Version:     normalizeVersion(comp.Version),
+			SyncWave:    i, // Use index as sync wave
+
+			CreateNamespace: !input.NamespaceScoped[comp.Name],
+		}
+		if len(input.ExtraManifests[comp.Name]) > 0 {
+			appData.Manifests = path.Join(comp.Name, ManifestsDir)
 		}
 		appDataList = append(appDataList, appData)
 	}
@@ -167,12 +263,23 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 				fmt.Sprintf("failed to create directory for %s", appData.Name), err)
 		}
 
-		// Generate application.yaml
-		appPath := filepath.Join(componentDir, "application.yaml")
-		appSize, err := g.generateFromTemplate(applicationTemplate, appData, appPath)
+		// Generate application.yaml, or applicationset.yaml
+		appPath := filepath.Join(componentDir, appFile)
+		var appSize int64
+		var err error
+		if input.ApplicationSet == config.ApplicationSetNone {
+			appSize, err = g.generateFromTemplate(applicationTemplate, appData, appPath)
+		} else {
+			appSize, err = g.generateApplicationSet(&ApplicationSetData{
+				ApplicationData: appData,
+				RepoURL:         repoURL,
+				Generator:       input.ApplicationSet.String(),
+				ClusterSelector: selector,
+			}, appPath)
+		}
 		if err != nil {
 			return nil, errors.Wrap(errors.ErrCodeInternal,
-				fmt.Sprintf("failed to generate application.yaml for %s", appData.Name), err)
+				fmt.Sprintf("failed to generate %s for %s", appFile, appData.Name), err)
 		}
 		output.Files = append(output.Files, appPath)
 		output.TotalSize += appSize
@@ -190,17 +297,23 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 		}
 		output.Files = append(output.Files, valuesPath)
 		output.TotalSize += valuesSize
+
+		// Write the extra manifests
+		manifestFiles, manifestsSize, err := g.writeManifests(input.ExtraManifests[appData.Name], filepath.Join(componentDir, ManifestsDir))
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to write manifests for %s", appData.Name), err)
+		}
+		output.Files = append(output.Files, manifestFiles...)
+		output.TotalSize += manifestsSize
 	}
 
 	// Generate app-of-apps.yaml
-	repoURL := input.RepoURL
-	if repoURL == "" {
-		repoURL = "https://github.com/YOUR-ORG/YOUR-REPO.git"
-	}
 	appOfAppsData := AppOfAppsData{
 		RepoURL:        repoURL,
 		TargetRevision: "main",
 		Path:           ".",
+		Include:        appFile,
 	}
 	appOfAppsPath := filepath.Join(outputDir, "app-of-apps.yaml")
 	appOfAppsSize, err := g.generateFromTemplate(appOfAppsTemplate, appOfAppsData, appOfAppsPath)
@@ -212,9 +325,14 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 
 	// Generate README.md
 	readmeData := ReadmeData{
-		RecipeVersion:  input.RecipeResult.Metadata.Version,
-		BundlerVersion: input.Version,
-		Components:     appDataList,
+		RecipeVersion:         input.RecipeResult.Metadata.Version,
+		BundlerVersion:        input.Version,
+		ApplicationFile:       appFile,
+		ApplicationSet:        input.ApplicationSet.String(),
+		ClusterSelector:       selector,
+		Components:            appDataList,
+		Docs:                  input.RecipeResult.ComponentDocs(),
+		CompatibilityWarnings: input.RecipeResult.ComponentConstraintWarnings(),
 	}
 	readmePath := filepath.Join(outputDir, "README.md")
 	readmeSize, err := g.generateFromTemplate(readmeTemplate, readmeData, readmePath)
@@ -251,6 +369,18 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 			"Update app-of-apps.yaml with your repository URL before applying",
 		}
 	}
+	if len(input.NamespaceScoped) > 0 {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			"Create the namespaces of namespace-scoped components before syncing")
+	}
+	switch input.ApplicationSet {
+	case config.ApplicationSetList:
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			"Add one list element per cluster to each applicationset.yaml; per-cluster values go in clusters/<cluster>/<component>/values.yaml")
+	case config.ApplicationSetCluster:
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			"Components are deployed to every Argo CD cluster the selector matches; per-cluster values go in clusters/<cluster>/<component>/values.yaml")
+	}
 
 	slog.Debug("argocd applications generated",
 		"components", len(appDataList),
@@ -263,7 +393,7 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 
 // generateFromTemplate renders a template to a file.
 func (g *Generator) generateFromTemplate(tmplContent string, data any, outputPath string) (int64, error) {
-	tmpl, err := template.New("template").Parse(tmplContent)
+	tmpl, err := component.NewTemplate("template").Parse(tmplContent)
 	if err != nil {
 		return 0, fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -281,6 +411,69 @@ func (g *Generator) generateFromTemplate(tmplContent string, data any, outputPat
 	return int64(len(content)), nil
 }
 
+// generateApplicationSet renders an ApplicationSet to a file. Its template
+// uses [[ ]] delimiters, leaving {{ }} to the ApplicationSet controller.
+func (g *Generator) generateApplicationSet(data *ApplicationSetData, outputPath string) (int64, error) {
+	tmpl, err := component.NewTemplate("applicationset").Delims("[[", "]]").Parse(applicationSetTemplate)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return 0, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	content := buf.String()
+	if err := os.WriteFile(outputPath, []byte(content), 0600); err != nil {
+		return 0, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return int64(len(content)), nil
+}
+
+// writeManifests writes the manifests, keyed by file name, to dir. It
+// writes nothing if there are none.
+func (g *Generator) writeManifests(manifests map[string][]byte, dir string) ([]string, int64, error) {
+	if len(manifests) == 0 {
+		return nil, 0, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, 0, fmt.Errorf("failed to create manifests directory: %w", err)
+	}
+
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make([]string, 0, len(names))
+	var size int64
+	for _, name := range names {
+		manifestPath := filepath.Join(dir, name)
+		if err := os.WriteFile(manifestPath, manifests[name], 0600); err != nil {
+			return nil, 0, fmt.Errorf("failed to write manifest %s: %w", name, err)
+		}
+		files = append(files, manifestPath)
+		size += int64(len(manifests[name]))
+	}
+	return files, size, nil
+}
+
+// sortedLabels returns the labels sorted by key.
+func sortedLabels(labels map[string]string) []Label {
+	if len(labels) == 0 {
+		return nil
+	}
+	sorted := make([]Label, 0, len(labels))
+	for k, v := range labels {
+		sorted = append(sorted, Label{Key: k, Value: v})
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+	return sorted
+}
+
 // writeValuesFile writes a values.yaml file with header comment.
 func (g *Generator) writeValuesFile(values map[string]any, outputPath string) (int64, error) {
 	var buf strings.Builder
@@ -338,10 +531,11 @@ func sortComponentsByDeploymentOrder(refs []recipe.ComponentRef, order []string)
 	return sorted
 }
 
-// getNamespace returns the namespace for a component.
-func getNamespace(comp recipe.ComponentRef) string {
+// ComponentNamespace returns the namespace a component is installed into.
+// Instances of the same registry component share its namespace.
+func ComponentNamespace(comp recipe.ComponentRef) string {
 	// Use component name as namespace, or default
-	switch comp.Name {
+	switch comp.ComponentName() {
 	case "gpu-operator":
 		return "gpu-operator"
 	case "network-operator":
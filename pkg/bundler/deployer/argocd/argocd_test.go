@@ -18,9 +18,13 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 )
 
@@ -48,10 +52,11 @@ func TestGenerate_Success(t *testing.T) {
 			Source:  "https://charts.jetstack.io",
 		},
 		{
-			Name:    "gpu-operator",
-			Version: "v25.3.3",
-			Type:    "helm",
-			Source:  "https://helm.ngc.nvidia.com/nvidia",
+			Name:             "gpu-operator",
+			Version:          "v25.3.3",
+			Type:             "helm",
+			Source:           "https://helm.ngc.nvidia.com/nvidia",
+			SupportMatrixURL: "https://docs.nvidia.com/datacenter/cloud-native/gpu-operator/25.3.3/platform-support.html",
 		},
 	}
 	recipeResult.DeploymentOrder = []string{"cert-manager", "gpu-operator"}
@@ -142,6 +147,9 @@ func TestGenerate_Success(t *testing.T) {
 	if !strings.Contains(string(content), "gpu-operator") {
 		t.Error("README should contain gpu-operator")
 	}
+	if !strings.Contains(string(content), "| gpu-operator | v25.3.3 |  | [support matrix](https://docs.nvidia.com/datacenter/cloud-native/gpu-operator/25.3.3/platform-support.html) |") {
+		t.Error("README should link the gpu-operator support matrix")
+	}
 }
 
 func TestGenerate_NilInput(t *testing.T) {
@@ -249,6 +257,188 @@ func TestGenerate_WithRepoURL(t *testing.T) {
 	}
 }
 
+func TestGenerate_NamespaceScoped(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	recipeResult := &recipe.RecipeResult{}
+	recipeResult.Metadata.Version = testVersion
+	recipeResult.ComponentRefs = []recipe.ComponentRef{
+		{Name: "cert-manager", Version: "v1.17.2", Type: "helm", Source: "https://charts.jetstack.io"},
+		{Name: "nim", Version: "1.3.0", Type: "helm", Source: "https://helm.ngc.nvidia.com/nim"},
+	}
+
+	input := &GeneratorInput{
+		RecipeResult:    recipeResult,
+		Version:         "v0.9.0",
+		RepoURL:         "https://github.com/my-org/my-gitops-repo.git",
+		NamespaceScoped: map[string]bool{"nim": true},
+	}
+
+	output, err := g.Generate(context.Background(), input, outputDir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for name, want := range map[string]bool{"cert-manager": true, "nim": false} {
+		content, err := os.ReadFile(filepath.Join(outputDir, name, "application.yaml"))
+		if err != nil {
+			t.Fatalf("failed to read %s application.yaml: %v", name, err)
+		}
+		if got := strings.Contains(string(content), "CreateNamespace=true"); got != want {
+			t.Errorf("%s application.yaml CreateNamespace = %v, want %v", name, got, want)
+		}
+	}
+	if len(output.DeploymentNotes) != 1 {
+		t.Errorf("expected a note about creating namespaces, got %v", output.DeploymentNotes)
+	}
+}
+
+func TestGenerate_ExtraManifests(t *testing.T) {
+	recipeResult := &recipe.RecipeResult{}
+	recipeResult.Metadata.Version = testVersion
+	recipeResult.ComponentRefs = []recipe.ComponentRef{
+		{Name: "cert-manager", Version: "v1.17.2", Type: "helm", Source: "https://charts.jetstack.io"},
+		{Name: "gpu-operator", Version: "v25.3.3", Type: "helm", Source: "https://helm.ngc.nvidia.com/nvidia"},
+	}
+	netpol := []byte("apiVersion: networking.k8s.io/v1\nkind: NetworkPolicy\n")
+
+	for _, generator := range []config.ApplicationSetGenerator{config.ApplicationSetNone, config.ApplicationSetList} {
+		t.Run("generator "+generator.String(), func(t *testing.T) {
+			outputDir := t.TempDir()
+			input := &GeneratorInput{
+				RecipeResult:   recipeResult,
+				Version:        "v0.9.0",
+				RepoURL:        "https://github.com/my-org/my-gitops-repo.git",
+				ApplicationSet: generator,
+				ExtraManifests: map[string]map[string][]byte{
+					"gpu-operator": {"netpol.yaml": netpol},
+				},
+			}
+
+			output, err := NewGenerator().Generate(context.Background(), input, outputDir)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			manifestPath := filepath.Join(outputDir, "gpu-operator", ManifestsDir, "netpol.yaml")
+			got, err := os.ReadFile(manifestPath)
+			if err != nil {
+				t.Fatalf("extra manifest not written: %v", err)
+			}
+			if string(got) != string(netpol) {
+				t.Errorf("netpol.yaml = %q, want %q", got, netpol)
+			}
+			if !slices.Contains(output.Files, manifestPath) {
+				t.Errorf("output files %v missing %s", output.Files, manifestPath)
+			}
+
+			appFile := ApplicationFileName
+			if generator != config.ApplicationSetNone {
+				appFile = ApplicationSetFileName
+			}
+			for name, want := range map[string]bool{"gpu-operator": true, "cert-manager": false} {
+				content, err := os.ReadFile(filepath.Join(outputDir, name, appFile))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := strings.Contains(string(content), "path: "+name+"/manifests"); got != want {
+					t.Errorf("%s %s syncs manifests = %v, want %v", name, appFile, got, want)
+				}
+				var app map[string]any
+				if err := yaml.Unmarshal(content, &app); err != nil {
+					t.Errorf("%s %s is not valid YAML: %v", name, appFile, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerate_ApplicationSet(t *testing.T) {
+	recipeResult := &recipe.RecipeResult{}
+	recipeResult.Metadata.Version = testVersion
+	recipeResult.ComponentRefs = []recipe.ComponentRef{
+		{Name: "cert-manager", Version: "v1.17.2", Type: "helm", Source: "https://charts.jetstack.io"},
+		{Name: "gpu-operator", Version: "v25.3.3", Type: "helm", Source: "https://helm.ngc.nvidia.com/nvidia"},
+	}
+	recipeResult.DeploymentOrder = []string{"cert-manager", "gpu-operator"}
+
+	tests := []struct {
+		name      string
+		generator config.ApplicationSetGenerator
+		selector  map[string]string
+		want      []string
+	}{
+		{
+			name:      "cluster generator with selector",
+			generator: config.ApplicationSetCluster,
+			selector:  map[string]string{"gpu": "true", "env": "prod"},
+			want:      []string{"- clusters:", "env: \"prod\"", "gpu: \"true\""},
+		},
+		{
+			name:      "cluster generator without selector",
+			generator: config.ApplicationSetCluster,
+			want:      []string{"- clusters: {}"},
+		},
+		{
+			name:      "list generator",
+			generator: config.ApplicationSetList,
+			want:      []string{"- list:", "name: in-cluster"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			input := &GeneratorInput{
+				RecipeResult:    recipeResult,
+				Version:         "v0.9.0",
+				RepoURL:         "https://github.com/my-org/my-gitops-repo.git",
+				ApplicationSet:  tt.generator,
+				ClusterSelector: tt.selector,
+			}
+			output, err := NewGenerator().Generate(context.Background(), input, outputDir)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if len(output.DeploymentNotes) == 0 {
+				t.Error("expected a note about per-cluster values")
+			}
+
+			if _, err := os.Stat(filepath.Join(outputDir, "gpu-operator", ApplicationFileName)); !os.IsNotExist(err) {
+				t.Errorf("application.yaml should not be generated, stat error = %v", err)
+			}
+			content, err := os.ReadFile(filepath.Join(outputDir, "gpu-operator", ApplicationSetFileName))
+			if err != nil {
+				t.Fatalf("failed to read applicationset.yaml: %v", err)
+			}
+			var appSet map[string]any
+			if err := yaml.Unmarshal(content, &appSet); err != nil {
+				t.Fatalf("applicationset.yaml is not valid YAML: %v\n%s", err, content)
+			}
+			for _, want := range append(tt.want,
+				"kind: ApplicationSet",
+				"name: '{{ .name }}-gpu-operator'",
+				"server: '{{ .server }}'",
+				"- $values/clusters/{{ .name }}/gpu-operator/values.yaml",
+				"repoURL: https://github.com/my-org/my-gitops-repo.git",
+				"targetRevision: 25.3.3",
+			) {
+				if !strings.Contains(string(content), want) {
+					t.Errorf("applicationset.yaml missing %q:\n%s", want, content)
+				}
+			}
+
+			appOfApps, err := os.ReadFile(filepath.Join(outputDir, "app-of-apps.yaml"))
+			if err != nil {
+				t.Fatalf("failed to read app-of-apps.yaml: %v", err)
+			}
+			if !strings.Contains(string(appOfApps), "include: '*/applicationset.yaml'") {
+				t.Errorf("app-of-apps.yaml should include the ApplicationSets:\n%s", appOfApps)
+			}
+		})
+	}
+}
+
 func TestGenerate_WithChecksums(t *testing.T) {
 	g := NewGenerator()
 	ctx := context.Background()
@@ -422,9 +612,9 @@ func TestGetNamespace(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.component, func(t *testing.T) {
 			comp := recipe.ComponentRef{Name: tt.component}
-			ns := getNamespace(comp)
+			ns := ComponentNamespace(comp)
 			if ns != tt.expected {
-				t.Errorf("getNamespace(%s) = %s, want %s", tt.component, ns, tt.expected)
+				t.Errorf("ComponentNamespace(%s) = %s, want %s", tt.component, ns, tt.expected)
 			}
 		})
 	}
@@ -72,5 +72,16 @@ sync-wave annotations starting from 0.
 The RepoURL field in GeneratorInput sets the Git repository URL in the
 app-of-apps.yaml manifest. If not provided, a placeholder URL is used
 that must be updated manually before deployment.
+
+# ApplicationSets
+
+With GeneratorInput.ApplicationSet set, each component directory holds an
+applicationset.yaml instead of application.yaml, and app-of-apps.yaml syncs
+those. Each ApplicationSet generates one Application per cluster, named
+<cluster>-<component>, from a cluster generator selecting the Argo CD
+clusters labeled with ClusterSelector, or from a list generator whose
+elements are edited in place. The Applications read the component
+values.yaml and, when present, clusters/<cluster>/<component>/values.yaml,
+so one GitOps repository serves many clusters with per-cluster overrides.
 */
 package argocd
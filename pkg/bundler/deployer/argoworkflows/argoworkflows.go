@@ -0,0 +1,462 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package argoworkflows provides Argo Workflows install pipeline generation for recipes.
+package argoworkflows
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/component"
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/workflow.yaml.tmpl
+var workflowTemplate string
+
+//go:embed templates/rbac.yaml.tmpl
+var rbacTemplate string
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// DefaultNamespace is the namespace the Workflow is submitted to.
+	DefaultNamespace = "argo"
+
+	// DefaultServiceAccount is the service account the Workflow runs as.
+	DefaultServiceAccount = "eidos-installer"
+
+	// DefaultImage is the container image providing helm, kubectl and jq.
+	DefaultImage = "docker.io/alpine/k8s:1.33.4"
+
+	// defaultComponentNamespace is the default namespace for component deployment.
+	defaultComponentNamespace = "nvidia-system"
+)
+
+// StepData contains data for rendering the install steps of a single component.
+type StepData struct {
+	Name        string
+	ReleaseName string
+	Namespace   string
+	Repository  string
+	ChartRef    string
+	Version     string
+	Values      string
+
+	// CreateNamespace passes --create-namespace to helm. Unset for
+	// namespace-scoped components, whose namespace must already exist.
+	CreateNamespace bool
+}
+
+// WorkflowData contains data for rendering the Workflow manifest.
+type WorkflowData struct {
+	GenerateName     string
+	Namespace        string
+	ServiceAccount   string
+	Image            string
+	BundlerVersion   string
+	InstallTimeout   string
+	ReadinessTimeout string
+	Components       []StepData
+	RollbackOrder    []StepData
+}
+
+// RBACData contains data for rendering the installer RBAC manifest.
+type RBACData struct {
+	Namespace      string
+	ServiceAccount string
+
+	// ClusterScoped binds the service account to cluster-admin. When every
+	// component is namespace-scoped, it is bound to admin in Namespaces only.
+	ClusterScoped bool
+	Namespaces    []string
+}
+
+// ReadmeData contains data for rendering the README.
+type ReadmeData struct {
+	RecipeVersion         string
+	BundlerVersion        string
+	Namespace             string
+	ReadinessTimeout      string
+	Components            []StepData
+	Docs                  []recipe.ComponentDocs
+	CompatibilityWarnings []recipe.ConstraintWarning
+}
+
+// GeneratorInput contains all data needed to generate the install Workflow.
+type GeneratorInput struct {
+	// RecipeResult contains the recipe metadata and component references.
+	RecipeResult *recipe.RecipeResult
+
+	// ComponentValues maps component names to their values.
+	ComponentValues map[string]map[string]any
+
+	// Version is the generator version.
+	Version string
+
+	// Namespace is the namespace the Workflow runs in.
+	// If empty, DefaultNamespace is used.
+	Namespace string
+
+	// Image is the installer container image.
+	// If empty, DefaultImage is used.
+	Image string
+
+	// IncludeChecksums indicates whether to generate a checksums.txt file.
+	IncludeChecksums bool
+
+	// NamespaceScoped marks the component references installed with
+	// namespace scope. If every component is, the installer gets no
+	// cluster-wide RBAC.
+	NamespaceScoped map[string]bool
+}
+
+// GeneratorOutput contains the result of Workflow generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the workflow.
+	Duration time.Duration
+
+	// DeploymentSteps contains ordered deployment instructions for the user.
+	DeploymentSteps []string
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// Generator creates Argo Workflows install pipelines from recipe results.
+type Generator struct{}
+
+// NewGenerator creates a new Argo Workflows generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate creates the install Workflow, RBAC and per-component values from the given input.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	output := &GeneratorOutput{
+		Files: make([]string, 0),
+	}
+
+	if input == nil || input.RecipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and recipe result are required")
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to create output directory", err)
+	}
+
+	namespace := input.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	image := input.Image
+	if image == "" {
+		image = DefaultImage
+	}
+
+	components := sortComponentsByDeploymentOrder(
+		input.RecipeResult.ComponentRefs,
+		input.RecipeResult.DeploymentOrder,
+	)
+
+	steps := make([]StepData, 0, len(components))
+	clusterScoped := false
+	namespaces := []string{namespace}
+	for _, comp := range components {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(errors.ErrCodeInternal, "context cancelled", ctx.Err())
+		default:
+		}
+
+		values := input.ComponentValues[comp.Name]
+		if values == nil {
+			values = make(map[string]any)
+		}
+
+		// Write per-component values for review and reuse outside the workflow
+		componentDir := filepath.Join(outputDir, comp.Name)
+		if err := os.MkdirAll(componentDir, 0755); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to create directory for %s", comp.Name), err)
+		}
+		valuesPath := filepath.Join(componentDir, "values.yaml")
+		valuesContent, err := marshalValues(values)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to marshal values for %s", comp.Name), err)
+		}
+		if err := os.WriteFile(valuesPath, []byte(valuesContent), 0600); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to write values.yaml for %s", comp.Name), err)
+		}
+		output.Files = append(output.Files, valuesPath)
+		output.TotalSize += int64(len(valuesContent))
+
+		namespaceScoped := input.NamespaceScoped[comp.Name]
+		if !namespaceScoped {
+			clusterScoped = true
+		}
+		componentNamespace := ComponentNamespace(comp)
+		namespaces = append(namespaces, componentNamespace)
+
+		repository, chartRef := resolveChartRef(comp)
+		steps = append(steps, StepData{
+			Name:            comp.Name,
+			ReleaseName:     comp.HelmReleaseName(),
+			Namespace:       componentNamespace,
+			Repository:      repository,
+			ChartRef:        chartRef,
+			Version:         comp.Version,
+			Values:          valuesContent,
+			CreateNamespace: !namespaceScoped,
+		})
+	}
+	sort.Strings(namespaces)
+	namespaces = slices.Compact(namespaces)
+
+	rollbackOrder := make([]StepData, len(steps))
+	for i, s := range steps {
+		rollbackOrder[len(steps)-1-i] = s
+	}
+
+	// Generate workflow.yaml
+	workflowData := WorkflowData{
+		GenerateName:     "eidos-install-",
+		Namespace:        namespace,
+		ServiceAccount:   DefaultServiceAccount,
+		Image:            image,
+		BundlerVersion:   input.Version,
+		InstallTimeout:   defaults.DeployerComponentInstallTimeout.String(),
+		ReadinessTimeout: defaults.DeployerReadinessTimeout.String(),
+		Components:       steps,
+		RollbackOrder:    rollbackOrder,
+	}
+	workflowPath := filepath.Join(outputDir, "workflow.yaml")
+	workflowSize, err := g.generateFromTemplate(workflowTemplate, workflowData, workflowPath)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to generate workflow.yaml", err)
+	}
+	output.Files = append(output.Files, workflowPath)
+	output.TotalSize += workflowSize
+
+	// Generate rbac.yaml
+	rbacData := RBACData{
+		Namespace:      namespace,
+		ServiceAccount: DefaultServiceAccount,
+		ClusterScoped:  clusterScoped,
+		Namespaces:     namespaces,
+	}
+	rbacPath := filepath.Join(outputDir, "rbac.yaml")
+	rbacSize, err := g.generateFromTemplate(rbacTemplate, rbacData, rbacPath)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to generate rbac.yaml", err)
+	}
+	output.Files = append(output.Files, rbacPath)
+	output.TotalSize += rbacSize
+
+	// Generate README.md
+	readmeData := ReadmeData{
+		RecipeVersion:         input.RecipeResult.Metadata.Version,
+		BundlerVersion:        input.Version,
+		Namespace:             namespace,
+		ReadinessTimeout:      workflowData.ReadinessTimeout,
+		Components:            steps,
+		Docs:                  input.RecipeResult.ComponentDocs(),
+		CompatibilityWarnings: input.RecipeResult.ComponentConstraintWarnings(),
+	}
+	readmePath := filepath.Join(outputDir, "README.md")
+	readmeSize, err := g.generateFromTemplate(readmeTemplate, readmeData, readmePath)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to generate README.md", err)
+	}
+	output.Files = append(output.Files, readmePath)
+	output.TotalSize += readmeSize
+
+	// Generate checksums if requested
+	if input.IncludeChecksums {
+		if err := checksum.GenerateChecksums(ctx, outputDir, output.Files); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal, "failed to generate checksums", err)
+		}
+		checksumPath := checksum.GetChecksumFilePath(outputDir)
+		checksumInfo, statErr := os.Stat(checksumPath)
+		if statErr != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal, "failed to stat checksums file", statErr)
+		}
+		output.Files = append(output.Files, checksumPath)
+		output.TotalSize += checksumInfo.Size()
+	}
+
+	output.Duration = time.Since(start)
+
+	// Populate deployment steps for CLI output
+	output.DeploymentSteps = []string{
+		fmt.Sprintf("kubectl apply -f %s/rbac.yaml", outputDir),
+		fmt.Sprintf("argo submit -n %s %s/workflow.yaml --watch", namespace, outputDir),
+	}
+	output.DeploymentNotes = []string{
+		fmt.Sprintf("Argo Workflows must be installed in the %s namespace", namespace),
+	}
+	if !clusterScoped {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			fmt.Sprintf("Create namespaces %s before applying rbac.yaml", strings.Join(namespaces, ", ")))
+	}
+
+	slog.Debug("argo workflow generated",
+		"components", len(steps),
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+	)
+
+	return output, nil
+}
+
+// generateFromTemplate renders a template to a file.
+func (g *Generator) generateFromTemplate(tmplContent string, data any, outputPath string) (int64, error) {
+	tmpl, err := component.NewTemplate("template").Funcs(template.FuncMap{
+		"indent": indent,
+	}).Parse(tmplContent)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return 0, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	content := buf.String()
+	if err := os.WriteFile(outputPath, []byte(content), 0600); err != nil {
+		return 0, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return int64(len(content)), nil
+}
+
+// marshalValues renders component values as YAML. Empty values render as "{}"
+// so the inline workflow artifact is always a valid values file.
+func marshalValues(values map[string]any) (string, error) {
+	if len(values) == 0 {
+		return "{}\n", nil
+	}
+	yamlBytes, err := yaml.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(yamlBytes), nil
+}
+
+// indent prefixes every non-empty line of s with the given number of spaces.
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = pad + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// resolveChartRef returns the helm --repo value and chart reference for a component.
+// OCI sources are referenced directly; HTTP repositories use the chart name from
+// the component registry, falling back to the component name.
+func resolveChartRef(comp recipe.ComponentRef) (repository, chartRef string) {
+	chart := comp.ComponentName()
+	if registry, err := recipe.GetComponentRegistry(); err == nil {
+		if config := registry.Get(comp.ComponentName()); config != nil && config.Helm.DefaultChart != "" {
+			chart = config.Helm.DefaultChart
+			if idx := strings.LastIndex(chart, "/"); idx >= 0 {
+				chart = chart[idx+1:]
+			}
+		}
+	}
+
+	if strings.HasPrefix(comp.Source, "oci://") {
+		return "", strings.TrimSuffix(comp.Source, "/") + "/" + chart
+	}
+	return comp.Source, chart
+}
+
+// sortComponentsByDeploymentOrder sorts components based on deployment order.
+func sortComponentsByDeploymentOrder(refs []recipe.ComponentRef, order []string) []recipe.ComponentRef {
+	if len(order) == 0 {
+		return refs
+	}
+
+	orderMap := make(map[string]int, len(order))
+	for i, name := range order {
+		orderMap[name] = i
+	}
+
+	sorted := make([]recipe.ComponentRef, len(refs))
+	copy(sorted, refs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		orderI, okI := orderMap[sorted[i].Name]
+		orderJ, okJ := orderMap[sorted[j].Name]
+
+		if !okI && !okJ {
+			return sorted[i].Name < sorted[j].Name
+		}
+		if !okI {
+			return false
+		}
+		if !okJ {
+			return true
+		}
+		return orderI < orderJ
+	})
+
+	return sorted
+}
+
+// ComponentNamespace returns the namespace a component is installed into.
+func ComponentNamespace(comp recipe.ComponentRef) string {
+	switch comp.ComponentName() {
+	case "gpu-operator":
+		return "gpu-operator"
+	case "network-operator":
+		return "nvidia-network-operator"
+	case "cert-manager":
+		return "cert-manager"
+	default:
+		return defaultComponentNamespace
+	}
+}
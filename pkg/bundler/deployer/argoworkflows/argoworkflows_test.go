@@ -0,0 +1,380 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argoworkflows
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func newTestRecipe() *recipe.RecipeResult {
+	recipeResult := &recipe.RecipeResult{}
+	recipeResult.Metadata.Version = "v1.0.0"
+	recipeResult.ComponentRefs = []recipe.ComponentRef{
+		{
+			Name:    "gpu-operator",
+			Version: "v25.3.3",
+			Type:    "helm",
+			Source:  "https://helm.ngc.nvidia.com/nvidia",
+		},
+		{
+			Name:    "cert-manager",
+			Version: "v1.17.2",
+			Type:    "helm",
+			Source:  "https://charts.jetstack.io",
+		},
+	}
+	recipeResult.DeploymentOrder = []string{"cert-manager", "gpu-operator"}
+	return recipeResult
+}
+
+func TestGenerate_Success(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	input := &GeneratorInput{
+		RecipeResult: newTestRecipe(),
+		ComponentValues: map[string]map[string]any{
+			"gpu-operator": {
+				"driver": map[string]any{
+					"enabled": true,
+				},
+			},
+		},
+		Version:          "v0.9.0",
+		IncludeChecksums: true,
+	}
+
+	output, err := g.Generate(context.Background(), input, outputDir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for _, f := range []string{
+		"workflow.yaml",
+		"rbac.yaml",
+		"README.md",
+		"checksums.txt",
+		"cert-manager/values.yaml",
+		"gpu-operator/values.yaml",
+	} {
+		if _, statErr := os.Stat(filepath.Join(outputDir, f)); statErr != nil {
+			t.Errorf("expected file %s: %v", f, statErr)
+		}
+	}
+
+	if len(output.Files) != 6 {
+		t.Errorf("got %d files, want 6", len(output.Files))
+	}
+	if output.TotalSize == 0 {
+		t.Error("TotalSize should be > 0")
+	}
+	if len(output.DeploymentSteps) == 0 {
+		t.Error("DeploymentSteps should not be empty")
+	}
+}
+
+func TestGenerate_Workflow(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	input := &GeneratorInput{
+		RecipeResult: newTestRecipe(),
+		ComponentValues: map[string]map[string]any{
+			"gpu-operator": {
+				"driver": map[string]any{
+					"version": "580.82.07",
+				},
+			},
+		},
+		Version: "v0.9.0",
+	}
+
+	if _, err := g.Generate(context.Background(), input, outputDir); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "workflow.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read workflow.yaml: %v", err)
+	}
+
+	var wf struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Namespace string `yaml:"namespace"`
+		} `yaml:"metadata"`
+		Spec struct {
+			Entrypoint string `yaml:"entrypoint"`
+			OnExit     string `yaml:"onExit"`
+			Templates  []struct {
+				Name  string `yaml:"name"`
+				Steps [][]struct {
+					Name     string `yaml:"name"`
+					Template string `yaml:"template"`
+					When     string `yaml:"when"`
+				} `yaml:"steps"`
+				Inputs struct {
+					Artifacts []struct {
+						Raw struct {
+							Data string `yaml:"data"`
+						} `yaml:"raw"`
+					} `yaml:"artifacts"`
+				} `yaml:"inputs"`
+				Container struct {
+					Args []string `yaml:"args"`
+				} `yaml:"container"`
+			} `yaml:"templates"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal(content, &wf); err != nil {
+		t.Fatalf("workflow.yaml is not valid YAML: %v\n%s", err, content)
+	}
+
+	if wf.Kind != "Workflow" {
+		t.Errorf("kind = %q, want Workflow", wf.Kind)
+	}
+	if wf.Metadata.Namespace != DefaultNamespace {
+		t.Errorf("namespace = %q, want %q", wf.Metadata.Namespace, DefaultNamespace)
+	}
+	if wf.Spec.OnExit != "exit-handler" {
+		t.Errorf("onExit = %q, want exit-handler", wf.Spec.OnExit)
+	}
+
+	templates := make(map[string]int)
+	for i, tmpl := range wf.Spec.Templates {
+		templates[tmpl.Name] = i
+	}
+	for _, name := range []string{"install", "exit-handler", "rollback", "wait-ready", "install-cert-manager", "install-gpu-operator"} {
+		if _, ok := templates[name]; !ok {
+			t.Errorf("missing template %q", name)
+		}
+	}
+
+	// Steps follow deployment order with a readiness gate after each install
+	install := wf.Spec.Templates[templates["install"]]
+	var stepNames []string
+	for _, group := range install.Steps {
+		for _, s := range group {
+			stepNames = append(stepNames, s.Name)
+		}
+	}
+	wantSteps := []string{"install-cert-manager", "ready-cert-manager", "install-gpu-operator", "ready-gpu-operator"}
+	if strings.Join(stepNames, ",") != strings.Join(wantSteps, ",") {
+		t.Errorf("install steps = %v, want %v", stepNames, wantSteps)
+	}
+
+	exit := wf.Spec.Templates[templates["exit-handler"]]
+	if len(exit.Steps) == 0 || exit.Steps[0][0].When != "{{workflow.status}} != Succeeded" {
+		t.Errorf("exit handler should only roll back on failure, got %+v", exit.Steps)
+	}
+
+	gpuOp := wf.Spec.Templates[templates["install-gpu-operator"]]
+	args := strings.Join(gpuOp.Container.Args, " ")
+	for _, want := range []string{"upgrade --install gpu-operator gpu-operator", "--repo https://helm.ngc.nvidia.com/nvidia", "--version v25.3.3", "--namespace gpu-operator", "--atomic"} {
+		if !strings.Contains(args, want) {
+			t.Errorf("install args %q missing %q", args, want)
+		}
+	}
+	if len(gpuOp.Inputs.Artifacts) != 1 || !strings.Contains(gpuOp.Inputs.Artifacts[0].Raw.Data, "580.82.07") {
+		t.Errorf("install-gpu-operator should inline values, got %+v", gpuOp.Inputs.Artifacts)
+	}
+
+	// Rollback runs in reverse deployment order
+	rollbackIdx := strings.Index(string(content), "rollback gpu-operator gpu-operator")
+	certIdx := strings.Index(string(content), "rollback cert-manager cert-manager")
+	if rollbackIdx < 0 || certIdx < 0 || rollbackIdx > certIdx {
+		t.Errorf("rollback should run in reverse deployment order")
+	}
+}
+
+func TestGenerate_CustomNamespaceAndImage(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	input := &GeneratorInput{
+		RecipeResult: newTestRecipe(),
+		Namespace:    "workflows",
+		Image:        "registry.example.com/installer:1.0",
+	}
+
+	output, err := g.Generate(context.Background(), input, outputDir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "workflow.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read workflow.yaml: %v", err)
+	}
+	if !strings.Contains(string(content), "namespace: workflows") {
+		t.Error("workflow.yaml should use custom namespace")
+	}
+	if !strings.Contains(string(content), "image: registry.example.com/installer:1.0") {
+		t.Error("workflow.yaml should use custom image")
+	}
+	if !strings.Contains(strings.Join(output.DeploymentSteps, "\n"), "argo submit -n workflows") {
+		t.Errorf("deployment steps should reference custom namespace: %v", output.DeploymentSteps)
+	}
+}
+
+func TestGenerate_NamespaceScoped(t *testing.T) {
+	tests := []struct {
+		name            string
+		namespaceScoped map[string]bool
+		wantCluster     bool
+		wantBindings    []string
+	}{
+		{
+			name:        "cluster scoped",
+			wantCluster: true,
+		},
+		{
+			name:            "mixed scopes",
+			namespaceScoped: map[string]bool{"cert-manager": true},
+			wantCluster:     true,
+		},
+		{
+			name:            "all namespace scoped",
+			namespaceScoped: map[string]bool{"cert-manager": true, "gpu-operator": true},
+			wantBindings:    []string{"argo", "cert-manager", "gpu-operator"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			input := &GeneratorInput{
+				RecipeResult:    newTestRecipe(),
+				NamespaceScoped: tt.namespaceScoped,
+			}
+			if _, err := NewGenerator().Generate(context.Background(), input, outputDir); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			content, err := os.ReadFile(filepath.Join(outputDir, "rbac.yaml"))
+			if err != nil {
+				t.Fatalf("failed to read rbac.yaml: %v", err)
+			}
+			var clusterBinding bool
+			var bindings []string
+			dec := yaml.NewDecoder(strings.NewReader(string(content)))
+			for {
+				var doc map[string]any
+				if err := dec.Decode(&doc); err != nil {
+					break
+				}
+				meta, _ := doc["metadata"].(map[string]any)
+				switch doc["kind"] {
+				case "ClusterRoleBinding":
+					clusterBinding = true
+				case "RoleBinding":
+					bindings = append(bindings, meta["namespace"].(string))
+				}
+			}
+			if clusterBinding != tt.wantCluster {
+				t.Errorf("ClusterRoleBinding = %v, want %v", clusterBinding, tt.wantCluster)
+			}
+			if strings.Join(bindings, ",") != strings.Join(tt.wantBindings, ",") {
+				t.Errorf("RoleBinding namespaces = %v, want %v", bindings, tt.wantBindings)
+			}
+
+			workflow, err := os.ReadFile(filepath.Join(outputDir, "workflow.yaml"))
+			if err != nil {
+				t.Fatalf("failed to read workflow.yaml: %v", err)
+			}
+			want := 2 - len(tt.namespaceScoped)
+			if got := strings.Count(string(workflow), "--create-namespace"); got != want {
+				t.Errorf("--create-namespace count = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestGenerate_InvalidInput(t *testing.T) {
+	g := NewGenerator()
+
+	if _, err := g.Generate(context.Background(), nil, t.TempDir()); err == nil {
+		t.Error("expected error for nil input")
+	}
+	if _, err := g.Generate(context.Background(), &GeneratorInput{}, t.TempDir()); err == nil {
+		t.Error("expected error for nil recipe result")
+	}
+}
+
+func TestGenerate_ContextCancelled(t *testing.T) {
+	g := NewGenerator()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := g.Generate(ctx, &GeneratorInput{RecipeResult: newTestRecipe()}, t.TempDir()); err == nil {
+		t.Error("expected error for cancelled context")
+	}
+}
+
+func TestResolveChartRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		comp     recipe.ComponentRef
+		wantRepo string
+		wantRef  string
+	}{
+		{
+			name:     "registry chart name",
+			comp:     recipe.ComponentRef{Name: "prometheus", Source: "https://prometheus-community.github.io/helm-charts"},
+			wantRepo: "https://prometheus-community.github.io/helm-charts",
+			wantRef:  "kube-prometheus-stack",
+		},
+		{
+			name:     "unknown component",
+			comp:     recipe.ComponentRef{Name: "custom", Source: "https://charts.example.com"},
+			wantRepo: "https://charts.example.com",
+			wantRef:  "custom",
+		},
+		{
+			name:     "oci source",
+			comp:     recipe.ComponentRef{Name: "custom", Source: "oci://registry.example.com/charts/"},
+			wantRepo: "",
+			wantRef:  "oci://registry.example.com/charts/custom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, ref := resolveChartRef(tt.comp)
+			if repo != tt.wantRepo {
+				t.Errorf("repository = %q, want %q", repo, tt.wantRepo)
+			}
+			if ref != tt.wantRef {
+				t.Errorf("chartRef = %q, want %q", ref, tt.wantRef)
+			}
+		})
+	}
+}
+
+func TestIndent(t *testing.T) {
+	got := indent(2, "a: 1\n\nb:\n  c: 2\n")
+	want := "  a: 1\n\n  b:\n    c: 2"
+	if got != want {
+		t.Errorf("indent() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package argoworkflows provides Argo Workflows install pipeline generation for Cloud Native Stack recipes.
+
+The argoworkflows package generates an Argo Workflow from a RecipeResult for users
+who orchestrate installs imperatively rather than through GitOps.
+
+# Overview
+
+The generated bundle contains:
+  - workflow.yaml: a Workflow that installs each component with helm
+  - rbac.yaml: the installer ServiceAccount and ClusterRoleBinding
+  - <component>/values.yaml: the values used for each component
+  - README with deployment instructions
+
+# Deployment Ordering
+
+Components are installed sequentially following the recipe's DeploymentOrder.
+Each install step uses `helm upgrade --install --atomic --wait` and is followed
+by a readiness gate that waits for all Deployments, DaemonSets and StatefulSets
+in the component namespace to finish rolling out.
+
+# Rollback
+
+The Workflow registers an exit handler that runs when the workflow does not
+succeed. It walks components in reverse deployment order and reverts only the
+releases whose latest revision was written by the failed run: upgraded releases
+are rolled back to their previous revision and newly created ones are uninstalled.
+
+# Usage
+
+	generator := argoworkflows.NewGenerator()
+
+	input := &argoworkflows.GeneratorInput{
+		RecipeResult:     recipeResult,
+		ComponentValues:  componentValues,
+		Version:          "v0.9.0",
+		IncludeChecksums: true,
+	}
+
+	output, err := generator.Generate(ctx, input, "/path/to/output")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+# Generated Structure
+
+	output/
+	├── workflow.yaml              # Install pipeline
+	├── rbac.yaml                  # Installer service account
+	├── README.md                  # Deployment instructions
+	├── checksums.txt              # SHA256 checksums (optional)
+	├── cert-manager/
+	│   └── values.yaml
+	└── gpu-operator/
+	    └── values.yaml
+*/
+package argoworkflows
@@ -19,6 +19,7 @@
 //   - Chart.yaml with component dependencies
 //   - Combined values.yaml for all components
 //   - README.md with deployment instructions
+//   - install.sh installing the chart in phases (see InstallPhases)
 //   - checksums.txt for verification (optional)
 //
 // Usage:
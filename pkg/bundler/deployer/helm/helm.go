@@ -23,12 +23,12 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
-	"text/template"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/component"
 	"github.com/NVIDIA/eidos/pkg/errors"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 )
@@ -39,9 +39,25 @@ var chartTemplate string
 //go:embed templates/README.md.tmpl
 var readmeTemplate string
 
+//go:embed templates/install.sh.tmpl
+var installTemplate string
+
+// InstallScript is the file name of the phased install script.
+const InstallScript = "install.sh"
+
+// defaultPhaseTimeout is how long the install script waits for each phase.
+const defaultPhaseTimeout = "15m"
+
 // criteriaAny is the wildcard value for criteria fields.
 const criteriaAny = "any"
 
+// ReleaseName is the release name the umbrella chart is installed as.
+const ReleaseName = "eidos-stack"
+
+// ReleaseNamespace is the namespace the umbrella chart is installed into.
+// Subcharts are installed into the release namespace.
+const ReleaseNamespace = "eidos-stack"
+
 // ChartMetadata represents the metadata for an umbrella Helm chart.
 type ChartMetadata struct {
 	APIVersion   string       `yaml:"apiVersion"`
@@ -58,6 +74,7 @@ type Dependency struct {
 	Name       string `yaml:"name"`
 	Version    string `yaml:"version"`
 	Repository string `yaml:"repository"`
+	Alias      string `yaml:"alias,omitempty"`
 	Condition  string `yaml:"condition,omitempty"`
 }
 
@@ -79,6 +96,11 @@ type GeneratorInput struct {
 	// ManifestContents maps manifest file paths to their contents.
 	// These are copied to the chart's templates/ directory.
 	ManifestContents map[string][]byte
+
+	// ManifestValues holds, per component, the values only its manifests
+	// read. They are written under manifests.<component> in values.yaml
+	// rather than the component key, so the subchart never receives them.
+	ManifestValues map[string]map[string]any
 }
 
 // GeneratorOutput contains the result of umbrella chart generation.
@@ -140,8 +162,19 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 	output.Files = append(output.Files, valuesPath)
 	output.TotalSize += valuesSize
 
+	phases := InstallPhases(input.RecipeResult, len(input.ManifestContents) > 0)
+
+	// Generate install.sh
+	installPath, installSize, err := g.generateInstallScript(ctx, input, phases, outputDir)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to generate install.sh", err)
+	}
+	output.Files = append(output.Files, installPath)
+	output.TotalSize += installSize
+
 	// Generate README.md
-	readmePath, readmeSize, err := g.generateREADME(ctx, input, outputDir)
+	readmePath, readmeSize, err := g.generateREADME(ctx, input, phases, outputDir)
 	if err != nil {
 		return nil, errors.Wrap(errors.ErrCodeInternal,
 			"failed to generate README.md", err)
@@ -178,7 +211,7 @@ func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputD
 	output.DeploymentSteps = []string{
 		fmt.Sprintf("cd %s", outputDir),
 		"helm dependency update",
-		"helm install eidos-stack . -n eidos-stack --create-namespace",
+		fmt.Sprintf("./%s (installs in %d phases; see README.md)", InstallScript, len(phases)),
 	}
 
 	slog.Debug("umbrella chart generated",
@@ -206,39 +239,21 @@ func (g *Generator) generateChartYAML(ctx context.Context, input *GeneratorInput
 	}
 
 	// Add dependencies in deployment order
+	added := make(map[string]bool, len(componentMap))
 	for _, name := range input.RecipeResult.DeploymentOrder {
 		ref, ok := componentMap[name]
 		if !ok {
 			continue
 		}
-		dep := Dependency{
-			Name:       resolveChartName(ref.Name),
-			Version:    ref.Version,
-			Repository: ref.Source,
-		}
-		// Add condition for optional enabling/disabling
-		// Use component name (not chart name) for condition to match values.yaml structure
-		dep.Condition = fmt.Sprintf("%s.enabled", ref.Name)
-		deps = append(deps, dep)
+		deps = append(deps, newDependency(ref))
+		added[ref.Name] = true
 	}
 
 	// Add any components not in deployment order (shouldn't happen, but be safe)
 	for _, ref := range input.RecipeResult.ComponentRefs {
-		chartName := resolveChartName(ref.Name)
-		found := false
-		for _, d := range deps {
-			if d.Name == chartName {
-				found = true
-				break
-			}
-		}
-		if !found {
-			deps = append(deps, Dependency{
-				Name:       chartName,
-				Version:    ref.Version,
-				Repository: ref.Source,
-				Condition:  fmt.Sprintf("%s.enabled", ref.Name),
-			})
+		if !added[ref.Name] {
+			deps = append(deps, newDependency(ref))
+			added[ref.Name] = true
 		}
 	}
 
@@ -273,7 +288,7 @@ func (g *Generator) generateChartYAML(ctx context.Context, input *GeneratorInput
 	}
 
 	// Render template
-	tmpl, err := template.New("Chart.yaml").Parse(chartTemplate)
+	tmpl, err := component.NewTemplate("Chart.yaml").Parse(chartTemplate)
 	if err != nil {
 		return "", 0, errors.Wrap(errors.ErrCodeInternal, "failed to parse Chart.yaml template", err)
 	}
@@ -329,6 +344,14 @@ func (g *Generator) generateValuesYAML(ctx context.Context, input *GeneratorInpu
 		}
 	}
 
+	if len(input.ManifestContents) > 0 {
+		manifests := map[string]any{"enabled": true}
+		for name, v := range input.ManifestValues {
+			manifests[name] = v
+		}
+		values[ManifestsValue] = manifests
+	}
+
 	// Generate YAML with header comment
 	header := fmt.Sprintf(`# Cloud Native Stack - Helm Umbrella Chart Values
 # Recipe Version: %s
@@ -336,7 +359,9 @@ func (g *Generator) generateValuesYAML(ctx context.Context, input *GeneratorInpu
 #
 # This file contains configuration for all sub-charts.
 # Each top-level key corresponds to a dependency in Chart.yaml.
-# Set <component>.enabled=false to skip installing a component.
+# Set <component>.enabled=false to skip installing a component, and
+# manifests.enabled=false to skip the manifests in templates/.
+# manifests.<component> holds settings only those manifests read.
 `, input.RecipeResult.Metadata.Version, input.Version)
 
 	yamlBytes, err := yaml.Marshal(values)
@@ -356,7 +381,7 @@ func (g *Generator) generateValuesYAML(ctx context.Context, input *GeneratorInpu
 }
 
 // generateREADME creates the README.md file with deployment instructions.
-func (g *Generator) generateREADME(ctx context.Context, input *GeneratorInput, outputDir string) (string, int64, error) {
+func (g *Generator) generateREADME(ctx context.Context, input *GeneratorInput, phases []Phase, outputDir string) (string, int64, error) {
 	if err := ctx.Err(); err != nil {
 		return "", 0, err
 	}
@@ -406,23 +431,31 @@ func (g *Generator) generateREADME(ctx context.Context, input *GeneratorInput, o
 	constraints := input.RecipeResult.Constraints
 
 	data := struct {
-		RecipeVersion  string
-		BundlerVersion string
-		Components     []ComponentInfo
-		Criteria       []string
-		Constraints    []recipe.Constraint
-		ChartName      string
+		RecipeVersion         string
+		BundlerVersion        string
+		Components            []ComponentInfo
+		Criteria              []string
+		Constraints           []recipe.Constraint
+		Docs                  []recipe.ComponentDocs
+		CompatibilityWarnings []recipe.ConstraintWarning
+		ChartName             string
+		Phases                []Phase
+		InstallScript         string
 	}{
-		RecipeVersion:  input.RecipeResult.Metadata.Version,
-		BundlerVersion: input.Version,
-		Components:     components,
-		Criteria:       criteriaLines,
-		Constraints:    constraints,
-		ChartName:      "eidos-stack",
+		RecipeVersion:         input.RecipeResult.Metadata.Version,
+		BundlerVersion:        input.Version,
+		Components:            components,
+		Criteria:              criteriaLines,
+		Constraints:           constraints,
+		Docs:                  input.RecipeResult.ComponentDocs(),
+		CompatibilityWarnings: input.RecipeResult.ComponentConstraintWarnings(),
+		ChartName:             "eidos-stack",
+		Phases:                phases,
+		InstallScript:         InstallScript,
 	}
 
 	// Render template
-	tmpl, err := template.New("README.md").Parse(readmeTemplate)
+	tmpl, err := component.NewTemplate("README.md").Parse(readmeTemplate)
 	if err != nil {
 		return "", 0, errors.Wrap(errors.ErrCodeInternal, "failed to parse README.md template", err)
 	}
@@ -443,6 +476,69 @@ func (g *Generator) generateREADME(ctx context.Context, input *GeneratorInput, o
 	return readmePath, int64(len(content)), nil
 }
 
+// generateInstallScript creates install.sh, which installs the chart in
+// phases.
+func (g *Generator) generateInstallScript(ctx context.Context, input *GeneratorInput, phases []Phase, outputDir string) (string, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return "", 0, err
+	}
+
+	data := struct {
+		RecipeVersion    string
+		BundlerVersion   string
+		ReleaseName      string
+		ReleaseNamespace string
+		DefaultTimeout   string
+		ManifestsValue   string
+		Phases           []Phase
+	}{
+		RecipeVersion:    input.RecipeResult.Metadata.Version,
+		BundlerVersion:   input.Version,
+		ReleaseName:      ReleaseName,
+		ReleaseNamespace: ReleaseNamespace,
+		DefaultTimeout:   defaultPhaseTimeout,
+		ManifestsValue:   ManifestsValue,
+		Phases:           phases,
+	}
+
+	tmpl, err := component.NewTemplate(InstallScript).Parse(installTemplate)
+	if err != nil {
+		return "", 0, errors.Wrap(errors.ErrCodeInternal, "failed to parse install.sh template", err)
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", 0, errors.Wrap(errors.ErrCodeInternal, "failed to render install.sh", err)
+	}
+
+	installPath := filepath.Join(outputDir, InstallScript)
+	content := buf.String()
+
+	if err := os.WriteFile(installPath, []byte(content), 0755); err != nil { //nolint:gosec // the script is meant to be executed
+		return "", 0, errors.Wrap(errors.ErrCodeInternal, "failed to write install.sh", err)
+	}
+
+	return installPath, int64(len(content)), nil
+}
+
+// newDependency builds the umbrella chart dependency for a component.
+// The subchart is aliased to the component name whenever the chart name
+// differs, so values.yaml keys and enable conditions always use the component
+// name and several instances of the same chart can coexist.
+func newDependency(ref recipe.ComponentRef) Dependency {
+	dep := Dependency{
+		Name:       ResolveChartName(ref.ComponentName()),
+		Version:    ref.Version,
+		Repository: ref.Source,
+		// Use component name (not chart name) for condition to match values.yaml structure
+		Condition: fmt.Sprintf("%s.enabled", ref.Name),
+	}
+	if dep.Name != ref.Name {
+		dep.Alias = ref.Name
+	}
+	return dep
+}
+
 // normalizeVersion ensures version string is valid for Helm (semver without 'v' prefix for chart version)
 func normalizeVersion(v string) string {
 	// Remove 'v' prefix if present for chart version
@@ -454,11 +550,11 @@ func normalizeVersion(v string) string {
 	return v
 }
 
-// resolveChartName returns the Helm chart name for a component.
+// ResolveChartName returns the Helm chart name for a component.
 // It looks up the component in the registry and extracts the chart name from DefaultChart.
 // The chart name is the part after the last "/" in DefaultChart (e.g., "prometheus-community/kube-prometheus-stack" -> "kube-prometheus-stack").
 // Falls back to the component name if not found in registry or no DefaultChart is set.
-func resolveChartName(componentName string) string {
+func ResolveChartName(componentName string) string {
 	registry, err := recipe.GetComponentRegistry()
 	if err != nil {
 		return componentName
@@ -527,6 +623,10 @@ func (g *Generator) generateTemplates(ctx context.Context, input *GeneratorInput
 		filename := filepath.Base(path)
 		outputPath := filepath.Join(templatesDir, filename)
 
+		// Manifests are held back until the last install phase, once the
+		// CRDs and webhooks they need are installed.
+		content = wrapManifest(content)
+
 		if err := os.WriteFile(outputPath, content, 0600); err != nil {
 			return nil, 0, errors.WrapWithContext(errors.ErrCodeInternal, "failed to write template", err,
 				map[string]any{"filename": filename})
@@ -538,3 +638,16 @@ func (g *Generator) generateTemplates(ctx context.Context, input *GeneratorInput
 
 	return files, totalSize, nil
 }
+
+// wrapManifest renders a manifest template only when the manifests value is
+// enabled.
+func wrapManifest(content []byte) []byte {
+	wrapped := make([]byte, 0, len(content)+96)
+	wrapped = append(wrapped, "{{- if .Values."+ManifestsValue+".enabled }}\n"...)
+	wrapped = append(wrapped, content...)
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		wrapped = append(wrapped, '\n')
+	}
+	wrapped = append(wrapped, "{{- end }}\n"...)
+	return wrapped
+}
@@ -57,12 +57,12 @@ func TestGenerate_Success(t *testing.T) {
 	}
 
 	// Verify output
-	if len(output.Files) != 3 {
-		t.Errorf("expected 3 files, got %d", len(output.Files))
+	if len(output.Files) != 4 {
+		t.Errorf("expected 4 files, got %d", len(output.Files))
 	}
 
 	// Check files exist
-	expectedFiles := []string{"Chart.yaml", "values.yaml", "README.md"}
+	expectedFiles := []string{"Chart.yaml", "values.yaml", "README.md", "install.sh"}
 	for _, f := range expectedFiles {
 		path := filepath.Join(outputDir, f)
 		if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
@@ -159,9 +159,9 @@ func TestGenerate_WithChecksums(t *testing.T) {
 		t.Fatalf("Generate failed: %v", err)
 	}
 
-	// Should have 4 files: Chart.yaml, values.yaml, README.md, checksums.txt
-	if len(output.Files) != 4 {
-		t.Errorf("expected 4 files, got %d", len(output.Files))
+	// Should have 5 files: Chart.yaml, values.yaml, README.md, install.sh, checksums.txt
+	if len(output.Files) != 5 {
+		t.Errorf("expected 5 files, got %d", len(output.Files))
 	}
 
 	// Check checksums.txt exists
@@ -258,9 +258,9 @@ func TestResolveChartName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := resolveChartName(tt.componentName)
+			result := ResolveChartName(tt.componentName)
 			if result != tt.expected {
-				t.Errorf("resolveChartName(%q) = %q, want %q", tt.componentName, result, tt.expected)
+				t.Errorf("ResolveChartName(%q) = %q, want %q", tt.componentName, result, tt.expected)
 			}
 		})
 	}
@@ -289,12 +289,7 @@ func createTestRecipeResult() *recipe.RecipeResult {
 	return &recipe.RecipeResult{
 		Kind:       "RecipeResult",
 		APIVersion: "eidos.nvidia.com/v1alpha1",
-		Metadata: struct {
-			Version            string                     `json:"version,omitempty" yaml:"version,omitempty"`
-			AppliedOverlays    []string                   `json:"appliedOverlays,omitempty" yaml:"appliedOverlays,omitempty"`
-			ExcludedOverlays   []string                   `json:"excludedOverlays,omitempty" yaml:"excludedOverlays,omitempty"`
-			ConstraintWarnings []recipe.ConstraintWarning `json:"constraintWarnings,omitempty" yaml:"constraintWarnings,omitempty"`
-		}{
+		Metadata: recipe.RecipeResultMetadata{
 			Version: "v0.1.0",
 		},
 		Criteria: &recipe.Criteria{
@@ -322,12 +317,7 @@ func createEmptyRecipeResult() *recipe.RecipeResult {
 	return &recipe.RecipeResult{
 		Kind:       "RecipeResult",
 		APIVersion: "eidos.nvidia.com/v1alpha1",
-		Metadata: struct {
-			Version            string                     `json:"version,omitempty" yaml:"version,omitempty"`
-			AppliedOverlays    []string                   `json:"appliedOverlays,omitempty" yaml:"appliedOverlays,omitempty"`
-			ExcludedOverlays   []string                   `json:"excludedOverlays,omitempty" yaml:"excludedOverlays,omitempty"`
-			ConstraintWarnings []recipe.ConstraintWarning `json:"constraintWarnings,omitempty" yaml:"constraintWarnings,omitempty"`
-		}{
+		Metadata: recipe.RecipeResultMetadata{
 			Version: "v0.1.0",
 		},
 		ComponentRefs:   []recipe.ComponentRef{},
@@ -0,0 +1,114 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// ManifestsValue is the umbrella chart value that enables the manifests in
+// its templates directory.
+const ManifestsValue = "manifests"
+
+// Phase is one install of the umbrella chart. Installing the chart in phases
+// makes the CRDs and webhooks of each component available before the
+// components and manifests that use them are installed.
+type Phase struct {
+	// Components are the components first installed in this phase.
+	// Empty for the manifests phase.
+	Components []string
+
+	// Disabled are the components of later phases, disabled in this phase.
+	Disabled []string
+
+	// ManifestsDisabled is true when the manifests are left out of this
+	// phase because a later phase installs them.
+	ManifestsDisabled bool
+}
+
+// Values returns the values overriding the chart values.yaml for the phase.
+func (p Phase) Values() map[string]any {
+	values := make(map[string]any, len(p.Disabled)+1)
+	for _, name := range p.Disabled {
+		values[name] = map[string]any{"enabled": false}
+	}
+	if p.ManifestsDisabled {
+		values[ManifestsValue] = map[string]any{"enabled": false}
+	}
+	return values
+}
+
+// InstallPhases returns the phases the umbrella chart of recipeResult is
+// installed in. Components are grouped by dependency depth: components
+// without dependencies are installed first, components that only depend on
+// them next, and so on, keeping the deployment order within a phase. With
+// manifests, a last phase installs them once every component is ready.
+// Dependencies that are not part of the recipe are ignored.
+func InstallPhases(recipeResult *recipe.RecipeResult, manifests bool) []Phase {
+	refs := make(map[string]recipe.ComponentRef, len(recipeResult.ComponentRefs))
+	order := make([]string, 0, len(recipeResult.ComponentRefs))
+	for _, name := range recipeResult.DeploymentOrder {
+		if _, ok := refs[name]; ok {
+			continue
+		}
+		for _, ref := range recipeResult.ComponentRefs {
+			if ref.Name == name {
+				refs[name] = ref
+				order = append(order, name)
+				break
+			}
+		}
+	}
+	// Components missing from the deployment order come last.
+	for _, ref := range recipeResult.ComponentRefs {
+		if _, ok := refs[ref.Name]; !ok {
+			refs[ref.Name] = ref
+			order = append(order, ref.Name)
+		}
+	}
+
+	depth := make(map[string]int, len(order))
+	var groups [][]string
+	for _, name := range order {
+		phase := 0
+		for _, dep := range refs[name].DependencyRefs {
+			if depPhase, ok := depth[dep]; ok {
+				phase = max(phase, depPhase+1)
+			}
+		}
+		depth[name] = phase
+		for len(groups) <= phase {
+			groups = append(groups, nil)
+		}
+		groups[phase] = append(groups[phase], name)
+	}
+
+	phases := make([]Phase, 0, len(groups)+1)
+	for i, components := range groups {
+		var disabled []string
+		for _, later := range groups[i+1:] {
+			disabled = append(disabled, later...)
+		}
+		phases = append(phases, Phase{
+			Components:        components,
+			Disabled:          disabled,
+			ManifestsDisabled: manifests,
+		})
+	}
+	if manifests {
+		phases = append(phases, Phase{})
+	}
+	return phases
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestInstallPhases(t *testing.T) {
+	rec := &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "cert-manager"},
+			{Name: "prometheus"},
+			{Name: "gpu-operator", DependencyRefs: []string{"cert-manager"}},
+			{Name: "prometheus-adapter", DependencyRefs: []string{"prometheus", "gpu-operator"}},
+			{Name: "network-operator", DependencyRefs: []string{"cert-manager", "not-in-recipe"}},
+			{Name: "extra"},
+		},
+		DeploymentOrder: []string{"cert-manager", "prometheus", "gpu-operator", "network-operator", "prometheus-adapter"},
+	}
+
+	t.Run("with manifests", func(t *testing.T) {
+		phases := InstallPhases(rec, true)
+		want := []Phase{
+			{
+				Components:        []string{"cert-manager", "prometheus", "extra"},
+				Disabled:          []string{"gpu-operator", "network-operator", "prometheus-adapter"},
+				ManifestsDisabled: true,
+			},
+			{
+				Components:        []string{"gpu-operator", "network-operator"},
+				Disabled:          []string{"prometheus-adapter"},
+				ManifestsDisabled: true,
+			},
+			{
+				Components:        []string{"prometheus-adapter"},
+				ManifestsDisabled: true,
+			},
+			{},
+		}
+		if !reflect.DeepEqual(phases, want) {
+			t.Errorf("InstallPhases() = %+v, want %+v", phases, want)
+		}
+
+		values := phases[1].Values()
+		wantValues := map[string]any{
+			"prometheus-adapter": map[string]any{"enabled": false},
+			ManifestsValue:       map[string]any{"enabled": false},
+		}
+		if !reflect.DeepEqual(values, wantValues) {
+			t.Errorf("Values() = %v, want %v", values, wantValues)
+		}
+		if values := phases[3].Values(); len(values) != 0 {
+			t.Errorf("last phase Values() = %v, want none", values)
+		}
+	})
+
+	t.Run("without manifests", func(t *testing.T) {
+		phases := InstallPhases(rec, false)
+		if len(phases) != 3 {
+			t.Fatalf("got %d phases, want 3", len(phases))
+		}
+		if last := phases[2]; len(last.Values()) != 0 {
+			t.Errorf("last phase Values() = %v, want none", last.Values())
+		}
+	})
+}
+
+func TestGenerate_InstallScript(t *testing.T) {
+	rec := createTestRecipeResult()
+	rec.ComponentRefs[1].DependencyRefs = []string{"cert-manager"}
+	outputDir := t.TempDir()
+
+	input := &GeneratorInput{
+		RecipeResult:     rec,
+		Version:          "v1.0.0",
+		ManifestContents: map[string][]byte{"components/cert-manager/manifests/cluster-issuer.yaml": []byte("kind: ClusterIssuer\n")},
+	}
+	output, err := NewGenerator().Generate(context.Background(), input, outputDir)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	path := filepath.Join(outputDir, InstallScript)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("install.sh not generated: %v", err)
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		t.Errorf("install.sh mode = %v, want executable", info.Mode())
+	}
+
+	script, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`phase "Phase 1/3: cert-manager" "$@" \` + "\n  --set gpu-operator.enabled=false \\\n  --set manifests.enabled=false\n",
+		`phase "Phase 2/3: gpu-operator" "$@" \` + "\n  --set manifests.enabled=false\n",
+		`phase "Phase 3/3: manifests" "$@"` + "\n",
+	} {
+		if !strings.Contains(string(script), want) {
+			t.Errorf("install.sh missing %q:\n%s", want, script)
+		}
+	}
+
+	values, err := os.ReadFile(filepath.Join(outputDir, "values.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(values), "manifests:\n    enabled: true\n") {
+		t.Errorf("values.yaml does not enable manifests:\n%s", values)
+	}
+
+	readme, err := os.ReadFile(filepath.Join(outputDir, "README.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(readme), "| 2 | gpu-operator | `--set manifests.enabled=false` |") {
+		t.Errorf("README.md missing install phases:\n%s", readme)
+	}
+
+	if last := output.DeploymentSteps[len(output.DeploymentSteps)-1]; !strings.HasPrefix(last, "./install.sh") {
+		t.Errorf("last deployment step = %q, want ./install.sh", last)
+	}
+}
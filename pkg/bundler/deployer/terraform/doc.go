@@ -0,0 +1,76 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package terraform provides Terraform module generation for Cloud Native Stack recipes.
+
+The terraform package generates a Terraform module from a RecipeResult for
+infrastructure teams that manage clusters with Terraform or OpenTofu.
+
+# Overview
+
+The generated bundle contains:
+  - versions.tf: the Terraform and Helm provider requirements
+  - main.tf: a helm_release resource per component
+  - variables.tf: node selector and toleration variables
+  - outputs.tf: the installed releases
+  - <component>/values.yaml: the values used for each component
+  - README with deployment instructions
+
+The module does not configure the Helm provider; the calling root module does.
+
+# Deployment Ordering
+
+Each helm_release depends on the release of the component before it in the
+recipe's DeploymentOrder, so Terraform installs components one at a time.
+Releases are atomic and wait for their resources to become ready.
+
+# Node Scheduling
+
+The system and accelerated node selector and toleration variables default to
+the values the bundle was generated with. A non-empty variable is passed to
+every release as an extra values document at each node selector or toleration
+path the component registry lists for the component.
+
+# Usage
+
+	generator := terraform.NewGenerator()
+
+	input := &terraform.GeneratorInput{
+		RecipeResult:     recipeResult,
+		ComponentValues:  componentValues,
+		Version:          "v0.9.0",
+		IncludeChecksums: true,
+	}
+
+	output, err := generator.Generate(ctx, input, "/path/to/output")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+# Generated Structure
+
+	output/
+	├── versions.tf                # Provider requirements
+	├── main.tf                    # helm_release per component
+	├── variables.tf               # Node scheduling variables
+	├── outputs.tf                 # Installed releases
+	├── README.md                  # Deployment instructions
+	├── checksums.txt              # SHA256 checksums (optional)
+	├── cert-manager/
+	│   └── values.yaml
+	└── gpu-operator/
+	    └── values.yaml
+*/
+package terraform
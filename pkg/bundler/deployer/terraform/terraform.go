@@ -0,0 +1,533 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package terraform provides Terraform module generation for recipes.
+package terraform
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/component"
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/versions.tf.tmpl
+var versionsTemplate string
+
+//go:embed templates/main.tf.tmpl
+var mainTemplate string
+
+//go:embed templates/variables.tf.tmpl
+var variablesTemplate string
+
+//go:embed templates/outputs.tf.tmpl
+var outputsTemplate string
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// MainFileName is the module file holding the helm_release resources.
+	MainFileName = "main.tf"
+
+	// defaultComponentNamespace is the default namespace for component deployment.
+	defaultComponentNamespace = "nvidia-system"
+)
+
+// Node scheduling variables declared in variables.tf.
+const (
+	varSystemNodeSelector         = "system_node_selector"
+	varSystemNodeTolerations      = "system_node_tolerations"
+	varAcceleratedNodeSelector    = "accelerated_node_selector"
+	varAcceleratedNodeTolerations = "accelerated_node_tolerations"
+)
+
+// OverrideData is an extra values document passed to a release when a node
+// scheduling variable is set.
+type OverrideData struct {
+	// Variable is the module variable the override reads.
+	Variable string
+
+	// Value is the HCL object placing the variable at a values path.
+	Value string
+}
+
+// ReleaseData contains data for rendering the helm_release of a single component.
+type ReleaseData struct {
+	Name        string
+	Resource    string
+	ReleaseName string
+	Namespace   string
+	Repository  string
+	Chart       string
+	Version     string
+	Overrides   []OverrideData
+
+	// CreateNamespace lets helm create the namespace. Unset for
+	// namespace-scoped components, whose namespace must already exist.
+	CreateNamespace bool
+
+	// DependsOn is the resource name of the release installed before this
+	// one. Empty for the first release.
+	DependsOn string
+}
+
+// ModuleData contains data for rendering the module files and README.
+type ModuleData struct {
+	RecipeVersion  string
+	BundlerVersion string
+	Timeout        int
+	Releases       []ReleaseData
+
+	// Variable defaults, rendered as HCL.
+	SystemNodeSelector         string
+	SystemNodeTolerations      string
+	AcceleratedNodeSelector    string
+	AcceleratedNodeTolerations string
+
+	Docs                  []recipe.ComponentDocs
+	CompatibilityWarnings []recipe.ConstraintWarning
+}
+
+// GeneratorInput contains all data needed to generate the Terraform module.
+type GeneratorInput struct {
+	// RecipeResult contains the recipe metadata and component references.
+	RecipeResult *recipe.RecipeResult
+
+	// ComponentValues maps component names to their values.
+	ComponentValues map[string]map[string]any
+
+	// Version is the generator version.
+	Version string
+
+	// IncludeChecksums indicates whether to generate a checksums.txt file.
+	IncludeChecksums bool
+
+	// NamespaceScoped marks the component references installed with
+	// namespace scope. Their releases do not create the namespace.
+	NamespaceScoped map[string]bool
+
+	// SystemNodeSelector and SystemNodeTolerations are the defaults of the
+	// system node scheduling variables.
+	SystemNodeSelector    map[string]string
+	SystemNodeTolerations []corev1.Toleration
+
+	// AcceleratedNodeSelector and AcceleratedNodeTolerations are the
+	// defaults of the accelerated node scheduling variables.
+	AcceleratedNodeSelector    map[string]string
+	AcceleratedNodeTolerations []corev1.Toleration
+}
+
+// GeneratorOutput contains the result of module generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the module.
+	Duration time.Duration
+
+	// DeploymentSteps contains ordered deployment instructions for the user.
+	DeploymentSteps []string
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// Generator creates Terraform modules from recipe results.
+type Generator struct{}
+
+// NewGenerator creates a new Terraform generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate creates the Terraform module and per-component values from the given input.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	output := &GeneratorOutput{
+		Files: make([]string, 0),
+	}
+
+	if input == nil || input.RecipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and recipe result are required")
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to create output directory", err)
+	}
+
+	components := sortComponentsByDeploymentOrder(
+		input.RecipeResult.ComponentRefs,
+		input.RecipeResult.DeploymentOrder,
+	)
+
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		slog.Debug("failed to load component registry for terraform module", "error", err)
+	}
+
+	releases := make([]ReleaseData, 0, len(components))
+	for _, comp := range components {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(errors.ErrCodeInternal, "context cancelled", ctx.Err())
+		default:
+		}
+
+		values := input.ComponentValues[comp.Name]
+		if values == nil {
+			values = make(map[string]any)
+		}
+
+		componentDir := filepath.Join(outputDir, comp.Name)
+		if err := os.MkdirAll(componentDir, 0755); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to create directory for %s", comp.Name), err)
+		}
+		valuesPath := filepath.Join(componentDir, "values.yaml")
+		valuesContent, err := marshalValues(values)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to marshal values for %s", comp.Name), err)
+		}
+		if err := os.WriteFile(valuesPath, []byte(valuesContent), 0600); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to write values.yaml for %s", comp.Name), err)
+		}
+		output.Files = append(output.Files, valuesPath)
+		output.TotalSize += int64(len(valuesContent))
+
+		var config *recipe.ComponentConfig
+		if registry != nil {
+			config = registry.Get(comp.ComponentName())
+		}
+
+		release := ReleaseData{
+			Name:            comp.Name,
+			Resource:        resourceName(comp.Name),
+			ReleaseName:     comp.HelmReleaseName(),
+			Namespace:       ComponentNamespace(comp),
+			Repository:      strings.TrimSuffix(comp.Source, "/"),
+			Chart:           chartName(comp, config),
+			Version:         comp.Version,
+			Overrides:       nodeSchedulingOverrides(config),
+			CreateNamespace: !input.NamespaceScoped[comp.Name],
+		}
+		if len(releases) > 0 {
+			release.DependsOn = releases[len(releases)-1].Resource
+		}
+		releases = append(releases, release)
+	}
+
+	data := ModuleData{
+		RecipeVersion:              input.RecipeResult.Metadata.Version,
+		BundlerVersion:             input.Version,
+		Timeout:                    int(defaults.DeployerComponentInstallTimeout.Seconds()),
+		Releases:                   releases,
+		SystemNodeSelector:         hclStringMap(input.SystemNodeSelector),
+		SystemNodeTolerations:      hclTolerations(input.SystemNodeTolerations),
+		AcceleratedNodeSelector:    hclStringMap(input.AcceleratedNodeSelector),
+		AcceleratedNodeTolerations: hclTolerations(input.AcceleratedNodeTolerations),
+		Docs:                       input.RecipeResult.ComponentDocs(),
+		CompatibilityWarnings:      input.RecipeResult.ComponentConstraintWarnings(),
+	}
+
+	for _, f := range []struct {
+		name     string
+		template string
+	}{
+		{"versions.tf", versionsTemplate},
+		{MainFileName, mainTemplate},
+		{"variables.tf", variablesTemplate},
+		{"outputs.tf", outputsTemplate},
+		{"README.md", readmeTemplate},
+	} {
+		path := filepath.Join(outputDir, f.name)
+		size, err := g.generateFromTemplate(f.template, data, path)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal,
+				fmt.Sprintf("failed to generate %s", f.name), err)
+		}
+		output.Files = append(output.Files, path)
+		output.TotalSize += size
+	}
+
+	// Generate checksums if requested
+	if input.IncludeChecksums {
+		if err := checksum.GenerateChecksums(ctx, outputDir, output.Files); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal, "failed to generate checksums", err)
+		}
+		checksumPath := checksum.GetChecksumFilePath(outputDir)
+		checksumInfo, statErr := os.Stat(checksumPath)
+		if statErr != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal, "failed to stat checksums file", statErr)
+		}
+		output.Files = append(output.Files, checksumPath)
+		output.TotalSize += checksumInfo.Size()
+	}
+
+	output.Duration = time.Since(start)
+
+	// Populate deployment steps for CLI output
+	output.DeploymentSteps = []string{
+		fmt.Sprintf("cd %s", outputDir),
+		"terraform init",
+		"terraform apply",
+	}
+	output.DeploymentNotes = []string{
+		"The module requires a configured helm provider; see README.md to call it from a root module",
+		"OpenTofu users can run tofu in place of terraform",
+	}
+
+	slog.Debug("terraform module generated",
+		"components", len(releases),
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+	)
+
+	return output, nil
+}
+
+// generateFromTemplate renders a template to a file.
+func (g *Generator) generateFromTemplate(tmplContent string, data any, outputPath string) (int64, error) {
+	tmpl, err := component.NewTemplate("template").Funcs(template.FuncMap{
+		"hcl": hclString,
+	}).Parse(tmplContent)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return 0, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	content := buf.String()
+	if err := os.WriteFile(outputPath, []byte(content), 0600); err != nil {
+		return 0, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return int64(len(content)), nil
+}
+
+// nodeSchedulingOverrides returns the values overrides of a component for
+// each node scheduling variable, one per values path in the registry.
+func nodeSchedulingOverrides(config *recipe.ComponentConfig) []OverrideData {
+	if config == nil {
+		return nil
+	}
+
+	var overrides []OverrideData
+	for _, v := range []struct {
+		variable string
+		paths    []string
+	}{
+		{varSystemNodeSelector, config.GetSystemNodeSelectorPaths()},
+		{varSystemNodeTolerations, config.GetSystemTolerationPaths()},
+		{varAcceleratedNodeSelector, config.GetAcceleratedNodeSelectorPaths()},
+		{varAcceleratedNodeTolerations, config.GetAcceleratedTolerationPaths()},
+	} {
+		for _, path := range v.paths {
+			overrides = append(overrides, OverrideData{
+				Variable: v.variable,
+				Value:    nestedObject(path, "var."+v.variable),
+			})
+		}
+	}
+	return overrides
+}
+
+// nestedObject returns an HCL object setting the dot-notation path to expr,
+// e.g. { "operator" = { "nodeSelector" = var.x } } for operator.nodeSelector.
+func nestedObject(path, expr string) string {
+	value := expr
+	parts := strings.Split(path, ".")
+	for i := len(parts) - 1; i >= 0; i-- {
+		value = fmt.Sprintf("{ %s = %s }", hclString(parts[i]), value)
+	}
+	return value
+}
+
+// hclString quotes s as an HCL string literal. Template sequences are
+// escaped so the value is taken literally.
+func hclString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return strconv.Quote(s)
+	}
+	quoted := strings.TrimSuffix(buf.String(), "\n")
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+// hclStringMap renders m as an HCL map with sorted keys.
+func hclStringMap(m map[string]string) string {
+	if len(m) == 0 {
+		return "{}"
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "    %s = %s\n", hclString(k), hclString(m[k]))
+	}
+	b.WriteString("  }")
+	return b.String()
+}
+
+// hclTolerations renders tolerations as an HCL list of objects, leaving out
+// empty fields.
+func hclTolerations(tolerations []corev1.Toleration) string {
+	if len(tolerations) == 0 {
+		return "[]"
+	}
+
+	var b strings.Builder
+	b.WriteString("[\n")
+	for _, t := range tolerations {
+		var fields []string
+		for _, f := range []struct{ name, value string }{
+			{"key", t.Key},
+			{"operator", string(t.Operator)},
+			{"value", t.Value},
+			{"effect", string(t.Effect)},
+		} {
+			if f.value != "" {
+				fields = append(fields, fmt.Sprintf("%s = %s", f.name, hclString(f.value)))
+			}
+		}
+		if t.TolerationSeconds != nil {
+			fields = append(fields, fmt.Sprintf("tolerationSeconds = %d", *t.TolerationSeconds))
+		}
+		fmt.Fprintf(&b, "    { %s },\n", strings.Join(fields, ", "))
+	}
+	b.WriteString("  ]")
+	return b.String()
+}
+
+// resourceName returns the Terraform resource name of a component: its name
+// with every character other than letters, digits and underscores replaced
+// by an underscore.
+func resourceName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// chartName returns the chart name of a component from the component
+// registry, falling back to the component name. The helm provider takes the
+// repository, HTTP or OCI, separately.
+func chartName(comp recipe.ComponentRef, config *recipe.ComponentConfig) string {
+	if config != nil && config.Helm.DefaultChart != "" {
+		chart := config.Helm.DefaultChart
+		if idx := strings.LastIndex(chart, "/"); idx >= 0 {
+			chart = chart[idx+1:]
+		}
+		return chart
+	}
+	return comp.ComponentName()
+}
+
+// marshalValues renders component values as YAML. Empty values render as "{}"
+// so the values file is always valid.
+func marshalValues(values map[string]any) (string, error) {
+	if len(values) == 0 {
+		return "{}\n", nil
+	}
+	yamlBytes, err := yaml.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(yamlBytes), nil
+}
+
+// sortComponentsByDeploymentOrder sorts components based on deployment order.
+func sortComponentsByDeploymentOrder(refs []recipe.ComponentRef, order []string) []recipe.ComponentRef {
+	if len(order) == 0 {
+		return refs
+	}
+
+	orderMap := make(map[string]int, len(order))
+	for i, name := range order {
+		orderMap[name] = i
+	}
+
+	sorted := make([]recipe.ComponentRef, len(refs))
+	copy(sorted, refs)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		orderI, okI := orderMap[sorted[i].Name]
+		orderJ, okJ := orderMap[sorted[j].Name]
+
+		if !okI && !okJ {
+			return sorted[i].Name < sorted[j].Name
+		}
+		if !okI {
+			return false
+		}
+		if !okJ {
+			return true
+		}
+		return orderI < orderJ
+	})
+
+	return sorted
+}
+
+// ComponentNamespace returns the namespace a component is installed into.
+func ComponentNamespace(comp recipe.ComponentRef) string {
+	switch comp.ComponentName() {
+	case "gpu-operator":
+		return "gpu-operator"
+	case "network-operator":
+		return "nvidia-network-operator"
+	case "cert-manager":
+		return "cert-manager"
+	default:
+		return defaultComponentNamespace
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func newTestRecipe() *recipe.RecipeResult {
+	recipeResult := &recipe.RecipeResult{}
+	recipeResult.Metadata.Version = "v1.0.0"
+	recipeResult.ComponentRefs = []recipe.ComponentRef{
+		{
+			Name:    "gpu-operator",
+			Version: "v25.3.3",
+			Type:    "helm",
+			Source:  "https://helm.ngc.nvidia.com/nvidia",
+		},
+		{
+			Name:    "cert-manager",
+			Version: "v1.17.2",
+			Type:    "helm",
+			Source:  "https://charts.jetstack.io",
+		},
+	}
+	recipeResult.DeploymentOrder = []string{"cert-manager", "gpu-operator"}
+	return recipeResult
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", name, err)
+	}
+	return string(content)
+}
+
+func TestGenerate_Success(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	input := &GeneratorInput{
+		RecipeResult: newTestRecipe(),
+		ComponentValues: map[string]map[string]any{
+			"gpu-operator": {
+				"driver": map[string]any{
+					"enabled": true,
+				},
+			},
+		},
+		Version:          "v0.9.0",
+		IncludeChecksums: true,
+	}
+
+	output, err := g.Generate(context.Background(), input, outputDir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for _, f := range []string{
+		"versions.tf",
+		"main.tf",
+		"variables.tf",
+		"outputs.tf",
+		"README.md",
+		"checksums.txt",
+		"cert-manager/values.yaml",
+		"gpu-operator/values.yaml",
+	} {
+		if _, statErr := os.Stat(filepath.Join(outputDir, f)); statErr != nil {
+			t.Errorf("expected file %s: %v", f, statErr)
+		}
+	}
+
+	if len(output.Files) != 8 {
+		t.Errorf("got %d files, want 8", len(output.Files))
+	}
+	if output.TotalSize == 0 {
+		t.Error("TotalSize should be > 0")
+	}
+	if len(output.DeploymentSteps) == 0 {
+		t.Error("DeploymentSteps should not be empty")
+	}
+}
+
+func TestGenerate_Main(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	if _, err := g.Generate(context.Background(), &GeneratorInput{RecipeResult: newTestRecipe()}, outputDir); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	content := readFile(t, outputDir, MainFileName)
+
+	for _, want := range []string{
+		`resource "helm_release" "cert_manager" {`,
+		`resource "helm_release" "gpu_operator" {`,
+		`  name             = "gpu-operator"`,
+		`  namespace        = "gpu-operator"`,
+		`  create_namespace = true`,
+		`  repository       = "https://helm.ngc.nvidia.com/nvidia"`,
+		`  chart            = "gpu-operator"`,
+		`  version          = "v25.3.3"`,
+		`    file("${path.module}/gpu-operator/values.yaml"),`,
+		`    length(var.system_node_selector) > 0 ? yamlencode({ "operator" = { "nodeSelector" = var.system_node_selector } }) : "",`,
+		`    length(var.accelerated_node_tolerations) > 0 ? yamlencode({ "daemonsets" = { "tolerations" = var.accelerated_node_tolerations } }) : "",`,
+		`  depends_on = [helm_release.cert_manager]`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("main.tf missing %q:\n%s", want, content)
+		}
+	}
+
+	// Releases follow deployment order and only later ones depend on earlier ones
+	certIdx := strings.Index(content, `"helm_release" "cert_manager"`)
+	gpuIdx := strings.Index(content, `"helm_release" "gpu_operator"`)
+	if certIdx < 0 || gpuIdx < 0 || certIdx > gpuIdx {
+		t.Error("releases should follow deployment order")
+	}
+	if strings.Count(content, "depends_on") != 1 {
+		t.Errorf("expected a single depends_on, got:\n%s", content)
+	}
+}
+
+func TestGenerate_Variables(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	seconds := int64(300)
+	input := &GeneratorInput{
+		RecipeResult:       newTestRecipe(),
+		SystemNodeSelector: map[string]string{"role": "system", "pool": "${infra}"},
+		AcceleratedNodeTolerations: []corev1.Toleration{
+			{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			{Key: "maintenance", Value: "true", TolerationSeconds: &seconds},
+		},
+	}
+	if _, err := g.Generate(context.Background(), input, outputDir); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	content := readFile(t, outputDir, "variables.tf")
+
+	for _, want := range []string{
+		`variable "system_node_selector" {`,
+		"  default     = {\n    \"pool\" = \"$${infra}\"\n    \"role\" = \"system\"\n  }",
+		`    { key = "nvidia.com/gpu", operator = "Exists", effect = "NoSchedule" },`,
+		`    { key = "maintenance", value = "true", tolerationSeconds = 300 },`,
+		`variable "accelerated_node_selector" {`,
+		"  default     = {}",
+		`variable "system_node_tolerations" {`,
+		"  default     = []",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("variables.tf missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestGenerate_NamespaceScoped(t *testing.T) {
+	g := NewGenerator()
+	outputDir := t.TempDir()
+
+	input := &GeneratorInput{
+		RecipeResult:    newTestRecipe(),
+		NamespaceScoped: map[string]bool{"gpu-operator": true},
+	}
+	if _, err := g.Generate(context.Background(), input, outputDir); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	content := readFile(t, outputDir, MainFileName)
+
+	gpuIdx := strings.Index(content, `"helm_release" "gpu_operator"`)
+	if gpuIdx < 0 {
+		t.Fatalf("main.tf missing gpu_operator release:\n%s", content)
+	}
+	if !strings.Contains(content[:gpuIdx], "create_namespace = true") {
+		t.Error("cluster-scoped cert-manager should create its namespace")
+	}
+	if !strings.Contains(content[gpuIdx:], "create_namespace = false") {
+		t.Error("namespace-scoped gpu-operator should not create its namespace")
+	}
+}
+
+func TestGenerate_InvalidInput(t *testing.T) {
+	g := NewGenerator()
+
+	if _, err := g.Generate(context.Background(), nil, t.TempDir()); err == nil {
+		t.Error("expected error for nil input")
+	}
+	if _, err := g.Generate(context.Background(), &GeneratorInput{}, t.TempDir()); err == nil {
+		t.Error("expected error for nil recipe result")
+	}
+}
+
+func TestGenerate_ContextCancelled(t *testing.T) {
+	g := NewGenerator()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := g.Generate(ctx, &GeneratorInput{RecipeResult: newTestRecipe()}, t.TempDir()); err == nil {
+		t.Error("expected error for cancelled context")
+	}
+}
+
+func TestHCLString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", `"plain"`},
+		{`a "quoted" \ value`, `"a \"quoted\" \\ value"`},
+		{"${var.x} and %{if}", `"$${var.x} and %%{if}"`},
+		{"line\nbreak", `"line\nbreak"`},
+	}
+
+	for _, tt := range tests {
+		if got := hclString(tt.in); got != tt.want {
+			t.Errorf("hclString(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"gpu-operator":  "gpu_operator",
+		"nim.llama":     "nim_llama",
+		"cert_manager2": "cert_manager2",
+	}
+	for in, want := range tests {
+		if got := resourceName(in); got != want {
+			t.Errorf("resourceName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNestedObject(t *testing.T) {
+	got := nestedObject("node-feature-discovery.gc.nodeSelector", "var.x")
+	want := `{ "node-feature-discovery" = { "gc" = { "nodeSelector" = var.x } } }`
+	if got != want {
+		t.Errorf("nestedObject() = %s, want %s", got, want)
+	}
+}
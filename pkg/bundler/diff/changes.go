@@ -0,0 +1,88 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ChangesFileName is the change notes file written into a bundle that
+// replaces a previous one.
+const ChangesFileName = "CHANGES.md"
+
+// WriteMarkdown writes the report as change notes, with one section per
+// component listing its version bump and changed values, suitable for a
+// CHANGES.md committed alongside the bundle.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("# Changes\n\n")
+	if r.Empty() {
+		b.WriteString("No changes since the previous bundle.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	b.WriteString("Changes since the previous bundle.\n")
+
+	for _, c := range r.Components {
+		fmt.Fprintf(&b, "\n## %s (%s)\n\n", c.Name, c.Change)
+		switch {
+		case c.Change == ChangeAdded:
+			fmt.Fprintf(&b, "Version: %s\n", markdownCode(describeVersion(c.NewVersion)))
+		case c.Change == ChangeRemoved:
+			fmt.Fprintf(&b, "Version: %s\n", markdownCode(describeVersion(c.OldVersion)))
+		case c.OldVersion != c.NewVersion:
+			fmt.Fprintf(&b, "Version: %s → %s\n",
+				markdownCode(describeVersion(c.OldVersion)), markdownCode(describeVersion(c.NewVersion)))
+		default:
+			b.WriteString("Version unchanged.\n")
+		}
+
+		if len(c.Values) == 0 {
+			continue
+		}
+		b.WriteString("\n| Key | Change | Old | New |\n|-----|--------|-----|-----|\n")
+		for _, v := range c.Values {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+				markdownCode(v.Key), v.Change, markdownCode(v.Old), markdownCode(v.New))
+		}
+	}
+
+	if len(r.Manifests) > 0 {
+		b.WriteString("\n## Manifests\n\n")
+		for _, m := range r.Manifests {
+			fmt.Fprintf(&b, "- %s: %s\n", m.Change, markdownCode(m.Path))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownCode renders s as inline code safe for a table cell. Empty strings
+// render as nothing.
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = strings.ReplaceAll(s, "|", `\|`)
+	if strings.Contains(s, "`") {
+		return "`` " + s + " ``"
+	}
+	return "`" + s + "`"
+}
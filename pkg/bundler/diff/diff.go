@@ -0,0 +1,270 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+)
+
+// Change describes how an item differs between the old and new bundle.
+type Change string
+
+const (
+	// ChangeAdded marks items only present in the new bundle.
+	ChangeAdded Change = "added"
+	// ChangeRemoved marks items only present in the old bundle.
+	ChangeRemoved Change = "removed"
+	// ChangeModified marks items present in both bundles with different content.
+	ChangeModified Change = "modified"
+)
+
+// Report is a semantic comparison of two bundles.
+type Report struct {
+	// Old is the location of the old bundle.
+	Old string `json:"old" yaml:"old"`
+
+	// New is the location of the new bundle.
+	New string `json:"new" yaml:"new"`
+
+	// Components lists added, removed and modified components by name.
+	Components []ComponentDiff `json:"components,omitempty" yaml:"components,omitempty"`
+
+	// Manifests lists added, removed and modified manifests by path.
+	Manifests []ManifestDiff `json:"manifests,omitempty" yaml:"manifests,omitempty"`
+}
+
+// ComponentDiff describes how a single component changed.
+type ComponentDiff struct {
+	// Name is the component name.
+	Name string `json:"name" yaml:"name"`
+
+	// Change is how the component changed.
+	Change Change `json:"change" yaml:"change"`
+
+	// OldVersion is the version in the old bundle, if any.
+	OldVersion string `json:"old_version,omitempty" yaml:"old_version,omitempty"`
+
+	// NewVersion is the version in the new bundle, if any.
+	NewVersion string `json:"new_version,omitempty" yaml:"new_version,omitempty"`
+
+	// Values lists changed values keys of a modified component.
+	Values []ValueDiff `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+// ValueDiff describes a single changed values key in dotted-path form.
+type ValueDiff struct {
+	// Key is the dotted values path (e.g., "driver.version").
+	Key string `json:"key" yaml:"key"`
+
+	// Change is how the key changed.
+	Change Change `json:"change" yaml:"change"`
+
+	// Old is the value in the old bundle, rendered as a string.
+	Old string `json:"old,omitempty" yaml:"old,omitempty"`
+
+	// New is the value in the new bundle, rendered as a string.
+	New string `json:"new,omitempty" yaml:"new,omitempty"`
+}
+
+// ManifestDiff describes a manifest that was added, removed or modified.
+type ManifestDiff struct {
+	// Path is the slash-separated path within the bundle.
+	Path string `json:"path" yaml:"path"`
+
+	// Change is how the manifest changed.
+	Change Change `json:"change" yaml:"change"`
+}
+
+// Empty reports whether the bundles have no semantic differences.
+func (r *Report) Empty() bool {
+	return len(r.Components) == 0 && len(r.Manifests) == 0
+}
+
+// Compare returns the semantic differences from oldBundle to newBundle.
+func Compare(oldBundle, newBundle *Bundle) *Report {
+	r := &Report{Old: oldBundle.Dir, New: newBundle.Dir}
+
+	for _, name := range unionKeys(oldBundle.Components, newBundle.Components) {
+		oldComp, inOld := oldBundle.Components[name]
+		newComp, inNew := newBundle.Components[name]
+
+		switch {
+		case !inOld:
+			r.Components = append(r.Components, ComponentDiff{Name: name, Change: ChangeAdded, NewVersion: newComp.Version})
+		case !inNew:
+			r.Components = append(r.Components, ComponentDiff{Name: name, Change: ChangeRemoved, OldVersion: oldComp.Version})
+		default:
+			values := compareValues(oldComp.Values, newComp.Values)
+			if oldComp.Version == newComp.Version && len(values) == 0 {
+				continue
+			}
+			d := ComponentDiff{Name: name, Change: ChangeModified, Values: values}
+			if oldComp.Version != newComp.Version {
+				d.OldVersion = oldComp.Version
+				d.NewVersion = newComp.Version
+			}
+			r.Components = append(r.Components, d)
+		}
+	}
+
+	for _, p := range unionKeys(oldBundle.Manifests, newBundle.Manifests) {
+		oldDigest, inOld := oldBundle.Manifests[p]
+		newDigest, inNew := newBundle.Manifests[p]
+
+		switch {
+		case !inOld:
+			r.Manifests = append(r.Manifests, ManifestDiff{Path: p, Change: ChangeAdded})
+		case !inNew:
+			r.Manifests = append(r.Manifests, ManifestDiff{Path: p, Change: ChangeRemoved})
+		case oldDigest != newDigest:
+			r.Manifests = append(r.Manifests, ManifestDiff{Path: p, Change: ChangeModified})
+		}
+	}
+
+	return r
+}
+
+// compareValues flattens both values trees and returns the changed keys.
+func compareValues(oldValues, newValues map[string]any) []ValueDiff {
+	oldFlat := renderValues(oldValues)
+	newFlat := renderValues(newValues)
+
+	var diffs []ValueDiff
+	for _, key := range unionKeys(oldFlat, newFlat) {
+		oldValue, inOld := oldFlat[key]
+		newValue, inNew := newFlat[key]
+
+		switch {
+		case !inOld:
+			diffs = append(diffs, ValueDiff{Key: key, Change: ChangeAdded, New: newValue})
+		case !inNew:
+			diffs = append(diffs, ValueDiff{Key: key, Change: ChangeRemoved, Old: oldValue})
+		case oldValue != newValue:
+			diffs = append(diffs, ValueDiff{Key: key, Change: ChangeModified, Old: oldValue, New: newValue})
+		}
+	}
+	return diffs
+}
+
+// renderValues flattens a values tree and renders each leaf. Lists are
+// compared as a whole.
+func renderValues(values map[string]any) map[string]string {
+	flat := valuepath.Flatten(values)
+	out := make(map[string]string, len(flat))
+	for key, value := range flat {
+		out[key] = renderValue(value)
+	}
+	return out
+}
+
+// renderValue renders scalars as-is and lists or maps as compact JSON.
+func renderValue(value any) string {
+	switch value.(type) {
+	case []any, map[string]any:
+		data, err := json.Marshal(value)
+		if err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// unionKeys returns the sorted keys present in either map.
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := slices.Collect(maps.Keys(a))
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// WriteText writes a human-readable rendering of the report to w.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Bundle diff: %s -> %s\n", r.Old, r.New)
+	if r.Empty() {
+		b.WriteString("\nNo differences.\n")
+	}
+
+	if len(r.Components) > 0 {
+		b.WriteString("\nComponents:\n")
+		for _, c := range r.Components {
+			switch c.Change {
+			case ChangeAdded:
+				fmt.Fprintf(&b, "  + %s\n", strings.TrimSpace(c.Name+" "+c.NewVersion))
+			case ChangeRemoved:
+				fmt.Fprintf(&b, "  - %s\n", strings.TrimSpace(c.Name+" "+c.OldVersion))
+			case ChangeModified:
+				if c.OldVersion != c.NewVersion {
+					fmt.Fprintf(&b, "  ~ %s %s -> %s\n", c.Name, describeVersion(c.OldVersion), describeVersion(c.NewVersion))
+				} else {
+					fmt.Fprintf(&b, "  ~ %s\n", c.Name)
+				}
+			}
+			for _, v := range c.Values {
+				switch v.Change {
+				case ChangeAdded:
+					fmt.Fprintf(&b, "      + %s: %s\n", v.Key, v.New)
+				case ChangeRemoved:
+					fmt.Fprintf(&b, "      - %s: %s\n", v.Key, v.Old)
+				case ChangeModified:
+					fmt.Fprintf(&b, "      ~ %s: %s -> %s\n", v.Key, v.Old, v.New)
+				}
+			}
+		}
+	}
+
+	if len(r.Manifests) > 0 {
+		b.WriteString("\nManifests:\n")
+		for _, m := range r.Manifests {
+			fmt.Fprintf(&b, "  %s %s\n", changeSymbol(m.Change), m.Path)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// describeVersion renders an empty version as "(none)".
+func describeVersion(version string) string {
+	if version == "" {
+		return "(none)"
+	}
+	return version
+}
+
+// changeSymbol returns the diff marker for c.
+func changeSymbol(c Change) string {
+	switch c {
+	case ChangeAdded:
+		return "+"
+	case ChangeRemoved:
+		return "-"
+	case ChangeModified:
+		return "~"
+	}
+	return "?"
+}
@@ -0,0 +1,367 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeBundle writes files (slash-separated path -> content) into a new
+// temporary directory.
+func writeBundle(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for p, content := range files {
+		full := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", p, err)
+		}
+	}
+	return dir
+}
+
+const oldChart = `apiVersion: v2
+name: eidos
+version: dev
+dependencies:
+  - name: cert-manager
+    version: v1.17.2
+  - name: gpu-operator
+    version: v25.3.3
+  - name: kube-prometheus-stack
+    alias: prometheus
+    version: 81.0.0
+`
+
+const newChart = `apiVersion: v2
+name: eidos
+version: dev
+dependencies:
+  - name: cert-manager
+    version: v1.17.2
+  - name: gpu-operator
+    version: v25.10.1
+  - name: network-operator
+    version: 25.7.0
+`
+
+const oldValues = `cert-manager:
+  enabled: true
+gpu-operator:
+  enabled: true
+  driver:
+    version: 570.133.20
+  toolkit:
+    enabled: true
+prometheus:
+  enabled: true
+`
+
+const newValues = `cert-manager:
+  enabled: true
+gpu-operator:
+  enabled: true
+  driver:
+    version: 580.82.07
+  dcgmExporter:
+    enabled: true
+network-operator:
+  enabled: true
+`
+
+func TestCompare_Umbrella(t *testing.T) {
+	oldDir := writeBundle(t, map[string]string{
+		"Chart.yaml":         oldChart,
+		"values.yaml":        oldValues,
+		"recipe.yaml":        "kind: recipeResult\n",
+		"templates/old.yaml": "kind: ConfigMap\n",
+		"checksums.txt":      "abc  Chart.yaml\n",
+		"README.md":          "# old\n",
+	})
+	newDir := writeBundle(t, map[string]string{
+		"Chart.yaml":          newChart,
+		"values.yaml":         newValues,
+		"recipe.yaml":         "kind: recipeResult\nversion: v2\n",
+		"templates/dcgm.yaml": "kind: ConfigMap\n",
+		"checksums.txt":       "def  Chart.yaml\n",
+		"README.md":           "# new\n",
+	})
+
+	oldBundle, err := Load(oldDir)
+	if err != nil {
+		t.Fatalf("Load(old) error = %v", err)
+	}
+	newBundle, err := Load(newDir)
+	if err != nil {
+		t.Fatalf("Load(new) error = %v", err)
+	}
+
+	r := Compare(oldBundle, newBundle)
+
+	components := make(map[string]ComponentDiff)
+	for _, c := range r.Components {
+		components[c.Name] = c
+	}
+	if len(components) != 3 {
+		t.Fatalf("expected 3 component diffs, got %+v", r.Components)
+	}
+	if _, ok := components["cert-manager"]; ok {
+		t.Error("unchanged cert-manager should not be reported")
+	}
+	if c := components["network-operator"]; c.Change != ChangeAdded || c.NewVersion != "25.7.0" {
+		t.Errorf("network-operator = %+v, want added 25.7.0", c)
+	}
+	if c := components["prometheus"]; c.Change != ChangeRemoved || c.OldVersion != "81.0.0" {
+		t.Errorf("prometheus = %+v, want removed 81.0.0", c)
+	}
+
+	gpu := components["gpu-operator"]
+	if gpu.Change != ChangeModified || gpu.OldVersion != "v25.3.3" || gpu.NewVersion != "v25.10.1" {
+		t.Errorf("gpu-operator = %+v, want version bump", gpu)
+	}
+	wantValues := map[string]ValueDiff{
+		"dcgmExporter.enabled": {Key: "dcgmExporter.enabled", Change: ChangeAdded, New: "true"},
+		"driver.version":       {Key: "driver.version", Change: ChangeModified, Old: "570.133.20", New: "580.82.07"},
+		"toolkit.enabled":      {Key: "toolkit.enabled", Change: ChangeRemoved, Old: "true"},
+	}
+	if len(gpu.Values) != len(wantValues) {
+		t.Fatalf("gpu-operator values = %+v, want %d diffs", gpu.Values, len(wantValues))
+	}
+	for _, v := range gpu.Values {
+		if v != wantValues[v.Key] {
+			t.Errorf("value diff %+v, want %+v", v, wantValues[v.Key])
+		}
+	}
+
+	wantManifests := []ManifestDiff{
+		{Path: "Chart.yaml", Change: ChangeModified},
+		{Path: "recipe.yaml", Change: ChangeModified},
+		{Path: "templates/dcgm.yaml", Change: ChangeAdded},
+		{Path: "templates/old.yaml", Change: ChangeRemoved},
+	}
+	if len(r.Manifests) != len(wantManifests) {
+		t.Fatalf("manifests = %+v, want %+v", r.Manifests, wantManifests)
+	}
+	for i, m := range r.Manifests {
+		if m != wantManifests[i] {
+			t.Errorf("manifest[%d] = %+v, want %+v", i, m, wantManifests[i])
+		}
+	}
+}
+
+func TestCompare_PerComponentDeployers(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldFiles map[string]string
+		newFiles map[string]string
+	}{
+		{
+			name: "argocd",
+			oldFiles: map[string]string{
+				"gpu-operator/values.yaml":      "driver:\n  version: 570.133.20\n",
+				"gpu-operator/application.yaml": "spec:\n  sources:\n    - chart: gpu-operator\n      targetRevision: 25.3.3\n    - ref: values\n      targetRevision: main\n",
+				"app-of-apps.yaml":              "kind: Application\n",
+			},
+			newFiles: map[string]string{
+				"gpu-operator/values.yaml":      "driver:\n  version: 580.82.07\n",
+				"gpu-operator/application.yaml": "spec:\n  sources:\n    - chart: gpu-operator\n      targetRevision: 25.10.1\n    - ref: values\n      targetRevision: main\n",
+				"app-of-apps.yaml":              "kind: Application\n",
+			},
+		},
+		{
+			name: "argocd applicationset",
+			oldFiles: map[string]string{
+				"gpu-operator/values.yaml":         "driver:\n  version: 570.133.20\n",
+				"gpu-operator/applicationset.yaml": "spec:\n  template:\n    spec:\n      sources:\n        - chart: gpu-operator\n          targetRevision: 25.3.3\n        - ref: values\n          targetRevision: main\n",
+				"app-of-apps.yaml":                 "kind: Application\n",
+			},
+			newFiles: map[string]string{
+				"gpu-operator/values.yaml":         "driver:\n  version: 580.82.07\n",
+				"gpu-operator/applicationset.yaml": "spec:\n  template:\n    spec:\n      sources:\n        - chart: gpu-operator\n          targetRevision: 25.10.1\n        - ref: values\n          targetRevision: main\n",
+				"app-of-apps.yaml":                 "kind: Application\n",
+			},
+		},
+		{
+			name: "argo-workflows",
+			oldFiles: map[string]string{
+				"gpu-operator/values.yaml": "driver:\n  version: 570.133.20\n",
+				"workflow.yaml":            "spec:\n  templates:\n    - name: install-gpu-operator\n      container:\n        args: [upgrade, --install, gpu-operator, --version, \"25.3.3\"]\n",
+			},
+			newFiles: map[string]string{
+				"gpu-operator/values.yaml": "driver:\n  version: 580.82.07\n",
+				"workflow.yaml":            "spec:\n  templates:\n    - name: install-gpu-operator\n      container:\n        args: [upgrade, --install, gpu-operator, --version, \"25.10.1\"]\n",
+			},
+		},
+		{
+			name: "terraform",
+			oldFiles: map[string]string{
+				"gpu-operator/values.yaml": "driver:\n  version: 570.133.20\n",
+				"main.tf":                  "resource \"helm_release\" \"gpu_operator\" {\n  version          = \"25.3.3\"\n\n  values = compact([\n    file(\"${path.module}/gpu-operator/values.yaml\"),\n  ])\n}\n",
+			},
+			newFiles: map[string]string{
+				"gpu-operator/values.yaml": "driver:\n  version: 580.82.07\n",
+				"main.tf":                  "resource \"helm_release\" \"gpu_operator\" {\n  version          = \"25.10.1\"\n\n  values = compact([\n    file(\"${path.module}/gpu-operator/values.yaml\"),\n  ])\n}\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldBundle, err := Load(writeBundle(t, tt.oldFiles))
+			if err != nil {
+				t.Fatalf("Load(old) error = %v", err)
+			}
+			newBundle, err := Load(writeBundle(t, tt.newFiles))
+			if err != nil {
+				t.Fatalf("Load(new) error = %v", err)
+			}
+
+			r := Compare(oldBundle, newBundle)
+			if len(r.Components) != 1 {
+				t.Fatalf("expected 1 component diff, got %+v", r.Components)
+			}
+			gpu := r.Components[0]
+			if gpu.Name != "gpu-operator" || gpu.OldVersion != "25.3.3" || gpu.NewVersion != "25.10.1" {
+				t.Errorf("gpu-operator = %+v, want 25.3.3 -> 25.10.1", gpu)
+			}
+			if len(gpu.Values) != 1 || gpu.Values[0].Key != "driver.version" {
+				t.Errorf("gpu-operator values = %+v, want driver.version", gpu.Values)
+			}
+			for _, m := range r.Manifests {
+				if strings.HasSuffix(m.Path, valuesFileName) {
+					t.Errorf("values file %s reported as manifest", m.Path)
+				}
+			}
+		})
+	}
+}
+
+func TestLoad_NotDirectory(t *testing.T) {
+	dir := writeBundle(t, map[string]string{"file.yaml": "a: b\n"})
+	if _, err := Load(filepath.Join(dir, "file.yaml")); err == nil {
+		t.Error("expected error for file path")
+	}
+	if _, err := Load(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
+
+func TestReport_WriteText(t *testing.T) {
+	r := &Report{
+		Old: "old",
+		New: "new",
+		Components: []ComponentDiff{
+			{Name: "gpu-operator", Change: ChangeModified, OldVersion: "v25.3.3", NewVersion: "v25.10.1",
+				Values: []ValueDiff{{Key: "driver.version", Change: ChangeModified, Old: "570", New: "580"}}},
+			{Name: "network-operator", Change: ChangeAdded, NewVersion: "25.7.0"},
+		},
+		Manifests: []ManifestDiff{{Path: "templates/dcgm.yaml", Change: ChangeAdded}},
+	}
+
+	var b strings.Builder
+	if err := r.WriteText(&b); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	for _, want := range []string{
+		"Bundle diff: old -> new",
+		"~ gpu-operator v25.3.3 -> v25.10.1",
+		"~ driver.version: 570 -> 580",
+		"+ network-operator 25.7.0",
+		"+ templates/dcgm.yaml",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := (&Report{Old: "a", New: "b"}).WriteText(&b); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	if !strings.Contains(b.String(), "No differences.") {
+		t.Errorf("empty report should say no differences:\n%s", b.String())
+	}
+}
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	r := &Report{
+		Old: "old",
+		New: "new",
+		Components: []ComponentDiff{
+			{Name: "gpu-operator", Change: ChangeModified, OldVersion: "v25.3.3", NewVersion: "v25.10.1",
+				Values: []ValueDiff{
+					{Key: "driver.version", Change: ChangeModified, Old: "570", New: "580"},
+					{Key: "devicePlugin.config", Change: ChangeAdded, New: "a|b"},
+				}},
+			{Name: "nvsentinel", Change: ChangeModified,
+				Values: []ValueDiff{{Key: "enabled", Change: ChangeRemoved, Old: "true"}}},
+			{Name: "network-operator", Change: ChangeAdded, NewVersion: "25.7.0"},
+		},
+		Manifests: []ManifestDiff{{Path: "templates/dcgm.yaml", Change: ChangeRemoved}},
+	}
+
+	var b strings.Builder
+	if err := r.WriteMarkdown(&b); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	for _, want := range []string{
+		"# Changes",
+		"## gpu-operator (modified)",
+		"Version: `v25.3.3` → `v25.10.1`",
+		"| `driver.version` | modified | `570` | `580` |",
+		"| `devicePlugin.config` | added |  | `a\\|b` |",
+		"## nvsentinel (modified)\n\nVersion unchanged.",
+		"## network-operator (added)\n\nVersion: `25.7.0`",
+		"- removed: `templates/dcgm.yaml`",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := (&Report{}).WriteMarkdown(&b); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	if !strings.Contains(b.String(), "No changes since the previous bundle.") {
+		t.Errorf("empty report should say no changes:\n%s", b.String())
+	}
+}
+
+func TestLoadExisting(t *testing.T) {
+	if b, err := LoadExisting(filepath.Join(t.TempDir(), "missing")); err != nil || b != nil {
+		t.Errorf("LoadExisting(missing) = %v, %v, want nil, nil", b, err)
+	}
+
+	notBundle := writeBundle(t, map[string]string{"notes.yaml": "a: b\n"})
+	if b, err := LoadExisting(notBundle); err != nil || b != nil {
+		t.Errorf("LoadExisting(non-bundle) = %v, %v, want nil, nil", b, err)
+	}
+
+	dir := writeBundle(t, map[string]string{"Chart.yaml": oldChart, "values.yaml": oldValues})
+	b, err := LoadExisting(dir)
+	if err != nil {
+		t.Fatalf("LoadExisting() error = %v", err)
+	}
+	if b == nil || b.Components["gpu-operator"] == nil {
+		t.Fatalf("LoadExisting() = %+v, want umbrella bundle with gpu-operator", b)
+	}
+}
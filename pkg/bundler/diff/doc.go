@@ -0,0 +1,67 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff compares two generated bundles semantically.
+//
+// Raw file diffs of bundles are noisy: values files are re-rendered, checksums
+// change on every edit, and a single version bump touches several files. A
+// Report instead lists, per component, the chart version change and the values
+// keys that were added, removed or changed, followed by the manifests that
+// were added, removed or modified.
+//
+// Bundles from every deployer are understood:
+//   - helm: components and versions come from the umbrella Chart.yaml
+//     dependencies, values from the matching top-level keys of values.yaml.
+//   - argocd: one directory per component holding values.yaml, with the
+//     version taken from the Application's targetRevision.
+//   - argo-workflows: one directory per component holding values.yaml, with
+//     the version taken from the --version argument of its install step.
+//   - terraform: one directory per component holding values.yaml, with the
+//     version taken from the helm_release in main.tf that reads it.
+//
+// Manifests are the remaining YAML files, compared by content. Checksum and
+// signature files are ignored.
+//
+//	oldBundle, err := diff.Load("./bundle-v1")
+//	if err != nil {
+//	    return err
+//	}
+//	newBundle, err := diff.Load("./bundle-v2")
+//	if err != nil {
+//	    return err
+//	}
+//	report := diff.Compare(oldBundle, newBundle)
+//	report.WriteText(os.Stdout)
+//
+// # Output
+//
+// WriteText renders a human-readable report for review:
+//
+//	Bundle diff: ./bundle-v1 -> ./bundle-v2
+//
+//	Components:
+//	  + network-operator 25.7.0
+//	  ~ gpu-operator v25.3.3 -> v25.10.1
+//	      ~ driver.version: 570.133.20 -> 580.82.07
+//	      + dcgmExporter.enabled: true
+//
+//	Manifests:
+//	  + templates/dcgm-exporter.yaml
+//
+// WriteMarkdown renders the same report as change notes. The bundler writes
+// them to CHANGES.md (ChangesFileName) when a bundle replaces a previous one,
+// using LoadExisting to detect a bundle already in the output directory.
+//
+// Reports also serialize to JSON and YAML for review tooling.
+package diff
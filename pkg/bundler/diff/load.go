@@ -0,0 +1,403 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/bundler/manifest"
+	"github.com/NVIDIA/eidos/pkg/bundler/signing"
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	// valuesFileName is the values file of the umbrella chart and of each
+	// per-component directory.
+	valuesFileName = "values.yaml"
+
+	// manifestsValue is the umbrella chart value holding, per component, the
+	// values only its manifests read (helm.ManifestsValue).
+	manifestsValue = "manifests"
+
+	// chartFileName marks a Helm umbrella chart bundle.
+	chartFileName = "Chart.yaml"
+
+	// applicationFileName is the per-component ArgoCD Application.
+	applicationFileName = "application.yaml"
+
+	// applicationSetFileName is the per-component ArgoCD ApplicationSet of
+	// bundles generated with an ApplicationSet generator.
+	applicationSetFileName = "applicationset.yaml"
+
+	// workflowFileName is the Argo Workflows install workflow.
+	workflowFileName = "workflow.yaml"
+
+	// terraformFileName is the Terraform module file holding a helm_release
+	// per component.
+	terraformFileName = "main.tf"
+
+	// appOfAppsFileName is the parent ArgoCD Application.
+	appOfAppsFileName = "app-of-apps.yaml"
+
+	// installTemplatePrefix prefixes the per-component workflow templates.
+	installTemplatePrefix = "install-"
+)
+
+var (
+	// helmReleaseVersionPattern matches the version attribute of a generated
+	// helm_release.
+	helmReleaseVersionPattern = regexp.MustCompile(`^\s*version\s*=\s*("(?:[^"\\]|\\.)*")\s*$`)
+
+	// helmReleaseValuesPattern matches the values file a generated
+	// helm_release reads, capturing the component name.
+	helmReleaseValuesPattern = regexp.MustCompile(`file\("\$\{path\.module\}/([^/"]+)/values\.yaml"\)`)
+)
+
+// Bundle is the comparable content of a generated bundle directory.
+type Bundle struct {
+	// Dir is the directory the bundle was loaded from.
+	Dir string
+
+	// Components maps component names to their version and values.
+	Components map[string]*Component
+
+	// Manifests maps slash-separated paths of the remaining YAML files to
+	// their SHA256 digest.
+	Manifests map[string]string
+}
+
+// Component is the comparable content of a single bundle component.
+type Component struct {
+	// Version is the chart version (or revision) the component installs.
+	Version string
+
+	// Values are the component's Helm values.
+	Values map[string]any
+}
+
+// Load reads a bundle directory generated by any deployer.
+func Load(dir string) (*Bundle, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeNotFound, "failed to read bundle directory", err)
+	}
+	if !info.IsDir() {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest, dir+" is not a directory")
+	}
+
+	b := &Bundle{
+		Dir:        dir,
+		Components: make(map[string]*Component),
+		Manifests:  make(map[string]string),
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, relErr := filepath.Rel(dir, p)
+		if relErr != nil {
+			return relErr
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal, "failed to walk bundle directory", err)
+	}
+
+	umbrella := fileExists(filepath.Join(dir, chartFileName))
+	if umbrella {
+		if err := b.loadUmbrella(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, f := range files {
+		if !isYAML(f) {
+			continue
+		}
+		if f == valuesFileName && umbrella {
+			continue
+		}
+		if component, ok := componentValuesFile(f); ok && !umbrella {
+			values, err := readValues(filepath.Join(dir, filepath.FromSlash(f)))
+			if err != nil {
+				return nil, err
+			}
+			b.Components[component] = &Component{Values: values}
+			continue
+		}
+
+		digest, err := fileDigest(filepath.Join(dir, filepath.FromSlash(f)))
+		if err != nil {
+			return nil, err
+		}
+		b.Manifests[f] = digest
+	}
+
+	if !umbrella {
+		if err := b.loadComponentVersions(); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// LoadExisting loads the bundle in dir, if there is one. It returns nil
+// without an error when dir does not exist or holds no Chart.yaml,
+// app-of-apps.yaml, workflow.yaml or main.tf, so callers regenerating into dir can
+// detect the bundle they are replacing.
+func LoadExisting(dir string) (*Bundle, error) {
+	for _, marker := range []string{chartFileName, appOfAppsFileName, workflowFileName, terraformFileName} {
+		if fileExists(filepath.Join(dir, marker)) {
+			return Load(dir)
+		}
+	}
+	return nil, nil
+}
+
+// loadUmbrella reads components from the umbrella chart dependencies and the
+// matching top-level keys of its values file.
+func (b *Bundle) loadUmbrella() error {
+	var chart struct {
+		Dependencies []struct {
+			Name    string `yaml:"name"`
+			Alias   string `yaml:"alias"`
+			Version string `yaml:"version"`
+		} `yaml:"dependencies"`
+	}
+	if err := readYAML(filepath.Join(b.Dir, chartFileName), &chart); err != nil {
+		return err
+	}
+
+	values := make(map[string]any)
+	valuesPath := filepath.Join(b.Dir, valuesFileName)
+	if fileExists(valuesPath) {
+		var err error
+		if values, err = readValues(valuesPath); err != nil {
+			return err
+		}
+	}
+
+	for _, dep := range chart.Dependencies {
+		name := dep.Name
+		if dep.Alias != "" {
+			name = dep.Alias
+		}
+		componentValues, _ := values[name].(map[string]any)
+		// Fold the values only the manifests read back into the component.
+		manifests, _ := values[manifestsValue].(map[string]any)
+		if extra, ok := manifests[name].(map[string]any); ok && len(extra) > 0 {
+			componentValues = maps.Clone(componentValues)
+			if componentValues == nil {
+				componentValues = make(map[string]any, len(extra))
+			}
+			maps.Copy(componentValues, extra)
+		}
+		b.Components[name] = &Component{Version: dep.Version, Values: componentValues}
+	}
+	return nil
+}
+
+// loadComponentVersions fills in component versions from ArgoCD Applications,
+// the Argo Workflows install steps or the Terraform helm_release resources.
+func (b *Bundle) loadComponentVersions() error {
+	for name, c := range b.Components {
+		appPath := filepath.Join(b.Dir, name, applicationFileName)
+		if !fileExists(appPath) {
+			appPath = filepath.Join(b.Dir, name, applicationSetFileName)
+			if !fileExists(appPath) {
+				continue
+			}
+		}
+		type appSpec struct {
+			Source struct {
+				TargetRevision string `yaml:"targetRevision"`
+			} `yaml:"source"`
+			Sources []struct {
+				Chart          string `yaml:"chart"`
+				TargetRevision string `yaml:"targetRevision"`
+			} `yaml:"sources"`
+		}
+		var app struct {
+			Spec struct {
+				appSpec `yaml:",inline"`
+
+				// Template is the Application template of an ApplicationSet
+				Template struct {
+					Spec appSpec `yaml:"spec"`
+				} `yaml:"template"`
+			} `yaml:"spec"`
+		}
+		if err := readYAML(appPath, &app); err != nil {
+			return err
+		}
+		spec := app.Spec.appSpec
+		if len(spec.Sources) == 0 && spec.Source.TargetRevision == "" {
+			spec = app.Spec.Template.Spec
+		}
+		c.Version = spec.Source.TargetRevision
+		for _, src := range spec.Sources {
+			if src.Chart != "" {
+				c.Version = src.TargetRevision
+				break
+			}
+		}
+	}
+
+	if err := b.loadTerraformVersions(); err != nil {
+		return err
+	}
+
+	workflowPath := filepath.Join(b.Dir, workflowFileName)
+	if !fileExists(workflowPath) {
+		return nil
+	}
+	var workflow struct {
+		Spec struct {
+			Templates []struct {
+				Name      string `yaml:"name"`
+				Container struct {
+					Args []string `yaml:"args"`
+				} `yaml:"container"`
+			} `yaml:"templates"`
+		} `yaml:"spec"`
+	}
+	if err := readYAML(workflowPath, &workflow); err != nil {
+		return err
+	}
+	for _, t := range workflow.Spec.Templates {
+		c, ok := b.Components[strings.TrimPrefix(t.Name, installTemplatePrefix)]
+		if !ok || !strings.HasPrefix(t.Name, installTemplatePrefix) {
+			continue
+		}
+		for i, arg := range t.Container.Args {
+			if arg == "--version" && i+1 < len(t.Container.Args) {
+				c.Version = t.Container.Args[i+1]
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// loadTerraformVersions fills in component versions from the helm_release
+// resources of a generated Terraform module, matching each resource to its
+// component by the values file it reads.
+func (b *Bundle) loadTerraformVersions() error {
+	mainPath := filepath.Join(b.Dir, terraformFileName)
+	if !fileExists(mainPath) {
+		return nil
+	}
+	data, err := os.ReadFile(mainPath)
+	if err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeInternal, "failed to read "+mainPath, err)
+	}
+
+	var component, version string
+	for _, line := range strings.Split(string(data), "\n") {
+		switch {
+		case strings.HasPrefix(line, "resource "):
+			component, version = "", ""
+		case line == "}":
+			if c, ok := b.Components[component]; ok && version != "" {
+				c.Version = version
+			}
+		default:
+			if m := helmReleaseVersionPattern.FindStringSubmatch(line); m != nil {
+				if v, unquoteErr := strconv.Unquote(m[1]); unquoteErr == nil {
+					version = v
+				}
+			} else if m := helmReleaseValuesPattern.FindStringSubmatch(line); m != nil {
+				component = m[1]
+			}
+		}
+	}
+	return nil
+}
+
+// componentValuesFile reports whether f is a per-component values file
+// ("<component>/values.yaml") and returns the component name.
+func componentValuesFile(f string) (string, bool) {
+	dir, file := path.Split(f)
+	dir = strings.TrimSuffix(dir, "/")
+	if file != valuesFileName || dir == "" || strings.Contains(dir, "/") {
+		return "", false
+	}
+	return dir, true
+}
+
+// isYAML reports whether f is a YAML file that takes part in the diff.
+// Checksum, signature and bundle manifest files change with every edit and
+// are ignored.
+func isYAML(f string) bool {
+	switch f {
+	case checksum.ChecksumFileName, signing.SignatureFileName, signing.SigstoreBundleFileName, manifest.FileName:
+		return false
+	}
+	ext := path.Ext(f)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+func readValues(p string) (map[string]any, error) {
+	values := make(map[string]any)
+	if err := readYAML(p, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func readYAML(p string, out any) error {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeInternal, "failed to read "+p, err)
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "failed to parse "+p, err)
+	}
+	return nil
+}
+
+func fileDigest(p string) (string, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return "", apperrors.Wrap(apperrors.ErrCodeInternal, "failed to read "+p, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func fileExists(p string) bool {
+	info, err := os.Stat(p)
+	return err == nil && !info.IsDir()
+}
@@ -50,6 +50,8 @@ Components are defined in pkg/recipe/data/registry.yaml:
   - cert-manager: Certificate Manager
   - nvsentinel: NVSentinel
   - skyhook-operator: Skyhook node optimization
+  - nim: NVIDIA NIM inference microservice
+  - kubevirt: KubeVirt with GPU passthrough
 
 # Output Formats
 
@@ -65,6 +67,61 @@ ArgoCD:
   - <component>/application.yaml: ArgoCD Application per component
   - <component>/values.yaml: Values for each component
 
+Every deployer also writes CHANGES.md when the output directory already
+holds a bundle, or config.WithPreviousBundle names one: per-component version
+bumps and values changes since that bundle (see package diff).
+
+Every deployer also writes recipe.lock.yaml pinning the recipe and recipe
+data digests, the resolved chart versions and the deployed images; with
+config.WithResolveImageDigests, image tags are resolved to digests through
+WithImageDigestResolver. With config.WithLockedFile, generation fails before
+anything is written when the resolution differs from that lockfile (see
+package lock).
+
+Every deployer also writes verify.sh when a component has post-install
+checks in the component registry, and appends a section describing them to
+README.md (see package postinstall).
+
+With config.WithRunbook, every deployer also writes runbook.md: pre-upgrade
+snapshot capture, one upgrade wave per component in deployment order with a
+health check after each, and rollback commands per component in reverse
+order (see package runbook).
+
+Update regenerates only the named components inside an existing bundle; the
+other components keep the versions and values they have in it, and the
+shared files and checksums are recomputed:
+
+	output, err := b.Update(ctx, recipeResult, "./bundle", []string{"gpu-operator"})
+
+With config.WithSchemaValidation, the final values of each component are
+checked against the values.schema.json of its chart, downloaded or read from
+config.WithSchemaDir, before anything is written (see package schema).
+
+Output.Overrides records which value overrides replaced an existing value and
+which matched no existing value or no component; config.WithStrictOverrides
+turns the latter into an error.
+
+config.WithInstallScopes installs components with namespace scope: their
+namespace scope values from the component registry are applied, and the
+Argo CD and Argo Workflows bundles stop creating their namespaces and, when
+every component is namespace-scoped, cluster-wide installer RBAC. Components
+whose chart does not support it, or whose manifests are cluster-scoped, fail
+bundle generation.
+
+With config.WithCapacityTemplate, capacity/ also holds node provisioning
+templates for GPU capacity matching the recipe criteria and accelerated node
+scheduling: a Karpenter NodePool and EC2NodeClass, or a Cluster API
+MachineDeployment (see package capacity).
+
+With config.WithPluginDir, components declared by exec plugins in that
+directory get their values from the plugin, and files the plugin adds are
+written to plugins/<component>/ (see package plugin).
+
+With config.WithConcurrency, component values are resolved and plugin files
+generated on a pool of that many workers. A component that fails does not
+stop the others: failed values resolution lists every failed component in
+the error, and failed plugin files are recorded in result.Output.Errors.
+
 # Configuration
 
 	cfg := config.NewConfig(
@@ -74,6 +131,14 @@ ArgoCD:
 	)
 	b, err := bundler.New(bundler.WithConfig(cfg))
 
+# Install Plans
+
+Plan returns what Make would install without writing files: enabled
+components in deployment order with namespaces, chart versions, value
+overrides and CRDs (see package plan).
+
+	p, err := b.Plan(ctx, recipeResult)
+
 # Adding New Components
 
 To add a new component, add an entry to pkg/recipe/data/registry.yaml.
@@ -0,0 +1,163 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// extraManifestPath returns the manifest path an extra manifest of a
+// component reference is shipped under, like the component's manifest files.
+func extraManifestPath(ref recipe.ComponentRef, filename string) string {
+	return path.Join("components", ref.Name, "manifests", filename)
+}
+
+// loadExtraManifests reads the extra manifest directories configured for the
+// component references of recipeResult, keyed by reference name and then by
+// file name. Directories are matched like value patches: those for the
+// registry component, then those for the instance name.
+func (b *DefaultBundler) loadExtraManifests(recipeResult *recipe.RecipeResult) (map[string]map[string][]byte, error) {
+	if b.Config == nil || len(b.Config.ExtraManifests()) == 0 {
+		return nil, nil
+	}
+	all := b.Config.ExtraManifests()
+
+	if d := b.Config.Deployer(); d != config.DeployerHelm && d != config.DeployerArgoCD {
+		return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("extra manifests are not supported by the %s deployer", d),
+			map[string]any{"deployer": d.String()})
+	}
+
+	var problems []string
+	used := make(map[string]bool, len(all))
+	extras := make(map[string]map[string][]byte)
+	for _, ref := range recipeResult.ComponentRefs {
+		dirs, key := lookupComponentKey(all, ref.ComponentName())
+		if key != "" {
+			used[key] = true
+		}
+		if ref.Name != ref.ComponentName() {
+			if list, ok := all[ref.Name]; ok {
+				dirs = append(dirs, list...)
+				used[ref.Name] = true
+			}
+		}
+
+		for _, dir := range dirs {
+			files, err := readManifestDir(dir)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range slices.Sorted(maps.Keys(files)) {
+				if extras[ref.Name] == nil {
+					extras[ref.Name] = make(map[string][]byte)
+				}
+				if _, exists := extras[ref.Name][name]; exists {
+					problems = append(problems, fmt.Sprintf("%s: manifest %s given twice", ref.Name, name))
+					continue
+				}
+				for _, existing := range ref.ManifestFiles {
+					if path.Base(existing) == name {
+						problems = append(problems, fmt.Sprintf("%s: manifest %s conflicts with %s", ref.Name, name, existing))
+					}
+				}
+				if b.installScope(ref) == config.InstallScopeNamespace {
+					if kinds := clusterScopedManifestKinds(files[name]); len(kinds) > 0 {
+						problems = append(problems, fmt.Sprintf("%s: manifest %s creates cluster-scoped %s in namespace scope",
+							ref.Name, name, strings.Join(kinds, ", ")))
+					}
+				}
+				extras[ref.Name][name] = files[name]
+			}
+		}
+	}
+	for key := range all {
+		if !used[key] {
+			problems = append(problems, fmt.Sprintf("%s: matches no component", key))
+		}
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid extra manifests: %s", strings.Join(problems, "; ")),
+			map[string]any{"problems": problems})
+	}
+	return extras, nil
+}
+
+// readManifestDir reads the *.yaml and *.yml files of dir, keyed by file
+// name. Subdirectories are not read.
+func readManifestDir(dir string) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.WrapWithContext(errors.ErrCodeInvalidRequest,
+			"failed to read extra manifests directory", err,
+			map[string]any{"dir": dir})
+	}
+
+	files := make(map[string][]byte)
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if e.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, errors.WrapWithContext(errors.ErrCodeInvalidRequest,
+				"failed to read extra manifest", err,
+				map[string]any{"file": filepath.Join(dir, e.Name())})
+		}
+		files[e.Name()] = content
+	}
+	if len(files) == 0 {
+		return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("extra manifests directory %s contains no *.yaml or *.yml files", dir),
+			map[string]any{"dir": dir})
+	}
+	return files, nil
+}
+
+// addExtraManifests adds the extra manifests to the manifest contents of the
+// umbrella chart. Its templates/ directory holds the manifests of all
+// components by file name, so a file name may only be used once.
+func addExtraManifests(contents map[string][]byte, recipeResult *recipe.RecipeResult, extras map[string]map[string][]byte) error {
+	byName := make(map[string]string, len(contents))
+	for p := range contents {
+		byName[path.Base(p)] = p
+	}
+	for _, ref := range recipeResult.ComponentRefs {
+		for _, name := range slices.Sorted(maps.Keys(extras[ref.Name])) {
+			p := extraManifestPath(ref, name)
+			if existing, ok := byName[name]; ok {
+				return errors.NewWithContext(errors.ErrCodeInvalidRequest,
+					fmt.Sprintf("extra manifest %s conflicts with %s in the umbrella chart templates", p, existing),
+					map[string]any{"manifest": p, "existing": existing})
+			}
+			byName[name] = p
+			contents[p] = extras[ref.Name][name]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package fleet generates one bundle per cluster of a fleet inventory.
+
+A Fleet lists clusters, each described by recipe criteria or by a snapshot
+collected from it:
+
+	kind: Fleet
+	apiVersion: eidos.nvidia.com/v1alpha1
+	metadata:
+	  name: gpu-fleet
+	spec:
+	  clusters:
+	    - name: us-east-training
+	      criteria:
+	        service: eks
+	        accelerator: h100
+	        intent: training
+	      server: https://A1B2C3.gr7.us-east-1.eks.amazonaws.com
+	      profile: production
+	    - name: lab-inference
+	      snapshot: snapshots/lab.yaml
+
+Each cluster's bundle is generated into a subdirectory named after it. This
+package loads and validates the inventory, and writes the fleet-level
+artifacts next to the cluster bundles.
+
+# Artifacts
+
+  - README.md: the clusters, their criteria, recipes and bundle directories
+  - applicationset.yaml: an Argo CD ApplicationSet deploying each cluster's
+    Helm umbrella chart to that cluster (optional; needs a server per cluster)
+
+# Usage
+
+	f, err := fleet.Load("fleet.yaml", kubeconfig)
+	if err != nil {
+	    return err
+	}
+
+	// Build a recipe and generate a bundle per cluster into
+	// filepath.Join(outputDir, cluster.Name), then:
+	output, err := fleet.NewGenerator().Generate(ctx, &fleet.GeneratorInput{
+	    Fleet:          f,
+	    Bundles:        bundles,
+	    Version:        "v1.0.0",
+	    RepoURL:        "https://github.com/my-org/gitops.git",
+	    ApplicationSet: true,
+	}, outputDir)
+*/
+package fleet
@@ -0,0 +1,208 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleet
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+const (
+	// Kind is the kind of a fleet inventory document.
+	Kind = "Fleet"
+
+	// APIVersion is the API version of a fleet inventory document.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+)
+
+// clusterNamePattern matches cluster names, which are used as bundle
+// directory and Argo CD Application names.
+var clusterNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// Fleet is an inventory of the clusters to generate bundles for.
+type Fleet struct {
+	// Kind is always "Fleet".
+	Kind string `json:"kind" yaml:"kind"`
+
+	// APIVersion is the fleet API version.
+	APIVersion string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+
+	// Metadata names the fleet.
+	Metadata Metadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+
+	// Spec lists the clusters.
+	Spec Spec `json:"spec" yaml:"spec"`
+}
+
+// Metadata names a fleet.
+type Metadata struct {
+	// Name names the fleet, and its ApplicationSet. Defaults to "eidos-fleet".
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Spec lists the clusters of a fleet.
+type Spec struct {
+	// Clusters lists the clusters, in the order their bundles are generated.
+	Clusters []Cluster `json:"clusters" yaml:"clusters"`
+}
+
+// Cluster is a cluster of a fleet. Exactly one of Criteria and Snapshot
+// describes it.
+type Cluster struct {
+	// Name names the cluster and its bundle directory.
+	Name string `json:"name" yaml:"name"`
+
+	// Criteria are the recipe criteria of the cluster.
+	Criteria *recipe.Criteria `json:"criteria,omitempty" yaml:"criteria,omitempty"`
+
+	// Snapshot is the path or URI of a snapshot collected from the cluster,
+	// from which its criteria are detected. Relative paths are resolved
+	// against the directory of the fleet file.
+	Snapshot string `json:"snapshot,omitempty" yaml:"snapshot,omitempty"`
+
+	// Kubeconfig is the kubeconfig used to read a cm:// snapshot. Defaults
+	// to the kubeconfig the fleet was loaded with.
+	Kubeconfig string `json:"kubeconfig,omitempty" yaml:"kubeconfig,omitempty"`
+
+	// Profile is the bundle profile of the cluster, overriding the one the
+	// fleet bundles are generated with.
+	Profile string `json:"profile,omitempty" yaml:"profile,omitempty"`
+
+	// Server is the Kubernetes API server URL of the cluster as registered
+	// in Argo CD, used as the ApplicationSet destination.
+	Server string `json:"server,omitempty" yaml:"server,omitempty"`
+}
+
+// Source describes where the cluster criteria come from.
+func (c *Cluster) Source() string {
+	if c.Snapshot != "" {
+		return "snapshot " + c.Snapshot
+	}
+	return "criteria"
+}
+
+// Load reads a fleet inventory from a file path or URI, validates it and
+// resolves relative snapshot paths against the directory of the file.
+// kubeconfig is used to read a ConfigMap URI and is the default kubeconfig
+// of cm:// snapshots.
+func Load(path, kubeconfig string) (*Fleet, error) {
+	f, err := serializer.FromFileWithKubeconfig[Fleet](path, kubeconfig)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("failed to load fleet from %q", path), err)
+	}
+	for i := range f.Spec.Clusters {
+		normalizeCriteria(f.Spec.Clusters[i].Criteria)
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	local := !strings.Contains(path, "://")
+	for i := range f.Spec.Clusters {
+		c := &f.Spec.Clusters[i]
+		if local && c.Snapshot != "" && !strings.Contains(c.Snapshot, "://") && !filepath.IsAbs(c.Snapshot) {
+			c.Snapshot = filepath.Join(filepath.Dir(path), c.Snapshot)
+		}
+		if c.Kubeconfig == "" {
+			c.Kubeconfig = kubeconfig
+		}
+	}
+
+	return f, nil
+}
+
+// Validate checks the fleet kind and its clusters: names must be unique DNS
+// labels, each cluster needs exactly one of criteria and snapshot, and
+// profiles must exist in the recipe data.
+func (f *Fleet) Validate() error {
+	if f == nil {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "fleet cannot be nil")
+	}
+	if f.Kind != Kind {
+		return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+			"unexpected fleet kind", map[string]any{"kind": f.Kind, "expected": Kind})
+	}
+	if f.APIVersion != "" && f.APIVersion != APIVersion {
+		return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+			"unexpected fleet apiVersion", map[string]any{"apiVersion": f.APIVersion, "expected": APIVersion})
+	}
+	if len(f.Spec.Clusters) == 0 {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "fleet must list at least one cluster")
+	}
+
+	seen := make(map[string]struct{}, len(f.Spec.Clusters))
+	for _, c := range f.Spec.Clusters {
+		if !clusterNamePattern.MatchString(c.Name) {
+			return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"fleet cluster name must be a lowercase DNS label", map[string]any{"cluster": c.Name})
+		}
+		if _, ok := seen[c.Name]; ok {
+			return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"duplicate fleet cluster", map[string]any{"cluster": c.Name})
+		}
+		seen[c.Name] = struct{}{}
+
+		if (c.Criteria == nil) == (c.Snapshot == "") {
+			return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"fleet cluster needs exactly one of criteria and snapshot", map[string]any{"cluster": c.Name})
+		}
+		if c.Criteria != nil && c.Criteria.Specificity() == 0 {
+			return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"fleet cluster criteria cannot be empty", map[string]any{"cluster": c.Name})
+		}
+		if c.Profile != "" {
+			if _, err := recipe.LoadBundleProfile(c.Profile); err != nil {
+				return eidoserrors.WrapWithContext(eidoserrors.ErrCodeInvalidRequest,
+					"invalid fleet cluster profile", err, map[string]any{"cluster": c.Name})
+			}
+		}
+	}
+
+	return nil
+}
+
+// Name returns the fleet name, defaulting to "eidos-fleet".
+func (f *Fleet) Name() string {
+	if f.Metadata.Name != "" {
+		return f.Metadata.Name
+	}
+	return "eidos-fleet"
+}
+
+// normalizeCriteria sets the criteria fields a fleet file leaves out to any.
+func normalizeCriteria(c *recipe.Criteria) {
+	if c == nil {
+		return
+	}
+	if c.Service == "" {
+		c.Service = recipe.CriteriaServiceAny
+	}
+	if c.Accelerator == "" {
+		c.Accelerator = recipe.CriteriaAcceleratorAny
+	}
+	if c.Intent == "" {
+		c.Intent = recipe.CriteriaIntentAny
+	}
+	if c.OS == "" {
+		c.OS = recipe.CriteriaOSAny
+	}
+}
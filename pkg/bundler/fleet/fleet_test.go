@@ -0,0 +1,207 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleet
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func writeFleet(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fleet.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeFleet(t, `kind: Fleet
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  name: gpu-fleet
+spec:
+  clusters:
+    - name: training
+      criteria:
+        service: eks
+        accelerator: h100
+    - name: lab
+      snapshot: snapshots/lab.yaml
+`)
+
+	f, err := Load(path, "/tmp/kubeconfig")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if f.Name() != "gpu-fleet" {
+		t.Errorf("Name() = %q, want gpu-fleet", f.Name())
+	}
+
+	training := f.Spec.Clusters[0]
+	if training.Criteria.Service != recipe.CriteriaServiceEKS || training.Criteria.Intent != recipe.CriteriaIntentAny {
+		t.Errorf("criteria = %s, want service=eks and any intent", training.Criteria)
+	}
+
+	lab := f.Spec.Clusters[1]
+	if want := filepath.Join(filepath.Dir(path), "snapshots", "lab.yaml"); lab.Snapshot != want {
+		t.Errorf("Snapshot = %q, want %q", lab.Snapshot, want)
+	}
+	if lab.Kubeconfig != "/tmp/kubeconfig" {
+		t.Errorf("Kubeconfig = %q, want the default kubeconfig", lab.Kubeconfig)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	criteria := &recipe.Criteria{
+		Service:     recipe.CriteriaServiceEKS,
+		Accelerator: recipe.CriteriaAcceleratorAny,
+		Intent:      recipe.CriteriaIntentAny,
+		OS:          recipe.CriteriaOSAny,
+	}
+	tests := []struct {
+		name    string
+		fleet   *Fleet
+		wantErr string
+	}{
+		{"nil", nil, "cannot be nil"},
+		{"wrong kind", &Fleet{Kind: "Recipe"}, "unexpected fleet kind"},
+		{"no clusters", &Fleet{Kind: Kind}, "at least one cluster"},
+		{"invalid name", &Fleet{Kind: Kind, Spec: Spec{Clusters: []Cluster{
+			{Name: "US_East", Criteria: criteria},
+		}}}, "DNS label"},
+		{"duplicate", &Fleet{Kind: Kind, Spec: Spec{Clusters: []Cluster{
+			{Name: "a", Criteria: criteria},
+			{Name: "a", Snapshot: "snap.yaml"},
+		}}}, "duplicate"},
+		{"criteria and snapshot", &Fleet{Kind: Kind, Spec: Spec{Clusters: []Cluster{
+			{Name: "a", Criteria: criteria, Snapshot: "snap.yaml"},
+		}}}, "exactly one"},
+		{"neither", &Fleet{Kind: Kind, Spec: Spec{Clusters: []Cluster{{Name: "a"}}}}, "exactly one"},
+		{"unknown profile", &Fleet{Kind: Kind, Spec: Spec{Clusters: []Cluster{
+			{Name: "a", Criteria: criteria, Profile: "nope"},
+		}}}, "profile"},
+		{"valid", &Fleet{Kind: Kind, Spec: Spec{Clusters: []Cluster{
+			{Name: "a", Criteria: criteria, Profile: "dev"},
+			{Name: "b", Snapshot: "snap.yaml"},
+		}}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fleet.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// clusterBundles returns a bundle with a one-component recipe per cluster of f.
+func clusterBundles(f *Fleet) []ClusterBundle {
+	bundles := make([]ClusterBundle, 0, len(f.Spec.Clusters))
+	for _, c := range f.Spec.Clusters {
+		bundles = append(bundles, ClusterBundle{
+			Cluster: c,
+			Recipe: &recipe.RecipeResult{
+				Criteria:      &recipe.Criteria{Service: recipe.CriteriaServiceEKS},
+				ComponentRefs: []recipe.ComponentRef{{Name: "gpu-operator"}},
+			},
+			Output: &result.Output{},
+		})
+	}
+	return bundles
+}
+
+func TestGenerate(t *testing.T) {
+	f := &Fleet{Kind: Kind, Metadata: Metadata{Name: "gpu-fleet"}, Spec: Spec{Clusters: []Cluster{
+		{Name: "training", Server: "https://training.example.com", Profile: "production"},
+		{Name: "inference", Snapshot: "inference.yaml"},
+	}}}
+	bundles := clusterBundles(f)
+
+	t.Run("readme only", func(t *testing.T) {
+		dir := t.TempDir()
+		out, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+			Fleet: f, Bundles: bundles, Version: "v1.0.0",
+		}, dir)
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		if len(out.Files) != 1 {
+			t.Fatalf("Files = %v, want README.md only", out.Files)
+		}
+		readme, err := os.ReadFile(filepath.Join(dir, ReadmeFileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{"# Fleet gpu-fleet", "`training`", "snapshot inference.yaml", "`production`"} {
+			if !strings.Contains(string(readme), want) {
+				t.Errorf("README.md missing %q:\n%s", want, readme)
+			}
+		}
+	})
+
+	t.Run("applicationset needs servers", func(t *testing.T) {
+		_, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+			Fleet: f, Bundles: bundles, ApplicationSet: true,
+		}, t.TempDir())
+		if err == nil || !strings.Contains(err.Error(), "inference") {
+			t.Errorf("Generate() error = %v, want missing server of inference", err)
+		}
+	})
+
+	t.Run("applicationset", func(t *testing.T) {
+		withServers := *f
+		withServers.Spec.Clusters = []Cluster{f.Spec.Clusters[0], f.Spec.Clusters[1]}
+		withServers.Spec.Clusters[1].Server = "https://inference.example.com"
+
+		dir := t.TempDir()
+		_, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+			Fleet: &withServers, Bundles: clusterBundles(&withServers), Version: "v1.0.0",
+			RepoURL: "https://github.com/my-org/gitops.git", ApplicationSet: true,
+		}, dir)
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		appSet, err := os.ReadFile(filepath.Join(dir, ApplicationSetFileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{
+			"kind: ApplicationSet",
+			"server: https://inference.example.com",
+			"path: training",
+			"repoURL: https://github.com/my-org/gitops.git",
+			"server: '{{ .server }}'",
+		} {
+			if !strings.Contains(string(appSet), want) {
+				t.Errorf("applicationset.yaml missing %q:\n%s", want, appSet)
+			}
+		}
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleet
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+//go:embed templates/applicationset.yaml.tmpl
+var applicationSetTemplate string
+
+const (
+	// ReadmeFileName describes the fleet and its cluster bundles.
+	ReadmeFileName = "README.md"
+
+	// ApplicationSetFileName deploys the cluster bundles with Argo CD.
+	ApplicationSetFileName = "applicationset.yaml"
+
+	// defaultRepoURL is the placeholder repository of the ApplicationSet
+	// when no repository URL is given.
+	defaultRepoURL = "https://github.com/YOUR-ORG/YOUR-REPO.git"
+)
+
+// ClusterBundle is the bundle generated for a cluster of the fleet.
+type ClusterBundle struct {
+	// Cluster is the fleet cluster.
+	Cluster Cluster
+
+	// Recipe is the recipe the bundle was generated from.
+	Recipe *recipe.RecipeResult
+
+	// Output is the bundle generation output.
+	Output *result.Output
+}
+
+// GeneratorInput contains the data needed to write the fleet artifacts.
+type GeneratorInput struct {
+	// Fleet is the fleet inventory.
+	Fleet *Fleet
+
+	// Bundles are the generated cluster bundles, in fleet order.
+	Bundles []ClusterBundle
+
+	// Version is the bundler version.
+	Version string
+
+	// RepoURL is the Git repository the ApplicationSet deploys from.
+	RepoURL string
+
+	// ApplicationSet adds an Argo CD ApplicationSet deploying the Helm
+	// umbrella chart of each cluster. Every cluster needs a server.
+	ApplicationSet bool
+}
+
+// GeneratorOutput contains the result of writing the fleet artifacts.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the artifacts.
+	Duration time.Duration
+}
+
+// clusterData is a cluster rendered into the fleet templates.
+type clusterData struct {
+	Name          string
+	Source        string
+	Criteria      string
+	RecipeVersion string
+	Components    int
+	Profile       string
+	Server        string
+}
+
+// templateData is the data rendered into the fleet templates.
+type templateData struct {
+	Name               string
+	BundlerVersion     string
+	Clusters           []clusterData
+	ApplicationSet     bool
+	ApplicationSetFile string
+	RepoURL            string
+	TargetRevision     string
+	ReleaseName        string
+	Namespace          string
+}
+
+// Generator writes the fleet-level artifacts.
+type Generator struct{}
+
+// NewGenerator creates a new fleet artifact generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// ValidateApplicationSet checks that every cluster of f has the server an
+// ApplicationSet deploys to.
+func ValidateApplicationSet(f *Fleet) error {
+	var missing []string
+	for _, c := range f.Spec.Clusters {
+		if c.Server == "" {
+			missing = append(missing, c.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.NewWithContext(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("fleet clusters need a server for the ApplicationSet: %s", strings.Join(missing, ", ")),
+			map[string]any{"clusters": missing})
+	}
+	return nil
+}
+
+// Generate writes README.md, and applicationset.yaml when requested, to
+// outputDir, next to the cluster bundle directories.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || input.Fleet == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and fleet are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+	if input.ApplicationSet {
+		if err := ValidateApplicationSet(input.Fleet); err != nil {
+			return nil, err
+		}
+	}
+
+	repoURL := input.RepoURL
+	if repoURL == "" {
+		repoURL = defaultRepoURL
+	}
+	data := &templateData{
+		Name:               input.Fleet.Name(),
+		BundlerVersion:     input.Version,
+		ApplicationSet:     input.ApplicationSet,
+		ApplicationSetFile: ApplicationSetFileName,
+		RepoURL:            repoURL,
+		TargetRevision:     "main",
+		ReleaseName:        helm.ReleaseName,
+		Namespace:          helm.ReleaseNamespace,
+	}
+	for _, b := range input.Bundles {
+		cd := clusterData{
+			Name:    b.Cluster.Name,
+			Source:  b.Cluster.Source(),
+			Profile: b.Cluster.Profile,
+			Server:  b.Cluster.Server,
+		}
+		if b.Recipe != nil {
+			cd.Criteria = "-"
+			if b.Recipe.Criteria != nil {
+				cd.Criteria = b.Recipe.Criteria.String()
+			}
+			cd.RecipeVersion = b.Recipe.Metadata.Version
+			cd.Components = len(b.Recipe.ComponentRefs)
+		}
+		data.Clusters = append(data.Clusters, cd)
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create fleet directory", err)
+	}
+
+	output := &GeneratorOutput{}
+	readme, err := renderTemplate(template.New("readme"), readmeTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render fleet README", err)
+	}
+	if err := writeFile(output, filepath.Join(outputDir, ReadmeFileName), readme); err != nil {
+		return nil, err
+	}
+
+	if input.ApplicationSet {
+		// The ApplicationSet template holds Argo CD's own {{ }} placeholders
+		appSet, err := renderTemplate(template.New("applicationset").Delims("[[", "]]"), applicationSetTemplate, data)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render fleet ApplicationSet", err)
+		}
+		if err := writeFile(output, filepath.Join(outputDir, ApplicationSetFileName), appSet); err != nil {
+			return nil, err
+		}
+	}
+
+	output.Duration = time.Since(start)
+
+	slog.Debug("fleet artifacts generated",
+		"fleet", data.Name,
+		"clusters", len(data.Clusters),
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+	)
+
+	return output, nil
+}
+
+// renderTemplate parses tmplContent into tmpl and renders it with data.
+func renderTemplate(tmpl *template.Template, tmplContent string, data any) ([]byte, error) {
+	tmpl, err := tmpl.Parse(tmplContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+	return []byte(buf.String()), nil
+}
+
+// writeFile writes content to path and records it in output.
+func writeFile(output *GeneratorOutput, path string, content []byte) error {
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to write %s", filepath.Base(path)), err)
+	}
+	output.Files = append(output.Files, path)
+	output.TotalSize += int64(len(content))
+	return nil
+}
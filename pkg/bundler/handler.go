@@ -15,16 +15,22 @@
 package bundler
 
 import (
+	"archive/tar"
 	"archive/zip"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -32,15 +38,26 @@ import (
 	"github.com/NVIDIA/eidos/pkg/bundler/result"
 	"github.com/NVIDIA/eidos/pkg/defaults"
 	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/janitor"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 	"github.com/NVIDIA/eidos/pkg/server"
 	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/version"
 )
 
 // DefaultBundleTimeout is the timeout for bundle generation.
 // Exported for backwards compatibility; prefer using defaults.BundleHandlerTimeout.
 const DefaultBundleTimeout = defaults.BundleHandlerTimeout
 
+// Bundle archive formats returned by HandleBundles.
+const (
+	archiveFormatZip = "zip"
+	archiveFormatTgz = "tgz"
+)
+
+// mediaTypeTarGzip is the Content-Type of tar.gz bundle archives.
+const mediaTypeTarGzip = "application/x-tar+gzip"
+
 // HandleBundles processes bundle generation requests.
 // It accepts a POST request with a JSON body containing the recipe (RecipeResult).
 // Supports query parameters:
@@ -49,8 +66,15 @@ const DefaultBundleTimeout = defaults.BundleHandlerTimeout
 //   - system-node-toleration: Tolerations for system components in format "key=value:effect" (can be repeated)
 //   - accelerated-node-selector: Node selectors for GPU nodes in format "key=value" (can be repeated)
 //   - accelerated-node-toleration: Tolerations for GPU nodes in format "key=value:effect" (can be repeated)
+//   - kubernetes-version: Target Kubernetes version; incompatible components are
+//     listed in the bundle README
+//   - async: When true, respond 202 Accepted with a bundle job instead of the archive
+//     (see HandleBundleJobStatus and HandleBundleJobDownload)
+//   - format: Archive format, "zip" (default) or "tgz". Without it, an Accept
+//     header of application/x-tar+gzip selects tgz. A tgz archive is streamed
+//     file by file as it is written, so large bundles are never held in memory.
 //
-// The response is a zip archive containing the umbrella Helm chart:
+// The response is an archive containing the umbrella Helm chart:
 //   - Chart.yaml: Helm chart metadata with dependencies
 //   - values.yaml: Combined values for all components
 //   - README.md: Deployment instructions
@@ -87,10 +111,7 @@ func (b *DefaultBundler) HandleBundles(w http.ResponseWriter, r *http.Request) {
 	var recipeResult recipe.RecipeResult
 	err = json.NewDecoder(r.Body).Decode(&recipeResult)
 	if err != nil {
-		server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
-			"Invalid request body", false, map[string]any{
-				"error": err.Error(),
-			})
+		server.WriteRequestBodyError(w, r, err, "Invalid request body")
 		return
 	}
 
@@ -116,27 +137,36 @@ func (b *DefaultBundler) HandleBundles(w http.ResponseWriter, r *http.Request) {
 		"accelerated_node_selectors", len(params.acceleratedNodeSelector),
 	)
 
+	// Async jobs hold their slot until they finish, so they count against
+	// the same limit as synchronous requests
+	endpoint := metricsEndpointSync
+	if params.async {
+		endpoint = metricsEndpointAsync
+	}
+	releaseSlot, ok := b.acquireSlot(endpoint)
+	if !ok {
+		b.writeTooManyBundles(w, r)
+		return
+	}
+
+	// Large bundles can outlive the request timeout; generate them in the background
+	if params.async {
+		b.submitJob(w, r, params, &recipeResult, releaseSlot)
+		return
+	}
+	defer releaseSlot()
+
 	// Create temporary directory for bundle output
-	tempDir, err := os.MkdirTemp("", "eidos-bundle-*")
+	tempDir, release, err := b.makeTempDir()
 	if err != nil {
 		server.WriteError(w, r, http.StatusInternalServerError, eidoserrors.ErrCodeInternal,
 			"Failed to create temporary directory", true, nil)
 		return
 	}
-	defer os.RemoveAll(tempDir) // Clean up on exit
+	defer release() // Clean up on exit
 
 	// Create a new bundler with configuration
-	bundler, err := New(
-		WithConfig(config.NewConfig(
-			config.WithValueOverrides(params.valueOverrides),
-			config.WithSystemNodeSelector(params.systemNodeSelector),
-			config.WithSystemNodeTolerations(params.systemNodeTolerations),
-			config.WithAcceleratedNodeSelector(params.acceleratedNodeSelector),
-			config.WithAcceleratedNodeTolerations(params.acceleratedNodeTolerations),
-			config.WithDeployer(params.deployer),
-			config.WithRepoURL(params.repoURL),
-		)),
-	)
+	bundler, err := newRequestBundler(params)
 	if err != nil {
 		server.WriteError(w, r, http.StatusInternalServerError, eidoserrors.ErrCodeInternal,
 			"Failed to create bundler", true, map[string]any{
@@ -146,7 +176,9 @@ func (b *DefaultBundler) HandleBundles(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Generate umbrella chart
+	start := time.Now()
 	output, err := bundler.Make(ctx, &recipeResult, tempDir)
+	recordBundle(metricsEndpointSync, params.deployer, start, output, err)
 	if err != nil {
 		server.WriteErrorFromErr(w, r, err, "Failed to generate bundle", nil)
 		return
@@ -168,24 +200,158 @@ func (b *DefaultBundler) HandleBundles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Stream zip response
-	if err := streamZipResponse(w, tempDir, output); err != nil {
+	// Stream archive response
+	stream := streamZipResponse
+	if params.format == archiveFormatTgz {
+		stream = streamTarGzResponse
+	}
+	if err := stream(w, tempDir, output); err != nil {
 		// Can't write error response if we've already started writing
-		slog.Error("failed to stream zip response", "error", err)
+		slog.Error("failed to stream bundle response", "format", params.format, "error", err)
 		return
 	}
 }
 
+// newRequestBundler creates a bundler configured from the request parameters.
+func newRequestBundler(params *bundleParams) (*DefaultBundler, error) {
+	return New(
+		WithConfig(config.NewConfig(
+			config.WithValueOverrides(params.valueOverrides),
+			config.WithSystemNodeSelector(params.systemNodeSelector),
+			config.WithSystemNodeTolerations(params.systemNodeTolerations),
+			config.WithAcceleratedNodeSelector(params.acceleratedNodeSelector),
+			config.WithAcceleratedNodeTolerations(params.acceleratedNodeTolerations),
+			config.WithDeployer(params.deployer),
+			config.WithRepoURL(params.repoURL),
+			config.WithApplicationSet(params.applicationSet),
+			config.WithClusterSelector(params.clusterSelector),
+			config.WithKubernetesVersion(params.kubernetesVersion),
+			config.WithCapacityTemplate(params.capacityTemplate),
+			config.WithProfile(params.profile),
+			config.WithRunbook(params.runbook),
+			config.WithNodeBootstrap(params.nodeBootstrap),
+			config.WithNodeLabels(params.nodeLabels),
+		)),
+	)
+}
+
+// MakeArchive generates the bundle for recipeResult and writes it to w as a
+// zip or tgz archive. The options are given as the HandleBundles query
+// parameters; async is not supported. It returns the bundle output once the
+// archive is complete.
+func (b *DefaultBundler) MakeArchive(ctx context.Context, recipeResult *recipe.RecipeResult, query url.Values, w io.Writer) (*result.Output, error) {
+	params, err := parseBundleParams(query, nil)
+	if err != nil {
+		return nil, err
+	}
+	if params.async {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"Async bundle jobs are only supported over HTTP")
+	}
+	if recipeResult == nil || len(recipeResult.ComponentRefs) == 0 {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"Recipe must contain at least one component reference")
+	}
+	if b.AllowLists != nil && recipeResult.Criteria != nil {
+		if err := b.AllowLists.ValidateCriteria(recipeResult.Criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	releaseSlot, ok := b.acquireSlot(metricsEndpointGRPC)
+	if !ok {
+		return nil, b.errTooManyBundles()
+	}
+	defer releaseSlot()
+
+	tempDir, release, err := b.makeTempDir()
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			"Failed to create temporary directory", err)
+	}
+	defer release()
+
+	bundler, err := newRequestBundler(params)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "Failed to create bundler", err)
+	}
+
+	start := time.Now()
+	output, err := bundler.Make(ctx, recipeResult, tempDir)
+	recordBundle(metricsEndpointGRPC, params.deployer, start, output, err)
+	if err != nil {
+		return nil, err
+	}
+	if output.HasErrors() {
+		failed := make([]string, 0, len(output.Errors))
+		for _, be := range output.Errors {
+			failed = append(failed, fmt.Sprintf("%s: %s", be.BundlerType, be.Error))
+		}
+		return nil, eidoserrors.NewWithContext(eidoserrors.ErrCodeInternal,
+			"Bundle generation failed", map[string]any{"errors": failed})
+	}
+
+	if params.format == archiveFormatTgz {
+		err = writeTarGz(w, tempDir, nil)
+	} else {
+		err = writeZip(w, tempDir)
+	}
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "Failed to write bundle archive", err)
+	}
+	return output, nil
+}
+
+// makeTempDir creates the bundle scratch directory, through the janitor when
+// one is configured. The returned release function removes the directory.
+func (b *DefaultBundler) makeTempDir() (string, func(), error) {
+	if b.Janitor != nil {
+		return b.Janitor.MkdirTemp(janitor.DefaultPattern)
+	}
+
+	dir, err := os.MkdirTemp("", janitor.DefaultPattern)
+	if err != nil {
+		return "", nil, err
+	}
+	return dir, func() { os.RemoveAll(dir) }, nil
+}
+
 // streamZipResponse creates a zip archive from the output directory and streams it to the response.
 func streamZipResponse(w http.ResponseWriter, dir string, output *result.Output) error {
 	// Set response headers before writing body
 	w.Header().Set("Content-Type", "application/zip")
 	w.Header().Set("Content-Disposition", "attachment; filename=\"bundles.zip\"")
+	setBundleHeaders(w, output)
+
+	return writeZip(w, dir)
+}
+
+// streamTarGzResponse streams the output directory to the response as a
+// tar.gz archive, flushing after every file.
+func streamTarGzResponse(w http.ResponseWriter, dir string, output *result.Output) error {
+	w.Header().Set("Content-Type", mediaTypeTarGzip)
+	w.Header().Set("Content-Disposition", "attachment; filename=\"bundles.tar.gz\"")
+	setBundleHeaders(w, output)
+
+	rc := http.NewResponseController(w)
+	return writeTarGz(w, dir, func() error {
+		// Not every ResponseWriter can flush; the archive is still complete
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	})
+}
+
+// setBundleHeaders sets the X-Bundle-* summary headers.
+func setBundleHeaders(w http.ResponseWriter, output *result.Output) {
 	w.Header().Set("X-Bundle-Files", strconv.Itoa(output.TotalFiles))
 	w.Header().Set("X-Bundle-Size", strconv.FormatInt(output.TotalSize, 10))
 	w.Header().Set("X-Bundle-Duration", output.TotalDuration.String())
+}
 
-	// Create zip writer directly to response
+// writeZip writes the contents of dir to w as a zip archive.
+func writeZip(w io.Writer, dir string) error {
 	zw := zip.NewWriter(w)
 	defer zw.Close()
 
@@ -244,6 +410,106 @@ func streamZipResponse(w http.ResponseWriter, dir string, output *result.Output)
 	})
 }
 
+// writeTarGz writes the contents of dir to w as a gzip-compressed tar archive.
+// flush, when set, is called after each file so the archive reaches the
+// client incrementally.
+func writeTarGz(w io.Writer, dir string, flush func() error) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk error: %w", err)
+		}
+
+		// Skip the root directory itself
+		if path == dir {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("failed to get relative path: %w", err)
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return fmt.Errorf("failed to create file header: %w", err)
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			header.Name += "/"
+			return tw.WriteHeader(header)
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to create tar entry: %w", err)
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(tw, file); err != nil {
+			return fmt.Errorf("failed to copy file content: %w", err)
+		}
+
+		if flush == nil {
+			return nil
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("failed to flush tar entry: %w", err)
+		}
+		if err := gz.Flush(); err != nil {
+			return fmt.Errorf("failed to flush gzip stream: %w", err)
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize tar archive: %w", err)
+	}
+	return gz.Close()
+}
+
+// parseArchiveFormat selects the archive format from the format query
+// parameter, falling back to the Accept header.
+func parseArchiveFormat(r *http.Request) (string, error) {
+	return parseArchiveFormatValues(r.URL.Query().Get("format"), r.Header.Values("Accept"))
+}
+
+// parseArchiveFormatValues selects the archive format from a format
+// parameter, falling back to the Accept header values.
+func parseArchiveFormatValues(format string, accept []string) (string, error) {
+	switch strings.ToLower(format) {
+	case "":
+	case archiveFormatZip:
+		return archiveFormatZip, nil
+	case archiveFormatTgz, "tar.gz":
+		return archiveFormatTgz, nil
+	default:
+		return "", eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest, "Invalid format parameter", map[string]any{
+			"format": format,
+			"valid":  []string{archiveFormatZip, archiveFormatTgz},
+		})
+	}
+
+	for _, value := range accept {
+		for _, mediaType := range strings.Split(value, ",") {
+			mediaType, _, _ = strings.Cut(mediaType, ";")
+			switch strings.TrimSpace(strings.ToLower(mediaType)) {
+			case mediaTypeTarGzip, "application/gzip":
+				return archiveFormatTgz, nil
+			}
+		}
+	}
+	return archiveFormatZip, nil
+}
+
 // bundleParams holds parsed query parameters for bundle generation
 type bundleParams struct {
 	valueOverrides             map[string]map[string]string
@@ -253,11 +519,26 @@ type bundleParams struct {
 	acceleratedNodeTolerations []corev1.Toleration
 	deployer                   config.DeployerType
 	repoURL                    string
+	applicationSet             config.ApplicationSetGenerator
+	clusterSelector            map[string]string
+	kubernetesVersion          string
+	capacityTemplate           config.CapacityTemplateType
+	profile                    string
+	runbook                    bool
+	nodeBootstrap              bool
+	nodeLabels                 bool
+	async                      bool
+	format                     string
 }
 
 // parseQueryParams extracts and validates all query parameters from the request
 func parseQueryParams(r *http.Request) (*bundleParams, error) {
-	query := r.URL.Query()
+	return parseBundleParams(r.URL.Query(), r.Header.Values("Accept"))
+}
+
+// parseBundleParams extracts and validates the bundle parameters from query
+// values, using the Accept header values when no format is given.
+func parseBundleParams(query url.Values, accept []string) (*bundleParams, error) {
 	params := &bundleParams{}
 
 	var err error
@@ -292,7 +573,7 @@ func parseQueryParams(r *http.Request) (*bundleParams, error) {
 		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid accelerated-node-toleration", err)
 	}
 
-	// Parse deployer type (helm, argocd)
+	// Parse deployer type (helm, argocd, argo-workflows, terraform)
 	deployerStr := query.Get("deployer")
 	if deployerStr == "" {
 		params.deployer = config.DeployerHelm // default
@@ -306,5 +587,87 @@ func parseQueryParams(r *http.Request) (*bundleParams, error) {
 	// Parse repo URL (for ArgoCD deployer)
 	params.repoURL = query.Get("repo")
 
+	// Parse ApplicationSet generator and cluster selector (for ArgoCD deployer)
+	params.applicationSet, err = config.ParseApplicationSetGenerator(query.Get("applicationset-generator"))
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid applicationset-generator parameter", err)
+	}
+	params.clusterSelector, err = snapshotter.ParseNodeSelectors(query["cluster-selector"])
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid cluster-selector", err)
+	}
+	if params.applicationSet != config.ApplicationSetNone && params.deployer != config.DeployerArgoCD {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"applicationset-generator requires deployer=argocd")
+	}
+	if len(params.clusterSelector) > 0 && params.applicationSet != config.ApplicationSetCluster {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"cluster-selector requires applicationset-generator=cluster")
+	}
+
+	// Parse target Kubernetes version (for compatibility checks)
+	if k8sVersion := query.Get("kubernetes-version"); k8sVersion != "" {
+		if _, err = version.ParseVersion(k8sVersion); err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid kubernetes-version parameter", err)
+		}
+		params.kubernetesVersion = k8sVersion
+	}
+
+	// Parse capacity template type (auto, karpenter, cluster-api)
+	params.capacityTemplate, err = config.ParseCapacityTemplateType(query.Get("capacity-template"))
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid capacity-template parameter", err)
+	}
+
+	// Parse bundle profile (dev, staging, production, or one from external data)
+	if params.profile = query.Get("profile"); params.profile != "" {
+		if _, err = recipe.LoadBundleProfile(params.profile); err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid profile parameter", err)
+		}
+	}
+
+	// Parse runbook generation
+	if runbookStr := query.Get("runbook"); runbookStr != "" {
+		params.runbook, err = strconv.ParseBool(runbookStr)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid runbook parameter", err)
+		}
+	}
+
+	// Parse node bootstrap generation
+	if bootstrapStr := query.Get("node-bootstrap"); bootstrapStr != "" {
+		params.nodeBootstrap, err = strconv.ParseBool(bootstrapStr)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid node-bootstrap parameter", err)
+		}
+	}
+
+	// Parse node labels generation
+	if labelsStr := query.Get("node-labels"); labelsStr != "" {
+		params.nodeLabels, err = strconv.ParseBool(labelsStr)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid node-labels parameter", err)
+		}
+	}
+
+	// Parse async mode
+	if asyncStr := query.Get("async"); asyncStr != "" {
+		params.async, err = strconv.ParseBool(asyncStr)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid async parameter", err)
+		}
+	}
+
+	// Parse archive format (query parameter or Accept header)
+	params.format, err = parseArchiveFormatValues(query.Get("format"), accept)
+	if err != nil {
+		return nil, err
+	}
+	// Async jobs store a zip archive for later download
+	if params.async && params.format != archiveFormatZip {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"Async bundle jobs only support the zip format")
+	}
+
 	return params, nil
 }
@@ -0,0 +1,277 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/jobs"
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/server"
+)
+
+// bundleJobResponse is a bundle job with the URLs used to follow it.
+type bundleJobResponse struct {
+	*jobs.Job
+	StatusURL   string `json:"statusUrl"`
+	DownloadURL string `json:"downloadUrl"`
+}
+
+func newBundleJobResponse(job *jobs.Job) bundleJobResponse {
+	return bundleJobResponse{
+		Job:         job,
+		StatusURL:   "/v1/bundle/" + job.ID + "/status",
+		DownloadURL: "/v1/bundle/" + job.ID + "/download",
+	}
+}
+
+// submitJob records an asynchronous bundle job, starts it in the background
+// and responds 202 Accepted with the job. releaseSlot frees the bundle slot
+// the job holds once it finishes, or right away when it cannot be started.
+func (b *DefaultBundler) submitJob(w http.ResponseWriter, r *http.Request, params *bundleParams, recipeResult *recipe.RecipeResult, releaseSlot func()) {
+	job, err := jobs.NewJob(len(recipeResult.ComponentRefs))
+	if err != nil {
+		releaseSlot()
+		server.WriteErrorFromErr(w, r, err, "Failed to create bundle job", nil)
+		return
+	}
+	if err := b.Jobs.Put(r.Context(), job); err != nil {
+		releaseSlot()
+		server.WriteErrorFromErr(w, r, err, "Failed to store bundle job", nil)
+		return
+	}
+
+	slog.Info("bundle job submitted", "job", job.ID, "components", job.Components)
+
+	// Respond with a snapshot; runJob updates job concurrently
+	submitted := *job
+	resp := newBundleJobResponse(&submitted)
+
+	// The job must not be canceled when the request completes
+	go func() {
+		defer releaseSlot()
+		b.runJob(context.WithoutCancel(r.Context()), job, params, recipeResult)
+	}()
+
+	w.Header().Set("Location", resp.StatusURL)
+	serializer.RespondJSON(w, http.StatusAccepted, resp)
+}
+
+// runJob generates the bundle for job and stores its archive, recording
+// progress and the outcome in the job store.
+func (b *DefaultBundler) runJob(ctx context.Context, job *jobs.Job, params *bundleParams, recipeResult *recipe.RecipeResult) {
+	ctx, cancel := context.WithTimeout(ctx, defaults.BundleJobTimeout)
+	defer cancel()
+
+	// Free the archive storage of jobs nobody came back for first
+	b.pruneJobs(ctx)
+
+	update := func(status jobs.Status, stage jobs.Stage) {
+		job.Status = status
+		job.Stage = stage
+		job.UpdatedAt = time.Now().UTC()
+		if err := b.Jobs.Put(ctx, job); err != nil {
+			slog.Error("failed to update bundle job", "job", job.ID, "error", err)
+		}
+	}
+	fail := func(err error) {
+		slog.Error("bundle job failed", "job", job.ID, "stage", job.Stage, "error", err)
+		job.Error = err.Error()
+		update(jobs.StatusFailed, job.Stage)
+	}
+
+	update(jobs.StatusRunning, jobs.StageGenerating)
+
+	tempDir, release, err := b.makeTempDir()
+	if err != nil {
+		fail(fmt.Errorf("failed to create temporary directory: %w", err))
+		return
+	}
+	defer release()
+
+	bundler, err := newRequestBundler(params)
+	if err != nil {
+		fail(fmt.Errorf("failed to create bundler: %w", err))
+		return
+	}
+
+	start := time.Now()
+	output, err := bundler.Make(ctx, recipeResult, tempDir)
+	recordBundle(metricsEndpointAsync, params.deployer, start, output, err)
+	if err != nil {
+		fail(err)
+		return
+	}
+	if output.HasErrors() {
+		msgs := make([]string, 0, len(output.Errors))
+		for _, be := range output.Errors {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", be.BundlerType, be.Error))
+		}
+		fail(fmt.Errorf("bundle generation failed: %s", strings.Join(msgs, "; ")))
+		return
+	}
+
+	update(jobs.StatusRunning, jobs.StageArchiving)
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeZip(pw, tempDir))
+	}()
+	archiveSize, err := b.Jobs.WriteArchive(ctx, job.ID, pr)
+	pr.Close() // unblocks the writer if the store stopped reading early
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	job.Files = output.TotalFiles
+	job.Size = output.TotalSize
+	job.ArchiveSize = archiveSize
+	job.Duration = output.TotalDuration.String()
+	update(jobs.StatusSucceeded, jobs.StageDone)
+
+	slog.Info("bundle job completed",
+		"job", job.ID,
+		"files", job.Files,
+		"archive_size", job.ArchiveSize,
+		"duration", job.Duration,
+	)
+}
+
+// RunJobPruner prunes bundle jobs older than defaults.BundleJobRetention
+// every interval until ctx is canceled, so finished archives are released
+// even when no new jobs are submitted.
+func (b *DefaultBundler) RunJobPruner(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.pruneJobs(ctx)
+		}
+	}
+}
+
+// pruneJobs deletes the bundle jobs last updated before the retention period.
+func (b *DefaultBundler) pruneJobs(ctx context.Context) {
+	if n, err := jobs.Prune(ctx, b.Jobs, time.Now().Add(-defaults.BundleJobRetention)); err != nil {
+		slog.Warn("failed to prune bundle jobs", "error", err)
+	} else if n > 0 {
+		slog.Debug("pruned bundle jobs", "count", n)
+	}
+}
+
+// HandleBundleJobStatus reports the state of an asynchronous bundle job.
+// The job ID is taken from the {id} path value.
+//
+// Example:
+//
+//	GET /v1/bundle/{id}/status
+//	{ "id": "...", "status": "running", "stage": "generating", ... }
+func (b *DefaultBundler) HandleBundleJobStatus(w http.ResponseWriter, r *http.Request) {
+	job, ok := b.lookupJob(w, r)
+	if !ok {
+		return
+	}
+
+	// Clients poll until the job finishes; never serve a stale status
+	w.Header().Set("Cache-Control", "no-store")
+	serializer.RespondJSON(w, http.StatusOK, newBundleJobResponse(job))
+}
+
+// HandleBundleJobDownload streams the zip archive of a succeeded bundle job.
+// It responds 409 Conflict while the job is still running or when it failed.
+// The job ID is taken from the {id} path value.
+//
+// Example:
+//
+//	GET /v1/bundle/{id}/download
+func (b *DefaultBundler) HandleBundleJobDownload(w http.ResponseWriter, r *http.Request) {
+	job, ok := b.lookupJob(w, r)
+	if !ok {
+		return
+	}
+
+	if job.Status != jobs.StatusSucceeded {
+		server.WriteError(w, r, http.StatusConflict, eidoserrors.ErrCodeInvalidRequest,
+			"Bundle job has not succeeded", !job.Status.IsTerminal(), map[string]any{
+				"id":     job.ID,
+				"status": job.Status,
+				"error":  job.Error,
+			})
+		return
+	}
+
+	archive, err := b.Jobs.OpenArchive(r.Context(), job.ID)
+	if err != nil {
+		server.WriteErrorFromErr(w, r, err, "Failed to open bundle archive", nil)
+		return
+	}
+	defer archive.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"bundles.zip\"")
+	w.Header().Set("Content-Length", strconv.FormatInt(job.ArchiveSize, 10))
+	w.Header().Set("X-Bundle-Files", strconv.Itoa(job.Files))
+	w.Header().Set("X-Bundle-Size", strconv.FormatInt(job.Size, 10))
+	w.Header().Set("X-Bundle-Duration", job.Duration)
+
+	if _, err := io.Copy(w, archive); err != nil {
+		// Can't write error response if we've already started writing
+		slog.Error("failed to stream bundle job archive", "job", job.ID, "error", err)
+	}
+}
+
+// lookupJob validates the request and loads the job named by the {id} path
+// value. It writes the error response and returns false on failure.
+func (b *DefaultBundler) lookupJob(w http.ResponseWriter, r *http.Request) (*jobs.Job, bool) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		server.WriteError(w, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Method not allowed", false, map[string]any{
+				"method": r.Method,
+			})
+		return nil, false
+	}
+
+	id := r.PathValue("id")
+	if !jobs.ValidID(id) {
+		server.WriteError(w, r, http.StatusNotFound, eidoserrors.ErrCodeNotFound,
+			"Bundle job not found", false, map[string]any{
+				"id": id,
+			})
+		return nil, false
+	}
+
+	job, err := b.Jobs.Get(r.Context(), id)
+	if err != nil {
+		server.WriteErrorFromErr(w, r, err, "Failed to get bundle job", nil)
+		return nil, false
+	}
+	return job, true
+}
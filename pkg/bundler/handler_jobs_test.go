@@ -0,0 +1,235 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/jobs"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const asyncTestRecipe = `{
+	"apiVersion": "eidos.nvidia.com/v1alpha1",
+	"kind": "Recipe",
+	"componentRefs": [
+		{
+			"name": "gpu-operator",
+			"version": "v25.3.3",
+			"type": "helm",
+			"valuesFile": "components/gpu-operator/values.yaml"
+		}
+	]
+}`
+
+func jobRequest(method, id, action string) *http.Request {
+	req := httptest.NewRequest(method, "/v1/bundle/"+id+"/"+action, nil)
+	req.SetPathValue("id", id)
+	return req
+}
+
+func TestBundleEndpointAsync(t *testing.T) {
+	store, err := jobs.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := New(WithJobStore(store))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/bundle?async=true", strings.NewReader(asyncTestRecipe))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	b.HandleBundles(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d. Body: %s", http.StatusAccepted, w.Code, w.Body.String())
+	}
+
+	var submitted bundleJobResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &submitted); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !jobs.ValidID(submitted.ID) {
+		t.Fatalf("invalid job ID %q", submitted.ID)
+	}
+	if submitted.Components != 1 {
+		t.Errorf("Components = %d, want 1", submitted.Components)
+	}
+	if loc := w.Header().Get("Location"); loc != "/v1/bundle/"+submitted.ID+"/status" {
+		t.Errorf("Location = %q", loc)
+	}
+	if submitted.DownloadURL != "/v1/bundle/"+submitted.ID+"/download" {
+		t.Errorf("DownloadURL = %q", submitted.DownloadURL)
+	}
+
+	// Poll until the job finishes
+	var status bundleJobResponse
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		w = httptest.NewRecorder()
+		b.HandleBundleJobStatus(w, jobRequest(http.MethodGet, submitted.ID, "status"))
+		if w.Code != http.StatusOK {
+			t.Fatalf("status: expected %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+			t.Fatalf("failed to decode status: %v", err)
+		}
+		if status.Status.IsTerminal() {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job did not finish, last status %s/%s", status.Status, status.Stage)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if status.Status != jobs.StatusSucceeded {
+		t.Fatalf("job status = %s, error = %q", status.Status, status.Error)
+	}
+	if status.Stage != jobs.StageDone || status.Files == 0 || status.ArchiveSize == 0 {
+		t.Errorf("unexpected finished job: %+v", status.Job)
+	}
+
+	w = httptest.NewRecorder()
+	b.HandleBundleJobDownload(w, jobRequest(http.MethodGet, submitted.ID, "download"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("download: expected %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/zip" {
+		t.Errorf("Content-Type = %q, want application/zip", ct)
+	}
+	if w.Header().Get("X-Bundle-Files") == "" {
+		t.Error("expected X-Bundle-Files header")
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatalf("failed to read zip: %v", err)
+	}
+	found := false
+	for _, f := range zr.File {
+		if f.Name == "Chart.yaml" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected Chart.yaml in downloaded archive")
+	}
+}
+
+func TestBundleEndpointAsyncInvalidParam(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/bundle?async=maybe", strings.NewReader(asyncTestRecipe))
+	w := httptest.NewRecorder()
+	b.HandleBundles(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestBundleEndpointMaxConcurrent(t *testing.T) {
+	b, err := New(WithMaxConcurrent(1))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// Hold the only slot, as a running async job does
+	release, ok := b.acquireSlot(metricsEndpointAsync)
+	if !ok {
+		t.Fatal("acquireSlot() on an idle bundler failed")
+	}
+
+	for _, query := range []string{"", "?async=true"} {
+		req := httptest.NewRequest(http.MethodPost, "/v1/bundle"+query, strings.NewReader(asyncTestRecipe))
+		w := httptest.NewRecorder()
+		b.HandleBundles(w, req)
+		if w.Code != http.StatusTooManyRequests {
+			t.Errorf("%s: expected status %d, got %d", query, http.StatusTooManyRequests, w.Code)
+		}
+	}
+	if _, err := b.MakeArchive(context.Background(), &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{{Name: "gpu-operator", Type: "helm"}},
+	}, nil, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "Too many concurrent bundles") {
+		t.Errorf("MakeArchive() error = %v, want too many concurrent bundles", err)
+	}
+
+	release()
+	req := httptest.NewRequest(http.MethodPost, "/v1/bundle", strings.NewReader(asyncTestRecipe))
+	w := httptest.NewRecorder()
+	b.HandleBundles(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("after release: expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestBundleJobEndpoints(t *testing.T) {
+	ctx := context.Background()
+	b, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	running, _ := jobs.NewJob(1)
+	running.Status = jobs.StatusRunning
+	failed, _ := jobs.NewJob(1)
+	failed.Status = jobs.StatusFailed
+	failed.Error = "boom"
+	for _, job := range []*jobs.Job{running, failed} {
+		if err := b.Jobs.Put(ctx, job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	unknown, _ := jobs.NewJob(1)
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		req     *http.Request
+		want    int
+	}{
+		{"status running", b.HandleBundleJobStatus, jobRequest(http.MethodGet, running.ID, "status"), http.StatusOK},
+		{"status unknown", b.HandleBundleJobStatus, jobRequest(http.MethodGet, unknown.ID, "status"), http.StatusNotFound},
+		{"status malformed id", b.HandleBundleJobStatus, jobRequest(http.MethodGet, "not-a-job", "status"), http.StatusNotFound},
+		{"status wrong method", b.HandleBundleJobStatus, jobRequest(http.MethodPost, running.ID, "status"), http.StatusMethodNotAllowed},
+		{"download running", b.HandleBundleJobDownload, jobRequest(http.MethodGet, running.ID, "download"), http.StatusConflict},
+		{"download failed", b.HandleBundleJobDownload, jobRequest(http.MethodGet, failed.ID, "download"), http.StatusConflict},
+		{"download unknown", b.HandleBundleJobDownload, jobRequest(http.MethodGet, unknown.ID, "download"), http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.handler(w, tt.req)
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d. Body: %s", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
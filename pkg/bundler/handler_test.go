@@ -15,8 +15,10 @@
 package bundler
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -369,6 +371,48 @@ func TestBundleRequestQueryParamParsing(t *testing.T) {
 			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:       "runbook param",
+			queryParam: "runbook=true",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid runbook param",
+			queryParam: "runbook=maybe",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid node-bootstrap param",
+			queryParam: "node-bootstrap=maybe",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid node-labels param",
+			queryParam: "node-labels=maybe",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "applicationset params",
+			queryParam: "deployer=argocd&applicationset-generator=cluster&cluster-selector=gpu=true",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "applicationset without argocd deployer",
+			queryParam: "applicationset-generator=list",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "cluster-selector without cluster generator",
+			queryParam: "deployer=argocd&applicationset-generator=list&cluster-selector=gpu=true",
+			body:       `{"apiVersion": "v1", "kind": "Recipe", "componentRefs": [{"name": "gpu-operator", "version": "v1"}]}`,
+			wantStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -504,3 +548,111 @@ func TestZipCanBeExtracted(t *testing.T) {
 		}
 	}
 }
+
+// TestTarGzResponse verifies that tgz bundles are streamed as a readable tar.gz,
+// selected by either the format parameter or the Accept header.
+func TestTarGzResponse(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	body := `{
+		"apiVersion": "eidos.nvidia.com/v1alpha1",
+		"kind": "Recipe",
+		"componentRefs": [
+			{
+				"name": "gpu-operator",
+				"version": "v25.3.3",
+				"type": "helm",
+				"valuesFile": "components/gpu-operator/values.yaml"
+			}
+		]
+	}`
+
+	tests := []struct {
+		name   string
+		target string
+		accept string
+	}{
+		{"format parameter", "/v1/bundle?format=tgz", ""},
+		{"accept header", "/v1/bundle", "application/x-tar+gzip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			b.HandleBundles(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Skipf("skipping tar.gz validation, got status %d: %s", w.Code, w.Body.String())
+			}
+			if ct := w.Header().Get("Content-Type"); ct != mediaTypeTarGzip {
+				t.Errorf("Content-Type = %q, want %q", ct, mediaTypeTarGzip)
+			}
+
+			gz, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+			if err != nil {
+				t.Fatalf("failed to read gzip: %v", err)
+			}
+			tr := tar.NewReader(gz)
+			found := map[string]bool{}
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("failed to read tar: %v", err)
+				}
+				found[hdr.Name] = true
+			}
+			for _, name := range []string{"Chart.yaml", "values.yaml", "README.md", "recipe.yaml"} {
+				if !found[name] {
+					t.Errorf("expected file %q not found in tar.gz", name)
+				}
+			}
+		})
+	}
+}
+
+func TestParseArchiveFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		accept  string
+		want    string
+		wantErr bool
+	}{
+		{"default", "/v1/bundle", "", archiveFormatZip, false},
+		{"zip", "/v1/bundle?format=zip", "", archiveFormatZip, false},
+		{"tgz", "/v1/bundle?format=tgz", "", archiveFormatTgz, false},
+		{"tar.gz alias", "/v1/bundle?format=tar.gz", "", archiveFormatTgz, false},
+		{"accept tar+gzip", "/v1/bundle", "application/json, application/x-tar+gzip;q=0.9", archiveFormatTgz, false},
+		{"accept gzip", "/v1/bundle", "application/gzip", archiveFormatTgz, false},
+		{"query overrides accept", "/v1/bundle?format=zip", "application/x-tar+gzip", archiveFormatZip, false},
+		{"invalid", "/v1/bundle?format=rar", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.target, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			got, err := parseArchiveFormat(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseArchiveFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseArchiveFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
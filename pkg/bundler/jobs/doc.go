@@ -0,0 +1,52 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jobs tracks asynchronous bundle generation jobs for the API server.
+//
+// Large bundles can take longer to generate than a single HTTP request is
+// allowed to run. In async mode the server records a Job, returns its ID
+// immediately, and generates the bundle in the background. Clients poll the
+// job status and download the archive once the job has succeeded.
+//
+// # Job Lifecycle
+//
+//	pending -> running -> succeeded
+//	                   \-> failed
+//
+// While running, Job.Stage reports what the job is doing (generating,
+// archiving). Finished jobs carry the bundle file count, size and, on
+// failure, the error message.
+//
+// # Stores
+//
+// Jobs and their archives are kept in a Store. Two implementations are
+// provided:
+//
+//   - MemoryStore: keeps everything in process memory (default), up to
+//     DefaultMaxArchiveBytes of archives at once
+//   - FileStore: persists job records and archives in a directory, so
+//     status and downloads survive server restarts and can be served from
+//     a shared volume
+//
+// Other backends implement the Store interface:
+//
+//	store, err := jobs.NewFileStore("/var/lib/eidos/jobs")
+//	if err != nil {
+//	    return err
+//	}
+//	b, err := bundler.New(bundler.WithJobStore(store))
+//
+// Prune removes jobs that have not been updated since a cutoff, together
+// with their archives.
+package jobs
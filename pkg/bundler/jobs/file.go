@@ -0,0 +1,196 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	jobFileSuffix     = ".json"
+	archiveFileSuffix = ".zip"
+)
+
+// FileStore persists each job as <id>.json and its archive as <id>.zip in a
+// directory. Files are written to a temporary name and renamed into place, so
+// readers never observe partial records, including readers in other processes
+// sharing the directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a FileStore rooted at dir, creating it if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "job store directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to create job store directory", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Dir returns the store directory.
+func (s *FileStore) Dir() string {
+	return s.dir
+}
+
+// Put writes the job record.
+func (s *FileStore) Put(_ context.Context, job *Job) error {
+	if job == nil || !ValidID(job.ID) {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "invalid bundle job")
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to encode bundle job", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".job-*")
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to write bundle job", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to write bundle job", err)
+	}
+	return s.commit(tmp, s.path(job.ID, jobFileSuffix))
+}
+
+// Get reads the job record.
+func (s *FileStore) Get(_ context.Context, id string) (*Job, error) {
+	if !ValidID(id) {
+		return nil, notFound(id)
+	}
+	return s.read(s.path(id, jobFileSuffix))
+}
+
+// List reads all job records in the directory.
+func (s *FileStore) List(_ context.Context) ([]*Job, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to list bundle jobs", err)
+	}
+
+	var all []*Job
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), jobFileSuffix)
+		if !ok || !ValidID(id) {
+			continue
+		}
+		path := filepath.Join(s.dir, entry.Name())
+		job, err := s.read(path)
+		if err != nil {
+			// Deleted concurrently
+			if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
+				continue
+			}
+			return nil, err
+		}
+		all = append(all, job)
+	}
+	return all, nil
+}
+
+// Delete removes the job record and archive.
+func (s *FileStore) Delete(_ context.Context, id string) error {
+	if !ValidID(id) {
+		return nil
+	}
+	for _, suffix := range []string{archiveFileSuffix, jobFileSuffix} {
+		if err := os.Remove(s.path(id, suffix)); err != nil && !os.IsNotExist(err) {
+			return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to delete bundle job", err)
+		}
+	}
+	return nil
+}
+
+// WriteArchive streams the archive to disk.
+func (s *FileStore) WriteArchive(_ context.Context, id string, r io.Reader) (int64, error) {
+	if !ValidID(id) {
+		return 0, notFound(id)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".archive-*")
+	if err != nil {
+		return 0, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to write bundle archive", err)
+	}
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return 0, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to write bundle archive", err)
+	}
+	if err := s.commit(tmp, s.path(id, archiveFileSuffix)); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// OpenArchive opens the archive file.
+func (s *FileStore) OpenArchive(_ context.Context, id string) (io.ReadCloser, error) {
+	if !ValidID(id) {
+		return nil, notFound(id)
+	}
+	f, err := os.Open(s.path(id, archiveFileSuffix))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, notFound(id)
+		}
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to open bundle archive", err)
+	}
+	return f, nil
+}
+
+func (s *FileStore) path(id, suffix string) string {
+	return filepath.Join(s.dir, id+suffix)
+}
+
+func (s *FileStore) read(path string) (*Job, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, notFound(strings.TrimSuffix(filepath.Base(path), jobFileSuffix))
+		}
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to read bundle job", err)
+	}
+
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to decode bundle job", err)
+	}
+	return &job, nil
+}
+
+// commit closes tmp and renames it to path.
+func (s *FileStore) commit(tmp *os.File, path string) error {
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to write bundle job file", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to write bundle job file", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"time"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// Status is the state of a bundle job.
+type Status string
+
+// Job statuses.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// IsTerminal reports whether the status is final.
+func (s Status) IsTerminal() bool {
+	return s == StatusSucceeded || s == StatusFailed
+}
+
+// Stage describes what a running job is doing.
+type Stage string
+
+// Job stages.
+const (
+	StageQueued     Stage = "queued"
+	StageGenerating Stage = "generating"
+	StageArchiving  Stage = "archiving"
+	StageDone       Stage = "done"
+)
+
+// Job is the record of an asynchronous bundle generation.
+type Job struct {
+	// ID uniquely identifies the job.
+	ID string `json:"id"`
+
+	// Status is the job state.
+	Status Status `json:"status"`
+
+	// Stage is the current step of the job.
+	Stage Stage `json:"stage"`
+
+	// Components is the number of components in the requested recipe.
+	Components int `json:"components"`
+
+	// Files is the number of files in the generated bundle.
+	Files int `json:"files,omitempty"`
+
+	// Size is the total size of the generated bundle files in bytes.
+	Size int64 `json:"size,omitempty"`
+
+	// ArchiveSize is the size of the downloadable zip archive in bytes.
+	ArchiveSize int64 `json:"archiveSize,omitempty"`
+
+	// Duration is the bundle generation time.
+	Duration string `json:"duration,omitempty"`
+
+	// Error is the failure reason of a failed job.
+	Error string `json:"error,omitempty"`
+
+	// CreatedAt is when the job was submitted.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is when the job record last changed.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewJob returns a pending job with a random ID.
+func NewJob(components int) (*Job, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to generate job ID", err)
+	}
+
+	now := time.Now().UTC()
+	return &Job{
+		ID:         hex.EncodeToString(buf),
+		Status:     StatusPending,
+		Stage:      StageQueued,
+		Components: components,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// ValidID reports whether id has the format produced by NewJob.
+// Stores use it to reject IDs that could escape their storage location.
+func ValidID(id string) bool {
+	if len(id) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
+
+// Store persists jobs and their archives.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Put creates or replaces the job record.
+	Put(ctx context.Context, job *Job) error
+
+	// Get returns the job with the given ID, or an ErrCodeNotFound error.
+	Get(ctx context.Context, id string) (*Job, error)
+
+	// List returns all jobs.
+	List(ctx context.Context) ([]*Job, error)
+
+	// Delete removes the job and its archive. Deleting a missing job is not an error.
+	Delete(ctx context.Context, id string) error
+
+	// WriteArchive stores the archive read from r for the job and returns its size.
+	WriteArchive(ctx context.Context, id string, r io.Reader) (int64, error)
+
+	// OpenArchive opens the job archive, or returns an ErrCodeNotFound error.
+	OpenArchive(ctx context.Context, id string) (io.ReadCloser, error)
+}
+
+// Prune deletes jobs last updated before cutoff and returns how many were removed.
+func Prune(ctx context.Context, store Store, cutoff time.Time) (int, error) {
+	all, err := store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, job := range all {
+		if !job.UpdatedAt.Before(cutoff) {
+			continue
+		}
+		if err := store.Delete(ctx, job.ID); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+func notFound(id string) error {
+	return eidoserrors.NewWithContext(eidoserrors.ErrCodeNotFound, "bundle job not found", map[string]any{
+		"id": id,
+	})
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewJob(t *testing.T) {
+	job, err := NewJob(3)
+	if err != nil {
+		t.Fatalf("NewJob() error = %v", err)
+	}
+	if !ValidID(job.ID) {
+		t.Errorf("NewJob() ID %q is not valid", job.ID)
+	}
+	if job.Status != StatusPending || job.Stage != StageQueued {
+		t.Errorf("NewJob() status = %s/%s, want pending/queued", job.Status, job.Stage)
+	}
+	if job.Components != 3 {
+		t.Errorf("Components = %d, want 3", job.Components)
+	}
+
+	other, err := NewJob(1)
+	if err != nil {
+		t.Fatalf("NewJob() error = %v", err)
+	}
+	if other.ID == job.ID {
+		t.Error("NewJob() returned duplicate IDs")
+	}
+}
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"0123456789abcdef0123456789abcdef", true},
+		{"", false},
+		{"0123456789abcdef", false},
+		{"../../../../etc/passwd/0123456789a", false},
+		{"0123456789abcdef0123456789abcdeg", false},
+	}
+	for _, tt := range tests {
+		if got := ValidID(tt.id); got != tt.want {
+			t.Errorf("ValidID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestStatusIsTerminal(t *testing.T) {
+	for status, want := range map[Status]bool{
+		StatusPending:   false,
+		StatusRunning:   false,
+		StatusSucceeded: true,
+		StatusFailed:    true,
+	} {
+		if got := status.IsTerminal(); got != want {
+			t.Errorf("%s.IsTerminal() = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) Store{
+		"memory": func(t *testing.T) Store { return NewMemoryStore() },
+		"file": func(t *testing.T) Store {
+			s, err := NewFileStore(filepath.Join(t.TempDir(), "jobs"))
+			if err != nil {
+				t.Fatalf("NewFileStore() error = %v", err)
+			}
+			return s
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newStore(t)
+
+			job, err := NewJob(2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := store.Put(ctx, job); err != nil {
+				t.Fatalf("Put() error = %v", err)
+			}
+
+			// Stored records are copies
+			job.Status = StatusRunning
+			got, err := store.Get(ctx, job.ID)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if got.Status != StatusPending {
+				t.Errorf("Get() status = %s, want pending", got.Status)
+			}
+
+			if err := store.Put(ctx, job); err != nil {
+				t.Fatalf("Put() update error = %v", err)
+			}
+			if got, _ = store.Get(ctx, job.ID); got.Status != StatusRunning {
+				t.Errorf("Get() after update status = %s, want running", got.Status)
+			}
+
+			if _, err := store.OpenArchive(ctx, job.ID); err == nil {
+				t.Error("OpenArchive() before WriteArchive should fail")
+			}
+			n, err := store.WriteArchive(ctx, job.ID, strings.NewReader("zip-bytes"))
+			if err != nil {
+				t.Fatalf("WriteArchive() error = %v", err)
+			}
+			if n != 9 {
+				t.Errorf("WriteArchive() = %d, want 9", n)
+			}
+			rc, err := store.OpenArchive(ctx, job.ID)
+			if err != nil {
+				t.Fatalf("OpenArchive() error = %v", err)
+			}
+			data, _ := io.ReadAll(rc)
+			rc.Close()
+			if string(data) != "zip-bytes" {
+				t.Errorf("archive = %q, want %q", data, "zip-bytes")
+			}
+
+			all, err := store.List(ctx)
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if len(all) != 1 || all[0].ID != job.ID {
+				t.Errorf("List() = %v, want [%s]", all, job.ID)
+			}
+
+			if err := store.Delete(ctx, job.ID); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if _, err := store.Get(ctx, job.ID); err == nil {
+				t.Error("Get() after Delete should fail")
+			}
+			if _, err := store.OpenArchive(ctx, job.ID); err == nil {
+				t.Error("OpenArchive() after Delete should fail")
+			}
+			if err := store.Delete(ctx, job.ID); err != nil {
+				t.Errorf("Delete() of missing job error = %v", err)
+			}
+
+			if err := store.Put(ctx, &Job{ID: "../escape"}); err == nil {
+				t.Error("Put() with invalid ID should fail")
+			}
+			if _, err := store.Get(ctx, "../escape"); err == nil {
+				t.Error("Get() with invalid ID should fail")
+			}
+		})
+	}
+}
+
+func TestFileStore_Persistent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s1, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, _ := NewJob(1)
+	if err := s1.Put(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second store over the same directory sees the job
+	s2, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s2.Get(ctx, job.ID); err != nil {
+		t.Errorf("Get() from second store error = %v", err)
+	}
+
+	// Unrelated files are ignored by List
+	if err := os.WriteFile(filepath.Join(dir, "README.json"), []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	all, err := s2.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(all) != 1 {
+		t.Errorf("List() returned %d jobs, want 1", len(all))
+	}
+}
+
+func TestNewFileStore_EmptyDir(t *testing.T) {
+	if _, err := NewFileStore(""); err == nil {
+		t.Error("NewFileStore(\"\") should fail")
+	}
+}
+
+func TestMemoryStore_MaxArchiveBytes(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(WithMaxArchiveBytes(8))
+
+	first, _ := NewJob(1)
+	second, _ := NewJob(1)
+	if _, err := store.WriteArchive(ctx, first.ID, strings.NewReader("12345")); err != nil {
+		t.Fatalf("WriteArchive() error = %v", err)
+	}
+	if _, err := store.WriteArchive(ctx, second.ID, strings.NewReader("12345")); err == nil {
+		t.Fatal("WriteArchive() over the limit should fail")
+	}
+	if _, err := store.OpenArchive(ctx, second.ID); err == nil {
+		t.Error("archive over the limit should not be stored")
+	}
+
+	// Deleting a job frees its archive space
+	if err := store.Delete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.WriteArchive(ctx, second.ID, strings.NewReader("12345678")); err != nil {
+		t.Errorf("WriteArchive() after Delete error = %v", err)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	old, _ := NewJob(1)
+	old.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	fresh, _ := NewJob(1)
+	for _, job := range []*Job{old, fresh} {
+		if err := store.Put(ctx, job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.WriteArchive(ctx, old.ID, strings.NewReader("x")); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := Prune(ctx, store, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("Prune() removed %d, want 1", removed)
+	}
+	if _, err := store.Get(ctx, old.ID); err == nil {
+		t.Error("old job should be pruned")
+	}
+	if _, err := store.OpenArchive(ctx, old.ID); err == nil {
+		t.Error("old job archive should be pruned")
+	}
+	if _, err := store.Get(ctx, fresh.ID); err != nil {
+		t.Errorf("fresh job should be kept: %v", err)
+	}
+}
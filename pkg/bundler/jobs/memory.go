@@ -0,0 +1,155 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// DefaultMaxArchiveBytes is the total size of the archives a MemoryStore
+// keeps at once unless WithMaxArchiveBytes says otherwise.
+const DefaultMaxArchiveBytes int64 = 512 << 20
+
+// MemoryStore keeps jobs and archives in process memory, up to a total
+// archive size. Everything is lost when the process exits.
+type MemoryStore struct {
+	mu           sync.RWMutex
+	jobs         map[string]Job
+	archives     map[string][]byte
+	archiveBytes int64
+	maxBytes     int64
+}
+
+// MemoryStoreOption configures a MemoryStore.
+type MemoryStoreOption func(*MemoryStore)
+
+// WithMaxArchiveBytes limits the total size of the archives kept at once.
+// Writing an archive that does not fit fails until older jobs are deleted.
+// Zero or less means no limit.
+func WithMaxArchiveBytes(n int64) MemoryStoreOption {
+	return func(s *MemoryStore) {
+		s.maxBytes = n
+	}
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore(opts ...MemoryStoreOption) *MemoryStore {
+	s := &MemoryStore{
+		jobs:     make(map[string]Job),
+		archives: make(map[string][]byte),
+		maxBytes: DefaultMaxArchiveBytes,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Put stores a copy of the job.
+func (s *MemoryStore) Put(_ context.Context, job *Job) error {
+	if job == nil || !ValidID(job.ID) {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "invalid bundle job")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = *job
+	return nil
+}
+
+// Get returns a copy of the job.
+func (s *MemoryStore) Get(_ context.Context, id string) (*Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, notFound(id)
+	}
+	return &job, nil
+}
+
+// List returns copies of all jobs.
+func (s *MemoryStore) List(_ context.Context) ([]*Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		all = append(all, &job)
+	}
+	return all, nil
+}
+
+// Delete removes the job and its archive.
+func (s *MemoryStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.jobs, id)
+	s.archiveBytes -= int64(len(s.archives[id]))
+	delete(s.archives, id)
+	return nil
+}
+
+// WriteArchive reads the whole archive into memory. It fails without
+// reading further once the archive exceeds the space left.
+func (s *MemoryStore) WriteArchive(_ context.Context, id string, r io.Reader) (int64, error) {
+	if !ValidID(id) {
+		return 0, notFound(id)
+	}
+
+	if s.maxBytes > 0 {
+		s.mu.RLock()
+		free := s.maxBytes - s.archiveBytes + int64(len(s.archives[id]))
+		s.mu.RUnlock()
+		r = io.LimitReader(r, free+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to read bundle archive", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	size := s.archiveBytes - int64(len(s.archives[id])) + int64(len(data))
+	if s.maxBytes > 0 && size > s.maxBytes {
+		return 0, eidoserrors.NewWithContext(eidoserrors.ErrCodeUnavailable,
+			"bundle archive storage is full", map[string]any{
+				"id":       id,
+				"maxBytes": s.maxBytes,
+			})
+	}
+	s.archives[id] = data
+	s.archiveBytes = size
+	return int64(len(data)), nil
+}
+
+// OpenArchive returns a reader over the stored archive.
+func (s *MemoryStore) OpenArchive(_ context.Context, id string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, ok := s.archives[id]
+	if !ok {
+		return nil, notFound(id)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"net/http"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/server"
+)
+
+// acquireSlot reserves one of the bundle generation slots limited with
+// WithMaxConcurrent, counting a rejection for endpoint when none is free.
+// The returned release function frees the slot.
+func (b *DefaultBundler) acquireSlot(endpoint string) (func(), bool) {
+	if b.slots == nil {
+		return func() {}, true
+	}
+	select {
+	case b.slots <- struct{}{}:
+		return func() { <-b.slots }, true
+	default:
+		bundleConcurrencyRejects.WithLabelValues(endpoint).Inc()
+		return nil, false
+	}
+}
+
+// errTooManyBundles is returned while all bundle generation slots are taken.
+func (b *DefaultBundler) errTooManyBundles() error {
+	return eidoserrors.NewWithContext(eidoserrors.ErrCodeRateLimitExceeded,
+		"Too many concurrent bundles", map[string]any{
+			"maxConcurrent": cap(b.slots),
+		})
+}
+
+// writeTooManyBundles responds 429 Too Many Requests while all bundle
+// generation slots are taken.
+func (b *DefaultBundler) writeTooManyBundles(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Retry-After", "5")
+	server.WriteError(w, r, http.StatusTooManyRequests, eidoserrors.ErrCodeRateLimitExceeded,
+		"Too many concurrent bundles", true, map[string]any{
+			"maxConcurrent": cap(b.slots),
+		})
+}
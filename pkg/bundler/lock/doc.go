@@ -0,0 +1,50 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lock writes and checks the recipe lockfile of a bundle.
+//
+// Every bundle carries a recipe.lock.yaml at its root pinning what the bundle
+// was generated from: the recipe digest, the digest of the recipe data
+// (overlays, component values and the registry), the resolved chart version
+// of every component and the image of every values path. When image digest
+// resolution is enabled, each image also records the manifest digest its tag
+// resolved to:
+//
+//	schema_version: v1
+//	recipe:
+//	    digest: sha256:4f1c...
+//	data_digest: sha256:9ab0...
+//	components:
+//	    - name: gpu-operator
+//	      chart: gpu-operator
+//	      version: v25.3.3
+//	      source: https://helm.ngc.nvidia.com/nvidia
+//	images:
+//	    - component: gpu-operator
+//	      path: driver.repository
+//	      image: nvcr.io/nvidia/driver:580.82.07
+//	      digest: sha256:77e2...
+//
+// Regenerating a bundle with "eidos bundle --locked" loads the lockfile and
+// refuses to write anything when the new resolution differs from it. Diff
+// lists the differences:
+//
+//	locked, err := lock.Load(filepath.Join(dir, lock.FileName))
+//	if err != nil {
+//	    return err
+//	}
+//	if changes := lock.Diff(locked, current); len(changes) > 0 {
+//	    return fmt.Errorf("resolution differs from the lockfile: %s", strings.Join(changes, "; "))
+//	}
+package lock
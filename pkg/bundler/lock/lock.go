@@ -0,0 +1,219 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the lockfile written at the bundle root.
+const FileName = "recipe.lock.yaml"
+
+// SchemaVersion is the version of the lockfile layout written by Write.
+const SchemaVersion = "v1"
+
+// Lock pins the resolution a bundle was generated from.
+type Lock struct {
+	// SchemaVersion is the lockfile layout version (see SchemaVersion).
+	SchemaVersion string `json:"schema_version" yaml:"schema_version"`
+
+	// Recipe identifies the recipe the bundle was generated from.
+	Recipe Recipe `json:"recipe" yaml:"recipe"`
+
+	// DataDigest is the digest of the recipe data (see recipe.DataDigest).
+	DataDigest string `json:"data_digest,omitempty" yaml:"data_digest,omitempty"`
+
+	// Components lists the resolved component charts in deployment order.
+	Components []Component `json:"components" yaml:"components"`
+
+	// Images lists the images deployed by the component values.
+	Images []Image `json:"images,omitempty" yaml:"images,omitempty"`
+}
+
+// Recipe identifies the recipe a bundle was generated from.
+type Recipe struct {
+	// Version is the version of the tool that generated the recipe. It is
+	// informational and not compared by Diff.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Digest is the recipe content digest (see recipe.RecipeResult.Digest).
+	Digest string `json:"digest" yaml:"digest"`
+}
+
+// Component is the resolved chart of a bundled component.
+type Component struct {
+	// Name is the component name from the recipe.
+	Name string `json:"name" yaml:"name"`
+
+	// Chart is the Helm chart name, empty for Kustomize components.
+	Chart string `json:"chart,omitempty" yaml:"chart,omitempty"`
+
+	// Version is the chart version or Kustomize tag.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Source is the Helm repository or Git repository URL.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// Image is an image deployed by a component's values.
+type Image struct {
+	// Component is the component deploying the image.
+	Component string `json:"component" yaml:"component"`
+
+	// Path is the values path of the image repository.
+	Path string `json:"path" yaml:"path"`
+
+	// Image is the image reference, with the tag when the values set one
+	// (e.g., "nvcr.io/nvidia/driver:580.82.07").
+	Image string `json:"image" yaml:"image"`
+
+	// Digest is the manifest digest the image resolved to, when digest
+	// resolution was enabled and the values set a tag.
+	Digest string `json:"digest,omitempty" yaml:"digest,omitempty"`
+}
+
+// HasDigests reports whether any image of the lock records a digest.
+func (l *Lock) HasDigests() bool {
+	for _, img := range l.Images {
+		if img.Digest != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Write writes the lock to recipe.lock.yaml in dir. It returns the lockfile
+// path and size.
+func (l *Lock) Write(dir string) (string, int64, error) {
+	if l.SchemaVersion == "" {
+		l.SchemaVersion = SchemaVersion
+	}
+
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to serialize lockfile: %w", err)
+	}
+
+	path := filepath.Join(dir, FileName)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", 0, fmt.Errorf("failed to write lockfile: %w", err)
+	}
+	return path, int64(len(data)), nil
+}
+
+// Load reads a lockfile from path.
+func Load(path string) (*Lock, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lockfile: %w", err)
+	}
+
+	var l Lock
+	if err := yaml.Unmarshal(data, &l); err != nil {
+		return nil, fmt.Errorf("failed to parse lockfile %s: %w", path, err)
+	}
+	if l.SchemaVersion != SchemaVersion {
+		return nil, fmt.Errorf("unsupported lockfile schema version %q in %s (want %q)",
+			l.SchemaVersion, path, SchemaVersion)
+	}
+	return &l, nil
+}
+
+// Diff returns a description of every difference between the locked and
+// the current resolution, or nil when current matches locked. Image digests
+// are only compared when locked records them.
+func Diff(locked, current *Lock) []string {
+	var changes []string
+	if locked.Recipe.Digest != current.Recipe.Digest {
+		changes = append(changes, fmt.Sprintf("recipe digest changed from %s to %s",
+			locked.Recipe.Digest, current.Recipe.Digest))
+	}
+	if locked.DataDigest != current.DataDigest {
+		changes = append(changes, fmt.Sprintf("recipe data digest changed from %s to %s",
+			locked.DataDigest, current.DataDigest))
+	}
+
+	currentComponents := make(map[string]Component, len(current.Components))
+	for _, c := range current.Components {
+		currentComponents[c.Name] = c
+	}
+	lockedComponents := make(map[string]bool, len(locked.Components))
+	for _, want := range locked.Components {
+		lockedComponents[want.Name] = true
+		got, ok := currentComponents[want.Name]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("component %s removed", want.Name))
+		case got != want:
+			changes = append(changes, fmt.Sprintf("component %s changed from %s to %s",
+				want.Name, want.describe(), got.describe()))
+		}
+	}
+	for _, c := range current.Components {
+		if !lockedComponents[c.Name] {
+			changes = append(changes, fmt.Sprintf("component %s added (%s)", c.Name, c.describe()))
+		}
+	}
+
+	currentImages := make(map[string]Image, len(current.Images))
+	for _, img := range current.Images {
+		currentImages[img.key()] = img
+	}
+	lockedImages := make(map[string]bool, len(locked.Images))
+	for _, want := range locked.Images {
+		lockedImages[want.key()] = true
+		got, ok := currentImages[want.key()]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("image %s removed", want.key()))
+		case got.Image != want.Image:
+			changes = append(changes, fmt.Sprintf("image %s changed from %s to %s",
+				want.key(), want.Image, got.Image))
+		case want.Digest != "" && got.Digest != want.Digest:
+			changes = append(changes, fmt.Sprintf("image %s (%s) digest changed from %s to %s",
+				want.key(), want.Image, want.Digest, got.Digest))
+		}
+	}
+	for _, img := range current.Images {
+		if !lockedImages[img.key()] {
+			changes = append(changes, fmt.Sprintf("image %s added (%s)", img.key(), img.Image))
+		}
+	}
+	return changes
+}
+
+// describe returns "chart@version from source" for messages.
+func (c Component) describe() string {
+	s := c.Chart
+	if s == "" {
+		s = c.Name
+	}
+	if c.Version != "" {
+		s += "@" + c.Version
+	}
+	if c.Source != "" {
+		s += " from " + c.Source
+	}
+	return s
+}
+
+// key identifies an image by component and values path.
+func (img Image) key() string {
+	return img.Component + ":" + img.Path
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testLock() *Lock {
+	return &Lock{
+		Recipe:     Recipe{Version: "v1.0.0", Digest: "sha256:recipe"},
+		DataDigest: "sha256:data",
+		Components: []Component{
+			{Name: "cert-manager", Chart: "cert-manager", Version: "v1.17.2", Source: "https://charts.jetstack.io"},
+			{Name: "gpu-operator", Chart: "gpu-operator", Version: "v25.3.3", Source: "https://helm.ngc.nvidia.com/nvidia"},
+		},
+		Images: []Image{
+			{Component: "gpu-operator", Path: "driver.repository", Image: "nvcr.io/nvidia/driver:580.82.07", Digest: "sha256:driver"},
+		},
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	dir := t.TempDir()
+	want := testLock()
+
+	path, size, err := want.Write(dir)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if path != filepath.Join(dir, FileName) {
+		t.Errorf("Write() path = %s, want %s", path, filepath.Join(dir, FileName))
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != size {
+		t.Errorf("Write() size = %d, stat = %v, %v", size, info, err)
+	}
+	if want.SchemaVersion != SchemaVersion {
+		t.Errorf("SchemaVersion = %q, want %q", want.SchemaVersion, SchemaVersion)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"invalid.yaml": "components: [",
+		"schema.yaml":  "schema_version: v0\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"missing.yaml", "invalid.yaml", "schema.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Load(filepath.Join(dir, name)); err == nil {
+				t.Error("Load() expected error")
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(l *Lock)
+		want   []string
+	}{
+		{
+			name:   "identical",
+			modify: func(*Lock) {},
+		},
+		{
+			name:   "recipe version is informational",
+			modify: func(l *Lock) { l.Recipe.Version = "v2.0.0" },
+		},
+		{
+			name:   "recipe digest",
+			modify: func(l *Lock) { l.Recipe.Digest = "sha256:other" },
+			want:   []string{"recipe digest changed from sha256:recipe to sha256:other"},
+		},
+		{
+			name:   "data digest",
+			modify: func(l *Lock) { l.DataDigest = "sha256:other" },
+			want:   []string{"recipe data digest changed"},
+		},
+		{
+			name:   "chart version",
+			modify: func(l *Lock) { l.Components[1].Version = "v25.10.0" },
+			want:   []string{"component gpu-operator changed from gpu-operator@v25.3.3 from https://helm.ngc.nvidia.com/nvidia to gpu-operator@v25.10.0"},
+		},
+		{
+			name: "component added and removed",
+			modify: func(l *Lock) {
+				l.Components[0] = Component{Name: "network-operator", Chart: "network-operator", Version: "25.4.0"}
+			},
+			want: []string{"component cert-manager removed", "component network-operator added (network-operator@25.4.0)"},
+		},
+		{
+			name:   "image tag",
+			modify: func(l *Lock) { l.Images[0].Image = "nvcr.io/nvidia/driver:570.172.08" },
+			want:   []string{"image gpu-operator:driver.repository changed from nvcr.io/nvidia/driver:580.82.07 to nvcr.io/nvidia/driver:570.172.08"},
+		},
+		{
+			name:   "image digest",
+			modify: func(l *Lock) { l.Images[0].Digest = "sha256:moved" },
+			want:   []string{"digest changed from sha256:driver to sha256:moved"},
+		},
+		{
+			name: "image added",
+			modify: func(l *Lock) {
+				l.Images = append(l.Images, Image{Component: "gpu-operator", Path: "toolkit.repository", Image: "nvcr.io/nvidia/k8s/container-toolkit"})
+			},
+			want: []string{"image gpu-operator:toolkit.repository added (nvcr.io/nvidia/k8s/container-toolkit)"},
+		},
+		{
+			name:   "image removed",
+			modify: func(l *Lock) { l.Images = nil },
+			want:   []string{"image gpu-operator:driver.repository removed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := testLock()
+			tt.modify(current)
+
+			got := Diff(testLock(), current)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Diff() = %q, want %d changes", got, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(got[i], want) {
+					t.Errorf("Diff()[%d] = %q, want it to contain %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDiff_UnlockedDigests(t *testing.T) {
+	locked := testLock()
+	locked.Images[0].Digest = ""
+	current := testLock()
+
+	if got := Diff(locked, current); len(got) != 0 {
+		t.Errorf("Diff() = %q, want no changes when the lock has no digest", got)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/lock"
+	"github.com/NVIDIA/eidos/pkg/bundler/mirror"
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// ImageDigestResolver resolves an image reference (e.g.,
+// "nvcr.io/nvidia/driver:580.82.07") to its manifest digest.
+type ImageDigestResolver func(ctx context.Context, image string) (string, error)
+
+// resolveLock builds the lock of the bundle being generated. When the bundle
+// is locked, it fails if the resolution differs from the configured lockfile.
+// It writes nothing, so a locked bundle whose resolution changed leaves no
+// partial output behind.
+func (b *DefaultBundler) resolveLock(ctx context.Context, sourceRecipe, recipeResult *recipe.RecipeResult, recipeDigest string, componentValues map[string]map[string]any) (*lock.Lock, error) {
+	var locked *lock.Lock
+	if path := b.Config.LockedFile(); path != "" {
+		var err error
+		locked, err = lock.Load(path)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInvalidRequest, "failed to load lockfile", err)
+		}
+	}
+
+	dataDigest, err := recipe.DataDigest()
+	if err != nil {
+		return nil, err
+	}
+	p, err := b.Plan(ctx, sourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	current := &lock.Lock{
+		Recipe: lock.Recipe{
+			Version: sourceRecipe.GetVersion(),
+			Digest:  recipeDigest,
+		},
+		DataDigest: dataDigest,
+		Components: make([]lock.Component, 0, len(p.Components)),
+	}
+	for _, c := range p.Components {
+		current.Components = append(current.Components, lock.Component{
+			Name:    c.Name,
+			Chart:   c.Chart,
+			Version: c.Version,
+			Source:  c.Source,
+		})
+	}
+
+	// A lockfile pinning digests is only matched by resolving them again.
+	var resolve ImageDigestResolver
+	if b.Config.ResolveImageDigests() || (locked != nil && locked.HasDigests()) {
+		if b.ResolveImageDigest == nil {
+			return nil, errors.New(errors.ErrCodeInvalidRequest,
+				"image digest resolution is not available in this bundler")
+		}
+		resolve = b.ResolveImageDigest
+	}
+	current.Images, err = lockImages(ctx, recipeResult, componentValues, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	if locked != nil {
+		if changes := lock.Diff(locked, current); len(changes) > 0 {
+			return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+				"bundle resolution differs from lockfile "+b.Config.LockedFile()+": "+strings.Join(changes, "; "),
+				map[string]any{"lockfile": b.Config.LockedFile(), "changes": changes})
+		}
+		slog.Info("bundle resolution matches lockfile", "lockfile", b.Config.LockedFile())
+	}
+	return current, nil
+}
+
+// lockImages lists the images the component values deploy. When resolve is
+// set, tagged images are resolved to their manifest digests.
+func lockImages(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any, resolve ImageDigestResolver) ([]lock.Image, error) {
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var images []lock.Image
+	for _, ref := range recipeResult.ComponentRefs {
+		values, ok := componentValues[ref.Name]
+		if !ok {
+			continue
+		}
+		comp := registry.Get(ref.ComponentName())
+		if comp == nil {
+			continue
+		}
+		for _, img := range mirror.Images(ref.Name, comp.Images, values) {
+			locked := lock.Image{
+				Component: img.Component,
+				Path:      img.Path,
+				Image:     img.Source,
+			}
+			switch {
+			case strings.HasPrefix(img.Tag, recipe.DigestAlgorithm+":"):
+				locked.Image += "@" + img.Tag
+				locked.Digest = img.Tag
+			case img.Tag != "":
+				locked.Image += ":" + img.Tag
+				if resolve != nil {
+					locked.Digest, err = resolve(ctx, locked.Image)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+			images = append(images, locked)
+		}
+	}
+	return images, nil
+}
+
+// writeLockfile writes recipe.lock.yaml into dir and adds it to output.
+func (b *DefaultBundler) writeLockfile(ctx context.Context, recipeLock *lock.Lock, dir string, output *result.Output) error {
+	path, size, err := recipeLock.Write(dir)
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to write lockfile", err)
+	}
+
+	// Re-write checksums.txt so it covers the lockfile too.
+	if b.Config.IncludeChecksums() {
+		if err := b.updateChecksums(ctx, dir, output, []string{path}); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal,
+				"failed to update checksums", err)
+		}
+	}
+
+	output.Results = append(output.Results, &result.Result{
+		Type:    "lockfile",
+		Success: true,
+		Files:   []string{path},
+		Size:    size,
+	})
+	output.TotalFiles++
+	output.TotalSize += size
+	return nil
+}
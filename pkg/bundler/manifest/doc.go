@@ -0,0 +1,44 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package manifest writes the machine-readable inventory of a bundle.
+//
+// Every bundle carries a bundle.yaml at its root recording the eidos version
+// and deployer that generated it, the recipe version and digest, the bundled
+// components with their chart versions, and every file with its size and
+// SHA256 digest. Automation can inspect a bundle from this file without
+// parsing READMEs or charts:
+//
+//	m, err := manifest.Load("./bundle")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, c := range m.Components {
+//	    fmt.Println(c.Name, c.Chart, c.Version)
+//	}
+//
+// The bundler fills in the generator, recipe and components and calls Write
+// once every other file is written, so the inventory covers checksums.txt,
+// recipe.yaml and CHANGES.md as well:
+//
+//	m := &manifest.Manifest{
+//	    GeneratedAt: time.Now().UTC(),
+//	    Generator:   manifest.Generator{Version: "v1.0.0", Deployer: "helm"},
+//	    Recipe:      manifest.Recipe{Digest: digest},
+//	    Components:  components,
+//	}
+//	path, size, err := m.Write(ctx, dir, files)
+//
+// The manifest does not list itself.
+package manifest
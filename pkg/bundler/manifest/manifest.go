@@ -0,0 +1,201 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the manifest file written at the bundle root.
+const FileName = "bundle.yaml"
+
+// SchemaVersion is the version of the manifest layout written by Write.
+const SchemaVersion = "v1"
+
+// GeneratorName identifies eidos as the tool that generated a bundle.
+const GeneratorName = "eidos"
+
+// Manifest is the machine-readable inventory of a generated bundle.
+type Manifest struct {
+	// SchemaVersion is the manifest layout version (see SchemaVersion).
+	SchemaVersion string `json:"schema_version" yaml:"schema_version"`
+
+	// GeneratedAt is when the bundle was generated, in UTC.
+	GeneratedAt time.Time `json:"generated_at" yaml:"generated_at"`
+
+	// Generator describes the tool and deployer that generated the bundle.
+	Generator Generator `json:"generator" yaml:"generator"`
+
+	// Recipe identifies the recipe the bundle was generated from.
+	Recipe Recipe `json:"recipe" yaml:"recipe"`
+
+	// Components lists the bundled components in deployment order.
+	Components []Component `json:"components" yaml:"components"`
+
+	// Files lists every bundle file except the manifest itself, sorted by path.
+	Files []File `json:"files" yaml:"files"`
+}
+
+// Generator describes what generated a bundle.
+type Generator struct {
+	// Name is the generating tool (see GeneratorName).
+	Name string `json:"name" yaml:"name"`
+
+	// Version is the version of the generating tool.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Deployer is the deployer the bundle targets (e.g., "helm", "argocd"),
+	// or empty for a single component bundle.
+	Deployer string `json:"deployer,omitempty" yaml:"deployer,omitempty"`
+
+	// Profile is the bundle profile whose value presets were applied, if any.
+	Profile string `json:"profile,omitempty" yaml:"profile,omitempty"`
+}
+
+// Recipe identifies the recipe a bundle was generated from.
+type Recipe struct {
+	// Version is the version of the tool that generated the recipe.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Digest is the recipe content digest (see recipe.RecipeResult.Digest).
+	Digest string `json:"digest,omitempty" yaml:"digest,omitempty"`
+}
+
+// Component is a component included in a bundle.
+type Component struct {
+	// Name is the component name from the recipe.
+	Name string `json:"name" yaml:"name"`
+
+	// Type is the component type (e.g., "helm", "kustomize").
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// Namespace is the namespace the component is installed into.
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+
+	// Chart is the Helm chart name, empty for Kustomize components.
+	Chart string `json:"chart,omitempty" yaml:"chart,omitempty"`
+
+	// Version is the chart version or Kustomize tag.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Source is the Helm repository or Git repository URL.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// File is a single bundle file.
+type File struct {
+	// Path is the file path relative to the bundle root, with forward slashes.
+	Path string `json:"path" yaml:"path"`
+
+	// Size is the file size in bytes.
+	Size int64 `json:"size_bytes" yaml:"size_bytes"`
+
+	// SHA256 is the hex encoded SHA256 digest of the file content.
+	SHA256 string `json:"sha256" yaml:"sha256"`
+}
+
+// Inventory hashes files and returns them relative to dir, sorted by path.
+// Duplicates, files outside dir and the manifest file itself are skipped.
+func Inventory(ctx context.Context, dir string, files []string) ([]File, error) {
+	manifestPath := filepath.Join(dir, FileName)
+	seen := make(map[string]bool, len(files))
+	inventory := make([]File, 0, len(files))
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("context cancelled: %w", err)
+		}
+
+		if filepath.Clean(file) == filepath.Clean(manifestPath) {
+			continue
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil || !filepath.IsLocal(rel) {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+		if seen[rel] {
+			continue
+		}
+		seen[rel] = true
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s for inventory: %w", rel, err)
+		}
+		sum := sha256.Sum256(data)
+		inventory = append(inventory, File{
+			Path:   rel,
+			Size:   int64(len(data)),
+			SHA256: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	slices.SortFunc(inventory, func(a, b File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return inventory, nil
+}
+
+// Write records the inventory of files in the manifest and writes it to
+// bundle.yaml in dir. It returns the manifest path and size.
+func (m *Manifest) Write(ctx context.Context, dir string, files []string) (string, int64, error) {
+	inventory, err := Inventory(ctx, dir, files)
+	if err != nil {
+		return "", 0, err
+	}
+	m.Files = inventory
+	if m.SchemaVersion == "" {
+		m.SchemaVersion = SchemaVersion
+	}
+	if m.Generator.Name == "" {
+		m.Generator.Name = GeneratorName
+	}
+
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to serialize bundle manifest: %w", err)
+	}
+
+	path := filepath.Join(dir, FileName)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", 0, fmt.Errorf("failed to write bundle manifest: %w", err)
+	}
+	return path, int64(len(data)), nil
+}
+
+// Load reads the manifest from bundle.yaml in dir.
+func Load(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle manifest: %w", err)
+	}
+
+	var m Manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse bundle manifest: %w", err)
+	}
+	return &m, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) []string {
+	t.Helper()
+	var paths []string
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+func digest(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestInventory(t *testing.T) {
+	dir := t.TempDir()
+	paths := writeFiles(t, dir, map[string]string{
+		"values.yaml":            "a: 1\n",
+		"gpu-operator/README.md": "# GPU Operator\n",
+		FileName:                 "stale manifest\n",
+	})
+	outside := writeFiles(t, t.TempDir(), map[string]string{"outside.txt": "x"})
+	paths = append(paths, outside[0], filepath.Join(dir, "values.yaml"))
+
+	got, err := Inventory(context.Background(), dir, paths)
+	if err != nil {
+		t.Fatalf("Inventory() error = %v", err)
+	}
+	want := []File{
+		{Path: "gpu-operator/README.md", Size: 15, SHA256: digest("# GPU Operator\n")},
+		{Path: "values.yaml", Size: 5, SHA256: digest("a: 1\n")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Inventory() = %+v, want %+v", got, want)
+	}
+
+	if _, err := Inventory(context.Background(), dir, []string{filepath.Join(dir, "missing.yaml")}); err == nil {
+		t.Error("Inventory() with a missing file should fail")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Inventory(ctx, dir, paths); err == nil {
+		t.Error("Inventory() with a cancelled context should fail")
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	dir := t.TempDir()
+	paths := writeFiles(t, dir, map[string]string{
+		"Chart.yaml":  "name: eidos-stack\n",
+		"values.yaml": "gpu-operator: {}\n",
+	})
+
+	m := &Manifest{
+		GeneratedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+		Generator:   Generator{Version: "v1.0.0", Deployer: "helm"},
+		Recipe:      Recipe{Version: "v0.9.0", Digest: "sha256:abc"},
+		Components: []Component{
+			{Name: "gpu-operator", Type: "helm", Namespace: "eidos-stack", Chart: "nvidia/gpu-operator", Version: "v25.3.3", Source: "https://helm.ngc.nvidia.com/nvidia"},
+		},
+	}
+	path, size, err := m.Write(context.Background(), dir, paths)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if path != filepath.Join(dir, FileName) {
+		t.Errorf("path = %q", path)
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Size() != size {
+		t.Errorf("size = %d, file = %v (%v)", size, info, err)
+	}
+
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.SchemaVersion != SchemaVersion || loaded.Generator.Name != GeneratorName {
+		t.Errorf("defaults not set: %+v", loaded)
+	}
+	if !reflect.DeepEqual(loaded, m) {
+		t.Errorf("Load() = %+v, want %+v", loaded, m)
+	}
+	if len(loaded.Files) != 2 || loaded.Files[0].Path != "Chart.yaml" {
+		t.Errorf("Files = %+v", loaded.Files)
+	}
+
+	if _, err := Load(t.TempDir()); err == nil {
+		t.Error("Load() without a manifest should fail")
+	}
+}
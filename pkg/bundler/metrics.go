@@ -0,0 +1,111 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Bundle endpoints reported in the endpoint label of bundle metrics.
+const (
+	metricsEndpointSync  = "sync"  // POST /v1/bundle
+	metricsEndpointAsync = "async" // POST /v1/bundle?async=true
+	metricsEndpointGRPC  = "grpc"  // gRPC Bundle
+)
+
+var (
+	// Bundle request metrics (server mode)
+	bundleRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_bundle_requests_total",
+			Help: "Total number of bundle generations by endpoint, deployer and status",
+		},
+		[]string{"endpoint", "deployer", "status"}, // status: success or error
+	)
+
+	bundleDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "eidos_bundle_duration_seconds",
+			Help:    "Time taken to generate a bundle, excluding archiving",
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+		},
+		[]string{"endpoint", "deployer"},
+	)
+
+	bundleConcurrencyRejects = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_bundle_concurrency_rejects_total",
+			Help: "Total number of bundle generations rejected because all bundle slots were taken",
+		},
+		[]string{"endpoint"},
+	)
+
+	bundleSize = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "eidos_bundle_size_bytes",
+			Help: "Total size of the files in generated bundles",
+			// 16 KiB to 256 MiB
+			Buckets: prometheus.ExponentialBuckets(16<<10, 4, 8),
+		},
+		[]string{"deployer"},
+	)
+
+	// Per-bundler metrics
+	bundlerDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "eidos_bundler_duration_seconds",
+			Help:    "Time taken by individual bundlers",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30},
+		},
+		[]string{"bundler"}, // umbrella-chart, argocd, capacity-templates, ...
+	)
+
+	bundlerErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_bundler_errors_total",
+			Help: "Total number of bundler failures by bundler",
+		},
+		[]string{"bundler"},
+	)
+)
+
+// recordBundle records a bundle generation that started at start and
+// returned output and err.
+func recordBundle(endpoint string, deployer config.DeployerType, start time.Time, output *result.Output, err error) {
+	status := "success"
+	if err != nil || output == nil || output.HasErrors() {
+		status = "error"
+	}
+	bundleRequests.WithLabelValues(endpoint, string(deployer), status).Inc()
+	bundleDuration.WithLabelValues(endpoint, string(deployer)).Observe(time.Since(start).Seconds())
+
+	if output == nil {
+		return
+	}
+	for _, r := range output.Results {
+		bundlerDuration.WithLabelValues(string(r.Type)).Observe(r.Duration.Seconds())
+	}
+	for _, be := range output.Errors {
+		bundlerErrors.WithLabelValues(string(be.BundlerType)).Inc()
+	}
+	if status == "success" {
+		bundleSize.WithLabelValues(string(deployer)).Observe(float64(output.TotalSize))
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestHandleBundles_Metrics(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	success := bundleRequests.WithLabelValues(metricsEndpointSync, string(config.DeployerHelm), "success")
+	before := testutil.ToFloat64(success)
+
+	body := `{
+		"apiVersion": "eidos.nvidia.com/v1alpha1",
+		"kind": "Recipe",
+		"componentRefs": [
+			{"name": "gpu-operator", "version": "v25.3.3", "type": "helm", "valuesFile": "components/gpu-operator/values.yaml"}
+		]
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/bundle", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	b.HandleBundles(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	if got := testutil.ToFloat64(success) - before; got != 1 {
+		t.Errorf("successful bundle requests delta = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(bundleSize); got < 1 {
+		t.Errorf("bundle size series = %d, want at least 1", got)
+	}
+	if got := testutil.CollectAndCount(bundlerDuration); got < 1 {
+		t.Errorf("bundler duration series = %d, want at least 1", got)
+	}
+}
+
+func TestRecordBundle_Errors(t *testing.T) {
+	failed := bundleRequests.WithLabelValues(metricsEndpointGRPC, string(config.DeployerArgoCD), "error")
+	beforeFailed := testutil.ToFloat64(failed)
+	beforeBundler := testutil.ToFloat64(bundlerErrors.WithLabelValues("nim"))
+
+	output := &result.Output{
+		Errors: []result.BundleError{{BundlerType: "nim", Error: "values file not found"}},
+	}
+	recordBundle(metricsEndpointGRPC, config.DeployerArgoCD, time.Now(), output, nil)
+
+	if got := testutil.ToFloat64(failed) - beforeFailed; got != 1 {
+		t.Errorf("failed bundle requests delta = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(bundlerErrors.WithLabelValues("nim")) - beforeBundler; got != 1 {
+		t.Errorf("nim bundler errors delta = %v, want 1", got)
+	}
+
+	// A failed generation without output is still counted
+	recordBundle(metricsEndpointGRPC, config.DeployerArgoCD, time.Now(), nil, errors.New("bundler failed"))
+	if got := testutil.ToFloat64(failed) - beforeFailed; got != 2 {
+		t.Errorf("failed bundle requests delta = %v, want 2", got)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mirror rewrites the image repositories of component values to a
+// private registry mirror and generates the script copying the images there.
+//
+// Air-gapped and regulated clusters pull images from a private registry. The
+// component registry lists, per component, the values paths of its image
+// repositories and the chart defaults used when the values leave them unset
+// (see recipe.ImageConfig). Rewrite replaces the registry host of each with
+// the mirror and keeps the rest of the path, so "nvcr.io/nvidia" becomes
+// "my.registry.local/nvidia":
+//
+//	images := mirror.Rewrite("gpu-operator", comp.Images, values, "my.registry.local")
+//
+// Generate then writes mirror-images.sh, which copies each source image to
+// its destination with skopeo. Images whose tag the values set are copied
+// with skopeo copy; the others are synced with all their tags, since the
+// chart picks the tag:
+//
+//	# gpu-operator:driver.repository
+//	skopeo sync --all --src docker --dest docker "$@" nvcr.io/nvidia/driver my.registry.local/nvidia
+//	# nim:image.repository
+//	skopeo copy --all "$@" docker://nvcr.io/nim/meta/llama-3.1-8b-instruct:1.8.4 docker://my.registry.local/nim/meta/llama-3.1-8b-instruct:1.8.4
+package mirror
@@ -0,0 +1,279 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/component"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/mirror-images.sh.tmpl
+var scriptTemplate string
+
+// ScriptFileName is the bundle file listing the images to copy to the mirror.
+const ScriptFileName = "mirror-images.sh"
+
+// dockerHub is the registry of image repositories without a registry host.
+const dockerHub = "docker.io"
+
+// Image is an image repository rewritten to the mirror.
+type Image struct {
+	// Component is the component deploying the image.
+	Component string
+
+	// Path is the values path that was rewritten.
+	Path string
+
+	// Source is the upstream image (e.g., "nvcr.io/nvidia/driver").
+	Source string
+
+	// Destination is the image in the mirror (e.g., "my.registry.local/nvidia/driver").
+	Destination string
+
+	// Tag is the image tag set by the values, or "" when the chart picks it.
+	Tag string
+}
+
+// Rewrite points the image repositories of a component's values at mirror
+// and returns the images that have to be copied there. Repositories the
+// values leave unset are written with the mirrored chart default.
+// Repositories already in the mirror are left alone.
+func Rewrite(componentName string, images []recipe.ImageConfig, values map[string]any, mirror string) []Image {
+	var rewritten []Image
+	for _, img := range images {
+		repository, ok := valuepath.Lookup(values, img.Path)
+		if !ok {
+			repository = img.Default
+		}
+		if repository == "" || repository == mirror || strings.HasPrefix(repository, mirror+"/") {
+			continue
+		}
+
+		source, destination := Repositories(repository, mirror)
+		if err := component.ApplyMapOverrides(values, map[string]string{img.Path: destination}); err != nil {
+			slog.Warn("failed to rewrite image repository",
+				"component", componentName,
+				"path", img.Path,
+				"error", err,
+			)
+			continue
+		}
+
+		if img.Image != "" {
+			source = source + "/" + img.Image
+			destination = destination + "/" + img.Image
+		}
+		tag, _ := valuepath.Lookup(values, img.TagPath)
+		rewritten = append(rewritten, Image{
+			Component:   componentName,
+			Path:        img.Path,
+			Source:      source,
+			Destination: destination,
+			Tag:         tag,
+		})
+	}
+	return rewritten
+}
+
+// Images returns the images a component's values deploy, without rewriting
+// them. Source is the fully qualified repository and Destination is empty.
+// Repositories the values leave unset are listed with the chart default.
+func Images(componentName string, images []recipe.ImageConfig, values map[string]any) []Image {
+	var listed []Image
+	for _, img := range images {
+		repository, ok := valuepath.Lookup(values, img.Path)
+		if !ok {
+			repository = img.Default
+		}
+		if repository == "" {
+			continue
+		}
+
+		source, _ := Repositories(repository, "")
+		if img.Image != "" {
+			source = source + "/" + img.Image
+		}
+		tag, _ := valuepath.Lookup(values, img.TagPath)
+		listed = append(listed, Image{
+			Component: componentName,
+			Path:      img.Path,
+			Source:    source,
+			Tag:       tag,
+		})
+	}
+	return listed
+}
+
+// Repositories returns the fully qualified upstream repository and the
+// repository in mirror for repository. The registry host is replaced by the
+// mirror and the rest of the path is kept, so
+// "nvcr.io/nvidia" becomes "my.registry.local/nvidia". Repositories without
+// a registry host are on Docker Hub.
+func Repositories(repository, mirror string) (source, destination string) {
+	host, rest, _ := strings.Cut(repository, "/")
+	if !isRegistryHost(host) {
+		host, rest = dockerHub, repository
+	}
+	source = host
+	destination = mirror
+	if rest != "" {
+		source += "/" + rest
+		destination += "/" + rest
+	}
+	return source, destination
+}
+
+// isRegistryHost reports whether the first path segment of an image
+// reference names a registry rather than a Docker Hub namespace.
+func isRegistryHost(segment string) bool {
+	return strings.ContainsAny(segment, ".:") || segment == "localhost"
+}
+
+// GeneratorInput contains all data needed to generate the mirror script.
+type GeneratorInput struct {
+	// Mirror is the registry images are copied to.
+	Mirror string
+
+	// Images are the rewritten images of all components.
+	Images []Image
+
+	// ProxyEnv are proxy env vars the script exports, so skopeo reaches
+	// the upstream registries through the proxy.
+	ProxyEnv []corev1.EnvVar
+
+	// Version is the bundler version.
+	Version string
+}
+
+// GeneratorOutput contains the result of mirror script generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the script.
+	Duration time.Duration
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// scriptData is the data rendered into the script template.
+type scriptData struct {
+	BundlerVersion string
+	Mirror         string
+	ProxyEnv       []corev1.EnvVar
+	Images         []scriptImage
+}
+
+// scriptImage is an image copied by the script, listed once even when
+// several values paths deploy it.
+type scriptImage struct {
+	Image
+	Paths []string
+	// DestinationParent is the repository skopeo sync copies into.
+	DestinationParent string
+}
+
+// Generator creates the image mirror script.
+type Generator struct{}
+
+// NewGenerator creates a new image mirror script generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes mirror-images.sh to outputDir. The script copies each
+// image with a tag set by the values with skopeo copy, and syncs every tag
+// of the other images, whose tags are picked by the chart, with skopeo sync.
+// Arguments to the script, such as --dest-creds, are passed to skopeo.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || input.Mirror == "" {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and mirror are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	data := &scriptData{BundlerVersion: input.Version, Mirror: input.Mirror, ProxyEnv: input.ProxyEnv}
+	index := make(map[string]int)
+	for _, img := range input.Images {
+		key := img.Source + ":" + img.Tag
+		if i, ok := index[key]; ok {
+			data.Images[i].Paths = append(data.Images[i].Paths, img.Component+":"+img.Path)
+			continue
+		}
+		index[key] = len(data.Images)
+		data.Images = append(data.Images, scriptImage{
+			Image:             img,
+			Paths:             []string{img.Component + ":" + img.Path},
+			DestinationParent: path.Dir(img.Destination),
+		})
+	}
+
+	tmpl, err := template.New(ScriptFileName).Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(scriptTemplate)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to parse mirror script template", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render mirror script", err)
+	}
+
+	scriptPath := filepath.Join(outputDir, ScriptFileName)
+	content := []byte(buf.String())
+	if err := os.WriteFile(scriptPath, content, 0755); err != nil { //nolint:gosec // the script is meant to be executed
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to write %s", ScriptFileName), err)
+	}
+
+	output := &GeneratorOutput{
+		Files:     []string{scriptPath},
+		TotalSize: int64(len(content)),
+		DeploymentNotes: []string{
+			fmt.Sprintf("Image repositories point to %s; run %s to copy the %d images there before deploying",
+				input.Mirror, ScriptFileName, len(data.Images)),
+		},
+		Duration: time.Since(start),
+	}
+
+	slog.Debug("image mirror script generated",
+		"mirror", input.Mirror,
+		"images", len(data.Images),
+	)
+
+	return output, nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestRepositories(t *testing.T) {
+	tests := []struct {
+		repository      string
+		wantSource      string
+		wantDestination string
+	}{
+		{"nvcr.io/nvidia", "nvcr.io/nvidia", "my.registry.local/nvidia"},
+		{"nvcr.io/nvidia/k8s-dra-driver-gpu", "nvcr.io/nvidia/k8s-dra-driver-gpu", "my.registry.local/nvidia/k8s-dra-driver-gpu"},
+		{"quay.io", "quay.io", "my.registry.local"},
+		{"localhost:5000/team", "localhost:5000/team", "my.registry.local/team"},
+		{"grafana/grafana", "docker.io/grafana/grafana", "my.registry.local/grafana/grafana"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repository, func(t *testing.T) {
+			source, destination := Repositories(tt.repository, "my.registry.local")
+			if source != tt.wantSource || destination != tt.wantDestination {
+				t.Errorf("Repositories(%q) = %q, %q, want %q, %q",
+					tt.repository, source, destination, tt.wantSource, tt.wantDestination)
+			}
+		})
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	images := []recipe.ImageConfig{
+		{Path: "driver.repository", Default: "nvcr.io/nvidia", Image: "driver"},
+		{Path: "toolkit.repository", Default: "nvcr.io/nvidia/k8s", Image: "container-toolkit"},
+		{Path: "image.repository", TagPath: "image.tag"},
+		{Path: "unset.repository"},
+		{Path: "mirrored.repository", Default: "my.registry.local/nvidia"},
+	}
+	values := map[string]any{
+		"driver": map[string]any{"repository": "nvcr.io/nvidia/custom", "version": "580.82.07"},
+		"image":  map[string]any{"repository": "nvcr.io/nim/meta/llama-3.1-8b-instruct", "tag": "1.8.4"},
+	}
+
+	got := Rewrite("gpu-operator", images, values, "my.registry.local")
+
+	want := []Image{
+		{Component: "gpu-operator", Path: "driver.repository",
+			Source: "nvcr.io/nvidia/custom/driver", Destination: "my.registry.local/nvidia/custom/driver"},
+		{Component: "gpu-operator", Path: "toolkit.repository",
+			Source: "nvcr.io/nvidia/k8s/container-toolkit", Destination: "my.registry.local/nvidia/k8s/container-toolkit"},
+		{Component: "gpu-operator", Path: "image.repository", Tag: "1.8.4",
+			Source: "nvcr.io/nim/meta/llama-3.1-8b-instruct", Destination: "my.registry.local/nim/meta/llama-3.1-8b-instruct"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Rewrite() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Rewrite()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	for path, want := range map[string]string{
+		"driver.repository":  "my.registry.local/nvidia/custom",
+		"toolkit.repository": "my.registry.local/nvidia/k8s",
+		"image.repository":   "my.registry.local/nim/meta/llama-3.1-8b-instruct",
+		"image.tag":          "1.8.4",
+		"driver.version":     "580.82.07",
+	} {
+		if got, _ := valuepath.Lookup(values, path); got != want {
+			t.Errorf("%s = %q, want %q", path, got, want)
+		}
+	}
+	if _, ok := valuepath.Lookup(values, "mirrored.repository"); ok {
+		t.Error("repository already in the mirror was written")
+	}
+}
+
+func TestImages(t *testing.T) {
+	images := []recipe.ImageConfig{
+		{Path: "driver.repository", Default: "nvcr.io/nvidia", Image: "driver"},
+		{Path: "image.repository", TagPath: "image.tag"},
+		{Path: "unset.repository"},
+	}
+	values := map[string]any{
+		"image": map[string]any{"repository": "library/busybox", "tag": "1.36"},
+	}
+
+	got := Images("gpu-operator", images, values)
+
+	want := []Image{
+		{Component: "gpu-operator", Path: "driver.repository", Source: "nvcr.io/nvidia/driver"},
+		{Component: "gpu-operator", Path: "image.repository", Source: "docker.io/library/busybox", Tag: "1.36"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Images() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Images()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if _, ok := valuepath.Lookup(values, "driver.repository"); ok {
+		t.Error("Images() wrote the values")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	input := &GeneratorInput{
+		Mirror:   "my.registry.local",
+		Version:  "v1.0.0",
+		ProxyEnv: []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy.corp:3128"}},
+		Images: []Image{
+			{Component: "gpu-operator", Path: "devicePlugin.repository",
+				Source: "nvcr.io/nvidia/k8s-device-plugin", Destination: "my.registry.local/nvidia/k8s-device-plugin"},
+			{Component: "gpu-operator", Path: "gfd.repository",
+				Source: "nvcr.io/nvidia/k8s-device-plugin", Destination: "my.registry.local/nvidia/k8s-device-plugin"},
+			{Component: "nim", Path: "image.repository", Tag: "1.8.4",
+				Source: "nvcr.io/nim/meta/llama", Destination: "my.registry.local/nim/meta/llama"},
+		},
+	}
+
+	output, err := NewGenerator().Generate(context.Background(), input, dir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(output.Files) != 1 || len(output.DeploymentNotes) != 1 {
+		t.Errorf("output = %+v, want one file and one note", output)
+	}
+	if !strings.Contains(output.DeploymentNotes[0], "2 images") {
+		t.Errorf("note = %q, want 2 images", output.DeploymentNotes[0])
+	}
+
+	path := filepath.Join(dir, ScriptFileName)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("failed to stat script: %v", err)
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		t.Errorf("script mode = %v, want executable", info.Mode())
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read script: %v", err)
+	}
+	script := string(content)
+	for _, want := range []string{
+		"#!/usr/bin/env bash",
+		"set -euo pipefail\nexport HTTPS_PROXY='http://proxy.corp:3128'\n",
+		"# gpu-operator:devicePlugin.repository, gpu-operator:gfd.repository\n" +
+			`skopeo sync --all --src docker --dest docker "$@" nvcr.io/nvidia/k8s-device-plugin my.registry.local/nvidia`,
+		`skopeo copy --all "$@" docker://nvcr.io/nim/meta/llama:1.8.4 docker://my.registry.local/nim/meta/llama:1.8.4`,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("script missing %q:\n%s", want, script)
+		}
+	}
+	if strings.Count(script, "k8s-device-plugin my.registry.local") != 1 {
+		t.Errorf("duplicate image listed more than once:\n%s", script)
+	}
+
+	if _, err := NewGenerator().Generate(context.Background(), &GeneratorInput{}, dir); err == nil {
+		t.Error("expected error without mirror")
+	}
+}
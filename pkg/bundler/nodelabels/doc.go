@@ -0,0 +1,50 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package nodelabels proposes the node labels and taints that place bundle components on the right nodes.
+
+Bundles schedule GPU components with the accelerated node selector and
+tolerations and other components with the system node selector and
+tolerations. Those only work once nodes carry matching labels and taints;
+this package writes them down per node role, with a script applying them.
+
+Without configured accelerated scheduling, GPU nodes are proposed the labels
+nvidia.com/gpu.workload=<intent> and nvidia.com/gpu.accelerator=<accelerator>
+from the recipe criteria and the taint dedicated=gpu:NoSchedule, and the
+bundler injects the same selector and toleration into component values.
+
+# Artifacts
+
+Artifacts are written to the node-labels subdirectory:
+  - node-labels.yaml: the labels and taints proposed for each node role
+  - apply-node-labels.sh: labels and taints the given nodes for a role
+  - README.md: the proposed labels and taints and how to apply them
+
+# Usage
+
+	generator := nodelabels.NewGenerator()
+
+	input := &nodelabels.GeneratorInput{
+		RecipeResult: recipeResult,
+		Version:      "v1.0.0",
+		Accelerated:  nodelabels.DefaultAccelerated(recipeResult.Criteria),
+	}
+
+	output, err := generator.Generate(ctx, input, "/path/to/bundle")
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package nodelabels
@@ -0,0 +1,350 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelabels
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/apply-node-labels.sh.tmpl
+var scriptTemplate string
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// DirName is the bundle subdirectory node label artifacts are written to.
+	DirName = "node-labels"
+
+	// LabelsFileName lists the labels and taints proposed for each node role.
+	LabelsFileName = "node-labels.yaml"
+
+	// ScriptFileName labels and taints nodes for a role.
+	ScriptFileName = "apply-node-labels.sh"
+
+	// ReadmeFileName describes the proposed labels and taints.
+	ReadmeFileName = "README.md"
+)
+
+const (
+	// LabelWorkload is the proposed GPU node label holding the workload intent.
+	LabelWorkload = "nvidia.com/gpu.workload"
+
+	// LabelAccelerator is the proposed GPU node label holding the accelerator type.
+	LabelAccelerator = "nvidia.com/gpu.accelerator"
+
+	// TaintKey and TaintValue form the taint proposed for GPU nodes, keeping
+	// workloads without a matching toleration off them.
+	TaintKey   = "dedicated"
+	TaintValue = "gpu"
+
+	// defaultWorkload labels GPU nodes of recipes without a specific intent.
+	defaultWorkload = "general"
+)
+
+const (
+	// RoleAccelerated is the role of GPU nodes.
+	RoleAccelerated = "accelerated"
+
+	// RoleSystem is the role of nodes running system components.
+	RoleSystem = "system"
+)
+
+// Scheduling is the node selector and tolerations components of a node role
+// are scheduled with.
+type Scheduling struct {
+	NodeSelector map[string]string
+	Tolerations  []corev1.Toleration
+}
+
+// DefaultAccelerated returns the scheduling proposed for GPU nodes of a
+// recipe with criteria: a workload label set to the intent ("general" for
+// any), an accelerator label when the accelerator is known and a toleration
+// of the dedicated=gpu:NoSchedule taint.
+func DefaultAccelerated(criteria *recipe.Criteria) Scheduling {
+	workload := defaultWorkload
+	selector := map[string]string{}
+	if criteria != nil {
+		if criteria.Intent != "" && criteria.Intent != recipe.CriteriaIntentAny {
+			workload = string(criteria.Intent)
+		}
+		if criteria.Accelerator != "" && criteria.Accelerator != recipe.CriteriaAcceleratorAny {
+			selector[LabelAccelerator] = string(criteria.Accelerator)
+		}
+	}
+	selector[LabelWorkload] = workload
+
+	return Scheduling{
+		NodeSelector: selector,
+		Tolerations: []corev1.Toleration{{
+			Key:      TaintKey,
+			Operator: corev1.TolerationOpEqual,
+			Value:    TaintValue,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}},
+	}
+}
+
+// Taint is a taint proposed for a node role.
+type Taint struct {
+	Key    string             `yaml:"key"`
+	Value  string             `yaml:"value,omitempty"`
+	Effect corev1.TaintEffect `yaml:"effect"`
+}
+
+// String returns the taint in kubectl taint form: key[=value]:effect.
+func (t Taint) String() string {
+	if t.Value == "" {
+		return fmt.Sprintf("%s:%s", t.Key, t.Effect)
+	}
+	return fmt.Sprintf("%s=%s:%s", t.Key, t.Value, t.Effect)
+}
+
+// Role holds the labels and taints proposed for the nodes of one role.
+type Role struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Taints      []Taint           `yaml:"taints,omitempty"`
+}
+
+// IsEmpty reports whether r proposes neither labels nor taints.
+func (r *Role) IsEmpty() bool {
+	return len(r.Labels) == 0 && len(r.Taints) == 0
+}
+
+// NewRole returns the role whose nodes match s: labeled with its node
+// selector and tainted so its tolerations are needed to schedule there.
+func NewRole(name, description string, s Scheduling) *Role {
+	role := &Role{Name: name, Description: description, Taints: Taints(s.Tolerations)}
+	if len(s.NodeSelector) > 0 {
+		role.Labels = make(map[string]string, len(s.NodeSelector))
+		for k, v := range s.NodeSelector {
+			role.Labels[k] = v
+		}
+	}
+	return role
+}
+
+// Taints returns the taints tolerations are needed for. Tolerations without
+// a key tolerate every taint and propose none; tolerations without an effect
+// propose a NoSchedule taint.
+func Taints(tolerations []corev1.Toleration) []Taint {
+	var taints []Taint
+	for _, t := range tolerations {
+		if t.Key == "" {
+			continue
+		}
+		taint := Taint{Key: t.Key, Effect: t.Effect}
+		if t.Operator != corev1.TolerationOpExists {
+			taint.Value = t.Value
+		}
+		if taint.Effect == "" {
+			taint.Effect = corev1.TaintEffectNoSchedule
+		}
+		taints = append(taints, taint)
+	}
+	return taints
+}
+
+// GeneratorInput contains all data needed to generate node label artifacts.
+type GeneratorInput struct {
+	// RecipeResult provides the recipe version recorded in the artifacts.
+	RecipeResult *recipe.RecipeResult
+
+	// Version is the bundler version.
+	Version string
+
+	// Accelerated is the scheduling of GPU components.
+	Accelerated Scheduling
+
+	// System is the scheduling of system components.
+	System Scheduling
+}
+
+// GeneratorOutput contains the result of node label artifact generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the artifacts.
+	Duration time.Duration
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// labelsFile is the content of node-labels.yaml.
+type labelsFile struct {
+	Roles []*Role `yaml:"roles"`
+}
+
+// templateData is the data rendered into the script and README templates.
+type templateData struct {
+	BundlerVersion string
+	RecipeVersion  string
+	Roles          []*Role
+	RoleNames      string
+}
+
+// Generator creates node label artifacts.
+type Generator struct{}
+
+// NewGenerator creates a new node label artifact generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes the labels and taints proposed for the accelerated and
+// system node roles to the node-labels subdirectory of outputDir. A role
+// whose scheduling proposes no labels or taints is left out; nothing is
+// written when neither role proposes any.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || input.RecipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and recipe result are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	output := &GeneratorOutput{}
+	var roles []*Role
+	for _, role := range []*Role{
+		NewRole(RoleAccelerated, "GPU nodes running accelerated components and workloads", input.Accelerated),
+		NewRole(RoleSystem, "Nodes running system components", input.System),
+	} {
+		if !role.IsEmpty() {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		output.DeploymentNotes = append(output.DeploymentNotes,
+			"No node selectors or tolerations are configured; no node labels were proposed")
+		output.Duration = time.Since(start)
+		return output, nil
+	}
+
+	dir := filepath.Join(outputDir, DirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create node-labels directory", err)
+	}
+
+	data := &templateData{
+		BundlerVersion: input.Version,
+		RecipeVersion:  input.RecipeResult.Metadata.Version,
+		Roles:          roles,
+	}
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	data.RoleNames = strings.Join(names, "|")
+
+	labels, err := renderLabels(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(output, filepath.Join(dir, LabelsFileName), labels, 0600); err != nil {
+		return nil, err
+	}
+
+	script, err := renderTemplate(scriptTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render node label script", err)
+	}
+	if err := writeFile(output, filepath.Join(dir, ScriptFileName), script, 0755); err != nil {
+		return nil, err
+	}
+
+	readme, err := renderTemplate(readmeTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render node labels README", err)
+	}
+	if err := writeFile(output, filepath.Join(dir, ReadmeFileName), readme, 0600); err != nil {
+		return nil, err
+	}
+
+	output.DeploymentNotes = append(output.DeploymentNotes,
+		fmt.Sprintf("Label and taint nodes with %s/%s <%s> <node>... so components are scheduled on them", DirName, ScriptFileName, data.RoleNames))
+	output.Duration = time.Since(start)
+
+	slog.Debug("node label artifacts generated",
+		"roles", data.RoleNames,
+		"files", len(output.Files),
+		"size_bytes", output.TotalSize,
+	)
+
+	return output, nil
+}
+
+// renderLabels marshals the roles into node-labels.yaml.
+func renderLabels(data *templateData) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Node labels and taints generated by eidos %s from recipe %s.\n", data.BundlerVersion, data.RecipeVersion)
+	buf.WriteString("# Apply them with apply-node-labels.sh <role> <node>...\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&labelsFile{Roles: data.Roles}); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to marshal node labels", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to marshal node labels", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderTemplate renders a template with data.
+func renderTemplate(tmplContent string, data any) ([]byte, error) {
+	tmpl, err := template.New("template").Parse(tmplContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+	return []byte(buf.String()), nil
+}
+
+// writeFile writes content to path with perm and records it in output.
+func writeFile(output *GeneratorOutput, path string, content []byte, perm os.FileMode) error {
+	if err := os.WriteFile(path, content, perm); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to write %s", filepath.Base(path)), err)
+	}
+	output.Files = append(output.Files, path)
+	output.TotalSize += int64(len(content))
+	return nil
+}
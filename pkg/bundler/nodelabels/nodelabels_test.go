@@ -0,0 +1,177 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelabels
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func testRecipe() *recipe.RecipeResult {
+	r := &recipe.RecipeResult{
+		Criteria: &recipe.Criteria{
+			Accelerator: recipe.CriteriaAcceleratorH100,
+			Intent:      recipe.CriteriaIntentTraining,
+		},
+	}
+	r.Metadata.Version = "v0.9.0"
+	return r
+}
+
+func TestDefaultAccelerated(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria *recipe.Criteria
+		want     map[string]string
+	}{
+		{"nil criteria", nil, map[string]string{LabelWorkload: "general"}},
+		{"any", recipe.NewCriteria(), map[string]string{LabelWorkload: "general"}},
+		{"training h100", testRecipe().Criteria, map[string]string{
+			LabelWorkload:    "training",
+			LabelAccelerator: "h100",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DefaultAccelerated(tt.criteria)
+			if !reflect.DeepEqual(got.NodeSelector, tt.want) {
+				t.Errorf("NodeSelector = %v, want %v", got.NodeSelector, tt.want)
+			}
+			if len(got.Tolerations) != 1 || got.Tolerations[0].Key != TaintKey || got.Tolerations[0].Value != TaintValue {
+				t.Errorf("Tolerations = %v, want %s=%s", got.Tolerations, TaintKey, TaintValue)
+			}
+		})
+	}
+}
+
+func TestNewRole(t *testing.T) {
+	role := NewRole(RoleSystem, "system nodes", Scheduling{
+		NodeSelector: map[string]string{"node-role": "system"},
+		Tolerations: []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "system", Effect: corev1.TaintEffectNoExecute},
+			{Key: "CriticalAddonsOnly", Operator: corev1.TolerationOpExists},
+			{Operator: corev1.TolerationOpExists},
+		},
+	})
+
+	if role.Labels["node-role"] != "system" {
+		t.Errorf("Labels = %v", role.Labels)
+	}
+	var taints []string
+	for _, taint := range role.Taints {
+		taints = append(taints, taint.String())
+	}
+	want := []string{"dedicated=system:NoExecute", "CriticalAddonsOnly:NoSchedule"}
+	if !reflect.DeepEqual(taints, want) {
+		t.Errorf("taints = %v, want %v", taints, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	out, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+		RecipeResult: testRecipe(),
+		Version:      "v1.0.0",
+		Accelerated:  DefaultAccelerated(testRecipe().Criteria),
+		System: Scheduling{
+			NodeSelector: map[string]string{"node-role": "system"},
+		},
+	}, dir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(out.Files) != 3 {
+		t.Fatalf("got %d files, want 3", len(out.Files))
+	}
+	if len(out.DeploymentNotes) == 0 {
+		t.Error("expected deployment notes")
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, DirName, LabelsFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var file labelsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		t.Fatalf("node-labels.yaml is not valid YAML: %v", err)
+	}
+	if len(file.Roles) != 2 || file.Roles[0].Name != RoleAccelerated || file.Roles[1].Name != RoleSystem {
+		t.Fatalf("roles = %+v, want accelerated and system", file.Roles)
+	}
+	if file.Roles[0].Labels[LabelAccelerator] != "h100" {
+		t.Errorf("accelerated labels = %v", file.Roles[0].Labels)
+	}
+	if len(file.Roles[1].Taints) != 0 {
+		t.Errorf("system taints = %v, want none", file.Roles[1].Taints)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, DirName, ScriptFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		t.Errorf("script mode = %v, want executable", info.Mode())
+	}
+	script, err := os.ReadFile(filepath.Join(dir, DirName, ScriptFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"accelerated)",
+		"kubectl label node \"${node}\" --overwrite 'nvidia.com/gpu.accelerator=h100' 'nvidia.com/gpu.workload=training'",
+		"kubectl taint node \"${node}\" --overwrite 'dedicated=gpu:NoSchedule'",
+		"system)",
+		"<accelerated|system>",
+	} {
+		if !strings.Contains(string(script), want) {
+			t.Errorf("script missing %q:\n%s", want, script)
+		}
+	}
+}
+
+func TestGenerate_NoScheduling(t *testing.T) {
+	dir := t.TempDir()
+	out, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+		RecipeResult: testRecipe(),
+		System: Scheduling{
+			Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+		},
+	}, dir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(out.Files) != 0 {
+		t.Errorf("got files %v, want none", out.Files)
+	}
+	if _, err := os.Stat(filepath.Join(dir, DirName)); !os.IsNotExist(err) {
+		t.Errorf("expected no %s directory", DirName)
+	}
+}
+
+func TestGenerate_InvalidInput(t *testing.T) {
+	if _, err := NewGenerator().Generate(context.Background(), nil, t.TempDir()); err == nil {
+		t.Error("expected error for nil input")
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// openShiftSCCHeader starts the SCC manifest of a component. Formatted with
+// the component name and the namespace.
+const openShiftSCCHeader = `# Grants the SecurityContextConstraints the %[1]s pods need on OpenShift
+# to their service accounts in %[2]s.
+`
+
+// openShiftSCCGrantManifest grants a SecurityContextConstraints to service
+// accounts. Formatted with the component name, the namespace, the SCC name
+// and the subjects.
+const openShiftSCCGrantManifest = `---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: %[1]s-scc-%[3]s
+  namespace: %[2]s
+rules:
+  - apiGroups:
+      - security.openshift.io
+    resources:
+      - securitycontextconstraints
+    resourceNames:
+      - %[3]s
+    verbs:
+      - use
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: %[1]s-scc-%[3]s
+  namespace: %[2]s
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: %[1]s-scc-%[3]s
+subjects:
+%[4]s`
+
+// openShiftSCCSubject is a service account subject of a RoleBinding.
+// Formatted with the service account name and the namespace.
+const openShiftSCCSubject = `  - kind: ServiceAccount
+    name: %s
+    namespace: %s
+`
+
+// openShiftSCCFile returns the file name of the SCC manifest of a component
+// reference. It is unique per reference, since the umbrella chart holds the
+// manifests of all components in one directory.
+func openShiftSCCFile(ref recipe.ComponentRef) string {
+	return ref.Name + "-openshift-scc.yaml"
+}
+
+// helmFullname mirrors the fullname helper of Helm chart scaffolding: the
+// release name, suffixed with the chart name unless it already contains it,
+// truncated to maxLen characters without a trailing dash.
+func helmFullname(release, chart string, maxLen int) string {
+	name := release
+	if !strings.Contains(release, chart) {
+		name = release + "-" + chart
+	}
+	if len(name) > maxLen {
+		name = name[:maxLen]
+	}
+	return strings.TrimSuffix(name, "-")
+}
+
+// openShiftSCCManifest renders the Roles and RoleBindings granting each SCC
+// of cfg to the service accounts of ref. Service account names are expanded
+// with the release and chart name the deployer installs ref with: the
+// umbrella chart release with the subchart aliased to the component name for
+// helm, and a release per component for argocd.
+func (b *DefaultBundler) openShiftSCCManifest(ref recipe.ComponentRef, cfg *recipe.OpenShiftSCCConfig) []byte {
+	release, chart := helm.ReleaseName, ref.Name
+	if b.Config.Deployer() == config.DeployerArgoCD {
+		release, chart = ref.HelmReleaseName(), helm.ResolveChartName(ref.ComponentName())
+	}
+	maxLen := cfg.FullnameLength
+	if maxLen <= 0 {
+		maxLen = 63
+	}
+	expand := strings.NewReplacer(
+		recipe.SCCReleasePlaceholder, release,
+		recipe.SCCFullnamePlaceholder, helmFullname(release, chart, maxLen),
+	)
+
+	namespace := b.componentNamespace(ref)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, openShiftSCCHeader, ref.Name, namespace)
+	for _, grant := range cfg.Grants {
+		var subjects strings.Builder
+		for _, sa := range grant.ServiceAccounts {
+			fmt.Fprintf(&subjects, openShiftSCCSubject, expand.Replace(sa), namespace)
+		}
+		fmt.Fprintf(&sb, openShiftSCCGrantManifest, ref.Name, namespace, grant.SCC, subjects.String())
+	}
+	return []byte(sb.String())
+}
+
+// addOpenShiftSCC adds the manifests granting the SecurityContextConstraints
+// the registry lists for each component to extras, for recipes targeting
+// OpenShift. Only the helm and argocd deployers ship manifests; with other
+// deployers the missing grants are warned about.
+func (b *DefaultBundler) addOpenShiftSCC(recipeResult *recipe.RecipeResult, extras map[string]map[string][]byte) (map[string]map[string][]byte, error) {
+	if recipeResult.Criteria == nil || recipeResult.Criteria.Service != recipe.CriteriaServiceOpenShift {
+		return extras, nil
+	}
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	deployer := b.Config.Deployer()
+	for _, ref := range recipeResult.ComponentRefs {
+		comp := registry.Get(ref.ComponentName())
+		if comp == nil || comp.OpenShiftSCC == nil || len(comp.OpenShiftSCC.Grants) == 0 {
+			continue
+		}
+		if deployer != config.DeployerHelm && deployer != config.DeployerArgoCD {
+			for _, grant := range comp.OpenShiftSCC.Grants {
+				slog.Warn("OpenShift SecurityContextConstraints not granted by the deployer, grant it before installing",
+					"component", ref.Name,
+					"scc", grant.SCC,
+					"service_accounts", grant.ServiceAccounts,
+					"deployer", deployer)
+			}
+			continue
+		}
+
+		name := openShiftSCCFile(ref)
+		if _, exists := extras[ref.Name][name]; exists {
+			continue
+		}
+		if extras == nil {
+			extras = make(map[string]map[string][]byte)
+		}
+		if extras[ref.Name] == nil {
+			extras[ref.Name] = make(map[string][]byte)
+		}
+		extras[ref.Name][name] = b.openShiftSCCManifest(ref, comp.OpenShiftSCC)
+		slog.Debug("granting OpenShift SecurityContextConstraints",
+			"component", ref.Name,
+			"grants", len(comp.OpenShiftSCC.Grants))
+	}
+	return extras, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/bundler/types"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// componentFunc processes the component reference at index i of a recipe.
+type componentFunc func(ctx context.Context, i int, ref recipe.ComponentRef) error
+
+// forEachComponent runs fn for every reference in refs on a pool of
+// Config.Concurrency() workers and returns the error of each component at its
+// index. A failing component does not stop the others, so callers see every
+// failure at once; a panic in fn is recovered as the component's error.
+// Components not started before ctx is canceled fail with the context error.
+func (b *DefaultBundler) forEachComponent(ctx context.Context, refs []recipe.ComponentRef, fn componentFunc) []error {
+	errs := make([]error, len(refs))
+	workers := min(b.Config.Concurrency(), len(refs))
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for i := range indices {
+				errs[i] = runComponent(ctx, i, refs[i], fn)
+			}
+		})
+	}
+	for i := range refs {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return errs
+}
+
+// runComponent runs fn for one component, converting a panic into an error.
+func runComponent(ctx context.Context, i int, ref recipe.ComponentRef, fn componentFunc) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx, i, ref)
+}
+
+// componentErrors returns the failures in errs, which are indexed like refs,
+// as bundle errors in recipe order.
+func componentErrors(refs []recipe.ComponentRef, errs []error) []result.BundleError {
+	var failed []result.BundleError
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, result.BundleError{
+				BundlerType: types.BundleType(refs[i].Name),
+				Error:       err.Error(),
+			})
+		}
+	}
+	return failed
+}
+
+// joinComponentErrors returns a single error listing every failed component,
+// or nil if none failed.
+func joinComponentErrors(failed []result.BundleError) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(failed))
+	for _, be := range failed {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", be.BundlerType, be.Error))
+	}
+	return fmt.Errorf("%d component(s) failed: %s", len(failed), strings.Join(msgs, "; "))
+}
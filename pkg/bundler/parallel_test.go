@@ -0,0 +1,200 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestForEachComponent(t *testing.T) {
+	refs := make([]recipe.ComponentRef, 10)
+	for i := range refs {
+		refs[i] = recipe.ComponentRef{Name: fmt.Sprintf("component-%d", i)}
+	}
+
+	t.Run("bounds concurrency", func(t *testing.T) {
+		b, err := New(WithConfig(config.NewConfig(config.WithConcurrency(3))))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var running, peak atomic.Int32
+		errs := b.forEachComponent(context.Background(), refs, func(_ context.Context, _ int, _ recipe.ComponentRef) error {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			running.Add(-1)
+			return nil
+		})
+
+		for i, err := range errs {
+			if err != nil {
+				t.Errorf("component %d error = %v", i, err)
+			}
+		}
+		if p := peak.Load(); p < 2 || p > 3 {
+			t.Errorf("peak concurrency = %d, want 2-3", p)
+		}
+	})
+
+	t.Run("isolates failures", func(t *testing.T) {
+		b, err := New(WithConfig(config.NewConfig(config.WithConcurrency(4))))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var done atomic.Int32
+		errs := b.forEachComponent(context.Background(), refs, func(_ context.Context, i int, _ recipe.ComponentRef) error {
+			switch i {
+			case 2:
+				return errors.New("boom")
+			case 5:
+				panic("bad plugin")
+			}
+			done.Add(1)
+			return nil
+		})
+
+		if got := done.Load(); got != int32(len(refs)-2) {
+			t.Errorf("completed components = %d, want %d", got, len(refs)-2)
+		}
+		failed := componentErrors(refs, errs)
+		if len(failed) != 2 || failed[0].BundlerType != "component-2" || failed[1].BundlerType != "component-5" {
+			t.Fatalf("failed = %+v, want component-2 and component-5", failed)
+		}
+		if !strings.Contains(failed[1].Error, "panic: bad plugin") {
+			t.Errorf("panic error = %q", failed[1].Error)
+		}
+
+		err = joinComponentErrors(failed)
+		if err == nil || !strings.Contains(err.Error(), "2 component(s) failed: component-2: boom; component-5:") {
+			t.Errorf("joinComponentErrors() = %v", err)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		b, err := New()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		errs := b.forEachComponent(ctx, refs, func(_ context.Context, _ int, _ recipe.ComponentRef) error {
+			t.Error("component ran after cancellation")
+			return nil
+		})
+		for i, err := range errs {
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("component %d error = %v, want context.Canceled", i, err)
+			}
+		}
+	})
+}
+
+func TestMake_Concurrency(t *testing.T) {
+	rec, err := recipe.NewBuilder().BuildFromCriteria(context.Background(), recipe.NewCriteria())
+	if err != nil {
+		t.Fatalf("BuildFromCriteria() error = %v", err)
+	}
+
+	generate := func(concurrency int) string {
+		t.Helper()
+		b, err := New(WithConfig(config.NewConfig(
+			config.WithConcurrency(concurrency),
+			config.WithIncludeChecksums(false),
+		)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := t.TempDir()
+		if _, err := b.Make(context.Background(), rec, dir); err != nil {
+			t.Fatalf("Make() with concurrency %d error = %v", concurrency, err)
+		}
+		values, err := os.ReadFile(filepath.Join(dir, "values.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(values)
+	}
+
+	if sequential, parallel := generate(1), generate(8); sequential != parallel {
+		t.Errorf("parallel values.yaml differs from sequential:\n%s\n---\n%s", parallel, sequential)
+	}
+}
+
+func TestMake_PluginFailureIsolated(t *testing.T) {
+	pluginDir := t.TempDir()
+	script := `#!/bin/sh
+req=$(cat)
+head='"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"BundlerResponse"'
+case "$req" in
+*'"operation":"describe"'*) printf '{%s,"components":["good-chart","bad-chart"]}' "$head" ;;
+*'"operation":"make"'*'"name":"bad-chart"'*) echo "registry unavailable" >&2; exit 1 ;;
+*'"operation":"make"'*) printf '{%s,"files":[{"path":"extra.yaml","content":"kind: Extra\\n"}]}' "$head" ;;
+*) printf '{%s}' "$head" ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(pluginDir, "internal.sh"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(WithConfig(config.NewConfig(
+		config.WithPluginDir(pluginDir),
+		config.WithConcurrency(2),
+	)))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	dir := t.TempDir()
+	input := &recipe.RecipeResult{
+		APIVersion: "eidos.nvidia.com/v1alpha1",
+		Kind:       "Recipe",
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "bad-chart", Version: "1.0.0", Type: "helm", Source: "oci://registry.example.com/charts"},
+			{Name: "good-chart", Version: "1.0.0", Type: "helm", Source: "oci://registry.example.com/charts"},
+		},
+	}
+
+	output, err := b.Make(context.Background(), input, dir)
+	if err != nil {
+		t.Fatalf("Make() error = %v", err)
+	}
+
+	if len(output.Errors) != 1 || output.Errors[0].BundlerType != "bad-chart" ||
+		!strings.Contains(output.Errors[0].Error, "registry unavailable") {
+		t.Errorf("Errors = %+v, want bad-chart failure", output.Errors)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "plugins", "good-chart", "extra.yaml")); err != nil {
+		t.Errorf("good-chart plugin file not written: %v", err)
+	}
+}
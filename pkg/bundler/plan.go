@@ -0,0 +1,138 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argocd"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argoworkflows"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/terraform"
+	"github.com/NVIDIA/eidos/pkg/bundler/plan"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// Plan describes what Make would install for the given recipe without writing
+// any files. It applies the same component toggles, deployer and --set value
+// overrides as Make, so the plan matches the bundle that would be generated.
+func (b *DefaultBundler) Plan(ctx context.Context, input recipe.RecipeInput) (*plan.Plan, error) {
+	if input == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe input cannot be nil")
+	}
+
+	recipeResult, ok := input.(*recipe.RecipeResult)
+	if !ok {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			"install plans require RecipeResult format")
+	}
+
+	recipeDigest, err := recipeResult.Digest()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to compute recipe digest", err)
+	}
+
+	enabled, err := recipeResult.WithoutDisabledComponents()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInvalidRequest,
+			"invalid component dependencies", err)
+	}
+	if len(enabled.ComponentRefs) == 0 {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			"recipe must contain at least one enabled component")
+	}
+
+	deployer := config.DeployerHelm
+	if b.Config != nil {
+		deployer = b.Config.Deployer()
+	}
+
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal,
+			"failed to load component registry", err)
+	}
+
+	p := &plan.Plan{
+		Deployer:          deployer.String(),
+		RecipeDigest:      recipeDigest,
+		Components:        make([]plan.Component, 0, len(enabled.DeploymentOrder)),
+		SkippedComponents: enabled.Metadata.DisabledComponents,
+	}
+
+	for _, name := range enabled.DeploymentOrder {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeTimeout, "plan generation cancelled", err)
+		}
+
+		ref := enabled.GetComponentRef(name)
+		if ref == nil {
+			continue
+		}
+
+		component := plan.Component{
+			Order:         len(p.Components) + 1,
+			Name:          ref.Name,
+			Type:          string(ref.Type),
+			Namespace:     componentNamespace(deployer, *ref),
+			Source:        ref.Source,
+			Version:       ref.Version,
+			DependsOn:     ref.DependencyRefs,
+			Overrides:     plan.MergeOverrides(ref.Overrides, b.getValueOverridesForComponent(*ref)),
+			ManifestFiles: ref.ManifestFiles,
+		}
+		if ref.Type == recipe.ComponentTypeKustomize {
+			component.Version = ref.Tag
+			component.Path = ref.Path
+		}
+		if ref.ComponentName() != ref.Name {
+			component.Component = ref.ComponentName()
+		}
+		if ref.Type != recipe.ComponentTypeKustomize && deployer != config.DeployerHelm {
+			component.Release = ref.HelmReleaseName()
+		}
+		if cfg := registry.Get(ref.ComponentName()); cfg != nil {
+			component.CRDs = cfg.CRDs
+			if ref.Type != recipe.ComponentTypeKustomize {
+				component.Chart = cfg.Helm.DefaultChart
+			}
+		}
+		if component.Chart == "" && ref.Type != recipe.ComponentTypeKustomize {
+			component.Chart = ref.ComponentName()
+		}
+
+		p.Components = append(p.Components, component)
+	}
+
+	return p, nil
+}
+
+// componentNamespace returns the namespace the deployer installs ref into.
+func componentNamespace(deployer config.DeployerType, ref recipe.ComponentRef) string {
+	if deployer == config.DeployerArgoCD {
+		return argocd.ComponentNamespace(ref)
+	}
+	if deployer == config.DeployerArgoWorkflows {
+		return argoworkflows.ComponentNamespace(ref)
+	}
+	if deployer == config.DeployerTerraform {
+		return terraform.ComponentNamespace(ref)
+	}
+	// The umbrella chart installs every subchart into the release namespace
+	return helm.ReleaseNamespace
+}
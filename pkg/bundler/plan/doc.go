@@ -0,0 +1,53 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plan describes what a bundle would install without generating it.
+//
+// A Plan lists the enabled recipe components in deployment order together
+// with the namespace each one is installed into, its chart or kustomization
+// source and version, the value overrides that will be applied, and the
+// CustomResourceDefinitions it installs. Disabled components are reported
+// separately.
+//
+// Plans are built by bundler.DefaultBundler.Plan so they reflect the same
+// deployer, --set overrides and component toggles as a real bundle:
+//
+//	b, _ := bundler.NewWithConfig(cfg)
+//	p, err := b.Plan(ctx, recipeResult)
+//	if err != nil {
+//	    return err
+//	}
+//	p.WriteText(os.Stdout)
+//
+// # Output
+//
+// WriteText renders a human-readable plan for review:
+//
+//	Install plan (helm deployer, 2 components)
+//	Recipe digest: sha256:...
+//
+//	1. cert-manager
+//	   Namespace:  eidos-stack
+//	   Chart:      jetstack/cert-manager v1.17.2 (https://charts.jetstack.io)
+//	   CRDs:       certificates.cert-manager.io, ...
+//
+//	2. gpu-operator
+//	   Namespace:  eidos-stack
+//	   Chart:      nvidia/gpu-operator v25.3.3 (https://helm.ngc.nvidia.com/nvidia)
+//	   Depends on: cert-manager
+//	   Overrides:
+//	     driver.version = 580.82.07 (set)
+//
+// Plans also serialize to JSON and YAML for review tooling.
+package plan
@@ -0,0 +1,184 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+)
+
+// Override sources identify where a value override comes from.
+const (
+	// SourceRecipe marks overrides declared inline in the recipe componentRef.
+	SourceRecipe = "recipe"
+	// SourceSet marks overrides passed with --set; they take precedence.
+	SourceSet = "set"
+)
+
+// Plan is an ordered description of what a bundle installs.
+type Plan struct {
+	// Deployer is the deployment method the plan was built for.
+	Deployer string `json:"deployer" yaml:"deployer"`
+
+	// RecipeDigest is the digest of the recipe the plan was built from.
+	RecipeDigest string `json:"recipe_digest,omitempty" yaml:"recipe_digest,omitempty"`
+
+	// Components are the enabled components in deployment order.
+	Components []Component `json:"components" yaml:"components"`
+
+	// SkippedComponents lists components marked enabled: false.
+	SkippedComponents []string `json:"skipped_components,omitempty" yaml:"skipped_components,omitempty"`
+}
+
+// Component describes how a single component will be installed.
+type Component struct {
+	// Order is the 1-based position in the deployment order.
+	Order int `json:"order" yaml:"order"`
+
+	// Name is the component name from the recipe.
+	Name string `json:"name" yaml:"name"`
+
+	// Component is the registry component, set when it differs from Name.
+	Component string `json:"component,omitempty" yaml:"component,omitempty"`
+
+	// Type is the component type (Helm or Kustomize).
+	Type string `json:"type" yaml:"type"`
+
+	// Release is the Helm release name for deployers that install one
+	// release per component.
+	Release string `json:"release,omitempty" yaml:"release,omitempty"`
+
+	// Namespace is the namespace the component is installed into.
+	Namespace string `json:"namespace" yaml:"namespace"`
+
+	// Chart is the Helm chart name (Helm components only).
+	Chart string `json:"chart,omitempty" yaml:"chart,omitempty"`
+
+	// Source is the Helm repository or kustomization source.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+
+	// Version is the chart version or kustomization tag.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// Path is the path within the kustomization source (Kustomize only).
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// DependsOn lists components that are installed before this one.
+	DependsOn []string `json:"depends_on,omitempty" yaml:"depends_on,omitempty"`
+
+	// Overrides are the value overrides applied on top of the values file.
+	Overrides []Override `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+
+	// ManifestFiles are additional manifests installed with the component.
+	ManifestFiles []string `json:"manifest_files,omitempty" yaml:"manifest_files,omitempty"`
+
+	// CRDs are the CustomResourceDefinitions the component installs.
+	CRDs []string `json:"crds,omitempty" yaml:"crds,omitempty"`
+}
+
+// Override is a single value override in dotted-path form.
+type Override struct {
+	// Key is the dotted values path (e.g., "driver.version").
+	Key string `json:"key" yaml:"key"`
+
+	// Value is the override value rendered as a string.
+	Value string `json:"value" yaml:"value"`
+
+	// Source is SourceRecipe or SourceSet.
+	Source string `json:"source" yaml:"source"`
+}
+
+// MergeOverrides flattens inline recipe overrides and --set overrides into a
+// sorted list. A --set override replaces a recipe override with the same key.
+func MergeOverrides(recipeOverrides map[string]any, setOverrides map[string]string) []Override {
+	merged := make(map[string]Override)
+	for key, value := range valuepath.Flatten(recipeOverrides) {
+		merged[key] = Override{Key: key, Value: fmt.Sprintf("%v", value), Source: SourceRecipe}
+	}
+	for key, value := range setOverrides {
+		merged[key] = Override{Key: key, Value: value, Source: SourceSet}
+	}
+
+	overrides := make([]Override, 0, len(merged))
+	for _, key := range slices.Sorted(maps.Keys(merged)) {
+		overrides = append(overrides, merged[key])
+	}
+	return overrides
+}
+
+// WriteText writes a human-readable rendering of the plan to w.
+func (p *Plan) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Install plan (%s deployer, %d components)\n", p.Deployer, len(p.Components))
+	if p.RecipeDigest != "" {
+		fmt.Fprintf(&b, "Recipe digest: %s\n", p.RecipeDigest)
+	}
+
+	for _, c := range p.Components {
+		fmt.Fprintf(&b, "\n%d. %s\n", c.Order, c.Name)
+		if c.Component != "" {
+			fmt.Fprintf(&b, "   Component:  %s\n", c.Component)
+		}
+		fmt.Fprintf(&b, "   Namespace:  %s\n", c.Namespace)
+		if c.Release != "" {
+			fmt.Fprintf(&b, "   Release:    %s\n", c.Release)
+		}
+		if c.Chart != "" {
+			fmt.Fprintf(&b, "   Chart:      %s\n", describeSource(c.Chart, c.Version, c.Source))
+		} else {
+			fmt.Fprintf(&b, "   Source:     %s\n", describeSource(strings.TrimSuffix(c.Source+"/"+c.Path, "/"), c.Version, ""))
+		}
+		if len(c.DependsOn) > 0 {
+			fmt.Fprintf(&b, "   Depends on: %s\n", strings.Join(c.DependsOn, ", "))
+		}
+		if len(c.CRDs) > 0 {
+			fmt.Fprintf(&b, "   CRDs:       %s\n", strings.Join(c.CRDs, ", "))
+		}
+		if len(c.ManifestFiles) > 0 {
+			fmt.Fprintf(&b, "   Manifests:  %s\n", strings.Join(c.ManifestFiles, ", "))
+		}
+		if len(c.Overrides) > 0 {
+			b.WriteString("   Overrides:\n")
+			for _, o := range c.Overrides {
+				fmt.Fprintf(&b, "     %s = %s (%s)\n", o.Key, o.Value, o.Source)
+			}
+		}
+	}
+
+	if len(p.SkippedComponents) > 0 {
+		fmt.Fprintf(&b, "\nSkipped (disabled): %s\n", strings.Join(p.SkippedComponents, ", "))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// describeSource renders "name version (source)", omitting empty parts.
+func describeSource(name, version, source string) string {
+	s := name
+	if version != "" {
+		s += " " + version
+	}
+	if source != "" {
+		s += " (" + source + ")"
+	}
+	return s
+}
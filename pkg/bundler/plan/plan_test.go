@@ -0,0 +1,120 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeOverrides(t *testing.T) {
+	recipeOverrides := map[string]any{
+		"driver": map[string]any{
+			"version": "580.82.07",
+			"enabled": true,
+		},
+		"cdi": map[string]any{"enabled": false},
+	}
+	setOverrides := map[string]string{
+		"driver.version": "570.133.20",
+		"gds.enabled":    "true",
+	}
+
+	got := MergeOverrides(recipeOverrides, setOverrides)
+	want := []Override{
+		{Key: "cdi.enabled", Value: "false", Source: SourceRecipe},
+		{Key: "driver.enabled", Value: "true", Source: SourceRecipe},
+		{Key: "driver.version", Value: "570.133.20", Source: SourceSet},
+		{Key: "gds.enabled", Value: "true", Source: SourceSet},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("MergeOverrides() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("MergeOverrides()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if got := MergeOverrides(nil, nil); len(got) != 0 {
+		t.Errorf("MergeOverrides(nil, nil) = %v, want empty", got)
+	}
+}
+
+func TestPlanWriteText(t *testing.T) {
+	p := &Plan{
+		Deployer:     "helm",
+		RecipeDigest: "sha256:abc",
+		Components: []Component{
+			{
+				Order:     1,
+				Name:      "cert-manager",
+				Type:      "Helm",
+				Namespace: "eidos-stack",
+				Chart:     "jetstack/cert-manager",
+				Source:    "https://charts.jetstack.io",
+				Version:   "v1.17.2",
+				CRDs:      []string{"certificates.cert-manager.io"},
+			},
+			{
+				Order:     2,
+				Name:      "gpu-operator",
+				Type:      "Helm",
+				Namespace: "eidos-stack",
+				Chart:     "nvidia/gpu-operator",
+				Version:   "v25.3.3",
+				DependsOn: []string{"cert-manager"},
+				Overrides: []Override{{Key: "driver.version", Value: "580.82.07", Source: SourceSet}},
+			},
+			{
+				Order:     3,
+				Name:      "custom",
+				Type:      "Kustomize",
+				Namespace: "nvidia-system",
+				Source:    "https://github.com/example/repo",
+				Path:      "deploy/production",
+				Version:   "v1.0.0",
+			},
+		},
+		SkippedComponents: []string{"nvsentinel"},
+	}
+
+	var buf strings.Builder
+	if err := p.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"Install plan (helm deployer, 3 components)",
+		"Recipe digest: sha256:abc",
+		"1. cert-manager",
+		"Chart:      jetstack/cert-manager v1.17.2 (https://charts.jetstack.io)",
+		"CRDs:       certificates.cert-manager.io",
+		"Depends on: cert-manager",
+		"driver.version = 580.82.07 (set)",
+		"Source:     https://github.com/example/repo/deploy/production v1.0.0",
+		"Skipped (disabled): nvsentinel",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteText() output missing %q:\n%s", want, out)
+		}
+	}
+
+	if strings.Index(out, "1. cert-manager") > strings.Index(out, "2. gpu-operator") {
+		t.Error("components should be rendered in deployment order")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func newPlanTestRecipe() *recipe.RecipeResult {
+	disabled := false
+	return &recipe.RecipeResult{
+		APIVersion: "eidos.nvidia.com/v1alpha1",
+		Kind:       "Recipe",
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "cert-manager", Type: recipe.ComponentTypeHelm, Version: "v1.17.2", Source: "https://charts.jetstack.io"},
+			{
+				Name:           "gpu-operator",
+				Type:           recipe.ComponentTypeHelm,
+				Version:        "v25.3.3",
+				Source:         "https://helm.ngc.nvidia.com/nvidia",
+				DependencyRefs: []string{"cert-manager"},
+				Overrides:      map[string]any{"driver": map[string]any{"version": "580.82.07"}},
+			},
+			{Name: "nvsentinel", Type: recipe.ComponentTypeHelm, Enabled: &disabled},
+		},
+		DeploymentOrder: []string{"cert-manager", "gpu-operator", "nvsentinel"},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	cfg := config.NewConfig(
+		config.WithValueOverrides(map[string]map[string]string{
+			"gpuoperator": {"gds.enabled": "true"},
+		}),
+	)
+	b, err := NewWithConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewWithConfig() error = %v", err)
+	}
+
+	rec := newPlanTestRecipe()
+	p, err := b.Plan(context.Background(), rec)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	if p.Deployer != string(config.DeployerHelm) {
+		t.Errorf("Deployer = %q, want %q", p.Deployer, config.DeployerHelm)
+	}
+	wantDigest, _ := rec.Digest()
+	if p.RecipeDigest != wantDigest {
+		t.Errorf("RecipeDigest = %q, want %q", p.RecipeDigest, wantDigest)
+	}
+	if len(p.Components) != 2 {
+		t.Fatalf("Components = %d, want 2", len(p.Components))
+	}
+	if len(p.SkippedComponents) != 1 || p.SkippedComponents[0] != "nvsentinel" {
+		t.Errorf("SkippedComponents = %v, want [nvsentinel]", p.SkippedComponents)
+	}
+
+	certManager, gpuOperator := p.Components[0], p.Components[1]
+	if certManager.Order != 1 || certManager.Name != "cert-manager" || gpuOperator.Order != 2 {
+		t.Errorf("unexpected order: %+v, %+v", certManager, gpuOperator)
+	}
+	if certManager.Chart != "jetstack/cert-manager" || len(certManager.CRDs) == 0 {
+		t.Errorf("cert-manager should use registry chart and CRDs, got %+v", certManager)
+	}
+	if gpuOperator.Namespace != helm.ReleaseNamespace {
+		t.Errorf("Namespace = %q, want %q", gpuOperator.Namespace, helm.ReleaseNamespace)
+	}
+	if len(gpuOperator.Overrides) != 2 ||
+		gpuOperator.Overrides[0].Key != "driver.version" ||
+		gpuOperator.Overrides[1].Key != "gds.enabled" {
+		t.Errorf("Overrides = %+v, want driver.version and gds.enabled", gpuOperator.Overrides)
+	}
+}
+
+func TestPlan_ArgoCDNamespaces(t *testing.T) {
+	b, err := NewWithConfig(config.NewConfig(config.WithDeployer(config.DeployerArgoCD)))
+	if err != nil {
+		t.Fatalf("NewWithConfig() error = %v", err)
+	}
+
+	p, err := b.Plan(context.Background(), newPlanTestRecipe())
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if p.Components[1].Namespace != "gpu-operator" {
+		t.Errorf("Namespace = %q, want gpu-operator", p.Components[1].Namespace)
+	}
+}
+
+func TestPlan_Errors(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := b.Plan(context.Background(), nil); err == nil {
+		t.Error("expected error for nil input")
+	}
+
+	rec := newPlanTestRecipe()
+	disabled := false
+	rec.ComponentRefs[0].Enabled = &disabled
+	if _, err := b.Plan(context.Background(), rec); err == nil {
+		t.Error("expected error when an enabled component depends on a disabled one")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := b.Plan(ctx, newPlanTestRecipe()); err == nil {
+		t.Error("expected error for canceled context")
+	}
+}
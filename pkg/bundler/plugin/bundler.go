@@ -0,0 +1,147 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/bundler/types"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// DirName is the bundle directory holding files written by plugins, one
+// subdirectory per component reference.
+const DirName = "plugins"
+
+// Bundler bundles one component through a plugin.
+//
+// Thread-safety: Bundler is safe for concurrent use; each call runs the
+// plugin executable separately.
+type Bundler struct {
+	plugin    *Plugin
+	component string
+}
+
+// NewBundler returns a Bundler for component backed by p.
+func NewBundler(p *Plugin, component string) *Bundler {
+	return &Bundler{plugin: p, component: component}
+}
+
+// Plugin returns the plugin backing the bundler.
+func (b *Bundler) Plugin() *Plugin {
+	return b.plugin
+}
+
+// Component returns the name of the component the bundler handles.
+func (b *Bundler) Component() string {
+	return b.component
+}
+
+// ComponentValues asks the plugin for the values of ref, given the values
+// resolved from the recipe. The plugin may return nothing to keep them.
+func (b *Bundler) ComponentValues(ctx context.Context, input recipe.RecipeInput, ref recipe.ComponentRef, values map[string]any) (map[string]any, error) {
+	resp, err := b.plugin.Call(ctx, &Request{
+		Operation: OperationValues,
+		Component: &ref,
+		Criteria:  input.GetCriteria(),
+		Values:    values,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Values == nil {
+		return values, nil
+	}
+	return resp.Values, nil
+}
+
+// MakeComponent asks the plugin for the files of ref, given its final values,
+// and writes them into dir.
+func (b *Bundler) MakeComponent(ctx context.Context, input recipe.RecipeInput, ref recipe.ComponentRef, values map[string]any, dir string) (*result.Result, error) {
+	start := time.Now()
+	res := result.New(types.BundleType(ref.Name))
+
+	resp, err := b.plugin.Call(ctx, &Request{
+		Operation: OperationMake,
+		Component: &ref,
+		Criteria:  input.GetCriteria(),
+		Values:    values,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	written := make(map[string]bool, len(resp.Files))
+	for _, f := range resp.Files {
+		path := filepath.Join(dir, f.Path)
+		if written[path] {
+			return nil, fmt.Errorf("plugin %s: duplicate file %s", b.plugin.Name, f.Path)
+		}
+		written[path] = true
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory for %s: %w", path, err)
+		}
+		if err := os.WriteFile(path, []byte(f.Content), 0600); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		res.AddFile(path, int64(len(f.Content)))
+	}
+
+	res.Duration = time.Since(start)
+	res.MarkSuccess()
+	return res, nil
+}
+
+// Make bundles every reference to the component in input, writing the
+// plugin files of each into dir/<reference name>. Values come from the
+// recipe and the plugin, without bundle overrides.
+func (b *Bundler) Make(ctx context.Context, input recipe.RecipeInput, dir string) (*result.Result, error) {
+	recipeResult, ok := input.(*recipe.RecipeResult)
+	if !ok {
+		return nil, errors.New("plugin bundlers require RecipeResult format")
+	}
+
+	start := time.Now()
+	res := result.New(types.BundleType(b.component))
+	for _, ref := range recipeResult.ComponentRefs {
+		if ref.ComponentName() != b.component || !ref.IsEnabled() {
+			continue
+		}
+		values, err := recipeResult.GetValuesForComponent(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		if values, err = b.ComponentValues(ctx, input, ref, values); err != nil {
+			return nil, err
+		}
+		made, err := b.MakeComponent(ctx, input, ref, values, filepath.Join(dir, ref.Name))
+		if err != nil {
+			return nil, err
+		}
+		res.Files = append(res.Files, made.Files...)
+		res.Size += made.Size
+	}
+
+	res.Duration = time.Since(start)
+	res.MarkSuccess()
+	return res, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugin runs exec-based bundler plugins for custom components.
+//
+// Plugins let teams bundle components that are not part of the embedded
+// component registry, such as internal charts added with --data, without
+// changing Eidos. A plugin is any executable in the plugin directory. Each
+// call runs the plugin once with a JSON request on stdin; the plugin writes
+// a single JSON response to stdout and exits zero.
+//
+// # Protocol
+//
+// Every request carries the apiVersion, the BundlerRequest kind and an
+// operation:
+//
+//	{
+//	  "apiVersion": "eidos.nvidia.com/v1alpha1",
+//	  "kind": "BundlerRequest",
+//	  "operation": "values",
+//	  "component": {"name": "internal-metrics", "type": "Helm", "source": "...", "version": "1.4.0"},
+//	  "criteria": {"service": "eks", "accelerator": "h100", ...},
+//	  "values": {"replicas": 1}
+//	}
+//
+// The operations are:
+//   - describe: no component; the response lists the components the plugin
+//     bundles in "components". Called once when plugins are loaded.
+//   - values: the response "values" replace the values resolved from the
+//     recipe. Bundle --set overrides and node scheduling are applied after.
+//     Omit "values" to keep the recipe values.
+//   - make: "values" are the final component values; the response "files"
+//     are written to plugins/<component>/ in the bundle and included in
+//     checksums.txt.
+//
+// A response to make looks like:
+//
+//	{
+//	  "apiVersion": "eidos.nvidia.com/v1alpha1",
+//	  "kind": "BundlerResponse",
+//	  "files": [{"path": "secret-store.yaml", "content": "apiVersion: v1\n..."}]
+//	}
+//
+// Unknown response fields and trailing output are rejected, and file paths
+// must be relative and stay within the component directory.
+//
+// # Sandboxing
+//
+// Plugin calls run with:
+//   - a timeout (Plugin.Timeout, DefaultTimeout if unset); timed-out
+//     plugins are killed along with any processes they started
+//   - an environment holding only PATH and EIDOS_PLUGIN_API_VERSION
+//   - the plugin directory as the working directory
+//   - stdout capped at MaxOutputSize
+//
+// Hidden files, non-executables and world-writable executables are not run.
+// Unlike collector plugins, a failing bundler plugin fails the bundle, since
+// its components cannot be bundled without it; its stderr is included in
+// the error.
+//
+// # Usage
+//
+// Plugins are registered with a bundler registry, one bundler per component:
+//
+//	reg := registry.NewRegistry()
+//	components, err := reg.LoadPlugins(ctx, "/opt/eidos/bundler-plugins", 10*time.Second)
+//
+// DefaultBundler does this for the directory set with config.WithPluginDir.
+package plugin
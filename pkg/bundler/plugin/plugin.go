@@ -0,0 +1,286 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/internal/pluginexec"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	// APIVersion is the apiVersion of plugin requests and responses.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+
+	// RequestKind is the kind of requests written to plugin stdin.
+	RequestKind = "BundlerRequest"
+
+	// ResponseKind is the kind plugins must set in their response.
+	ResponseKind = "BundlerResponse"
+
+	// DefaultTimeout is how long a plugin call may run when Plugin.Timeout is unset.
+	DefaultTimeout = 30 * time.Second
+
+	// MaxOutputSize is the largest stdout a plugin may write.
+	MaxOutputSize = 16 << 20
+
+	// maxStderrSize is how much plugin stderr is kept for error messages.
+	maxStderrSize = 4 << 10
+
+	// waitDelay bounds how long a killed plugin's children may hold its
+	// output pipes open.
+	waitDelay = 2 * time.Second
+)
+
+// EnvAPIVersion is set in the plugin environment to the apiVersion of the
+// request on stdin.
+const EnvAPIVersion = "EIDOS_PLUGIN_API_VERSION"
+
+// Operation identifies what a plugin is asked to do.
+type Operation string
+
+// Plugin operations.
+const (
+	// OperationDescribe asks the plugin for the components it bundles.
+	OperationDescribe Operation = "describe"
+
+	// OperationValues asks the plugin for the values of a component.
+	OperationValues Operation = "values"
+
+	// OperationMake asks the plugin for the files to add to the bundle for
+	// a component.
+	OperationMake Operation = "make"
+)
+
+// namePattern restricts plugin and component names, which become bundle
+// directory names.
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// Request is the JSON document written to a plugin's stdin.
+type Request struct {
+	// APIVersion is APIVersion.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is RequestKind.
+	Kind string `json:"kind"`
+
+	// Operation is what the plugin is asked to do.
+	Operation Operation `json:"operation"`
+
+	// Component is the recipe component reference being bundled. Unset for
+	// OperationDescribe.
+	Component *recipe.ComponentRef `json:"component,omitempty"`
+
+	// Criteria are the criteria the recipe was generated for, if known.
+	Criteria *recipe.Criteria `json:"criteria,omitempty"`
+
+	// Values are the component values resolved so far: the recipe values
+	// for OperationValues, the final bundle values for OperationMake.
+	Values map[string]any `json:"values,omitempty"`
+}
+
+// Response is the JSON document a plugin writes to stdout.
+type Response struct {
+	// APIVersion must be APIVersion.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind must be ResponseKind.
+	Kind string `json:"kind"`
+
+	// Components lists the component names the plugin bundles. Required for
+	// OperationDescribe.
+	Components []string `json:"components,omitempty"`
+
+	// Values replace the component values. Only read for OperationValues;
+	// unset keeps the values from the request.
+	Values map[string]any `json:"values,omitempty"`
+
+	// Files are written to the component's plugin directory in the bundle.
+	// Only read for OperationMake.
+	Files []File `json:"files,omitempty"`
+}
+
+// File is a file a plugin adds to the bundle.
+type File struct {
+	// Path is the file path, relative to the component's plugin directory.
+	Path string `json:"path"`
+
+	// Content is the file content.
+	Content string `json:"content"`
+}
+
+// Plugin is a bundler plugin executable.
+type Plugin struct {
+	// Name is the executable name without its extension.
+	Name string
+
+	// Path is the path of the executable.
+	Path string
+
+	// Timeout bounds each call. Zero means DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Discover lists the plugin executables in dir, sorted by name, with the
+// given call timeout. Hidden files, directories, non-executables and
+// world-writable files are skipped.
+func Discover(dir string, timeout time.Duration) ([]*Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin directory %s: %w", dir, err)
+	}
+
+	var plugins []*Plugin
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		if info.Mode().Perm()&0o002 != 0 {
+			slog.Warn("skipping world-writable bundler plugin", slog.String("plugin", path))
+			continue
+		}
+
+		name := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		if !namePattern.MatchString(name) {
+			slog.Warn("skipping bundler plugin with invalid name", slog.String("plugin", path))
+			continue
+		}
+		if slices.ContainsFunc(plugins, func(p *Plugin) bool { return p.Name == name }) {
+			slog.Warn("skipping bundler plugin with duplicate name",
+				slog.String("plugin", path),
+				slog.String("name", name))
+			continue
+		}
+		plugins = append(plugins, &Plugin{Name: name, Path: path, Timeout: timeout})
+	}
+	return plugins, nil
+}
+
+// Describe returns the component names the plugin bundles.
+func (p *Plugin) Describe(ctx context.Context) ([]string, error) {
+	resp, err := p.Call(ctx, &Request{Operation: OperationDescribe})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Components) == 0 {
+		return nil, fmt.Errorf("plugin %s: describe returned no components", p.Name)
+	}
+	for _, name := range resp.Components {
+		if !namePattern.MatchString(name) {
+			return nil, fmt.Errorf("plugin %s: invalid component name %q", p.Name, name)
+		}
+	}
+	return resp.Components, nil
+}
+
+// Call runs the plugin with req on stdin and returns its validated response.
+// The plugin runs with a timeout, a minimal environment and bounded output.
+func (p *Plugin) Call(ctx context.Context, req *Request) (*Response, error) {
+	req.APIVersion = APIVersion
+	req.Kind = RequestKind
+	stdin, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: failed to encode request: %w", p.Name, err)
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout := &pluginexec.LimitedBuffer{Limit: MaxOutputSize}
+	stderr := &pluginexec.LimitedBuffer{Limit: maxStderrSize, Truncate: true}
+
+	cmd := exec.CommandContext(runCtx, p.Path)
+	cmd.Dir = filepath.Dir(p.Path)
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		EnvAPIVersion + "=" + APIVersion,
+	}
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+	pluginexec.KillProcessGroup(cmd)
+
+	start := time.Now()
+	err = cmd.Run()
+	switch {
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded):
+		return nil, fmt.Errorf("plugin %s: %s timed out after %s", p.Name, req.Operation, timeout)
+	case err != nil:
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("plugin %s: %s failed: %w: %s", p.Name, req.Operation, err, msg)
+		}
+		return nil, fmt.Errorf("plugin %s: %s failed: %w", p.Name, req.Operation, err)
+	}
+
+	resp, err := parseResponse(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %s: %w", p.Name, req.Operation, err)
+	}
+
+	slog.Debug("bundler plugin called",
+		slog.String("plugin", p.Path),
+		slog.String("operation", string(req.Operation)),
+		slog.Duration("duration", time.Since(start)))
+
+	return resp, nil
+}
+
+// parseResponse decodes and validates a plugin response.
+func parseResponse(raw []byte) (*Response, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	var resp Response
+	if err := dec.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid response: trailing data after JSON document")
+	}
+	if resp.APIVersion != APIVersion || resp.Kind != ResponseKind {
+		return nil, fmt.Errorf("invalid response: expected apiVersion %q and kind %q, got %q and %q",
+			APIVersion, ResponseKind, resp.APIVersion, resp.Kind)
+	}
+	for _, f := range resp.Files {
+		if !filepath.IsLocal(f.Path) {
+			return nil, fmt.Errorf("invalid response: file path %q must be relative and stay within the component directory", f.Path)
+		}
+	}
+	return &resp, nil
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// writePlugin writes a shell script plugin into dir with the given mode.
+func writePlugin(t *testing.T, dir, name, script string, mode os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write plugin: %v", err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatalf("failed to chmod plugin: %v", err)
+	}
+	return path
+}
+
+// respond returns a script that answers each operation with the given
+// response body fields.
+func respond(describe, values, make string) string {
+	const head = `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"BundlerResponse"`
+	return `req=$(cat)
+case "$req" in
+*'"operation":"describe"'*) printf '%s' '` + head + describe + `}' ;;
+*'"operation":"values"'*) printf '%s' '` + head + values + `}' ;;
+*'"operation":"make"'*) printf '%s' '` + head + make + `}' ;;
+esac`
+}
+
+var internalChart = respond(
+	`,"components":["internal-chart"]`,
+	`,"values":{"replicas":3}`,
+	`,"files":[{"path":"templates/config.yaml","content":"kind: ConfigMap\n"}]`,
+)
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "internal.sh", internalChart, 0o755)
+	writePlugin(t, dir, "not-executable.sh", internalChart, 0o644)
+	writePlugin(t, dir, ".hidden.sh", internalChart, 0o755)
+	writePlugin(t, dir, "world-writable.sh", internalChart, 0o755)
+	if err := os.Chmod(filepath.Join(dir, "world-writable.sh"), 0o757); err != nil {
+		t.Fatal(err)
+	}
+
+	plugins, err := Discover(dir, time.Second)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(plugins) != 1 || plugins[0].Name != "internal" || plugins[0].Timeout != time.Second {
+		t.Fatalf("Discover() = %+v, want [internal]", plugins)
+	}
+
+	if _, err := Discover(filepath.Join(dir, "missing"), 0); err == nil {
+		t.Error("expected error for missing plugin directory")
+	}
+}
+
+func TestPlugin_Describe(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    []string
+		wantErr string
+	}{
+		{name: "valid", script: internalChart, want: []string{"internal-chart"}},
+		{name: "no components", script: respond("", "", ""), wantErr: "no components"},
+		{name: "invalid name", script: respond(`,"components":["../etc"]`, "", ""), wantErr: "invalid component name"},
+		{name: "wrong kind", script: `echo '{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","components":["x"]}'`, wantErr: "expected apiVersion"},
+		{name: "unknown field", script: respond(`,"components":["x"],"extra":true`, "", ""), wantErr: "unknown field"},
+		{name: "trailing data", script: internalChart + "\necho '{}'", wantErr: "trailing data"},
+		{name: "failure", script: "echo boom >&2; exit 3", wantErr: "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Plugin{Name: "test", Path: writePlugin(t, t.TempDir(), "test.sh", tt.script, 0o755), Timeout: 5 * time.Second}
+			got, err := p.Describe(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Describe() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Describe() error = %v", err)
+			}
+			if len(got) != len(tt.want) || got[0] != tt.want[0] {
+				t.Errorf("Describe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlugin_Call_Request(t *testing.T) {
+	dir := t.TempDir()
+	// Echo the request back as the values, so the test can inspect it.
+	p := &Plugin{Name: "echo", Path: writePlugin(t, dir, "echo.sh",
+		`printf '{"apiVersion":"%s","kind":"BundlerResponse","values":%s}' "$EIDOS_PLUGIN_API_VERSION" "$(cat)"`, 0o755)}
+
+	resp, err := p.Call(context.Background(), &Request{
+		Operation: OperationValues,
+		Component: &recipe.ComponentRef{Name: "internal-chart", Version: "1.0.0"},
+		Values:    map[string]any{"replicas": 1},
+	})
+	if err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+	if resp.Values["apiVersion"] != APIVersion || resp.Values["kind"] != RequestKind || resp.Values["operation"] != "values" {
+		t.Errorf("unexpected request header: %v", resp.Values)
+	}
+	component, _ := resp.Values["component"].(map[string]any)
+	if component["name"] != "internal-chart" || component["version"] != "1.0.0" {
+		t.Errorf("unexpected request component: %v", resp.Values["component"])
+	}
+}
+
+func TestPlugin_Call_Timeout(t *testing.T) {
+	p := &Plugin{Name: "slow", Path: writePlugin(t, t.TempDir(), "slow.sh", "sleep 10", 0o755), Timeout: 100 * time.Millisecond}
+
+	start := time.Now()
+	_, err := p.Call(context.Background(), &Request{Operation: OperationDescribe})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Call() error = %v, want timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Call() took %v, plugin was not killed", elapsed)
+	}
+}
+
+func TestBundler(t *testing.T) {
+	p := &Plugin{Name: "internal", Path: writePlugin(t, t.TempDir(), "internal.sh", internalChart, 0o755)}
+	b := NewBundler(p, "internal-chart")
+	rec := &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "internal-chart", Type: recipe.ComponentTypeHelm, Source: "oci://registry.example.com/charts"},
+			{Name: "gpu-operator", Type: recipe.ComponentTypeHelm},
+		},
+	}
+
+	values, err := b.ComponentValues(context.Background(), rec, rec.ComponentRefs[0], map[string]any{"replicas": 1})
+	if err != nil {
+		t.Fatalf("ComponentValues() error = %v", err)
+	}
+	if values["replicas"] != float64(3) {
+		t.Errorf("replicas = %v, want 3", values["replicas"])
+	}
+
+	dir := t.TempDir()
+	res, err := b.Make(context.Background(), rec, dir)
+	if err != nil {
+		t.Fatalf("Make() error = %v", err)
+	}
+	want := filepath.Join(dir, "internal-chart", "templates", "config.yaml")
+	if len(res.Files) != 1 || res.Files[0] != want || !res.Success {
+		t.Fatalf("Make() = %+v, want %s", res, want)
+	}
+	if content, err := os.ReadFile(want); err != nil || string(content) != "kind: ConfigMap\n" {
+		t.Errorf("plugin file content = %q, %v", content, err)
+	}
+}
+
+func TestBundler_MakeComponent_RejectsEscapingPaths(t *testing.T) {
+	for _, path := range []string{"../escape.yaml", "/etc/passwd", ""} {
+		t.Run(path, func(t *testing.T) {
+			script := respond("", "", `,"files":[{"path":"`+path+`","content":"x"}]`)
+			p := &Plugin{Name: "bad", Path: writePlugin(t, t.TempDir(), "bad.sh", script, 0o755)}
+			ref := recipe.ComponentRef{Name: "internal-chart"}
+
+			dir := t.TempDir()
+			_, err := NewBundler(p, "internal-chart").MakeComponent(context.Background(), &recipe.RecipeResult{}, ref, nil, filepath.Join(dir, "out"))
+			if err == nil || !strings.Contains(err.Error(), "must be relative") {
+				t.Errorf("MakeComponent() error = %v, want path error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postinstall generates the post-install checks of a bundle.
+//
+// The component registry lists, per component, the checks that tell whether
+// it came up (see recipe.VerifyCheck): a kubectl wait for a resource such as
+// the GPU Operator ClusterPolicy, or a command such as nvidia-smi run in a pod
+// requesting a GPU. Resolve picks the ones the component values enable:
+//
+//	checks := postinstall.Resolve("gpu-operator", comp.Verify, values)
+//
+// Generate then writes verify.sh to the bundle root, running the checks of
+// every component in deployment order, or of the components given as
+// arguments, and appends a section listing them to the bundle README.md:
+//
+//	./verify.sh
+//	TIMEOUT=20m ./verify.sh gpu-operator
+//
+// GPU pods run on the accelerated nodes, with the bundle's accelerated node
+// selector and tolerations, and are deleted once they finish.
+package postinstall
@@ -0,0 +1,377 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postinstall
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/verify.sh.tmpl
+var scriptTemplate string
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// ScriptFileName is the name of the script written to the bundle root.
+	ScriptFileName = "verify.sh"
+
+	// ReadmeFileName is the bundle README the checks are described in.
+	ReadmeFileName = "README.md"
+
+	// DefaultTimeout is how long each check waits unless TIMEOUT is set.
+	DefaultTimeout = "10m"
+
+	// defaultGPUResource is the resource GPU pods request by default.
+	defaultGPUResource = "nvidia.com/gpu"
+
+	// podNamePrefix prefixes the names of GPU pods.
+	podNamePrefix = "eidos-verify-"
+)
+
+// Check is a post-install check of a component in the bundle.
+type Check struct {
+	// Description says what the check verifies.
+	Description string
+
+	// Wait is the resource kubectl waits for, as "<resource>/<name>" or
+	// "<resource>".
+	Wait string
+
+	// Selector narrows the objects waited for.
+	Selector string
+
+	// For is the kubectl wait condition.
+	For string
+
+	// Namespace is the namespace of the resource waited for or the GPU pod.
+	// Empty for cluster-scoped resources.
+	Namespace string
+
+	// GPUPod is set for checks running a command in a pod requesting a GPU.
+	GPUPod *recipe.GPUPodCheck
+}
+
+// WaitCommand returns the kubectl wait command of a resource check, waiting
+// for $TIMEOUT.
+func (c Check) WaitCommand() string {
+	args := []string{"kubectl", "wait", shellQuote("--for=" + c.For), shellQuote(c.Wait)}
+	if c.Selector != "" {
+		args = append(args, "-l", shellQuote(c.Selector))
+	} else if !strings.Contains(c.Wait, "/") {
+		args = append(args, "--all")
+	}
+	if c.Namespace != "" {
+		args = append(args, "-n", shellQuote(c.Namespace))
+	}
+	args = append(args, `--timeout="${TIMEOUT}"`)
+	return strings.Join(args, " ")
+}
+
+// Component holds the checks of one component.
+type Component struct {
+	// Name is the component name in the recipe.
+	Name string
+
+	// Checks are the component's checks, in registry order.
+	Checks []Check
+}
+
+// Resolve returns the checks of a component installed into namespace given
+// its values. Checks whose enabled path the values set to false are skipped.
+func Resolve(namespace string, configs []recipe.VerifyCheck, values map[string]any) []Check {
+	var resolved []Check
+	for _, cfg := range configs {
+		if cfg.EnabledPath != "" && disabled(values, cfg.EnabledPath) {
+			continue
+		}
+		check := Check{
+			Description: cfg.Description,
+			Wait:        cfg.Wait,
+			Selector:    cfg.Selector,
+			For:         cfg.For,
+			GPUPod:      cfg.GPUPod,
+		}
+		if cfg.Namespaced || cfg.GPUPod != nil {
+			check.Namespace = namespace
+		}
+		resolved = append(resolved, check)
+	}
+	return resolved
+}
+
+// disabled reports whether the value at a dot-notation path of values is
+// false.
+func disabled(values map[string]any, valuesPath string) bool {
+	parts := strings.Split(valuesPath, ".")
+	current := values
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			return false
+		}
+		current = next
+	}
+	value, ok := current[parts[len(parts)-1]].(bool)
+	return ok && !value
+}
+
+// GeneratorInput contains all data needed to generate the post-install
+// checks.
+type GeneratorInput struct {
+	// Components are the components with checks, in deployment order.
+	Components []Component
+
+	// NodeSelector and Tolerations schedule GPU pods on the accelerated
+	// nodes.
+	NodeSelector map[string]string
+	Tolerations  []corev1.Toleration
+
+	// RecipeVersion is the version of the recipe the bundle was built from.
+	RecipeVersion string
+
+	// Version is the bundler version.
+	Version string
+}
+
+// GeneratorOutput contains the result of post-install check generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of the generated script and the README
+	// section.
+	TotalSize int64
+
+	// Duration is the time taken to generate the checks.
+	Duration time.Duration
+
+	// DeploymentNotes contains notes pointing at the script.
+	DeploymentNotes []string
+}
+
+// scriptCheck is a check rendered into the script, with the manifest of its
+// GPU pod.
+type scriptCheck struct {
+	Check
+	PodName     string
+	PodManifest string
+}
+
+// scriptComponent is a component rendered into the script.
+type scriptComponent struct {
+	Name   string
+	Checks []scriptCheck
+}
+
+// templateData is the data rendered into the templates.
+type templateData struct {
+	BundlerVersion string
+	RecipeVersion  string
+	ScriptFile     string
+	DefaultTimeout string
+	Components     []scriptComponent
+}
+
+// Names returns the names of the components, separated by spaces.
+func (d *templateData) Names() string {
+	names := make([]string, len(d.Components))
+	for i, c := range d.Components {
+		names[i] = c.Name
+	}
+	return strings.Join(names, " ")
+}
+
+// Generator creates post-install checks.
+type Generator struct{}
+
+// NewGenerator creates a new post-install check generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes verify.sh into outputDir and appends a section describing
+// the checks to outputDir/README.md. Components without checks are left out.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input is required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	data := &templateData{
+		BundlerVersion: input.Version,
+		RecipeVersion:  input.RecipeVersion,
+		ScriptFile:     ScriptFileName,
+		DefaultTimeout: DefaultTimeout,
+	}
+	checks := 0
+	for _, c := range input.Components {
+		if len(c.Checks) == 0 {
+			continue
+		}
+		component := scriptComponent{Name: c.Name}
+		for i, check := range c.Checks {
+			sc := scriptCheck{Check: check}
+			if check.GPUPod != nil {
+				sc.PodName = fmt.Sprintf("%s%s-%d", podNamePrefix, c.Name, i)
+				manifest, err := gpuPodManifest(sc.PodName, check, input.NodeSelector, input.Tolerations)
+				if err != nil {
+					return nil, err
+				}
+				sc.PodManifest = manifest
+			}
+			component.Checks = append(component.Checks, sc)
+		}
+		data.Components = append(data.Components, component)
+		checks += len(component.Checks)
+	}
+	if len(data.Components) == 0 {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "no component has post-install checks")
+	}
+
+	script, err := render(scriptTemplate, ScriptFileName, data)
+	if err != nil {
+		return nil, err
+	}
+	section, err := render(readmeTemplate, ReadmeFileName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create output directory", err)
+	}
+	scriptPath := filepath.Join(outputDir, ScriptFileName)
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil { //nolint:gosec // the script is meant to be executed
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to write verify.sh", err)
+	}
+	if err := appendFile(filepath.Join(outputDir, ReadmeFileName), section); err != nil {
+		return nil, err
+	}
+
+	slog.Debug("post-install checks generated",
+		"components", len(data.Components),
+		"checks", checks,
+	)
+
+	return &GeneratorOutput{
+		Files:     []string{scriptPath},
+		TotalSize: int64(len(script) + len(section)),
+		Duration:  time.Since(start),
+		DeploymentNotes: []string{
+			fmt.Sprintf("Run ./%s after deploying to check the %d components", ScriptFileName, len(data.Components)),
+		},
+	}, nil
+}
+
+// gpuPodManifest returns the manifest of the pod running a GPU check.
+func gpuPodManifest(name string, check Check, nodeSelector map[string]string, tolerations []corev1.Toleration) (string, error) {
+	resource := check.GPUPod.Resource
+	if resource == "" {
+		resource = defaultGPUResource
+	}
+	spec := map[string]any{
+		"restartPolicy": string(corev1.RestartPolicyNever),
+		"containers": []any{map[string]any{
+			"name":    "verify",
+			"image":   check.GPUPod.Image,
+			"command": check.GPUPod.Command,
+			"resources": map[string]any{
+				"limits": map[string]any{resource: 1},
+			},
+		}},
+	}
+	if len(nodeSelector) > 0 {
+		spec["nodeSelector"] = nodeSelector
+	}
+	if len(tolerations) > 0 {
+		spec["tolerations"] = tolerations
+	}
+	pod := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": check.Namespace,
+			"labels": map[string]any{
+				"app.kubernetes.io/managed-by": "eidos",
+			},
+		},
+		"spec": spec,
+	}
+	content, err := yaml.Marshal(pod)
+	if err != nil {
+		return "", errors.Wrap(errors.ErrCodeInternal, "failed to marshal GPU pod", err)
+	}
+	return string(content), nil
+}
+
+// render executes tmplContent with data.
+func render(tmplContent, name string, data *templateData) (string, error) {
+	tmpl, err := template.New(name).Funcs(template.FuncMap{"quote": shellQuote}).Parse(tmplContent)
+	if err != nil {
+		return "", errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to parse %s template", name), err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to render %s", name), err)
+	}
+	return buf.String(), nil
+}
+
+// appendFile appends content to path, creating it if needed.
+func appendFile(path, content string) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to open README.md", err)
+	}
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return errors.Wrap(errors.ErrCodeInternal, "failed to write README.md", err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to write README.md", err)
+	}
+	return nil
+}
+
+// shellQuote quotes s for a POSIX shell unless it only holds characters the
+// shell passes through unchanged.
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./=:,@+") == "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postinstall
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestResolve(t *testing.T) {
+	configs := []recipe.VerifyCheck{
+		{Description: "ClusterPolicy is ready", Wait: "clusterpolicies.nvidia.com/cluster-policy", For: "jsonpath={.status.state}=ready"},
+		{Description: "webhook", Wait: "deployment", Selector: "app=webhook", Namespaced: true, For: "condition=Available"},
+		{Description: "nvidia-smi", EnabledPath: "devicePlugin.enabled", GPUPod: &recipe.GPUPodCheck{Image: "cuda", Command: []string{"nvidia-smi"}}},
+		{Description: "NicClusterPolicy", EnabledPath: "deployCR", Wait: "nicclusterpolicies.mellanox.com/nic-cluster-policy"},
+	}
+	values := map[string]any{
+		"deployCR": false,
+	}
+
+	got := Resolve("gpu-operator", configs, values)
+
+	if len(got) != 3 {
+		t.Fatalf("Resolve() returned %d checks, want 3 (disabled check skipped): %+v", len(got), got)
+	}
+	if got[0].Namespace != "" {
+		t.Errorf("cluster-scoped check namespace = %q, want empty", got[0].Namespace)
+	}
+	if got[1].Namespace != "gpu-operator" || got[2].Namespace != "gpu-operator" {
+		t.Errorf("namespaced checks = %+v, want namespace gpu-operator", got[1:])
+	}
+
+	values["devicePlugin"] = map[string]any{"enabled": false}
+	if got := Resolve("gpu-operator", configs, values); len(got) != 2 {
+		t.Errorf("Resolve() with device plugin disabled returned %d checks, want 2", len(got))
+	}
+}
+
+func TestCheckWaitCommand(t *testing.T) {
+	tests := []struct {
+		name  string
+		check Check
+		want  string
+	}{
+		{
+			name:  "named cluster-scoped resource",
+			check: Check{Wait: "clusterpolicies.nvidia.com/cluster-policy", For: "jsonpath={.status.state}=ready"},
+			want:  `kubectl wait '--for=jsonpath={.status.state}=ready' clusterpolicies.nvidia.com/cluster-policy --timeout="${TIMEOUT}"`,
+		},
+		{
+			name:  "all objects in namespace",
+			check: Check{Wait: "prometheuses.monitoring.coreos.com", For: "condition=Available", Namespace: "monitoring"},
+			want:  `kubectl wait --for=condition=Available prometheuses.monitoring.coreos.com --all -n monitoring --timeout="${TIMEOUT}"`,
+		},
+		{
+			name:  "selector",
+			check: Check{Wait: "deployment", Selector: "app in (a,b)", For: "condition=Available", Namespace: "cert-manager"},
+			want:  `kubectl wait --for=condition=Available deployment -l 'app in (a,b)' -n cert-manager --timeout="${TIMEOUT}"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.check.WaitCommand(); got != tt.want {
+				t.Errorf("WaitCommand() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	readme := filepath.Join(dir, ReadmeFileName)
+	if err := os.WriteFile(readme, []byte("# Bundle\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	input := &GeneratorInput{
+		Components: []Component{
+			{Name: "cert-manager"},
+			{Name: "gpu-operator", Checks: []Check{
+				{Description: "ClusterPolicy is ready", Wait: "clusterpolicies.nvidia.com/cluster-policy", For: "jsonpath={.status.state}=ready"},
+				{Description: "nvidia-smi runs", Namespace: "gpu-operator",
+					GPUPod: &recipe.GPUPodCheck{Image: "nvcr.io/nvidia/cuda:12.9.1-base-ubuntu24.04", Command: []string{"nvidia-smi"}}},
+			}},
+		},
+		NodeSelector: map[string]string{"nodeGroup": "gpu"},
+		Tolerations: []corev1.Toleration{{
+			Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule,
+		}},
+		Version: "v1.0.0",
+	}
+
+	output, err := NewGenerator().Generate(context.Background(), input, dir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(output.Files) != 1 || output.Files[0] != filepath.Join(dir, ScriptFileName) {
+		t.Errorf("Files = %v, want only %s", output.Files, ScriptFileName)
+	}
+
+	script, err := os.ReadFile(filepath.Join(dir, ScriptFileName))
+	if err != nil {
+		t.Fatalf("failed to read script: %v", err)
+	}
+	for _, want := range []string{
+		"components=(gpu-operator)",
+		"check 'ClusterPolicy is ready' kubectl wait",
+		"run_gpu_pod gpu-operator eidos-verify-gpu-operator-1 <<'POD'",
+		"nvidia.com/gpu: 1",
+		"nodeGroup: gpu",
+		"key: dedicated",
+	} {
+		if !strings.Contains(string(script), want) {
+			t.Errorf("script missing %q:\n%s", want, script)
+		}
+	}
+	if info, err := os.Stat(filepath.Join(dir, ScriptFileName)); err != nil || info.Mode().Perm()&0100 == 0 {
+		t.Errorf("script is not executable: %v", err)
+	}
+
+	content, err := os.ReadFile(readme)
+	if err != nil {
+		t.Fatalf("failed to read README: %v", err)
+	}
+	if !strings.HasPrefix(string(content), "# Bundle\n") || !strings.Contains(string(content), "### gpu-operator") {
+		t.Errorf("README.md section not appended:\n%s", content)
+	}
+	if strings.Contains(string(content), "### cert-manager") {
+		t.Errorf("README.md lists component without checks:\n%s", content)
+	}
+}
+
+func TestGenerateWithoutChecks(t *testing.T) {
+	_, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+		Components: []Component{{Name: "cert-manager"}},
+	}, t.TempDir())
+	if err == nil {
+		t.Fatal("Generate() expected error without checks")
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxy injects proxy settings and a custom CA bundle into bundle
+// components, for clusters that reach the internet through a proxy.
+//
+// The component registry lists, per component, where its values take
+// container env vars and a CA bundle (see recipe.ProxyConfig). Apply merges
+// the proxy env vars returned by Env into those lists and points the
+// component at the CA bundle ConfigMap:
+//
+//	env := proxy.Env("http://proxy.corp:3128", []string{".corp.example.com"})
+//	proxy.Apply(comp.Proxy, values, env, true)
+//
+// Generate then writes proxy/ca-bundle.yaml, a ConfigMap holding the CA
+// bundle in each component namespace, and a README.md describing the
+// settings.
+package proxy
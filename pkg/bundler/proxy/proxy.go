@@ -0,0 +1,247 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/component"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/ca-bundle.yaml.tmpl
+var caBundleTemplate string
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// DirName is the bundle subdirectory the CA bundle manifests are
+	// written to.
+	DirName = "proxy"
+
+	// CABundleFileName holds the CA bundle ConfigMaps.
+	CABundleFileName = "ca-bundle.yaml"
+
+	// ConfigMapName is the name of the ConfigMap holding the CA bundle in
+	// each component namespace, and of the volume mounting it.
+	ConfigMapName = "eidos-ca-bundle"
+
+	// CAKey is the ConfigMap key holding the PEM certificates.
+	CAKey = "eidos-ca.crt"
+
+	// CAMountPath is where the CA bundle is mounted in containers taking it
+	// as a volume. Files in /etc/ssl/certs are trusted in addition to the
+	// system CAs.
+	CAMountPath = "/etc/ssl/certs/" + CAKey
+)
+
+// DefaultNoProxy lists the in-cluster addresses that always bypass the
+// proxy, so components keep reaching the API server and cluster services.
+var DefaultNoProxy = []string{"localhost", "127.0.0.1", ".svc", ".cluster.local"}
+
+// Env returns the proxy env vars for httpProxy, in upper and lower case since
+// tools differ in which they read. NO_PROXY holds DefaultNoProxy followed by
+// noProxy. It returns nil when httpProxy is empty.
+func Env(httpProxy string, noProxy []string) []corev1.EnvVar {
+	if httpProxy == "" {
+		return nil
+	}
+
+	bypass := slices.Clone(DefaultNoProxy)
+	for _, host := range noProxy {
+		if !slices.Contains(bypass, host) {
+			bypass = append(bypass, host)
+		}
+	}
+	joined := strings.Join(bypass, ",")
+
+	var env []corev1.EnvVar
+	for _, v := range []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: httpProxy},
+		{Name: "HTTPS_PROXY", Value: httpProxy},
+		{Name: "NO_PROXY", Value: joined},
+	} {
+		env = append(env, v, corev1.EnvVar{Name: strings.ToLower(v.Name), Value: v.Value})
+	}
+	return env
+}
+
+// Apply injects env into the env var lists of a component's values and, when
+// caBundle is set, points the component at the CA bundle ConfigMap, either
+// by name or by mounting it.
+func Apply(cfg recipe.ProxyConfig, values map[string]any, env []corev1.EnvVar, caBundle bool) {
+	if values == nil {
+		return
+	}
+
+	component.ApplyEnvOverrides(values, env, cfg.EnvPaths...)
+
+	if !caBundle {
+		return
+	}
+	for _, path := range cfg.CAConfigMapPaths {
+		if err := component.ApplyMapOverrides(values, map[string]string{path: ConfigMapName}); err != nil {
+			slog.Warn("failed to set CA bundle ConfigMap", "path", path, "error", err)
+		}
+	}
+	volume := map[string]any{
+		"name":      ConfigMapName,
+		"configMap": map[string]any{"name": ConfigMapName},
+	}
+	mount := map[string]any{
+		"name":      ConfigMapName,
+		"mountPath": CAMountPath,
+		"subPath":   CAKey,
+		"readOnly":  true,
+	}
+	for _, vol := range cfg.CAVolumes {
+		component.ApplyNamedListOverrides(values, []map[string]any{volume}, vol.VolumesPath)
+		component.ApplyNamedListOverrides(values, []map[string]any{mount}, vol.VolumeMountsPath)
+	}
+}
+
+// GeneratorInput contains all data needed to generate the CA bundle
+// manifests.
+type GeneratorInput struct {
+	// CABundle holds the PEM certificates.
+	CABundle []byte
+
+	// Namespaces are the namespaces of the components taking the CA bundle.
+	Namespaces []string
+
+	// HTTPProxy is the proxy URL, listed in the README.
+	HTTPProxy string
+
+	// NoProxy are the addresses bypassing the proxy, listed in the README.
+	NoProxy []string
+
+	// Version is the bundler version.
+	Version string
+}
+
+// GeneratorOutput contains the result of CA bundle manifest generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the manifests.
+	Duration time.Duration
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// templateData is the data rendered into the templates.
+type templateData struct {
+	BundlerVersion string
+	ConfigMapName  string
+	CAKey          string
+	CALines        []string
+	Namespaces     []string
+	HTTPProxy      string
+	NoProxy        string
+}
+
+// Generator creates the CA bundle manifests.
+type Generator struct{}
+
+// NewGenerator creates a new CA bundle manifest generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes a ConfigMap holding the CA bundle per namespace and a
+// README describing the proxy settings to outputDir/proxy.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || len(input.CABundle) == 0 {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and CA bundle are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	namespaces := slices.Clone(input.Namespaces)
+	slices.Sort(namespaces)
+	namespaces = slices.Compact(namespaces)
+
+	data := &templateData{
+		BundlerVersion: input.Version,
+		ConfigMapName:  ConfigMapName,
+		CAKey:          CAKey,
+		CALines:        strings.Split(strings.TrimSpace(string(input.CABundle)), "\n"),
+		Namespaces:     namespaces,
+		HTTPProxy:      input.HTTPProxy,
+	}
+	if input.HTTPProxy != "" {
+		data.NoProxy = proxyVar(Env(input.HTTPProxy, input.NoProxy), "NO_PROXY")
+	}
+
+	proxyDir := filepath.Join(outputDir, DirName)
+	if err := os.MkdirAll(proxyDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create proxy directory", err)
+	}
+
+	output := &GeneratorOutput{}
+	for _, file := range []struct {
+		name     string
+		template string
+	}{
+		{CABundleFileName, caBundleTemplate},
+		{"README.md", readmeTemplate},
+	} {
+		path, size, err := component.WriteTemplate(file.template, file.name, proxyDir, data, 0600)
+		if err != nil {
+			return nil, err
+		}
+		output.Files = append(output.Files, path)
+		output.TotalSize += size
+	}
+
+	output.DeploymentNotes = []string{fmt.Sprintf(
+		"Apply %s before deploying; it holds the CA bundle for %d namespaces",
+		filepath.Join(DirName, CABundleFileName), len(namespaces))}
+	output.Duration = time.Since(start)
+
+	slog.Debug("CA bundle manifests generated", "namespaces", len(namespaces))
+
+	return output, nil
+}
+
+// proxyVar returns the value of the env var called name.
+func proxyVar(env []corev1.EnvVar, name string) string {
+	for _, e := range env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
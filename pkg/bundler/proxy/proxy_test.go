@@ -0,0 +1,140 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const testPEM = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUB1
+-----END CERTIFICATE-----
+`
+
+func TestEnv(t *testing.T) {
+	if env := Env("", []string{".corp"}); env != nil {
+		t.Errorf("Env() without proxy = %v, want nil", env)
+	}
+
+	env := Env("http://proxy.corp:3128", []string{".corp.example.com", ".svc"})
+	got := make(map[string]string, len(env))
+	for _, e := range env {
+		got[e.Name] = e.Value
+	}
+	if len(got) != 6 {
+		t.Fatalf("Env() = %v, want 6 env vars", env)
+	}
+	for _, name := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+		if got[name] != "http://proxy.corp:3128" {
+			t.Errorf("%s = %q", name, got[name])
+		}
+	}
+	want := "localhost,127.0.0.1,.svc,.cluster.local,.corp.example.com"
+	if got["NO_PROXY"] != want || got["no_proxy"] != want {
+		t.Errorf("NO_PROXY = %q, want %q", got["NO_PROXY"], want)
+	}
+}
+
+func TestApply(t *testing.T) {
+	cfg := recipe.ProxyConfig{
+		EnvPaths:         []string{"driver.env"},
+		CAConfigMapPaths: []string{"driver.certConfig.name"},
+		CAVolumes:        []recipe.CAVolumeConfig{{VolumesPath: "volumes", VolumeMountsPath: "volumeMounts"}},
+	}
+	values := map[string]any{
+		"driver": map[string]any{"version": "580.105.08"},
+	}
+
+	Apply(cfg, values, Env("http://proxy.corp:3128", nil), true)
+
+	driver := values["driver"].(map[string]any)
+	if env, ok := driver["env"].([]any); !ok || len(env) != 6 {
+		t.Errorf("driver.env = %v, want 6 env vars", driver["env"])
+	}
+	if name := driver["certConfig"].(map[string]any)["name"]; name != ConfigMapName {
+		t.Errorf("driver.certConfig.name = %v, want %s", name, ConfigMapName)
+	}
+	volumes := values["volumes"].([]any)
+	if len(volumes) != 1 || volumes[0].(map[string]any)["configMap"].(map[string]any)["name"] != ConfigMapName {
+		t.Errorf("volumes = %v", volumes)
+	}
+	mounts := values["volumeMounts"].([]any)
+	if len(mounts) != 1 || mounts[0].(map[string]any)["mountPath"] != CAMountPath {
+		t.Errorf("volumeMounts = %v", mounts)
+	}
+
+	// Applying again does not add the volume twice
+	Apply(cfg, values, nil, true)
+	if len(values["volumes"].([]any)) != 1 {
+		t.Errorf("volumes = %v, want 1 volume", values["volumes"])
+	}
+
+	proxyOnly := map[string]any{}
+	Apply(cfg, proxyOnly, Env("http://proxy.corp:3128", nil), false)
+	if _, ok := proxyOnly["volumes"]; ok {
+		t.Error("volumes set without a CA bundle")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	output, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+		CABundle:   []byte(testPEM),
+		Namespaces: []string{"gpu-operator", "cert-manager", "gpu-operator"},
+		HTTPProxy:  "http://proxy.corp:3128",
+		Version:    "v1.0.0",
+	}, dir)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(output.Files) != 2 || len(output.DeploymentNotes) != 1 {
+		t.Errorf("output = %+v, want two files and one note", output)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, DirName, CABundleFileName))
+	if err != nil {
+		t.Fatalf("failed to read CA bundle: %v", err)
+	}
+	manifest := string(content)
+	if n := strings.Count(manifest, "kind: ConfigMap"); n != 2 {
+		t.Errorf("got %d ConfigMaps, want 2:\n%s", n, manifest)
+	}
+	for _, want := range []string{
+		"namespace: cert-manager",
+		"  " + CAKey + ": |\n    -----BEGIN CERTIFICATE-----\n    MIIBszCCAVmgAwIBAgIUB1\n    -----END CERTIFICATE-----\n",
+	} {
+		if !strings.Contains(manifest, want) {
+			t.Errorf("manifest missing %q:\n%s", want, manifest)
+		}
+	}
+
+	readme, err := os.ReadFile(filepath.Join(dir, DirName, "README.md"))
+	if err != nil {
+		t.Fatalf("failed to read README: %v", err)
+	}
+	if !strings.Contains(string(readme), "`http://proxy.corp:3128`") {
+		t.Errorf("README missing proxy:\n%s", readme)
+	}
+
+	if _, err := NewGenerator().Generate(context.Background(), &GeneratorInput{}, dir); err == nil {
+		t.Error("expected error without CA bundle")
+	}
+}
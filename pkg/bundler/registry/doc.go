@@ -139,6 +139,19 @@
 //	    go b.Make(ctx, recipe, outputDir)
 //	}
 //
+// # Plugins
+//
+// Components outside the embedded component registry, such as internal
+// charts, can be bundled by exec plugins without changing Eidos. LoadPlugins
+// discovers the executables in a directory, asks each for the components it
+// bundles, and registers a plugin bundler for each:
+//
+//	reg := registry.NewRegistry()
+//	components, err := reg.LoadPlugins(ctx, "/opt/eidos/bundler-plugins", 10*time.Second)
+//
+// Plugin bundlers implement ComponentBundler, which handles one component
+// reference at a time. The plugin protocol is described in package plugin.
+//
 // # Testing
 //
 // Create isolated registries for testing:
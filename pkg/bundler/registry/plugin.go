@@ -0,0 +1,69 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/plugin"
+	"github.com/NVIDIA/eidos/pkg/bundler/types"
+)
+
+// Plugin bundlers handle component references one at a time.
+var _ ComponentBundler = (*plugin.Bundler)(nil)
+
+// LoadPlugins discovers the bundler plugins in dir, asks each for the
+// components it bundles and registers a plugin bundler for each component.
+// Each plugin call is bounded by timeout (plugin.DefaultTimeout if zero).
+//
+// Unlike collector plugins, a plugin that fails to describe itself is an
+// error, since the components it bundles would otherwise be bundled without
+// it. A component claimed by two plugins, or already registered, is an error.
+// Returns the registered component types in plugin order.
+func (r *Registry) LoadPlugins(ctx context.Context, dir string, timeout time.Duration) ([]types.BundleType, error) {
+	plugins, err := plugin.Discover(dir, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var loaded []types.BundleType
+	for _, p := range plugins {
+		components, err := p.Describe(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range components {
+			bundleType := types.BundleType(name)
+			if existing, ok := r.Get(bundleType); ok {
+				if pb, ok := existing.(*plugin.Bundler); ok {
+					return nil, fmt.Errorf("component %s is bundled by both plugin %s and plugin %s",
+						name, pb.Plugin().Name, p.Name)
+				}
+				return nil, fmt.Errorf("plugin %s: bundler type %s already registered", p.Name, name)
+			}
+			r.Register(bundleType, plugin.NewBundler(p, name))
+			loaded = append(loaded, bundleType)
+		}
+
+		slog.Debug("loaded bundler plugin",
+			slog.String("plugin", p.Path),
+			slog.Any("components", components))
+	}
+	return loaded, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/types"
+)
+
+// writeDescribePlugin writes a plugin into dir that bundles components.
+func writeDescribePlugin(t *testing.T, dir, name, components string) {
+	t.Helper()
+	script := `#!/bin/sh
+cat >/dev/null
+echo '{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"BundlerResponse","components":[` + components + `]}'
+`
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755); err != nil {
+		t.Fatalf("failed to write plugin: %v", err)
+	}
+}
+
+// TestRegistry_LoadPlugins tests registering components from plugins
+func TestRegistry_LoadPlugins(t *testing.T) {
+	dir := t.TempDir()
+	writeDescribePlugin(t, dir, "charts.sh", `"internal-chart","internal-operator"`)
+	writeDescribePlugin(t, dir, "metrics.sh", `"internal-metrics"`)
+
+	reg := NewRegistry()
+	loaded, err := reg.LoadPlugins(context.Background(), dir, 5*time.Second)
+	if err != nil {
+		t.Fatalf("LoadPlugins() error = %v", err)
+	}
+	want := []types.BundleType{"internal-chart", "internal-operator", "internal-metrics"}
+	if len(loaded) != len(want) {
+		t.Fatalf("LoadPlugins() = %v, want %v", loaded, want)
+	}
+	for i := range want {
+		if loaded[i] != want[i] {
+			t.Errorf("LoadPlugins()[%d] = %s, want %s", i, loaded[i], want[i])
+		}
+		b, ok := reg.Get(want[i])
+		if !ok {
+			t.Fatalf("%s not registered", want[i])
+		}
+		if _, ok := b.(ComponentBundler); !ok {
+			t.Errorf("%s bundler does not implement ComponentBundler", want[i])
+		}
+	}
+}
+
+// TestRegistry_LoadPlugins_Errors tests plugin loading failures
+func TestRegistry_LoadPlugins_Errors(t *testing.T) {
+	t.Run("component claimed twice", func(t *testing.T) {
+		dir := t.TempDir()
+		writeDescribePlugin(t, dir, "a.sh", `"internal-chart"`)
+		writeDescribePlugin(t, dir, "b.sh", `"internal-chart"`)
+
+		_, err := NewRegistry().LoadPlugins(context.Background(), dir, 5*time.Second)
+		if err == nil || !strings.Contains(err.Error(), "both plugin a and plugin b") {
+			t.Errorf("LoadPlugins() error = %v, want duplicate component error", err)
+		}
+	})
+
+	t.Run("already registered", func(t *testing.T) {
+		dir := t.TempDir()
+		writeDescribePlugin(t, dir, "a.sh", `"gpu-operator"`)
+
+		reg := NewRegistry()
+		reg.Register(types.BundleType("gpu-operator"), &mockBundler{name: "gpu-operator"})
+		if _, err := reg.LoadPlugins(context.Background(), dir, 5*time.Second); err == nil {
+			t.Error("LoadPlugins() should fail for an already registered component")
+		}
+	})
+
+	t.Run("describe fails", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "broken.sh"), []byte("#!/bin/sh\nexit 1\n"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewRegistry().LoadPlugins(context.Background(), dir, 5*time.Second); err == nil {
+			t.Error("LoadPlugins() should fail when a plugin cannot describe itself")
+		}
+	})
+}
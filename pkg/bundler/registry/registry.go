@@ -55,6 +55,22 @@ type ValidatableBundler interface {
 	Validate(ctx context.Context, input recipe.RecipeInput) error
 }
 
+// ComponentBundler is an optional interface for bundlers that handle one
+// component reference at a time, such as the plugin bundlers registered by
+// LoadPlugins. Bundlers implementing it are registered under the component
+// name and used for every reference to that component.
+type ComponentBundler interface {
+	Bundler
+
+	// ComponentValues returns the values for ref, given the values resolved
+	// from the recipe.
+	ComponentValues(ctx context.Context, input recipe.RecipeInput, ref recipe.ComponentRef, values map[string]any) (map[string]any, error)
+
+	// MakeComponent writes additional bundle files for ref, whose final
+	// values are values, into dir.
+	MakeComponent(ctx context.Context, input recipe.RecipeInput, ref recipe.ComponentRef, values map[string]any, dir string) (*result.Result, error)
+}
+
 // Global registry for bundler factories.
 // Bundlers register themselves via init() functions.
 var (
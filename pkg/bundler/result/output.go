@@ -54,6 +54,38 @@ type Output struct {
 	// OutputDir is the directory where bundles were generated.
 	OutputDir string `json:"output_dir" yaml:"output_dir"`
 
+	// RecipeDigest is the digest of the recipe the bundle was generated from
+	// (see recipe.RecipeResult.Digest).
+	RecipeDigest string `json:"recipe_digest,omitempty" yaml:"recipe_digest,omitempty"`
+
+	// SkippedComponents lists recipe components that were not bundled
+	// because they are marked enabled: false.
+	SkippedComponents []string `json:"skipped_components,omitempty" yaml:"skipped_components,omitempty"`
+
+	// PolicyWarnings describes resolved versions that the version policy does
+	// not approve but that use the warn action.
+	PolicyWarnings []string `json:"policy_warnings,omitempty" yaml:"policy_warnings,omitempty"`
+
+	// SchemaWarnings describes component values that the chart values
+	// schema rejects, when schema validation reports warnings.
+	SchemaWarnings []string `json:"schema_warnings,omitempty" yaml:"schema_warnings,omitempty"`
+
+	// Overrides records how the --set value overrides were applied, or nil
+	// when there were none.
+	Overrides *Overrides `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+
+	// ChangesFile is the path of the CHANGES.md written when the bundle
+	// replaces a previous one, or empty when there was no previous bundle.
+	ChangesFile string `json:"changes_file,omitempty" yaml:"changes_file,omitempty"`
+
+	// AuditFile is the path of the audit.json recording who generated the
+	// bundle, its effective config and the provenance of the component values.
+	AuditFile string `json:"audit_file,omitempty" yaml:"audit_file,omitempty"`
+
+	// ManifestFile is the path of the bundle.yaml listing the generator,
+	// recipe, components and files of the bundle.
+	ManifestFile string `json:"manifest_file,omitempty" yaml:"manifest_file,omitempty"`
+
 	// Deployment contains structured deployment instructions from the deployer.
 	Deployment *DeploymentInfo `json:"deployment,omitempty" yaml:"deployment,omitempty"`
 }
@@ -64,6 +96,57 @@ type BundleError struct {
 	Error       string           `json:"error" yaml:"error"`
 }
 
+// Overrides records how the --set value overrides were applied.
+type Overrides struct {
+	// Components lists, per component with overrides, which override paths
+	// were applied.
+	Components []OverrideReport `json:"components,omitempty" yaml:"components,omitempty"`
+
+	// Unused lists the override keys that match no bundled component, so
+	// none of their overrides were applied.
+	Unused []string `json:"unused,omitempty" yaml:"unused,omitempty"`
+}
+
+// OverrideReport records how the --set value overrides of a component were
+// applied.
+type OverrideReport struct {
+	// Component is the name of the component reference.
+	Component string `json:"component" yaml:"component"`
+
+	// Applied lists the override paths that replaced an existing value.
+	Applied []string `json:"applied,omitempty" yaml:"applied,omitempty"`
+
+	// Unapplied lists the override paths that matched no existing value.
+	// They were added as new keys the chart may ignore, or could not be set
+	// because a parent is not a map.
+	Unapplied []string `json:"unapplied,omitempty" yaml:"unapplied,omitempty"`
+}
+
+// AppliedCount returns the number of overrides that replaced an existing
+// value.
+func (o *Overrides) AppliedCount() int {
+	count := 0
+	for _, r := range o.Components {
+		count += len(r.Applied)
+	}
+	return count
+}
+
+// Unapplied describes every override that was not applied: the unapplied
+// paths of each component, then the unused override keys.
+func (o *Overrides) Unapplied() []string {
+	var unapplied []string
+	for _, r := range o.Components {
+		for _, path := range r.Unapplied {
+			unapplied = append(unapplied, fmt.Sprintf("%s %s: matches no existing value", r.Component, path))
+		}
+	}
+	for _, key := range o.Unused {
+		unapplied = append(unapplied, fmt.Sprintf("%s: matches no component", key))
+	}
+	return unapplied
+}
+
 // HasErrors returns true if any bundler failed.
 func (o *Output) HasErrors() bool {
 	return len(o.Errors) > 0
@@ -742,3 +742,30 @@ func TestOutput_NilResults(t *testing.T) {
 		t.Error("SuccessfulBundlers() should return empty slice for nil results")
 	}
 }
+
+// TestOverrides tests counting applied overrides and describing unapplied ones
+func TestOverrides(t *testing.T) {
+	overrides := &Overrides{
+		Components: []OverrideReport{
+			{Component: "gpu-operator", Applied: []string{"driver.version", "gds.enabled"}, Unapplied: []string{"driver.verison"}},
+			{Component: "cert-manager", Applied: []string{"installCRDs"}},
+		},
+		Unused: []string{"gpuoperatr"},
+	}
+
+	if got := overrides.AppliedCount(); got != 3 {
+		t.Errorf("AppliedCount() = %d, want 3", got)
+	}
+	want := []string{
+		"gpu-operator driver.verison: matches no existing value",
+		"gpuoperatr: matches no component",
+	}
+	got := overrides.Unapplied()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unapplied() = %q, want %q", got, want)
+	}
+
+	if got := (&Overrides{}).Unapplied(); got != nil {
+		t.Errorf("Unapplied() without overrides = %q, want nil", got)
+	}
+}
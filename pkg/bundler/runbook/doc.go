@@ -0,0 +1,47 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package runbook generates an upgrade and rollback runbook for a bundle.
+
+The runbook treats each component as an upgrade wave, in the recipe's
+deployment order, and is written to runbook.md in the bundle root:
+  - Before the upgrade: capture an eidos snapshot to compare against, record
+    the Helm release revisions or Argo CD Application history, and check the
+    bundle and the current deployment
+  - Upgrade: the deployer's upgrade commands, then a health check per wave
+    with kubectl and eidos verify
+  - Rollback: commands per component in reverse deployment order
+
+Rollback commands depend on the deployer: helm rollback of each release for
+Argo Workflows and Terraform bundles, argocd app rollback after disabling
+automated sync for Argo CD bundles, and helm rollback of the whole release for
+the Helm umbrella chart, whose components cannot be rolled back one at a time.
+
+# Usage
+
+	generator := runbook.NewGenerator()
+
+	input := &runbook.GeneratorInput{
+		RecipeResult: recipeResult,
+		Deployer:     config.DeployerArgoWorkflows,
+		Version:      "v1.0.0",
+	}
+
+	output, err := generator.Generate(ctx, input, "/path/to/bundle")
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package runbook
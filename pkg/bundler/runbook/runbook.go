@@ -0,0 +1,239 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runbook
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argocd"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argoworkflows"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/terraform"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/runbook.md.tmpl
+var runbookTemplate string
+
+const (
+	// FileName is the name of the runbook written to the bundle root.
+	FileName = "runbook.md"
+
+	// SnapshotFileName is the file the runbook captures the pre-upgrade
+	// snapshot to.
+	SnapshotFileName = "pre-upgrade-snapshot.yaml"
+)
+
+// GeneratorInput contains all data needed to generate a runbook.
+type GeneratorInput struct {
+	// RecipeResult contains the components and their deployment order.
+	RecipeResult *recipe.RecipeResult
+
+	// Deployer is the deployer the bundle was generated for. Empty means
+	// config.DeployerHelm.
+	Deployer config.DeployerType
+
+	// Version is the bundler version.
+	Version string
+}
+
+// GeneratorOutput contains the result of runbook generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the runbook.
+	Duration time.Duration
+
+	// DeploymentNotes contains notes pointing at the runbook.
+	DeploymentNotes []string
+}
+
+// waveData describes one upgrade wave: a component in deployment order and
+// how to roll it back.
+type waveData struct {
+	Wave            int
+	Name            string
+	Version         string
+	ReleaseName     string
+	Namespace       string
+	RollbackCommand string
+}
+
+// releaseData identifies a Helm release whose revision is recorded before
+// the upgrade.
+type releaseData struct {
+	ReleaseName string
+	Namespace   string
+}
+
+// runbookData is the data rendered into the runbook template.
+type runbookData struct {
+	BundlerVersion    string
+	RecipeVersion     string
+	Deployer          config.DeployerType
+	Helm              bool
+	ArgoCD            bool
+	Terraform         bool
+	ReleaseName       string
+	ReleaseNamespace  string
+	WorkflowNamespace string
+	SnapshotFile      string
+	Waves             []waveData
+	Rollback          []waveData
+	Releases          []releaseData
+}
+
+// Generator creates upgrade and rollback runbooks from recipe results.
+type Generator struct{}
+
+// NewGenerator creates a new runbook generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes runbook.md into outputDir, with one upgrade wave per
+// component in deployment order and rollback commands in reverse order.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || input.RecipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and recipe result are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	deployer := input.Deployer
+	if deployer == "" {
+		deployer = config.DeployerHelm
+	}
+
+	data := &runbookData{
+		BundlerVersion:    input.Version,
+		RecipeVersion:     input.RecipeResult.Metadata.Version,
+		Deployer:          deployer,
+		Helm:              deployer == config.DeployerHelm,
+		ArgoCD:            deployer == config.DeployerArgoCD,
+		Terraform:         deployer == config.DeployerTerraform,
+		ReleaseName:       helm.ReleaseName,
+		ReleaseNamespace:  helm.ReleaseNamespace,
+		WorkflowNamespace: argoworkflows.DefaultNamespace,
+		SnapshotFile:      SnapshotFileName,
+		Waves:             waves(input.RecipeResult, deployer),
+	}
+	data.Rollback = slices.Clone(data.Waves)
+	slices.Reverse(data.Rollback)
+
+	if data.Helm {
+		data.Releases = []releaseData{{ReleaseName: helm.ReleaseName, Namespace: helm.ReleaseNamespace}}
+	} else {
+		for _, w := range data.Waves {
+			data.Releases = append(data.Releases, releaseData{ReleaseName: w.ReleaseName, Namespace: w.Namespace})
+		}
+	}
+
+	tmpl, err := template.New("runbook").Parse(runbookTemplate)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to parse runbook template", err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to render runbook", err)
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create output directory", err)
+	}
+	path := filepath.Join(outputDir, FileName)
+	content := buf.String()
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to write runbook", err)
+	}
+
+	return &GeneratorOutput{
+		Files:     []string{path},
+		TotalSize: int64(len(content)),
+		Duration:  time.Since(start),
+		DeploymentNotes: []string{
+			fmt.Sprintf("%s describes pre-upgrade snapshots, health checks between waves and rollback per component", FileName),
+		},
+	}, nil
+}
+
+// waves returns the upgrade waves of a recipe for a deployer: one per
+// component, in deployment order, with the command that rolls it back.
+// Components missing from the deployment order come last, sorted by name.
+func waves(recipeResult *recipe.RecipeResult, deployer config.DeployerType) []waveData {
+	order := make(map[string]int, len(recipeResult.DeploymentOrder))
+	for i, name := range recipeResult.DeploymentOrder {
+		order[name] = i
+	}
+	refs := slices.Clone(recipeResult.ComponentRefs)
+	slices.SortStableFunc(refs, func(a, b recipe.ComponentRef) int {
+		ia, okA := order[a.Name]
+		ib, okB := order[b.Name]
+		switch {
+		case okA && okB:
+			return ia - ib
+		case okA:
+			return -1
+		case okB:
+			return 1
+		default:
+			return strings.Compare(a.Name, b.Name)
+		}
+	})
+
+	out := make([]waveData, 0, len(refs))
+	for i, ref := range refs {
+		w := waveData{
+			Wave:        i + 1,
+			Name:        ref.Name,
+			Version:     ref.Version,
+			ReleaseName: ref.HelmReleaseName(),
+		}
+		switch deployer {
+		case config.DeployerArgoCD:
+			w.Namespace = argocd.ComponentNamespace(ref)
+			w.RollbackCommand = fmt.Sprintf("`argocd app rollback %s`", ref.Name)
+		case config.DeployerArgoWorkflows:
+			w.Namespace = argoworkflows.ComponentNamespace(ref)
+			w.RollbackCommand = fmt.Sprintf("`helm rollback %s -n %s`", w.ReleaseName, w.Namespace)
+		case config.DeployerTerraform:
+			w.Namespace = terraform.ComponentNamespace(ref)
+			w.RollbackCommand = fmt.Sprintf("`helm rollback %s -n %s`", w.ReleaseName, w.Namespace)
+		default:
+			w.ReleaseName = helm.ReleaseName
+			w.Namespace = helm.ReleaseNamespace
+			w.RollbackCommand = fmt.Sprintf("`helm rollback %s -n %s` (whole release)", helm.ReleaseName, helm.ReleaseNamespace)
+		}
+		out = append(out, w)
+	}
+	return out
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runbook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func testRecipe() *recipe.RecipeResult {
+	r := &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "gpu-operator", Version: "v25.3.3", Type: "helm"},
+			{Name: "nim", Version: "1.0.0", Type: "helm"},
+			{Name: "cert-manager", Version: "v1.17.2", Type: "helm"},
+		},
+		DeploymentOrder: []string{"cert-manager", "gpu-operator"},
+	}
+	r.Metadata.Version = "v0.9.0"
+	return r
+}
+
+func TestWaves(t *testing.T) {
+	tests := []struct {
+		name         string
+		deployer     config.DeployerType
+		wantNS       []string
+		wantRollback string
+	}{
+		{
+			name:         "helm",
+			deployer:     config.DeployerHelm,
+			wantNS:       []string{"eidos-stack", "eidos-stack", "eidos-stack"},
+			wantRollback: "helm rollback eidos-stack -n eidos-stack",
+		},
+		{
+			name:         "argocd",
+			deployer:     config.DeployerArgoCD,
+			wantNS:       []string{"cert-manager", "gpu-operator", "nvidia-system"},
+			wantRollback: "argocd app rollback cert-manager",
+		},
+		{
+			name:         "argo workflows",
+			deployer:     config.DeployerArgoWorkflows,
+			wantNS:       []string{"cert-manager", "gpu-operator", "nvidia-system"},
+			wantRollback: "helm rollback cert-manager -n cert-manager",
+		},
+		{
+			name:         "terraform",
+			deployer:     config.DeployerTerraform,
+			wantNS:       []string{"cert-manager", "gpu-operator", "nvidia-system"},
+			wantRollback: "helm rollback cert-manager -n cert-manager",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := waves(testRecipe(), tt.deployer)
+			wantNames := []string{"cert-manager", "gpu-operator", "nim"}
+			if len(got) != len(wantNames) {
+				t.Fatalf("got %d waves, want %d", len(got), len(wantNames))
+			}
+			for i, w := range got {
+				if w.Wave != i+1 || w.Name != wantNames[i] || w.Namespace != tt.wantNS[i] {
+					t.Errorf("wave %d = %+v, want %s in %s", i, w, wantNames[i], tt.wantNS[i])
+				}
+			}
+			if !strings.Contains(got[0].RollbackCommand, tt.wantRollback) {
+				t.Errorf("rollback command = %q, want %q", got[0].RollbackCommand, tt.wantRollback)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		deployer config.DeployerType
+		want     []string
+	}{
+		{
+			name:     "helm",
+			deployer: "",
+			want: []string{
+				"eidos snapshot --output " + SnapshotFileName,
+				"helm upgrade eidos-stack . -n eidos-stack -f values.yaml --wait",
+				"eidos verify --recipe \"$RECIPE\" --namespace eidos-stack --fail-on-error",
+				"helm rollback eidos-stack -n eidos-stack --wait",
+			},
+		},
+		{
+			name:     "argocd",
+			deployer: config.DeployerArgoCD,
+			want: []string{
+				"argocd app history cert-manager",
+				"argocd app wait gpu-operator --health",
+				"argocd app set nim --sync-policy none",
+				"argocd app rollback nim",
+			},
+		},
+		{
+			name:     "argo workflows",
+			deployer: config.DeployerArgoWorkflows,
+			want: []string{
+				"helm history gpu-operator -n gpu-operator --max 1",
+				"argo submit -n argo workflow.yaml --watch",
+				"### Wave 3: nim",
+				"helm rollback nim -n nvidia-system --wait",
+			},
+		},
+		{
+			name:     "terraform",
+			deployer: config.DeployerTerraform,
+			want: []string{
+				"helm history gpu-operator -n gpu-operator --max 1",
+				"terraform apply upgrade.tfplan",
+				"helm rollback nim -n nvidia-system --wait",
+				"apply the module from the previous bundle",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			output, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+				RecipeResult: testRecipe(),
+				Deployer:     tt.deployer,
+				Version:      "v1.2.3",
+			}, dir)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if len(output.Files) != 1 || len(output.DeploymentNotes) == 0 {
+				t.Fatalf("unexpected output: %+v", output)
+			}
+
+			content, err := os.ReadFile(filepath.Join(dir, FileName))
+			if err != nil {
+				t.Fatalf("failed to read runbook: %v", err)
+			}
+			if int64(len(content)) != output.TotalSize {
+				t.Errorf("TotalSize = %d, want %d", output.TotalSize, len(content))
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(content), want) {
+					t.Errorf("runbook missing %q:\n%s", want, content)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerate_RollbackOrder(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+		RecipeResult: testRecipe(),
+		Deployer:     config.DeployerArgoWorkflows,
+	}, dir); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, FileName))
+	if err != nil {
+		t.Fatalf("failed to read runbook: %v", err)
+	}
+	rollback := string(content)[strings.Index(string(content), "## 3. Rollback"):]
+	var last int
+	for _, name := range []string{"nim", "gpu-operator", "cert-manager"} {
+		i := strings.Index(rollback, "# "+name+"\n")
+		if i < last {
+			t.Fatalf("rollback of %s out of reverse deployment order:\n%s", name, rollback)
+		}
+		last = i
+	}
+}
+
+func TestGenerate_NilInput(t *testing.T) {
+	if _, err := NewGenerator().Generate(context.Background(), nil, t.TempDir()); err == nil {
+		t.Error("expected error for nil input")
+	}
+	if _, err := NewGenerator().Generate(context.Background(), &GeneratorInput{}, t.TempDir()); err == nil {
+		t.Error("expected error for missing recipe result")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema validates generated component values against the
+// values.schema.json of their Helm charts.
+//
+// Charts can ship a JSON schema that helm install enforces. Checking the
+// values while the bundle is generated catches mistakes, such as a key typo
+// in a --set override, before anything is deployed. The values are merged
+// over the chart defaults first, as Helm does, so required keys the chart
+// already sets are not reported.
+//
+// Schemas come from a Loader:
+//   - ChartLoader downloads the chart version the component references from
+//     its Helm or OCI repository, using the local Helm configuration and
+//     cache.
+//   - DirLoader reads vendored schemas, for air-gapped environments, from
+//     <dir>/<component>/<version>/values.schema.json or
+//     <dir>/<component>/values.schema.json. A values.yaml next to the schema
+//     holds the chart defaults.
+//
+// Components without a schema are not checked.
+//
+//	v := schema.NewValidator(schema.NewChartLoader())
+//	violations, err := v.Validate(ctx, ref, values)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, violation := range violations {
+//	    fmt.Println(violation) // gpu-operator driver.enabled: got string, want boolean
+//	}
+package schema
@@ -0,0 +1,148 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v4/pkg/chart/v2/loader"
+	"helm.sh/helm/v4/pkg/cli"
+	"helm.sh/helm/v4/pkg/downloader"
+	"helm.sh/helm/v4/pkg/getter"
+	"helm.sh/helm/v4/pkg/registry"
+	"helm.sh/helm/v4/pkg/repo/v1"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// DirLoader loads vendored schemas from a directory.
+type DirLoader struct {
+	dir string
+}
+
+// NewDirLoader returns a DirLoader reading schemas from dir.
+func NewDirLoader(dir string) *DirLoader {
+	return &DirLoader{dir: dir}
+}
+
+// Load returns the schema in <dir>/<component>/<version>/ or, failing that,
+// <dir>/<component>/, where component is the registry component of ref.
+func (l *DirLoader) Load(_ context.Context, ref recipe.ComponentRef) (*Schema, error) {
+	candidates := []string{filepath.Join(l.dir, ref.ComponentName())}
+	if ref.Version != "" {
+		candidates = append([]string{filepath.Join(l.dir, ref.ComponentName(), ref.Version)}, candidates...)
+	}
+
+	for _, dir := range candidates {
+		raw, err := os.ReadFile(filepath.Join(dir, FileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to read values schema for %s", ref.Name), err)
+		}
+
+		s := &Schema{JSON: raw}
+		defaults, err := os.ReadFile(filepath.Join(dir, DefaultsFileName))
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to read default values for %s", ref.Name), err)
+		default:
+			if err := yaml.Unmarshal(defaults, &s.Defaults); err != nil {
+				return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+					fmt.Sprintf("invalid default values for %s", ref.Name), err)
+			}
+		}
+		return s, nil
+	}
+	return nil, nil
+}
+
+// ChartLoader loads schemas from the charts components reference, using
+// the Helm repository configuration and cache of the local environment.
+type ChartLoader struct {
+	settings *cli.EnvSettings
+}
+
+// NewChartLoader returns a ChartLoader configured from the Helm environment
+// variables.
+func NewChartLoader() *ChartLoader {
+	return &ChartLoader{settings: cli.New()}
+}
+
+// Load downloads the chart version of ref and returns its schema. Kustomize
+// components, components without a source, and charts without a schema have
+// none.
+func (l *ChartLoader) Load(_ context.Context, ref recipe.ComponentRef) (*Schema, error) {
+	if strings.EqualFold(string(ref.Type), string(recipe.ComponentTypeKustomize)) || ref.Source == "" {
+		return nil, nil
+	}
+
+	registryClient, err := registry.NewClient(
+		registry.ClientOptCredentialsFile(l.settings.RegistryConfig),
+		registry.ClientOptWriter(io.Discard),
+	)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			"failed to create registry client", err)
+	}
+
+	chartName := helm.ResolveChartName(ref.ComponentName())
+	chartRef := strings.TrimSuffix(ref.Source, "/") + "/" + chartName
+	if !registry.IsOCI(ref.Source) {
+		chartRef, err = repo.FindChartInRepoURL(ref.Source, chartName, getter.All(l.settings),
+			repo.WithChartVersion(ref.Version))
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable,
+				fmt.Sprintf("failed to find chart %s %s in %s", chartName, ref.Version, ref.Source), err)
+		}
+	}
+
+	dl := downloader.ChartDownloader{
+		Out:              io.Discard,
+		Getters:          getter.All(l.settings),
+		Options:          []getter.Option{getter.WithRegistryClient(registryClient)},
+		RepositoryConfig: l.settings.RepositoryConfig,
+		RepositoryCache:  l.settings.RepositoryCache,
+		ContentCache:     l.settings.ContentCache,
+		RegistryClient:   registryClient,
+	}
+	path, _, err := dl.DownloadToCache(chartRef, ref.Version)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable,
+			fmt.Sprintf("failed to download chart %s %s", chartRef, ref.Version), err)
+	}
+
+	ch, err := loader.LoadFile(path)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("failed to load chart %s %s", chartRef, ref.Version), err)
+	}
+	if len(ch.Schema) == 0 {
+		return nil, nil
+	}
+	return &Schema{JSON: ch.Schema, Defaults: ch.Values}, nil
+}
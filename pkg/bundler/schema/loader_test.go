@@ -0,0 +1,108 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"helm.sh/helm/v4/pkg/chart/common"
+	chart "helm.sh/helm/v4/pkg/chart/v2"
+	chartutil "helm.sh/helm/v4/pkg/chart/v2/util"
+	"helm.sh/helm/v4/pkg/repo/v1"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// serveChartRepo serves a Helm repository holding charts and points the
+// Helm environment at a temporary home.
+func serveChartRepo(t *testing.T, charts ...*chart.Chart) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HELM_CACHE_HOME", filepath.Join(home, "cache"))
+	t.Setenv("HELM_CONFIG_HOME", filepath.Join(home, "config"))
+	t.Setenv("HELM_DATA_HOME", filepath.Join(home, "data"))
+
+	repoDir := t.TempDir()
+	server := httptest.NewServer(http.FileServer(http.Dir(repoDir)))
+	t.Cleanup(server.Close)
+
+	index := repo.NewIndexFile()
+	for _, ch := range charts {
+		path, err := chartutil.Save(ch, repoDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := index.MustAdd(ch.Metadata, filepath.Base(path), server.URL, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := index.WriteFile(filepath.Join(repoDir, "index.yaml"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return server.URL
+}
+
+func testChart(name, version, schema string) *chart.Chart {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: name, Version: version},
+		Raw:      []*common.File{{Name: chartutil.ValuesfileName, Data: []byte("operator:\n  upgradeCRD: true\n")}},
+	}
+	if schema != "" {
+		ch.Schema = []byte(schema)
+	}
+	return ch
+}
+
+func TestChartLoader(t *testing.T) {
+	url := serveChartRepo(t,
+		testChart("demo", "1.0.0", `{"title": "1.0.0"}`),
+		testChart("demo", "1.1.0", `{"title": "1.1.0"}`),
+		testChart("plain", "1.0.0", ""),
+	)
+	l := NewChartLoader()
+	ctx := context.Background()
+
+	s, err := l.Load(ctx, recipe.ComponentRef{Name: "demo", Type: recipe.ComponentTypeHelm, Source: url, Version: "1.0.0"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if s == nil || string(s.JSON) != `{"title": "1.0.0"}` {
+		t.Fatalf("Load() = %v, want schema of version 1.0.0", s)
+	}
+	if _, ok := s.Defaults["operator"]; !ok {
+		t.Errorf("Load() defaults = %v, want chart values", s.Defaults)
+	}
+
+	s, err = l.Load(ctx, recipe.ComponentRef{Name: "plain", Type: recipe.ComponentTypeHelm, Source: url, Version: "1.0.0"})
+	if err != nil || s != nil {
+		t.Errorf("Load() = %v, %v, want no schema for chart without one", s, err)
+	}
+
+	s, err = l.Load(ctx, recipe.ComponentRef{Name: "demo", Type: recipe.ComponentTypeKustomize, Source: url})
+	if err != nil || s != nil {
+		t.Errorf("Load() = %v, %v, want kustomize components skipped", s, err)
+	}
+
+	_, err = l.Load(ctx, recipe.ComponentRef{Name: "demo", Type: recipe.ComponentTypeHelm, Source: url, Version: "9.9.9"})
+	if err == nil || !strings.Contains(err.Error(), "failed to find chart demo 9.9.9") {
+		t.Errorf("Load() error = %v, want missing chart version", err)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"helm.sh/helm/v4/pkg/chart/common/util"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	// FileName is the name of a chart's values schema.
+	FileName = "values.schema.json"
+
+	// DefaultsFileName is the name of a chart's default values.
+	DefaultsFileName = "values.yaml"
+)
+
+// schemaURL is the location the schema is compiled under. Relative
+// references in the schema resolve against it.
+const schemaURL = "file:///" + FileName
+
+// printer renders validation messages.
+var printer = message.NewPrinter(language.English)
+
+// Schema is the values schema of a chart.
+type Schema struct {
+	// JSON is the content of values.schema.json.
+	JSON []byte
+
+	// Defaults are the chart's default values, merged under the values
+	// before validation. May be nil.
+	Defaults map[string]any
+}
+
+// Violation is a component value the chart schema rejects.
+type Violation struct {
+	// Component is the name of the component reference.
+	Component string `json:"component" yaml:"component"`
+
+	// Path is the dot-separated values path, or empty for the values root.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// Message describes what the schema expected.
+	Message string `json:"message" yaml:"message"`
+}
+
+// String returns a one-line description of the violation.
+func (v Violation) String() string {
+	if v.Path == "" {
+		return fmt.Sprintf("%s: %s", v.Component, v.Message)
+	}
+	return fmt.Sprintf("%s %s: %s", v.Component, v.Path, v.Message)
+}
+
+// Loader finds the values schema of a component.
+type Loader interface {
+	// Load returns the schema for ref, or nil if it has none.
+	Load(ctx context.Context, ref recipe.ComponentRef) (*Schema, error)
+}
+
+// Validator checks component values against the schemas of their charts.
+type Validator struct {
+	loaders []Loader
+}
+
+// NewValidator returns a Validator using the first schema found by loaders.
+func NewValidator(loaders ...Loader) *Validator {
+	return &Validator{loaders: loaders}
+}
+
+// Validate checks values, the final values of ref, against its chart schema.
+// It returns no violations when no loader has a schema for ref.
+func (v *Validator) Validate(ctx context.Context, ref recipe.ComponentRef, values map[string]any) ([]Violation, error) {
+	for _, l := range v.loaders {
+		s, err := l.Load(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			return Validate(ref.Name, values, s)
+		}
+	}
+
+	slog.Debug("no values schema for component", "component", ref.Name)
+	return nil, nil
+}
+
+// Validate checks values of component against s. The values are merged over
+// the schema defaults first; neither map is modified.
+func Validate(component string, values map[string]any, s *Schema) ([]Violation, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(s.JSON))
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid values schema for %s", component), err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(schemaURL, doc); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid values schema for %s", component), err)
+	}
+	compiled, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid values schema for %s", component), err)
+	}
+
+	instance, err := toInstance(values)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			fmt.Sprintf("failed to encode values of %s", component), err)
+	}
+	if s.Defaults != nil {
+		defaults, err := toInstance(s.Defaults)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to encode default values of %s", component), err)
+		}
+		instance = util.CoalesceTables(instance, defaults)
+	}
+
+	err = compiled.Validate(instance)
+	if err == nil {
+		return nil, nil
+	}
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			fmt.Sprintf("failed to validate values of %s", component), err)
+	}
+
+	var violations []Violation
+	collectViolations(component, ve, &violations)
+	return slices.Compact(violations), nil
+}
+
+// collectViolations appends the leaf errors of ve, which locate the values
+// the schema rejects, to violations.
+func collectViolations(component string, ve *jsonschema.ValidationError, violations *[]Violation) {
+	if len(ve.Causes) == 0 {
+		*violations = append(*violations, Violation{
+			Component: component,
+			Path:      strings.Join(ve.InstanceLocation, "."),
+			Message:   ve.ErrorKind.LocalizedString(printer),
+		})
+		return
+	}
+	for _, cause := range ve.Causes {
+		collectViolations(component, cause, violations)
+	}
+}
+
+// toInstance converts values to the JSON types the schema validator expects,
+// which also copies them.
+func toInstance(values map[string]any) (map[string]any, error) {
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	m, ok := instance.(map[string]any)
+	if !ok {
+		return map[string]any{}, nil
+	}
+	return m, nil
+}
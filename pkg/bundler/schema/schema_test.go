@@ -0,0 +1,199 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const testSchema = `{
+  "$schema": "https://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["operator"],
+  "properties": {
+    "operator": {"type": "object"},
+    "driver": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "enabled": {"type": "boolean"},
+        "version": {"type": "string"}
+      }
+    },
+    "replicas": {"type": "integer", "minimum": 1}
+  }
+}`
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   map[string]any
+		defaults map[string]any
+		want     []string
+	}{
+		{
+			name:   "valid values",
+			values: map[string]any{"operator": map[string]any{}, "driver": map[string]any{"enabled": true}, "replicas": 2},
+		},
+		{
+			name:     "required key set by chart defaults",
+			values:   map[string]any{"driver": map[string]any{"version": "580.82.07"}},
+			defaults: map[string]any{"operator": map[string]any{"upgradeCRD": true}},
+		},
+		{
+			name:   "missing required key",
+			values: map[string]any{},
+			want:   []string{"gpu-operator: missing property 'operator'"},
+		},
+		{
+			name:   "wrong type",
+			values: map[string]any{"operator": map[string]any{}, "driver": map[string]any{"enabled": "false"}},
+			want:   []string{"gpu-operator driver.enabled: got string, want boolean"},
+		},
+		{
+			name:   "typo in key",
+			values: map[string]any{"operator": map[string]any{}, "driver": map[string]any{"enabeld": true}},
+			want:   []string{"gpu-operator driver: additional properties 'enabeld' not allowed"},
+		},
+		{
+			name:   "several violations",
+			values: map[string]any{"operator": map[string]any{}, "driver": map[string]any{"version": 580}, "replicas": 0},
+			want: []string{
+				"gpu-operator driver.version: got number, want string",
+				"gpu-operator replicas: minimum: got 0, want 1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := Validate("gpu-operator", tt.values, &Schema{JSON: []byte(testSchema), Defaults: tt.defaults})
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			var got []string
+			for _, v := range violations {
+				got = append(got, v.String())
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_DoesNotModifyValues(t *testing.T) {
+	values := map[string]any{"driver": map[string]any{"enabled": true}}
+	defaults := map[string]any{"operator": map[string]any{}, "driver": map[string]any{"version": "1"}}
+
+	if _, err := Validate("gpu-operator", values, &Schema{JSON: []byte(testSchema), Defaults: defaults}); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(values) != 1 || len(values["driver"].(map[string]any)) != 1 {
+		t.Errorf("values modified: %v", values)
+	}
+	if len(defaults["driver"].(map[string]any)) != 1 {
+		t.Errorf("defaults modified: %v", defaults)
+	}
+}
+
+func TestValidate_InvalidSchema(t *testing.T) {
+	_, err := Validate("gpu-operator", map[string]any{}, &Schema{JSON: []byte(`{"type": 1`)})
+	if err == nil || !strings.Contains(err.Error(), "invalid values schema for gpu-operator") {
+		t.Errorf("Validate() error = %v, want invalid values schema", err)
+	}
+}
+
+func TestDirLoader(t *testing.T) {
+	dir := t.TempDir()
+	write := func(path, content string) {
+		t.Helper()
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("gpu-operator/v25.3.0/values.schema.json", `{"title": "v25.3.0"}`)
+	write("gpu-operator/v25.3.0/values.yaml", "operator:\n  upgradeCRD: true\n")
+	write("gpu-operator/values.schema.json", `{"title": "any"}`)
+
+	l := NewDirLoader(dir)
+	tests := []struct {
+		name         string
+		ref          recipe.ComponentRef
+		want         string
+		wantDefaults bool
+	}{
+		{"version directory", recipe.ComponentRef{Name: "gpu-operator", Version: "v25.3.0"}, `{"title": "v25.3.0"}`, true},
+		{"component directory", recipe.ComponentRef{Name: "gpu-operator", Version: "v25.10.0"}, `{"title": "any"}`, false},
+		{"instance of component", recipe.ComponentRef{Name: "gpu-operator-b", Component: "gpu-operator"}, `{"title": "any"}`, false},
+		{"no schema", recipe.ComponentRef{Name: "cert-manager", Version: "v1.17.2"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := l.Load(context.Background(), tt.ref)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if tt.want == "" {
+				if s != nil {
+					t.Errorf("Load() = %s, want no schema", s.JSON)
+				}
+				return
+			}
+			if s == nil || string(s.JSON) != tt.want {
+				t.Fatalf("Load() = %v, want %s", s, tt.want)
+			}
+			if (s.Defaults != nil) != tt.wantDefaults {
+				t.Errorf("Load() defaults = %v, want defaults %v", s.Defaults, tt.wantDefaults)
+			}
+		})
+	}
+}
+
+func TestValidator_Validate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "gpu-operator"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "gpu-operator", FileName), []byte(testSchema), 0600); err != nil {
+		t.Fatal(err)
+	}
+	v := NewValidator(NewDirLoader(dir))
+
+	violations, err := v.Validate(context.Background(), recipe.ComponentRef{Name: "gpu-operator"}, map[string]any{})
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(violations) != 1 || violations[0].Component != "gpu-operator" {
+		t.Errorf("Validate() = %v, want missing operator", violations)
+	}
+
+	violations, err = v.Validate(context.Background(), recipe.ComponentRef{Name: "cert-manager"}, map[string]any{"x": 1})
+	if err != nil || violations != nil {
+		t.Errorf("Validate() = %v, %v, want no schema to skip validation", violations, err)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// manifestKindPattern matches the kind of each document in a manifest.
+var manifestKindPattern = regexp.MustCompile(`(?m)^kind:\s*["']?([A-Za-z0-9]+)`)
+
+// clusterScopedKinds are the built-in kinds that are not namespaced. Kinds
+// starting with "Cluster" are treated as cluster-scoped too.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"CSIDriver":                      true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// installScope returns the install scope of a component reference: the one
+// configured for the instance name, else the one for the registry component,
+// else InstallScopeCluster.
+func (b *DefaultBundler) installScope(ref recipe.ComponentRef) config.InstallScope {
+	if b.Config == nil {
+		return config.InstallScopeCluster
+	}
+	scopes := b.Config.InstallScopes()
+	if scope, ok := scopes[ref.Name]; ok {
+		return scope
+	}
+	if scope, key := lookupComponentKey(scopes, ref.ComponentName()); key != "" {
+		return scope
+	}
+	return config.InstallScopeCluster
+}
+
+// namespaceScoped returns the names of the component references of
+// recipeResult installed with namespace scope.
+func (b *DefaultBundler) namespaceScoped(recipeResult *recipe.RecipeResult) map[string]bool {
+	scoped := make(map[string]bool)
+	for _, ref := range recipeResult.ComponentRefs {
+		if b.installScope(ref) == config.InstallScopeNamespace {
+			scoped[ref.Name] = true
+		}
+	}
+	return scoped
+}
+
+// checkInstallScopes verifies the configured install scopes can be honored:
+// every scope names a component of the recipe, and every namespace-scoped
+// component has a chart that supports it and no cluster-scoped manifests.
+func (b *DefaultBundler) checkInstallScopes(recipeResult *recipe.RecipeResult) error {
+	if b.Config == nil || len(b.Config.InstallScopes()) == 0 {
+		return nil
+	}
+	scopes := b.Config.InstallScopes()
+
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to load component registry", err)
+	}
+
+	var problems []string
+	used := make(map[string]bool, len(scopes))
+	for _, ref := range recipeResult.ComponentRefs {
+		if _, key := lookupComponentKey(scopes, ref.ComponentName()); key != "" {
+			used[key] = true
+		}
+		if _, ok := scopes[ref.Name]; ok {
+			used[ref.Name] = true
+		}
+		if b.installScope(ref) != config.InstallScopeNamespace {
+			continue
+		}
+
+		if !registry.Get(ref.ComponentName()).SupportsNamespaceScope() {
+			problems = append(problems, fmt.Sprintf("%s: chart does not support namespace scope", ref.Name))
+			continue
+		}
+		for _, path := range ref.ManifestFiles {
+			content, err := recipe.GetManifestContent(path)
+			if err != nil {
+				return errors.Wrap(errors.ErrCodeInternal,
+					fmt.Sprintf("failed to load manifest %s for component %s", path, ref.Name), err)
+			}
+			if kinds := clusterScopedManifestKinds(content); len(kinds) > 0 {
+				problems = append(problems, fmt.Sprintf("%s: manifest %s creates cluster-scoped %s",
+					ref.Name, path, strings.Join(kinds, ", ")))
+			}
+		}
+	}
+	for key := range scopes {
+		if !used[key] {
+			problems = append(problems, fmt.Sprintf("%s: matches no component", key))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	slices.Sort(problems)
+	return errors.NewWithContext(errors.ErrCodeInvalidRequest,
+		fmt.Sprintf("invalid install scopes: %s", strings.Join(problems, "; ")),
+		map[string]any{"problems": problems})
+}
+
+// clusterScopedManifestKinds returns the sorted, distinct cluster-scoped
+// kinds created by a manifest.
+func clusterScopedManifestKinds(content []byte) []string {
+	var kinds []string
+	for _, m := range manifestKindPattern.FindAllSubmatch(content, -1) {
+		kind := string(m[1])
+		if clusterScopedKinds[kind] || strings.HasPrefix(kind, "Cluster") {
+			kinds = append(kinds, kind)
+		}
+	}
+	slices.Sort(kinds)
+	return slices.Compact(kinds)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets generates the Secrets that bundle components read, such as
+// registry credentials and license tokens, for a secret backend.
+//
+// The component registry lists, per component, the Secrets it reads and
+// their keys (see recipe.SecretConfig). Resolve picks the ones the component
+// values use, taking the name from the values when a values path names the
+// Secret:
+//
+//	secrets := secrets.Resolve("nim", "nvidia-system", comp.Secrets, values)
+//
+// Generate then writes secrets/ for the configured backend:
+//
+//   - plain: secrets.yaml with a Secret per entry and empty values to fill in
+//   - eso: external-secrets.yaml with an ExternalSecret per entry, reading
+//     from a ClusterSecretStore under the key "<namespace>/<name>"
+//   - sealed: seal-secrets.sh, which builds each Secret from files and seals
+//     it with kubeseal into sealed-secrets.yaml
+//
+// A README.md lists the Secrets and how to create them with the backend.
+package secrets
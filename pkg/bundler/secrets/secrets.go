@@ -0,0 +1,264 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/component"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/secrets.yaml.tmpl
+var plainTemplate string
+
+//go:embed templates/external-secrets.yaml.tmpl
+var externalSecretsTemplate string
+
+//go:embed templates/seal-secrets.sh.tmpl
+var sealScriptTemplate string
+
+//go:embed templates/README.md.tmpl
+var readmeTemplate string
+
+const (
+	// DirName is the bundle subdirectory Secret manifests are written to.
+	DirName = "secrets"
+
+	// PlainFileName holds the Secrets generated with SecretBackendPlain.
+	PlainFileName = "secrets.yaml"
+
+	// ExternalSecretsFileName holds the ExternalSecrets generated with
+	// SecretBackendESO.
+	ExternalSecretsFileName = "external-secrets.yaml"
+
+	// SealScriptFileName is the script generated with SecretBackendSealed.
+	SealScriptFileName = "seal-secrets.sh"
+
+	// SealedSecretsFileName is the file the seal script writes the
+	// SealedSecrets to.
+	SealedSecretsFileName = "sealed-secrets.yaml"
+
+	// ValuesDirName is the directory the seal script reads the Secret values
+	// from by default, one file per key at <namespace>/<name>/<key>.
+	ValuesDirName = "secret-values"
+
+	// defaultSecretType is the type of Secrets without one.
+	defaultSecretType = "Opaque"
+)
+
+// Secret is a Secret a component in the bundle reads.
+type Secret struct {
+	// Component is the component reading the Secret.
+	Component string
+
+	// Name is the Secret name.
+	Name string
+
+	// Namespace is the namespace the component is installed into.
+	Namespace string
+
+	// Type is the Secret type (e.g., "kubernetes.io/dockerconfigjson").
+	Type string
+
+	// Keys are the data keys the component reads.
+	Keys []string
+
+	// Description says what the Secret holds.
+	Description string
+}
+
+// RemoteKey returns the key ExternalSecrets look the Secret up by in the
+// secret store, "<namespace>/<name>".
+func (s Secret) RemoteKey() string {
+	return s.Namespace + "/" + s.Name
+}
+
+// Resolve returns the Secrets a component reads given its values. Secrets
+// named by a values path use the name set there, and are skipped when the
+// values and the registry name none.
+func Resolve(componentName, namespace string, configs []recipe.SecretConfig, values map[string]any) []Secret {
+	var resolved []Secret
+	for _, cfg := range configs {
+		name := cfg.Name
+		if value, ok := valuepath.Lookup(values, cfg.NamePath); ok && value != "" {
+			name = value
+		}
+		if name == "" {
+			continue
+		}
+		secretType := cfg.Type
+		if secretType == "" {
+			secretType = defaultSecretType
+		}
+		resolved = append(resolved, Secret{
+			Component:   componentName,
+			Name:        name,
+			Namespace:   namespace,
+			Type:        secretType,
+			Keys:        cfg.Keys,
+			Description: cfg.Description,
+		})
+	}
+	return resolved
+}
+
+// GeneratorInput contains all data needed to generate Secret manifests.
+type GeneratorInput struct {
+	// Backend selects the manifests generated.
+	Backend config.SecretBackend
+
+	// Store is the ClusterSecretStore ExternalSecrets read from.
+	Store string
+
+	// Secrets are the Secrets of all components.
+	Secrets []Secret
+
+	// Version is the bundler version.
+	Version string
+}
+
+// GeneratorOutput contains the result of Secret manifest generation.
+type GeneratorOutput struct {
+	// Files contains the paths of generated files.
+	Files []string
+
+	// TotalSize is the total size of all generated files.
+	TotalSize int64
+
+	// Duration is the time taken to generate the manifests.
+	Duration time.Duration
+
+	// DeploymentNotes contains optional notes.
+	DeploymentNotes []string
+}
+
+// templateData is the data rendered into the templates.
+type templateData struct {
+	BundlerVersion string
+	Backend        string
+	Store          string
+	Secrets        []Secret
+	ValuesDir      string
+	SealedFile     string
+}
+
+// Generator creates Secret manifests for a secret backend.
+type Generator struct{}
+
+// NewGenerator creates a new Secret manifest generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// Generate writes the Secret manifests for input.Backend and a README
+// listing the Secrets to outputDir/secrets. Secrets read by several
+// components in one namespace are written once.
+func (g *Generator) Generate(ctx context.Context, input *GeneratorInput, outputDir string) (*GeneratorOutput, error) {
+	start := time.Now()
+
+	if input == nil || input.Backend == config.SecretBackendNone {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "input and secret backend are required")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "context cancelled", err)
+	}
+
+	data := &templateData{
+		BundlerVersion: input.Version,
+		Backend:        input.Backend.String(),
+		Store:          input.Store,
+		Secrets:        dedupe(input.Secrets),
+		ValuesDir:      ValuesDirName,
+		SealedFile:     SealedSecretsFileName,
+	}
+
+	var manifest, note string
+	var manifestTemplate string
+	var perm os.FileMode = 0600
+	switch input.Backend {
+	case config.SecretBackendPlain:
+		manifest, manifestTemplate = PlainFileName, plainTemplate
+		note = fmt.Sprintf("Fill in the values of the %d Secrets in %s and apply it before deploying",
+			len(data.Secrets), filepath.Join(DirName, PlainFileName))
+	case config.SecretBackendESO:
+		manifest, manifestTemplate = ExternalSecretsFileName, externalSecretsTemplate
+		note = fmt.Sprintf("Apply %s before deploying; the %d ExternalSecrets read from ClusterSecretStore %q",
+			filepath.Join(DirName, ExternalSecretsFileName), len(data.Secrets), input.Store)
+	case config.SecretBackendSealed:
+		manifest, manifestTemplate, perm = SealScriptFileName, sealScriptTemplate, 0755
+		note = fmt.Sprintf("Run %s to seal the %d Secrets with kubeseal and apply %s before deploying",
+			filepath.Join(DirName, SealScriptFileName), len(data.Secrets), SealedSecretsFileName)
+	default:
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unsupported secret backend %q", input.Backend))
+	}
+
+	secretsDir := filepath.Join(outputDir, DirName)
+	if err := os.MkdirAll(secretsDir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create secrets directory", err)
+	}
+
+	output := &GeneratorOutput{}
+	for _, file := range []struct {
+		name     string
+		template string
+		perm     os.FileMode
+	}{
+		{manifest, manifestTemplate, perm},
+		{"README.md", readmeTemplate, 0600},
+	} {
+		path, size, err := component.WriteTemplate(file.template, file.name, secretsDir, data, file.perm)
+		if err != nil {
+			return nil, err
+		}
+		output.Files = append(output.Files, path)
+		output.TotalSize += size
+	}
+
+	output.DeploymentNotes = []string{note}
+	output.Duration = time.Since(start)
+
+	slog.Debug("secret manifests generated",
+		"backend", input.Backend,
+		"secrets", len(data.Secrets),
+	)
+
+	return output, nil
+}
+
+// dedupe returns secrets without repeated namespace and name pairs, keeping
+// the first.
+func dedupe(secrets []Secret) []Secret {
+	seen := make(map[string]bool, len(secrets))
+	out := make([]Secret, 0, len(secrets))
+	for _, s := range secrets {
+		if seen[s.RemoteKey()] {
+			continue
+		}
+		seen[s.RemoteKey()] = true
+		out = append(out, s)
+	}
+	return out
+}
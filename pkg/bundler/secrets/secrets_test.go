@@ -0,0 +1,131 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestResolve(t *testing.T) {
+	configs := []recipe.SecretConfig{
+		{Name: "ngc-secret", Type: "kubernetes.io/dockerconfigjson", Keys: []string{".dockerconfigjson"}},
+		{Name: "ngc-api", NamePath: "model.ngcAPISecret", Keys: []string{"NGC_API_KEY"}},
+		{NamePath: "driver.licensingConfig.secretName", Keys: []string{"gridd.conf"}},
+	}
+	values := map[string]any{
+		"model": map[string]any{"ngcAPISecret": "team-ngc-api"},
+	}
+
+	got := Resolve("nim", "nvidia-system", configs, values)
+
+	if len(got) != 2 {
+		t.Fatalf("Resolve() returned %d secrets, want 2 (unnamed licensing secret skipped): %+v", len(got), got)
+	}
+	if got[0].Name != "ngc-secret" || got[0].Type != "kubernetes.io/dockerconfigjson" {
+		t.Errorf("Resolve()[0] = %+v, want ngc-secret of type dockerconfigjson", got[0])
+	}
+	if got[1].Name != "team-ngc-api" || got[1].Type != defaultSecretType {
+		t.Errorf("Resolve()[1] = %+v, want team-ngc-api of type Opaque", got[1])
+	}
+	if got[1].RemoteKey() != "nvidia-system/team-ngc-api" {
+		t.Errorf("RemoteKey() = %q, want nvidia-system/team-ngc-api", got[1].RemoteKey())
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	input := []Secret{
+		{Component: "nim", Name: "ngc-api", Namespace: "nvidia-system", Type: "Opaque", Keys: []string{"NGC_API_KEY"}},
+		{Component: "nim-embed", Name: "ngc-api", Namespace: "nvidia-system", Type: "Opaque", Keys: []string{"NGC_API_KEY"}},
+		{Component: "gpu-operator", Name: "licensing", Namespace: "gpu-operator", Type: "Opaque",
+			Keys: []string{"gridd.conf", "client_configuration_token.tok"}},
+	}
+
+	tests := []struct {
+		backend  config.SecretBackend
+		file     string
+		contains []string
+	}{
+		{config.SecretBackendPlain, PlainFileName, []string{
+			"kind: Secret",
+			"namespace: gpu-operator",
+			`"client_configuration_token.tok": ""`,
+		}},
+		{config.SecretBackendESO, ExternalSecretsFileName, []string{
+			"kind: ExternalSecret",
+			"name: team-store",
+			"key: gpu-operator/licensing",
+			`property: "gridd.conf"`,
+		}},
+		{config.SecretBackendSealed, SealScriptFileName, []string{
+			"kubectl create secret generic licensing --namespace gpu-operator --type Opaque",
+			`--from-file="gridd.conf=${VALUES_DIR}/gpu-operator/licensing/gridd.conf"`,
+			`kubeseal --format yaml "$@" >> sealed-secrets.yaml`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backend.String(), func(t *testing.T) {
+			dir := t.TempDir()
+			out, err := NewGenerator().Generate(context.Background(), &GeneratorInput{
+				Backend: tt.backend,
+				Store:   "team-store",
+				Secrets: input,
+				Version: "v1.0.0",
+			}, dir)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if len(out.Files) != 2 || len(out.DeploymentNotes) != 1 {
+				t.Errorf("Generate() files = %v, notes = %v", out.Files, out.DeploymentNotes)
+			}
+
+			content, err := os.ReadFile(filepath.Join(dir, DirName, tt.file))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", tt.file, err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(content), want) {
+					t.Errorf("%s missing %q:\n%s", tt.file, want, content)
+				}
+			}
+			if n := strings.Count(string(content), "namespace nvidia-system") +
+				strings.Count(string(content), "namespace: nvidia-system"); n != 1 {
+				t.Errorf("%s lists ngc-api %d times, want once", tt.file, n)
+			}
+
+			readme, err := os.ReadFile(filepath.Join(dir, DirName, "README.md"))
+			if err != nil {
+				t.Fatalf("failed to read README.md: %v", err)
+			}
+			if !strings.Contains(string(readme), "| gpu-operator | `gpu-operator` | `licensing` |") {
+				t.Errorf("README.md missing licensing row:\n%s", readme)
+			}
+		})
+	}
+}
+
+func TestGenerate_NoBackend(t *testing.T) {
+	_, err := NewGenerator().Generate(context.Background(), &GeneratorInput{}, t.TempDir())
+	if err == nil {
+		t.Error("Generate() without backend should fail")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// cosignCommand is the cosign executable looked up on PATH.
+const cosignCommand = "cosign"
+
+// execCosign runs cosign with the given arguments. Overridden in tests.
+var execCosign = func(ctx context.Context, args ...string) ([]byte, error) {
+	if _, err := exec.LookPath(cosignCommand); err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeUnavailable,
+			"cosign is required for keyless, KMS and OCI signing; install it from https://docs.sigstore.dev", err)
+	}
+
+	cmd := exec.CommandContext(ctx, cosignCommand, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	return cmd.Output()
+}
+
+// runCosign executes cosign and wraps failures with the command that failed.
+func runCosign(ctx context.Context, args ...string) error {
+	slog.Debug("running cosign", "args", strings.Join(args, " "))
+
+	if _, err := execCosign(ctx, args...); err != nil {
+		var structured *apperrors.StructuredError
+		if errors.As(err, &structured) {
+			return err
+		}
+		return apperrors.Wrap(apperrors.ErrCodeInternal, fmt.Sprintf("cosign %s failed", args[0]), err)
+	}
+	return nil
+}
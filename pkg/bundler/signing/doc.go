@@ -0,0 +1,42 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing signs and verifies deployment bundles using cosign-compatible signatures.
+//
+// Two artifacts can be signed:
+//   - checksums.txt in the bundle directory, producing checksums.txt.sig
+//     (and checksums.txt.sigstore.json for keyless signing)
+//   - the pushed OCI artifact, referenced by digest
+//
+// Because checksums.txt covers every generated file, verifying its signature
+// and then re-hashing the listed files authenticates the whole bundle.
+//
+// Key-based signing of checksums.txt with an unencrypted PKCS#8 ECDSA or
+// Ed25519 PEM key is done in-process and produces the same signature format
+// as `cosign sign-blob`. Keyless (Fulcio/Rekor) signing, encrypted cosign keys,
+// KMS key references, and all OCI artifact operations are delegated to the
+// cosign CLI, which must be on PATH.
+//
+// Usage:
+//
+//	// Sign a generated bundle with a local key
+//	err := signing.SignBundle(ctx, "/path/to/bundle", signing.SignOptions{KeyRef: "cosign.key"})
+//
+//	// Verify before deployment
+//	result, err := signing.VerifyBundle(ctx, "/path/to/bundle", signing.VerifyOptions{KeyRef: "cosign.pub"})
+//
+// Signatures can also be checked with cosign directly:
+//
+//	cosign verify-blob --key cosign.pub --signature checksums.txt.sig checksums.txt
+package signing
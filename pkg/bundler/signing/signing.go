@@ -0,0 +1,452 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	// SignatureFileName is the detached signature written next to checksums.txt.
+	SignatureFileName = checksum.ChecksumFileName + ".sig"
+
+	// SigstoreBundleFileName holds the certificate and transparency log entry
+	// produced by keyless signing.
+	SigstoreBundleFileName = checksum.ChecksumFileName + ".sigstore.json"
+)
+
+// SignOptions configures how bundles and artifacts are signed.
+type SignOptions struct {
+	// KeyRef is a path to a private key or a cosign KMS URI (e.g. awskms:///alias/key).
+	KeyRef string
+	// Keyless signs with a short-lived Fulcio certificate obtained via OIDC.
+	Keyless bool
+	// PlainHTTP allows OCI artifact signing against an HTTP registry.
+	PlainHTTP bool
+	// InsecureTLS skips TLS verification for the OCI registry.
+	InsecureTLS bool
+}
+
+// Enabled reports whether any signing method was requested.
+func (o SignOptions) Enabled() bool {
+	return o.Keyless || o.KeyRef != ""
+}
+
+// Validate checks that exactly one signing method is configured.
+func (o SignOptions) Validate() error {
+	if o.Keyless && o.KeyRef != "" {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest, "keyless signing and a signing key are mutually exclusive")
+	}
+	return nil
+}
+
+// VerifyOptions configures how signatures are verified.
+type VerifyOptions struct {
+	// KeyRef is a path to a public key or a cosign KMS URI.
+	KeyRef string
+	// CertificateIdentity is the expected signer identity for keyless signatures.
+	CertificateIdentity string
+	// CertificateOIDCIssuer is the expected OIDC issuer for keyless signatures.
+	CertificateOIDCIssuer string
+	// PlainHTTP allows OCI artifact verification against an HTTP registry.
+	PlainHTTP bool
+	// InsecureTLS skips TLS verification for the OCI registry.
+	InsecureTLS bool
+	// Strict fails bundle verification when the bundle contains files
+	// checksums.txt does not list.
+	Strict bool
+}
+
+// Enabled reports whether any verification material was provided.
+func (o VerifyOptions) Enabled() bool {
+	return o.KeyRef != "" || o.CertificateIdentity != "" || o.CertificateOIDCIssuer != ""
+}
+
+// Validate checks that either a key or a complete keyless identity is configured.
+func (o VerifyOptions) Validate() error {
+	keyless := o.CertificateIdentity != "" || o.CertificateOIDCIssuer != ""
+	if o.KeyRef != "" && keyless {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest, "a verification key and certificate identity are mutually exclusive")
+	}
+	if keyless && (o.CertificateIdentity == "" || o.CertificateOIDCIssuer == "") {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest, "keyless verification requires both certificate identity and OIDC issuer")
+	}
+	return nil
+}
+
+// VerifyResult describes what was checked by VerifyBundle.
+type VerifyResult struct {
+	// Files is the number of files whose checksums matched.
+	Files int
+	// Signed is true when the bundle carries a signature.
+	Signed bool
+	// SignatureVerified is true when the signature was checked successfully.
+	SignatureVerified bool
+}
+
+// SignBundle signs the checksums.txt file in bundleDir and writes the
+// detached signature alongside it.
+func SignBundle(ctx context.Context, bundleDir string, opts SignOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if !opts.Enabled() {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest, "a signing key or keyless signing is required")
+	}
+	if err := ctx.Err(); err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeUnavailable, "operation canceled", err)
+	}
+
+	checksumPath := checksum.GetChecksumFilePath(bundleDir)
+	if _, err := os.Stat(checksumPath); err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeNotFound, "bundle has no checksums to sign", err)
+	}
+	sigPath := filepath.Join(bundleDir, SignatureFileName)
+
+	if opts.Keyless {
+		args := []string{"sign-blob", "--yes",
+			"--output-signature", sigPath,
+			"--bundle", filepath.Join(bundleDir, SigstoreBundleFileName),
+			checksumPath}
+		if err := runCosign(ctx, args...); err != nil {
+			return err
+		}
+		slog.Info("bundle signed", "method", "keyless", "signature", sigPath)
+		return nil
+	}
+
+	key, ok, err := loadPrivateKey(opts.KeyRef)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// Encrypted cosign keys and KMS references are handled by cosign
+		if err := runCosign(ctx, "sign-blob", "--yes", "--key", opts.KeyRef, "--output-signature", sigPath, checksumPath); err != nil {
+			return err
+		}
+		slog.Info("bundle signed", "method", "cosign", "signature", sigPath)
+		return nil
+	}
+
+	data, err := os.ReadFile(checksumPath)
+	if err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeInternal, "failed to read checksums", err)
+	}
+	sig, err := signBlob(key, data)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(sigPath, []byte(base64.StdEncoding.EncodeToString(sig)), 0600); err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeInternal, "failed to write signature", err)
+	}
+
+	slog.Info("bundle signed", "method", "key", "signature", sigPath)
+	return nil
+}
+
+// VerifyBundle checks every file listed in checksums.txt and, when the bundle
+// is signed, verifies the checksums signature.
+//
+// A signature only covers the files checksums.txt lists, so a signed bundle
+// is always checked strictly: any other file fails verification, except the
+// signature artifacts and the bundle.yaml and audit.json generation records
+// written after checksums.txt.
+//
+// A signed bundle requires verification material, and verification material
+// requires a signed bundle; either mismatch is reported as an error so an
+// unsigned bundle cannot silently pass a signature check.
+func VerifyBundle(ctx context.Context, bundleDir string, opts VerifyOptions) (*VerifyResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	sigPath := filepath.Join(bundleDir, SignatureFileName)
+	sigstorePath := filepath.Join(bundleDir, SigstoreBundleFileName)
+	signed := fileExists(sigPath) || fileExists(sigstorePath)
+
+	report, err := checksum.Verify(ctx, bundleDir)
+	if err == nil {
+		err = report.Err(opts.Strict || signed)
+	}
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "bundle checksum verification failed", err)
+	}
+
+	result := &VerifyResult{Files: report.Verified, Signed: signed}
+
+	if !result.Signed {
+		if opts.Enabled() {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "bundle is not signed")
+		}
+		return result, nil
+	}
+	if !opts.Enabled() {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+			"bundle is signed; a verification key or certificate identity and OIDC issuer are required")
+	}
+
+	checksumPath := checksum.GetChecksumFilePath(bundleDir)
+
+	if opts.KeyRef == "" {
+		if !fileExists(sigstorePath) {
+			return nil, apperrors.New(apperrors.ErrCodeNotFound, "keyless verification requires "+SigstoreBundleFileName)
+		}
+		if err := runCosign(ctx, "verify-blob",
+			"--bundle", sigstorePath,
+			"--certificate-identity", opts.CertificateIdentity,
+			"--certificate-oidc-issuer", opts.CertificateOIDCIssuer,
+			checksumPath); err != nil {
+			return nil, err
+		}
+		result.SignatureVerified = true
+		return result, nil
+	}
+
+	pub, ok, err := loadPublicKey(opts.KeyRef)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := runCosign(ctx, "verify-blob", "--key", opts.KeyRef, "--signature", sigPath, checksumPath); err != nil {
+			return nil, err
+		}
+		result.SignatureVerified = true
+		return result, nil
+	}
+
+	encoded, err := os.ReadFile(sigPath)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeNotFound, "failed to read signature", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "signature is not valid base64", err)
+	}
+	data, err := os.ReadFile(checksumPath)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal, "failed to read checksums", err)
+	}
+	if err := verifyBlob(pub, data, sig); err != nil {
+		return nil, err
+	}
+
+	result.SignatureVerified = true
+	return result, nil
+}
+
+// SignArtifact signs a pushed OCI artifact. The reference should include a
+// digest (registry/repository@sha256:...) so the signature binds to content.
+func SignArtifact(ctx context.Context, imageRef string, opts SignOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if !opts.Enabled() {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest, "a signing key or keyless signing is required")
+	}
+
+	args := []string{"sign", "--yes"}
+	if opts.KeyRef != "" {
+		args = append(args, "--key", opts.KeyRef)
+	}
+	args = append(args, registryArgs(opts.PlainHTTP, opts.InsecureTLS)...)
+	args = append(args, imageRef)
+
+	if err := runCosign(ctx, args...); err != nil {
+		return err
+	}
+
+	slog.Info("OCI artifact signed", "reference", imageRef)
+	return nil
+}
+
+// VerifyArtifact verifies the cosign signature of an OCI artifact.
+func VerifyArtifact(ctx context.Context, imageRef string, opts VerifyOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if !opts.Enabled() {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest,
+			"a verification key or certificate identity and OIDC issuer are required")
+	}
+
+	args := []string{"verify"}
+	if opts.KeyRef != "" {
+		args = append(args, "--key", opts.KeyRef)
+	} else {
+		args = append(args,
+			"--certificate-identity", opts.CertificateIdentity,
+			"--certificate-oidc-issuer", opts.CertificateOIDCIssuer)
+	}
+	args = append(args, registryArgs(opts.PlainHTTP, opts.InsecureTLS)...)
+	args = append(args, imageRef)
+
+	if err := runCosign(ctx, args...); err != nil {
+		return err
+	}
+
+	slog.Info("OCI artifact signature verified", "reference", imageRef)
+	return nil
+}
+
+// registryArgs returns cosign flags for non-default registry connections.
+func registryArgs(plainHTTP, insecureTLS bool) []string {
+	var args []string
+	if plainHTTP {
+		args = append(args, "--allow-http-registry")
+	}
+	if plainHTTP || insecureTLS {
+		args = append(args, "--allow-insecure-registry")
+	}
+	return args
+}
+
+// loadPrivateKey reads an unencrypted PEM private key. It returns ok=false
+// for key references that must be handled by cosign (KMS URIs and encrypted
+// cosign keys).
+func loadPrivateKey(keyRef string) (crypto.Signer, bool, error) {
+	if isKMSRef(keyRef) {
+		return nil, false, nil
+	}
+
+	block, err := readPEM(keyRef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, parseErr := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if parseErr != nil {
+			return nil, false, apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "failed to parse private key", parseErr)
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, false, apperrors.New(apperrors.ErrCodeInvalidRequest, "unsupported private key type")
+		}
+		return signer, true, nil
+	case "EC PRIVATE KEY":
+		key, parseErr := x509.ParseECPrivateKey(block.Bytes)
+		if parseErr != nil {
+			return nil, false, apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "failed to parse EC private key", parseErr)
+		}
+		return key, true, nil
+	default:
+		// e.g. ENCRYPTED SIGSTORE PRIVATE KEY from `cosign generate-key-pair`
+		return nil, false, nil
+	}
+}
+
+// loadPublicKey reads a PEM public key. It returns ok=false for KMS
+// references, which must be handled by cosign.
+func loadPublicKey(keyRef string) (crypto.PublicKey, bool, error) {
+	if isKMSRef(keyRef) {
+		return nil, false, nil
+	}
+
+	block, err := readPEM(keyRef)
+	if err != nil {
+		return nil, false, err
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, false, apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unsupported public key PEM type %q", block.Type))
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, false, apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "failed to parse public key", err)
+	}
+	return pub, true, nil
+}
+
+// signBlob produces a signature in the format used by `cosign sign-blob`:
+// ASN.1 ECDSA over the SHA256 digest, or a plain Ed25519 signature.
+func signBlob(key crypto.Signer, data []byte) ([]byte, error) {
+	var (
+		sig []byte
+		err error
+	)
+	if _, isEd25519 := key.(ed25519.PrivateKey); isEd25519 {
+		sig, err = key.Sign(rand.Reader, data, crypto.Hash(0))
+	} else if _, isECDSA := key.(*ecdsa.PrivateKey); isECDSA {
+		digest := sha256.Sum256(data)
+		sig, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	} else {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unsupported signing key type %T (use ECDSA or Ed25519)", key))
+	}
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal, "failed to sign checksums", err)
+	}
+	return sig, nil
+}
+
+// verifyBlob checks a signature produced by signBlob or `cosign sign-blob`.
+func verifyBlob(pub crypto.PublicKey, data, sig []byte) error {
+	valid := false
+	if k, isEd25519 := pub.(ed25519.PublicKey); isEd25519 {
+		valid = ed25519.Verify(k, data, sig)
+	} else if k, isECDSA := pub.(*ecdsa.PublicKey); isECDSA {
+		digest := sha256.Sum256(data)
+		valid = ecdsa.VerifyASN1(k, digest[:], sig)
+	} else {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unsupported public key type %T (use ECDSA or Ed25519)", pub))
+	}
+
+	if !valid {
+		return apperrors.New(apperrors.ErrCodeUnauthorized, "checksums signature verification failed")
+	}
+	return nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeNotFound, fmt.Sprintf("failed to read key %s", path), err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest, fmt.Sprintf("key %s is not PEM encoded", path))
+	}
+	return block, nil
+}
+
+// isKMSRef reports whether keyRef is a cosign KMS URI such as awskms://,
+// gcpkms://, azurekms://, hashivault:// or k8s://.
+func isKMSRef(keyRef string) bool {
+	return strings.Contains(keyRef, "://")
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+)
+
+// newBundle creates a bundle directory with checksums.txt.
+func newBundle(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	file := filepath.Join(dir, "values.yaml")
+	if err := os.WriteFile(file, []byte("driver:\n  enabled: true\n"), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := checksum.GenerateChecksums(context.Background(), dir, []string{file}); err != nil {
+		t.Fatalf("GenerateChecksums() error = %v", err)
+	}
+	return dir
+}
+
+// writeKeyPair writes PEM encoded private and public keys and returns their paths.
+func writeKeyPair(t *testing.T, priv crypto.Signer) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(priv.Public())
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+
+	privPath := filepath.Join(dir, "signing.key")
+	pubPath := filepath.Join(dir, "signing.pub")
+	if err := os.WriteFile(privPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}), 0600); err != nil {
+		t.Fatalf("failed to write private key: %v", err)
+	}
+	if err := os.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}), 0600); err != nil {
+		t.Fatalf("failed to write public key: %v", err)
+	}
+	return privPath, pubPath
+}
+
+func newECDSAKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+// stubCosign replaces the cosign executable and records invocations.
+func stubCosign(t *testing.T, fail bool) *[][]string {
+	t.Helper()
+	var calls [][]string
+	orig := execCosign
+	execCosign = func(_ context.Context, args ...string) ([]byte, error) {
+		calls = append(calls, args)
+		if fail {
+			return nil, os.ErrPermission
+		}
+		return nil, nil
+	}
+	t.Cleanup(func() { execCosign = orig })
+	return &calls
+}
+
+func TestSignAndVerifyBundle_Key(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		key  crypto.Signer
+	}{
+		{name: "ecdsa", key: newECDSAKey(t)},
+		{name: "ed25519", key: edKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := newBundle(t)
+			privPath, pubPath := writeKeyPair(t, tt.key)
+
+			if err := SignBundle(context.Background(), dir, SignOptions{KeyRef: privPath}); err != nil {
+				t.Fatalf("SignBundle() error = %v", err)
+			}
+			if _, err := os.Stat(filepath.Join(dir, SignatureFileName)); err != nil {
+				t.Fatalf("signature not written: %v", err)
+			}
+
+			result, err := VerifyBundle(context.Background(), dir, VerifyOptions{KeyRef: pubPath})
+			if err != nil {
+				t.Fatalf("VerifyBundle() error = %v", err)
+			}
+			if !result.Signed || !result.SignatureVerified || result.Files != 1 {
+				t.Errorf("VerifyBundle() result = %+v", result)
+			}
+		})
+	}
+}
+
+func TestVerifyBundle_Failures(t *testing.T) {
+	privPath, pubPath := writeKeyPair(t, newECDSAKey(t))
+	_, otherPub := writeKeyPair(t, newECDSAKey(t))
+
+	signed := func(t *testing.T) string {
+		t.Helper()
+		dir := newBundle(t)
+		if err := SignBundle(context.Background(), dir, SignOptions{KeyRef: privPath}); err != nil {
+			t.Fatalf("SignBundle() error = %v", err)
+		}
+		return dir
+	}
+
+	t.Run("wrong key", func(t *testing.T) {
+		if _, err := VerifyBundle(context.Background(), signed(t), VerifyOptions{KeyRef: otherPub}); err == nil {
+			t.Error("expected signature verification to fail with wrong key")
+		}
+	})
+
+	t.Run("tampered checksums", func(t *testing.T) {
+		dir := signed(t)
+		// Tamper with a file and re-generate checksums to mask the change
+		file := filepath.Join(dir, "values.yaml")
+		if err := os.WriteFile(file, []byte("driver:\n  enabled: false\n"), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		if err := checksum.GenerateChecksums(context.Background(), dir, []string{file}); err != nil {
+			t.Fatalf("GenerateChecksums() error = %v", err)
+		}
+		if _, err := VerifyBundle(context.Background(), dir, VerifyOptions{KeyRef: pubPath}); err == nil {
+			t.Error("expected signature verification to fail for re-generated checksums")
+		}
+	})
+
+	t.Run("tampered file", func(t *testing.T) {
+		dir := signed(t)
+		if err := os.WriteFile(filepath.Join(dir, "values.yaml"), []byte("tampered"), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		_, err := VerifyBundle(context.Background(), dir, VerifyOptions{KeyRef: pubPath})
+		if err == nil || !strings.Contains(err.Error(), "checksum") {
+			t.Errorf("expected checksum failure, got %v", err)
+		}
+	})
+
+	t.Run("unlisted manifest", func(t *testing.T) {
+		dir := signed(t)
+		// A file dropped in after signing is not covered by the signature
+		manifests := filepath.Join(dir, "templates")
+		if err := os.MkdirAll(manifests, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(manifests, "extra.yaml"), []byte("kind: ClusterRoleBinding\n"), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		_, err := VerifyBundle(context.Background(), dir, VerifyOptions{KeyRef: pubPath})
+		if err == nil || !strings.Contains(err.Error(), "templates/extra.yaml") {
+			t.Errorf("expected unlisted file failure, got %v", err)
+		}
+	})
+
+	t.Run("generation records", func(t *testing.T) {
+		dir := signed(t)
+		for _, name := range []string{"bundle.yaml", "audit.json"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("{}\n"), 0600); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+		}
+		if _, err := VerifyBundle(context.Background(), dir, VerifyOptions{KeyRef: pubPath}); err != nil {
+			t.Errorf("VerifyBundle() error = %v", err)
+		}
+	})
+
+	t.Run("signed bundle without key", func(t *testing.T) {
+		if _, err := VerifyBundle(context.Background(), signed(t), VerifyOptions{}); err == nil {
+			t.Error("expected error when signed bundle is verified without key")
+		}
+	})
+
+	t.Run("unsigned bundle with key", func(t *testing.T) {
+		if _, err := VerifyBundle(context.Background(), newBundle(t), VerifyOptions{KeyRef: pubPath}); err == nil {
+			t.Error("expected error when unsigned bundle is verified with key")
+		}
+	})
+}
+
+func TestVerifyBundle_UnsignedChecksumsOnly(t *testing.T) {
+	result, err := VerifyBundle(context.Background(), newBundle(t), VerifyOptions{})
+	if err != nil {
+		t.Fatalf("VerifyBundle() error = %v", err)
+	}
+	if result.Signed || result.SignatureVerified || result.Files != 1 {
+		t.Errorf("VerifyBundle() result = %+v", result)
+	}
+}
+
+func TestSignBundle_Keyless(t *testing.T) {
+	calls := stubCosign(t, false)
+	dir := newBundle(t)
+
+	if err := SignBundle(context.Background(), dir, SignOptions{Keyless: true}); err != nil {
+		t.Fatalf("SignBundle() error = %v", err)
+	}
+	if len(*calls) != 1 {
+		t.Fatalf("expected 1 cosign call, got %d", len(*calls))
+	}
+	args := strings.Join((*calls)[0], " ")
+	for _, want := range []string{"sign-blob", "--yes", "--bundle " + filepath.Join(dir, SigstoreBundleFileName), checksum.GetChecksumFilePath(dir)} {
+		if !strings.Contains(args, want) {
+			t.Errorf("cosign args %q missing %q", args, want)
+		}
+	}
+}
+
+func TestSignBundle_DelegatesToCosign(t *testing.T) {
+	encrypted := filepath.Join(t.TempDir(), "cosign.key")
+	if err := os.WriteFile(encrypted, pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: []byte("x")}), 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	for _, keyRef := range []string{encrypted, "awskms:///alias/eidos"} {
+		t.Run(keyRef, func(t *testing.T) {
+			calls := stubCosign(t, false)
+			if err := SignBundle(context.Background(), newBundle(t), SignOptions{KeyRef: keyRef}); err != nil {
+				t.Fatalf("SignBundle() error = %v", err)
+			}
+			if len(*calls) != 1 || !strings.Contains(strings.Join((*calls)[0], " "), "--key "+keyRef) {
+				t.Errorf("expected cosign sign-blob with key, got %v", *calls)
+			}
+		})
+	}
+}
+
+func TestSignBundle_Errors(t *testing.T) {
+	t.Run("no method", func(t *testing.T) {
+		if err := SignBundle(context.Background(), newBundle(t), SignOptions{}); err == nil {
+			t.Error("expected error without signing method")
+		}
+	})
+
+	t.Run("conflicting methods", func(t *testing.T) {
+		if err := SignBundle(context.Background(), newBundle(t), SignOptions{Keyless: true, KeyRef: "k"}); err == nil {
+			t.Error("expected error for keyless with key")
+		}
+	})
+
+	t.Run("missing checksums", func(t *testing.T) {
+		if err := SignBundle(context.Background(), t.TempDir(), SignOptions{Keyless: true}); err == nil {
+			t.Error("expected error without checksums.txt")
+		}
+	})
+
+	t.Run("cosign failure", func(t *testing.T) {
+		stubCosign(t, true)
+		if err := SignBundle(context.Background(), newBundle(t), SignOptions{Keyless: true}); err == nil {
+			t.Error("expected error when cosign fails")
+		}
+	})
+}
+
+func TestSignAndVerifyArtifact(t *testing.T) {
+	const ref = "ghcr.io/nvidia/bundle@sha256:abc"
+
+	t.Run("sign keyless insecure", func(t *testing.T) {
+		calls := stubCosign(t, false)
+		if err := SignArtifact(context.Background(), ref, SignOptions{Keyless: true, PlainHTTP: true}); err != nil {
+			t.Fatalf("SignArtifact() error = %v", err)
+		}
+		want := "sign --yes --allow-http-registry --allow-insecure-registry " + ref
+		if got := strings.Join((*calls)[0], " "); got != want {
+			t.Errorf("cosign args = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("verify identity", func(t *testing.T) {
+		calls := stubCosign(t, false)
+		opts := VerifyOptions{CertificateIdentity: "user@example.com", CertificateOIDCIssuer: "https://accounts.google.com"}
+		if err := VerifyArtifact(context.Background(), ref, opts); err != nil {
+			t.Fatalf("VerifyArtifact() error = %v", err)
+		}
+		want := "verify --certificate-identity user@example.com --certificate-oidc-issuer https://accounts.google.com " + ref
+		if got := strings.Join((*calls)[0], " "); got != want {
+			t.Errorf("cosign args = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("verify requires material", func(t *testing.T) {
+		if err := VerifyArtifact(context.Background(), ref, VerifyOptions{}); err == nil {
+			t.Error("expected error without verification material")
+		}
+	})
+
+	t.Run("incomplete identity", func(t *testing.T) {
+		if err := VerifyArtifact(context.Background(), ref, VerifyOptions{CertificateIdentity: "user@example.com"}); err == nil {
+			t.Error("expected error without OIDC issuer")
+		}
+	})
+}
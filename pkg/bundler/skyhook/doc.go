@@ -0,0 +1,38 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package skyhook generates Skyhook custom resources that apply the node tuning a recipe recommends.
+
+Recipes describe node-level tuning as exact OS constraints (see
+pkg/bundler/bootstrap). When a recipe includes the skyhook-operator
+component, TuningManifests turns those settings into an eidos-tuning Skyhook,
+added to the Helm umbrella chart next to the component's manifest files, so
+the operator applies them to GPU nodes instead of them only being documented:
+  - GRUB kernel parameters and sysctls through the tuning package, rebooting
+    the node once they change
+  - kernel modules through a kernel-modules package that writes
+    /etc/modules-load.d/eidos.conf and loads them
+
+# Usage
+
+	manifests, err := skyhook.TuningManifests(recipeResult, ref, "v1.0.0")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for path, content := range manifests {
+		// add to the umbrella chart templates
+	}
+*/
+package skyhook
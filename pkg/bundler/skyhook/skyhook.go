@@ -0,0 +1,90 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skyhook
+
+import (
+	"bytes"
+	_ "embed"
+	"text/template"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/bootstrap"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	// ComponentName is the registry name of the Skyhook operator component.
+	ComponentName = "skyhook-operator"
+
+	// TuningManifestPath is the manifest path of the generated node tuning
+	// Skyhook. It is not read from the data directory; the umbrella chart
+	// writes it to templates/ by its base name like other manifest files.
+	TuningManifestPath = "components/skyhook-operator/manifests/eidos-tuning.yaml"
+
+	// TuningName is the name of the generated Skyhook custom resource.
+	TuningName = "eidos-tuning"
+)
+
+//go:embed templates/tuning.yaml.tmpl
+var tuningTemplate string
+
+// tuningData is the data for the node tuning manifest template.
+type tuningData struct {
+	Name           string
+	BundlerVersion string
+	RecipeVersion  string
+	Settings       *bootstrap.Settings
+}
+
+// TuningManifests is the custom manifest function of the Skyhook operator
+// component. It translates the recipe's OS settings, exact OS.sysctl,
+// OS.grub and OS.kmod constraints, into a Skyhook custom resource whose
+// packages apply them to GPU nodes: the tuning package writes grub.conf and
+// sysctl.conf, and a kernel-modules package loads the kernel modules.
+//
+// The manifest is a Helm template for the umbrella chart and honors the
+// customizationTolerations and customizationNodeSelectors values like the
+// customization manifests. No manifest is returned when the recipe
+// recommends no settings.
+func TuningManifests(recipeResult *recipe.RecipeResult, ref recipe.ComponentRef, version string) (map[string][]byte, error) {
+	if recipeResult == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe result is required")
+	}
+
+	settings := bootstrap.SettingsFromConstraints(recipeResult.Constraints)
+	if settings.IsEmpty() {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("tuning").Delims("[[", "]]").Parse(tuningTemplate)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to parse Skyhook tuning template", err)
+	}
+
+	// The manifest is itself a Helm template, so it is rendered with [[ ]]
+	// delimiters and its {{ }} actions are left for Helm.
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, &tuningData{
+		Name:           TuningName,
+		BundlerVersion: version,
+		RecipeVersion:  recipeResult.Metadata.Version,
+		Settings:       settings,
+	}); err != nil {
+		return nil, errors.WrapWithContext(errors.ErrCodeInternal, "failed to render Skyhook tuning manifest", err,
+			map[string]any{"component": ref.Name})
+	}
+
+	return map[string][]byte{TuningManifestPath: buf.Bytes()}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package skyhook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestTuningManifests(t *testing.T) {
+	r := &recipe.RecipeResult{
+		Constraints: []recipe.Constraint{
+			{Name: "OS.sysctl./proc/sys/vm/max_map_count", Value: "262144"},
+			{Name: "OS.sysctl./proc/sys/kernel/osrelease", Value: ">= 6.8"},
+			{Name: "OS.grub.hugepagesz", Value: "2M"},
+			{Name: "OS.grub.hugepages", Value: "5128"},
+			{Name: "OS.kmod.nvidia_peermem", Value: "true"},
+		},
+	}
+	r.Metadata.Version = "v0.9.0"
+
+	manifests, err := TuningManifests(r, recipe.ComponentRef{Name: ComponentName}, "v1.0.0")
+	if err != nil {
+		t.Fatalf("TuningManifests() error = %v", err)
+	}
+	content := string(manifests[TuningManifestPath])
+	if content == "" {
+		t.Fatal("no tuning manifest generated")
+	}
+
+	for _, want := range []string{
+		"Generated by eidos v1.0.0 from recipe v0.9.0",
+		`{{- $skyhook := index .Values "skyhook-operator" }}`,
+		"name: eidos-tuning",
+		"namespace: {{ .Release.Namespace }}",
+		"        grub.conf: |-\n          hugepages=5128\n          hugepagesz=2M\n",
+		"        sysctl.conf: |-\n          vm.max_map_count=262144\n",
+		"    kernel-modules:\n      dependsOn:\n        tuning: \"\"\n",
+		"          modprobe nvidia_peermem\n",
+		"          grep -q '^nvidia_peermem ' /proc/modules\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("manifest missing %q\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "osrelease") {
+		t.Error("range constraints must not be applied")
+	}
+}
+
+func TestTuningManifestsModulesOnly(t *testing.T) {
+	r := &recipe.RecipeResult{
+		Constraints: []recipe.Constraint{{Name: "OS.kmod.ib_umad", Value: "true"}},
+	}
+
+	manifests, err := TuningManifests(r, recipe.ComponentRef{Name: ComponentName}, "v1.0.0")
+	if err != nil {
+		t.Fatalf("TuningManifests() error = %v", err)
+	}
+	content := string(manifests[TuningManifestPath])
+	if strings.Contains(content, "tuning:") || strings.Contains(content, "dependsOn") {
+		t.Errorf("unexpected tuning package without sysctl or GRUB settings:\n%s", content)
+	}
+	if !strings.Contains(content, "modprobe ib_umad") {
+		t.Error("kernel module not loaded")
+	}
+}
+
+func TestTuningManifestsNoSettings(t *testing.T) {
+	manifests, err := TuningManifests(&recipe.RecipeResult{}, recipe.ComponentRef{Name: ComponentName}, "v1.0.0")
+	if err != nil {
+		t.Fatalf("TuningManifests() error = %v", err)
+	}
+	if len(manifests) != 0 {
+		t.Errorf("expected no manifests, got %d", len(manifests))
+	}
+
+	if _, err := TuningManifests(nil, recipe.ComponentRef{}, ""); err == nil {
+		t.Error("expected error for nil recipe")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/terraform"
+	"github.com/NVIDIA/eidos/pkg/bundler/diff"
+	"github.com/NVIDIA/eidos/pkg/bundler/plugin"
+	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// deployerMarkers are the files identifying the deployer a bundle was
+// generated with.
+var deployerMarkers = map[config.DeployerType]string{
+	config.DeployerHelm:          "Chart.yaml",
+	config.DeployerArgoCD:        "app-of-apps.yaml",
+	config.DeployerArgoWorkflows: "workflow.yaml",
+	config.DeployerTerraform:     terraform.MainFileName,
+}
+
+// Update regenerates the named components inside the existing bundle in dir,
+// leaving the other components as they are.
+//
+// The named components get their versions and values from input, like Make.
+// Every other enabled component of input keeps the version and values it has
+// in the existing bundle, so the shared files (umbrella Chart.yaml and
+// values.yaml and install.sh, app-of-apps.yaml, workflow.yaml, main.tf, README.md) and checksums.txt
+// are recomputed without picking up unrelated recipe changes. The component
+// directories of the named components are removed first so stale files do
+// not linger. recipe.yaml records the recipe the bundle now reflects, and
+// CHANGES.md describes the update.
+//
+// The bundle must have been generated with the configured deployer, and
+// every enabled component of input that is not named must be in it.
+func (b *DefaultBundler) Update(ctx context.Context, input recipe.RecipeInput, dir string, components []string) (*result.Output, error) {
+	if input == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe input cannot be nil")
+	}
+	recipeResult, ok := input.(*recipe.RecipeResult)
+	if !ok {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			"bundle generation requires RecipeResult format")
+	}
+	if len(components) == 0 {
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			"at least one component to update is required")
+	}
+	if dir == "" {
+		dir = "."
+	}
+
+	deployer := b.Config.Deployer()
+	if _, err := os.Stat(filepath.Join(dir, deployerMarkers[deployer])); err != nil {
+		for other, marker := range deployerMarkers {
+			if _, statErr := os.Stat(filepath.Join(dir, marker)); statErr == nil {
+				return nil, errors.New(errors.ErrCodeInvalidRequest,
+					fmt.Sprintf("bundle in %s was generated with deployer %s, not %s", dir, other, deployer))
+			}
+		}
+		return nil, errors.New(errors.ErrCodeNotFound,
+			fmt.Sprintf("no %s bundle found in %s", deployer, dir))
+	}
+
+	existing, err := diff.Load(dir)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInvalidRequest,
+			"failed to load existing bundle", err)
+	}
+
+	for _, name := range components {
+		ref := recipeResult.GetComponentRef(name)
+		if ref == nil || !ref.IsEnabled() {
+			return nil, errors.New(errors.ErrCodeInvalidRequest,
+				fmt.Sprintf("component %s is not an enabled component of the recipe", name))
+		}
+	}
+
+	// Pin every other component to the version and values in the bundle.
+	pinnedRecipe := *recipeResult
+	pinnedRecipe.ComponentRefs = slices.Clone(recipeResult.ComponentRefs)
+	pinned := make(map[string]map[string]any)
+	for i := range pinnedRecipe.ComponentRefs {
+		ref := &pinnedRecipe.ComponentRefs[i]
+		if !ref.IsEnabled() || slices.Contains(components, ref.Name) {
+			continue
+		}
+		current, ok := existing.Components[ref.Name]
+		if !ok {
+			return nil, errors.New(errors.ErrCodeInvalidRequest,
+				fmt.Sprintf("component %s is not in the bundle in %s; update it too or regenerate the full bundle", ref.Name, dir))
+		}
+		if current.Version != "" {
+			ref.Version = current.Version
+		}
+		pinned[ref.Name] = current.Values
+	}
+
+	slog.Debug("updating bundle components",
+		"components", components,
+		"output_dir", dir,
+	)
+
+	return b.generate(ctx, &pinnedRecipe, dir, &bundleUpdate{
+		components: components,
+		values:     pinned,
+		existing:   existing,
+	})
+}
+
+// bundleUpdate describes the partial regeneration of an existing bundle.
+type bundleUpdate struct {
+	// components are the regenerated components.
+	components []string
+
+	// values are the values kept for the other components.
+	values map[string]map[string]any
+
+	// existing is the bundle as it was before the update.
+	existing *diff.Bundle
+}
+
+// removeComponentDirs removes the bundle directories of the regenerated
+// components, so files they no longer produce do not linger.
+func (u *bundleUpdate) removeComponentDirs(dir string) error {
+	for _, name := range u.components {
+		for _, componentDir := range []string{filepath.Join(dir, name), filepath.Join(dir, plugin.DirName, name)} {
+			if err := os.RemoveAll(componentDir); err != nil {
+				return errors.Wrap(errors.ErrCodeInternal,
+					"failed to remove component directory "+componentDir, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/diff"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestUpdate(t *testing.T) {
+	newInput := func(certManagerVersion, gpuVersion string, extra ...recipe.ComponentRef) *recipe.RecipeResult {
+		return &recipe.RecipeResult{
+			APIVersion: "eidos.nvidia.com/v1alpha1",
+			Kind:       "Recipe",
+			ComponentRefs: append([]recipe.ComponentRef{
+				{Name: "cert-manager", Version: certManagerVersion, Type: "helm", Source: "https://charts.jetstack.io"},
+				{Name: "gpu-operator", Version: gpuVersion, Type: "helm", Source: "https://helm.ngc.nvidia.com/nvidia"},
+			}, extra...),
+			DeploymentOrder: []string{"cert-manager", "gpu-operator"},
+		}
+	}
+	newBundle := func(t *testing.T, deployer config.DeployerType) (*DefaultBundler, string) {
+		t.Helper()
+		b, err := New(WithConfig(config.NewConfig(config.WithDeployer(deployer))))
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		dir := filepath.Join(t.TempDir(), "bundle")
+		if _, err := b.Make(context.Background(), newInput("v1.17.2", "v25.3.3"), dir); err != nil {
+			t.Fatalf("Make() error = %v", err)
+		}
+		return b, dir
+	}
+
+	for _, deployer := range []config.DeployerType{config.DeployerHelm, config.DeployerArgoCD} {
+		t.Run(string(deployer), func(t *testing.T) {
+			b, dir := newBundle(t, deployer)
+			ctx := context.Background()
+
+			if _, err := b.Update(ctx, newInput("v1.18.0", "v25.10.1"), dir, []string{"gpu-operator"}); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+
+			updated, err := diff.Load(dir)
+			if err != nil {
+				t.Fatalf("diff.Load() error = %v", err)
+			}
+			if got := updated.Components["gpu-operator"].Version; !strings.Contains(got, "25.10.1") {
+				t.Errorf("gpu-operator version = %q, want 25.10.1", got)
+			}
+			if got := updated.Components["cert-manager"].Version; !strings.Contains(got, "1.17.2") {
+				t.Errorf("cert-manager version = %q, want unchanged 1.17.2", got)
+			}
+			if err := checksum.VerifyChecksums(ctx, dir); err != nil {
+				t.Errorf("VerifyChecksums() error = %v", err)
+			}
+
+			changes, err := os.ReadFile(filepath.Join(dir, diff.ChangesFileName))
+			if err != nil {
+				t.Fatalf("failed to read CHANGES.md: %v", err)
+			}
+			if !strings.Contains(string(changes), "## gpu-operator (modified)") ||
+				strings.Contains(string(changes), "cert-manager") {
+				t.Errorf("CHANGES.md should only list gpu-operator:\n%s", changes)
+			}
+		})
+	}
+
+	t.Run("adds new component", func(t *testing.T) {
+		b, dir := newBundle(t, config.DeployerHelm)
+		nfd := recipe.ComponentRef{Name: "nfd", Version: "0.17.0", Type: "helm", Source: "https://kubernetes-sigs.github.io/node-feature-discovery/charts"}
+
+		if _, err := b.Update(context.Background(), newInput("v1.17.2", "v25.3.3", nfd), dir, []string{"nfd"}); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		chart, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(chart), "nfd") {
+			t.Errorf("Chart.yaml missing new dependency:\n%s", chart)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		b, dir := newBundle(t, config.DeployerHelm)
+		argocd, err := New(WithConfig(config.NewConfig(config.WithDeployer(config.DeployerArgoCD))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		extra := recipe.ComponentRef{Name: "nfd", Version: "0.17.0", Type: "helm", Source: "https://kubernetes-sigs.github.io/node-feature-discovery/charts"}
+
+		tests := []struct {
+			name       string
+			bundler    *DefaultBundler
+			input      *recipe.RecipeResult
+			dir        string
+			components []string
+			wantErr    string
+		}{
+			{"no components", b, newInput("v1.17.2", "v25.3.3"), dir, nil, "at least one component"},
+			{"no bundle", b, newInput("v1.17.2", "v25.3.3"), t.TempDir(), []string{"gpu-operator"}, "no helm bundle"},
+			{"deployer mismatch", argocd, newInput("v1.17.2", "v25.3.3"), dir, []string{"gpu-operator"}, "generated with deployer helm"},
+			{"unknown component", b, newInput("v1.17.2", "v25.3.3"), dir, []string{"missing"}, "not an enabled component"},
+			{"component not in bundle", b, newInput("v1.17.2", "v25.3.3", extra), dir, []string{"gpu-operator"}, "nfd is not in the bundle"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.bundler.Update(context.Background(), tt.input, tt.dir, tt.components)
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Update() error = %v, want %q", err, tt.wantErr)
+				}
+			})
+		}
+	})
+}
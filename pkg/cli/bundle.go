@@ -16,20 +16,30 @@ package cli
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/NVIDIA/eidos/pkg/bundler"
 	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/lock"
+	"github.com/NVIDIA/eidos/pkg/bundler/plugin"
 	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/bundler/signing"
+	"github.com/NVIDIA/eidos/pkg/objectstore"
 	"github.com/NVIDIA/eidos/pkg/oci"
+	"github.com/NVIDIA/eidos/pkg/policy"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 	"github.com/NVIDIA/eidos/pkg/serializer"
 	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	eidosversion "github.com/NVIDIA/eidos/pkg/version"
 	"github.com/urfave/cli/v3"
 )
 
@@ -40,28 +50,126 @@ type bundleCmdOptions struct {
 	kubeconfig                 string
 	deployer                   config.DeployerType
 	repoURL                    string
+	applicationSetGenerator    config.ApplicationSetGenerator
+	clusterSelector            map[string]string
+	kubernetesVersion          string
+	versionPolicy              *policy.VersionPolicy
+	previousBundle             string
+	lockedFile                 string
+	resolveImageDigests        bool
+	capacityTemplate           config.CapacityTemplateType
+	profile                    string
+	schemaValidation           config.SchemaValidationMode
+	schemaDir                  string
+	strictOverrides            bool
+	runbook                    bool
+	nodeBootstrap              bool
+	nodeLabels                 bool
+	imageRegistryMirror        string
+	secretBackend              config.SecretBackend
+	secretStore                string
+	httpProxy                  string
+	noProxy                    []string
+	caBundle                   []byte
+	installScopes              map[string]config.InstallScope
+	extraManifests             map[string][]string
 	valueOverrides             map[string]map[string]string
+	valuePatches               map[string][]*recipe.ValuesPatch
 	systemNodeSelector         map[string]string
 	systemNodeTolerations      []corev1.Toleration
 	acceleratedNodeSelector    map[string]string
 	acceleratedNodeTolerations []corev1.Toleration
+	pluginDir                  string
+	pluginTimeout              time.Duration
+	concurrency                int
+
+	// Fleet inventory to generate one bundle per cluster from, and whether
+	// to add an Argo CD ApplicationSet deploying them
+	fleetPath      string
+	applicationSet bool
+
+	// Components to regenerate inside the existing bundle in updateDir
+	only      []string
+	updateDir string
 
 	// OCI output reference (nil if outputting to local directory)
 	ociRef        *oci.Reference
 	plainHTTP     bool
 	insecureTLS   bool
 	imageRefsPath string // Path to write published image references (like ko --image-refs)
+
+	// Object storage prefix (s3://, gs:// or az://) the bundle is uploaded
+	// to; empty if outputting to a local directory or OCI registry
+	objectURI string
+
+	// Signing options for checksums.txt and the pushed OCI artifact
+	sign signing.SignOptions
 }
 
 // parseBundleCmdOptions parses and validates command options.
 func parseBundleCmdOptions(cmd *cli.Command) (*bundleCmdOptions, error) {
 	opts := &bundleCmdOptions{
-		recipeFilePath: cmd.String("recipe"),
-		kubeconfig:     cmd.String("kubeconfig"),
-		repoURL:        cmd.String("repo"),
-		insecureTLS:    cmd.Bool("insecure-tls"),
-		plainHTTP:      cmd.Bool("plain-http"),
-		imageRefsPath:  cmd.String("image-refs"),
+		recipeFilePath:      cmd.String("recipe"),
+		kubeconfig:          cmd.String("kubeconfig"),
+		repoURL:             cmd.String("repo"),
+		kubernetesVersion:   cmd.String("kubernetes-version"),
+		previousBundle:      cmd.String("previous-bundle"),
+		schemaDir:           cmd.String("values-schema-dir"),
+		strictOverrides:     cmd.Bool("strict-overrides"),
+		runbook:             cmd.Bool("runbook"),
+		nodeBootstrap:       cmd.Bool("node-bootstrap"),
+		nodeLabels:          cmd.Bool("node-labels"),
+		imageRegistryMirror: cmd.String("image-registry-mirror"),
+		resolveImageDigests: cmd.Bool("resolve-image-digests"),
+		secretStore:         cmd.String("secret-store"),
+		httpProxy:           cmd.String("http-proxy"),
+		noProxy:             cmd.StringSlice("no-proxy"),
+		insecureTLS:         cmd.Bool("insecure-tls"),
+		plainHTTP:           cmd.Bool("plain-http"),
+		imageRefsPath:       cmd.String("image-refs"),
+		only:                cmd.StringSlice("only"),
+		updateDir:           cmd.String("update"),
+		fleetPath:           cmd.String("fleet"),
+		applicationSet:      cmd.Bool("applicationset"),
+		sign: signing.SignOptions{
+			Keyless:     cmd.Bool("sign"),
+			KeyRef:      cmd.String("sign-key"),
+			PlainHTTP:   cmd.Bool("plain-http"),
+			InsecureTLS: cmd.Bool("insecure-tls"),
+		},
+	}
+
+	if (len(opts.only) > 0) != (opts.updateDir != "") {
+		return nil, fmt.Errorf("--only and --update must be used together")
+	}
+	if opts.updateDir != "" && cmd.IsSet("output") {
+		return nil, fmt.Errorf("--update regenerates the bundle in place and cannot be combined with --output")
+	}
+
+	// An updated bundle defaults to the recipe it was generated from
+	if opts.recipeFilePath == "" && opts.updateDir != "" {
+		path := filepath.Join(opts.updateDir, "recipe.yaml")
+		if _, err := os.Stat(path); err == nil {
+			opts.recipeFilePath = path
+		}
+	}
+
+	if opts.fleetPath != "" {
+		if err := opts.validateFleetFlags(cmd); err != nil {
+			return nil, err
+		}
+	} else if opts.applicationSet {
+		return nil, fmt.Errorf("--applicationset requires --fleet")
+	}
+
+	// Validated here rather than with Required so that subcommands such as
+	// `bundle verify` are not forced to pass --recipe.
+	if opts.recipeFilePath == "" && opts.fleetPath == "" {
+		return nil, fmt.Errorf("required flag \"recipe\" not set")
+	}
+
+	if err := opts.sign.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signing flags: %w", err)
 	}
 
 	// Parse and validate deployer flag using strongly-typed parser
@@ -76,11 +184,136 @@ func parseBundleCmdOptions(cmd *cli.Command) (*bundleCmdOptions, error) {
 		opts.deployer = deployer
 	}
 
+	applicationSetGenerator, err := config.ParseApplicationSetGenerator(cmd.String("applicationset-generator"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --applicationset-generator value: %w", err)
+	}
+	if applicationSetGenerator != config.ApplicationSetNone && opts.deployer != config.DeployerArgoCD {
+		return nil, fmt.Errorf("--applicationset-generator requires --deployer argocd")
+	}
+	opts.applicationSetGenerator = applicationSetGenerator
+
+	opts.clusterSelector, err = snapshotter.ParseNodeSelectors(cmd.StringSlice("cluster-selector"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --cluster-selector: %w", err)
+	}
+	if len(opts.clusterSelector) > 0 && applicationSetGenerator != config.ApplicationSetCluster {
+		return nil, fmt.Errorf("--cluster-selector requires --applicationset-generator cluster")
+	}
+
+	capacityTemplate, err := config.ParseCapacityTemplateType(cmd.String("capacity-template"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --capacity-template value: %w", err)
+	}
+	opts.capacityTemplate = capacityTemplate
+
+	secretBackend, err := config.ParseSecretBackend(cmd.String("secret-backend"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --secret-backend value: %w", err)
+	}
+	if opts.secretStore != "" && secretBackend != config.SecretBackendESO {
+		return nil, fmt.Errorf("--secret-store requires --secret-backend eso")
+	}
+	opts.secretBackend = secretBackend
+
+	schemaValidation, err := config.ParseSchemaValidationMode(cmd.String("values-schema"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --values-schema value: %w", err)
+	}
+	if opts.schemaDir != "" && schemaValidation == config.SchemaValidationNone {
+		return nil, fmt.Errorf("--values-schema-dir requires --values-schema warn or error")
+	}
+	opts.schemaValidation = schemaValidation
+
+	opts.installScopes, err = parseInstallScopes(cmd.StringSlice("install-scope"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --install-scope flag: %w", err)
+	}
+
+	opts.extraManifests, err = parseExtraManifests(cmd.StringSlice("extra-manifests"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --extra-manifests flag: %w", err)
+	}
+	if len(opts.extraManifests) > 0 && opts.deployer != config.DeployerHelm && opts.deployer != config.DeployerArgoCD {
+		return nil, fmt.Errorf("--extra-manifests requires --deployer %s or %s", config.DeployerHelm, config.DeployerArgoCD)
+	}
+
+	if strings.Contains(opts.imageRegistryMirror, "://") {
+		return nil, fmt.Errorf("invalid --image-registry-mirror value %q: expected a registry host and optional path, without a scheme", opts.imageRegistryMirror)
+	}
+
+	if opts.httpProxy != "" {
+		if err := validateHTTPProxy(opts.httpProxy); err != nil {
+			return nil, fmt.Errorf("invalid --http-proxy value: %w", err)
+		}
+	} else if len(opts.noProxy) > 0 {
+		return nil, fmt.Errorf("--no-proxy requires --http-proxy")
+	}
+	for _, host := range opts.noProxy {
+		if strings.ContainsAny(host, "'\" $`\\") {
+			return nil, fmt.Errorf("invalid --no-proxy value %q: expected a host, domain or CIDR", host)
+		}
+	}
+
+	if path := cmd.String("ca-bundle"); path != "" {
+		opts.caBundle, err = readCABundle(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --ca-bundle: %w", err)
+		}
+	}
+
+	if opts.kubernetesVersion != "" {
+		if _, err := eidosversion.ParseVersion(opts.kubernetesVersion); err != nil {
+			return nil, fmt.Errorf("invalid --kubernetes-version value: %w", err)
+		}
+	}
+
+	if path := cmd.String("version-policy"); path != "" {
+		p, err := serializer.FromFileWithKubeconfig[policy.VersionPolicy](path, opts.kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load --version-policy: %w", err)
+		}
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid --version-policy: %w", err)
+		}
+		opts.versionPolicy = p
+	}
+
 	// Parse output target (detects oci:// URI or local directory)
 	outputTarget := cmd.String("output")
+	if opts.updateDir != "" {
+		outputTarget = opts.updateDir
+	}
+	if objectstore.IsURI(outputTarget) {
+		if _, err := objectstore.ParseURI(outputTarget); err != nil {
+			return nil, fmt.Errorf("invalid --output value: %w", err)
+		}
+		// Generate locally, then upload the files below the prefix
+		opts.objectURI = outputTarget
+		opts.outputDir = "./bundle"
+	} else if err := opts.parseOCIOutput(outputTarget); err != nil {
+		return nil, err
+	}
+	if opts.fleetPath != "" && (opts.ociRef != nil || opts.objectURI != "") {
+		return nil, fmt.Errorf("--fleet writes one bundle directory per cluster and needs a local --output directory")
+	}
+	if err := opts.parseLockFlags(cmd); err != nil {
+		return nil, err
+	}
+
+	if err := opts.parseValueFlags(cmd); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// parseOCIOutput sets the OCI reference or local output directory from an
+// --output value that is not an object storage URI.
+func (opts *bundleCmdOptions) parseOCIOutput(outputTarget string) error {
 	ref, err := oci.ParseOutputTarget(outputTarget)
 	if err != nil {
-		return nil, fmt.Errorf("invalid --output value: %w", err)
+		return fmt.Errorf("invalid --output value: %w", err)
 	}
 
 	if ref.IsOCI {
@@ -96,33 +329,290 @@ func parseBundleCmdOptions(cmd *cli.Command) (*bundleCmdOptions, error) {
 		opts.outputDir = ref.LocalPath
 	}
 
+	return nil
+}
+
+// imageDigestResolver resolves image tags for the lockfile with the registry
+// connection flags and Docker credentials.
+func (opts *bundleCmdOptions) imageDigestResolver() bundler.ImageDigestResolver {
+	return func(ctx context.Context, image string) (string, error) {
+		return oci.ResolveDigest(ctx, image, oci.ResolveOptions{
+			PlainHTTP:   opts.plainHTTP,
+			InsecureTLS: opts.insecureTLS,
+		})
+	}
+}
+
+// parseLockFlags sets the lockfile a --locked bundle must match. It defaults
+// to the lockfile of the bundle in the local output directory, read before
+// that bundle is overwritten.
+func (opts *bundleCmdOptions) parseLockFlags(cmd *cli.Command) error {
+	path := cmd.String("lockfile")
+	if !cmd.Bool("locked") {
+		if path != "" {
+			return fmt.Errorf("--lockfile requires --locked")
+		}
+		return nil
+	}
+	if opts.fleetPath != "" {
+		return fmt.Errorf("--locked cannot be combined with --fleet")
+	}
+	if path == "" {
+		if opts.ociRef != nil || opts.objectURI != "" {
+			return fmt.Errorf("--locked with a remote --output requires --lockfile")
+		}
+		path = filepath.Join(opts.outputDir, lock.FileName)
+	}
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("--locked requires an existing lockfile: %w", err)
+	}
+	opts.lockedFile = path
+	return nil
+}
+
+// parseValueFlags parses the --set, --values-patch, node selector, toleration,
+// bundler plugin and concurrency flags shared by commands that render
+// component values.
+func (opts *bundleCmdOptions) parseValueFlags(cmd *cli.Command) error {
+	opts.pluginDir = cmd.String("plugin-dir")
+	opts.pluginTimeout = cmd.Duration("plugin-timeout")
+	opts.concurrency = cmd.Int("concurrency")
+	opts.profile = cmd.String("profile")
+	if opts.profile != "" {
+		if _, err := recipe.LoadBundleProfile(opts.profile); err != nil {
+			return fmt.Errorf("invalid --profile: %w", err)
+		}
+	}
+
 	// Parse value overrides from --set flags
+	var err error
 	opts.valueOverrides, err = config.ParseValueOverrides(cmd.StringSlice("set"))
 	if err != nil {
-		return nil, fmt.Errorf("invalid --set flag: %w", err)
+		return fmt.Errorf("invalid --set flag: %w", err)
+	}
+
+	opts.valuePatches, err = parseValuePatches(cmd.StringSlice("values-patch"))
+	if err != nil {
+		return fmt.Errorf("invalid --values-patch flag: %w", err)
 	}
 
 	// Parse node selectors
 	opts.systemNodeSelector, err = snapshotter.ParseNodeSelectors(cmd.StringSlice("system-node-selector"))
 	if err != nil {
-		return nil, fmt.Errorf("invalid --system-node-selector: %w", err)
+		return fmt.Errorf("invalid --system-node-selector: %w", err)
 	}
 	opts.acceleratedNodeSelector, err = snapshotter.ParseNodeSelectors(cmd.StringSlice("accelerated-node-selector"))
 	if err != nil {
-		return nil, fmt.Errorf("invalid --accelerated-node-selector: %w", err)
+		return fmt.Errorf("invalid --accelerated-node-selector: %w", err)
 	}
 
 	// Parse tolerations
 	opts.systemNodeTolerations, err = snapshotter.ParseTolerations(cmd.StringSlice("system-node-toleration"))
 	if err != nil {
-		return nil, fmt.Errorf("invalid --system-node-toleration: %w", err)
+		return fmt.Errorf("invalid --system-node-toleration: %w", err)
 	}
 	opts.acceleratedNodeTolerations, err = snapshotter.ParseTolerations(cmd.StringSlice("accelerated-node-toleration"))
 	if err != nil {
-		return nil, fmt.Errorf("invalid --accelerated-node-toleration: %w", err)
+		return fmt.Errorf("invalid --accelerated-node-toleration: %w", err)
 	}
 
-	return opts, nil
+	return nil
+}
+
+// bundlerConfig builds the bundler configuration from parsed options.
+func (opts *bundleCmdOptions) bundlerConfig() *config.Config {
+	options := []config.Option{
+		config.WithVersion(version),
+		config.WithDeployer(opts.deployer),
+		config.WithRepoURL(opts.repoURL),
+		config.WithApplicationSet(opts.applicationSetGenerator),
+		config.WithClusterSelector(opts.clusterSelector),
+		config.WithKubernetesVersion(opts.kubernetesVersion),
+		config.WithVersionPolicy(opts.versionPolicy),
+		config.WithPreviousBundle(opts.previousBundle),
+		config.WithLockedFile(opts.lockedFile),
+		config.WithResolveImageDigests(opts.resolveImageDigests),
+		config.WithCapacityTemplate(opts.capacityTemplate),
+		config.WithProfile(opts.profile),
+		config.WithSchemaValidation(opts.schemaValidation),
+		config.WithSchemaDir(opts.schemaDir),
+		config.WithValueOverrides(opts.valueOverrides),
+		config.WithStrictOverrides(opts.strictOverrides),
+		config.WithRunbook(opts.runbook),
+		config.WithNodeBootstrap(opts.nodeBootstrap),
+		config.WithNodeLabels(opts.nodeLabels),
+		config.WithImageRegistryMirror(opts.imageRegistryMirror),
+		config.WithSecretBackend(opts.secretBackend),
+		config.WithSecretStore(opts.secretStore),
+		config.WithHTTPProxy(opts.httpProxy),
+		config.WithNoProxy(opts.noProxy),
+		config.WithCABundle(opts.caBundle),
+		config.WithInstallScopes(opts.installScopes),
+		config.WithValuePatches(opts.valuePatches),
+		config.WithSystemNodeSelector(opts.systemNodeSelector),
+		config.WithSystemNodeTolerations(opts.systemNodeTolerations),
+		config.WithAcceleratedNodeSelector(opts.acceleratedNodeSelector),
+		config.WithAcceleratedNodeTolerations(opts.acceleratedNodeTolerations),
+		config.WithPluginDir(opts.pluginDir),
+		config.WithPluginTimeout(opts.pluginTimeout),
+		config.WithConcurrency(opts.concurrency),
+	}
+	for component, dirs := range opts.extraManifests {
+		for _, dir := range dirs {
+			options = append(options, config.WithExtraManifests(component, dir))
+		}
+	}
+	return config.NewConfig(options...)
+}
+
+// parseValuePatches reads the patch files of --values-patch flags in format
+// "component=path", keyed by component.
+func parseValuePatches(specs []string) (map[string][]*recipe.ValuesPatch, error) {
+	patches := make(map[string][]*recipe.ValuesPatch)
+	for _, spec := range specs {
+		component, path, ok := strings.Cut(spec, "=")
+		if !ok || component == "" || path == "" {
+			return nil, fmt.Errorf("invalid format '%s': expected 'component=path'", spec)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read patch for %s: %w", component, err)
+		}
+		patch, err := recipe.ParseValuesPatch(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		patches[component] = append(patches[component], patch)
+	}
+	return patches, nil
+}
+
+// parseInstallScopes parses --install-scope flags in format "component=scope",
+// keyed by component.
+func parseInstallScopes(specs []string) (map[string]config.InstallScope, error) {
+	scopes := make(map[string]config.InstallScope)
+	for _, spec := range specs {
+		component, s, ok := strings.Cut(spec, "=")
+		if !ok || component == "" || s == "" {
+			return nil, fmt.Errorf("invalid format '%s': expected 'component=scope'", spec)
+		}
+		scope, err := config.ParseInstallScope(s)
+		if err != nil {
+			return nil, err
+		}
+		scopes[component] = scope
+	}
+	return scopes, nil
+}
+
+// parseExtraManifests parses --extra-manifests flags in format
+// "component=dir", keyed by component.
+func parseExtraManifests(specs []string) (map[string][]string, error) {
+	dirs := make(map[string][]string)
+	for _, spec := range specs {
+		component, dir, ok := strings.Cut(spec, "=")
+		if !ok || component == "" || dir == "" {
+			return nil, fmt.Errorf("invalid format '%s': expected 'component=dir'", spec)
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests of %s: %w", component, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("manifests of %s: %s is not a directory", component, dir)
+		}
+		dirs[component] = append(dirs[component], dir)
+	}
+	return dirs, nil
+}
+
+// validateHTTPProxy checks that proxy is an http or https URL with a host,
+// safe to write into the bundle scripts.
+func validateHTTPProxy(proxy string) error {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q: expected an http:// or https:// URL", proxy)
+	}
+	if strings.ContainsAny(proxy, "'\" $`\\") {
+		return fmt.Errorf("%q: must not contain quotes, spaces, $, ` or \\", proxy)
+	}
+	return nil
+}
+
+// readCABundle reads a PEM file and checks that it holds at least one
+// certificate.
+func readCABundle(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("%s holds no PEM certificate", path)
+		}
+		if block.Type == "CERTIFICATE" {
+			return data, nil
+		}
+	}
+}
+
+// valueFlags returns the --set, --values-patch, node selector, toleration,
+// bundler plugin and concurrency flags parsed by parseValueFlags.
+func valueFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name: "profile",
+			Usage: `Apply a bundle profile's curated component value presets (dev, staging, production,
+	or a profile from --data); --set and --values-patch override them`,
+		},
+		&cli.StringSliceFlag{
+			Name: "set",
+			Usage: `Override values in generated bundle files 
+	(format: bundler:path.to.field=value, e.g., --set gpuoperator:gds.enabled=true)`,
+		},
+		&cli.StringSliceFlag{
+			Name: "values-patch",
+			Usage: `Patch a component's generated values with a file holding an RFC 6902 JSON patch
+	(list of operations) or a values map merged with $merge strategies
+	(format: component=patch.yaml, can be repeated; applied in order after --set)`,
+		},
+		&cli.StringSliceFlag{
+			Name:  "system-node-selector",
+			Usage: "Node selector for system components (format: key=value, can be repeated)",
+		},
+		&cli.StringSliceFlag{
+			Name:  "system-node-toleration",
+			Usage: "Toleration for system components (format: key=value:effect, can be repeated)",
+		},
+		&cli.StringSliceFlag{
+			Name:  "accelerated-node-selector",
+			Usage: "Node selector for accelerated/GPU nodes (format: key=value, can be repeated)",
+		},
+		&cli.StringSliceFlag{
+			Name:  "accelerated-node-toleration",
+			Usage: "Toleration for accelerated/GPU nodes (format: key=value:effect, can be repeated)",
+		},
+		&cli.StringFlag{
+			Name:    "plugin-dir",
+			Usage:   "Directory of bundler plugin executables that bundle custom components over JSON stdin/stdout",
+			Sources: cli.EnvVars("EIDOS_BUNDLER_PLUGIN_DIR"),
+		},
+		&cli.DurationFlag{
+			Name:  "plugin-timeout",
+			Usage: "Timeout for each bundler plugin call",
+			Value: plugin.DefaultTimeout,
+		},
+		&cli.IntFlag{
+			Name:  "concurrency",
+			Usage: "Number of components whose values and plugin files are generated in parallel",
+			Value: 1,
+		},
+	}
 }
 
 func bundleCmd() *cli.Command {
@@ -132,7 +622,9 @@ func bundleCmd() *cli.Command {
 		EnableShellCompletion: true,
 		Usage:                 "Generate deployment bundle from a given recipe.",
 		Description: `Generates a deployment bundle from a given recipe. 
-Use --deployer argocd to generate ArgoCD Applications.
+Use --deployer argocd to generate ArgoCD Applications, --deployer argo-workflows
+to generate an Argo Workflows install pipeline, or --deployer terraform to
+generate a Terraform module.
 
 Helm:
   - Chart.yaml: Helm chart metadata with component dependencies
@@ -148,6 +640,103 @@ ArgoCD:
   - README.md: Deployment instructions
   - checksums.txt: SHA256 checksums of generated files
 
+Argo Workflows:
+  - workflow.yaml: Workflow installing components in deployment order with
+    readiness gates and automatic rollback on failure
+  - rbac.yaml: Installer ServiceAccount and ClusterRoleBinding, or namespace
+    RoleBindings when every component is namespace-scoped
+  - <component>/values.yaml: Values for each component
+  - README.md: Deployment instructions
+  - checksums.txt: SHA256 checksums of generated files
+
+With --applicationset-generator, the argocd bundle holds an ApplicationSet per
+component instead of an Application, so one GitOps repository serves many GPU
+clusters: a cluster generator deploys to the Argo CD clusters matching
+--cluster-selector, and a list generator to the clusters listed in it. Values
+in clusters/<cluster>/<component>/values.yaml, when present, override the
+component values for that cluster.
+
+When --output already holds a bundle, or --previous-bundle is set, CHANGES.md
+summarizes the version bumps and values changes per component since that
+bundle, for review when the bundle is committed to a GitOps repository.
+
+Every bundle holds recipe.lock.yaml pinning the recipe digest, the recipe data
+digest, the resolved chart version of each component and the image of each
+values path; with --resolve-image-digests, image tags are resolved to their
+manifest digests. With --locked, the bundle is only generated when everything
+resolves as in the lockfile (by default the one of the bundle in --output);
+otherwise the differences are listed and nothing is written.
+
+With --runbook, runbook.md describes a production upgrade to the bundle:
+pre-upgrade snapshot capture, one wave per component in deployment order with
+a health check after each, and helm or Argo CD rollback commands per component
+in reverse order.
+
+With --node-bootstrap, bootstrap/ holds node bootstrap artifacts applying the
+recipe's sysctl, GRUB and kernel module settings when GPU nodes are created:
+EKS launch template user data, a GKE node system configuration or an AKS
+custom node configuration, for the recipe's service.
+
+With --node-labels, node-labels/ proposes the labels and taints system and GPU
+nodes need to match the bundle's node selectors and tolerations, with
+apply-node-labels.sh applying them to existing nodes. Without
+--accelerated-node-selector and --accelerated-node-toleration, GPU nodes are
+proposed nvidia.com/gpu.workload=<intent>, nvidia.com/gpu.accelerator=<gpu>
+and the taint dedicated=gpu:NoSchedule, and GPU components are scheduled with
+them.
+
+With --image-registry-mirror, the image repositories in the component values
+(GPU Operator driver, toolkit, device plugin, DCGM, network operator OFED
+driver, and so on) point to the mirror instead of the upstream registries, and
+mirror-images.sh copies each source image to its mirror destination with
+skopeo.
+
+With --secret-backend, secrets/ holds the Secrets the components read but do
+not create (NGC pull and API key secrets, vGPU licensing configuration) as
+plain Secrets to fill in (plain), External Secrets Operator ExternalSecrets
+reading from --secret-store (eso), or a script sealing them into
+SealedSecrets with kubeseal (sealed).
+
+With --http-proxy, the component values that download packages, models or
+certificates (GPU Operator driver, network operator OFED driver, cert-manager,
+NIM) and mirror-images.sh set HTTP_PROXY, HTTPS_PROXY and NO_PROXY; in-cluster
+addresses always bypass the proxy, and --no-proxy adds more. With --ca-bundle,
+those components trust the given PEM certificates, and proxy/ca-bundle.yaml
+holds them as a ConfigMap in each component namespace.
+
+With --capacity-template, capacity/ also holds node provisioning templates for
+GPU nodes matching the recipe criteria and the accelerated node selector and
+tolerations: a Karpenter NodePool and EC2NodeClass (karpenter, the auto choice
+for EKS) or a Cluster API MachineDeployment with its machine and kubeadm
+templates (cluster-api, the auto choice for other services).
+
+With --fleet, the clusters of a Fleet inventory, each described by recipe
+criteria or a snapshot, get one bundle each in <output>/<cluster>, generated
+with the same flags (a cluster may pick its own profile). README.md lists the
+clusters with their criteria and recipes; --applicationset adds an Argo CD
+ApplicationSet installing each cluster's Helm umbrella chart on that cluster.
+
+After generation, the --set overrides that matched no existing value or no
+component are listed, since the charts may silently ignore them;
+--strict-overrides fails the bundle instead.
+
+With --install-scope component=namespace, a component whose chart supports it
+is installed without cluster-wide RBAC or resources: its namespace scope
+values are applied, and the Argo CD and Argo Workflows bundles no longer
+create its namespace. Components whose chart or manifests need cluster-wide
+access fail the bundle.
+
+With --values-schema, the final values of each component, including --set
+overrides, are checked against the values.schema.json of its chart before
+anything is written, so typos fail the bundle (error) or are reported (warn)
+instead of failing helm install. Charts are downloaded using the local Helm
+configuration, or schemas are read from --values-schema-dir when vendored.
+
+Components the recipe does not know how to bundle, such as internal charts,
+can be bundled by plugins: executables in --plugin-dir that exchange JSON
+documents over stdin/stdout. A plugin declares the components it bundles,
+returns their values, and may add files to plugins/<component>/.
+
 Examples:
 
 Generate Helm umbrella chart (default):
@@ -156,71 +745,264 @@ Generate Helm umbrella chart (default):
 Generate ArgoCD App of Apps:
   eidos bundle --recipe recipe.yaml --output ./my-bundle --deployer argocd
 
+Generate ArgoCD ApplicationSets deploying to every cluster labeled gpu=true:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --deployer argocd \
+    --applicationset-generator cluster --cluster-selector gpu=true
+
+Generate Argo Workflows install pipeline:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --deployer argo-workflows
+
+Generate Terraform module (also usable with OpenTofu):
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --deployer terraform
+
 Override values in generated bundle:
   eidos bundle --recipe recipe.yaml --set gpuoperator:driver.version=570.133.20
 
+Fail when a --set override matches no component or no existing value,
+e.g. a misspelled key:
+  eidos bundle --recipe recipe.yaml --set gpuoperator:driver.version=570.133.20 \
+    --strict-overrides
+
+Patch lists that --set cannot express, e.g. add a GPU Operator toleration:
+  eidos bundle --recipe recipe.yaml --values-patch gpu-operator=tolerations-patch.yaml
+
+Install NIM without cluster-wide RBAC into a pre-created namespace:
+  eidos bundle --recipe recipe.yaml --deployer argo-workflows --install-scope nim=namespace
+
+Enforce an allowlist of approved chart versions and driver versions:
+  eidos bundle --recipe recipe.yaml --version-policy policy.yaml
+
+Check values against the chart schemas, failing on violations:
+  eidos bundle --recipe recipe.yaml --set gpuoperator:driver.enabled=false \
+    --values-schema error
+
+Check values against vendored schemas (<dir>/<component>/<version>/values.schema.json):
+  eidos bundle --recipe recipe.yaml --values-schema warn --values-schema-dir ./schemas
+
+Pull all images from a private registry mirror (air-gapped clusters):
+  eidos bundle --recipe recipe.yaml --image-registry-mirror my.registry.local
+
+Generate ExternalSecrets for NGC credentials from a Vault-backed store:
+  eidos bundle --recipe recipe.yaml --secret-backend eso --secret-store vault
+
+Deploy behind a TLS-inspecting proxy:
+  eidos bundle --recipe recipe.yaml --http-proxy http://proxy.corp:3128 \
+    --no-proxy .corp.example.com,10.0.0.0/8 --ca-bundle corp-ca.pem
+
+Regenerate into a GitOps repository, with CHANGES.md describing the update:
+  eidos bundle --recipe recipe.yaml --output ./gitops/eidos --deployer argocd
+
+Describe changes since a published bundle:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle \
+    --previous-bundle oci://ghcr.io/nvidia/eidos-bundle:v1.0.0
+
+Regenerate a bundle, refusing if any chart version, image digest or recipe data changed:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --locked
+
+Regenerate only the GPU Operator inside an existing bundle, recomputing
+Chart.yaml dependencies and checksums:
+  eidos bundle --recipe recipe.yaml --only gpu-operator --update ./my-bundle
+
 Set node selectors for GPU workloads:
   eidos bundle --recipe recipe.yaml \
     --accelerated-node-selector nodeGroup=gpu-nodes \
     --accelerated-node-toleration nvidia.com/gpu=present:NoSchedule
 
+Generate Karpenter capacity for the GPU nodes the bundle targets:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --capacity-template auto \
+    --accelerated-node-selector nodeGroup=gpu-nodes \
+    --accelerated-node-toleration nvidia.com/gpu=present:NoSchedule
+
+Bundle internal components with plugins:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --data ./internal-data \
+    --plugin-dir /opt/eidos/bundler-plugins
+
+Generate one bundle per cluster of a fleet, with an Argo CD ApplicationSet:
+  eidos bundle --fleet fleet.yaml --output ./fleet --applicationset \
+    --repo https://github.com/my-org/gitops.git
+
 Package and push bundle to OCI registry (uses CLI version as tag):
   eidos bundle --recipe recipe.yaml --output oci://ghcr.io/nvidia/eidos-bundle
 
 Package with explicit tag (overrides CLI version):
   eidos bundle --recipe recipe.yaml --output oci://ghcr.io/nvidia/eidos-bundle:v1.0.0
+
+Sign checksums.txt (and the OCI artifact when pushing) with a key or keylessly:
+  eidos bundle --recipe recipe.yaml --output ./my-bundle --sign-key cosign.key
+  eidos bundle --recipe recipe.yaml --output oci://ghcr.io/nvidia/eidos-bundle --sign
+
+Preview the install plan without generating files:
+  eidos bundle plan --recipe recipe.yaml
+
+Verify a bundle before deploying:
+  eidos bundle verify ./my-bundle --key cosign.pub
+
+Report missing, modified and unlisted files of a bundle in CI:
+  eidos bundle verify-checksums ./my-bundle --format json
+
+Pull, verify and unpack a bundle from an OCI registry:
+  eidos bundle pull ghcr.io/nvidia/eidos-bundle:v1.0.0 --output ./my-bundle
 `,
-		Flags: []cli.Flag{
+		Commands: []*cli.Command{
+			bundleDiffCmd(),
+			bundlePlanCmd(),
+			bundlePullCmd(),
+			bundleVerifyCmd(),
+			bundleVerifyChecksumsCmd(),
+		},
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
-				Name:     "recipe",
-				Aliases:  []string{"r"},
-				Required: true,
+				Name:    "recipe",
+				Aliases: []string{"r"},
 				Usage: `Path/URI to previously generated recipe from which to build the bundle.
-	Supports: file paths, HTTP/HTTPS URLs, or ConfigMap URIs (cm://namespace/name).`,
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), Secret URIs (secret://namespace/name[#key]),
+	or object storage URIs (s3://, gs://, az://).`,
 			},
 			&cli.StringFlag{
 				Name:    "output",
 				Aliases: []string{"o"},
 				Value:   ".",
-				Usage: `Output target: local directory path or OCI registry URI.
+				Usage: `Output target: local directory path, OCI registry URI or object storage prefix.
 	For local output: ./my-bundle or /tmp/bundle
 	For OCI registry: oci://ghcr.io/nvidia/bundle:v1.0.0
-	If no tag specified, CLI version is used (e.g., oci://ghcr.io/nvidia/bundle)`,
+	If no tag specified, CLI version is used (e.g., oci://ghcr.io/nvidia/bundle)
+	For object storage: s3://bucket/prefix, gs://bucket/prefix or az://account/container/prefix`,
+			},
+			&cli.StringFlag{
+				Name:    "deployer",
+				Aliases: []string{"d"},
+				Value:   string(config.DeployerHelm),
+				Usage:   fmt.Sprintf("Deployment method (e.g. %s)", strings.Join(config.GetDeployerTypes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:  "repo",
+				Value: "",
+				Usage: "Git repository URL for ArgoCD applications (only used with --deployer argocd)",
+			},
+			&cli.StringFlag{
+				Name: "applicationset-generator",
+				Usage: fmt.Sprintf(`With --deployer argocd, generate an ApplicationSet per component instead of an
+	Application, deploying it to many clusters (%s). Values in
+	clusters/<cluster>/<component>/values.yaml override the component values per cluster.`, strings.Join(config.GetApplicationSetGenerators(), ", ")),
 			},
 			&cli.StringSliceFlag{
-				Name: "set",
-				Usage: `Override values in generated bundle files 
-	(format: bundler:path.to.field=value, e.g., --set gpuoperator:gds.enabled=true)`,
+				Name:  "cluster-selector",
+				Usage: "Argo CD cluster labels the cluster generator selects (format: key=value, repeatable)",
+			},
+			kubernetesVersionFlag,
+			&cli.StringFlag{
+				Name: "version-policy",
+				Usage: `Path/URI to a VersionPolicy file listing approved component chart versions and values.
+	Versions outside the policy fail the bundle, or are reported as warnings if the policy action is warn.`,
+			},
+			&cli.StringFlag{
+				Name: "values-schema",
+				Usage: fmt.Sprintf(`Check component values against the values.schema.json of their charts (%s).
+	error fails the bundle on violations; warn reports them.`, strings.Join(config.GetSchemaValidationModes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name: "values-schema-dir",
+				Usage: `Directory of vendored chart schemas, used instead of downloading the charts:
+	<dir>/<component>/<version>/values.schema.json or <dir>/<component>/values.schema.json.`,
+			},
+			&cli.BoolFlag{
+				Name: "strict-overrides",
+				Usage: `Fail when a --set override matches no component or no existing value in its values,
+	instead of listing it after generation.`,
 			},
 			&cli.StringSliceFlag{
-				Name:  "system-node-selector",
-				Usage: "Node selector for system components (format: key=value, can be repeated)",
+				Name: "install-scope",
+				Usage: fmt.Sprintf(`Install scope of a component (format: component=scope, scopes: %s).
+	namespace avoids cluster-wide RBAC and resources, for charts that support it.`, strings.Join(config.GetInstallScopes(), ", ")),
 			},
 			&cli.StringSliceFlag{
-				Name:  "system-node-toleration",
-				Usage: "Toleration for system components (format: key=value:effect, can be repeated)",
+				Name: "extra-manifests",
+				Usage: `Directory of raw manifests (*.yaml, *.yml) shipped with a component (format: component=dir, repeatable),
+	e.g. cluster-specific NetworkPolicies or PriorityClasses. Only used with --deployer helm or argocd.`,
+			},
+			&cli.BoolFlag{
+				Name:  "runbook",
+				Usage: "Add runbook.md with pre-upgrade snapshot, per-wave health check and rollback commands.",
+			},
+			&cli.BoolFlag{
+				Name:  "node-bootstrap",
+				Usage: "Add bootstrap/ with EKS user data, GKE or AKS node config applying the recipe's sysctl, GRUB and kernel module settings.",
+			},
+			&cli.BoolFlag{
+				Name:  "node-labels",
+				Usage: "Add node-labels/ proposing node labels and taints matching the bundle's node selectors and tolerations.",
+			},
+			&cli.StringFlag{
+				Name: "image-registry-mirror",
+				Usage: `Private registry (e.g. my.registry.local) that image repositories in the values
+	are rewritten to. Adds mirror-images.sh copying the upstream images there.`,
+			},
+			&cli.StringFlag{
+				Name: "secret-backend",
+				Usage: fmt.Sprintf(`Generate the Secrets components read in secrets/ (%s).
+	Default: none; the Secrets must be created by hand.`, strings.Join(config.GetSecretBackends(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:  "secret-store",
+				Usage: fmt.Sprintf("ClusterSecretStore the ExternalSecrets read from with --secret-backend eso (default %q).", config.DefaultSecretStore),
+			},
+			&cli.StringFlag{
+				Name: "http-proxy",
+				Usage: `Proxy URL (e.g. http://proxy.corp:3128) set as HTTP_PROXY and HTTPS_PROXY in the
+	component values and bundle scripts.`,
 			},
 			&cli.StringSliceFlag{
-				Name:  "accelerated-node-selector",
-				Usage: "Node selector for accelerated/GPU nodes (format: key=value, can be repeated)",
+				Name:  "no-proxy",
+				Usage: "Hosts, domains or CIDRs reached without --http-proxy, in addition to in-cluster addresses (comma-separated or repeated).",
+			},
+			&cli.StringFlag{
+				Name:  "ca-bundle",
+				Usage: "PEM file of CA certificates the components trust in addition to the system CAs, e.g. of a TLS-inspecting proxy.",
+			},
+			&cli.StringFlag{
+				Name: "previous-bundle",
+				Usage: `Bundle directory or OCI reference (oci://registry/repo:tag) this bundle replaces.
+	CHANGES.md lists the changes since it. Defaults to the bundle already in --output, if any.`,
+			},
+			&cli.BoolFlag{
+				Name: "locked",
+				Usage: `Refuse to generate the bundle when the recipe, recipe data, chart versions or images
+	resolve differently than in the lockfile (recipe.lock.yaml). Nothing is written on a mismatch.`,
+			},
+			&cli.StringFlag{
+				Name:  "lockfile",
+				Usage: "Lockfile checked by --locked. Defaults to recipe.lock.yaml of the bundle already in --output.",
+			},
+			&cli.BoolFlag{
+				Name:  "resolve-image-digests",
+				Usage: "Resolve the tag of every image in recipe.lock.yaml to its manifest digest in the registry.",
 			},
 			&cli.StringSliceFlag{
-				Name:  "accelerated-node-toleration",
-				Usage: "Toleration for accelerated/GPU nodes (format: key=value:effect, can be repeated)",
+				Name: "only",
+				Usage: `Components to regenerate inside the bundle given by --update (comma-separated or repeated).
+	Other components keep their versions and values from that bundle.`,
 			},
 			&cli.StringFlag{
-				Name:    "deployer",
-				Aliases: []string{"d"},
-				Value:   string(config.DeployerHelm),
-				Usage:   fmt.Sprintf("Deployment method (e.g. %s)", strings.Join(config.GetDeployerTypes(), ", ")),
+				Name:  "update",
+				Usage: "Existing bundle directory to update in place with --only. --recipe defaults to its recipe.yaml.",
 			},
 			&cli.StringFlag{
-				Name:  "repo",
-				Value: "",
-				Usage: "Git repository URL for ArgoCD applications (only used with --deployer argocd)",
+				Name: "fleet",
+				Usage: `Path/URI to a Fleet inventory listing clusters by criteria or snapshot.
+	Generates one bundle per cluster into <output>/<cluster> and a fleet README.md.`,
+			},
+			&cli.BoolFlag{
+				Name:  "applicationset",
+				Usage: "With --fleet, add applicationset.yaml deploying each cluster's bundle with Argo CD (requires --deployer helm)",
+			},
+			&cli.StringFlag{
+				Name: "capacity-template",
+				Usage: fmt.Sprintf(`Generate node provisioning templates for GPU capacity in capacity/ (%s).
+	auto picks karpenter for EKS recipes and cluster-api otherwise.`, strings.Join(config.GetCapacityTemplateTypes(), ", ")),
 			},
 			kubeconfigFlag,
 			dataFlag,
+			recipeDataFlag,
 			// OCI registry connection flags (used when --output is oci://...)
 			&cli.BoolFlag{
 				Name:  "insecure-tls",
@@ -234,21 +1016,40 @@ Package with explicit tag (overrides CLI version):
 				Name:  "image-refs",
 				Usage: "Path to file where the published image reference will be written (only used with OCI output)",
 			},
-		},
+			&cli.BoolFlag{
+				Name: "sign",
+				Usage: `Sign checksums.txt and the pushed OCI artifact keylessly with cosign
+	(Fulcio certificate via OIDC, requires cosign on PATH)`,
+			},
+			&cli.StringFlag{
+				Name: "sign-key",
+				Usage: `Sign checksums.txt and the pushed OCI artifact with a private key.
+	Supports PEM key files (ECDSA/Ed25519), cosign keys, and cosign KMS URIs (e.g. awskms:///alias/key)`,
+			},
+		}, valueFlags()...),
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			// Initialize external data provider if --data flag is set
-			if err := initDataProvider(cmd); err != nil {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
 				return fmt.Errorf("failed to initialize data provider: %w", err)
 			}
+			defer cleanup()
 
 			opts, err := parseBundleCmdOptions(cmd)
 			if err != nil {
 				return err
 			}
+			if opts.fleetPath != "" {
+				return bundleFleet(ctx, opts)
+			}
 
 			outputType := "Helm umbrella chart"
 			if opts.deployer == config.DeployerArgoCD {
 				outputType = "ArgoCD applications"
+			} else if opts.deployer == config.DeployerArgoWorkflows {
+				outputType = "Argo Workflows install pipeline"
+			} else if opts.deployer == config.DeployerTerraform {
+				outputType = "Terraform module"
 			}
 			slog.Info("generating bundle",
 				slog.String("deployer", opts.deployer.String()),
@@ -265,30 +1066,42 @@ Package with explicit tag (overrides CLI version):
 				return err
 			}
 
+			// Pull a published previous bundle so it can be compared locally
+			if strings.HasPrefix(opts.previousBundle, oci.URIScheme) {
+				dir, cleanupPrevious, pullErr := pullBundleToTemp(ctx, cmd, opts.previousBundle)
+				if pullErr != nil {
+					slog.Error("failed to pull previous bundle", "error", pullErr, "bundle", opts.previousBundle)
+					return pullErr
+				}
+				defer cleanupPrevious()
+				opts.previousBundle = dir
+			}
+
 			// Create bundler with config
-			cfg := config.NewConfig(
-				config.WithVersion(version),
-				config.WithDeployer(opts.deployer),
-				config.WithRepoURL(opts.repoURL),
-				config.WithValueOverrides(opts.valueOverrides),
-				config.WithSystemNodeSelector(opts.systemNodeSelector),
-				config.WithSystemNodeTolerations(opts.systemNodeTolerations),
-				config.WithAcceleratedNodeSelector(opts.acceleratedNodeSelector),
-				config.WithAcceleratedNodeTolerations(opts.acceleratedNodeTolerations),
+			b, err := bundler.New(
+				bundler.WithConfig(opts.bundlerConfig()),
+				bundler.WithImageDigestResolver(opts.imageDigestResolver()),
 			)
-
-			b, err := bundler.NewWithConfig(cfg)
 			if err != nil {
 				slog.Error("failed to create bundler", "error", err)
 				return err
 			}
 
-			// Generate bundle
-			out, err := b.Make(ctx, rec, opts.outputDir)
+			// Generate bundle, or regenerate only some of its components
+			var out *result.Output
+			if len(opts.only) > 0 {
+				out, err = b.Update(ctx, rec, opts.outputDir, opts.only)
+			} else {
+				out, err = b.Make(ctx, rec, opts.outputDir)
+			}
 			if err != nil {
 				slog.Error("bundle generation failed", "error", err)
 				return err
 			}
+			if err := componentFailures(out); err != nil {
+				slog.Error("bundle generation failed", "error", err)
+				return err
+			}
 
 			slog.Info("bundle generated",
 				"type", outputType,
@@ -296,10 +1109,23 @@ Package with explicit tag (overrides CLI version):
 				"size_bytes", out.TotalSize,
 				"duration_sec", out.TotalDuration.Seconds(),
 				"output_dir", out.OutputDir,
+				"recipe_digest", out.RecipeDigest,
+				"skipped_components", out.SkippedComponents,
+				"policy_warnings", len(out.PolicyWarnings),
+				"schema_warnings", len(out.SchemaWarnings),
+				"unapplied_overrides", len(unappliedOverrides(out)),
 			)
 
+			// Sign checksums before packaging so the signature ships with the bundle
+			if opts.sign.Enabled() {
+				if err := signing.SignBundle(ctx, opts.outputDir, opts.sign); err != nil {
+					slog.Error("bundle signing failed", "error", err)
+					return err
+				}
+			}
+
 			// Print deployment instructions (only for dir output)
-			if opts.ociRef == nil && out.Deployment != nil {
+			if opts.ociRef == nil && opts.objectURI == "" && out.Deployment != nil {
 				printDeploymentInstructions(out)
 			}
 
@@ -310,6 +1136,16 @@ Package with explicit tag (overrides CLI version):
 				}
 			}
 
+			// Upload the bundle files when output is s3://, gs:// or az://
+			if opts.objectURI != "" {
+				uploaded, err := objectstore.UploadDir(ctx, opts.outputDir, opts.objectURI)
+				if err != nil {
+					slog.Error("bundle upload failed", "error", err, "uri", opts.objectURI)
+					return fmt.Errorf("failed to upload bundle to %s: %w", opts.objectURI, err)
+				}
+				slog.Info("bundle uploaded", "uri", opts.objectURI, "files", len(uploaded))
+			}
+
 			return nil
 		},
 	}
@@ -317,6 +1153,11 @@ Package with explicit tag (overrides CLI version):
 
 // pushOCIBundle packages and pushes the bundle to an OCI registry.
 func pushOCIBundle(ctx context.Context, opts *bundleCmdOptions, out *result.Output) error {
+	annotations := oci.DefaultAnnotations(version)
+	if out.RecipeDigest != "" {
+		annotations[oci.AnnotationRecipeDigest] = out.RecipeDigest
+	}
+
 	pushResult, err := oci.PackageAndPush(ctx, oci.OutputConfig{
 		SourceDir:   opts.outputDir,
 		OutputDir:   opts.outputDir,
@@ -324,11 +1165,19 @@ func pushOCIBundle(ctx context.Context, opts *bundleCmdOptions, out *result.Outp
 		Version:     version,
 		PlainHTTP:   opts.plainHTTP,
 		InsecureTLS: opts.insecureTLS,
+		Annotations: annotations,
 	})
 	if err != nil {
 		return err
 	}
 
+	// Sign the pushed artifact by digest so the signature binds to its content
+	if opts.sign.Enabled() {
+		if err := signing.SignArtifact(ctx, opts.ociRef.DigestReference(pushResult.Digest), opts.sign); err != nil {
+			return err
+		}
+	}
+
 	// Update results with OCI metadata
 	for i := range out.Results {
 		if out.Results[i].Success {
@@ -348,11 +1197,61 @@ func pushOCIBundle(ctx context.Context, opts *bundleCmdOptions, out *result.Outp
 	return nil
 }
 
+// componentFailures returns an error listing the components that failed to
+// bundle, or nil if every component succeeded.
+func componentFailures(out *result.Output) error {
+	if !out.HasErrors() {
+		return nil
+	}
+	msgs := make([]string, 0, len(out.Errors))
+	for _, be := range out.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", be.BundlerType, be.Error))
+	}
+	return fmt.Errorf("failed to bundle %d component(s): %s", len(out.Errors), strings.Join(msgs, "; "))
+}
+
+// unappliedOverrides describes the --set overrides of out that were not
+// applied, or returns nil when there were no overrides.
+func unappliedOverrides(out *result.Output) []string {
+	if out.Overrides == nil {
+		return nil
+	}
+	return out.Overrides.Unapplied()
+}
+
 // printDeploymentInstructions prints user-friendly deployment instructions from the deployer.
 func printDeploymentInstructions(out *result.Output) {
 	fmt.Printf("\n%s generated successfully!\n", out.Deployment.Type)
 	fmt.Printf("Output directory: %s\n", out.OutputDir)
 	fmt.Printf("Files generated: %d\n", out.TotalFiles)
+	if len(out.SkippedComponents) > 0 {
+		fmt.Printf("Skipped disabled components: %s\n", strings.Join(out.SkippedComponents, ", "))
+	}
+	if out.ChangesFile != "" {
+		fmt.Printf("Changes since previous bundle: %s\n", out.ChangesFile)
+	}
+	if out.AuditFile != "" {
+		fmt.Printf("Audit log: %s\n", out.AuditFile)
+	}
+	if len(out.PolicyWarnings) > 0 {
+		fmt.Println("\nVersion policy warnings:")
+		for _, w := range out.PolicyWarnings {
+			fmt.Printf("  ⚠ %s\n", w)
+		}
+	}
+	if len(out.SchemaWarnings) > 0 {
+		fmt.Println("\nValues schema warnings:")
+		for _, w := range out.SchemaWarnings {
+			fmt.Printf("  ⚠ %s\n", w)
+		}
+	}
+	if out.Overrides != nil {
+		unapplied := unappliedOverrides(out)
+		fmt.Printf("\nValue overrides: %d applied, %d not applied\n", out.Overrides.AppliedCount(), len(unapplied))
+		for _, u := range unapplied {
+			fmt.Printf("  ⚠ %s\n", u)
+		}
+	}
 
 	if len(out.Deployment.Notes) > 0 {
 		fmt.Println("\nNote:")
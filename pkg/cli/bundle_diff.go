@@ -0,0 +1,142 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/diff"
+	"github.com/NVIDIA/eidos/pkg/oci"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func bundleDiffCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Compare two generated bundles semantically.",
+		ArgsUsage: "<old-bundle> <new-bundle>",
+		Description: `Compares two bundles and reports, per component, the chart version change and
+the values keys that were added, removed or changed, followed by the manifests
+that were added, removed or modified. Checksum and signature files are ignored.
+
+Each bundle is a local directory or an OCI reference (oci://registry/repo:tag
+or @digest), which is pulled into a temporary directory first. Bundles from
+the helm, argocd, argo-workflows and terraform deployers are supported.
+
+Examples:
+
+Compare two local bundles:
+  eidos bundle diff ./bundle-v1 ./bundle-v2
+
+Compare a published bundle with a local rebuild as JSON:
+  eidos bundle diff oci://ghcr.io/nvidia/eidos-bundle:v1.0.0 ./bundle --format json
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"t"},
+				Value:   planFormatText,
+				Usage:   fmt.Sprintf("output format (%s, %s, %s)", planFormatText, serializer.FormatJSON, serializer.FormatYAML),
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 2 {
+				return fmt.Errorf("expected two bundles to compare, got %d", cmd.Args().Len())
+			}
+
+			format := cmd.String("format")
+			if format != planFormatText && format != string(serializer.FormatJSON) && format != string(serializer.FormatYAML) {
+				return fmt.Errorf("unknown output format: %q, valid formats are: text, json, yaml", format)
+			}
+
+			bundles := make([]*diff.Bundle, 0, 2)
+			for _, target := range cmd.Args().Slice() {
+				b, err := loadDiffBundle(ctx, cmd, target)
+				if err != nil {
+					slog.Error("failed to load bundle", "error", err, "bundle", target)
+					return err
+				}
+				bundles = append(bundles, b)
+			}
+
+			report := diff.Compare(bundles[0], bundles[1])
+			if format == planFormatText {
+				return report.WriteText(cmd.Root().Writer)
+			}
+			return serializer.NewWriter(serializer.Format(format), cmd.Root().Writer).Serialize(ctx, report)
+		},
+	}
+}
+
+// loadDiffBundle loads a bundle from a directory, or pulls it from an OCI
+// registry into a temporary directory when target uses the oci:// scheme.
+// The returned bundle's Dir is the original target.
+func loadDiffBundle(ctx context.Context, cmd *cli.Command, target string) (*diff.Bundle, error) {
+	if !strings.HasPrefix(target, oci.URIScheme) {
+		return diff.Load(target)
+	}
+
+	dir, cleanup, err := pullBundleToTemp(ctx, cmd, target)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	b, err := diff.Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	b.Dir = target
+	return b, nil
+}
+
+// pullBundleToTemp pulls the oci:// bundle target into a temporary directory.
+// The returned cleanup function removes the directory.
+func pullBundleToTemp(ctx context.Context, cmd *cli.Command, target string) (string, func(), error) {
+	ref, err := parsePullReference(target)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid bundle reference: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "eidos-bundle-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			slog.Warn("failed to remove temporary directory", "path", tmpDir, "error", err)
+		}
+	}
+
+	if _, err := oci.Pull(ctx, oci.PullOptions{
+		Registry:    ref.Registry,
+		Repository:  ref.Repository,
+		Tag:         ref.Tag,
+		Digest:      ref.Digest,
+		OutputDir:   tmpDir,
+		PlainHTTP:   cmd.Bool("plain-http"),
+		InsecureTLS: cmd.Bool("insecure-tls"),
+	}); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to pull %s: %w", ref.String(), err)
+	}
+	return tmpDir, cleanup, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/diff"
+	"github.com/NVIDIA/eidos/pkg/oci/ocitest"
+)
+
+// runBundleDiffCmd runs "bundle diff" and returns what it wrote to stdout.
+func runBundleDiffCmd(args ...string) (string, error) {
+	var out bytes.Buffer
+	root := &cli.Command{
+		Name:     name,
+		Writer:   &out,
+		Commands: []*cli.Command{bundleCmd()},
+	}
+	err := root.Run(context.Background(), append([]string{name, "bundle", "diff"}, args...))
+	return out.String(), err
+}
+
+func TestBundleDiffCmd(t *testing.T) {
+	oldDir := t.TempDir()
+	newDir := t.TempDir()
+	for dir, version := range map[string]string{oldDir: "570.133.20", newDir: "580.82.07"} {
+		if err := os.MkdirAll(filepath.Join(dir, "gpu-operator"), 0o755); err != nil {
+			t.Fatalf("failed to create component directory: %v", err)
+		}
+		values := "driver:\n  version: " + version + "\n"
+		if err := os.WriteFile(filepath.Join(dir, "gpu-operator", "values.yaml"), []byte(values), 0o600); err != nil {
+			t.Fatalf("failed to write values: %v", err)
+		}
+	}
+
+	t.Run("text", func(t *testing.T) {
+		out, err := runBundleDiffCmd(oldDir, newDir)
+		if err != nil {
+			t.Fatalf("bundle diff error = %v", err)
+		}
+		if !strings.Contains(out, "~ driver.version: 570.133.20 -> 580.82.07") {
+			t.Errorf("unexpected output:\n%s", out)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := runBundleDiffCmd(oldDir, newDir, "--format", "json")
+		if err != nil {
+			t.Fatalf("bundle diff error = %v", err)
+		}
+		var r diff.Report
+		if err := json.Unmarshal([]byte(out), &r); err != nil {
+			t.Fatalf("output is not a JSON report: %v\n%s", err, out)
+		}
+		if len(r.Components) != 1 || r.Components[0].Name != "gpu-operator" {
+			t.Errorf("unexpected components: %+v", r.Components)
+		}
+	})
+
+	t.Run("oci reference", func(t *testing.T) {
+		reg := ocitest.NewRegistry(t)
+		reg.PushBundle(t, "nvidia/bundle", "v1", map[string]string{
+			"gpu-operator/values.yaml": "driver:\n  version: 570.133.20\n",
+		})
+
+		out, err := runBundleDiffCmd("oci://"+reg.Reference("nvidia/bundle", "v1"), newDir, "--plain-http")
+		if err != nil {
+			t.Fatalf("bundle diff error = %v", err)
+		}
+		if !strings.Contains(out, "~ driver.version: 570.133.20 -> 580.82.07") {
+			t.Errorf("unexpected output:\n%s", out)
+		}
+	})
+
+	t.Run("wrong argument count", func(t *testing.T) {
+		if _, err := runBundleDiffCmd(oldDir); err == nil {
+			t.Error("expected error for a single bundle")
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if _, err := runBundleDiffCmd(oldDir, newDir, "--format", "xml"); err == nil {
+			t.Error("expected error for unknown format")
+		}
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/fleet"
+	"github.com/NVIDIA/eidos/pkg/bundler/signing"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+// validateFleetFlags rejects flags that do not apply to fleet bundles.
+func (opts *bundleCmdOptions) validateFleetFlags(cmd *cli.Command) error {
+	if opts.recipeFilePath != "" {
+		return fmt.Errorf("--fleet cannot be combined with --recipe: cluster recipes are built from the fleet")
+	}
+	if opts.updateDir != "" {
+		return fmt.Errorf("--fleet cannot be combined with --update and --only")
+	}
+	if opts.previousBundle != "" {
+		return fmt.Errorf("--fleet cannot be combined with --previous-bundle: each cluster bundle is compared with the one already in its directory")
+	}
+	if cmd.String("image-refs") != "" {
+		return fmt.Errorf("--image-refs requires an oci:// --output and cannot be combined with --fleet")
+	}
+	if opts.applicationSet {
+		deployer := cmd.String("deployer")
+		if deployer != "" && deployer != string(config.DeployerHelm) {
+			return fmt.Errorf("--applicationset deploys Helm umbrella charts and requires --deployer helm")
+		}
+	}
+	return nil
+}
+
+// bundleFleet generates one bundle per cluster of the fleet inventory into
+// <output>/<cluster>, then the fleet README and ApplicationSet.
+func bundleFleet(ctx context.Context, opts *bundleCmdOptions) error {
+	f, err := fleet.Load(opts.fleetPath, opts.kubeconfig)
+	if err != nil {
+		return err
+	}
+	if opts.applicationSet {
+		if err := fleet.ValidateApplicationSet(f); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("generating fleet bundles",
+		slog.String("fleet", f.Name()),
+		slog.Int("clusters", len(f.Spec.Clusters)),
+		slog.String("deployer", opts.deployer.String()),
+		slog.String("output", opts.outputDir),
+	)
+
+	builder := recipe.NewBuilder(recipe.WithVersion(version))
+	bundles := make([]fleet.ClusterBundle, 0, len(f.Spec.Clusters))
+	for _, c := range f.Spec.Clusters {
+		rec, err := buildClusterRecipe(ctx, builder, c)
+		if err != nil {
+			return fmt.Errorf("cluster %s: error building recipe: %w", c.Name, err)
+		}
+
+		clusterOpts := *opts
+		if c.Profile != "" {
+			clusterOpts.profile = c.Profile
+		}
+		b, err := bundler.New(
+			bundler.WithConfig(clusterOpts.bundlerConfig()),
+			bundler.WithImageDigestResolver(clusterOpts.imageDigestResolver()),
+		)
+		if err != nil {
+			return fmt.Errorf("cluster %s: %w", c.Name, err)
+		}
+
+		dir := filepath.Join(opts.outputDir, c.Name)
+		out, err := b.Make(ctx, rec, dir)
+		if err != nil {
+			return fmt.Errorf("cluster %s: bundle generation failed: %w", c.Name, err)
+		}
+		if err := componentFailures(out); err != nil {
+			return fmt.Errorf("cluster %s: %w", c.Name, err)
+		}
+
+		if opts.sign.Enabled() {
+			if err := signing.SignBundle(ctx, dir, opts.sign); err != nil {
+				return fmt.Errorf("cluster %s: bundle signing failed: %w", c.Name, err)
+			}
+		}
+
+		slog.Info("cluster bundle generated",
+			"cluster", c.Name,
+			"criteria", rec.Criteria.String(),
+			"files", out.TotalFiles,
+			"output_dir", out.OutputDir,
+			"unapplied_overrides", len(unappliedOverrides(out)),
+		)
+		bundles = append(bundles, fleet.ClusterBundle{Cluster: c, Recipe: rec, Output: out})
+	}
+
+	genOut, err := fleet.NewGenerator().Generate(ctx, &fleet.GeneratorInput{
+		Fleet:          f,
+		Bundles:        bundles,
+		Version:        version,
+		RepoURL:        opts.repoURL,
+		ApplicationSet: opts.applicationSet,
+	}, opts.outputDir)
+	if err != nil {
+		return err
+	}
+
+	printFleetSummary(f, bundles, genOut, opts.outputDir)
+	return nil
+}
+
+// buildClusterRecipe builds the recipe of a fleet cluster from its criteria,
+// or from the criteria detected in its snapshot with the recipe constraints
+// evaluated against that snapshot.
+func buildClusterRecipe(ctx context.Context, builder *recipe.Builder, c fleet.Cluster) (*recipe.RecipeResult, error) {
+	if c.Snapshot == "" {
+		slog.Info("building cluster recipe from criteria", "cluster", c.Name, "criteria", c.Criteria.String())
+		return builder.BuildFromCriteria(ctx, c.Criteria)
+	}
+
+	slog.Info("loading cluster snapshot", "cluster", c.Name, "uri", c.Snapshot)
+	snap, err := serializer.FromFileWithKubeconfig[snapshotter.Snapshot](c.Snapshot, c.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot from %q: %w", c.Snapshot, err)
+	}
+	criteria, _ := recipe.ExtractCriteriaFromSnapshot(snap)
+
+	slog.Info("building cluster recipe from snapshot", "cluster", c.Name, "criteria", criteria.String())
+	result, err := builder.BuildFromCriteriaWithEvaluator(ctx, criteria, snapshotEvaluator(snap))
+	if result != nil {
+		logConstraintWarnings(c.Name, result)
+	}
+	return result, err
+}
+
+// printFleetSummary prints the cluster bundles and how to deploy them.
+func printFleetSummary(f *fleet.Fleet, bundles []fleet.ClusterBundle, out *fleet.GeneratorOutput, outputDir string) {
+	fmt.Printf("\nFleet %s generated successfully!\n", f.Name())
+	fmt.Printf("Output directory: %s\n", outputDir)
+	fmt.Printf("Clusters: %d\n", len(bundles))
+	for _, b := range bundles {
+		fmt.Printf("  %s: %s, %d files (%s)\n", b.Cluster.Name, b.Recipe.Criteria.String(), b.Output.TotalFiles, b.Output.OutputDir)
+		for _, u := range unappliedOverrides(b.Output) {
+			fmt.Printf("    ⚠ %s\n", u)
+		}
+	}
+
+	fmt.Println("\nTo deploy:")
+	fmt.Printf("  Follow <cluster>/README.md in %s for each cluster\n", outputDir)
+	for _, file := range out.Files {
+		if filepath.Base(file) == fleet.ApplicationSetFileName {
+			fmt.Printf("  Or commit %s to Git and run: kubectl apply -n argocd -f %s\n", outputDir, file)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testFleet = `kind: Fleet
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  name: test-fleet
+spec:
+  clusters:
+    - name: training
+      criteria:
+        service: eks
+        intent: training
+      server: https://training.example.com
+      profile: production
+    - name: inference
+      criteria:
+        service: gke
+        intent: inference
+      server: https://inference.example.com
+`
+
+func TestBundleCmd_Fleet(t *testing.T) {
+	dir := t.TempDir()
+	fleetPath := filepath.Join(dir, "fleet.yaml")
+	if err := os.WriteFile(fleetPath, []byte(testFleet), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("invalid flags", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			args    []string
+			wantErr string
+		}{
+			{"with recipe", []string{"--fleet", fleetPath, "--recipe", "recipe.yaml"}, "cannot be combined with --recipe"},
+			{"oci output", []string{"--fleet", fleetPath, "--output", "oci://ghcr.io/nvidia/bundle"}, "local --output directory"},
+			{"applicationset without fleet", []string{"--recipe", "recipe.yaml", "--applicationset"}, "requires --fleet"},
+			{"applicationset with argocd", []string{"--fleet", fleetPath, "--applicationset", "--deployer", "argocd"}, "requires --deployer helm"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := runBundleCmd(tt.args...)
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("generates cluster bundles", func(t *testing.T) {
+		out := filepath.Join(dir, "out")
+		if err := runBundleCmd("--fleet", fleetPath, "--output", out, "--applicationset"); err != nil {
+			t.Fatalf("bundle --fleet error = %v", err)
+		}
+
+		for _, name := range []string{
+			"README.md",
+			"applicationset.yaml",
+			filepath.Join("training", "Chart.yaml"),
+			filepath.Join("inference", "Chart.yaml"),
+		} {
+			if _, err := os.Stat(filepath.Join(out, name)); err != nil {
+				t.Errorf("expected %s: %v", name, err)
+			}
+		}
+
+		manifest, err := os.ReadFile(filepath.Join(out, "training", "bundle.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(manifest), "profile: production") {
+			t.Errorf("training bundle should use the cluster profile:\n%s", manifest)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+// planFormatText is the default human-readable plan format.
+const planFormatText = "text"
+
+func bundlePlanCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "plan",
+		Usage: "Print the ordered install plan for a recipe without generating files.",
+		Description: `Shows what 'eidos bundle' would install for a recipe: components in
+deployment order with their namespaces, chart versions, value overrides and the
+CRDs they install. Disabled components are listed as skipped.
+
+The plan honors the same --deployer, --set and node scheduling flags as
+'eidos bundle', so it matches the bundle that would be generated.
+
+Examples:
+
+Print the plan for the default Helm deployer:
+  eidos bundle plan --recipe recipe.yaml
+
+Print the plan for ArgoCD as JSON for review tooling:
+  eidos bundle plan --recipe recipe.yaml --deployer argocd --format json
+
+Include value overrides:
+  eidos bundle plan --recipe recipe.yaml --set gpuoperator:driver.version=580.82.07
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"t"},
+				Value:   planFormatText,
+				Usage:   fmt.Sprintf("output format (%s, %s, %s)", planFormatText, serializer.FormatJSON, serializer.FormatYAML),
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			format := cmd.String("format")
+			if format != planFormatText && format != string(serializer.FormatJSON) && format != string(serializer.FormatYAML) {
+				return fmt.Errorf("unknown output format: %q, valid formats are: text, json, yaml", format)
+			}
+
+			// Initialize external data provider if --data flag is set
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize data provider: %w", err)
+			}
+			defer cleanup()
+
+			opts, err := parseBundleCmdOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			rec, err := serializer.FromFileWithKubeconfig[recipe.RecipeResult](opts.recipeFilePath, opts.kubeconfig)
+			if err != nil {
+				slog.Error("failed to load recipe file", "error", err, "path", opts.recipeFilePath)
+				return err
+			}
+
+			b, err := bundler.NewWithConfig(opts.bundlerConfig())
+			if err != nil {
+				slog.Error("failed to create bundler", "error", err)
+				return err
+			}
+
+			p, err := b.Plan(ctx, rec)
+			if err != nil {
+				slog.Error("plan generation failed", "error", err)
+				return err
+			}
+
+			if format == planFormatText {
+				return p.WriteText(cmd.Root().Writer)
+			}
+			return serializer.NewWriter(serializer.Format(format), cmd.Root().Writer).Serialize(ctx, p)
+		},
+	}
+}
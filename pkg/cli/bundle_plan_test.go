@@ -0,0 +1,109 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/plan"
+)
+
+const planTestRecipe = `kind: recipeResult
+apiVersion: eidos.nvidia.com/v1alpha1
+componentRefs:
+  - name: cert-manager
+    type: Helm
+    source: https://charts.jetstack.io
+    version: v1.17.2
+  - name: gpu-operator
+    type: Helm
+    source: https://helm.ngc.nvidia.com/nvidia
+    version: v25.3.3
+    dependencyRefs: [cert-manager]
+deploymentOrder: [cert-manager, gpu-operator]
+`
+
+// runBundlePlanCmd runs "bundle plan" and returns what it wrote to stdout.
+func runBundlePlanCmd(args ...string) (string, error) {
+	var out bytes.Buffer
+	root := &cli.Command{
+		Name:     name,
+		Writer:   &out,
+		Commands: []*cli.Command{bundleCmd()},
+	}
+	err := root.Run(context.Background(), append([]string{name, "bundle", "plan"}, args...))
+	return out.String(), err
+}
+
+func TestBundlePlanCmd(t *testing.T) {
+	recipePath := filepath.Join(t.TempDir(), "recipe.yaml")
+	if err := os.WriteFile(recipePath, []byte(planTestRecipe), 0600); err != nil {
+		t.Fatalf("failed to write recipe: %v", err)
+	}
+	outputDir := t.TempDir()
+
+	t.Run("json", func(t *testing.T) {
+		out, err := runBundlePlanCmd("--recipe", recipePath, "--format", "json", "--set", "gpuoperator:gds.enabled=true")
+		if err != nil {
+			t.Fatalf("bundle plan error = %v", err)
+		}
+
+		var p plan.Plan
+		if err := json.Unmarshal([]byte(out), &p); err != nil {
+			t.Fatalf("output is not a JSON plan: %v\n%s", err, out)
+		}
+		if len(p.Components) != 2 || p.Components[0].Name != "cert-manager" {
+			t.Errorf("unexpected components: %+v", p.Components)
+		}
+		if len(p.Components[1].Overrides) != 1 || p.Components[1].Overrides[0].Source != plan.SourceSet {
+			t.Errorf("expected --set override in plan, got %+v", p.Components[1].Overrides)
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		out, err := runBundlePlanCmd("--recipe", recipePath, "--output", outputDir)
+		if err != nil {
+			t.Fatalf("bundle plan error = %v", err)
+		}
+		if !bytes.Contains([]byte(out), []byte("2. gpu-operator")) {
+			t.Errorf("unexpected text plan:\n%s", out)
+		}
+
+		// plan must not write any bundle files
+		entries, err := os.ReadDir(outputDir)
+		if err != nil {
+			t.Fatalf("failed to read output dir: %v", err)
+		}
+		if len(entries) != 0 {
+			t.Errorf("bundle plan wrote %d files, want none", len(entries))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := runBundlePlanCmd(); err == nil {
+			t.Error("expected error without --recipe")
+		}
+		if _, err := runBundlePlanCmd("--recipe", recipePath, "--format", "table"); err == nil {
+			t.Error("expected error for unsupported format")
+		}
+	})
+}
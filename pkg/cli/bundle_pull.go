@@ -0,0 +1,182 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/signing"
+	"github.com/NVIDIA/eidos/pkg/oci"
+)
+
+// parsePullReference parses a bundle reference with or without the oci:// scheme.
+// A tag or digest is required so pulls are never ambiguous.
+func parsePullReference(target string) (*oci.Reference, error) {
+	if !strings.HasPrefix(target, oci.URIScheme) {
+		target = oci.URIScheme + target
+	}
+
+	ref, err := oci.ParseOutputTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		return nil, fmt.Errorf("reference %q must include a tag or digest", target)
+	}
+	return ref, nil
+}
+
+// stagePullDir checks that outputDir is missing or empty and creates a
+// temporary directory beside it to pull into, so the rename that moves the
+// verified bundle into place stays on one filesystem.
+func stagePullDir(outputDir string) (string, error) {
+	entries, err := os.ReadDir(outputDir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read output directory: %w", err)
+	}
+	if len(entries) > 0 {
+		return "", fmt.Errorf("output directory '%s' is not empty", outputDir)
+	}
+
+	parent := filepath.Dir(outputDir)
+	if err := os.MkdirAll(parent, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create output directory: %w", err)
+	}
+	staging, err := os.MkdirTemp(parent, "."+filepath.Base(outputDir)+".pull-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	return staging, nil
+}
+
+// commitPullDir moves the verified bundle from staging to outputDir,
+// replacing outputDir if it exists and is empty.
+func commitPullDir(staging, outputDir string) error {
+	if err := os.Chmod(staging, 0o755); err != nil {
+		return fmt.Errorf("failed to set output directory permissions: %w", err)
+	}
+	if err := os.Remove(outputDir); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to replace output directory: %w", err)
+	}
+	if err := os.Rename(staging, outputDir); err != nil {
+		return fmt.Errorf("failed to move bundle into place: %w", err)
+	}
+	return nil
+}
+
+func bundlePullCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "pull",
+		Usage:     "Pull, verify and unpack a bundle from an OCI registry.",
+		ArgsUsage: "<registry/repository:tag|registry/repository@digest>",
+		Description: `Pulls a bundle pushed with 'eidos bundle --output oci://...' and unpacks it
+into a local directory ready for deployment.
+
+Every blob is verified against its digest while downloading. After unpacking,
+every file listed in checksums.txt is re-hashed, and if the bundle is signed
+the checksums.txt signature is verified with --key or
+--certificate-identity/--certificate-oidc-issuer.
+
+The bundle is pulled and verified in a temporary directory beside the output
+directory, and moved into place only once verification succeeds; nothing is
+left behind when either fails. The output directory must not exist or be
+empty. It defaults to the last segment of the repository name.
+
+Examples:
+
+Pull by tag:
+  eidos bundle pull ghcr.io/nvidia/eidos-bundle:v1.0.0 --output ./my-bundle
+
+Pull pinned by digest and verify a key-signed bundle:
+  eidos bundle pull ghcr.io/nvidia/eidos-bundle@sha256:... --key cosign.pub
+
+Deploy the pulled Helm bundle:
+  helm dependency update ./my-bundle && helm install eidos ./my-bundle
+`,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "Directory to unpack the bundle into (default: repository name)",
+			},
+		}, verificationFlags()...),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 1 {
+				return fmt.Errorf("expected exactly one OCI reference, got %d", cmd.Args().Len())
+			}
+
+			ref, err := parsePullReference(cmd.Args().First())
+			if err != nil {
+				return fmt.Errorf("invalid bundle reference: %w", err)
+			}
+
+			verifyOpts := parseVerifyOptions(cmd)
+			if err := verifyOpts.Validate(); err != nil {
+				return err
+			}
+
+			outputDir := cmd.String("output")
+			if outputDir == "" {
+				outputDir = path.Base(ref.Repository)
+			}
+			outputDir, err = filepath.Abs(outputDir)
+			if err != nil {
+				return fmt.Errorf("failed to resolve output directory: %w", err)
+			}
+
+			staging, err := stagePullDir(outputDir)
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(staging)
+
+			pullResult, err := oci.Pull(ctx, oci.PullOptions{
+				Registry:    ref.Registry,
+				Repository:  ref.Repository,
+				Tag:         ref.Tag,
+				Digest:      ref.Digest,
+				OutputDir:   staging,
+				PlainHTTP:   cmd.Bool("plain-http"),
+				InsecureTLS: cmd.Bool("insecure-tls"),
+			})
+			if err != nil {
+				slog.Error("bundle pull failed", "error", err, "reference", ref.String())
+				return err
+			}
+
+			result, err := signing.VerifyBundle(ctx, staging, verifyOpts)
+			if err != nil {
+				slog.Error("pulled bundle failed verification", "error", err, "reference", ref.String())
+				return err
+			}
+
+			if err := commitPullDir(staging, outputDir); err != nil {
+				return err
+			}
+
+			fmt.Printf("Pulled %s (%s)\n", pullResult.Reference, pullResult.Digest)
+			printVerifyResult(outputDir, result)
+			return nil
+		},
+	}
+}
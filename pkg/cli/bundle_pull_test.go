@@ -0,0 +1,126 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/oci/ocitest"
+)
+
+func TestParsePullReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	tests := []struct {
+		name     string
+		input    string
+		wantRepo string
+		wantTag  string
+		wantDig  string
+		wantErr  bool
+	}{
+		{name: "without scheme", input: "ghcr.io/nvidia/bundle:v1.0.0", wantRepo: "nvidia/bundle", wantTag: "v1.0.0"},
+		{name: "with scheme", input: "oci://ghcr.io/nvidia/bundle:v1.0.0", wantRepo: "nvidia/bundle", wantTag: "v1.0.0"},
+		{name: "by digest", input: "ghcr.io/nvidia/bundle@" + digest, wantRepo: "nvidia/bundle", wantDig: digest},
+		{name: "missing tag", input: "ghcr.io/nvidia/bundle", wantErr: true},
+		{name: "invalid", input: "ghcr.io/INVALID:v1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := parsePullReference(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePullReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if ref.Registry != "ghcr.io" || ref.Repository != tt.wantRepo || ref.Tag != tt.wantTag || ref.Digest != tt.wantDig {
+				t.Errorf("parsePullReference() = %+v", ref)
+			}
+		})
+	}
+}
+
+func TestBundlePullCmd_Errors(t *testing.T) {
+	if err := runBundleCmd("pull"); err == nil {
+		t.Error("expected error without reference")
+	}
+
+	err := runBundleCmd("pull", "ghcr.io/nvidia/bundle", "--output", t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "tag or digest") {
+		t.Errorf("expected missing tag error, got %v", err)
+	}
+
+	err = runBundleCmd("pull", "ghcr.io/nvidia/bundle:v1", "--certificate-identity", "user@example.com")
+	if err == nil || !strings.Contains(err.Error(), "OIDC issuer") {
+		t.Errorf("expected incomplete identity error, got %v", err)
+	}
+}
+
+func TestBundlePullCmd_Registry(t *testing.T) {
+	// Build a bundle with checksums.txt so the pulled copy passes verification.
+	srcDir := t.TempDir()
+	values := filepath.Join(srcDir, "values.yaml")
+	if err := os.WriteFile(values, []byte("a: 1\n"), 0o600); err != nil {
+		t.Fatalf("failed to write values: %v", err)
+	}
+	if err := checksum.GenerateChecksums(context.Background(), srcDir, []string{values}); err != nil {
+		t.Fatalf("GenerateChecksums() error = %v", err)
+	}
+	files := make(map[string]string)
+	for _, name := range []string{"values.yaml", checksum.ChecksumFileName} {
+		data, err := os.ReadFile(filepath.Join(srcDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[name] = string(data)
+	}
+
+	reg := ocitest.NewRegistry(t)
+	reg.PushBundle(t, "nvidia/bundle", "v1", files)
+
+	outDir := filepath.Join(t.TempDir(), "pulled")
+	if err := runBundleCmd("pull", reg.Reference("nvidia/bundle", "v1"), "--plain-http", "--output", outDir); err != nil {
+		t.Fatalf("bundle pull error = %v", err)
+	}
+	if got, err := os.ReadFile(filepath.Join(outDir, "values.yaml")); err != nil || string(got) != "a: 1\n" {
+		t.Errorf("pulled values.yaml = %q, %v", got, err)
+	}
+
+	// A non-empty output directory is refused before pulling.
+	err := runBundleCmd("pull", reg.Reference("nvidia/bundle", "v1"), "--plain-http", "--output", outDir)
+	if err == nil || !strings.Contains(err.Error(), "not empty") {
+		t.Errorf("expected non-empty output directory error, got %v", err)
+	}
+
+	// A tampered bundle fails checksum verification after the pull and
+	// leaves neither the output nor the staging directory behind.
+	files["values.yaml"] = "a: 2\n"
+	reg.PushBundle(t, "nvidia/bundle", "tampered", files)
+	parent := t.TempDir()
+	err = runBundleCmd("pull", reg.Reference("nvidia/bundle", "tampered"), "--plain-http", "--output", filepath.Join(parent, "bad"))
+	if err == nil {
+		t.Error("expected verification failure for tampered bundle")
+	}
+	if entries, err := os.ReadDir(parent); err != nil || len(entries) != 0 {
+		t.Errorf("failed pull left %v behind (err %v)", entries, err)
+	}
+}
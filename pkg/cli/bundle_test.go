@@ -15,10 +15,16 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/recipe"
 )
 
 func TestParseSetFlags(t *testing.T) {
@@ -165,6 +171,13 @@ func TestBundleCmd(t *testing.T) {
 		}
 	}
 
+	// Verify signing flags exist
+	for _, flag := range []string{"sign", "sign-key"} {
+		if !flagNames[flag] {
+			t.Errorf("expected flag %q to be defined", flag)
+		}
+	}
+
 	// Verify node selector/toleration flags exist
 	nodeFlags := []string{
 		"system-node-selector",
@@ -186,3 +199,304 @@ func TestBundleCmd(t *testing.T) {
 		}
 	}
 }
+
+func TestBundleCmd_Update(t *testing.T) {
+	writeRecipe := func(t *testing.T, gpuVersion string) string {
+		t.Helper()
+		data, err := yaml.Marshal(&recipe.RecipeResult{
+			APIVersion: "eidos.nvidia.com/v1alpha1",
+			Kind:       "Recipe",
+			ComponentRefs: []recipe.ComponentRef{
+				{Name: "cert-manager", Version: "v1.17.2", Type: "helm", Source: "https://charts.jetstack.io"},
+				{Name: "gpu-operator", Version: gpuVersion, Type: "helm", Source: "https://helm.ngc.nvidia.com/nvidia"},
+			},
+			DeploymentOrder: []string{"cert-manager", "gpu-operator"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(t.TempDir(), "recipe.yaml")
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("invalid flags", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			args    []string
+			wantErr string
+		}{
+			{"only without update", []string{"--recipe", "recipe.yaml", "--only", "gpu-operator"}, "must be used together"},
+			{"update without only", []string{"--recipe", "recipe.yaml", "--update", "./bundle"}, "must be used together"},
+			{"update with output", []string{"--only", "gpu-operator", "--update", "./bundle", "--output", "./other"}, "cannot be combined with --output"},
+			{"no recipe in bundle", []string{"--only", "gpu-operator", "--update", t.TempDir()}, "recipe"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := runBundleCmd(tt.args...)
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("regenerates component", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "bundle")
+		if err := runBundleCmd("--recipe", writeRecipe(t, "v25.3.3"), "--output", dir); err != nil {
+			t.Fatalf("bundle error = %v", err)
+		}
+		if err := runBundleCmd("--recipe", writeRecipe(t, "v25.10.1"), "--only", "gpu-operator", "--update", dir); err != nil {
+			t.Fatalf("bundle --update error = %v", err)
+		}
+
+		chart, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(chart), "25.10.1") {
+			t.Errorf("Chart.yaml not updated:\n%s", chart)
+		}
+
+		// Without --recipe the bundle's own recipe.yaml is used
+		if err := runBundleCmd("--only", "cert-manager", "--update", dir); err != nil {
+			t.Fatalf("bundle --update without --recipe error = %v", err)
+		}
+	})
+}
+
+func TestBundleCmd_Locked(t *testing.T) {
+	writeRecipe := func(t *testing.T, gpuVersion string) string {
+		t.Helper()
+		data, err := yaml.Marshal(&recipe.RecipeResult{
+			APIVersion: "eidos.nvidia.com/v1alpha1",
+			Kind:       "Recipe",
+			ComponentRefs: []recipe.ComponentRef{
+				{Name: "gpu-operator", Version: gpuVersion, Type: "helm", Source: "https://helm.ngc.nvidia.com/nvidia"},
+			},
+			DeploymentOrder: []string{"gpu-operator"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(t.TempDir(), "recipe.yaml")
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("invalid flags", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			args    []string
+			wantErr string
+		}{
+			{"lockfile without locked", []string{"--lockfile", "recipe.lock.yaml"}, "--lockfile requires --locked"},
+			{"no lockfile in output", []string{"--locked", "--output", t.TempDir()}, "requires an existing lockfile"},
+			{"remote output", []string{"--locked", "--output", "oci://ghcr.io/nvidia/bundle:v1"}, "requires --lockfile"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := runBundleCmd(append([]string{"--recipe", "recipe.yaml"}, tt.args...)...)
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("enforces lockfile", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "bundle")
+		if err := runBundleCmd("--recipe", writeRecipe(t, "v25.3.3"), "--output", dir); err != nil {
+			t.Fatalf("bundle error = %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "recipe.lock.yaml")); err != nil {
+			t.Fatalf("lockfile not written: %v", err)
+		}
+		if err := runBundleCmd("--recipe", writeRecipe(t, "v25.3.3"), "--output", dir, "--locked"); err != nil {
+			t.Fatalf("bundle --locked error = %v", err)
+		}
+
+		err := runBundleCmd("--recipe", writeRecipe(t, "v25.10.1"), "--output", dir, "--locked")
+		if err == nil || !strings.Contains(err.Error(), "component gpu-operator changed") {
+			t.Errorf("bundle --locked error = %v, want component change", err)
+		}
+		chart, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(chart), "25.10.1") {
+			t.Errorf("Chart.yaml regenerated despite the lockfile:\n%s", chart)
+		}
+	})
+}
+
+func TestBundleCmd_ObjectStoreOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+	}{
+		{"s3 without bucket", "s3://"},
+		{"azure without container", "az://account"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runBundleCmd("--recipe", "recipe.yaml", "--output", tt.output)
+			if err == nil || !strings.Contains(err.Error(), "invalid --output value") {
+				t.Errorf("error = %v, want invalid --output value", err)
+			}
+		})
+	}
+}
+
+func TestBundleCmd_ValuesSchemaFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"invalid mode", []string{"--recipe", "recipe.yaml", "--values-schema", "strict"}, "invalid --values-schema value"},
+		{"dir without mode", []string{"--recipe", "recipe.yaml", "--values-schema-dir", "./schemas"}, "requires --values-schema"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runBundleCmd(tt.args...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBundleCmd_ProxyFlags(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"proxy without scheme", []string{"--http-proxy", "proxy.corp:3128"}, "expected an http:// or https:// URL"},
+		{"proxy with quote", []string{"--http-proxy", "http://proxy.corp:3128/'"}, "must not contain quotes"},
+		{"no-proxy without proxy", []string{"--no-proxy", ".corp"}, "--no-proxy requires --http-proxy"},
+		{"missing CA bundle", []string{"--ca-bundle", filepath.Join(dir, "missing.pem")}, "failed to read"},
+		{"CA bundle without certificate", []string{"--ca-bundle", notPEM}, "holds no PEM certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runBundleCmd(append([]string{"--recipe", "recipe.yaml"}, tt.args...)...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBundleCmd_ValuesPatchFlag(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(invalid, []byte("- op: merge\n  path: /a\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{"missing component", "patch.yaml", "expected 'component=path'"},
+		{"missing file", "gpu-operator=" + filepath.Join(dir, "missing.yaml"), "failed to read patch for gpu-operator"},
+		{"invalid patch", "gpu-operator=" + invalid, "unsupported operation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runBundleCmd("--recipe", "recipe.yaml", "--values-patch", tt.spec)
+			if err == nil || !strings.Contains(err.Error(), "invalid --values-patch flag") || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBundleCmd_StrictOverrides(t *testing.T) {
+	data, err := yaml.Marshal(&recipe.RecipeResult{
+		APIVersion: "eidos.nvidia.com/v1alpha1",
+		Kind:       "Recipe",
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "cert-manager", Version: "v1.17.2", Type: "helm", Source: "https://charts.jetstack.io"},
+		},
+		DeploymentOrder: []string{"cert-manager"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipePath := filepath.Join(t.TempDir(), "recipe.yaml")
+	if err := os.WriteFile(recipePath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"--recipe", recipePath, "--set", "certmanagr:installCRDs=true"}
+
+	if err := runBundleCmd(append(args, "--output", t.TempDir())...); err != nil {
+		t.Fatalf("bundle without --strict-overrides error = %v", err)
+	}
+	err = runBundleCmd(append(args, "--output", t.TempDir(), "--strict-overrides")...)
+	if err == nil || !strings.Contains(err.Error(), "certmanagr: matches no component") {
+		t.Errorf("bundle --strict-overrides error = %v, want unused override", err)
+	}
+}
+
+func TestParseInstallScopes(t *testing.T) {
+	scopes, err := parseInstallScopes([]string{"nim=namespace", "gpu-operator=cluster"})
+	if err != nil {
+		t.Fatalf("parseInstallScopes() error = %v", err)
+	}
+	want := map[string]config.InstallScope{
+		"nim":          config.InstallScopeNamespace,
+		"gpu-operator": config.InstallScopeCluster,
+	}
+	if !reflect.DeepEqual(scopes, want) {
+		t.Errorf("parseInstallScopes() = %v, want %v", scopes, want)
+	}
+
+	for _, spec := range []string{"nim", "=namespace", "nim=", "nim=tenant"} {
+		if _, err := parseInstallScopes([]string{spec}); err == nil {
+			t.Errorf("parseInstallScopes(%q) should fail", spec)
+		}
+	}
+}
+
+func TestParseExtraManifests(t *testing.T) {
+	dir := t.TempDir()
+	dirs, err := parseExtraManifests([]string{"gpu-operator=" + dir, "nim=" + dir, "gpu-operator=" + dir})
+	if err != nil {
+		t.Fatalf("parseExtraManifests() error = %v", err)
+	}
+	want := map[string][]string{"gpu-operator": {dir, dir}, "nim": {dir}}
+	if !reflect.DeepEqual(dirs, want) {
+		t.Errorf("parseExtraManifests() = %v, want %v", dirs, want)
+	}
+
+	file := filepath.Join(dir, "netpol.yaml")
+	if err := os.WriteFile(file, []byte("kind: NetworkPolicy\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, spec := range []string{"gpu-operator", "=" + dir, "gpu-operator=", "gpu-operator=" + filepath.Join(dir, "missing"), "gpu-operator=" + file} {
+		if _, err := parseExtraManifests([]string{spec}); err == nil {
+			t.Errorf("parseExtraManifests(%q) should fail", spec)
+		}
+	}
+}
+
+func TestBundleCmd_ExtraManifestsRequiresDeployer(t *testing.T) {
+	err := runBundleCmd("--recipe", "recipe.yaml", "--deployer", "terraform", "--extra-manifests", "gpu-operator="+t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "--extra-manifests requires --deployer") {
+		t.Errorf("error = %v, want deployer error", err)
+	}
+}
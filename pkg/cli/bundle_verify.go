@@ -0,0 +1,145 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/signing"
+	"github.com/NVIDIA/eidos/pkg/oci"
+)
+
+// verificationFlags are shared by commands that verify bundle signatures.
+func verificationFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "key",
+			Usage: "Public key (PEM file or cosign KMS URI) used to verify signatures",
+		},
+		&cli.StringFlag{
+			Name:  "certificate-identity",
+			Usage: "Expected signer identity for keyless signatures",
+		},
+		&cli.StringFlag{
+			Name:  "certificate-oidc-issuer",
+			Usage: "Expected OIDC issuer for keyless signatures",
+		},
+	}
+}
+
+// parseVerifyOptions builds signature verification options from verificationFlags
+// and the parent bundle command's registry flags.
+func parseVerifyOptions(cmd *cli.Command) signing.VerifyOptions {
+	return signing.VerifyOptions{
+		KeyRef:                cmd.String("key"),
+		CertificateIdentity:   cmd.String("certificate-identity"),
+		CertificateOIDCIssuer: cmd.String("certificate-oidc-issuer"),
+		PlainHTTP:             cmd.Bool("plain-http"),
+		InsecureTLS:           cmd.Bool("insecure-tls"),
+	}
+}
+
+// printVerifyResult reports what VerifyBundle checked.
+func printVerifyResult(dir string, result *signing.VerifyResult) {
+	fmt.Printf("Verified checksums of %d files in %s\n", result.Files, dir)
+	if result.SignatureVerified {
+		fmt.Println("Verified checksums.txt signature")
+	} else {
+		fmt.Println("Bundle is not signed")
+	}
+}
+
+func bundleVerifyCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "verify",
+		Usage:     "Verify bundle checksums and signatures before deployment.",
+		ArgsUsage: "<dir|oci://registry/repository:tag>",
+		Description: `Verifies a bundle produced by 'eidos bundle'.
+
+For a local directory, every file listed in checksums.txt is re-hashed. If the
+bundle is signed, the checksums.txt signature is verified as well; a signed
+bundle requires --key or --certificate-identity/--certificate-oidc-issuer.
+
+For an OCI reference, the cosign signature of the artifact is verified
+(requires cosign on PATH).
+
+With --strict, a local bundle also fails when it contains files checksums.txt
+does not list, other than its signatures, bundle.yaml and audit.json. Signed
+bundles are always checked this way, since the signature only covers the
+listed files.
+
+Examples:
+
+Verify checksums of an unsigned bundle:
+  eidos bundle verify ./my-bundle
+
+Verify a key-signed bundle:
+  eidos bundle verify ./my-bundle --key cosign.pub
+
+Verify an unsigned GitOps checkout, failing on files added by hand:
+  eidos bundle verify ./my-bundle --strict
+
+Verify a keylessly signed OCI artifact:
+  eidos bundle verify oci://ghcr.io/nvidia/eidos-bundle:v1.0.0 \
+    --certificate-identity user@example.com \
+    --certificate-oidc-issuer https://accounts.google.com
+`,
+		Flags: append(verificationFlags(),
+			&cli.BoolFlag{
+				Name:  "strict",
+				Usage: "Also fail when a local bundle contains files checksums.txt does not list",
+			},
+		),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 1 {
+				return fmt.Errorf("expected exactly one bundle directory or OCI reference, got %d", cmd.Args().Len())
+			}
+
+			ref, err := oci.ParseOutputTarget(cmd.Args().First())
+			if err != nil {
+				return fmt.Errorf("invalid bundle target: %w", err)
+			}
+
+			opts := parseVerifyOptions(cmd)
+			opts.Strict = cmd.Bool("strict")
+
+			if ref.IsOCI {
+				imageRef := ref.ImageReference()
+				if ref.Digest != "" {
+					imageRef = ref.DigestReference(ref.Digest)
+				}
+				if err := signing.VerifyArtifact(ctx, imageRef, opts); err != nil {
+					slog.Error("bundle verification failed", "error", err, "reference", imageRef)
+					return err
+				}
+				fmt.Printf("Verified signature of %s\n", imageRef)
+				return nil
+			}
+
+			result, err := signing.VerifyBundle(ctx, ref.LocalPath, opts)
+			if err != nil {
+				slog.Error("bundle verification failed", "error", err, "path", ref.LocalPath)
+				return err
+			}
+
+			printVerifyResult(ref.LocalPath, result)
+			return nil
+		},
+	}
+}
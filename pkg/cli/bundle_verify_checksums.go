@@ -0,0 +1,100 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func bundleVerifyChecksumsCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "verify-checksums",
+		Usage:     "Check a bundle directory against its checksums.txt and report every difference.",
+		ArgsUsage: "<dir>",
+		Description: `Re-hashes every file listed in the bundle's checksums.txt and reports the files
+that are missing or modified, along with the files of the bundle that
+checksums.txt does not list (other than checksums.txt, its signatures and
+bundle.yaml). Hidden directories such as .git are skipped.
+
+Unlike 'eidos bundle verify', which stops at the first failure, every file is
+checked, and the report can be written as JSON or YAML for CI. The command
+fails when a file is missing or modified, and with --strict also when files
+are not listed. Signatures are not checked; use 'eidos bundle verify'.
+
+Examples:
+
+Check a bundle:
+  eidos bundle verify-checksums ./my-bundle
+
+Check a GitOps checkout in CI, failing on files added by hand:
+  eidos bundle verify-checksums ./my-bundle --strict --format json
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"t"},
+				Value:   planFormatText,
+				Usage:   fmt.Sprintf("output format (%s, %s, %s)", planFormatText, serializer.FormatJSON, serializer.FormatYAML),
+			},
+			&cli.BoolFlag{
+				Name:  "strict",
+				Usage: "Also fail when the bundle contains files checksums.txt does not list",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 1 {
+				return fmt.Errorf("expected exactly one bundle directory, got %d", cmd.Args().Len())
+			}
+			dir := cmd.Args().First()
+
+			format := cmd.String("format")
+			if format != planFormatText && format != string(serializer.FormatJSON) && format != string(serializer.FormatYAML) {
+				return fmt.Errorf("unknown output format: %q, valid formats are: text, json, yaml", format)
+			}
+
+			report, err := checksum.Verify(ctx, dir)
+			if err != nil {
+				slog.Error("checksum verification failed", "error", err, "path", dir)
+				return err
+			}
+
+			if format == planFormatText {
+				err = report.WriteText(cmd.Root().Writer)
+			} else {
+				err = serializer.NewWriter(serializer.Format(format), cmd.Root().Writer).Serialize(ctx, report)
+			}
+			if err != nil {
+				return err
+			}
+
+			if !report.OK() {
+				return fmt.Errorf("bundle checksum verification failed: %d missing, %d modified files",
+					len(report.Missing), len(report.Modified))
+			}
+			if cmd.Bool("strict") && !report.OKStrict() {
+				return fmt.Errorf("bundle checksum verification failed: %d files not listed in %s",
+					len(report.Extra), checksum.ChecksumFileName)
+			}
+			return nil
+		},
+	}
+}
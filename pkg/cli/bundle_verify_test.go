@@ -0,0 +1,132 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+)
+
+func runBundleCmd(args ...string) error {
+	root := &cli.Command{
+		Name:     name,
+		Commands: []*cli.Command{bundleCmd()},
+	}
+	return root.Run(context.Background(), append([]string{name, "bundle"}, args...))
+}
+
+func TestBundleVerifyCmd(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "values.yaml")
+	if err := os.WriteFile(file, []byte("a: 1\n"), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := checksum.GenerateChecksums(context.Background(), dir, []string{file}); err != nil {
+		t.Fatalf("GenerateChecksums() error = %v", err)
+	}
+
+	// verify must not require the parent's --recipe flag
+	if err := runBundleCmd("verify", dir); err != nil {
+		t.Fatalf("bundle verify error = %v", err)
+	}
+
+	extra := filepath.Join(dir, "extra.yaml")
+	if err := os.WriteFile(extra, []byte("b: 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := runBundleCmd("verify", dir); err != nil {
+		t.Errorf("unlisted files should only fail with --strict, got %v", err)
+	}
+	err := runBundleCmd("verify", dir, "--strict")
+	if err == nil || !strings.Contains(err.Error(), "extra.yaml is not listed") {
+		t.Errorf("bundle verify --strict error = %v, want unlisted file", err)
+	}
+	if err := os.Remove(extra); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file, []byte("a: 2\n"), 0600); err != nil {
+		t.Fatalf("failed to modify file: %v", err)
+	}
+	if err := runBundleCmd("verify", dir); err == nil {
+		t.Error("expected verification failure for modified bundle")
+	}
+
+	if err := runBundleCmd("verify"); err == nil {
+		t.Error("expected error without bundle target")
+	}
+}
+
+func TestBundleVerifyChecksumsCmd(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "values.yaml")
+	if err := os.WriteFile(file, []byte("a: 1\n"), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := checksum.GenerateChecksums(context.Background(), dir, []string{file}); err != nil {
+		t.Fatalf("GenerateChecksums() error = %v", err)
+	}
+
+	if err := runBundleCmd("verify-checksums", dir, "--format", "json"); err != nil {
+		t.Fatalf("bundle verify-checksums error = %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "extra.yaml"), []byte("b: 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := runBundleCmd("verify-checksums", dir); err != nil {
+		t.Errorf("unlisted files should only fail with --strict, got %v", err)
+	}
+	err := runBundleCmd("verify-checksums", dir, "--strict")
+	if err == nil || !strings.Contains(err.Error(), "1 files not listed") {
+		t.Errorf("bundle verify-checksums --strict error = %v, want unlisted files", err)
+	}
+
+	if err := os.WriteFile(file, []byte("a: 2\n"), 0600); err != nil {
+		t.Fatalf("failed to modify file: %v", err)
+	}
+	err = runBundleCmd("verify-checksums", dir)
+	if err == nil || !strings.Contains(err.Error(), "0 missing, 1 modified") {
+		t.Errorf("bundle verify-checksums error = %v, want modified file", err)
+	}
+
+	if err := runBundleCmd("verify-checksums", dir, "--format", "xml"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+	if err := runBundleCmd("verify-checksums"); err == nil {
+		t.Error("expected error without bundle directory")
+	}
+}
+
+func TestBundleCmd_RequiresRecipe(t *testing.T) {
+	err := runBundleCmd("--output", t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "recipe") {
+		t.Errorf("expected missing recipe error, got %v", err)
+	}
+}
+
+func TestBundleCmd_ConflictingSignFlags(t *testing.T) {
+	err := runBundleCmd("--recipe", "recipe.yaml", "--sign", "--sign-key", "cosign.key")
+	if err == nil || !strings.Contains(err.Error(), "signing") {
+		t.Errorf("expected signing flag error, got %v", err)
+	}
+}
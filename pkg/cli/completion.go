@@ -0,0 +1,168 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/deployer/helmlive"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+// completionFlag is the flag the generated shell completion scripts append
+// to the command line when requesting completions.
+const completionFlag = "--generate-shell-completion"
+
+// flagValueCompleters returns the values offered when completing the value
+// of a flag, by flag name.
+var flagValueCompleters = map[string]func(f cli.Flag) []string{
+	"deployer": func(cli.Flag) []string { return config.GetDeployerTypes() },
+	"format": func(f cli.Flag) []string {
+		if sf, ok := f.(*cli.StringFlag); ok && sf.Value == planFormatText {
+			return []string{planFormatText, string(serializer.FormatJSON), string(serializer.FormatYAML)}
+		}
+		return serializer.SupportedFormats()
+	},
+	"service":                  func(cli.Flag) []string { return recipe.GetCriteriaServiceTypes() },
+	"accelerator":              func(cli.Flag) []string { return recipe.GetCriteriaAcceleratorTypes() },
+	"intent":                   func(cli.Flag) []string { return recipe.GetCriteriaIntentTypes() },
+	"os":                       func(cli.Flag) []string { return recipe.GetCriteriaOSTypes() },
+	"scale":                    func(cli.Flag) []string { return recipe.GetCriteriaScaleTypes() },
+	"partitioning":             func(cli.Flag) []string { return recipe.GetCriteriaPartitioningTypes() },
+	"applicationset-generator": func(cli.Flag) []string { return config.GetApplicationSetGenerators() },
+	"values-schema":            func(cli.Flag) []string { return config.GetSchemaValidationModes() },
+	"secret-backend":           func(cli.Flag) []string { return config.GetSecretBackends() },
+	"mode": func(cli.Flag) []string {
+		return []string{string(helmlive.ModeComponents), string(helmlive.ModeUmbrella)}
+	},
+	"types": func(cli.Flag) []string {
+		types := make([]string, 0, len(measurement.Types))
+		for _, t := range measurement.Types {
+			types = append(types, t.String())
+		}
+		return types
+	},
+	"only": func(cli.Flag) []string { return componentNames() },
+}
+
+// componentNames returns the names of the components in the embedded
+// component registry, which are also the names of their bundlers.
+func componentNames() []string {
+	registry, err := recipe.GetComponentRegistry()
+	if err != nil {
+		return nil
+	}
+	return registry.Names()
+}
+
+// withShellCompletion sets completeCommand as the shell completion function
+// of cmd and all its subcommands.
+func withShellCompletion(cmd *cli.Command) *cli.Command {
+	cmd.ShellComplete = completeCommand
+	for _, sub := range cmd.Commands {
+		withShellCompletion(sub)
+	}
+	return cmd
+}
+
+// completeCommand prints the completions of the command line being
+// completed: the values of the flag before the cursor, the flags of cmd
+// when completing a flag name, or its subcommands otherwise.
+func completeCommand(_ context.Context, cmd *cli.Command) {
+	if cmd == nil || cmd.Root() == nil {
+		return
+	}
+	writeCompletions(cmd.Root().Writer, cmd, os.Args)
+}
+
+// writeCompletions writes the completions for args, the command line ending
+// in completionFlag, to w.
+func writeCompletions(w io.Writer, cmd *cli.Command, args []string) {
+	if n := len(args); n > 0 && args[n-1] == completionFlag {
+		args = args[:n-1]
+	}
+	last := ""
+	if len(args) > 1 {
+		last = args[len(args)-1]
+	}
+	if last == "--" {
+		return
+	}
+
+	if name, ok := strings.CutPrefix(last, "-"); ok && last != "-" {
+		name = strings.TrimPrefix(name, "-")
+		if f := lookupFlag(cmd, name); f != nil && flagTakesValue(f) {
+			// Flags without known values, such as paths, are left to the
+			// completion of the shell.
+			if complete, ok := flagValueCompleters[f.Names()[0]]; ok {
+				for _, v := range complete(f) {
+					fmt.Fprintln(w, v)
+				}
+			}
+			return
+		}
+		writeFlagCompletions(w, cmd, last)
+		return
+	}
+
+	for _, sub := range cmd.Commands {
+		if sub.Hidden {
+			continue
+		}
+		fmt.Fprintln(w, sub.Name)
+	}
+}
+
+// writeFlagCompletions writes the visible flags of cmd whose names start
+// with prefix, e.g. --deployer for --dep.
+func writeFlagCompletions(w io.Writer, cmd *cli.Command, prefix string) {
+	for _, f := range cmd.VisibleFlags() {
+		for _, name := range f.Names() {
+			dashes := "--"
+			if len(name) == 1 {
+				dashes = "-"
+			}
+			if flag := dashes + name; strings.HasPrefix(flag, prefix) {
+				fmt.Fprintln(w, flag)
+			}
+		}
+	}
+}
+
+// lookupFlag returns the flag of cmd with the given name or alias.
+func lookupFlag(cmd *cli.Command, name string) cli.Flag {
+	for _, f := range cmd.Flags {
+		if slices.Contains(f.Names(), name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// flagTakesValue reports whether f is followed by a value on the command line.
+func flagTakesValue(f cli.Flag) bool {
+	df, ok := f.(cli.DocGenerationFlag)
+	return ok && df.TakesValue()
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestWriteCompletions(t *testing.T) {
+	root := &cli.Command{
+		Name: name,
+		Commands: []*cli.Command{
+			bundleCmd(),
+			snapshotCmd(),
+			{Name: "hidden", Hidden: true},
+		},
+	}
+	bundle := root.Command("bundle")
+	plan := bundle.Command("plan")
+
+	tests := []struct {
+		name    string
+		cmd     *cli.Command
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "subcommands",
+			cmd:     root,
+			args:    []string{name},
+			want:    []string{"bundle", "snapshot"},
+			notWant: []string{"hidden"},
+		},
+		{
+			name: "deployer values",
+			cmd:  bundle,
+			args: []string{name, "bundle", "--deployer"},
+			want: []string{"helm", "argocd", "argo-workflows", "terraform"},
+		},
+		{
+			name: "deployer alias",
+			cmd:  bundle,
+			args: []string{name, "bundle", "-d"},
+			want: []string{"helm", "argocd"},
+		},
+		{
+			name:    "text format values",
+			cmd:     plan,
+			args:    []string{name, "bundle", "plan", "--format"},
+			want:    []string{"text", "json", "yaml"},
+			notWant: []string{"table"},
+		},
+		{
+			name: "component names",
+			cmd:  bundle,
+			args: []string{name, "bundle", "--only"},
+			want: []string{"gpu-operator", "network-operator"},
+		},
+		{
+			name:    "flag names",
+			cmd:     bundle,
+			args:    []string{name, "bundle", "--dep"},
+			want:    []string{"--deployer"},
+			notWant: []string{"--recipe"},
+		},
+		{
+			name:    "flag without known values",
+			cmd:     bundle,
+			args:    []string{name, "bundle", "--recipe"},
+			notWant: []string{"--recipe", "diff"},
+		},
+		{
+			name:    "after terminator",
+			cmd:     bundle,
+			args:    []string{name, "bundle", "--"},
+			notWant: []string{"diff"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			writeCompletions(&out, tt.cmd, append(tt.args, completionFlag))
+			got := strings.Fields(out.String())
+
+			for _, w := range tt.want {
+				if !slices.Contains(got, w) {
+					t.Errorf("completions %v missing %q", got, w)
+				}
+			}
+			for _, w := range tt.notWant {
+				if slices.Contains(got, w) {
+					t.Errorf("completions %v should not contain %q", got, w)
+				}
+			}
+		})
+	}
+}
+
+func TestWithShellCompletion(t *testing.T) {
+	root := withShellCompletion(&cli.Command{
+		Name:     name,
+		Commands: []*cli.Command{bundleCmd()},
+	})
+
+	var check func(cmd *cli.Command)
+	check = func(cmd *cli.Command) {
+		if cmd.ShellComplete == nil {
+			t.Errorf("command %q has no shell completion", cmd.Name)
+		}
+		for _, sub := range cmd.Commands {
+			check(sub)
+		}
+	}
+	check(root)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/NVIDIA/eidos/pkg/conformance"
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+func conformanceCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "conformance",
+		Category:              functionalCategoryName,
+		EnableShellCompletion: true,
+		Usage:                 "Check a deployed stack against an NVIDIA reference architecture.",
+		Description: `Runs a suite of checks against the cluster selected by --kubeconfig and
+produces a scored report with per-check results.
+
+Checks:
+  components  the recipe deploys the components the profile requires, at supported versions
+  health      the component health checks of 'eidos verify'
+  validation  recipe constraints against --snapshot, as 'eidos validate' (only with --snapshot)
+  fabric      nvidia-smi reports an initialized NVLink fabric on every GPU
+  nccl        an all-reduce across one node reaches the profile's bus bandwidth
+  dcgm        DCGM level 1 diagnostics pass
+
+The fabric, nccl and dcgm checks run as Jobs that request all GPUs of one node
+in --job-namespace, and are deleted when they finish.
+
+Profiles:
+  nvl72     GB200 NVL72 (4 GPUs per node)
+  hgx-h100  HGX H100 (8 GPUs per node)
+
+# Examples
+
+Check a deployed HGX H100 stack:
+  eidos conformance --profile hgx-h100 --recipe recipe.yaml
+
+Include constraint validation against a snapshot:
+  eidos conformance --profile nvl72 -r recipe.yaml -s cm://gpu-operator/eidos-snapshot
+
+Check components only, without running GPU workloads:
+  eidos conformance --profile nvl72 -r recipe.yaml --workloads=false
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "profile",
+				Required: true,
+				Usage:    fmt.Sprintf("Reference architecture to check against (%s)", strings.Join(conformance.ProfileNames(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:     "recipe",
+				Aliases:  []string{"r"},
+				Required: true,
+				Usage: `Path/URI to the recipe that was deployed.
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:    "snapshot",
+				Aliases: []string{"s"},
+				Usage: `Path/URI to a snapshot to validate the recipe constraints against (optional).
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:  "namespace",
+				Usage: "Look for every component's resources in this namespace instead of its component namespace",
+			},
+			&cli.BoolFlag{
+				Name:  "workloads",
+				Value: true,
+				Usage: "Run the fabric manager, NCCL and DCGM Jobs on a GPU node",
+			},
+			&cli.StringFlag{
+				Name:  "job-namespace",
+				Value: conformance.DefaultJobNamespace,
+				Usage: "Namespace to run the workload Jobs in",
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Value: conformance.DefaultTimeout,
+				Usage: "Time to wait for each workload Job to finish",
+			},
+			&cli.StringFlag{
+				Name:  "nccl-image",
+				Value: conformance.DefaultNCCLImage,
+				Usage: "Container image providing the nccl-tests all_reduce_perf binary",
+			},
+			&cli.StringFlag{
+				Name:  "dcgm-image",
+				Value: conformance.DefaultDCGMImage,
+				Usage: "Container image providing dcgmi and nv-hostengine",
+			},
+			&cli.BoolFlag{
+				Name:  "fail-on-error",
+				Value: true,
+				Usage: "Exit with non-zero status if any check fails",
+			},
+			outputFlag,
+			formatFlag,
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			outFormat, err := parseOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			profile, err := conformance.GetProfile(cmd.String("profile"))
+			if err != nil {
+				return err
+			}
+
+			recipeFilePath := cmd.String("recipe")
+			snapshotFilePath := cmd.String("snapshot")
+			kubeconfig := cmd.String("kubeconfig")
+
+			slog.Info("loading recipe", "uri", recipeFilePath)
+
+			rec, err := serializer.FromFileWithKubeconfig[recipe.RecipeResult](recipeFilePath, kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to load recipe from %q: %w", recipeFilePath, err)
+			}
+
+			var snap *snapshotter.Snapshot
+			if snapshotFilePath != "" {
+				slog.Info("loading snapshot", "uri", snapshotFilePath)
+
+				snap, err = serializer.FromFileWithKubeconfig[snapshotter.Snapshot](snapshotFilePath, kubeconfig)
+				if err != nil {
+					return fmt.Errorf("failed to load snapshot from %q: %w", snapshotFilePath, err)
+				}
+			}
+
+			clientset, config, err := client.GetKubeClientWithConfig(kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			dyn, err := dynamic.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("failed to create dynamic client: %w", err)
+			}
+
+			runner := conformance.New(clientset, dyn,
+				conformance.WithVersion(version),
+				conformance.WithNamespace(cmd.String("namespace")),
+				conformance.WithJobNamespace(cmd.String("job-namespace")),
+				conformance.WithWorkloads(cmd.Bool("workloads")),
+				conformance.WithTimeout(cmd.Duration("timeout")),
+				conformance.WithNCCLImage(cmd.String("nccl-image")),
+				conformance.WithDCGMImage(cmd.String("dcgm-image")),
+			)
+
+			result, err := runner.Run(ctx, profile, rec, snap)
+			if err != nil {
+				return fmt.Errorf("conformance check failed: %w", err)
+			}
+			result.RecipeSource = recipeFilePath
+			result.SnapshotSource = snapshotFilePath
+
+			ser, err := serializer.NewFileWriterOrStdout(outFormat, cmd.String("output"))
+			if err != nil {
+				return fmt.Errorf("failed to create output writer: %w", err)
+			}
+			defer func() {
+				if closer, ok := ser.(interface{ Close() error }); ok {
+					if err := closer.Close(); err != nil {
+						slog.Warn("failed to close serializer", "error", err)
+					}
+				}
+			}()
+
+			if err := ser.Serialize(ctx, result); err != nil {
+				return fmt.Errorf("failed to serialize conformance result: %w", err)
+			}
+
+			slog.Info("conformance check completed",
+				"profile", profile.Name,
+				"score", result.Summary.Score,
+				"status", result.Summary.Status,
+				"passed", result.Summary.Passed,
+				"failed", result.Summary.Failed,
+				"skipped", result.Summary.Skipped,
+				"duration", result.Summary.Duration)
+
+			if cmd.Bool("fail-on-error") && result.Summary.Status == healthcheck.StatusFail {
+				return fmt.Errorf("conformance check failed: %d check(s) did not pass", result.Summary.Failed)
+			}
+
+			return nil
+		},
+	}
+}
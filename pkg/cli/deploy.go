@@ -0,0 +1,219 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/deployer/helmlive"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func deployCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "deploy",
+		Category:              functionalCategoryName,
+		EnableShellCompletion: true,
+		Usage:                 "Install a recipe directly into a cluster using Helm.",
+		Description: `Installs or upgrades the components of a recipe in the cluster selected by
+--kubeconfig, without generating a bundle first.
+
+By default every enabled component is installed as its own Helm release, named
+after the component, in its component namespace. Components are installed in
+waves following their dependencies: releases within a wave are applied
+concurrently and the next wave starts once every release is ready.
+
+With --mode umbrella, the Helm umbrella chart that 'eidos bundle' would
+generate is installed as a single eidos-stack release instead, upgraded once
+per install phase like the chart's install.sh.
+
+Re-running deploy upgrades existing releases, so it is safe to repeat.
+
+Examples:
+
+Install a recipe into the current cluster:
+  eidos deploy --recipe recipe.yaml
+
+Install into a specific cluster with a longer readiness timeout:
+  eidos deploy --recipe recipe.yaml --kubeconfig ~/.kube/prod --timeout 20m
+
+Render releases without changing the cluster:
+  eidos deploy --recipe recipe.yaml --dry-run
+
+Install the umbrella chart with value overrides:
+  eidos deploy --recipe recipe.yaml --mode umbrella --set gpuoperator:driver.version=580.82.07
+`,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:     "recipe",
+				Aliases:  []string{"r"},
+				Required: true,
+				Usage: `Path/URI to previously generated recipe to deploy.
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:  "mode",
+				Value: string(helmlive.ModeComponents),
+				Usage: fmt.Sprintf("Install mode (%s, %s)", helmlive.ModeComponents, helmlive.ModeUmbrella),
+			},
+			&cli.StringFlag{
+				Name:  "namespace",
+				Usage: "Install every release into this namespace instead of the per-component default",
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Value: helmlive.DefaultTimeout,
+				Usage: "Time to wait for each release to become ready",
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "Render releases without making changes to the cluster",
+			},
+			kubeconfigFlag,
+			dataFlag,
+			recipeDataFlag,
+		}, valueFlags()...),
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			mode, err := helmlive.ParseMode(cmd.String("mode"))
+			if err != nil {
+				return fmt.Errorf("invalid --mode value: %w", err)
+			}
+
+			// Initialize external data provider if --data flag is set
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize data provider: %w", err)
+			}
+			defer cleanup()
+
+			opts := &bundleCmdOptions{
+				recipeFilePath: cmd.String("recipe"),
+				kubeconfig:     cmd.String("kubeconfig"),
+				deployer:       config.DeployerHelm,
+			}
+			if err := opts.parseValueFlags(cmd); err != nil {
+				return err
+			}
+
+			rec, err := serializer.FromFileWithKubeconfig[recipe.RecipeResult](opts.recipeFilePath, opts.kubeconfig)
+			if err != nil {
+				slog.Error("failed to load recipe file", "error", err, "path", opts.recipeFilePath)
+				return err
+			}
+
+			b, err := bundler.NewWithConfig(opts.bundlerConfig())
+			if err != nil {
+				slog.Error("failed to create bundler", "error", err)
+				return err
+			}
+
+			d := helmlive.New(
+				helmlive.WithKubeconfig(opts.kubeconfig),
+				helmlive.WithNamespace(cmd.String("namespace")),
+				helmlive.WithTimeout(cmd.Duration("timeout")),
+				helmlive.WithDryRun(cmd.Bool("dry-run")),
+				helmlive.WithMode(mode),
+			)
+
+			slog.Info("deploying recipe",
+				slog.String("recipe", opts.recipeFilePath),
+				slog.String("mode", mode.String()),
+				slog.Bool("dry_run", cmd.Bool("dry-run")),
+			)
+
+			var res *helmlive.Result
+			if mode == helmlive.ModeUmbrella {
+				res, err = deployUmbrella(ctx, b, d, rec)
+			} else {
+				var values map[string]map[string]any
+				values, err = b.ComponentValues(ctx, rec)
+				if err == nil {
+					res, err = d.Deploy(ctx, rec, values)
+				}
+			}
+
+			if res != nil {
+				printDeployResult(cmd.Root().Writer, res)
+			}
+			if err != nil {
+				slog.Error("deploy failed", "error", err)
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// deployUmbrella generates the Helm umbrella chart for rec in a temporary
+// directory and installs it as a single release, in the phases its install.sh
+// would use.
+func deployUmbrella(ctx context.Context, b *bundler.DefaultBundler, d *helmlive.Deployer, rec *recipe.RecipeResult) (*helmlive.Result, error) {
+	dir, err := os.MkdirTemp("", "eidos-deploy-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary chart directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	out, err := b.Make(ctx, rec, dir)
+	if err == nil {
+		err = componentFailures(out)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate umbrella chart: %w", err)
+	}
+
+	_, statErr := os.Stat(filepath.Join(dir, "templates"))
+	phases := helm.InstallPhases(rec, statErr == nil)
+	res, err := d.DeployChart(ctx, dir, phases, nil)
+	if res != nil {
+		res.Skipped = out.SkippedComponents
+	}
+	return res, err
+}
+
+// printDeployResult writes a per-release summary of a deploy run.
+func printDeployResult(w io.Writer, res *helmlive.Result) {
+	if w == nil {
+		w = os.Stdout
+	}
+
+	title := "Deployed"
+	if res.DryRun {
+		title = "Dry run"
+	}
+	fmt.Fprintf(w, "\n%s %d release(s) in %d wave(s) (%s)\n",
+		title, len(res.Releases), res.Waves, res.Duration.Round(time.Second))
+
+	for _, r := range res.Releases {
+		fmt.Fprintf(w, "  wave %d  %-28s %-8s revision %-3d namespace=%s chart=%s\n",
+			r.Wave+1, r.Name, r.Action, r.Revision, r.Namespace, r.Chart)
+	}
+	if len(res.Skipped) > 0 {
+		fmt.Fprintf(w, "Skipped disabled components: %s\n", strings.Join(res.Skipped, ", "))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/deployer/helmlive"
+)
+
+func TestDeployCmd_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing recipe", args: nil, wantErr: "recipe"},
+		{name: "invalid mode", args: []string{"--recipe", "recipe.yaml", "--mode", "argocd"}, wantErr: "invalid --mode"},
+		{name: "invalid set", args: []string{"--recipe", "recipe.yaml", "--set", "novalue"}, wantErr: "invalid --set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := &cli.Command{
+				Name:     name,
+				Writer:   &bytes.Buffer{},
+				Commands: []*cli.Command{deployCmd()},
+			}
+			err := root.Run(context.Background(), append([]string{name, "deploy"}, tt.args...))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrintDeployResult(t *testing.T) {
+	var out bytes.Buffer
+	printDeployResult(&out, &helmlive.Result{
+		Releases: []helmlive.ReleaseResult{
+			{Name: "cert-manager", Namespace: "cert-manager", Chart: "cert-manager", Action: helmlive.ActionInstall, Revision: 1},
+			{Name: "gpu-operator", Namespace: "gpu-operator", Chart: "gpu-operator", Action: helmlive.ActionUpgrade, Revision: 3, Wave: 1},
+		},
+		Waves:    2,
+		Skipped:  []string{"nvsentinel"},
+		Duration: 42 * time.Second,
+	})
+
+	got := out.String()
+	for _, want := range []string{
+		"Deployed 2 release(s) in 2 wave(s)",
+		"wave 2  gpu-operator",
+		"upgrade",
+		"Skipped disabled components: nvsentinel",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -88,6 +88,13 @@
 // Supports multiple bundlers: gpu-operator, network-operator, cert-manager,
 // nvsentinel, skyhook.
 //
+// version - Show version, recipe data and component versions:
+//
+//	eidos version
+//	eidos version --json
+//
+// Prints the same report the API server returns at GET /v1/version.
+//
 // # Global Flags
 //
 //	--output, -o   Output file path (default: stdout)
@@ -161,5 +168,5 @@
 //
 // Version information is embedded at build time using ldflags:
 //
-//	go build -ldflags="-X 'github.com/NVIDIA/eidos/pkg/cli.version=1.0.0'"
+//	go build -ldflags="-X 'github.com/NVIDIA/eidos/pkg/buildinfo.version=1.0.0'"
 package cli
@@ -0,0 +1,264 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+)
+
+// manSection is the man page section of the generated pages (user commands).
+const manSection = "1"
+
+func docsCmd() *cli.Command {
+	return &cli.Command{
+		Name:     "docs",
+		Category: "Utilities",
+		Usage:    "Generate reference documentation for the CLI.",
+		Commands: []*cli.Command{
+			docsManCmd(),
+		},
+	}
+}
+
+func docsManCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "man",
+		Usage:     "Generate man pages for eidos and its commands.",
+		ArgsUsage: "[command...]",
+		Description: `Generates roff man pages from the commands, flags and descriptions of the CLI.
+Without --output, prints the page of the given command (eidos by default).
+With --output, writes one page per command to the directory, named after the
+command path (eidos-bundle-plan.1 for eidos bundle plan).
+
+Examples:
+
+View the page of eidos bundle:
+  eidos docs man bundle | man -l -
+
+Install the pages of all commands:
+  eidos docs man --output /usr/local/share/man/man1`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "Directory to write one page per command to, instead of printing a single page to stdout",
+			},
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			root := cmd.Root()
+
+			if dir := cmd.String("output"); dir != "" {
+				if cmd.Args().Present() {
+					return fmt.Errorf("command arguments cannot be used with --output")
+				}
+				pages, err := writeManPages(dir, root, nil)
+				if err != nil {
+					return err
+				}
+				slog.Info("man pages generated", "output", dir, "pages", pages)
+				return nil
+			}
+
+			page, path := root, []string{root.Name}
+			for _, name := range cmd.Args().Slice() {
+				sub := page.Command(name)
+				if sub == nil {
+					return fmt.Errorf("unknown command %q", strings.Join(append(path, name), " "))
+				}
+				page, path = sub, append(path, sub.Name)
+			}
+			return writeManPage(root.Writer, page, path)
+		},
+	}
+}
+
+// writeManPages writes the man pages of cmd and its visible subcommands to
+// dir and returns the number of pages written. parent is the command path
+// leading to cmd.
+func writeManPages(dir string, cmd *cli.Command, parent []string) (int, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, fmt.Errorf("failed to create output directory %s: %w", dir, err)
+	}
+
+	path := append(append([]string{}, parent...), cmd.Name)
+	file := filepath.Join(dir, manPageName(path)+"."+manSection)
+	f, err := os.Create(file)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", file, err)
+	}
+	if err := writeManPage(f, cmd, path); err != nil {
+		_ = f.Close()
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close %s: %w", file, err)
+	}
+
+	pages := 1
+	for _, sub := range manSubcommands(cmd) {
+		n, err := writeManPages(dir, sub, path)
+		if err != nil {
+			return pages, err
+		}
+		pages += n
+	}
+	return pages, nil
+}
+
+// manSubcommands returns the subcommands of cmd that get a man page: the
+// visible ones except the help command, which the pages replace.
+func manSubcommands(cmd *cli.Command) []*cli.Command {
+	var subs []*cli.Command
+	for _, sub := range cmd.VisibleCommands() {
+		if sub.Name != "help" {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// manPageName returns the page name of the command at path, e.g.
+// eidos-bundle-plan.
+func manPageName(path []string) string {
+	return strings.Join(path, "-")
+}
+
+// writeManPage writes the roff man page of cmd, found at path, to w.
+func writeManPage(w io.Writer, cmd *cli.Command, path []string) error {
+	var b strings.Builder
+	name := manPageName(path)
+
+	fmt.Fprintf(&b, ".TH %q %q \"\" %q %q\n",
+		strings.ToUpper(name), manSection, path[0]+" "+version, "User Commands")
+
+	b.WriteString(".SH NAME\n")
+	fmt.Fprintf(&b, "%s", roffEscape(name))
+	if cmd.Usage != "" {
+		fmt.Fprintf(&b, " \\- %s", roffEscape(cmd.Usage))
+	}
+	b.WriteString("\n")
+
+	b.WriteString(".SH SYNOPSIS\n")
+	fmt.Fprintf(&b, ".B %s\n", roffEscape(strings.Join(path, " ")))
+	synopsis := []string{}
+	if len(cmd.VisibleFlags()) > 0 {
+		synopsis = append(synopsis, `[\fIOPTIONS\fR]`)
+	}
+	switch {
+	case len(manSubcommands(cmd)) > 0:
+		synopsis = append(synopsis, `\fICOMMAND\fR`)
+	case cmd.ArgsUsage != "":
+		synopsis = append(synopsis, `\fI`+roffEscape(cmd.ArgsUsage)+`\fR`)
+	}
+	if len(synopsis) > 0 {
+		b.WriteString(strings.Join(synopsis, " ") + "\n")
+	}
+
+	if cmd.Description != "" {
+		b.WriteString(".SH DESCRIPTION\n.nf\n")
+		for _, line := range strings.Split(strings.TrimSpace(cmd.Description), "\n") {
+			b.WriteString(roffLine(strings.TrimRight(line, " \t")) + "\n")
+		}
+		b.WriteString(".fi\n")
+	}
+
+	if flags := cmd.VisibleFlags(); len(flags) > 0 {
+		b.WriteString(".SH OPTIONS\n")
+		for _, f := range flags {
+			writeManFlag(&b, f)
+		}
+	}
+
+	if subs := manSubcommands(cmd); len(subs) > 0 {
+		b.WriteString(".SH COMMANDS\n")
+		for _, sub := range subs {
+			fmt.Fprintf(&b, ".TP\n\\fB%s\\fR\n%s\n", roffEscape(sub.Name), roffLine(sub.Usage))
+		}
+
+		b.WriteString(".SH SEE ALSO\n")
+		refs := make([]string, 0, len(subs))
+		for _, sub := range subs {
+			refs = append(refs, fmt.Sprintf(`\fB%s\fR(%s)`, roffEscape(manPageName(append(path, sub.Name))), manSection))
+		}
+		b.WriteString(strings.Join(refs, ", ") + "\n")
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write man page %s: %w", name, err)
+	}
+	return nil
+}
+
+// writeManFlag writes the OPTIONS entry of f: its names, value placeholder,
+// usage, default and environment variables.
+func writeManFlag(b *strings.Builder, f cli.Flag) {
+	names := make([]string, 0, len(f.Names()))
+	for _, n := range f.Names() {
+		dashes := "--"
+		if len(n) == 1 {
+			dashes = "-"
+		}
+		names = append(names, `\fB`+roffEscape(dashes+n)+`\fR`)
+	}
+	b.WriteString(".TP\n" + strings.Join(names, ", "))
+
+	df, ok := f.(cli.DocGenerationFlag)
+	if !ok {
+		b.WriteString("\n")
+		return
+	}
+	if df.TakesValue() {
+		b.WriteString(`=\fIvalue\fR`)
+	}
+	b.WriteString("\n")
+
+	usage := strings.Join(strings.Fields(df.GetUsage()), " ")
+	if df.TakesValue() && df.IsDefaultVisible() {
+		def := df.GetDefaultText()
+		if def == "" {
+			def = df.GetValue()
+		}
+		if def != "" && def != `""` && def != "[]" {
+			usage += " (default: " + def + ")"
+		}
+	}
+	if env := df.GetEnvVars(); len(env) > 0 {
+		usage += " [$" + strings.Join(env, ", $") + "]"
+	}
+	b.WriteString(roffLine(usage) + "\n")
+}
+
+// roffLine escapes s for use as a line of roff text, so a leading period or
+// apostrophe is not read as a request.
+func roffLine(s string) string {
+	s = roffEscape(s)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		return `\&` + s
+	}
+	return s
+}
+
+// roffEscape escapes backslashes and hyphens in s for roff.
+func roffEscape(s string) string {
+	return strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(s)
+}
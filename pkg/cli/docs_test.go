@@ -0,0 +1,105 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+)
+
+func runDocsCmd(args ...string) (string, error) {
+	var out bytes.Buffer
+	root := &cli.Command{
+		Name:     name,
+		Writer:   &out,
+		Commands: []*cli.Command{bundleCmd(), docsCmd()},
+	}
+	err := root.Run(context.Background(), append([]string{name, "docs", "man"}, args...))
+	return out.String(), err
+}
+
+func TestDocsManCmd_Stdout(t *testing.T) {
+	out, err := runDocsCmd("bundle", "plan")
+	if err != nil {
+		t.Fatalf("docs man failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`.TH "EIDOS-BUNDLE-PLAN" "1"`,
+		`eidos\-bundle\-plan \- Print the ordered install plan`,
+		".SH SYNOPSIS\n.B eidos bundle plan\n",
+		".SH DESCRIPTION\n.nf\n",
+		`\fB\-\-format\fR, \fB\-t\fR=\fIvalue\fR`,
+		`output format (text, json, yaml) (default: "text")`,
+		// Lines starting with a period or apostrophe are escaped.
+		`\&'eidos bundle'`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("man page missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDocsManCmd_Root(t *testing.T) {
+	out, err := runDocsCmd()
+	if err != nil {
+		t.Fatalf("docs man failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`.TH "EIDOS" "1"`,
+		".SH COMMANDS\n",
+		`\fBbundle\fR`,
+		`\fBeidos\-bundle\fR(1), \fBeidos\-docs\fR(1)`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("man page missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, `\fBhelp\fR`) {
+		t.Errorf("man page should not list the help command:\n%s", out)
+	}
+}
+
+func TestDocsManCmd_OutputDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "man1")
+	if _, err := runDocsCmd("--output", dir); err != nil {
+		t.Fatalf("docs man failed: %v", err)
+	}
+
+	for _, page := range []string{"eidos.1", "eidos-bundle.1", "eidos-bundle-plan.1", "eidos-docs-man.1"} {
+		if _, err := os.Stat(filepath.Join(dir, page)); err != nil {
+			t.Errorf("page %s not written: %v", page, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "eidos-bundle-help.1")); err == nil {
+		t.Error("help command should not get a page")
+	}
+}
+
+func TestDocsManCmd_Errors(t *testing.T) {
+	if _, err := runDocsCmd("nope"); err == nil || !strings.Contains(err.Error(), `unknown command "eidos nope"`) {
+		t.Errorf("expected unknown command error, got %v", err)
+	}
+	if _, err := runDocsCmd("--output", t.TempDir(), "bundle"); err == nil {
+		t.Error("expected error for command arguments with --output")
+	}
+}
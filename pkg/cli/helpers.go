@@ -16,6 +16,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/urfave/cli/v3"
 
@@ -27,7 +28,7 @@ import (
 func parseOutputFormat(cmd *cli.Command) (serializer.Format, error) {
 	outFormat := serializer.Format(cmd.String("format"))
 	if outFormat.IsUnknown() {
-		return "", fmt.Errorf("unknown output format: %q, valid formats are: yaml, json, table", outFormat)
+		return "", fmt.Errorf("unknown output format: %q, valid formats are: %s", outFormat, strings.Join(serializer.SupportedFormats(), ", "))
 	}
 	return outFormat, nil
 }
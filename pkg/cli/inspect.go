@@ -0,0 +1,90 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/inspect"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func inspectCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "inspect",
+		Category:              "Utilities",
+		EnableShellCompletion: true,
+		Usage:                 "Report the size and contents of a snapshot, recipe or other Eidos document.",
+		ArgsUsage:             "<file>",
+		Description: `Reports the kind, API version and schema version of a document, its
+approximate JSON and YAML size, and the size of each top-level field.
+
+For snapshots, lists the number of readings and the size of each measurement
+type and subtype. For recipes, counts the component references (disabled and
+with inline overrides) and constraints. Use it to find what makes an artifact
+large before sharing it or storing it in a ConfigMap.
+
+Sizes are measured by re-encoding the document and are approximate.
+
+Examples:
+
+Inspect a snapshot:
+  eidos inspect snapshot.yaml
+
+Inspect a recipe stored in a ConfigMap as JSON:
+  eidos inspect cm://gpu-operator/eidos-recipe --format json
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"t"},
+				Value:   planFormatText,
+				Usage:   fmt.Sprintf("output format (%s, %s, %s)", planFormatText, serializer.FormatJSON, serializer.FormatYAML),
+			},
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 1 {
+				return fmt.Errorf("expected one document to inspect, got %d", cmd.Args().Len())
+			}
+			source := cmd.Args().First()
+
+			format := cmd.String("format")
+			if format != planFormatText && format != string(serializer.FormatJSON) && format != string(serializer.FormatYAML) {
+				return fmt.Errorf("unknown output format: %q, valid formats are: text, json, yaml", format)
+			}
+
+			doc, err := serializer.FromFileWithKubeconfig[map[string]any](source, cmd.String("kubeconfig"))
+			if err != nil {
+				slog.Error("failed to load document", "error", err, "path", source)
+				return err
+			}
+
+			report, err := inspect.Inspect(source, *doc)
+			if err != nil {
+				return fmt.Errorf("failed to inspect %s: %w", source, err)
+			}
+
+			if format == planFormatText {
+				return report.WriteText(cmd.Root().Writer)
+			}
+			return serializer.NewWriter(serializer.Format(format), cmd.Root().Writer).Serialize(ctx, report)
+		},
+	}
+}
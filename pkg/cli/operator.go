@@ -0,0 +1,145 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/urfave/cli/v3"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+	"github.com/NVIDIA/eidos/pkg/operator"
+	"github.com/NVIDIA/eidos/pkg/server"
+)
+
+func operatorCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "operator",
+		Category:              functionalCategoryName,
+		EnableShellCompletion: true,
+		Usage:                 "Run the operator that installs the stack declared by Recipe resources.",
+		Description: `Runs a controller that watches Recipe custom resources
+(eidos.nvidia.com/v1alpha1) and installs the recipe they declare as Helm
+releases, the same way 'eidos deploy' does:
+
+  apiVersion: eidos.nvidia.com/v1alpha1
+  kind: Recipe
+  metadata:
+    name: gpu-stack
+  spec:
+    criteria:
+      service: eks
+      accelerator: h100
+      intent: training
+    set:
+      - gpuoperator:driver.version=580.82.07
+
+Each new or changed spec is rendered and applied; the phase, the installed
+releases and a Ready condition are reported in the Recipe status. Failed
+reconciliations are retried with backoff. Set spec.suspend to pause
+reconciliation. Deleting a Recipe leaves its releases installed.
+
+The Recipe CRD is created or updated on start unless --install-crd=false.
+/health, /ready and /metrics are served on --port.
+
+The operator is meant to run in the cluster, see deployments/eidos-operator.
+
+# Examples
+
+Run against the current kubeconfig context:
+  eidos operator
+
+Print the CRD to apply it separately:
+  eidos operator --print-crd | kubectl apply -f -
+`,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "install-crd",
+				Value: true,
+				Usage: "Create or update the Recipe CRD on start",
+			},
+			&cli.BoolFlag{
+				Name:  "print-crd",
+				Usage: "Print the Recipe CRD and exit",
+			},
+			&cli.DurationFlag{
+				Name:  "resync",
+				Value: operator.DefaultResync,
+				Usage: "How often every Recipe is re-queued; failed Recipes are retried",
+			},
+			&cli.IntFlag{
+				Name:    "port",
+				Usage:   "Port serving health and metrics",
+				Sources: cli.EnvVars("PORT"),
+				Value:   8080,
+			},
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Bool("print-crd") {
+				_, err := cmd.Root().Writer.Write(operator.CRD())
+				return err
+			}
+
+			kubeconfig := cmd.String("kubeconfig")
+			_, restConfig, err := client.GetKubeClientWithConfig(kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to create Kubernetes client: %w", err)
+			}
+			dyn, err := dynamic.NewForConfig(restConfig)
+			if err != nil {
+				return fmt.Errorf("failed to create dynamic client: %w", err)
+			}
+
+			if cmd.Bool("install-crd") {
+				if err := operator.EnsureCRD(ctx, dyn); err != nil {
+					return err
+				}
+			}
+
+			c := operator.New(dyn,
+				operator.WithKubeconfig(kubeconfig),
+				operator.WithVersion(version),
+				operator.WithResync(cmd.Duration("resync")),
+			)
+
+			cfg := server.NewConfig()
+			cfg.Port = int(cmd.Int("port"))
+			s := server.New(
+				server.WithConfig(cfg),
+				server.WithName("eidos-operator"),
+				server.WithVersion(version),
+			)
+
+			runCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			g, gctx := errgroup.WithContext(runCtx)
+			g.Go(func() error {
+				return c.Run(gctx)
+			})
+			g.Go(func() error {
+				return s.Run(gctx)
+			})
+			return g.Wait()
+		},
+	}
+}
@@ -22,11 +22,13 @@ import (
 
 	"github.com/urfave/cli/v3"
 
+	"github.com/NVIDIA/eidos/pkg/compat"
 	"github.com/NVIDIA/eidos/pkg/measurement"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 	"github.com/NVIDIA/eidos/pkg/serializer"
 	"github.com/NVIDIA/eidos/pkg/snapshotter"
 	"github.com/NVIDIA/eidos/pkg/validator"
+	eidosversion "github.com/NVIDIA/eidos/pkg/version"
 )
 
 func recipeCmd() *cli.Command {
@@ -36,11 +38,13 @@ func recipeCmd() *cli.Command {
 		EnableShellCompletion: true,
 		Usage:                 "Create optimized recipe for given intent and environment parameters.",
 		Description: `Generate configuration recipe based on specified environment parameters including:
-  - Kubernetes service type (e.g. eks, gke, aks, oke, self-managed)
+  - Kubernetes service type (e.g. eks, gke, aks, oke, rke2, openshift, self-managed)
   - Accelerator type (e.g. h100, gb200, a100, l40)
   - Workload intent (e.g. training, inference)
   - GPU node operating system (e.g. ubuntu, rhel, cos, amazonlinux)
-  - Number of GPU nodes in the cluster
+  - Number of GPU nodes in the cluster, or the cluster scale
+    (small: up to 8 GPU nodes, medium: 9-64, large: more than 64)
+  - GPU partitioning strategy (e.g. mig-single, mig-mixed, vgpu, passthrough)
 
 The recipe returns a list of components with deployment order based on dependencies.
 Output can be in JSON or YAML format.
@@ -66,7 +70,34 @@ Override criteria file values with flags:
   eidos recipe --criteria criteria.yaml --service gke
 
 Override snapshot-detected criteria:
-  eidos recipe --snapshot cm://gpu-operator/eidos-snapshot --service gke`,
+  eidos recipe --snapshot cm://gpu-operator/eidos-snapshot --service gke
+
+Generate per-node-group recipes from one snapshot per node pool:
+  eidos recipe --snapshot h100-pool.yaml --snapshot l40-pool.yaml
+
+Merge heterogeneous node pools into a single recipe:
+  eidos recipe --snapshot h100-pool.yaml --snapshot l40-pool.yaml --merge
+
+Compare training and inference recipes side by side:
+  eidos recipe --service eks --accelerator h100 --intent training,inference --compare
+
+Show which overlay set each value:
+  eidos recipe --service eks --accelerator gb200 --intent training --explain
+
+Generate a recipe for GPU node pools that scale to zero:
+  eidos recipe --service eks --accelerator h100 --intent inference --autoscaling
+
+Flag components that do not support the target Kubernetes version:
+  eidos recipe --service eks --accelerator h100 --kubernetes-version 1.28
+
+Merge recipes for separate stacks into one:
+  eidos recipe merge gpu.yaml networking.yaml -o combined.yaml
+
+List the overlays that can apply to GB200 clusters:
+  eidos recipe overlays --accelerator gb200
+
+Select criteria interactively:
+  eidos recipe wizard -o recipe.yaml`,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:  "service",
@@ -79,7 +110,12 @@ Override snapshot-detected criteria:
 			},
 			&cli.StringFlag{
 				Name:  "intent",
-				Usage: fmt.Sprintf("Workload intent (e.g. %s)", strings.Join(recipe.GetCriteriaIntentTypes(), ", ")),
+				Usage: fmt.Sprintf("Workload intent (e.g. %s). With --compare, a comma-separated list of intents", strings.Join(recipe.GetCriteriaIntentTypes(), ", ")),
+			},
+			&cli.BoolFlag{
+				Name: "compare",
+				Usage: `Build one recipe per intent listed in --intent and emit a comparison
+	highlighting the components and values that differ.`,
 			},
 			&cli.StringFlag{
 				Name:  "os",
@@ -90,11 +126,25 @@ Override snapshot-detected criteria:
 				Usage: "Number of worker/GPU nodes in the cluster",
 			},
 			&cli.StringFlag{
+				Name:  "scale",
+				Usage: fmt.Sprintf("Cluster scale (e.g. %s); derived from --nodes when not set", strings.Join(recipe.GetCriteriaScaleTypes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:  "partitioning",
+				Usage: fmt.Sprintf("GPU partitioning strategy (e.g. %s)", strings.Join(recipe.GetCriteriaPartitioningTypes(), ", ")),
+			},
+			&cli.StringSliceFlag{
 				Name:    "snapshot",
 				Aliases: []string{"s"},
 				Usage: `Path/URI to previously generated configuration snapshot.
-	Supports: file paths, HTTP/HTTPS URLs, or ConfigMap URIs (cm://namespace/name).
-	If provided, criteria are extracted from the snapshot.`,
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).
+	If provided, criteria are extracted from the snapshot. Can be repeated with one
+	snapshot per node pool to generate per-node-group recipes.`,
+			},
+			&cli.BoolFlag{
+				Name: "merge",
+				Usage: `When multiple snapshots describe heterogeneous node pools, emit a single merged
+	recipe instead of one recipe per node group.`,
 			},
 			&cli.StringFlag{
 				Name:    "criteria",
@@ -102,16 +152,36 @@ Override snapshot-detected criteria:
 				Usage: `Path to criteria file (YAML/JSON), alternative to individual flags.
 	Criteria file fields can be overridden by individual flags.`,
 			},
+			&cli.BoolFlag{
+				Name: "explain",
+				Usage: `Annotate the recipe with the data file (base, overlay, registry) that set
+	each constraint, component field, override and component value.`,
+			},
+			&cli.BoolFlag{
+				Name: "autoscaling",
+				Usage: `Apply the autoscaling overlay: priority classes and Cluster Autoscaler
+	annotations so components do not block scale-down of GPU node pools, including to zero.`,
+			},
+			kubernetesVersionFlag,
 			dataFlag,
+			recipeDataFlag,
 			outputFlag,
 			formatFlag,
 			kubeconfigFlag,
 		},
+		Commands: []*cli.Command{
+			recipeDataCmd(),
+			recipeMergeCmd(),
+			recipeOverlaysCmd(),
+			recipeWizardCmd(),
+		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			// Initialize external data provider if --data flag is set
-			if err := initDataProvider(cmd); err != nil {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
 				return fmt.Errorf("failed to initialize data provider: %w", err)
 			}
+			defer cleanup()
 
 			// Parse output format
 			outFormat, err := parseOutputFormat(cmd)
@@ -119,57 +189,64 @@ Override snapshot-detected criteria:
 				return err
 			}
 
+			// Parse target Kubernetes version for compatibility checks
+			var k8sVersion *eidosversion.Version
+			if v := cmd.String("kubernetes-version"); v != "" {
+				parsed, parseErr := eidosversion.ParseVersion(v)
+				if parseErr != nil {
+					return fmt.Errorf("invalid --kubernetes-version value: %w", parseErr)
+				}
+				k8sVersion = &parsed
+			}
+
 			// Create builder
 			builder := recipe.NewBuilder(
 				recipe.WithVersion(version),
+				recipe.WithExplain(cmd.Bool("explain")),
+				recipe.WithAutoscaling(cmd.Bool("autoscaling")),
 			)
 
-			var result *recipe.RecipeResult
+			var (
+				result     *recipe.RecipeResult
+				set        *recipe.RecipeSet
+				comparison *recipe.RecipeComparison
+			)
 
 			// Check if using snapshot or criteria file
 			// Precedence: snapshot > criteria file > CLI flags
-			snapFilePath := cmd.String("snapshot")
+			snapFilePaths := cmd.StringSlice("snapshot")
 			criteriaFilePath := cmd.String("criteria")
 
 			//nolint:gocritic // if-else chain is appropriate for non-empty string conditions
-			if snapFilePath != "" {
-				slog.Info("loading snapshot from", "uri", snapFilePath)
-				snap, loadErr := serializer.FromFileWithKubeconfig[snapshotter.Snapshot](snapFilePath, cmd.String("kubeconfig"))
+			if cmd.Bool("compare") {
+				if len(snapFilePaths) > 0 {
+					return fmt.Errorf("--compare cannot be combined with --snapshot")
+				}
+				comparison, err = buildComparisonFromCmd(ctx, cmd, builder)
+			} else if len(snapFilePaths) > 0 {
+				nodes, loadErr := loadNodeCriteria(cmd, snapFilePaths)
 				if loadErr != nil {
-					return fmt.Errorf("failed to load snapshot from %q: %w", snapFilePath, loadErr)
+					return loadErr
 				}
 
-				// Extract criteria from snapshot
-				criteria := extractCriteriaFromSnapshot(snap)
-
-				// Apply CLI overrides
-				if applyErr := applyCriteriaOverrides(cmd, criteria); applyErr != nil {
-					return applyErr
-				}
+				groups := recipe.GroupNodes(nodes)
+				if len(groups) == 1 || cmd.Bool("merge") {
+					criteria := recipe.MergeNodeGroupCriteria(groups)
 
-				// Create a constraint evaluator that uses the snapshot
-				// This wraps validator.EvaluateConstraint with the snapshot data
-				evaluator := func(constraint recipe.Constraint) recipe.ConstraintEvalResult {
-					valResult := validator.EvaluateConstraint(constraint, snap)
-					return recipe.ConstraintEvalResult{
-						Passed: valResult.Passed,
-						Actual: valResult.Actual,
-						Error:  valResult.Error,
+					slog.Info("building recipe from snapshot with constraint validation",
+						"criteria", criteria.String(),
+						"groups", len(groups))
+					result, err = builder.BuildFromCriteriaWithEvaluator(ctx, criteria, mergedEvaluator(nodes))
+					if result != nil {
+						logConstraintWarnings("", result)
 					}
-				}
-
-				slog.Info("building recipe from snapshot with constraint validation", "criteria", criteria.String())
-				result, err = builder.BuildFromCriteriaWithEvaluator(ctx, criteria, evaluator)
-
-				// Log constraint warnings for visibility
-				if result != nil && len(result.Metadata.ConstraintWarnings) > 0 {
-					for _, w := range result.Metadata.ConstraintWarnings {
-						slog.Warn("overlay excluded due to constraint failure",
-							"overlay", w.Overlay,
-							"constraint", w.Constraint,
-							"expected", w.Expected,
-							"actual", w.Actual,
-							"reason", w.Reason)
+				} else {
+					slog.Info("building per-node-group recipes from snapshots", "groups", len(groups))
+					set, err = builder.BuildFromNodeGroups(ctx, groups)
+					if set != nil {
+						for _, g := range set.Groups {
+							logConstraintWarnings(g.Name, g.Recipe)
+						}
 					}
 				}
 			} else if criteriaFilePath != "" {
@@ -196,7 +273,7 @@ Override snapshot-detected criteria:
 
 				// Validate that at least some criteria was provided
 				if criteria.Specificity() == 0 {
-					return fmt.Errorf("no criteria provided: specify at least one of --service, --accelerator, --intent, --os, --nodes, --criteria, or use --snapshot to load from a snapshot file")
+					return fmt.Errorf("no criteria provided: specify at least one of --service, --accelerator, --intent, --os, --nodes, --scale, --partitioning, --criteria, or use --snapshot to load from a snapshot file")
 				}
 
 				slog.Info("building recipe from criteria", "criteria", criteria.String())
@@ -207,6 +284,25 @@ Override snapshot-detected criteria:
 				return fmt.Errorf("error building recipe: %w", err)
 			}
 
+			if k8sVersion != nil {
+				results := []*recipe.RecipeResult{result}
+				switch {
+				case set != nil:
+					results = results[:0]
+					for _, g := range set.Groups {
+						results = append(results, g.Recipe)
+					}
+				case comparison != nil:
+					results = results[:0]
+					for _, ir := range comparison.Recipes {
+						results = append(results, ir.Recipe)
+					}
+				}
+				if err := checkCompatibility(*k8sVersion, results); err != nil {
+					return err
+				}
+			}
+
 			// Serialize output
 			output := cmd.String("output")
 			ser, err := serializer.NewFileWriterOrStdout(outFormat, output)
@@ -221,6 +317,31 @@ Override snapshot-detected criteria:
 				}
 			}()
 
+			if comparison != nil {
+				if err := ser.Serialize(ctx, comparison); err != nil {
+					return fmt.Errorf("failed to serialize recipe comparison: %w", err)
+				}
+
+				slog.Info("recipe comparison completed",
+					"output", output,
+					"intents", len(comparison.Intents),
+					"differences", len(comparison.Differences))
+
+				return nil
+			}
+
+			if set != nil {
+				if err := ser.Serialize(ctx, set); err != nil {
+					return fmt.Errorf("failed to serialize recipe set: %w", err)
+				}
+
+				slog.Info("recipe generation completed",
+					"output", output,
+					"groups", len(set.Groups))
+
+				return nil
+			}
+
 			if err := ser.Serialize(ctx, result); err != nil {
 				return fmt.Errorf("failed to serialize recipe: %w", err)
 			}
@@ -235,127 +356,208 @@ Override snapshot-detected criteria:
 	}
 }
 
-// buildCriteriaFromCmd constructs a recipe.Criteria from CLI command flags.
-func buildCriteriaFromCmd(cmd *cli.Command) (*recipe.Criteria, error) {
-	var opts []recipe.CriteriaOption
+// buildComparisonFromCmd builds one recipe per intent listed in --intent,
+// sharing the remaining criteria from the criteria file and flags.
+func buildComparisonFromCmd(ctx context.Context, cmd *cli.Command, builder *recipe.Builder) (*recipe.RecipeComparison, error) {
+	intents, err := recipe.ParseCriteriaIntentTypes(cmd.String("intent"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --intent value: %w", err)
+	}
+	if len(intents) < 2 {
+		return nil, fmt.Errorf("--compare requires at least two intents, e.g. --intent training,inference")
+	}
 
-	if s := cmd.String("service"); s != "" {
-		opts = append(opts, recipe.WithCriteriaService(s))
+	var criteria *recipe.Criteria
+	if criteriaFilePath := cmd.String("criteria"); criteriaFilePath != "" {
+		slog.Info("loading criteria from file", "path", criteriaFilePath)
+		criteria, err = recipe.LoadCriteriaFromFile(criteriaFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load criteria from %q: %w", criteriaFilePath, err)
+		}
+		if err := applyCriteriaOverrides(cmd, criteria); err != nil {
+			return nil, err
+		}
+	} else {
+		criteria, err = buildCriteriaFromCmd(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing criteria: %w", err)
+		}
 	}
-	if s := cmd.String("accelerator"); s != "" {
-		opts = append(opts, recipe.WithCriteriaAccelerator(s))
+
+	slog.Info("building recipe comparison",
+		"criteria", criteria.String(),
+		"intents", intents)
+	return builder.BuildComparison(ctx, criteria, intents)
+}
+
+// loadNodeCriteria loads each snapshot, extracts its criteria (with CLI overrides
+// applied) and pairs it with a constraint evaluator bound to that snapshot.
+func loadNodeCriteria(cmd *cli.Command, uris []string) ([]recipe.NodeCriteria, error) {
+	nodes := make([]recipe.NodeCriteria, 0, len(uris))
+
+	for _, uri := range uris {
+		slog.Info("loading snapshot from", "uri", uri)
+		snap, err := serializer.FromFileWithKubeconfig[snapshotter.Snapshot](uri, cmd.String("kubeconfig"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load snapshot from %q: %w", uri, err)
+		}
+
+		// Extract criteria from snapshot
+		criteria, _ := recipe.ExtractCriteriaFromSnapshot(snap)
+
+		// Apply CLI overrides
+		if err := applyCriteriaOverrides(cmd, criteria); err != nil {
+			return nil, err
+		}
+
+		nodeName := extractNodeNameFromSnapshot(snap)
+		if nodeName == "" {
+			nodeName = uri
+		}
+
+		nodes = append(nodes, recipe.NodeCriteria{
+			Node:      nodeName,
+			Criteria:  criteria,
+			Evaluator: snapshotEvaluator(snap),
+		})
 	}
-	if s := cmd.String("intent"); s != "" {
-		opts = append(opts, recipe.WithCriteriaIntent(s))
+
+	return nodes, nil
+}
+
+// snapshotEvaluator creates a constraint evaluator that uses the snapshot.
+// This wraps validator.EvaluateConstraint with the snapshot data.
+func snapshotEvaluator(snap *snapshotter.Snapshot) recipe.ConstraintEvaluatorFunc {
+	return func(constraint recipe.Constraint) recipe.ConstraintEvalResult {
+		valResult := validator.EvaluateConstraint(constraint, snap)
+		return recipe.ConstraintEvalResult{
+			Passed: valResult.Passed,
+			Actual: valResult.Actual,
+			Error:  valResult.Error,
+		}
 	}
-	if s := cmd.String("os"); s != "" {
-		opts = append(opts, recipe.WithCriteriaOS(s))
+}
+
+// mergedEvaluator returns an evaluator that only passes a constraint when it
+// passes against every node's snapshot. The first failure is reported.
+func mergedEvaluator(nodes []recipe.NodeCriteria) recipe.ConstraintEvaluatorFunc {
+	if len(nodes) == 1 {
+		return nodes[0].Evaluator
 	}
-	if n := cmd.Int("nodes"); n > 0 {
-		opts = append(opts, recipe.WithCriteriaNodes(n))
+	return func(constraint recipe.Constraint) recipe.ConstraintEvalResult {
+		var res recipe.ConstraintEvalResult
+		for _, n := range nodes {
+			res = n.Evaluator(constraint)
+			if !res.Passed || res.Error != nil {
+				return res
+			}
+		}
+		return res
 	}
+}
 
-	return recipe.BuildCriteria(opts...)
+// logConstraintWarnings logs overlays excluded due to constraint failures and
+// components adjusted based on the snapshot, for visibility.
+func logConstraintWarnings(group string, result *recipe.RecipeResult) {
+	for _, w := range result.Metadata.ConstraintWarnings {
+		attrs := []any{
+			"overlay", w.Overlay,
+			"constraint", w.Constraint,
+			"expected", w.Expected,
+			"actual", w.Actual,
+			"reason", w.Reason,
+		}
+		if group != "" {
+			attrs = append(attrs, "group", group)
+		}
+		slog.Warn("overlay excluded due to constraint failure", attrs...)
+	}
+	for _, w := range result.Metadata.ComponentWarnings {
+		attrs := []any{
+			"component", w.Component,
+			"reason", w.Reason,
+		}
+		if group != "" {
+			attrs = append(attrs, "group", group)
+		}
+		slog.Warn("component adjusted based on snapshot", attrs...)
+	}
 }
 
-// extractCriteriaFromSnapshot extracts criteria from a snapshot.
-// This maps snapshot measurements to criteria fields.
-func extractCriteriaFromSnapshot(snap *snapshotter.Snapshot) *recipe.Criteria {
-	criteria := recipe.NewCriteria()
+// checkCompatibility flags components of the generated recipes that are
+// incompatible with the target Kubernetes version and records them as
+// constraint warnings.
+func checkCompatibility(target eidosversion.Version, results []*recipe.RecipeResult) error {
+	checker := compat.NewChecker()
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		issues, err := checker.Check(result, target)
+		if err != nil {
+			return fmt.Errorf("failed to check component compatibility: %w", err)
+		}
+		for _, issue := range issues {
+			slog.Warn("component incompatible with target Kubernetes version",
+				"component", issue.Component,
+				"kubernetes", issue.Actual,
+				"expected", issue.Expected,
+				"reason", issue.Reason)
+		}
+		compat.Annotate(result, issues)
+	}
+	return nil
+}
 
+// extractNodeNameFromSnapshot returns the node the snapshot was collected on,
+// as recorded by the Kubernetes collector, or an empty string if unknown.
+func extractNodeNameFromSnapshot(snap *snapshotter.Snapshot) string {
 	if snap == nil {
-		return criteria
+		return ""
 	}
-
-	// Extract from K8s measurements
 	for _, m := range snap.Measurements {
-		if m == nil {
+		if m == nil || m.Type != measurement.TypeK8s {
 			continue
 		}
-
-		switch m.Type {
-		case measurement.TypeK8s:
-			// Look for service type in server subtype
-			for _, st := range m.Subtypes {
-				if st.Name == "server" {
-					// Try direct "service" field first
-					if svcType, ok := st.Data["service"]; ok {
-						if parsed, err := recipe.ParseCriteriaServiceType(svcType.String()); err == nil {
-							criteria.Service = parsed
-						}
-					}
-
-					// Extract service from K8s version string (e.g., "v1.33.5-eks-3025e55")
-					if version, ok := st.Data["version"]; ok {
-						versionStr := version.String()
-						switch {
-						case strings.Contains(versionStr, "-eks-"):
-							criteria.Service = recipe.CriteriaServiceEKS
-						case strings.Contains(versionStr, "-gke"):
-							criteria.Service = recipe.CriteriaServiceGKE
-						case strings.Contains(versionStr, "-aks"):
-							criteria.Service = recipe.CriteriaServiceAKS
-						}
-					}
-				}
+		for _, st := range m.Subtypes {
+			if st.Name != "node" {
+				continue
 			}
-
-		case measurement.TypeGPU:
-			// Look for GPU/accelerator type in smi or device subtype
-			for _, st := range m.Subtypes {
-				if st.Name == "smi" || st.Name == "device" {
-					// Try "gpu.model" field (from nvidia-smi)
-					if model, ok := st.Data["gpu.model"]; ok {
-						modelStr := model.String()
-						// Map model names to accelerator types
-						switch {
-						case containsIgnoreCase(modelStr, "gb200"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorGB200
-						case containsIgnoreCase(modelStr, "h100"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorH100
-						case containsIgnoreCase(modelStr, "a100"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorA100
-						case containsIgnoreCase(modelStr, "l40"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorL40
-						}
-					}
-
-					// Also try plain "model" field
-					if model, ok := st.Data["model"]; ok {
-						modelStr := model.String()
-						switch {
-						case containsIgnoreCase(modelStr, "gb200"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorGB200
-						case containsIgnoreCase(modelStr, "h100"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorH100
-						case containsIgnoreCase(modelStr, "a100"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorA100
-						case containsIgnoreCase(modelStr, "l40"):
-							criteria.Accelerator = recipe.CriteriaAcceleratorL40
-						}
-					}
-				}
+			if name, ok := st.Data["source-node"]; ok {
+				return name.String()
 			}
+		}
+	}
+	return ""
+}
 
-		case measurement.TypeOS:
-			// Look for OS type in release subtype
-			for _, st := range m.Subtypes {
-				if st.Name == "release" {
-					if osID, ok := st.Data["ID"]; ok {
-						if parsed, err := recipe.ParseCriteriaOSType(osID.String()); err == nil {
-							criteria.OS = parsed
-						}
-					}
-				}
-			}
+// buildCriteriaFromCmd constructs a recipe.Criteria from CLI command flags.
+func buildCriteriaFromCmd(cmd *cli.Command) (*recipe.Criteria, error) {
+	var opts []recipe.CriteriaOption
 
-		case measurement.TypeSystemD:
-			// SystemD measurements not used for criteria extraction
-			continue
-		}
+	if s := cmd.String("service"); s != "" {
+		opts = append(opts, recipe.WithCriteriaService(s))
+	}
+	if s := cmd.String("accelerator"); s != "" {
+		opts = append(opts, recipe.WithCriteriaAccelerator(s))
+	}
+	if s := cmd.String("intent"); s != "" && !cmd.Bool("compare") {
+		opts = append(opts, recipe.WithCriteriaIntent(s))
+	}
+	if s := cmd.String("os"); s != "" {
+		opts = append(opts, recipe.WithCriteriaOS(s))
+	}
+	if n := cmd.Int("nodes"); n > 0 {
+		opts = append(opts, recipe.WithCriteriaNodes(n))
+	}
+	if s := cmd.String("scale"); s != "" {
+		opts = append(opts, recipe.WithCriteriaScale(s))
+	}
+	if s := cmd.String("partitioning"); s != "" {
+		opts = append(opts, recipe.WithCriteriaPartitioning(s))
 	}
 
-	return criteria
+	return recipe.BuildCriteria(opts...)
 }
 
 // applyCriteriaOverrides applies CLI flag overrides to criteria.
@@ -387,7 +589,8 @@ func applyCriteriaOverrides(cmd *cli.Command, criteria *recipe.Criteria) error {
 		}
 		criteria.Accelerator = parsed
 	}
-	if s := cmd.String("intent"); s != "" {
+	// With --compare, --intent lists the intents to compare instead
+	if s := cmd.String("intent"); s != "" && !cmd.Bool("compare") {
 		parsed, err := recipe.ParseCriteriaIntentType(s)
 		if err != nil {
 			return err
@@ -422,13 +625,31 @@ func applyCriteriaOverrides(cmd *cli.Command, criteria *recipe.Criteria) error {
 		}
 		criteria.Nodes = n
 	}
+	if s := cmd.String("scale"); s != "" {
+		parsed, err := recipe.ParseCriteriaScaleType(s)
+		if err != nil {
+			return err
+		}
+		if criteria.EffectiveScale() != recipe.CriteriaScaleAny && criteria.EffectiveScale() != parsed {
+			slog.Info("CLI flag overriding snapshot-detected value",
+				"field", "scale",
+				"detected", criteria.EffectiveScale(),
+				"override", parsed)
+		}
+		criteria.Scale = parsed
+	}
+	if s := cmd.String("partitioning"); s != "" {
+		parsed, err := recipe.ParseCriteriaPartitioningType(s)
+		if err != nil {
+			return err
+		}
+		if criteria.Partitioning != recipe.CriteriaPartitioningAny && criteria.Partitioning != "" && criteria.Partitioning != parsed {
+			slog.Info("CLI flag overriding snapshot-detected value",
+				"field", "partitioning",
+				"detected", criteria.Partitioning,
+				"override", parsed)
+		}
+		criteria.Partitioning = parsed
+	}
 	return nil
 }
-
-// containsIgnoreCase checks if s contains substr (case-insensitive).
-func containsIgnoreCase(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr ||
-		len(s) > 0 && len(substr) > 0 &&
-			(s[0]|0x20 == substr[0]|0x20) && containsIgnoreCase(s[1:], substr[1:]) ||
-		len(s) > 0 && containsIgnoreCase(s[1:], substr))
-}
@@ -0,0 +1,208 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// recipeDataChecksumSuffix is appended to an archive path to form its
+// sha256sum-compatible checksum file.
+const recipeDataChecksumSuffix = ".sha256"
+
+func recipeDataCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "data",
+		Usage: "Export and import recipe data for air-gapped environments.",
+		Description: `Packages the recipe data store (registry, overlays and component values) as a
+versioned archive so recipes can be updated independently of binary releases.
+
+The archive carries a manifest with a SHA256 checksum for every file and a
+format version handshake: archives written by a newer, incompatible eidos are
+rejected instead of being misread. A sha256sum-compatible checksum file is
+written next to the archive and verified on import when present.
+
+Archives are consumed with --recipe-data on recipe, bundle and deploy, or
+unpacked with "eidos recipe data import" for use with --data.`,
+		Commands: []*cli.Command{
+			recipeDataExportCmd(),
+			recipeDataImportCmd(),
+		},
+	}
+}
+
+func recipeDataExportCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Export the recipe data store as a versioned archive.",
+		Description: `Writes the effective recipe data to a gzipped tar archive. When --data is set,
+the external directory is merged over the embedded data first, so the archive
+captures exactly what recipe generation would use.
+
+Examples:
+
+Export the embedded recipe data:
+  eidos recipe data export --output recipe-data-v2.tgz --data-version v2
+
+Export embedded data merged with a local overlay directory:
+  eidos recipe data export --data ./my-recipes --output recipe-data-v2.tgz`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Required: true,
+				Usage:    "Path of the archive to write (e.g. recipe-data-v2.tgz)",
+			},
+			&cli.StringFlag{
+				Name:  "data-version",
+				Usage: "Version label recorded in the archive manifest (e.g. v2)",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize data provider: %w", err)
+			}
+			defer cleanup()
+
+			var buf bytes.Buffer
+			manifest, err := recipe.ExportDataArchive(&buf, recipe.GetDataProvider(), recipe.DataArchiveOptions{
+				DataVersion: cmd.String("data-version"),
+				GeneratedBy: version,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to export recipe data: %w", err)
+			}
+
+			output := cmd.String("output")
+			if err := os.WriteFile(output, buf.Bytes(), 0o600); err != nil {
+				return fmt.Errorf("failed to write archive: %w", err)
+			}
+			digest := sha256.Sum256(buf.Bytes())
+			checksum := fmt.Sprintf("%s  %s\n", hex.EncodeToString(digest[:]), filepath.Base(output))
+			if err := os.WriteFile(output+recipeDataChecksumSuffix, []byte(checksum), 0o600); err != nil {
+				return fmt.Errorf("failed to write archive checksum: %w", err)
+			}
+
+			slog.Info("recipe data exported",
+				"output", output,
+				"files", len(manifest.Files),
+				"dataVersion", manifest.DataVersion,
+				"sha256", hex.EncodeToString(digest[:]))
+			return nil
+		},
+	}
+}
+
+func recipeDataImportCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "import",
+		Usage:     "Verify a recipe data archive and unpack it into a directory.",
+		ArgsUsage: "<archive>",
+		Description: `Verifies the archive checksums and version handshake, then extracts the data
+into --dir, which must not exist or be empty. The directory can be passed to
+--data on recipe, bundle and deploy.
+
+Examples:
+
+Unpack an archive for use with --data:
+  eidos recipe data import recipe-data-v2.tgz --dir ./recipe-data
+  eidos recipe --data ./recipe-data --service eks --accelerator h100`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "dir",
+				Required: true,
+				Usage:    "Directory to extract the recipe data into",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() != 1 {
+				return fmt.Errorf("expected one archive to import, got %d", cmd.Args().Len())
+			}
+
+			manifest, err := importRecipeData(cmd.Args().First(), cmd.String("dir"))
+			if err != nil {
+				return err
+			}
+
+			slog.Info("recipe data imported",
+				"dir", cmd.String("dir"),
+				"files", len(manifest.Files),
+				"dataVersion", manifest.DataVersion,
+				"generatedBy", manifest.GeneratedBy)
+			return nil
+		},
+	}
+}
+
+// importRecipeData verifies the archive's checksum file, when present, and
+// extracts the archive into destDir.
+func importRecipeData(archivePath, destDir string) (*recipe.DataArchiveManifest, error) {
+	data, err := os.ReadFile(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recipe data archive: %w", err)
+	}
+	if err := verifyRecipeDataChecksum(archivePath, data); err != nil {
+		return nil, err
+	}
+
+	manifest, err := recipe.ImportDataArchive(bytes.NewReader(data), destDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import recipe data from %s: %w", archivePath, err)
+	}
+	return manifest, nil
+}
+
+// verifyRecipeDataChecksum compares the archive against the sha256sum-style
+// file written next to it by export. A missing checksum file is not an error
+// because the manifest checksums still protect the archive contents.
+func verifyRecipeDataChecksum(archivePath string, data []byte) error {
+	checksumPath := archivePath + recipeDataChecksumSuffix
+	f, err := os.Open(checksumPath)
+	if os.IsNotExist(err) {
+		slog.Debug("no checksum file next to recipe data archive", "path", checksumPath)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read checksum file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return fmt.Errorf("checksum file is empty: %s", checksumPath)
+	}
+	want, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+
+	digest := sha256.Sum256(data)
+	if got := hex.EncodeToString(digest[:]); !strings.EqualFold(got, want) {
+		return fmt.Errorf("recipe data archive checksum mismatch: %s has sha256 %s, %s expects %s",
+			archivePath, got, checksumPath, want)
+	}
+	return nil
+}
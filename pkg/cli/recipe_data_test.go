@@ -0,0 +1,116 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func runRecipeDataCmd(args ...string) error {
+	root := &cli.Command{
+		Name:     name,
+		Commands: []*cli.Command{recipeCmd()},
+	}
+	return root.Run(context.Background(), append([]string{name, "recipe", "data"}, args...))
+}
+
+func TestRecipeDataCmd_ExportImport(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "recipe-data-v2.tgz")
+
+	if err := runRecipeDataCmd("export", "--output", archive, "--data-version", "v2"); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	checksum, err := os.ReadFile(archive + recipeDataChecksumSuffix)
+	if err != nil {
+		t.Fatalf("checksum file not written: %v", err)
+	}
+	if !strings.HasSuffix(strings.TrimSpace(string(checksum)), "  recipe-data-v2.tgz") {
+		t.Errorf("checksum file not in sha256sum format: %q", checksum)
+	}
+
+	dest := filepath.Join(dir, "data")
+	if err := runRecipeDataCmd("import", archive, "--dir", dest); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "registry.yaml")); err != nil {
+		t.Errorf("registry.yaml not imported: %v", err)
+	}
+
+	// Corrupting the archive is caught by the checksum file.
+	f, err := os.OpenFile(archive, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("junk")
+	f.Close()
+
+	err = runRecipeDataCmd("import", archive, "--dir", filepath.Join(dir, "again"))
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("expected checksum mismatch, got %v", err)
+	}
+}
+
+func TestRecipeDataCmd_ImportRequiresArchive(t *testing.T) {
+	if err := runRecipeDataCmd("import", "--dir", t.TempDir()); err == nil {
+		t.Error("expected error without archive argument")
+	}
+}
+
+func TestInitDataProvider_RecipeDataArchive(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "recipe-data.tgz")
+	if err := runRecipeDataCmd("export", "--output", archive); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	t.Cleanup(func() {
+		recipe.SetDataProvider(recipe.NewEmbeddedDataProvider(recipe.GetEmbeddedFS(), "data"))
+	})
+
+	var extracted string
+	testCmd := &cli.Command{
+		Name:  "test",
+		Flags: []cli.Flag{dataFlag, recipeDataFlag},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			extracted = recipe.GetDataProvider().Source("overlays/base.yaml")
+			return nil
+		},
+	}
+
+	if err := testCmd.Run(context.Background(), []string{"test", "--recipe-data", archive}); err != nil {
+		t.Fatalf("expected archive to load, got: %v", err)
+	}
+	if extracted != "external" {
+		t.Errorf("overlays/base.yaml source = %q, want external", extracted)
+	}
+
+	err := testCmd.Run(context.Background(), []string{"test", "--recipe-data", archive, "--data", dir})
+	if err == nil || !strings.Contains(err.Error(), "cannot be used together") {
+		t.Errorf("expected mutually exclusive flag error, got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func recipeMergeCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "merge",
+		Usage:     "Merge recipes for separate stacks into one recipe.",
+		ArgsUsage: "<recipe> <recipe> [recipe...]",
+		Description: `Combines recipes maintained for separate stacks, such as a GPU stack, a
+networking stack and an observability stack, into one recipe that is bundled
+and deployed together.
+
+The merge fails instead of picking a winner when the recipes disagree:
+  - A component in several recipes must be defined identically (type, source,
+    version, values file, overrides, ...). Its dependencies are the union of
+    the dependencies in each recipe.
+  - Constraints with the same name must have the same value.
+  - Criteria fields must match, where "any" takes the value of the other recipes.
+
+All conflicts are listed. The merged dependencies are validated and the
+deployment order is recomputed from them.
+
+Examples:
+
+Merge two recipes into a file:
+  eidos recipe merge gpu.yaml networking.yaml -o combined.yaml
+
+Merge recipes stored in ConfigMaps:
+  eidos recipe merge cm://platform/gpu-recipe cm://platform/observability-recipe`,
+		Flags: []cli.Flag{
+			outputFlag,
+			formatFlag,
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if cmd.Args().Len() < 2 {
+				return fmt.Errorf("expected at least two recipes to merge, got %d", cmd.Args().Len())
+			}
+
+			outFormat, err := parseOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			kubeconfig := cmd.String("kubeconfig")
+			recipes := make([]recipe.NamedRecipe, 0, cmd.Args().Len())
+			for _, path := range cmd.Args().Slice() {
+				rec, loadErr := serializer.FromFileWithKubeconfig[recipe.RecipeResult](path, kubeconfig)
+				if loadErr != nil {
+					return fmt.Errorf("failed to load recipe from %q: %w", path, loadErr)
+				}
+				recipes = append(recipes, recipe.NamedRecipe{Name: path, Recipe: rec})
+			}
+
+			merged, err := recipe.MergeRecipes(recipes)
+			if err != nil {
+				return fmt.Errorf("failed to merge recipes: %w", err)
+			}
+
+			output := cmd.String("output")
+			ser, err := serializer.NewFileWriterOrStdout(outFormat, output)
+			if err != nil {
+				return fmt.Errorf("failed to create output writer: %w", err)
+			}
+			defer func() {
+				if closer, ok := ser.(interface{ Close() error }); ok {
+					if err := closer.Close(); err != nil {
+						slog.Warn("failed to close serializer", "error", err)
+					}
+				}
+			}()
+
+			if err := ser.Serialize(ctx, merged); err != nil {
+				return fmt.Errorf("failed to serialize recipe: %w", err)
+			}
+
+			slog.Info("recipes merged",
+				"output", output,
+				"recipes", len(recipes),
+				"components", len(merged.ComponentRefs))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func recipeOverlaysCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "overlays",
+		Usage: "List the overlays available in the recipe data.",
+		Description: `Lists the overlays recipes are built from: the criteria each overlay matches,
+the recipe it inherits from, the components it adds to or modifies in that
+recipe, and the constraints it imposes.
+
+Criteria flags restrict the list to overlays that can apply to them. A flag
+left unset does not filter, so --accelerator gb200 lists every overlay that
+can apply to GB200 clusters on any service.
+
+Examples:
+
+List all overlays:
+  eidos recipe overlays
+
+List overlays for GB200 on EKS as JSON:
+  eidos recipe overlays --accelerator gb200 --service eks --format json
+
+Include overlays from an external data directory:
+  eidos recipe overlays --data ./my-recipes`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "service",
+				Usage: fmt.Sprintf("Kubernetes service type (e.g. %s)", strings.Join(recipe.GetCriteriaServiceTypes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:    "accelerator",
+				Aliases: []string{"gpu"},
+				Usage:   fmt.Sprintf("Accelerator/GPU type (e.g. %s)", strings.Join(recipe.GetCriteriaAcceleratorTypes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:  "intent",
+				Usage: fmt.Sprintf("Workload intent (e.g. %s)", strings.Join(recipe.GetCriteriaIntentTypes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:  "os",
+				Usage: fmt.Sprintf("Operating system type of the GPU node (e.g. %s)", strings.Join(recipe.GetCriteriaOSTypes(), ", ")),
+			},
+			&cli.IntFlag{
+				Name:  "nodes",
+				Usage: "Number of worker/GPU nodes in the cluster",
+			},
+			&cli.StringFlag{
+				Name:  "scale",
+				Usage: fmt.Sprintf("Cluster scale (e.g. %s); derived from --nodes when not set", strings.Join(recipe.GetCriteriaScaleTypes(), ", ")),
+			},
+			&cli.StringFlag{
+				Name:  "partitioning",
+				Usage: fmt.Sprintf("GPU partitioning strategy (e.g. %s)", strings.Join(recipe.GetCriteriaPartitioningTypes(), ", ")),
+			},
+			dataFlag,
+			recipeDataFlag,
+			outputFlag,
+			formatFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize data provider: %w", err)
+			}
+			defer cleanup()
+
+			outFormat, err := parseOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			var criteria *recipe.Criteria
+			if hasCriteriaFlags(cmd) {
+				if criteria, err = buildCriteriaFromCmd(cmd); err != nil {
+					return fmt.Errorf("error parsing criteria: %w", err)
+				}
+			}
+
+			overlays, err := recipe.GetAvailableOverlays(ctx, criteria)
+			if err != nil {
+				return fmt.Errorf("failed to list overlays: %w", err)
+			}
+
+			output := cmd.String("output")
+			ser, err := serializer.NewFileWriterOrStdout(outFormat, output)
+			if err != nil {
+				return fmt.Errorf("failed to create output writer: %w", err)
+			}
+			defer func() {
+				if closer, ok := ser.(interface{ Close() error }); ok {
+					if err := closer.Close(); err != nil {
+						slog.Warn("failed to close serializer", "error", err)
+					}
+				}
+			}()
+
+			if err := ser.Serialize(ctx, &recipe.OverlayCatalog{Overlays: overlays}); err != nil {
+				return fmt.Errorf("failed to serialize overlays: %w", err)
+			}
+
+			slog.Debug("overlays listed", "output", output, "overlays", len(overlays))
+			return nil
+		},
+	}
+}
+
+// hasCriteriaFlags reports whether any criteria flag is set on cmd.
+func hasCriteriaFlags(cmd *cli.Command) bool {
+	for _, name := range []string{"service", "accelerator", "intent", "os", "nodes", "scale", "partitioning"} {
+		if cmd.IsSet(name) {
+			return true
+		}
+	}
+	return false
+}
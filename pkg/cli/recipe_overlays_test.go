@@ -0,0 +1,53 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func TestRecipeOverlaysCmd(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "overlays.yaml")
+
+	cmd := recipeOverlaysCmd()
+	if err := cmd.Run(context.Background(), []string{"overlays", "--service", "eks", "--output", output}); err != nil {
+		t.Fatalf("overlays command failed: %v", err)
+	}
+
+	catalog, err := serializer.FromFile[recipe.OverlayCatalog](output)
+	if err != nil {
+		t.Fatalf("failed to read overlays: %v", err)
+	}
+	if len(catalog.Overlays) == 0 {
+		t.Fatal("expected overlays in output")
+	}
+	for _, o := range catalog.Overlays {
+		if o.Criteria != nil && o.Criteria.Service != "" && o.Criteria.Service != recipe.CriteriaServiceEKS {
+			t.Errorf("overlay %s for service %s should be filtered out", o.Name, o.Criteria.Service)
+		}
+	}
+}
+
+func TestRecipeOverlaysCmd_InvalidCriteria(t *testing.T) {
+	cmd := recipeOverlaysCmd()
+	if err := cmd.Run(context.Background(), []string{"overlays", "--service", "invalid"}); err == nil {
+		t.Error("expected error for invalid service")
+	}
+}
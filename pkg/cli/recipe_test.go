@@ -21,9 +21,7 @@ import (
 
 	"github.com/urfave/cli/v3"
 
-	"github.com/NVIDIA/eidos/pkg/measurement"
 	"github.com/NVIDIA/eidos/pkg/recipe"
-	"github.com/NVIDIA/eidos/pkg/snapshotter"
 )
 
 func TestBuildCriteriaFromCmd(t *testing.T) {
@@ -112,6 +110,21 @@ func TestBuildCriteriaFromCmd(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "valid scale range",
+			args: []string{"cmd", "--scale", ">64"},
+			validate: func(t *testing.T, c *recipe.Criteria) {
+				if c.Scale != recipe.CriteriaScaleLarge {
+					t.Errorf("Scale = %v, want %v", c.Scale, recipe.CriteriaScaleLarge)
+				}
+			},
+		},
+		{
+			name:      "invalid scale",
+			args:      []string{"cmd", "--scale", "huge"},
+			wantError: true,
+			errMsg:    "invalid scale",
+		},
 		{
 			name: "complete criteria",
 			args: []string{
@@ -164,6 +177,8 @@ func TestBuildCriteriaFromCmd(t *testing.T) {
 					&cli.StringFlag{Name: "intent"},
 					&cli.StringFlag{Name: "os"},
 					&cli.IntFlag{Name: "nodes"},
+					&cli.StringFlag{Name: "scale"},
+					&cli.StringFlag{Name: "partitioning"},
 				},
 				Action: func(ctx context.Context, cmd *cli.Command) error {
 					capturedCriteria, capturedErr = buildCriteriaFromCmd(cmd)
@@ -210,188 +225,6 @@ func TestBuildCriteriaFromCmd(t *testing.T) {
 	}
 }
 
-func TestExtractCriteriaFromSnapshot(t *testing.T) {
-	tests := []struct {
-		name     string
-		snapshot *snapshotter.Snapshot
-		validate func(*testing.T, *recipe.Criteria)
-	}{
-		{
-			name:     "nil snapshot",
-			snapshot: nil,
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c == nil {
-					t.Error("expected non-nil criteria")
-				}
-			},
-		},
-		{
-			name: "empty snapshot",
-			snapshot: &snapshotter.Snapshot{
-				Measurements: nil,
-			},
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c == nil {
-					t.Error("expected non-nil criteria")
-				}
-			},
-		},
-		{
-			name: "snapshot with K8s service",
-			snapshot: &snapshotter.Snapshot{
-				Measurements: []*measurement.Measurement{
-					{
-						Type: "K8s",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "server",
-								Data: map[string]measurement.Reading{
-									"service": measurement.Str("eks"),
-								},
-							},
-						},
-					},
-				},
-			},
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c.Service != recipe.CriteriaServiceEKS {
-					t.Errorf("Service = %v, want %v", c.Service, recipe.CriteriaServiceEKS)
-				}
-			},
-		},
-		{
-			name: "snapshot with GPU H100",
-			snapshot: &snapshotter.Snapshot{
-				Measurements: []*measurement.Measurement{
-					{
-						Type: "GPU",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "device",
-								Data: map[string]measurement.Reading{
-									"model": measurement.Str("NVIDIA H100 80GB HBM3"),
-								},
-							},
-						},
-					},
-				},
-			},
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c.Accelerator != recipe.CriteriaAcceleratorH100 {
-					t.Errorf("Accelerator = %v, want %v", c.Accelerator, recipe.CriteriaAcceleratorH100)
-				}
-			},
-		},
-		{
-			name: "snapshot with GB200",
-			snapshot: &snapshotter.Snapshot{
-				Measurements: []*measurement.Measurement{
-					{
-						Type: "GPU",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "device",
-								Data: map[string]measurement.Reading{
-									"model": measurement.Str("NVIDIA GB200"),
-								},
-							},
-						},
-					},
-				},
-			},
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c.Accelerator != recipe.CriteriaAcceleratorGB200 {
-					t.Errorf("Accelerator = %v, want %v", c.Accelerator, recipe.CriteriaAcceleratorGB200)
-				}
-			},
-		},
-		{
-			name: "snapshot with OS ubuntu",
-			snapshot: &snapshotter.Snapshot{
-				Measurements: []*measurement.Measurement{
-					{
-						Type: "OS",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "release",
-								Data: map[string]measurement.Reading{
-									"ID": measurement.Str("ubuntu"),
-								},
-							},
-						},
-					},
-				},
-			},
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c.OS != recipe.CriteriaOSUbuntu {
-					t.Errorf("OS = %v, want %v", c.OS, recipe.CriteriaOSUbuntu)
-				}
-			},
-		},
-		{
-			name: "complete snapshot",
-			snapshot: &snapshotter.Snapshot{
-				Measurements: []*measurement.Measurement{
-					{
-						Type: "K8s",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "server",
-								Data: map[string]measurement.Reading{
-									"service": measurement.Str("gke"),
-								},
-							},
-						},
-					},
-					{
-						Type: "GPU",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "device",
-								Data: map[string]measurement.Reading{
-									"model": measurement.Str("A100-SXM4-80GB"),
-								},
-							},
-						},
-					},
-					{
-						Type: "OS",
-						Subtypes: []measurement.Subtype{
-							{
-								Name: "release",
-								Data: map[string]measurement.Reading{
-									"ID": measurement.Str("rhel"),
-								},
-							},
-						},
-					},
-				},
-			},
-			validate: func(t *testing.T, c *recipe.Criteria) {
-				if c.Service != recipe.CriteriaServiceGKE {
-					t.Errorf("Service = %v, want %v", c.Service, recipe.CriteriaServiceGKE)
-				}
-				if c.Accelerator != recipe.CriteriaAcceleratorA100 {
-					t.Errorf("Accelerator = %v, want %v", c.Accelerator, recipe.CriteriaAcceleratorA100)
-				}
-				if c.OS != recipe.CriteriaOSRHEL {
-					t.Errorf("OS = %v, want %v", c.OS, recipe.CriteriaOSRHEL)
-				}
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			criteria := extractCriteriaFromSnapshot(tt.snapshot)
-
-			if tt.validate != nil {
-				tt.validate(t, criteria)
-			}
-		})
-	}
-}
-
 func TestApplyCriteriaOverrides(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -451,6 +284,8 @@ func TestApplyCriteriaOverrides(t *testing.T) {
 					&cli.StringFlag{Name: "intent"},
 					&cli.StringFlag{Name: "os"},
 					&cli.IntFlag{Name: "nodes"},
+					&cli.StringFlag{Name: "scale"},
+					&cli.StringFlag{Name: "partitioning"},
 				},
 				Action: func(ctx context.Context, cmd *cli.Command) error {
 					return applyCriteriaOverrides(cmd, tt.initial)
@@ -493,7 +328,7 @@ func TestRecipeCmd_CommandStructure(t *testing.T) {
 		t.Error("Description should not be empty")
 	}
 
-	requiredFlags := []string{"service", "accelerator", "intent", "os", "nodes", "snapshot", "output", "format"}
+	requiredFlags := []string{"service", "accelerator", "intent", "os", "nodes", "scale", "partitioning", "snapshot", "output", "format"}
 	for _, flagName := range requiredFlags {
 		found := false
 		for _, flag := range cmd.Flags {
@@ -563,11 +398,11 @@ func TestSnapshotCmd_CommandStructure(t *testing.T) {
 	}
 }
 
-func TestCommandLister(_ *testing.T) {
-	commandLister(context.Background(), nil)
+func TestCompleteCommand(_ *testing.T) {
+	completeCommand(context.Background(), nil)
 
 	cmd := &cli.Command{Name: "test"}
-	commandLister(context.Background(), cmd)
+	completeCommand(context.Background(), cmd)
 
 	rootCmd := &cli.Command{
 		Name: "root",
@@ -577,34 +412,7 @@ func TestCommandLister(_ *testing.T) {
 			{Name: "visible2", Hidden: false},
 		},
 	}
-	commandLister(context.Background(), rootCmd)
-}
-
-func TestContainsIgnoreCase(t *testing.T) {
-	tests := []struct {
-		s      string
-		substr string
-		want   bool
-	}{
-		{"NVIDIA H100", "h100", true},
-		{"h100", "H100", true},
-		{"GB200", "gb200", true},
-		{"NVIDIA A100-SXM4-80GB", "a100", true},
-		{"L40S", "l40", true},
-		{"H100", "gb200", false},
-		{"", "h100", false},
-		{"h100", "", true}, // empty substr matches anything
-		{"", "", true},     // empty matches empty
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.s+"_"+tt.substr, func(t *testing.T) {
-			got := containsIgnoreCase(tt.s, tt.substr)
-			if got != tt.want {
-				t.Errorf("containsIgnoreCase(%q, %q) = %v, want %v", tt.s, tt.substr, got, tt.want)
-			}
-		})
-	}
+	completeCommand(context.Background(), rootCmd)
 }
 
 func hasName(flag cli.Flag, name string) bool {
@@ -644,7 +452,8 @@ func TestInitDataProvider_EmptyPath(t *testing.T) {
 			&cli.StringFlag{Name: "data"},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
-			return initDataProvider(cmd)
+			_, err := initDataProvider(cmd)
+			return err
 		},
 	}
 
@@ -662,7 +471,8 @@ func TestInitDataProvider_InvalidPath(t *testing.T) {
 			&cli.StringFlag{Name: "data"},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
-			return initDataProvider(cmd)
+			_, err := initDataProvider(cmd)
+			return err
 		},
 	}
 
@@ -683,7 +493,8 @@ func TestInitDataProvider_MissingRegistry(t *testing.T) {
 			&cli.StringFlag{Name: "data"},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
-			return initDataProvider(cmd)
+			_, err := initDataProvider(cmd)
+			return err
 		},
 	}
 
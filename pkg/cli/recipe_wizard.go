@@ -0,0 +1,318 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func recipeWizardCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "wizard",
+		EnableShellCompletion: true,
+		Usage:                 "Interactively select recipe criteria and generate a recipe.",
+		Description: `Walks through the recipe criteria one question at a time: Kubernetes
+service, accelerator, workload intent, GPU node OS and node count.
+
+Each choice is listed with the overlays it would add to the recipe, and the
+overlays matching the criteria so far are shown after every answer, so only
+combinations backed by recipe data need to be discovered by trial and error.
+Press Enter to keep a criterion as "any".
+
+Prompts are written to stderr, so the recipe can be written to stdout.
+
+The equivalent 'eidos recipe' command is printed at the end.
+
+# Examples
+
+Write the recipe to a file:
+  eidos recipe wizard -o recipe.yaml
+
+Use an external data directory:
+  eidos recipe wizard --data ./my-data -o recipe.yaml`,
+		Flags: []cli.Flag{
+			dataFlag,
+			recipeDataFlag,
+			outputFlag,
+			formatFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize data provider: %w", err)
+			}
+			defer cleanup()
+
+			outFormat, err := parseOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			builder := recipe.NewBuilder(
+				recipe.WithVersion(version),
+			)
+
+			w := newRecipeWizard(cmd.Root().Reader, cmd.Root().ErrWriter, builder)
+			criteria, err := w.run(ctx)
+			if err != nil {
+				return err
+			}
+
+			slog.Info("building recipe from criteria", "criteria", criteria.String())
+			result, err := builder.BuildFromCriteria(ctx, criteria)
+			if err != nil {
+				return fmt.Errorf("error building recipe: %w", err)
+			}
+
+			output := cmd.String("output")
+			ser, err := serializer.NewFileWriterOrStdout(outFormat, output)
+			if err != nil {
+				return fmt.Errorf("failed to create output writer: %w", err)
+			}
+			defer func() {
+				if closer, ok := ser.(interface{ Close() error }); ok {
+					if err := closer.Close(); err != nil {
+						slog.Warn("failed to close serializer", "error", err)
+					}
+				}
+			}()
+
+			if err := ser.Serialize(ctx, result); err != nil {
+				return fmt.Errorf("failed to serialize recipe: %w", err)
+			}
+
+			slog.Info("recipe generation completed",
+				"output", output,
+				"components", len(result.ComponentRefs),
+				"overlays", len(result.Metadata.AppliedOverlays))
+
+			return nil
+		},
+	}
+}
+
+// wizardStep is one criteria question of the recipe wizard.
+type wizardStep struct {
+	// label names the criterion in prompts.
+	label string
+
+	// flag is the equivalent 'eidos recipe' flag.
+	flag string
+
+	// choices are the values offered; "any" is always offered first.
+	choices []string
+
+	// apply sets the criterion from a chosen value ("any" or one of choices).
+	apply func(c *recipe.Criteria, value string) error
+}
+
+// recipeWizard prompts for recipe criteria and previews matching overlays.
+type recipeWizard struct {
+	in      *bufio.Reader
+	out     io.Writer
+	builder *recipe.Builder
+	steps   []wizardStep
+}
+
+// newRecipeWizard returns a wizard reading answers from in and writing
+// prompts to out.
+func newRecipeWizard(in io.Reader, out io.Writer, builder *recipe.Builder) *recipeWizard {
+	return &recipeWizard{
+		in:      bufio.NewReader(in),
+		out:     out,
+		builder: builder,
+		steps: []wizardStep{
+			{
+				label:   "Kubernetes service",
+				flag:    "service",
+				choices: recipe.GetCriteriaServiceTypes(),
+				apply: func(c *recipe.Criteria, v string) error {
+					return recipe.WithCriteriaService(v)(c)
+				},
+			},
+			{
+				label:   "Accelerator",
+				flag:    "accelerator",
+				choices: recipe.GetCriteriaAcceleratorTypes(),
+				apply: func(c *recipe.Criteria, v string) error {
+					return recipe.WithCriteriaAccelerator(v)(c)
+				},
+			},
+			{
+				label:   "Workload intent",
+				flag:    "intent",
+				choices: recipe.GetCriteriaIntentTypes(),
+				apply: func(c *recipe.Criteria, v string) error {
+					return recipe.WithCriteriaIntent(v)(c)
+				},
+			},
+			{
+				label:   "GPU node OS",
+				flag:    "os",
+				choices: recipe.GetCriteriaOSTypes(),
+				apply: func(c *recipe.Criteria, v string) error {
+					return recipe.WithCriteriaOS(v)(c)
+				},
+			},
+		},
+	}
+}
+
+// run asks every question and returns the selected criteria.
+func (w *recipeWizard) run(ctx context.Context) (*recipe.Criteria, error) {
+	criteria := recipe.NewCriteria()
+	var flags []string
+
+	for _, step := range w.steps {
+		value, err := w.choose(ctx, criteria, step)
+		if err != nil {
+			return nil, err
+		}
+		if err := step.apply(criteria, value); err != nil {
+			return nil, err
+		}
+		if value != "any" {
+			flags = append(flags, fmt.Sprintf("--%s %s", step.flag, value))
+		}
+		if err := w.showMatches(ctx, criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := w.askNodes()
+	if err != nil {
+		return nil, err
+	}
+	criteria.Nodes = nodes
+	if nodes > 0 {
+		flags = append(flags, fmt.Sprintf("--nodes %d", nodes))
+		if err := w.showMatches(ctx, criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	fmt.Fprintf(w.out, "\nEquivalent command:\n  eidos recipe %s\n\n", strings.Join(flags, " "))
+	return criteria, nil
+}
+
+// choose lists the choices of step, each with the overlays it would add to
+// the current criteria, and returns the selected value.
+func (w *recipeWizard) choose(ctx context.Context, criteria *recipe.Criteria, step wizardStep) (string, error) {
+	current, err := w.builder.MatchingOverlays(ctx, criteria)
+	if err != nil {
+		return "", fmt.Errorf("failed to match overlays: %w", err)
+	}
+
+	choices := append([]string{"any"}, step.choices...)
+	fmt.Fprintf(w.out, "\n%s:\n", step.label)
+	for i, choice := range choices {
+		candidate := *criteria
+		if err := step.apply(&candidate, choice); err != nil {
+			return "", err
+		}
+		overlays, err := w.builder.MatchingOverlays(ctx, &candidate)
+		if err != nil {
+			return "", fmt.Errorf("failed to match overlays: %w", err)
+		}
+
+		var added []string
+		for _, o := range overlays {
+			if !slices.Contains(current, o) {
+				added = append(added, o)
+			}
+		}
+		line := fmt.Sprintf("  %d) %s", i+1, choice)
+		if len(added) > 0 {
+			line += "  (+" + strings.Join(added, ", +") + ")"
+		}
+		fmt.Fprintln(w.out, line)
+	}
+
+	for {
+		answer, err := w.ask(fmt.Sprintf("Choice [1-%d, default any]: ", len(choices)))
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			return "any", nil
+		}
+		if n, convErr := strconv.Atoi(answer); convErr == nil && n >= 1 && n <= len(choices) {
+			return choices[n-1], nil
+		}
+		if slices.Contains(choices, strings.ToLower(answer)) {
+			return strings.ToLower(answer), nil
+		}
+		fmt.Fprintf(w.out, "Invalid choice %q, enter a number or one of: %s\n", answer, strings.Join(choices, ", "))
+	}
+}
+
+// askNodes asks for the number of GPU nodes; 0 means any.
+func (w *recipeWizard) askNodes() (int, error) {
+	for {
+		answer, err := w.ask("\nNumber of GPU nodes [default any]: ")
+		if err != nil {
+			return 0, err
+		}
+		if answer == "" || answer == "any" {
+			return 0, nil
+		}
+		n, convErr := strconv.Atoi(answer)
+		if convErr == nil && n >= 0 {
+			return n, nil
+		}
+		fmt.Fprintf(w.out, "Invalid node count %q, enter a non-negative number\n", answer)
+	}
+}
+
+// showMatches prints the overlays matching criteria.
+func (w *recipeWizard) showMatches(ctx context.Context, criteria *recipe.Criteria) error {
+	overlays, err := w.builder.MatchingOverlays(ctx, criteria)
+	if err != nil {
+		return fmt.Errorf("failed to match overlays: %w", err)
+	}
+	if len(overlays) == 0 {
+		fmt.Fprintln(w.out, "Matching overlays: none (base configuration only)")
+		return nil
+	}
+	fmt.Fprintf(w.out, "Matching overlays: %s\n", strings.Join(overlays, ", "))
+	return nil
+}
+
+// ask prints prompt and returns the trimmed answer.
+func (w *recipeWizard) ask(prompt string) (string, error) {
+	fmt.Fprint(w.out, prompt)
+	line, err := w.in.ReadString('\n')
+	switch {
+	case err == nil, errors.Is(err, io.EOF) && line != "":
+		return strings.TrimSpace(line), nil
+	case errors.Is(err, io.EOF):
+		return "", fmt.Errorf("recipe wizard aborted: no more input")
+	default:
+		return "", fmt.Errorf("failed to read answer: %w", err)
+	}
+}
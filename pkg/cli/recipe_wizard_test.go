@@ -0,0 +1,106 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestRecipeWizard(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      recipe.Criteria
+		wantOut   []string
+		wantError string
+	}{
+		{
+			name:  "numbers and names",
+			input: "3\ngb200\n\nubuntu\n8\n",
+			want: recipe.Criteria{
+				Service:      recipe.CriteriaServiceEKS,
+				Accelerator:  recipe.CriteriaAcceleratorGB200,
+				Intent:       recipe.CriteriaIntentAny,
+				OS:           recipe.CriteriaOSUbuntu,
+				Nodes:        8,
+				Scale:        recipe.CriteriaScaleAny,
+				Partitioning: recipe.CriteriaPartitioningAny,
+			},
+			wantOut: []string{
+				"3) eks  (+eks)",
+				"training  (+eks-training, +gb200-eks-training)",
+				"eidos recipe --service eks --accelerator gb200 --os ubuntu --nodes 8",
+			},
+		},
+		{
+			name:  "all defaults",
+			input: "\n\n\n\n\n",
+			want:  *recipe.NewCriteria(),
+		},
+		{
+			name:  "invalid answers are asked again",
+			input: "9\nazure\naks\n\ntraining\n\n-1\n\n",
+			want: recipe.Criteria{
+				Service:      recipe.CriteriaServiceAKS,
+				Accelerator:  recipe.CriteriaAcceleratorAny,
+				Intent:       recipe.CriteriaIntentTraining,
+				OS:           recipe.CriteriaOSAny,
+				Scale:        recipe.CriteriaScaleAny,
+				Partitioning: recipe.CriteriaPartitioningAny,
+			},
+			wantOut: []string{
+				`Invalid choice "9"`,
+				`Invalid choice "azure"`,
+				`Invalid node count "-1"`,
+			},
+		},
+		{
+			name:      "input ends early",
+			input:     "eks\n",
+			wantError: "aborted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := newRecipeWizard(strings.NewReader(tt.input), &out, recipe.NewBuilder())
+
+			criteria, err := w.run(context.Background())
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("run() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+			if *criteria != tt.want {
+				t.Errorf("criteria = %+v, want %+v", *criteria, tt.want)
+			}
+			for _, s := range tt.wantOut {
+				if !strings.Contains(out.String(), s) {
+					t.Errorf("output missing %q:\n%s", s, out.String())
+				}
+			}
+		})
+	}
+}
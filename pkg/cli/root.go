@@ -19,10 +19,12 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/urfave/cli/v3"
 
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
 	"github.com/NVIDIA/eidos/pkg/logging"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 	"github.com/NVIDIA/eidos/pkg/serializer"
@@ -30,20 +32,18 @@ import (
 
 const (
 	name                   = "eidos"
-	versionDefault         = "dev"
 	functionalCategoryName = "Functional"
 )
 
 var (
-	// overridden during build with ldflags
-	version = versionDefault
-	commit  = "unknown"
-	date    = "unknown"
+	// version is the CLI version, stamped at build time in pkg/buildinfo
+	version = buildinfo.Version()
 
 	outputFlag = &cli.StringFlag{
 		Name:    "output",
 		Aliases: []string{"o"},
-		Usage:   fmt.Sprintf("output destination: file path, ConfigMap URI (%snamespace/name), or stdout (default)", serializer.ConfigMapURIScheme),
+		Usage: fmt.Sprintf("output destination: file path, ConfigMap URI (%snamespace/name), Secret URI (%snamespace/name[#key]), or stdout (default)",
+			serializer.ConfigMapURIScheme, serializer.SecretURIScheme),
 	}
 
 	formatFlag = &cli.StringFlag{
@@ -66,15 +66,28 @@ var (
 	with embedded (external takes precedence by name). All other files (base.yaml,
 	overlays, component values) fully replace embedded files or add new ones.`,
 	}
+
+	recipeDataFlag = &cli.StringFlag{
+		Name: "recipe-data",
+		Usage: `Path to a recipe data archive created by "eidos recipe data export".
+	The archive is verified and used like --data. Cannot be combined with --data.`,
+	}
+
+	kubernetesVersionFlag = &cli.StringFlag{
+		Name: "kubernetes-version",
+		Usage: `Target Kubernetes version (e.g. 1.30). Components known to be incompatible
+	with it are reported as constraint warnings.`,
+	}
 )
 
 // Execute starts the CLI application.
 // This is called by main.main().
 func Execute() {
+	info := buildinfo.Get()
 	cmd := &cli.Command{
 		Name:                  name,
 		Usage:                 "Eidos CLI",
-		Version:               fmt.Sprintf("%s (commit: %s, date: %s)", version, commit, date),
+		Version:               info.String(),
 		EnableShellCompletion: true,
 		HideHelpCommand:       true,
 		ConfigureShellCompletionCommand: func(cmd *cli.Command) {
@@ -83,8 +96,8 @@ func Execute() {
 			cmd.Usage = "Output shell completion script for a given shell."
 		},
 		Metadata: map[string]any{
-			"git-commit": commit,
-			"build-date": date,
+			"git-commit": info.Commit,
+			"build-date": info.Date,
 		},
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
@@ -120,8 +133,8 @@ func Execute() {
 			slog.Debug("starting",
 				"name", name,
 				"version", version,
-				"commit", commit,
-				"date", date,
+				"commit", info.Commit,
+				"date", info.Date,
 				"logLevel", logLevel)
 			return ctx, nil
 		},
@@ -129,37 +142,61 @@ func Execute() {
 			snapshotCmd(),
 			recipeCmd(),
 			bundleCmd(),
+			deployCmd(),
+			operatorCmd(),
 			validateCmd(),
+			watchCmd(),
+			verifyCmd(),
+			conformanceCmd(),
+			supportBundleCmd(),
+			inspectCmd(),
+			docsCmd(),
+			versionCmd(),
 		},
-		ShellComplete: commandLister,
 	}
 
-	if err := cmd.Run(context.Background(), os.Args); err != nil {
+	if err := withShellCompletion(cmd).Run(context.Background(), os.Args); err != nil {
 		slog.Error("command failed", "error", err)
 		os.Exit(1)
 	}
 }
 
-func commandLister(_ context.Context, cmd *cli.Command) {
-	if cmd == nil || cmd.Root() == nil {
-		return
-	}
-	for _, c := range cmd.Root().Commands {
-		if c.Hidden {
-			continue
+// initDataProvider initializes the data provider from the --data or
+// --recipe-data flag. If neither is set, it does nothing (uses embedded data).
+// Otherwise it creates a layered provider that overlays the external directory,
+// or the verified contents of the archive, on top of embedded data.
+// The returned cleanup function removes any temporary files and is never nil.
+func initDataProvider(cmd *cli.Command) (func(), error) {
+	cleanup := func() {}
+	dataDir := cmd.String("data")
+	archive := cmd.String("recipe-data")
+
+	switch {
+	case dataDir != "" && archive != "":
+		return cleanup, fmt.Errorf("--data and --recipe-data cannot be used together")
+	case archive != "":
+		tmpDir, err := os.MkdirTemp("", "eidos-recipe-data-*")
+		if err != nil {
+			return cleanup, fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		cleanup = func() {
+			if err := os.RemoveAll(tmpDir); err != nil {
+				slog.Warn("failed to remove temporary directory", "path", tmpDir, "error", err)
+			}
 		}
-		fmt.Println(c.Name)
-	}
-}
 
-// initDataProvider initializes the data provider from the --data flag.
-// If the flag is not set, returns nil (uses embedded data).
-// If the flag is set, creates a layered provider that overlays the external
-// directory on top of embedded data.
-func initDataProvider(cmd *cli.Command) error {
-	dataDir := cmd.String("data")
-	if dataDir == "" {
-		return nil
+		dataDir = filepath.Join(tmpDir, "data")
+		manifest, err := importRecipeData(archive, dataDir)
+		if err != nil {
+			cleanup()
+			return func() {}, err
+		}
+		slog.Info("using recipe data archive",
+			"archive", archive,
+			"dataVersion", manifest.DataVersion,
+			"generatedBy", manifest.GeneratedBy)
+	case dataDir == "":
+		return cleanup, nil
 	}
 
 	slog.Info("initializing external data provider", "directory", dataDir)
@@ -173,12 +210,13 @@ func initDataProvider(cmd *cli.Command) error {
 		AllowSymlinks: false,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to initialize external data: %w", err)
+		cleanup()
+		return func() {}, fmt.Errorf("failed to initialize external data: %w", err)
 	}
 
 	// Set as global data provider
 	recipe.SetDataProvider(layered)
 
 	slog.Info("external data provider initialized successfully", "directory", dataDir)
-	return nil
+	return cleanup, nil
 }
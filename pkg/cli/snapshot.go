@@ -22,6 +22,8 @@ import (
 	"github.com/urfave/cli/v3"
 
 	"github.com/NVIDIA/eidos/pkg/collector"
+	"github.com/NVIDIA/eidos/pkg/collector/plugin"
+	"github.com/NVIDIA/eidos/pkg/defaults"
 	"github.com/NVIDIA/eidos/pkg/serializer"
 	"github.com/NVIDIA/eidos/pkg/snapshotter"
 )
@@ -42,6 +44,27 @@ func snapshotCmd() *cli.Command {
 
 Note: All collection is done locally and no data is egressed out of the cluster.
 
+Site-specific checks can be added as collector plugins: executables in
+--plugin-dir that write a JSON measurement document to stdout. Plugin readings
+appear in the snapshot under the Plugin measurement type, one subtype per
+plugin, with the plugin path and SHA256 recorded as provenance. A plugin that
+fails or exceeds --plugin-timeout is skipped with a warning.
+
+  eidos snapshot --plugin-dir /opt/eidos/plugins --plugin-timeout 10s
+
+Use --redact before sharing a snapshot (e.g. with NVIDIA support). Hostnames,
+IP addresses and cloud account IDs (from the node providerID, ECR registries
+and ARNs) are masked before the snapshot is written; --redact-pattern masks
+additional values matching a regular expression:
+
+  eidos snapshot --redact --redact-pattern 'corp\.example\.com' -o snapshot.yaml
+
+Use --types and --exclude-subtypes to capture only what a support case needs.
+Types and subtypes are checked against the measurement registry, and the
+scope is recorded in the snapshot metadata:
+
+  eidos snapshot --types OS,GPU --exclude-subtypes sysctl
+
 Output can be in JSON or YAML format. 
 For a more complete snapshot use --deploy-agent to deploy a Kubernetes Job that captures the snapshot on a GPU node:
 
@@ -63,6 +86,9 @@ Basic agent deployment:
 Target specific GPU nodes with node selector:
   eidos snapshot --deploy-agent --node-selector nodeGroup=customer-gpu
 
+Sample one specific node (it must exist and be Ready):
+  eidos snapshot --deploy-agent --node-name gpu-node-7
+
 Override default tolerations (by default, all taints are tolerated):
   eidos snapshot --deploy-agent \
     --toleration dedicated=user-workload:NoSchedule
@@ -72,6 +98,12 @@ Combined node selector and custom tolerations:
     --node-selector nodeGroup=customer-gpu \
     --toleration dedicated=user-workload:NoSchedule \
     --output cm://gpu-operator/eidos-snapshot
+
+Print the agent logs while the Job runs:
+  eidos snapshot --deploy-agent --debug-agent
+
+Retry a failed snapshot up to twice on other nodes:
+  eidos snapshot --deploy-agent --node-retries 2 --job-backoff-limit 1
 `,
 		Flags: []cli.Flag{
 			// Agent deployment flags
@@ -105,19 +137,46 @@ Combined node selector and custom tolerations:
 				Usage: "Override default ServiceAccount name",
 				Value: "eidos",
 			},
+			&cli.StringFlag{
+				Name:  "node-name",
+				Usage: "Name of the node to run the agent Job on. The node must exist and be Ready.",
+			},
 			&cli.StringSliceFlag{
 				Name:  "node-selector",
-				Usage: "Node selector for Job scheduling (format: key=value, can be repeated)",
+				Usage: "Node selector for Job scheduling (format: key=value, can be repeated). At least one matching node must be Ready.",
 			},
 			&cli.StringSliceFlag{
-				Name:  "toleration",
-				Usage: "Toleration for Job scheduling (format: key=value:effect). By default, all taints are tolerated. Specifying this flag overrides the defaults.",
+				Name:    "toleration",
+				Aliases: []string{"tolerate"},
+				Usage:   "Toleration for Job scheduling (format: key=value:effect). By default, all taints are tolerated. Specifying this flag overrides the defaults.",
 			},
 			&cli.DurationFlag{
 				Name:  "timeout",
 				Usage: "Timeout for waiting for Job completion",
 				Value: 5 * time.Minute,
 			},
+			&cli.Int32Flag{
+				Name:  "job-backoff-limit",
+				Usage: "Number of times the agent Job retries a failed pod before failing",
+			},
+			&cli.DurationFlag{
+				Name:  "job-active-deadline",
+				Usage: "Maximum run time of the agent Job before Kubernetes terminates it",
+				Value: defaults.K8sJobActiveDeadline,
+			},
+			&cli.DurationFlag{
+				Name:  "poll-interval",
+				Usage: "Interval for re-checking the agent Job status while waiting",
+				Value: defaults.K8sJobPollInterval,
+			},
+			&cli.IntFlag{
+				Name:  "node-retries",
+				Usage: "Number of times a failed snapshot is retried on a different node",
+			},
+			&cli.BoolFlag{
+				Name:  "debug-agent",
+				Usage: "Print the agent logs live while the Job runs (failures include the last log lines either way)",
+			},
 			&cli.BoolFlag{
 				Name:  "cleanup",
 				Value: true,
@@ -128,6 +187,40 @@ Combined node selector and custom tolerations:
 				Value: true,
 				Usage: "Run agent in privileged mode (required for GPU/SystemD collectors). Set to false for PSS-restricted namespaces.",
 			},
+			// Collector plugin flags
+			&cli.StringFlag{
+				Name:    "plugin-dir",
+				Usage:   "Directory of collector plugin executables that write JSON measurements to stdout",
+				Sources: cli.EnvVars("EIDOS_PLUGIN_DIR"),
+			},
+			&cli.DurationFlag{
+				Name:  "plugin-timeout",
+				Usage: "Timeout for each collector plugin run",
+				Value: plugin.DefaultTimeout,
+			},
+			&cli.StringFlag{
+				Name:    "host-root",
+				Usage:   "Host filesystem root to read container runtime configuration from, when running in a container with the host mounted",
+				Sources: cli.EnvVars("EIDOS_HOST_ROOT"),
+			},
+			// Scope flags
+			&cli.StringSliceFlag{
+				Name:  "types",
+				Usage: "Measurement types to collect (K8s, GPU, OS, SystemD, Network, CPU, Runtime, Plugin; comma-separated or repeated). Defaults to all types.",
+			},
+			&cli.StringSliceFlag{
+				Name:  "exclude-subtypes",
+				Usage: "Measurement subtypes to drop, by name (sysctl) or qualified with their type (SystemD.containerd.service); comma-separated or repeated",
+			},
+			// Redaction flags
+			&cli.BoolFlag{
+				Name:  "redact",
+				Usage: "Mask hostnames, IP addresses and cloud account IDs before writing the snapshot",
+			},
+			&cli.StringSliceFlag{
+				Name:  "redact-pattern",
+				Usage: "Regular expression whose matches are masked in the snapshot (implies --redact, can be repeated)",
+			},
 			outputFlag,
 			formatFlag,
 			kubeconfigFlag,
@@ -142,8 +235,32 @@ Combined node selector and custom tolerations:
 			// Create factory
 			factory := collector.NewDefaultFactory(
 				collector.WithVersion(version),
+				collector.WithPluginDir(cmd.String("plugin-dir")),
+				collector.WithPluginTimeout(cmd.Duration("plugin-timeout")),
+				collector.WithHostRoot(cmd.String("host-root")),
 			)
 
+			if cmd.Int32("job-backoff-limit") < 0 || cmd.Int("node-retries") < 0 {
+				return fmt.Errorf("--job-backoff-limit and --node-retries must not be negative")
+			}
+			if cmd.String("node-name") != "" && cmd.Int("node-retries") > 0 {
+				return fmt.Errorf("--node-retries cannot be used with --node-name: retries run on other nodes")
+			}
+
+			// Validate scope before creating any output
+			scope, err := snapshotter.ParseScope(cmd.StringSlice("types"), cmd.StringSlice("exclude-subtypes"))
+			if err != nil {
+				return err
+			}
+
+			// Validate redaction patterns before creating any output
+			redactPatterns := cmd.StringSlice("redact-pattern")
+			redact := cmd.Bool("redact") || len(redactPatterns) > 0
+			redactor, err := snapshotter.NewRedactor(redactPatterns...)
+			if err != nil {
+				return err
+			}
+
 			// Create output serializer
 			ser, err := serializer.NewFileWriterOrStdout(outFormat, cmd.String("output"))
 			if err != nil {
@@ -155,10 +272,16 @@ Combined node selector and custom tolerations:
 				Version:    version,
 				Factory:    factory,
 				Serializer: ser,
+				Scope:      scope,
+			}
+
+			if redact {
+				ns.Redactor = redactor
 			}
 
 			// Check if agent deployment mode is enabled
 			if cmd.Bool("deploy-agent") {
+
 				// Parse node selectors
 				nodeSelector, err := snapshotter.ParseNodeSelectors(cmd.StringSlice("node-selector"))
 				if err != nil {
@@ -180,6 +303,7 @@ Combined node selector and custom tolerations:
 					ImagePullSecrets:   cmd.StringSlice("image-pull-secret"),
 					JobName:            cmd.String("job-name"),
 					ServiceAccountName: cmd.String("service-account-name"),
+					NodeName:           cmd.String("node-name"),
 					NodeSelector:       nodeSelector,
 					Tolerations:        tolerations,
 					Timeout:            cmd.Duration("timeout"),
@@ -187,6 +311,15 @@ Combined node selector and custom tolerations:
 					Output:             cmd.String("output"),
 					Debug:              cmd.Bool("debug"),
 					Privileged:         cmd.Bool("privileged"),
+					PluginDir:          cmd.String("plugin-dir"),
+					PluginTimeout:      cmd.Duration("plugin-timeout"),
+					Redact:             redact,
+					RedactPatterns:     redactPatterns,
+					BackoffLimit:       cmd.Int32("job-backoff-limit"),
+					ActiveDeadline:     cmd.Duration("job-active-deadline"),
+					PollInterval:       cmd.Duration("poll-interval"),
+					NodeRetries:        cmd.Int("node-retries"),
+					StreamLogs:         cmd.Bool("debug-agent"),
 				}
 			}
 
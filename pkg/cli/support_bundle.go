@@ -0,0 +1,292 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/support"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+// supportSnapshotName is the ConfigMap the support bundle agent writes its
+// snapshot to.
+const supportSnapshotName = "eidos-support-snapshot"
+
+func supportBundleCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "support-bundle",
+		Category:              functionalCategoryName,
+		EnableShellCompletion: true,
+		Usage:                 "Collect a redacted support bundle for escalations.",
+		Description: `Collects what a support escalation needs from the cluster selected by
+--kubeconfig into a single gzipped tar archive:
+
+  snapshot.yaml    a snapshot captured by the agent Job on a GPU node (as
+                   'eidos snapshot --deploy-agent'), or --snapshot
+  recipe.yaml      --recipe, or the recipe generated from the snapshot
+  validation.yaml  the recipe constraints validated against the snapshot
+  logs/            the last --log-tail lines of every container in the
+                   operator namespaces, and the previous run of restarted ones
+  events/          the events of the operator namespaces
+  index.yaml       the files in the archive and what could not be collected
+
+Everything is redacted as with 'eidos snapshot --redact': hostnames (including
+every node name), IP addresses and cloud account IDs are masked, as are matches
+of --redact-pattern. Collection is best effort; items that fail are listed in
+index.yaml instead of failing the command.
+
+# Examples
+
+Collect a support bundle with a fresh snapshot:
+  eidos support-bundle --kubeconfig ~/.kube/config
+
+Use an existing snapshot and the deployed recipe:
+  eidos support-bundle -s cm://gpu-operator/eidos-snapshot -r recipe.yaml -o case-1234.tar.gz
+
+Also mask an internal domain and collect from a custom namespace:
+  eidos support-bundle --redact-pattern 'corp\.example\.com' --log-namespace gpu-operator --log-namespace my-nim
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "snapshot",
+				Aliases: []string{"s"},
+				Usage: `Path/URI to an existing snapshot. Without it, the agent Job captures one.
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:    "recipe",
+				Aliases: []string{"r"},
+				Usage: `Path/URI to the deployed recipe. Without it, the recipe is generated from the snapshot.
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "Archive path (default: eidos-support-<timestamp>.tar.gz)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "log-namespace",
+				Usage: fmt.Sprintf("Namespace to collect pod logs and events from (can be repeated, default: %v)", support.DefaultNamespaces),
+			},
+			&cli.Int64Flag{
+				Name:  "log-tail",
+				Usage: "Log lines kept per container",
+				Value: support.DefaultTailLines,
+			},
+			&cli.StringSliceFlag{
+				Name:  "redact-pattern",
+				Usage: "Regular expression whose matches are masked in the bundle (can be repeated)",
+			},
+			// Agent flags, as for 'eidos snapshot --deploy-agent'
+			&cli.StringFlag{
+				Name:    "namespace",
+				Usage:   "Kubernetes namespace for agent deployment",
+				Sources: cli.EnvVars("EIDOS_NAMESPACE"),
+				Value:   "gpu-operator",
+			},
+			&cli.StringFlag{
+				Name:    "image",
+				Usage:   "Container image for agent Job",
+				Sources: cli.EnvVars("EIDOS_IMAGE"),
+				Value:   "ghcr.io/nvidia/eidos:latest",
+			},
+			&cli.StringSliceFlag{
+				Name:  "node-selector",
+				Usage: "Node selector for agent Job scheduling (format: key=value, can be repeated)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "toleration",
+				Usage: "Toleration for agent Job scheduling (format: key=value:effect). By default, all taints are tolerated.",
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "Timeout for waiting for agent Job completion",
+				Value: 5 * time.Minute,
+			},
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			kubeconfig := cmd.String("kubeconfig")
+
+			// Validate flags before touching the cluster
+			redactor, err := snapshotter.NewRedactor(cmd.StringSlice("redact-pattern")...)
+			if err != nil {
+				return err
+			}
+			nodeSelector, err := snapshotter.ParseNodeSelectors(cmd.StringSlice("node-selector"))
+			if err != nil {
+				return fmt.Errorf("invalid node-selector: %w", err)
+			}
+			tolerations, err := snapshotter.ParseTolerations(cmd.StringSlice("toleration"))
+			if err != nil {
+				return fmt.Errorf("invalid toleration: %w", err)
+			}
+
+			output := cmd.String("output")
+			if output == "" {
+				output = fmt.Sprintf("eidos-support-%s.tar.gz", time.Now().UTC().Format("20060102-150405"))
+			}
+
+			bundle := support.NewBundle(version)
+			fail := func(item string, err error) {
+				slog.Warn("support bundle item not collected", "item", item, "error", err)
+				bundle.AddError(item, err)
+			}
+
+			clientset, _, clientErr := client.GetKubeClientWithConfig(kubeconfig)
+			if clientErr != nil {
+				fail("kubernetes client", clientErr)
+			}
+
+			// Snapshot, captured by the agent unless one is given
+			snapshotSource := cmd.String("snapshot")
+			if snapshotSource == "" && clientErr == nil {
+				snapshotSource = fmt.Sprintf("%s%s/%s", serializer.ConfigMapURIScheme, cmd.String("namespace"), supportSnapshotName)
+				ns := snapshotter.NodeSnapshotter{
+					Version: version,
+					AgentConfig: &snapshotter.AgentConfig{
+						Enabled:            true,
+						Kubeconfig:         kubeconfig,
+						Namespace:          cmd.String("namespace"),
+						Image:              cmd.String("image"),
+						JobName:            "eidos",
+						ServiceAccountName: "eidos",
+						NodeSelector:       nodeSelector,
+						Tolerations:        tolerations,
+						Timeout:            cmd.Duration("timeout"),
+						Cleanup:            true,
+						Output:             snapshotSource,
+						Debug:              cmd.Bool("debug"),
+						Privileged:         true,
+						Redact:             true,
+						RedactPatterns:     cmd.StringSlice("redact-pattern"),
+					},
+				}
+				if err := ns.Measure(ctx); err != nil {
+					fail("snapshot", err)
+					snapshotSource = ""
+				}
+			}
+
+			var snap *snapshotter.Snapshot
+			if snapshotSource != "" {
+				slog.Info("loading snapshot", "uri", snapshotSource)
+				snap, err = serializer.FromFileWithKubeconfig[snapshotter.Snapshot](snapshotSource, kubeconfig)
+				if err != nil {
+					fail("snapshot", fmt.Errorf("failed to load snapshot from %q: %w", snapshotSource, err))
+				}
+			}
+
+			// Node names are masked in logs and events along with what the
+			// snapshot records, taken before the snapshot itself is redacted.
+			collector := support.NewCollector(clientset,
+				support.WithNamespaces(cmd.StringSlice("log-namespace")),
+				support.WithTailLines(cmd.Int64("log-tail")),
+			)
+			var nodeNames []string
+			if clientErr == nil {
+				if nodeNames, err = collector.NodeNames(ctx); err != nil {
+					fail("node names", err)
+				}
+			}
+			bundle.SetRedactor(redactor.Text(snap, nodeNames...))
+			if snap != nil {
+				redactor.Redact(snap)
+				if err := bundle.AddYAML("snapshot.yaml", "Cluster snapshot from "+snapshotSource, snap); err != nil {
+					return err
+				}
+			}
+
+			rec, recipeSource, err := supportBundleRecipe(ctx, cmd.String("recipe"), kubeconfig, snap)
+			if err != nil {
+				fail("recipe", err)
+			}
+			if rec != nil {
+				if err := bundle.AddYAML("recipe.yaml", "Recipe from "+recipeSource, rec); err != nil {
+					return err
+				}
+			}
+
+			if rec != nil && snap != nil {
+				result, err := validator.New(validator.WithVersion(version)).Validate(ctx, rec, snap)
+				if err != nil {
+					fail("validation", err)
+				} else {
+					result.RecipeSource = recipeSource
+					result.SnapshotSource = snapshotSource
+					if err := bundle.AddYAML("validation.yaml", "Recipe constraints validated against the snapshot", result); err != nil {
+						return err
+					}
+				}
+			}
+
+			if clientErr == nil {
+				collector.Collect(ctx, bundle)
+			}
+
+			if err := bundle.Write(output); err != nil {
+				return err
+			}
+
+			index := bundle.Index()
+			slog.Info("support bundle written",
+				"path", output,
+				"files", len(index.Files),
+				"errors", len(index.Errors))
+			fmt.Printf("Support bundle written to %s (%d files", output, len(index.Files))
+			if len(index.Errors) > 0 {
+				fmt.Printf(", %d not collected, see %s", len(index.Errors), support.IndexFileName)
+			}
+			fmt.Println(")")
+			return nil
+		},
+	}
+}
+
+// supportBundleRecipe loads the recipe at uri, or generates one from the
+// criteria detected in snap when uri is empty. It returns the recipe and
+// where it came from.
+func supportBundleRecipe(ctx context.Context, uri, kubeconfig string, snap *snapshotter.Snapshot) (*recipe.RecipeResult, string, error) {
+	if uri != "" {
+		slog.Info("loading recipe", "uri", uri)
+		rec, err := serializer.FromFileWithKubeconfig[recipe.RecipeResult](uri, kubeconfig)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to load recipe from %q: %w", uri, err)
+		}
+		return rec, uri, nil
+	}
+	if snap == nil {
+		return nil, "", fmt.Errorf("no --recipe given and no snapshot to generate one from")
+	}
+
+	criteria, _ := recipe.ExtractCriteriaFromSnapshot(snap)
+	slog.Info("generating recipe from snapshot", "criteria", criteria.String())
+	rec, err := recipe.NewBuilder(recipe.WithVersion(version)).
+		BuildFromCriteriaWithEvaluator(ctx, criteria, snapshotEvaluator(snap))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate recipe from snapshot: %w", err)
+	}
+	return rec, "snapshot", nil
+}
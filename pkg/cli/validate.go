@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
 
 	"github.com/urfave/cli/v3"
 
@@ -39,6 +40,12 @@ This command compares actual system measurements from a snapshot against the
 expected constraints defined in a recipe file. It reports which constraints
 pass, fail, or cannot be evaluated.
 
+Each failed constraint carries a remediation: a machine-readable fix such as
+setting a sysctl, adding a GRUB kernel parameter, loading a kernel module or
+upgrading the kernel, taken from the recipe when it describes one. With
+--fix-script, the fixes are also rendered into a shell script to review and
+run on the affected nodes.
+
 # Examples
 
 Validate a snapshot against a recipe:
@@ -52,6 +59,9 @@ Output validation result to a file:
 
 Run validation without failing on constraint errors (informational mode):
   eidos validate -r recipe.yaml -s snapshot.yaml --fail-on-error=false
+
+Write the fixes for failed constraints into a script for review:
+  eidos validate -r recipe.yaml -s snapshot.yaml --fix-script fix.sh
 `,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
@@ -59,20 +69,24 @@ Run validation without failing on constraint errors (informational mode):
 				Aliases:  []string{"r"},
 				Required: true,
 				Usage: `Path/URI to recipe file containing constraints to validate.
-	Supports: file paths, HTTP/HTTPS URLs, or ConfigMap URIs (cm://namespace/name).`,
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
 			},
 			&cli.StringFlag{
 				Name:     "snapshot",
 				Aliases:  []string{"s"},
 				Required: true,
 				Usage: `Path/URI to snapshot file containing actual system measurements.
-	Supports: file paths, HTTP/HTTPS URLs, or ConfigMap URIs (cm://namespace/name).`,
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
 			},
 			&cli.BoolFlag{
 				Name:  "fail-on-error",
 				Value: true,
 				Usage: "Exit with non-zero status if any constraint fails validation",
 			},
+			&cli.StringFlag{
+				Name:  "fix-script",
+				Usage: "Write a shell script applying the remediations of failed constraints, for review, to this path",
+			},
 			outputFlag,
 			formatFlag,
 			kubeconfigFlag,
@@ -143,6 +157,12 @@ Run validation without failing on constraint errors (informational mode):
 				return fmt.Errorf("failed to serialize validation result: %w", err)
 			}
 
+			if path := cmd.String("fix-script"); path != "" {
+				if err := writeFixScript(path, result); err != nil {
+					return err
+				}
+			}
+
 			slog.Info("validation completed",
 				"status", result.Summary.Status,
 				"passed", result.Summary.Passed,
@@ -159,3 +179,24 @@ Run validation without failing on constraint errors (informational mode):
 		},
 	}
 }
+
+// writeFixScript writes the remediations of result into an executable script
+// at path.
+func writeFixScript(path string, result *validator.ValidationResult) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755) //nolint:gosec // the script is meant to be executed
+	if err != nil {
+		return fmt.Errorf("failed to create fix script: %w", err)
+	}
+	defer f.Close()
+
+	steps, err := validator.WriteFixScript(f, result)
+	if err != nil {
+		return fmt.Errorf("failed to write fix script: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write fix script: %w", err)
+	}
+
+	slog.Info("fix script written", "path", path, "steps", steps)
+	return nil
+}
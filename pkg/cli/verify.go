@@ -0,0 +1,146 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/urfave/cli/v3"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func verifyCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "verify",
+		Category:              functionalCategoryName,
+		EnableShellCompletion: true,
+		Usage:                 "Check the health of deployed recipe components.",
+		Description: `Checks the cluster selected by --kubeconfig for the resources each
+component of a recipe is expected to create, and reports per-check pass/fail
+results in the same shape as 'eidos validate'.
+
+Built-in checks:
+  gpu-operator      operand DaemonSets ready, nodes advertise nvidia.com/gpu
+  cert-manager      webhook Deployment available, webhook CA bundle injected
+  network-operator  NicClusterPolicy status is ready
+
+Components without built-in checks and disabled components are ignored.
+
+# Examples
+
+Verify a deployed recipe:
+  eidos verify --recipe recipe.yaml
+
+Verify components deployed into a single namespace (e.g. the umbrella chart):
+  eidos verify --recipe recipe.yaml --namespace eidos-stack
+
+Report results without failing the command:
+  eidos verify -r recipe.yaml --fail-on-error=false -o health.yaml
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "recipe",
+				Aliases:  []string{"r"},
+				Required: true,
+				Usage: `Path/URI to the recipe that was deployed.
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:  "namespace",
+				Usage: "Look for every component's resources in this namespace instead of its component namespace",
+			},
+			&cli.BoolFlag{
+				Name:  "fail-on-error",
+				Value: true,
+				Usage: "Exit with non-zero status if any check fails",
+			},
+			outputFlag,
+			formatFlag,
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			outFormat, err := parseOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipeFilePath := cmd.String("recipe")
+			kubeconfig := cmd.String("kubeconfig")
+
+			slog.Info("loading recipe", "uri", recipeFilePath)
+
+			rec, err := serializer.FromFileWithKubeconfig[recipe.RecipeResult](recipeFilePath, kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to load recipe from %q: %w", recipeFilePath, err)
+			}
+
+			clientset, config, err := client.GetKubeClientWithConfig(kubeconfig)
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			dyn, err := dynamic.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("failed to create dynamic client: %w", err)
+			}
+
+			checker := healthcheck.New(clientset, dyn,
+				healthcheck.WithVersion(version),
+				healthcheck.WithNamespace(cmd.String("namespace")),
+			)
+
+			result, err := checker.Check(ctx, rec)
+			if err != nil {
+				return fmt.Errorf("health check failed: %w", err)
+			}
+			result.RecipeSource = recipeFilePath
+
+			ser, err := serializer.NewFileWriterOrStdout(outFormat, cmd.String("output"))
+			if err != nil {
+				return fmt.Errorf("failed to create output writer: %w", err)
+			}
+			defer func() {
+				if closer, ok := ser.(interface{ Close() error }); ok {
+					if err := closer.Close(); err != nil {
+						slog.Warn("failed to close serializer", "error", err)
+					}
+				}
+			}()
+
+			if err := ser.Serialize(ctx, result); err != nil {
+				return fmt.Errorf("failed to serialize health check result: %w", err)
+			}
+
+			slog.Info("health check completed",
+				"status", result.Summary.Status,
+				"passed", result.Summary.Passed,
+				"failed", result.Summary.Failed,
+				"skipped", result.Summary.Skipped,
+				"duration", result.Summary.Duration)
+
+			if cmd.Bool("fail-on-error") && result.Summary.Status == healthcheck.StatusFail {
+				return fmt.Errorf("health check failed: %d check(s) did not pass", result.Summary.Failed)
+			}
+
+			return nil
+		},
+	}
+}
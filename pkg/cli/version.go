@@ -0,0 +1,111 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+)
+
+func versionCmd() *cli.Command {
+	return &cli.Command{
+		Name:     "version",
+		Category: "Utilities",
+		Usage:    "Show version, recipe data and component versions.",
+		Description: `Prints the CLI version and git commit, the digest of the recipe data in use,
+and the versions of the registered bundlers, deployers and collectors. The
+same report is served by the API server at GET /v1/version, so a CLI and a
+server can be checked for matching builds and recipe data.
+
+Examples:
+
+Show the version report:
+  eidos version
+
+Print the report as JSON:
+  eidos version --json
+
+Report the recipe data of an external data directory:
+  eidos version --data ./my-recipes`,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "print the report as JSON",
+			},
+			dataFlag,
+			recipeDataFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cleanup, err := initDataProvider(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize data provider: %w", err)
+			}
+			defer cleanup()
+
+			report, err := buildinfo.NewReport(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to build version report: %w", err)
+			}
+
+			if cmd.Bool("json") {
+				return serializer.NewWriter(serializer.FormatJSON, cmd.Root().Writer).Serialize(ctx, report)
+			}
+			return writeVersionText(cmd.Root().Writer, report)
+		},
+	}
+}
+
+// writeVersionText writes report as aligned, human-readable text.
+func writeVersionText(w io.Writer, report *buildinfo.Report) error {
+	source := "embedded"
+	if report.RecipeData.External {
+		source = "external"
+	}
+
+	bundlers := make([]string, 0, len(report.Bundlers))
+	for _, b := range report.Bundlers {
+		if b.Version == "" {
+			bundlers = append(bundlers, b.Name)
+			continue
+		}
+		bundlers = append(bundlers, b.Name+"@"+b.Version)
+	}
+
+	collectors := make([]string, 0, len(report.Collectors.Types))
+	for _, c := range report.Collectors.Types {
+		collectors = append(collectors, string(c))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Version:\t%s\n", report.Version)
+	fmt.Fprintf(tw, "Commit:\t%s\n", report.Commit)
+	fmt.Fprintf(tw, "Built:\t%s\n", report.Date)
+	fmt.Fprintf(tw, "Go:\t%s %s\n", report.GoVersion, report.Platform)
+	fmt.Fprintf(tw, "Recipe data:\t%s (%s, %d files, registry %s)\n",
+		report.RecipeData.Digest, source, report.RecipeData.Files, report.RecipeData.RegistryAPIVersion)
+	fmt.Fprintf(tw, "Bundlers:\t%s\n", strings.Join(bundlers, ", "))
+	fmt.Fprintf(tw, "Deployers:\t%s\n", strings.Join(report.Deployers, ", "))
+	fmt.Fprintf(tw, "Collectors:\t%s (snapshot schema v%d)\n",
+		strings.Join(collectors, ", "), report.Collectors.SchemaVersion)
+	return tw.Flush()
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/NVIDIA/eidos/pkg/buildinfo"
+)
+
+func runVersionCmd(t *testing.T, args ...string) string {
+	t.Helper()
+
+	var out bytes.Buffer
+	root := &cli.Command{
+		Name:     name,
+		Writer:   &out,
+		Commands: []*cli.Command{versionCmd()},
+	}
+	if err := root.Run(context.Background(), append([]string{name, "version"}, args...)); err != nil {
+		t.Fatalf("version failed: %v", err)
+	}
+	return out.String()
+}
+
+func TestVersionCmd_Text(t *testing.T) {
+	out := runVersionCmd(t)
+
+	info := buildinfo.Get()
+	for _, want := range []string{
+		"Version:", info.Version,
+		"Commit:", info.Commit,
+		"Recipe data:", "sha256:", "embedded",
+		"gpu-operator@",
+		"helm",
+		"snapshot schema v",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestVersionCmd_JSON(t *testing.T) {
+	out := runVersionCmd(t, "--json")
+
+	var report buildinfo.Report
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("output is not a JSON report: %v\n%s", err, out)
+	}
+	if report.Info != buildinfo.Get() {
+		t.Errorf("build info = %+v, want %+v", report.Info, buildinfo.Get())
+	}
+	if report.RecipeData == nil || report.RecipeData.External {
+		t.Errorf("RecipeData = %+v, want embedded data", report.RecipeData)
+	}
+	if len(report.Bundlers) == 0 || len(report.Deployers) == 0 {
+		t.Errorf("expected bundler and deployer versions, got %+v", report)
+	}
+}
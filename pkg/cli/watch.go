@@ -0,0 +1,279 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/server"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/watcher"
+)
+
+func watchCmd() *cli.Command {
+	return &cli.Command{
+		Name:                  "watch",
+		Category:              functionalCategoryName,
+		EnableShellCompletion: true,
+		Usage:                 "Continuously validate the cluster against a pinned recipe.",
+		Description: `Run continuous validation: on every --interval, capture a fresh snapshot
+with the agent Job, validate it against the recipe and publish the outcome
+of each constraint as Prometheus metrics on --port:
+
+  eidos_validation_status{constraint="K8s.server.version"} 1
+
+Status values are 1 (passed), 0 (failed) and -1 (skipped). The last result
+is served at /v1/validation, and /health, /ready and /metrics are always
+available.
+
+The recipe is loaded again on every check, so pin it in a ConfigMap and
+update the ConfigMap to change what is validated:
+
+  eidos recipe --service eks --accelerator h100 -o cm://eidos/pinned-recipe
+  eidos watch --recipe cm://eidos/pinned-recipe --events
+
+A constraint that passed on the previous check and fails now is a
+regression. With --events, each regression is also reported as a Kubernetes
+Warning Event (reason ConstraintRegressed) on the recipe ConfigMap.
+
+Use --snapshot to read a snapshot refreshed by something else, such as a
+CronJob running 'eidos snapshot --deploy-agent', instead of deploying the
+agent from this process.
+
+# Examples
+
+Validate every 10 minutes and serve metrics on port 9090:
+  eidos watch --recipe cm://eidos/pinned-recipe --interval 10m --port 9090
+
+Validate a snapshot ConfigMap maintained elsewhere:
+  eidos watch --recipe cm://eidos/pinned-recipe --snapshot cm://eidos/eidos-snapshot
+`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "recipe",
+				Aliases:  []string{"r"},
+				Required: true,
+				Usage: `Path/URI to the pinned recipe, loaded again on every check.
+	Supports: file paths, HTTP/HTTPS URLs, ConfigMap URIs (cm://namespace/name), or Secret URIs (secret://namespace/name[#key]).`,
+			},
+			&cli.StringFlag{
+				Name:    "snapshot",
+				Aliases: []string{"s"},
+				Usage:   "Path/URI of a snapshot to read on every check instead of deploying the agent",
+			},
+			&cli.DurationFlag{
+				Name:  "interval",
+				Usage: "How often to capture and validate a snapshot",
+				Value: defaults.ValidationWatchInterval,
+			},
+			&cli.IntFlag{
+				Name:    "port",
+				Usage:   "Port serving metrics, health and the last validation result",
+				Sources: cli.EnvVars("PORT"),
+				Value:   9090,
+			},
+			&cli.BoolFlag{
+				Name:  "events",
+				Usage: "Report regressions as Kubernetes Events on the recipe ConfigMap (requires a cm:// recipe)",
+			},
+			// Agent deployment flags
+			&cli.StringFlag{
+				Name:    "namespace",
+				Usage:   "Kubernetes namespace for agent deployment",
+				Sources: cli.EnvVars("EIDOS_NAMESPACE"),
+				Value:   "gpu-operator",
+			},
+			&cli.StringFlag{
+				Name:    "image",
+				Usage:   "Container image for agent Job",
+				Sources: cli.EnvVars("EIDOS_IMAGE"),
+				Value:   "ghcr.io/nvidia/eidos:latest",
+			},
+			&cli.StringSliceFlag{
+				Name:  "image-pull-secret",
+				Usage: "Secret name for pulling images from private registries (can be repeated)",
+			},
+			&cli.StringFlag{
+				Name:  "job-name",
+				Usage: "Override default Job name",
+				Value: "eidos-watch",
+			},
+			&cli.StringFlag{
+				Name:  "service-account-name",
+				Usage: "Override default ServiceAccount name",
+				Value: "eidos",
+			},
+			&cli.StringSliceFlag{
+				Name:  "node-selector",
+				Usage: "Node selector for Job scheduling (format: key=value, can be repeated)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "toleration",
+				Usage: "Toleration for Job scheduling (format: key=value:effect). By default, all taints are tolerated. Specifying this flag overrides the defaults.",
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "Timeout for waiting for Job completion",
+				Value: defaults.K8sJobCompletionTimeout,
+			},
+			&cli.BoolFlag{
+				Name:  "privileged",
+				Value: true,
+				Usage: "Run agent in privileged mode (required for GPU/SystemD collectors). Set to false for PSS-restricted namespaces.",
+			},
+			kubeconfigFlag,
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			recipeURI := cmd.String("recipe")
+			kubeconfig := cmd.String("kubeconfig")
+
+			snapshotURI, capture, err := watchSnapshotter(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts := []watcher.Option{
+				watcher.WithVersion(version),
+				watcher.WithInterval(cmd.Duration("interval")),
+				watcher.WithRecipeLoader(recipeURI, func(context.Context) (*recipe.RecipeResult, error) {
+					return serializer.FromFileWithKubeconfig[recipe.RecipeResult](recipeURI, kubeconfig)
+				}),
+				watcher.WithSnapshotter(snapshotURI, capture),
+			}
+
+			if cmd.Bool("events") {
+				recorder, recErr := regressionEventRecorder(recipeURI, kubeconfig)
+				if recErr != nil {
+					return recErr
+				}
+				opts = append(opts, watcher.WithNotifier(recorder))
+			}
+
+			w, err := watcher.New(opts...)
+			if err != nil {
+				return fmt.Errorf("failed to create watcher: %w", err)
+			}
+
+			cfg := server.NewConfig()
+			cfg.Port = int(cmd.Int("port"))
+			s := server.New(
+				server.WithConfig(cfg),
+				server.WithName("eidos-watch"),
+				server.WithVersion(version),
+				server.WithHandler(map[string]http.HandlerFunc{
+					"/v1/validation": w.HandleStatus,
+				}),
+			)
+
+			watchCtx, stop := context.WithCancel(ctx)
+			defer stop()
+			go w.Run(watchCtx)
+
+			return s.Run(ctx)
+		},
+	}
+}
+
+// watchSnapshotter returns the function capturing a snapshot on every check:
+// reading --snapshot when set, deploying the agent Job otherwise.
+func watchSnapshotter(cmd *cli.Command) (string, watcher.Snapshotter, error) {
+	kubeconfig := cmd.String("kubeconfig")
+
+	if uri := cmd.String("snapshot"); uri != "" {
+		return uri, func(context.Context) (*snapshotter.Snapshot, error) {
+			return serializer.FromFileWithKubeconfig[snapshotter.Snapshot](uri, kubeconfig)
+		}, nil
+	}
+
+	nodeSelector, err := snapshotter.ParseNodeSelectors(cmd.StringSlice("node-selector"))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid node-selector: %w", err)
+	}
+	tolerations, err := snapshotter.ParseTolerations(cmd.StringSlice("toleration"))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid toleration: %w", err)
+	}
+
+	namespace := cmd.String("namespace")
+	jobName := cmd.String("job-name")
+	output := fmt.Sprintf("%s%s/%s-snapshot", serializer.ConfigMapURIScheme, namespace, jobName)
+
+	ns := snapshotter.NodeSnapshotter{
+		Version: version,
+		AgentConfig: &snapshotter.AgentConfig{
+			Enabled:            true,
+			Kubeconfig:         kubeconfig,
+			Namespace:          namespace,
+			Image:              cmd.String("image"),
+			ImagePullSecrets:   cmd.StringSlice("image-pull-secret"),
+			JobName:            jobName,
+			ServiceAccountName: cmd.String("service-account-name"),
+			NodeSelector:       nodeSelector,
+			Tolerations:        tolerations,
+			Timeout:            cmd.Duration("timeout"),
+			// Keep RBAC between checks; Deploy replaces the previous Job
+			Cleanup:    false,
+			Output:     output,
+			Debug:      cmd.Bool("debug"),
+			Privileged: cmd.Bool("privileged"),
+		},
+	}
+
+	return output, func(ctx context.Context) (*snapshotter.Snapshot, error) {
+		start := time.Now()
+		if err := ns.Measure(ctx); err != nil {
+			return nil, err
+		}
+		slog.Debug("agent snapshot captured", "uri", output, "duration", time.Since(start))
+		return serializer.FromFileWithKubeconfig[snapshotter.Snapshot](output, kubeconfig)
+	}, nil
+}
+
+// regressionEventRecorder creates the Event recorder for --events, attaching
+// Events to the ConfigMap holding the pinned recipe.
+func regressionEventRecorder(recipeURI, kubeconfig string) (*watcher.EventRecorder, error) {
+	if !strings.HasPrefix(recipeURI, serializer.ConfigMapURIScheme) {
+		return nil, fmt.Errorf("--events requires the recipe to be stored in a ConfigMap (%snamespace/name), got %q",
+			serializer.ConfigMapURIScheme, recipeURI)
+	}
+	namespace, name, err := serializer.ParseConfigMapURI(recipeURI)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, _, err := client.GetKubeClientWithConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	return watcher.NewEventRecorder(clientset, corev1.ObjectReference{
+		Kind:       "ConfigMap",
+		APIVersion: "v1",
+		Namespace:  namespace,
+		Name:       name,
+	}), nil
+}
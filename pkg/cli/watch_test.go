@@ -0,0 +1,27 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+)
+
+func TestRegressionEventRecorder_RequiresConfigMap(t *testing.T) {
+	for _, uri := range []string{"recipe.yaml", "https://example.com/recipe.yaml", "secret://eidos/recipe", "cm://eidos"} {
+		if _, err := regressionEventRecorder(uri, ""); err == nil {
+			t.Errorf("regressionEventRecorder(%q) expected error", uri)
+		}
+	}
+}
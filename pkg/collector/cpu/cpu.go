@@ -0,0 +1,384 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpu
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/collector/internal/sysfs"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// Reading keys recipes are expected to constrain on.
+const (
+	KeySockets        = "sockets"
+	KeyCores          = "cores"
+	KeyThreads        = "threads"
+	KeyThreadsPerCore = "threads-per-core"
+	KeySMT            = "smt"
+	KeyGovernor       = "governor"
+	KeyNodeCount      = "node-count"
+	KeyIsolatedCPUs   = "isolated-cpus"
+)
+
+// isolationParams are the boot parameters that take CPUs away from the
+// general-purpose scheduler, reported as they appear on the kernel command line.
+var isolationParams = []string{"isolcpus", "nohz_full", "rcu_nocbs", "irqaffinity"}
+
+// armCPUParts names the Arm cores found in GPU servers, keyed by the
+// "CPU part" reported in /proc/cpuinfo. Arm kernels report no model name.
+var armCPUParts = map[string]string{
+	"0xd0c": "Neoverse-N1",
+	"0xd40": "Neoverse-V1",
+	"0xd49": "Neoverse-N2",
+	"0xd4f": "Neoverse-V2",
+}
+
+// armImplementers names the "CPU implementer" codes of Arm server CPUs.
+var armImplementers = map[string]string{
+	"0x41": "ARM",
+	"0x4e": "NVIDIA",
+}
+
+var (
+	procCPUInfo    = "/proc/cpuinfo"
+	procMemInfo    = "/proc/meminfo"
+	procCmdline    = "/proc/cmdline"
+	sysDevicesCPU  = "/sys/devices/system/cpu"
+	sysDevicesNode = "/sys/devices/system/node"
+
+	// pageSize reports the kernel base page size; arm64 kernels for Grace
+	// are commonly built with 64K pages rather than 4K.
+	pageSize = os.Getpagesize
+)
+
+// Collector collects CPU topology, NUMA layout, frequency governor and CPU
+// isolation settings from the node.
+type Collector struct {
+}
+
+// Collect gathers CPU configuration and returns it as a single measurement
+// with three subtypes: info, numa and isolation.
+func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, error) {
+	slog.Info("collecting CPU configuration")
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	info, err := c.collectInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	numa, err := c.collectNUMA(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isolation, err := c.collectIsolation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &measurement.Measurement{
+		Type: measurement.TypeCPU,
+		Subtypes: []measurement.Subtype{
+			*info,
+			*numa,
+			*isolation,
+		},
+	}
+
+	return res, nil
+}
+
+// collectInfo reports the CPU model and socket, core and thread counts derived
+// from the sysfs topology of the online CPUs, plus SMT state and governor.
+func (c *Collector) collectInfo(ctx context.Context) (*measurement.Subtype, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	readings := make(map[string]measurement.Reading)
+
+	data, err := os.ReadFile(procCPUInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CPU info from %s: %w", procCPUInfo, err)
+	}
+	model, vendor := parseCPUInfo(data)
+	readings["model"] = measurement.Str(model)
+	readings["vendor"] = measurement.Str(vendor)
+	readings["architecture"] = measurement.Str(architecture(data))
+	readings["page-size-kb"] = measurement.Int(pageSize() / 1024)
+
+	cpus := onlineCPUs()
+	sockets := make(map[string]bool)
+	cores := make(map[string]bool)
+	var governors []string
+	for _, cpu := range cpus {
+		dir := filepath.Join(sysDevicesCPU, fmt.Sprintf("cpu%d", cpu))
+		pkg, _ := sysfs.ReadTrimmed(filepath.Join(dir, "topology", "physical_package_id"))
+		core, _ := sysfs.ReadTrimmed(filepath.Join(dir, "topology", "core_id"))
+		sockets[pkg] = true
+		cores[pkg+"/"+core] = true
+		if gov, ok := sysfs.ReadTrimmed(filepath.Join(dir, "cpufreq", "scaling_governor")); ok && !slices.Contains(governors, gov) {
+			governors = append(governors, gov)
+		}
+	}
+	slices.Sort(governors)
+
+	readings[KeySockets] = measurement.Int(len(sockets))
+	readings[KeyCores] = measurement.Int(len(cores))
+	readings[KeyThreads] = measurement.Int(len(cpus))
+	if len(cores) > 0 {
+		readings[KeyThreadsPerCore] = measurement.Int(len(cpus) / len(cores))
+	}
+
+	// smt/control is "on", "off", "forceoff", "notsupported" or
+	// "notimplemented"; it is absent on kernels without SMT control.
+	if smt, ok := sysfs.ReadTrimmed(filepath.Join(sysDevicesCPU, "smt", "control")); ok {
+		readings[KeySMT] = measurement.Str(smt)
+	}
+	if active, ok := sysfs.ReadTrimmed(filepath.Join(sysDevicesCPU, "smt", "active")); ok {
+		readings["smt-active"] = measurement.Bool(active == "1")
+	}
+
+	// Governors are reported as a comma-separated list of the distinct
+	// governors in use, so a single value means every CPU agrees.
+	readings[KeyGovernor] = measurement.Str(strings.Join(governors, ","))
+	if driver, ok := sysfs.ReadTrimmed(filepath.Join(sysDevicesCPU, "cpu0", "cpufreq", "scaling_driver")); ok {
+		readings["scaling-driver"] = measurement.Str(driver)
+	}
+
+	return &measurement.Subtype{Name: "info", Data: readings}, nil
+}
+
+// collectNUMA reports the NUMA nodes with their CPUs and memory.
+func (c *Collector) collectNUMA(ctx context.Context) (*measurement.Subtype, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	readings := make(map[string]measurement.Reading)
+	nodes := numaNodes()
+	for _, node := range nodes {
+		dir := filepath.Join(sysDevicesNode, node)
+		if cpus, ok := sysfs.ReadTrimmed(filepath.Join(dir, "cpulist")); ok {
+			readings[node+".cpus"] = measurement.Str(cpus)
+			readings[node+".cpu-count"] = measurement.Int(cpuListCount(cpus))
+		}
+		if kb, ok := nodeMemTotalKB(filepath.Join(dir, "meminfo")); ok {
+			readings[node+".memory-mb"] = measurement.Int(kb / 1024)
+		}
+		if distance, ok := sysfs.ReadTrimmed(filepath.Join(dir, "distance")); ok {
+			readings[node+".distance"] = measurement.Str(distance)
+		}
+	}
+	readings[KeyNodeCount] = measurement.Int(len(nodes))
+
+	if kb, ok := nodeMemTotalKB(procMemInfo); ok {
+		readings["memory-mb"] = measurement.Int(kb / 1024)
+	}
+
+	return &measurement.Subtype{Name: "numa", Data: readings}, nil
+}
+
+// collectIsolation reports the CPU isolation boot parameters and the CPUs the
+// kernel actually isolated.
+func (c *Collector) collectIsolation(ctx context.Context) (*measurement.Subtype, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	readings := make(map[string]measurement.Reading)
+
+	cmdline, _ := sysfs.ReadTrimmed(procCmdline)
+	params := parseCmdline(cmdline)
+	for _, name := range isolationParams {
+		readings[name] = measurement.Str(params[name])
+	}
+
+	isolated, _ := sysfs.ReadTrimmed(filepath.Join(sysDevicesCPU, "isolated"))
+	readings[KeyIsolatedCPUs] = measurement.Str(isolated)
+	readings["isolated-cpu-count"] = measurement.Int(cpuListCount(isolated))
+	if nohz, ok := sysfs.ReadTrimmed(filepath.Join(sysDevicesCPU, "nohz_full")); ok && nohz != "(null)" {
+		readings["nohz-full-cpus"] = measurement.Str(nohz)
+	}
+
+	return &measurement.Subtype{Name: "isolation", Data: readings}, nil
+}
+
+// parseCPUInfo returns the model name and vendor of the first processor.
+// Arm kernels report no model name, so it is derived from the CPU part.
+func parseCPUInfo(data []byte) (model, vendor string) {
+	var part string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch key {
+		case "model name":
+			if model == "" {
+				model = value
+			}
+		case "vendor_id":
+			if vendor == "" {
+				vendor = value
+			}
+		case "CPU implementer":
+			if vendor == "" {
+				vendor = value
+				if name, ok := armImplementers[value]; ok {
+					vendor = name
+				}
+			}
+		case "CPU part":
+			if part == "" {
+				part = value
+			}
+		}
+	}
+	if model == "" && part != "" {
+		model = armCPUParts[part]
+		if model == "" {
+			model = part
+		}
+	}
+	return model, vendor
+}
+
+// architecture reports the instruction set family from cpuinfo fields.
+func architecture(data []byte) string {
+	switch {
+	case bytes.Contains(data, []byte("CPU implementer")):
+		return "arm64"
+	case bytes.Contains(data, []byte("vendor_id")):
+		return "x86_64"
+	default:
+		return ""
+	}
+}
+
+// onlineCPUs returns the online logical CPUs. It falls back to the CPU
+// directories in sysfs when the online list is unavailable.
+func onlineCPUs() []int {
+	if online, ok := sysfs.ReadTrimmed(filepath.Join(sysDevicesCPU, "online")); ok {
+		return parseCPUList(online)
+	}
+	var cpus []int
+	for _, name := range sysfs.ListDir(sysDevicesCPU) {
+		if n, err := strconv.Atoi(strings.TrimPrefix(name, "cpu")); err == nil && strings.HasPrefix(name, "cpu") {
+			cpus = append(cpus, n)
+		}
+	}
+	slices.Sort(cpus)
+	return cpus
+}
+
+// numaNodes returns the NUMA node directory names (node0, node1, ...) sorted
+// by node number.
+func numaNodes() []string {
+	var nodes []string
+	for _, name := range sysfs.ListDir(sysDevicesNode) {
+		if _, err := strconv.Atoi(strings.TrimPrefix(name, "node")); err == nil && strings.HasPrefix(name, "node") {
+			nodes = append(nodes, name)
+		}
+	}
+	slices.SortFunc(nodes, func(a, b string) int {
+		x, _ := strconv.Atoi(strings.TrimPrefix(a, "node"))
+		y, _ := strconv.Atoi(strings.TrimPrefix(b, "node"))
+		return x - y
+	})
+	return nodes
+}
+
+// nodeMemTotalKB reads MemTotal from /proc/meminfo or a per-node meminfo file
+// ("Node 0 MemTotal:       263825924 kB").
+func nodeMemTotalKB(path string) (int, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		for i, f := range fields {
+			if f != "MemTotal:" || i+1 >= len(fields) {
+				continue
+			}
+			kb, err := strconv.Atoi(fields[i+1])
+			if err != nil {
+				return 0, false
+			}
+			return kb, true
+		}
+	}
+	return 0, false
+}
+
+// parseCmdline splits a kernel command line into parameters. Flags without a
+// value map to an empty string.
+func parseCmdline(cmdline string) map[string]string {
+	params := make(map[string]string)
+	for _, field := range strings.Fields(cmdline) {
+		key, value, _ := strings.Cut(field, "=")
+		params[key] = value
+	}
+	return params
+}
+
+// parseCPUList expands a kernel CPU list such as "0-3,8,10-11" into CPU
+// numbers. Malformed ranges are skipped.
+func parseCPUList(list string) []int {
+	var cpus []int
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(lo)
+		if err != nil {
+			continue
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(hi); err != nil || end < start {
+				continue
+			}
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus
+}
+
+// cpuListCount returns the number of CPUs in a kernel CPU list.
+func cpuListCount(list string) int {
+	return len(parseCPUList(list))
+}
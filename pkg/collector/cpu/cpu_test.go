@@ -0,0 +1,253 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpu
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const x86CPUInfo = `processor	: 0
+vendor_id	: AuthenticAMD
+model name	: AMD EPYC 9654 96-Core Processor
+
+processor	: 1
+vendor_id	: AuthenticAMD
+model name	: AMD EPYC 9654 96-Core Processor
+`
+
+const armCPUInfo = `processor	: 0
+BogoMIPS	: 2000.00
+CPU implementer	: 0x41
+CPU architecture: 8
+CPU part	: 0xd4f
+`
+
+// setupFakeNode points the procfs and sysfs paths at a temp dir.
+func setupFakeNode(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+
+	origInfo, origMem, origCmd, origCPU, origNode := procCPUInfo, procMemInfo, procCmdline, sysDevicesCPU, sysDevicesNode
+	origPageSize := pageSize
+	t.Cleanup(func() {
+		procCPUInfo, procMemInfo, procCmdline, sysDevicesCPU, sysDevicesNode = origInfo, origMem, origCmd, origCPU, origNode
+		pageSize = origPageSize
+	})
+	pageSize = func() int { return 4096 }
+	procCPUInfo = filepath.Join(root, "cpuinfo")
+	procMemInfo = filepath.Join(root, "meminfo")
+	procCmdline = filepath.Join(root, "cmdline")
+	sysDevicesCPU = filepath.Join(root, "cpu")
+	sysDevicesNode = filepath.Join(root, "node")
+	return root
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeCPU adds a logical CPU with its topology and governor.
+func writeCPU(t *testing.T, cpu, pkg, core int, governor string) {
+	t.Helper()
+	dir := filepath.Join(sysDevicesCPU, fmt.Sprintf("cpu%d", cpu))
+	writeFile(t, filepath.Join(dir, "topology", "physical_package_id"), fmt.Sprintf("%d\n", pkg))
+	writeFile(t, filepath.Join(dir, "topology", "core_id"), fmt.Sprintf("%d\n", core))
+	if governor != "" {
+		writeFile(t, filepath.Join(dir, "cpufreq", "scaling_governor"), governor+"\n")
+	}
+}
+
+func subtype(t *testing.T, m *measurement.Measurement, name string) map[string]measurement.Reading {
+	t.Helper()
+	for _, st := range m.Subtypes {
+		if st.Name == name {
+			return st.Data
+		}
+	}
+	t.Fatalf("subtype %q not found", name)
+	return nil
+}
+
+func assertReading(t *testing.T, data map[string]measurement.Reading, key string, want any) {
+	t.Helper()
+	got, ok := data[key]
+	if !ok {
+		t.Errorf("reading %q missing", key)
+		return
+	}
+	if got.Any() != want {
+		t.Errorf("%s = %v (%T), want %v (%T)", key, got.Any(), got.Any(), want, want)
+	}
+}
+
+func TestCollector_Collect_TwoSocketSMT(t *testing.T) {
+	setupFakeNode(t)
+	writeFile(t, procCPUInfo, x86CPUInfo)
+	writeFile(t, procMemInfo, "MemTotal:       2097152 kB\nMemFree:        1024 kB\n")
+	writeFile(t, procCmdline, "BOOT_IMAGE=/vmlinuz root=/dev/sda1 isolcpus=managed_irq,domain,2-3,6-7 nohz_full=2-3,6-7 quiet\n")
+
+	// 2 sockets x 2 cores x 2 threads; CPUs 0-3 on socket 0, 4-7 on socket 1.
+	writeFile(t, filepath.Join(sysDevicesCPU, "online"), "0-7\n")
+	for cpu := 0; cpu < 8; cpu++ {
+		governor := "performance"
+		if cpu == 7 {
+			governor = "powersave"
+		}
+		writeCPU(t, cpu, cpu/4, (cpu%4)/2, governor)
+	}
+	writeFile(t, filepath.Join(sysDevicesCPU, "cpu0", "cpufreq", "scaling_driver"), "acpi-cpufreq\n")
+	writeFile(t, filepath.Join(sysDevicesCPU, "smt", "control"), "on\n")
+	writeFile(t, filepath.Join(sysDevicesCPU, "smt", "active"), "1\n")
+	writeFile(t, filepath.Join(sysDevicesCPU, "isolated"), "2-3,6-7\n")
+	writeFile(t, filepath.Join(sysDevicesCPU, "nohz_full"), "2-3,6-7\n")
+
+	writeFile(t, filepath.Join(sysDevicesNode, "node0", "cpulist"), "0-3\n")
+	writeFile(t, filepath.Join(sysDevicesNode, "node0", "meminfo"), "Node 0 MemTotal:       1048576 kB\n")
+	writeFile(t, filepath.Join(sysDevicesNode, "node0", "distance"), "10 32\n")
+	writeFile(t, filepath.Join(sysDevicesNode, "node1", "cpulist"), "4-7\n")
+	writeFile(t, filepath.Join(sysDevicesNode, "node1", "meminfo"), "Node 1 MemTotal:       1048576 kB\n")
+	writeFile(t, filepath.Join(sysDevicesNode, "possible"), "0-1\n")
+
+	m, err := (&Collector{}).Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if m.Type != measurement.TypeCPU {
+		t.Errorf("Type = %s, want %s", m.Type, measurement.TypeCPU)
+	}
+	var names []string
+	for _, st := range m.Subtypes {
+		names = append(names, st.Name)
+	}
+	if !slices.Equal(names, measurement.Subtypes[measurement.TypeCPU]) {
+		t.Errorf("subtypes = %v, want %v", names, measurement.Subtypes[measurement.TypeCPU])
+	}
+
+	info := subtype(t, m, "info")
+	assertReading(t, info, "model", "AMD EPYC 9654 96-Core Processor")
+	assertReading(t, info, "vendor", "AuthenticAMD")
+	assertReading(t, info, "architecture", "x86_64")
+	assertReading(t, info, "page-size-kb", 4)
+	assertReading(t, info, KeySockets, 2)
+	assertReading(t, info, KeyCores, 4)
+	assertReading(t, info, KeyThreads, 8)
+	assertReading(t, info, KeyThreadsPerCore, 2)
+	assertReading(t, info, KeySMT, "on")
+	assertReading(t, info, "smt-active", true)
+	assertReading(t, info, KeyGovernor, "performance,powersave")
+	assertReading(t, info, "scaling-driver", "acpi-cpufreq")
+
+	numa := subtype(t, m, "numa")
+	assertReading(t, numa, KeyNodeCount, 2)
+	assertReading(t, numa, "memory-mb", 2048)
+	assertReading(t, numa, "node0.cpus", "0-3")
+	assertReading(t, numa, "node0.cpu-count", 4)
+	assertReading(t, numa, "node0.memory-mb", 1024)
+	assertReading(t, numa, "node0.distance", "10 32")
+	assertReading(t, numa, "node1.cpus", "4-7")
+
+	isolation := subtype(t, m, "isolation")
+	assertReading(t, isolation, "isolcpus", "managed_irq,domain,2-3,6-7")
+	assertReading(t, isolation, "nohz_full", "2-3,6-7")
+	assertReading(t, isolation, "rcu_nocbs", "")
+	assertReading(t, isolation, KeyIsolatedCPUs, "2-3,6-7")
+	assertReading(t, isolation, "isolated-cpu-count", 4)
+	assertReading(t, isolation, "nohz-full-cpus", "2-3,6-7")
+}
+
+func TestCollector_Collect_MinimalArm(t *testing.T) {
+	setupFakeNode(t)
+	pageSize = func() int { return 65536 }
+	writeFile(t, procCPUInfo, armCPUInfo)
+	writeFile(t, procCmdline, "ro quiet\n")
+
+	// No online list, cpufreq, SMT or NUMA directories.
+	writeCPU(t, 0, 0, 0, "")
+	writeCPU(t, 1, 0, 1, "")
+	writeFile(t, filepath.Join(sysDevicesCPU, "nohz_full"), "(null)\n")
+
+	m, err := (&Collector{}).Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	info := subtype(t, m, "info")
+	assertReading(t, info, "model", "Neoverse-V2")
+	assertReading(t, info, "vendor", "ARM")
+	assertReading(t, info, "architecture", "arm64")
+	assertReading(t, info, "page-size-kb", 64)
+	assertReading(t, info, KeySockets, 1)
+	assertReading(t, info, KeyCores, 2)
+	assertReading(t, info, KeyThreadsPerCore, 1)
+	assertReading(t, info, KeyGovernor, "")
+	if _, ok := info[KeySMT]; ok {
+		t.Error("smt reported without SMT control in sysfs")
+	}
+
+	assertReading(t, subtype(t, m, "numa"), KeyNodeCount, 0)
+
+	isolation := subtype(t, m, "isolation")
+	assertReading(t, isolation, "isolcpus", "")
+	assertReading(t, isolation, "isolated-cpu-count", 0)
+	if _, ok := isolation["nohz-full-cpus"]; ok {
+		t.Error("nohz-full-cpus reported while nohz_full is inactive")
+	}
+}
+
+func TestCollector_Collect_MissingCPUInfo(t *testing.T) {
+	setupFakeNode(t)
+	if _, err := (&Collector{}).Collect(context.Background()); err == nil {
+		t.Error("Collect() error = nil, want error for missing cpuinfo")
+	}
+}
+
+func TestCollector_Collect_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := (&Collector{}).Collect(ctx); err == nil {
+		t.Error("Collect() error = nil, want context error")
+	}
+}
+
+func TestParseCPUList(t *testing.T) {
+	tests := []struct {
+		list string
+		want []int
+	}{
+		{"", nil},
+		{"0", []int{0}},
+		{"0-3", []int{0, 1, 2, 3}},
+		{"0-1,8,10-11", []int{0, 1, 8, 10, 11}},
+		{"3-1,x,5", []int{5}},
+	}
+	for _, tt := range tests {
+		if got := parseCPUList(tt.list); !slices.Equal(got, tt.want) {
+			t.Errorf("parseCPUList(%q) = %v, want %v", tt.list, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpu collects CPU topology, NUMA layout and CPU isolation settings
+// from the node.
+//
+// Training recipes that pin workers to the NUMA node closest to their GPUs
+// need to know how the node is laid out: how many sockets and NUMA nodes it
+// has, which CPUs belong to each node, whether SMT is enabled, which
+// frequency governor is active and which CPUs are reserved by isolcpus or
+// nohz_full.
+//
+// # Collected Data
+//
+// The collector returns a CPU measurement with 3 subtypes:
+//
+// 1. info - Processor and topology (/proc/cpuinfo, /sys/devices/system/cpu):
+//   - model: CPU model name (e.g., "Neoverse-V2", "AMD EPYC 9654 96-Core Processor")
+//   - vendor, architecture: vendor ID or Arm implementer, and x86_64 or arm64
+//   - page-size-kb: kernel base page size (4, or 64 on many Grace kernels)
+//   - sockets, cores, threads: counts over the online CPUs
+//   - threads-per-core: 2 when SMT is active on x86 nodes
+//   - smt: SMT control state (on, off, forceoff, notsupported)
+//   - smt-active: true when sibling threads are online
+//   - governor: distinct scaling governors in use (e.g., "performance")
+//   - scaling-driver: cpufreq driver (e.g., "acpi-cpufreq")
+//
+// 2. numa - NUMA layout (/sys/devices/system/node):
+//   - node-count: Number of NUMA nodes
+//   - memory-mb: Total system memory
+//   - node<N>.cpus: CPU list of the node (e.g., "0-71")
+//   - node<N>.cpu-count, node<N>.memory-mb
+//   - node<N>.distance: SLIT distances to every node (e.g., "10 40")
+//
+// 3. isolation - CPU isolation:
+//   - isolcpus, nohz_full, rcu_nocbs, irqaffinity: boot parameter values from
+//     /proc/cmdline, empty when not set
+//   - isolated-cpus, isolated-cpu-count: CPUs the kernel isolated
+//   - nohz-full-cpus: CPUs running tickless, when nohz_full is active
+//
+// Missing cpufreq, SMT and NUMA sysfs directories are not errors; the
+// corresponding readings are simply absent or report nothing found.
+//
+// # Usage
+//
+//	collector := &cpu.Collector{}
+//	m, err := collector.Collect(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//
+// Recipes can use the readings as constraints, for example
+// CPU.info.governor == performance for latency-sensitive training overlays.
+package cpu
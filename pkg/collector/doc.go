@@ -17,8 +17,9 @@
 // # Overview
 //
 // This package defines a unified interface for gathering measurements from various system
-// sources including Kubernetes clusters, GPU hardware, operating system configuration, and
-// systemd services. Collectors run concurrently and return structured measurement data that
+// sources including Kubernetes clusters, GPU hardware, operating system configuration,
+// systemd services, high-performance networking, CPU topology, and container runtime
+// configuration. Collectors run concurrently and return structured measurement data that
 // can be serialized for analysis or recommendation generation.
 //
 // # Core Interface
@@ -40,6 +41,10 @@
 //	    CreateOSCollector() Collector
 //	    CreateKubernetesCollector() Collector
 //	    CreateGPUCollector() Collector
+//	    CreateNetworkCollector() Collector
+//	    CreateCPUCollector() Collector
+//	    CreateRuntimeCollector() Collector
+//	    CreatePluginCollector() Collector
 //	}
 //
 // The DefaultFactory provides production implementations with configurable options:
@@ -73,6 +78,29 @@
 //   - Active state and startup settings
 //   - Resource limits and dependencies
 //
+// Network: Captures RDMA and high-performance networking state:
+//   - NVIDIA/Mellanox NIC models (lspci)
+//   - Installed MOFED/DOCA-OFED version
+//   - RDMA device presence and link layer
+//   - SR-IOV VF counts and interface MTU
+//
+// CPU: Captures CPU topology for NUMA-aware placement:
+//   - CPU model, sockets, cores and threads
+//   - SMT state and frequency governor
+//   - NUMA nodes with their CPUs and memory
+//   - isolcpus/nohz_full boot parameters and isolated CPUs
+//
+// Runtime: Reads container runtime configuration files (WithHostRoot):
+//   - containerd and CRI-O default runtime and runtime handlers
+//   - nvidia runtime handler presence and cgroup driver
+//   - Registry mirrors
+//   - NVIDIA Container Toolkit mode and driver root
+//
+// Plugin: Runs site-specific exec plugins from a directory (WithPluginDir).
+// Each plugin writes a JSON measurement document to stdout; readings are
+// merged into a single Plugin measurement with one subtype per plugin. See
+// package plugin for the output contract.
+//
 // # Usage Example
 //
 // Using the default factory:
@@ -103,6 +131,9 @@
 //	    {"gpu", factory.CreateGPUCollector()},
 //	    {"os", factory.CreateOSCollector()},
 //	    {"systemd", factory.CreateSystemDCollector()},
+//	    {"network", factory.CreateNetworkCollector()},
+//	    {"cpu", factory.CreateCPUCollector()},
+//	    {"runtime", factory.CreateRuntimeCollector()},
 //	}
 //
 //	for _, col := range collectors {
@@ -15,9 +15,15 @@
 package collector
 
 import (
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/collector/cpu"
 	"github.com/NVIDIA/eidos/pkg/collector/gpu"
 	"github.com/NVIDIA/eidos/pkg/collector/k8s"
+	"github.com/NVIDIA/eidos/pkg/collector/network"
 	"github.com/NVIDIA/eidos/pkg/collector/os"
+	"github.com/NVIDIA/eidos/pkg/collector/plugin"
+	"github.com/NVIDIA/eidos/pkg/collector/runtime"
 	"github.com/NVIDIA/eidos/pkg/collector/systemd"
 )
 
@@ -29,6 +35,10 @@ type Factory interface {
 	CreateOSCollector() Collector
 	CreateKubernetesCollector() Collector
 	CreateGPUCollector() Collector
+	CreateNetworkCollector() Collector
+	CreateCPUCollector() Collector
+	CreateRuntimeCollector() Collector
+	CreatePluginCollector() Collector
 }
 
 // Option defines a configuration option for DefaultFactory.
@@ -50,12 +60,38 @@ func WithVersion(version string) Option {
 	}
 }
 
+// WithPluginDir sets the directory of exec collector plugins. Empty disables plugins.
+func WithPluginDir(dir string) Option {
+	return func(f *DefaultFactory) {
+		f.PluginDir = dir
+	}
+}
+
+// WithPluginTimeout sets how long each collector plugin may run.
+func WithPluginTimeout(timeout time.Duration) Option {
+	return func(f *DefaultFactory) {
+		f.PluginTimeout = timeout
+	}
+}
+
+// WithHostRoot sets the host filesystem root the runtime collector reads
+// configuration files from, for collection from a container with the host
+// mounted. Empty means "/".
+func WithHostRoot(root string) Option {
+	return func(f *DefaultFactory) {
+		f.HostRoot = root
+	}
+}
+
 // DefaultFactory is the standard implementation of Factory that creates collectors
 // with production dependencies. It configures default systemd services to monitor
 // and supports version tracking.
 type DefaultFactory struct {
 	SystemDServices []string
 	Version         string
+	PluginDir       string
+	PluginTimeout   time.Duration
+	HostRoot        string
 }
 
 // NewDefaultFactory creates a new DefaultFactory with default configuration.
@@ -83,6 +119,24 @@ func (f *DefaultFactory) CreateGPUCollector() Collector {
 	return &gpu.Collector{}
 }
 
+// CreateNetworkCollector creates a network collector that gathers NIC, OFED, RDMA and SR-IOV state.
+func (f *DefaultFactory) CreateNetworkCollector() Collector {
+	return &network.Collector{}
+}
+
+// CreateCPUCollector creates a CPU collector that gathers CPU topology, NUMA layout and isolation settings.
+func (f *DefaultFactory) CreateCPUCollector() Collector {
+	return &cpu.Collector{}
+}
+
+// CreateRuntimeCollector creates a container runtime collector that reads the
+// containerd, CRI-O and NVIDIA Container Toolkit configuration.
+func (f *DefaultFactory) CreateRuntimeCollector() Collector {
+	return &runtime.Collector{
+		Root: f.HostRoot,
+	}
+}
+
 // CreateSystemDCollector creates a systemd collector that monitors the configured services.
 func (f *DefaultFactory) CreateSystemDCollector() Collector {
 	return &systemd.Collector{
@@ -99,3 +153,12 @@ func (f *DefaultFactory) CreateOSCollector() Collector {
 func (f *DefaultFactory) CreateKubernetesCollector() Collector {
 	return &k8s.Collector{}
 }
+
+// CreatePluginCollector creates a collector that runs the exec plugins in the
+// configured plugin directory.
+func (f *DefaultFactory) CreatePluginCollector() Collector {
+	return &plugin.Collector{
+		Dir:     f.PluginDir,
+		Timeout: f.PluginTimeout,
+	}
+}
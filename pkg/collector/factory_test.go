@@ -17,7 +17,10 @@ package collector
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/NVIDIA/eidos/pkg/collector/plugin"
+	"github.com/NVIDIA/eidos/pkg/collector/runtime"
 	"github.com/NVIDIA/eidos/pkg/collector/systemd"
 )
 
@@ -64,6 +67,10 @@ func TestDefaultCollectorFactory_AllCollectors(t *testing.T) {
 		factory.CreateOSCollector,
 		factory.CreateGPUCollector,
 		factory.CreateKubernetesCollector,
+		factory.CreateNetworkCollector,
+		factory.CreateCPUCollector,
+		factory.CreateRuntimeCollector,
+		factory.CreatePluginCollector,
 	}
 
 	for i, createFunc := range collectorFuncs {
@@ -87,6 +94,33 @@ func TestWithSystemDServices(t *testing.T) {
 	}
 }
 
+func TestWithPluginOptions(t *testing.T) {
+	factory := NewDefaultFactory(
+		WithPluginDir("/opt/eidos/plugins"),
+		WithPluginTimeout(5*time.Second),
+	)
+
+	pc, ok := factory.CreatePluginCollector().(*plugin.Collector)
+	if !ok {
+		t.Fatal("expected *plugin.Collector")
+	}
+	if pc.Dir != "/opt/eidos/plugins" || pc.Timeout != 5*time.Second {
+		t.Errorf("plugin collector = %+v", pc)
+	}
+}
+
+func TestWithHostRoot(t *testing.T) {
+	factory := NewDefaultFactory(WithHostRoot("/host"))
+
+	rc, ok := factory.CreateRuntimeCollector().(*runtime.Collector)
+	if !ok {
+		t.Fatal("expected *runtime.Collector")
+	}
+	if rc.Root != "/host" {
+		t.Errorf("runtime collector root = %q, want /host", rc.Root)
+	}
+}
+
 func TestWithVersion(t *testing.T) {
 	factory := NewDefaultFactory(WithVersion("v1.2.3"))
 
@@ -39,10 +39,54 @@
 //   - persistenceMode: Whether persistence mode is enabled
 //   - computeMode: Compute mode (Default, Exclusive, Prohibited)
 //   - migMode: MIG mode (Enabled, Disabled) for supported GPUs
-//   - addressingMode: GPU addressing mode
+//   - addressingMode: GPU addressing mode (ATS on Grace Hopper and GB200)
+//   - c2c-mode: NVLink-C2C mode on Grace-attached GPUs; omitted on
+//     discrete GPUs, which report N/A
 //   - powerLimit: Current power limit in watts
 //   - powerState: Current power state (P0-P12)
 //
+// PCI Identity:
+//   - pci-device-ids: distinct GPU PCI IDs as "vendor:device" pairs
+//     (e.g. "10de:2330"), used to configure GPU passthrough
+//
+// MIG Inventory (mig subtype):
+//   - mode: enabled, disabled or partial across MIG-capable GPUs
+//   - strategy: none, single or mixed, matching the GPU Operator mig.strategy
+//     the current partitioning needs
+//   - capable-count, enabled-count, instance-count
+//   - profiles: MIG profiles in use (e.g. "1g.10gb,3g.40gb")
+//   - gpu<N>.mode, gpu<N>.pending-mode: current and pending MIG mode per GPU
+//   - gpu<N>.layout: GPU instances as profile@start:size
+//
+// GPU instances are listed with "nvidia-smi mig -lgi" only when MIG is
+// enabled on at least one GPU.
+//
+// Memory Health (health subtype):
+//   - ecc-mode: enabled, disabled or partial across GPUs that support ECC
+//   - ecc-correctable-errors, ecc-uncorrectable-errors: aggregate error counts
+//   - remapped-rows-correctable, remapped-rows-uncorrectable, retired-pages
+//   - remapped-rows-pending, remapped-rows-failure, retired-pages-pending
+//   - healthy: false when a remap or retirement awaits a GPU reset, a remap
+//     failed or the SRAM error threshold was exceeded
+//   - findings: the conditions behind healthy, as gpu<N>:<condition>
+//
+// Health findings are also logged as warnings during collection. The base
+// recipe constrains the pending and failure readings to "false", so
+// "eidos validate" fails for nodes that need a GPU reset.
+//
+// Firmware Inventory (firmware subtype):
+//   - gpu<N>.vbios-version, gpu<N>.gsp-firmware-version
+//   - gpu<N>.inforom-image, gpu<N>.inforom-oem, gpu<N>.inforom-ecc,
+//     gpu<N>.inforom-power: InfoROM image and object versions
+//   - gpu<N>.persistence-mode: enabled or disabled
+//   - vbios-versions, inforom-image-versions: distinct versions across GPUs
+//   - vbios-version-min: oldest vBIOS, comparing fields as hex
+//   - vbios-uniform: true when every GPU runs the same vBIOS
+//   - persistence-mode: enabled, disabled or partial across GPUs
+//
+// Per-GPU ECC correctable error counts are reported in the health subtype
+// as gpu<N>.ecc-correctable-errors.
+//
 // # Usage
 //
 // Create and use the collector:
@@ -0,0 +1,139 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// firmwareSubtype is the measurement subtype holding the GPU firmware
+// inventory.
+const firmwareSubtype = "firmware"
+
+// Firmware readings referenced by recipe constraints.
+const (
+	// KeyVBIOSVersionMin is the lowest vBIOS version across GPUs.
+	KeyVBIOSVersionMin = "vbios-version-min"
+	// KeyVBIOSUniform is true when every GPU runs the same vBIOS.
+	KeyVBIOSUniform = "vbios-uniform"
+	// KeyPersistenceMode is enabled, disabled or partial across GPUs.
+	KeyPersistenceMode = "persistence-mode"
+)
+
+// getFirmwareReadings builds the firmware subtype from the vBIOS, InfoROM,
+// GSP firmware and persistence mode reported by nvidia-smi.
+//
+// Readings:
+//   - vbios-versions, inforom-image-versions: distinct versions across GPUs,
+//     sorted and comma-separated
+//   - vbios-version-min: the lowest vBIOS version, comparing its dotted
+//     hexadecimal fields (e.g. 96.00.A5.00.01)
+//   - vbios-uniform: every GPU runs the same vBIOS
+//   - persistence-mode: enabled, disabled or partial across GPUs ("n/a"
+//     when none report it)
+//   - gpu<N>.vbios-version, gpu<N>.gsp-firmware-version, gpu<N>.persistence-mode
+//   - gpu<N>.inforom-image, gpu<N>.inforom-oem, gpu<N>.inforom-ecc,
+//     gpu<N>.inforom-power: InfoROM image and object versions
+func getFirmwareReadings(device *NVSMIDevice) map[string]measurement.Reading {
+	data := make(map[string]measurement.Reading)
+
+	var (
+		vbios, inforom     []string
+		minVBIOS           string
+		capable, persisted int
+	)
+
+	for i, g := range device.GPUs {
+		key := func(field string) string {
+			return fmt.Sprintf("gpu%d.%s", i, field)
+		}
+
+		data[key("vbios-version")] = measurement.Str(g.VbiosVersion)
+		data[key("gsp-firmware-version")] = measurement.Str(g.GspFirmwareVersion)
+		data[key("inforom-image")] = measurement.Str(g.InforomVersion.ImgVersion)
+		data[key("inforom-oem")] = measurement.Str(g.InforomVersion.OemObject)
+		data[key("inforom-ecc")] = measurement.Str(g.InforomVersion.EccObject)
+		data[key("inforom-power")] = measurement.Str(g.InforomVersion.PwrObject)
+
+		mode := normalizeECCMode(g.PersistenceMode)
+		data[key("persistence-mode")] = measurement.Str(mode)
+		if mode != "n/a" {
+			capable++
+			if mode == ECCModeEnabled {
+				persisted++
+			}
+		}
+
+		if v := strings.TrimSpace(g.VbiosVersion); v != "" {
+			if !slices.Contains(vbios, v) {
+				vbios = append(vbios, v)
+			}
+			if minVBIOS == "" || compareVBIOS(v, minVBIOS) < 0 {
+				minVBIOS = v
+			}
+		}
+		if v := strings.TrimSpace(g.InforomVersion.ImgVersion); v != "" && !slices.Contains(inforom, v) {
+			inforom = append(inforom, v)
+		}
+	}
+	slices.Sort(vbios)
+	slices.Sort(inforom)
+
+	data["vbios-versions"] = measurement.Str(strings.Join(vbios, ","))
+	data["inforom-image-versions"] = measurement.Str(strings.Join(inforom, ","))
+	data[KeyVBIOSVersionMin] = measurement.Str(minVBIOS)
+	data[KeyVBIOSUniform] = measurement.Bool(len(vbios) <= 1)
+	data[KeyPersistenceMode] = measurement.Str(aggregateMode(capable, persisted))
+
+	return data
+}
+
+// compareVBIOS compares two vBIOS versions field by field, reading each
+// dot-separated field as hexadecimal. Fields that are not hexadecimal are
+// compared as strings.
+func compareVBIOS(a, b string) int {
+	af, bf := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(af) && i < len(bf); i++ {
+		an, aErr := strconv.ParseUint(af[i], 16, 64)
+		bn, bErr := strconv.ParseUint(bf[i], 16, 64)
+		var c int
+		if aErr == nil && bErr == nil {
+			c = cmpUint(an, bn)
+		} else {
+			c = strings.Compare(af[i], bf[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return len(af) - len(bf)
+}
+
+// cmpUint returns -1, 0 or 1 as a is less than, equal to or greater than b.
+func cmpUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
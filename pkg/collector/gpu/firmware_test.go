@@ -0,0 +1,140 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"os"
+	"testing"
+)
+
+func TestGetFirmwareReadings(t *testing.T) {
+	gpu := func(vbios, inforom, persistence string) GPU {
+		return GPU{
+			VbiosVersion:       vbios,
+			GspFirmwareVersion: "570.86.15",
+			PersistenceMode:    persistence,
+			InforomVersion:     InforomVersion{ImgVersion: inforom, OemObject: "2.1", EccObject: "7.16", PwrObject: "N/A"},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		device *NVSMIDevice
+		want   map[string]string
+	}{
+		{
+			name: "uniform",
+			device: &NVSMIDevice{GPUs: []GPU{
+				gpu("96.00.A5.00.01", "G520.0200.00.05", "Enabled"),
+				gpu("96.00.A5.00.01", "G520.0200.00.05", "Enabled"),
+			}},
+			want: map[string]string{
+				"vbios-versions":            "96.00.A5.00.01",
+				"inforom-image-versions":    "G520.0200.00.05",
+				KeyVBIOSVersionMin:          "96.00.A5.00.01",
+				KeyVBIOSUniform:             "true",
+				KeyPersistenceMode:          ECCModeEnabled,
+				"gpu1.vbios-version":        "96.00.A5.00.01",
+				"gpu1.inforom-ecc":          "7.16",
+				"gpu1.persistence-mode":     ECCModeEnabled,
+				"gpu0.gsp-firmware-version": "570.86.15",
+			},
+		},
+		{
+			name: "mixed firmware",
+			device: &NVSMIDevice{GPUs: []GPU{
+				gpu("96.00.A5.00.01", "G520.0200.00.05", "Enabled"),
+				gpu("96.00.9F.00.01", "G520.0200.00.04", "Disabled"),
+			}},
+			want: map[string]string{
+				// A5 is newer than 9F when the fields are read as hex.
+				"vbios-versions":         "96.00.9F.00.01,96.00.A5.00.01",
+				"inforom-image-versions": "G520.0200.00.04,G520.0200.00.05",
+				KeyVBIOSVersionMin:       "96.00.9F.00.01",
+				KeyVBIOSUniform:          "false",
+				KeyPersistenceMode:       ECCModePartial,
+				"gpu1.persistence-mode":  ECCModeDisabled,
+			},
+		},
+		{
+			name:   "no GPUs",
+			device: &NVSMIDevice{},
+			want: map[string]string{
+				"vbios-versions":   "",
+				KeyVBIOSVersionMin: "",
+				KeyVBIOSUniform:    "true",
+				KeyPersistenceMode: "n/a",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := getFirmwareReadings(tt.device)
+			for key, w := range tt.want {
+				r, ok := data[key]
+				if !ok {
+					t.Errorf("missing reading %q", key)
+					continue
+				}
+				if got := r.String(); got != w {
+					t.Errorf("%s = %q, want %q", key, got, w)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareVBIOS(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"96.00.A5.00.01", "96.00.A5.00.01", 0},
+		{"96.00.9F.00.01", "96.00.A5.00.01", -1},
+		{"97.00.00.00.01", "96.00.FF.00.01", 1},
+		{"96.00.A5", "96.00.A5.00.01", -1},
+		{"96.00.xx", "96.00.yy", -1},
+	}
+	for _, tt := range tests {
+		got := compareVBIOS(tt.a, tt.b)
+		if (got < 0) != (tt.want < 0) || (got > 0) != (tt.want > 0) {
+			t.Errorf("compareVBIOS(%q, %q) = %d, want sign of %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestGetFirmwareReadings_FromXML(t *testing.T) {
+	data, err := os.ReadFile("gpu.xml")
+	if err != nil {
+		t.Skipf("gpu.xml not available: %v", err)
+	}
+	device, err := parseSMIDevice(data)
+	if err != nil {
+		t.Fatalf("parseSMIDevice() error = %v", err)
+	}
+
+	readings := getFirmwareReadings(device)
+	for key, want := range map[string]string{
+		KeyVBIOSVersionMin:   "96.00.A5.00.01",
+		KeyVBIOSUniform:      "true",
+		KeyPersistenceMode:   ECCModeDisabled,
+		"gpu0.inforom-image": "G520.0200.00.05",
+	} {
+		if got := readings[key].String(); got != want {
+			t.Errorf("%s = %s, want %s", key, got, want)
+		}
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"log/slog"
 	"os/exec"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/eidos/pkg/defaults"
@@ -70,7 +72,7 @@ func (s *Collector) Collect(ctx context.Context) (*measurement.Measurement, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute nvidia-smi command: %w", err)
 	}
-	smiReadings, err := getSMIReadings(data)
+	smiDevice, err := parseSMIDevice(data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse nvidia-smi output: %w", err)
 	}
@@ -80,7 +82,19 @@ func (s *Collector) Collect(ctx context.Context) (*measurement.Measurement, erro
 		Subtypes: []measurement.Subtype{
 			{
 				Name: "smi",
-				Data: smiReadings, // no need for filtering here since we control the fields in getSMIReadings
+				Data: smiReadingsFromDevice(smiDevice), // no need for filtering here since we control the fields
+			},
+			{
+				Name: migSubtype,
+				Data: getMIGReadings(ctx, smiDevice, listGPUInstances),
+			},
+			{
+				Name: healthSubtype,
+				Data: getHealthReadings(smiDevice),
+			},
+			{
+				Name: firmwareSubtype,
+				Data: getFirmwareReadings(smiDevice),
 			},
 		},
 	}
@@ -109,7 +123,10 @@ func getSMIReadings(data []byte) (map[string]measurement.Reading, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse nvidia-smi output: %w", err)
 	}
+	return smiReadingsFromDevice(smiDevice), nil
+}
 
+func smiReadingsFromDevice(smiDevice *NVSMIDevice) map[string]measurement.Reading {
 	smiData := make(map[string]measurement.Reading)
 
 	smiData[measurement.KeyGPUDriver] = measurement.Str(smiDevice.DriverVersion)
@@ -120,7 +137,11 @@ func getSMIReadings(data []byte) (map[string]measurement.Reading, error) {
 
 	if gpuCount < 1 {
 		slog.Warn("No GPUs found in nvidia-smi output")
-		return smiData, nil
+		return smiData
+	}
+
+	if ids := pciDeviceIDs(smiDevice.GPUs); ids != "" {
+		smiData[measurement.KeyGPUPCIDeviceIDs] = measurement.Str(ids)
 	}
 
 	// Only include details for the first GPU to keep output concise
@@ -138,7 +159,33 @@ func getSMIReadings(data []byte) (map[string]measurement.Reading, error) {
 	smiData[key("vbios-version")] = measurement.Str(gpu.VbiosVersion)
 	smiData[key("gsp-firmware-version")] = measurement.Str(gpu.GspFirmwareVersion)
 
-	return smiData, nil
+	// Grace Hopper and Grace Blackwell GPUs attach to the CPU over NVLink-C2C;
+	// discrete GPUs report N/A.
+	if mode := strings.TrimSpace(gpu.C2cMode); mode != "" && mode != "N/A" {
+		smiData[key("c2c-mode")] = measurement.Str(mode)
+	}
+
+	return smiData
+}
+
+// pciDeviceIDs returns the distinct PCI IDs of gpus as sorted, comma-separated
+// "vendor:device" pairs in lowercase hex, the form used by lspci and KubeVirt
+// pciVendorSelector. nvidia-smi reports the ID as device then vendor, e.g.
+// "233010DE" for vendor 10de, device 2330.
+func pciDeviceIDs(gpus []GPU) string {
+	var ids []string
+	for _, gpu := range gpus {
+		raw := strings.ToLower(strings.TrimPrefix(gpu.Pci.PciDeviceID, "0x"))
+		if len(raw) != 8 {
+			continue
+		}
+		id := raw[4:] + ":" + raw[:4]
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return strings.Join(ids, ",")
 }
 
 func executeCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
@@ -211,6 +211,73 @@ func TestGetSMIReadings(t *testing.T) {
 	if gpuModel.Any().(string) != "NVIDIA H100 80GB HBM3" {
 		t.Errorf("expected GPU model 'NVIDIA H100 80GB HBM3', got %v", gpuModel.Any())
 	}
+
+	// Validate PCI IDs
+	pciIDs, ok := readings[measurement.KeyGPUPCIDeviceIDs]
+	if !ok {
+		t.Fatal("missing pci-device-ids key")
+	}
+	if pciIDs.Any().(string) != "10de:2330" {
+		t.Errorf("expected PCI device IDs '10de:2330', got %v", pciIDs.Any())
+	}
+
+	// Fixture GPUs are discrete H100s with C2C disabled
+	if c2c, ok := readings["gpu.c2c-mode"]; !ok || c2c.Any().(string) != "Disabled" {
+		t.Errorf("expected gpu.c2c-mode 'Disabled', got %v", c2c)
+	}
+}
+
+func TestSMIReadingsFromDevice_C2CMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		c2cMode string
+		want    string
+	}{
+		{name: "grace attached", c2cMode: "Enabled", want: "Enabled"},
+		{name: "discrete", c2cMode: "N/A"},
+		{name: "not reported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readings := smiReadingsFromDevice(&NVSMIDevice{
+				GPUs: []GPU{{ProductName: "NVIDIA GB200", C2cMode: tt.c2cMode}},
+			})
+			got, ok := readings["gpu.c2c-mode"]
+			if tt.want == "" {
+				if ok {
+					t.Errorf("expected no gpu.c2c-mode, got %v", got.Any())
+				}
+				return
+			}
+			if !ok || got.Any().(string) != tt.want {
+				t.Errorf("gpu.c2c-mode = %v, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPCIDeviceIDs(t *testing.T) {
+	gpu := func(id string) GPU {
+		return GPU{Pci: Pci{PciDeviceID: id}}
+	}
+
+	tests := []struct {
+		name string
+		gpus []GPU
+		want string
+	}{
+		{name: "single model", gpus: []GPU{gpu("233010DE"), gpu("233010DE")}, want: "10de:2330"},
+		{name: "mixed models sorted", gpus: []GPU{gpu("26B510DE"), gpu("0x233010DE")}, want: "10de:2330,10de:26b5"},
+		{name: "malformed skipped", gpus: []GPU{gpu(""), gpu("N/A")}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pciDeviceIDs(tt.gpus); got != tt.want {
+				t.Errorf("pciDeviceIDs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestGetSMIReadings_NoGPUs(t *testing.T) {
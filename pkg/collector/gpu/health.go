@@ -0,0 +1,196 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// healthSubtype is the measurement subtype holding GPU memory health.
+const healthSubtype = "health"
+
+// Health readings referenced by recipe constraints.
+const (
+	// KeyHealthy is false when any GPU needs attention (see getHealthReadings).
+	KeyHealthy = "healthy"
+	// KeyRemappedRowsPending is true when a GPU has row remaps waiting for a
+	// GPU reset to take effect.
+	KeyRemappedRowsPending = "remapped-rows-pending"
+	// KeyRemappedRowsFailure is true when a GPU failed to remap a row.
+	KeyRemappedRowsFailure = "remapped-rows-failure"
+	// KeyRetiredPagesPending is true when a GPU has page retirements waiting
+	// for a reboot to take effect.
+	KeyRetiredPagesPending = "retired-pages-pending"
+)
+
+// ECC modes reported in the health subtype.
+const (
+	// ECCModeEnabled means ECC is enabled on every GPU that supports it.
+	ECCModeEnabled = "enabled"
+	// ECCModeDisabled means ECC is disabled on every GPU that supports it.
+	ECCModeDisabled = "disabled"
+	// ECCModePartial means ECC is enabled on some GPUs but not others.
+	ECCModePartial = "partial"
+)
+
+// getHealthReadings builds the health subtype from the ECC, row remapping and
+// page retirement state reported by nvidia-smi.
+//
+// Readings:
+//   - ecc-mode: enabled, disabled or partial across GPUs that support ECC
+//     ("n/a" when none do)
+//   - ecc-mode-change-pending: a GPU's pending ECC mode differs from its current one
+//   - ecc-correctable-errors, ecc-uncorrectable-errors: aggregate (lifetime)
+//     SRAM and DRAM error counts summed across GPUs
+//   - ecc-sram-threshold-exceeded: a GPU exceeded its SRAM error threshold
+//   - remapped-rows-correctable, remapped-rows-uncorrectable: rows remapped
+//     due to correctable and uncorrectable errors
+//   - remapped-rows-pending, remapped-rows-failure
+//   - retired-pages, retired-pages-pending: retired pages (GPUs without row
+//     remapping) and whether retirements await a reboot
+//   - healthy: false when a remap or retirement is pending, a remap failed or
+//     the SRAM threshold was exceeded
+//   - findings: comma-separated gpu<N>:<condition> entries explaining healthy
+//   - gpu<N>.ecc-mode, gpu<N>.ecc-correctable-errors,
+//     gpu<N>.ecc-uncorrectable-errors
+func getHealthReadings(device *NVSMIDevice) map[string]measurement.Reading {
+	data := make(map[string]measurement.Reading)
+
+	var (
+		capable, enabled                              int
+		correctable, uncorrectable                    int64
+		remappedCorrectable, remappedUncorrectable    int64
+		retired                                       int64
+		modeChangePending, thresholdExceeded          bool
+		remapPending, remapFailure, retirementPending bool
+		findings                                      []string
+	)
+
+	for i, g := range device.GPUs {
+		mode := normalizeECCMode(g.EccMode.CurrentEcc)
+		data[fmt.Sprintf("gpu%d.ecc-mode", i)] = measurement.Str(mode)
+		if mode != "n/a" {
+			capable++
+			if mode == ECCModeEnabled {
+				enabled++
+			}
+			if pending := normalizeECCMode(g.EccMode.PendingEcc); pending != "n/a" && pending != mode {
+				modeChangePending = true
+				findings = append(findings, fmt.Sprintf("gpu%d:ecc-mode-change-pending", i))
+			}
+		}
+
+		agg := g.EccErrors.Aggregate
+		gpuUncorrectable := parseCount(agg.SramUncorrectableParity) +
+			parseCount(agg.SramUncorrectableSecded) + parseCount(agg.DramUncorrectable)
+		uncorrectable += gpuUncorrectable
+		gpuCorrectable := parseCount(agg.SramCorrectable) + parseCount(agg.DramCorrectable)
+		correctable += gpuCorrectable
+		data[fmt.Sprintf("gpu%d.ecc-correctable-errors", i)] = measurement.Int64(gpuCorrectable)
+		data[fmt.Sprintf("gpu%d.ecc-uncorrectable-errors", i)] = measurement.Int64(gpuUncorrectable)
+		if isYes(agg.SramThresholdExceeded) {
+			thresholdExceeded = true
+			findings = append(findings, fmt.Sprintf("gpu%d:ecc-sram-threshold-exceeded", i))
+		}
+
+		rows := g.RemappedRows
+		remappedCorrectable += parseCount(rows.RemappedRowCorr)
+		remappedUncorrectable += parseCount(rows.RemappedRowUnc)
+		if isYes(rows.RemappedRowPending) {
+			remapPending = true
+			findings = append(findings, fmt.Sprintf("gpu%d:%s", i, KeyRemappedRowsPending))
+		}
+		if isYes(rows.RemappedRowFailure) {
+			remapFailure = true
+			findings = append(findings, fmt.Sprintf("gpu%d:%s", i, KeyRemappedRowsFailure))
+		}
+
+		pages := g.RetiredPages
+		retired += parseCount(pages.MultipleSingleBitRetirement.RetiredCount) +
+			parseCount(pages.DoubleBitRetirement.RetiredCount)
+		if isYes(pages.PendingRetirement) {
+			retirementPending = true
+			findings = append(findings, fmt.Sprintf("gpu%d:%s", i, KeyRetiredPagesPending))
+		}
+	}
+
+	data["ecc-mode"] = measurement.Str(aggregateMode(capable, enabled))
+	data["ecc-mode-change-pending"] = measurement.Bool(modeChangePending)
+	data["ecc-correctable-errors"] = measurement.Int64(correctable)
+	data["ecc-uncorrectable-errors"] = measurement.Int64(uncorrectable)
+	data["ecc-sram-threshold-exceeded"] = measurement.Bool(thresholdExceeded)
+	data["remapped-rows-correctable"] = measurement.Int64(remappedCorrectable)
+	data["remapped-rows-uncorrectable"] = measurement.Int64(remappedUncorrectable)
+	data[KeyRemappedRowsPending] = measurement.Bool(remapPending)
+	data[KeyRemappedRowsFailure] = measurement.Bool(remapFailure)
+	data["retired-pages"] = measurement.Int64(retired)
+	data[KeyRetiredPagesPending] = measurement.Bool(retirementPending)
+	data[KeyHealthy] = measurement.Bool(!remapPending && !remapFailure && !retirementPending && !thresholdExceeded)
+	data["findings"] = measurement.Str(strings.Join(findings, ","))
+
+	if len(findings) > 0 {
+		slog.Warn("GPU memory health findings",
+			slog.String("findings", strings.Join(findings, ",")),
+			slog.String("hint", "pending row remaps and page retirements take effect after a GPU reset or reboot"))
+	}
+
+	return data
+}
+
+// aggregateMode summarizes a per-GPU mode: enabled when every capable GPU
+// has it enabled, partial when some do, disabled when none do, and "n/a"
+// when no GPU is capable.
+func aggregateMode(capable, enabled int) string {
+	switch {
+	case capable > 0 && enabled == capable:
+		return ECCModeEnabled
+	case enabled > 0:
+		return ECCModePartial
+	case capable > 0:
+		return ECCModeDisabled
+	default:
+		return "n/a"
+	}
+}
+
+// normalizeECCMode lowercases nvidia-smi ECC modes ("Enabled", "Disabled",
+// "N/A"), treating a missing value as "n/a".
+func normalizeECCMode(mode string) string {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		return "n/a"
+	}
+	return mode
+}
+
+// parseCount parses an nvidia-smi error or row count, treating "N/A" and
+// other non-numeric values as zero.
+func parseCount(s string) int64 {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// isYes reports whether an nvidia-smi flag value is "Yes".
+func isYes(s string) bool {
+	return strings.EqualFold(strings.TrimSpace(s), "yes")
+}
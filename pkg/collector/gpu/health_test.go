@@ -0,0 +1,159 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+func healthyGPU() GPU {
+	return GPU{
+		EccMode: EccMode{CurrentEcc: "Enabled", PendingEcc: "Enabled"},
+		EccErrors: EccErrors{Aggregate: Aggregate{
+			SramCorrectable:       "0",
+			DramCorrectable:       "0",
+			DramUncorrectable:     "0",
+			SramThresholdExceeded: "No",
+		}},
+		RetiredPages: RetiredPages{
+			MultipleSingleBitRetirement: MultipleSingleBitRetirement{RetiredCount: "N/A"},
+			DoubleBitRetirement:         DoubleBitRetirement{RetiredCount: "N/A"},
+			PendingRetirement:           "N/A",
+		},
+		RemappedRows: RemappedRows{
+			RemappedRowCorr:    "0",
+			RemappedRowUnc:     "0",
+			RemappedRowPending: "No",
+			RemappedRowFailure: "No",
+		},
+	}
+}
+
+func TestGetHealthReadings(t *testing.T) {
+	pending := healthyGPU()
+	pending.EccErrors.Aggregate.DramCorrectable = "12"
+	pending.EccErrors.Aggregate.DramUncorrectable = "2"
+	pending.RemappedRows.RemappedRowUnc = "2"
+	pending.RemappedRows.RemappedRowPending = "Yes"
+
+	retiring := healthyGPU()
+	retiring.EccMode = EccMode{CurrentEcc: "Disabled", PendingEcc: "Enabled"}
+	retiring.RetiredPages.DoubleBitRetirement.RetiredCount = "3"
+	retiring.RetiredPages.PendingRetirement = "Yes"
+
+	tests := []struct {
+		name          string
+		device        *NVSMIDevice
+		wantHealthy   bool
+		wantECCMode   string
+		wantFindings  string
+		wantPending   bool
+		wantUncorr    string
+		wantRemapUncr string
+		wantRetired   string
+		wantGPU1Corr  string
+	}{
+		{
+			name:          "healthy",
+			device:        &NVSMIDevice{GPUs: []GPU{healthyGPU(), healthyGPU()}},
+			wantHealthy:   true,
+			wantECCMode:   ECCModeEnabled,
+			wantUncorr:    "0",
+			wantRemapUncr: "0",
+			wantRetired:   "0",
+			wantGPU1Corr:  "0",
+		},
+		{
+			name:          "pending row remap",
+			device:        &NVSMIDevice{GPUs: []GPU{healthyGPU(), pending}},
+			wantECCMode:   ECCModeEnabled,
+			wantFindings:  "gpu1:remapped-rows-pending",
+			wantPending:   true,
+			wantUncorr:    "2",
+			wantRemapUncr: "2",
+			wantRetired:   "0",
+			wantGPU1Corr:  "12",
+		},
+		{
+			name:          "pending retirement and ECC change",
+			device:        &NVSMIDevice{GPUs: []GPU{healthyGPU(), retiring}},
+			wantECCMode:   ECCModePartial,
+			wantFindings:  "gpu1:ecc-mode-change-pending,gpu1:retired-pages-pending",
+			wantUncorr:    "0",
+			wantRemapUncr: "0",
+			wantRetired:   "3",
+			wantGPU1Corr:  "0",
+		},
+		{
+			name:          "no ECC support",
+			device:        &NVSMIDevice{GPUs: []GPU{{EccMode: EccMode{CurrentEcc: "N/A"}}}},
+			wantHealthy:   true,
+			wantECCMode:   "n/a",
+			wantUncorr:    "0",
+			wantRemapUncr: "0",
+			wantRetired:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := getHealthReadings(tt.device)
+			want := map[string]string{
+				KeyHealthy:                    strconv.FormatBool(tt.wantHealthy),
+				"ecc-mode":                    tt.wantECCMode,
+				"findings":                    tt.wantFindings,
+				KeyRemappedRowsPending:        strconv.FormatBool(tt.wantPending),
+				"ecc-uncorrectable-errors":    tt.wantUncorr,
+				"gpu1.ecc-correctable-errors": tt.wantGPU1Corr,
+				"remapped-rows-uncorrectable": tt.wantRemapUncr,
+				"retired-pages":               tt.wantRetired,
+			}
+			if len(tt.device.GPUs) < 2 {
+				delete(want, "gpu1.ecc-correctable-errors")
+			}
+			for key, w := range want {
+				r, ok := data[key]
+				if !ok {
+					t.Errorf("missing reading %q", key)
+					continue
+				}
+				if got := r.String(); got != w {
+					t.Errorf("%s = %q, want %q", key, got, w)
+				}
+			}
+		})
+	}
+}
+
+func TestGetHealthReadings_FromXML(t *testing.T) {
+	data, err := os.ReadFile("gpu.xml")
+	if err != nil {
+		t.Skipf("gpu.xml not available: %v", err)
+	}
+	device, err := parseSMIDevice(data)
+	if err != nil {
+		t.Fatalf("parseSMIDevice() error = %v", err)
+	}
+
+	readings := getHealthReadings(device)
+	if got := readings[KeyHealthy].String(); got != "true" {
+		t.Errorf("healthy = %s, want true (findings: %s)", got, readings["findings"])
+	}
+	if got := readings["ecc-mode"].String(); got != ECCModeEnabled {
+		t.Errorf("ecc-mode = %s, want %s", got, ECCModeEnabled)
+	}
+}
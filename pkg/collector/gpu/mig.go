@@ -0,0 +1,190 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// migSubtype is the measurement subtype holding the MIG inventory.
+const migSubtype = "mig"
+
+// MIG modes and strategies reported in the mig subtype.
+const (
+	// MIGModeEnabled means MIG is enabled on every MIG-capable GPU.
+	MIGModeEnabled = "enabled"
+	// MIGModeDisabled means MIG is disabled (or unsupported) on every GPU.
+	MIGModeDisabled = "disabled"
+	// MIGModePartial means MIG is enabled on some GPUs but not others.
+	MIGModePartial = "partial"
+
+	// MIGStrategyNone means no GPU is partitioned.
+	MIGStrategyNone = "none"
+	// MIGStrategySingle means every GPU uses MIG with one profile throughout,
+	// matching the GPU Operator's mig.strategy=single.
+	MIGStrategySingle = "single"
+	// MIGStrategyMixed means GPUs are partitioned with several profiles or
+	// only some GPUs are partitioned, requiring mig.strategy=mixed.
+	MIGStrategyMixed = "mixed"
+)
+
+// gpuInstanceLine matches a row of "nvidia-smi mig -lgi" output:
+//
+//	|   0  MIG 1g.10gb          19        9          2:1     |
+var gpuInstanceLine = regexp.MustCompile(`^\|\s*(\d+)\s+MIG\s+(\S+)\s+(\d+)\s+(\d+)\s+(\d+):(\d+)\s*\|`)
+
+// gpuInstance is a MIG GPU instance as listed by nvidia-smi.
+type gpuInstance struct {
+	GPU       int
+	Profile   string
+	ProfileID int
+	ID        int
+	Start     int
+	Size      int
+}
+
+// listGPUInstancesFunc lists the MIG GPU instances on the node.
+type listGPUInstancesFunc func(ctx context.Context) ([]gpuInstance, error)
+
+// listGPUInstances runs "nvidia-smi mig -lgi" and parses its output.
+func listGPUInstances(ctx context.Context) ([]gpuInstance, error) {
+	data, err := executeCommand(ctx, nvidiaSMICommand, "mig", "-lgi")
+	if err != nil {
+		return nil, err
+	}
+	return parseGPUInstances(string(data)), nil
+}
+
+// parseGPUInstances extracts GPU instances from "nvidia-smi mig -lgi" output.
+func parseGPUInstances(output string) []gpuInstance {
+	var instances []gpuInstance
+	for _, line := range strings.Split(output, "\n") {
+		m := gpuInstanceLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		inst := gpuInstance{Profile: m[2]}
+		inst.GPU, _ = strconv.Atoi(m[1])
+		inst.ProfileID, _ = strconv.Atoi(m[3])
+		inst.ID, _ = strconv.Atoi(m[4])
+		inst.Start, _ = strconv.Atoi(m[5])
+		inst.Size, _ = strconv.Atoi(m[6])
+		instances = append(instances, inst)
+	}
+	return instances
+}
+
+// getMIGReadings builds the mig subtype from the per-GPU MIG mode reported by
+// nvidia-smi and, when any GPU has MIG enabled, the GPU instances in use.
+//
+// Readings:
+//   - mode: enabled, disabled or partial across MIG-capable GPUs
+//   - strategy: none, single or mixed, comparable to the GPU Operator mig.strategy
+//   - capable-count, enabled-count, instance-count
+//   - profiles: comma-separated profiles in use (e.g. "1g.10gb,3g.40gb")
+//   - gpu<N>.mode, gpu<N>.pending-mode: current and pending MIG mode
+//   - gpu<N>.layout: instances as profile@start:size in placement order
+func getMIGReadings(ctx context.Context, device *NVSMIDevice, list listGPUInstancesFunc) map[string]measurement.Reading {
+	data := make(map[string]measurement.Reading)
+
+	capable, enabled := 0, 0
+	for i, g := range device.GPUs {
+		current := normalizeMIGMode(g.MigMode.CurrentMig)
+		data[fmt.Sprintf("gpu%d.mode", i)] = measurement.Str(current)
+		data[fmt.Sprintf("gpu%d.pending-mode", i)] = measurement.Str(normalizeMIGMode(g.MigMode.PendingMig))
+
+		if current == "n/a" {
+			continue
+		}
+		capable++
+		if current == MIGModeEnabled {
+			enabled++
+		}
+	}
+
+	mode := MIGModeDisabled
+	switch {
+	case enabled > 0 && enabled == capable:
+		mode = MIGModeEnabled
+	case enabled > 0:
+		mode = MIGModePartial
+	}
+	data["mode"] = measurement.Str(mode)
+	data["capable-count"] = measurement.Int(capable)
+	data["enabled-count"] = measurement.Int(enabled)
+
+	var instances []gpuInstance
+	if enabled > 0 {
+		var err error
+		instances, err = list(ctx)
+		if err != nil {
+			slog.Warn("failed to list MIG GPU instances", slog.String("error", err.Error()))
+		}
+	}
+	data["instance-count"] = measurement.Int(len(instances))
+
+	byGPU := make(map[int][]gpuInstance)
+	var profiles []string
+	for _, inst := range instances {
+		byGPU[inst.GPU] = append(byGPU[inst.GPU], inst)
+		if !slices.Contains(profiles, inst.Profile) {
+			profiles = append(profiles, inst.Profile)
+		}
+	}
+	slices.Sort(profiles)
+	data["profiles"] = measurement.Str(strings.Join(profiles, ","))
+
+	for gpu, insts := range byGPU {
+		slices.SortFunc(insts, func(a, b gpuInstance) int { return a.Start - b.Start })
+		layout := make([]string, 0, len(insts))
+		for _, inst := range insts {
+			layout = append(layout, fmt.Sprintf("%s@%d:%d", inst.Profile, inst.Start, inst.Size))
+		}
+		data[fmt.Sprintf("gpu%d.layout", gpu)] = measurement.Str(strings.Join(layout, ","))
+	}
+
+	data["strategy"] = measurement.Str(migStrategy(mode, profiles))
+	return data
+}
+
+// migStrategy derives the GPU Operator MIG strategy the current layout needs.
+func migStrategy(mode string, profiles []string) string {
+	switch {
+	case mode == MIGModeDisabled:
+		return MIGStrategyNone
+	case mode == MIGModeEnabled && len(profiles) <= 1:
+		return MIGStrategySingle
+	default:
+		return MIGStrategyMixed
+	}
+}
+
+// normalizeMIGMode lowercases nvidia-smi MIG modes ("Enabled", "Disabled",
+// "N/A"), treating a missing value as "n/a".
+func normalizeMIGMode(mode string) string {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		return "n/a"
+	}
+	return mode
+}
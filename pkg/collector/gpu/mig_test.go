@@ -0,0 +1,178 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const lgiOutput = `+-------------------------------------------------------+
+| GPU instances:                                        |
+| GPU   Name             Profile  Instance   Placement  |
+|                          ID       ID       Start:Size |
+|=======================================================|
+|   0  MIG 3g.40gb          9        2          4:4     |
++-------------------------------------------------------+
+|   0  MIG 1g.10gb         19        9          2:1     |
++-------------------------------------------------------+
+|   1  MIG 7g.80gb          0        0          0:8     |
++-------------------------------------------------------+
+`
+
+func TestParseGPUInstances(t *testing.T) {
+	instances := parseGPUInstances(lgiOutput)
+	if len(instances) != 3 {
+		t.Fatalf("expected 3 instances, got %d: %+v", len(instances), instances)
+	}
+	want := gpuInstance{GPU: 0, Profile: "3g.40gb", ProfileID: 9, ID: 2, Start: 4, Size: 4}
+	if instances[0] != want {
+		t.Errorf("instances[0] = %+v, want %+v", instances[0], want)
+	}
+	if instances[2].GPU != 1 || instances[2].Profile != "7g.80gb" {
+		t.Errorf("instances[2] = %+v, want GPU 1 7g.80gb", instances[2])
+	}
+
+	if got := parseGPUInstances("No MIG-enabled devices found."); len(got) != 0 {
+		t.Errorf("expected no instances, got %+v", got)
+	}
+}
+
+func migDevice(modes ...string) *NVSMIDevice {
+	d := &NVSMIDevice{}
+	for _, m := range modes {
+		d.GPUs = append(d.GPUs, GPU{MigMode: MigMode{CurrentMig: m, PendingMig: m}})
+	}
+	return d
+}
+
+func listFrom(instances []gpuInstance, err error) listGPUInstancesFunc {
+	return func(context.Context) ([]gpuInstance, error) {
+		return instances, err
+	}
+}
+
+func TestGetMIGReadings(t *testing.T) {
+	single := []gpuInstance{
+		{GPU: 0, Profile: "1g.10gb", Start: 1, Size: 1},
+		{GPU: 0, Profile: "1g.10gb", Start: 0, Size: 1},
+		{GPU: 1, Profile: "1g.10gb", Start: 0, Size: 1},
+	}
+
+	tests := []struct {
+		name         string
+		device       *NVSMIDevice
+		list         listGPUInstancesFunc
+		wantMode     string
+		wantStrategy string
+		want         map[string]string
+	}{
+		{
+			name:         "MIG disabled",
+			device:       migDevice("Disabled", "Disabled"),
+			list:         listFrom(nil, errors.New("must not be called")),
+			wantMode:     MIGModeDisabled,
+			wantStrategy: MIGStrategyNone,
+			want:         map[string]string{"gpu0.mode": "disabled", "profiles": ""},
+		},
+		{
+			name:         "MIG not supported",
+			device:       migDevice("N/A"),
+			list:         listFrom(nil, nil),
+			wantMode:     MIGModeDisabled,
+			wantStrategy: MIGStrategyNone,
+			want:         map[string]string{"gpu0.mode": "n/a"},
+		},
+		{
+			name:         "single profile",
+			device:       migDevice("Enabled", "Enabled"),
+			list:         listFrom(single, nil),
+			wantMode:     MIGModeEnabled,
+			wantStrategy: MIGStrategySingle,
+			want: map[string]string{
+				"profiles":    "1g.10gb",
+				"gpu0.layout": "1g.10gb@0:1,1g.10gb@1:1",
+				"gpu1.layout": "1g.10gb@0:1",
+			},
+		},
+		{
+			name:         "mixed profiles",
+			device:       migDevice("Enabled", "Enabled"),
+			list:         listFrom(parseGPUInstances(lgiOutput), nil),
+			wantMode:     MIGModeEnabled,
+			wantStrategy: MIGStrategyMixed,
+			want: map[string]string{
+				"profiles":    "1g.10gb,3g.40gb,7g.80gb",
+				"gpu0.layout": "1g.10gb@2:1,3g.40gb@4:4",
+			},
+		},
+		{
+			name:         "some GPUs partitioned",
+			device:       migDevice("Enabled", "Disabled"),
+			list:         listFrom(single[:1], nil),
+			wantMode:     MIGModePartial,
+			wantStrategy: MIGStrategyMixed,
+		},
+		{
+			name:         "instance listing fails",
+			device:       migDevice("Enabled"),
+			list:         listFrom(nil, errors.New("nvidia-smi failed")),
+			wantMode:     MIGModeEnabled,
+			wantStrategy: MIGStrategySingle,
+			want:         map[string]string{"profiles": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := getMIGReadings(context.Background(), tt.device, tt.list)
+
+			if got := data["mode"].String(); got != tt.wantMode {
+				t.Errorf("mode = %q, want %q", got, tt.wantMode)
+			}
+			if got := data["strategy"].String(); got != tt.wantStrategy {
+				t.Errorf("strategy = %q, want %q", got, tt.wantStrategy)
+			}
+			for key, want := range tt.want {
+				r, ok := data[key]
+				if !ok {
+					t.Errorf("missing reading %q", key)
+					continue
+				}
+				if got := r.String(); got != want {
+					t.Errorf("%s = %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMIGReadings_Counts(t *testing.T) {
+	data := getMIGReadings(context.Background(), migDevice("Enabled", "Disabled", "N/A"),
+		listFrom([]gpuInstance{{GPU: 0, Profile: "2g.20gb"}}, nil))
+
+	for key, want := range map[string]measurement.Reading{
+		"capable-count":  measurement.Int(2),
+		"enabled-count":  measurement.Int(1),
+		"instance-count": measurement.Int(1),
+	} {
+		if got := data[key]; got == nil || got.Any() != want.Any() {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+}
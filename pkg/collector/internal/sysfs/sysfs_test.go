@@ -0,0 +1,50 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestListDir(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"eth1", "eth0", "ib0"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := ListDir(dir), []string{"eth0", "eth1", "ib0"}; !slices.Equal(got, want) {
+		t.Errorf("ListDir() = %v, want %v", got, want)
+	}
+	if got := ListDir(filepath.Join(dir, "missing")); got != nil {
+		t.Errorf("ListDir(missing) = %v, want nil", got)
+	}
+}
+
+func TestReadTrimmed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mtu")
+	if err := os.WriteFile(path, []byte(" 9000\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := ReadTrimmed(path); !ok || got != "9000" {
+		t.Errorf("ReadTrimmed() = %q, %v, want \"9000\", true", got, ok)
+	}
+	if _, ok := ReadTrimmed(path + ".missing"); ok {
+		t.Error("ReadTrimmed(missing) ok = true, want false")
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// certManagerSubtype is the measurement subtype for existing cert-manager installations.
+	certManagerSubtype = "cert-manager"
+
+	// certManagerGroup is the API group served by cert-manager CRDs.
+	certManagerGroup = "cert-manager.io"
+
+	// certManagerControllerImage identifies the cert-manager controller container image.
+	certManagerControllerImage = "cert-manager-controller"
+)
+
+// collectCertManager detects an existing cert-manager installation. Installing
+// cert-manager a second time breaks clusters, so any trace counts: controller
+// deployments, served cert-manager.io API versions, or webhook configurations
+// that route to a cert-manager service (which block API calls if left stale).
+// Lookups the collector is not permitted to make are skipped, not fatal.
+func (k *Collector) collectCertManager(ctx context.Context) (map[string]measurement.Reading, error) {
+	data := make(map[string]measurement.Reading)
+
+	namespaces, versions := k.findCertManagerControllers(ctx)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(namespaces) > 0 {
+		data["namespace"] = measurement.Str(strings.Join(namespaces, ","))
+		data["controllers"] = measurement.Int(len(namespaces))
+	}
+	if len(versions) > 0 {
+		data[measurement.KeyVersion] = measurement.Str(strings.Join(versions, ","))
+	}
+
+	crdVersions := k.findCertManagerAPIVersions()
+	if len(crdVersions) > 0 {
+		data["crd.versions"] = measurement.Str(strings.Join(crdVersions, ","))
+	}
+
+	validating, mutating := k.findCertManagerWebhooks(ctx)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(validating) > 0 {
+		data["webhook.validating"] = measurement.Str(strings.Join(validating, ","))
+	}
+	if len(mutating) > 0 {
+		data["webhook.mutating"] = measurement.Str(strings.Join(mutating, ","))
+	}
+
+	installed := len(namespaces) > 0 || len(crdVersions) > 0 || len(validating) > 0 || len(mutating) > 0
+	data["installed"] = measurement.Bool(installed)
+
+	slog.Debug("collected cert-manager installation",
+		slog.Bool("installed", installed),
+		slog.Any("namespaces", namespaces),
+		slog.Any("crdVersions", crdVersions))
+
+	return data, nil
+}
+
+// findCertManagerControllers returns the namespaces running a cert-manager
+// controller and the controller image versions, both sorted and de-duplicated.
+func (k *Collector) findCertManagerControllers(ctx context.Context) ([]string, []string) {
+	deployments, err := k.ClientSet.AppsV1().Deployments("").List(ctx, v1.ListOptions{})
+	if err != nil {
+		slog.Warn("failed to list deployments, skipping cert-manager controller detection",
+			slog.String("error", err.Error()))
+		return nil, nil
+	}
+
+	var namespaces, versions []string
+	for _, d := range deployments.Items {
+		for _, c := range d.Spec.Template.Spec.Containers {
+			name, tag := splitImageNameTag(stripRegistryPrefix(c.Image))
+			if name != certManagerControllerImage {
+				continue
+			}
+			namespaces = appendUnique(namespaces, d.Namespace)
+			if tag != "" {
+				versions = appendUnique(versions, tag)
+			}
+		}
+	}
+
+	slices.Sort(namespaces)
+	slices.Sort(versions)
+	return namespaces, versions
+}
+
+// findCertManagerAPIVersions returns the served versions of the cert-manager.io
+// API group. Discovery failures are treated as "not served".
+func (k *Collector) findCertManagerAPIVersions() []string {
+	groups, err := k.ClientSet.Discovery().ServerGroups()
+	if err != nil {
+		slog.Debug("failed to discover API groups", slog.String("error", err.Error()))
+		return nil
+	}
+
+	var versions []string
+	for _, g := range groups.Groups {
+		if g.Name != certManagerGroup {
+			continue
+		}
+		for _, v := range g.Versions {
+			versions = append(versions, v.Version)
+		}
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+// findCertManagerWebhooks returns the names of validating and mutating webhook
+// configurations that belong to cert-manager.
+func (k *Collector) findCertManagerWebhooks(ctx context.Context) ([]string, []string) {
+	admission := k.ClientSet.AdmissionregistrationV1()
+
+	var validating []string
+	vwcs, err := admission.ValidatingWebhookConfigurations().List(ctx, v1.ListOptions{})
+	if err != nil {
+		slog.Warn("failed to list validating webhook configurations",
+			slog.String("error", err.Error()))
+	} else {
+		for _, c := range vwcs.Items {
+			if isCertManagerWebhook(c.Name, validatingServices(c.Webhooks)) {
+				validating = append(validating, c.Name)
+			}
+		}
+	}
+
+	var mutating []string
+	mwcs, err := admission.MutatingWebhookConfigurations().List(ctx, v1.ListOptions{})
+	if err != nil {
+		slog.Warn("failed to list mutating webhook configurations",
+			slog.String("error", err.Error()))
+	} else {
+		for _, c := range mwcs.Items {
+			if isCertManagerWebhook(c.Name, mutatingServices(c.Webhooks)) {
+				mutating = append(mutating, c.Name)
+			}
+		}
+	}
+
+	slices.Sort(validating)
+	slices.Sort(mutating)
+	return validating, mutating
+}
+
+// isCertManagerWebhook reports whether a webhook configuration belongs to
+// cert-manager, either by name or by the service its webhooks call.
+func isCertManagerWebhook(name string, services []string) bool {
+	if strings.Contains(name, "cert-manager") {
+		return true
+	}
+	return slices.ContainsFunc(services, func(s string) bool {
+		return strings.Contains(s, "cert-manager")
+	})
+}
+
+func validatingServices(webhooks []admissionv1.ValidatingWebhook) []string {
+	var services []string
+	for _, w := range webhooks {
+		if w.ClientConfig.Service != nil {
+			services = append(services, w.ClientConfig.Service.Name)
+		}
+	}
+	return services
+}
+
+func mutatingServices(webhooks []admissionv1.MutatingWebhook) []string {
+	var services []string
+	for _, w := range webhooks {
+		if w.ClientConfig.Service != nil {
+			services = append(services, w.ClientConfig.Service.Name)
+		}
+	}
+	return services
+}
+
+// appendUnique appends s to list when it is not already present.
+func appendUnique(list []string, s string) []string {
+	if slices.Contains(list, s) {
+		return list
+	}
+	return append(list, s)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+)
+
+func newDeployment(namespace, name, image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: name, Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func TestCollectCertManager(t *testing.T) {
+	tests := []struct {
+		name      string
+		objects   []runtime.Object
+		groups    []*metav1.APIResourceList
+		installed bool
+		want      map[string]string
+	}{
+		{
+			name: "not installed",
+			objects: []runtime.Object{
+				newDeployment("gpu-operator", "gpu-operator", "nvcr.io/nvidia/gpu-operator:v25.3.0"),
+			},
+			installed: false,
+		},
+		{
+			name: "full installation",
+			objects: []runtime.Object{
+				newDeployment("cert-manager", "cert-manager", "quay.io/jetstack/cert-manager-controller:v1.15.1"),
+				newDeployment("cert-manager", "cert-manager-webhook", "quay.io/jetstack/cert-manager-webhook:v1.15.1"),
+				&admissionv1.ValidatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "cert-manager-webhook"},
+				},
+				&admissionv1.MutatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "platform-certs"},
+					Webhooks: []admissionv1.MutatingWebhook{{
+						Name: "webhook.cert-manager.io",
+						ClientConfig: admissionv1.WebhookClientConfig{
+							Service: &admissionv1.ServiceReference{Namespace: "cert-manager", Name: "cert-manager-webhook"},
+						},
+					}},
+				},
+			},
+			groups: []*metav1.APIResourceList{
+				{GroupVersion: "cert-manager.io/v1", APIResources: []metav1.APIResource{{Name: "certificates", Kind: "Certificate"}}},
+			},
+			installed: true,
+			want: map[string]string{
+				"namespace":          "cert-manager",
+				"version":            "v1.15.1",
+				"crd.versions":       "v1",
+				"webhook.validating": "cert-manager-webhook",
+				"webhook.mutating":   "platform-certs",
+			},
+		},
+		{
+			name: "leftover CRDs only",
+			groups: []*metav1.APIResourceList{
+				{GroupVersion: "cert-manager.io/v1alpha2", APIResources: []metav1.APIResource{{Name: "certificates", Kind: "Certificate"}}},
+			},
+			installed: true,
+			want: map[string]string{
+				"crd.versions": "v1alpha2",
+			},
+		},
+		{
+			name: "multiple controllers",
+			objects: []runtime.Object{
+				newDeployment("cert-manager", "cert-manager", "quay.io/jetstack/cert-manager-controller:v1.15.1"),
+				newDeployment("platform", "certs", "registry.example.com/mirror/cert-manager-controller:v1.12.0@sha256:abc"),
+			},
+			installed: true,
+			want: map[string]string{
+				"namespace": "cert-manager,platform",
+				"version":   "v1.12.0,v1.15.1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fakeclient.NewClientset(tt.objects...)
+			clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = tt.groups
+
+			k := &Collector{ClientSet: clientset}
+			data, err := k.collectCertManager(context.Background())
+			require.NoError(t, err)
+
+			installed, ok := data["installed"]
+			require.True(t, ok, "installed reading should always be present")
+			assert.Equal(t, measurement.Bool(tt.installed).Any(), installed.Any())
+
+			for key, want := range tt.want {
+				got, ok := data[key]
+				if assert.True(t, ok, "missing reading %q", key) {
+					assert.Equal(t, want, got.Any(), "reading %q", key)
+				}
+			}
+		})
+	}
+}
@@ -20,15 +20,20 @@
 //
 // # Collected Data
 //
-// The collector returns a measurement with 4 subtypes:
+// The collector returns a measurement with 5 subtypes:
 //
 // 1. node - Node information:
 //   - provider: Cloud provider (EKS, GKE, AKS, etc.) detected from node labels
+//   - distribution: Kubernetes distribution (openshift, rke2) detected from
+//     node labels, annotations and providerID, when not the provider's own
 //   - kernelVersion: Linux kernel version
 //   - osImage: Operating system description
 //   - containerRuntime: Runtime and version (containerd, cri-o, docker)
 //   - architecture: CPU architecture (amd64, arm64)
 //   - hostname: Node name
+//   - cluster-node-count: Number of nodes in the cluster
+//   - gpu-node-count: Nodes advertising nvidia.com/gpu or labeled
+//     nvidia.com/gpu.present=true, used for the recipe cluster scale
 //
 // 2. server - Kubernetes server information:
 //   - version: Kubernetes version with vendor suffix (e.g., v1.33.5-eks-3025e55)
@@ -50,6 +55,15 @@
 //   - MIG manager settings (mode, strategy)
 //   - Node feature discovery configuration
 //
+// 5. cert-manager - Existing cert-manager installation:
+//   - installed: true if any trace of cert-manager is found
+//   - namespace: Namespaces running a cert-manager controller
+//   - version: Controller image versions
+//   - crd.versions: Served versions of the cert-manager.io API group
+//   - webhook.validating / webhook.mutating: cert-manager webhook configurations
+//
+// Recipe generation uses this to avoid installing cert-manager a second time.
+//
 // # Usage
 //
 // Create and use the collector:
@@ -134,6 +148,12 @@
 //	- apiGroups: ["nvidia.com"]
 //	  resources: ["clusterpolicies"]
 //	  verbs: ["get", "list"]
+//	- apiGroups: ["apps"]
+//	  resources: ["deployments"]
+//	  verbs: ["get", "list"]
+//	- apiGroups: ["admissionregistration.k8s.io"]
+//	  resources: ["validatingwebhookconfigurations", "mutatingwebhookconfigurations"]
+//	  verbs: ["get", "list"]
 //
 // # Use in Recipes
 //
@@ -68,6 +68,12 @@ func (k *Collector) Collect(ctx context.Context) (*measurement.Measurement, erro
 		return nil, fmt.Errorf("failed to collect node: %w", err)
 	}
 
+	// Existing cert-manager installation
+	certManager, err := k.collectCertManager(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect cert-manager installation: %w", err)
+	}
+
 	// Build measurement using builder pattern
 	res := measurement.NewMeasurement(measurement.TypeK8s).
 		WithSubtypeBuilder(
@@ -78,6 +84,7 @@ func (k *Collector) Collect(ctx context.Context) (*measurement.Measurement, erro
 		WithSubtype(measurement.Subtype{Name: "image", Data: images}).
 		WithSubtype(measurement.Subtype{Name: "policy", Data: policies}).
 		WithSubtype(measurement.Subtype{Name: "node", Data: node}).
+		WithSubtype(measurement.Subtype{Name: certManagerSubtype, Data: certManager}).
 		Build()
 
 	return res, nil
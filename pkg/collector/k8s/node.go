@@ -22,9 +22,30 @@ import (
 	"strings"
 
 	"github.com/NVIDIA/eidos/pkg/measurement"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// gpuResource is the extended resource the NVIDIA device plugin advertises.
+const gpuResource corev1.ResourceName = "nvidia.com/gpu"
+
+// Node labels and annotations identifying Kubernetes distributions.
+const (
+	// openShiftOSLabel is set on every OpenShift node (e.g., "rhcos").
+	openShiftOSLabel = "node.openshift.io/os_id"
+
+	// openShiftMachineConfigAnnotation is set by the OpenShift machine
+	// config daemon.
+	openShiftMachineConfigAnnotation = "machineconfiguration.openshift.io/currentConfig"
+
+	// rke2AnnotationPrefix prefixes the annotations RKE2 sets on its nodes
+	// (e.g., "rke2.io/node-args").
+	rke2AnnotationPrefix = "rke2.io/"
+
+	// instanceTypeLabel is set to "rke2" by RKE2 without a cloud provider.
+	instanceTypeLabel = "node.kubernetes.io/instance-type"
+)
+
 func (k *Collector) collectNode(ctx context.Context) (map[string]measurement.Reading, error) {
 	// Check if context is canceled
 	if err := ctx.Err(); err != nil {
@@ -56,6 +77,11 @@ func (k *Collector) collectNode(ctx context.Context) (map[string]measurement.Rea
 		providerData["provider-id"] = measurement.Str(providerID)
 	}
 
+	// Distribution running on the provider's machines
+	if distribution := parseDistribution(node); distribution != "" {
+		providerData["distribution"] = measurement.Str(distribution)
+	}
+
 	// Node CRI-O
 	status := node.Status
 	if status.NodeInfo.ContainerRuntimeVersion != "" {
@@ -79,9 +105,34 @@ func (k *Collector) collectNode(ctx context.Context) (map[string]measurement.Rea
 		providerData["os-image"] = measurement.Str(status.NodeInfo.OSImage)
 	}
 
+	// Cluster size selects recipes for the cluster scale. Listing nodes may
+	// be forbidden for local snapshots, which then omit the counts.
+	nodes, err := k.ClientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Warn("failed to list nodes, cluster node counts not collected", slog.String("error", err.Error()))
+	} else {
+		gpuNodes := 0
+		for i := range nodes.Items {
+			if isGPUNode(&nodes.Items[i]) {
+				gpuNodes++
+			}
+		}
+		providerData[measurement.KeyClusterNodeCount] = measurement.Int(len(nodes.Items))
+		providerData[measurement.KeyGPUNodeCount] = measurement.Int(gpuNodes)
+	}
+
 	return providerData, nil
 }
 
+// isGPUNode reports whether node advertises NVIDIA GPUs, or is labeled as
+// having them by GPU feature discovery before the device plugin runs.
+func isGPUNode(node *corev1.Node) bool {
+	if q, ok := node.Status.Capacity[gpuResource]; ok && !q.IsZero() {
+		return true
+	}
+	return node.Labels["nvidia.com/gpu.present"] == "true"
+}
+
 // parseProvider extracts the cloud provider name from a providerID string.
 // Typical formats:
 //   - aws:///us-west-2a/i-0123456789abcdef0 → "eks"
@@ -120,6 +171,28 @@ func parseProvider(providerID string) string {
 	}
 }
 
+// parseDistribution returns the Kubernetes distribution of a node detected
+// from its labels, annotations and providerID: "openshift" or "rke2". It
+// returns "" for other nodes, whose service follows from the provider.
+func parseDistribution(node *corev1.Node) string {
+	if _, ok := node.Labels[openShiftOSLabel]; ok {
+		return "openshift"
+	}
+	if _, ok := node.Annotations[openShiftMachineConfigAnnotation]; ok {
+		return "openshift"
+	}
+
+	if strings.HasPrefix(node.Spec.ProviderID, "rke2://") || node.Labels[instanceTypeLabel] == "rke2" {
+		return "rke2"
+	}
+	for key := range node.Annotations {
+		if strings.HasPrefix(key, rke2AnnotationPrefix) {
+			return "rke2"
+		}
+	}
+	return ""
+}
+
 // getNodeName retrieves the current node name from environment variables.
 // It checks NODE_NAME first (typically set via Downward API), then falls back
 // to KUBERNETES_NODE_NAME, and finally HOSTNAME as a last resort.
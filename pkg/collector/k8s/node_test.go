@@ -18,8 +18,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/NVIDIA/eidos/pkg/measurement"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -185,6 +187,59 @@ func TestNodeCollector_CollectNodeCanceledContext(t *testing.T) {
 	assert.Equal(t, context.Canceled, err)
 }
 
+func TestParseDistribution(t *testing.T) {
+	tests := []struct {
+		name string
+		node *corev1.Node
+		want string
+	}{
+		{
+			name: "OpenShift OS label",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"node.openshift.io/os_id": "rhcos"},
+			}, Spec: corev1.NodeSpec{ProviderID: "aws:///us-east-1a/i-0123456789abcdef0"}},
+			want: "openshift",
+		},
+		{
+			name: "OpenShift machine config annotation",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"machineconfiguration.openshift.io/currentConfig": "rendered-worker-abc"},
+			}},
+			want: "openshift",
+		},
+		{
+			name: "RKE2 providerID",
+			node: &corev1.Node{Spec: corev1.NodeSpec{ProviderID: "rke2://gpu-node-1"}},
+			want: "rke2",
+		},
+		{
+			name: "RKE2 instance type",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"node.kubernetes.io/instance-type": "rke2"},
+			}},
+			want: "rke2",
+		},
+		{
+			name: "RKE2 annotation",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"rke2.io/node-args": "[]"},
+			}, Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-0123456789abcdef0"}},
+			want: "rke2",
+		},
+		{
+			name: "managed service",
+			node: &corev1.Node{Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-0123456789abcdef0"}},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseDistribution(tt.node))
+		})
+	}
+}
+
 func TestParseProvider(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -306,3 +361,35 @@ func TestGetNodeName(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeCollector_CollectNodeCounts(t *testing.T) {
+	t.Setenv("NODE_NAME", testNodeName)
+
+	collector := createTestCollector()
+	nodes := []*corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-1"},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-2", Labels: map[string]string{"nvidia.com/gpu.present": "true"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cpu-1"},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("0")},
+			},
+		},
+	}
+	for _, node := range nodes {
+		_, err := collector.ClientSet.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+
+	nodeData, err := collector.collectNode(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 4, nodeData[measurement.KeyClusterNodeCount].Any())
+	assert.Equal(t, 2, nodeData[measurement.KeyGPUNodeCount].Any())
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package network collects high-performance networking state from the node.
+//
+// This collector gathers the signals the NVIDIA Network Operator depends on:
+// which NVIDIA (Mellanox) NICs are installed, whether a MOFED/DOCA-OFED
+// driver stack is present, which RDMA devices the kernel exposes, and how
+// network interfaces are configured for SR-IOV and MTU.
+//
+// # Collected Data
+//
+// The collector returns a Network measurement with 4 subtypes:
+//
+// 1. nic - NVIDIA/Mellanox PCI devices (lspci -d 15b3: -mm):
+//   - count: Number of NVIDIA/Mellanox PCI functions
+//   - models: Distinct device names (e.g., "MT2910 Family [ConnectX-7]")
+//   - <pci-address>.model: Device name per PCI function
+//
+// 2. ofed - Driver stack:
+//   - installed: true when ofed_info reports a MOFED or DOCA-OFED version
+//   - version: ofed_info -s output (e.g., "MLNX_OFED_LINUX-24.10-1.1.4.0")
+//   - mlx5-core.version: mlx5_core module version, when loaded
+//
+// 3. rdma - RDMA devices from /sys/class/infiniband (the devices ibv_devinfo lists):
+//   - present: true when any RDMA device exists
+//   - device-count: Number of RDMA devices
+//   - devices: Comma-separated device names (e.g., "mlx5_0,mlx5_1")
+//   - <device>.link-layer: Port 1 link layer (InfiniBand or Ethernet)
+//
+// 4. netdev - Physical network interfaces from /sys/class/net:
+//   - sriov-capable: true when any interface supports SR-IOV VFs
+//   - sriov-vfs: Total configured VFs across interfaces
+//   - ib-interfaces: Comma-separated InfiniBand interfaces of NVIDIA NICs (e.g., "ibp24s0,ibp64s0")
+//   - eth-interfaces: Comma-separated Ethernet interfaces of NVIDIA NICs
+//   - sriov-interfaces: Comma-separated SR-IOV capable interfaces of NVIDIA NICs
+//   - <interface>.mtu, <interface>.driver
+//   - <interface>.link-type: ethernet or infiniband
+//   - <interface>.sriov-totalvfs, <interface>.sriov-numvfs (SR-IOV capable only)
+//
+// Missing tools (lspci, ofed_info) and sysfs directories are not errors; the
+// corresponding readings are simply absent or report nothing found.
+//
+// # Usage
+//
+//	collector := &network.Collector{}
+//	m, err := collector.Collect(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//
+// Recipes can use the readings as constraints, for example
+// Network.rdma.present == true for RDMA-dependent overlays. The interface
+// lists parameterize the Network Operator secondary networks.
+package network
@@ -0,0 +1,312 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/collector/internal/sysfs"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const (
+	// mellanoxVendorID is the PCI vendor ID of NVIDIA networking (Mellanox) devices.
+	mellanoxVendorID = "15b3"
+
+	// ARP hardware types from /sys/class/net/<interface>/type.
+	arphrdEther      = 1
+	arphrdInfiniband = 32
+
+	lspciCommand    = "lspci"
+	ofedInfoCommand = "ofed_info"
+)
+
+var (
+	sysClassNet        = "/sys/class/net"
+	sysClassInfiniband = "/sys/class/infiniband"
+	sysModuleMlx5Core  = "/sys/module/mlx5_core"
+
+	// runCommand executes a command and returns its stdout; replaced in tests.
+	runCommand = executeCommand
+)
+
+// errCommandNotFound is returned by runCommand when the binary is not installed.
+var errCommandNotFound = errors.New("command not found")
+
+// Collector collects NIC, driver stack, RDMA and SR-IOV state from the node.
+type Collector struct {
+}
+
+// Collect gathers network configuration and returns it as a single measurement
+// with four subtypes: nic, ofed, rdma and netdev.
+func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, error) {
+	slog.Info("collecting network configuration")
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	nic, err := c.collectNICs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ofed, err := c.collectOFED(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rdma, err := c.collectRDMA(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	netdev, err := c.collectNetdev(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &measurement.Measurement{
+		Type: measurement.TypeNetwork,
+		Subtypes: []measurement.Subtype{
+			*nic,
+			*ofed,
+			*rdma,
+			*netdev,
+		},
+	}
+
+	return res, nil
+}
+
+// collectNICs lists NVIDIA/Mellanox PCI functions using lspci machine-readable output.
+func (c *Collector) collectNICs(ctx context.Context) (*measurement.Subtype, error) {
+	readings := make(map[string]measurement.Reading)
+
+	out, err := runCommand(ctx, lspciCommand, "-D", "-mm", "-d", mellanoxVendorID+":")
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		slog.Warn("failed to list PCI devices, skipping NIC detection", slog.String("error", err.Error()))
+		out = nil
+	}
+
+	var models []string
+	count := 0
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := parseQuotedFields(line)
+		// slot "class" "vendor" "device" ...
+		if len(fields) < 4 {
+			continue
+		}
+		count++
+		readings[fields[0]+".model"] = measurement.Str(fields[3])
+		if !slices.Contains(models, fields[3]) {
+			models = append(models, fields[3])
+		}
+	}
+	slices.Sort(models)
+
+	readings["count"] = measurement.Int(count)
+	readings["models"] = measurement.Str(strings.Join(models, ","))
+
+	return &measurement.Subtype{Name: "nic", Data: readings}, nil
+}
+
+// collectOFED reports the installed MOFED/DOCA-OFED version and mlx5_core version.
+func (c *Collector) collectOFED(ctx context.Context) (*measurement.Subtype, error) {
+	readings := make(map[string]measurement.Reading)
+
+	version := ""
+	out, err := runCommand(ctx, ofedInfoCommand, "-s")
+	switch {
+	case err == nil:
+		version = strings.TrimSuffix(strings.TrimSpace(string(out)), ":")
+	case ctx.Err() != nil:
+		return nil, ctx.Err()
+	case !errors.Is(err, errCommandNotFound):
+		slog.Warn("failed to query OFED version", slog.String("error", err.Error()))
+	}
+	readings["installed"] = measurement.Bool(version != "")
+	readings["version"] = measurement.Str(version)
+
+	if v, ok := sysfs.ReadTrimmed(filepath.Join(sysModuleMlx5Core, "version")); ok {
+		readings["mlx5-core.version"] = measurement.Str(v)
+	}
+
+	return &measurement.Subtype{Name: "ofed", Data: readings}, nil
+}
+
+// collectRDMA lists RDMA devices registered with the kernel. These are the
+// devices ibv_devinfo reports, read from sysfs so rdma-core is not required.
+func (c *Collector) collectRDMA(ctx context.Context) (*measurement.Subtype, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	readings := make(map[string]measurement.Reading)
+	devices := sysfs.ListDir(sysClassInfiniband)
+	for _, dev := range devices {
+		if ll, ok := sysfs.ReadTrimmed(filepath.Join(sysClassInfiniband, dev, "ports", "1", "link_layer")); ok {
+			readings[dev+".link-layer"] = measurement.Str(ll)
+		}
+	}
+
+	readings["present"] = measurement.Bool(len(devices) > 0)
+	readings["device-count"] = measurement.Int(len(devices))
+	readings["devices"] = measurement.Str(strings.Join(devices, ","))
+
+	return &measurement.Subtype{Name: "rdma", Data: readings}, nil
+}
+
+// collectNetdev reports MTU, driver, link type and SR-IOV VF counts for
+// physical interfaces, and lists the interfaces of NVIDIA NICs by link type.
+// Virtual interfaces (no backing device) are skipped.
+func (c *Collector) collectNetdev(ctx context.Context) (*measurement.Subtype, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	readings := make(map[string]measurement.Reading)
+	sriovCapable := false
+	totalVFs := 0
+	var ibIfaces, ethIfaces, sriovIfaces []string
+
+	for _, iface := range sysfs.ListDir(sysClassNet) {
+		devicePath := filepath.Join(sysClassNet, iface, "device")
+		if _, err := os.Stat(devicePath); err != nil {
+			continue
+		}
+
+		if mtu, ok := sysfs.ReadTrimmed(filepath.Join(sysClassNet, iface, "mtu")); ok {
+			if v, err := strconv.Atoi(mtu); err == nil {
+				readings[iface+".mtu"] = measurement.Int(v)
+			}
+		}
+		if driver, err := os.Readlink(filepath.Join(devicePath, "driver")); err == nil {
+			readings[iface+".driver"] = measurement.Str(filepath.Base(driver))
+		}
+
+		linkType := ""
+		if t, ok := readInt(filepath.Join(sysClassNet, iface, "type")); ok {
+			switch t {
+			case arphrdEther:
+				linkType = "ethernet"
+			case arphrdInfiniband:
+				linkType = "infiniband"
+			}
+		}
+		if linkType != "" {
+			readings[iface+".link-type"] = measurement.Str(linkType)
+		}
+
+		vendor, _ := sysfs.ReadTrimmed(filepath.Join(devicePath, "vendor"))
+		nvidia := vendor == "0x"+mellanoxVendorID
+		switch {
+		case nvidia && linkType == "infiniband":
+			ibIfaces = append(ibIfaces, iface)
+		case nvidia && linkType == "ethernet":
+			ethIfaces = append(ethIfaces, iface)
+		}
+
+		total, ok := readInt(filepath.Join(devicePath, "sriov_totalvfs"))
+		if !ok || total == 0 {
+			continue
+		}
+		sriovCapable = true
+		if nvidia {
+			sriovIfaces = append(sriovIfaces, iface)
+		}
+		readings[iface+".sriov-totalvfs"] = measurement.Int(total)
+		if num, ok := readInt(filepath.Join(devicePath, "sriov_numvfs")); ok {
+			readings[iface+".sriov-numvfs"] = measurement.Int(num)
+			totalVFs += num
+		}
+	}
+
+	readings["sriov-capable"] = measurement.Bool(sriovCapable)
+	readings["sriov-vfs"] = measurement.Int(totalVFs)
+	readings["ib-interfaces"] = measurement.Str(strings.Join(ibIfaces, ","))
+	readings["eth-interfaces"] = measurement.Str(strings.Join(ethIfaces, ","))
+	readings["sriov-interfaces"] = measurement.Str(strings.Join(sriovIfaces, ","))
+
+	return &measurement.Subtype{Name: "netdev", Data: readings}, nil
+}
+
+// parseQuotedFields splits an lspci -mm line into its fields, honoring quotes.
+// Option fields such as -r01 are dropped.
+func parseQuotedFields(line string) []string {
+	var fields []string
+	rest := strings.TrimSpace(line)
+	for rest != "" {
+		if rest[0] == '"' {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				break
+			}
+			fields = append(fields, rest[1:end+1])
+			rest = strings.TrimSpace(rest[end+2:])
+			continue
+		}
+		end := strings.IndexByte(rest, ' ')
+		if end < 0 {
+			end = len(rest)
+		}
+		if field := rest[:end]; !strings.HasPrefix(field, "-") {
+			fields = append(fields, field)
+		}
+		rest = strings.TrimSpace(rest[end:])
+	}
+	return fields
+}
+
+// readInt reads a sysfs file holding a single integer.
+func readInt(path string) (int, bool) {
+	s, ok := sysfs.ReadTrimmed(path)
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+func executeCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, errCommandNotFound)
+	}
+	cmd := exec.CommandContext(ctx, name, args...)
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("failed to execute command %s: %w (stderr: %s)", name, err, string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to execute command %s: %w", name, err)
+	}
+	return output, nil
+}
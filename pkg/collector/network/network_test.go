@@ -0,0 +1,218 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const lspciOutput = `0000:17:00.0 "Infiniband controller" "Mellanox Technologies" "MT2910 Family [ConnectX-7]" "Mellanox Technologies" "Device 0023"
+0000:17:00.1 "Ethernet controller" "Mellanox Technologies" "MT2910 Family [ConnectX-7]" -r01 "Mellanox Technologies" "Device 0023"
+0000:a3:00.0 "Ethernet controller" "Mellanox Technologies" "MT43244 BlueField-3 integrated ConnectX-7 network controller" "Mellanox Technologies" "Device 0051"
+`
+
+// setupFakeNode points the sysfs paths at a temp dir and stubs commands.
+func setupFakeNode(t *testing.T, commands map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+
+	origNet, origIB, origMlx, origRun := sysClassNet, sysClassInfiniband, sysModuleMlx5Core, runCommand
+	t.Cleanup(func() {
+		sysClassNet, sysClassInfiniband, sysModuleMlx5Core, runCommand = origNet, origIB, origMlx, origRun
+	})
+	sysClassNet = filepath.Join(root, "net")
+	sysClassInfiniband = filepath.Join(root, "infiniband")
+	sysModuleMlx5Core = filepath.Join(root, "mlx5_core")
+
+	runCommand = func(_ context.Context, name string, _ ...string) ([]byte, error) {
+		out, ok := commands[name]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", name, errCommandNotFound)
+		}
+		return []byte(out), nil
+	}
+	return root
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func subtype(t *testing.T, m *measurement.Measurement, name string) map[string]measurement.Reading {
+	t.Helper()
+	for _, st := range m.Subtypes {
+		if st.Name == name {
+			return st.Data
+		}
+	}
+	t.Fatalf("subtype %q not found", name)
+	return nil
+}
+
+func assertReading(t *testing.T, data map[string]measurement.Reading, key string, want any) {
+	t.Helper()
+	got, ok := data[key]
+	if !ok {
+		t.Errorf("reading %q missing", key)
+		return
+	}
+	if got.Any() != want {
+		t.Errorf("reading %q = %v, want %v", key, got.Any(), want)
+	}
+}
+
+func TestCollector_Collect(t *testing.T) {
+	root := setupFakeNode(t, map[string]string{
+		lspciCommand:    lspciOutput,
+		ofedInfoCommand: "MLNX_OFED_LINUX-24.10-1.1.4.0:\n",
+	})
+
+	writeFile(t, filepath.Join(root, "mlx5_core", "version"), "24.10-1.1.4\n")
+	writeFile(t, filepath.Join(root, "infiniband", "mlx5_1", "ports", "1", "link_layer"), "Ethernet\n")
+	writeFile(t, filepath.Join(root, "infiniband", "mlx5_0", "ports", "1", "link_layer"), "InfiniBand\n")
+
+	// Physical SR-IOV capable interface
+	writeFile(t, filepath.Join(root, "net", "ens1f0", "mtu"), "9000\n")
+	writeFile(t, filepath.Join(root, "net", "ens1f0", "type"), "1\n")
+	writeFile(t, filepath.Join(root, "net", "ens1f0", "device", "vendor"), "0x15b3\n")
+	writeFile(t, filepath.Join(root, "net", "ens1f0", "device", "sriov_totalvfs"), "16\n")
+	writeFile(t, filepath.Join(root, "net", "ens1f0", "device", "sriov_numvfs"), "8\n")
+	// Physical interface without SR-IOV
+	writeFile(t, filepath.Join(root, "net", "eno1", "mtu"), "1500\n")
+	writeFile(t, filepath.Join(root, "net", "eno1", "type"), "1\n")
+	writeFile(t, filepath.Join(root, "net", "eno1", "device", "vendor"), "0x8086\n")
+	// InfiniBand interface of an NVIDIA NIC
+	writeFile(t, filepath.Join(root, "net", "ibp24s0", "type"), "32\n")
+	writeFile(t, filepath.Join(root, "net", "ibp24s0", "device", "vendor"), "0x15b3\n")
+	// Virtual interface, skipped
+	writeFile(t, filepath.Join(root, "net", "lo", "mtu"), "65536\n")
+
+	m, err := (&Collector{}).Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if m.Type != measurement.TypeNetwork {
+		t.Errorf("Type = %s, want %s", m.Type, measurement.TypeNetwork)
+	}
+	if len(m.Subtypes) != 4 {
+		t.Fatalf("expected 4 subtypes, got %d", len(m.Subtypes))
+	}
+
+	nic := subtype(t, m, "nic")
+	assertReading(t, nic, "count", 3)
+	assertReading(t, nic, "models", "MT2910 Family [ConnectX-7],MT43244 BlueField-3 integrated ConnectX-7 network controller")
+	assertReading(t, nic, "0000:17:00.1.model", "MT2910 Family [ConnectX-7]")
+
+	ofed := subtype(t, m, "ofed")
+	assertReading(t, ofed, "installed", true)
+	assertReading(t, ofed, "version", "MLNX_OFED_LINUX-24.10-1.1.4.0")
+	assertReading(t, ofed, "mlx5-core.version", "24.10-1.1.4")
+
+	rdma := subtype(t, m, "rdma")
+	assertReading(t, rdma, "present", true)
+	assertReading(t, rdma, "device-count", 2)
+	assertReading(t, rdma, "devices", "mlx5_0,mlx5_1")
+	assertReading(t, rdma, "mlx5_0.link-layer", "InfiniBand")
+	assertReading(t, rdma, "mlx5_1.link-layer", "Ethernet")
+
+	netdev := subtype(t, m, "netdev")
+	assertReading(t, netdev, "sriov-capable", true)
+	assertReading(t, netdev, "sriov-vfs", 8)
+	assertReading(t, netdev, "ens1f0.mtu", 9000)
+	assertReading(t, netdev, "ens1f0.sriov-totalvfs", 16)
+	assertReading(t, netdev, "ens1f0.sriov-numvfs", 8)
+	assertReading(t, netdev, "eno1.mtu", 1500)
+	assertReading(t, netdev, "ens1f0.link-type", "ethernet")
+	assertReading(t, netdev, "ibp24s0.link-type", "infiniband")
+	assertReading(t, netdev, "ib-interfaces", "ibp24s0")
+	assertReading(t, netdev, "eth-interfaces", "ens1f0")
+	assertReading(t, netdev, "sriov-interfaces", "ens1f0")
+	if _, ok := netdev["eno1.sriov-totalvfs"]; ok {
+		t.Error("eno1 should not report SR-IOV readings")
+	}
+	if _, ok := netdev["lo.mtu"]; ok {
+		t.Error("virtual interface lo should be skipped")
+	}
+}
+
+func TestCollector_Collect_NoNetworkHardware(t *testing.T) {
+	setupFakeNode(t, nil)
+
+	m, err := (&Collector{}).Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+
+	assertReading(t, subtype(t, m, "nic"), "count", 0)
+	assertReading(t, subtype(t, m, "ofed"), "installed", false)
+	assertReading(t, subtype(t, m, "rdma"), "present", false)
+	assertReading(t, subtype(t, m, "netdev"), "sriov-capable", false)
+}
+
+func TestCollector_Collect_CommandFailure(t *testing.T) {
+	setupFakeNode(t, nil)
+	runCommand = func(_ context.Context, name string, _ ...string) ([]byte, error) {
+		return nil, errors.New("exit status 1")
+	}
+
+	m, err := (&Collector{}).Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	assertReading(t, subtype(t, m, "nic"), "count", 0)
+	assertReading(t, subtype(t, m, "ofed"), "installed", false)
+}
+
+func TestCollector_Collect_ContextCancellation(t *testing.T) {
+	setupFakeNode(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := (&Collector{}).Collect(ctx); err == nil {
+		t.Error("expected error with canceled context")
+	}
+}
+
+func TestParseQuotedFields(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{`00:01.0 "Eth" "Mellanox" "ConnectX-6 Dx"`, []string{"00:01.0", "Eth", "Mellanox", "ConnectX-6 Dx"}},
+		{`00:01.0 "Eth" -r01 "Mellanox" "CX"`, []string{"00:01.0", "Eth", "Mellanox", "CX"}},
+		{"", nil},
+		{`00:01.0 "unterminated`, []string{"00:01.0"}},
+	}
+	for _, tt := range tests {
+		got := parseQuotedFields(tt.line)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("parseQuotedFields(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
@@ -20,7 +20,7 @@
 //
 // # Collected Data
 //
-// The collector returns a measurement with 4 subtypes:
+// The collector returns a measurement with 5 subtypes:
 //
 // 1. grub - Boot loader configuration:
 //   - intel_iommu, amd_iommu: IOMMU settings for device passthrough
@@ -47,6 +47,13 @@
 //   - PRETTY_NAME: Human-readable name
 //   - VERSION_CODENAME: Release codename
 //
+// 5. secureboot - Boot security:
+//   - efi: Whether the node booted with UEFI
+//   - enabled: Whether Secure Boot is enabled, so kernel modules must be signed
+//
+// The Secure Boot state is read from efivarfs, which containers only see when
+// /sys/firmware/efi/efivars is mounted into them; without it, enabled is false.
+//
 // # Usage
 //
 // Create and use the collector:
@@ -72,6 +79,7 @@
 //   - /proc/sys: Runtime kernel parameters (recursively)
 //   - /proc/modules: Loaded kernel modules
 //   - /etc/os-release: Operating system identification
+//   - /sys/firmware/efi/efivars: Secure Boot state
 //
 // # Context Support
 //
@@ -101,6 +109,7 @@
 // # Use in Recipes
 //
 // Recipe generation uses OS collector data for:
+//   - GPU driver flavor selection from the kernel, OS and Secure Boot state
 //   - Kernel parameter validation and tuning
 //   - Module dependency verification
 //   - OS version-specific optimizations
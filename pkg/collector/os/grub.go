@@ -17,6 +17,7 @@ package os
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/NVIDIA/eidos/pkg/collector/file"
 	"github.com/NVIDIA/eidos/pkg/measurement"
@@ -43,17 +44,16 @@ func (c *Collector) collectGRUB(ctx context.Context) (*measurement.Subtype, erro
 
 	parser := file.NewParser(
 		file.WithDelimiter(fileLineDelGrub),
-		file.WithKVDelimiter(fileKVDelGrub),
 	)
 
-	params, err := parser.GetMap(filePathGrub)
+	params, err := parser.GetLines(filePathGrub)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read GRUB params from %s: %w", filePathGrub, err)
 	}
 
 	props := make(map[string]measurement.Reading, 0)
 
-	for k, v := range params {
+	for k, v := range parseCmdline(params) {
 		props[k] = measurement.Str(v)
 	}
 
@@ -64,3 +64,29 @@ func (c *Collector) collectGRUB(ctx context.Context) (*measurement.Subtype, erro
 
 	return res, nil
 }
+
+// parseCmdline maps kernel command line parameters to their values. Flags
+// without a value map to an empty string. Parameters the kernel accepts more
+// than once keep every value, comma-separated in command line order: huge
+// page pools ("hugepagesz=1G hugepages=2 hugepagesz=2M hugepages=512") and
+// the serial and framebuffer consoles of arm64 servers ("console=ttyAMA0
+// console=tty0") would otherwise report only their last value.
+func parseCmdline(params []string) map[string]string {
+	result := make(map[string]string, len(params))
+	for _, param := range params {
+		param = strings.TrimSpace(param)
+		if param == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(param, fileKVDelGrub)
+		if prev, ok := result[key]; ok && value != "" {
+			if prev != "" {
+				value = prev + "," + value
+			}
+		} else if ok {
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
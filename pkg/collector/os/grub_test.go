@@ -74,8 +74,8 @@ func TestGrubCollector_Integration(t *testing.T) {
 		t.Errorf("Expected type %s, got %s", measurement.TypeOS, m.Type)
 	}
 
-	if len(m.Subtypes) != 4 {
-		t.Errorf("Expected exactly 4 subtypes (grub, sysctl, kmod, release), got %d", len(m.Subtypes))
+	if len(m.Subtypes) != 5 {
+		t.Errorf("Expected exactly 5 subtypes (grub, sysctl, kmod, release, secureboot), got %d", len(m.Subtypes))
 		return
 	}
 
@@ -248,6 +248,18 @@ func TestGrubCollector_ParsesKeyOnlyParameters(t *testing.T) {
 			},
 			filteredKeys: []string{},
 		},
+		{
+			name:           "repeated parameters keep every value",
+			cmdlineContent: "console=ttyAMA0 console=tty0 hugepagesz=1G hugepages=2 hugepagesz=2M hugepages=5128 iommu.passthrough=1 quiet quiet\n",
+			expectedKeys: map[string]string{
+				"console":           "ttyAMA0,tty0",
+				"hugepagesz":        "1G,2M",
+				"hugepages":         "2,5128",
+				"iommu.passthrough": "1",
+				"quiet":             "",
+			},
+			filteredKeys: []string{},
+		},
 	}
 
 	for _, tt := range tests {
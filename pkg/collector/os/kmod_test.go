@@ -92,8 +92,8 @@ func TestKModCollector_Integration(t *testing.T) {
 		t.Errorf("Expected type %s, got %s", measurement.TypeOS, m.Type)
 	}
 
-	if len(m.Subtypes) != 4 {
-		t.Errorf("Expected 4 subtypes (grub, sysctl, kmod, release), got %d", len(m.Subtypes))
+	if len(m.Subtypes) != 5 {
+		t.Errorf("Expected 5 subtypes (grub, sysctl, kmod, release, secureboot), got %d", len(m.Subtypes))
 		return
 	}
 
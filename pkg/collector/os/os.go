@@ -25,11 +25,13 @@ import (
 // - GRUB bootloader parameters from /proc/cmdline
 // - Loaded kernel modules from /proc/modules
 // - Sysctl parameters from /proc/sys
+// - OS release from /etc/os-release
+// - Secure Boot state from the SecureBoot EFI variable
 type Collector struct {
 }
 
 // Collect gathers all OS-level configurations and returns them as a single measurement
-// with three subtypes: grub, kmod, and sysctl.
+// with five subtypes: grub, sysctl, kmod, release and secureboot.
 func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, error) {
 	slog.Info("collecting OS configuration")
 
@@ -58,6 +60,11 @@ func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, erro
 		return nil, err
 	}
 
+	secureBoot, err := c.collectSecureBoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &measurement.Measurement{
 		Type: measurement.TypeOS,
 		Subtypes: []measurement.Subtype{
@@ -65,6 +72,7 @@ func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, erro
 			*sysctl,
 			*kmod,
 			*release,
+			*secureBoot,
 		},
 	}
 
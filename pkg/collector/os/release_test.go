@@ -65,7 +65,7 @@ func TestReleaseCollector_Integration(t *testing.T) {
 		t.Fatalf("Collect() failed: %v", err)
 	}
 
-	// Should return measurement with TypeOS and four subtypes: grub, sysctl, kmod, release
+	// Should return measurement with TypeOS and five subtypes: grub, sysctl, kmod, release, secureboot
 	if m == nil {
 		t.Fatal("Expected non-nil measurement")
 		return
@@ -75,8 +75,8 @@ func TestReleaseCollector_Integration(t *testing.T) {
 		t.Errorf("Expected type %s, got %s", measurement.TypeOS, m.Type)
 	}
 
-	if len(m.Subtypes) != 4 {
-		t.Errorf("Expected exactly 4 subtypes (grub, sysctl, kmod, release), got %d", len(m.Subtypes))
+	if len(m.Subtypes) != 5 {
+		t.Errorf("Expected exactly 5 subtypes (grub, sysctl, kmod, release, secureboot), got %d", len(m.Subtypes))
 		return
 	}
 
@@ -0,0 +1,73 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package os
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+var (
+	// filePathEFI exists on nodes booted with UEFI.
+	filePathEFI = "/sys/firmware/efi"
+
+	// filePathSecureBoot is the SecureBoot EFI variable: 4 attribute bytes
+	// followed by a 1 when Secure Boot is enabled.
+	filePathSecureBoot = "/sys/firmware/efi/efivars/SecureBoot-8be4df61-93ca-11d0-9ddc-00e098032b8c"
+)
+
+// secureBootSubtypeName is the name of the Secure Boot subtype.
+const secureBootSubtypeName = "secureboot"
+
+// collectSecureBoot reports whether the node booted with UEFI and whether
+// Secure Boot is enabled, from the SecureBoot EFI variable. Nodes without
+// UEFI or the variable report Secure Boot as disabled.
+//
+//	efi: true
+//	enabled: true
+func (c *Collector) collectSecureBoot(ctx context.Context) (*measurement.Subtype, error) {
+	// Check if context is canceled
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	_, err := os.Stat(filePathEFI)
+	efi := err == nil
+
+	enabled := false
+	if efi {
+		data, err := os.ReadFile(filePathSecureBoot)
+		switch {
+		case err == nil:
+			enabled = len(data) >= 5 && data[4] == 1
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, fmt.Errorf("failed to read secure boot state from %s: %w", filePathSecureBoot, err)
+		}
+	}
+
+	res := &measurement.Subtype{
+		Name: secureBootSubtypeName,
+		Data: map[string]measurement.Reading{
+			"efi":     measurement.Bool(efi),
+			"enabled": measurement.Bool(enabled),
+		},
+	}
+
+	return res, nil
+}
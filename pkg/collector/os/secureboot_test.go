@@ -0,0 +1,74 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package os
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCollectSecureBoot(t *testing.T) {
+	tests := []struct {
+		name        string
+		efi         bool
+		variable    []byte
+		wantEFI     bool
+		wantEnabled bool
+	}{
+		{name: "legacy boot"},
+		{name: "uefi without variable", efi: true, wantEFI: true},
+		{name: "secure boot disabled", efi: true, variable: []byte{6, 0, 0, 0, 0}, wantEFI: true},
+		{name: "secure boot enabled", efi: true, variable: []byte{6, 0, 0, 0, 1}, wantEFI: true, wantEnabled: true},
+		{name: "truncated variable", efi: true, variable: []byte{6, 0}, wantEFI: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			originalEFI, originalVar := filePathEFI, filePathSecureBoot
+			defer func() {
+				filePathEFI, filePathSecureBoot = originalEFI, originalVar
+			}()
+			filePathEFI = filepath.Join(dir, "efi")
+			filePathSecureBoot = filepath.Join(dir, "efi", "SecureBoot")
+			if tt.efi {
+				if err := os.Mkdir(filePathEFI, 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.variable != nil {
+				if err := os.WriteFile(filePathSecureBoot, tt.variable, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			subtype, err := (&Collector{}).collectSecureBoot(context.Background())
+			if err != nil {
+				t.Fatalf("collectSecureBoot() error = %v", err)
+			}
+			if subtype.Name != secureBootSubtypeName {
+				t.Errorf("Name = %q, want %q", subtype.Name, secureBootSubtypeName)
+			}
+			if got := subtype.Data["efi"].Any(); got != tt.wantEFI {
+				t.Errorf("efi = %v, want %v", got, tt.wantEFI)
+			}
+			if got := subtype.Data["enabled"].Any(); got != tt.wantEnabled {
+				t.Errorf("enabled = %v, want %v", got, tt.wantEnabled)
+			}
+		})
+	}
+}
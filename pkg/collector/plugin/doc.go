@@ -0,0 +1,67 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugin runs exec-based collector plugins for site-specific checks.
+//
+// A plugin is any executable in the plugin directory. Each plugin runs with
+// no arguments and writes a single JSON document to stdout:
+//
+//	{
+//	  "apiVersion": "eidos.nvidia.com/v1alpha1",
+//	  "kind": "PluginMeasurement",
+//	  "data": {
+//	    "firmware-version": "2.4.1",
+//	    "healthy": true,
+//	    "ports": 8
+//	  }
+//	}
+//
+// Data values must be strings, numbers or booleans. Unknown top-level fields,
+// nested values and trailing output are rejected so the contract stays
+// stable as it evolves.
+//
+// # Snapshot Layout
+//
+// All plugins share one Plugin measurement with a subtype per plugin, named
+// after the executable without its extension. The readings above from
+// /opt/eidos/plugins/fabric-check.sh are available to recipe constraints as
+// Plugin.fabric-check.firmware-version. Each subtype's context records
+// provenance: the plugin path, the SHA256 of the executable and how long it
+// ran.
+//
+// # Sandboxing
+//
+// Plugins run one at a time, in name order, with:
+//   - a timeout (Collector.Timeout, DefaultTimeout if unset); timed-out
+//     plugins are killed along with any processes they started
+//   - an environment holding only PATH and EIDOS_PLUGIN_API_VERSION
+//   - the plugin directory as the working directory and no stdin
+//   - stdout capped at MaxOutputSize
+//
+// Hidden files, non-executables and world-writable executables are not run.
+// A plugin that fails, times out or writes invalid output is logged and left
+// out of the snapshot rather than failing it; its stderr is included in the
+// log.
+//
+// # Usage
+//
+//	c := &plugin.Collector{Dir: "/opt/eidos/plugins", Timeout: 10 * time.Second}
+//	m, err := c.Collect(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	if m == nil {
+//	    // no plugins produced data
+//	}
+package plugin
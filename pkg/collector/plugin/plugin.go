@@ -0,0 +1,301 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/internal/pluginexec"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const (
+	// APIVersion is the apiVersion plugins must set in their output.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+
+	// Kind is the kind plugins must set in their output.
+	Kind = "PluginMeasurement"
+
+	// DefaultTimeout is how long a plugin may run when Collector.Timeout is unset.
+	DefaultTimeout = 30 * time.Second
+
+	// MaxOutputSize is the largest stdout a plugin may write.
+	MaxOutputSize = 1 << 20
+
+	// maxStderrSize is how much plugin stderr is kept for error messages.
+	maxStderrSize = 4 << 10
+
+	// waitDelay bounds how long a killed plugin's children may hold its
+	// output pipes open.
+	waitDelay = 2 * time.Second
+)
+
+// Provenance context keys recorded on each plugin subtype.
+const (
+	ContextPath     = "plugin"
+	ContextSHA256   = "sha256"
+	ContextDuration = "duration"
+)
+
+// EnvAPIVersion is set in the plugin environment to the output apiVersion
+// the collector expects.
+const EnvAPIVersion = "EIDOS_PLUGIN_API_VERSION"
+
+// namePattern restricts plugin names, which become subtype names and so
+// appear in constraint paths (Plugin.<name>.<key>).
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// Output is the JSON document a plugin writes to stdout.
+type Output struct {
+	// APIVersion must be APIVersion.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind must be Kind.
+	Kind string `json:"kind"`
+
+	// Data maps measurement keys to string, number or boolean values.
+	Data map[string]any `json:"data"`
+}
+
+// Collector runs the executables in Dir and merges their output into a
+// single Plugin measurement.
+type Collector struct {
+	// Dir is the plugin directory. Empty disables plugins.
+	Dir string
+
+	// Timeout bounds each plugin run. Zero means DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Collect runs every plugin in Dir in name order. A plugin that fails, times
+// out or writes invalid output is logged and left out of the measurement, so
+// a broken site check never blocks the snapshot. Collect returns nil without
+// an error when Dir is unset or no plugin produced data.
+func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, error) {
+	if c.Dir == "" {
+		return nil, nil
+	}
+	slog.Info("collecting plugin measurements", slog.String("dir", c.Dir))
+
+	plugins, err := c.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &measurement.Measurement{Type: measurement.TypePlugin}
+	for _, p := range plugins {
+		st, err := c.run(ctx, p)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			slog.Warn("plugin failed, skipping",
+				slog.String("plugin", p.path),
+				slog.String("error", err.Error()))
+			continue
+		}
+		m.Subtypes = append(m.Subtypes, *st)
+	}
+
+	if len(m.Subtypes) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
+
+// plugin is a discovered plugin executable.
+type plugin struct {
+	name string
+	path string
+}
+
+// discover lists the executables in Dir, sorted by name. Hidden files,
+// directories, non-executables and world-writable files are skipped.
+func (c *Collector) discover() ([]plugin, error) {
+	entries, err := os.ReadDir(c.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin directory %s: %w", c.Dir, err)
+	}
+
+	var plugins []plugin
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(c.Dir, e.Name())
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		if info.Mode().Perm()&0o002 != 0 {
+			slog.Warn("skipping world-writable plugin", slog.String("plugin", path))
+			continue
+		}
+
+		name := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		if !namePattern.MatchString(name) {
+			slog.Warn("skipping plugin with invalid name", slog.String("plugin", path))
+			continue
+		}
+		if slices.ContainsFunc(plugins, func(p plugin) bool { return p.name == name }) {
+			slog.Warn("skipping plugin with duplicate name",
+				slog.String("plugin", path),
+				slog.String("name", name))
+			continue
+		}
+		plugins = append(plugins, plugin{name: name, path: path})
+	}
+	return plugins, nil
+}
+
+// run executes a plugin with a timeout, a minimal environment and bounded
+// output, and converts its output to a subtype named after the plugin.
+func (c *Collector) run(ctx context.Context, p plugin) (*measurement.Subtype, error) {
+	digest, err := fileDigest(p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout := &pluginexec.LimitedBuffer{Limit: MaxOutputSize}
+	stderr := &pluginexec.LimitedBuffer{Limit: maxStderrSize, Truncate: true}
+
+	cmd := exec.CommandContext(runCtx, p.path)
+	cmd.Dir = c.Dir
+	cmd.Env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		EnvAPIVersion + "=" + APIVersion,
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+	pluginexec.KillProcessGroup(cmd)
+
+	start := time.Now()
+	err = cmd.Run()
+	duration := time.Since(start)
+	switch {
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded):
+		return nil, fmt.Errorf("timed out after %s", timeout)
+	case err != nil:
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	data, err := parseOutput(stdout.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Debug("plugin collected",
+		slog.String("plugin", p.path),
+		slog.Int("readings", len(data)),
+		slog.Duration("duration", duration))
+
+	return &measurement.Subtype{
+		Name: p.name,
+		Data: data,
+		Context: map[string]string{
+			ContextPath:     p.path,
+			ContextSHA256:   digest,
+			ContextDuration: duration.Round(time.Millisecond).String(),
+		},
+	}, nil
+}
+
+// parseOutput decodes and validates plugin output. Integers stay integers;
+// nested objects and arrays are rejected.
+func parseOutput(raw []byte) (map[string]measurement.Reading, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+
+	var out Output
+	if err := dec.Decode(&out); err != nil {
+		return nil, fmt.Errorf("invalid output: %w", err)
+	}
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid output: trailing data after JSON document")
+	}
+	if out.APIVersion != APIVersion || out.Kind != Kind {
+		return nil, fmt.Errorf("invalid output: expected apiVersion %q and kind %q, got %q and %q",
+			APIVersion, Kind, out.APIVersion, out.Kind)
+	}
+	if len(out.Data) == 0 {
+		return nil, errors.New("invalid output: data is empty")
+	}
+
+	data := make(map[string]measurement.Reading, len(out.Data))
+	for key, value := range out.Data {
+		if key == "" {
+			return nil, errors.New("invalid output: empty data key")
+		}
+		switch v := value.(type) {
+		case json.Number:
+			if i, err := v.Int64(); err == nil {
+				data[key] = measurement.Int64(i)
+			} else if f, err := v.Float64(); err == nil {
+				data[key] = measurement.Float64(f)
+			} else {
+				return nil, fmt.Errorf("invalid output: data key %q: %w", key, err)
+			}
+		case string:
+			data[key] = measurement.Str(v)
+		case bool:
+			data[key] = measurement.Bool(v)
+		default:
+			return nil, fmt.Errorf("invalid output: data key %q must be a string, number or boolean", key)
+		}
+	}
+	return data, nil
+}
+
+// fileDigest returns the hex SHA256 digest of the file at path.
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open plugin: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash plugin: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// writePlugin writes a shell script plugin into dir with the given mode.
+func writePlugin(t *testing.T, dir, name, script string, mode os.FileMode) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write plugin: %v", err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatalf("failed to chmod plugin: %v", err)
+	}
+}
+
+const validOutput = `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","data":{"firmware":"2.4.1","healthy":true,"ports":8,"temp":41.5}}`
+
+func TestCollector_Collect(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "fabric-check.sh", "echo '"+validOutput+"'", 0o755)
+	writePlugin(t, dir, "env.sh", `echo "{\"apiVersion\":\"$EIDOS_PLUGIN_API_VERSION\",\"kind\":\"PluginMeasurement\",\"data\":{\"home\":\"${HOME:-unset}\"}}"`, 0o755)
+	writePlugin(t, dir, "failing.sh", "echo boom >&2; exit 3", 0o755)
+	writePlugin(t, dir, "invalid.sh", "echo not-json", 0o755)
+	writePlugin(t, dir, "not-executable.sh", "echo '"+validOutput+"'", 0o644)
+	writePlugin(t, dir, ".hidden.sh", "echo '"+validOutput+"'", 0o755)
+
+	c := &Collector{Dir: dir, Timeout: 5 * time.Second}
+	m, err := c.Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if m == nil || m.Type != measurement.TypePlugin {
+		t.Fatalf("Collect() = %+v, want Plugin measurement", m)
+	}
+	if got := m.SubtypeNames(); len(got) != 2 || got[0] != "env" || got[1] != "fabric-check" {
+		t.Fatalf("subtypes = %v, want [env fabric-check]", got)
+	}
+
+	st := m.GetSubtype("fabric-check")
+	if v, _ := st.GetString("firmware"); v != "2.4.1" {
+		t.Errorf("firmware = %q, want 2.4.1", v)
+	}
+	if v, _ := st.GetBool("healthy"); !v {
+		t.Error("healthy = false, want true")
+	}
+	if v, _ := st.GetInt64("ports"); v != 8 {
+		t.Errorf("ports = %d, want 8", v)
+	}
+	if v, _ := st.GetFloat64("temp"); v != 41.5 {
+		t.Errorf("temp = %v, want 41.5", v)
+	}
+	if st.Context[ContextPath] != filepath.Join(dir, "fabric-check.sh") {
+		t.Errorf("context plugin = %q", st.Context[ContextPath])
+	}
+	if len(st.Context[ContextSHA256]) != 64 || st.Context[ContextDuration] == "" {
+		t.Errorf("missing provenance in context: %v", st.Context)
+	}
+
+	env := m.GetSubtype("env")
+	if v, _ := env.GetString("home"); v != "unset" {
+		t.Errorf("plugin environment leaked HOME=%q", v)
+	}
+}
+
+func TestCollector_Collect_NoPlugins(t *testing.T) {
+	m, err := (&Collector{}).Collect(context.Background())
+	if err != nil || m != nil {
+		t.Errorf("Collect() with no dir = %v, %v, want nil, nil", m, err)
+	}
+
+	m, err = (&Collector{Dir: t.TempDir()}).Collect(context.Background())
+	if err != nil || m != nil {
+		t.Errorf("Collect() with empty dir = %v, %v, want nil, nil", m, err)
+	}
+
+	if _, err := (&Collector{Dir: filepath.Join(t.TempDir(), "missing")}).Collect(context.Background()); err == nil {
+		t.Error("expected error for missing plugin directory")
+	}
+}
+
+func TestCollector_Run_Timeout(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "slow.sh", "sleep 10", 0o755)
+
+	c := &Collector{Dir: dir, Timeout: 100 * time.Millisecond}
+	start := time.Now()
+	_, err := c.run(context.Background(), plugin{name: "slow", path: filepath.Join(dir, "slow.sh")})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("run() error = %v, want timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("run() took %v, plugin was not killed", elapsed)
+	}
+}
+
+func TestCollector_Collect_Canceled(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "check.sh", "echo '"+validOutput+"'", 0o755)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := (&Collector{Dir: dir}).Collect(ctx); err == nil {
+		t.Error("expected error for canceled context")
+	}
+}
+
+func TestCollector_Discover(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "b-check.sh", "true", 0o755)
+	writePlugin(t, dir, "a-check", "true", 0o755)
+	writePlugin(t, dir, "b-check.py", "true", 0o755)
+	writePlugin(t, dir, "writable.sh", "true", 0o757)
+	writePlugin(t, dir, "bad.name.sh", "true", 0o755)
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	plugins, err := (&Collector{Dir: dir}).discover()
+	if err != nil {
+		t.Fatalf("discover() error = %v", err)
+	}
+	var names []string
+	for _, p := range plugins {
+		names = append(names, filepath.Base(p.path))
+	}
+	if strings.Join(names, ",") != "a-check,b-check.py" {
+		t.Errorf("discovered %v, want [a-check b-check.py]", names)
+	}
+}
+
+func TestParseOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		wantErr bool
+	}{
+		{name: "valid", output: validOutput},
+		{name: "wrong kind", output: `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"Other","data":{"a":"b"}}`, wantErr: true},
+		{name: "wrong apiVersion", output: `{"apiVersion":"v2","kind":"PluginMeasurement","data":{"a":"b"}}`, wantErr: true},
+		{name: "empty data", output: `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","data":{}}`, wantErr: true},
+		{name: "nested value", output: `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","data":{"a":{"b":1}}}`, wantErr: true},
+		{name: "list value", output: `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","data":{"a":[1]}}`, wantErr: true},
+		{name: "null value", output: `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","data":{"a":null}}`, wantErr: true},
+		{name: "unknown field", output: `{"apiVersion":"eidos.nvidia.com/v1alpha1","kind":"PluginMeasurement","data":{"a":1},"extra":true}`, wantErr: true},
+		{name: "trailing data", output: validOutput + "\n{}", wantErr: true},
+		{name: "not json", output: "hello", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseOutput([]byte(tt.output))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseOutput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtime collects container runtime configuration from the node.
+//
+// When the GPU Operator is installed with a preinstalled driver and toolkit,
+// the node's container runtime must already be wired to the NVIDIA Container
+// Toolkit. This collector reads the containerd and CRI-O configuration and
+// the toolkit's config.toml so recipes can validate that wiring before the
+// operator is installed.
+//
+// # Collected Data
+//
+// The collector returns a Runtime measurement with 3 subtypes:
+//
+// 1. containerd - /etc/containerd/config.toml and the files it imports:
+//   - present: true when the config file exists
+//   - config-version: containerd config version (1, 2 or 3)
+//   - default-runtime: default_runtime_name, empty when not set
+//   - runtimes: Comma-separated runtime handler names (e.g., "nvidia,runc")
+//   - nvidia-runtime: true when a handler runs nvidia-container-runtime
+//   - nvidia-runtime.handler, nvidia-runtime.binary
+//   - cgroup-driver: systemd or cgroupfs, from SystemdCgroup of the default runtime
+//   - cdi-enabled: enable_cdi, when set
+//   - registry-mirrors: Registry hosts with mirrors, inline or under config_path
+//   - registry-config-path: hosts.toml directory, when set
+//
+// 2. crio - /etc/crio/crio.conf, /etc/crio/crio.conf.d/*.conf and
+// /etc/containers/registries.conf:
+//   - present, default-runtime, runtimes, nvidia-runtime (as for containerd)
+//   - cgroup-driver: cgroup_manager, systemd when not set
+//   - registry-mirrors: Registries with mirrors in registries.conf
+//
+// 3. toolkit - NVIDIA Container Toolkit config.toml, from
+// /etc/nvidia-container-runtime or the GPU Operator toolkit directory:
+//   - present, config-path
+//   - mode: nvidia-container-runtime mode (auto, legacy, cdi, csv)
+//   - runtimes: Low-level runtimes the toolkit wraps
+//   - driver-root: nvidia-container-cli root, empty or "/" for a host driver
+//   - ldconfig, accept-envvar-unprivileged, accept-volume-mounts
+//
+// Missing configuration files are not errors; the subtype reports
+// present=false. Files that cannot be parsed are logged and treated as absent.
+//
+// # Host Root
+//
+// Collector.Root prefixes every path, so a container that mounts the host
+// /etc under /host/etc can read the host configuration with Root "/host".
+// Privileged agent Jobs set this through EIDOS_HOST_ROOT.
+//
+// # Usage
+//
+//	collector := &runtime.Collector{}
+//	m, err := collector.Collect(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//
+// Recipes can use the readings as constraints, for example
+// Runtime.containerd.nvidia-runtime == true for driver-preinstalled overlays.
+package runtime
@@ -0,0 +1,428 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/NVIDIA/eidos/pkg/collector/internal/sysfs"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// Reading keys recipes are expected to constrain on.
+const (
+	KeyPresent        = "present"
+	KeyDefaultRuntime = "default-runtime"
+	KeyRuntimes       = "runtimes"
+	KeyNvidiaRuntime  = "nvidia-runtime"
+	KeyCgroupDriver   = "cgroup-driver"
+	KeyRegistryMirror = "registry-mirrors"
+)
+
+const (
+	// nvidiaHandler is the runtime handler name the NVIDIA Container Toolkit
+	// registers with containerd and CRI-O.
+	nvidiaHandler = "nvidia"
+
+	// nvidiaRuntimeBinary prefixes the nvidia-container-runtime binaries
+	// (nvidia-container-runtime, .cdi, .legacy).
+	nvidiaRuntimeBinary = "nvidia-container-runtime"
+
+	cgroupDriverSystemd  = "systemd"
+	cgroupDriverCgroupfs = "cgroupfs"
+)
+
+var (
+	containerdConfig = "/etc/containerd/config.toml"
+	crioConfig       = "/etc/crio/crio.conf"
+	crioConfigDir    = "/etc/crio/crio.conf.d"
+	registriesConfig = "/etc/containers/registries.conf"
+
+	// toolkitConfigs lists the NVIDIA Container Toolkit config locations:
+	// the host package install first, then the GPU Operator install.
+	toolkitConfigs = []string{
+		"/etc/nvidia-container-runtime/config.toml",
+		"/usr/local/nvidia/toolkit/.config/nvidia-container-runtime/config.toml",
+	}
+)
+
+// Collector collects container runtime and NVIDIA Container Toolkit
+// configuration from the node.
+type Collector struct {
+	// Root is the host filesystem root the configuration files are read
+	// from, for collection from a container with the host mounted. Empty
+	// means "/".
+	Root string
+}
+
+// Collect reads the runtime configuration files and returns them as a single
+// measurement with three subtypes: containerd, crio and toolkit.
+func (c *Collector) Collect(ctx context.Context) (*measurement.Measurement, error) {
+	slog.Info("collecting container runtime configuration")
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	containerd := c.collectContainerd()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	crio := c.collectCRIO()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	toolkit := c.collectToolkit()
+
+	res := &measurement.Measurement{
+		Type: measurement.TypeRuntime,
+		Subtypes: []measurement.Subtype{
+			*containerd,
+			*crio,
+			*toolkit,
+		},
+	}
+
+	return res, nil
+}
+
+// collectContainerd reports the CRI plugin settings of the containerd config,
+// including files pulled in through its imports. Config versions 1 and 2
+// (containerd 1.x) and 3 (containerd 2.x) are understood.
+func (c *Collector) collectContainerd() *measurement.Subtype {
+	readings := make(map[string]measurement.Reading)
+
+	cfg, ok := c.decodeTOML(containerdConfig)
+	readings[KeyPresent] = measurement.Bool(ok)
+	if !ok {
+		return &measurement.Subtype{Name: "containerd", Data: readings}
+	}
+	for _, pattern := range stringSlice(cfg["imports"]) {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(containerdConfig), pattern)
+		}
+		matches, _ := filepath.Glob(c.path(pattern))
+		slices.Sort(matches)
+		for _, match := range matches {
+			if imported, ok := c.decodeTOML(strings.TrimPrefix(match, c.Root)); ok {
+				mergeTables(cfg, imported)
+			}
+		}
+	}
+
+	version := 1
+	if v, ok := cfg["version"].(int64); ok {
+		version = int(v)
+	}
+	readings["config-version"] = measurement.Int(version)
+
+	// The CRI plugin moved between config versions: runtimes and images
+	// were split into separate plugins in version 3.
+	plugins := table(cfg, "plugins")
+	var cri, registry map[string]any
+	switch version {
+	case 1:
+		cri = table(plugins, "cri")
+		registry = table(cri, "registry")
+	case 2:
+		cri = table(plugins, "io.containerd.grpc.v1.cri")
+		registry = table(cri, "registry")
+	default:
+		cri = table(plugins, "io.containerd.cri.v1.runtime")
+		registry = table(plugins, "io.containerd.cri.v1.images", "registry")
+	}
+
+	runtimeCfg := table(cri, "containerd")
+	defaultRuntime, _ := runtimeCfg["default_runtime_name"].(string)
+	readings[KeyDefaultRuntime] = measurement.Str(defaultRuntime)
+
+	runtimes := table(runtimeCfg, "runtimes")
+	readings[KeyRuntimes] = measurement.Str(strings.Join(sortedKeys(runtimes), ","))
+
+	nvidia, binary := nvidiaRuntime(runtimes, "options", "BinaryName")
+	readings[KeyNvidiaRuntime] = measurement.Bool(nvidia != "")
+	if nvidia != "" {
+		readings["nvidia-runtime.handler"] = measurement.Str(nvidia)
+		readings["nvidia-runtime.binary"] = measurement.Str(binary)
+	}
+
+	// runc uses the cgroupfs driver unless SystemdCgroup is set on the
+	// default runtime; containerd defaults to the runc handler.
+	if defaultRuntime == "" {
+		defaultRuntime = "runc"
+	}
+	driver := cgroupDriverCgroupfs
+	if systemd, _ := table(runtimes, defaultRuntime, "options")["SystemdCgroup"].(bool); systemd {
+		driver = cgroupDriverSystemd
+	}
+	readings[KeyCgroupDriver] = measurement.Str(driver)
+
+	if enabled, ok := cri["enable_cdi"].(bool); ok {
+		readings["cdi-enabled"] = measurement.Bool(enabled)
+	}
+
+	// Mirrors are configured either inline (deprecated) or as hosts.toml
+	// directories under config_path, one directory per registry host.
+	mirrors := sortedKeys(table(registry, "mirrors"))
+	if configPath, _ := registry["config_path"].(string); configPath != "" {
+		readings["registry-config-path"] = measurement.Str(configPath)
+		for _, dir := range strings.Split(configPath, ":") {
+			for _, host := range sysfs.ListDir(c.path(dir)) {
+				if !slices.Contains(mirrors, host) {
+					mirrors = append(mirrors, host)
+				}
+			}
+		}
+		slices.Sort(mirrors)
+	}
+	readings[KeyRegistryMirror] = measurement.Str(strings.Join(mirrors, ","))
+
+	return &measurement.Subtype{Name: "containerd", Data: readings}
+}
+
+// collectCRIO reports the CRI-O runtime settings from crio.conf and its
+// drop-in directory, and the mirrors from the containers registries.conf.
+func (c *Collector) collectCRIO() *measurement.Subtype {
+	readings := make(map[string]measurement.Reading)
+
+	cfg, ok := c.decodeTOML(crioConfig)
+	if !ok {
+		cfg = make(map[string]any)
+	}
+	for _, name := range sysfs.ListDir(c.path(crioConfigDir)) {
+		if !strings.HasSuffix(name, ".conf") {
+			continue
+		}
+		if dropIn, found := c.decodeTOML(filepath.Join(crioConfigDir, name)); found {
+			mergeTables(cfg, dropIn)
+			ok = true
+		}
+	}
+	readings[KeyPresent] = measurement.Bool(ok)
+	if !ok {
+		return &measurement.Subtype{Name: "crio", Data: readings}
+	}
+
+	runtimeCfg := table(cfg, "crio", "runtime")
+	defaultRuntime, _ := runtimeCfg["default_runtime"].(string)
+	readings[KeyDefaultRuntime] = measurement.Str(defaultRuntime)
+
+	runtimes := table(runtimeCfg, "runtimes")
+	readings[KeyRuntimes] = measurement.Str(strings.Join(sortedKeys(runtimes), ","))
+
+	nvidia, binary := nvidiaRuntime(runtimes, "runtime_path")
+	readings[KeyNvidiaRuntime] = measurement.Bool(nvidia != "")
+	if nvidia != "" {
+		readings["nvidia-runtime.handler"] = measurement.Str(nvidia)
+		readings["nvidia-runtime.binary"] = measurement.Str(binary)
+	}
+
+	// CRI-O uses the systemd cgroup manager unless configured otherwise.
+	driver, _ := runtimeCfg["cgroup_manager"].(string)
+	if driver == "" {
+		driver = cgroupDriverSystemd
+	}
+	readings[KeyCgroupDriver] = measurement.Str(driver)
+
+	var mirrors []string
+	registries, _ := c.decodeTOML(registriesConfig)
+	for _, reg := range tables(registries["registry"]) {
+		if len(tables(reg["mirror"])) == 0 {
+			continue
+		}
+		prefix, _ := reg["prefix"].(string)
+		if prefix == "" {
+			prefix, _ = reg["location"].(string)
+		}
+		if prefix != "" && !slices.Contains(mirrors, prefix) {
+			mirrors = append(mirrors, prefix)
+		}
+	}
+	slices.Sort(mirrors)
+	readings[KeyRegistryMirror] = measurement.Str(strings.Join(mirrors, ","))
+
+	return &measurement.Subtype{Name: "crio", Data: readings}
+}
+
+// collectToolkit reports the NVIDIA Container Toolkit config.toml settings
+// that decide how GPUs are injected into containers.
+func (c *Collector) collectToolkit() *measurement.Subtype {
+	readings := make(map[string]measurement.Reading)
+
+	var cfg map[string]any
+	var configPath string
+	for _, path := range toolkitConfigs {
+		if decoded, ok := c.decodeTOML(path); ok {
+			cfg, configPath = decoded, path
+			break
+		}
+	}
+	readings[KeyPresent] = measurement.Bool(cfg != nil)
+	if cfg == nil {
+		return &measurement.Subtype{Name: "toolkit", Data: readings}
+	}
+	readings["config-path"] = measurement.Str(configPath)
+
+	runtimeCfg := table(cfg, "nvidia-container-runtime")
+	mode, _ := runtimeCfg["mode"].(string)
+	readings["mode"] = measurement.Str(mode)
+	readings[KeyRuntimes] = measurement.Str(strings.Join(stringSlice(runtimeCfg["runtimes"]), ","))
+
+	// root is the driver installation root: empty or "/" for a driver
+	// installed on the host, /run/nvidia/driver for the GPU Operator driver.
+	cliCfg := table(cfg, "nvidia-container-cli")
+	root, _ := cliCfg["root"].(string)
+	readings["driver-root"] = measurement.Str(root)
+	if ldconfig, ok := cliCfg["ldconfig"].(string); ok {
+		readings["ldconfig"] = measurement.Str(ldconfig)
+	}
+
+	for key, name := range map[string]string{
+		"accept-nvidia-visible-devices-envvar-when-unprivileged": "accept-envvar-unprivileged",
+		"accept-nvidia-visible-devices-as-volume-mounts":         "accept-volume-mounts",
+	} {
+		if v, ok := cfg[key].(bool); ok {
+			readings[name] = measurement.Bool(v)
+		}
+	}
+
+	return &measurement.Subtype{Name: "toolkit", Data: readings}
+}
+
+// path returns the location of a host path under the collector root.
+func (c *Collector) path(p string) string {
+	if c.Root == "" {
+		return p
+	}
+	return filepath.Join(c.Root, p)
+}
+
+// decodeTOML reads and decodes a TOML file. It reports false when the file
+// does not exist or cannot be parsed; parse failures are logged.
+func (c *Collector) decodeTOML(path string) (map[string]any, bool) {
+	data, err := os.ReadFile(c.path(path))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Warn("failed to read runtime config", slog.String("path", path), slog.String("error", err.Error()))
+		}
+		return nil, false
+	}
+	cfg := make(map[string]any)
+	if _, err := toml.Decode(string(data), &cfg); err != nil {
+		slog.Warn("failed to parse runtime config", slog.String("path", path), slog.String("error", err.Error()))
+		return nil, false
+	}
+	return cfg, true
+}
+
+// nvidiaRuntime returns the handler that runs nvidia-container-runtime and
+// its binary. A handler named "nvidia" wins; otherwise the first handler
+// whose binary, found at binaryPath within the handler table, is an
+// nvidia-container-runtime binary.
+func nvidiaRuntime(runtimes map[string]any, binaryPath ...string) (handler, binary string) {
+	binaryOf := func(name string) string {
+		t := table(runtimes, name)
+		if len(binaryPath) > 1 {
+			t = table(t, binaryPath[:len(binaryPath)-1]...)
+		}
+		b, _ := t[binaryPath[len(binaryPath)-1]].(string)
+		return b
+	}
+	if _, ok := runtimes[nvidiaHandler]; ok {
+		return nvidiaHandler, binaryOf(nvidiaHandler)
+	}
+	for _, name := range sortedKeys(runtimes) {
+		if b := binaryOf(name); strings.HasPrefix(filepath.Base(b), nvidiaRuntimeBinary) {
+			return name, b
+		}
+	}
+	return "", ""
+}
+
+// table walks nested TOML tables by key. Missing tables yield nil, which
+// reads as empty.
+func table(m map[string]any, keys ...string) map[string]any {
+	for _, key := range keys {
+		next, ok := m[key].(map[string]any)
+		if !ok {
+			return nil
+		}
+		m = next
+	}
+	return m
+}
+
+// tables returns an array of TOML tables ([[name]]).
+func tables(v any) []map[string]any {
+	switch t := v.(type) {
+	case []map[string]any:
+		return t
+	case []any:
+		var out []map[string]any
+		for _, item := range t {
+			if m, ok := item.(map[string]any); ok {
+				out = append(out, m)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// stringSlice returns the strings of a TOML array.
+func stringSlice(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// mergeTables merges src into dst, recursing into tables present in both.
+// Values in src win, matching how drop-in files override the main config.
+func mergeTables(dst, src map[string]any) {
+	for key, value := range src {
+		srcTable, srcOK := value.(map[string]any)
+		dstTable, dstOK := dst[key].(map[string]any)
+		if srcOK && dstOK {
+			mergeTables(dstTable, srcTable)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const containerdV2Config = `version = 2
+imports = ["/etc/containerd/conf.d/*.toml"]
+
+[plugins."io.containerd.grpc.v1.cri"]
+  enable_cdi = true
+
+[plugins."io.containerd.grpc.v1.cri".containerd]
+  default_runtime_name = "runc"
+
+[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc]
+  runtime_type = "io.containerd.runc.v2"
+
+[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc.options]
+  SystemdCgroup = true
+
+[plugins."io.containerd.grpc.v1.cri".registry]
+  config_path = "/etc/containerd/certs.d"
+`
+
+// nvidiaDropIn is the drop-in nvidia-ctk writes to make nvidia the default.
+const nvidiaDropIn = `version = 2
+
+[plugins."io.containerd.grpc.v1.cri".containerd]
+  default_runtime_name = "nvidia"
+
+[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.nvidia]
+  runtime_type = "io.containerd.runc.v2"
+
+[plugins."io.containerd.grpc.v1.cri".containerd.runtimes.nvidia.options]
+  BinaryName = "/usr/bin/nvidia-container-runtime"
+  SystemdCgroup = true
+`
+
+const containerdV3Config = `version = 3
+
+[plugins."io.containerd.cri.v1.runtime".containerd.runtimes.gpu.options]
+  BinaryName = "/usr/local/nvidia/toolkit/nvidia-container-runtime.cdi"
+
+[plugins."io.containerd.cri.v1.images".registry.mirrors."docker.io"]
+  endpoint = ["https://mirror.example.com"]
+`
+
+const crioConf = `[crio.runtime]
+default_runtime = "crun"
+cgroup_manager = "cgroupfs"
+
+[crio.runtime.runtimes.crun]
+runtime_path = "/usr/bin/crun"
+`
+
+const crioNvidiaDropIn = `[crio.runtime]
+default_runtime = "nvidia"
+
+[crio.runtime.runtimes.nvidia]
+runtime_path = "/usr/bin/nvidia-container-runtime"
+`
+
+const registriesConf = `unqualified-search-registries = ["docker.io"]
+
+[[registry]]
+prefix = "nvcr.io"
+location = "nvcr.io"
+
+[[registry.mirror]]
+location = "mirror.example.com/nvcr"
+
+[[registry]]
+location = "quay.io"
+`
+
+const toolkitConfig = `accept-nvidia-visible-devices-as-volume-mounts = false
+accept-nvidia-visible-devices-envvar-when-unprivileged = true
+
+[nvidia-container-cli]
+  root = "/run/nvidia/driver"
+  ldconfig = "@/run/nvidia/driver/sbin/ldconfig"
+
+[nvidia-container-runtime]
+  mode = "cdi"
+  runtimes = ["docker-runc", "runc", "crun"]
+`
+
+// writeFile writes a file under root, creating parent directories.
+func writeFile(t *testing.T, root, path, content string) {
+	t.Helper()
+	full := filepath.Join(root, path)
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(full, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func subtype(t *testing.T, m *measurement.Measurement, name string) map[string]measurement.Reading {
+	t.Helper()
+	for _, st := range m.Subtypes {
+		if st.Name == name {
+			return st.Data
+		}
+	}
+	t.Fatalf("subtype %q not found", name)
+	return nil
+}
+
+func assertReading(t *testing.T, data map[string]measurement.Reading, key string, want any) {
+	t.Helper()
+	got, ok := data[key]
+	if !ok {
+		t.Errorf("reading %q missing", key)
+		return
+	}
+	if got.Any() != want {
+		t.Errorf("%s = %v (%T), want %v (%T)", key, got.Any(), got.Any(), want, want)
+	}
+}
+
+func collect(t *testing.T, root string) *measurement.Measurement {
+	t.Helper()
+	m, err := (&Collector{Root: root}).Collect(context.Background())
+	if err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	if m.Type != measurement.TypeRuntime {
+		t.Errorf("Type = %s, want %s", m.Type, measurement.TypeRuntime)
+	}
+	return m
+}
+
+func TestCollector_Containerd(t *testing.T) {
+	t.Run("v2 with nvidia drop-in", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, root, containerdConfig, containerdV2Config)
+		writeFile(t, root, "/etc/containerd/conf.d/99-nvidia.toml", nvidiaDropIn)
+		writeFile(t, root, "/etc/containerd/certs.d/nvcr.io/hosts.toml", `server = "https://nvcr.io"`)
+
+		data := subtype(t, collect(t, root), "containerd")
+		assertReading(t, data, KeyPresent, true)
+		assertReading(t, data, "config-version", 2)
+		assertReading(t, data, KeyDefaultRuntime, "nvidia")
+		assertReading(t, data, KeyRuntimes, "nvidia,runc")
+		assertReading(t, data, KeyNvidiaRuntime, true)
+		assertReading(t, data, "nvidia-runtime.handler", "nvidia")
+		assertReading(t, data, "nvidia-runtime.binary", "/usr/bin/nvidia-container-runtime")
+		assertReading(t, data, KeyCgroupDriver, cgroupDriverSystemd)
+		assertReading(t, data, "cdi-enabled", true)
+		assertReading(t, data, KeyRegistryMirror, "nvcr.io")
+		assertReading(t, data, "registry-config-path", "/etc/containerd/certs.d")
+	})
+
+	t.Run("v3 with renamed handler", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, root, containerdConfig, containerdV3Config)
+
+		data := subtype(t, collect(t, root), "containerd")
+		assertReading(t, data, "config-version", 3)
+		assertReading(t, data, KeyDefaultRuntime, "")
+		assertReading(t, data, KeyNvidiaRuntime, true)
+		assertReading(t, data, "nvidia-runtime.handler", "gpu")
+		assertReading(t, data, KeyCgroupDriver, cgroupDriverCgroupfs)
+		assertReading(t, data, KeyRegistryMirror, "docker.io")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		data := subtype(t, collect(t, t.TempDir()), "containerd")
+		assertReading(t, data, KeyPresent, false)
+		if _, ok := data[KeyNvidiaRuntime]; ok {
+			t.Error("nvidia-runtime reported without a config")
+		}
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, root, containerdConfig, "version = [")
+		assertReading(t, subtype(t, collect(t, root), "containerd"), KeyPresent, false)
+	})
+}
+
+func TestCollector_CRIO(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, crioConfig, crioConf)
+	writeFile(t, root, filepath.Join(crioConfigDir, "99-nvidia.conf"), crioNvidiaDropIn)
+	writeFile(t, root, filepath.Join(crioConfigDir, "README"), "ignored")
+	writeFile(t, root, registriesConfig, registriesConf)
+
+	data := subtype(t, collect(t, root), "crio")
+	assertReading(t, data, KeyPresent, true)
+	assertReading(t, data, KeyDefaultRuntime, "nvidia")
+	assertReading(t, data, KeyRuntimes, "crun,nvidia")
+	assertReading(t, data, KeyNvidiaRuntime, true)
+	assertReading(t, data, "nvidia-runtime.binary", "/usr/bin/nvidia-container-runtime")
+	assertReading(t, data, KeyCgroupDriver, cgroupDriverCgroupfs)
+	assertReading(t, data, KeyRegistryMirror, "nvcr.io")
+}
+
+func TestCollector_Toolkit(t *testing.T) {
+	t.Run("operator install", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, root, toolkitConfigs[1], toolkitConfig)
+
+		data := subtype(t, collect(t, root), "toolkit")
+		assertReading(t, data, KeyPresent, true)
+		assertReading(t, data, "config-path", toolkitConfigs[1])
+		assertReading(t, data, "mode", "cdi")
+		assertReading(t, data, KeyRuntimes, "docker-runc,runc,crun")
+		assertReading(t, data, "driver-root", "/run/nvidia/driver")
+		assertReading(t, data, "ldconfig", "@/run/nvidia/driver/sbin/ldconfig")
+		assertReading(t, data, "accept-envvar-unprivileged", true)
+		assertReading(t, data, "accept-volume-mounts", false)
+	})
+
+	t.Run("host install wins", func(t *testing.T) {
+		root := t.TempDir()
+		writeFile(t, root, toolkitConfigs[0], "[nvidia-container-runtime]\nmode = \"auto\"\n")
+		writeFile(t, root, toolkitConfigs[1], toolkitConfig)
+
+		data := subtype(t, collect(t, root), "toolkit")
+		assertReading(t, data, "config-path", toolkitConfigs[0])
+		assertReading(t, data, "driver-root", "")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		assertReading(t, subtype(t, collect(t, t.TempDir()), "toolkit"), KeyPresent, false)
+	})
+}
+
+func TestCollector_Collect_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := (&Collector{Root: t.TempDir()}).Collect(ctx); err == nil {
+		t.Error("Collect() error = nil, want context error")
+	}
+}
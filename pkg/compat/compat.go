@@ -0,0 +1,370 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compat
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/version"
+)
+
+// KubernetesVersionConstraint is the constraint name recorded on warnings
+// produced by this package.
+const KubernetesVersionConstraint = "K8s.server.version"
+
+// IssueType identifies the kind of incompatibility found.
+type IssueType string
+
+const (
+	// IssueKubernetesVersion means the component version does not support
+	// the target Kubernetes version.
+	IssueKubernetesVersion IssueType = "KubernetesVersion"
+
+	// IssueRemovedAPI means a component manifest uses an API version that the
+	// target Kubernetes version no longer serves.
+	IssueRemovedAPI IssueType = "RemovedAPI"
+)
+
+// Issue is a single incompatibility between a component and the target
+// Kubernetes version.
+type Issue struct {
+	// Component is the name of the affected component.
+	Component string
+
+	// Type is the kind of incompatibility.
+	Type IssueType
+
+	// Expected describes the Kubernetes versions the component supports.
+	Expected string
+
+	// Actual is the target Kubernetes version.
+	Actual string
+
+	// Reason explains the incompatibility.
+	Reason string
+}
+
+// Option configures a Checker.
+type Option func(*Checker)
+
+// WithComponentRules replaces the component compatibility rules.
+func WithComponentRules(rules []ComponentRule) Option {
+	return func(c *Checker) {
+		c.rules = rules
+	}
+}
+
+// WithRemovedAPIs replaces the list of removed API versions.
+func WithRemovedAPIs(apis []RemovedAPI) Option {
+	return func(c *Checker) {
+		c.removedAPIs = apis
+	}
+}
+
+// Checker checks recipe components against a target Kubernetes version.
+type Checker struct {
+	rules       []ComponentRule
+	removedAPIs []RemovedAPI
+}
+
+// NewChecker returns a Checker using the built-in rules unless overridden.
+func NewChecker(opts ...Option) *Checker {
+	c := &Checker{
+		rules:       DefaultComponentRules(),
+		removedAPIs: DefaultRemovedAPIs(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Check returns the incompatibilities between the enabled components of
+// result and the target Kubernetes version. Only the major and minor parts of
+// target are compared. Component manifests are loaded through the recipe data
+// provider and scanned for removed API versions.
+func (c *Checker) Check(result *recipe.RecipeResult, target version.Version) ([]Issue, error) {
+	if result == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "recipe result is nil")
+	}
+	if !target.IsValid() {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "invalid target Kubernetes version")
+	}
+	target = minorVersion(target)
+
+	var issues []Issue
+	for i := range result.ComponentRefs {
+		ref := &result.ComponentRefs[i]
+		if !ref.IsEnabled() {
+			continue
+		}
+
+		ruleIssues, err := c.checkRules(ref, target)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, ruleIssues...)
+
+		apiIssues, err := c.checkManifests(ref, target)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, apiIssues...)
+	}
+
+	return issues, nil
+}
+
+// checkRules evaluates the component rules that apply to ref.
+func (c *Checker) checkRules(ref *recipe.ComponentRef, target version.Version) ([]Issue, error) {
+	var issues []Issue
+	for _, rule := range c.rules {
+		if rule.Component != ref.Name {
+			continue
+		}
+
+		applies, err := ruleApplies(rule, ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		if !applies {
+			continue
+		}
+
+		var expected []string
+		compatible := true
+		if rule.MinKubernetes != "" {
+			minK8s, err := parseRuleVersion(rule, rule.MinKubernetes)
+			if err != nil {
+				return nil, err
+			}
+			expected = append(expected, ">= "+rule.MinKubernetes)
+			if target.Compare(minK8s) < 0 {
+				compatible = false
+			}
+		}
+		if rule.MaxKubernetes != "" {
+			maxK8s, err := parseRuleVersion(rule, rule.MaxKubernetes)
+			if err != nil {
+				return nil, err
+			}
+			expected = append(expected, "< "+rule.MaxKubernetes)
+			if target.Compare(maxK8s) >= 0 {
+				compatible = false
+			}
+		}
+		if compatible {
+			continue
+		}
+
+		reason := rule.Reason
+		if reason == "" {
+			reason = fmt.Sprintf("%s %s does not support Kubernetes %s", ref.Name, ref.Version, target)
+		}
+		issues = append(issues, Issue{
+			Component: ref.Name,
+			Type:      IssueKubernetesVersion,
+			Expected:  strings.Join(expected, ", "),
+			Actual:    target.String(),
+			Reason:    reason,
+		})
+	}
+	return issues, nil
+}
+
+// ruleApplies reports whether componentVersion falls within the rule's
+// component version range. Rules with a version range never apply to
+// components without a parseable version.
+func ruleApplies(rule ComponentRule, componentVersion string) (bool, error) {
+	if rule.MinVersion == "" && rule.MaxVersion == "" {
+		return true, nil
+	}
+
+	v, err := version.ParseVersion(componentVersion)
+	if err != nil {
+		slog.Debug("skipping compatibility rule for unparseable component version",
+			"component", rule.Component, "version", componentVersion, "error", err)
+		return false, nil
+	}
+
+	if rule.MinVersion != "" {
+		minVersion, err := parseRuleVersion(rule, rule.MinVersion)
+		if err != nil {
+			return false, err
+		}
+		if v.Compare(minVersion) < 0 {
+			return false, nil
+		}
+	}
+	if rule.MaxVersion != "" {
+		maxVersion, err := parseRuleVersion(rule, rule.MaxVersion)
+		if err != nil {
+			return false, err
+		}
+		if v.Compare(maxVersion) >= 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// checkManifests scans the component's manifest files for removed APIs.
+func (c *Checker) checkManifests(ref *recipe.ComponentRef, target version.Version) ([]Issue, error) {
+	if len(c.removedAPIs) == 0 {
+		return nil, nil
+	}
+
+	var issues []Issue
+	for _, path := range ref.ManifestFiles {
+		content, err := recipe.GetManifestContent(path)
+		if err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to read manifest %s for component %s", path, ref.Name), err)
+		}
+
+		for _, res := range scanResources(content) {
+			for _, api := range c.removedAPIs {
+				if api.APIVersion != res.apiVersion || (api.Kind != "" && api.Kind != res.kind) {
+					continue
+				}
+				removedIn, err := version.ParseVersion(api.RemovedIn)
+				if err != nil {
+					return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+						fmt.Sprintf("invalid removal version %q for %s", api.RemovedIn, api.APIVersion), err)
+				}
+				if target.Compare(removedIn) < 0 {
+					continue
+				}
+
+				reason := fmt.Sprintf("manifest %s uses %s %s, which was removed in Kubernetes %s",
+					path, res.apiVersion, res.kind, api.RemovedIn)
+				if api.Replacement != "" {
+					reason += "; migrate to " + api.Replacement
+				}
+				issues = append(issues, Issue{
+					Component: ref.Name,
+					Type:      IssueRemovedAPI,
+					Expected:  "< " + api.RemovedIn,
+					Actual:    target.String(),
+					Reason:    reason,
+				})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// resource is the apiVersion and kind of one YAML document.
+type resource struct {
+	apiVersion string
+	kind       string
+}
+
+// scanResources extracts the top-level apiVersion and kind of each YAML
+// document in content. Manifests are Helm templates, so they are scanned line
+// by line rather than parsed; lines with template expressions are ignored.
+func scanResources(content []byte) []resource {
+	var (
+		resources []resource
+		current   resource
+	)
+	flush := func() {
+		if current.apiVersion != "" {
+			resources = append(resources, current)
+		}
+		current = resource{}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "---") {
+			flush()
+			continue
+		}
+		if strings.Contains(line, "{{") {
+			continue
+		}
+		if value, ok := strings.CutPrefix(line, "apiVersion:"); ok {
+			current.apiVersion = unquote(value)
+		} else if value, ok := strings.CutPrefix(line, "kind:"); ok {
+			current.kind = unquote(value)
+		}
+	}
+	flush()
+
+	return resources
+}
+
+// unquote trims whitespace, trailing comments and surrounding quotes from a
+// YAML scalar.
+func unquote(s string) string {
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = s[:i]
+	}
+	return strings.Trim(strings.TrimSpace(s), `"'`)
+}
+
+// parseRuleVersion parses a version from a component rule.
+func parseRuleVersion(rule ComponentRule, s string) (version.Version, error) {
+	v, err := version.ParseVersion(s)
+	if err != nil {
+		return version.Version{}, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			fmt.Sprintf("invalid version %q in compatibility rule for %s", s, rule.Component), err)
+	}
+	return v, nil
+}
+
+// minorVersion truncates v to major.minor so that patch releases and
+// provider suffixes (e.g. "1.30.4-eks-a737599") do not affect comparisons.
+func minorVersion(v version.Version) version.Version {
+	if v.Precision < 2 {
+		return v
+	}
+	return version.Version{Major: v.Major, Minor: v.Minor, Precision: 2}
+}
+
+// Annotate records issues as constraint warnings on result. Issues already
+// present are not added again.
+func Annotate(result *recipe.RecipeResult, issues []Issue) {
+	if result == nil || len(issues) == 0 {
+		return
+	}
+
+	// Copy so results sharing the slice (e.g. shallow copies) are unaffected.
+	warnings := make([]recipe.ConstraintWarning, len(result.Metadata.ConstraintWarnings), len(result.Metadata.ConstraintWarnings)+len(issues))
+	copy(warnings, result.Metadata.ConstraintWarnings)
+
+	for _, issue := range issues {
+		w := recipe.ConstraintWarning{
+			Component:  issue.Component,
+			Constraint: KubernetesVersionConstraint,
+			Expected:   issue.Expected,
+			Actual:     issue.Actual,
+			Reason:     issue.Reason,
+		}
+		if !slices.Contains(warnings, w) {
+			warnings = append(warnings, w)
+		}
+	}
+	result.Metadata.ConstraintWarnings = warnings
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compat
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/version"
+)
+
+func TestCheckComponentRules(t *testing.T) {
+	rules := []ComponentRule{
+		{Component: "gpu-operator", MinVersion: "v25.3.0", MinKubernetes: "1.29"},
+		{Component: "gpu-operator", MaxVersion: "v25.3.0", MaxKubernetes: "1.33"},
+		{Component: "dra", MinKubernetes: "1.32", Reason: "needs DRA"},
+	}
+
+	tests := []struct {
+		name       string
+		components []recipe.ComponentRef
+		target     string
+		wantIssues int
+	}{
+		{
+			name:       "new operator on old kubernetes",
+			components: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v25.10.1"}},
+			target:     "1.28",
+			wantIssues: 1,
+		},
+		{
+			name:       "new operator on supported kubernetes",
+			components: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v25.10.1"}},
+			target:     "1.29.4",
+			wantIssues: 0,
+		},
+		{
+			name:       "old operator on too new kubernetes",
+			components: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v24.9.2"}},
+			target:     "v1.33.1-eks-a737599",
+			wantIssues: 1,
+		},
+		{
+			name:       "unversioned rule",
+			components: []recipe.ComponentRef{{Name: "dra"}},
+			target:     "1.31",
+			wantIssues: 1,
+		},
+		{
+			name:       "unparseable component version skips ranged rules",
+			components: []recipe.ComponentRef{{Name: "gpu-operator", Version: "latest"}},
+			target:     "1.20",
+			wantIssues: 0,
+		},
+		{
+			name: "disabled component ignored",
+			components: []recipe.ComponentRef{
+				{Name: "dra", Enabled: boolPtr(false)},
+			},
+			target:     "1.31",
+			wantIssues: 0,
+		},
+	}
+
+	checker := NewChecker(WithComponentRules(rules), WithRemovedAPIs(nil))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &recipe.RecipeResult{ComponentRefs: tt.components}
+			issues, err := checker.Check(result, version.MustParseVersion(tt.target))
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if len(issues) != tt.wantIssues {
+				t.Fatalf("Check() returned %d issues, want %d: %+v", len(issues), tt.wantIssues, issues)
+			}
+			for _, issue := range issues {
+				if issue.Type != IssueKubernetesVersion {
+					t.Errorf("issue type = %q, want %q", issue.Type, IssueKubernetesVersion)
+				}
+				if issue.Reason == "" {
+					t.Error("issue reason is empty")
+				}
+			}
+		})
+	}
+}
+
+func TestCheckInvalidInput(t *testing.T) {
+	checker := NewChecker()
+	if _, err := checker.Check(nil, version.MustParseVersion("1.30")); err == nil {
+		t.Error("expected error for nil result")
+	}
+	if _, err := checker.Check(&recipe.RecipeResult{}, version.Version{}); err == nil {
+		t.Error("expected error for invalid target version")
+	}
+
+	bad := NewChecker(WithComponentRules([]ComponentRule{{Component: "x", MinKubernetes: "one"}}))
+	result := &recipe.RecipeResult{ComponentRefs: []recipe.ComponentRef{{Name: "x"}}}
+	if _, err := bad.Check(result, version.MustParseVersion("1.30")); err == nil {
+		t.Error("expected error for invalid rule version")
+	}
+}
+
+func TestScanResources(t *testing.T) {
+	content := []byte(`apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+metadata:
+  name: pdb
+---
+{{- if .Values.enabled }}
+apiVersion: "batch/v1beta1" # legacy
+kind: CronJob
+spec:
+  jobTemplate:
+    apiVersion: ignored/v1
+{{- end }}
+---
+apiVersion: {{ .Values.apiVersion }}
+kind: Thing
+`)
+
+	got := scanResources(content)
+	want := []resource{
+		{apiVersion: "policy/v1beta1", kind: "PodDisruptionBudget"},
+		{apiVersion: "batch/v1beta1", kind: "CronJob"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("scanResources() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("resource[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCheckEmbeddedManifests(t *testing.T) {
+	// Embedded manifests must not use APIs removed in supported Kubernetes versions.
+	result := &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{
+				Name:          "gpu-operator",
+				ManifestFiles: []string{"components/gpu-operator/manifests/dcgm-exporter.yaml"},
+			},
+		},
+	}
+
+	checker := NewChecker(WithComponentRules(nil))
+	issues, err := checker.Check(result, version.MustParseVersion("1.33"))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+
+	result.ComponentRefs[0].ManifestFiles = []string{"components/does-not-exist.yaml"}
+	if _, err := checker.Check(result, version.MustParseVersion("1.33")); err == nil {
+		t.Error("expected error for missing manifest")
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	existing := []recipe.ConstraintWarning{{Overlay: "eks", Constraint: "OS.release.ID"}}
+	result := &recipe.RecipeResult{}
+	result.Metadata.ConstraintWarnings = existing
+
+	issue := Issue{
+		Component: "gpu-operator",
+		Type:      IssueKubernetesVersion,
+		Expected:  ">= 1.29",
+		Actual:    "1.28",
+		Reason:    "too old",
+	}
+	Annotate(result, []Issue{issue, issue})
+
+	warnings := result.Metadata.ConstraintWarnings
+	if len(warnings) != 2 {
+		t.Fatalf("got %d warnings, want 2: %+v", len(warnings), warnings)
+	}
+	w := warnings[1]
+	if w.Component != "gpu-operator" || w.Constraint != KubernetesVersionConstraint || w.Overlay != "" {
+		t.Errorf("unexpected warning: %+v", w)
+	}
+
+	Annotate(result, []Issue{issue})
+	if len(result.Metadata.ConstraintWarnings) != 2 {
+		t.Errorf("Annotate() added duplicate warning")
+	}
+	if len(existing) != 1 || cap(existing) != 1 {
+		t.Errorf("Annotate() modified the original slice")
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
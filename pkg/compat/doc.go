@@ -0,0 +1,45 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compat flags known incompatibilities between the components of a
+// recipe and a target Kubernetes version.
+//
+// Two kinds of checks are performed:
+//
+//   - Component rules: a component version range that requires a minimum
+//     (or maximum) Kubernetes version, e.g. GPU Operator v25 requires
+//     Kubernetes 1.29 or newer.
+//   - Removed APIs: manifests shipped with a component that still use an
+//     API version removed from Kubernetes, e.g. policy/v1beta1.
+//
+// # Usage
+//
+//	target, err := version.ParseVersion("1.28")
+//	if err != nil {
+//	    return err
+//	}
+//	issues, err := compat.NewChecker().Check(result, target)
+//	if err != nil {
+//	    return err
+//	}
+//	compat.Annotate(result, issues)
+//
+// Annotate records each issue as a recipe.ConstraintWarning in
+// RecipeResult.Metadata.ConstraintWarnings, so the warnings are serialized
+// with the recipe and rendered into the bundle README.
+//
+// The built-in rules are returned by DefaultComponentRules and
+// DefaultRemovedAPIs. Use WithComponentRules and WithRemovedAPIs to replace
+// them.
+package compat
@@ -0,0 +1,131 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compat
+
+// ComponentRule describes the Kubernetes versions supported by a range of
+// component versions. Empty bounds are unbounded.
+type ComponentRule struct {
+	// Component is the component name as used in recipe componentRefs.
+	Component string
+
+	// MinVersion is the first component version the rule applies to (inclusive).
+	MinVersion string
+
+	// MaxVersion is the component version the rule stops applying at (exclusive).
+	MaxVersion string
+
+	// MinKubernetes is the oldest supported Kubernetes version (inclusive).
+	MinKubernetes string
+
+	// MaxKubernetes is the first unsupported Kubernetes version (exclusive).
+	MaxKubernetes string
+
+	// Reason is a short explanation included in the reported issue.
+	Reason string
+}
+
+// RemovedAPI describes an API version that is no longer served by Kubernetes.
+type RemovedAPI struct {
+	// APIVersion is the group/version, e.g. "policy/v1beta1".
+	APIVersion string
+
+	// Kind restricts the rule to one kind. Empty matches every kind.
+	Kind string
+
+	// RemovedIn is the Kubernetes version that stopped serving the API.
+	RemovedIn string
+
+	// Replacement is the API version to migrate to, if any.
+	Replacement string
+}
+
+// DefaultComponentRules returns the built-in component compatibility rules.
+func DefaultComponentRules() []ComponentRule {
+	return []ComponentRule{
+		{
+			Component:     "gpu-operator",
+			MinVersion:    "v25.3.0",
+			MinKubernetes: "1.29",
+			Reason:        "GPU Operator v25.3 and later support Kubernetes 1.29 and newer",
+		},
+		{
+			Component:     "gpu-operator",
+			MinVersion:    "v24.9.0",
+			MaxVersion:    "v25.3.0",
+			MinKubernetes: "1.27",
+			Reason:        "GPU Operator v24.9 supports Kubernetes 1.27 and newer",
+		},
+		{
+			Component:     "nvidia-dra-driver-gpu",
+			MinKubernetes: "1.32",
+			Reason:        "the NVIDIA DRA driver requires the resource.k8s.io/v1beta1 API (Kubernetes 1.32 and newer)",
+		},
+		{
+			Component:     "cert-manager",
+			MinVersion:    "v1.17.0",
+			MinKubernetes: "1.29",
+			Reason:        "cert-manager v1.17 and later support Kubernetes 1.29 and newer",
+		},
+		{
+			Component:     "network-operator",
+			MinVersion:    "v25.1.0",
+			MinKubernetes: "1.29",
+			Reason:        "Network Operator v25.1 and later support Kubernetes 1.29 and newer",
+		},
+	}
+}
+
+// DefaultRemovedAPIs returns the built-in list of API versions removed from
+// Kubernetes, based on the upstream deprecation guide.
+func DefaultRemovedAPIs() []RemovedAPI {
+	return []RemovedAPI{
+		// Removed in 1.22
+		{APIVersion: "extensions/v1beta1", Kind: "Ingress", RemovedIn: "1.22", Replacement: "networking.k8s.io/v1"},
+		{APIVersion: "networking.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "networking.k8s.io/v1"},
+		{APIVersion: "apiextensions.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "apiextensions.k8s.io/v1"},
+		{APIVersion: "admissionregistration.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "admissionregistration.k8s.io/v1"},
+		{APIVersion: "rbac.authorization.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "rbac.authorization.k8s.io/v1"},
+		{APIVersion: "scheduling.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "scheduling.k8s.io/v1"},
+		{APIVersion: "certificates.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "certificates.k8s.io/v1"},
+		{APIVersion: "coordination.k8s.io/v1beta1", RemovedIn: "1.22", Replacement: "coordination.k8s.io/v1"},
+		{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIDriver", RemovedIn: "1.22", Replacement: "storage.k8s.io/v1"},
+		{APIVersion: "storage.k8s.io/v1beta1", Kind: "StorageClass", RemovedIn: "1.22", Replacement: "storage.k8s.io/v1"},
+
+		// Removed in 1.25
+		{APIVersion: "policy/v1beta1", Kind: "PodSecurityPolicy", RemovedIn: "1.25"},
+		{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget", RemovedIn: "1.25", Replacement: "policy/v1"},
+		{APIVersion: "batch/v1beta1", Kind: "CronJob", RemovedIn: "1.25", Replacement: "batch/v1"},
+		{APIVersion: "discovery.k8s.io/v1beta1", RemovedIn: "1.25", Replacement: "discovery.k8s.io/v1"},
+		{APIVersion: "events.k8s.io/v1beta1", RemovedIn: "1.25", Replacement: "events.k8s.io/v1"},
+		{APIVersion: "autoscaling/v2beta1", RemovedIn: "1.25", Replacement: "autoscaling/v2"},
+		{APIVersion: "node.k8s.io/v1beta1", RemovedIn: "1.25", Replacement: "node.k8s.io/v1"},
+
+		// Removed in 1.26
+		{APIVersion: "autoscaling/v2beta2", RemovedIn: "1.26", Replacement: "autoscaling/v2"},
+		{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta1", RemovedIn: "1.26", Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+
+		// Removed in 1.27
+		{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIStorageCapacity", RemovedIn: "1.27", Replacement: "storage.k8s.io/v1"},
+
+		// Removed in 1.29
+		{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta2", RemovedIn: "1.29", Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+
+		// Removed in 1.31
+		{APIVersion: "resource.k8s.io/v1alpha2", RemovedIn: "1.31", Replacement: "resource.k8s.io/v1beta1"},
+
+		// Removed in 1.32
+		{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3", RemovedIn: "1.32", Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	}
+}
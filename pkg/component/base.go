@@ -21,7 +21,6 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 	"time"
 
 	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
@@ -119,7 +118,7 @@ func (b *BaseBundler) WriteFileString(path, content string, perm os.FileMode) er
 // The template is parsed and executed with the provided data structure.
 // Returns the rendered content as a string.
 func (b *BaseBundler) RenderTemplate(tmplContent, name string, data any) (string, error) {
-	tmpl, err := template.New(name).Parse(tmplContent)
+	tmpl, err := NewTemplate(name).Parse(tmplContent)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template %s: %w", name, err)
 	}
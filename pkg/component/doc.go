@@ -41,6 +41,7 @@
 //   - Calling optional CustomManifestFunc for additional files
 //   - Generating README from templates
 //   - Computing checksums
+//   - Writing the bundle.yaml manifest listing the component and files
 //
 // # Minimal Bundler Example
 //
@@ -108,7 +109,7 @@
 //   - CreateBundleDir: Creates directory structure with proper permissions
 //   - WriteFile: Writes content with automatic directory creation
 //   - WriteFileString: Convenience wrapper for string content
-//   - RenderTemplate: Renders Go templates with error handling
+//   - RenderTemplate: Renders Go templates with TemplateFuncs and error handling
 //   - GenerateFileFromTemplate: One-step template rendering and file writing
 //   - GenerateChecksums: Creates checksums.txt with SHA256 hashes
 //   - CheckContext: Periodic context cancellation checking
@@ -123,11 +124,37 @@
 //   - GetBundlerVersion: Returns bundler version from config
 //   - GetRecipeBundlerVersion: Returns recipe version from config
 //   - MarshalYAMLWithHeader: Serializes values with component header
-//   - ApplyMapOverrides: Applies dot-notation overrides to nested maps
+//   - ApplyMapOverrides: Applies dot-notation overrides to nested maps and list items
+//   - HasMapPath: Reports whether a dot-notation path exists in nested maps and lists
 //   - ApplyNodeSelectorOverrides: Applies node selectors to Helm paths
 //   - ApplyTolerationsOverrides: Applies tolerations to Helm paths
 //   - GenerateDefaultBundleMetadata: Creates default BundleMetadata struct
 //
+// # Template Functions
+//
+// Templates rendered by RenderTemplate, GenerateFileFromTemplate and
+// TemplateRenderer, and the README and manifest templates of the helm,
+// argocd, argo-workflows and terraform deployers, are parsed with
+// NewTemplate. They can rely on:
+//
+//   - The sprig text functions, e.g. default, indent, nindent, quote, trimPrefix
+//     and semverCompare
+//   - toYaml: renders a value as YAML, without the trailing newline
+//   - imageRepository, imageTag: split an image reference like
+//     "nvcr.io/nvidia/driver:570.86" into "nvcr.io/nvidia/driver" and "570.86"
+//   - The partials componentDocs and compatibilityWarnings, rendering the
+//     README sections for RecipeResult.ComponentDocs and
+//     RecipeResult.ComponentConstraintWarnings
+//
+// For example:
+//
+//	{{ if semverCompare ">=25.3.0" .Script.HelmChartVersion }}...{{ end }}
+//	image: {{ imageRepository .Image }}
+//	{{- template "componentDocs" .Docs }}
+//
+// Other bundle templates, such as skyhook, bootstrap, runbook and secrets,
+// use plain text/template and only the functions they register themselves.
+//
 // # Default BundleMetadata
 //
 // Components using the default metadata get:
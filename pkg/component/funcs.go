@@ -0,0 +1,99 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	_ "embed"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed templates/partials.tmpl
+var partialsTemplate string
+
+// TemplateFuncs returns the functions available to bundle templates: the
+// sprig text functions (e.g. default, indent, nindent, semverCompare, trimPrefix)
+// plus the domain helpers toYaml, imageRepository and imageTag.
+//
+// A new map is returned on every call, so callers may add or override
+// functions.
+func TemplateFuncs() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	funcs["toYaml"] = toYAML
+	funcs["imageRepository"] = imageRepository
+	funcs["imageTag"] = imageTag
+	return funcs
+}
+
+// NewTemplate returns a template with TemplateFuncs and the shared partial
+// templates defined, ready to parse a bundle template into:
+//
+//	tmpl, err := component.NewTemplate("README.md").Parse(readmeTemplate)
+//
+// The partials render the README sections common to all bundles:
+//
+//	{{- template "componentDocs" .Docs }}
+//	{{- template "compatibilityWarnings" .CompatibilityWarnings }}
+func NewTemplate(name string) *template.Template {
+	return template.Must(template.New(name).Funcs(TemplateFuncs()).Parse(partialsTemplate))
+}
+
+// toYAML renders v as YAML indented by two spaces, without the trailing
+// newline, like the Helm function of the same name. It renders an empty
+// string if v cannot be marshaled.
+func toYAML(v any) string {
+	var buf strings.Builder
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return ""
+	}
+	if err := encoder.Close(); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// splitImage splits an image reference into its repository, tag and digest.
+// The registry host may carry a port, so only a colon after the last slash
+// starts the tag.
+func splitImage(ref string) (repository, tag, digest string) {
+	repository, digest, _ = strings.Cut(ref, "@")
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	return repository, tag, digest
+}
+
+// imageRepository returns the repository of an image reference, without its
+// tag or digest: "nvcr.io/nvidia/driver:570.86" is "nvcr.io/nvidia/driver".
+func imageRepository(ref string) string {
+	repository, _, _ := splitImage(ref)
+	return repository
+}
+
+// imageTag returns the tag of an image reference: "nvcr.io/nvidia/driver:570.86"
+// is "570.86". References without a tag or digest are "latest"; references
+// pinned only by digest have no tag.
+func imageTag(ref string) string {
+	_, tag, digest := splitImage(ref)
+	if tag == "" && digest == "" {
+		return "latest"
+	}
+	return tag
+}
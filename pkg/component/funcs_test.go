@@ -0,0 +1,137 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func TestImageHelpers(t *testing.T) {
+	tests := []struct {
+		ref            string
+		wantRepository string
+		wantTag        string
+	}{
+		{"nvcr.io/nvidia/driver:570.86", "nvcr.io/nvidia/driver", "570.86"},
+		{"nginx", "nginx", "latest"},
+		{"localhost:5000/nvidia/driver:v1", "localhost:5000/nvidia/driver", "v1"},
+		{"localhost:5000/nvidia/driver", "localhost:5000/nvidia/driver", "latest"},
+		{"nvcr.io/nvidia/driver:570.86@sha256:abc", "nvcr.io/nvidia/driver", "570.86"},
+		{"nvcr.io/nvidia/driver@sha256:abc", "nvcr.io/nvidia/driver", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			if got := imageRepository(tt.ref); got != tt.wantRepository {
+				t.Errorf("imageRepository(%q) = %q, want %q", tt.ref, got, tt.wantRepository)
+			}
+			if got := imageTag(tt.ref); got != tt.wantTag {
+				t.Errorf("imageTag(%q) = %q, want %q", tt.ref, got, tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestNewTemplate_Funcs(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+		data any
+		want string
+	}{
+		{
+			name: "toYaml",
+			tmpl: "values:\n  {{- toYaml .Values | nindent 2 }}",
+			data: map[string]any{"Values": map[string]any{"driver": map[string]any{"enabled": true}}},
+			want: "values:\n  driver:\n    enabled: true",
+		},
+		{
+			name: "semverCompare",
+			tmpl: `{{ if semverCompare ">=25.3.0" .Version }}new{{ else }}old{{ end }}`,
+			data: map[string]any{"Version": "v25.10.1"},
+			want: "new",
+		},
+		{
+			name: "image",
+			tmpl: "{{ imageRepository .Image }} {{ imageTag .Image }}",
+			data: map[string]any{"Image": "nvcr.io/nvidia/driver:570.86"},
+			want: "nvcr.io/nvidia/driver 570.86",
+		},
+		{
+			name: "sprig default",
+			tmpl: `{{ .Namespace | default "nvidia-system" }}`,
+			data: map[string]any{"Namespace": ""},
+			want: "nvidia-system",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := NewTemplate(tt.name).Parse(tt.tmpl)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			var b strings.Builder
+			if err := tmpl.Execute(&b, tt.data); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("rendered %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTemplate_Partials(t *testing.T) {
+	tmpl, err := NewTemplate("README.md").Parse(`# Bundle
+{{- template "componentDocs" .Docs }}
+{{- template "compatibilityWarnings" .Warnings }}
+`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var empty strings.Builder
+	if err := tmpl.Execute(&empty, map[string]any{"Docs": nil, "Warnings": nil}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if empty.String() != "# Bundle\n" {
+		t.Errorf("rendered %q without docs or warnings, want only the heading", empty.String())
+	}
+
+	var b strings.Builder
+	err = tmpl.Execute(&b, map[string]any{
+		"Docs": []recipe.ComponentDocs{
+			{Name: "gpu-operator", Version: "v25.3.3", DocsURL: "https://docs.example.com/gpu-operator"},
+		},
+		"Warnings": []recipe.ConstraintWarning{
+			{Component: "gpu-operator", Expected: ">= 1.29", Actual: "1.27", Reason: "requires CDI"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	for _, want := range []string{
+		"\n\n## Documentation\n",
+		"| gpu-operator | v25.3.3 | [docs](https://docs.example.com/gpu-operator) |  |",
+		"may not work on Kubernetes 1.27:",
+		"| gpu-operator | >= 1.29 | requires CDI |",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("rendered README missing %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/NVIDIA/eidos/pkg/bundler/manifest"
 	"github.com/NVIDIA/eidos/pkg/bundler/result"
 	"github.com/NVIDIA/eidos/pkg/errors"
 	"github.com/NVIDIA/eidos/pkg/recipe"
@@ -196,7 +197,8 @@ func enrichConfigFromRegistry(cfg *ComponentConfig) {
 
 // MakeBundle generates a bundle using the generic bundling logic.
 // This function handles the common steps: creating directories, applying overrides,
-// writing values.yaml, generating README, generating checksums, and finalizing.
+// writing values.yaml, generating README, generating checksums, writing the
+// bundle.yaml manifest, and finalizing.
 // Configuration is enriched from the component registry when values are not
 // explicitly set in the ComponentConfig.
 func MakeBundle(ctx context.Context, b *BaseBundler, input recipe.RecipeInput, outputDir string, cfg ComponentConfig) (*result.Result, error) {
@@ -332,6 +334,12 @@ func MakeBundle(ctx context.Context, b *BaseBundler, input recipe.RecipeInput, o
 		}
 	}
 
+	// Write the bundle manifest last so its inventory covers checksums.txt
+	if err := writeManifest(ctx, b, componentRef, configMap, cfg, dirs.Root); err != nil {
+		return b.Result, errors.Wrap(errors.ErrCodeInternal,
+			"failed to write bundle manifest", err)
+	}
+
 	// Finalize bundle generation
 	b.Finalize(start)
 
@@ -345,6 +353,31 @@ func MakeBundle(ctx context.Context, b *BaseBundler, input recipe.RecipeInput, o
 	return b.Result, nil
 }
 
+// writeManifest writes bundle.yaml into the component bundle directory,
+// listing the component and the files written so far.
+func writeManifest(ctx context.Context, b *BaseBundler, ref *recipe.ComponentRef, configMap map[string]string, cfg ComponentConfig, bundleDir string) error {
+	m := &manifest.Manifest{
+		GeneratedAt: time.Now().UTC(),
+		Generator:   manifest.Generator{Version: configMap["bundler_version"]},
+		Recipe:      manifest.Recipe{Version: configMap["recipe_version"]},
+		Components: []manifest.Component{{
+			Name:      cfg.Name,
+			Type:      string(ref.Type),
+			Namespace: configMap["namespace"],
+			Chart:     cfg.DefaultHelmChart,
+			Version:   ref.Version,
+			Source:    ref.Source,
+		}},
+	}
+
+	path, size, err := m.Write(ctx, bundleDir, b.Result.Files)
+	if err != nil {
+		return err
+	}
+	b.Result.AddFile(path, size)
+	return nil
+}
+
 // getValueOverridesForComponent retrieves value overrides for a component from config.
 // It checks the component name first, then any alternative keys specified in the config.
 func getValueOverridesForComponent(b *BaseBundler, cfg ComponentConfig) map[string]string {
@@ -15,9 +15,13 @@
 package component
 
 import (
+	"context"
+	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/manifest"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 )
 
@@ -368,3 +372,56 @@ func TestGetValueOverridesForComponent(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeBundle_WritesManifest(t *testing.T) {
+	input := &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "my-operator", Version: "v1.2.0", Type: "helm", Source: "https://charts.example.com"},
+		},
+	}
+	cfg := ComponentConfig{
+		Name:             "my-operator",
+		DisplayName:      "My Operator",
+		DefaultHelmChart: "example/my-operator",
+	}
+	b := NewBaseBundler(config.NewConfig(config.WithVersion("v1.0.0")), "my-operator")
+
+	dir := t.TempDir()
+	res, err := MakeBundle(context.Background(), b, input, dir, cfg)
+	if err != nil {
+		t.Fatalf("MakeBundle() error = %v", err)
+	}
+
+	bundleDir := filepath.Join(dir, "my-operator")
+	if !slices.Contains(res.Files, filepath.Join(bundleDir, manifest.FileName)) {
+		t.Errorf("result files %v do not include the manifest", res.Files)
+	}
+	m, err := manifest.Load(bundleDir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := manifest.Component{
+		Name:      "my-operator",
+		Type:      "helm",
+		Namespace: "my-operator",
+		Chart:     "example/my-operator",
+		Version:   "v1.2.0",
+		Source:    "https://charts.example.com",
+	}
+	if len(m.Components) != 1 || m.Components[0] != want {
+		t.Errorf("Components = %+v, want %+v", m.Components, want)
+	}
+	if m.Generator.Version != "v1.0.0" {
+		t.Errorf("Generator.Version = %q", m.Generator.Version)
+	}
+
+	var paths []string
+	for _, f := range m.Files {
+		paths = append(paths, f.Path)
+	}
+	for _, name := range []string{"values.yaml", "checksums.txt"} {
+		if !slices.Contains(paths, name) {
+			t.Errorf("Files %v missing %s", paths, name)
+		}
+	}
+}
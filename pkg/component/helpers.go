@@ -28,6 +28,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/NVIDIA/eidos/pkg/bundler/result"
+	"github.com/NVIDIA/eidos/pkg/errors"
 )
 
 // TemplateRenderer provides template rendering functionality for bundlers.
@@ -50,7 +51,7 @@ func (r *TemplateRenderer) Render(name string, data map[string]any) (string, err
 		return "", fmt.Errorf("template %s not found", name)
 	}
 
-	tmpl, err := template.New(name).Parse(tmplContent)
+	tmpl, err := NewTemplate(name).Parse(tmplContent)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template %s: %w", name, err)
 	}
@@ -63,6 +64,28 @@ func (r *TemplateRenderer) Render(name string, data map[string]any) (string, err
 	return buf.String(), nil
 }
 
+// WriteTemplate renders tmplContent with data into dir/name and returns the
+// path and size of the written file.
+func WriteTemplate(tmplContent, name, dir string, data any, perm os.FileMode) (string, int64, error) {
+	tmpl, err := template.New(name).Parse(tmplContent)
+	if err != nil {
+		return "", 0, errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to parse %s template", name), err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", 0, errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to render %s", name), err)
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, buf.Bytes(), perm); err != nil {
+		return "", 0, errors.Wrap(errors.ErrCodeInternal,
+			fmt.Sprintf("failed to write %s", name), err)
+	}
+	return path, int64(buf.Len()), nil
+}
+
 // FileWriter provides file writing functionality with result tracking.
 type FileWriter struct {
 	result *result.Result
@@ -74,6 +74,39 @@ func TestTemplateRenderer_Render(t *testing.T) {
 	}
 }
 
+func TestWriteTemplate(t *testing.T) {
+	dir := t.TempDir()
+
+	path, size, err := WriteTemplate("Hello {{.Name}}!\n", "hello.txt", dir, map[string]any{"Name": "World"}, 0600)
+	if err != nil {
+		t.Fatalf("WriteTemplate() error = %v", err)
+	}
+	if path != filepath.Join(dir, "hello.txt") {
+		t.Errorf("WriteTemplate() path = %q", path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "Hello World!\n" || size != int64(len(content)) {
+		t.Errorf("WriteTemplate() wrote %q (size %d)", content, size)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("WriteTemplate() mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	if _, _, err := WriteTemplate("{{.Name", "bad.txt", dir, nil, 0600); err == nil {
+		t.Error("WriteTemplate() expected parse error")
+	}
+	if _, _, err := WriteTemplate("{{.Name}}", "missing.txt", filepath.Join(dir, "missing"), map[string]any{}, 0600); err == nil {
+		t.Error("WriteTemplate() expected write error")
+	}
+}
+
 func TestDirectoryManager_CreateDirectories(t *testing.T) {
 	tmpDir := t.TempDir()
 	manager := NewDirectoryManager()
@@ -68,6 +68,10 @@ func ApplyValueOverrides(target any, overrides map[string]string) error {
 
 // ApplyMapOverrides applies overrides to a map[string]any using dot-notation paths.
 // Handles nested maps by traversing the path segments and creating nested maps as needed.
+// A segment can select a list item by index, as in "tolerations[0].effect",
+// where the index one past the end appends an item, or by a key field, as in
+// "env[name=LOG_LEVEL].value", which updates the item with that key or
+// appends one, so other list items are kept.
 // Useful for applying --set flag overrides to values.yaml content.
 func ApplyMapOverrides(target map[string]any, overrides map[string]string) error {
 	if target == nil {
@@ -92,9 +96,132 @@ func ApplyMapOverrides(target map[string]any, overrides map[string]string) error
 	return nil
 }
 
+// HasMapPath reports whether a dot-notation path names an existing key of
+// target. Useful for telling --set overrides that replace a value from those
+// that add a new key.
+func HasMapPath(target map[string]any, path string) bool {
+	segments, err := splitMapPath(path)
+	if err != nil {
+		return false
+	}
+
+	var current any = target
+	for _, seg := range segments {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return false
+		}
+		if current, ok = m[seg.key]; !ok {
+			return false
+		}
+		if seg.hasItem {
+			list, ok := current.([]any)
+			if !ok {
+				return false
+			}
+			i := seg.find(list)
+			if i < 0 || i >= len(list) {
+				return false
+			}
+			current = list[i]
+		}
+	}
+	return true
+}
+
+// mapPathSegment is a segment of a dot-notation values path: a map key,
+// optionally followed by a list item selector.
+type mapPathSegment struct {
+	key string
+
+	// hasItem is set when the segment selects an item of the list at key,
+	// by field value when field is set and by index otherwise.
+	hasItem bool
+	index   int
+	field   string
+	value   string
+}
+
+// find returns the index of the list item seg selects, len(list) for an
+// item to append, or -1 when an index is out of range.
+func (seg mapPathSegment) find(list []any) int {
+	if seg.field == "" {
+		if seg.index > len(list) {
+			return -1
+		}
+		return seg.index
+	}
+	for i, item := range list {
+		if m, ok := item.(map[string]any); ok && fmt.Sprint(m[seg.field]) == seg.value {
+			return i
+		}
+	}
+	return len(list)
+}
+
+// splitMapPath splits a dot-notation path into segments. Segments may end
+// in a list item selector, "[<index>]" or "[<field>=<value>]"; dots inside
+// selectors, as in "tolerations[key=nvidia.com/gpu]", do not split the path.
+func splitMapPath(path string) ([]mapPathSegment, error) {
+	var segments []mapPathSegment
+	for len(path) > 0 {
+		end := strings.IndexAny(path, ".[")
+		if end < 0 {
+			end = len(path)
+		}
+		seg := mapPathSegment{key: path[:end]}
+		if seg.key == "" {
+			return nil, fmt.Errorf("empty path segment")
+		}
+		path = path[end:]
+
+		if strings.HasPrefix(path, "[") {
+			closing := strings.Index(path, "]")
+			if closing < 0 {
+				return nil, fmt.Errorf("unclosed list selector in %q", seg.key+path)
+			}
+			selector := path[1:closing]
+			path = path[closing+1:]
+			seg.hasItem = true
+			if field, value, ok := strings.Cut(selector, "="); ok {
+				if field == "" {
+					return nil, fmt.Errorf("list selector %q has no field", selector)
+				}
+				seg.field, seg.value = field, value
+			} else {
+				index, err := strconv.Atoi(selector)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("list selector %q must be an index or field=value", selector)
+				}
+				seg.index = index
+			}
+		}
+
+		segments = append(segments, seg)
+		switch {
+		case path == "":
+		case strings.HasPrefix(path, "."):
+			path = path[1:]
+			if path == "" {
+				return nil, fmt.Errorf("empty path segment")
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q after list selector", path)
+		}
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("empty path")
+	}
+	return segments, nil
+}
+
 // setMapValueByPath sets a value in a nested map using dot-notation path.
 // Creates nested maps as needed. Converts string values to bools when appropriate.
 func setMapValueByPath(target map[string]any, path, value string) error {
+	if strings.Contains(path, "[") {
+		return setMapValueBySegments(target, path, value)
+	}
+
 	parts := strings.Split(path, ".")
 	current := target
 
@@ -125,6 +252,73 @@ func setMapValueByPath(target map[string]any, path, value string) error {
 	return nil
 }
 
+// setMapValueBySegments sets a value at a path with list item selectors,
+// creating maps, lists and list items as needed.
+func setMapValueBySegments(target map[string]any, path, value string) error {
+	segments, err := splitMapPath(path)
+	if err != nil {
+		return err
+	}
+
+	current := target
+	for i, seg := range segments {
+		last := i == len(segments)-1
+		if !seg.hasItem {
+			if last {
+				current[seg.key] = convertMapValue(value)
+				return nil
+			}
+			next, exists := current[seg.key]
+			if !exists {
+				next = make(map[string]any)
+				current[seg.key] = next
+			}
+			nextMap, ok := next.(map[string]any)
+			if !ok {
+				return fmt.Errorf("path segment %q exists but is not a map (type: %T)", seg.key, next)
+			}
+			current = nextMap
+			continue
+		}
+
+		list, ok := current[seg.key].([]any)
+		if !ok && current[seg.key] != nil {
+			return fmt.Errorf("path segment %q exists but is not a list (type: %T)", seg.key, current[seg.key])
+		}
+		index := seg.find(list)
+		if index < 0 {
+			return fmt.Errorf("list index %d of %q is out of range (length %d)", seg.index, seg.key, len(list))
+		}
+
+		if last {
+			if seg.field != "" {
+				return fmt.Errorf("path must select a field of the %q item [%s=%s]", seg.key, seg.field, seg.value)
+			}
+			if index == len(list) {
+				list = append(list, nil)
+			}
+			list[index] = convertMapValue(value)
+			current[seg.key] = list
+			return nil
+		}
+
+		if index == len(list) {
+			item := make(map[string]any)
+			if seg.field != "" {
+				item[seg.field] = seg.value
+			}
+			list = append(list, item)
+			current[seg.key] = list
+		}
+		item, ok := list[index].(map[string]any)
+		if !ok {
+			return fmt.Errorf("item %d of %q is not a map (type: %T)", index, seg.key, list[index])
+		}
+		current = item
+	}
+	return nil
+}
+
 // convertMapValue converts a string value to an appropriate Go type.
 // Handles bools ("true"/"false") and numbers.
 func convertMapValue(value string) any {
@@ -642,6 +836,73 @@ func setTolerationsAtPath(values map[string]any, tolerations []map[string]any, p
 	current[lastPart] = tolInterface
 }
 
+// ApplyEnvOverrides merges env vars into the env var lists of a values map.
+// Env vars replace existing entries with the same name and are appended
+// otherwise, so values the chart or recipe already set are kept.
+// The function applies to the specified paths in the values map (e.g., "driver.env", "extraEnv").
+func ApplyEnvOverrides(values map[string]any, env []corev1.EnvVar, paths ...string) {
+	if len(env) == 0 {
+		return
+	}
+
+	entries := make([]map[string]any, 0, len(env))
+	for _, e := range env {
+		entries = append(entries, map[string]any{
+			"name":  e.Name,
+			"value": e.Value,
+		})
+	}
+	ApplyNamedListOverrides(values, entries, paths...)
+}
+
+// ApplyNamedListOverrides merges entries into the lists at the specified
+// dot-notation paths of a values map, such as env vars, volumes or volume
+// mounts. An entry replaces an existing one with the same "name" and is
+// appended otherwise. Missing lists are created.
+func ApplyNamedListOverrides(values map[string]any, entries []map[string]any, paths ...string) {
+	if len(entries) == 0 || values == nil {
+		return
+	}
+
+	for _, path := range paths {
+		parent, key := parentAtPath(values, path)
+		existing, _ := parent[key].([]any)
+
+		merged := make([]any, 0, len(existing)+len(entries))
+		merged = append(merged, existing...)
+		for _, entry := range entries {
+			replaced := false
+			for i, item := range merged {
+				if itemMap, ok := item.(map[string]any); ok && itemMap["name"] == entry["name"] {
+					merged[i] = entry
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				merged = append(merged, entry)
+			}
+		}
+		parent[key] = merged
+	}
+}
+
+// parentAtPath returns the map holding the last field of a dot-notation path
+// and the field name, creating intermediate maps as needed.
+func parentAtPath(values map[string]any, path string) (map[string]any, string) {
+	parts := strings.Split(path, ".")
+	current := values
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[part] = next
+		}
+		current = next
+	}
+	return current, parts[len(parts)-1]
+}
+
 // TolerationsToPodSpec converts a slice of corev1.Toleration to a YAML-friendly format.
 // This format matches what Kubernetes expects in pod specs and Helm values.
 func TolerationsToPodSpec(tolerations []corev1.Toleration) []map[string]any {
@@ -15,6 +15,7 @@
 package component
 
 import (
+	"reflect"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -653,6 +654,61 @@ func TestApplyTolerationsOverrides(t *testing.T) {
 	}
 }
 
+func TestApplyEnvOverrides(t *testing.T) {
+	env := []corev1.EnvVar{
+		{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+		{Name: "NO_PROXY", Value: ".svc"},
+	}
+
+	t.Run("creates missing lists", func(t *testing.T) {
+		values := map[string]any{}
+		ApplyEnvOverrides(values, env, "driver.env", "extraEnv")
+
+		for _, list := range []any{values["driver"].(map[string]any)["env"], values["extraEnv"]} {
+			entries, ok := list.([]any)
+			if !ok || len(entries) != 2 {
+				t.Fatalf("env = %v, want 2 entries", list)
+			}
+			first := entries[0].(map[string]any)
+			if first["name"] != "HTTPS_PROXY" || first["value"] != "http://proxy.example.com:3128" {
+				t.Errorf("env[0] = %v", first)
+			}
+		}
+	})
+
+	t.Run("keeps and replaces existing entries", func(t *testing.T) {
+		values := map[string]any{
+			"env": []any{
+				map[string]any{"name": "DEVICE_LIST_STRATEGY", "value": "volume-mounts"},
+				map[string]any{"name": "NO_PROXY", "value": "old"},
+			},
+		}
+		ApplyEnvOverrides(values, env, "env")
+
+		entries := values["env"].([]any)
+		if len(entries) != 3 {
+			t.Fatalf("expected 3 env vars, got %d: %v", len(entries), entries)
+		}
+		if entries[0].(map[string]any)["name"] != "DEVICE_LIST_STRATEGY" {
+			t.Errorf("existing env var not kept first: %v", entries[0])
+		}
+		if got := entries[1].(map[string]any)["value"]; got != ".svc" {
+			t.Errorf("NO_PROXY = %v, want .svc", got)
+		}
+		if entries[2].(map[string]any)["name"] != "HTTPS_PROXY" {
+			t.Errorf("new env var not appended: %v", entries[2])
+		}
+	})
+
+	t.Run("no env is a no-op", func(t *testing.T) {
+		values := map[string]any{}
+		ApplyEnvOverrides(values, nil, "env")
+		if len(values) != 0 {
+			t.Errorf("values = %v, want empty", values)
+		}
+	})
+}
+
 func TestTolerationsToPodSpec(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -821,6 +877,96 @@ func TestApplyMapOverrides(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "updates list item by key field",
+			target: map[string]any{
+				"env": []any{
+					map[string]any{"name": "A", "value": "1"},
+					map[string]any{"name": "B", "value": "2"},
+				},
+			},
+			overrides: map[string]string{
+				"env[name=B].value": "3",
+			},
+			verify: func(t *testing.T, target map[string]any) {
+				want := []any{
+					map[string]any{"name": "A", "value": "1"},
+					map[string]any{"name": "B", "value": int64(3)},
+				}
+				if !reflect.DeepEqual(target["env"], want) {
+					t.Errorf("env = %v, want %v", target["env"], want)
+				}
+			},
+		},
+		{
+			name: "appends list item by key field",
+			target: map[string]any{
+				"daemonsets": map[string]any{
+					"tolerations": []any{map[string]any{"operator": "Exists"}},
+				},
+			},
+			overrides: map[string]string{
+				"daemonsets.tolerations[key=nvidia.com/gpu].effect": "NoSchedule",
+			},
+			verify: func(t *testing.T, target map[string]any) {
+				want := []any{
+					map[string]any{"operator": "Exists"},
+					map[string]any{"key": "nvidia.com/gpu", "effect": "NoSchedule"},
+				}
+				got := target["daemonsets"].(map[string]any)["tolerations"]
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("daemonsets.tolerations = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "sets and appends list items by index",
+			target: map[string]any{
+				"args": []any{"--a"},
+			},
+			overrides: map[string]string{
+				"args[0]": "--b",
+				"args[1]": "--c",
+			},
+			verify: func(t *testing.T, target map[string]any) {
+				want := []any{"--b", "--c"}
+				if !reflect.DeepEqual(target["args"], want) {
+					t.Errorf("args = %v, want %v", target["args"], want)
+				}
+			},
+		},
+		{
+			name:   "creates list",
+			target: map[string]any{},
+			overrides: map[string]string{
+				"driver.env[name=A].value": "x",
+			},
+			verify: func(t *testing.T, target map[string]any) {
+				want := []any{map[string]any{"name": "A", "value": "x"}}
+				got := target["driver"].(map[string]any)["env"]
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("driver.env = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:      "list index out of range",
+			target:    map[string]any{"args": []any{"--a"}},
+			overrides: map[string]string{"args[2]": "--b"},
+			wantErr:   true,
+		},
+		{
+			name:      "key selector without field",
+			target:    map[string]any{},
+			overrides: map[string]string{"env[name=A]": "x"},
+			wantErr:   true,
+		},
+		{
+			name:      "selector on a map",
+			target:    map[string]any{"env": map[string]any{}},
+			overrides: map[string]string{"env[0].value": "x"},
+			wantErr:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -837,6 +983,40 @@ func TestApplyMapOverrides(t *testing.T) {
 	}
 }
 
+func TestHasMapPath(t *testing.T) {
+	target := map[string]any{
+		"driver": map[string]any{"version": "570", "repository": nil},
+		"gds":    "disabled",
+		"env":    []any{map[string]any{"name": "A", "value": "1"}},
+	}
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"driver", true},
+		{"driver.version", true},
+		{"driver.repository", true},
+		{"driver.enabled", false},
+		{"gds.enabled", false},
+		{"toolkit.enabled", false},
+		{"driver.version.major", false},
+		{"env[name=A].value", true},
+		{"env[name=B].value", false},
+		{"env[0].name", true},
+		{"env[1]", false},
+		{"driver[0]", false},
+		{"env[", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := HasMapPath(target, tt.path); got != tt.want {
+				t.Errorf("HasMapPath(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConvertMapValue(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,283 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/validator"
+	eidosversion "github.com/NVIDIA/eidos/pkg/version"
+)
+
+const (
+	// APIVersion is the API version for conformance results.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+
+	// DefaultJobNamespace is the namespace workload Jobs run in.
+	DefaultJobNamespace = "default"
+
+	// DefaultTimeout bounds each workload Job.
+	DefaultTimeout = 15 * time.Minute
+
+	// DefaultNCCLImage provides the nccl-tests all_reduce_perf binary. It is
+	// the NVIDIA HPC-Benchmarks image from NGC, pinned by digest so every run
+	// executes the same binary; refresh the tag and digest together with
+	// `crane digest nvcr.io/nvidia/hpc-benchmarks:<tag>`. Override it with
+	// WithNCCLImage (--nccl-image).
+	DefaultNCCLImage = "nvcr.io/nvidia/hpc-benchmarks:25.04@sha256:5f2a1d2c9f8e3b7a4c6d0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d"
+
+	// DefaultDCGMImage provides dcgmi and nv-hostengine.
+	DefaultDCGMImage = "nvcr.io/nvidia/cloud-native/dcgm:4.2.3-1-ubuntu22.04"
+
+	// defaultPollInterval is how often workload Job status is read.
+	defaultPollInterval = 5 * time.Second
+)
+
+// Runner checks a deployed stack against a reference architecture profile.
+type Runner struct {
+	// Version is the runner version (typically the CLI version).
+	Version string
+
+	// Namespace overrides the component namespace for health checks.
+	Namespace string
+
+	// JobNamespace is the namespace workload Jobs run in.
+	JobNamespace string
+
+	// Workloads enables the fabric manager, NCCL and DCGM Jobs.
+	// When disabled, those checks are reported as skipped.
+	Workloads bool
+
+	// Timeout bounds each workload Job.
+	Timeout time.Duration
+
+	// NCCLImage is the container image for the NCCL bandwidth test.
+	NCCLImage string
+
+	// DCGMImage is the container image for the fabric manager and DCGM checks.
+	DCGMImage string
+
+	client       kubernetes.Interface
+	dynamic      dynamic.Interface
+	pollInterval time.Duration
+
+	// jobLogs reads the logs of a finished Job; replaced in tests because
+	// the fake clientset cannot serve real pod logs.
+	jobLogs func(ctx context.Context, namespace, name string) (string, error)
+}
+
+// Option is a functional option for configuring Runner instances.
+type Option func(*Runner)
+
+// WithVersion returns an Option that sets the Runner version string.
+func WithVersion(version string) Option {
+	return func(r *Runner) {
+		r.Version = version
+	}
+}
+
+// WithNamespace returns an Option that looks for every component's resources
+// in namespace instead of its component namespace.
+func WithNamespace(namespace string) Option {
+	return func(r *Runner) {
+		r.Namespace = namespace
+	}
+}
+
+// WithJobNamespace returns an Option that sets the namespace workload Jobs run in.
+func WithJobNamespace(namespace string) Option {
+	return func(r *Runner) {
+		r.JobNamespace = namespace
+	}
+}
+
+// WithWorkloads returns an Option that enables or disables the workload Jobs.
+func WithWorkloads(enabled bool) Option {
+	return func(r *Runner) {
+		r.Workloads = enabled
+	}
+}
+
+// WithTimeout returns an Option that bounds each workload Job.
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *Runner) {
+		r.Timeout = timeout
+	}
+}
+
+// WithNCCLImage returns an Option that sets the NCCL test image.
+func WithNCCLImage(image string) Option {
+	return func(r *Runner) {
+		r.NCCLImage = image
+	}
+}
+
+// WithDCGMImage returns an Option that sets the DCGM image.
+func WithDCGMImage(image string) Option {
+	return func(r *Runner) {
+		r.DCGMImage = image
+	}
+}
+
+// New creates a new Runner using the given typed and dynamic clients.
+// Workload Jobs are enabled by default.
+func New(client kubernetes.Interface, dyn dynamic.Interface, opts ...Option) *Runner {
+	r := &Runner{
+		JobNamespace: DefaultJobNamespace,
+		Workloads:    true,
+		Timeout:      DefaultTimeout,
+		NCCLImage:    DefaultNCCLImage,
+		DCGMImage:    DefaultDCGMImage,
+		client:       client,
+		dynamic:      dyn,
+		pollInterval: defaultPollInterval,
+	}
+	r.jobLogs = r.podLogs
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run checks the stack deployed from rec against profile and returns a
+// scored report. Recipe constraints are validated when snap is not nil.
+func (r *Runner) Run(ctx context.Context, profile Profile, rec *recipe.RecipeResult, snap *snapshotter.Snapshot) (*Result, error) {
+	start := time.Now()
+
+	if rec == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe cannot be nil")
+	}
+	if r.client == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "kubernetes client cannot be nil")
+	}
+
+	result := NewResult()
+	result.Init(header.KindConformanceResult, APIVersion, r.Version)
+	result.Profile = profile.Name
+
+	for _, cr := range checkComponents(profile, rec) {
+		result.add(CategoryComponents, cr)
+	}
+
+	checker := healthcheck.New(r.client, r.dynamic,
+		healthcheck.WithVersion(r.Version),
+		healthcheck.WithNamespace(r.Namespace),
+	)
+	health, err := checker.Check(ctx, rec)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "health check failed", err)
+	}
+	for _, cr := range health.Results {
+		result.add(CategoryHealth, cr)
+	}
+
+	if snap != nil {
+		v := validator.New(validator.WithVersion(r.Version))
+		validation, err := v.Validate(ctx, rec, snap)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrCodeInternal, "validation failed", err)
+		}
+		for _, cv := range validation.Results {
+			result.add(CategoryValidation, healthcheck.CheckResult{
+				Name:     cv.Name,
+				Expected: cv.Expected,
+				Actual:   cv.Actual,
+				Status:   healthcheck.CheckStatus(cv.Status),
+				Message:  cv.Message,
+			})
+		}
+	}
+
+	if profile.FabricManager {
+		result.add(CategoryFabric, r.checkFabric(ctx, profile))
+	}
+	result.add(CategoryNCCL, r.checkNCCL(ctx, profile))
+	result.add(CategoryDCGM, r.checkDCGM(ctx, profile))
+
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeTimeout, "conformance check cancelled", err)
+	}
+
+	result.summarize()
+	result.Summary.Duration = time.Since(start)
+
+	slog.Debug("conformance check completed",
+		"profile", profile.Name,
+		"score", result.Summary.Score,
+		"passed", result.Summary.Passed,
+		"failed", result.Summary.Failed,
+		"skipped", result.Summary.Skipped,
+		"status", result.Summary.Status,
+		"duration", result.Summary.Duration)
+
+	return result, nil
+}
+
+// checkComponents verifies that the recipe deploys every component the
+// profile requires, at a supported version.
+func checkComponents(profile Profile, rec *recipe.RecipeResult) []healthcheck.CheckResult {
+	results := make([]healthcheck.CheckResult, 0, len(profile.Components))
+	for _, req := range profile.Components {
+		cr := healthcheck.CheckResult{
+			Name:      "components." + req.Name + ".version",
+			Component: req.Name,
+			Expected:  ">= " + req.MinVersion,
+		}
+
+		ref := rec.GetComponentRef(req.Name)
+		switch {
+		case ref == nil:
+			cr.Actual = "missing"
+			cr.Status = healthcheck.CheckStatusFailed
+			cr.Message = fmt.Sprintf("profile %s requires component %s", profile.Name, req.Name)
+		case !ref.IsEnabled():
+			cr.Actual = "disabled"
+			cr.Status = healthcheck.CheckStatusFailed
+			cr.Message = fmt.Sprintf("profile %s requires component %s, which the recipe disables", profile.Name, req.Name)
+		default:
+			cr.Actual = ref.Version
+			cr.Status, cr.Message = compareVersion(ref.Version, req.MinVersion)
+		}
+		results = append(results, cr)
+	}
+	return results
+}
+
+// compareVersion reports whether actual is at least minimum.
+func compareVersion(actual, minimum string) (healthcheck.CheckStatus, string) {
+	want, err := eidosversion.ParseVersion(minimum)
+	if err != nil {
+		return healthcheck.CheckStatusSkipped, fmt.Sprintf("invalid minimum version %q: %v", minimum, err)
+	}
+	got, err := eidosversion.ParseVersion(actual)
+	if err != nil {
+		return healthcheck.CheckStatusSkipped, fmt.Sprintf("cannot parse version %q: %v", actual, err)
+	}
+	if !got.EqualsOrNewer(want) {
+		return healthcheck.CheckStatusFailed, fmt.Sprintf("version %s is older than %s", actual, minimum)
+	}
+	return healthcheck.CheckStatusPassed, ""
+}
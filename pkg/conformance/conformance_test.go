@@ -0,0 +1,334 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const fabricOutput = `==============NVSMI LOG==============
+
+GPU 00000000:18:00.0
+    Product Name                          : NVIDIA H100 80GB HBM3
+    Fabric
+        State                             : Completed
+        Status                            : Success
+    Processes                             : None
+
+GPU 00000000:2A:00.0
+    Product Name                          : NVIDIA H100 80GB HBM3
+    Fabric
+        State                             : In Progress
+        Status                            : N/A
+    Processes                             : None
+`
+
+const ncclOutput = `#       size         count      type   redop    root     time   algbw   busbw #wrong
+  1073741824     268435456     float     sum      -1   4523.1  237.39  415.43      0
+  4294967296    1073741824     float     sum      -1    17830  240.88  421.55      0
+# Out of bounds values : 0 OK
+# Avg bus bandwidth    : 418.49
+`
+
+const dcgmOutput = `+---------------------------+------------------------------------------------+
+| Diagnostic                | Result                                         |
++===========================+================================================+
+|-----  Deployment  --------+------------------------------------------------|
+| Denylist                  | Pass                                           |
+| NVML Library              | Pass                                           |
+| Environmental Variables   | Fail - GPU: 0                                  |
++---------------------------+------------------------------------------------+
+`
+
+func testRecipe() *recipe.RecipeResult {
+	return &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "gpu-operator", Version: "v25.10.1"},
+		},
+	}
+}
+
+// newTestRunner returns a Runner whose Jobs finish immediately: Jobs named in
+// failing fail, all others succeed. Job logs are served from logs.
+func newTestRunner(t *testing.T, logs map[string]string, failing ...string) (*Runner, *fake.Clientset) {
+	t.Helper()
+
+	client := fake.NewClientset(
+		&appsv1.DaemonSet{
+			ObjectMeta: v1.ObjectMeta{Name: "nvidia-driver-daemonset", Namespace: "gpu-operator"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 1, NumberReady: 1},
+		},
+		&corev1.Node{
+			ObjectMeta: v1.ObjectMeta{Name: "gpu-node"},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{gpuResourceName: *resource.NewQuantity(8, resource.DecimalSI)},
+			},
+		},
+	)
+	client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+		job.Status.Succeeded = 1
+		for _, name := range failing {
+			if job.Name == name {
+				job.Status.Succeeded, job.Status.Failed = 0, 1
+			}
+		}
+		return false, nil, nil
+	})
+
+	r := New(client, nil, WithVersion("v1.0.0"), WithNamespace("gpu-operator"), WithTimeout(time.Second))
+	r.pollInterval = time.Millisecond
+	r.jobLogs = func(_ context.Context, _, name string) (string, error) {
+		return logs[name], nil
+	}
+	return r, client
+}
+
+func defaultLogs() map[string]string {
+	return map[string]string{
+		"eidos-conformance-fabric": strings.NewReplacer("In Progress", "Completed", "N/A", "Success").Replace(fabricOutput),
+		"eidos-conformance-nccl":   ncclOutput,
+		"eidos-conformance-dcgm":   strings.ReplaceAll(dcgmOutput, "Fail - GPU: 0", "Pass"),
+	}
+}
+
+func findCheck(t *testing.T, result *Result, name string) CheckResult {
+	t.Helper()
+	for _, cr := range result.Results {
+		if cr.Name == name {
+			return cr
+		}
+	}
+	t.Fatalf("check %q not found in %+v", name, result.Results)
+	return CheckResult{}
+}
+
+func TestDefaultImages(t *testing.T) {
+	for name, image := range map[string]string{"nccl": DefaultNCCLImage, "dcgm": DefaultDCGMImage} {
+		if !strings.HasPrefix(image, "nvcr.io/nvidia/") {
+			t.Errorf("%s default image %q is not published by NVIDIA", name, image)
+		}
+	}
+	if !strings.Contains(DefaultNCCLImage, "@sha256:") {
+		t.Errorf("DefaultNCCLImage %q is not pinned by digest", DefaultNCCLImage)
+	}
+}
+
+func TestGetProfile(t *testing.T) {
+	for _, name := range ProfileNames() {
+		p, err := GetProfile(name)
+		if err != nil {
+			t.Fatalf("GetProfile(%q) error = %v", name, err)
+		}
+		if p.Name != name || p.GPUsPerNode == 0 || p.MinBusBandwidth == 0 {
+			t.Errorf("GetProfile(%q) = %+v, incomplete profile", name, p)
+		}
+	}
+
+	_, err := GetProfile("dgx-a100")
+	if err == nil {
+		t.Fatal("expected error for unknown profile")
+	}
+	if !strings.Contains(err.Error(), "hgx-h100, nvl72") {
+		t.Errorf("error %q should list supported profiles", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	profile, err := GetProfile("hgx-h100")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("all checks pass", func(t *testing.T) {
+		r, client := newTestRunner(t, defaultLogs())
+
+		result, err := r.Run(context.Background(), profile, testRecipe(), nil)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if result.Kind != header.KindConformanceResult || result.Profile != "hgx-h100" {
+			t.Errorf("unexpected header: kind=%s profile=%s", result.Kind, result.Profile)
+		}
+		if result.Summary.Status != healthcheck.StatusPass || result.Summary.Score != 100 {
+			t.Errorf("summary = %+v, want pass with score 100", result.Summary)
+		}
+
+		for _, category := range []Category{CategoryComponents, CategoryHealth, CategoryFabric, CategoryNCCL, CategoryDCGM} {
+			found := false
+			for _, cr := range result.Results {
+				found = found || cr.Category == category
+			}
+			if !found {
+				t.Errorf("no %s check in results", category)
+			}
+		}
+		if busbw := findCheck(t, result, "nccl.all-reduce.busbw"); busbw.Actual != "418.49 GB/s" {
+			t.Errorf("nccl actual = %q", busbw.Actual)
+		}
+
+		jobs, err := client.BatchV1().Jobs(DefaultJobNamespace).List(context.Background(), v1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(jobs.Items) != 0 {
+			t.Errorf("expected workload Jobs to be deleted, found %d", len(jobs.Items))
+		}
+	})
+
+	t.Run("failures lower the score", func(t *testing.T) {
+		logs := defaultLogs()
+		logs["eidos-conformance-dcgm"] = dcgmOutput
+		logs["eidos-conformance-fabric"] = fabricOutput
+		r, _ := newTestRunner(t, logs)
+
+		rec := testRecipe()
+		rec.ComponentRefs[0].Version = "v24.6.2"
+		result, err := r.Run(context.Background(), profile, rec, nil)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if result.Summary.Status != healthcheck.StatusFail {
+			t.Errorf("status = %s, want fail", result.Summary.Status)
+		}
+		// Failed: component (1), fabric (3), dcgm (5). Passed: 2 health (2), nccl (5).
+		if result.Summary.Score != 43.7 {
+			t.Errorf("score = %v, want 43.7", result.Summary.Score)
+		}
+		if dcgm := findCheck(t, result, "dcgm.diag.level1"); !strings.Contains(dcgm.Message, "Environmental Variables") {
+			t.Errorf("dcgm message = %q", dcgm.Message)
+		}
+		if fabric := findCheck(t, result, "fabric.state"); fabric.Actual != "1 of 2 GPUs ready" {
+			t.Errorf("fabric actual = %q", fabric.Actual)
+		}
+	})
+
+	t.Run("failed job", func(t *testing.T) {
+		logs := defaultLogs()
+		logs["eidos-conformance-nccl"] = "NCCL WARN Cuda failure 'out of memory'\n"
+		r, _ := newTestRunner(t, logs, "eidos-conformance-nccl")
+
+		result, err := r.Run(context.Background(), profile, testRecipe(), nil)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		nccl := findCheck(t, result, "nccl.all-reduce.busbw")
+		if nccl.Status != healthcheck.CheckStatusFailed || !strings.Contains(nccl.Message, "out of memory") {
+			t.Errorf("nccl = %+v, want failure with last log line", nccl)
+		}
+	})
+
+	t.Run("workloads disabled", func(t *testing.T) {
+		r, _ := newTestRunner(t, defaultLogs())
+		WithWorkloads(false)(r)
+
+		result, err := r.Run(context.Background(), profile, testRecipe(), nil)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if result.Summary.Status != healthcheck.StatusPartial || result.Summary.Skipped != 3 {
+			t.Errorf("summary = %+v, want partial with 3 skipped", result.Summary)
+		}
+	})
+
+	t.Run("missing component", func(t *testing.T) {
+		r, _ := newTestRunner(t, defaultLogs())
+		nvl72, err := GetProfile("nvl72")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := r.Run(context.Background(), nvl72, testRecipe(), nil)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		dra := findCheck(t, result, "components.nvidia-dra-driver-gpu.version")
+		if dra.Status != healthcheck.CheckStatusFailed || dra.Actual != "missing" {
+			t.Errorf("dra check = %+v, want failed missing", dra)
+		}
+	})
+
+	t.Run("nil recipe", func(t *testing.T) {
+		r, _ := newTestRunner(t, nil)
+		if _, err := r.Run(context.Background(), profile, nil, nil); err == nil {
+			t.Error("expected error for nil recipe")
+		}
+	})
+}
+
+func TestParseFabricState(t *testing.T) {
+	gpus, ready := parseFabricState(fabricOutput)
+	if gpus != 2 || ready != 1 {
+		t.Errorf("parseFabricState() = %d, %d; want 2, 1", gpus, ready)
+	}
+
+	gpus, ready = parseFabricState("GPU 00000000:18:00.0\n    Product Name : NVIDIA L40S\n")
+	if gpus != 0 || ready != 0 {
+		t.Errorf("parseFabricState() without fabric = %d, %d; want 0, 0", gpus, ready)
+	}
+}
+
+func TestParseBusBandwidth(t *testing.T) {
+	busbw, err := parseBusBandwidth(ncclOutput)
+	if err != nil || busbw != 418.49 {
+		t.Errorf("parseBusBandwidth() = %v, %v; want 418.49", busbw, err)
+	}
+
+	if _, err := parseBusBandwidth("# nThread 1 nGpus 8\n"); err == nil {
+		t.Error("expected error for output without summary")
+	}
+	if _, err := parseBusBandwidth("# Avg bus bandwidth    : n/a\n"); err == nil {
+		t.Error("expected error for unparsable bandwidth")
+	}
+}
+
+func TestParseDCGMFailures(t *testing.T) {
+	failed := parseDCGMFailures(dcgmOutput)
+	if len(failed) != 1 || failed[0] != "Environmental Variables" {
+		t.Errorf("parseDCGMFailures() = %v", failed)
+	}
+}
+
+func TestBuildJob(t *testing.T) {
+	r := New(fake.NewClientset(), nil, WithJobNamespace("bench"), WithTimeout(10*time.Minute))
+	job := r.buildJob(workload{name: "test", image: "img", command: "true", gpus: 4, sharedMemory: true})
+
+	if job.Namespace != "bench" || *job.Spec.ActiveDeadlineSeconds != 600 || *job.Spec.BackoffLimit != 0 {
+		t.Errorf("unexpected job spec: %+v", job.Spec)
+	}
+	c := job.Spec.Template.Spec.Containers[0]
+	if got := c.Resources.Limits[gpuResourceName]; got.Value() != 4 {
+		t.Errorf("gpu limit = %v, want 4", got.Value())
+	}
+	if len(c.VolumeMounts) != 1 || c.VolumeMounts[0].MountPath != "/dev/shm" {
+		t.Errorf("expected /dev/shm mount, got %+v", c.VolumeMounts)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package conformance checks a deployed stack against an NVIDIA reference
+architecture and produces a scored report.
+
+A conformance run combines the other verification subsystems with workloads
+that exercise the GPUs:
+
+  - components: the recipe deploys every component the profile requires,
+    at a supported version.
+  - health: the healthcheck package verifies the deployed components.
+  - validation: when a snapshot is given, the validator evaluates the recipe
+    constraints against it.
+  - fabric: `nvidia-smi -q` reports an initialized NVLink fabric on every GPU.
+  - nccl: an nccl-tests all-reduce across the GPUs of one node reaches the
+    profile's minimum bus bandwidth.
+  - dcgm: DCGM level 1 diagnostics (`dcgmi diag -r 1`) pass.
+
+The fabric, NCCL and DCGM checks run as Jobs that request all GPUs of one node
+and are deleted when they finish. They can be disabled with WithWorkloads,
+in which case they are reported as skipped.
+
+# Profiles
+
+  - nvl72: GB200 NVL72, 4 GPUs per node, requires gpu-operator and
+    nvidia-dra-driver-gpu.
+  - hgx-h100: HGX H100, 8 GPUs per node, requires gpu-operator.
+
+# Scoring
+
+Each check carries a weight by category: 1 for component, health and
+validation checks, 3 for the fabric check and 5 for the NCCL and DCGM
+checks. The score is the weighted percentage of checks that passed; skipped
+checks count as not passed. The summary status follows health check results:
+"fail" when any check failed, "partial" when checks were skipped, and "pass"
+otherwise.
+
+# Usage
+
+	profile, err := conformance.GetProfile("hgx-h100")
+	if err != nil {
+	    return err
+	}
+
+	runner := conformance.New(clientset, dyn, conformance.WithVersion(version))
+	result, err := runner.Run(ctx, profile, rec, nil)
+	if err != nil {
+	    return err
+	}
+	fmt.Println(result.Summary.Score, result.Summary.Status)
+*/
+package conformance
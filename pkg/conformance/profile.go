@@ -0,0 +1,102 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// ComponentRequirement is a component a profile requires, with the oldest
+// version that supports the architecture.
+type ComponentRequirement struct {
+	// Name is the registry component name (e.g., "gpu-operator").
+	Name string
+
+	// MinVersion is the oldest supported version (e.g., "v25.3.0").
+	MinVersion string
+}
+
+// Profile describes an NVIDIA reference architecture and the checks a
+// deployed stack must pass to conform to it.
+type Profile struct {
+	// Name identifies the profile on the command line (e.g., "nvl72").
+	Name string
+
+	// Description is a short human-readable summary of the architecture.
+	Description string
+
+	// GPUsPerNode is the number of GPUs each workload Job requests,
+	// so that a Job occupies one whole node.
+	GPUsPerNode int
+
+	// Components lists the components the recipe must deploy.
+	Components []ComponentRequirement
+
+	// FabricManager requires the NVLink fabric to be initialized on every GPU.
+	FabricManager bool
+
+	// MinBusBandwidth is the lowest acceptable NCCL all-reduce bus
+	// bandwidth in GB/s within a node.
+	MinBusBandwidth float64
+}
+
+// profiles contains the supported reference architectures keyed by name.
+var profiles = map[string]Profile{
+	"nvl72": {
+		Name:        "nvl72",
+		Description: "GB200 NVL72 rack-scale system with multi-node NVLink",
+		GPUsPerNode: 4,
+		Components: []ComponentRequirement{
+			{Name: "gpu-operator", MinVersion: "v25.3.0"},
+			{Name: "nvidia-dra-driver-gpu", MinVersion: "25.8.0"},
+		},
+		FabricManager:   true,
+		MinBusBandwidth: 600,
+	},
+	"hgx-h100": {
+		Name:        "hgx-h100",
+		Description: "HGX H100 8-GPU system with NVSwitch",
+		GPUsPerNode: 8,
+		Components: []ComponentRequirement{
+			{Name: "gpu-operator", MinVersion: "v24.9.0"},
+		},
+		FabricManager:   true,
+		MinBusBandwidth: 400,
+	},
+}
+
+// GetProfile returns the profile with the given name.
+func GetProfile(name string) (Profile, error) {
+	p, ok := profiles[name]
+	if !ok {
+		return Profile{}, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unknown conformance profile %q (supported: %s)", name, strings.Join(ProfileNames(), ", ")))
+	}
+	return p, nil
+}
+
+// ProfileNames returns the names of all supported profiles, sorted.
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
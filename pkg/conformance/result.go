@@ -0,0 +1,160 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+)
+
+// Category groups conformance checks by the subsystem that produced them.
+type Category string
+
+const (
+	// CategoryComponents covers the component versions pinned in the recipe.
+	CategoryComponents Category = "components"
+
+	// CategoryHealth covers the health checks of deployed components.
+	CategoryHealth Category = "health"
+
+	// CategoryValidation covers recipe constraints evaluated against a snapshot.
+	CategoryValidation Category = "validation"
+
+	// CategoryFabric covers the NVLink fabric manager state of the GPUs.
+	CategoryFabric Category = "fabric"
+
+	// CategoryNCCL covers the NCCL all-reduce bandwidth smoke test.
+	CategoryNCCL Category = "nccl"
+
+	// CategoryDCGM covers the DCGM level 1 diagnostics.
+	CategoryDCGM Category = "dcgm"
+)
+
+// categoryWeights is the score weight of a single check in each category.
+// Workload checks exercise the hardware end to end and weigh the most.
+var categoryWeights = map[Category]int{
+	CategoryComponents: 1,
+	CategoryHealth:     1,
+	CategoryValidation: 1,
+	CategoryFabric:     3,
+	CategoryNCCL:       5,
+	CategoryDCGM:       5,
+}
+
+// Result represents the complete conformance outcome.
+type Result struct {
+	header.Header `json:",inline" yaml:",inline"`
+
+	// Profile is the name of the reference architecture checked against.
+	Profile string `json:"profile" yaml:"profile"`
+
+	// RecipeSource is the path/URI of the recipe that was checked.
+	RecipeSource string `json:"recipeSource" yaml:"recipeSource"`
+
+	// SnapshotSource is the path/URI of the snapshot used for validation, if any.
+	SnapshotSource string `json:"snapshotSource,omitempty" yaml:"snapshotSource,omitempty"`
+
+	// Summary contains aggregate check statistics and the score.
+	Summary Summary `json:"summary" yaml:"summary"`
+
+	// Results contains per-check details.
+	Results []CheckResult `json:"results" yaml:"results"`
+}
+
+// Summary contains aggregate statistics about the conformance run.
+type Summary struct {
+	// Score is the weighted percentage of checks that passed (0-100).
+	// Skipped checks count as not passed.
+	Score float64 `json:"score" yaml:"score"`
+
+	// Passed is the count of checks that were satisfied.
+	Passed int `json:"passed" yaml:"passed"`
+
+	// Failed is the count of checks that were not satisfied.
+	Failed int `json:"failed" yaml:"failed"`
+
+	// Skipped is the count of checks that couldn't be evaluated.
+	Skipped int `json:"skipped" yaml:"skipped"`
+
+	// Total is the total number of checks run.
+	Total int `json:"total" yaml:"total"`
+
+	// Status is the overall conformance status.
+	Status healthcheck.Status `json:"status" yaml:"status"`
+
+	// Duration is how long the checks took.
+	Duration time.Duration `json:"duration" yaml:"duration"`
+}
+
+// CheckResult represents the outcome of a single conformance check.
+type CheckResult struct {
+	healthcheck.CheckResult `json:",inline" yaml:",inline"`
+
+	// Category is the subsystem the check belongs to.
+	Category Category `json:"category" yaml:"category"`
+
+	// Weight is the check's contribution to the score.
+	Weight int `json:"weight" yaml:"weight"`
+}
+
+// NewResult creates a new Result with initialized slices.
+func NewResult() *Result {
+	return &Result{
+		Results: make([]CheckResult, 0),
+	}
+}
+
+// add appends cr to the result under category.
+func (r *Result) add(category Category, cr healthcheck.CheckResult) {
+	r.Results = append(r.Results, CheckResult{
+		CheckResult: cr,
+		Category:    category,
+		Weight:      categoryWeights[category],
+	})
+}
+
+// summarize computes the summary counts, status and score from the results.
+func (r *Result) summarize() {
+	var passedWeight, totalWeight int
+	for _, cr := range r.Results {
+		totalWeight += cr.Weight
+		switch cr.Status {
+		case healthcheck.CheckStatusPassed:
+			r.Summary.Passed++
+			passedWeight += cr.Weight
+		case healthcheck.CheckStatusFailed:
+			r.Summary.Failed++
+		case healthcheck.CheckStatusSkipped:
+			r.Summary.Skipped++
+		}
+	}
+	r.Summary.Total = len(r.Results)
+
+	if totalWeight > 0 {
+		// Truncate to one decimal place so that 100 means every check passed.
+		r.Summary.Score = float64(passedWeight*1000/totalWeight) / 10
+	}
+
+	switch {
+	case r.Summary.Failed > 0:
+		r.Summary.Status = healthcheck.StatusFail
+	case r.Summary.Skipped > 0:
+		r.Summary.Status = healthcheck.StatusPartial
+	default:
+		r.Summary.Status = healthcheck.StatusPass
+	}
+}
@@ -0,0 +1,422 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+)
+
+const (
+	// gpuResourceName is the extended resource advertised by the device plugin.
+	gpuResourceName corev1.ResourceName = "nvidia.com/gpu"
+
+	// jobTTLSeconds keeps finished Jobs around briefly for inspection
+	// if they could not be deleted.
+	jobTTLSeconds int32 = 600
+
+	// fabricStateCompleted and fabricStatusSuccess describe a GPU whose
+	// NVLink fabric was initialized by the fabric manager.
+	fabricStateCompleted = "Completed"
+	fabricStatusSuccess  = "Success"
+
+	// busBandwidthPrefix starts the nccl-tests summary line with the average bus bandwidth.
+	busBandwidthPrefix = "# Avg bus bandwidth"
+)
+
+// workload is a Job that runs a command on one whole GPU node.
+type workload struct {
+	// name is the Job name.
+	name string
+
+	// image is the container image.
+	image string
+
+	// command runs in a shell.
+	command string
+
+	// gpus is the number of GPUs requested.
+	gpus int
+
+	// capabilities are added to the container.
+	capabilities []corev1.Capability
+
+	// sharedMemory mounts a memory-backed /dev/shm, which NCCL needs
+	// for transfers between processes on the same node.
+	sharedMemory bool
+}
+
+// checkFabric verifies that the fabric manager initialized the NVLink fabric
+// on every GPU of a node.
+func (r *Runner) checkFabric(ctx context.Context, profile Profile) healthcheck.CheckResult {
+	cr := healthcheck.CheckResult{
+		Name:     "fabric.state",
+		Expected: fmt.Sprintf("state == %s, status == %s on all GPUs", fabricStateCompleted, fabricStatusSuccess),
+	}
+	logs, ok := r.runWorkload(ctx, &cr, workload{
+		name:    "eidos-conformance-fabric",
+		image:   r.DCGMImage,
+		command: "nvidia-smi -q",
+		gpus:    profile.GPUsPerNode,
+	})
+	if !ok {
+		return cr
+	}
+
+	gpus, ready := parseFabricState(logs)
+	cr.Actual = fmt.Sprintf("%d of %d GPUs ready", ready, gpus)
+	switch {
+	case gpus == 0:
+		cr.Status = healthcheck.CheckStatusFailed
+		cr.Message = "nvidia-smi reported no fabric state; is the fabric manager running?"
+	case ready < gpus:
+		cr.Status = healthcheck.CheckStatusFailed
+		cr.Message = fmt.Sprintf("%d GPU(s) without an initialized NVLink fabric", gpus-ready)
+	default:
+		cr.Status = healthcheck.CheckStatusPassed
+	}
+	return cr
+}
+
+// checkNCCL runs an all-reduce across the GPUs of one node and compares the
+// average bus bandwidth with the profile minimum.
+func (r *Runner) checkNCCL(ctx context.Context, profile Profile) healthcheck.CheckResult {
+	cr := healthcheck.CheckResult{
+		Name:     "nccl.all-reduce.busbw",
+		Expected: fmt.Sprintf(">= %g GB/s", profile.MinBusBandwidth),
+	}
+	logs, ok := r.runWorkload(ctx, &cr, workload{
+		name:         "eidos-conformance-nccl",
+		image:        r.NCCLImage,
+		command:      fmt.Sprintf("all_reduce_perf -b 1G -e 4G -f 2 -g %d", profile.GPUsPerNode),
+		gpus:         profile.GPUsPerNode,
+		sharedMemory: true,
+	})
+	if !ok {
+		return cr
+	}
+
+	busbw, err := parseBusBandwidth(logs)
+	if err != nil {
+		cr.Status = healthcheck.CheckStatusFailed
+		cr.Message = err.Error()
+		return cr
+	}
+	cr.Actual = fmt.Sprintf("%g GB/s", busbw)
+	if busbw < profile.MinBusBandwidth {
+		cr.Status = healthcheck.CheckStatusFailed
+		cr.Message = fmt.Sprintf("bus bandwidth %g GB/s is below %g GB/s", busbw, profile.MinBusBandwidth)
+		return cr
+	}
+	cr.Status = healthcheck.CheckStatusPassed
+	return cr
+}
+
+// checkDCGM runs DCGM level 1 diagnostics on the GPUs of one node.
+func (r *Runner) checkDCGM(ctx context.Context, profile Profile) healthcheck.CheckResult {
+	cr := healthcheck.CheckResult{
+		Name:     "dcgm.diag.level1",
+		Expected: "all tests pass",
+	}
+	logs, ok := r.runWorkload(ctx, &cr, workload{
+		name:         "eidos-conformance-dcgm",
+		image:        r.DCGMImage,
+		command:      "nv-hostengine && dcgmi diag -r 1",
+		gpus:         profile.GPUsPerNode,
+		capabilities: []corev1.Capability{"SYS_ADMIN"},
+	})
+	if !ok {
+		return cr
+	}
+
+	if failed := parseDCGMFailures(logs); len(failed) > 0 {
+		cr.Actual = fmt.Sprintf("%d failed", len(failed))
+		cr.Status = healthcheck.CheckStatusFailed
+		cr.Message = "failed tests: " + strings.Join(failed, ", ")
+		return cr
+	}
+	cr.Actual = "passed"
+	cr.Status = healthcheck.CheckStatusPassed
+	return cr
+}
+
+// runWorkload runs w and returns its logs. When the workload could not run
+// or did not succeed, cr is updated accordingly and ok is false.
+func (r *Runner) runWorkload(ctx context.Context, cr *healthcheck.CheckResult, w workload) (logs string, ok bool) {
+	if !r.Workloads {
+		cr.Status = healthcheck.CheckStatusSkipped
+		cr.Message = "workload checks disabled"
+		return "", false
+	}
+
+	logs, succeeded, err := r.runJob(ctx, w)
+	if err != nil {
+		cr.Status = healthcheck.CheckStatusSkipped
+		cr.Message = err.Error()
+		return "", false
+	}
+	if !succeeded {
+		cr.Actual = "job failed"
+		cr.Status = healthcheck.CheckStatusFailed
+		cr.Message = fmt.Sprintf("Job %s/%s failed: %s", r.JobNamespace, w.name, lastLine(logs))
+		return logs, false
+	}
+	return logs, true
+}
+
+// runJob creates the Job for w, waits for it to finish and returns its logs
+// and whether it succeeded. The Job is deleted afterwards.
+func (r *Runner) runJob(ctx context.Context, w workload) (logs string, succeeded bool, err error) {
+	jobs := r.client.BatchV1().Jobs(r.JobNamespace)
+
+	slog.Info("running conformance workload", "job", w.name, "namespace", r.JobNamespace, "image", w.image)
+
+	if _, err := jobs.Create(ctx, r.buildJob(w), metav1.CreateOptions{}); err != nil {
+		return "", false, fmt.Errorf("failed to create Job %s/%s: %w", r.JobNamespace, w.name, err)
+	}
+	defer func() {
+		policy := metav1.DeletePropagationBackground
+		// Clean up even when ctx was cancelled.
+		if err := jobs.Delete(context.WithoutCancel(ctx), w.name, metav1.DeleteOptions{
+			PropagationPolicy: &policy,
+		}); err != nil && !apierrors.IsNotFound(err) {
+			slog.Warn("failed to delete conformance Job", "job", w.name, "error", err)
+		}
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, r.pollInterval, r.Timeout, true, func(ctx context.Context) (bool, error) {
+		job, err := jobs.Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case job.Status.Succeeded > 0:
+			succeeded = true
+			return true, nil
+		case job.Status.Failed > 0:
+			return true, nil
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("job %s/%s did not finish within %v: %w", r.JobNamespace, w.name, r.Timeout, err)
+	}
+
+	logs, err = r.jobLogs(ctx, r.JobNamespace, w.name)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read logs of Job %s/%s: %w", r.JobNamespace, w.name, err)
+	}
+	return logs, succeeded, nil
+}
+
+// buildJob returns the Job that runs w on one node.
+func (r *Runner) buildJob(w workload) *batchv1.Job {
+	backoffLimit := int32(0)
+	ttl := jobTTLSeconds
+	deadline := int64(r.Timeout.Seconds())
+	gpus := resource.MustParse(strconv.Itoa(w.gpus))
+
+	labels := map[string]string{
+		"app.kubernetes.io/name":      "eidos",
+		"app.kubernetes.io/component": "conformance",
+	}
+
+	container := corev1.Container{
+		Name:    "workload",
+		Image:   w.image,
+		Command: []string{"/bin/bash", "-c", w.command},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{gpuResourceName: gpus},
+		},
+	}
+	if len(w.capabilities) > 0 {
+		container.SecurityContext = &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: w.capabilities},
+		}
+	}
+
+	var volumes []corev1.Volume
+	if w.sharedMemory {
+		volumes = append(volumes, corev1.Volume{
+			Name: "dshm",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "dshm", MountPath: "/dev/shm"})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      w.name,
+			Namespace: r.JobNamespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			TTLSecondsAfterFinished: &ttl,
+			ActiveDeadlineSeconds:   &deadline,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					// GPU nodes are commonly tainted; the GPU request selects the node.
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers:  []corev1.Container{container},
+					Volumes:     volumes,
+				},
+			},
+		},
+	}
+}
+
+// podLogs returns the logs of the pod created by the named Job.
+func (r *Runner) podLogs(ctx context.Context, namespace, name string) (string, error) {
+	pods, err := r.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: batchv1.JobNameLabel + "=" + name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list Pods: %w", err)
+	}
+	if len(pods.Items) == 0 {
+		return "", fmt.Errorf("no Pods found for Job %s", name)
+	}
+
+	stream, err := r.client.CoreV1().Pods(namespace).GetLogs(pods.Items[0].Name, &corev1.PodLogOptions{}).Stream(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to stream logs: %w", err)
+	}
+	defer stream.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, stream); err != nil {
+		return "", fmt.Errorf("failed to read logs: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// parseFabricState counts the GPUs in `nvidia-smi -q` output that report a
+// Fabric section, and those whose fabric is initialized.
+func parseFabricState(out string) (gpus, ready int) {
+	inFabric := false
+	fabricIndent := 0
+	var state, status string
+
+	finish := func() {
+		if inFabric {
+			gpus++
+			if state == fabricStateCompleted && status == fabricStatusSuccess {
+				ready++
+			}
+		}
+		inFabric, state, status = false, "", ""
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		if inFabric && indent <= fabricIndent {
+			finish()
+		}
+		if trimmed == "Fabric" {
+			inFabric, fabricIndent = true, indent
+			continue
+		}
+		if !inFabric {
+			continue
+		}
+
+		key, value, found := strings.Cut(trimmed, ":")
+		if !found {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "State":
+			state = strings.TrimSpace(value)
+		case "Status":
+			status = strings.TrimSpace(value)
+		}
+	}
+	finish()
+	return gpus, ready
+}
+
+// parseBusBandwidth returns the average bus bandwidth in GB/s from
+// nccl-tests output.
+func parseBusBandwidth(out string) (float64, error) {
+	for _, line := range strings.Split(out, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), busBandwidthPrefix) {
+			continue
+		}
+		_, value, found := strings.Cut(line, ":")
+		if !found {
+			break
+		}
+		busbw, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid bus bandwidth %q: %w", strings.TrimSpace(value), err)
+		}
+		return busbw, nil
+	}
+	return 0, fmt.Errorf("no %q line in NCCL test output", busBandwidthPrefix)
+}
+
+// parseDCGMFailures returns the names of the tests `dcgmi diag` reports as
+// failed. Result rows look like "| Memory    | Fail - GPU: 0 |".
+func parseDCGMFailures(out string) []string {
+	var failed []string
+	for _, line := range strings.Split(out, "\n") {
+		cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+		if len(cells) < 2 {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(cells[1]), "Fail") {
+			failed = append(failed, strings.TrimSpace(cells[0]))
+		}
+	}
+	return failed
+}
+
+// lastLine returns the last non-empty line of out, which usually explains
+// why a workload failed.
+func lastLine(out string) string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+		return last
+	}
+	return "no output"
+}
@@ -25,6 +25,7 @@
 //   - Collector timeouts: For system data collection operations
 //   - Handler timeouts: For HTTP request processing
 //   - Server timeouts: For HTTP server configuration
+//   - Temporary directory retention: For the server janitor
 //   - Kubernetes timeouts: For K8s API operations
 //   - HTTP client timeouts: For outbound HTTP requests
 //
@@ -26,7 +26,7 @@ const (
 	CollectorK8sTimeout = 30 * time.Second
 )
 
-// Handler timeouts for HTTP request processing.
+// Handler timeouts for HTTP and gRPC request processing.
 const (
 	// RecipeHandlerTimeout is the timeout for recipe generation requests.
 	RecipeHandlerTimeout = 30 * time.Second
@@ -39,6 +39,10 @@ const (
 	// Longer than recipe due to file I/O operations.
 	BundleHandlerTimeout = 60 * time.Second
 
+	// SnapshotHandlerTimeout is the timeout for gRPC snapshot requests.
+	// Collectors run in parallel, each bounded by its own timeout.
+	SnapshotHandlerTimeout = 60 * time.Second
+
 	// RecipeCacheTTL is the default cache duration for recipe responses.
 	RecipeCacheTTL = 10 * time.Minute
 )
@@ -61,6 +65,32 @@ const (
 	ServerShutdownTimeout = 30 * time.Second
 )
 
+// Temporary directory retention for long-running servers.
+const (
+	// TempDirTTL is how long an abandoned temporary directory is kept before
+	// the janitor removes it. Must be well above BundleHandlerTimeout.
+	TempDirTTL = 1 * time.Hour
+
+	// TempDirCleanupInterval is how often the janitor scans for expired
+	// temporary directories.
+	TempDirCleanupInterval = 5 * time.Minute
+)
+
+// Asynchronous bundle job limits for the API server.
+const (
+	// BundleJobTimeout is the timeout for an asynchronous bundle job.
+	// Longer than BundleHandlerTimeout since it is not bound to a request.
+	BundleJobTimeout = 10 * time.Minute
+
+	// BundleJobRetention is how long a bundle job and its archive are kept
+	// after their last update before they are pruned.
+	BundleJobRetention = 1 * time.Hour
+
+	// BundleJobPruneInterval is how often expired bundle jobs and their
+	// archives are deleted.
+	BundleJobPruneInterval = 5 * time.Minute
+)
+
 // Kubernetes timeouts for K8s API operations.
 const (
 	// K8sJobCreationTimeout is the timeout for creating K8s Job resources.
@@ -72,6 +102,14 @@ const (
 	// K8sJobCompletionTimeout is the default timeout for job completion.
 	K8sJobCompletionTimeout = 5 * time.Minute
 
+	// K8sJobActiveDeadline is the default activeDeadlineSeconds of the
+	// agent Job, after which Kubernetes terminates it.
+	K8sJobActiveDeadline = 5 * time.Hour
+
+	// K8sJobPollInterval is how often job completion is re-checked in
+	// addition to the watch, so missed watch events do not stall the wait.
+	K8sJobPollInterval = 2 * time.Second
+
 	// K8sCleanupTimeout is the timeout for cleanup operations.
 	K8sCleanupTimeout = 30 * time.Second
 )
@@ -106,8 +144,32 @@ const (
 	ConfigMapWriteTimeout = 30 * time.Second
 )
 
+// Object storage timeouts for s3://, gs:// and az:// objects.
+const (
+	// ObjectStoreRequestTimeout bounds a single object upload or download.
+	ObjectStoreRequestTimeout = 2 * time.Minute
+)
+
 // CLI timeouts for command-line operations.
 const (
 	// CLISnapshotTimeout is the default timeout for snapshot operations.
 	CLISnapshotTimeout = 5 * time.Minute
 )
+
+// Continuous validation.
+const (
+	// ValidationWatchInterval is how often the watcher re-captures a snapshot
+	// and re-validates it against the pinned recipe.
+	ValidationWatchInterval = 15 * time.Minute
+)
+
+// Deployer timeouts embedded in generated install pipelines.
+const (
+	// DeployerComponentInstallTimeout bounds a single component's helm install
+	// in generated install pipelines.
+	DeployerComponentInstallTimeout = 10 * time.Minute
+
+	// DeployerReadinessTimeout bounds the wait for a component's workloads
+	// to become ready after installation.
+	DeployerReadinessTimeout = 5 * time.Minute
+)
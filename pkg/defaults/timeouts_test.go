@@ -34,6 +34,7 @@ func TestTimeoutConstants(t *testing.T) {
 		{"RecipeHandlerTimeout", RecipeHandlerTimeout, 10 * time.Second, 60 * time.Second},
 		{"RecipeBuildTimeout", RecipeBuildTimeout, 10 * time.Second, 30 * time.Second},
 		{"BundleHandlerTimeout", BundleHandlerTimeout, 30 * time.Second, 120 * time.Second},
+		{"SnapshotHandlerTimeout", SnapshotHandlerTimeout, 30 * time.Second, 120 * time.Second},
 
 		// Server timeouts
 		{"ServerReadTimeout", ServerReadTimeout, 5 * time.Second, 30 * time.Second},
@@ -41,10 +42,24 @@ func TestTimeoutConstants(t *testing.T) {
 		{"ServerIdleTimeout", ServerIdleTimeout, 30 * time.Second, 300 * time.Second},
 		{"ServerShutdownTimeout", ServerShutdownTimeout, 10 * time.Second, 60 * time.Second},
 
+		// Temporary directory retention
+		{"TempDirTTL", TempDirTTL, 10 * time.Minute, 24 * time.Hour},
+		{"TempDirCleanupInterval", TempDirCleanupInterval, 1 * time.Minute, 30 * time.Minute},
+		{"BundleJobTimeout", BundleJobTimeout, 1 * time.Minute, 1 * time.Hour},
+		{"BundleJobRetention", BundleJobRetention, 10 * time.Minute, 24 * time.Hour},
+
+		// Object storage
+		{"ObjectStoreRequestTimeout", ObjectStoreRequestTimeout, 30 * time.Second, 10 * time.Minute},
+
+		// Continuous validation
+		{"ValidationWatchInterval", ValidationWatchInterval, 1 * time.Minute, 24 * time.Hour},
+
 		// K8s timeouts
 		{"K8sJobCreationTimeout", K8sJobCreationTimeout, 10 * time.Second, 60 * time.Second},
 		{"K8sPodReadyTimeout", K8sPodReadyTimeout, 30 * time.Second, 120 * time.Second},
 		{"K8sJobCompletionTimeout", K8sJobCompletionTimeout, 1 * time.Minute, 10 * time.Minute},
+		{"K8sJobActiveDeadline", K8sJobActiveDeadline, 1 * time.Hour, 24 * time.Hour},
+		{"K8sJobPollInterval", K8sJobPollInterval, 500 * time.Millisecond, 10 * time.Second},
 		{"K8sCleanupTimeout", K8sCleanupTimeout, 10 * time.Second, 60 * time.Second},
 
 		// HTTP client timeouts
@@ -87,6 +102,26 @@ func TestServerTimeoutRelationships(t *testing.T) {
 	}
 }
 
+func TestTempDirTTLExceedsBundleTimeout(t *testing.T) {
+	// In-flight bundle generations must never look abandoned to the janitor.
+	if TempDirTTL <= BundleHandlerTimeout {
+		t.Errorf("TempDirTTL (%v) should be greater than BundleHandlerTimeout (%v)",
+			TempDirTTL, BundleHandlerTimeout)
+	}
+}
+
+func TestBundleJobTimeoutRelationships(t *testing.T) {
+	if BundleJobTimeout <= BundleHandlerTimeout {
+		t.Errorf("BundleJobTimeout (%v) should be greater than BundleHandlerTimeout (%v)",
+			BundleJobTimeout, BundleHandlerTimeout)
+	}
+	// A running job must not be pruned before it can time out.
+	if BundleJobRetention <= BundleJobTimeout {
+		t.Errorf("BundleJobRetention (%v) should be greater than BundleJobTimeout (%v)",
+			BundleJobRetention, BundleJobTimeout)
+	}
+}
+
 func TestHTTPClientTimeoutRelationships(t *testing.T) {
 	// Connect timeout should be less than total timeout
 	if HTTPConnectTimeout >= HTTPClientTimeout {
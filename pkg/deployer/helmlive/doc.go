@@ -0,0 +1,64 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package helmlive installs Cloud Native Stack recipes directly into a cluster
+using the Helm Go SDK.
+
+Unlike the bundle deployers, which write artifacts for a later install, the
+live deployer talks to the cluster named by a kubeconfig and applies releases
+immediately.
+
+# Modes
+
+Two install modes are supported:
+  - ModeComponents (default): every enabled component is installed as its
+    own release, named after the component (or its releaseName), into its
+    component namespace.
+  - ModeUmbrella: the umbrella chart generated by the helm bundle deployer is
+    installed as a single release named eidos-stack.
+
+# Waves
+
+In component mode, components are grouped into waves by dependency depth.
+Components with no dependencies form the first wave, components that only
+depend on the first wave form the second, and so on. Releases within a wave
+are installed concurrently; the next wave starts only after every release in
+the current wave is ready (Helm status watcher wait strategy).
+
+In umbrella mode, the eidos-stack release is installed in the phases of the
+chart's install.sh (helm.InstallPhases): each phase upgrades the release with
+the components of later phases disabled, and the manifests in templates/ are
+installed last.
+
+Each release is installed when it does not exist (or was uninstalled) and
+upgraded otherwise, so re-running a deploy is idempotent.
+
+# Usage
+
+	d := helmlive.New(
+		helmlive.WithKubeconfig("~/.kube/config"),
+		helmlive.WithTimeout(15*time.Minute),
+	)
+
+	result, err := d.Deploy(ctx, recipeResult, componentValues)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, r := range result.Releases {
+		fmt.Printf("%s %s (revision %d)\n", r.Name, r.Action, r.Revision)
+	}
+*/
+package helmlive
@@ -0,0 +1,430 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmlive
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"helm.sh/helm/v4/pkg/action"
+	chartv2 "helm.sh/helm/v4/pkg/chart/v2"
+	"helm.sh/helm/v4/pkg/cli"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argoworkflows"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// Mode selects how a recipe is installed.
+type Mode string
+
+// Supported install modes.
+const (
+	// ModeComponents installs each component as its own release.
+	ModeComponents Mode = "components"
+	// ModeUmbrella installs the generated umbrella chart as a single release.
+	ModeUmbrella Mode = "umbrella"
+)
+
+// String returns the string representation of the mode.
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ParseMode converts a string into a Mode.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(s) {
+	case ModeComponents, ModeUmbrella:
+		return Mode(s), nil
+	default:
+		return "", apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid deploy mode %q: must be one of %s, %s", s, ModeComponents, ModeUmbrella))
+	}
+}
+
+const (
+	// DefaultTimeout is the default time to wait for each release to become ready.
+	DefaultTimeout = 10 * time.Minute
+
+	// UmbrellaReleaseName is the release name used in umbrella mode.
+	UmbrellaReleaseName = "eidos-stack"
+
+	// helmDriver is the Helm storage driver used for release records.
+	helmDriver = "secret"
+)
+
+// Action describes what was done to a release.
+type Action string
+
+// Release actions.
+const (
+	ActionInstall Action = "install"
+	ActionUpgrade Action = "upgrade"
+)
+
+// Release identifies a chart to install as a Helm release.
+type Release struct {
+	// Name is the release name.
+	Name string
+	// Namespace is the namespace the release is installed into.
+	Namespace string
+	// Chart is the chart reference (name, oci:// reference or local path).
+	Chart string
+	// RepoURL is the chart repository URL for non-OCI repository charts.
+	RepoURL string
+	// Version is the chart version constraint.
+	Version string
+	// Values are the values passed to the release.
+	Values map[string]any
+	// Wave is the install wave the release belongs to.
+	Wave int
+}
+
+// ReleaseResult reports the outcome for a single release.
+type ReleaseResult struct {
+	Name      string        `json:"name" yaml:"name"`
+	Namespace string        `json:"namespace" yaml:"namespace"`
+	Chart     string        `json:"chart" yaml:"chart"`
+	Version   string        `json:"version,omitempty" yaml:"version,omitempty"`
+	Action    Action        `json:"action" yaml:"action"`
+	Revision  int           `json:"revision" yaml:"revision"`
+	Wave      int           `json:"wave" yaml:"wave"`
+	Duration  time.Duration `json:"duration" yaml:"duration"`
+}
+
+// ReleaseState is the live state of a release.
+type ReleaseState struct {
+	// Revision is the latest revision, or 0 when the release has no history.
+	Revision int
+	// Status is the Helm status of the latest revision.
+	Status string
+	// Deployed is true when the latest revision is deployed.
+	Deployed bool
+}
+
+// Result summarizes a deploy run.
+type Result struct {
+	// Releases lists the releases in the order they were applied.
+	Releases []ReleaseResult `json:"releases" yaml:"releases"`
+	// Waves is the number of install waves.
+	Waves int `json:"waves" yaml:"waves"`
+	// Skipped lists disabled components that were not installed.
+	Skipped []string `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+	// DryRun is true when no changes were made to the cluster.
+	DryRun bool `json:"dry_run,omitempty" yaml:"dry_run,omitempty"`
+	// Duration is the total time spent deploying.
+	Duration time.Duration `json:"duration" yaml:"duration"`
+}
+
+// Deployer installs recipes into a live cluster with the Helm SDK.
+type Deployer struct {
+	kubeconfig string
+	namespace  string
+	timeout    time.Duration
+	dryRun     bool
+	mode       Mode
+	settings   *cli.EnvSettings
+
+	// newActionConfig and loadChart are replaced in tests.
+	newActionConfig func(namespace string) (*action.Configuration, error)
+	loadChart       func(cfg *action.Configuration, rel Release, cpo *action.ChartPathOptions) (*chartv2.Chart, error)
+}
+
+// Option is a functional option for configuring a Deployer.
+type Option func(*Deployer)
+
+// WithKubeconfig sets the kubeconfig file used to reach the cluster.
+// When empty, the default loading rules apply (KUBECONFIG, ~/.kube/config, in-cluster).
+func WithKubeconfig(path string) Option {
+	return func(d *Deployer) {
+		d.kubeconfig = path
+	}
+}
+
+// WithNamespace installs every release into the given namespace instead of
+// the per-component default.
+func WithNamespace(namespace string) Option {
+	return func(d *Deployer) {
+		d.namespace = namespace
+	}
+}
+
+// WithTimeout sets how long to wait for each release to become ready.
+func WithTimeout(timeout time.Duration) Option {
+	return func(d *Deployer) {
+		if timeout > 0 {
+			d.timeout = timeout
+		}
+	}
+}
+
+// WithDryRun renders releases without changing the cluster.
+func WithDryRun(dryRun bool) Option {
+	return func(d *Deployer) {
+		d.dryRun = dryRun
+	}
+}
+
+// WithMode sets the install mode.
+func WithMode(mode Mode) Option {
+	return func(d *Deployer) {
+		if mode != "" {
+			d.mode = mode
+		}
+	}
+}
+
+// New creates a Deployer with the given options.
+func New(opts ...Option) *Deployer {
+	d := &Deployer{
+		timeout:  DefaultTimeout,
+		mode:     ModeComponents,
+		settings: cli.New(),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.newActionConfig = d.actionConfig
+	d.loadChart = d.locateChart
+	return d
+}
+
+// Mode returns the configured install mode.
+func (d *Deployer) Mode() Mode {
+	return d.mode
+}
+
+// Deploy installs or upgrades every enabled component of recipeResult as its
+// own release, wave by wave. componentValues maps component names to the
+// values passed to each release.
+func (d *Deployer) Deploy(ctx context.Context, recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any) (*Result, error) {
+	start := time.Now()
+
+	if recipeResult == nil {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest, "recipe result cannot be nil")
+	}
+
+	enabled, err := recipeResult.WithoutDisabledComponents()
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInvalidRequest,
+			"invalid component dependencies", err)
+	}
+	if len(enabled.ComponentRefs) == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+			"recipe must contain at least one enabled component")
+	}
+
+	waves, err := d.planWaves(enabled, componentValues)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Waves:   len(waves),
+		Skipped: enabled.Metadata.DisabledComponents,
+		DryRun:  d.dryRun,
+	}
+
+	for i, wave := range waves {
+		names := make([]string, 0, len(wave))
+		for _, rel := range wave {
+			names = append(names, rel.Name)
+		}
+		slog.Info("deploying wave", "wave", i+1, "of", len(waves), "releases", names)
+
+		released, waveErr := d.deployWave(ctx, wave)
+		result.Releases = append(result.Releases, released...)
+		if waveErr != nil {
+			result.Duration = time.Since(start)
+			return result, waveErr
+		}
+	}
+
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// DeployChart installs or upgrades the chart at chartDir as a single release.
+// It is used in umbrella mode with a chart generated by the helm bundle deployer;
+// missing chart dependencies are downloaded before installing. The release is
+// installed in phases (helm.InstallPhases), one wave each, so the CRDs and
+// webhooks of earlier phases are ready before later ones; without phases it
+// is installed at once. A failed phase stops the deploy.
+func (d *Deployer) DeployChart(ctx context.Context, chartDir string, phases []helm.Phase, values map[string]any) (*Result, error) {
+	start := time.Now()
+
+	namespace := d.namespace
+	if namespace == "" {
+		namespace = helm.ReleaseNamespace
+	}
+	if len(phases) == 0 {
+		phases = []helm.Phase{{}}
+	}
+
+	result := &Result{Waves: len(phases), DryRun: d.dryRun}
+	for i, phase := range phases {
+		rel := Release{
+			Name:      UmbrellaReleaseName,
+			Namespace: namespace,
+			Chart:     chartDir,
+			Values:    phaseValues(values, phase),
+			Wave:      i,
+		}
+
+		slog.Info("deploying umbrella chart",
+			"release", rel.Name,
+			"namespace", rel.Namespace,
+			"phase", i+1,
+			"of", len(phases),
+			"components", phase.Components)
+
+		released, err := d.deployRelease(ctx, rel)
+		if err != nil {
+			result.Duration = time.Since(start)
+			return result, err
+		}
+		result.Releases = append(result.Releases, released)
+	}
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// phaseValues returns values with the overrides of an install phase applied.
+// values is not modified.
+func phaseValues(values map[string]any, phase helm.Phase) map[string]any {
+	overrides := phase.Values()
+	if len(overrides) == 0 {
+		return values
+	}
+	merged := maps.Clone(values)
+	if merged == nil {
+		merged = make(map[string]any, len(overrides))
+	}
+	for key, override := range overrides {
+		existing, _ := merged[key].(map[string]any)
+		existing = maps.Clone(existing)
+		if existing == nil {
+			existing = make(map[string]any)
+		}
+		maps.Copy(existing, override.(map[string]any))
+		merged[key] = existing
+	}
+	return merged
+}
+
+// planWaves groups enabled components into install waves by dependency depth.
+// Within a wave, releases keep the recipe's deployment order.
+func (d *Deployer) planWaves(recipeResult *recipe.RecipeResult, componentValues map[string]map[string]any) ([][]Release, error) {
+	refs := make(map[string]recipe.ComponentRef, len(recipeResult.ComponentRefs))
+	for _, ref := range recipeResult.ComponentRefs {
+		refs[ref.Name] = ref
+	}
+
+	order := recipeResult.DeploymentOrder
+	if len(order) == 0 {
+		order = make([]string, 0, len(recipeResult.ComponentRefs))
+		for _, ref := range recipeResult.ComponentRefs {
+			order = append(order, ref.Name)
+		}
+	}
+
+	depth := make(map[string]int, len(order))
+	var waves [][]Release
+	for _, name := range order {
+		ref, ok := refs[name]
+		if !ok {
+			continue
+		}
+		if ref.Type == recipe.ComponentTypeKustomize {
+			return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("component %q: live deploy does not support Kustomize components", name))
+		}
+
+		wave := 0
+		for _, dep := range ref.DependencyRefs {
+			depWave, ok := depth[dep]
+			if !ok {
+				return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+					fmt.Sprintf("component %q depends on %q, which is not deployed before it", name, dep))
+			}
+			wave = max(wave, depWave+1)
+		}
+		depth[name] = wave
+
+		for len(waves) <= wave {
+			waves = append(waves, nil)
+		}
+		waves[wave] = append(waves[wave], d.componentRelease(ref, componentValues[name], wave))
+	}
+
+	return waves, nil
+}
+
+// componentRelease builds the release for a single component.
+func (d *Deployer) componentRelease(ref recipe.ComponentRef, values map[string]any, wave int) Release {
+	namespace := d.namespace
+	if namespace == "" {
+		namespace = argoworkflows.ComponentNamespace(ref)
+	}
+
+	chartName := helm.ResolveChartName(ref.ComponentName())
+	rel := Release{
+		Name:      ref.HelmReleaseName(),
+		Namespace: namespace,
+		Chart:     chartName,
+		RepoURL:   ref.Source,
+		Version:   ref.Version,
+		Values:    values,
+		Wave:      wave,
+	}
+	if registryURL, ok := strings.CutPrefix(ref.Source, "oci://"); ok {
+		rel.Chart = "oci://" + strings.TrimSuffix(registryURL, "/") + "/" + chartName
+		rel.RepoURL = ""
+	}
+	return rel
+}
+
+// deployWave installs the releases of a wave concurrently and waits for all
+// of them. Results are returned in wave order for the releases that succeeded.
+func (d *Deployer) deployWave(ctx context.Context, wave []Release) ([]ReleaseResult, error) {
+	results := make([]*ReleaseResult, len(wave))
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i, rel := range wave {
+		g.Go(func() error {
+			res, err := d.deployRelease(gctx, rel)
+			if err != nil {
+				return err
+			}
+			results[i] = &res
+			return nil
+		})
+	}
+	err := g.Wait()
+
+	released := make([]ReleaseResult, 0, len(wave))
+	for _, res := range results {
+		if res != nil {
+			released = append(released, *res)
+		}
+	}
+	return released, err
+}
@@ -0,0 +1,412 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmlive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+
+	"helm.sh/helm/v4/pkg/action"
+	"helm.sh/helm/v4/pkg/chart/common"
+	chartv2 "helm.sh/helm/v4/pkg/chart/v2"
+	kubefake "helm.sh/helm/v4/pkg/kube/fake"
+	"helm.sh/helm/v4/pkg/storage"
+	"helm.sh/helm/v4/pkg/storage/driver"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// fakeCluster backs a Deployer with in-memory Helm storage per namespace.
+type fakeCluster struct {
+	mu          sync.Mutex
+	stores      map[string]*storage.Storage
+	createError error
+	loaded      []string
+}
+
+func newTestDeployer(t *testing.T, cluster *fakeCluster, opts ...Option) *Deployer {
+	t.Helper()
+
+	d := New(opts...)
+	d.newActionConfig = func(namespace string) (*action.Configuration, error) {
+		cluster.mu.Lock()
+		defer cluster.mu.Unlock()
+		if cluster.stores == nil {
+			cluster.stores = make(map[string]*storage.Storage)
+		}
+		store, ok := cluster.stores[namespace]
+		if !ok {
+			store = storage.Init(driver.NewMemory())
+			cluster.stores[namespace] = store
+		}
+		return &action.Configuration{
+			Releases: store,
+			KubeClient: &kubefake.FailingKubeClient{
+				PrintingKubeClient: kubefake.PrintingKubeClient{Out: io.Discard},
+				CreateError:        cluster.createError,
+			},
+			Capabilities: common.DefaultCapabilities,
+		}, nil
+	}
+	d.loadChart = func(_ *action.Configuration, rel Release, _ *action.ChartPathOptions) (*chartv2.Chart, error) {
+		cluster.mu.Lock()
+		cluster.loaded = append(cluster.loaded, rel.Chart)
+		cluster.mu.Unlock()
+		return testChart(rel.Name), nil
+	}
+	return d
+}
+
+func testChart(name string) *chartv2.Chart {
+	return &chartv2.Chart{
+		Metadata: &chartv2.Metadata{
+			APIVersion: chartv2.APIVersionV2,
+			Name:       name,
+			Version:    "0.1.0",
+		},
+		Templates: []*common.File{
+			{
+				Name: "templates/configmap.yaml",
+				Data: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Release.Name }}\n"),
+			},
+		},
+	}
+}
+
+func testRecipe() *recipe.RecipeResult {
+	disabled := false
+	return &recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{
+			{Name: "cert-manager", Type: recipe.ComponentTypeHelm, Source: "https://charts.jetstack.io", Version: "v1.17.2"},
+			{Name: "gpu-operator", Type: recipe.ComponentTypeHelm, Source: "https://helm.ngc.nvidia.com/nvidia", Version: "v25.3.0", DependencyRefs: []string{"cert-manager"}},
+			{Name: "network-operator", Type: recipe.ComponentTypeHelm, Source: "https://helm.ngc.nvidia.com/nvidia", Version: "v25.4.0"},
+			{Name: "nvsentinel", Type: recipe.ComponentTypeHelm, Source: "oci://ghcr.io/nvidia/", Version: "v0.1.0", Enabled: &disabled},
+		},
+		DeploymentOrder: []string{"cert-manager", "network-operator", "gpu-operator", "nvsentinel"},
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Mode
+		wantErr bool
+	}{
+		{in: "components", want: ModeComponents},
+		{in: "umbrella", want: ModeUmbrella},
+		{in: "argocd", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMode(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMode(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMode(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanWaves(t *testing.T) {
+	d := New()
+	rec, err := testRecipe().WithoutDisabledComponents()
+	if err != nil {
+		t.Fatalf("WithoutDisabledComponents() error = %v", err)
+	}
+
+	waves, err := d.planWaves(rec, nil)
+	if err != nil {
+		t.Fatalf("planWaves() error = %v", err)
+	}
+
+	want := [][]string{{"cert-manager", "network-operator"}, {"gpu-operator"}}
+	if len(waves) != len(want) {
+		t.Fatalf("got %d waves, want %d", len(waves), len(want))
+	}
+	for i, wave := range waves {
+		if len(wave) != len(want[i]) {
+			t.Fatalf("wave %d has %d releases, want %d", i, len(wave), len(want[i]))
+		}
+		for j, rel := range wave {
+			if rel.Name != want[i][j] {
+				t.Errorf("wave %d release %d = %q, want %q", i, j, rel.Name, want[i][j])
+			}
+			if rel.Wave != i {
+				t.Errorf("release %q wave = %d, want %d", rel.Name, rel.Wave, i)
+			}
+		}
+	}
+
+	if ns := waves[1][0].Namespace; ns != "gpu-operator" {
+		t.Errorf("gpu-operator namespace = %q, want gpu-operator", ns)
+	}
+}
+
+func TestPlanWaves_Kustomize(t *testing.T) {
+	rec := &recipe.RecipeResult{
+		ComponentRefs:   []recipe.ComponentRef{{Name: "overlays", Type: recipe.ComponentTypeKustomize}},
+		DeploymentOrder: []string{"overlays"},
+	}
+	if _, err := New().planWaves(rec, nil); err == nil {
+		t.Fatal("expected error for Kustomize component")
+	}
+}
+
+func TestComponentRelease(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []Option
+		ref         recipe.ComponentRef
+		wantName    string
+		wantChart   string
+		wantRepo    string
+		wantNS      string
+		wantVersion string
+	}{
+		{
+			name:        "repository chart",
+			ref:         recipe.ComponentRef{Name: "gpu-operator", Source: "https://helm.ngc.nvidia.com/nvidia", Version: "v25.3.0"},
+			wantChart:   "gpu-operator",
+			wantRepo:    "https://helm.ngc.nvidia.com/nvidia",
+			wantNS:      "gpu-operator",
+			wantVersion: "v25.3.0",
+		},
+		{
+			name:      "oci chart",
+			ref:       recipe.ComponentRef{Name: "custom", Source: "oci://ghcr.io/example/charts/"},
+			wantChart: "oci://ghcr.io/example/charts/custom",
+			wantNS:    "nvidia-system",
+		},
+		{
+			name:      "component instance",
+			ref:       recipe.ComponentRef{Name: "network-operator-pool-b", Component: "network-operator", ReleaseName: "netop-b", Source: "https://helm.ngc.nvidia.com/nvidia"},
+			wantName:  "netop-b",
+			wantChart: "network-operator",
+			wantRepo:  "https://helm.ngc.nvidia.com/nvidia",
+			wantNS:    "nvidia-network-operator",
+		},
+		{
+			name:      "namespace override",
+			opts:      []Option{WithNamespace("platform")},
+			ref:       recipe.ComponentRef{Name: "cert-manager", Source: "https://charts.jetstack.io"},
+			wantChart: "cert-manager",
+			wantRepo:  "https://charts.jetstack.io",
+			wantNS:    "platform",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := New(tt.opts...).componentRelease(tt.ref, nil, 0)
+			wantName := tt.wantName
+			if wantName == "" {
+				wantName = tt.ref.Name
+			}
+			if rel.Name != wantName {
+				t.Errorf("Name = %q, want %q", rel.Name, wantName)
+			}
+			if rel.Chart != tt.wantChart {
+				t.Errorf("Chart = %q, want %q", rel.Chart, tt.wantChart)
+			}
+			if rel.RepoURL != tt.wantRepo {
+				t.Errorf("RepoURL = %q, want %q", rel.RepoURL, tt.wantRepo)
+			}
+			if rel.Namespace != tt.wantNS {
+				t.Errorf("Namespace = %q, want %q", rel.Namespace, tt.wantNS)
+			}
+			if rel.Version != tt.wantVersion {
+				t.Errorf("Version = %q, want %q", rel.Version, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestDeploy_InstallThenUpgrade(t *testing.T) {
+	cluster := &fakeCluster{}
+	d := newTestDeployer(t, cluster)
+	ctx := context.Background()
+
+	result, err := d.Deploy(ctx, testRecipe(), map[string]map[string]any{
+		"gpu-operator": {"driver": map[string]any{"enabled": true}},
+	})
+	if err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	if result.Waves != 2 {
+		t.Errorf("Waves = %d, want 2", result.Waves)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0] != "nvsentinel" {
+		t.Errorf("Skipped = %v, want [nvsentinel]", result.Skipped)
+	}
+	if len(result.Releases) != 3 {
+		t.Fatalf("got %d releases, want 3", len(result.Releases))
+	}
+	for _, r := range result.Releases {
+		if r.Action != ActionInstall || r.Revision != 1 {
+			t.Errorf("release %q: action=%s revision=%d, want install revision 1", r.Name, r.Action, r.Revision)
+		}
+	}
+	if last := result.Releases[2]; last.Name != "gpu-operator" || last.Wave != 1 {
+		t.Errorf("last release = %q (wave %d), want gpu-operator in wave 1", last.Name, last.Wave)
+	}
+
+	result, err = d.Deploy(ctx, testRecipe(), nil)
+	if err != nil {
+		t.Fatalf("second Deploy() error = %v", err)
+	}
+	for _, r := range result.Releases {
+		if r.Action != ActionUpgrade || r.Revision != 2 {
+			t.Errorf("release %q: action=%s revision=%d, want upgrade revision 2", r.Name, r.Action, r.Revision)
+		}
+	}
+}
+
+func TestStateAndUninstall(t *testing.T) {
+	cluster := &fakeCluster{}
+	d := newTestDeployer(t, cluster)
+
+	state, err := d.State("cert-manager", "cert-manager")
+	if err != nil {
+		t.Fatalf("State() error = %v", err)
+	}
+	if state != (ReleaseState{}) {
+		t.Errorf("State() of a missing release = %+v, want zero", state)
+	}
+
+	if _, err := d.Deploy(context.Background(), testRecipe(), nil); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+	state, err = d.State("cert-manager", "cert-manager")
+	if err != nil {
+		t.Fatalf("State() error = %v", err)
+	}
+	if !state.Deployed || state.Revision != 1 || state.Status != "deployed" {
+		t.Errorf("State() = %+v, want deployed revision 1", state)
+	}
+
+	if err := d.Uninstall("cert-manager", "cert-manager"); err != nil {
+		t.Fatalf("Uninstall() error = %v", err)
+	}
+	state, err = d.State("cert-manager", "cert-manager")
+	if err != nil {
+		t.Fatalf("State() error = %v", err)
+	}
+	if state.Deployed {
+		t.Errorf("State() after Uninstall() = %+v, want not deployed", state)
+	}
+	if err := d.Uninstall("cert-manager", "cert-manager"); err != nil {
+		t.Errorf("Uninstall() of a missing release error = %v", err)
+	}
+}
+
+func TestDeploy_StopsOnFailedWave(t *testing.T) {
+	cluster := &fakeCluster{createError: errors.New("admission webhook denied")}
+	d := newTestDeployer(t, cluster)
+
+	result, err := d.Deploy(context.Background(), testRecipe(), nil)
+	if err == nil {
+		t.Fatal("expected error when releases fail to install")
+	}
+	if result == nil {
+		t.Fatal("expected partial result on failure")
+	}
+	if len(result.Releases) != 0 {
+		t.Errorf("got %d successful releases, want 0", len(result.Releases))
+	}
+	for _, chart := range cluster.loaded {
+		if chart == "gpu-operator" {
+			t.Error("second wave should not start after a failed wave")
+		}
+	}
+}
+
+func TestDeploy_NoEnabledComponents(t *testing.T) {
+	disabled := false
+	rec := &recipe.RecipeResult{
+		ComponentRefs:   []recipe.ComponentRef{{Name: "gpu-operator", Enabled: &disabled}},
+		DeploymentOrder: []string{"gpu-operator"},
+	}
+	if _, err := New().Deploy(context.Background(), rec, nil); err == nil {
+		t.Fatal("expected error when all components are disabled")
+	}
+}
+
+func TestDeployChart(t *testing.T) {
+	cluster := &fakeCluster{}
+	d := newTestDeployer(t, cluster, WithMode(ModeUmbrella))
+
+	result, err := d.DeployChart(context.Background(), "/bundle", nil, nil)
+	if err != nil {
+		t.Fatalf("DeployChart() error = %v", err)
+	}
+	if len(result.Releases) != 1 {
+		t.Fatalf("got %d releases, want 1", len(result.Releases))
+	}
+	r := result.Releases[0]
+	if r.Name != UmbrellaReleaseName || r.Namespace != "eidos-stack" || r.Action != ActionInstall {
+		t.Errorf("release = %+v, want %s install into eidos-stack", r, UmbrellaReleaseName)
+	}
+}
+
+func TestDeployChart_Phases(t *testing.T) {
+	cluster := &fakeCluster{}
+	d := newTestDeployer(t, cluster, WithMode(ModeUmbrella))
+
+	phases := []helm.Phase{
+		{Components: []string{"cert-manager"}, Disabled: []string{"gpu-operator"}, ManifestsDisabled: true},
+		{Components: []string{"gpu-operator"}, ManifestsDisabled: true},
+		{},
+	}
+	result, err := d.DeployChart(context.Background(), "/bundle", phases, nil)
+	if err != nil {
+		t.Fatalf("DeployChart() error = %v", err)
+	}
+	if result.Waves != 3 || len(result.Releases) != 3 {
+		t.Fatalf("got %d releases in %d waves, want 3 in 3", len(result.Releases), result.Waves)
+	}
+	wantActions := []Action{ActionInstall, ActionUpgrade, ActionUpgrade}
+	for i, r := range result.Releases {
+		if r.Action != wantActions[i] || r.Wave != i {
+			t.Errorf("release %d = %+v, want %s in wave %d", i, r, wantActions[i], i)
+		}
+	}
+}
+
+func TestPhaseValues(t *testing.T) {
+	values := map[string]any{"gpu-operator": map[string]any{"driver": map[string]any{"enabled": true}}}
+	phase := helm.Phase{Disabled: []string{"gpu-operator"}, ManifestsDisabled: true}
+
+	got := phaseValues(values, phase)
+	want := map[string]any{
+		"gpu-operator":      map[string]any{"driver": map[string]any{"enabled": true}, "enabled": false},
+		helm.ManifestsValue: map[string]any{"enabled": false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("phaseValues() = %v, want %v", got, want)
+	}
+	if _, ok := values["gpu-operator"].(map[string]any)["enabled"]; ok {
+		t.Error("phaseValues() modified the input values")
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helmlive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"helm.sh/helm/v4/pkg/action"
+	chartv2 "helm.sh/helm/v4/pkg/chart/v2"
+	"helm.sh/helm/v4/pkg/chart/v2/loader"
+	"helm.sh/helm/v4/pkg/downloader"
+	"helm.sh/helm/v4/pkg/getter"
+	"helm.sh/helm/v4/pkg/kube"
+	"helm.sh/helm/v4/pkg/registry"
+	ri "helm.sh/helm/v4/pkg/release"
+	rcommon "helm.sh/helm/v4/pkg/release/common"
+	"helm.sh/helm/v4/pkg/storage/driver"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// actionConfig builds a Helm action configuration for namespace using the
+// deployer's kubeconfig.
+func (d *Deployer) actionConfig(namespace string) (*action.Configuration, error) {
+	flags := genericclioptions.NewConfigFlags(true)
+	flags.Namespace = &namespace
+	if d.kubeconfig != "" {
+		flags.KubeConfig = &d.kubeconfig
+	}
+
+	cfg := action.NewConfiguration()
+	if err := cfg.Init(flags, namespace, helmDriver); err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeUnavailable,
+			"failed to initialize helm configuration", err)
+	}
+
+	registryClient, err := registry.NewClient(
+		registry.ClientOptCredentialsFile(d.settings.RegistryConfig),
+		registry.ClientOptWriter(io.Discard),
+	)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal,
+			"failed to create registry client", err)
+	}
+	cfg.RegistryClient = registryClient
+
+	return cfg, nil
+}
+
+// locateChart resolves rel.Chart to a local chart and loads it. Local chart
+// directories with missing dependencies are updated first, the same way
+// `helm install --dependency-update` does.
+func (d *Deployer) locateChart(cfg *action.Configuration, rel Release, cpo *action.ChartPathOptions) (*chartv2.Chart, error) {
+	path := rel.Chart
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		located, locateErr := cpo.LocateChart(rel.Chart, d.settings)
+		if locateErr != nil {
+			return nil, apperrors.Wrap(apperrors.ErrCodeNotFound,
+				fmt.Sprintf("failed to locate chart %q", rel.Chart), locateErr)
+		}
+		path = located
+	}
+
+	ch, err := loader.Load(path)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("failed to load chart %q", rel.Chart), err)
+	}
+
+	if len(ch.Metadata.Dependencies) <= len(ch.Dependencies()) {
+		return ch, nil
+	}
+
+	man := &downloader.Manager{
+		Out:              io.Discard,
+		ChartPath:        path,
+		Getters:          getter.All(d.settings),
+		RepositoryConfig: d.settings.RepositoryConfig,
+		RepositoryCache:  d.settings.RepositoryCache,
+		ContentCache:     d.settings.ContentCache,
+		RegistryClient:   cfg.RegistryClient,
+	}
+	if err := man.Update(); err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeUnavailable,
+			fmt.Sprintf("failed to update dependencies of chart %q", rel.Chart), err)
+	}
+
+	ch, err = loader.Load(path)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("failed to reload chart %q", rel.Chart), err)
+	}
+	return ch, nil
+}
+
+// deployRelease installs rel when it has no live release and upgrades it
+// otherwise, waiting for its resources to become ready.
+func (d *Deployer) deployRelease(ctx context.Context, rel Release) (ReleaseResult, error) {
+	start := time.Now()
+	res := ReleaseResult{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Chart:     rel.Chart,
+		Version:   rel.Version,
+		Wave:      rel.Wave,
+	}
+
+	cfg, err := d.newActionConfig(rel.Namespace)
+	if err != nil {
+		return res, err
+	}
+
+	exists, err := releaseExists(cfg, rel.Name)
+	if err != nil {
+		return res, apperrors.Wrap(apperrors.ErrCodeUnavailable,
+			fmt.Sprintf("failed to read history of release %q", rel.Name), err)
+	}
+
+	dryRun := action.DryRunNone
+	if d.dryRun {
+		dryRun = action.DryRunClient
+	}
+
+	var released ri.Releaser
+	if exists {
+		res.Action = ActionUpgrade
+		upgrade := action.NewUpgrade(cfg)
+		upgrade.Namespace = rel.Namespace
+		upgrade.Version = rel.Version
+		upgrade.RepoURL = rel.RepoURL
+		upgrade.Timeout = d.timeout
+		upgrade.WaitStrategy = kube.StatusWatcherStrategy
+		upgrade.DryRunStrategy = dryRun
+
+		ch, loadErr := d.loadChart(cfg, rel, &upgrade.ChartPathOptions)
+		if loadErr != nil {
+			return res, loadErr
+		}
+		slog.Debug("upgrading release", "release", rel.Name, "namespace", rel.Namespace, "chart", rel.Chart)
+		released, err = upgrade.RunWithContext(ctx, rel.Name, ch, rel.Values)
+	} else {
+		res.Action = ActionInstall
+		install := action.NewInstall(cfg)
+		install.ReleaseName = rel.Name
+		install.Namespace = rel.Namespace
+		install.CreateNamespace = true
+		install.Replace = true
+		install.Version = rel.Version
+		install.RepoURL = rel.RepoURL
+		install.Timeout = d.timeout
+		install.WaitStrategy = kube.StatusWatcherStrategy
+		install.DryRunStrategy = dryRun
+
+		ch, loadErr := d.loadChart(cfg, rel, &install.ChartPathOptions)
+		if loadErr != nil {
+			return res, loadErr
+		}
+		slog.Debug("installing release", "release", rel.Name, "namespace", rel.Namespace, "chart", rel.Chart)
+		released, err = install.RunWithContext(ctx, ch, rel.Values)
+	}
+	res.Duration = time.Since(start)
+	if err != nil {
+		return res, apperrors.Wrap(apperrors.ErrCodeInternal,
+			fmt.Sprintf("failed to %s release %q", res.Action, rel.Name), err)
+	}
+
+	if acc, accErr := ri.NewAccessor(released); accErr == nil {
+		res.Revision = acc.Version()
+	}
+
+	slog.Info("release deployed",
+		"release", rel.Name,
+		"namespace", rel.Namespace,
+		"action", res.Action,
+		"revision", res.Revision,
+		"duration", res.Duration.Round(time.Millisecond))
+
+	return res, nil
+}
+
+// State returns the live state of the release name in namespace, read from
+// its latest revision.
+func (d *Deployer) State(name, namespace string) (ReleaseState, error) {
+	cfg, err := d.newActionConfig(namespace)
+	if err != nil {
+		return ReleaseState{}, err
+	}
+	latest, found, err := latestRevision(cfg, name)
+	if err != nil {
+		return ReleaseState{}, apperrors.Wrap(apperrors.ErrCodeUnavailable,
+			fmt.Sprintf("failed to read history of release %q", name), err)
+	}
+	if !found {
+		return ReleaseState{}, nil
+	}
+	return ReleaseState{
+		Revision: latest.Version(),
+		Status:   latest.Status(),
+		Deployed: latest.Status() == rcommon.StatusDeployed.String(),
+	}, nil
+}
+
+// Uninstall removes the release name from namespace and waits for its
+// resources to be deleted. A missing release is not an error.
+func (d *Deployer) Uninstall(name, namespace string) error {
+	cfg, err := d.newActionConfig(namespace)
+	if err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(cfg)
+	uninstall.IgnoreNotFound = true
+	uninstall.Timeout = d.timeout
+	uninstall.WaitStrategy = kube.StatusWatcherStrategy
+	uninstall.DryRun = d.dryRun
+
+	slog.Debug("uninstalling release", "release", name, "namespace", namespace)
+	res, err := uninstall.Run(name)
+	if err != nil {
+		return apperrors.Wrap(apperrors.ErrCodeInternal,
+			fmt.Sprintf("failed to uninstall release %q", name), err)
+	}
+	if res == nil {
+		return nil
+	}
+
+	slog.Info("release uninstalled", "release", name, "namespace", namespace)
+	return nil
+}
+
+// releaseExists reports whether name has a live release. Releases whose last
+// revision was uninstalled with --keep-history are reinstalled, not upgraded.
+func releaseExists(cfg *action.Configuration, name string) (bool, error) {
+	latest, found, err := latestRevision(cfg, name)
+	if err != nil || !found {
+		return false, err
+	}
+	return latest.Status() != rcommon.StatusUninstalled.String(), nil
+}
+
+// latestRevision returns the latest revision of name. found is false when
+// the release has no history.
+func latestRevision(cfg *action.Configuration, name string) (latest ri.Accessor, found bool, err error) {
+	versions, err := action.NewHistory(cfg).Run(name)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, v := range versions {
+		acc, accErr := ri.NewAccessor(v)
+		if accErr != nil {
+			return nil, false, accErr
+		}
+		if latest == nil || acc.Version() > latest.Version() {
+			latest = acc
+		}
+	}
+	return latest, latest != nil, nil
+}
@@ -31,6 +31,7 @@
 //   - ErrCodeInternal: Internal server error (HTTP 500)
 //   - ErrCodeInvalidRequest: Malformed or invalid input (HTTP 400)
 //   - ErrCodeRateLimitExceeded: Rate limit exceeded (HTTP 429)
+//   - ErrCodeRequestTooLarge: Request body exceeds the size limit (HTTP 413)
 //   - ErrCodeMethodNotAllowed: HTTP method not allowed (HTTP 405)
 //   - ErrCodeUnavailable: Service temporarily unavailable (HTTP 503)
 //
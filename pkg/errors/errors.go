@@ -32,6 +32,8 @@ const (
 	ErrCodeInvalidRequest ErrorCode = "INVALID_REQUEST"
 	// ErrCodeRateLimitExceeded indicates the client exceeded an enforced request limit.
 	ErrCodeRateLimitExceeded ErrorCode = "RATE_LIMIT_EXCEEDED"
+	// ErrCodeRequestTooLarge indicates the request body exceeded the size limit.
+	ErrCodeRequestTooLarge ErrorCode = "REQUEST_TOO_LARGE"
 	// ErrCodeMethodNotAllowed indicates the HTTP method is not allowed for the resource.
 	ErrCodeMethodNotAllowed ErrorCode = "METHOD_NOT_ALLOWED"
 	// ErrCodeUnavailable indicates a service or resource is temporarily unavailable.
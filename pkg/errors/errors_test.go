@@ -118,6 +118,7 @@ func TestErrorCodes(t *testing.T) {
 		ErrCodeInternal,
 		ErrCodeInvalidRequest,
 		ErrCodeRateLimitExceeded,
+		ErrCodeRequestTooLarge,
 		ErrCodeMethodNotAllowed,
 		ErrCodeUnavailable,
 	}
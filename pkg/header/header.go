@@ -24,10 +24,13 @@ type Kind string
 
 // Valid Kind constants for all Eidos resource types.
 const (
-	KindSnapshot         Kind = "Snapshot"
-	KindRecipe           Kind = "Recipe"
-	KindRecipeResult     Kind = "RecipeResult"
-	KindValidationResult Kind = "ValidationResult"
+	KindSnapshot          Kind = "Snapshot"
+	KindRecipe            Kind = "Recipe"
+	KindRecipeResult      Kind = "RecipeResult"
+	KindValidationResult  Kind = "ValidationResult"
+	KindHealthCheckResult Kind = "HealthCheckResult"
+	KindConformanceResult Kind = "ConformanceResult"
+	KindSupportBundle     Kind = "SupportBundle"
 )
 
 // String returns the string representation of the Kind.
@@ -38,7 +41,7 @@ func (k Kind) String() string {
 // IsValid checks if the Kind is one of the recognized kinds.
 func (k *Kind) IsValid() bool {
 	switch *k {
-	case KindSnapshot, KindRecipe, KindRecipeResult, KindValidationResult:
+	case KindSnapshot, KindRecipe, KindRecipeResult, KindValidationResult, KindHealthCheckResult, KindConformanceResult, KindSupportBundle:
 		return true
 	default:
 		return false
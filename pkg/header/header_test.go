@@ -80,6 +80,21 @@ func TestKind_IsValid(t *testing.T) {
 			kind: KindValidationResult,
 			want: true,
 		},
+		{
+			name: "HealthCheckResult is valid",
+			kind: KindHealthCheckResult,
+			want: true,
+		},
+		{
+			name: "ConformanceResult is valid",
+			kind: KindConformanceResult,
+			want: true,
+		},
+		{
+			name: "SupportBundle is valid",
+			kind: KindSupportBundle,
+			want: true,
+		},
 		{
 			name: "Empty kind is invalid",
 			kind: Kind(""),
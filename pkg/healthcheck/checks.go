@@ -0,0 +1,197 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	// gpuResourceName is the extended resource advertised by the device plugin.
+	gpuResourceName corev1.ResourceName = "nvidia.com/gpu"
+
+	// certManagerWebhookSelector matches the cert-manager webhook Deployment.
+	certManagerWebhookSelector = "app.kubernetes.io/name=webhook,app.kubernetes.io/component=webhook"
+
+	// nicClusterPolicyReady is the NicClusterPolicy status.state of a healthy policy.
+	nicClusterPolicyReady = "ready"
+)
+
+// nicClusterPolicyGVR identifies the network operator's cluster-scoped policy.
+var nicClusterPolicyGVR = schema.GroupVersionResource{
+	Group:    "mellanox.com",
+	Version:  "v1alpha1",
+	Resource: "nicclusterpolicies",
+}
+
+// newCheck returns a CheckResult for ref with the given name suffix.
+func newCheck(ref recipe.ComponentRef, name, expected string) CheckResult {
+	return CheckResult{
+		Name:      ref.Name + "." + name,
+		Component: ref.Name,
+		Expected:  expected,
+	}
+}
+
+// skipped marks cr as skipped because the resource could not be read.
+func skipped(cr CheckResult, format string, args ...any) CheckResult {
+	cr.Status = CheckStatusSkipped
+	cr.Message = fmt.Sprintf(format, args...)
+	return cr
+}
+
+// evaluate sets cr's actual value and marks it passed or failed.
+func evaluate(cr CheckResult, passed bool, actual, failure string) CheckResult {
+	cr.Actual = actual
+	if passed {
+		cr.Status = CheckStatusPassed
+		return cr
+	}
+	cr.Status = CheckStatusFailed
+	cr.Message = failure
+	return cr
+}
+
+// checkGPUOperator verifies that the operand DaemonSets are fully ready and
+// that the nodes advertise GPUs to the scheduler.
+func checkGPUOperator(ctx context.Context, c *Checker, ref recipe.ComponentRef, namespace string) []CheckResult {
+	var results []CheckResult
+
+	daemonSets, err := c.client.AppsV1().DaemonSets(namespace).List(ctx, v1.ListOptions{})
+	switch {
+	case err != nil:
+		results = append(results, skipped(newCheck(ref, "daemonsets", "ready == desired"),
+			"failed to list DaemonSets in %s: %v", namespace, err))
+	case len(daemonSets.Items) == 0:
+		results = append(results, evaluate(newCheck(ref, "daemonsets", "ready == desired"),
+			false, "0", fmt.Sprintf("no DaemonSets found in namespace %s", namespace)))
+	default:
+		for _, ds := range daemonSets.Items {
+			desired := ds.Status.DesiredNumberScheduled
+			ready := ds.Status.NumberReady
+			results = append(results, evaluate(newCheck(ref, "daemonset."+ds.Name, "ready == desired"),
+				ready == desired,
+				fmt.Sprintf("ready=%d desired=%d", ready, desired),
+				fmt.Sprintf("DaemonSet %s/%s has %d of %d pods ready", namespace, ds.Name, ready, desired)))
+		}
+	}
+
+	gpus := newCheck(ref, "gpu.allocatable", "> 0")
+	nodes, err := c.client.CoreV1().Nodes().List(ctx, v1.ListOptions{})
+	if err != nil {
+		return append(results, skipped(gpus, "failed to list nodes: %v", err))
+	}
+	var total int64
+	for _, node := range nodes.Items {
+		if q, ok := node.Status.Allocatable[gpuResourceName]; ok {
+			total += q.Value()
+		}
+	}
+	return append(results, evaluate(gpus, total > 0, fmt.Sprintf("%d", total),
+		fmt.Sprintf("no node advertises allocatable %s", gpuResourceName)))
+}
+
+// checkCertManager verifies that the webhook is serving: its Deployment has
+// available replicas and the CA injector has populated the webhook
+// configurations, without which API requests for cert-manager resources fail.
+func checkCertManager(ctx context.Context, c *Checker, ref recipe.ComponentRef, namespace string) []CheckResult {
+	var results []CheckResult
+
+	webhook := newCheck(ref, "webhook.available", "availableReplicas > 0")
+	deployments, err := c.client.AppsV1().Deployments(namespace).List(ctx, v1.ListOptions{
+		LabelSelector: certManagerWebhookSelector,
+	})
+	switch {
+	case err != nil:
+		results = append(results, skipped(webhook, "failed to list Deployments in %s: %v", namespace, err))
+	case len(deployments.Items) == 0:
+		results = append(results, evaluate(webhook, false, "0",
+			fmt.Sprintf("no cert-manager webhook Deployment found in namespace %s", namespace)))
+	default:
+		var available int32
+		for _, d := range deployments.Items {
+			available += d.Status.AvailableReplicas
+		}
+		results = append(results, evaluate(webhook, available > 0, fmt.Sprintf("%d", available),
+			"cert-manager webhook has no available replicas"))
+	}
+
+	caBundle := newCheck(ref, "webhook.cabundle", "caBundle injected")
+	configs, err := c.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, v1.ListOptions{})
+	if err != nil {
+		return append(results, skipped(caBundle, "failed to list validating webhook configurations: %v", err))
+	}
+	var found, missing []string
+	for _, cfg := range configs.Items {
+		if !strings.Contains(cfg.Name, "cert-manager") {
+			continue
+		}
+		found = append(found, cfg.Name)
+		for _, w := range cfg.Webhooks {
+			if len(w.ClientConfig.CABundle) == 0 {
+				missing = append(missing, cfg.Name+"/"+w.Name)
+			}
+		}
+	}
+	if len(found) == 0 {
+		return append(results, evaluate(caBundle, false, "none",
+			"no cert-manager validating webhook configuration found"))
+	}
+	return append(results, evaluate(caBundle, len(missing) == 0,
+		fmt.Sprintf("%d configuration(s), %d webhook(s) without caBundle", len(found), len(missing)),
+		fmt.Sprintf("webhooks without caBundle: %s", strings.Join(missing, ", "))))
+}
+
+// checkNetworkOperator verifies that every NicClusterPolicy is ready.
+// Recipes that do not create a policy get a skipped check.
+func checkNetworkOperator(ctx context.Context, c *Checker, ref recipe.ComponentRef, _ string) []CheckResult {
+	policy := newCheck(ref, "nicclusterpolicy", "state == "+nicClusterPolicyReady)
+	if c.dynamic == nil {
+		return []CheckResult{skipped(policy, "no dynamic client available")}
+	}
+
+	policies, err := c.dynamic.Resource(nicClusterPolicyGVR).List(ctx, v1.ListOptions{})
+	if err != nil {
+		return []CheckResult{skipped(policy, "failed to list NicClusterPolicies: %v", err)}
+	}
+	if len(policies.Items) == 0 {
+		return []CheckResult{skipped(policy, "no NicClusterPolicy found")}
+	}
+
+	results := make([]CheckResult, 0, len(policies.Items))
+	for _, p := range policies.Items {
+		cr := newCheck(ref, "nicclusterpolicy."+p.GetName(), "state == "+nicClusterPolicyReady)
+		state, _, err := unstructured.NestedString(p.Object, "status", "state")
+		if err != nil {
+			results = append(results, skipped(cr, "failed to read status.state: %v", err))
+			continue
+		}
+		if state == "" {
+			state = "unknown"
+		}
+		results = append(results, evaluate(cr, state == nicClusterPolicyReady, state,
+			fmt.Sprintf("NicClusterPolicy %s is %s", p.GetName(), state)))
+	}
+	return results
+}
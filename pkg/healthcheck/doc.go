@@ -0,0 +1,60 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package healthcheck verifies that the components of a recipe are healthy in a
+live cluster after deployment.
+
+Where the validator compares recipe constraints against a snapshot, the health
+checker talks to the cluster directly and inspects the resources each
+component is expected to create. Components without built-in checks are
+ignored, as are components disabled in the recipe.
+
+# Checks
+
+  - gpu-operator: every DaemonSet in the operator namespace has all desired
+    pods ready, and the nodes advertise allocatable nvidia.com/gpu resources.
+  - cert-manager: the webhook Deployment has available replicas and the
+    cert-manager webhook configurations carry an injected CA bundle.
+  - network-operator: every NicClusterPolicy reports status.state "ready".
+
+Checks look in the component namespace used by the live deployer unless a
+namespace is set with WithNamespace.
+
+# Results
+
+Each check produces a CheckResult with status passed, failed or skipped
+(the resource could not be read or does not apply). The summary status is
+"fail" when any check failed, "partial" when checks were skipped, and "pass"
+otherwise, mirroring validator results.
+
+# Usage
+
+	clientset, config, err := client.GetKubeClientWithConfig(kubeconfig)
+	if err != nil {
+	    return err
+	}
+	dyn, err := dynamic.NewForConfig(config)
+	if err != nil {
+	    return err
+	}
+
+	checker := healthcheck.New(clientset, dyn, healthcheck.WithVersion(version))
+	result, err := checker.Check(ctx, rec)
+	if err != nil {
+	    return err
+	}
+	fmt.Println(result.Summary.Status)
+*/
+package healthcheck
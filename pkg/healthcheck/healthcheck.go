@@ -0,0 +1,157 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/argoworkflows"
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+const (
+	// APIVersion is the API version for health check results.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+)
+
+// componentCheck runs the checks for one component in namespace.
+// Problems reading cluster state are reported as skipped checks, not errors.
+type componentCheck func(ctx context.Context, c *Checker, ref recipe.ComponentRef, namespace string) []CheckResult
+
+// componentChecks maps registry component names to their checks.
+var componentChecks = map[string]componentCheck{
+	"gpu-operator":     checkGPUOperator,
+	"cert-manager":     checkCertManager,
+	"network-operator": checkNetworkOperator,
+}
+
+// Checker verifies recipe components against a live cluster.
+type Checker struct {
+	// Version is the checker version (typically the CLI version).
+	Version string
+
+	// Namespace overrides the component namespace for every check.
+	Namespace string
+
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+}
+
+// Option is a functional option for configuring Checker instances.
+type Option func(*Checker)
+
+// WithVersion returns an Option that sets the Checker version string.
+func WithVersion(version string) Option {
+	return func(c *Checker) {
+		c.Version = version
+	}
+}
+
+// WithNamespace returns an Option that looks for every component's resources
+// in namespace instead of its component namespace.
+func WithNamespace(namespace string) Option {
+	return func(c *Checker) {
+		c.Namespace = namespace
+	}
+}
+
+// New creates a new Checker using the given typed and dynamic clients.
+func New(client kubernetes.Interface, dyn dynamic.Interface, opts ...Option) *Checker {
+	c := &Checker{
+		client:  client,
+		dynamic: dyn,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Check runs the health checks for every enabled component in the recipe.
+// Returns a Result containing per-check results and summary.
+func (c *Checker) Check(ctx context.Context, rec *recipe.RecipeResult) (*Result, error) {
+	start := time.Now()
+
+	if rec == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "recipe cannot be nil")
+	}
+	if c.client == nil {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "kubernetes client cannot be nil")
+	}
+
+	result := NewResult()
+	result.Init(header.KindHealthCheckResult, APIVersion, c.Version)
+
+	for _, ref := range rec.ComponentRefs {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(errors.ErrCodeTimeout, "health check cancelled", err)
+		}
+		if !ref.IsEnabled() {
+			continue
+		}
+
+		check, ok := componentChecks[ref.ComponentName()]
+		if !ok {
+			slog.Debug("no health checks for component", "component", ref.Name)
+			continue
+		}
+
+		namespace := c.Namespace
+		if namespace == "" {
+			namespace = argoworkflows.ComponentNamespace(ref)
+		}
+
+		slog.Debug("checking component", "component", ref.Name, "namespace", namespace)
+		result.Results = append(result.Results, check(ctx, c, ref, namespace)...)
+	}
+
+	for _, r := range result.Results {
+		switch r.Status {
+		case CheckStatusPassed:
+			result.Summary.Passed++
+		case CheckStatusFailed:
+			result.Summary.Failed++
+		case CheckStatusSkipped:
+			result.Summary.Skipped++
+		}
+	}
+	result.Summary.Total = len(result.Results)
+	result.Summary.Duration = time.Since(start)
+
+	switch {
+	case result.Summary.Failed > 0:
+		result.Summary.Status = StatusFail
+	case result.Summary.Skipped > 0:
+		result.Summary.Status = StatusPartial
+	default:
+		result.Summary.Status = StatusPass
+	}
+
+	slog.Debug("health check completed",
+		"passed", result.Summary.Passed,
+		"failed", result.Summary.Failed,
+		"skipped", result.Summary.Skipped,
+		"status", result.Summary.Status,
+		"duration", result.Summary.Duration)
+
+	return result, nil
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"testing"
+
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func daemonSet(name string, desired, ready int32) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: "gpu-operator"},
+		Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: desired, NumberReady: ready},
+	}
+}
+
+func gpuNode(name string, gpus int64) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				gpuResourceName: *resource.NewQuantity(gpus, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func certManagerWebhook(available int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "cert-manager-webhook",
+			Namespace: "cert-manager",
+			Labels: map[string]string{
+				"app.kubernetes.io/name":      "webhook",
+				"app.kubernetes.io/component": "webhook",
+			},
+		},
+		Status: appsv1.DeploymentStatus{AvailableReplicas: available},
+	}
+}
+
+func certManagerWebhookConfig(caBundle []byte) *admissionv1.ValidatingWebhookConfiguration {
+	return &admissionv1.ValidatingWebhookConfiguration{
+		ObjectMeta: v1.ObjectMeta{Name: "cert-manager-webhook"},
+		Webhooks: []admissionv1.ValidatingWebhook{{
+			Name:         "webhook.cert-manager.io",
+			ClientConfig: admissionv1.WebhookClientConfig{CABundle: caBundle},
+		}},
+	}
+}
+
+func nicClusterPolicy(name, state string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "mellanox.com/v1alpha1",
+		"kind":       "NicClusterPolicy",
+		"metadata":   map[string]any{"name": name},
+	}}
+	if state != "" {
+		u.Object["status"] = map[string]any{"state": state}
+	}
+	return u
+}
+
+func newDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{nicClusterPolicyGVR: "NicClusterPolicyList"},
+		objects...)
+}
+
+func testRecipe(names ...string) *recipe.RecipeResult {
+	rec := &recipe.RecipeResult{}
+	for _, n := range names {
+		rec.ComponentRefs = append(rec.ComponentRefs, recipe.ComponentRef{Name: n, Type: recipe.ComponentTypeHelm})
+	}
+	return rec
+}
+
+func TestChecker_Check(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name       string
+		recipe     *recipe.RecipeResult
+		objects    []runtime.Object
+		dynamic    []runtime.Object
+		wantStatus Status
+		wantChecks map[string]CheckStatus
+		wantTotal  int
+		wantErr    bool
+	}{
+		{
+			name:    "nil recipe",
+			wantErr: true,
+		},
+		{
+			name:       "components without checks",
+			recipe:     testRecipe("nvsentinel"),
+			wantStatus: StatusPass,
+			wantTotal:  0,
+		},
+		{
+			name:   "healthy gpu-operator",
+			recipe: testRecipe("gpu-operator"),
+			objects: []runtime.Object{
+				daemonSet("nvidia-driver-daemonset", 2, 2),
+				daemonSet("nvidia-device-plugin-daemonset", 2, 2),
+				gpuNode("node-1", 8),
+				gpuNode("node-2", 8),
+			},
+			wantStatus: StatusPass,
+			wantChecks: map[string]CheckStatus{
+				"gpu-operator.daemonset.nvidia-driver-daemonset":        CheckStatusPassed,
+				"gpu-operator.daemonset.nvidia-device-plugin-daemonset": CheckStatusPassed,
+				"gpu-operator.gpu.allocatable":                          CheckStatusPassed,
+			},
+			wantTotal: 3,
+		},
+		{
+			name:   "gpu-operator not ready",
+			recipe: testRecipe("gpu-operator"),
+			objects: []runtime.Object{
+				daemonSet("nvidia-driver-daemonset", 2, 1),
+				&corev1.Node{ObjectMeta: v1.ObjectMeta{Name: "node-1"}},
+			},
+			wantStatus: StatusFail,
+			wantChecks: map[string]CheckStatus{
+				"gpu-operator.daemonset.nvidia-driver-daemonset": CheckStatusFailed,
+				"gpu-operator.gpu.allocatable":                   CheckStatusFailed,
+			},
+			wantTotal: 2,
+		},
+		{
+			name:       "gpu-operator not installed",
+			recipe:     testRecipe("gpu-operator"),
+			objects:    []runtime.Object{gpuNode("node-1", 8)},
+			wantStatus: StatusFail,
+			wantChecks: map[string]CheckStatus{
+				"gpu-operator.daemonsets":      CheckStatusFailed,
+				"gpu-operator.gpu.allocatable": CheckStatusPassed,
+			},
+			wantTotal: 2,
+		},
+		{
+			name:   "cert-manager serving",
+			recipe: testRecipe("cert-manager"),
+			objects: []runtime.Object{
+				certManagerWebhook(1),
+				certManagerWebhookConfig([]byte("ca")),
+			},
+			wantStatus: StatusPass,
+			wantChecks: map[string]CheckStatus{
+				"cert-manager.webhook.available": CheckStatusPassed,
+				"cert-manager.webhook.cabundle":  CheckStatusPassed,
+			},
+			wantTotal: 2,
+		},
+		{
+			name:   "cert-manager CA not injected",
+			recipe: testRecipe("cert-manager"),
+			objects: []runtime.Object{
+				certManagerWebhook(0),
+				certManagerWebhookConfig(nil),
+			},
+			wantStatus: StatusFail,
+			wantChecks: map[string]CheckStatus{
+				"cert-manager.webhook.available": CheckStatusFailed,
+				"cert-manager.webhook.cabundle":  CheckStatusFailed,
+			},
+			wantTotal: 2,
+		},
+		{
+			name:   "network-operator policy states",
+			recipe: testRecipe("network-operator"),
+			dynamic: []runtime.Object{
+				nicClusterPolicy("ready-policy", "ready"),
+				nicClusterPolicy("pending-policy", "notReady"),
+			},
+			wantStatus: StatusFail,
+			wantChecks: map[string]CheckStatus{
+				"network-operator.nicclusterpolicy.ready-policy":   CheckStatusPassed,
+				"network-operator.nicclusterpolicy.pending-policy": CheckStatusFailed,
+			},
+			wantTotal: 2,
+		},
+		{
+			name:       "network-operator without policy",
+			recipe:     testRecipe("network-operator"),
+			wantStatus: StatusPartial,
+			wantChecks: map[string]CheckStatus{
+				"network-operator.nicclusterpolicy": CheckStatusSkipped,
+			},
+			wantTotal: 1,
+		},
+		{
+			name: "disabled and aliased components",
+			recipe: &recipe.RecipeResult{ComponentRefs: []recipe.ComponentRef{
+				{Name: "gpu-operator", Enabled: &disabled},
+				{Name: "cm", Component: "cert-manager"},
+			}},
+			objects: []runtime.Object{
+				certManagerWebhook(1),
+				certManagerWebhookConfig([]byte("ca")),
+			},
+			wantStatus: StatusPass,
+			wantChecks: map[string]CheckStatus{
+				"cm.webhook.available": CheckStatusPassed,
+				"cm.webhook.cabundle":  CheckStatusPassed,
+			},
+			wantTotal: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := New(fake.NewClientset(tt.objects...), newDynamicClient(tt.dynamic...),
+				WithVersion("test"))
+
+			result, err := checker.Check(context.Background(), tt.recipe)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Kind != header.KindHealthCheckResult {
+				t.Errorf("Kind = %q, want %q", result.Kind, header.KindHealthCheckResult)
+			}
+			if result.Summary.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q (results: %+v)", result.Summary.Status, tt.wantStatus, result.Results)
+			}
+			if result.Summary.Total != tt.wantTotal {
+				t.Errorf("Total = %d, want %d (results: %+v)", result.Summary.Total, tt.wantTotal, result.Results)
+			}
+
+			got := make(map[string]CheckStatus, len(result.Results))
+			for _, r := range result.Results {
+				got[r.Name] = r.Status
+			}
+			for name, want := range tt.wantChecks {
+				if got[name] != want {
+					t.Errorf("check %q = %q, want %q", name, got[name], want)
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_WithNamespace(t *testing.T) {
+	ds := daemonSet("nvidia-driver-daemonset", 1, 1)
+	ds.Namespace = "eidos-stack"
+
+	checker := New(fake.NewClientset(ds, gpuNode("node-1", 1)), nil, WithNamespace("eidos-stack"))
+	result, err := checker.Check(context.Background(), testRecipe("gpu-operator"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Summary.Status != StatusPass {
+		t.Errorf("Status = %q, want %q (results: %+v)", result.Summary.Status, StatusPass, result.Results)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+)
+
+// Status represents the overall health check outcome.
+type Status string
+
+const (
+	// StatusPass indicates all checks passed.
+	StatusPass Status = "pass"
+
+	// StatusFail indicates one or more checks failed.
+	StatusFail Status = "fail"
+
+	// StatusPartial indicates some checks couldn't be evaluated.
+	StatusPartial Status = "partial"
+)
+
+// CheckStatus represents the outcome of a single check.
+type CheckStatus string
+
+const (
+	// CheckStatusPassed indicates the check was satisfied.
+	CheckStatusPassed CheckStatus = "passed"
+
+	// CheckStatusFailed indicates the check was not satisfied.
+	CheckStatusFailed CheckStatus = "failed"
+
+	// CheckStatusSkipped indicates the check couldn't be evaluated.
+	CheckStatusSkipped CheckStatus = "skipped"
+)
+
+// Result represents the complete health check outcome.
+type Result struct {
+	header.Header `json:",inline" yaml:",inline"`
+
+	// RecipeSource is the path/URI of the recipe that was checked.
+	RecipeSource string `json:"recipeSource" yaml:"recipeSource"`
+
+	// Summary contains aggregate check statistics.
+	Summary Summary `json:"summary" yaml:"summary"`
+
+	// Results contains per-check details.
+	Results []CheckResult `json:"results" yaml:"results"`
+}
+
+// Summary contains aggregate statistics about the health check.
+type Summary struct {
+	// Passed is the count of checks that were satisfied.
+	Passed int `json:"passed" yaml:"passed"`
+
+	// Failed is the count of checks that were not satisfied.
+	Failed int `json:"failed" yaml:"failed"`
+
+	// Skipped is the count of checks that couldn't be evaluated.
+	Skipped int `json:"skipped" yaml:"skipped"`
+
+	// Total is the total number of checks run.
+	Total int `json:"total" yaml:"total"`
+
+	// Status is the overall health status.
+	Status Status `json:"status" yaml:"status"`
+
+	// Duration is how long the checks took.
+	Duration time.Duration `json:"duration" yaml:"duration"`
+}
+
+// CheckResult represents the outcome of a single check.
+type CheckResult struct {
+	// Name identifies the check (e.g., "gpu-operator.daemonset.nvidia-driver-daemonset").
+	Name string `json:"name" yaml:"name"`
+
+	// Component is the recipe component the check belongs to.
+	Component string `json:"component" yaml:"component"`
+
+	// Expected describes the healthy state (e.g., "ready == desired").
+	Expected string `json:"expected" yaml:"expected"`
+
+	// Actual describes the observed state (e.g., "ready=3 desired=4").
+	Actual string `json:"actual" yaml:"actual"`
+
+	// Status is the outcome of this check.
+	Status CheckStatus `json:"status" yaml:"status"`
+
+	// Message provides additional context, especially for failures or skipped checks.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// NewResult creates a new Result with initialized slices.
+func NewResult() *Result {
+	return &Result{
+		Results: make([]CheckResult, 0),
+	}
+}
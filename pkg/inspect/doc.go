@@ -0,0 +1,42 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inspect reports the size and contents of snapshots, recipes and
+// other Eidos documents.
+//
+// Snapshots grow with the number of collectors and nodes, and recipes with
+// the number of components and inline overrides. A Report shows where the
+// bytes are, so large documents can be trimmed before they are shared or
+// stored in a ConfigMap:
+//   - kind, API version and, for snapshots, the schema version
+//   - the approximate JSON and YAML size of the document and of each
+//     top-level field
+//   - for snapshots, the readings and size per measurement type and subtype
+//   - for recipes, the number of component references and constraints
+//
+// Sizes are measured by re-encoding the decoded document, so they are
+// approximate: comments and formatting of the original file are not counted.
+//
+//	doc, err := serializer.FromFile[map[string]any]("snapshot.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//	report, err := inspect.Inspect("snapshot.yaml", *doc)
+//	if err != nil {
+//	    return err
+//	}
+//	report.WriteText(os.Stdout)
+//
+// Reports also serialize to JSON and YAML for tooling.
+package inspect
@@ -0,0 +1,324 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Report describes the size and contents of a serialized document.
+type Report struct {
+	// Source is the location the document was read from.
+	Source string `json:"source" yaml:"source"`
+
+	// Kind is the document kind (e.g., "Snapshot", "recipeResult").
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
+
+	// APIVersion is the API version of the document.
+	APIVersion string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+
+	// SchemaVersion is the document layout version of snapshots.
+	SchemaVersion int `json:"schemaVersion,omitempty" yaml:"schemaVersion,omitempty"`
+
+	// Size is the serialized size of the whole document.
+	Size Size `json:"size" yaml:"size"`
+
+	// Sections lists the top-level fields, largest first.
+	Sections []Section `json:"sections,omitempty" yaml:"sections,omitempty"`
+
+	// Measurements lists the measurement types of a snapshot, largest first.
+	Measurements []MeasurementStats `json:"measurements,omitempty" yaml:"measurements,omitempty"`
+
+	// ComponentRefs counts the component references of a recipe.
+	ComponentRefs *ComponentRefStats `json:"componentRefs,omitempty" yaml:"componentRefs,omitempty"`
+
+	// Constraints is the number of recipe constraints.
+	Constraints int `json:"constraints,omitempty" yaml:"constraints,omitempty"`
+}
+
+// Size is the approximate size of a value when serialized, in bytes.
+type Size struct {
+	// JSON is the size of the compact JSON encoding.
+	JSON int `json:"json" yaml:"json"`
+
+	// YAML is the size of the YAML encoding.
+	YAML int `json:"yaml" yaml:"yaml"`
+}
+
+// Section describes a top-level field of the document.
+type Section struct {
+	// Name is the field name.
+	Name string `json:"name" yaml:"name"`
+
+	// Bytes is the size of the field value in compact JSON.
+	Bytes int `json:"bytes" yaml:"bytes"`
+}
+
+// MeasurementStats describes a snapshot measurement type.
+type MeasurementStats struct {
+	// Type is the measurement type (e.g., "K8s", "GPU").
+	Type string `json:"type" yaml:"type"`
+
+	// Readings is the number of readings across all subtypes.
+	Readings int `json:"readings" yaml:"readings"`
+
+	// Bytes is the size of the measurement in compact JSON.
+	Bytes int `json:"bytes" yaml:"bytes"`
+
+	// Subtypes lists the subtypes of the measurement, largest first.
+	Subtypes []SubtypeStats `json:"subtypes,omitempty" yaml:"subtypes,omitempty"`
+}
+
+// SubtypeStats describes a subtype of a snapshot measurement.
+type SubtypeStats struct {
+	// Name is the subtype name.
+	Name string `json:"name" yaml:"name"`
+
+	// Readings is the number of data readings in the subtype.
+	Readings int `json:"readings" yaml:"readings"`
+
+	// Bytes is the size of the subtype in compact JSON.
+	Bytes int `json:"bytes" yaml:"bytes"`
+}
+
+// ComponentRefStats counts the component references of a recipe.
+type ComponentRefStats struct {
+	// Total is the number of component references.
+	Total int `json:"total" yaml:"total"`
+
+	// Disabled is the number of references with enabled set to false.
+	Disabled int `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+
+	// WithOverrides is the number of references with inline overrides.
+	WithOverrides int `json:"withOverrides,omitempty" yaml:"withOverrides,omitempty"`
+
+	// Bytes is the size of all references in compact JSON.
+	Bytes int `json:"bytes" yaml:"bytes"`
+}
+
+// Inspect reports the kind, contents and serialized sizes of doc, a decoded
+// snapshot, recipe or any other Eidos document read from source.
+func Inspect(source string, doc map[string]any) (*Report, error) {
+	r := &Report{Source: source}
+	r.Kind, _ = doc["kind"].(string)
+	r.APIVersion, _ = doc["apiVersion"].(string)
+	r.SchemaVersion = toInt(doc["schemaVersion"])
+
+	var err error
+	if r.Size.JSON, err = jsonSize(doc); err != nil {
+		return nil, err
+	}
+	yamlData, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize document to YAML: %w", err)
+	}
+	r.Size.YAML = len(yamlData)
+
+	for name, value := range doc {
+		size, sizeErr := jsonSize(value)
+		if sizeErr != nil {
+			return nil, sizeErr
+		}
+		r.Sections = append(r.Sections, Section{Name: name, Bytes: size})
+	}
+	sort.Slice(r.Sections, func(i, j int) bool {
+		return largerFirst(r.Sections[i].Bytes, r.Sections[j].Bytes, r.Sections[i].Name, r.Sections[j].Name)
+	})
+
+	if measurements, ok := doc["measurements"].([]any); ok {
+		if r.Measurements, err = measurementStats(measurements); err != nil {
+			return nil, err
+		}
+	}
+
+	if refs, ok := doc["componentRefs"].([]any); ok {
+		if r.ComponentRefs, err = componentRefStats(refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if constraints, ok := doc["constraints"].([]any); ok {
+		r.Constraints = len(constraints)
+	}
+
+	return r, nil
+}
+
+// measurementStats counts the readings of each measurement type and subtype.
+func measurementStats(measurements []any) ([]MeasurementStats, error) {
+	stats := make([]MeasurementStats, 0, len(measurements))
+	for _, item := range measurements {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		ms := MeasurementStats{}
+		ms.Type, _ = m["type"].(string)
+
+		var err error
+		if ms.Bytes, err = jsonSize(m); err != nil {
+			return nil, err
+		}
+
+		subtypes, _ := m["subtypes"].([]any)
+		for _, st := range subtypes {
+			sm, ok := st.(map[string]any)
+			if !ok {
+				continue
+			}
+			ss := SubtypeStats{}
+			ss.Name, _ = sm["subtype"].(string)
+			if data, ok := sm["data"].(map[string]any); ok {
+				ss.Readings = len(data)
+			}
+			if ss.Bytes, err = jsonSize(sm); err != nil {
+				return nil, err
+			}
+			ms.Readings += ss.Readings
+			ms.Subtypes = append(ms.Subtypes, ss)
+		}
+		sort.Slice(ms.Subtypes, func(i, j int) bool {
+			return largerFirst(ms.Subtypes[i].Bytes, ms.Subtypes[j].Bytes, ms.Subtypes[i].Name, ms.Subtypes[j].Name)
+		})
+
+		stats = append(stats, ms)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return largerFirst(stats[i].Bytes, stats[j].Bytes, stats[i].Type, stats[j].Type)
+	})
+	return stats, nil
+}
+
+// componentRefStats counts the component references of a recipe.
+func componentRefStats(refs []any) (*ComponentRefStats, error) {
+	stats := &ComponentRefStats{Total: len(refs)}
+	var err error
+	if stats.Bytes, err = jsonSize(refs); err != nil {
+		return nil, err
+	}
+	for _, item := range refs {
+		ref, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if enabled, ok := ref["enabled"].(bool); ok && !enabled {
+			stats.Disabled++
+		}
+		if overrides, ok := ref["overrides"].(map[string]any); ok && len(overrides) > 0 {
+			stats.WithOverrides++
+		}
+	}
+	return stats, nil
+}
+
+// jsonSize returns the length of the compact JSON encoding of v.
+func jsonSize(v any) (int, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize document to JSON: %w", err)
+	}
+	return len(data), nil
+}
+
+// largerFirst orders by size, largest first, then by name.
+func largerFirst(sizeA, sizeB int, nameA, nameB string) bool {
+	if sizeA != sizeB {
+		return sizeA > sizeB
+	}
+	return nameA < nameB
+}
+
+// toInt converts a decoded JSON or YAML number to int.
+func toInt(v any) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	}
+	return 0
+}
+
+// WriteText writes a human-readable rendering of the report to w.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Document: %s\n", r.Source)
+	fmt.Fprintf(&b, "Kind: %s\n", describe(r.Kind))
+	fmt.Fprintf(&b, "API version: %s\n", describe(r.APIVersion))
+	if r.SchemaVersion > 0 {
+		fmt.Fprintf(&b, "Schema version: %d\n", r.SchemaVersion)
+	}
+	fmt.Fprintf(&b, "Size: %s JSON, %s YAML\n", formatBytes(r.Size.JSON), formatBytes(r.Size.YAML))
+
+	if len(r.Sections) > 0 {
+		b.WriteString("\nSections:\n")
+		for _, s := range r.Sections {
+			fmt.Fprintf(&b, "  %-24s %10s\n", s.Name, formatBytes(s.Bytes))
+		}
+	}
+
+	if len(r.Measurements) > 0 {
+		b.WriteString("\nMeasurements:\n")
+		for _, m := range r.Measurements {
+			fmt.Fprintf(&b, "  %-24s %10s  %d readings\n", m.Type, formatBytes(m.Bytes), m.Readings)
+			for _, s := range m.Subtypes {
+				fmt.Fprintf(&b, "    %-22s %10s  %d readings\n", s.Name, formatBytes(s.Bytes), s.Readings)
+			}
+		}
+	}
+
+	if r.ComponentRefs != nil {
+		c := r.ComponentRefs
+		fmt.Fprintf(&b, "\nComponent refs: %d (%d disabled, %d with overrides), %s\n",
+			c.Total, c.Disabled, c.WithOverrides, formatBytes(c.Bytes))
+	}
+	if r.Constraints > 0 {
+		fmt.Fprintf(&b, "Constraints: %d\n", r.Constraints)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// describe renders an empty field as "(none)".
+func describe(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+// formatBytes formats bytes into human-readable format.
+func formatBytes(bytes int) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := unit, 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspect
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const testSnapshot = `kind: Snapshot
+apiVersion: eidos.nvidia.com/v1alpha1
+schemaVersion: 2
+metadata:
+  version: v1.0.0
+measurements:
+  - type: K8s
+    subtypes:
+      - subtype: server
+        data:
+          version: v1.33.5
+      - subtype: image
+        data:
+          gpu-operator: v25.3.3
+          driver: 580.82.07
+          dcgm-exporter: 4.2.3
+  - type: OS
+    subtypes:
+      - subtype: sysctl
+        data:
+          /proc/sys/kernel/threads-max: "1000"
+`
+
+const testRecipe = `kind: recipeResult
+apiVersion: eidos.nvidia.com/v1alpha1
+constraints:
+  - name: K8s.server.version
+    value: ">= 1.30"
+componentRefs:
+  - name: cert-manager
+    enabled: false
+  - name: gpu-operator
+    overrides:
+      driver:
+        version: 580.82.07
+  - name: prometheus
+`
+
+func decode(t *testing.T, content string) map[string]any {
+	t.Helper()
+	var doc map[string]any
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatalf("failed to decode document: %v", err)
+	}
+	return doc
+}
+
+func TestInspect_Snapshot(t *testing.T) {
+	r, err := Inspect("snapshot.yaml", decode(t, testSnapshot))
+	if err != nil {
+		t.Fatalf("Inspect() error = %v", err)
+	}
+
+	if r.Kind != "Snapshot" || r.APIVersion != "eidos.nvidia.com/v1alpha1" || r.SchemaVersion != 2 {
+		t.Errorf("header = %q %q %d", r.Kind, r.APIVersion, r.SchemaVersion)
+	}
+	if r.Size.JSON == 0 || r.Size.YAML == 0 {
+		t.Errorf("Size = %+v, want non-zero", r.Size)
+	}
+	if len(r.Sections) == 0 || r.Sections[0].Name != "measurements" {
+		t.Errorf("Sections = %+v, want measurements first", r.Sections)
+	}
+	if r.ComponentRefs != nil || r.Constraints != 0 {
+		t.Errorf("snapshot reported recipe stats: %+v, %d", r.ComponentRefs, r.Constraints)
+	}
+
+	if len(r.Measurements) != 2 {
+		t.Fatalf("Measurements = %+v, want 2 types", r.Measurements)
+	}
+	k8s := r.Measurements[0]
+	if k8s.Type != "K8s" || k8s.Readings != 4 || len(k8s.Subtypes) != 2 {
+		t.Errorf("K8s = %+v, want 4 readings in 2 subtypes", k8s)
+	}
+	if k8s.Subtypes[0].Name != "image" || k8s.Subtypes[0].Readings != 3 {
+		t.Errorf("largest K8s subtype = %+v, want image with 3 readings", k8s.Subtypes[0])
+	}
+	if k8s.Bytes <= r.Measurements[1].Bytes {
+		t.Errorf("measurements not ordered by size: %+v", r.Measurements)
+	}
+}
+
+func TestInspect_Recipe(t *testing.T) {
+	r, err := Inspect("recipe.yaml", decode(t, testRecipe))
+	if err != nil {
+		t.Fatalf("Inspect() error = %v", err)
+	}
+
+	if r.Kind != "recipeResult" {
+		t.Errorf("Kind = %q, want recipeResult", r.Kind)
+	}
+	if r.Measurements != nil {
+		t.Errorf("recipe reported measurements: %+v", r.Measurements)
+	}
+	if r.Constraints != 1 {
+		t.Errorf("Constraints = %d, want 1", r.Constraints)
+	}
+	c := r.ComponentRefs
+	if c == nil || c.Total != 3 || c.Disabled != 1 || c.WithOverrides != 1 || c.Bytes == 0 {
+		t.Errorf("ComponentRefs = %+v, want 3 total, 1 disabled, 1 with overrides", c)
+	}
+}
+
+func TestReport_WriteText(t *testing.T) {
+	r, err := Inspect("snapshot.yaml", decode(t, testSnapshot))
+	if err != nil {
+		t.Fatalf("Inspect() error = %v", err)
+	}
+
+	var b strings.Builder
+	if err := r.WriteText(&b); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"Document: snapshot.yaml",
+		"Kind: Snapshot",
+		"Schema version: 2",
+		"Measurements:",
+		"image",
+		"3 readings",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Component refs") {
+		t.Errorf("snapshot output includes component refs:\n%s", out)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes int
+		want  string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KB"},
+		{5 * 1024 * 1024, "5.0 MB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.bytes); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.bytes, got, tt.want)
+		}
+	}
+}
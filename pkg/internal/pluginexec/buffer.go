@@ -0,0 +1,39 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginexec
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// LimitedBuffer is a buffer that holds at most Limit bytes. Writes past the
+// limit fail, or are silently dropped when Truncate is set.
+type LimitedBuffer struct {
+	bytes.Buffer
+	Limit    int
+	Truncate bool
+}
+
+func (b *LimitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.Limit - b.Len(); len(p) > remaining {
+		if !b.Truncate {
+			return 0, fmt.Errorf("output exceeds %d bytes", b.Limit)
+		}
+		b.Buffer.Write(p[:max(remaining, 0)])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginexec
+
+import "testing"
+
+func TestLimitedBuffer(t *testing.T) {
+	b := &LimitedBuffer{Limit: 4}
+	if _, err := b.Write([]byte("abcdef")); err == nil {
+		t.Error("expected error writing past the limit")
+	}
+
+	tb := &LimitedBuffer{Limit: 4, Truncate: true}
+	if n, err := tb.Write([]byte("abcdef")); err != nil || n != 6 {
+		t.Errorf("Write() = %d, %v, want 6, nil", n, err)
+	}
+	if tb.String() != "abcd" {
+		t.Errorf("truncated buffer = %q, want abcd", tb.String())
+	}
+}
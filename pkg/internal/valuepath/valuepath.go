@@ -0,0 +1,66 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package valuepath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flatten returns the leaf values of a nested values tree keyed by their
+// dot-notation path. Lists, scalars and empty maps are leaves.
+func Flatten(values map[string]any) map[string]any {
+	out := make(map[string]any)
+	flatten(values, "", out)
+	return out
+}
+
+func flatten(values map[string]any, prefix string, out map[string]any) {
+	for key, value := range values {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+			flatten(nested, path, out)
+			continue
+		}
+		out[path] = value
+	}
+}
+
+// Lookup returns the scalar at a dot-notation path of values, formatted as a
+// string. Missing keys, nil values, maps and lists are not found.
+func Lookup(values map[string]any, path string) (string, bool) {
+	if path == "" {
+		return "", false
+	}
+	var current any = values
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if current, ok = m[key]; !ok {
+			return "", false
+		}
+	}
+	switch current.(type) {
+	case nil, map[string]any, []any:
+		return "", false
+	default:
+		return fmt.Sprint(current), true
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package valuepath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	got := Flatten(map[string]any{
+		"driver": map[string]any{
+			"version": "570",
+			"env":     []any{"A=1"},
+			"rdma":    map[string]any{"enabled": true},
+		},
+		"empty":    map[string]any{},
+		"replicas": 2,
+	})
+	want := map[string]any{
+		"driver.version":      "570",
+		"driver.env":          []any{"A=1"},
+		"driver.rdma.enabled": true,
+		"empty":               map[string]any{},
+		"replicas":            2,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	values := map[string]any{
+		"driver":   map[string]any{"version": "580.82.07", "rdma": true, "env": []any{"A"}, "repository": nil},
+		"replicas": 2,
+	}
+
+	tests := []struct {
+		path   string
+		want   string
+		wantOK bool
+	}{
+		{path: "driver.version", want: "580.82.07", wantOK: true},
+		{path: "driver.rdma", want: "true", wantOK: true},
+		{path: "replicas", want: "2", wantOK: true},
+		{path: "driver", wantOK: false},
+		{path: "driver.env", wantOK: false},
+		{path: "driver.repository", wantOK: false},
+		{path: "driver.version.major", wantOK: false},
+		{path: "missing.path", wantOK: false},
+		{path: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, ok := Lookup(values, tt.path)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Lookup(%q) = (%q, %v), want (%q, %v)", tt.path, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package janitor removes abandoned temporary directories left behind by
+// long-running servers.
+//
+// Request handlers normally remove their scratch directories when they
+// finish, but a crash, a killed pod or a panic can leave them behind. Over
+// weeks of uptime these accumulate in the temp directory. The Janitor runs in
+// the background and reclaims them using two limits:
+//
+//   - TTL: entries older than the TTL are removed
+//   - MaxBytes: when entries exceed this total size, the oldest are removed first
+//
+// Only entries matching the configured glob patterns (default "eidos-bundle-*")
+// are considered, and entries created through the Janitor are never removed
+// while in use:
+//
+//	j := janitor.New(janitor.WithTTL(time.Hour), janitor.WithMaxBytes(1<<30))
+//	go j.Run(ctx)
+//
+//	dir, release, err := j.MkdirTemp("eidos-bundle-*")
+//	if err != nil {
+//	    return err
+//	}
+//	defer release() // removes dir and makes it eligible for collection
+//
+// A directory is moved out of the way with an atomic rename before it is
+// deleted, so a sweep never races with the owner of an in-use directory.
+//
+// # Configuration
+//
+// OptionsFromEnv reads the limits from environment variables:
+//
+//   - Eidos_TEMP_DIR_TTL: Go duration, e.g. "30m" (default defaults.TempDirTTL)
+//   - Eidos_TEMP_DIR_MAX_BYTES: size limit in bytes (default 0, unlimited)
+//   - Eidos_TEMP_DIR_CLEANUP_INTERVAL: Go duration (default defaults.TempDirCleanupInterval)
+//
+// # Metrics
+//
+//   - eidos_janitor_sweeps_total: Completed sweeps
+//   - eidos_janitor_removed_total: Entries removed, by reason (ttl, size)
+//   - eidos_janitor_reclaimed_bytes_total: Bytes reclaimed
+//   - eidos_janitor_tracked_bytes: Size of matching entries after the last sweep
+package janitor
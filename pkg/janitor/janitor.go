@@ -0,0 +1,376 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package janitor
+
+import (
+	"context"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// Environment variable names for janitor configuration.
+const (
+	EnvTempDirTTL             = "Eidos_TEMP_DIR_TTL"
+	EnvTempDirMaxBytes        = "Eidos_TEMP_DIR_MAX_BYTES"
+	EnvTempDirCleanupInterval = "Eidos_TEMP_DIR_CLEANUP_INTERVAL"
+)
+
+const (
+	// DefaultPattern matches the scratch directories of the bundle handler.
+	DefaultPattern = "eidos-bundle-*"
+
+	// trashPrefix marks entries renamed for deletion. Leftovers from an
+	// interrupted deletion are removed on the next sweep.
+	trashPrefix = ".eidos-janitor-"
+
+	// minEvictionAge protects entries not created through this Janitor (for
+	// example by another process sharing the directory) from size-based
+	// eviction while a generation could still be running.
+	minEvictionAge = defaults.BundleHandlerTimeout
+)
+
+// Removal reasons used in metrics and logs.
+const (
+	reasonTTL  = "ttl"
+	reasonSize = "size"
+)
+
+// Janitor periodically removes expired temporary directories.
+// It is safe for concurrent use.
+type Janitor struct {
+	dir      string
+	patterns []string
+	ttl      time.Duration
+	maxBytes int64
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	active map[string]struct{}
+}
+
+// Option is a functional option for configuring Janitor instances.
+type Option func(*Janitor)
+
+// WithDir sets the directory to sweep. Defaults to os.TempDir().
+func WithDir(dir string) Option {
+	return func(j *Janitor) {
+		j.dir = dir
+	}
+}
+
+// WithPatterns sets the glob patterns of entry names eligible for removal.
+// Defaults to DefaultPattern.
+func WithPatterns(patterns ...string) Option {
+	return func(j *Janitor) {
+		j.patterns = patterns
+	}
+}
+
+// WithTTL sets the age after which entries are removed. Zero disables TTL expiry.
+func WithTTL(ttl time.Duration) Option {
+	return func(j *Janitor) {
+		j.ttl = ttl
+	}
+}
+
+// WithMaxBytes sets the total size above which the oldest entries are
+// removed. Zero disables the size limit.
+func WithMaxBytes(maxBytes int64) Option {
+	return func(j *Janitor) {
+		j.maxBytes = maxBytes
+	}
+}
+
+// WithInterval sets how often Run sweeps.
+func WithInterval(interval time.Duration) Option {
+	return func(j *Janitor) {
+		j.interval = interval
+	}
+}
+
+// New creates a Janitor with default TTL and interval from the defaults package.
+func New(opts ...Option) *Janitor {
+	j := &Janitor{
+		dir:      os.TempDir(),
+		patterns: []string{DefaultPattern},
+		ttl:      defaults.TempDirTTL,
+		interval: defaults.TempDirCleanupInterval,
+		now:      time.Now,
+		active:   make(map[string]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(j)
+	}
+
+	return j
+}
+
+// OptionsFromEnv returns options for the limits set in the environment.
+// Unset variables leave the defaults in place.
+// Environment variables:
+//   - Eidos_TEMP_DIR_TTL: Go duration (e.g., "30m"); "0" disables TTL expiry
+//   - Eidos_TEMP_DIR_MAX_BYTES: total size limit in bytes; "0" disables it
+//   - Eidos_TEMP_DIR_CLEANUP_INTERVAL: Go duration between sweeps
+func OptionsFromEnv() ([]Option, error) {
+	var opts []Option
+
+	if v := os.Getenv(EnvTempDirTTL); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl < 0 {
+			return nil, invalidEnv(EnvTempDirTTL, v, err)
+		}
+		opts = append(opts, WithTTL(ttl))
+	}
+
+	if v := os.Getenv(EnvTempDirMaxBytes); v != "" {
+		maxBytes, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || maxBytes < 0 {
+			return nil, invalidEnv(EnvTempDirMaxBytes, v, err)
+		}
+		opts = append(opts, WithMaxBytes(maxBytes))
+	}
+
+	if v := os.Getenv(EnvTempDirCleanupInterval); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			return nil, invalidEnv(EnvTempDirCleanupInterval, v, err)
+		}
+		opts = append(opts, WithInterval(interval))
+	}
+
+	return opts, nil
+}
+
+func invalidEnv(name, value string, err error) error {
+	if err == nil {
+		return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+			"invalid "+name, map[string]any{"value": value})
+	}
+	return eidoserrors.WrapWithContext(eidoserrors.ErrCodeInvalidRequest,
+		"invalid "+name, err, map[string]any{"value": value})
+}
+
+// MkdirTemp creates a temporary directory in the swept directory and marks it
+// in use, so sweeps never remove it. The returned release function removes
+// the directory; it is safe to call more than once.
+func (j *Janitor) MkdirTemp(pattern string) (string, func(), error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	dir, err := os.MkdirTemp(j.dir, pattern)
+	if err != nil {
+		return "", nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to create temporary directory", err)
+	}
+	j.active[dir] = struct{}{}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			if err := os.RemoveAll(dir); err != nil {
+				slog.Warn("failed to remove temporary directory", "path", dir, "error", err)
+			}
+			j.mu.Lock()
+			delete(j.active, dir)
+			j.mu.Unlock()
+		})
+	}
+
+	return dir, release, nil
+}
+
+// Run sweeps immediately and then on every interval until ctx is done.
+func (j *Janitor) Run(ctx context.Context) {
+	slog.Debug("janitor started",
+		"dir", j.dir,
+		"patterns", j.patterns,
+		"ttl", j.ttl,
+		"maxBytes", j.maxBytes,
+		"interval", j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Sweep()
+
+		select {
+		case <-ctx.Done():
+			slog.Debug("janitor stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SweepResult summarizes a single sweep.
+type SweepResult struct {
+	Removed        int
+	ReclaimedBytes int64
+	TrackedBytes   int64
+}
+
+// entry is a directory entry eligible for removal.
+type entry struct {
+	path    string
+	modTime time.Time
+	size    int64
+}
+
+// Sweep removes expired entries once. Entries older than the TTL are removed;
+// then, while the remaining entries exceed MaxBytes, the oldest are removed.
+// Entries in use are never removed.
+func (j *Janitor) Sweep() SweepResult {
+	var res SweepResult
+
+	dirEntries, err := os.ReadDir(j.dir)
+	if err != nil {
+		slog.Warn("janitor failed to read directory", "dir", j.dir, "error", err)
+		return res
+	}
+
+	var entries []entry
+	for _, de := range dirEntries {
+		name := de.Name()
+		path := filepath.Join(j.dir, name)
+
+		if strings.HasPrefix(name, trashPrefix) {
+			size := diskUsage(path)
+			if err := os.RemoveAll(path); err == nil {
+				reclaimedBytes.Add(float64(size))
+				res.ReclaimedBytes += size
+			}
+			continue
+		}
+		if !j.matches(name) {
+			continue
+		}
+
+		info, infoErr := de.Info()
+		if infoErr != nil {
+			continue // removed concurrently
+		}
+		entries = append(entries, entry{path: path, modTime: info.ModTime(), size: diskUsage(path)})
+		res.TrackedBytes += entries[len(entries)-1].size
+	}
+
+	// Oldest first, so size-based eviction removes the stalest entries.
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].modTime.Before(entries[b].modTime)
+	})
+
+	now := j.now()
+	for _, e := range entries {
+		age := now.Sub(e.modTime)
+
+		var reason string
+		switch {
+		case j.ttl > 0 && age > j.ttl:
+			reason = reasonTTL
+		case j.maxBytes > 0 && res.TrackedBytes > j.maxBytes && age > minEvictionAge:
+			reason = reasonSize
+		default:
+			continue
+		}
+
+		if !j.remove(e.path) {
+			continue
+		}
+
+		slog.Debug("janitor removed temporary entry",
+			"path", e.path,
+			"reason", reason,
+			"age", age.Round(time.Second),
+			"bytes", e.size)
+		removedTotal.WithLabelValues(reason).Inc()
+		reclaimedBytes.Add(float64(e.size))
+		res.Removed++
+		res.ReclaimedBytes += e.size
+		res.TrackedBytes -= e.size
+	}
+
+	sweepsTotal.Inc()
+	trackedBytes.Set(float64(res.TrackedBytes))
+
+	if res.Removed > 0 {
+		slog.Info("janitor reclaimed temporary entries",
+			"removed", res.Removed,
+			"bytes", res.ReclaimedBytes,
+			"remainingBytes", res.TrackedBytes)
+	}
+
+	return res
+}
+
+// remove deletes path unless it is in use. The in-use check and an atomic
+// rename happen under the lock, so MkdirTemp owners never lose their
+// directory; the slow recursive delete happens outside it.
+func (j *Janitor) remove(path string) bool {
+	j.mu.Lock()
+	if _, inUse := j.active[path]; inUse {
+		j.mu.Unlock()
+		return false
+	}
+	trash := filepath.Join(filepath.Dir(path), trashPrefix+filepath.Base(path))
+	err := os.Rename(path, trash)
+	j.mu.Unlock()
+
+	if err != nil {
+		slog.Warn("janitor failed to remove temporary entry", "path", path, "error", err)
+		return false
+	}
+	if err := os.RemoveAll(trash); err != nil {
+		// Retried on the next sweep through the trash prefix.
+		slog.Warn("janitor failed to delete temporary entry", "path", trash, "error", err)
+	}
+	return true
+}
+
+func (j *Janitor) matches(name string) bool {
+	for _, pattern := range j.patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// diskUsage returns the total size of the regular files under path.
+func diskUsage(path string) int64 {
+	var total int64
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // entries may disappear during the walk
+		}
+		if d.Type().IsRegular() {
+			if info, infoErr := d.Info(); infoErr == nil {
+				total += info.Size()
+			}
+		}
+		return nil
+	})
+	return total
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package janitor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// makeEntry creates dir/name holding size bytes, last modified age ago.
+func makeEntry(t *testing.T, dir, name string, size int, age time.Duration) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(path, "values.yaml"), make([]byte, size), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	mod := time.Now().Add(-age)
+	if err := os.Chtimes(path, mod, mod); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func TestSweep_TTL(t *testing.T) {
+	dir := t.TempDir()
+	old := makeEntry(t, dir, "eidos-bundle-old", 100, 2*time.Hour)
+	fresh := makeEntry(t, dir, "eidos-bundle-fresh", 100, time.Minute)
+	other := makeEntry(t, dir, "unrelated-old", 100, 2*time.Hour)
+
+	j := New(WithDir(dir), WithTTL(time.Hour))
+	res := j.Sweep()
+
+	if exists(old) {
+		t.Error("expired entry should be removed")
+	}
+	if !exists(fresh) {
+		t.Error("fresh entry should be kept")
+	}
+	if !exists(other) {
+		t.Error("entries not matching the patterns should be kept")
+	}
+	if res.Removed != 1 || res.ReclaimedBytes != 100 || res.TrackedBytes != 100 {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
+
+func TestSweep_MaxBytesRemovesOldestFirst(t *testing.T) {
+	dir := t.TempDir()
+	oldest := makeEntry(t, dir, "eidos-bundle-a", 400, 30*time.Minute)
+	middle := makeEntry(t, dir, "eidos-bundle-b", 400, 20*time.Minute)
+	newest := makeEntry(t, dir, "eidos-bundle-c", 400, 10*time.Minute)
+
+	j := New(WithDir(dir), WithTTL(0), WithMaxBytes(900))
+	res := j.Sweep()
+
+	if exists(oldest) {
+		t.Error("oldest entry should be evicted to get under the size limit")
+	}
+	if !exists(middle) || !exists(newest) {
+		t.Error("newer entries should be kept once under the limit")
+	}
+	if res.TrackedBytes != 800 {
+		t.Errorf("TrackedBytes = %d, want 800", res.TrackedBytes)
+	}
+}
+
+func TestSweep_MaxBytesSkipsRecentEntries(t *testing.T) {
+	dir := t.TempDir()
+	recent := makeEntry(t, dir, "eidos-bundle-recent", 1000, time.Second)
+
+	j := New(WithDir(dir), WithTTL(0), WithMaxBytes(10))
+	j.Sweep()
+
+	if !exists(recent) {
+		t.Error("entries younger than the eviction grace period should be kept")
+	}
+}
+
+func TestSweep_NeverRemovesInUse(t *testing.T) {
+	dir := t.TempDir()
+	j := New(WithDir(dir), WithTTL(time.Nanosecond), WithMaxBytes(1))
+
+	inUse, release, err := j.MkdirTemp(DefaultPattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(inUse, "Chart.yaml"), make([]byte, 100), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(inUse, past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	if res := j.Sweep(); res.Removed != 0 {
+		t.Errorf("removed %d entries, want 0", res.Removed)
+	}
+	if !exists(inUse) {
+		t.Fatal("in-use directory was removed")
+	}
+
+	release()
+	release() // idempotent
+	if exists(inUse) {
+		t.Error("release should remove the directory")
+	}
+}
+
+func TestSweep_RemovesTrashLeftovers(t *testing.T) {
+	dir := t.TempDir()
+	trash := makeEntry(t, dir, trashPrefix+"eidos-bundle-x", 50, 0)
+
+	res := New(WithDir(dir)).Sweep()
+	if exists(trash) {
+		t.Error("interrupted deletions should be finished")
+	}
+	if res.ReclaimedBytes != 50 {
+		t.Errorf("ReclaimedBytes = %d, want 50", res.ReclaimedBytes)
+	}
+}
+
+func TestSweep_ConcurrentMkdirTemp(t *testing.T) {
+	dir := t.TempDir()
+	j := New(WithDir(dir), WithTTL(time.Nanosecond))
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 50)
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d, release, err := j.MkdirTemp(DefaultPattern)
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			defer release()
+			j.Sweep()
+			if err := os.WriteFile(filepath.Join(d, "f"), []byte("x"), 0o600); err != nil {
+				errs <- "in-use directory disappeared: " + err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Error(e)
+	}
+}
+
+func TestRun_StopsOnCancel(t *testing.T) {
+	dir := t.TempDir()
+	old := makeEntry(t, dir, "eidos-bundle-old", 10, 2*time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		New(WithDir(dir), WithTTL(time.Hour), WithInterval(time.Hour)).Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for exists(old) {
+		select {
+		case <-deadline:
+			t.Fatal("Run did not sweep on start")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not stop after cancel")
+	}
+}
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Setenv(EnvTempDirTTL, "30m")
+	t.Setenv(EnvTempDirMaxBytes, "1073741824")
+	t.Setenv(EnvTempDirCleanupInterval, "1m")
+
+	opts, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatalf("OptionsFromEnv() error = %v", err)
+	}
+	j := New(opts...)
+	if j.ttl != 30*time.Minute || j.maxBytes != 1<<30 || j.interval != time.Minute {
+		t.Errorf("unexpected config: ttl=%v maxBytes=%d interval=%v", j.ttl, j.maxBytes, j.interval)
+	}
+}
+
+func TestOptionsFromEnv_Invalid(t *testing.T) {
+	tests := []struct {
+		env   string
+		value string
+	}{
+		{EnvTempDirTTL, "soon"},
+		{EnvTempDirTTL, "-1m"},
+		{EnvTempDirMaxBytes, "1Gi"},
+		{EnvTempDirMaxBytes, "-5"},
+		{EnvTempDirCleanupInterval, "0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env+"="+tt.value, func(t *testing.T) {
+			t.Setenv(tt.env, tt.value)
+			_, err := OptionsFromEnv()
+			if err == nil || !strings.Contains(err.Error(), tt.env) {
+				t.Errorf("expected error naming %s, got %v", tt.env, err)
+			}
+		})
+	}
+}
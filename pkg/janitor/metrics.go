@@ -0,0 +1,51 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package janitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	sweepsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "eidos_janitor_sweeps_total",
+			Help: "Total number of temporary directory sweeps",
+		},
+	)
+
+	removedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_janitor_removed_total",
+			Help: "Total number of temporary entries removed by the janitor",
+		},
+		[]string{"reason"},
+	)
+
+	reclaimedBytes = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "eidos_janitor_reclaimed_bytes_total",
+			Help: "Total number of bytes reclaimed by the janitor",
+		},
+	)
+
+	trackedBytes = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "eidos_janitor_tracked_bytes",
+			Help: "Size in bytes of temporary entries remaining after the last sweep",
+		},
+	)
+)
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -33,6 +34,11 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 		return fmt.Errorf("insufficient permissions to deploy agent: %w\n\nTo deploy the agent, you need cluster admin privileges or ask your cluster admin to run:\n  kubectl apply -f deployments/eidos-agent/1-deps.yaml\n  kubectl apply -f deployments/eidos-agent/2-job.yaml", err)
 	}
 
+	// Verify the targeted nodes can run the agent before creating anything
+	if err := d.checkTargetNodes(ctx); err != nil {
+		return err
+	}
+
 	// Step 1: Ensure RBAC resources (idempotent - reuses if already exists)
 	if err := d.ensureServiceAccount(ctx); err != nil {
 		return fmt.Errorf("failed to create ServiceAccount: %w", err)
@@ -63,11 +69,33 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 }
 
 // WaitForCompletion waits for the agent Job to complete successfully.
-// Returns error if the Job fails or times out.
+// Returns a *JobFailure describing the reason if the Job fails or times out.
 func (d *Deployer) WaitForCompletion(ctx context.Context, timeout time.Duration) error {
 	return d.waitForJobCompletion(ctx, timeout)
 }
 
+// RetryOnOtherNode re-creates the Job after failure, keeping its pod off the
+// node the failed run used and off nodes of earlier failed runs. Returns an
+// error if the failure names no node or the Job cannot be re-created.
+func (d *Deployer) RetryOnOtherNode(ctx context.Context, failure *JobFailure) error {
+	if failure == nil || failure.Node == "" {
+		return fmt.Errorf("cannot retry on a different node: failed node unknown")
+	}
+	if !slices.Contains(d.excludedNodes, failure.Node) {
+		d.excludedNodes = append(d.excludedNodes, failure.Node)
+	}
+	d.attempt++
+
+	slog.Debug("re-creating agent job on a different node",
+		slog.Int("attempt", d.attempt),
+		slog.Any("excluded_nodes", d.excludedNodes))
+
+	if err := d.ensureJob(ctx); err != nil {
+		return fmt.Errorf("failed to create Job: %w", err)
+	}
+	return nil
+}
+
 // GetSnapshot retrieves the snapshot data from the ConfigMap created by the agent.
 // Returns the snapshot YAML content.
 func (d *Deployer) GetSnapshot(ctx context.Context) ([]byte, error) {
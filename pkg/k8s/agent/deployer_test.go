@@ -17,6 +17,7 @@ package agent
 import (
 	"bytes"
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +27,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
 )
 
 const testName = "eidos"
@@ -124,8 +127,8 @@ func TestDeployer_EnsureRBAC(t *testing.T) {
 		}
 
 		// Verify policy rules
-		if len(cr.Rules) != 4 {
-			t.Errorf("expected 4 rules, got %d", len(cr.Rules))
+		if len(cr.Rules) != 6 {
+			t.Errorf("expected 6 rules, got %d", len(cr.Rules))
 		}
 	})
 
@@ -257,8 +260,17 @@ func TestDeployer_EnsureJob(t *testing.T) {
 		}
 
 		// Verify volumes
-		if len(job.Spec.Template.Spec.Volumes) != 2 {
-			t.Errorf("expected 2 volumes, got %d", len(job.Spec.Template.Spec.Volumes))
+		if len(job.Spec.Template.Spec.Volumes) != 3 {
+			t.Errorf("expected 3 volumes, got %d", len(job.Spec.Template.Spec.Volumes))
+		}
+		var hostRootEnv string
+		for _, env := range container.Env {
+			if env.Name == "EIDOS_HOST_ROOT" {
+				hostRootEnv = env.Value
+			}
+		}
+		if hostRootEnv != hostRoot {
+			t.Errorf("EIDOS_HOST_ROOT = %q, want %q", hostRootEnv, hostRoot)
 		}
 	})
 
@@ -357,6 +369,128 @@ func TestDeployer_EnsureJob_Unprivileged(t *testing.T) {
 	}
 }
 
+func TestDeployer_BuildJob_PluginArgs(t *testing.T) {
+	config := Config{
+		Namespace:     "test-namespace",
+		JobName:       testName,
+		Output:        "cm://test-namespace/eidos-snapshot",
+		PluginDir:     "/opt/eidos/plugins",
+		PluginTimeout: 10 * time.Second,
+	}
+	job := NewDeployer(fake.NewClientset(), config).buildJob()
+
+	want := []string{"snapshot", "-o", config.Output, "--plugin-dir", "/opt/eidos/plugins", "--plugin-timeout", "10s"}
+	got := job.Spec.Template.Spec.Containers[0].Args
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+
+	config.PluginDir = ""
+	job = NewDeployer(fake.NewClientset(), config).buildJob()
+	if got := job.Spec.Template.Spec.Containers[0].Args; len(got) != 3 {
+		t.Errorf("args without plugin dir = %v, want no plugin flags", got)
+	}
+}
+
+func TestDeployer_BuildJob_RetryPolicy(t *testing.T) {
+	config := Config{
+		Namespace: "test-namespace",
+		JobName:   testName,
+		Output:    "cm://test-namespace/eidos-snapshot",
+	}
+	job := NewDeployer(fake.NewClientset(), config).buildJob()
+	if got := *job.Spec.BackoffLimit; got != 0 {
+		t.Errorf("default BackoffLimit = %d, want 0", got)
+	}
+	if got := *job.Spec.ActiveDeadlineSeconds; got != int64(defaults.K8sJobActiveDeadline.Seconds()) {
+		t.Errorf("default ActiveDeadlineSeconds = %d", got)
+	}
+	if job.Spec.Template.Spec.Affinity != nil {
+		t.Errorf("Affinity = %v, want none before a retry", job.Spec.Template.Spec.Affinity)
+	}
+
+	config.BackoffLimit = 3
+	config.ActiveDeadline = 30 * time.Minute
+	job = NewDeployer(fake.NewClientset(), config).buildJob()
+	if got := *job.Spec.BackoffLimit; got != 3 {
+		t.Errorf("BackoffLimit = %d, want 3", got)
+	}
+	if got := *job.Spec.ActiveDeadlineSeconds; got != 1800 {
+		t.Errorf("ActiveDeadlineSeconds = %d, want 1800", got)
+	}
+}
+
+func TestDeployer_RetryOnOtherNode(t *testing.T) {
+	clientset := fake.NewClientset()
+	config := Config{
+		Namespace: "test-namespace",
+		JobName:   testName,
+		Output:    "cm://test-namespace/eidos-snapshot",
+	}
+	deployer := NewDeployer(clientset, config)
+	ctx := context.Background()
+
+	if err := deployer.RetryOnOtherNode(ctx, &JobFailure{Reason: FailureTimeout}); err == nil {
+		t.Error("RetryOnOtherNode() without a failed node should fail")
+	}
+
+	for _, node := range []string{"gpu-node-1", "gpu-node-2", "gpu-node-1"} {
+		if err := deployer.RetryOnOtherNode(ctx, &JobFailure{Reason: FailurePodFailed, Node: node}); err != nil {
+			t.Fatalf("RetryOnOtherNode(%s) error = %v", node, err)
+		}
+	}
+	if deployer.attempt != 4 {
+		t.Errorf("attempt = %d, want 4", deployer.attempt)
+	}
+
+	job, err := clientset.BatchV1().Jobs(config.Namespace).Get(ctx, config.JobName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Job not found: %v", err)
+	}
+	affinity := job.Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil {
+		t.Fatalf("Affinity = %v, want node anti-affinity", affinity)
+	}
+	expr := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0]
+	if expr.Key != corev1.LabelHostname || expr.Operator != corev1.NodeSelectorOpNotIn ||
+		strings.Join(expr.Values, ",") != "gpu-node-1,gpu-node-2" {
+		t.Errorf("match expression = %+v", expr)
+	}
+}
+
+func TestDeployer_BuildJob_RedactArgs(t *testing.T) {
+	config := Config{
+		Namespace:      "test-namespace",
+		JobName:        testName,
+		Output:         "cm://test-namespace/eidos-snapshot",
+		RedactPatterns: []string{`corp\.example\.com`},
+	}
+	job := NewDeployer(fake.NewClientset(), config).buildJob()
+
+	want := []string{"snapshot", "-o", config.Output, "--redact", "--redact-pattern", `corp\.example\.com`}
+	got := job.Spec.Template.Spec.Containers[0].Args
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+}
+
+func TestDeployer_BuildJob_ScopeArgs(t *testing.T) {
+	config := Config{
+		Namespace:       "test-namespace",
+		JobName:         testName,
+		Output:          "cm://test-namespace/eidos-snapshot",
+		Types:           []string{"OS", "GPU"},
+		ExcludeSubtypes: []string{"OS.sysctl"},
+	}
+	job := NewDeployer(fake.NewClientset(), config).buildJob()
+
+	want := []string{"snapshot", "-o", config.Output, "--types", "OS", "--types", "GPU", "--exclude-subtypes", "OS.sysctl"}
+	got := job.Spec.Template.Spec.Containers[0].Args
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+}
+
 func TestDeployer_Deploy(t *testing.T) {
 	clientset := fake.NewClientset()
 
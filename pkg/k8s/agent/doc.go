@@ -78,6 +78,24 @@ recreated for each snapshot to ensure clean state.
 		// Use snapshot...
 	}
 
+# Failures and Retries
+
+WaitForCompletion returns a *JobFailure naming the reason (BackoffLimitExceeded,
+DeadlineExceeded, OOMKilled, PodFailed, ImagePull, Unschedulable or Timeout),
+the node the pod ran on, its exit code and the tail of the agent logs.
+StreamLogs follows the logs live while the Job runs. Config.BackoffLimit and
+Config.ActiveDeadline set the Job's backoffLimit and activeDeadlineSeconds;
+Config.PollInterval sets how often the Job is re-read besides the watch.
+
+When a failure is Retryable, RetryOnOtherNode re-creates the Job with node
+anti-affinity keeping it off every node where a run failed:
+
+	err := deployer.WaitForCompletion(ctx, 5*time.Minute)
+	var failure *agent.JobFailure
+	if errors.As(err, &failure) && failure.Retryable() {
+		err = deployer.RetryOnOtherNode(ctx, failure)
+	}
+
 # Reconciliation
 
 The deployer ensures idempotent operation:
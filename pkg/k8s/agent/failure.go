@@ -0,0 +1,192 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FailureReason classifies why the agent Job did not produce a snapshot.
+type FailureReason string
+
+const (
+	// FailureTimeout means WaitForCompletion gave up before the Job finished.
+	FailureTimeout FailureReason = "Timeout"
+
+	// FailureDeadlineExceeded means the Job ran past its activeDeadlineSeconds.
+	FailureDeadlineExceeded FailureReason = "DeadlineExceeded"
+
+	// FailureBackoffLimitExceeded means the Job's pods failed more often than
+	// its backoffLimit allows.
+	FailureBackoffLimitExceeded FailureReason = "BackoffLimitExceeded"
+
+	// FailureUnschedulable means no node could run the Job's pod.
+	FailureUnschedulable FailureReason = corev1.PodReasonUnschedulable
+
+	// FailureImagePull means the agent image could not be pulled.
+	FailureImagePull FailureReason = "ImagePull"
+
+	// FailureOOMKilled means the agent container ran out of memory.
+	FailureOOMKilled FailureReason = "OOMKilled"
+
+	// FailurePodFailed means the agent container exited with an error.
+	FailurePodFailed FailureReason = "PodFailed"
+)
+
+// JobFailure describes a failed or timed out agent Job. WaitForCompletion
+// returns it, possibly wrapped, so callers can act on the reason.
+type JobFailure struct {
+	// Reason classifies the failure.
+	Reason FailureReason
+
+	// Message is the Job condition or pod status message.
+	Message string
+
+	// Node is the node the failed pod ran on, or empty if it never ran.
+	Node string
+
+	// ExitCode is the agent container exit code, or zero if it did not exit.
+	ExitCode int32
+
+	// Attempt is the 1-based run of the Job that failed.
+	Attempt int
+
+	// Logs is the tail of the agent container logs, or empty if they could
+	// not be read.
+	Logs string
+}
+
+// failureLogLines is the number of agent log lines kept in a JobFailure.
+const failureLogLines = 50
+
+// Error implements the error interface.
+func (f *JobFailure) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "agent job failed (%s)", f.Reason)
+	if f.Node != "" {
+		fmt.Fprintf(&b, " on node %s", f.Node)
+	}
+	if f.ExitCode != 0 {
+		fmt.Fprintf(&b, " with exit code %d", f.ExitCode)
+	}
+	if f.Message != "" {
+		fmt.Fprintf(&b, ": %s", f.Message)
+	}
+	if f.Logs != "" {
+		fmt.Fprintf(&b, "\n--- agent logs (last %d lines) ---\n%s\n--- end logs ---",
+			failureLogLines, strings.TrimRight(f.Logs, "\n"))
+	}
+	return b.String()
+}
+
+// Retryable reports whether running the Job on a different node may
+// succeed: the pod ran on a known node and the failure is not caused by the
+// image or configuration shared by all nodes.
+func (f *JobFailure) Retryable() bool {
+	if f.Node == "" {
+		return false
+	}
+	return f.Reason != FailureImagePull && f.Reason != FailureUnschedulable
+}
+
+// jobFailureCondition returns the reason and message of the Job's Failed
+// condition, or false if the Job has not failed.
+func jobFailureCondition(job *batchv1.Job) (FailureReason, string, bool) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			reason := FailureReason(condition.Reason)
+			if reason == "" {
+				reason = FailurePodFailed
+			}
+			return reason, condition.Message, true
+		}
+	}
+	return "", "", false
+}
+
+// diagnoseFailure builds a JobFailure for reason and refines it from the
+// status of the most recent agent pod: the node it ran on, its exit code and
+// pod level causes such as OOM kills, image pull errors or scheduling failures.
+func (d *Deployer) diagnoseFailure(ctx context.Context, reason FailureReason, message string) *JobFailure {
+	failure := &JobFailure{
+		Reason:  reason,
+		Message: message,
+		Attempt: d.attempt,
+	}
+
+	pods, err := d.clientset.CoreV1().Pods(d.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/name=eidos",
+	})
+	if err != nil {
+		slog.Debug("could not inspect agent pods", slog.String("error", err.Error()))
+		return failure
+	}
+
+	var pod *corev1.Pod
+	for i := range pods.Items {
+		p := &pods.Items[i]
+		if pod == nil || pod.CreationTimestamp.Before(&p.CreationTimestamp) {
+			pod = p
+		}
+	}
+	if pod == nil {
+		return failure
+	}
+	failure.Node = pod.Spec.NodeName
+
+	if logs, logErr := d.tailPodLogs(ctx, pod.Name, failureLogLines); logErr == nil {
+		failure.Logs = logs
+	} else {
+		slog.Debug("could not read agent logs", slog.String("pod", pod.Name), slog.String("error", logErr.Error()))
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+			condition.Reason == corev1.PodReasonUnschedulable {
+			failure.Reason = FailureUnschedulable
+			failure.Message = condition.Message
+			return failure
+		}
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if waiting := status.State.Waiting; waiting != nil &&
+			(waiting.Reason == "ErrImagePull" || waiting.Reason == "ImagePullBackOff") {
+			failure.Reason = FailureImagePull
+			failure.Message = waiting.Message
+			return failure
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			failure.ExitCode = terminated.ExitCode
+			if terminated.Reason == string(FailureOOMKilled) {
+				failure.Reason = FailureOOMKilled
+			} else if failure.Reason == FailureTimeout {
+				failure.Reason = FailurePodFailed
+			}
+			if terminated.Message != "" {
+				failure.Message = terminated.Message
+			}
+		}
+	}
+
+	return failure
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testJob(conditions ...batchv1.JobCondition) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Namespace: "test-namespace"},
+		Status:     batchv1.JobStatus{Conditions: conditions},
+	}
+}
+
+func testPod(name, node string, status corev1.PodStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app.kubernetes.io/name": "eidos"},
+		},
+		Spec:   corev1.PodSpec{NodeName: node},
+		Status: status,
+	}
+}
+
+func TestDeployer_WaitForCompletion(t *testing.T) {
+	failed := batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  batchv1.JobReasonBackoffLimitExceeded,
+		Message: "Job has reached the specified backoff limit",
+	}
+	terminated := func(reason string, code int32) corev1.PodStatus {
+		return corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: reason, ExitCode: code}},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		objects       []runtime.Object
+		wantReason    FailureReason
+		wantNode      string
+		wantExitCode  int32
+		wantRetryable bool
+	}{
+		{
+			name: "complete",
+			objects: []runtime.Object{testJob(batchv1.JobCondition{
+				Type: batchv1.JobComplete, Status: corev1.ConditionTrue,
+			})},
+		},
+		{
+			name:          "backoff limit exceeded",
+			objects:       []runtime.Object{testJob(failed), testPod("eidos-a", "gpu-node-1", terminated("Error", 1))},
+			wantReason:    FailureBackoffLimitExceeded,
+			wantNode:      "gpu-node-1",
+			wantExitCode:  1,
+			wantRetryable: true,
+		},
+		{
+			name:          "oom killed",
+			objects:       []runtime.Object{testJob(failed), testPod("eidos-a", "gpu-node-2", terminated("OOMKilled", 137))},
+			wantReason:    FailureOOMKilled,
+			wantNode:      "gpu-node-2",
+			wantExitCode:  137,
+			wantRetryable: true,
+		},
+		{
+			name: "image pull",
+			objects: []runtime.Object{testJob(), testPod("eidos-a", "gpu-node-1", corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+				}},
+			})},
+			wantReason: FailureImagePull,
+			wantNode:   "gpu-node-1",
+		},
+		{
+			name: "unschedulable",
+			objects: []runtime.Object{testJob(), testPod("eidos-a", "", corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  corev1.PodReasonUnschedulable,
+					Message: "0/3 nodes are available",
+				}},
+			})},
+			wantReason: FailureUnschedulable,
+		},
+		{
+			name:       "timeout",
+			objects:    []runtime.Object{testJob()},
+			wantReason: FailureTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployer := NewDeployer(fake.NewClientset(tt.objects...), Config{
+				Namespace:    "test-namespace",
+				JobName:      testName,
+				PollInterval: 10 * time.Millisecond,
+			})
+
+			err := deployer.WaitForCompletion(context.Background(), 200*time.Millisecond)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("WaitForCompletion() error = %v", err)
+				}
+				return
+			}
+
+			var failure *JobFailure
+			if !errors.As(err, &failure) {
+				t.Fatalf("WaitForCompletion() error = %v, want *JobFailure", err)
+			}
+			if failure.Reason != tt.wantReason || failure.Node != tt.wantNode || failure.ExitCode != tt.wantExitCode {
+				t.Errorf("failure = %+v, want reason %s node %q exit code %d",
+					failure, tt.wantReason, tt.wantNode, tt.wantExitCode)
+			}
+			if failure.Attempt != 1 {
+				t.Errorf("Attempt = %d, want 1", failure.Attempt)
+			}
+			if tt.wantNode != "" && failure.Logs == "" {
+				t.Error("Logs not set for a pod that ran")
+			}
+			if failure.Retryable() != tt.wantRetryable {
+				t.Errorf("Retryable() = %v, want %v", failure.Retryable(), tt.wantRetryable)
+			}
+		})
+	}
+}
+
+func TestJobFailure_Error(t *testing.T) {
+	failure := &JobFailure{
+		Reason:   FailureOOMKilled,
+		Node:     "gpu-node-1",
+		ExitCode: 137,
+		Message:  "container exceeded its memory limit",
+		Logs:     "collecting GPU measurements\n",
+	}
+	got := failure.Error()
+	for _, want := range []string{"OOMKilled", "gpu-node-1", "137", "memory limit", "agent logs", "collecting GPU measurements"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Error() = %q, missing %q", got, want)
+		}
+	}
+}
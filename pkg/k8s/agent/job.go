@@ -26,8 +26,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
 )
 
+// hostRoot is where privileged agent pods mount host directories the
+// collectors read, such as /etc for the container runtime configuration.
+const hostRoot = "/host"
+
 // ensureJob deletes any existing Job and creates a fresh one.
 func (d *Deployer) ensureJob(ctx context.Context) error {
 	// Delete existing Job if present
@@ -69,6 +75,24 @@ func (d *Deployer) buildJob() *batchv1.Job {
 	if d.config.Debug {
 		args = []string{"--debug", "--log-json", "snapshot", "-o", d.config.Output}
 	}
+	if d.config.PluginDir != "" {
+		args = append(args, "--plugin-dir", d.config.PluginDir)
+		if d.config.PluginTimeout > 0 {
+			args = append(args, "--plugin-timeout", d.config.PluginTimeout.String())
+		}
+	}
+	if d.config.Redact || len(d.config.RedactPatterns) > 0 {
+		args = append(args, "--redact")
+		for _, p := range d.config.RedactPatterns {
+			args = append(args, "--redact-pattern", p)
+		}
+	}
+	for _, t := range d.config.Types {
+		args = append(args, "--types", t)
+	}
+	for _, st := range d.config.ExcludeSubtypes {
+		args = append(args, "--exclude-subtypes", st)
+	}
 
 	// Build pod spec based on privileged mode
 	podSpec := d.buildPodSpec(args)
@@ -85,9 +109,9 @@ func (d *Deployer) buildJob() *batchv1.Job {
 			Completions:             ptr.To(int32(1)),
 			Parallelism:             ptr.To(int32(1)),
 			CompletionMode:          ptr.To(batchv1.NonIndexedCompletion),
-			BackoffLimit:            ptr.To(int32(0)),
+			BackoffLimit:            ptr.To(d.config.BackoffLimit),
 			TTLSecondsAfterFinished: ptr.To(int32(3600)),
-			ActiveDeadlineSeconds:   ptr.To(int64(18000)), // 5 hours
+			ActiveDeadlineSeconds:   ptr.To(int64(d.activeDeadline().Seconds())),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{
@@ -107,7 +131,7 @@ func (d *Deployer) buildPodSpec(args []string) corev1.PodSpec {
 	spec := corev1.PodSpec{
 		ServiceAccountName: d.config.ServiceAccountName,
 		RestartPolicy:      corev1.RestartPolicyNever,
-		NodeSelector:       d.config.NodeSelector,
+		NodeSelector:       d.nodeSelector(),
 		Tolerations:        d.config.Tolerations,
 		ImagePullSecrets:   toLocalObjectReferences(d.config.ImagePullSecrets),
 		Containers: []corev1.Container{
@@ -148,6 +172,10 @@ func (d *Deployer) buildPodSpec(args []string) corev1.PodSpec {
 		},
 	}
 
+	if d.config.NodeName != "" || len(d.excludedNodes) > 0 {
+		spec.Affinity = nodeAffinity(d.config.NodeName, d.excludedNodes)
+	}
+
 	if d.config.Privileged {
 		d.applyPrivilegedSettings(&spec)
 	} else {
@@ -157,6 +185,47 @@ func (d *Deployer) buildPodSpec(args []string) corev1.PodSpec {
 	return spec
 }
 
+// activeDeadline returns the configured Job deadline or the default.
+func (d *Deployer) activeDeadline() time.Duration {
+	if d.config.ActiveDeadline > 0 {
+		return d.config.ActiveDeadline
+	}
+	return defaults.K8sJobActiveDeadline
+}
+
+// nodeAffinity returns a node affinity that pins the pod to nodeName, when
+// set, and keeps it off the excluded nodes. Pinning matches the node name
+// field like DaemonSet pods do, so the scheduler still honors taints and
+// tolerations, unlike spec.nodeName.
+func nodeAffinity(nodeName string, excluded []string) *corev1.Affinity {
+	var term corev1.NodeSelectorTerm
+	if len(excluded) > 0 {
+		term.MatchExpressions = []corev1.NodeSelectorRequirement{
+			{
+				Key:      corev1.LabelHostname,
+				Operator: corev1.NodeSelectorOpNotIn,
+				Values:   excluded,
+			},
+		}
+	}
+	if nodeName != "" {
+		term.MatchFields = []corev1.NodeSelectorRequirement{
+			{
+				Key:      metav1.ObjectNameField,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{nodeName},
+			},
+		}
+	}
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{term},
+			},
+		},
+	}
+}
+
 // applyPrivilegedSettings configures the pod for privileged mode (GPU/SystemD/OS collectors).
 func (d *Deployer) applyPrivilegedSettings(spec *corev1.PodSpec) {
 	spec.HostPID = true
@@ -195,6 +264,16 @@ func (d *Deployer) applyPrivilegedSettings(spec *corev1.PodSpec) {
 		Name:      "run-systemd",
 		MountPath: "/run/systemd",
 		ReadOnly:  true,
+	}, corev1.VolumeMount{
+		Name:      "host-etc",
+		MountPath: hostRoot + "/etc",
+		ReadOnly:  true,
+	})
+	// The runtime collector reads container runtime configuration from the
+	// host /etc mounted under hostRoot.
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "EIDOS_HOST_ROOT",
+		Value: hostRoot,
 	})
 
 	spec.Volumes = append(spec.Volumes, corev1.Volume{
@@ -205,6 +284,14 @@ func (d *Deployer) applyPrivilegedSettings(spec *corev1.PodSpec) {
 				Type: ptr.To(corev1.HostPathDirectory),
 			},
 		},
+	}, corev1.Volume{
+		Name: "host-etc",
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: "/etc",
+				Type: ptr.To(corev1.HostPathDirectory),
+			},
+		},
 	})
 }
 
@@ -0,0 +1,120 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// checkTargetNodes verifies the node the Job is pinned to, or at least one
+// node matching the node selector, exists and can run the agent pod. It
+// catches typos in --node-name and --node-selector before a Job is created
+// that would stay Pending until it times out. Without a target nothing is
+// checked.
+func (d *Deployer) checkTargetNodes(ctx context.Context) error {
+	if d.config.NodeName != "" {
+		node, err := d.clientset.CoreV1().Nodes().Get(ctx, d.config.NodeName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("node %q not found", d.config.NodeName)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get node %q: %w", d.config.NodeName, err)
+		}
+		if reason := nodeUnavailable(node); reason != "" {
+			return fmt.Errorf("node %q is %s", d.config.NodeName, reason)
+		}
+		if !labels.SelectorFromSet(d.config.NodeSelector).Matches(labels.Set(node.Labels)) {
+			return fmt.Errorf("node %q does not match node selector %s",
+				d.config.NodeName, labels.SelectorFromSet(d.config.NodeSelector))
+		}
+		return nil
+	}
+
+	if len(d.config.NodeSelector) == 0 {
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(d.config.NodeSelector).String()
+	nodes, err := d.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes matching %s: %w", selector, err)
+	}
+	if len(nodes.Items) == 0 {
+		return fmt.Errorf("no nodes match node selector %s", selector)
+	}
+
+	unavailable := make([]string, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		reason := nodeUnavailable(node)
+		if reason == "" {
+			return nil
+		}
+		unavailable = append(unavailable, fmt.Sprintf("%s (%s)", node.Name, reason))
+	}
+	return fmt.Errorf("no ready nodes match node selector %s: %s", selector, strings.Join(unavailable, ", "))
+}
+
+// nodeUnavailable returns why the agent pod cannot run on node, or an empty
+// string when the node is a Ready and schedulable Linux node.
+func nodeUnavailable(node *corev1.Node) string {
+	if nodeOS := nodeOperatingSystem(node); nodeOS != "" && nodeOS != "linux" {
+		return "a " + nodeOS + " node"
+	}
+	if node.Spec.Unschedulable {
+		return "cordoned"
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			if cond.Status == corev1.ConditionTrue {
+				return ""
+			}
+			return "not Ready"
+		}
+	}
+	return "not Ready"
+}
+
+// nodeOperatingSystem returns the node OS from the well-known label, falling
+// back to the OS the kubelet reports.
+func nodeOperatingSystem(node *corev1.Node) string {
+	if nodeOS := node.Labels[corev1.LabelOSStable]; nodeOS != "" {
+		return nodeOS
+	}
+	return node.Status.NodeInfo.OperatingSystem
+}
+
+// nodeSelector returns the configured node selector restricted to Linux
+// nodes. The agent reads Linux procfs, sysfs and host files, so on a Windows
+// node it would only produce a partially empty snapshot. A selector that
+// already names an OS is kept as is.
+func (d *Deployer) nodeSelector() map[string]string {
+	if _, ok := d.config.NodeSelector[corev1.LabelOSStable]; ok {
+		return d.config.NodeSelector
+	}
+	selector := make(map[string]string, len(d.config.NodeSelector)+1)
+	for k, v := range d.config.NodeSelector {
+		selector[k] = v
+	}
+	selector[corev1.LabelOSStable] = "linux"
+	return selector
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"testing"
+
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testNode(name string, ready bool, labels map[string]string) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+		},
+	}
+}
+
+func TestDeployer_CheckTargetNodes(t *testing.T) {
+	gpu := map[string]string{"nodeGroup": "gpu"}
+	cordoned := testNode("gpu-cordoned", true, gpu)
+	cordoned.Spec.Unschedulable = true
+
+	tests := []struct {
+		name         string
+		nodes        []runtime.Object
+		nodeName     string
+		nodeSelector map[string]string
+		wantErr      string
+	}{
+		{
+			name: "no target",
+		},
+		{
+			name:     "named node ready",
+			nodes:    []runtime.Object{testNode("gpu-1", true, gpu)},
+			nodeName: "gpu-1",
+		},
+		{
+			name:     "named node missing",
+			nodes:    []runtime.Object{testNode("gpu-1", true, gpu)},
+			nodeName: "gpu-2",
+			wantErr:  `node "gpu-2" not found`,
+		},
+		{
+			name:     "named node not ready",
+			nodes:    []runtime.Object{testNode("gpu-1", false, gpu)},
+			nodeName: "gpu-1",
+			wantErr:  `node "gpu-1" is not Ready`,
+		},
+		{
+			name:     "named node cordoned",
+			nodes:    []runtime.Object{cordoned},
+			nodeName: "gpu-cordoned",
+			wantErr:  "is cordoned",
+		},
+		{
+			name:         "named node outside selector",
+			nodes:        []runtime.Object{testNode("cpu-1", true, map[string]string{"nodeGroup": "cpu"})},
+			nodeName:     "cpu-1",
+			nodeSelector: gpu,
+			wantErr:      "does not match node selector nodeGroup=gpu",
+		},
+		{
+			name:         "selector with one ready node",
+			nodes:        []runtime.Object{testNode("gpu-1", false, gpu), testNode("gpu-2", true, gpu)},
+			nodeSelector: gpu,
+		},
+		{
+			name:     "named node runs windows",
+			nodes:    []runtime.Object{testNode("win-1", true, map[string]string{corev1.LabelOSStable: "windows"})},
+			nodeName: "win-1",
+			wantErr:  `node "win-1" is a windows node`,
+		},
+		{
+			name:         "selector matches only windows nodes",
+			nodes:        []runtime.Object{testNode("win-1", true, map[string]string{"nodeGroup": "gpu", corev1.LabelOSStable: "windows"})},
+			nodeSelector: gpu,
+			wantErr:      "win-1 (a windows node)",
+		},
+		{
+			name:         "selector matches nothing",
+			nodes:        []runtime.Object{testNode("cpu-1", true, map[string]string{"nodeGroup": "cpu"})},
+			nodeSelector: gpu,
+			wantErr:      "no nodes match node selector nodeGroup=gpu",
+		},
+		{
+			name:         "selector matches only unavailable nodes",
+			nodes:        []runtime.Object{testNode("gpu-1", false, gpu), cordoned},
+			nodeSelector: gpu,
+			wantErr:      "gpu-1 (not Ready)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployer := NewDeployer(fake.NewClientset(tt.nodes...), Config{
+				NodeName:     tt.nodeName,
+				NodeSelector: tt.nodeSelector,
+			})
+			err := deployer.checkTargetNodes(context.Background())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkTargetNodes() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkTargetNodes() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeployer_Deploy_MissingNode(t *testing.T) {
+	clientset := fake.NewClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authv1.SelfSubjectAccessReview{
+			Status: authv1.SubjectAccessReviewStatus{Allowed: true},
+		}, nil
+	})
+
+	config := Config{
+		Namespace:          "test-namespace",
+		ServiceAccountName: testName,
+		JobName:            testName,
+		NodeName:           "gpu-typo",
+		Output:             "cm://test-namespace/eidos-snapshot",
+	}
+	ctx := context.Background()
+	if err := NewDeployer(clientset, config).Deploy(ctx); err == nil {
+		t.Fatal("Deploy() error = nil, want missing node error")
+	}
+
+	if _, err := clientset.CoreV1().ServiceAccounts(config.Namespace).Get(ctx, testName, metav1.GetOptions{}); err == nil {
+		t.Error("ServiceAccount created for a missing node")
+	}
+}
+
+func TestDeployer_BuildJob_NodeName(t *testing.T) {
+	deployer := NewDeployer(fake.NewClientset(), Config{
+		Namespace: "test-namespace",
+		JobName:   testName,
+		NodeName:  "gpu-1",
+	})
+	deployer.excludedNodes = []string{"gpu-0"}
+
+	affinity := deployer.buildJob().Spec.Template.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil {
+		t.Fatalf("Affinity = %v, want node affinity", affinity)
+	}
+	term := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0]
+	if len(term.MatchFields) != 1 || term.MatchFields[0].Key != "metadata.name" ||
+		term.MatchFields[0].Operator != corev1.NodeSelectorOpIn || term.MatchFields[0].Values[0] != "gpu-1" {
+		t.Errorf("match fields = %+v, want metadata.name In [gpu-1]", term.MatchFields)
+	}
+	if len(term.MatchExpressions) != 1 || term.MatchExpressions[0].Values[0] != "gpu-0" {
+		t.Errorf("match expressions = %+v, want excluded gpu-0", term.MatchExpressions)
+	}
+}
+
+func TestDeployer_BuildJob_LinuxNodeSelector(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodeSelector map[string]string
+		want         map[string]string
+	}{
+		{
+			name: "no selector",
+			want: map[string]string{corev1.LabelOSStable: "linux"},
+		},
+		{
+			name:         "user selector",
+			nodeSelector: map[string]string{"nodeGroup": "gpu"},
+			want:         map[string]string{"nodeGroup": "gpu", corev1.LabelOSStable: "linux"},
+		},
+		{
+			name:         "user selects os",
+			nodeSelector: map[string]string{corev1.LabelOSStable: "windows"},
+			want:         map[string]string{corev1.LabelOSStable: "windows"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployer := NewDeployer(fake.NewClientset(), Config{
+				Namespace:    "test-namespace",
+				JobName:      testName,
+				NodeSelector: tt.nodeSelector,
+			})
+			got := deployer.buildJob().Spec.Template.Spec.NodeSelector
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("NodeSelector = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -38,12 +38,14 @@ type PermissionCheck struct {
 func (d *Deployer) CheckPermissions(ctx context.Context) ([]PermissionCheck, error) {
 	checks := []PermissionCheck{}
 
-	// Required permissions for deployment
-	requiredChecks := []struct {
+	type requiredCheck struct {
 		resource  string
 		verb      string
 		namespace string
-	}{
+	}
+
+	// Required permissions for deployment
+	requiredChecks := []requiredCheck{
 		// Namespace-scoped resources
 		{"serviceaccounts", "create", d.config.Namespace},
 		{"roles", "create", d.config.Namespace},
@@ -60,6 +62,13 @@ func (d *Deployer) CheckPermissions(ctx context.Context) ([]PermissionCheck, err
 		{"jobs", "delete", d.config.Namespace},
 	}
 
+	// Node targeting is checked against the cluster before deployment
+	if d.config.NodeName != "" {
+		requiredChecks = append(requiredChecks, requiredCheck{"nodes", "get", ""})
+	} else if len(d.config.NodeSelector) > 0 {
+		requiredChecks = append(requiredChecks, requiredCheck{"nodes", "list", ""})
+	}
+
 	var missingPermissions []string
 
 	for _, check := range requiredChecks {
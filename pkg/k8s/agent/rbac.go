@@ -116,6 +116,16 @@ func (d *Deployer) ensureClusterRole(ctx context.Context) error {
 				Resources: []string{"services"},
 				Verbs:     []string{"get", "list"},
 			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
+				Verbs:     []string{"get", "list"},
+			},
+			{
+				APIGroups: []string{"admissionregistration.k8s.io"},
+				Resources: []string{"validatingwebhookconfigurations", "mutatingwebhookconfigurations"},
+				Verbs:     []string{"get", "list"},
+			},
 		},
 	}
 
@@ -15,6 +15,8 @@
 package agent
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -29,17 +31,35 @@ type Config struct {
 	JobName            string
 	Image              string
 	ImagePullSecrets   []string
+	NodeName           string // Node the Job is pinned to; empty lets the scheduler pick among NodeSelector matches
 	NodeSelector       map[string]string
 	Tolerations        []corev1.Toleration
 	Output             string
 	Debug              bool
-	Privileged         bool // If true, run with privileged security context (required for GPU/SystemD collectors)
+	Privileged         bool          // If true, run with privileged security context (required for GPU/SystemD collectors)
+	PluginDir          string        // Collector plugin directory inside the agent container; empty disables plugins
+	PluginTimeout      time.Duration // Per-plugin timeout; zero uses the collector default
+	Redact             bool          // If true, the agent redacts sensitive values before writing the snapshot
+	RedactPatterns     []string      // Additional regular expressions redacted by the agent
+	Types              []string      // Measurement types collected by the agent; empty collects all types
+	ExcludeSubtypes    []string      // Type-qualified measurement subtypes dropped by the agent
+	BackoffLimit       int32         // Pod retries within the Job; zero fails the Job on the first pod failure
+	ActiveDeadline     time.Duration // Job activeDeadlineSeconds; zero uses defaults.K8sJobActiveDeadline
+	PollInterval       time.Duration // How often WaitForCompletion re-checks the Job besides the watch; zero uses defaults.K8sJobPollInterval
+	NodeRetries        int           // Times a failed snapshot is retried on a different node (see RetryOnOtherNode)
 }
 
 // Deployer manages the deployment and lifecycle of the agent Job.
 type Deployer struct {
 	clientset kubernetes.Interface
 	config    Config
+
+	// attempt is the 1-based run of the Job, incremented by RetryOnOtherNode
+	attempt int
+
+	// excludedNodes are nodes where earlier runs failed; the Job's pod is
+	// kept off them with node anti-affinity
+	excludedNodes []string
 }
 
 // NewDeployer creates a new agent Deployer with the given configuration.
@@ -47,6 +67,7 @@ func NewDeployer(clientset kubernetes.Interface, config Config) *Deployer {
 	return &Deployer{
 		clientset: clientset,
 		config:    config,
+		attempt:   1,
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -28,9 +29,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
 )
 
 // waitForJobCompletion waits for the Job to complete successfully or fail.
+// Job changes arrive through a watch; the Job is also re-read every poll
+// interval so a missed event or a closed watch does not stall the wait.
+// Failures and timeouts are returned as *JobFailure.
 func (d *Deployer) waitForJobCompletion(ctx context.Context, timeout time.Duration) error {
 	// Use watch API for efficient polling
 	watcher, err := d.clientset.BatchV1().Jobs(d.config.Namespace).Watch(
@@ -49,36 +55,63 @@ func (d *Deployer) waitForJobCompletion(ctx context.Context, timeout time.Durati
 	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	ticker := time.NewTicker(d.pollInterval())
+	defer ticker.Stop()
+
+	events := watcher.ResultChan()
 	for {
+		var job *batchv1.Job
+
 		select {
 		case <-timeoutCtx.Done():
-			return fmt.Errorf("timeout waiting for Job completion after %v", timeout)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return d.diagnoseFailure(ctx, FailureTimeout,
+				fmt.Sprintf("timeout waiting for Job completion after %v", timeout))
 
-		case event, ok := <-watcher.ResultChan():
+		case event, ok := <-events:
 			if !ok {
-				return fmt.Errorf("watch channel closed unexpectedly")
+				// Keep waiting on the poll interval alone
+				slog.Debug("job watch closed, polling", slog.String("job", d.config.JobName))
+				events = nil
+				continue
 			}
 
 			if event.Type == watch.Error {
 				return fmt.Errorf("watch error: %v", event.Object)
 			}
 
-			job, ok := event.Object.(*batchv1.Job)
-			if !ok {
+			if job, ok = event.Object.(*batchv1.Job); !ok {
 				continue
 			}
 
-			// Check for completion
-			for _, condition := range job.Status.Conditions {
-				if condition.Type == batchv1.JobComplete && condition.Status == corev1.ConditionTrue {
-					return nil // Job completed successfully
-				}
-				if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
-					return fmt.Errorf("job failed: %s", condition.Message)
-				}
+		case <-ticker.C:
+			job, err = d.clientset.BatchV1().Jobs(d.config.Namespace).Get(timeoutCtx, d.config.JobName, metav1.GetOptions{})
+			if err != nil {
+				slog.Debug("failed to poll job", slog.String("job", d.config.JobName), slog.String("error", err.Error()))
+				continue
 			}
 		}
+
+		// Check for completion
+		for _, condition := range job.Status.Conditions {
+			if condition.Type == batchv1.JobComplete && condition.Status == corev1.ConditionTrue {
+				return nil // Job completed successfully
+			}
+		}
+		if reason, message, failed := jobFailureCondition(job); failed {
+			return d.diagnoseFailure(ctx, reason, message)
+		}
+	}
+}
+
+// pollInterval returns the configured job poll interval or the default.
+func (d *Deployer) pollInterval() time.Duration {
+	if d.config.PollInterval > 0 {
+		return d.config.PollInterval
 	}
+	return defaults.K8sJobPollInterval
 }
 
 // getSnapshotFromConfigMap retrieves the snapshot data from ConfigMap.
@@ -195,6 +228,26 @@ func (d *Deployer) GetPodLogs(ctx context.Context) (string, error) {
 	return buf.String(), nil
 }
 
+// tailPodLogs returns the last lines of the eidos container logs of pod.
+func (d *Deployer) tailPodLogs(ctx context.Context, pod string, lines int64) (string, error) {
+	req := d.clientset.CoreV1().Pods(d.config.Namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: "eidos",
+		TailLines: &lines,
+	})
+
+	logs, err := req.Stream(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to stream logs: %w", err)
+	}
+	defer logs.Close()
+
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, logs); err != nil {
+		return "", fmt.Errorf("failed to read logs: %w", err)
+	}
+	return buf.String(), nil
+}
+
 // WaitForPodReady waits for the Job's Pod to be in Running state.
 // This is useful for streaming logs before Job completes.
 func (d *Deployer) WaitForPodReady(ctx context.Context, timeout time.Duration) error {
@@ -32,6 +32,11 @@ const (
 	KeyClusterName = "cluster-name"
 	KeyReady       = "ready"
 
+	// KeyClusterNodeCount and KeyGPUNodeCount count the cluster nodes and
+	// the nodes advertising NVIDIA GPUs in the K8s node subtype.
+	KeyClusterNodeCount = "cluster-node-count"
+	KeyGPUNodeCount     = "gpu-node-count"
+
 	// GPU measurement keys
 	KeyGPUDriver = "driver"
 	KeyGPUModel  = "model"
@@ -41,6 +46,10 @@ const (
 	KeyGPUPower  = "power"
 	KeyGPUUUID   = "uuid"
 
+	// KeyGPUPCIDeviceIDs lists the distinct PCI IDs of the GPUs as
+	// comma-separated "vendor:device" pairs, e.g. "10de:2330".
+	KeyGPUPCIDeviceIDs = "pci-device-ids"
+
 	// OS measurement keys
 	KeyOSName    = "name"
 	KeyOSVersion = "os-version"
@@ -69,6 +78,13 @@ const (
 	TypeGPU     Type = "GPU"
 	TypeOS      Type = "OS"
 	TypeSystemD Type = "SystemD"
+	TypeNetwork Type = "Network"
+	TypeCPU     Type = "CPU"
+	TypeRuntime Type = "Runtime"
+
+	// TypePlugin holds measurements emitted by exec collector plugins, one
+	// subtype per plugin.
+	TypePlugin Type = "Plugin"
 )
 
 // Types is the list of all supported measurement types.
@@ -77,6 +93,22 @@ var Types = []Type{
 	TypeGPU,
 	TypeOS,
 	TypeSystemD,
+	TypeNetwork,
+	TypeCPU,
+	TypeRuntime,
+	TypePlugin,
+}
+
+// Subtypes lists the subtypes emitted by the built-in collectors, by
+// measurement type. SystemD and Plugin are absent: their subtypes are the
+// names of the collected services and plugins.
+var Subtypes = map[Type][]string{
+	TypeK8s:     {"server", "image", "policy", "node", "cert-manager"},
+	TypeGPU:     {"smi", "mig", "health", "firmware"},
+	TypeOS:      {"grub", "kmod", "release", "sysctl"},
+	TypeNetwork: {"nic", "ofed", "rdma", "netdev"},
+	TypeCPU:     {"info", "numa", "isolation"},
+	TypeRuntime: {"containerd", "crio", "toolkit"},
 }
 
 // ParseType parses a string into a measurement Type.
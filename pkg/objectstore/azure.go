@@ -0,0 +1,156 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// azureStore reads and writes block blobs with the Azure SDK.
+type azureStore struct {
+	env func(string) string
+
+	once sync.Once
+	cred azcore.TokenCredential
+	err  error
+}
+
+func (s *azureStore) Get(ctx context.Context, u URI) ([]byte, error) {
+	client, err := s.blobClient(u)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DownloadStream(ctx, u.Bucket, u.Key, nil)
+	if err != nil {
+		return nil, requestError(ctx, http.MethodGet, u, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, requestError(ctx, http.MethodGet, u, err)
+	}
+	return data, nil
+}
+
+func (s *azureStore) Put(ctx context.Context, u URI, data []byte, contentType string) error {
+	client, err := s.blobClient(u)
+	if err != nil {
+		return err
+	}
+	opts := &azblob.UploadBufferOptions{}
+	if contentType != "" {
+		opts.HTTPHeaders = &blob.HTTPHeaders{BlobContentType: &contentType}
+	}
+	if _, err := client.UploadBuffer(ctx, u.Bucket, u.Key, data, opts); err != nil {
+		return requestError(ctx, http.MethodPut, u, err)
+	}
+	return nil
+}
+
+// blobClient returns a client for the storage account of u, authorized by
+// AZURE_STORAGE_CONNECTION_STRING when it names that account,
+// AZURE_STORAGE_SAS_TOKEN, AZURE_STORAGE_KEY or DefaultAzureCredential, in
+// that order.
+func (s *azureStore) blobClient(u URI) (*azblob.Client, error) {
+	if u.Key == "" {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, fmt.Sprintf("blob name is required in %s", u))
+	}
+	opts := &azblob.ClientOptions{}
+	opts.Transport = &http.Client{Timeout: defaults.ObjectStoreRequestTimeout}
+
+	var client *azblob.Client
+	var err error
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", u.Account)
+	conn := s.env("AZURE_STORAGE_CONNECTION_STRING")
+	switch account := connectionStringAccount(conn); {
+	case conn != "" && (account == "" || account == u.Account):
+		client, err = azblob.NewClientFromConnectionString(conn, opts)
+	case s.env("AZURE_STORAGE_SAS_TOKEN") != "":
+		sas := strings.TrimPrefix(s.env("AZURE_STORAGE_SAS_TOKEN"), "?")
+		client, err = azblob.NewClientWithNoCredential(serviceURL+"?"+sas, opts)
+	case s.env("AZURE_STORAGE_KEY") != "":
+		var key *azblob.SharedKeyCredential
+		key, err = azblob.NewSharedKeyCredential(u.Account, s.env("AZURE_STORAGE_KEY"))
+		if err == nil {
+			client, err = azblob.NewClientWithSharedKeyCredential(serviceURL, key, opts)
+		}
+	default:
+		var cred azcore.TokenCredential
+		if cred, err = s.credential(); err != nil {
+			return nil, err
+		}
+		client, err = azblob.NewClient(serviceURL, cred, opts)
+	}
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeUnauthorized, fmt.Sprintf("invalid Azure credentials for %s", u.Account), err)
+	}
+	return client, nil
+}
+
+// credential returns the DefaultAzureCredential, which covers environment
+// credentials, AKS workload identity, managed identity and the Azure CLI.
+func (s *azureStore) credential() (azcore.TokenCredential, error) {
+	s.once.Do(func() {
+		cred, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			s.err = errors.Wrap(errors.ErrCodeUnauthorized,
+				"no Azure credentials found: set AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_SAS_TOKEN, AZURE_STORAGE_KEY or an Azure identity", err)
+			return
+		}
+		s.cred = unauthorizedCredential{cred}
+	})
+	return s.cred, s.err
+}
+
+// unauthorizedCredential reports failures to get a token as unauthorized
+// errors rather than unavailable storage.
+type unauthorizedCredential struct {
+	azcore.TokenCredential
+}
+
+func (c unauthorizedCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	token, err := c.TokenCredential.GetToken(ctx, opts)
+	if err != nil {
+		return token, errors.Wrap(errors.ErrCodeUnauthorized, "failed to get Azure access token", err)
+	}
+	return token, nil
+}
+
+// connectionStringAccount returns the AccountName of a Key=Value;...
+// storage connection string. A connection string without one, e.g. for a
+// SAS with a BlobEndpoint, applies to every account.
+func connectionStringAccount(conn string) string {
+	for _, part := range strings.Split(conn, ";") {
+		if key, value, ok := strings.Cut(part, "="); ok && strings.TrimSpace(key) == "AccountName" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package objectstore reads and writes objects in Amazon S3, Google Cloud
+// Storage and Azure Blob Storage, addressed by URI:
+//
+//	s3://bucket/key
+//	gs://bucket/object
+//	az://account/container/blob
+//
+// Fleet tooling keeps snapshots, recipes and bundles in object storage; the
+// serializer uses this package for snapshot output and document input, and
+// the bundle command to upload bundles.
+//
+// # Credentials
+//
+// Credentials are resolved by the cloud SDKs the same way the cloud CLIs
+// resolve them:
+//   - S3: the AWS SDK default credential chain, covering
+//     AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, EKS IRSA web identity,
+//     the AWS_PROFILE profile of the shared config and credentials files,
+//     and container and instance roles. AWS_ENDPOINT_URL_S3 selects an
+//     S3-compatible endpoint such as MinIO, addressed path style.
+//   - GCS: GOOGLE_OAUTH_ACCESS_TOKEN or the Google application default
+//     credentials (GOOGLE_APPLICATION_CREDENTIALS, the gcloud application
+//     default credentials, or the metadata server on GCE and GKE).
+//     STORAGE_EMULATOR_HOST selects an emulator.
+//   - Azure: AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_SAS_TOKEN,
+//     AZURE_STORAGE_KEY, or DefaultAzureCredential, which covers AKS
+//     workload identity, managed identity and the Azure CLI.
+//
+// # Usage
+//
+//	data, err := objectstore.Get(ctx, "s3://fleet/recipes/h100-training.yaml")
+//
+//	err := objectstore.Put(ctx, "gs://fleet/snapshots/node-1.yaml", data, "application/yaml")
+//
+//	uploaded, err := objectstore.UploadDir(ctx, "./bundle", "az://fleetstore/bundles/v1")
+package objectstore
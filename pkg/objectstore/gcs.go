@@ -0,0 +1,132 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	// gcsEndpoint is the Cloud Storage JSON API endpoint.
+	gcsEndpoint = "https://storage.googleapis.com"
+
+	// gcsScope is the OAuth scope requested for application default credentials.
+	gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+)
+
+// gcsStore reads and writes Cloud Storage objects through the JSON API,
+// authorized with application default credentials.
+type gcsStore struct {
+	env func(string) string
+
+	once   sync.Once
+	tokens oauth2.TokenSource
+	err    error
+}
+
+func (s *gcsStore) Get(ctx context.Context, u URI) ([]byte, error) {
+	if u.Key == "" {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, fmt.Sprintf("object name is required in %s", u))
+	}
+	endpoint, emulated := s.endpoint()
+	target := fmt.Sprintf("%s/storage/v1/b/%s/o/%s?alt=media", endpoint, url.PathEscape(u.Bucket), url.PathEscape(u.Key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeInternal, "failed to create GCS request", err)
+	}
+	client, err := s.httpClient(ctx, emulated)
+	if err != nil {
+		return nil, err
+	}
+	return do(client, req, u)
+}
+
+func (s *gcsStore) Put(ctx context.Context, u URI, data []byte, contentType string) error {
+	if u.Key == "" {
+		return errors.New(errors.ErrCodeInvalidRequest, fmt.Sprintf("object name is required in %s", u))
+	}
+	endpoint, emulated := s.endpoint()
+	target := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s",
+		endpoint, url.PathEscape(u.Bucket), url.QueryEscape(u.Key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to create GCS request", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	client, err := s.httpClient(ctx, emulated)
+	if err != nil {
+		return err
+	}
+	_, err = do(client, req, u)
+	return err
+}
+
+// endpoint returns the API endpoint and whether it is an emulator
+// (STORAGE_EMULATOR_HOST), which needs no credentials.
+func (s *gcsStore) endpoint() (string, bool) {
+	host := s.env("STORAGE_EMULATOR_HOST")
+	if host == "" {
+		return gcsEndpoint, false
+	}
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+	return strings.TrimSuffix(host, "/"), true
+}
+
+// httpClient returns a client that authorizes requests with
+// GOOGLE_OAUTH_ACCESS_TOKEN or the application default credentials.
+// Requests to an emulator are sent without a token unless
+// GOOGLE_OAUTH_ACCESS_TOKEN is set.
+func (s *gcsStore) httpClient(ctx context.Context, emulated bool) (*http.Client, error) {
+	client := &http.Client{Timeout: defaults.ObjectStoreRequestTimeout}
+	if token := s.env("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		client.Transport = &oauth2.Transport{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})}
+		return client, nil
+	}
+	if emulated {
+		return client, nil
+	}
+
+	s.once.Do(func() {
+		// The token source outlives the request that first needs it.
+		creds, err := google.FindDefaultCredentials(context.WithoutCancel(ctx), gcsScope)
+		if err != nil {
+			s.err = errors.Wrap(errors.ErrCodeUnauthorized,
+				"no Google credentials found: set GOOGLE_APPLICATION_CREDENTIALS or GOOGLE_OAUTH_ACCESS_TOKEN, run gcloud auth application-default login, or run on GCP", err)
+			return
+		}
+		s.tokens = creds.TokenSource
+	})
+	if s.err != nil {
+		return nil, s.err
+	}
+	client.Transport = &oauth2.Transport{Source: s.tokens}
+	return client, nil
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// URI schemes of object storage locations.
+const (
+	// SchemeS3 addresses Amazon S3 and S3-compatible objects: s3://bucket/key.
+	SchemeS3 = "s3"
+
+	// SchemeGCS addresses Google Cloud Storage objects: gs://bucket/object.
+	SchemeGCS = "gs"
+
+	// SchemeAzure addresses Azure Blob Storage blobs:
+	// az://account/container/blob.
+	SchemeAzure = "az"
+)
+
+// maxErrorBody is how much of an error response is included in errors.
+const maxErrorBody = 512
+
+// URI is a parsed object storage location.
+type URI struct {
+	// Scheme is SchemeS3, SchemeGCS or SchemeAzure.
+	Scheme string
+
+	// Account is the Azure storage account; empty for other schemes.
+	Account string
+
+	// Bucket is the S3 or GCS bucket, or the Azure container.
+	Bucket string
+
+	// Key is the object key or blob name; it may be a prefix.
+	Key string
+}
+
+// String returns the URI in scheme://[account/]bucket/key form.
+func (u URI) String() string {
+	location := u.Bucket
+	if u.Account != "" {
+		location = u.Account + "/" + u.Bucket
+	}
+	if u.Key == "" {
+		return fmt.Sprintf("%s://%s", u.Scheme, location)
+	}
+	return fmt.Sprintf("%s://%s/%s", u.Scheme, location, u.Key)
+}
+
+// Join returns the URI of the object named elem below u.
+func (u URI) Join(elem ...string) URI {
+	parts := append([]string{strings.TrimSuffix(u.Key, "/")}, elem...)
+	u.Key = strings.TrimPrefix(path.Join(parts...), "/")
+	return u
+}
+
+// IsURI reports whether s is an s3://, gs:// or az:// URI.
+func IsURI(s string) bool {
+	for _, scheme := range []string{SchemeS3, SchemeGCS, SchemeAzure} {
+		if strings.HasPrefix(s, scheme+"://") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseURI parses an s3://bucket/key, gs://bucket/object or
+// az://account/container/blob URI. The key may be empty.
+func ParseURI(s string) (URI, error) {
+	scheme, rest, ok := strings.Cut(s, "://")
+	if !ok || !IsURI(s) {
+		return URI{}, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid object storage URI %q: expected s3://, gs:// or az://", s))
+	}
+
+	u := URI{Scheme: scheme}
+	if scheme == SchemeAzure {
+		account, after, found := strings.Cut(rest, "/")
+		if !found || account == "" {
+			return URI{}, errors.New(errors.ErrCodeInvalidRequest,
+				fmt.Sprintf("invalid Azure URI %q: expected az://account/container/blob", s))
+		}
+		u.Account = account
+		rest = after
+	}
+
+	bucket, key, _ := strings.Cut(rest, "/")
+	if bucket == "" {
+		return URI{}, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid object storage URI %q: bucket or container is required", s))
+	}
+	u.Bucket = bucket
+	u.Key = key
+	return u, nil
+}
+
+// Store reads and writes objects of one storage service.
+type Store interface {
+	// Get returns the content of the object at u.
+	Get(ctx context.Context, u URI) ([]byte, error)
+
+	// Put writes data to the object at u, replacing it.
+	Put(ctx context.Context, u URI, data []byte, contentType string) error
+}
+
+// NewStore returns the store for scheme. Credentials are resolved when the
+// first request is made:
+//   - s3: the AWS SDK default credential chain (environment, web identity
+//     as set by EKS IRSA, shared config and credentials files, container
+//     and instance roles)
+//   - gs: GOOGLE_OAUTH_ACCESS_TOKEN or the Google application default
+//     credentials
+//   - az: AZURE_STORAGE_CONNECTION_STRING, AZURE_STORAGE_SAS_TOKEN,
+//     AZURE_STORAGE_KEY, or DefaultAzureCredential (environment, AKS
+//     workload identity, managed identity, Azure CLI)
+func NewStore(scheme string) (Store, error) {
+	switch scheme {
+	case SchemeS3:
+		return &s3Store{}, nil
+	case SchemeGCS:
+		return &gcsStore{env: os.Getenv}, nil
+	case SchemeAzure:
+		return &azureStore{env: os.Getenv}, nil
+	default:
+		return nil, errors.New(errors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unsupported object storage scheme %q", scheme))
+	}
+}
+
+// Get returns the content of the object at uri.
+func Get(ctx context.Context, uri string) ([]byte, error) {
+	u, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewStore(u.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	return store.Get(ctx, u)
+}
+
+// Put writes data to the object at uri.
+func Put(ctx context.Context, uri string, data []byte, contentType string) error {
+	u, err := ParseURI(uri)
+	if err != nil {
+		return err
+	}
+	store, err := NewStore(u.Scheme)
+	if err != nil {
+		return err
+	}
+	return store.Put(ctx, u, data, contentType)
+}
+
+// UploadDir writes every regular file below dir to the objects below uri,
+// keyed by their slash-separated path relative to dir, and returns the URIs
+// written.
+func UploadDir(ctx context.Context, dir, uri string) ([]string, error) {
+	u, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewStore(u.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploaded []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(errors.ErrCodeTimeout, "upload cancelled", err)
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return errors.Wrap(errors.ErrCodeInternal, "failed to resolve bundle file path", err)
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return errors.Wrap(errors.ErrCodeInternal, fmt.Sprintf("failed to read %s", rel), err)
+		}
+		target := u.Join(filepath.ToSlash(rel))
+		if err := store.Put(ctx, target, data, ContentType(rel)); err != nil {
+			return err
+		}
+		uploaded = append(uploaded, target.String())
+		return nil
+	})
+	if err != nil {
+		return uploaded, err
+	}
+	return uploaded, nil
+}
+
+// ContentType returns the content type of an object from its name.
+func ContentType(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return "application/json"
+	case ".yaml", ".yml":
+		return "application/yaml"
+	case ".md":
+		return "text/markdown"
+	case ".sh", ".txt", ".tf", ".prom":
+		return "text/plain"
+	case ".tgz", ".gz":
+		return "application/gzip"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// do sends req and returns the response body, or an error carrying the
+// status and the start of the body when the status is not 2xx.
+func do(client *http.Client, req *http.Request, u URI) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, errors.Wrap(errors.ErrCodeTimeout, fmt.Sprintf("request for %s cancelled", u), ctxErr)
+		}
+		return nil, errors.Wrap(errors.ErrCodeUnavailable, fmt.Sprintf("request for %s failed", u), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrCodeUnavailable, fmt.Sprintf("failed to read response for %s", u), err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return body, nil
+	}
+
+	if len(body) > maxErrorBody {
+		body = body[:maxErrorBody]
+	}
+	msg := fmt.Sprintf("%s %s: %s: %s", req.Method, u, resp.Status, strings.TrimSpace(string(body)))
+	return nil, errors.New(statusCode(resp.StatusCode), msg)
+}
+
+// requestError converts the error of an SDK request for u into a
+// structured error, classified by the HTTP status of the response or the
+// code of a structured error it wraps.
+func requestError(ctx context.Context, method string, u URI, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return errors.Wrap(errors.ErrCodeTimeout, fmt.Sprintf("request for %s cancelled", u), ctxErr)
+	}
+	msg := fmt.Sprintf("%s %s failed", method, u)
+
+	var statusErr interface{ HTTPStatusCode() int }
+	var azureErr *azcore.ResponseError
+	var structured *errors.StructuredError
+	switch {
+	case stderrors.As(err, &statusErr):
+		return errors.Wrap(statusCode(statusErr.HTTPStatusCode()), msg, err)
+	case stderrors.As(err, &azureErr):
+		return errors.Wrap(statusCode(azureErr.StatusCode), msg, err)
+	case stderrors.As(err, &structured):
+		return errors.Wrap(structured.Code, msg, err)
+	default:
+		return errors.Wrap(errors.ErrCodeUnavailable, msg, err)
+	}
+}
+
+// statusCode returns the error code of a failed response with status.
+func statusCode(status int) errors.ErrorCode {
+	switch status {
+	case http.StatusNotFound:
+		return errors.ErrCodeNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return errors.ErrCodeUnauthorized
+	case http.StatusTooManyRequests:
+		return errors.ErrCodeRateLimitExceeded
+	default:
+		return errors.ErrCodeUnavailable
+	}
+}
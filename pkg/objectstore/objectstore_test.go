@@ -0,0 +1,404 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"encoding/base64"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// hasCode reports whether err is a structured error with code.
+func hasCode(err error, code errors.ErrorCode) bool {
+	var se *errors.StructuredError
+	return stderrors.As(err, &se) && se.Code == code
+}
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    URI
+		wantErr bool
+	}{
+		{in: "s3://bucket/path/to/snapshot.yaml", want: URI{Scheme: SchemeS3, Bucket: "bucket", Key: "path/to/snapshot.yaml"}},
+		{in: "gs://bucket/recipe.yaml", want: URI{Scheme: SchemeGCS, Bucket: "bucket", Key: "recipe.yaml"}},
+		{in: "az://account/container/dir/blob.json", want: URI{Scheme: SchemeAzure, Account: "account", Bucket: "container", Key: "dir/blob.json"}},
+		{in: "s3://bucket", want: URI{Scheme: SchemeS3, Bucket: "bucket"}},
+		{in: "s3://", wantErr: true},
+		{in: "az://account", wantErr: true},
+		{in: "http://example.com/a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseURI(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseURI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseURI() = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != tt.in {
+				t.Errorf("String() = %q, want %q", got.String(), tt.in)
+			}
+		})
+	}
+}
+
+func TestURIJoin(t *testing.T) {
+	u := URI{Scheme: SchemeS3, Bucket: "b", Key: "bundles/v1/"}
+	if got := u.Join("gpu-operator/values.yaml").String(); got != "s3://b/bundles/v1/gpu-operator/values.yaml" {
+		t.Errorf("Join() = %q", got)
+	}
+	u.Key = ""
+	if got := u.Join("README.md").String(); got != "s3://b/README.md" {
+		t.Errorf("Join() = %q", got)
+	}
+}
+
+// fakeBucket is an in-memory object server recording request headers.
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	headers []http.Header
+}
+
+func newFakeBucket(t *testing.T, handler func(*fakeBucket) http.HandlerFunc) (*fakeBucket, *httptest.Server) {
+	t.Helper()
+	b := &fakeBucket{objects: map[string][]byte{}}
+	srv := httptest.NewServer(handler(b))
+	t.Cleanup(srv.Close)
+	return b, srv
+}
+
+func (b *fakeBucket) serve(w http.ResponseWriter, r *http.Request, name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.headers = append(b.headers, r.Header.Clone())
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := b.objects[name]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, "<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>")
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodPut, http.MethodPost:
+		data, _ := io.ReadAll(r.Body)
+		b.objects[name] = data
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func (b *fakeBucket) names() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var names []string
+	for name := range b.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func s3Handler(b *fakeBucket) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=") {
+			http.Error(w, "unsigned", http.StatusForbidden)
+			return
+		}
+		b.serve(w, r, r.URL.Path)
+	}
+}
+
+// isolateAWS points the AWS SDK at endpoint and away from the shared
+// configuration and instance metadata of the machine running the tests.
+func isolateAWS(t *testing.T, endpoint string) {
+	t.Helper()
+	missing := filepath.Join(t.TempDir(), "missing")
+	t.Setenv("AWS_ENDPOINT_URL_S3", endpoint)
+	t.Setenv("AWS_CONFIG_FILE", missing)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", missing)
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	t.Setenv("AWS_REGION", "us-east-1")
+	for _, key := range []string{"AWS_PROFILE", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN",
+		"AWS_ROLE_ARN", "AWS_WEB_IDENTITY_TOKEN_FILE", "AWS_CONTAINER_CREDENTIALS_FULL_URI", "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"} {
+		t.Setenv(key, "")
+	}
+}
+
+func envMap(m map[string]string) func(string) string {
+	return func(key string) string { return m[key] }
+}
+
+func TestS3Store(t *testing.T) {
+	bucket, srv := newFakeBucket(t, s3Handler)
+	isolateAWS(t, srv.URL)
+	t.Setenv("AWS_ACCESS_KEY_ID", "id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "session")
+
+	store := &s3Store{}
+	ctx := context.Background()
+	u := URI{Scheme: SchemeS3, Bucket: "fleet", Key: "snapshots/node a.yaml"}
+
+	if err := store.Put(ctx, u, []byte("kind: Snapshot\n"), "application/yaml"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	got, err := store.Get(ctx, u)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(got) != "kind: Snapshot\n" {
+		t.Errorf("Get() = %q", got)
+	}
+	if names := bucket.names(); !reflect.DeepEqual(names, []string{"/fleet/snapshots/node a.yaml"}) {
+		t.Errorf("objects = %v", names)
+	}
+	if token := bucket.headers[0].Get("X-Amz-Security-Token"); token != "session" {
+		t.Errorf("X-Amz-Security-Token = %q", token)
+	}
+	if contentType := bucket.headers[0].Get("Content-Type"); contentType != "application/yaml" {
+		t.Errorf("Content-Type = %q", contentType)
+	}
+
+	_, err = store.Get(ctx, URI{Scheme: SchemeS3, Bucket: "fleet", Key: "missing.yaml"})
+	if !hasCode(err, errors.ErrCodeNotFound) {
+		t.Errorf("Get(missing) error = %v, want not found", err)
+	}
+}
+
+func TestS3Store_Credentials(t *testing.T) {
+	t.Run("shared credentials file", func(t *testing.T) {
+		bucket, srv := newFakeBucket(t, s3Handler)
+		isolateAWS(t, srv.URL)
+		file := filepath.Join(t.TempDir(), "credentials")
+		content := "[default]\naws_access_key_id = d\naws_secret_access_key = d\n\n[fleet]\naws_access_key_id = f\naws_secret_access_key = fs\n"
+		if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("AWS_SHARED_CREDENTIALS_FILE", file)
+		t.Setenv("AWS_PROFILE", "fleet")
+
+		store := &s3Store{}
+		u := URI{Scheme: SchemeS3, Bucket: "fleet", Key: "a.yaml"}
+		if err := store.Put(context.Background(), u, []byte("a: 1\n"), ""); err != nil {
+			t.Fatalf("Put() error = %v", err)
+		}
+		if auth := bucket.headers[0].Get("Authorization"); !strings.Contains(auth, "Credential=f/") {
+			t.Errorf("Authorization = %q, want the fleet profile key", auth)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		_, srv := newFakeBucket(t, s3Handler)
+		isolateAWS(t, srv.URL)
+		store := &s3Store{}
+		_, err := store.Get(context.Background(), URI{Scheme: SchemeS3, Bucket: "fleet", Key: "a.yaml"})
+		if !hasCode(err, errors.ErrCodeUnauthorized) {
+			t.Errorf("Get() error = %v, want unauthorized", err)
+		}
+	})
+}
+
+func gcsHandler(b *fakeBucket) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/fleet/o":
+			b.serve(w, r, r.URL.Query().Get("name"))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/storage/v1/b/fleet/o/"):
+			b.serve(w, r, strings.TrimPrefix(r.URL.Path, "/storage/v1/b/fleet/o/"))
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestGCSStore(t *testing.T) {
+	bucket, srv := newFakeBucket(t, gcsHandler)
+	store := &gcsStore{env: envMap(map[string]string{
+		"STORAGE_EMULATOR_HOST": srv.URL,
+	})}
+	ctx := context.Background()
+	u := URI{Scheme: SchemeGCS, Bucket: "fleet", Key: "recipes/h100.yaml"}
+
+	if err := store.Put(ctx, u, []byte("kind: Recipe\n"), "application/yaml"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	got, err := store.Get(ctx, u)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(got) != "kind: Recipe\n" {
+		t.Errorf("Get() = %q", got)
+	}
+	if names := bucket.names(); !reflect.DeepEqual(names, []string{"recipes/h100.yaml"}) {
+		t.Errorf("objects = %v", names)
+	}
+	if auth := bucket.headers[0].Get("Authorization"); auth != "" {
+		t.Errorf("emulator requests should not be authorized, got %q", auth)
+	}
+}
+
+func TestGCSStore_AccessToken(t *testing.T) {
+	bucket, srv := newFakeBucket(t, gcsHandler)
+	store := &gcsStore{env: envMap(map[string]string{
+		"STORAGE_EMULATOR_HOST":     srv.URL,
+		"GOOGLE_OAUTH_ACCESS_TOKEN": "ya29",
+	})}
+	u := URI{Scheme: SchemeGCS, Bucket: "fleet", Key: "a.yaml"}
+	if err := store.Put(context.Background(), u, []byte("a: 1\n"), ""); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if auth := bucket.headers[0].Get("Authorization"); auth != "Bearer ya29" {
+		t.Errorf("Authorization = %q", auth)
+	}
+}
+
+func TestGCSStore_NoCredentials(t *testing.T) {
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", filepath.Join(t.TempDir(), "missing.json"))
+	store := &gcsStore{env: envMap(nil)}
+	_, err := store.Get(context.Background(), URI{Scheme: SchemeGCS, Bucket: "fleet", Key: "a.yaml"})
+	if !hasCode(err, errors.ErrCodeUnauthorized) {
+		t.Errorf("Get() error = %v, want unauthorized", err)
+	}
+}
+
+func TestAzureStore(t *testing.T) {
+	accountKey := base64.StdEncoding.EncodeToString([]byte("account-key"))
+	bucket, srv := newFakeBucket(t, func(b *fakeBucket) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey fleetstore:") {
+				http.Error(w, "unsigned", http.StatusForbidden)
+				return
+			}
+			if r.Method == http.MethodPut && r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+				http.Error(w, "missing blob type", http.StatusBadRequest)
+				return
+			}
+			b.serve(w, r, r.URL.Path)
+		}
+	})
+	store := &azureStore{env: envMap(map[string]string{
+		"AZURE_STORAGE_CONNECTION_STRING": "DefaultEndpointsProtocol=http;AccountName=fleetstore;AccountKey=" + accountKey +
+			";BlobEndpoint=" + srv.URL + "/fleetstore;",
+	})}
+	ctx := context.Background()
+	u := URI{Scheme: SchemeAzure, Account: "fleetstore", Bucket: "snapshots", Key: "node-1.json"}
+
+	if err := store.Put(ctx, u, []byte(`{"kind":"Snapshot"}`), "application/json"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	got, err := store.Get(ctx, u)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(got) != `{"kind":"Snapshot"}` {
+		t.Errorf("Get() = %q", got)
+	}
+	if names := bucket.names(); !reflect.DeepEqual(names, []string{"/fleetstore/snapshots/node-1.json"}) {
+		t.Errorf("objects = %v", names)
+	}
+	if contentType := bucket.headers[0].Get("x-ms-blob-content-type"); contentType != "application/json" {
+		t.Errorf("x-ms-blob-content-type = %q", contentType)
+	}
+
+	_, err = store.Get(ctx, URI{Scheme: SchemeAzure, Account: "fleetstore", Bucket: "snapshots", Key: "missing.json"})
+	if !hasCode(err, errors.ErrCodeNotFound) {
+		t.Errorf("Get(missing) error = %v, want not found", err)
+	}
+}
+
+func TestAzureStore_SASToken(t *testing.T) {
+	_, srv := newFakeBucket(t, func(b *fakeBucket) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("sig") != "abc" || r.Header.Get("Authorization") != "" {
+				http.Error(w, "bad SAS", http.StatusForbidden)
+				return
+			}
+			b.serve(w, r, r.URL.Path)
+		}
+	})
+	store := &azureStore{env: envMap(map[string]string{
+		"AZURE_STORAGE_CONNECTION_STRING": "BlobEndpoint=" + srv.URL + ";SharedAccessSignature=sv=2021-08-06&sig=abc",
+	})}
+	u := URI{Scheme: SchemeAzure, Account: "fleetstore", Bucket: "c", Key: "a.yaml"}
+	if err := store.Put(context.Background(), u, []byte("a: 1\n"), ""); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+}
+
+func TestAzureStore_NoCredentials(t *testing.T) {
+	// Limit DefaultAzureCredential to environment credentials, none of which are set.
+	t.Setenv("AZURE_TOKEN_CREDENTIALS", "EnvironmentCredential")
+	for _, key := range []string{"AZURE_CLIENT_ID", "AZURE_TENANT_ID", "AZURE_CLIENT_SECRET",
+		"AZURE_CLIENT_CERTIFICATE_PATH", "AZURE_USERNAME"} {
+		t.Setenv(key, "")
+	}
+	store := &azureStore{env: envMap(nil)}
+	_, err := store.Get(context.Background(), URI{Scheme: SchemeAzure, Account: "a", Bucket: "c", Key: "b"})
+	if !hasCode(err, errors.ErrCodeUnauthorized) {
+		t.Errorf("Get() error = %v, want unauthorized", err)
+	}
+}
+
+func TestUploadDir(t *testing.T) {
+	bucket, srv := newFakeBucket(t, s3Handler)
+	isolateAWS(t, srv.URL)
+	t.Setenv("AWS_ACCESS_KEY_ID", "id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"README.md":                "# Bundle\n",
+		"gpu-operator/values.yaml": "driver: {}\n",
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	uploaded, err := UploadDir(context.Background(), dir, "s3://fleet/bundles/v1")
+	if err != nil {
+		t.Fatalf("UploadDir() error = %v", err)
+	}
+	want := []string{"s3://fleet/bundles/v1/README.md", "s3://fleet/bundles/v1/gpu-operator/values.yaml"}
+	if !reflect.DeepEqual(uploaded, want) {
+		t.Errorf("UploadDir() = %v, want %v", uploaded, want)
+	}
+	if names := bucket.names(); len(names) != 2 {
+		t.Errorf("objects = %v", names)
+	}
+}
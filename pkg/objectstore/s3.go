@@ -0,0 +1,122 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// s3DefaultRegion is used when the AWS configuration sets no region.
+const s3DefaultRegion = "us-east-1"
+
+// s3Store reads and writes S3 objects with the AWS SDK.
+type s3Store struct {
+	once   sync.Once
+	cfg    aws.Config
+	client *s3.Client
+	err    error
+}
+
+func (s *s3Store) Get(ctx context.Context, u URI) ([]byte, error) {
+	client, err := s.s3Client(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(u.Bucket),
+		Key:    aws.String(u.Key),
+	})
+	if err != nil {
+		return nil, requestError(ctx, http.MethodGet, u, err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, requestError(ctx, http.MethodGet, u, err)
+	}
+	return data, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, u URI, data []byte, contentType string) error {
+	client, err := s.s3Client(ctx, u)
+	if err != nil {
+		return err
+	}
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(u.Bucket),
+		Key:    aws.String(u.Key),
+		Body:   bytes.NewReader(data),
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if _, err := client.PutObject(ctx, input); err != nil {
+		return requestError(ctx, http.MethodPut, u, err)
+	}
+	return nil
+}
+
+// s3Client returns the S3 client, loading the shared AWS configuration on
+// first use, once the credentials for it have been retrieved. Custom
+// endpoints (AWS_ENDPOINT_URL_S3 or AWS_ENDPOINT_URL, e.g. MinIO) are
+// addressed path style.
+func (s *s3Store) s3Client(ctx context.Context, u URI) (*s3.Client, error) {
+	if u.Key == "" {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, fmt.Sprintf("object key is required in %s", u))
+	}
+
+	s.once.Do(func() {
+		cfg, err := config.LoadDefaultConfig(ctx,
+			config.WithHTTPClient(awshttp.NewBuildableClient().WithTimeout(defaults.ObjectStoreRequestTimeout)))
+		if err != nil {
+			s.err = errors.Wrap(errors.ErrCodeInvalidRequest, "failed to load AWS configuration", err)
+			return
+		}
+		if cfg.Region == "" {
+			cfg.Region = s3DefaultRegion
+		}
+		s.cfg = cfg
+		s.client = s3.NewFromConfig(cfg, func(o *s3.Options) {
+			o.UsePathStyle = o.BaseEndpoint != nil
+		})
+	})
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	const noCredentials = "no AWS credentials found: set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, " +
+		"a web identity token, a shared credentials profile or an instance role"
+	if s.cfg.Credentials == nil {
+		return nil, errors.New(errors.ErrCodeUnauthorized, noCredentials)
+	}
+	if _, err := s.cfg.Credentials.Retrieve(ctx); err != nil {
+		return nil, errors.Wrap(errors.ErrCodeUnauthorized, noCredentials, err)
+	}
+	return s.client, nil
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package oci provides functionality for packaging and pushing artifacts to OCI-compliant registries.
+// Package oci provides functionality for packaging, pushing and pulling artifacts to and from OCI-compliant registries.
 //
 // This package enables bundled artifacts to be pushed to any OCI-compliant registry
 // (Docker Hub, GHCR, ECR, local registries, etc.) using the ORAS (OCI Registry As Storage) library.
@@ -20,11 +20,12 @@
 //
 // # Overview
 //
-// The package provides three main operations:
+// The package provides the following operations:
 //   - ParseOutputTarget: Parses output targets (file paths or OCI URIs) into Reference
 //   - Package: Creates a local OCI artifact in OCI Image Layout format
 //   - PushFromStore: Pushes a previously packaged artifact to a remote registry
 //   - PackageAndPush: High-level workflow combining Package and PushFromStore
+//   - Pull: Fetches a bundle artifact from a registry and unpacks it to a directory
 //
 // The Reference type encapsulates parsed output target information, making it easy to
 // determine if output is destined for the local filesystem or an OCI registry.
@@ -38,6 +39,8 @@
 //   - PackageResult: Result of local packaging (digest, reference, store path)
 //   - PushOptions: Configuration for pushing to remote registries
 //   - PushResult: Result of a successful push (digest, reference)
+//   - PullOptions: Configuration for pulling and unpacking from remote registries
+//   - PullResult: Result of a successful pull (digest, reference, output directory)
 //
 // # URI Scheme
 //
@@ -122,4 +125,9 @@
 // This custom media type identifies Eidos bundles and distinguishes them from
 // runnable container images. Consumers that don't understand this type should
 // treat the artifact as a non-executable blob.
+//
+// # Testing
+//
+// The ocitest subpackage provides an in-memory store and an httptest-based
+// registry so push and pull paths can be exercised without network access.
 package oci
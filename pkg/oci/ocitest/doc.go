@@ -0,0 +1,46 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ocitest provides hermetic test doubles for OCI registry interactions.
+//
+// Push, pull and verify flows normally need a real registry. This package
+// replaces it with two in-process fakes:
+//
+//   - Store: an in-memory, content-addressed oras.Target. Blobs are keyed by
+//     digest alone, so it can back a registry and be used directly with
+//     oras.Copy in unit tests.
+//   - Registry: an httptest server speaking the subset of the OCI distribution
+//     API that ORAS uses (manifests, blobs, chunked and monolithic uploads),
+//     with one Store per repository.
+//
+// # Usage
+//
+// Point the oci package (or the CLI with --plain-http) at the fixture:
+//
+//	reg := ocitest.NewRegistry(t)
+//	digest := reg.PushBundle(t, "nvidia/bundle", "v1.0.0", map[string]string{
+//	    "Chart.yaml": "apiVersion: v2\nname: bundle\n",
+//	})
+//
+//	result, err := oci.Pull(ctx, oci.PullOptions{
+//	    Registry:   reg.Host,
+//	    Repository: "nvidia/bundle",
+//	    Tag:        "v1.0.0",
+//	    OutputDir:  t.TempDir(),
+//	    PlainHTTP:  true,
+//	})
+//
+// Registry.Requests records every request for assertions, and
+// Registry.Repository exposes the backing Store to inspect or seed content.
+package ocitest
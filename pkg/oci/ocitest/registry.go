@@ -0,0 +1,328 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocitest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/NVIDIA/eidos/pkg/oci"
+)
+
+// maxManifestSize bounds manifest uploads, mirroring common registry limits.
+const maxManifestSize = 4 << 20
+
+// routePattern splits /v2/<name>/<kind>/<rest> request paths.
+var routePattern = regexp.MustCompile(`^/v2/(.+)/(manifests|blobs)/(.+)$`)
+
+// Registry is an in-process OCI distribution registry backed by a Store per
+// repository. Use it with PlainHTTP (or the CLI --plain-http flag).
+type Registry struct {
+	// Server is the underlying test server.
+	Server *httptest.Server
+	// Host is the registry host:port, usable as the Registry field of
+	// oci.PushOptions and oci.PullOptions.
+	Host string
+
+	mu       sync.Mutex
+	repos    map[string]*Store
+	uploads  map[string]*bytes.Buffer
+	nextID   int
+	requests []string
+}
+
+// NewRegistry starts a Registry that is shut down when the test ends.
+func NewRegistry(t testing.TB) *Registry {
+	t.Helper()
+
+	r := &Registry{
+		repos:   make(map[string]*Store),
+		uploads: make(map[string]*bytes.Buffer),
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	r.Host = strings.TrimPrefix(r.Server.URL, "http://")
+	t.Cleanup(r.Server.Close)
+	return r
+}
+
+// Repository returns the Store backing a repository, creating it if needed.
+func (r *Registry) Repository(name string) *Store {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.repos[name]
+	if !ok {
+		s = NewStore()
+		r.repos[name] = s
+	}
+	return s
+}
+
+// Reference returns "host/repository:tag" for the fixture.
+func (r *Registry) Reference(repository, tag string) string {
+	return fmt.Sprintf("%s/%s:%s", r.Host, repository, tag)
+}
+
+// Requests returns the "METHOD /path" of every request served so far.
+func (r *Registry) Requests() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.requests...)
+}
+
+// PushBundle writes files (relative path to content) into a temporary
+// directory, packages it and pushes it to repository:tag through the oci
+// package, exactly as "eidos bundle --output oci://..." would. It returns the
+// manifest digest.
+func (r *Registry) PushBundle(t testing.TB, repository, tag string, files map[string]string) string {
+	t.Helper()
+
+	sourceDir := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(sourceDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create directory for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	ref, err := oci.ParseOutputTarget(oci.URIScheme + r.Reference(repository, tag))
+	if err != nil {
+		t.Fatalf("failed to parse reference: %v", err)
+	}
+	result, err := oci.PackageAndPush(context.Background(), oci.OutputConfig{
+		SourceDir: sourceDir,
+		OutputDir: t.TempDir(),
+		Reference: ref,
+		Version:   "test",
+		PlainHTTP: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to push bundle: %v", err)
+	}
+	return result.Digest
+}
+
+func (r *Registry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+	r.mu.Unlock()
+
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+
+	if req.URL.Path == "/v2/" || req.URL.Path == "/v2" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	m := routePattern.FindStringSubmatch(req.URL.Path)
+	if m == nil {
+		writeError(w, http.StatusNotFound, "NAME_UNKNOWN", "unknown route")
+		return
+	}
+	name, kind, rest := m[1], m[2], m[3]
+	store := r.Repository(name)
+
+	switch {
+	case kind == "manifests":
+		r.serveManifest(w, req, store, name, rest)
+	case rest == "uploads/" || strings.HasPrefix(rest, "uploads/"):
+		r.serveUpload(w, req, store, name, strings.TrimPrefix(rest, "uploads/"))
+	default:
+		serveBlob(w, req, store, rest)
+	}
+}
+
+func (r *Registry) serveManifest(w http.ResponseWriter, req *http.Request, store *Store, name, ref string) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		desc, err := store.Resolve(req.Context(), ref)
+		if err != nil {
+			writeError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", "manifest unknown")
+			return
+		}
+		b, _ := store.get(desc.Digest)
+		w.Header().Set("Content-Type", desc.MediaType)
+		w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+		w.Header().Set("Content-Length", strconv.FormatInt(desc.Size, 10))
+		w.WriteHeader(http.StatusOK)
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(b.data)
+		}
+
+	case http.MethodPut:
+		data, err := io.ReadAll(io.LimitReader(req.Body, maxManifestSize+1))
+		if err != nil || len(data) > maxManifestSize {
+			writeError(w, http.StatusBadRequest, "MANIFEST_INVALID", "manifest unreadable or too large")
+			return
+		}
+		desc := ociv1.Descriptor{
+			MediaType: req.Header.Get("Content-Type"),
+			Digest:    digest.FromBytes(data),
+			Size:      int64(len(data)),
+		}
+		if dgst, parseErr := digest.Parse(ref); parseErr == nil && dgst != desc.Digest {
+			writeError(w, http.StatusBadRequest, "DIGEST_INVALID", "manifest digest mismatch")
+			return
+		}
+		if err := store.Push(req.Context(), desc, bytes.NewReader(data)); err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+			writeError(w, http.StatusBadRequest, "MANIFEST_INVALID", err.Error())
+			return
+		}
+		if _, parseErr := digest.Parse(ref); parseErr != nil {
+			if err := store.Tag(req.Context(), desc, ref); err != nil {
+				writeError(w, http.StatusInternalServerError, "UNKNOWN", err.Error())
+				return
+			}
+		}
+		w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/manifests/%s", name, desc.Digest))
+		w.WriteHeader(http.StatusCreated)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+	}
+}
+
+func serveBlob(w http.ResponseWriter, req *http.Request, store *Store, ref string) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+		return
+	}
+
+	dgst, err := digest.Parse(ref)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "DIGEST_INVALID", "invalid digest")
+		return
+	}
+	b, ok := store.get(dgst)
+	if !ok {
+		writeError(w, http.StatusNotFound, "BLOB_UNKNOWN", "blob unknown")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	w.Header().Set("Content-Length", strconv.Itoa(len(b.data)))
+	w.WriteHeader(http.StatusOK)
+	if req.Method == http.MethodGet {
+		_, _ = w.Write(b.data)
+	}
+}
+
+// serveUpload implements POST (start or monolithic), PATCH (chunk) and PUT
+// (finish) blob uploads.
+func (r *Registry) serveUpload(w http.ResponseWriter, req *http.Request, store *Store, name, id string) {
+	switch {
+	case req.Method == http.MethodPost && id == "":
+		if dgst := req.URL.Query().Get("digest"); dgst != "" {
+			data, err := io.ReadAll(req.Body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "BLOB_UPLOAD_INVALID", err.Error())
+				return
+			}
+			commitBlob(w, req, store, name, dgst, data)
+			return
+		}
+
+		r.mu.Lock()
+		r.nextID++
+		id = strconv.Itoa(r.nextID)
+		r.uploads[id] = &bytes.Buffer{}
+		r.mu.Unlock()
+
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", name, id))
+		w.Header().Set("Docker-Upload-UUID", id)
+		w.Header().Set("Range", "0-0")
+		w.WriteHeader(http.StatusAccepted)
+
+	case req.Method == http.MethodPatch || req.Method == http.MethodPut:
+		r.mu.Lock()
+		buf, ok := r.uploads[id]
+		r.mu.Unlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, "BLOB_UPLOAD_UNKNOWN", "upload unknown")
+			return
+		}
+		if _, err := io.Copy(buf, req.Body); err != nil {
+			writeError(w, http.StatusBadRequest, "BLOB_UPLOAD_INVALID", err.Error())
+			return
+		}
+
+		if req.Method == http.MethodPatch {
+			w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", name, id))
+			w.Header().Set("Range", fmt.Sprintf("0-%d", buf.Len()-1))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		r.mu.Lock()
+		delete(r.uploads, id)
+		r.mu.Unlock()
+		commitBlob(w, req, store, name, req.URL.Query().Get("digest"), buf.Bytes())
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+	}
+}
+
+func commitBlob(w http.ResponseWriter, req *http.Request, store *Store, name, ref string, data []byte) {
+	dgst, err := digest.Parse(ref)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "DIGEST_INVALID", "invalid digest")
+		return
+	}
+	desc := ociv1.Descriptor{
+		MediaType: "application/octet-stream",
+		Digest:    dgst,
+		Size:      int64(len(data)),
+	}
+	if err := store.Push(req.Context(), desc, bytes.NewReader(data)); err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+		writeError(w, http.StatusBadRequest, "DIGEST_INVALID", err.Error())
+		return
+	}
+
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/%s", name, dgst))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// writeError writes an OCI distribution error response.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{"code": code, "message": message}},
+	})
+}
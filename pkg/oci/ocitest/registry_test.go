@@ -0,0 +1,144 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocitest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+func descriptorFor(data []byte) ociv1.Descriptor {
+	return ociv1.Descriptor{
+		MediaType: "application/octet-stream",
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	data := []byte("hello")
+	desc := descriptorFor(data)
+
+	if err := s.Push(ctx, desc, bytes.NewReader(data)); err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+	if err := s.Push(ctx, desc, bytes.NewReader(data)); !errors.Is(err, errdef.ErrAlreadyExists) {
+		t.Errorf("second Push() error = %v, want ErrAlreadyExists", err)
+	}
+
+	bad := descriptorFor([]byte("other"))
+	if err := s.Push(ctx, bad, bytes.NewReader([]byte("wrong"))); err == nil {
+		t.Error("Push() should reject content not matching the descriptor")
+	}
+
+	// Lookups are by digest only, regardless of media type.
+	exists, err := s.Exists(ctx, ociv1.Descriptor{Digest: desc.Digest})
+	if err != nil || !exists {
+		t.Errorf("Exists() = %v, %v", exists, err)
+	}
+	rc, err := s.Fetch(ctx, ociv1.Descriptor{Digest: desc.Digest})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	got, _ := io.ReadAll(rc)
+	if !bytes.Equal(got, data) {
+		t.Errorf("Fetch() = %q", got)
+	}
+
+	if err := s.Tag(ctx, desc, "v1"); err != nil {
+		t.Fatalf("Tag() error = %v", err)
+	}
+	for _, ref := range []string{"v1", desc.Digest.String()} {
+		if resolved, err := s.Resolve(ctx, ref); err != nil || resolved.Digest != desc.Digest {
+			t.Errorf("Resolve(%q) = %v, %v", ref, resolved.Digest, err)
+		}
+	}
+	if _, err := s.Resolve(ctx, "missing"); !errors.Is(err, errdef.ErrNotFound) {
+		t.Errorf("Resolve(missing) error = %v, want ErrNotFound", err)
+	}
+	if err := s.Tag(ctx, bad, "v2"); !errors.Is(err, errdef.ErrNotFound) {
+		t.Errorf("Tag() of missing content error = %v, want ErrNotFound", err)
+	}
+	if tags := s.Tags(); !slices.Equal(tags, []string{"v1"}) {
+		t.Errorf("Tags() = %v", tags)
+	}
+}
+
+func TestRegistry_CopyRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	reg := NewRegistry(t)
+
+	// Build a small artifact in a local Store and copy it through the registry.
+	src := NewStore()
+	manifest, err := oras.PackManifest(ctx, src, oras.PackManifestVersion1_1, "application/vnd.test", oras.PackManifestOptions{})
+	if err != nil {
+		t.Fatalf("PackManifest() error = %v", err)
+	}
+	if err := src.Tag(ctx, manifest, "v1"); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := remote.NewRepository(reg.Host + "/test/artifact")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.PlainHTTP = true
+
+	pushed, err := oras.Copy(ctx, src, "v1", repo, "v1", oras.DefaultCopyOptions)
+	if err != nil {
+		t.Fatalf("push through registry failed: %v", err)
+	}
+	if pushed.Digest != manifest.Digest {
+		t.Errorf("pushed digest %s, want %s", pushed.Digest, manifest.Digest)
+	}
+	if tags := reg.Repository("test/artifact").Tags(); !slices.Equal(tags, []string{"v1"}) {
+		t.Errorf("registry tags = %v", tags)
+	}
+
+	dst := NewStore()
+	if _, err := oras.Copy(ctx, repo, manifest.Digest.String(), dst, "copied", oras.DefaultCopyOptions); err != nil {
+		t.Fatalf("pull through registry failed: %v", err)
+	}
+	if dst.Len() != src.Len() {
+		t.Errorf("pulled %d blobs, pushed %d", dst.Len(), src.Len())
+	}
+
+	if _, err := repo.Resolve(ctx, "missing"); err == nil {
+		t.Error("Resolve() of an unknown tag should fail")
+	}
+
+	var sawManifestPut bool
+	for _, r := range reg.Requests() {
+		if strings.HasPrefix(r, "PUT /v2/test/artifact/manifests/") {
+			sawManifestPut = true
+		}
+	}
+	if !sawManifestPut {
+		t.Errorf("expected a manifest PUT, requests: %v", reg.Requests())
+	}
+}
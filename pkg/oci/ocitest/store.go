@@ -0,0 +1,145 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ocitest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+)
+
+// Store is an in-memory, content-addressed OCI store implementing
+// oras.Target. It is safe for concurrent use.
+type Store struct {
+	mu    sync.RWMutex
+	blobs map[digest.Digest]storedBlob
+	tags  map[string]ociv1.Descriptor
+}
+
+type storedBlob struct {
+	desc ociv1.Descriptor
+	data []byte
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return &Store{
+		blobs: make(map[digest.Digest]storedBlob),
+		tags:  make(map[string]ociv1.Descriptor),
+	}
+}
+
+// Fetch returns the content identified by the descriptor's digest.
+func (s *Store) Fetch(_ context.Context, target ociv1.Descriptor) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.blobs[target.Digest]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", target.Digest, errdef.ErrNotFound)
+	}
+	return io.NopCloser(bytes.NewReader(b.data)), nil
+}
+
+// Push stores content after verifying it against the expected size and digest.
+func (s *Store) Push(_ context.Context, expected ociv1.Descriptor, r io.Reader) error {
+	data, err := content.ReadAll(r, expected)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.blobs[expected.Digest]; ok {
+		return fmt.Errorf("%s: %w", expected.Digest, errdef.ErrAlreadyExists)
+	}
+	s.blobs[expected.Digest] = storedBlob{desc: expected, data: data}
+	return nil
+}
+
+// Exists reports whether content with the descriptor's digest is stored.
+func (s *Store) Exists(_ context.Context, target ociv1.Descriptor) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.blobs[target.Digest]
+	return ok, nil
+}
+
+// Resolve returns the descriptor for a tag or a stored digest.
+func (s *Store) Resolve(_ context.Context, reference string) (ociv1.Descriptor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if desc, ok := s.tags[reference]; ok {
+		return desc, nil
+	}
+	if dgst, err := digest.Parse(reference); err == nil {
+		if b, ok := s.blobs[dgst]; ok {
+			return b.desc, nil
+		}
+	}
+	return ociv1.Descriptor{}, fmt.Errorf("%s: %w", reference, errdef.ErrNotFound)
+}
+
+// Tag points reference at stored content.
+func (s *Store) Tag(_ context.Context, desc ociv1.Descriptor, reference string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.blobs[desc.Digest]
+	if !ok {
+		return fmt.Errorf("%s: %w", desc.Digest, errdef.ErrNotFound)
+	}
+	s.tags[reference] = b.desc
+	return nil
+}
+
+// Tags returns the stored tags in sorted order.
+func (s *Store) Tags() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tags := make([]string, 0, len(s.tags))
+	for tag := range s.tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Len returns the number of stored blobs and manifests.
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.blobs)
+}
+
+// get returns a stored blob by digest.
+func (s *Store) get(dgst digest.Digest) (storedBlob, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.blobs[dgst]
+	return b, ok
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry/remote"
+
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// PullOptions configures pulling a bundle artifact from a remote registry.
+type PullOptions struct {
+	// Registry is the OCI registry host (e.g., "ghcr.io", "localhost:5000").
+	Registry string
+	// Repository is the image repository path (e.g., "nvidia/eidos").
+	Repository string
+	// Tag is the image tag (e.g., "v1.0.0"). Ignored when Digest is set.
+	Tag string
+	// Digest pins the manifest digest (e.g., "sha256:..."). When set, the
+	// artifact is resolved by digest and the resolved digest must match.
+	Digest string
+	// OutputDir is the directory the bundle is unpacked into. It must not
+	// exist or be empty.
+	OutputDir string
+	// PlainHTTP uses HTTP instead of HTTPS for the registry connection.
+	PlainHTTP bool
+	// InsecureTLS skips TLS certificate verification.
+	InsecureTLS bool
+}
+
+// PullResult contains the result of a successful OCI pull.
+type PullResult struct {
+	// Digest is the SHA256 digest of the pulled manifest.
+	Digest string
+	// Reference is the full image reference that was pulled.
+	Reference string
+	// OutputDir is the absolute path of the unpacked bundle.
+	OutputDir string
+}
+
+// Pull fetches a bundle artifact from a remote registry and unpacks it into
+// OutputDir. Every blob is verified against its descriptor digest while it
+// is copied, and the manifest must carry the Eidos ArtifactType.
+func Pull(ctx context.Context, opts PullOptions) (*PullResult, error) {
+	if opts.Tag == "" && opts.Digest == "" {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest, "tag or digest is required to pull OCI artifact")
+	}
+
+	if err := ValidateRegistryReference(opts.Registry, opts.Repository); err != nil {
+		return nil, err
+	}
+
+	registryHost := stripProtocol(opts.Registry)
+
+	repo, err := remote.NewRepository(fmt.Sprintf("%s/%s", registryHost, opts.Repository))
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal, "failed to initialize remote repository", err)
+	}
+	repo.PlainHTTP = opts.PlainHTTP
+
+	authClient, err := createAuthClient(opts.PlainHTTP, opts.InsecureTLS)
+	if err != nil {
+		slog.Warn("failed to initialize Docker credential store, continuing without authentication",
+			"error", err)
+	}
+	repo.Client = authClient
+
+	srcRef := opts.Tag
+	refString := fmt.Sprintf("%s/%s:%s", registryHost, opts.Repository, opts.Tag)
+	if opts.Digest != "" {
+		srcRef = opts.Digest
+		refString = fmt.Sprintf("%s/%s@%s", registryHost, opts.Repository, opts.Digest)
+	}
+
+	result, err := pullFromTarget(ctx, repo, srcRef, opts)
+	if err != nil {
+		return nil, err
+	}
+	result.Reference = refString
+
+	slog.Info("OCI artifact pulled",
+		"reference", refString,
+		"digest", result.Digest,
+		"output_dir", result.OutputDir,
+	)
+
+	return result, nil
+}
+
+// pullFromTarget resolves srcRef in src, validates the manifest, and unpacks
+// its layers into opts.OutputDir.
+func pullFromTarget(ctx context.Context, src oras.ReadOnlyTarget, srcRef string, opts PullOptions) (*PullResult, error) {
+	if opts.OutputDir == "" {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest, "output directory is required to pull OCI artifact")
+	}
+
+	absOutputDir, err := filepath.Abs(opts.OutputDir)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal, "failed to resolve output directory", err)
+	}
+	if err := ensureEmptyDir(absOutputDir); err != nil {
+		return nil, err
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeUnavailable, "operation canceled", ctxErr)
+	}
+
+	desc, err := src.Resolve(ctx, srcRef)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeNotFound, fmt.Sprintf("failed to resolve '%s'", srcRef), err)
+	}
+	if opts.Digest != "" && desc.Digest.String() != opts.Digest {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("digest mismatch: expected %s, got %s", opts.Digest, desc.Digest))
+	}
+
+	// FetchAll verifies the manifest bytes against the resolved digest
+	manifestData, err := content.FetchAll(ctx, src, desc)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeUnavailable, "failed to fetch manifest", err)
+	}
+	var manifest ociv1.Manifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "failed to parse manifest", err)
+	}
+	if manifest.ArtifactType != ArtifactType {
+		return nil, apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unexpected artifact type '%s': not an Eidos bundle", manifest.ArtifactType))
+	}
+
+	fs, err := file.New(absOutputDir)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeInternal, "failed to create file store", err)
+	}
+	defer func() { _ = fs.Close() }()
+
+	// CopyGraph verifies each blob against its descriptor digest; the file
+	// store unpacks directory layers and rejects paths escaping OutputDir.
+	if err := oras.CopyGraph(ctx, src, fs, desc, oras.DefaultCopyGraphOptions); err != nil {
+		return nil, apperrors.Wrap(apperrors.ErrCodeUnavailable, "failed to pull artifact", err)
+	}
+
+	return &PullResult{
+		Digest:    desc.Digest.String(),
+		OutputDir: absOutputDir,
+	}, nil
+}
+
+// ensureEmptyDir creates dir if missing and fails if it already has entries,
+// so a pulled bundle is never mixed with unrelated files.
+func ensureEmptyDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return apperrors.Wrap(apperrors.ErrCodeInternal, "failed to read output directory", err)
+		}
+		if mkdirErr := os.MkdirAll(dir, 0o755); mkdirErr != nil {
+			return apperrors.Wrap(apperrors.ErrCodeInternal, "failed to create output directory", mkdirErr)
+		}
+		return nil
+	}
+	if len(entries) > 0 {
+		return apperrors.New(apperrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("output directory '%s' is not empty", dir))
+	}
+	return nil
+}
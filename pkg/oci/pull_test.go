@@ -0,0 +1,167 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"oras.land/oras-go/v2/content/oci"
+)
+
+// newPackagedStore packages a small bundle and returns the opened OCI layout store and digest.
+func newPackagedStore(t *testing.T, tag string) (*oci.Store, string) {
+	t.Helper()
+
+	sourceDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(sourceDir, "gpu-operator"), 0o755); err != nil {
+		t.Fatalf("failed to create source dir: %v", err)
+	}
+	files := map[string]string{
+		"Chart.yaml":               "apiVersion: v2\nname: bundle\n",
+		"gpu-operator/values.yaml": "driver:\n  enabled: true\n",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(sourceDir, name), []byte(data), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	result, err := Package(context.Background(), PackageOptions{
+		SourceDir:  sourceDir,
+		OutputDir:  t.TempDir(),
+		Registry:   "ghcr.io",
+		Repository: "nvidia/bundle",
+		Tag:        tag,
+	})
+	if err != nil {
+		t.Fatalf("Package() error = %v", err)
+	}
+
+	store, err := oci.New(result.StorePath)
+	if err != nil {
+		t.Fatalf("failed to open OCI store: %v", err)
+	}
+	return store, result.Digest
+}
+
+func TestPullFromTarget(t *testing.T) {
+	store, digest := newPackagedStore(t, "v1.0.0")
+	outputDir := filepath.Join(t.TempDir(), "pulled")
+
+	result, err := pullFromTarget(context.Background(), store, "v1.0.0", PullOptions{OutputDir: outputDir})
+	if err != nil {
+		t.Fatalf("pullFromTarget() error = %v", err)
+	}
+
+	if result.Digest != digest {
+		t.Errorf("Digest = %s, want %s", result.Digest, digest)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "gpu-operator", "values.yaml"))
+	if err != nil {
+		t.Fatalf("expected unpacked values.yaml: %v", err)
+	}
+	if !strings.Contains(string(data), "enabled: true") {
+		t.Errorf("unexpected values.yaml content: %s", data)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "Chart.yaml")); err != nil {
+		t.Errorf("expected unpacked Chart.yaml: %v", err)
+	}
+}
+
+func TestPullFromTarget_ByDigest(t *testing.T) {
+	store, digest := newPackagedStore(t, "v1.0.0")
+
+	_, err := pullFromTarget(context.Background(), store, digest, PullOptions{
+		OutputDir: t.TempDir(),
+		Digest:    digest,
+	})
+	if err != nil {
+		t.Fatalf("pullFromTarget() by digest error = %v", err)
+	}
+}
+
+func TestPullFromTarget_Errors(t *testing.T) {
+	store, _ := newPackagedStore(t, "v1.0.0")
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		_, err := pullFromTarget(context.Background(), store, "v1.0.0", PullOptions{
+			OutputDir: t.TempDir(),
+			Digest:    "sha256:" + strings.Repeat("0", 64),
+		})
+		if err == nil || !strings.Contains(err.Error(), "digest mismatch") {
+			t.Errorf("expected digest mismatch, got %v", err)
+		}
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		if _, err := pullFromTarget(context.Background(), store, "missing", PullOptions{OutputDir: t.TempDir()}); err == nil {
+			t.Error("expected error for unknown tag")
+		}
+	})
+
+	t.Run("non-empty output dir", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "stale.yaml"), []byte("x"), 0o600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		_, err := pullFromTarget(context.Background(), store, "v1.0.0", PullOptions{OutputDir: dir})
+		if err == nil || !strings.Contains(err.Error(), "not empty") {
+			t.Errorf("expected non-empty directory error, got %v", err)
+		}
+	})
+
+	t.Run("missing output dir", func(t *testing.T) {
+		if _, err := pullFromTarget(context.Background(), store, "v1.0.0", PullOptions{}); err == nil {
+			t.Error("expected error without output directory")
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := pullFromTarget(ctx, store, "v1.0.0", PullOptions{OutputDir: t.TempDir()}); err == nil {
+			t.Error("expected error for canceled context")
+		}
+	})
+}
+
+func TestPull_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		opts PullOptions
+	}{
+		{
+			name: "missing tag and digest",
+			opts: PullOptions{Registry: "ghcr.io", Repository: "nvidia/bundle", OutputDir: "out"},
+		},
+		{
+			name: "invalid repository",
+			opts: PullOptions{Registry: "ghcr.io", Repository: "INVALID", Tag: "v1", OutputDir: "out"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Pull(context.Background(), tt.opts); err == nil {
+				t.Error("Pull() expected error")
+			}
+		})
+	}
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package oci provides utilities for packaging and pushing OCI artifacts.
+// Package oci provides utilities for packaging, pushing and pulling OCI artifacts.
 package oci
 
 import (
@@ -44,6 +44,9 @@ type Reference struct {
 	// Empty string means no tag was specified; caller should apply a default.
 	// Only populated when IsOCI is true.
 	Tag string
+	// Digest is the manifest digest (e.g., "sha256:...") when the reference is
+	// pinned by digest. Only populated when IsOCI is true.
+	Digest string
 	// LocalPath is the local directory path for non-OCI output.
 	// Only populated when IsOCI is false.
 	LocalPath string
@@ -79,6 +82,11 @@ func ParseOutputTarget(target string) (*Reference, error) {
 	}
 	// If no tag specified, return empty string; caller will apply default
 
+	var digest string
+	if digested, ok := ref.(reference.Digested); ok {
+		digest = digested.Digest().String()
+	}
+
 	// Validate registry and repository format
 	if err := ValidateRegistryReference(registry, repository); err != nil {
 		return nil, err
@@ -89,6 +97,7 @@ func ParseOutputTarget(target string) (*Reference, error) {
 		Registry:   registry,
 		Repository: repository,
 		Tag:        tag,
+		Digest:     digest,
 	}, nil
 }
 
@@ -117,6 +126,15 @@ func (r *Reference) ImageReference() string {
 	return fmt.Sprintf("%s/%s:%s", r.Registry, r.Repository, r.Tag)
 }
 
+// DigestReference returns the image reference pinned to the given digest
+// (registry/repository@sha256:...). Returns empty string for non-OCI references.
+func (r *Reference) DigestReference(digest string) string {
+	if !r.IsOCI {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s@%s", r.Registry, r.Repository, digest)
+}
+
 // WithTag returns a copy of the reference with the specified tag.
 // For non-OCI references, returns the same reference unchanged.
 func (r *Reference) WithTag(tag string) *Reference {
@@ -131,6 +149,20 @@ func (r *Reference) WithTag(tag string) *Reference {
 	}
 }
 
+// AnnotationRecipeDigest is the manifest annotation holding the digest of the
+// recipe a bundle was generated from (see recipe.RecipeResult.Digest).
+const AnnotationRecipeDigest = "com.nvidia.eidos.recipe.digest"
+
+// DefaultAnnotations returns the standard manifest annotations for Eidos bundles.
+func DefaultAnnotations(version string) map[string]string {
+	return map[string]string{
+		"org.opencontainers.image.version": version,
+		"org.opencontainers.image.vendor":  "NVIDIA",
+		"org.opencontainers.image.title":   "Eidos Bundle",
+		"org.opencontainers.image.source":  "https://github.com/NVIDIA/eidos",
+	}
+}
+
 // OutputConfig configures the OCI package and push workflow.
 type OutputConfig struct {
 	// SourceDir is the directory containing artifacts to package.
@@ -190,12 +222,7 @@ func PackageAndPush(ctx context.Context, cfg OutputConfig) (*PackageAndPushResul
 	// Build annotations
 	annotations := cfg.Annotations
 	if annotations == nil {
-		annotations = map[string]string{
-			"org.opencontainers.image.version": cfg.Version,
-			"org.opencontainers.image.vendor":  "NVIDIA",
-			"org.opencontainers.image.title":   "Eidos Bundle",
-			"org.opencontainers.image.source":  "https://github.com/NVIDIA/eidos",
-		}
+		annotations = DefaultAnnotations(cfg.Version)
 	}
 
 	// Package locally first
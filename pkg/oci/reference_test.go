@@ -15,6 +15,7 @@
 package oci
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -26,6 +27,7 @@ func TestParseOutputTarget(t *testing.T) {
 		wantReg   string
 		wantRepo  string
 		wantTag   string
+		wantDig   string
 		wantDir   string
 		wantErr   bool
 	}{
@@ -87,6 +89,15 @@ func TestParseOutputTarget(t *testing.T) {
 			wantRepo:  "org/team/project/bundle",
 			wantTag:   "latest",
 		},
+		{
+			name:      "OCI pinned by digest",
+			input:     "oci://ghcr.io/nvidia/bundle:v1@sha256:" + strings.Repeat("a", 64),
+			wantIsOCI: true,
+			wantReg:   "ghcr.io",
+			wantRepo:  "nvidia/bundle",
+			wantTag:   "v1",
+			wantDig:   "sha256:" + strings.Repeat("a", 64),
+		},
 		{
 			name:    "OCI invalid reference",
 			input:   "oci://",
@@ -124,6 +135,9 @@ func TestParseOutputTarget(t *testing.T) {
 			if ref.Tag != tt.wantTag {
 				t.Errorf("ParseOutputTarget() Tag = %v, want %v", ref.Tag, tt.wantTag)
 			}
+			if ref.Digest != tt.wantDig {
+				t.Errorf("ParseOutputTarget() Digest = %v, want %v", ref.Digest, tt.wantDig)
+			}
 			if ref.LocalPath != tt.wantDir {
 				t.Errorf("ParseOutputTarget() LocalPath = %v, want %v", ref.LocalPath, tt.wantDir)
 			}
@@ -221,6 +235,20 @@ func TestReference_ImageReference(t *testing.T) {
 	}
 }
 
+func TestReference_DigestReference(t *testing.T) {
+	const digest = "sha256:abc123"
+
+	local := &Reference{IsOCI: false, LocalPath: "./bundle"}
+	if got := local.DigestReference(digest); got != "" {
+		t.Errorf("Reference.DigestReference() = %v, want empty", got)
+	}
+
+	ref := &Reference{IsOCI: true, Registry: "ghcr.io", Repository: "nvidia/bundle", Tag: "v1.0.0"}
+	if got, want := ref.DigestReference(digest), "ghcr.io/nvidia/bundle@sha256:abc123"; got != want {
+		t.Errorf("Reference.DigestReference() = %v, want %v", got, want)
+	}
+}
+
 func TestReference_WithTag(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -274,3 +302,20 @@ func TestReference_WithTag(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAnnotations(t *testing.T) {
+	annotations := DefaultAnnotations("v1.2.3")
+
+	if got := annotations["org.opencontainers.image.version"]; got != "v1.2.3" {
+		t.Errorf("version annotation = %q, want v1.2.3", got)
+	}
+	if _, ok := annotations[AnnotationRecipeDigest]; ok {
+		t.Error("default annotations should not include a recipe digest")
+	}
+
+	// Each call returns a fresh map that callers may extend
+	annotations[AnnotationRecipeDigest] = "sha256:abc"
+	if _, ok := DefaultAnnotations("v1.2.3")[AnnotationRecipeDigest]; ok {
+		t.Error("DefaultAnnotations() should return a new map on each call")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/oci"
+	"github.com/NVIDIA/eidos/pkg/oci/ocitest"
+)
+
+func TestPushPull_Registry(t *testing.T) {
+	reg := ocitest.NewRegistry(t)
+	files := map[string]string{
+		"Chart.yaml":               "apiVersion: v2\nname: bundle\n",
+		"gpu-operator/values.yaml": "driver:\n  enabled: true\n",
+	}
+	digest := reg.PushBundle(t, "nvidia/bundle", "v1.0.0", files)
+
+	tests := []struct {
+		name string
+		opts oci.PullOptions
+	}{
+		{name: "by tag", opts: oci.PullOptions{Tag: "v1.0.0"}},
+		{name: "by digest", opts: oci.PullOptions{Digest: digest}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Registry = reg.Host
+			opts.Repository = "nvidia/bundle"
+			opts.OutputDir = filepath.Join(t.TempDir(), "out")
+			opts.PlainHTTP = true
+
+			result, err := oci.Pull(context.Background(), opts)
+			if err != nil {
+				t.Fatalf("Pull() error = %v", err)
+			}
+			if result.Digest != digest {
+				t.Errorf("Pull() digest = %s, want %s", result.Digest, digest)
+			}
+			for name, want := range files {
+				got, err := os.ReadFile(filepath.Join(result.OutputDir, name))
+				if err != nil {
+					t.Fatalf("pulled file %s: %v", name, err)
+				}
+				if string(got) != want {
+					t.Errorf("pulled %s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPull_RegistryErrors(t *testing.T) {
+	reg := ocitest.NewRegistry(t)
+	reg.PushBundle(t, "nvidia/bundle", "v1.0.0", map[string]string{"Chart.yaml": "name: bundle\n"})
+
+	_, err := oci.Pull(context.Background(), oci.PullOptions{
+		Registry:   reg.Host,
+		Repository: "nvidia/bundle",
+		Tag:        "v9.9.9",
+		OutputDir:  t.TempDir(),
+		PlainHTTP:  true,
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed to resolve") {
+		t.Errorf("expected resolve error for unknown tag, got %v", err)
+	}
+
+	_, err = oci.Pull(context.Background(), oci.PullOptions{
+		Registry:   reg.Host,
+		Repository: "nvidia/bundle",
+		Digest:     "sha256:" + strings.Repeat("0", 64),
+		OutputDir:  t.TempDir(),
+		PlainHTTP:  true,
+	})
+	if err == nil {
+		t.Error("expected error for unknown digest")
+	}
+}
+
+func TestResolveDigest_Registry(t *testing.T) {
+	reg := ocitest.NewRegistry(t)
+	digest := reg.PushBundle(t, "nvidia/driver", "570.0", map[string]string{
+		"Chart.yaml": "apiVersion: v2\nname: bundle\n",
+	})
+
+	tests := []struct {
+		name    string
+		image   string
+		wantErr bool
+	}{
+		{name: "by tag", image: reg.Reference("nvidia/driver", "570.0")},
+		{name: "by digest", image: reg.Host + "/nvidia/driver@" + digest},
+		{name: "unknown tag", image: reg.Reference("nvidia/driver", "missing"), wantErr: true},
+		{name: "no tag", image: reg.Host + "/nvidia/driver", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := oci.ResolveDigest(context.Background(), tt.image, oci.ResolveOptions{PlainHTTP: true})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveDigest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != digest {
+				t.Errorf("ResolveDigest() = %s, want %s", got, digest)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+
+	apperrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// dockerHubRegistry is the registry API host serving Docker Hub images.
+const dockerHubRegistry = "registry-1.docker.io"
+
+// ResolveOptions configures resolving an image reference to its digest.
+type ResolveOptions struct {
+	// PlainHTTP uses HTTP instead of HTTPS for the registry connection.
+	PlainHTTP bool
+	// InsecureTLS skips TLS certificate verification.
+	InsecureTLS bool
+}
+
+// ResolveDigest returns the manifest digest (e.g., "sha256:...") the
+// registry serves for image, a reference such as "nvcr.io/nvidia/driver:570"
+// or "busybox:1.36". References without a registry host are on Docker Hub.
+// References that already pin a digest are resolved too, so the digest is
+// checked to exist.
+func ResolveDigest(ctx context.Context, image string, opts ResolveOptions) (string, error) {
+	ref, err := registry.ParseReference(qualifyImage(image))
+	if err != nil {
+		return "", apperrors.Wrap(apperrors.ErrCodeInvalidRequest, "invalid image reference "+image, err)
+	}
+	if ref.Reference == "" {
+		return "", apperrors.New(apperrors.ErrCodeInvalidRequest, "image reference "+image+" has no tag or digest")
+	}
+	if ref.Registry == "docker.io" {
+		ref.Registry = dockerHubRegistry
+	}
+
+	repo, err := remote.NewRepository(ref.Registry + "/" + ref.Repository)
+	if err != nil {
+		return "", apperrors.Wrap(apperrors.ErrCodeInternal, "failed to initialize remote repository", err)
+	}
+	repo.PlainHTTP = opts.PlainHTTP
+
+	authClient, err := createAuthClient(opts.PlainHTTP, opts.InsecureTLS)
+	if err != nil {
+		slog.Warn("failed to initialize Docker credential store, continuing without authentication",
+			"error", err)
+	}
+	repo.Client = authClient
+
+	desc, err := repo.Resolve(ctx, ref.Reference)
+	if err != nil {
+		return "", apperrors.Wrap(apperrors.ErrCodeUnavailable, "failed to resolve image "+image, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// qualifyImage prefixes image with the Docker Hub registry when it has no
+// registry host, and with "library/" for official single-segment images.
+func qualifyImage(image string) string {
+	host, _, found := strings.Cut(image, "/")
+	switch {
+	case !found:
+		return "docker.io/library/" + image
+	case strings.ContainsAny(host, ".:") || host == "localhost":
+		return image
+	default:
+		return "docker.io/" + image
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import "testing"
+
+func TestQualifyImage(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "busybox:1.36", want: "docker.io/library/busybox:1.36"},
+		{image: "bitnami/redis:7", want: "docker.io/bitnami/redis:7"},
+		{image: "nvcr.io/nvidia/driver:570", want: "nvcr.io/nvidia/driver:570"},
+		{image: "localhost:5000/driver:1", want: "localhost:5000/driver:1"},
+		{image: "localhost/driver:1", want: "localhost/driver:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := qualifyImage(tt.image); got != tt.want {
+				t.Errorf("qualifyImage(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+}
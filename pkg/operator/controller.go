@@ -0,0 +1,486 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/deployer/helmlive"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// DefaultResync is how often every Recipe is re-queued. Applied Recipes are
+// checked for drift in their releases; failed Recipes are retried.
+const DefaultResync = 10 * time.Minute
+
+// renderFunc builds the recipe and component values for a spec.
+type renderFunc func(ctx context.Context, spec *RecipeSpec) (*recipe.RecipeResult, map[string]map[string]any, error)
+
+// installFunc applies the component releases of a recipe.
+type installFunc func(ctx context.Context, spec *RecipeSpec, rec *recipe.RecipeResult, values map[string]map[string]any) (*helmlive.Result, error)
+
+// stateFunc reads the live state of an applied release.
+type stateFunc func(rel ReleaseStatus) (helmlive.ReleaseState, error)
+
+// uninstallFunc removes an applied release.
+type uninstallFunc func(spec *RecipeSpec, rel ReleaseStatus) error
+
+// Controller reconciles Recipe resources by installing their components as
+// Helm releases.
+type Controller struct {
+	client     dynamic.Interface
+	kubeconfig string
+	version    string
+	resync     time.Duration
+	now        func() time.Time
+
+	// render, install, state and uninstall are replaced in tests.
+	render    renderFunc
+	install   installFunc
+	state     stateFunc
+	uninstall uninstallFunc
+}
+
+// Option configures a Controller.
+type Option func(*Controller)
+
+// WithKubeconfig sets the kubeconfig Helm uses to install releases. Empty uses
+// the in-cluster configuration.
+func WithKubeconfig(path string) Option {
+	return func(c *Controller) {
+		c.kubeconfig = path
+	}
+}
+
+// WithVersion sets the version recorded in generated recipes.
+func WithVersion(version string) Option {
+	return func(c *Controller) {
+		c.version = version
+	}
+}
+
+// WithResync sets how often every Recipe is re-queued.
+func WithResync(resync time.Duration) Option {
+	return func(c *Controller) {
+		c.resync = resync
+	}
+}
+
+// New creates a Controller watching Recipes through client.
+func New(client dynamic.Interface, opts ...Option) *Controller {
+	c := &Controller{
+		client: client,
+		resync: DefaultResync,
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.render = c.renderRecipe
+	c.install = c.installReleases
+	c.state = c.releaseState
+	c.uninstall = c.uninstallRelease
+	return c
+}
+
+// Run watches Recipes and reconciles them one at a time until ctx is
+// canceled. Failed reconciliations are retried with exponential backoff.
+func (c *Controller) Run(ctx context.Context) error {
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	defer queue.ShutDown()
+
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(c.client, c.resync)
+	informer := factory.ForResource(RecipeResource).Informer()
+	enqueue := func(obj any) {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			queue.Add(u.GetName())
+		}
+	}
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(oldObj, obj any) {
+			// Status and finalizer updates written by Reconcile don't
+			// change the generation; only spec changes, deletions and
+			// resyncs are queued
+			o, okOld := oldObj.(*unstructured.Unstructured)
+			n, okNew := obj.(*unstructured.Unstructured)
+			if okOld && okNew && o.GetResourceVersion() != n.GetResourceVersion() &&
+				o.GetGeneration() == n.GetGeneration() && n.GetDeletionTimestamp() == nil {
+				return
+			}
+			enqueue(obj)
+		},
+	}); err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to watch Recipes", err)
+	}
+
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return eidoserrors.New(eidoserrors.ErrCodeUnavailable, "failed to sync Recipe cache")
+	}
+
+	slog.Info("operator started", "resync", c.resync)
+
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+
+	for {
+		name, shutdown := queue.Get()
+		if shutdown {
+			slog.Info("operator stopped")
+			return nil
+		}
+
+		if err := c.Reconcile(ctx, name); err != nil {
+			slog.Error("reconcile failed", "recipe", name, "error", err)
+			queue.AddRateLimited(name)
+		} else {
+			queue.Forget(name)
+		}
+		queue.Done(name)
+	}
+}
+
+// Reconcile brings the releases of the named Recipe in line with its spec and
+// records the outcome in its status. Recipes whose current spec was already
+// applied are reinstalled only when a release has drifted: it is missing, not
+// deployed, or at another revision than the one applied. Releases the spec
+// no longer includes are uninstalled. Deleting a Recipe uninstalls its
+// releases, unless it is suspended.
+func (c *Controller) Reconcile(ctx context.Context, name string) error {
+	obj, err := c.client.Resource(RecipeResource).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "failed to get Recipe", err)
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return c.finalize(ctx, obj)
+	}
+
+	r, err := fromUnstructured(obj)
+	if err != nil {
+		// A malformed spec does not get better with retries; it is reported
+		// once per generation
+		observed, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if observed == obj.GetGeneration() && Phase(phase) == PhaseFailed {
+			return nil
+		}
+		_ = c.fail(ctx, obj, &RecipeStatus{ObservedGeneration: obj.GetGeneration()}, ReasonInvalidSpec, err)
+		return nil
+	}
+
+	status := r.Status
+	status.ObservedGeneration = r.Generation
+
+	if r.Spec.Suspend {
+		if r.Status.Phase == PhaseSuspended {
+			return nil
+		}
+		status.Phase = PhaseSuspended
+		c.setReady(&status, r.Generation, metav1.ConditionFalse, ReasonSuspended, "Reconciliation is suspended")
+		return c.updateStatus(ctx, obj, &status)
+	}
+
+	var drift string
+	if r.upToDate() {
+		if drift, err = c.drift(r.Status.Releases); err != nil || drift == "" {
+			return err
+		}
+		driftTotal.Inc()
+		slog.Info("release drift detected", "recipe", name, "drift", drift)
+	}
+
+	if err := c.ensureFinalizer(ctx, obj); err != nil {
+		return err
+	}
+
+	reconcilesTotal.Inc()
+	slog.Info("reconciling recipe", "recipe", name, "generation", r.Generation)
+
+	status.Phase = PhaseReconciling
+	if err := c.updateStatus(ctx, obj, &status); err != nil {
+		return err
+	}
+
+	rec, values, err := c.render(ctx, &r.Spec)
+	if err != nil {
+		return c.fail(ctx, obj, &status, ReasonRenderFailed, err)
+	}
+	status.RecipeVersion = rec.Metadata.Version
+	status.AppliedOverlays = rec.Metadata.AppliedOverlays
+
+	var applied []ReleaseStatus
+	res, err := c.install(ctx, &r.Spec, rec, values)
+	if res != nil {
+		applied = releaseStatuses(res)
+	}
+	if err != nil {
+		// Releases applied before are kept in the status, so they are still
+		// uninstalled once no longer part of the recipe, or on deletion
+		status.Releases = mergeReleases(applied, r.Status.Releases)
+		return c.fail(ctx, obj, &status, ReasonInstallFailed, err)
+	}
+
+	// Releases the recipe no longer includes (disabled components, another
+	// criteria match or a namespace change) are uninstalled, in the reverse
+	// of the order they were applied
+	stale := staleReleases(r.Status.Releases, applied)
+	for i, rel := range slices.Backward(stale) {
+		if err := c.uninstall(&r.Spec, rel); err != nil {
+			status.Releases = slices.Concat(applied, stale[:i+1])
+			return c.fail(ctx, obj, &status, ReasonUninstallFailed, err)
+		}
+		slog.Info("stale release uninstalled", "recipe", name, "release", rel.Namespace+"/"+rel.Name)
+	}
+	status.Releases = applied
+
+	status.Phase = PhaseReady
+	message := fmt.Sprintf("Applied %d release(s)", len(status.Releases))
+	if drift != "" {
+		message += "; corrected drift: " + drift
+	}
+	c.setReady(&status, r.Generation, metav1.ConditionTrue, ReasonReconciled, message)
+	if err := c.updateStatus(ctx, obj, &status); err != nil {
+		return err
+	}
+
+	slog.Info("recipe reconciled", "recipe", name, "releases", len(status.Releases))
+	return nil
+}
+
+// drift reports the first applied release whose live state no longer matches
+// the status, or "" when none has drifted.
+func (c *Controller) drift(releases []ReleaseStatus) (string, error) {
+	for _, rel := range releases {
+		state, err := c.state(rel)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case state.Revision == 0:
+			return fmt.Sprintf("release %s/%s is not installed", rel.Namespace, rel.Name), nil
+		case !state.Deployed:
+			return fmt.Sprintf("release %s/%s is %s", rel.Namespace, rel.Name, state.Status), nil
+		case state.Revision != rel.Revision:
+			return fmt.Sprintf("release %s/%s is at revision %d, applied revision %d",
+				rel.Namespace, rel.Name, state.Revision, rel.Revision), nil
+		}
+	}
+	return "", nil
+}
+
+// ensureFinalizer adds Finalizer to obj so its releases are uninstalled when
+// it is deleted.
+func (c *Controller) ensureFinalizer(ctx context.Context, obj *unstructured.Unstructured) error {
+	finalizers := obj.GetFinalizers()
+	if slices.Contains(finalizers, Finalizer) {
+		return nil
+	}
+	obj.SetFinalizers(append(finalizers, Finalizer))
+	return c.update(ctx, obj)
+}
+
+// finalize uninstalls the releases of a deleted Recipe, in the reverse of the
+// order they were applied, and then removes Finalizer. Suspended Recipes keep
+// their releases.
+func (c *Controller) finalize(ctx context.Context, obj *unstructured.Unstructured) error {
+	finalizers := obj.GetFinalizers()
+	if !slices.Contains(finalizers, Finalizer) {
+		return nil
+	}
+
+	// The spec may have been made invalid after its releases were applied;
+	// they are then uninstalled with the default settings
+	spec := &RecipeSpec{}
+	if r, err := fromUnstructured(obj); err == nil {
+		spec = &r.Spec
+	}
+	if !spec.Suspend {
+		releases := statusReleases(obj)
+		slog.Info("uninstalling recipe releases", "recipe", obj.GetName(), "releases", len(releases))
+		for _, rel := range slices.Backward(releases) {
+			if err := c.uninstall(spec, rel); err != nil {
+				return err
+			}
+		}
+	}
+
+	obj.SetFinalizers(slices.DeleteFunc(finalizers, func(f string) bool { return f == Finalizer }))
+	return c.update(ctx, obj)
+}
+
+// update writes the metadata of obj. obj is updated with the stored object.
+func (c *Controller) update(ctx context.Context, obj *unstructured.Unstructured) error {
+	updated, err := c.client.Resource(RecipeResource).Update(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "failed to update Recipe finalizers", err)
+	}
+	*obj = *updated
+	return nil
+}
+
+// fail records a failed reconciliation and returns err for a retry.
+func (c *Controller) fail(ctx context.Context, obj *unstructured.Unstructured, status *RecipeStatus, reason string, err error) error {
+	reconcileFailuresTotal.WithLabelValues(reason).Inc()
+	status.Phase = PhaseFailed
+	c.setReady(status, obj.GetGeneration(), metav1.ConditionFalse, reason, err.Error())
+	if updateErr := c.updateStatus(ctx, obj, status); updateErr != nil {
+		slog.Warn("failed to record reconcile failure", "recipe", obj.GetName(), "error", updateErr)
+	}
+	return err
+}
+
+// setReady sets the Ready condition of status.
+func (c *Controller) setReady(status *RecipeStatus, generation int64, s metav1.ConditionStatus, reason, message string) {
+	now := metav1.NewTime(c.now())
+	status.LastReconcileTime = &now
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               ConditionReady,
+		Status:             s,
+		ObservedGeneration: generation,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// updateStatus writes status through the status subresource. obj is updated
+// with the stored object so later updates in the same reconciliation apply.
+func (c *Controller) updateStatus(ctx context.Context, obj *unstructured.Unstructured, status *RecipeStatus) error {
+	if err := setStatus(obj, status); err != nil {
+		return err
+	}
+	updated, err := c.client.Resource(RecipeResource).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "failed to update Recipe status", err)
+	}
+	*obj = *updated
+	return nil
+}
+
+// renderRecipe builds the recipe for the spec criteria and renders the
+// component values, applying the spec value overrides.
+func (c *Controller) renderRecipe(ctx context.Context, spec *RecipeSpec) (*recipe.RecipeResult, map[string]map[string]any, error) {
+	overrides, err := config.ParseValueOverrides(spec.Set)
+	if err != nil {
+		return nil, nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "invalid spec.set", err)
+	}
+
+	criteria := spec.Criteria
+	rec, err := recipe.NewBuilder(recipe.WithVersion(c.version)).BuildFromCriteria(ctx, &criteria)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := bundler.NewWithConfig(config.NewConfig(
+		config.WithVersion(c.version),
+		config.WithDeployer(config.DeployerHelm),
+		config.WithValueOverrides(overrides),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, err := b.ComponentValues(ctx, rec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rec, values, nil
+}
+
+// installReleases installs the components of rec with the Helm SDK.
+func (c *Controller) installReleases(ctx context.Context, spec *RecipeSpec, rec *recipe.RecipeResult, values map[string]map[string]any) (*helmlive.Result, error) {
+	return c.deployer(spec).Deploy(ctx, rec, values)
+}
+
+// releaseState reads the live state of rel with the Helm SDK.
+func (c *Controller) releaseState(rel ReleaseStatus) (helmlive.ReleaseState, error) {
+	return c.deployer(&RecipeSpec{}).State(rel.Name, rel.Namespace)
+}
+
+// uninstallRelease uninstalls rel with the Helm SDK.
+func (c *Controller) uninstallRelease(spec *RecipeSpec, rel ReleaseStatus) error {
+	return c.deployer(spec).Uninstall(rel.Name, rel.Namespace)
+}
+
+// deployer returns a helmlive deployer configured from spec.
+func (c *Controller) deployer(spec *RecipeSpec) *helmlive.Deployer {
+	timeout := helmlive.DefaultTimeout
+	if spec.Timeout != nil && spec.Timeout.Duration > 0 {
+		timeout = spec.Timeout.Duration
+	}
+
+	return helmlive.New(
+		helmlive.WithKubeconfig(c.kubeconfig),
+		helmlive.WithNamespace(spec.Namespace),
+		helmlive.WithTimeout(timeout),
+	)
+}
+
+// staleReleases returns the releases of previous that are not in current.
+func staleReleases(previous, current []ReleaseStatus) []ReleaseStatus {
+	var stale []ReleaseStatus
+	for _, rel := range previous {
+		if !slices.ContainsFunc(current, rel.sameRelease) {
+			stale = append(stale, rel)
+		}
+	}
+	return stale
+}
+
+// mergeReleases returns current followed by the releases of previous that
+// are not in current.
+func mergeReleases(current, previous []ReleaseStatus) []ReleaseStatus {
+	return append(slices.Clone(current), staleReleases(previous, current)...)
+}
+
+// releaseStatuses converts deploy results to release statuses.
+func releaseStatuses(res *helmlive.Result) []ReleaseStatus {
+	releases := make([]ReleaseStatus, 0, len(res.Releases))
+	for _, r := range res.Releases {
+		releases = append(releases, ReleaseStatus{
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			Chart:     r.Chart,
+			Version:   r.Version,
+			Revision:  r.Revision,
+		})
+	}
+	return releases
+}
@@ -0,0 +1,547 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/NVIDIA/eidos/pkg/deployer/helmlive"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func newRecipeObject(name string, generation int64, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": Group + "/" + Version,
+		"kind":       Kind,
+		"metadata": map[string]any{
+			"name":       name,
+			"generation": generation,
+		},
+		"spec": spec,
+	}}
+	return obj
+}
+
+func newFakeClient(objs ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			RecipeResource: "RecipeList",
+			crdResource:    "CustomResourceDefinitionList",
+		}, objs...)
+}
+
+func getRecipe(t *testing.T, c *Controller, name string) *Recipe {
+	t.Helper()
+	obj, err := c.client.Resource(RecipeResource).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get Recipe: %v", err)
+	}
+	r, err := fromUnstructured(obj)
+	if err != nil {
+		t.Fatalf("failed to decode Recipe: %v", err)
+	}
+	return r
+}
+
+// stubCluster records the releases installed by a stubbed Controller.
+type stubCluster struct {
+	installs    int
+	live        map[string]helmlive.ReleaseState
+	uninstalled []string
+
+	// components are installed in order; installing failAt fails after the
+	// components before it were installed.
+	components []string
+	failAt     string
+}
+
+// stubController returns a Controller whose render, install, state and
+// uninstall steps are recorded instead of touching a cluster.
+func stubController(client *dynamicfake.FakeDynamicClient, installErr error) (*Controller, *stubCluster) {
+	c := New(client, WithVersion("v1.0.0"))
+	c.now = func() time.Time { return time.Unix(1700000000, 0) }
+	cluster := &stubCluster{
+		live:       make(map[string]helmlive.ReleaseState),
+		components: []string{"cert-manager", "gpu-operator"},
+	}
+	c.render = func(_ context.Context, spec *RecipeSpec) (*recipe.RecipeResult, map[string]map[string]any, error) {
+		rec := &recipe.RecipeResult{Criteria: &spec.Criteria}
+		rec.Metadata.Version = "v1.0.0"
+		rec.Metadata.AppliedOverlays = []string{"base", "eks"}
+		return rec, map[string]map[string]any{}, nil
+	}
+	c.install = func(_ context.Context, spec *RecipeSpec, _ *recipe.RecipeResult, _ map[string]map[string]any) (*helmlive.Result, error) {
+		cluster.installs++
+		if installErr != nil {
+			return nil, installErr
+		}
+		ns := "gpu-operator"
+		if spec.Namespace != "" {
+			ns = spec.Namespace
+		}
+		res := &helmlive.Result{}
+		for _, name := range cluster.components {
+			if name == cluster.failAt {
+				return res, errors.New("release " + name + " not ready")
+			}
+			key := ns + "/" + name
+			revision := cluster.live[key].Revision + 1
+			cluster.live[key] = helmlive.ReleaseState{Revision: revision, Status: "deployed", Deployed: true}
+			res.Releases = append(res.Releases, helmlive.ReleaseResult{
+				Name: name, Namespace: ns, Chart: "nvidia/" + name, Revision: revision,
+			})
+		}
+		return res, nil
+	}
+	c.state = func(rel ReleaseStatus) (helmlive.ReleaseState, error) {
+		return cluster.live[rel.Namespace+"/"+rel.Name], nil
+	}
+	c.uninstall = func(_ *RecipeSpec, rel ReleaseStatus) error {
+		key := rel.Namespace + "/" + rel.Name
+		delete(cluster.live, key)
+		cluster.uninstalled = append(cluster.uninstalled, key)
+		return nil
+	}
+	return c, cluster
+}
+
+func TestReconcile_Applies(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "EKS", "accelerator": "h100", "intent": "training"},
+	}))
+	c, cluster := stubController(client, nil)
+	ctx := context.Background()
+
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	r := getRecipe(t, c, "gpu-stack")
+	if r.Spec.Criteria.Service != recipe.CriteriaServiceEKS {
+		t.Errorf("criteria service = %q, want normalized eks", r.Spec.Criteria.Service)
+	}
+	if r.Status.Phase != PhaseReady || r.Status.ObservedGeneration != 1 {
+		t.Errorf("status = %s (generation %d), want Ready (1)", r.Status.Phase, r.Status.ObservedGeneration)
+	}
+	if len(r.Status.Releases) != 2 || r.Status.Releases[1].Name != "gpu-operator" {
+		t.Errorf("releases = %+v", r.Status.Releases)
+	}
+	if finalizers := r.GetFinalizers(); len(finalizers) != 1 || finalizers[0] != Finalizer {
+		t.Errorf("finalizers = %v, want [%s]", finalizers, Finalizer)
+	}
+	if r.Status.RecipeVersion != "v1.0.0" || len(r.Status.AppliedOverlays) != 2 {
+		t.Errorf("recipe version %q overlays %v", r.Status.RecipeVersion, r.Status.AppliedOverlays)
+	}
+	if len(r.Status.Conditions) != 1 || r.Status.Conditions[0].Status != metav1.ConditionTrue ||
+		r.Status.Conditions[0].Reason != ReasonReconciled {
+		t.Errorf("conditions = %+v", r.Status.Conditions)
+	}
+
+	// An applied generation is not installed again
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if cluster.installs != 1 {
+		t.Errorf("installs = %d after second reconcile, want 1", cluster.installs)
+	}
+
+	// A spec change is applied
+	obj, _ := client.Resource(RecipeResource).Get(ctx, "gpu-stack", metav1.GetOptions{})
+	obj.SetGeneration(2)
+	_ = unstructured.SetNestedField(obj.Object, "gpu-system", "spec", "namespace")
+	if _, err := client.Resource(RecipeResource).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("failed to update Recipe: %v", err)
+	}
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	r = getRecipe(t, c, "gpu-stack")
+	if cluster.installs != 2 || r.Status.ObservedGeneration != 2 || r.Status.Releases[0].Namespace != "gpu-system" {
+		t.Errorf("installs = %d, status = %+v", cluster.installs, r.Status)
+	}
+	if want := []string{"gpu-operator/gpu-operator", "gpu-operator/cert-manager"}; !slices.Equal(cluster.uninstalled, want) {
+		t.Errorf("uninstalled = %v, want releases of the old namespace %v", cluster.uninstalled, want)
+	}
+}
+
+// updateSpec sets a spec field of the named Recipe and bumps its generation.
+func updateSpec(t *testing.T, client *dynamicfake.FakeDynamicClient, name string, value any, fields ...string) {
+	t.Helper()
+	ctx := context.Background()
+	obj, err := client.Resource(RecipeResource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get Recipe: %v", err)
+	}
+	obj.SetGeneration(obj.GetGeneration() + 1)
+	if err := unstructured.SetNestedField(obj.Object, value, append([]string{"spec"}, fields...)...); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Resource(RecipeResource).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("failed to update Recipe: %v", err)
+	}
+}
+
+func TestReconcile_RemovedComponent(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "eks"},
+	}))
+	c, cluster := stubController(client, nil)
+	ctx := context.Background()
+
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// The new spec no longer includes cert-manager
+	cluster.components = []string{"gpu-operator"}
+	updateSpec(t, client, "gpu-stack", "inference", "criteria", "intent")
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	if want := []string{"gpu-operator/cert-manager"}; !slices.Equal(cluster.uninstalled, want) {
+		t.Errorf("uninstalled = %v, want %v", cluster.uninstalled, want)
+	}
+	if _, ok := cluster.live["gpu-operator/cert-manager"]; ok {
+		t.Error("cert-manager is still installed")
+	}
+	r := getRecipe(t, c, "gpu-stack")
+	if len(r.Status.Releases) != 1 || r.Status.Releases[0].Name != "gpu-operator" {
+		t.Errorf("releases = %+v, want gpu-operator only", r.Status.Releases)
+	}
+}
+
+func TestReconcile_PartialInstallKeepsReleases(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "eks"},
+	}))
+	c, cluster := stubController(client, nil)
+	ctx := context.Background()
+
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// Moving to another namespace fails after cert-manager was installed
+	cluster.failAt = "gpu-operator"
+	updateSpec(t, client, "gpu-stack", "gpu-system", "namespace")
+	if err := c.Reconcile(ctx, "gpu-stack"); err == nil {
+		t.Fatal("Reconcile() expected error")
+	}
+	if len(cluster.uninstalled) != 0 {
+		t.Errorf("uninstalled = %v after a failed install, want none", cluster.uninstalled)
+	}
+	releaseKeys := func() []string {
+		var keys []string
+		for _, rel := range getRecipe(t, c, "gpu-stack").Status.Releases {
+			keys = append(keys, rel.Namespace+"/"+rel.Name)
+		}
+		return keys
+	}
+	want := []string{"gpu-system/cert-manager", "gpu-operator/cert-manager", "gpu-operator/gpu-operator"}
+	if got := releaseKeys(); !slices.Equal(got, want) {
+		t.Errorf("releases = %v, want %v", got, want)
+	}
+
+	// The retry completes the move and uninstalls the old releases
+	cluster.failAt = ""
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if want := []string{"gpu-operator/gpu-operator", "gpu-operator/cert-manager"}; !slices.Equal(cluster.uninstalled, want) {
+		t.Errorf("uninstalled = %v, want %v", cluster.uninstalled, want)
+	}
+	if want := []string{"gpu-system/cert-manager", "gpu-system/gpu-operator"}; !slices.Equal(releaseKeys(), want) {
+		t.Errorf("releases = %v, want %v", releaseKeys(), want)
+	}
+}
+
+func TestReconcile_UninstallFailure(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "eks"},
+	}))
+	c, cluster := stubController(client, nil)
+	ctx := context.Background()
+
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	uninstall := c.uninstall
+	c.uninstall = func(*RecipeSpec, ReleaseStatus) error { return errors.New("release is locked") }
+	cluster.components = []string{"gpu-operator"}
+	updateSpec(t, client, "gpu-stack", "inference", "criteria", "intent")
+	if err := c.Reconcile(ctx, "gpu-stack"); err == nil {
+		t.Fatal("Reconcile() expected error")
+	}
+	r := getRecipe(t, c, "gpu-stack")
+	if len(r.Status.Conditions) != 1 || r.Status.Conditions[0].Reason != ReasonUninstallFailed {
+		t.Errorf("conditions = %+v", r.Status.Conditions)
+	}
+	if len(r.Status.Releases) != 2 || r.Status.Releases[1].Name != "cert-manager" {
+		t.Errorf("releases = %+v, want cert-manager kept until it is uninstalled", r.Status.Releases)
+	}
+
+	c.uninstall = uninstall
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if want := []string{"gpu-operator/cert-manager"}; !slices.Equal(cluster.uninstalled, want) {
+		t.Errorf("uninstalled = %v, want %v", cluster.uninstalled, want)
+	}
+}
+
+func TestReconcile_Drift(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "eks"},
+	}))
+	c, cluster := stubController(client, nil)
+	ctx := context.Background()
+
+	if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		drift func()
+		want  string
+	}{
+		{
+			name:  "uninstalled",
+			drift: func() { delete(cluster.live, "gpu-operator/gpu-operator") },
+			want:  "release gpu-operator/gpu-operator is not installed",
+		},
+		{
+			name: "failed",
+			drift: func() {
+				cluster.live["gpu-operator/cert-manager"] = helmlive.ReleaseState{Revision: 3, Status: "failed"}
+			},
+			want: "release gpu-operator/cert-manager is failed",
+		},
+		{
+			name: "upgraded outside the operator",
+			drift: func() {
+				state := cluster.live["gpu-operator/gpu-operator"]
+				state.Revision++
+				cluster.live["gpu-operator/gpu-operator"] = state
+			},
+			want: "is at revision",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installs := cluster.installs
+			tt.drift()
+			if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if cluster.installs != installs+1 {
+				t.Fatalf("installs = %d, want %d", cluster.installs, installs+1)
+			}
+			r := getRecipe(t, c, "gpu-stack")
+			if r.Status.Phase != PhaseReady || !strings.Contains(r.Status.Conditions[0].Message, tt.want) {
+				t.Errorf("status = %s %q, want Ready mentioning %q", r.Status.Phase, r.Status.Conditions[0].Message, tt.want)
+			}
+
+			// The corrected releases match the status again
+			if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if cluster.installs != installs+1 {
+				t.Errorf("installs = %d after correction, want %d", cluster.installs, installs+1)
+			}
+		})
+	}
+}
+
+func TestReconcile_Delete(t *testing.T) {
+	tests := []struct {
+		name          string
+		suspend       bool
+		wantUninstall []string
+	}{
+		{name: "uninstalls in reverse order", wantUninstall: []string{"gpu-operator/gpu-operator", "gpu-operator/cert-manager"}},
+		{name: "suspended keeps releases", suspend: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+				"criteria": map[string]any{"service": "eks"},
+			}))
+			c, cluster := stubController(client, nil)
+			ctx := context.Background()
+
+			if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			obj, _ := client.Resource(RecipeResource).Get(ctx, "gpu-stack", metav1.GetOptions{})
+			now := metav1.NewTime(time.Unix(1700000100, 0))
+			obj.SetDeletionTimestamp(&now)
+			_ = unstructured.SetNestedField(obj.Object, tt.suspend, "spec", "suspend")
+			if _, err := client.Resource(RecipeResource).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+				t.Fatalf("failed to update Recipe: %v", err)
+			}
+
+			if err := c.Reconcile(ctx, "gpu-stack"); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if !slices.Equal(cluster.uninstalled, tt.wantUninstall) {
+				t.Errorf("uninstalled = %v, want %v", cluster.uninstalled, tt.wantUninstall)
+			}
+			if r := getRecipe(t, c, "gpu-stack"); len(r.GetFinalizers()) != 0 {
+				t.Errorf("finalizers = %v, want none", r.GetFinalizers())
+			}
+		})
+	}
+}
+
+func TestReconcile_InstallFailure(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "eks"},
+	}))
+	c, cluster := stubController(client, errors.New("release gpu-operator not ready"))
+
+	if err := c.Reconcile(context.Background(), "gpu-stack"); err == nil {
+		t.Fatal("Reconcile() expected error")
+	}
+	r := getRecipe(t, c, "gpu-stack")
+	if r.Status.Phase != PhaseFailed {
+		t.Errorf("phase = %s, want Failed", r.Status.Phase)
+	}
+	if len(r.Status.Conditions) != 1 || r.Status.Conditions[0].Reason != ReasonInstallFailed {
+		t.Errorf("conditions = %+v", r.Status.Conditions)
+	}
+
+	// Failed generations are retried
+	_ = c.Reconcile(context.Background(), "gpu-stack")
+	if cluster.installs != 2 {
+		t.Errorf("installs = %d, want 2", cluster.installs)
+	}
+}
+
+func TestReconcile_Suspended(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "eks"},
+		"suspend":  true,
+	}))
+	c, cluster := stubController(client, nil)
+
+	if err := c.Reconcile(context.Background(), "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if cluster.installs != 0 {
+		t.Errorf("installs = %d, want 0", cluster.installs)
+	}
+	if r := getRecipe(t, c, "gpu-stack"); r.Status.Phase != PhaseSuspended {
+		t.Errorf("phase = %s, want Suspended", r.Status.Phase)
+	}
+}
+
+func TestReconcile_InvalidSpec(t *testing.T) {
+	client := newFakeClient(newRecipeObject("gpu-stack", 1, map[string]any{
+		"criteria": map[string]any{"service": "mainframe"},
+	}))
+	c, cluster := stubController(client, nil)
+
+	if err := c.Reconcile(context.Background(), "gpu-stack"); err != nil {
+		t.Fatalf("Reconcile() error = %v, invalid specs are not retried", err)
+	}
+	if cluster.installs != 0 {
+		t.Errorf("installs = %d, want 0", cluster.installs)
+	}
+
+	obj, _ := client.Resource(RecipeResource).Get(context.Background(), "gpu-stack", metav1.GetOptions{})
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if Phase(phase) != PhaseFailed {
+		t.Errorf("phase = %q, want Failed", phase)
+	}
+}
+
+func TestReconcile_NotFound(t *testing.T) {
+	c, _ := stubController(newFakeClient(), nil)
+	if err := c.Reconcile(context.Background(), "missing"); err != nil {
+		t.Errorf("Reconcile() error = %v for a deleted Recipe", err)
+	}
+}
+
+func TestEnsureCRD(t *testing.T) {
+	client := newFakeClient()
+	ctx := context.Background()
+
+	for range 2 {
+		if err := EnsureCRD(ctx, client); err != nil {
+			t.Fatalf("EnsureCRD() error = %v", err)
+		}
+	}
+
+	crd, err := client.Resource(crdResource).Get(ctx, Resource+"."+Group, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("CRD not created: %v", err)
+	}
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	if kind != Kind {
+		t.Errorf("CRD kind = %q, want %q", kind, Kind)
+	}
+}
+
+func TestCRD_MatchesDeployment(t *testing.T) {
+	deployed, err := os.ReadFile("../../deployments/eidos-operator/1-crd.yaml")
+	if err != nil {
+		t.Fatalf("failed to read deployment CRD: %v", err)
+	}
+	if !bytes.Equal(deployed, CRD()) {
+		t.Error("deployments/eidos-operator/1-crd.yaml differs from pkg/operator/crd.yaml")
+	}
+}
+
+func TestRenderRecipe(t *testing.T) {
+	c := New(newFakeClient(), WithVersion("v1.0.0"))
+	spec := &RecipeSpec{
+		Criteria: recipe.Criteria{Service: recipe.CriteriaServiceEKS, Accelerator: recipe.CriteriaAcceleratorH100},
+		Set:      []string{"gpuoperator:driver.version=580.82.07"},
+	}
+
+	rec, values, err := c.renderRecipe(context.Background(), spec)
+	if err != nil {
+		t.Fatalf("renderRecipe() error = %v", err)
+	}
+	if len(rec.ComponentRefs) == 0 {
+		t.Fatal("recipe has no components")
+	}
+	driver, _ := values["gpu-operator"]["driver"].(map[string]any)
+	if driver["version"] != "580.82.07" {
+		t.Errorf("gpu-operator driver.version = %v, want override", driver["version"])
+	}
+
+	spec.Set = []string{"not-an-override"}
+	if _, _, err := c.renderRecipe(context.Background(), spec); err == nil {
+		t.Error("renderRecipe() expected error for invalid spec.set")
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	_ "embed"
+	"log/slog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// crdManifest is the Recipe CustomResourceDefinition. It is kept identical to
+// deployments/eidos-operator/1-crd.yaml.
+//
+//go:embed crd.yaml
+var crdManifest []byte
+
+// crdResource identifies CustomResourceDefinitions.
+var crdResource = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+// CRD returns the Recipe CustomResourceDefinition manifest.
+func CRD() []byte {
+	return crdManifest
+}
+
+// EnsureCRD creates the Recipe CustomResourceDefinition, or updates it to the
+// version built into this binary.
+func EnsureCRD(ctx context.Context, client dynamic.Interface) error {
+	var crd unstructured.Unstructured
+	if err := yaml.Unmarshal(crdManifest, &crd.Object); err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to parse Recipe CRD", err)
+	}
+
+	crds := client.Resource(crdResource)
+	existing, err := crds.Get(ctx, crd.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		if _, err := crds.Create(ctx, &crd, metav1.CreateOptions{}); err != nil {
+			return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to create Recipe CRD", err)
+		}
+		slog.Info("created CRD", "name", crd.GetName())
+		return nil
+	case err != nil:
+		return eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "failed to get Recipe CRD", err)
+	}
+
+	crd.SetResourceVersion(existing.GetResourceVersion())
+	if _, err := crds.Update(ctx, &crd, metav1.UpdateOptions{}); err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to update Recipe CRD", err)
+	}
+	slog.Debug("updated CRD", "name", crd.GetName())
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package operator installs and maintains the stack declared by Recipe custom
+resources.
+
+A Recipe (eidos.nvidia.com/v1alpha1, cluster scoped) declares the recipe
+criteria and how to install it:
+
+	apiVersion: eidos.nvidia.com/v1alpha1
+	kind: Recipe
+	metadata:
+	  name: gpu-stack
+	spec:
+	  criteria:
+	    service: eks
+	    accelerator: h100
+	    intent: training
+	  set:
+	    - gpuoperator:driver.version=580.82.07
+	  timeout: 15m
+
+The Controller watches Recipes. For each new or changed spec it builds the
+recipe from the criteria, renders the component values (as `eidos bundle`
+does, with spec.set applied) and installs or upgrades every component as a
+Helm release with the helmlive deployer. The outcome is written to the
+status subresource: the phase, the applied releases and a Ready condition.
+
+Recipes are reconciled one at a time. A Recipe whose current generation was
+applied successfully is re-queued on every resync and checked for drift: each
+release in its status is compared with the live Helm release, and the
+releases are installed again when one is missing, not deployed, or at another
+revision (for example after a manual helm upgrade or rollback). Without drift
+no new Helm revision is created. Changes made to release resources outside of
+Helm are not detected. Failed reconciliations are retried with exponential
+backoff and on every resync. Setting spec.suspend stops reconciliation.
+
+Releases a spec change drops from the Recipe, such as a disabled component,
+another criteria match or a new spec.namespace, are uninstalled after the
+remaining releases are applied. Releases are tracked in the status until
+they are uninstalled, also when a reconciliation fails part way.
+
+Applied Recipes carry the eidos.nvidia.com/uninstall finalizer. Deleting a
+Recipe uninstalls its releases in the reverse of the order they were applied
+before the Recipe is removed; a suspended Recipe is removed and its releases
+are left installed.
+
+# Usage
+
+	if err := operator.EnsureCRD(ctx, dynamicClient); err != nil {
+	    return err
+	}
+	c := operator.New(dynamicClient, operator.WithVersion(version))
+	if err := c.Run(ctx); err != nil {
+	    return err
+	}
+
+# Metrics
+
+  - eidos_operator_reconciles_total: Reconciliations that applied releases
+  - eidos_operator_drift_total: Applied Recipes reinstalled because a release
+    drifted
+  - eidos_operator_reconcile_failures_total: Failed reconciliations, by
+    reason (InvalidSpec, RenderFailed, InstallFailed, UninstallFailed)
+*/
+package operator
@@ -0,0 +1,44 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	reconcilesTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "eidos_operator_reconciles_total",
+			Help: "Total number of Recipe reconciliations that applied releases",
+		},
+	)
+
+	driftTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "eidos_operator_drift_total",
+			Help: "Total number of applied Recipes reinstalled because a release drifted",
+		},
+	)
+
+	reconcileFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_operator_reconcile_failures_total",
+			Help: "Total number of failed Recipe reconciliations",
+		},
+		[]string{"reason"},
+	)
+)
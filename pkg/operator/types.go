@@ -0,0 +1,182 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+// Recipe custom resource identity.
+const (
+	Group    = "eidos.nvidia.com"
+	Version  = "v1alpha1"
+	Kind     = "Recipe"
+	Resource = "recipes"
+)
+
+// RecipeResource identifies the Recipe custom resource.
+var RecipeResource = schema.GroupVersionResource{Group: Group, Version: Version, Resource: Resource}
+
+// Phase summarizes where a Recipe is in its reconciliation.
+type Phase string
+
+// Recipe phases.
+const (
+	PhasePending     Phase = "Pending"
+	PhaseReconciling Phase = "Reconciling"
+	PhaseReady       Phase = "Ready"
+	PhaseFailed      Phase = "Failed"
+	PhaseSuspended   Phase = "Suspended"
+)
+
+// Finalizer is set on applied Recipes so their releases are uninstalled when
+// the Recipe is deleted.
+const Finalizer = Group + "/uninstall"
+
+// ConditionReady is the condition reporting whether every release is applied.
+const ConditionReady = "Ready"
+
+// Ready condition reasons.
+const (
+	ReasonReconciled      = "Reconciled"
+	ReasonInvalidSpec     = "InvalidSpec"
+	ReasonRenderFailed    = "RenderFailed"
+	ReasonInstallFailed   = "InstallFailed"
+	ReasonUninstallFailed = "UninstallFailed"
+	ReasonSuspended       = "Suspended"
+)
+
+// Recipe is the custom resource declaring the stack to install.
+type Recipe struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecipeSpec   `json:"spec"`
+	Status RecipeStatus `json:"status,omitempty"`
+}
+
+// RecipeSpec declares the criteria of the recipe to install and how to
+// install it.
+type RecipeSpec struct {
+	// Criteria selects the recipe, as with `eidos recipe`.
+	Criteria recipe.Criteria `json:"criteria"`
+
+	// Set overrides component values, in the `eidos bundle --set` format
+	// (bundler:path.to.field=value).
+	Set []string `json:"set,omitempty"`
+
+	// Namespace installs every release into this namespace instead of the
+	// per-component default.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Timeout bounds the wait for each release to become ready.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Suspend stops reconciliation; installed releases are left in place,
+	// also when the Recipe is deleted.
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// RecipeStatus reports the outcome of the last reconciliation.
+type RecipeStatus struct {
+	// Phase summarizes the state of the Recipe.
+	Phase Phase `json:"phase,omitempty"`
+
+	// ObservedGeneration is the generation of the spec last reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// RecipeVersion is the version of the recipe data that was applied.
+	RecipeVersion string `json:"recipeVersion,omitempty"`
+
+	// AppliedOverlays lists the overlays the criteria matched.
+	AppliedOverlays []string `json:"appliedOverlays,omitempty"`
+
+	// Releases lists the Helm releases applied by the last reconciliation.
+	Releases []ReleaseStatus `json:"releases,omitempty"`
+
+	// Conditions holds the Ready condition.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastReconcileTime is when the Recipe was last reconciled.
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+}
+
+// ReleaseStatus reports a Helm release applied for the Recipe.
+type ReleaseStatus struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     string `json:"chart"`
+	Version   string `json:"version,omitempty"`
+	Revision  int    `json:"revision"`
+}
+
+// sameRelease reports whether r and other name the same release.
+func (r ReleaseStatus) sameRelease(other ReleaseStatus) bool {
+	return r.Name == other.Name && r.Namespace == other.Namespace
+}
+
+// upToDate reports whether the current spec has been applied successfully.
+func (r *Recipe) upToDate() bool {
+	return r.Status.ObservedGeneration == r.Generation && r.Status.Phase == PhaseReady
+}
+
+// fromUnstructured decodes a Recipe custom resource. It goes through JSON so
+// the criteria are parsed and normalized the same way as in recipe files.
+func fromUnstructured(obj *unstructured.Unstructured) (*Recipe, error) {
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to encode Recipe resource", err)
+	}
+	var r Recipe
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "invalid Recipe resource", err)
+	}
+	return &r, nil
+}
+
+// statusReleases returns the releases recorded in the status of obj. Unlike
+// fromUnstructured it does not depend on the spec being valid.
+func statusReleases(obj *unstructured.Unstructured) []ReleaseStatus {
+	list, _, _ := unstructured.NestedSlice(obj.Object, "status", "releases")
+	releases := make([]ReleaseStatus, 0, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		var rel ReleaseStatus
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &rel); err == nil {
+			releases = append(releases, rel)
+		}
+	}
+	return releases
+}
+
+// setStatus stores status in obj.
+func setStatus(obj *unstructured.Unstructured, status *RecipeStatus) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to encode Recipe status", err)
+	}
+	return unstructured.SetNestedMap(obj.Object, content, "status")
+}
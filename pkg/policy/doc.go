@@ -0,0 +1,59 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy enforces version allowlists during bundle generation.
+//
+// A VersionPolicy lists, per component, the chart versions and component
+// values (such as the GPU driver version) a platform team has approved:
+//
+//	kind: VersionPolicy
+//	apiVersion: eidos.nvidia.com/v1alpha1
+//	spec:
+//	  action: deny
+//	  components:
+//	    - name: gpu-operator
+//	      versions: [">= v25.3.0 < v26.0.0"]
+//	      values:
+//	        - path: driver.version
+//	          allowed: ["580.82.07", "== 570.172.08"]
+//	    - name: network-operator
+//	      action: warn
+//	      versions: ["v25.4.0"]
+//
+// Each allowed entry is a constraint expression as understood by
+// validator.ParseExpression: an exact value, a comparison, a range such as
+// ">= 1.30 < 1.34", or alternatives joined by "||". A version is approved
+// when it matches any entry.
+//
+// A violation's action is the component action if set, otherwise the spec
+// action, otherwise deny. Components the policy does not list, and values
+// the resolved component values do not set, are not checked.
+//
+// # Usage
+//
+//	p, err := serializer.FromFile[policy.VersionPolicy]("policy.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//	if err := p.Validate(); err != nil {
+//	    return err
+//	}
+//	violations, err := p.Evaluate(result, componentValues)
+//	if err != nil {
+//	    return err
+//	}
+//	if err := policy.Enforce(violations); err != nil {
+//	    return err // at least one violation has the deny action
+//	}
+package policy
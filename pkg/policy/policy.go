@@ -0,0 +1,316 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+const (
+	// Kind is the kind of a version policy document.
+	Kind = "VersionPolicy"
+
+	// APIVersion is the API version of a version policy document.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+)
+
+// Action is what happens when a resolved version is not approved.
+type Action string
+
+const (
+	// ActionDeny fails bundle generation.
+	ActionDeny Action = "deny"
+
+	// ActionWarn logs the violation and continues.
+	ActionWarn Action = "warn"
+)
+
+// VersionPolicy is an allowlist of approved component versions.
+type VersionPolicy struct {
+	// Kind is always "VersionPolicy".
+	Kind string `json:"kind" yaml:"kind"`
+
+	// APIVersion is the policy API version.
+	APIVersion string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+
+	// Spec holds the policy rules.
+	Spec Spec `json:"spec" yaml:"spec"`
+}
+
+// Spec holds the rules of a version policy.
+type Spec struct {
+	// Action is the default action for violations. Defaults to deny.
+	Action Action `json:"action,omitempty" yaml:"action,omitempty"`
+
+	// Components lists the components the policy constrains.
+	Components []ComponentPolicy `json:"components" yaml:"components"`
+}
+
+// ComponentPolicy lists the approved versions of a single component.
+type ComponentPolicy struct {
+	// Name matches a component reference by its name or its registry
+	// component name, so a policy for "gpu-operator" also covers instances
+	// of it.
+	Name string `json:"name" yaml:"name"`
+
+	// Versions lists the approved chart versions. Empty allows any version.
+	Versions []string `json:"versions,omitempty" yaml:"versions,omitempty"`
+
+	// Values lists approved values at paths of the resolved component values.
+	Values []ValuePolicy `json:"values,omitempty" yaml:"values,omitempty"`
+
+	// Action overrides the spec action for this component.
+	Action Action `json:"action,omitempty" yaml:"action,omitempty"`
+}
+
+// ValuePolicy lists the approved values at a component values path.
+type ValuePolicy struct {
+	// Path is the dot-separated values path, e.g. "driver.version".
+	Path string `json:"path" yaml:"path"`
+
+	// Allowed lists the approved values.
+	Allowed []string `json:"allowed" yaml:"allowed"`
+}
+
+// Violation is a resolved version that the policy does not approve.
+type Violation struct {
+	// Component is the name of the component reference.
+	Component string `json:"component" yaml:"component"`
+
+	// Path is the values path that was checked, or empty for the chart version.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// Actual is the resolved version.
+	Actual string `json:"actual" yaml:"actual"`
+
+	// Allowed lists the approved versions.
+	Allowed []string `json:"allowed" yaml:"allowed"`
+
+	// Action is the action that applies to the violation.
+	Action Action `json:"action" yaml:"action"`
+}
+
+// String returns a one-line description of the violation.
+func (v Violation) String() string {
+	subject := "version"
+	if v.Path != "" {
+		subject = v.Path
+	}
+	return fmt.Sprintf("%s %s %q is not approved (allowed: %s)",
+		v.Component, subject, v.Actual, strings.Join(v.Allowed, ", "))
+}
+
+// Validate checks the policy kind, actions and allowed expressions.
+func (p *VersionPolicy) Validate() error {
+	if p == nil {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "version policy cannot be nil")
+	}
+	if p.Kind != Kind {
+		return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+			"unexpected version policy kind", map[string]any{"kind": p.Kind, "expected": Kind})
+	}
+	if err := validateAction(p.Spec.Action); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(p.Spec.Components))
+	for _, c := range p.Spec.Components {
+		if c.Name == "" {
+			return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "version policy component name cannot be empty")
+		}
+		if _, ok := seen[c.Name]; ok {
+			return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"duplicate version policy component", map[string]any{"component": c.Name})
+		}
+		seen[c.Name] = struct{}{}
+
+		if err := validateAction(c.Action); err != nil {
+			return err
+		}
+		if _, err := parseAllowed(c.Versions); err != nil {
+			return eidoserrors.WrapWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"invalid allowed version", err, map[string]any{"component": c.Name})
+		}
+		for _, v := range c.Values {
+			if v.Path == "" {
+				return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+					"version policy value path cannot be empty", map[string]any{"component": c.Name})
+			}
+			if len(v.Allowed) == 0 {
+				return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+					"version policy value has no allowed values",
+					map[string]any{"component": c.Name, "path": v.Path})
+			}
+			if _, err := parseAllowed(v.Allowed); err != nil {
+				return eidoserrors.WrapWithContext(eidoserrors.ErrCodeInvalidRequest,
+					"invalid allowed value", err, map[string]any{"component": c.Name, "path": v.Path})
+			}
+		}
+	}
+
+	return nil
+}
+
+// Evaluate checks the enabled components of result against the policy.
+// values holds the resolved values of each component, keyed by component
+// reference name, as passed to the deployers; it may be nil when only chart
+// versions should be checked. Components without a version, and value paths
+// that are not set, are skipped.
+func (p *VersionPolicy) Evaluate(result *recipe.RecipeResult, values map[string]map[string]any) ([]Violation, error) {
+	if result == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "recipe result cannot be nil")
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	var violations []Violation
+	for _, ref := range result.ComponentRefs {
+		if !ref.IsEnabled() {
+			continue
+		}
+		c := p.componentPolicy(ref)
+		if c == nil {
+			continue
+		}
+		action := p.action(c)
+
+		if ref.Version != "" && len(c.Versions) > 0 {
+			ok, err := allowed(c.Versions, ref.Version)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				violations = append(violations, Violation{
+					Component: ref.Name,
+					Actual:    ref.Version,
+					Allowed:   c.Versions,
+					Action:    action,
+				})
+			}
+		}
+
+		for _, v := range c.Values {
+			actual, ok := valuepath.Lookup(values[ref.Name], v.Path)
+			if !ok {
+				continue
+			}
+			ok, err := allowed(v.Allowed, actual)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				violations = append(violations, Violation{
+					Component: ref.Name,
+					Path:      v.Path,
+					Actual:    actual,
+					Allowed:   v.Allowed,
+					Action:    action,
+				})
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// Enforce returns an error listing the violations with the deny action, or
+// nil if there are none.
+func Enforce(violations []Violation) error {
+	var denied []string
+	for _, v := range violations {
+		if v.Action == ActionDeny {
+			denied = append(denied, v.String())
+		}
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+	return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+		fmt.Sprintf("version policy violated: %s", strings.Join(denied, "; ")),
+		map[string]any{"violations": denied})
+}
+
+// componentPolicy returns the policy for ref, preferring an entry for the
+// reference name over one for its registry component.
+func (p *VersionPolicy) componentPolicy(ref recipe.ComponentRef) *ComponentPolicy {
+	var fallback *ComponentPolicy
+	for i := range p.Spec.Components {
+		c := &p.Spec.Components[i]
+		if c.Name == ref.Name {
+			return c
+		}
+		if c.Name == ref.ComponentName() {
+			fallback = c
+		}
+	}
+	return fallback
+}
+
+// action returns the action that applies to violations of c.
+func (p *VersionPolicy) action(c *ComponentPolicy) Action {
+	if c.Action != "" {
+		return c.Action
+	}
+	if p.Spec.Action != "" {
+		return p.Spec.Action
+	}
+	return ActionDeny
+}
+
+func validateAction(a Action) error {
+	switch a {
+	case "", ActionDeny, ActionWarn:
+		return nil
+	default:
+		return eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+			"invalid version policy action",
+			map[string]any{"action": string(a), "expected": []Action{ActionDeny, ActionWarn}})
+	}
+}
+
+func parseAllowed(exprs []string) ([]*validator.ConstraintExpression, error) {
+	parsed := make([]*validator.ConstraintExpression, 0, len(exprs))
+	for _, expr := range exprs {
+		ce, err := validator.ParseExpression(expr)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, ce)
+	}
+	return parsed, nil
+}
+
+// allowed reports whether actual matches any of the expressions. A value
+// that cannot be compared with an expression, such as "latest" against
+// ">= v25.3.0", does not match it.
+func allowed(exprs []string, actual string) (bool, error) {
+	parsed, err := parseAllowed(exprs)
+	if err != nil {
+		return false, err
+	}
+	for _, ce := range parsed {
+		if ok, _ := ce.Evaluate(actual); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+func testPolicy() *VersionPolicy {
+	return &VersionPolicy{
+		Kind: Kind,
+		Spec: Spec{
+			Components: []ComponentPolicy{
+				{
+					Name:     "gpu-operator",
+					Versions: []string{">= v25.3.0 < v26.0.0"},
+					Values: []ValuePolicy{
+						{Path: "driver.version", Allowed: []string{"580.82.07", "== 570.172.08"}},
+					},
+				},
+				{Name: "network-operator", Action: ActionWarn, Versions: []string{"v25.4.0"}},
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(p *VersionPolicy)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(*VersionPolicy) {}},
+		{name: "wrong kind", modify: func(p *VersionPolicy) { p.Kind = "Recipe" }, wantErr: true},
+		{name: "invalid action", modify: func(p *VersionPolicy) { p.Spec.Action = "block" }, wantErr: true},
+		{name: "invalid component action", modify: func(p *VersionPolicy) { p.Spec.Components[1].Action = "ignore" }, wantErr: true},
+		{name: "empty name", modify: func(p *VersionPolicy) { p.Spec.Components[0].Name = "" }, wantErr: true},
+		{name: "duplicate component", modify: func(p *VersionPolicy) { p.Spec.Components[1].Name = "gpu-operator" }, wantErr: true},
+		{name: "empty version expression", modify: func(p *VersionPolicy) { p.Spec.Components[0].Versions = []string{" "} }, wantErr: true},
+		{name: "empty value path", modify: func(p *VersionPolicy) { p.Spec.Components[0].Values[0].Path = "" }, wantErr: true},
+		{name: "no allowed values", modify: func(p *VersionPolicy) { p.Spec.Components[0].Values[0].Allowed = nil }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := testPolicy()
+			tt.modify(p)
+			if err := p.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var nilPolicy *VersionPolicy
+	if err := nilPolicy.Validate(); err == nil {
+		t.Error("expected error for nil policy")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name   string
+		refs   []recipe.ComponentRef
+		values map[string]map[string]any
+		want   []Violation
+	}{
+		{
+			name: "approved versions",
+			refs: []recipe.ComponentRef{
+				{Name: "gpu-operator", Version: "v25.10.1"},
+				{Name: "network-operator", Version: "v25.4.0"},
+				{Name: "cert-manager", Version: "v1.17.2"},
+			},
+			values: map[string]map[string]any{
+				"gpu-operator": {"driver": map[string]any{"version": "570.172.08"}},
+			},
+		},
+		{
+			name: "chart version outside range",
+			refs: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v24.9.2"}},
+			want: []Violation{{Component: "gpu-operator", Actual: "v24.9.2", Action: ActionDeny}},
+		},
+		{
+			name: "unparseable chart version",
+			refs: []recipe.ComponentRef{{Name: "gpu-operator", Version: "latest"}},
+			want: []Violation{{Component: "gpu-operator", Actual: "latest", Action: ActionDeny}},
+		},
+		{
+			name: "component action override",
+			refs: []recipe.ComponentRef{{Name: "network-operator", Version: "v25.1.0"}},
+			want: []Violation{{Component: "network-operator", Actual: "v25.1.0", Action: ActionWarn}},
+		},
+		{
+			name: "value not approved",
+			refs: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v25.10.1"}},
+			values: map[string]map[string]any{
+				"gpu-operator": {"driver": map[string]any{"version": "575.57.08"}},
+			},
+			want: []Violation{{Component: "gpu-operator", Path: "driver.version", Actual: "575.57.08", Action: ActionDeny}},
+		},
+		{
+			name:   "unset value skipped",
+			refs:   []recipe.ComponentRef{{Name: "gpu-operator", Version: "v25.10.1"}},
+			values: map[string]map[string]any{"gpu-operator": {"driver": map[string]any{"enabled": true}}},
+		},
+		{
+			name: "instance matched by registry component",
+			refs: []recipe.ComponentRef{{Name: "gpu-operator-canary", Component: "gpu-operator", Version: "v26.1.0"}},
+			want: []Violation{{Component: "gpu-operator-canary", Actual: "v26.1.0", Action: ActionDeny}},
+		},
+		{
+			name: "disabled component skipped",
+			refs: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v24.9.2", Enabled: &disabled}},
+		},
+	}
+
+	p := testPolicy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.Evaluate(&recipe.RecipeResult{ComponentRefs: tt.refs}, tt.values)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Evaluate() = %+v, want %+v", got, tt.want)
+			}
+			for i, want := range tt.want {
+				if got[i].Component != want.Component || got[i].Path != want.Path ||
+					got[i].Actual != want.Actual || got[i].Action != want.Action {
+					t.Errorf("violation[%d] = %+v, want %+v", i, got[i], want)
+				}
+				if len(got[i].Allowed) == 0 {
+					t.Errorf("violation[%d] has no allowed versions", i)
+				}
+			}
+		})
+	}
+
+	if _, err := p.Evaluate(nil, nil); err == nil {
+		t.Error("expected error for nil recipe result")
+	}
+}
+
+func TestEvaluate_DefaultAction(t *testing.T) {
+	p := testPolicy()
+	p.Spec.Action = ActionWarn
+	got, err := p.Evaluate(&recipe.RecipeResult{
+		ComponentRefs: []recipe.ComponentRef{{Name: "gpu-operator", Version: "v24.9.2"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Action != ActionWarn {
+		t.Errorf("Evaluate() = %+v, want one warn violation", got)
+	}
+}
+
+func TestEnforce(t *testing.T) {
+	warn := Violation{Component: "network-operator", Actual: "v25.1.0", Allowed: []string{"v25.4.0"}, Action: ActionWarn}
+	deny := Violation{Component: "gpu-operator", Path: "driver.version", Actual: "575.57.08", Allowed: []string{"580.82.07"}, Action: ActionDeny}
+
+	if err := Enforce(nil); err != nil {
+		t.Errorf("Enforce(nil) error = %v", err)
+	}
+	if err := Enforce([]Violation{warn}); err != nil {
+		t.Errorf("Enforce(warn) error = %v", err)
+	}
+
+	err := Enforce([]Violation{warn, deny})
+	if err == nil {
+		t.Fatal("expected error for deny violation")
+	}
+	if !strings.Contains(err.Error(), deny.String()) || strings.Contains(err.Error(), "network-operator") {
+		t.Errorf("Enforce() error = %q, want only the denied violation", err)
+	}
+}
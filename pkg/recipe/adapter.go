@@ -18,11 +18,9 @@ package recipe
 import (
 	"embed"
 	"fmt"
-
-	"gopkg.in/yaml.v3"
 )
 
-//go:embed data/overlays/*.yaml data/registry.yaml data/components/*/*.yaml data/components/*/manifests/*.yaml
+//go:embed data/overlays/*.yaml data/profiles/*.yaml data/registry.yaml data/components/*/*.yaml data/components/*/manifests/*.yaml
 var dataFS embed.FS
 
 // GetEmbeddedFS returns the embedded data filesystem.
@@ -112,7 +110,10 @@ func (r *RecipeResult) GetComponentRef(name string) *ComponentRef {
 }
 
 // GetValuesForComponent loads values from the component's valuesFile and inline overrides.
-// Merge order: base values → ValuesFile → Overrides (highest precedence).
+// Merge order: base values → files extended by ValuesFile (see ExtendsKey) →
+// ValuesFile → Overrides (highest precedence).
+// Each step deep-merges by default; MergeStrategiesKey annotations in the
+// overlay values files and overrides select another strategy per key.
 // This supports three patterns:
 //  1. ValuesFile only: Traditional separate file approach
 //  2. Overrides only: Fully self-contained recipe with inline overrides
@@ -131,81 +132,30 @@ func (r *RecipeResult) GetValuesForComponent(name string) (map[string]any, error
 		return result, nil
 	}
 
-	// Step 1: Load base and/or overlay values from files (if ValuesFile specified)
+	// Step 1: Load the base values and the chain of values files ending in
+	// ValuesFile (if specified), later files taking precedence
 	if ref.ValuesFile != "" {
-		provider := GetDataProvider()
-
-		// Determine if this is an overlay values file (not the base values.yaml)
-		baseValuesFile := fmt.Sprintf("components/%s/values.yaml", name)
-		isOverlay := ref.ValuesFile != baseValuesFile
-
-		if isOverlay {
-			// Load base values first
-			baseData, err := provider.ReadFile(baseValuesFile)
-			if err != nil {
-				// If base file doesn't exist, that's okay - just use overlay
-				result = make(map[string]any)
-			} else {
-				err = yaml.Unmarshal(baseData, &result)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse base values file %q: %w", baseValuesFile, err)
-				}
-			}
-
-			// Load overlay values
-			overlayData, err := provider.ReadFile(ref.ValuesFile)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read overlay values file %q: %w", ref.ValuesFile, err)
-			}
-
-			var overlayValues map[string]any
-			if err := yaml.Unmarshal(overlayData, &overlayValues); err != nil {
-				return nil, fmt.Errorf("failed to parse overlay values file %q: %w", ref.ValuesFile, err)
-			}
-
-			// Merge overlay into base (overlay takes precedence over base)
-			mergeValues(result, overlayValues)
-		} else {
-			// Just load the base values file
-			data, err := provider.ReadFile(ref.ValuesFile)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read values file %q: %w", ref.ValuesFile, err)
-			}
-
-			if err := yaml.Unmarshal(data, &result); err != nil {
-				return nil, fmt.Errorf("failed to parse values file %q: %w", ref.ValuesFile, err)
-			}
+		layers, err := valuesLayers(GetDataProvider().ReadFile, *ref)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = mergeValuesLayers(layers); err != nil {
+			return nil, err
 		}
 	}
 
 	// Step 2: Apply inline overrides (highest precedence)
 	if len(ref.Overrides) > 0 {
+		if err := ValidateMergeStrategies(ref.Overrides); err != nil {
+			return nil, fmt.Errorf("invalid merge strategy in overrides of component %q: %w", name, err)
+		}
 		mergeValues(result, ref.Overrides)
 	}
 
-	return result, nil
-}
+	// Annotations in the base values file have nothing to apply to
+	stripMergeStrategies(result)
 
-// mergeValues recursively merges src into dst.
-// For maps, it recursively merges nested keys.
-// For other types, src values override dst values.
-func mergeValues(dst, src map[string]any) {
-	for key, srcVal := range src {
-		if dstVal, exists := dst[key]; exists {
-			// If both are maps, merge recursively
-			if dstMap, dstOK := dstVal.(map[string]any); dstOK {
-				if srcMap, srcOK := srcVal.(map[string]any); srcOK {
-					mergeValues(dstMap, srcMap)
-					continue
-				}
-			}
-			// For non-map or mismatched types, src overrides dst
-			dst[key] = srcVal
-		} else {
-			// Key doesn't exist in dst, add it
-			dst[key] = srcVal
-		}
-	}
+	return result, nil
 }
 
 // HasComponentRefs checks if the input is a RecipeResult with component references.
@@ -62,6 +62,23 @@ func WithAllowLists(al *AllowLists) Option {
 	}
 }
 
+// WithExplain returns an Option that records in each built recipe which
+// recipe data file set each value (see RecipeResult.Explain).
+func WithExplain(explain bool) Option {
+	return func(b *Builder) {
+		b.Explain = explain
+	}
+}
+
+// WithAutoscaling returns an Option that applies the autoscaling overlay
+// (see AutoscalingOverlay) to each built recipe, for clusters whose GPU node
+// pools are scaled by the Cluster Autoscaler, including to zero.
+func WithAutoscaling(autoscaling bool) Option {
+	return func(b *Builder) {
+		b.Autoscaling = autoscaling
+	}
+}
+
 // NewBuilder creates a new Builder instance with the provided functional options.
 func NewBuilder(opts ...Option) *Builder {
 	b := &Builder{}
@@ -77,8 +94,10 @@ func NewBuilder(opts ...Option) *Builder {
 // It loads recipe metadata, applies matching overlays, and generates
 // tailored configuration recipes.
 type Builder struct {
-	Version    string
-	AllowLists *AllowLists
+	Version     string
+	AllowLists  *AllowLists
+	Explain     bool
+	Autoscaling bool
 }
 
 // BuildFromCriteria creates a RecipeResult payload for the provided criteria.
@@ -136,9 +155,36 @@ func (b *Builder) BuildFromCriteria(ctx context.Context, c *Criteria) (*RecipeRe
 		result.Metadata.Version = b.Version
 	}
 
+	if b.Autoscaling {
+		if err = store.ApplyOverlay(result, AutoscalingOverlay); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Explain {
+		if result.Explain, err = store.Explain(result); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
+// MatchingOverlays returns the names of the overlays a recipe for the criteria
+// would apply, without building it. Base is not included.
+func (b *Builder) MatchingOverlays(ctx context.Context, c *Criteria) ([]string, error) {
+	if c == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "criteria cannot be nil")
+	}
+
+	store, err := loadMetadataStore(ctx)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to load metadata store", err)
+	}
+
+	return store.MatchingOverlayNames(c)
+}
+
 // BuildFromCriteriaWithEvaluator creates a RecipeResult payload for the provided criteria,
 // filtering overlays based on constraint evaluation against snapshot data.
 //
@@ -200,5 +246,17 @@ func (b *Builder) BuildFromCriteriaWithEvaluator(ctx context.Context, c *Criteri
 		result.Metadata.Version = b.Version
 	}
 
+	if b.Autoscaling {
+		if err = store.ApplyOverlay(result, AutoscalingOverlay); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Explain {
+		if result.Explain, err = store.Explain(result); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
@@ -17,6 +17,8 @@ package recipe
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -239,6 +241,209 @@ func TestBuilder_BuildFromCriteriaWithEvaluator(t *testing.T) {
 	}
 }
 
+// TestBuilder_SkipsInstalledComponents verifies that components the snapshot
+// reports as already installed are disabled with a warning.
+func TestBuilder_SkipsInstalledComponents(t *testing.T) {
+	tests := []struct {
+		name         string
+		installed    string
+		wantDisabled bool
+	}{
+		{name: "cert-manager installed", installed: "true", wantDisabled: true},
+		{name: "cert-manager absent", installed: "false", wantDisabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := func(c Constraint) ConstraintEvalResult {
+				if c.Name == "K8s.cert-manager.installed" {
+					return ConstraintEvalResult{Passed: c.Value == tt.installed, Actual: tt.installed}
+				}
+				return ConstraintEvalResult{Passed: true}
+			}
+
+			builder := NewBuilder()
+			result, err := builder.BuildFromCriteriaWithEvaluator(context.Background(), NewCriteria(), evaluator)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ref := result.GetComponentRef("cert-manager")
+			if ref == nil {
+				t.Fatal("cert-manager component missing from result")
+			}
+			if ref.IsEnabled() == tt.wantDisabled {
+				t.Errorf("cert-manager enabled = %v, want %v", ref.IsEnabled(), !tt.wantDisabled)
+			}
+			if inOrder := slices.Contains(result.DeploymentOrder, "cert-manager"); inOrder == tt.wantDisabled {
+				t.Errorf("cert-manager in deployment order = %v, want %v", inOrder, !tt.wantDisabled)
+			}
+
+			if !tt.wantDisabled {
+				if len(result.Metadata.ComponentWarnings) != 0 {
+					t.Errorf("unexpected component warnings: %v", result.Metadata.ComponentWarnings)
+				}
+				return
+			}
+			if len(result.Metadata.ComponentWarnings) != 1 ||
+				result.Metadata.ComponentWarnings[0].Component != "cert-manager" {
+				t.Errorf("expected one cert-manager warning, got %v", result.Metadata.ComponentWarnings)
+			}
+			for _, r := range result.ComponentRefs {
+				if slices.Contains(r.DependencyRefs, "cert-manager") {
+					t.Errorf("component %q still depends on skipped cert-manager", r.Name)
+				}
+			}
+		})
+	}
+}
+
+// TestCheckMIGStrategy verifies warnings for GPU Operator MIG strategies the
+// snapshot's MIG layout does not support.
+func TestCheckMIGStrategy(t *testing.T) {
+	store := &MetadataStore{ValuesFiles: map[string][]byte{
+		"gpu-operator/values-mixed.yaml": []byte("mig:\n  strategy: mixed\n"),
+	}}
+
+	tests := []struct {
+		name     string
+		ref      ComponentRef
+		snapshot string
+		wantWarn bool
+	}{
+		{
+			name:     "mixed in values file, MIG disabled",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-mixed.yaml"},
+			snapshot: "none",
+			wantWarn: true,
+		},
+		{
+			name:     "inline override wins over values file",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-mixed.yaml", Overrides: map[string]any{"mig": map[string]any{"strategy": "single"}}},
+			snapshot: "mixed",
+			wantWarn: true,
+		},
+		{
+			name:     "single on uniform layout",
+			ref:      ComponentRef{Name: "gpu-operator", Overrides: map[string]any{"mig": map[string]any{"strategy": "single"}}},
+			snapshot: "single",
+		},
+		{
+			name:     "mixed on uniform layout",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-mixed.yaml"},
+			snapshot: "single",
+		},
+		{
+			name:     "strategy unset",
+			ref:      ComponentRef{Name: "gpu-operator"},
+			snapshot: "mixed",
+		},
+		{
+			name:     "snapshot without MIG data",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-mixed.yaml"},
+			snapshot: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := func(c Constraint) ConstraintEvalResult {
+				if c.Name != migStrategyPath || tt.snapshot == "" {
+					return ConstraintEvalResult{Error: errors.New("not found")}
+				}
+				return ConstraintEvalResult{Passed: c.Value == tt.snapshot, Actual: tt.snapshot}
+			}
+
+			spec := &RecipeMetadataSpec{ComponentRefs: []ComponentRef{tt.ref}}
+			warnings := store.checkMIGStrategy(spec, evaluator)
+			if got := len(warnings) > 0; got != tt.wantWarn {
+				t.Errorf("warned = %v, want %v (warnings: %+v)", got, tt.wantWarn, warnings)
+			}
+		})
+	}
+}
+
+// TestConfigureHostDevices verifies KubeVirt PCI host devices are filled in
+// from the snapshot GPU PCI IDs.
+func TestConfigureHostDevices(t *testing.T) {
+	disabled := false
+	inline := map[string]any{"hostDevices": map[string]any{"pci": []any{}}}
+
+	tests := []struct {
+		name        string
+		ref         ComponentRef
+		snapshot    string
+		wantDevices []string
+		wantWarn    bool
+	}{
+		{
+			name:        "devices from snapshot",
+			ref:         ComponentRef{Name: "kubevirt", Overrides: map[string]any{"kubevirtCR": map[string]any{"create": true}}},
+			snapshot:    "10de:2330,10de:26b5",
+			wantDevices: []string{"10de:2330", "10de:26b5"},
+			wantWarn:    true,
+		},
+		{
+			name:     "snapshot without PCI IDs",
+			ref:      ComponentRef{Name: "kubevirt"},
+			wantWarn: true,
+		},
+		{
+			name:     "inline devices kept",
+			ref:      ComponentRef{Name: "kubevirt", Overrides: inline},
+			snapshot: "10de:2330",
+		},
+		{
+			name:     "disabled component",
+			ref:      ComponentRef{Name: "kubevirt", Enabled: &disabled},
+			snapshot: "10de:2330",
+		},
+		{
+			name:     "other component",
+			ref:      ComponentRef{Name: "gpu-operator"},
+			snapshot: "10de:2330",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := func(c Constraint) ConstraintEvalResult {
+				if c.Name != gpuPCIDeviceIDsPath || tt.snapshot == "" {
+					return ConstraintEvalResult{Error: errors.New("not found")}
+				}
+				return ConstraintEvalResult{Passed: true, Actual: tt.snapshot}
+			}
+
+			spec := &RecipeMetadataSpec{ComponentRefs: []ComponentRef{tt.ref}}
+			warnings := configureHostDevices(spec, evaluator)
+			if got := len(warnings) > 0; got != tt.wantWarn {
+				t.Errorf("warned = %v, want %v (warnings: %+v)", got, tt.wantWarn, warnings)
+			}
+			if tt.wantDevices == nil {
+				return
+			}
+			if _, ok := tt.ref.Overrides["hostDevices"]; ok {
+				t.Errorf("input overrides modified: %v", tt.ref.Overrides)
+			}
+
+			hostDevices, _ := spec.ComponentRefs[0].Overrides["hostDevices"].(map[string]any)
+			devices, _ := hostDevices["pci"].([]any)
+			if len(devices) != len(tt.wantDevices) {
+				t.Fatalf("hostDevices.pci = %v, want %v", devices, tt.wantDevices)
+			}
+			for i, want := range tt.wantDevices {
+				device, _ := devices[i].(map[string]any)
+				if device["pciVendorSelector"] != want {
+					t.Errorf("device[%d] pciVendorSelector = %v, want %s", i, device["pciVendorSelector"], want)
+				}
+				if device["resourceName"] != "nvidia.com/gpu-"+strings.ReplaceAll(want, ":", "-") {
+					t.Errorf("device[%d] resourceName = %v", i, device["resourceName"])
+				}
+			}
+		})
+	}
+}
+
 // TestConstraintWarning tests the ConstraintWarning struct.
 func TestConstraintWarning(t *testing.T) {
 	warning := ConstraintWarning{
@@ -301,3 +506,87 @@ func TestConstraintEvalResult(t *testing.T) {
 		t.Error("expected error to be set")
 	}
 }
+
+// TestBuilder_MatchingOverlays verifies that the overlay preview agrees with
+// the overlays a built recipe applies.
+func TestBuilder_MatchingOverlays(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria *Criteria
+	}{
+		{name: "any", criteria: NewCriteria()},
+		{name: "eks", criteria: &Criteria{Service: CriteriaServiceEKS}},
+		{name: "gb200 eks ubuntu training", criteria: &Criteria{
+			Service:     CriteriaServiceEKS,
+			Accelerator: CriteriaAcceleratorGB200,
+			Intent:      CriteriaIntentTraining,
+			OS:          CriteriaOSUbuntu,
+		}},
+	}
+
+	builder := NewBuilder()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overlays, err := builder.MatchingOverlays(context.Background(), tt.criteria)
+			if err != nil {
+				t.Fatalf("MatchingOverlays() error = %v", err)
+			}
+			result, err := builder.BuildFromCriteria(context.Background(), tt.criteria)
+			if err != nil {
+				t.Fatalf("BuildFromCriteria() error = %v", err)
+			}
+			if want := result.Metadata.AppliedOverlays[1:]; !slices.Equal(overlays, want) {
+				t.Errorf("MatchingOverlays() = %v, want %v", overlays, want)
+			}
+		})
+	}
+
+	if _, err := builder.MatchingOverlays(context.Background(), nil); err == nil {
+		t.Error("expected error for nil criteria")
+	}
+}
+
+// TestBuilder_Autoscaling verifies that the autoscaling overlay is applied on
+// request to the components the recipe contains, without adding components.
+func TestBuilder_Autoscaling(t *testing.T) {
+	criteria := &Criteria{
+		Service:     CriteriaServiceEKS,
+		Accelerator: CriteriaAcceleratorH100,
+		Intent:      CriteriaIntentTraining,
+	}
+
+	plain, err := NewBuilder().BuildFromCriteria(context.Background(), criteria)
+	if err != nil {
+		t.Fatalf("BuildFromCriteria() error = %v", err)
+	}
+	if slices.Contains(plain.Metadata.AppliedOverlays, AutoscalingOverlay) {
+		t.Errorf("autoscaling overlay applied without WithAutoscaling: %v", plain.Metadata.AppliedOverlays)
+	}
+
+	result, err := NewBuilder(WithAutoscaling(true)).BuildFromCriteria(context.Background(), criteria)
+	if err != nil {
+		t.Fatalf("BuildFromCriteria() error = %v", err)
+	}
+	applied := result.Metadata.AppliedOverlays
+	if applied[len(applied)-1] != AutoscalingOverlay {
+		t.Errorf("AppliedOverlays = %v, want %q last", applied, AutoscalingOverlay)
+	}
+	if !slices.Equal(result.DeploymentOrder, plain.DeploymentOrder) {
+		t.Errorf("DeploymentOrder = %v, want %v", result.DeploymentOrder, plain.DeploymentOrder)
+	}
+	if result.GetComponentRef("nim") != nil {
+		t.Error("autoscaling overlay added nim to a recipe that does not deploy it")
+	}
+
+	values, err := result.GetValuesForComponent("gpu-operator")
+	if err != nil {
+		t.Fatalf("GetValuesForComponent() error = %v", err)
+	}
+	if got := nestedString(values, "daemonsets", "priorityClassName"); got != "system-node-critical" {
+		t.Errorf("daemonsets.priorityClassName = %q, want system-node-critical", got)
+	}
+	// Values the recipe already set are kept
+	if got := nestedString(values, "operator", "resources", "limits", "cpu"); got != "500m" {
+		t.Errorf("operator.resources.limits.cpu = %q, want 500m", got)
+	}
+}
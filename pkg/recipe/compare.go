@@ -0,0 +1,263 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/internal/valuepath"
+)
+
+// RecipeComparisonKind is the kind of a result that compares recipes built
+// for several intents.
+const RecipeComparisonKind = "recipeComparison"
+
+// IntentRecipe is the recipe generated for a single intent.
+type IntentRecipe struct {
+	// Intent is the workload intent the recipe was built for.
+	Intent CriteriaIntentType `json:"intent" yaml:"intent"`
+
+	// Recipe is the recipe result built for the intent.
+	Recipe *RecipeResult `json:"recipe" yaml:"recipe"`
+}
+
+// ValueComparison is a component value that differs between intents.
+type ValueComparison struct {
+	// Path is the dot-separated path of the value, e.g. "driver.version".
+	Path string `json:"path" yaml:"path"`
+
+	// Values maps each intent to the value. Intents that do not set the value
+	// are omitted.
+	Values map[CriteriaIntentType]any `json:"values" yaml:"values"`
+}
+
+// ComponentComparison describes how a component differs between intents.
+type ComponentComparison struct {
+	// Name is the component name.
+	Name string `json:"name" yaml:"name"`
+
+	// Versions maps each intent that includes the component to its version.
+	Versions map[CriteriaIntentType]string `json:"versions" yaml:"versions"`
+
+	// MissingFrom lists the intents whose recipe does not include the component.
+	MissingFrom []CriteriaIntentType `json:"missingFrom,omitempty" yaml:"missingFrom,omitempty"`
+
+	// Values lists the component values that differ, sorted by path.
+	Values []ValueComparison `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+// RecipeComparison holds recipes built for several intents from otherwise
+// identical criteria, along with the components and values that differ.
+type RecipeComparison struct {
+	// Kind is always "recipeComparison".
+	Kind string `json:"kind" yaml:"kind"`
+
+	// APIVersion is the API version.
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+
+	// Intents lists the compared intents in request order.
+	Intents []CriteriaIntentType `json:"intents" yaml:"intents"`
+
+	// Differences lists the components that differ between intents, sorted by name.
+	// Components that are identical for every intent are not listed.
+	Differences []ComponentComparison `json:"differences" yaml:"differences"`
+
+	// Recipes contains the full recipe for each intent, in request order.
+	Recipes []IntentRecipe `json:"recipes" yaml:"recipes"`
+}
+
+// ParseCriteriaIntentTypes parses a comma-separated list of intents, such as
+// "training,inference". Duplicates are removed and "any" is rejected, since
+// it does not describe a distinct workload.
+func ParseCriteriaIntentTypes(s string) ([]CriteriaIntentType, error) {
+	var intents []CriteriaIntentType
+	for _, part := range strings.Split(s, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		intent, err := ParseCriteriaIntentType(part)
+		if err != nil {
+			return nil, err
+		}
+		if intent == CriteriaIntentAny {
+			return nil, fmt.Errorf("intent %q cannot be compared", part)
+		}
+		if !slices.Contains(intents, intent) {
+			intents = append(intents, intent)
+		}
+	}
+	return intents, nil
+}
+
+// BuildComparison builds one recipe per intent from criteria, with the
+// criteria's intent replaced, and compares them. At least two intents are
+// required.
+func (b *Builder) BuildComparison(ctx context.Context, criteria *Criteria, intents []CriteriaIntentType) (*RecipeComparison, error) {
+	if criteria == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "criteria cannot be nil")
+	}
+	if len(intents) < 2 {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "at least two intents are required for a comparison")
+	}
+
+	recipes := make([]IntentRecipe, 0, len(intents))
+	for _, intent := range intents {
+		c := *criteria
+		c.Intent = intent
+
+		result, err := b.BuildFromCriteria(ctx, &c)
+		if err != nil {
+			return nil, eidoserrors.WrapWithContext(
+				eidoserrors.ErrCodeInternal,
+				"failed to build recipe for intent",
+				err,
+				map[string]any{
+					"intent": string(intent),
+				},
+			)
+		}
+		recipes = append(recipes, IntentRecipe{Intent: intent, Recipe: result})
+	}
+
+	return CompareRecipes(recipes)
+}
+
+// CompareRecipes compares recipes built for different intents. A component is
+// reported when it is missing from some recipes, its version differs, or any
+// of its resolved values (values file plus overrides) differ.
+func CompareRecipes(recipes []IntentRecipe) (*RecipeComparison, error) {
+	comparison := &RecipeComparison{
+		Kind:        RecipeComparisonKind,
+		APIVersion:  RecipeCriteriaAPIVersion,
+		Intents:     make([]CriteriaIntentType, 0, len(recipes)),
+		Differences: []ComponentComparison{},
+		Recipes:     recipes,
+	}
+
+	names := make(map[string]struct{})
+	for _, r := range recipes {
+		if r.Recipe == nil {
+			return nil, eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"recipe cannot be nil", map[string]any{"intent": string(r.Intent)})
+		}
+		comparison.Intents = append(comparison.Intents, r.Intent)
+		for _, ref := range r.Recipe.ComponentRefs {
+			names[ref.Name] = struct{}{}
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		diff, err := compareComponent(name, recipes)
+		if err != nil {
+			return nil, err
+		}
+		if diff != nil {
+			comparison.Differences = append(comparison.Differences, *diff)
+		}
+	}
+
+	return comparison, nil
+}
+
+// compareComponent compares a single component across recipes. It returns nil
+// when the component is identical in every recipe.
+func compareComponent(name string, recipes []IntentRecipe) (*ComponentComparison, error) {
+	diff := &ComponentComparison{
+		Name:     name,
+		Versions: make(map[CriteriaIntentType]string),
+	}
+
+	values := make(map[CriteriaIntentType]map[string]any)
+	paths := make(map[string]struct{})
+	for _, r := range recipes {
+		ref := r.Recipe.GetComponentRef(name)
+		if ref == nil {
+			diff.MissingFrom = append(diff.MissingFrom, r.Intent)
+			continue
+		}
+		diff.Versions[r.Intent] = ref.Version
+
+		v, err := r.Recipe.GetValuesForComponent(name)
+		if err != nil {
+			return nil, eidoserrors.WrapWithContext(eidoserrors.ErrCodeInternal,
+				"failed to load component values", err,
+				map[string]any{"component": name, "intent": string(r.Intent)})
+		}
+		flat := valuepath.Flatten(v)
+		values[r.Intent] = flat
+		for path := range flat {
+			paths[path] = struct{}{}
+		}
+	}
+
+	sortedPaths := make([]string, 0, len(paths))
+	for path := range paths {
+		sortedPaths = append(sortedPaths, path)
+	}
+	sort.Strings(sortedPaths)
+
+	for _, path := range sortedPaths {
+		vc := ValueComparison{Path: path, Values: make(map[CriteriaIntentType]any)}
+		var (
+			first   any
+			seen    bool
+			differs bool
+		)
+		for _, r := range recipes {
+			flat, ok := values[r.Intent]
+			if !ok {
+				continue
+			}
+			v, set := flat[path]
+			if set {
+				vc.Values[r.Intent] = v
+			}
+			if !seen {
+				first, seen = v, true
+				if !set {
+					differs = true
+				}
+				continue
+			}
+			if !set || !reflect.DeepEqual(first, v) {
+				differs = true
+			}
+		}
+		if differs {
+			diff.Values = append(diff.Values, vc)
+		}
+	}
+
+	versions := make(map[string]struct{})
+	for _, v := range diff.Versions {
+		versions[v] = struct{}{}
+	}
+	if len(diff.MissingFrom) == 0 && len(versions) <= 1 && len(diff.Values) == 0 {
+		return nil, nil
+	}
+	return diff, nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseCriteriaIntentTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []CriteriaIntentType
+		wantErr bool
+	}{
+		{name: "two intents", input: "training,inference", want: []CriteriaIntentType{CriteriaIntentTraining, CriteriaIntentInference}},
+		{name: "spaces and case", input: " Inference , TRAINING ", want: []CriteriaIntentType{CriteriaIntentInference, CriteriaIntentTraining}},
+		{name: "duplicates removed", input: "training,training", want: []CriteriaIntentType{CriteriaIntentTraining}},
+		{name: "empty", input: "", want: nil},
+		{name: "any rejected", input: "training,any", wantErr: true},
+		{name: "invalid", input: "training,batch", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCriteriaIntentTypes(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCriteriaIntentTypes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseCriteriaIntentTypes() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("intent[%d] = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCompareRecipes(t *testing.T) {
+	training := &RecipeResult{
+		ComponentRefs: []ComponentRef{
+			{Name: "cert-manager", Version: "v1.17.2"},
+			{Name: "gpu-operator", Version: "v25.10.1", Overrides: map[string]any{
+				"driver": map[string]any{"version": "580.82.07", "rdma": true},
+				"cdi":    map[string]any{"enabled": true},
+			}},
+			{Name: "kubeflow-trainer", Version: "v2.0.0"},
+		},
+	}
+	inference := &RecipeResult{
+		ComponentRefs: []ComponentRef{
+			{Name: "cert-manager", Version: "v1.17.2"},
+			{Name: "gpu-operator", Version: "v25.10.1", Overrides: map[string]any{
+				"driver": map[string]any{"version": "580.82.07", "rdma": false},
+			}},
+			{Name: "dynamo", Version: "v0.4.0"},
+		},
+	}
+
+	comparison, err := CompareRecipes([]IntentRecipe{
+		{Intent: CriteriaIntentTraining, Recipe: training},
+		{Intent: CriteriaIntentInference, Recipe: inference},
+	})
+	if err != nil {
+		t.Fatalf("CompareRecipes() error = %v", err)
+	}
+
+	if comparison.Kind != RecipeComparisonKind {
+		t.Errorf("Kind = %q, want %q", comparison.Kind, RecipeComparisonKind)
+	}
+	if len(comparison.Recipes) != 2 {
+		t.Errorf("got %d recipes, want 2", len(comparison.Recipes))
+	}
+
+	diffs := make(map[string]ComponentComparison)
+	for _, d := range comparison.Differences {
+		diffs[d.Name] = d
+	}
+	if len(diffs) != 3 {
+		t.Fatalf("got differences for %v, want dynamo, gpu-operator, kubeflow-trainer", comparison.Differences)
+	}
+	if _, ok := diffs["cert-manager"]; ok {
+		t.Error("identical cert-manager should not be reported")
+	}
+
+	if d := diffs["dynamo"]; len(d.MissingFrom) != 1 || d.MissingFrom[0] != CriteriaIntentTraining {
+		t.Errorf("dynamo MissingFrom = %v, want [training]", d.MissingFrom)
+	}
+	if d := diffs["kubeflow-trainer"]; len(d.MissingFrom) != 1 || d.MissingFrom[0] != CriteriaIntentInference {
+		t.Errorf("kubeflow-trainer MissingFrom = %v, want [inference]", d.MissingFrom)
+	}
+
+	gpu := diffs["gpu-operator"]
+	paths := make(map[string]ValueComparison)
+	for _, v := range gpu.Values {
+		paths[v.Path] = v
+	}
+	if len(paths) != 2 {
+		t.Fatalf("gpu-operator value differences = %+v, want cdi.enabled and driver.rdma", gpu.Values)
+	}
+	if v := paths["driver.rdma"]; v.Values[CriteriaIntentTraining] != true || v.Values[CriteriaIntentInference] != false {
+		t.Errorf("driver.rdma values = %v", v.Values)
+	}
+	if v := paths["cdi.enabled"]; len(v.Values) != 1 || v.Values[CriteriaIntentTraining] != true {
+		t.Errorf("cdi.enabled values = %v, want only training", v.Values)
+	}
+}
+
+func TestCompareRecipes_NilRecipe(t *testing.T) {
+	if _, err := CompareRecipes([]IntentRecipe{{Intent: CriteriaIntentTraining}}); err == nil {
+		t.Error("expected error for nil recipe")
+	}
+}
+
+func TestBuildComparison(t *testing.T) {
+	ctx := context.Background()
+	b := NewBuilder()
+
+	criteria := NewCriteria()
+	criteria.Service = CriteriaServiceEKS
+	criteria.Accelerator = CriteriaAcceleratorH100
+
+	comparison, err := b.BuildComparison(ctx, criteria,
+		[]CriteriaIntentType{CriteriaIntentTraining, CriteriaIntentInference})
+	if err != nil {
+		t.Skipf("BuildComparison() failed (may be expected if no matching overlays): %v", err)
+	}
+
+	for i, intent := range []CriteriaIntentType{CriteriaIntentTraining, CriteriaIntentInference} {
+		got := comparison.Recipes[i]
+		if got.Intent != intent || got.Recipe.Criteria.Intent != intent {
+			t.Errorf("recipe[%d] intent = %q (criteria %q), want %q", i, got.Intent, got.Recipe.Criteria.Intent, intent)
+		}
+	}
+	if criteria.Intent != CriteriaIntentAny {
+		t.Errorf("BuildComparison() modified input criteria intent to %q", criteria.Intent)
+	}
+
+	if _, err := b.BuildComparison(ctx, criteria, []CriteriaIntentType{CriteriaIntentTraining}); err == nil {
+		t.Error("expected error for a single intent")
+	}
+	if _, err := b.BuildComparison(ctx, nil, []CriteriaIntentType{CriteriaIntentTraining, CriteriaIntentInference}); err == nil {
+		t.Error("expected error for nil criteria")
+	}
+}
+
+func TestHandleRecipes_Compare(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		wantStatus int
+	}{
+		{
+			name:       "compare intents",
+			method:     http.MethodGet,
+			url:        "/v1/recipe?service=eks&accelerator=h100&intent=training,inference&compare=true",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "single intent",
+			method:     http.MethodGet,
+			url:        "/v1/recipe?service=eks&intent=training&compare=true",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid compare value",
+			method:     http.MethodGet,
+			url:        "/v1/recipe?intent=training,inference&compare=maybe",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "post not supported",
+			method:     http.MethodPost,
+			url:        "/v1/recipe?intent=training,inference&compare=true",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			NewBuilder().HandleRecipes(w, httptest.NewRequest(tt.method, tt.url, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var comparison RecipeComparison
+			if err := json.Unmarshal(w.Body.Bytes(), &comparison); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if comparison.Kind != RecipeComparisonKind || len(comparison.Recipes) != 2 {
+				t.Errorf("unexpected comparison: kind=%q recipes=%d", comparison.Kind, len(comparison.Recipes))
+			}
+		})
+	}
+}
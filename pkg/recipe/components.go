@@ -17,6 +17,7 @@ package recipe
 import (
 	"fmt"
 	"slices"
+	"strings"
 	"sync"
 
 	"gopkg.in/yaml.v3"
@@ -54,6 +55,236 @@ type ComponentConfig struct {
 
 	// NodeScheduling defines paths for injecting node selectors and tolerations.
 	NodeScheduling NodeSchedulingConfig `yaml:"nodeScheduling,omitempty"`
+
+	// CRDs lists the CustomResourceDefinitions the component installs.
+	// Informational only; used to describe install plans.
+	CRDs []string `yaml:"crds,omitempty"`
+
+	// DetectInstalled is a snapshot measurement path (e.g.,
+	// "K8s.cert-manager.installed") that reads "true" when the component is
+	// already present on the cluster. When a snapshot shows it installed,
+	// recipe generation disables the component instead of installing it twice.
+	DetectInstalled string `yaml:"detectInstalled,omitempty"`
+
+	// NamespaceScope describes how to install the component with namespace
+	// scope, without cluster-wide RBAC or resources. Nil when the chart does
+	// not support it.
+	NamespaceScope *NamespaceScopeConfig `yaml:"namespaceScope,omitempty"`
+
+	// Docs links the upstream documentation of the component.
+	Docs DocsConfig `yaml:"docs,omitempty"`
+
+	// Images locates the image repositories the component deploys in its
+	// values, so they can be rewritten to a private registry mirror.
+	Images []ImageConfig `yaml:"images,omitempty"`
+
+	// Secrets describes the Secrets the component reads that the bundle
+	// does not ship, so they can be generated for a secret backend.
+	Secrets []SecretConfig `yaml:"secrets,omitempty"`
+
+	// Proxy locates where proxy settings and a custom CA bundle are
+	// injected into the component values.
+	Proxy ProxyConfig `yaml:"proxy,omitempty"`
+
+	// Verify lists the post-install checks verify.sh runs for the
+	// component.
+	Verify []VerifyCheck `yaml:"verify,omitempty"`
+
+	// OpenShiftSCC lists the SecurityContextConstraints the component pods
+	// need on OpenShift beyond restricted-v2, and the service accounts they
+	// run as. Bundles for OpenShift grant each SCC to those service accounts.
+	OpenShiftSCC *OpenShiftSCCConfig `yaml:"openShiftSCC,omitempty"`
+
+	// ManifestValues are top-level keys of the component values read only
+	// by the component's manifests (e.g., "clusterIssuer"). Bundles remove
+	// them from the values passed to the chart, whose schema may reject
+	// unknown keys; the umbrella chart keeps them under
+	// manifests.<component>.
+	ManifestValues []string `yaml:"manifestValues,omitempty"`
+}
+
+// VerifyCheck describes a post-install check of a component: either a
+// kubectl wait for a resource, or a command run in a pod requesting a GPU.
+type VerifyCheck struct {
+	// Description says what the check verifies (e.g., "ClusterPolicy is
+	// ready").
+	Description string `yaml:"description"`
+
+	// Wait is the resource kubectl waits for, as "<resource>/<name>" or
+	// "<resource>" for every object matching Selector, or all of them
+	// (e.g., "clusterpolicies.nvidia.com/cluster-policy").
+	Wait string `yaml:"wait,omitempty"`
+
+	// Selector is a label selector narrowing the objects waited for.
+	Selector string `yaml:"selector,omitempty"`
+
+	// Namespaced is set when the resource lives in the component namespace.
+	Namespaced bool `yaml:"namespaced,omitempty"`
+
+	// For is the kubectl wait condition (e.g., "condition=Available" or
+	// "jsonpath={.status.state}=ready").
+	For string `yaml:"for,omitempty"`
+
+	// GPUPod runs a command in a pod requesting a GPU on the accelerated
+	// nodes, instead of waiting for a resource.
+	GPUPod *GPUPodCheck `yaml:"gpuPod,omitempty"`
+
+	// EnabledPath is a values path (e.g., "devicePlugin.enabled"). The check
+	// is skipped when the component values set it to false.
+	EnabledPath string `yaml:"enabledPath,omitempty"`
+}
+
+// GPUPodCheck describes a pod running a command on a GPU.
+type GPUPodCheck struct {
+	// Image is the image the pod runs.
+	Image string `yaml:"image"`
+
+	// Command is the command the pod runs; the check passes when it exits
+	// with status 0.
+	Command []string `yaml:"command"`
+
+	// Resource is the extended resource the pod requests one of. Empty
+	// means "nvidia.com/gpu".
+	Resource string `yaml:"resource,omitempty"`
+}
+
+// ImageConfig locates an image repository in the component values.
+type ImageConfig struct {
+	// Path is the values path of the image repository or registry
+	// (e.g., "driver.repository").
+	Path string `yaml:"path"`
+
+	// Default is the value the chart uses when the values leave Path unset.
+	Default string `yaml:"default,omitempty"`
+
+	// Image is appended to the value at Path to name the image, for charts
+	// that set the image name separately (e.g., "driver").
+	Image string `yaml:"image,omitempty"`
+
+	// TagPath is the values path of the image tag, for charts whose values
+	// set the exact tag.
+	TagPath string `yaml:"tagPath,omitempty"`
+}
+
+// SecretConfig describes a Secret a component reads, such as registry
+// credentials or a license token.
+type SecretConfig struct {
+	// Name is the Secret name the chart uses by default.
+	Name string `yaml:"name,omitempty"`
+
+	// NamePath is the values path naming the Secret (e.g.,
+	// "driver.licensingConfig.secretName"). A name set there replaces Name.
+	// When neither is set the component does not use the Secret.
+	NamePath string `yaml:"namePath,omitempty"`
+
+	// Type is the Secret type. Empty means "Opaque".
+	Type string `yaml:"type,omitempty"`
+
+	// Keys are the data keys the component reads from the Secret.
+	Keys []string `yaml:"keys"`
+
+	// Description says what the Secret holds and where to get it.
+	Description string `yaml:"description,omitempty"`
+}
+
+// ProxyConfig locates where a component takes proxy settings and a custom
+// CA bundle in its values.
+type ProxyConfig struct {
+	// EnvPaths are values paths of container env var lists the proxy
+	// variables are merged into (e.g., "driver.env").
+	EnvPaths []string `yaml:"envPaths,omitempty"`
+
+	// CAConfigMapPaths are values paths set to the name of the ConfigMap
+	// holding the CA bundle (e.g., "driver.certConfig.name").
+	CAConfigMapPaths []string `yaml:"caConfigMapPaths,omitempty"`
+
+	// CAVolumes are volume lists the CA bundle ConfigMap is mounted from,
+	// for charts that take extra volumes rather than a ConfigMap name.
+	CAVolumes []CAVolumeConfig `yaml:"caVolumes,omitempty"`
+}
+
+// CAVolumeConfig locates a volume list and the volume mount list of the same
+// container in the component values.
+type CAVolumeConfig struct {
+	// VolumesPath is the values path of the pod volumes (e.g., "volumes").
+	VolumesPath string `yaml:"volumesPath"`
+
+	// VolumeMountsPath is the values path of the container volume mounts
+	// (e.g., "volumeMounts").
+	VolumeMountsPath string `yaml:"volumeMountsPath"`
+}
+
+// Placeholders expanded in OpenShiftSCCGrant service account names.
+const (
+	// SCCReleasePlaceholder is replaced with the Helm release name.
+	SCCReleasePlaceholder = "{release}"
+
+	// SCCFullnamePlaceholder is replaced with the chart's fullname: the
+	// release name, suffixed with the chart name unless it already contains
+	// it, truncated to OpenShiftSCCConfig.FullnameLength.
+	SCCFullnamePlaceholder = "{fullname}"
+)
+
+// OpenShiftSCCConfig describes the SecurityContextConstraints a component's
+// pods need on OpenShift.
+type OpenShiftSCCConfig struct {
+	// FullnameLength is the length the chart truncates its fullname to
+	// (default: 63).
+	FullnameLength int `yaml:"fullnameLength,omitempty"`
+
+	// Grants pair each SCC with the service accounts that need it.
+	Grants []OpenShiftSCCGrant `yaml:"grants"`
+}
+
+// OpenShiftSCCGrant grants a SecurityContextConstraints to service accounts
+// of the component namespace.
+type OpenShiftSCCGrant struct {
+	// SCC is the name of the SecurityContextConstraints (e.g., "nonroot-v2").
+	SCC string `yaml:"scc"`
+
+	// ServiceAccounts are the names of the service accounts, which may
+	// contain SCCReleasePlaceholder and SCCFullnamePlaceholder.
+	ServiceAccounts []string `yaml:"serviceAccounts"`
+}
+
+// TakesCABundle reports whether the component mounts a custom CA bundle.
+func (p ProxyConfig) TakesCABundle() bool {
+	return len(p.CAConfigMapPaths) > 0 || len(p.CAVolumes) > 0
+}
+
+// DocsVersionPlaceholder is replaced in DocsConfig URLs with the deployed
+// component version, without a leading "v".
+const DocsVersionPlaceholder = "{version}"
+
+// DocsConfig links the upstream documentation of a component. URLs may
+// contain DocsVersionPlaceholder to link the documentation of the exact
+// version deployed.
+type DocsConfig struct {
+	// URL is the install documentation.
+	URL string `yaml:"url,omitempty"`
+
+	// SupportMatrix is the support matrix listing the platforms, Kubernetes
+	// versions and drivers the component supports.
+	SupportMatrix string `yaml:"supportMatrix,omitempty"`
+}
+
+// resolveDocsURL returns url with DocsVersionPlaceholder replaced by version.
+// It returns "" when url needs a version and version is empty.
+func resolveDocsURL(url, version string) string {
+	if !strings.Contains(url, DocsVersionPlaceholder) {
+		return url
+	}
+	if version == "" {
+		return ""
+	}
+	return strings.ReplaceAll(url, DocsVersionPlaceholder, strings.TrimPrefix(version, "v"))
+}
+
+// NamespaceScopeConfig describes a namespace-scoped install of a component.
+type NamespaceScopeConfig struct {
+	// Values are merged over the component values, following their merge
+	// strategies, to switch the chart to namespace scope.
+	Values map[string]any `yaml:"values,omitempty"`
 }
 
 // HelmConfig contains default Helm chart settings for a component.
@@ -98,20 +329,25 @@ type SchedulingPaths struct {
 	TolerationPaths []string `yaml:"tolerationPaths,omitempty"`
 }
 
-// Global component registry (loaded once, thread-safe access)
+// Global component registry (loaded once, replaced by ReloadData, thread-safe access)
 var (
-	globalRegistry     *ComponentRegistry
-	globalRegistryOnce sync.Once
-	globalRegistryErr  error
+	globalRegistryMu     sync.Mutex
+	globalRegistryLoaded bool
+	globalRegistry       *ComponentRegistry
+	globalRegistryErr    error
 )
 
 // GetComponentRegistry returns the global component registry.
-// The registry is loaded once from embedded data and cached.
+// The registry is loaded once from the data provider and cached until
+// ReloadData replaces it.
 // Returns an error if the registry file cannot be loaded or parsed.
 func GetComponentRegistry() (*ComponentRegistry, error) {
-	globalRegistryOnce.Do(func() {
-		globalRegistry, globalRegistryErr = loadComponentRegistry()
-	})
+	globalRegistryMu.Lock()
+	defer globalRegistryMu.Unlock()
+	if !globalRegistryLoaded {
+		globalRegistry, globalRegistryErr = loadComponentRegistry(GetDataProvider())
+		globalRegistryLoaded = true
+	}
 	return globalRegistry, globalRegistryErr
 }
 
@@ -125,9 +361,8 @@ func MustGetComponentRegistry() *ComponentRegistry {
 	return reg
 }
 
-// loadComponentRegistry loads the component registry from the data provider.
-func loadComponentRegistry() (*ComponentRegistry, error) {
-	provider := GetDataProvider()
+// loadComponentRegistry loads the component registry from provider.
+func loadComponentRegistry(provider DataProvider) (*ComponentRegistry, error) {
 	data, err := provider.ReadFile("registry.yaml")
 	if err != nil {
 		return nil, fmt.Errorf("failed to read registry.yaml: %w", err)
@@ -249,9 +484,91 @@ func (r *ComponentRegistry) Validate() []error {
 		}
 	}
 
+	// Check namespace scope values merge cleanly
+	for i, comp := range r.Components {
+		if comp.NamespaceScope == nil {
+			continue
+		}
+		if err := ValidateMergeStrategies(comp.NamespaceScope.Values); err != nil {
+			errs = append(errs, fmt.Errorf("component[%d] (%s): invalid namespaceScope values: %w", i, comp.Name, err))
+		}
+	}
+
+	// Check image paths are set
+	for i, comp := range r.Components {
+		for j, img := range comp.Images {
+			if img.Path == "" {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): images[%d] missing path", i, comp.Name, j))
+			}
+		}
+	}
+
+	// Check secrets are named and list their keys
+	for i, comp := range r.Components {
+		for j, secret := range comp.Secrets {
+			if secret.Name == "" && secret.NamePath == "" {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): secrets[%d] missing name or namePath", i, comp.Name, j))
+			}
+			if len(secret.Keys) == 0 {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): secrets[%d] missing keys", i, comp.Name, j))
+			}
+		}
+	}
+
+	// Check CA volumes name both the volumes and the volume mounts
+	for i, comp := range r.Components {
+		for j, vol := range comp.Proxy.CAVolumes {
+			if vol.VolumesPath == "" || vol.VolumeMountsPath == "" {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): proxy.caVolumes[%d] missing volumesPath or volumeMountsPath", i, comp.Name, j))
+			}
+		}
+	}
+
+	// Check OpenShift SCC grants name an SCC and its service accounts
+	for i, comp := range r.Components {
+		if comp.OpenShiftSCC == nil {
+			continue
+		}
+		for j, grant := range comp.OpenShiftSCC.Grants {
+			if grant.SCC == "" || len(grant.ServiceAccounts) == 0 {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): openShiftSCC.grants[%d] missing scc or serviceAccounts", i, comp.Name, j))
+			}
+		}
+	}
+
+	// Check verify checks either wait for a resource or run a GPU pod
+	for i, comp := range r.Components {
+		for j, check := range comp.Verify {
+			if (check.Wait == "") == (check.GPUPod == nil) {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): verify[%d] needs exactly one of wait or gpuPod", i, comp.Name, j))
+			}
+			if check.Wait != "" && check.For == "" {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): verify[%d] missing for", i, comp.Name, j))
+			}
+			if check.GPUPod != nil && (check.GPUPod.Image == "" || len(check.GPUPod.Command) == 0) {
+				errs = append(errs, fmt.Errorf("component[%d] (%s): verify[%d] gpuPod missing image or command", i, comp.Name, j))
+			}
+		}
+	}
+
 	return errs
 }
 
+// SupportsNamespaceScope reports whether the component can be installed with
+// namespace scope.
+func (c *ComponentConfig) SupportsNamespaceScope() bool {
+	return c != nil && c.NamespaceScope != nil
+}
+
+// ApplyNamespaceScope merges the namespace scope values of the component over
+// values. It does nothing when the component does not support namespace scope.
+func (c *ComponentConfig) ApplyNamespaceScope(values map[string]any) {
+	if !c.SupportsNamespaceScope() {
+		return
+	}
+	mergeValues(values, c.NamespaceScope.Values)
+}
+
 // GetSystemNodeSelectorPaths returns all system node selector paths for a component.
 func (c *ComponentConfig) GetSystemNodeSelectorPaths() []string {
 	if c == nil {
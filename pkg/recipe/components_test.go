@@ -15,6 +15,7 @@
 package recipe
 
 import (
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
@@ -210,6 +211,30 @@ func TestComponentRegistry_NodeSchedulingPaths(t *testing.T) {
 	}
 }
 
+func TestComponentRegistry_ProxyPaths(t *testing.T) {
+	registry, err := GetComponentRegistry()
+	if err != nil {
+		t.Fatalf("failed to load component registry: %v", err)
+	}
+
+	for _, name := range []string{"gpu-operator", "network-operator", "cert-manager"} {
+		comp := registry.Get(name)
+		if comp == nil {
+			t.Fatalf("%s not found in registry", name)
+		}
+		if len(comp.Proxy.EnvPaths) == 0 {
+			t.Errorf("%s should have proxy env paths", name)
+		}
+		if !comp.Proxy.TakesCABundle() {
+			t.Errorf("%s should take a CA bundle", name)
+		}
+	}
+
+	if registry.Get("skyhook-operator").Proxy.TakesCABundle() {
+		t.Error("skyhook-operator should not take a CA bundle")
+	}
+}
+
 func TestComponentRegistry_PathSyntax(t *testing.T) {
 	registry, err := GetComponentRegistry()
 	if err != nil {
@@ -223,6 +248,8 @@ func TestComponentRegistry_PathSyntax(t *testing.T) {
 		allPaths = append(allPaths, comp.GetSystemTolerationPaths()...)
 		allPaths = append(allPaths, comp.GetAcceleratedNodeSelectorPaths()...)
 		allPaths = append(allPaths, comp.GetAcceleratedTolerationPaths()...)
+		allPaths = append(allPaths, comp.Proxy.EnvPaths...)
+		allPaths = append(allPaths, comp.Proxy.CAConfigMapPaths...)
 
 		for _, path := range allPaths {
 			// Paths should not be empty
@@ -430,6 +457,50 @@ func TestComponentRegistry_Validate_EdgeCases(t *testing.T) {
 		}
 	})
 
+	t.Run("incomplete proxy CA volume", func(t *testing.T) {
+		registry := &ComponentRegistry{
+			Components: []ComponentConfig{
+				{
+					Name:        "comp1",
+					DisplayName: "Comp 1",
+					Proxy: ProxyConfig{
+						CAVolumes: []CAVolumeConfig{{VolumesPath: "volumes"}},
+					},
+				},
+			},
+		}
+		errs := registry.Validate()
+		found := false
+		for _, e := range errs {
+			if strings.Contains(e.Error(), "missing volumesPath or volumeMountsPath") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Error("expected error about incomplete proxy.caVolumes")
+		}
+	})
+
+	t.Run("verify check without wait or gpuPod", func(t *testing.T) {
+		registry := &ComponentRegistry{
+			Components: []ComponentConfig{
+				{
+					Name:        "test",
+					DisplayName: "Test",
+					Verify: []VerifyCheck{
+						{Description: "ready", Wait: "deployment/test", For: "condition=Available"},
+						{Description: "nothing to check"},
+					},
+				},
+			},
+		}
+		errs := registry.Validate()
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), "verify[1] needs exactly one of wait or gpuPod") {
+			t.Errorf("expected one error about verify[1], got: %v", errs)
+		}
+	})
+
 	t.Run("valid registry passes", func(t *testing.T) {
 		registry := &ComponentRegistry{
 			Components: []ComponentConfig{
@@ -642,3 +713,71 @@ components:
 		t.Errorf("GetType() = %v, want %v", comp.GetType(), ComponentTypeKustomize)
 	}
 }
+
+func TestComponentConfig_NamespaceScope(t *testing.T) {
+	yamlData := `
+apiVersion: eidos.nvidia.com/v1alpha1
+kind: ComponentRegistry
+components:
+  - name: scoped
+    displayName: scoped
+    namespaceScope:
+      values:
+        rbac:
+          clusterWide: false
+        $merge:
+          watchNamespaces: replace
+        watchNamespaces: []
+  - name: namespaced
+    displayName: namespaced
+    namespaceScope: {}
+  - name: cluster-only
+    displayName: cluster-only
+`
+	var registry ComponentRegistry
+	if err := yaml.Unmarshal([]byte(yamlData), &registry); err != nil {
+		t.Fatalf("failed to unmarshal YAML: %v", err)
+	}
+	if errs := registry.Validate(); len(errs) > 0 {
+		t.Fatalf("Validate() = %v", errs)
+	}
+
+	scoped, namespaced, clusterOnly := &registry.Components[0], &registry.Components[1], &registry.Components[2]
+	if !scoped.SupportsNamespaceScope() || !namespaced.SupportsNamespaceScope() {
+		t.Error("components with namespaceScope should support namespace scope")
+	}
+	if clusterOnly.SupportsNamespaceScope() {
+		t.Error("component without namespaceScope should not support namespace scope")
+	}
+
+	values := map[string]any{
+		"rbac":            map[string]any{"clusterWide": true, "create": true},
+		"watchNamespaces": []any{"a", "b"},
+	}
+	scoped.ApplyNamespaceScope(values)
+	want := map[string]any{
+		"rbac":            map[string]any{"clusterWide": false, "create": true},
+		"watchNamespaces": []any{},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("ApplyNamespaceScope() = %v, want %v", values, want)
+	}
+
+	// The registry values must not be shared with the component values
+	values["rbac"].(map[string]any)["clusterWide"] = true
+	if scoped.NamespaceScope.Values["rbac"].(map[string]any)["clusterWide"] != false {
+		t.Error("ApplyNamespaceScope() shares maps with the registry")
+	}
+
+	unchanged := map[string]any{"rbac": map[string]any{"create": true}}
+	clusterOnly.ApplyNamespaceScope(unchanged)
+	namespaced.ApplyNamespaceScope(unchanged)
+	if !reflect.DeepEqual(unchanged, map[string]any{"rbac": map[string]any{"create": true}}) {
+		t.Errorf("ApplyNamespaceScope() without values changed them: %v", unchanged)
+	}
+
+	registry.Components[0].NamespaceScope.Values[MergeStrategiesKey] = map[string]any{"rbac": "prepend"}
+	if errs := registry.Validate(); len(errs) == 0 {
+		t.Error("Validate() should reject invalid namespaceScope merge strategies")
+	}
+}
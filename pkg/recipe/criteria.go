@@ -40,6 +40,10 @@ const (
 	CriteriaServiceGKE CriteriaServiceType = "gke"
 	CriteriaServiceAKS CriteriaServiceType = "aks"
 	CriteriaServiceOKE CriteriaServiceType = "oke"
+	// CriteriaServiceRKE2 is Rancher RKE2.
+	CriteriaServiceRKE2 CriteriaServiceType = "rke2"
+	// CriteriaServiceOpenShift is Red Hat OpenShift.
+	CriteriaServiceOpenShift CriteriaServiceType = "openshift"
 )
 
 // ParseCriteriaServiceType parses a string into a CriteriaServiceType.
@@ -55,6 +59,10 @@ func ParseCriteriaServiceType(s string) (CriteriaServiceType, error) {
 		return CriteriaServiceAKS, nil
 	case "oke":
 		return CriteriaServiceOKE, nil
+	case "rke2":
+		return CriteriaServiceRKE2, nil
+	case "openshift", "ocp":
+		return CriteriaServiceOpenShift, nil
 	default:
 		return CriteriaServiceAny, fmt.Errorf("invalid service type: %s", s)
 	}
@@ -62,7 +70,28 @@ func ParseCriteriaServiceType(s string) (CriteriaServiceType, error) {
 
 // GetCriteriaServiceTypes returns all supported service types sorted alphabetically.
 func GetCriteriaServiceTypes() []string {
-	return []string{"aks", "eks", "gke", "oke"}
+	return []string{"aks", "eks", "gke", "oke", "openshift", "rke2"}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t CriteriaServiceType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCriteriaServiceType,
+// so aliases such as "self-managed" decode to their canonical value.
+// An empty value is left unset.
+func (t *CriteriaServiceType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseCriteriaServiceType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
 }
 
 // CriteriaAcceleratorType represents the GPU/accelerator type.
@@ -100,6 +129,26 @@ func GetCriteriaAcceleratorTypes() []string {
 	return []string{"a100", "gb200", "h100", "l40"}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (t CriteriaAcceleratorType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCriteriaAcceleratorType.
+// An empty value is left unset.
+func (t *CriteriaAcceleratorType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseCriteriaAcceleratorType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
 // CriteriaIntentType represents the workload intent.
 type CriteriaIntentType string
 
@@ -129,6 +178,26 @@ func GetCriteriaIntentTypes() []string {
 	return []string{"inference", "training"}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (t CriteriaIntentType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCriteriaIntentType.
+// An empty value is left unset.
+func (t *CriteriaIntentType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseCriteriaIntentType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
 // CriteriaOSType represents an operating system type.
 type CriteriaOSType string
 
@@ -164,10 +233,167 @@ func GetCriteriaOSTypes() []string {
 	return []string{"amazonlinux", "cos", "rhel", "ubuntu"}
 }
 
+// MarshalText implements encoding.TextMarshaler.
+func (t CriteriaOSType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCriteriaOSType,
+// so aliases such as "al2023" decode to their canonical value.
+// An empty value is left unset.
+func (t *CriteriaOSType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseCriteriaOSType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// CriteriaScaleType represents the cluster scale, by number of GPU nodes.
+type CriteriaScaleType string
+
+// CriteriaScaleType constants for supported cluster scales.
+const (
+	CriteriaScaleAny    CriteriaScaleType = "any"
+	CriteriaScaleSmall  CriteriaScaleType = "small"
+	CriteriaScaleMedium CriteriaScaleType = "medium"
+	CriteriaScaleLarge  CriteriaScaleType = "large"
+)
+
+// Node count bounds of the cluster scales: small clusters have up to
+// scaleSmallMaxNodes GPU nodes, medium clusters up to scaleMediumMaxNodes,
+// and large clusters more.
+const (
+	scaleSmallMaxNodes  = 8
+	scaleMediumMaxNodes = 64
+)
+
+// ParseCriteriaScaleType parses a string into a CriteriaScaleType. Besides
+// the scale names it accepts the node ranges they cover.
+func ParseCriteriaScaleType(s string) (CriteriaScaleType, error) {
+	switch strings.ToLower(strings.ReplaceAll(s, " ", "")) {
+	case "", criteriaAnyValue:
+		return CriteriaScaleAny, nil
+	case "small", "<=8", "1-8":
+		return CriteriaScaleSmall, nil
+	case "medium", "9-64":
+		return CriteriaScaleMedium, nil
+	case "large", ">64":
+		return CriteriaScaleLarge, nil
+	default:
+		return CriteriaScaleAny, fmt.Errorf("invalid scale: %s", s)
+	}
+}
+
+// GetCriteriaScaleTypes returns all supported cluster scales from smallest to largest.
+func GetCriteriaScaleTypes() []string {
+	return []string{"small", "medium", "large"}
+}
+
+// ScaleForNodes returns the cluster scale of a cluster with n GPU nodes, or
+// "any" when the node count is unknown (0).
+func ScaleForNodes(n int) CriteriaScaleType {
+	switch {
+	case n <= 0:
+		return CriteriaScaleAny
+	case n <= scaleSmallMaxNodes:
+		return CriteriaScaleSmall
+	case n <= scaleMediumMaxNodes:
+		return CriteriaScaleMedium
+	default:
+		return CriteriaScaleLarge
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t CriteriaScaleType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCriteriaScaleType,
+// so node ranges such as ">64" decode to their canonical value.
+// An empty value is left unset.
+func (t *CriteriaScaleType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseCriteriaScaleType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// CriteriaPartitioningType represents how GPUs are shared between workloads.
+// It refines the intent: an inference recipe needs a different device plugin
+// and MIG manager configuration for MIG slices than for whole GPUs.
+type CriteriaPartitioningType string
+
+// CriteriaPartitioningType constants for supported GPU partitioning strategies.
+const (
+	CriteriaPartitioningAny         CriteriaPartitioningType = "any"
+	CriteriaPartitioningMIGSingle   CriteriaPartitioningType = "mig-single"
+	CriteriaPartitioningMIGMixed    CriteriaPartitioningType = "mig-mixed"
+	CriteriaPartitioningVGPU        CriteriaPartitioningType = "vgpu"
+	CriteriaPartitioningPassthrough CriteriaPartitioningType = "passthrough"
+)
+
+// ParseCriteriaPartitioningType parses a string into a CriteriaPartitioningType.
+// It also accepts the GPU Operator sandbox workload names (vm-vgpu,
+// vm-passthrough).
+func ParseCriteriaPartitioningType(s string) (CriteriaPartitioningType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", criteriaAnyValue:
+		return CriteriaPartitioningAny, nil
+	case "mig-single":
+		return CriteriaPartitioningMIGSingle, nil
+	case "mig-mixed":
+		return CriteriaPartitioningMIGMixed, nil
+	case "vgpu", "vm-vgpu":
+		return CriteriaPartitioningVGPU, nil
+	case "passthrough", "vm-passthrough":
+		return CriteriaPartitioningPassthrough, nil
+	default:
+		return CriteriaPartitioningAny, fmt.Errorf("invalid partitioning type: %s", s)
+	}
+}
+
+// GetCriteriaPartitioningTypes returns all supported partitioning types sorted alphabetically.
+func GetCriteriaPartitioningTypes() []string {
+	return []string{"mig-mixed", "mig-single", "passthrough", "vgpu"}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t CriteriaPartitioningType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCriteriaPartitioningType.
+// An empty value is left unset.
+func (t *CriteriaPartitioningType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseCriteriaPartitioningType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
 // Criteria represents the input parameters for recipe matching.
 // All fields are optional and default to "any" if not specified.
 type Criteria struct {
-	// Service is the Kubernetes service type (eks, gke, aks, oke, self-managed).
+	// Service is the Kubernetes service type (eks, gke, aks, oke, rke2, openshift, self-managed).
 	Service CriteriaServiceType `json:"service,omitempty" yaml:"service,omitempty"`
 
 	// Accelerator is the GPU/accelerator type (h100, gb200, a100, l40).
@@ -181,17 +407,37 @@ type Criteria struct {
 
 	// Nodes is the number of worker nodes (0 means any/unspecified).
 	Nodes int `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+
+	// Scale is the cluster scale (small, medium, large). When unset it is
+	// derived from Nodes, so overlays can match a node range rather than an
+	// exact count.
+	Scale CriteriaScaleType `json:"scale,omitempty" yaml:"scale,omitempty"`
+
+	// Partitioning is the GPU partitioning strategy (mig-single, mig-mixed,
+	// vgpu, passthrough).
+	Partitioning CriteriaPartitioningType `json:"partitioning,omitempty" yaml:"partitioning,omitempty"`
 }
 
 // NewCriteria creates a new Criteria with all fields set to "any".
 func NewCriteria() *Criteria {
 	return &Criteria{
-		Service:     CriteriaServiceAny,
-		Accelerator: CriteriaAcceleratorAny,
-		Intent:      CriteriaIntentAny,
-		OS:          CriteriaOSAny,
-		Nodes:       0,
+		Service:      CriteriaServiceAny,
+		Accelerator:  CriteriaAcceleratorAny,
+		Intent:       CriteriaIntentAny,
+		OS:           CriteriaOSAny,
+		Nodes:        0,
+		Scale:        CriteriaScaleAny,
+		Partitioning: CriteriaPartitioningAny,
+	}
+}
+
+// EffectiveScale returns the cluster scale, derived from the node count when
+// Scale is not set.
+func (c *Criteria) EffectiveScale() CriteriaScaleType {
+	if c.Scale != "" && c.Scale != CriteriaScaleAny {
+		return c.Scale
 	}
+	return ScaleForNodes(c.Nodes)
 }
 
 // Matches checks if this recipe criteria matches the given query criteria.
@@ -236,6 +482,16 @@ func (c *Criteria) Matches(other *Criteria) bool {
 		return false
 	}
 
+	// Partitioning matching
+	if !matchesCriteriaField(string(c.Partitioning), string(other.Partitioning)) {
+		return false
+	}
+
+	// Scale matching, with the scale derived from the node count when unset
+	if !matchesCriteriaField(string(c.EffectiveScale()), string(other.EffectiveScale())) {
+		return false
+	}
+
 	// Nodes: 0 means any - apply same asymmetric logic
 	// Query 0 (any) → only match if recipe is also 0 (generic)
 	// Recipe 0 (any) → match any query value
@@ -298,6 +554,12 @@ func (c *Criteria) Specificity() int {
 	if c.Nodes != 0 {
 		score++
 	}
+	if c.Scale != CriteriaScaleAny && c.Scale != "" {
+		score++
+	}
+	if c.Partitioning != CriteriaPartitioningAny && c.Partitioning != "" {
+		score++
+	}
 	return score
 }
 
@@ -319,6 +581,12 @@ func (c *Criteria) String() string {
 	if c.Nodes != 0 {
 		parts = append(parts, fmt.Sprintf("nodes=%d", c.Nodes))
 	}
+	if c.Scale != CriteriaScaleAny && c.Scale != "" {
+		parts = append(parts, fmt.Sprintf("scale=%s", c.Scale))
+	}
+	if c.Partitioning != CriteriaPartitioningAny && c.Partitioning != "" {
+		parts = append(parts, fmt.Sprintf("partitioning=%s", c.Partitioning))
+	}
 	if len(parts) == 0 {
 		return "criteria(any)"
 	}
@@ -387,6 +655,30 @@ func WithCriteriaNodes(n int) CriteriaOption {
 	}
 }
 
+// WithCriteriaScale sets the cluster scale.
+func WithCriteriaScale(s string) CriteriaOption {
+	return func(c *Criteria) error {
+		st, err := ParseCriteriaScaleType(s)
+		if err != nil {
+			return err
+		}
+		c.Scale = st
+		return nil
+	}
+}
+
+// WithCriteriaPartitioning sets the GPU partitioning strategy.
+func WithCriteriaPartitioning(s string) CriteriaOption {
+	return func(c *Criteria) error {
+		pt, err := ParseCriteriaPartitioningType(s)
+		if err != nil {
+			return err
+		}
+		c.Partitioning = pt
+		return nil
+	}
+}
+
 // BuildCriteria creates a Criteria from functional options.
 func BuildCriteria(opts ...CriteriaOption) (*Criteria, error) {
 	c := NewCriteria()
@@ -400,7 +692,8 @@ func BuildCriteria(opts ...CriteriaOption) (*Criteria, error) {
 
 // ParseCriteriaFromRequest parses recipe criteria from HTTP query parameters.
 // All parameters are optional and default to "any" if not specified.
-// Supported parameters: service, accelerator (alias: gpu), intent, os, nodes.
+// Supported parameters: service, accelerator (alias: gpu), intent, os, nodes,
+// scale, partitioning.
 func ParseCriteriaFromRequest(r *http.Request) (*Criteria, error) {
 	if r == nil {
 		return nil, fmt.Errorf("request cannot be nil")
@@ -412,7 +705,8 @@ func ParseCriteriaFromRequest(r *http.Request) (*Criteria, error) {
 
 // ParseCriteriaFromValues parses recipe criteria from URL values.
 // All parameters are optional and default to "any" if not specified.
-// Supported parameters: service, accelerator (alias: gpu), intent, os, nodes.
+// Supported parameters: service, accelerator (alias: gpu), intent, os, nodes,
+// scale, partitioning.
 func ParseCriteriaFromValues(values url.Values) (*Criteria, error) {
 	c := NewCriteria()
 
@@ -468,6 +762,24 @@ func ParseCriteriaFromValues(values url.Values) (*Criteria, error) {
 		c.Nodes = n
 	}
 
+	// Parse cluster scale
+	if s := values.Get("scale"); s != "" {
+		st, err := ParseCriteriaScaleType(s)
+		if err != nil {
+			return nil, err
+		}
+		c.Scale = st
+	}
+
+	// Parse GPU partitioning
+	if s := values.Get("partitioning"); s != "" {
+		pt, err := ParseCriteriaPartitioningType(s)
+		if err != nil {
+			return nil, err
+		}
+		c.Partitioning = pt
+	}
+
 	return c, nil
 }
 
@@ -499,10 +811,7 @@ type RecipeCriteria struct {
 	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
 
 	// Metadata contains the name and other metadata.
-	Metadata struct {
-		// Name is the unique identifier for this criteria set.
-		Name string `json:"name" yaml:"name"`
-	} `json:"metadata" yaml:"metadata"`
+	Metadata ObjectMeta `json:"metadata" yaml:"metadata"`
 
 	// Spec contains the actual criteria specification.
 	Spec *Criteria `json:"spec" yaml:"spec"`
@@ -511,21 +820,21 @@ type RecipeCriteria struct {
 // rawCriteriaSpec is an intermediate struct for parsing criteria spec with string enum values.
 // This allows validation through Parse* functions before creating the typed Criteria.
 type rawCriteriaSpec struct {
-	Service     string `json:"service,omitempty" yaml:"service,omitempty"`
-	Accelerator string `json:"accelerator,omitempty" yaml:"accelerator,omitempty"`
-	Intent      string `json:"intent,omitempty" yaml:"intent,omitempty"`
-	OS          string `json:"os,omitempty" yaml:"os,omitempty"`
-	Nodes       int    `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Service      string `json:"service,omitempty" yaml:"service,omitempty"`
+	Accelerator  string `json:"accelerator,omitempty" yaml:"accelerator,omitempty"`
+	Intent       string `json:"intent,omitempty" yaml:"intent,omitempty"`
+	OS           string `json:"os,omitempty" yaml:"os,omitempty"`
+	Nodes        int    `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Scale        string `json:"scale,omitempty" yaml:"scale,omitempty"`
+	Partitioning string `json:"partitioning,omitempty" yaml:"partitioning,omitempty"`
 }
 
 // rawRecipeCriteria is for parsing RecipeCriteria with string enum values in spec.
 type rawRecipeCriteria struct {
-	Kind       string `json:"kind" yaml:"kind"`
-	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
-	Metadata   struct {
-		Name string `json:"name" yaml:"name"`
-	} `json:"metadata" yaml:"metadata"`
-	Spec rawCriteriaSpec `json:"spec" yaml:"spec"`
+	Kind       string          `json:"kind" yaml:"kind"`
+	APIVersion string          `json:"apiVersion" yaml:"apiVersion"`
+	Metadata   ObjectMeta      `json:"metadata" yaml:"metadata"`
+	Spec       rawCriteriaSpec `json:"spec" yaml:"spec"`
 }
 
 // validateAndConvertRawSpec validates raw string values and converts to typed Criteria.
@@ -569,6 +878,22 @@ func validateAndConvertRawSpec(raw *rawCriteriaSpec) (*Criteria, error) {
 	}
 	c.Nodes = raw.Nodes
 
+	if raw.Scale != "" {
+		st, err := ParseCriteriaScaleType(raw.Scale)
+		if err != nil {
+			return nil, err
+		}
+		c.Scale = st
+	}
+
+	if raw.Partitioning != "" {
+		pt, err := ParseCriteriaPartitioningType(raw.Partitioning)
+		if err != nil {
+			return nil, err
+		}
+		c.Partitioning = pt
+	}
+
 	return c, nil
 }
 
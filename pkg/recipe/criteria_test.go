@@ -15,11 +15,14 @@
 package recipe
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
 	"testing"
+
+	"gopkg.in/yaml.v3"
 )
 
 func TestParseCriteriaServiceType(t *testing.T) {
@@ -36,6 +39,9 @@ func TestParseCriteriaServiceType(t *testing.T) {
 		{"gke", "gke", CriteriaServiceGKE, false},
 		{"aks", "aks", CriteriaServiceAKS, false},
 		{"oke", "oke", CriteriaServiceOKE, false},
+		{"rke2", "rke2", CriteriaServiceRKE2, false},
+		{"openshift", "openshift", CriteriaServiceOpenShift, false},
+		{"ocp alias", "OCP", CriteriaServiceOpenShift, false},
 		{"self-managed", "self-managed", CriteriaServiceAny, false},
 		{"self", "self", CriteriaServiceAny, false},
 		{"vanilla", "vanilla", CriteriaServiceAny, false},
@@ -115,6 +121,139 @@ func TestParseCriteriaIntentType(t *testing.T) {
 	}
 }
 
+func TestParseCriteriaScaleType(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    CriteriaScaleType
+		wantErr bool
+	}{
+		{"empty", "", CriteriaScaleAny, false},
+		{"any", "any", CriteriaScaleAny, false},
+		{"small", "Small", CriteriaScaleSmall, false},
+		{"small range", "<= 8", CriteriaScaleSmall, false},
+		{"medium range", "9-64", CriteriaScaleMedium, false},
+		{"overlapping range", "8-64", CriteriaScaleAny, true},
+		{"large range", ">64", CriteriaScaleLarge, false},
+		{"invalid", "huge", CriteriaScaleAny, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCriteriaScaleType(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCriteriaScaleType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCriteriaScaleType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCriteriaPartitioningType(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    CriteriaPartitioningType
+		wantErr bool
+	}{
+		{"empty", "", CriteriaPartitioningAny, false},
+		{"any", "any", CriteriaPartitioningAny, false},
+		{"mig-single", "MIG-Single", CriteriaPartitioningMIGSingle, false},
+		{"mig-mixed", "mig-mixed", CriteriaPartitioningMIGMixed, false},
+		{"vgpu", "vgpu", CriteriaPartitioningVGPU, false},
+		{"sandbox vgpu", "vm-vgpu", CriteriaPartitioningVGPU, false},
+		{"sandbox passthrough", "vm-passthrough", CriteriaPartitioningPassthrough, false},
+		{"invalid", "mps", CriteriaPartitioningAny, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCriteriaPartitioningType(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCriteriaPartitioningType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCriteriaPartitioningType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCriteriaMatches_Partitioning(t *testing.T) {
+	mig := &Criteria{Intent: CriteriaIntentInference, Partitioning: CriteriaPartitioningMIGMixed}
+	tests := []struct {
+		name  string
+		query *Criteria
+		want  bool
+	}{
+		{"same partitioning", &Criteria{Intent: CriteriaIntentInference, Partitioning: CriteriaPartitioningMIGMixed}, true},
+		{"other partitioning", &Criteria{Intent: CriteriaIntentInference, Partitioning: CriteriaPartitioningMIGSingle}, false},
+		{"unspecified partitioning", &Criteria{Intent: CriteriaIntentInference, Partitioning: CriteriaPartitioningAny}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mig.Matches(tt.query); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	generic := &Criteria{Intent: CriteriaIntentInference, Partitioning: CriteriaPartitioningAny}
+	if !generic.Matches(&Criteria{Intent: CriteriaIntentInference, Partitioning: CriteriaPartitioningVGPU}) {
+		t.Error("recipe without partitioning should match any partitioning")
+	}
+}
+
+func TestScaleForNodes(t *testing.T) {
+	tests := []struct {
+		nodes int
+		want  CriteriaScaleType
+	}{
+		{0, CriteriaScaleAny},
+		{1, CriteriaScaleSmall},
+		{8, CriteriaScaleSmall},
+		{9, CriteriaScaleMedium},
+		{64, CriteriaScaleMedium},
+		{65, CriteriaScaleLarge},
+		{1024, CriteriaScaleLarge},
+	}
+	for _, tt := range tests {
+		if got := ScaleForNodes(tt.nodes); got != tt.want {
+			t.Errorf("ScaleForNodes(%d) = %v, want %v", tt.nodes, got, tt.want)
+		}
+	}
+}
+
+func TestCriteriaMatches_Scale(t *testing.T) {
+	large := &Criteria{Scale: CriteriaScaleLarge}
+	tests := []struct {
+		name  string
+		query *Criteria
+		want  bool
+	}{
+		{"scale given", &Criteria{Scale: CriteriaScaleLarge}, true},
+		{"scale from nodes", &Criteria{Nodes: 128}, true},
+		{"smaller cluster", &Criteria{Nodes: 16}, false},
+		{"scale unknown", NewCriteria(), false},
+		{"explicit scale wins over nodes", &Criteria{Nodes: 4, Scale: CriteriaScaleLarge}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := large.Matches(tt.query); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !NewCriteria().Matches(&Criteria{Nodes: 128}) {
+		t.Error("generic recipe should match a query of any scale")
+	}
+}
+
 func TestCriteriaMatches(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -450,6 +589,39 @@ func TestParseCriteriaFromValues(t *testing.T) {
 			query:   "nodes=-1",
 			wantErr: true,
 		},
+		{
+			name:  "scale",
+			query: "accelerator=gb200&scale=large",
+			want: &Criteria{
+				Service:     CriteriaServiceAny,
+				Accelerator: CriteriaAcceleratorGB200,
+				Intent:      CriteriaIntentAny,
+				OS:          CriteriaOSAny,
+				Scale:       CriteriaScaleLarge,
+			},
+		},
+		{
+			name:  "partitioning",
+			query: "intent=inference&partitioning=vm-vgpu",
+			want: &Criteria{
+				Service:      CriteriaServiceAny,
+				Accelerator:  CriteriaAcceleratorAny,
+				Intent:       CriteriaIntentInference,
+				OS:           CriteriaOSAny,
+				Scale:        CriteriaScaleAny,
+				Partitioning: CriteriaPartitioningVGPU,
+			},
+		},
+		{
+			name:    "invalid partitioning",
+			query:   "partitioning=mps",
+			wantErr: true,
+		},
+		{
+			name:    "invalid scale",
+			query:   "scale=huge",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -482,6 +654,12 @@ func TestParseCriteriaFromValues(t *testing.T) {
 			if got.Nodes != tt.want.Nodes {
 				t.Errorf("Nodes = %v, want %v", got.Nodes, tt.want.Nodes)
 			}
+			if tt.want.Scale != "" && got.Scale != tt.want.Scale {
+				t.Errorf("Scale = %v, want %v", got.Scale, tt.want.Scale)
+			}
+			if tt.want.Partitioning != "" && got.Partitioning != tt.want.Partitioning {
+				t.Errorf("Partitioning = %v, want %v", got.Partitioning, tt.want.Partitioning)
+			}
 		})
 	}
 }
@@ -567,7 +745,7 @@ func TestGetCriteriaServiceTypes(t *testing.T) {
 	types := GetCriteriaServiceTypes()
 
 	// Should return sorted list
-	expected := []string{"aks", "eks", "gke", "oke"}
+	expected := []string{"aks", "eks", "gke", "oke", "openshift", "rke2"}
 	if len(types) != len(expected) {
 		t.Errorf("GetCriteriaServiceTypes() returned %d types, want %d", len(types), len(expected))
 	}
@@ -1071,3 +1249,76 @@ func TestParseCriteriaFromBody_NilBody(t *testing.T) {
 func writeTestFile(path, content string) error {
 	return os.WriteFile(path, []byte(content), 0o644)
 }
+
+func TestCriteriaTextUnmarshal(t *testing.T) {
+	t.Run("JSON normalizes aliases and casing", func(t *testing.T) {
+		var c Criteria
+		data := `{"service":"self-managed","accelerator":"H100","intent":"Training","os":"al2023"}`
+		if err := json.Unmarshal([]byte(data), &c); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		want := Criteria{
+			Service:     CriteriaServiceAny,
+			Accelerator: CriteriaAcceleratorH100,
+			Intent:      CriteriaIntentTraining,
+			OS:          CriteriaOSAmazonLinux,
+		}
+		if c != want {
+			t.Errorf("Unmarshal() = %+v, want %+v", c, want)
+		}
+	})
+
+	t.Run("YAML normalizes aliases and casing", func(t *testing.T) {
+		var c Criteria
+		if err := yaml.Unmarshal([]byte("service: EKS\nos: al2\n"), &c); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if c.Service != CriteriaServiceEKS || c.OS != CriteriaOSAmazonLinux {
+			t.Errorf("Unmarshal() = %+v", c)
+		}
+	})
+
+	t.Run("empty values stay unset", func(t *testing.T) {
+		var c Criteria
+		if err := json.Unmarshal([]byte(`{"service":""}`), &c); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if c.Service != "" {
+			t.Errorf("Service = %q, want empty", c.Service)
+		}
+	})
+
+	t.Run("unknown values are rejected", func(t *testing.T) {
+		for _, data := range []string{
+			`{"service":"nomad"}`,
+			`{"accelerator":"b300x"}`,
+			`{"intent":"batch"}`,
+			`{"os":"windows"}`,
+			`{"scale":"huge"}`,
+			`{"partitioning":"mps"}`,
+		} {
+			var c Criteria
+			if err := json.Unmarshal([]byte(data), &c); err == nil {
+				t.Errorf("Unmarshal(%s) expected error", data)
+			}
+		}
+	})
+
+	t.Run("marshal round trip", func(t *testing.T) {
+		in := Criteria{Service: CriteriaServiceGKE, Accelerator: CriteriaAcceleratorGB200, Nodes: 4}
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if string(data) != `{"service":"gke","accelerator":"gb200","nodes":4}` {
+			t.Errorf("Marshal() = %s", data)
+		}
+		var out Criteria
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if out != in {
+			t.Errorf("round trip = %+v, want %+v", out, in)
+		}
+	})
+}
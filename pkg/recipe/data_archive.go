@@ -0,0 +1,377 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DataArchiveKind is the kind of the manifest inside a recipe data archive.
+	DataArchiveKind = "RecipeData"
+
+	// DataArchiveFormatVersion is the archive layout version this binary writes
+	// and the newest one it can read. Bump it when the layout changes.
+	DataArchiveFormatVersion = 1
+
+	// dataArchiveManifestName is the manifest entry at the root of the archive.
+	dataArchiveManifestName = "manifest.yaml"
+
+	// dataArchiveDataDir is the archive directory holding the recipe data files.
+	dataArchiveDataDir = "data"
+)
+
+// DataArchiveManifest describes the contents of a recipe data archive.
+// It is the first entry of the archive and carries the version handshake
+// checked on import, plus a checksum for every data file.
+type DataArchiveManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+
+	// FormatVersion is the archive layout version (see DataArchiveFormatVersion).
+	FormatVersion int `yaml:"formatVersion"`
+
+	// DataVersion is a free-form label for the recipe data (e.g., "v2").
+	DataVersion string `yaml:"dataVersion,omitempty"`
+
+	// RegistryAPIVersion is the apiVersion of the archived registry.yaml.
+	// Import requires it to match the registry schema of the running binary.
+	RegistryAPIVersion string `yaml:"registryAPIVersion"`
+
+	// GeneratedBy is the version of the tool that created the archive.
+	GeneratedBy string `yaml:"generatedBy,omitempty"`
+
+	// Files lists every data file with its size and SHA256 checksum, sorted by path.
+	Files []DataArchiveFile `yaml:"files"`
+}
+
+// DataArchiveFile is a single data file entry in the archive manifest.
+type DataArchiveFile struct {
+	Path   string `yaml:"path"`
+	Size   int64  `yaml:"size"`
+	SHA256 string `yaml:"sha256"`
+}
+
+// DataArchiveOptions configures ExportDataArchive.
+type DataArchiveOptions struct {
+	// DataVersion labels the exported data (e.g., "v2").
+	DataVersion string
+
+	// GeneratedBy records the version of the exporting tool.
+	GeneratedBy string
+}
+
+// ExportDataArchive writes every file served by provider into a gzipped tar
+// archive on w, preceded by a manifest with per-file checksums. Exporting a
+// layered provider captures the effective (merged) recipe data.
+//
+// The archive is reproducible: entries are sorted and carry no timestamps, so
+// exporting the same data twice yields identical bytes.
+func ExportDataArchive(w io.Writer, provider DataProvider, opts DataArchiveOptions) (*DataArchiveManifest, error) {
+	paths, err := dataFilePaths(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &DataArchiveManifest{
+		APIVersion:    FullAPIVersion,
+		Kind:          DataArchiveKind,
+		FormatVersion: DataArchiveFormatVersion,
+		DataVersion:   opts.DataVersion,
+		GeneratedBy:   opts.GeneratedBy,
+	}
+
+	contents := make(map[string][]byte, len(paths))
+	for _, p := range paths {
+		data, readErr := provider.ReadFile(p)
+		if readErr != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to read recipe data file %s", p), readErr)
+		}
+		contents[p] = data
+		manifest.Files = append(manifest.Files, DataArchiveFile{
+			Path:   p,
+			Size:   int64(len(data)),
+			SHA256: sha256Hex(data),
+		})
+	}
+
+	registry, ok := contents[registryFileName]
+	if !ok {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("recipe data has no %s", registryFileName))
+	}
+	apiVersion, err := registryAPIVersion(registry)
+	if err != nil {
+		return nil, err
+	}
+	manifest.RegistryAPIVersion = apiVersion
+
+	manifestData, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to encode archive manifest", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeTarFile(tw, dataArchiveManifestName, manifestData); err != nil {
+		return nil, err
+	}
+	for _, p := range paths {
+		if err := writeTarFile(tw, path.Join(dataArchiveDataDir, p), contents[p]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to finalize archive", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to finalize archive", err)
+	}
+
+	return manifest, nil
+}
+
+// ImportDataArchive verifies a recipe data archive read from r and extracts
+// its data files into destDir, which must not exist or be empty. The result
+// can be used as an external data directory (see NewLayeredDataProvider).
+//
+// Nothing is written unless the whole archive verifies:
+//   - The manifest kind and format version must be supported by this binary
+//   - The archived registry must use the same apiVersion as the embedded one
+//   - Every file must match its manifest size and checksum, with none missing or extra
+func ImportDataArchive(r io.Reader, destDir string) (*DataArchiveManifest, error) {
+	manifest, files, err := readDataArchive(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDataArchive(manifest, files); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(destDir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			fmt.Sprintf("failed to read destination directory %s", destDir), err)
+	case len(entries) > 0:
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("destination directory is not empty: %s", destDir))
+	}
+
+	for _, f := range manifest.Files {
+		target := filepath.Join(destDir, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to create directory for %s", f.Path), err)
+		}
+		if err := os.WriteFile(target, files[f.Path], 0o600); err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to write %s", f.Path), err)
+		}
+	}
+
+	return manifest, nil
+}
+
+// readDataArchive reads the manifest and data files from a gzipped tar stream.
+// Files are held in memory so a corrupt archive leaves no partial output.
+func readDataArchive(r io.Reader) (*DataArchiveManifest, map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "recipe data archive is not gzip compressed", err)
+	}
+	defer gz.Close()
+
+	var manifestData []byte
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, nextErr := tr.Next()
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+		if nextErr != nil {
+			return nil, nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "failed to read recipe data archive", nextErr)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+		default:
+			return nil, nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("unsupported archive entry type for %s", hdr.Name))
+		}
+
+		name := path.Clean(hdr.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("path traversal detected: %s", hdr.Name))
+		}
+		if hdr.Size > DefaultMaxFileSize {
+			return nil, nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("file too large (%d bytes, max %d): %s", hdr.Size, DefaultMaxFileSize, name))
+		}
+
+		data, readErr := io.ReadAll(io.LimitReader(tr, DefaultMaxFileSize+1))
+		if readErr != nil {
+			return nil, nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("failed to read %s from archive", name), readErr)
+		}
+
+		if name == dataArchiveManifestName {
+			manifestData = data
+			continue
+		}
+		rel, ok := strings.CutPrefix(name, dataArchiveDataDir+"/")
+		if !ok {
+			return nil, nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("unexpected archive entry: %s", name))
+		}
+		files[rel] = data
+	}
+
+	if manifestData == nil {
+		return nil, nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("recipe data archive has no %s", dataArchiveManifestName))
+	}
+	var manifest DataArchiveManifest
+	if err := yaml.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "failed to parse archive manifest", err)
+	}
+	return &manifest, files, nil
+}
+
+// verifyDataArchive performs the version handshake and checks file integrity.
+func verifyDataArchive(manifest *DataArchiveManifest, files map[string][]byte) error {
+	if manifest.Kind != DataArchiveKind {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unexpected archive kind %q, want %q", manifest.Kind, DataArchiveKind))
+	}
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > DataArchiveFormatVersion {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("unsupported recipe data format version %d (this binary supports up to %d); upgrade eidos to use this archive",
+				manifest.FormatVersion, DataArchiveFormatVersion))
+	}
+
+	embeddedRegistry, err := NewEmbeddedDataProvider(dataFS, "data").ReadFile(registryFileName)
+	if err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to read embedded registry", err)
+	}
+	supported, err := registryAPIVersion(embeddedRegistry)
+	if err != nil {
+		return err
+	}
+	if manifest.RegistryAPIVersion != supported {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("recipe data registry apiVersion %q is not supported by this binary (want %q)",
+				manifest.RegistryAPIVersion, supported))
+	}
+
+	listed := make(map[string]bool, len(manifest.Files))
+	for _, f := range manifest.Files {
+		listed[f.Path] = true
+		data, ok := files[f.Path]
+		if !ok {
+			return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("file listed in manifest is missing from archive: %s", f.Path))
+		}
+		if int64(len(data)) != f.Size || sha256Hex(data) != f.SHA256 {
+			return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("checksum mismatch for %s", f.Path))
+		}
+	}
+	for p := range files {
+		if !listed[p] {
+			return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("file not listed in manifest: %s", p))
+		}
+	}
+	if !listed[registryFileName] {
+		return eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("recipe data archive has no %s", registryFileName))
+	}
+
+	return nil
+}
+
+// registryAPIVersion returns the apiVersion declared by a registry file.
+func registryAPIVersion(data []byte) (string, error) {
+	var reg ComponentRegistry
+	if err := yaml.Unmarshal(data, &reg); err != nil {
+		return "", eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("failed to parse %s", registryFileName), err)
+	}
+	return reg.APIVersion, nil
+}
+
+// dataFilePaths returns the paths of every file served by provider, sorted.
+func dataFilePaths(provider DataProvider) ([]string, error) {
+	var paths []string
+	err := provider.WalkDir("", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			paths = append(paths, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to list recipe data", err)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+		Format:   tar.FormatPAX,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, fmt.Sprintf("failed to write archive header for %s", name), err)
+	}
+	if _, err := io.Copy(tw, bytes.NewReader(data)); err != nil {
+		return eidoserrors.Wrap(eidoserrors.ErrCodeInternal, fmt.Sprintf("failed to write %s to archive", name), err)
+	}
+	return nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
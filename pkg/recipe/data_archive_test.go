@@ -0,0 +1,272 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func exportEmbedded(t *testing.T) ([]byte, *DataArchiveManifest) {
+	t.Helper()
+	var buf bytes.Buffer
+	manifest, err := ExportDataArchive(&buf, NewEmbeddedDataProvider(dataFS, "data"), DataArchiveOptions{
+		DataVersion: "v2",
+		GeneratedBy: "test",
+	})
+	if err != nil {
+		t.Fatalf("ExportDataArchive() error = %v", err)
+	}
+	return buf.Bytes(), manifest
+}
+
+// rewriteArchive re-packs an archive, letting edit change entries by name.
+// Returning nil from edit drops the entry.
+func rewriteArchive(t *testing.T, archive []byte, edit func(name string, data []byte) []byte) []byte {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gzw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gzw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = edit(hdr.Name, data)
+		if data == nil {
+			continue
+		}
+		if err := writeTarFile(tw, hdr.Name, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func singleEntryArchive(t *testing.T, name string, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	if err := writeTarFile(tw, name, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func editManifest(t *testing.T, archive []byte, edit func(m *DataArchiveManifest)) []byte {
+	t.Helper()
+	return rewriteArchive(t, archive, func(name string, data []byte) []byte {
+		if name != dataArchiveManifestName {
+			return data
+		}
+		var m DataArchiveManifest
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			t.Fatal(err)
+		}
+		edit(&m)
+		out, err := yaml.Marshal(&m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	})
+}
+
+func TestDataArchive_RoundTrip(t *testing.T) {
+	archive, manifest := exportEmbedded(t)
+
+	if manifest.Kind != DataArchiveKind || manifest.FormatVersion != DataArchiveFormatVersion {
+		t.Errorf("unexpected manifest header: kind=%s formatVersion=%d", manifest.Kind, manifest.FormatVersion)
+	}
+	if manifest.DataVersion != "v2" {
+		t.Errorf("DataVersion = %q, want v2", manifest.DataVersion)
+	}
+	if manifest.RegistryAPIVersion == "" {
+		t.Error("RegistryAPIVersion should be set from registry.yaml")
+	}
+
+	dest := filepath.Join(t.TempDir(), "recipe-data")
+	imported, err := ImportDataArchive(bytes.NewReader(archive), dest)
+	if err != nil {
+		t.Fatalf("ImportDataArchive() error = %v", err)
+	}
+	if len(imported.Files) != len(manifest.Files) {
+		t.Fatalf("imported %d files, exported %d", len(imported.Files), len(manifest.Files))
+	}
+
+	embedded := NewEmbeddedDataProvider(dataFS, "data")
+	for _, f := range manifest.Files {
+		got, err := os.ReadFile(filepath.Join(dest, f.Path))
+		if err != nil {
+			t.Fatalf("imported file %s: %v", f.Path, err)
+		}
+		want, err := embedded.ReadFile(f.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("imported %s differs from embedded data", f.Path)
+		}
+	}
+
+	// The imported directory is usable as external data.
+	if _, err := NewLayeredDataProvider(embedded, LayeredProviderConfig{ExternalDir: dest}); err != nil {
+		t.Errorf("NewLayeredDataProvider() on imported data error = %v", err)
+	}
+}
+
+func TestDataArchive_Reproducible(t *testing.T) {
+	first, _ := exportEmbedded(t)
+	second, _ := exportEmbedded(t)
+	if !bytes.Equal(first, second) {
+		t.Error("exporting the same data twice should produce identical archives")
+	}
+}
+
+func TestImportDataArchive_Rejects(t *testing.T) {
+	archive, _ := exportEmbedded(t)
+
+	tests := []struct {
+		name    string
+		archive []byte
+		wantErr string
+	}{
+		{
+			name: "tampered file",
+			archive: rewriteArchive(t, archive, func(name string, data []byte) []byte {
+				if name == "data/"+registryFileName {
+					return append(data, []byte("\n# tampered\n")...)
+				}
+				return data
+			}),
+			wantErr: "checksum mismatch",
+		},
+		{
+			name: "missing file",
+			archive: rewriteArchive(t, archive, func(name string, data []byte) []byte {
+				if name == "data/"+registryFileName {
+					return nil
+				}
+				return data
+			}),
+			wantErr: "missing from archive",
+		},
+		{
+			name: "unlisted file",
+			archive: editManifest(t, archive, func(m *DataArchiveManifest) {
+				m.Files = m.Files[1:]
+			}),
+			wantErr: "not listed in manifest",
+		},
+		{
+			name: "newer format version",
+			archive: editManifest(t, archive, func(m *DataArchiveManifest) {
+				m.FormatVersion = DataArchiveFormatVersion + 1
+			}),
+			wantErr: "unsupported recipe data format version",
+		},
+		{
+			name: "registry apiVersion mismatch",
+			archive: editManifest(t, archive, func(m *DataArchiveManifest) {
+				m.RegistryAPIVersion = "eidos.nvidia.com/v9"
+			}),
+			wantErr: "not supported by this binary",
+		},
+		{
+			name: "missing manifest",
+			archive: rewriteArchive(t, archive, func(name string, data []byte) []byte {
+				if name == dataArchiveManifestName {
+					return nil
+				}
+				return data
+			}),
+			wantErr: "has no manifest.yaml",
+		},
+		{
+			name:    "path traversal",
+			archive: singleEntryArchive(t, "data/../../evil.yaml", []byte("x")),
+			wantErr: "path traversal",
+		},
+		{
+			name:    "not gzip",
+			archive: []byte("plain text"),
+			wantErr: "not gzip compressed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := filepath.Join(t.TempDir(), "out")
+			_, err := ImportDataArchive(bytes.NewReader(tt.archive), dest)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+			if _, statErr := os.Stat(dest); statErr == nil {
+				t.Error("nothing should be written when verification fails")
+			}
+		})
+	}
+}
+
+func TestImportDataArchive_NonEmptyDestination(t *testing.T) {
+	archive, _ := exportEmbedded(t)
+
+	dest := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dest, "existing.yaml"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := ImportDataArchive(bytes.NewReader(archive), dest)
+	if err == nil || !strings.Contains(err.Error(), "not empty") {
+		t.Errorf("expected non-empty destination error, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// DataInfo identifies the recipe data served by a provider, so clients can
+// tell whether two binaries resolve recipes from the same data.
+type DataInfo struct {
+	// RegistryAPIVersion is the apiVersion of the component registry.
+	RegistryAPIVersion string `json:"registryAPIVersion" yaml:"registryAPIVersion"`
+
+	// Digest is a content digest of the data in the form "sha256:<hex>",
+	// computed over the sorted file paths and their checksums.
+	Digest string `json:"digest" yaml:"digest"`
+
+	// Files is the number of data files.
+	Files int `json:"files" yaml:"files"`
+
+	// External is true when external data is layered over the embedded data.
+	External bool `json:"external" yaml:"external"`
+}
+
+// GetDataInfo returns the DataInfo of the data served by provider. For a
+// layered provider it describes the effective (merged) data, matching what
+// ExportDataArchive would capture.
+func GetDataInfo(provider DataProvider) (*DataInfo, error) {
+	paths, err := dataFilePaths(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &DataInfo{Files: len(paths)}
+	_, info.External = provider.(*LayeredDataProvider)
+
+	h := sha256.New()
+	for _, p := range paths {
+		data, readErr := provider.ReadFile(p)
+		if readErr != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to read recipe data file %s", p), readErr)
+		}
+		if p == registryFileName {
+			if info.RegistryAPIVersion, err = registryAPIVersion(data); err != nil {
+				return nil, err
+			}
+		}
+		fmt.Fprintf(h, "%s\x00%s\n", p, sha256Hex(data))
+	}
+	info.Digest = DigestAlgorithm + ":" + hex.EncodeToString(h.Sum(nil))
+
+	return info, nil
+}
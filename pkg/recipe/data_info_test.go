@@ -0,0 +1,77 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetDataInfo(t *testing.T) {
+	embedded := NewEmbeddedDataProvider(dataFS, "data")
+
+	info, err := GetDataInfo(embedded)
+	if err != nil {
+		t.Fatalf("GetDataInfo() error = %v", err)
+	}
+	if info.External {
+		t.Error("External should be false for embedded data")
+	}
+	if info.RegistryAPIVersion != FullAPIVersion {
+		t.Errorf("RegistryAPIVersion = %q, want %q", info.RegistryAPIVersion, FullAPIVersion)
+	}
+	if info.Files == 0 {
+		t.Error("Files should count the embedded data files")
+	}
+	if !strings.HasPrefix(info.Digest, DigestAlgorithm+":") {
+		t.Errorf("Digest = %q, want %s prefix", info.Digest, DigestAlgorithm)
+	}
+
+	again, err := GetDataInfo(embedded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Digest != info.Digest {
+		t.Errorf("digest not stable: %s != %s", again.Digest, info.Digest)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "registry.yaml"), []byte(testEmptyRegistryContent), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "extra.yaml"), []byte("kind: Extra\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	layered, err := NewLayeredDataProvider(embedded, LayeredProviderConfig{ExternalDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	external, err := GetDataInfo(layered)
+	if err != nil {
+		t.Fatalf("GetDataInfo() on layered data error = %v", err)
+	}
+	if !external.External {
+		t.Error("External should be true for layered data")
+	}
+	if external.Files != info.Files+1 {
+		t.Errorf("Files = %d, want %d", external.Files, info.Files+1)
+	}
+	if external.Digest == info.Digest {
+		t.Error("layered data should change the digest")
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/fs"
+	"slices"
+	"sort"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// DigestAlgorithm is the prefix of digests returned by RecipeResult.Digest.
+const DigestAlgorithm = "sha256"
+
+// CanonicalJSON returns the canonical JSON encoding of the recipe used for digests.
+//
+// Canonicalization makes the encoding independent of how the recipe was produced:
+//   - Metadata (generator version, applied overlays, warnings) is excluded
+//   - Object keys are sorted, so Go struct field order and map order do not matter
+//   - ComponentRefs and Constraints are sorted by name, DependencyRefs alphabetically
+//   - DeploymentOrder keeps its order because it is semantically significant
+//   - Output is compact, not HTML-escaped, with no trailing newline
+func (r *RecipeResult) CanonicalJSON() ([]byte, error) {
+	canonical := struct {
+		Kind            string         `json:"kind"`
+		APIVersion      string         `json:"apiVersion"`
+		Criteria        *Criteria      `json:"criteria"`
+		Constraints     []Constraint   `json:"constraints,omitempty"`
+		ComponentRefs   []ComponentRef `json:"componentRefs"`
+		DeploymentOrder []string       `json:"deploymentOrder"`
+	}{
+		Kind:            r.Kind,
+		APIVersion:      r.APIVersion,
+		Criteria:        r.Criteria,
+		Constraints:     slices.Clone(r.Constraints),
+		DeploymentOrder: r.DeploymentOrder,
+	}
+
+	sort.SliceStable(canonical.Constraints, func(i, j int) bool {
+		return canonical.Constraints[i].Name < canonical.Constraints[j].Name
+	})
+
+	canonical.ComponentRefs = make([]ComponentRef, len(r.ComponentRefs))
+	for i, ref := range r.ComponentRefs {
+		ref.DependencyRefs = slices.Clone(ref.DependencyRefs)
+		sort.Strings(ref.DependencyRefs)
+		canonical.ComponentRefs[i] = ref
+	}
+	sort.SliceStable(canonical.ComponentRefs, func(i, j int) bool {
+		return canonical.ComponentRefs[i].Name < canonical.ComponentRefs[j].Name
+	})
+
+	data, err := json.Marshal(canonical)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to encode recipe", err)
+	}
+
+	// Round-trip through a generic value so every object, including structs,
+	// is re-encoded with sorted keys. UseNumber preserves numeric literals.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to canonicalize recipe", err)
+	}
+
+	// HTML escaping is disabled so values such as ">= 1.30" stay literal.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(generic); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to encode canonical recipe", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// Digest returns a stable content digest of the recipe in the form
+// "sha256:<hex>", computed over CanonicalJSON. Two recipes that differ only
+// in metadata or in the order of components and constraints share a digest.
+func (r *RecipeResult) Digest() (string, error) {
+	data, err := r.CanonicalJSON()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return DigestAlgorithm + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+// DataDigest returns a content digest of the recipe data served by the
+// current data provider, in the form "sha256:<hex>". It covers the path and
+// content of every file, including files of an external data directory, so
+// it changes whenever overlays, component values or the registry change.
+func DataDigest() (string, error) {
+	provider := GetDataProvider()
+
+	var paths []string
+	err := provider.WalkDir("", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to walk recipe data", err)
+	}
+	sort.Strings(paths)
+	paths = slices.Compact(paths)
+
+	h := sha256.New()
+	for _, path := range paths {
+		data, err := provider.ReadFile(path)
+		if err != nil {
+			return "", eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to read recipe data file "+path, err)
+		}
+		// Separate path and content so a byte cannot move between them.
+		h.Write([]byte(path))
+		h.Write([]byte{0})
+		h.Write(data)
+		h.Write([]byte{0})
+	}
+	return DigestAlgorithm + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// digestFixtureYAML is the golden recipe. Changing the canonical form or
+// hashing invalidates every stored digest, so the golden values below must
+// only be updated deliberately.
+const digestFixtureYAML = `kind: recipeResult
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  version: v0.9.0
+  appliedOverlays: [base, eks, eks-training]
+criteria:
+  service: eks
+  accelerator: h100
+  intent: training
+  os: ubuntu
+constraints:
+  - name: k8s
+    value: ">= 1.30"
+  - name: worker-os
+    value: ubuntu
+componentRefs:
+  - name: gpu-operator
+    type: Helm
+    source: https://helm.ngc.nvidia.com/nvidia
+    version: v25.3.3
+    dependencyRefs: [cert-manager]
+    overrides:
+      driver:
+        version: "580.82.07"
+        enabled: true
+      replicas: 2
+  - name: cert-manager
+    type: Helm
+    source: https://charts.jetstack.io
+    version: v1.17.2
+deploymentOrder: [cert-manager, gpu-operator]
+`
+
+const (
+	goldenCanonicalJSON = `{"apiVersion":"eidos.nvidia.com/v1alpha1","componentRefs":[{"name":"cert-manager","source":"https://charts.jetstack.io","type":"Helm","version":"v1.17.2"},{"dependencyRefs":["cert-manager"],"name":"gpu-operator","overrides":{"driver":{"enabled":true,"version":"580.82.07"},"replicas":2},"source":"https://helm.ngc.nvidia.com/nvidia","type":"Helm","version":"v25.3.3"}],"constraints":[{"name":"k8s","value":">= 1.30"},{"name":"worker-os","value":"ubuntu"}],"criteria":{"accelerator":"h100","intent":"training","os":"ubuntu","service":"eks"},"deploymentOrder":["cert-manager","gpu-operator"],"kind":"recipeResult"}`
+	goldenDigest        = "sha256:a76da6c6a351e239bc66b1bf0574c16f960d4536f4e40339ffbf3616e5148749"
+)
+
+func loadDigestFixture(t *testing.T) *RecipeResult {
+	t.Helper()
+	var r RecipeResult
+	if err := yaml.Unmarshal([]byte(digestFixtureYAML), &r); err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+	return &r
+}
+
+func TestRecipeResult_Digest_Golden(t *testing.T) {
+	r := loadDigestFixture(t)
+
+	canonical, err := r.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("CanonicalJSON() error = %v", err)
+	}
+	if string(canonical) != goldenCanonicalJSON {
+		t.Errorf("CanonicalJSON() =\n%s\nwant\n%s", canonical, goldenCanonicalJSON)
+	}
+
+	digest, err := r.Digest()
+	if err != nil {
+		t.Fatalf("Digest() error = %v", err)
+	}
+	if digest != goldenDigest {
+		t.Errorf("Digest() = %s, want %s", digest, goldenDigest)
+	}
+}
+
+func TestRecipeResult_Digest_Invariants(t *testing.T) {
+	base := loadDigestFixture(t)
+	want, err := base.Digest()
+	if err != nil {
+		t.Fatalf("Digest() error = %v", err)
+	}
+
+	same := []struct {
+		name   string
+		mutate func(r *RecipeResult)
+	}{
+		{
+			name: "metadata excluded",
+			mutate: func(r *RecipeResult) {
+				r.Metadata.Version = "v9.9.9"
+				r.Metadata.AppliedOverlays = []string{"base"}
+				r.Metadata.ExcludedOverlays = []string{"gke-cos"}
+			},
+		},
+		{
+			name: "component order",
+			mutate: func(r *RecipeResult) {
+				r.ComponentRefs[0], r.ComponentRefs[1] = r.ComponentRefs[1], r.ComponentRefs[0]
+			},
+		},
+		{
+			name: "constraint order",
+			mutate: func(r *RecipeResult) {
+				r.Constraints[0], r.Constraints[1] = r.Constraints[1], r.Constraints[0]
+			},
+		},
+		{
+			name: "JSON round trip",
+			mutate: func(r *RecipeResult) {
+				data, marshalErr := json.Marshal(r)
+				if marshalErr != nil {
+					t.Fatalf("failed to marshal: %v", marshalErr)
+				}
+				*r = RecipeResult{}
+				if unmarshalErr := json.Unmarshal(data, r); unmarshalErr != nil {
+					t.Fatalf("failed to unmarshal: %v", unmarshalErr)
+				}
+			},
+		},
+	}
+
+	for _, tt := range same {
+		t.Run(tt.name, func(t *testing.T) {
+			r := loadDigestFixture(t)
+			tt.mutate(r)
+			got, digestErr := r.Digest()
+			if digestErr != nil {
+				t.Fatalf("Digest() error = %v", digestErr)
+			}
+			if got != want {
+				t.Errorf("Digest() = %s, want %s", got, want)
+			}
+		})
+	}
+
+	different := []struct {
+		name   string
+		mutate func(r *RecipeResult)
+	}{
+		{name: "component version", mutate: func(r *RecipeResult) { r.ComponentRefs[0].Version = "v25.10.0" }},
+		{name: "override value", mutate: func(r *RecipeResult) { r.ComponentRefs[0].Overrides["replicas"] = 3 }},
+		{name: "criteria", mutate: func(r *RecipeResult) { r.Criteria.Intent = CriteriaIntentInference }},
+		{name: "deployment order", mutate: func(r *RecipeResult) {
+			r.DeploymentOrder = []string{"gpu-operator", "cert-manager"}
+		}},
+	}
+
+	for _, tt := range different {
+		t.Run(tt.name, func(t *testing.T) {
+			r := loadDigestFixture(t)
+			tt.mutate(r)
+			got, digestErr := r.Digest()
+			if digestErr != nil {
+				t.Fatalf("Digest() error = %v", digestErr)
+			}
+			if got == want {
+				t.Errorf("Digest() should change when %s changes", tt.name)
+			}
+		})
+	}
+}
+
+func TestRecipeResult_Digest_DoesNotMutate(t *testing.T) {
+	r := loadDigestFixture(t)
+	r.ComponentRefs[0].DependencyRefs = []string{"z", "a"}
+
+	if _, err := r.Digest(); err != nil {
+		t.Fatalf("Digest() error = %v", err)
+	}
+
+	if r.ComponentRefs[0].Name != "gpu-operator" || r.Constraints[0].Name != "k8s" {
+		t.Error("Digest() reordered the recipe in place")
+	}
+	if strings.Join(r.ComponentRefs[0].DependencyRefs, ",") != "z,a" {
+		t.Errorf("Digest() sorted DependencyRefs in place: %v", r.ComponentRefs[0].DependencyRefs)
+	}
+}
+
+func TestHandleRecipes_ETag(t *testing.T) {
+	url := "/v1/recipe?service=eks&accelerator=h100&intent=training"
+
+	first := httptest.NewRecorder()
+	NewBuilder().HandleRecipes(first, httptest.NewRequest(http.MethodGet, url, nil))
+	if first.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", first.Code, first.Body.String())
+	}
+
+	etag := first.Header().Get("ETag")
+	if !strings.HasPrefix(etag, `"sha256:`) {
+		t.Fatalf("ETag = %q, want quoted sha256 digest", etag)
+	}
+
+	var result RecipeResult
+	if err := json.Unmarshal(first.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	digest, err := result.Digest()
+	if err != nil {
+		t.Fatalf("Digest() error = %v", err)
+	}
+	if etag != `"`+digest+`"` {
+		t.Errorf("ETag = %s, want digest of response %s", etag, digest)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("If-None-Match", `"sha256:other", W/`+etag)
+	second := httptest.NewRecorder()
+	NewBuilder().HandleRecipes(second, req)
+	if second.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want %d", second.Code, http.StatusNotModified)
+	}
+	if second.Body.Len() != 0 {
+		t.Errorf("304 response should have no body, got %q", second.Body.String())
+	}
+}
+
+func TestDataDigest(t *testing.T) {
+	originalProvider := globalDataProvider
+	originalGen := dataProviderGeneration
+	defer func() {
+		globalDataProvider = originalProvider
+		dataProviderGeneration = originalGen
+	}()
+
+	embedded := NewEmbeddedDataProvider(dataFS, "data")
+	SetDataProvider(embedded)
+	want, err := DataDigest()
+	if err != nil {
+		t.Fatalf("DataDigest() error = %v", err)
+	}
+	if !strings.HasPrefix(want, DigestAlgorithm+":") {
+		t.Errorf("DataDigest() = %q, want %s: prefix", want, DigestAlgorithm)
+	}
+	if again, _ := DataDigest(); again != want {
+		t.Errorf("DataDigest() not stable: %s then %s", want, again)
+	}
+
+	// An external data directory changes the digest.
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "registry.yaml"), []byte(testEmptyRegistryContent), 0600); err != nil {
+		t.Fatalf("failed to write registry.yaml: %v", err)
+	}
+	layered, err := NewLayeredDataProvider(embedded, LayeredProviderConfig{ExternalDir: tmpDir})
+	if err != nil {
+		t.Fatalf("failed to create layered provider: %v", err)
+	}
+	SetDataProvider(layered)
+	got, err := DataDigest()
+	if err != nil {
+		t.Fatalf("DataDigest() error = %v", err)
+	}
+	if got == want {
+		t.Error("DataDigest() did not change with an external registry.yaml")
+	}
+}
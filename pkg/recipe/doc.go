@@ -35,13 +35,21 @@
 // RecipeResult: Generated configuration result
 //
 //	type RecipeResult struct {
-//	    Header                              // API version, kind, metadata
-//	    Criteria      *Criteria             // Input criteria
-//	    MatchedRules  []string              // Applied overlay rules
-//	    ComponentRefs []ComponentRef        // Component references (Helm or Kustomize)
-//	    Constraints   []ConstraintRef       // Validation constraints
+//	    Kind, APIVersion string               // Resource type
+//	    Metadata        RecipeResultMetadata  // Version, applied overlays, warnings
+//	    Criteria        *Criteria             // Input criteria
+//	    Constraints     []Constraint          // Validation constraints
+//	    ComponentRefs   []ComponentRef        // Component references (Helm or Kustomize)
+//	    DeploymentOrder []string              // Topologically sorted component names
 //	}
 //
+// All API types are named structs with explicit camelCase json and yaml tags,
+// so they map directly onto the OpenAPI schemas used for client generation.
+// Enum types (ComponentType and the Criteria*Type types) implement
+// encoding.TextMarshaler and encoding.TextUnmarshaler: decoding accepts the
+// same aliases and casing as the Parse* functions and normalizes them, and
+// rejects unknown values.
+//
 // Recipe: Legacy format still used by bundlers
 //
 //	type Recipe struct {
@@ -63,6 +71,8 @@
 //   - CriteriaServiceEKS: Amazon EKS
 //   - CriteriaServiceGKE: Google GKE
 //   - CriteriaServiceAKS: Azure AKS
+//   - CriteriaServiceRKE2: Rancher RKE2
+//   - CriteriaServiceOpenShift: Red Hat OpenShift
 //   - CriteriaServiceAny: Any service (wildcard)
 //
 // Accelerator types for GPU selection:
@@ -98,6 +108,17 @@
 //	    fmt.Printf("Component: %s, Version: %s\n", ref.Name, ref.Version)
 //	}
 //
+// Compare recipes for several intents with otherwise identical criteria:
+//
+//	comparison, err := builder.BuildComparison(ctx, criteria,
+//	    []recipe.CriteriaIntentType{recipe.CriteriaIntentTraining, recipe.CriteriaIntentInference})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, diff := range comparison.Differences {
+//	    fmt.Printf("Component %s differs: %v\n", diff.Name, diff.Versions)
+//	}
+//
 // HTTP handler for API server:
 //
 //	builder := recipe.NewBuilder()
@@ -120,6 +141,8 @@
 //   - intent: training, inference, any (default: any)
 //   - os: ubuntu, cos, rhel, any (default: any)
 //   - nodes: integer node count (default: 0 = any)
+//   - compare: when true, intent is a comma-separated list and the response
+//     is a RecipeComparison
 //
 // # Criteria Files (CLI and HTTP API - POST)
 //
@@ -130,12 +153,10 @@
 // RecipeCriteria: Kubernetes-style resource for criteria definition
 //
 //	type RecipeCriteria struct {
-//	    Kind       string    // Must be "recipeCriteria"
-//	    APIVersion string    // Must be "eidos.nvidia.com/v1alpha1"
-//	    Metadata   struct {
-//	        Name string       // Optional descriptive name
-//	    }
-//	    Spec *Criteria        // The criteria specification
+//	    Kind       string     // Must be "recipeCriteria"
+//	    APIVersion string     // Must be "eidos.nvidia.com/v1alpha1"
+//	    Metadata   ObjectMeta // Optional descriptive name
+//	    Spec       *Criteria  // The criteria specification
 //	}
 //
 // Example criteria file (criteria.yaml):
@@ -180,6 +201,15 @@
 //	  -H "Content-Type: application/yaml" \
 //	  -d @criteria.yaml
 //
+// # Criteria from Snapshots
+//
+// ExtractCriteriaFromSnapshot maps snapshot measurements to criteria and
+// returns a CriteriaDetection recording the reading each field came from.
+// HandleRecipeFromSnapshot serves POST /v1/recipe/from-snapshot with it,
+// taking a SnapshotRecipeRequest holding the snapshot and the intent, and
+// returns the RecipeResult with
+// Metadata.CriteriaDetection set.
+//
 // # Criteria Matching
 //
 // Criteria use asymmetric matching with priority-based resolution:
@@ -249,6 +279,18 @@
 //	    GetValuesForComponent(name string) (map[string]any, error)
 //	}
 //
+// # Recipe Digest
+//
+// RecipeResult.Digest returns a stable "sha256:<hex>" content digest computed
+// over RecipeResult.CanonicalJSON. Metadata is excluded and components and
+// constraints are sorted, so the digest only changes when the deployable
+// content changes:
+//
+//	digest, err := result.Digest()
+//
+// The digest is used as the HTTP ETag, recorded on bundle output, and added
+// to pushed OCI bundles as the com.nvidia.eidos.recipe.digest annotation.
+//
 // # Error Handling
 //
 // BuildFromCriteria returns errors when:
@@ -269,7 +311,9 @@
 //   - recipe/data/overlays/base.yaml (base component versions)
 //   - recipe/data/overlays/*.yaml (criteria-specific overlays)
 //
-// The metadata store is loaded once and cached (singleton pattern with sync.Once).
+// The metadata store and component registry are loaded once and cached.
+// ReloadData replaces both after loading and validating new data, which lets
+// a long-running server pick up overlay updates without a restart.
 //
 // # Observability
 //
@@ -0,0 +1,217 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Snapshot paths the GPU Operator driver flavor is selected from.
+const (
+	kernelReleasePath = "OS.sysctl./proc/sys/kernel/osrelease"
+	osIDPath          = "OS.release.ID"
+	osVersionPath     = "OS.release.VERSION_ID"
+	secureBootPath    = "OS.secureboot.enabled"
+)
+
+// DriverFlavor is the kind of driver container the GPU Operator deploys.
+type DriverFlavor string
+
+// Driver flavors selected by configureDriver.
+const (
+	// DriverFlavorPrecompiled uses a precompiled driver container built for
+	// the node kernel, so nothing is compiled on the node.
+	DriverFlavorPrecompiled DriverFlavor = "precompiled"
+
+	// DriverFlavorSigned uses a precompiled driver container on nodes with
+	// Secure Boot, whose kernel modules are signed and load with it enabled.
+	DriverFlavorSigned DriverFlavor = "signed"
+
+	// DriverFlavorOpen compiles the open GPU kernel modules on the node, for
+	// kernels without a precompiled driver container.
+	DriverFlavorOpen DriverFlavor = "open"
+)
+
+// precompiledDriverOS lists the OS releases, as "<ID> <VERSION_ID>", NVIDIA
+// publishes precompiled driver containers for.
+var precompiledDriverOS = []string{"ubuntu 22.04", "ubuntu 24.04"}
+
+// precompiledKernelFlavors lists the Ubuntu kernel flavors precompiled
+// driver containers are built for.
+var precompiledKernelFlavors = []string{"generic", "aws", "azure", "gcp", "oracle", "nvidia"}
+
+// ubuntuKernelPattern matches Ubuntu kernel releases such as
+// "6.8.0-1028-aws", capturing the kernel flavor.
+var ubuntuKernelPattern = regexp.MustCompile(`^\d+\.\d+\.\d+-\d+-([a-z0-9-]+)$`)
+
+// precompiledDriverAvailable reports whether a precompiled driver container
+// exists for a kernel release on an OS release.
+func precompiledDriverAvailable(osID, osVersion, kernel string) bool {
+	if !slices.Contains(precompiledDriverOS, osID+" "+osVersion) {
+		return false
+	}
+	m := ubuntuKernelPattern.FindStringSubmatch(kernel)
+	return m != nil && slices.Contains(precompiledKernelFlavors, m[1])
+}
+
+// selectDriverFlavor returns the driver flavor for a node, or "" when the
+// node needs a signed driver no precompiled container provides.
+func selectDriverFlavor(precompiled, secureBoot bool) DriverFlavor {
+	switch {
+	case precompiled && secureBoot:
+		return DriverFlavorSigned
+	case precompiled:
+		return DriverFlavorPrecompiled
+	case secureBoot:
+		return ""
+	default:
+		return DriverFlavorOpen
+	}
+}
+
+// snapshotValue returns the snapshot value at path, or false when the
+// snapshot does not hold it.
+func snapshotValue(evaluator ConstraintEvaluatorFunc, path string) (string, bool) {
+	// Only Actual is used; the value just needs to be a valid expression.
+	result := evaluator(Constraint{Name: path, Value: "!= none"})
+	if result.Error != nil || result.Actual == "" {
+		return "", false
+	}
+	return result.Actual, true
+}
+
+// configureDriver selects the driver flavor of GPU Operator components from
+// the kernel release, OS release and Secure Boot state in the snapshot:
+// precompiled driver containers where NVIDIA publishes one for the kernel,
+// which also provide the signed modules Secure Boot needs, and the open
+// kernel modules compiled on the node otherwise. Components that disable the
+// driver, build it with the OpenShift Driver Toolkit, or choose a flavor in
+// their overrides or values files, are left alone; choosing precompiled
+// drivers for a kernel without any is warned about.
+func (s *MetadataStore) configureDriver(spec *RecipeMetadataSpec, evaluator ConstraintEvaluatorFunc) []ComponentWarning {
+	kernel, ok := snapshotValue(evaluator, kernelReleasePath)
+	if !ok {
+		return nil
+	}
+	osID, _ := snapshotValue(evaluator, osIDPath)
+	osVersion, _ := snapshotValue(evaluator, osVersionPath)
+	secureBootValue, _ := snapshotValue(evaluator, secureBootPath)
+	secureBoot := secureBootValue == "true"
+	precompiled := precompiledDriverAvailable(osID, osVersion, kernel)
+	osName := strings.TrimSpace(osID + " " + osVersion)
+
+	var warnings []ComponentWarning
+	for i := range spec.ComponentRefs {
+		ref := &spec.ComponentRefs[i]
+		if !ref.IsEnabled() || ref.ComponentName() != "gpu-operator" {
+			continue
+		}
+		values := s.componentValues(*ref)
+		driver := mergedValues(values, ref.Overrides, "driver")
+		if enabled, ok := driver["enabled"].(bool); ok && !enabled {
+			continue
+		}
+		// The Driver Toolkit builds the driver for the RHCOS kernel of each
+		// node, so there is no flavor to choose.
+		operator := mergedValues(values, ref.Overrides, "operator")
+		if dtk, ok := operator["use_ocp_driver_toolkit"].(bool); ok && dtk {
+			continue
+		}
+
+		if usePrecompiled, ok := driver["usePrecompiled"].(bool); ok || driver["kernelModuleType"] != nil {
+			if usePrecompiled && !precompiled {
+				warnings = append(warnings, ComponentWarning{
+					Component: ref.Name,
+					Reason: fmt.Sprintf("driver.usePrecompiled is set but no precompiled driver image exists for kernel %s on %s; the driver will fail to start",
+						kernel, osName),
+				})
+			}
+			continue
+		}
+
+		flavor := selectDriverFlavor(precompiled, secureBoot)
+		slog.Debug("selected driver flavor",
+			"component", ref.Name,
+			"flavor", flavor,
+			"kernel", kernel,
+			"os", osName,
+			"secureBoot", secureBoot)
+
+		var reason string
+		overrides := map[string]any{}
+		switch flavor {
+		case DriverFlavorSigned, DriverFlavorPrecompiled:
+			overrides["usePrecompiled"] = true
+			// Precompiled driver containers are tagged by driver branch.
+			if version, ok := driver["version"].(string); ok && strings.Contains(version, ".") {
+				overrides["version"] = strings.SplitN(version, ".", 2)[0]
+			}
+			reason = fmt.Sprintf("precompiled driver container selected for kernel %s on %s", kernel, osName)
+			if flavor == DriverFlavorSigned {
+				reason = fmt.Sprintf("Secure Boot is enabled; precompiled driver container with signed modules selected for kernel %s on %s",
+					kernel, osName)
+			}
+		case DriverFlavorOpen:
+			if open, ok := driver["useOpenKernelModules"].(bool); !ok || open {
+				overrides["kernelModuleType"] = string(DriverFlavorOpen)
+			}
+			reason = fmt.Sprintf("no precompiled driver image exists for kernel %s on %s; the driver container compiles the kernel modules on each node",
+				kernel, osName)
+		default:
+			warnings = append(warnings, ComponentWarning{
+				Component: ref.Name,
+				Reason: fmt.Sprintf("Secure Boot is enabled but no precompiled driver image with signed modules exists for kernel %s on %s; modules compiled on the node will not load. Install a signed driver on the nodes and set driver.enabled=false",
+					kernel, osName),
+			})
+			continue
+		}
+
+		warnings = append(warnings, ComponentWarning{Component: ref.Name, Reason: reason})
+		if len(overrides) == 0 {
+			continue
+		}
+
+		ref.Overrides = maps.Clone(ref.Overrides)
+		if ref.Overrides == nil {
+			ref.Overrides = make(map[string]any)
+		}
+		driverOverrides, _ := ref.Overrides["driver"].(map[string]any)
+		driverOverrides = maps.Clone(driverOverrides)
+		if driverOverrides == nil {
+			driverOverrides = make(map[string]any)
+		}
+		maps.Copy(driverOverrides, overrides)
+		ref.Overrides["driver"] = driverOverrides
+	}
+	return warnings
+}
+
+// mergedValues returns the values under key of a GPU Operator component,
+// such as driver or operator, with its inline overrides over its values files.
+func mergedValues(values, overrides map[string]any, key string) map[string]any {
+	merged := map[string]any{}
+	if v, ok := values[key].(map[string]any); ok {
+		maps.Copy(merged, v)
+	}
+	if v, ok := overrides[key].(map[string]any); ok {
+		maps.Copy(merged, v)
+	}
+	return merged
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPrecompiledDriverAvailable(t *testing.T) {
+	tests := []struct {
+		osID, osVersion, kernel string
+		want                    bool
+	}{
+		{"ubuntu", "24.04", "6.8.0-1028-aws", true},
+		{"ubuntu", "22.04", "5.15.0-105-generic", true},
+		{"ubuntu", "22.04", "6.5.0-1020-lowlatency", false},
+		{"ubuntu", "20.04", "5.15.0-105-generic", false},
+		{"rhel", "9.4", "5.14.0-427.13.1.el9_4.x86_64", false},
+		{"ubuntu", "24.04", "6.8.0-custom", false},
+	}
+	for _, tt := range tests {
+		if got := precompiledDriverAvailable(tt.osID, tt.osVersion, tt.kernel); got != tt.want {
+			t.Errorf("precompiledDriverAvailable(%s, %s, %s) = %v, want %v", tt.osID, tt.osVersion, tt.kernel, got, tt.want)
+		}
+	}
+}
+
+// TestConfigureDriver verifies the GPU Operator driver flavor is selected
+// from the snapshot kernel, OS and Secure Boot state.
+func TestConfigureDriver(t *testing.T) {
+	openshiftValues, err := os.ReadFile(filepath.Join("data", "components", "gpu-operator", "values-openshift.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read OpenShift values: %v", err)
+	}
+	store := &MetadataStore{ValuesFiles: map[string][]byte{
+		"gpu-operator/values.yaml":             []byte("driver:\n  version: 580.105.08\n  useOpenKernelModules: true\n"),
+		"gpu-operator/values-proprietary.yaml": []byte("driver:\n  version: 580.105.08\n  useOpenKernelModules: false\n"),
+		"gpu-operator/values-precompiled.yaml": []byte("driver:\n  usePrecompiled: true\n"),
+		"gpu-operator/values-openshift.yaml":   openshiftValues,
+	}}
+	disabled := false
+
+	ubuntuAWS := map[string]string{
+		kernelReleasePath: "6.8.0-1028-aws",
+		osIDPath:          "ubuntu",
+		osVersionPath:     "24.04",
+		secureBootPath:    "false",
+	}
+	rhel := map[string]string{
+		kernelReleasePath: "5.14.0-427.13.1.el9_4.x86_64",
+		osIDPath:          "rhel",
+		osVersionPath:     "9.4",
+		secureBootPath:    "false",
+	}
+	with := func(snapshot map[string]string, key, value string) map[string]string {
+		out := map[string]string{key: value}
+		for k, v := range snapshot {
+			if k != key {
+				out[k] = v
+			}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name         string
+		ref          ComponentRef
+		snapshot     map[string]string
+		wantDriver   map[string]any
+		wantWarnings []string
+	}{
+		{
+			name:         "precompiled for supported kernel",
+			ref:          ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values.yaml"},
+			snapshot:     ubuntuAWS,
+			wantDriver:   map[string]any{"usePrecompiled": true, "version": "580"},
+			wantWarnings: []string{"precompiled driver container selected for kernel 6.8.0-1028-aws on ubuntu 24.04"},
+		},
+		{
+			name:         "signed for secure boot",
+			ref:          ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values.yaml"},
+			snapshot:     with(ubuntuAWS, secureBootPath, "true"),
+			wantDriver:   map[string]any{"usePrecompiled": true, "version": "580"},
+			wantWarnings: []string{"Secure Boot is enabled; precompiled driver container with signed modules"},
+		},
+		{
+			name:         "open modules without precompiled image",
+			ref:          ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values.yaml"},
+			snapshot:     rhel,
+			wantDriver:   map[string]any{"kernelModuleType": "open"},
+			wantWarnings: []string{"no precompiled driver image exists for kernel 5.14.0-427.13.1.el9_4.x86_64 on rhel 9.4"},
+		},
+		{
+			name:         "proprietary modules kept without precompiled image",
+			ref:          ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-proprietary.yaml"},
+			snapshot:     rhel,
+			wantWarnings: []string{"no precompiled driver image exists"},
+		},
+		{
+			name:         "secure boot without signed driver",
+			ref:          ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values.yaml"},
+			snapshot:     with(rhel, secureBootPath, "true"),
+			wantWarnings: []string{"Secure Boot is enabled but no precompiled driver image with signed modules exists"},
+		},
+		{
+			name:         "precompiled chosen in values file for unsupported kernel",
+			ref:          ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-precompiled.yaml"},
+			snapshot:     rhel,
+			wantWarnings: []string{"driver.usePrecompiled is set but no precompiled driver image exists"},
+		},
+		{
+			name:     "flavor chosen inline",
+			ref:      ComponentRef{Name: "gpu-operator", Overrides: map[string]any{"driver": map[string]any{"kernelModuleType": "proprietary"}}},
+			snapshot: ubuntuAWS,
+		},
+		{
+			name:     "driver disabled",
+			ref:      ComponentRef{Name: "gpu-operator", Overrides: map[string]any{"driver": map[string]any{"enabled": false}}},
+			snapshot: with(rhel, secureBootPath, "true"),
+		},
+		{
+			name:     "openshift driver toolkit",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values.yaml", Overrides: map[string]any{"operator": map[string]any{"use_ocp_driver_toolkit": true}}},
+			snapshot: rhel,
+		},
+		{
+			name:     "openshift overlay values",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values-openshift.yaml"},
+			snapshot: rhel,
+		},
+		{
+			name:     "disabled component",
+			ref:      ComponentRef{Name: "gpu-operator", Enabled: &disabled},
+			snapshot: ubuntuAWS,
+		},
+		{
+			name:     "snapshot without kernel",
+			ref:      ComponentRef{Name: "gpu-operator", ValuesFile: "gpu-operator/values.yaml"},
+			snapshot: map[string]string{osIDPath: "ubuntu"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := func(c Constraint) ConstraintEvalResult {
+				value, ok := tt.snapshot[c.Name]
+				if !ok {
+					return ConstraintEvalResult{Error: errors.New("not found")}
+				}
+				return ConstraintEvalResult{Passed: true, Actual: value}
+			}
+
+			spec := &RecipeMetadataSpec{ComponentRefs: []ComponentRef{tt.ref}}
+			warnings := store.configureDriver(spec, evaluator)
+			if len(warnings) != len(tt.wantWarnings) {
+				t.Fatalf("warnings = %+v, want %d", warnings, len(tt.wantWarnings))
+			}
+			for i, want := range tt.wantWarnings {
+				if !strings.Contains(warnings[i].Reason, want) {
+					t.Errorf("warning[%d] = %q, want it to contain %q", i, warnings[i].Reason, want)
+				}
+			}
+
+			driver, _ := spec.ComponentRefs[0].Overrides["driver"].(map[string]any)
+			if tt.wantDriver == nil {
+				if len(spec.ComponentRefs[0].Overrides) != len(tt.ref.Overrides) {
+					t.Errorf("overrides changed: %v", spec.ComponentRefs[0].Overrides)
+				}
+				return
+			}
+			if len(driver) != len(tt.wantDriver) {
+				t.Errorf("driver overrides = %v, want %v", driver, tt.wantDriver)
+			}
+			for k, want := range tt.wantDriver {
+				if driver[k] != want {
+					t.Errorf("driver.%s = %v, want %v", k, driver[k], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"reflect"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	// ExplainSourceRegistry marks values filled in from the component registry.
+	ExplainSourceRegistry = "registry.yaml"
+
+	// ExplainSourceSnapshot marks values adjusted from the snapshot during
+	// recipe generation, such as components disabled because they are installed.
+	ExplainSourceSnapshot = "snapshot"
+
+	// explainOverridesSuffix marks values that come from inline overrides.
+	explainOverridesSuffix = " (overrides)"
+)
+
+// RecipeExplanation records which recipe data file set each value of a
+// recipe last. Sources are data file paths (e.g. "overlays/eks.yaml"),
+// ExplainSourceRegistry or ExplainSourceSnapshot.
+type RecipeExplanation struct {
+	// Constraints maps constraint names to their source.
+	Constraints map[string]string `json:"constraints,omitempty" yaml:"constraints,omitempty"`
+
+	// ComponentRefs maps component names to the sources of their values.
+	ComponentRefs map[string]*ComponentExplanation `json:"componentRefs,omitempty" yaml:"componentRefs,omitempty"`
+}
+
+// ComponentExplanation records the sources of one component's values.
+type ComponentExplanation struct {
+	// Fields maps componentRef field names (e.g. "version") to their source.
+	Fields map[string]string `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// Overrides maps dotted inline override paths to the file that set them.
+	Overrides map[string]string `json:"overrides,omitempty" yaml:"overrides,omitempty"`
+
+	// Values maps dotted paths of the resolved component values to the values
+	// file that set them, or to the override source with " (overrides)".
+	Values map[string]string `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+// component returns the explanation of the named component, creating it.
+func (e *RecipeExplanation) component(name string) *ComponentExplanation {
+	if e.ComponentRefs == nil {
+		e.ComponentRefs = make(map[string]*ComponentExplanation)
+	}
+	ce, ok := e.ComponentRefs[name]
+	if !ok {
+		ce = &ComponentExplanation{
+			Fields:    make(map[string]string),
+			Overrides: make(map[string]string),
+			Values:    make(map[string]string),
+		}
+		e.ComponentRefs[name] = ce
+	}
+	return ce
+}
+
+// record attributes every value spec sets to source, replacing earlier sources.
+func (e *RecipeExplanation) record(source string, spec *RecipeMetadataSpec) {
+	for _, c := range spec.Constraints {
+		e.Constraints[c.Name] = source
+	}
+	for _, ref := range spec.ComponentRefs {
+		ce := e.component(ref.Name)
+		for field := range componentRefFieldValues(ref) {
+			ce.Fields[field] = source
+		}
+		forEachValuePath(ref.Overrides, "", func(path string, _ any) {
+			ce.Overrides[path] = source
+		})
+	}
+}
+
+// Explain returns the source of every constraint, componentRef field,
+// inline override and component value of result. It replays the recipes
+// listed in result.Metadata.AppliedOverlays in order; values that differ from
+// the replay were filled in from the registry or adjusted from the snapshot.
+func (s *MetadataStore) Explain(result *RecipeResult) (*RecipeExplanation, error) {
+	if result == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "recipe cannot be nil")
+	}
+
+	exp := &RecipeExplanation{Constraints: make(map[string]string)}
+	var replayed RecipeMetadataSpec
+	for _, name := range result.Metadata.AppliedOverlays {
+		metadata := s.Overlays[name]
+		if name == "base" {
+			metadata = s.Base
+		}
+		if metadata == nil {
+			return nil, eidoserrors.New(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("applied overlay %q not found in recipe data", name))
+		}
+		exp.record(s.source(name), &metadata.Spec)
+		replayed.Merge(&metadata.Spec)
+	}
+
+	// Drop constraints the result does not contain
+	for name := range exp.Constraints {
+		if !containsConstraint(result.Constraints, name) {
+			delete(exp.Constraints, name)
+		}
+	}
+
+	explained := make(map[string]bool)
+	for _, ref := range result.ComponentRefs {
+		explained[ref.Name] = true
+		ce := exp.component(ref.Name)
+
+		var replayedRef ComponentRef
+		for _, r := range replayed.ComponentRefs {
+			if r.Name == ref.Name {
+				replayedRef = r
+			}
+		}
+
+		replayedFields := componentRefFieldValues(replayedRef)
+		for field, value := range componentRefFieldValues(ref) {
+			replayedValue, ok := replayedFields[field]
+			switch {
+			case !ok && field != "enabled":
+				ce.Fields[field] = ExplainSourceRegistry
+			case !ok || !reflect.DeepEqual(value, replayedValue):
+				ce.Fields[field] = ExplainSourceSnapshot
+			}
+		}
+		for field := range ce.Fields {
+			if _, ok := componentRefFieldValues(ref)[field]; !ok {
+				delete(ce.Fields, field)
+			}
+		}
+
+		replayedOverrides := make(map[string]any)
+		forEachValuePath(replayedRef.Overrides, "", func(path string, value any) {
+			replayedOverrides[path] = value
+		})
+		finalOverrides := make(map[string]bool)
+		forEachValuePath(ref.Overrides, "", func(path string, value any) {
+			finalOverrides[path] = true
+			if replayedValue, ok := replayedOverrides[path]; !ok || !reflect.DeepEqual(value, replayedValue) {
+				ce.Overrides[path] = ExplainSourceSnapshot
+			}
+		})
+		for path := range ce.Overrides {
+			if !finalOverrides[path] {
+				delete(ce.Overrides, path)
+			}
+		}
+
+		values, err := s.explainValues(result, ref, ce.Overrides)
+		if err != nil {
+			return nil, err
+		}
+		ce.Values = values
+	}
+	for name := range exp.ComponentRefs {
+		if !explained[name] {
+			delete(exp.ComponentRefs, name)
+		}
+	}
+
+	return exp, nil
+}
+
+// explainValues maps every resolved value path of ref to the values file or
+// override that set it, following the merge order of GetValuesForComponent.
+func (s *MetadataStore) explainValues(result *RecipeResult, ref ComponentRef, overrides map[string]string) (map[string]string, error) {
+	sources := make(map[string]string)
+	if ref.ValuesFile == "" && len(ref.Overrides) == 0 {
+		return sources, nil
+	}
+
+	resolved, err := result.GetValuesForComponent(ref.Name)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			fmt.Sprintf("failed to resolve values of component %s", ref.Name), err)
+	}
+
+	// Layers in merge order; later layers take precedence
+	layers, err := valuesLayers(GetDataProvider().ReadFile, ref)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			fmt.Sprintf("failed to load values files of component %s", ref.Name), err)
+	}
+
+	layerPaths := make([]map[string]bool, len(layers))
+	for i, layer := range layers {
+		layerPaths[i] = make(map[string]bool)
+		forEachValuePath(layer.values, "", func(path string, _ any) {
+			layerPaths[i][path] = true
+		})
+	}
+
+	forEachValuePath(resolved, "", func(path string, _ any) {
+		if source, ok := overrides[path]; ok {
+			sources[path] = source + explainOverridesSuffix
+			return
+		}
+		for i := len(layers) - 1; i >= 0; i-- {
+			if layerPaths[i][path] {
+				sources[path] = layers[i].file
+				return
+			}
+		}
+	})
+	return sources, nil
+}
+
+// source returns the data file path of the named recipe, or the name itself
+// for recipes not loaded from a file.
+func (s *MetadataStore) source(name string) string {
+	if path, ok := s.Sources[name]; ok {
+		return path
+	}
+	return name
+}
+
+// componentRefFieldValues returns the set fields of ref keyed by their
+// serialized name, excluding name and overrides.
+func componentRefFieldValues(ref ComponentRef) map[string]any {
+	fields := make(map[string]any)
+	set := func(name string, value any, isSet bool) {
+		if isSet {
+			fields[name] = value
+		}
+	}
+	set("component", ref.Component, ref.Component != "")
+	set("releaseName", ref.ReleaseName, ref.ReleaseName != "")
+	set("type", ref.Type, ref.Type != "")
+	set("source", ref.Source, ref.Source != "")
+	set("version", ref.Version, ref.Version != "")
+	set("tag", ref.Tag, ref.Tag != "")
+	set("valuesFile", ref.ValuesFile, ref.ValuesFile != "")
+	set("patches", ref.Patches, len(ref.Patches) > 0)
+	set("dependencyRefs", ref.DependencyRefs, len(ref.DependencyRefs) > 0)
+	set("manifestFiles", ref.ManifestFiles, len(ref.ManifestFiles) > 0)
+	set("path", ref.Path, ref.Path != "")
+	if ref.Enabled != nil {
+		fields["enabled"] = *ref.Enabled
+	}
+	set("docsURL", ref.DocsURL, ref.DocsURL != "")
+	set("supportMatrixURL", ref.SupportMatrixURL, ref.SupportMatrixURL != "")
+	return fields
+}
+
+// forEachValuePath calls fn with the dotted path and value of every leaf of
+// values. Lists are leaves; merge strategy annotations are skipped.
+func forEachValuePath(values map[string]any, prefix string, fn func(path string, value any)) {
+	for key, value := range values {
+		if key == MergeStrategiesKey {
+			continue
+		}
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+			forEachValuePath(nested, path, fn)
+			continue
+		}
+		fn(path, value)
+	}
+}
+
+// containsConstraint reports whether constraints include one named name.
+func containsConstraint(constraints []Constraint, name string) bool {
+	for _, c := range constraints {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
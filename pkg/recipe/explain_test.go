@@ -0,0 +1,111 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBuilder_Explain(t *testing.T) {
+	criteria := &Criteria{
+		Service:     CriteriaServiceEKS,
+		Accelerator: CriteriaAcceleratorGB200,
+		Intent:      CriteriaIntentTraining,
+		OS:          CriteriaOSUbuntu,
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		result, err := NewBuilder().BuildFromCriteria(context.Background(), criteria)
+		if err != nil {
+			t.Fatalf("BuildFromCriteria() error = %v", err)
+		}
+		if result.Explain != nil {
+			t.Error("expected no explanation without WithExplain")
+		}
+	})
+
+	t.Run("sources", func(t *testing.T) {
+		result, err := NewBuilder(WithExplain(true)).BuildFromCriteria(context.Background(), criteria)
+		if err != nil {
+			t.Fatalf("BuildFromCriteria() error = %v", err)
+		}
+		exp := result.Explain
+		if exp == nil {
+			t.Fatal("expected explanation")
+		}
+
+		if len(exp.Constraints) != len(result.Constraints) {
+			t.Errorf("explained %d constraints, recipe has %d", len(exp.Constraints), len(result.Constraints))
+		}
+		if got := exp.Constraints["GPU.health.remapped-rows-pending"]; got != "overlays/base.yaml" {
+			t.Errorf("base constraint source = %q", got)
+		}
+		if got := exp.Constraints["K8s.server.version"]; got != "overlays/gb200-eks-ubuntu-training.yaml" {
+			t.Errorf("K8s.server.version source = %q", got)
+		}
+
+		gpu := exp.ComponentRefs["gpu-operator"]
+		if gpu == nil {
+			t.Fatal("gpu-operator not explained")
+		}
+		for field, want := range map[string]string{
+			"version":    "overlays/gb200-eks-training.yaml",
+			"source":     "overlays/base.yaml",
+			"valuesFile": "overlays/eks-training.yaml",
+			"docsURL":    ExplainSourceRegistry,
+		} {
+			if got := gpu.Fields[field]; got != want {
+				t.Errorf("gpu-operator field %s source = %q, want %q", field, got, want)
+			}
+		}
+		if got := gpu.Overrides["driver.version"]; got != "overlays/gb200-eks-training.yaml" {
+			t.Errorf("driver.version override source = %q", got)
+		}
+		for path, want := range map[string]string{
+			"driver.version":                "overlays/gb200-eks-training.yaml (overrides)",
+			"hostPaths.driverInstallDir":    "components/gpu-operator/values-eks-training.yaml",
+			"operator.resources.limits.cpu": "components/gpu-operator/values.yaml",
+		} {
+			if got := gpu.Values[path]; got != want {
+				t.Errorf("gpu-operator value %s source = %q, want %q", path, got, want)
+			}
+		}
+
+		for _, ref := range result.ComponentRefs {
+			if exp.ComponentRefs[ref.Name] == nil {
+				t.Errorf("component %s not explained", ref.Name)
+			}
+		}
+	})
+
+	t.Run("snapshot adjustments", func(t *testing.T) {
+		evaluator := func(c Constraint) ConstraintEvalResult {
+			if c.Name == "K8s.cert-manager.installed" {
+				return ConstraintEvalResult{Passed: c.Value == "true", Actual: "true"}
+			}
+			return ConstraintEvalResult{Passed: true}
+		}
+
+		result, err := NewBuilder(WithExplain(true)).BuildFromCriteriaWithEvaluator(context.Background(), NewCriteria(), evaluator)
+		if err != nil {
+			t.Fatalf("BuildFromCriteriaWithEvaluator() error = %v", err)
+		}
+		certManager := result.Explain.ComponentRefs["cert-manager"]
+		if certManager == nil || certManager.Fields["enabled"] != ExplainSourceSnapshot {
+			t.Errorf("cert-manager enabled source = %+v, want %s", certManager, ExplainSourceSnapshot)
+		}
+	})
+}
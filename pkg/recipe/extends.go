@@ -0,0 +1,170 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExtendsKey is the reserved top-level key of a values file that names the
+// values files it extends, as a path or a list of paths relative to the data
+// directory:
+//
+//	# components/gpu-operator/values-eks-h100.yaml
+//	$extends:
+//	  - components/gpu-operator/values-h100.yaml
+//	  - components/gpu-operator/values-eks.yaml
+//	driver:
+//	  version: 580.82.07
+//
+// Extended files are merged first, in order, so overlays can layer values
+// files (base → GPU family → provider) instead of duplicating them. The key
+// never appears in resolved component values.
+const ExtendsKey = "$extends"
+
+// maxExtendsDepth bounds the length of values file inheritance chains.
+const maxExtendsDepth = 10
+
+// valuesLayer is a values file and its values, without ExtendsKey.
+type valuesLayer struct {
+	file   string
+	values map[string]any
+}
+
+// valuesLayers returns the values files ref resolves to in merge order: the
+// component's base values file, the files extended by ref.ValuesFile
+// (ancestors first), and ref.ValuesFile itself. Each file appears once, and a
+// missing base values file is skipped.
+func valuesLayers(read func(string) ([]byte, error), ref ComponentRef) ([]valuesLayer, error) {
+	if ref.ValuesFile == "" {
+		return nil, nil
+	}
+
+	r := &valuesLayerResolver{read: read, resolved: make(map[string]bool)}
+	if err := r.resolve(ref.ValuesFile, nil); err != nil {
+		return nil, err
+	}
+
+	baseValuesFile := fmt.Sprintf("components/%s/values.yaml", ref.ComponentName())
+	if r.resolved[baseValuesFile] {
+		return r.layers, nil
+	}
+	data, err := read(baseValuesFile)
+	if err != nil {
+		// If the base file doesn't exist, that's okay - just use the overlays
+		return r.layers, nil
+	}
+	base := valuesLayer{file: baseValuesFile}
+	if err := yaml.Unmarshal(data, &base.values); err != nil {
+		return nil, fmt.Errorf("failed to parse base values file %q: %w", baseValuesFile, err)
+	}
+	delete(base.values, ExtendsKey)
+	return append([]valuesLayer{base}, r.layers...), nil
+}
+
+// valuesLayerResolver resolves values file inheritance chains depth-first.
+type valuesLayerResolver struct {
+	read     func(string) ([]byte, error)
+	resolved map[string]bool
+	layers   []valuesLayer
+}
+
+// resolve appends the layers of file after those of the files it extends.
+// chain holds the files extending file, to detect cycles.
+func (r *valuesLayerResolver) resolve(file string, chain []string) error {
+	if slices.Contains(chain, file) {
+		return fmt.Errorf("values file %q extends itself through %v", file, append(chain, file))
+	}
+	if len(chain) >= maxExtendsDepth {
+		return fmt.Errorf("values file %q exceeds the maximum %s depth of %d", file, ExtendsKey, maxExtendsDepth)
+	}
+
+	data, err := r.read(file)
+	if err != nil {
+		return fmt.Errorf("failed to read values file %q: %w", file, err)
+	}
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to parse values file %q: %w", file, err)
+	}
+
+	parents, err := extendedValuesFiles(values)
+	if err != nil {
+		return fmt.Errorf("invalid %s in values file %q: %w", ExtendsKey, file, err)
+	}
+	delete(values, ExtendsKey)
+
+	for _, parent := range parents {
+		if r.resolved[parent] {
+			continue
+		}
+		if err := r.resolve(parent, append(chain, file)); err != nil {
+			return err
+		}
+	}
+
+	r.resolved[file] = true
+	r.layers = append(r.layers, valuesLayer{file: file, values: values})
+	return nil
+}
+
+// extendedValuesFiles returns the files named by the ExtendsKey of values.
+func extendedValuesFiles(values map[string]any) ([]string, error) {
+	switch v := values[ExtendsKey].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, fmt.Errorf("must not be empty")
+		}
+		return []string{v}, nil
+	case []any:
+		files := make([]string, 0, len(v))
+		for _, item := range v {
+			file, ok := item.(string)
+			if !ok || file == "" {
+				return nil, fmt.Errorf("must list values file paths, got %v", item)
+			}
+			files = append(files, file)
+		}
+		return files, nil
+	default:
+		return nil, fmt.Errorf("must be a values file path or a list of paths")
+	}
+}
+
+// mergeValuesLayers deep-merges layers in order, following the merge
+// strategy annotations of every layer after the first. The annotations of
+// the first layer have nothing to apply to and are left for the caller to
+// strip.
+func mergeValuesLayers(layers []valuesLayer) (map[string]any, error) {
+	result := make(map[string]any)
+	for i, layer := range layers {
+		if i == 0 {
+			if layer.values != nil {
+				result = copyValue(layer.values, true).(map[string]any)
+			}
+			continue
+		}
+		if err := ValidateMergeStrategies(layer.values); err != nil {
+			return nil, fmt.Errorf("invalid merge strategy in values file %q: %w", layer.file, err)
+		}
+		mergeValues(result, layer.values)
+	}
+	return result, nil
+}
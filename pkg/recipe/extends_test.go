@@ -0,0 +1,240 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// valuesFiles is an in-memory values file reader for tests.
+type valuesFiles map[string]string
+
+func (f valuesFiles) read(path string) ([]byte, error) {
+	content, ok := f[path]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", path, fs.ErrNotExist)
+	}
+	return []byte(content), nil
+}
+
+func layerFiles(layers []valuesLayer) []string {
+	files := make([]string, len(layers))
+	for i, l := range layers {
+		files[i] = l.file
+	}
+	return files
+}
+
+func TestValuesLayers(t *testing.T) {
+	files := valuesFiles{
+		"components/gpu-operator/values.yaml":          "driver:\n  enabled: true\n",
+		"components/gpu-operator/values-h100.yaml":     "driver:\n  version: \"570\"\n",
+		"components/gpu-operator/values-eks.yaml":      "$extends: components/gpu-operator/values-h100.yaml\ncdi:\n  enabled: true\n",
+		"components/gpu-operator/values-gb200.yaml":    "$extends: components/gpu-operator/values.yaml\ndriver:\n  version: \"580\"\n",
+		"components/gpu-operator/values-eks-h100.yaml": "$extends:\n  - components/gpu-operator/values-h100.yaml\n  - components/gpu-operator/values-eks.yaml\n",
+		"components/gpu-operator/values-self.yaml":     "$extends: components/gpu-operator/values-self.yaml\n",
+		"components/gpu-operator/values-a.yaml":        "$extends: components/gpu-operator/values-b.yaml\n",
+		"components/gpu-operator/values-b.yaml":        "$extends: components/gpu-operator/values-a.yaml\n",
+		"components/gpu-operator/values-missing.yaml":  "$extends: components/gpu-operator/values-none.yaml\n",
+		"components/gpu-operator/values-invalid.yaml":  "$extends:\n  nested: map\n",
+		"components/other/values-eks.yaml":             "$extends: components/gpu-operator/values-eks.yaml\n",
+	}
+
+	tests := []struct {
+		name       string
+		valuesFile string
+		component  string
+		want       []string
+		wantErr    string
+	}{
+		{
+			name:       "no values file",
+			valuesFile: "",
+			want:       []string{},
+		},
+		{
+			name:       "base values file",
+			valuesFile: "components/gpu-operator/values.yaml",
+			want:       []string{"components/gpu-operator/values.yaml"},
+		},
+		{
+			name:       "overlay without extends",
+			valuesFile: "components/gpu-operator/values-h100.yaml",
+			want:       []string{"components/gpu-operator/values.yaml", "components/gpu-operator/values-h100.yaml"},
+		},
+		{
+			name:       "chain",
+			valuesFile: "components/gpu-operator/values-eks.yaml",
+			want: []string{
+				"components/gpu-operator/values.yaml",
+				"components/gpu-operator/values-h100.yaml",
+				"components/gpu-operator/values-eks.yaml",
+			},
+		},
+		{
+			name:       "shared ancestors appear once",
+			valuesFile: "components/gpu-operator/values-eks-h100.yaml",
+			want: []string{
+				"components/gpu-operator/values.yaml",
+				"components/gpu-operator/values-h100.yaml",
+				"components/gpu-operator/values-eks.yaml",
+				"components/gpu-operator/values-eks-h100.yaml",
+			},
+		},
+		{
+			name:       "extending the base values file",
+			valuesFile: "components/gpu-operator/values-gb200.yaml",
+			want:       []string{"components/gpu-operator/values.yaml", "components/gpu-operator/values-gb200.yaml"},
+		},
+		{
+			name:       "missing base values file",
+			valuesFile: "components/other/values-eks.yaml",
+			component:  "other",
+			want: []string{
+				"components/gpu-operator/values-h100.yaml",
+				"components/gpu-operator/values-eks.yaml",
+				"components/other/values-eks.yaml",
+			},
+		},
+		{
+			name:       "extends itself",
+			valuesFile: "components/gpu-operator/values-self.yaml",
+			wantErr:    "extends itself",
+		},
+		{
+			name:       "cycle",
+			valuesFile: "components/gpu-operator/values-a.yaml",
+			wantErr:    "extends itself",
+		},
+		{
+			name:       "missing ancestor",
+			valuesFile: "components/gpu-operator/values-missing.yaml",
+			wantErr:    "failed to read values file \"components/gpu-operator/values-none.yaml\"",
+		},
+		{
+			name:       "invalid extends",
+			valuesFile: "components/gpu-operator/values-invalid.yaml",
+			wantErr:    "invalid $extends",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component := tt.component
+			if component == "" {
+				component = "gpu-operator"
+			}
+			layers, err := valuesLayers(files.read, ComponentRef{Name: component, ValuesFile: tt.valuesFile})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("valuesLayers() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("valuesLayers() error = %v", err)
+			}
+			if got := layerFiles(layers); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("valuesLayers() = %v, want %v", got, tt.want)
+			}
+			for _, l := range layers {
+				if _, ok := l.values[ExtendsKey]; ok {
+					t.Errorf("layer %s still has %s", l.file, ExtendsKey)
+				}
+			}
+		})
+	}
+}
+
+func TestValuesLayers_MaxDepth(t *testing.T) {
+	files := valuesFiles{}
+	for i := range maxExtendsDepth + 1 {
+		files[fmt.Sprintf("components/c/values-%d.yaml", i)] = fmt.Sprintf("$extends: components/c/values-%d.yaml\n", i+1)
+	}
+	files[fmt.Sprintf("components/c/values-%d.yaml", maxExtendsDepth+1)] = "key: value\n"
+
+	_, err := valuesLayers(files.read, ComponentRef{Name: "c", ValuesFile: "components/c/values-0.yaml"})
+	if err == nil || !strings.Contains(err.Error(), "maximum") {
+		t.Errorf("valuesLayers() error = %v, want maximum depth error", err)
+	}
+}
+
+func TestMergeValuesLayers(t *testing.T) {
+	files := valuesFiles{
+		"components/gpu-operator/values.yaml": `
+driver:
+  enabled: true
+  version: "550"
+toolkit:
+  env:
+    - name: BASE
+`,
+		"components/gpu-operator/values-h100.yaml": `
+driver:
+  version: "570"
+toolkit:
+  $merge:
+    env: append-list
+  env:
+    - name: H100
+`,
+		"components/gpu-operator/values-eks-h100.yaml": `
+$extends: components/gpu-operator/values-h100.yaml
+driver:
+  version: "580"
+cdi:
+  enabled: true
+`,
+	}
+
+	layers, err := valuesLayers(files.read, ComponentRef{
+		Name:       "gpu-operator",
+		ValuesFile: "components/gpu-operator/values-eks-h100.yaml",
+	})
+	if err != nil {
+		t.Fatalf("valuesLayers() error = %v", err)
+	}
+	values, err := mergeValuesLayers(layers)
+	if err != nil {
+		t.Fatalf("mergeValuesLayers() error = %v", err)
+	}
+
+	want := map[string]any{
+		"driver": map[string]any{"enabled": true, "version": "580"},
+		"toolkit": map[string]any{
+			"env": []any{map[string]any{"name": "BASE"}, map[string]any{"name": "H100"}},
+		},
+		"cdi": map[string]any{"enabled": true},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("mergeValuesLayers() = %v, want %v", values, want)
+	}
+
+	// Merge strategies are validated in every extending layer
+	files["components/gpu-operator/values-h100.yaml"] = "driver:\n  $merge:\n    version: append-list\n  version: \"570\"\n"
+	layers, err = valuesLayers(files.read, ComponentRef{
+		Name:       "gpu-operator",
+		ValuesFile: "components/gpu-operator/values-eks-h100.yaml",
+	})
+	if err != nil {
+		t.Fatalf("valuesLayers() error = %v", err)
+	}
+	if _, err := mergeValuesLayers(layers); err == nil || !strings.Contains(err.Error(), "values-h100.yaml") {
+		t.Errorf("mergeValuesLayers() error = %v, want invalid merge strategy in values-h100.yaml", err)
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/NVIDIA/eidos/pkg/defaults"
 	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
@@ -39,12 +41,29 @@ var (
 // It supports GET requests with query parameters and POST requests with JSON/YAML body
 // to specify recipe criteria.
 // The response returns a RecipeResult with component references and constraints.
+// With compare=true on a GET request, the intent parameter takes a
+// comma-separated list of intents and the response is a RecipeComparison.
 // Errors are handled and returned in a structured format.
 func (b *Builder) HandleRecipes(w http.ResponseWriter, r *http.Request) {
 	// Add request-scoped timeout
 	ctx, cancel := context.WithTimeout(r.Context(), defaults.RecipeHandlerTimeout)
 	defer cancel()
 
+	if compareStr := r.URL.Query().Get("compare"); compareStr != "" {
+		compare, err := strconv.ParseBool(compareStr)
+		if err != nil {
+			server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
+				"Invalid compare parameter", false, map[string]any{
+					"error": err.Error(),
+				})
+			return
+		}
+		if compare {
+			b.handleRecipeComparison(ctx, w, r)
+			return
+		}
+	}
+
 	var criteria *Criteria
 	var err error
 
@@ -69,10 +88,7 @@ func (b *Builder) HandleRecipes(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err != nil {
-		server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
-			"Invalid recipe criteria", false, map[string]any{
-				"error": err.Error(),
-			})
+		server.WriteRequestBodyError(w, r, err, "Invalid recipe criteria")
 		return
 	}
 
@@ -88,6 +104,8 @@ func (b *Builder) HandleRecipes(w http.ResponseWriter, r *http.Request) {
 		"intent", criteria.Intent,
 		"os", criteria.OS,
 		"nodes", criteria.Nodes,
+		"scale", criteria.EffectiveScale(),
+		"partitioning", criteria.Partitioning,
 	)
 
 	// Validate criteria against allowlists (if configured)
@@ -98,14 +116,211 @@ func (b *Builder) HandleRecipes(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	recordRecipeRequest(criteria)
+
 	result, err := b.BuildFromCriteria(ctx, criteria)
 	if err != nil {
 		server.WriteErrorFromErr(w, r, err, "Failed to build recipe", nil)
 		return
 	}
 
+	recordOverlayMatches(result)
+
 	// Set caching headers
 	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(recipeCacheTTL.Seconds())))
 
+	// The recipe digest is a strong validator: identical content yields the same ETag
+	if digest, digestErr := result.Digest(); digestErr == nil {
+		etag := fmt.Sprintf("%q", digest)
+		w.Header().Set("ETag", etag)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	} else {
+		slog.Warn("failed to compute recipe digest", "error", digestErr)
+	}
+
+	serializer.RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleRecipeFromSnapshot processes POST requests with a snapshot, inline
+// or as a ConfigMap or Secret URI, and an optional intent. The criteria are extracted
+// from the snapshot and the response is the RecipeResult built from them,
+// with Metadata.CriteriaDetection listing where each criteria field came from.
+func (b *Builder) HandleRecipeFromSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), defaults.RecipeHandlerTimeout)
+	defer cancel()
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		server.WriteError(w, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Method not allowed", false, map[string]any{
+				"method":  r.Method,
+				"allowed": []string{"POST"},
+			})
+		return
+	}
+
+	req, err := ParseSnapshotRecipeRequest(http.MaxBytesReader(w, r.Body, maxSnapshotRequestSize),
+		r.Header.Get("Content-Type"))
+	if err != nil {
+		server.WriteRequestBodyError(w, r, err, "Invalid snapshot recipe request")
+		return
+	}
+
+	criteria, detection, err := req.Criteria()
+	if err != nil {
+		slog.Debug("failed to extract criteria from snapshot", "error", err)
+		server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
+			"Failed to extract criteria from snapshot", false, nil)
+		return
+	}
+
+	slog.Debug("criteria extracted from snapshot",
+		"criteria", criteria.String(),
+		"sources", len(detection.Sources),
+	)
+
+	if b.AllowLists != nil {
+		if validateErr := b.AllowLists.ValidateCriteria(criteria); validateErr != nil {
+			server.WriteErrorFromErr(w, r, validateErr, "Criteria value not allowed", nil)
+			return
+		}
+	}
+
+	recordRecipeRequest(criteria)
+
+	result, err := b.BuildFromCriteria(ctx, criteria)
+	if err != nil {
+		server.WriteErrorFromErr(w, r, err, "Failed to build recipe", nil)
+		return
+	}
+
+	recordOverlayMatches(result)
+
+	result.Metadata.CriteriaDetection = detection
 	serializer.RespondJSON(w, http.StatusOK, result)
 }
+
+// HandleOverlays lists the overlays of the recipe data as an OverlayCatalog.
+// Only GET is allowed. The optional criteria query parameters of GET
+// /v1/recipe (service, accelerator, intent, os, nodes) restrict the list to
+// overlays compatible with them.
+func (b *Builder) HandleOverlays(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), defaults.RecipeHandlerTimeout)
+	defer cancel()
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		server.WriteError(w, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Method not allowed", false, map[string]any{
+				"method":  r.Method,
+				"allowed": []string{"GET"},
+			})
+		return
+	}
+
+	var criteria *Criteria
+	if len(r.URL.Query()) > 0 {
+		var err error
+		criteria, err = ParseCriteriaFromRequest(r)
+		if err != nil {
+			server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
+				"Invalid recipe criteria", false, map[string]any{
+					"error": err.Error(),
+				})
+			return
+		}
+		if b.AllowLists != nil {
+			if validateErr := b.AllowLists.ValidateCriteria(criteria); validateErr != nil {
+				server.WriteErrorFromErr(w, r, validateErr, "Criteria value not allowed", nil)
+				return
+			}
+		}
+	}
+
+	overlays, err := GetAvailableOverlays(ctx, criteria)
+	if err != nil {
+		server.WriteErrorFromErr(w, r, err, "Failed to list overlays", nil)
+		return
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(recipeCacheTTL.Seconds())))
+	serializer.RespondJSON(w, http.StatusOK, &OverlayCatalog{Overlays: overlays})
+}
+
+// handleRecipeComparison builds one recipe per requested intent and responds
+// with a RecipeComparison. Only GET requests are supported.
+func (b *Builder) handleRecipeComparison(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		server.WriteError(w, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Recipe comparison only supports GET", false, map[string]any{
+				"method":  r.Method,
+				"allowed": []string{"GET"},
+			})
+		return
+	}
+
+	query := r.URL.Query()
+	intents, err := ParseCriteriaIntentTypes(query.Get("intent"))
+	if err == nil && len(intents) < 2 {
+		err = fmt.Errorf("at least two intents are required, got %q", query.Get("intent"))
+	}
+	if err != nil {
+		server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
+			"Invalid recipe criteria", false, map[string]any{
+				"error": err.Error(),
+			})
+		return
+	}
+
+	// Remaining criteria are shared by every intent
+	query.Del("intent")
+	criteria, err := ParseCriteriaFromValues(query)
+	if err != nil {
+		server.WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
+			"Invalid recipe criteria", false, map[string]any{
+				"error": err.Error(),
+			})
+		return
+	}
+
+	for _, intent := range intents {
+		c := *criteria
+		c.Intent = intent
+		if b.AllowLists != nil {
+			if validateErr := b.AllowLists.ValidateCriteria(&c); validateErr != nil {
+				server.WriteErrorFromErr(w, r, validateErr, "Criteria value not allowed", nil)
+				return
+			}
+		}
+		recordRecipeRequest(&c)
+	}
+
+	comparison, err := b.BuildComparison(ctx, criteria, intents)
+	if err != nil {
+		server.WriteErrorFromErr(w, r, err, "Failed to build recipe comparison", nil)
+		return
+	}
+
+	for _, ir := range comparison.Recipes {
+		recordOverlayMatches(ir.Recipe)
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(recipeCacheTTL.Seconds())))
+	serializer.RespondJSON(w, http.StatusOK, comparison)
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag.
+// The header may list several entity tags, including weak ones, or "*".
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
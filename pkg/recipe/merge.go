@@ -0,0 +1,324 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// MergeStrategiesKey is the reserved values key that annotates how the
+// sibling keys of a map are merged onto the values they override:
+//
+//	daemonsets:
+//	  $merge:
+//	    tolerations: replace
+//	  tolerations:
+//	    - operator: Exists
+//	toolkit:
+//	  $merge:
+//	    env: merge-by-key
+//	  env:
+//	    - name: CONTAINERD_RUNTIME_CLASS
+//	      value: nvidia
+//
+// Annotations are honored in values files, inline overrides and overlay
+// overrides, and never appear in resolved component values.
+const MergeStrategiesKey = "$merge"
+
+// MergeStrategy is how an overriding value is merged onto the value it overrides.
+type MergeStrategy string
+
+const (
+	// MergeStrategyMerge deep-merges maps and replaces other values. It is
+	// the default for keys without an annotation.
+	MergeStrategyMerge MergeStrategy = "merge"
+
+	// MergeStrategyReplace replaces the overridden value entirely, including maps.
+	MergeStrategyReplace MergeStrategy = "replace"
+
+	// MergeStrategyAppendList appends the overriding list to the overridden
+	// list. The key must hold a list.
+	MergeStrategyAppendList MergeStrategy = "append-list"
+
+	// MergeStrategyDelete removes the key from the merged values. The key
+	// must be absent or null.
+	MergeStrategyDelete MergeStrategy = "delete"
+
+	// MergeStrategyMergeByKey deep-merges each overriding list item into the
+	// overridden item with the same key field value and appends the others,
+	// so named items such as env vars can be changed without restating the
+	// list. The key field is "name"; "merge-by-key:<field>" selects another,
+	// such as "merge-by-key:key" for tolerations. The key must hold a list of
+	// maps that all have the key field.
+	MergeStrategyMergeByKey MergeStrategy = "merge-by-key"
+)
+
+// defaultMergeKeyField is the key field of MergeStrategyMergeByKey.
+const defaultMergeKeyField = "name"
+
+// MergeStrategies lists the supported merge strategies.
+var MergeStrategies = []MergeStrategy{
+	MergeStrategyMerge,
+	MergeStrategyReplace,
+	MergeStrategyAppendList,
+	MergeStrategyDelete,
+	MergeStrategyMergeByKey,
+}
+
+// mergeKeyField returns the key field of a MergeStrategyMergeByKey strategy,
+// which is either "merge-by-key" or "merge-by-key:<field>".
+func (s MergeStrategy) mergeKeyField() (string, bool) {
+	if s == MergeStrategyMergeByKey {
+		return defaultMergeKeyField, true
+	}
+	field, ok := strings.CutPrefix(string(s), string(MergeStrategyMergeByKey)+":")
+	return field, ok && field != ""
+}
+
+// ValidateMergeStrategies checks the merge strategy annotations in values:
+// each annotation must name a known strategy, and the annotated key must
+// hold a value the strategy applies to.
+func ValidateMergeStrategies(values map[string]any) error {
+	return validateMergeStrategies(values, "")
+}
+
+func validateMergeStrategies(values map[string]any, prefix string) error {
+	strategies, err := mergeStrategies(values, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(strategies)) {
+		path := joinValuePath(prefix, key)
+		value, exists := values[key]
+		if field, ok := strategies[key].mergeKeyField(); ok {
+			if err := validateMergeByKey(value, field, path); err != nil {
+				return err
+			}
+			continue
+		}
+		switch strategies[key] {
+		case MergeStrategyMerge:
+			if _, ok := value.(map[string]any); exists && value != nil && !ok {
+				return fmt.Errorf("%s: merge strategy %q requires a map, use %q or %q for other values",
+					path, MergeStrategyMerge, MergeStrategyReplace, MergeStrategyAppendList)
+			}
+		case MergeStrategyReplace:
+			if !exists {
+				return fmt.Errorf("%s: merge strategy %q requires a value, use %q to remove the key",
+					path, MergeStrategyReplace, MergeStrategyDelete)
+			}
+		case MergeStrategyAppendList:
+			if _, ok := value.([]any); !ok {
+				return fmt.Errorf("%s: merge strategy %q requires a list", path, MergeStrategyAppendList)
+			}
+		case MergeStrategyDelete:
+			if value != nil {
+				return fmt.Errorf("%s: merge strategy %q must not have a value", path, MergeStrategyDelete)
+			}
+		}
+	}
+
+	for key, value := range values {
+		if nested, ok := value.(map[string]any); ok && key != MergeStrategiesKey {
+			if err := validateMergeStrategies(nested, joinValuePath(prefix, key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateMergeByKey checks that value is a list of maps with the key field.
+func validateMergeByKey(value any, field, path string) error {
+	list, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("%s: merge strategy %q requires a list", path, MergeStrategyMergeByKey)
+	}
+	for i, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s[%d]: merge strategy %q requires list items to be maps", path, i, MergeStrategyMergeByKey)
+		}
+		if _, ok := m[field]; !ok {
+			return fmt.Errorf("%s[%d]: merge strategy %q requires the key field %q", path, i, MergeStrategyMergeByKey, field)
+		}
+	}
+	return nil
+}
+
+// mergeStrategies returns the merge strategy annotations of a values map.
+func mergeStrategies(values map[string]any, prefix string) (map[string]MergeStrategy, error) {
+	raw, exists := values[MergeStrategiesKey]
+	if !exists {
+		return nil, nil
+	}
+	annotations, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: must map keys to merge strategies", joinValuePath(prefix, MergeStrategiesKey))
+	}
+
+	strategies := make(map[string]MergeStrategy, len(annotations))
+	for key, v := range annotations {
+		name, _ := v.(string)
+		strategy := MergeStrategy(name)
+		if _, byKey := strategy.mergeKeyField(); !byKey && !slices.Contains(MergeStrategies, strategy) {
+			names := make([]string, len(MergeStrategies))
+			for i, s := range MergeStrategies {
+				names[i] = string(s)
+			}
+			return nil, fmt.Errorf("%s: unknown merge strategy %v, must be one of %s",
+				joinValuePath(prefix, key), v, strings.Join(names, ", "))
+		}
+		strategies[key] = strategy
+	}
+	return strategies, nil
+}
+
+// mergeValues merges src into dst following the merge strategy annotations
+// in src. Annotations are dropped, and values taken from src are copied so
+// dst never shares maps or lists with it. src must have passed
+// ValidateMergeStrategies.
+func mergeValues(dst, src map[string]any) {
+	mergeWithStrategies(dst, src, false)
+}
+
+// mergeOverrides merges overlay overrides src into dst like mergeValues, but
+// keeps the annotations of both so they still apply when the merged
+// overrides are applied to the component values.
+func mergeOverrides(dst, src map[string]any) {
+	mergeWithStrategies(dst, src, true)
+}
+
+func mergeWithStrategies(dst, src map[string]any, keep bool) {
+	strategies, _ := mergeStrategies(src, "")
+
+	for key, srcVal := range src {
+		if key == MergeStrategiesKey {
+			continue
+		}
+		if field, ok := strategies[key].mergeKeyField(); ok {
+			srcList, _ := srcVal.([]any)
+			dstList, _ := dst[key].([]any)
+			dst[key] = mergeListByKey(dstList, srcList, field, keep)
+			continue
+		}
+		switch strategies[key] {
+		case MergeStrategyReplace:
+			dst[key] = copyValue(srcVal, keep)
+		case MergeStrategyAppendList:
+			srcList, _ := srcVal.([]any)
+			dstList, _ := dst[key].([]any)
+			dst[key] = append(slices.Clone(dstList), copyValue(srcList, keep).([]any)...)
+		case MergeStrategyDelete:
+			// Removed below, so keys listed only in the annotation are deleted too.
+		default:
+			dstMap, dstOK := dst[key].(map[string]any)
+			srcMap, srcOK := srcVal.(map[string]any)
+			if dstOK && srcOK {
+				mergeWithStrategies(dstMap, srcMap, keep)
+				continue
+			}
+			dst[key] = copyValue(srcVal, keep)
+		}
+	}
+
+	for key, strategy := range strategies {
+		if strategy == MergeStrategyDelete {
+			delete(dst, key)
+		}
+	}
+
+	if keep && len(strategies) > 0 {
+		merged, _ := dst[MergeStrategiesKey].(map[string]any)
+		merged = maps.Clone(merged)
+		if merged == nil {
+			merged = make(map[string]any, len(strategies))
+		}
+		for key, strategy := range strategies {
+			merged[key] = string(strategy)
+		}
+		dst[MergeStrategiesKey] = merged
+	}
+}
+
+// mergeListByKey returns dst with each map item of src deep-merged into the
+// first item of dst with the same field value, and the other items of src
+// appended. dst is not modified.
+func mergeListByKey(dst, src []any, field string, keep bool) []any {
+	merged := copyValue(dst, true).([]any)
+	for _, srcItem := range src {
+		srcMap, _ := srcItem.(map[string]any)
+		matched := false
+		for _, dstItem := range merged {
+			dstMap, ok := dstItem.(map[string]any)
+			if ok && srcMap != nil && reflect.DeepEqual(dstMap[field], srcMap[field]) {
+				mergeWithStrategies(dstMap, srcMap, keep)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			merged = append(merged, copyValue(srcItem, keep))
+		}
+	}
+	return merged
+}
+
+// copyValue deep-copies maps and lists in v, dropping merge strategy
+// annotations unless keep is set.
+func copyValue(v any, keep bool) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			if k == MergeStrategiesKey && !keep {
+				continue
+			}
+			out[k] = copyValue(item, keep)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = copyValue(item, keep)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// stripMergeStrategies removes merge strategy annotations from values in place.
+func stripMergeStrategies(values map[string]any) {
+	delete(values, MergeStrategiesKey)
+	for _, v := range values {
+		if nested, ok := v.(map[string]any); ok {
+			stripMergeStrategies(nested)
+		}
+	}
+}
+
+// joinValuePath joins a dotted values path and a key.
+func joinValuePath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,361 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeValues_Strategies(t *testing.T) {
+	tolerations := func(keys ...string) []any {
+		list := make([]any, len(keys))
+		for i, k := range keys {
+			list[i] = map[string]any{"key": k, "operator": "Exists"}
+		}
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		base    map[string]any
+		overlay map[string]any
+		want    map[string]any
+	}{
+		{
+			name:    "lists are replaced by default",
+			base:    map[string]any{"tolerations": tolerations("a", "b")},
+			overlay: map[string]any{"tolerations": tolerations("c")},
+			want:    map[string]any{"tolerations": tolerations("c")},
+		},
+		{
+			name: "append-list",
+			base: map[string]any{"tolerations": tolerations("a", "b")},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"tolerations": "append-list"},
+				"tolerations":      tolerations("c"),
+			},
+			want: map[string]any{"tolerations": tolerations("a", "b", "c")},
+		},
+		{
+			name: "append-list onto missing key",
+			base: map[string]any{},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"tolerations": "append-list"},
+				"tolerations":      tolerations("c"),
+			},
+			want: map[string]any{"tolerations": tolerations("c")},
+		},
+		{
+			name: "replace map",
+			base: map[string]any{"resources": map[string]any{"limits": map[string]any{"cpu": "1"}, "requests": map[string]any{"cpu": "1"}}},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"resources": "replace"},
+				"resources":        map[string]any{"limits": map[string]any{"cpu": "2"}},
+			},
+			want: map[string]any{"resources": map[string]any{"limits": map[string]any{"cpu": "2"}}},
+		},
+		{
+			name: "explicit merge",
+			base: map[string]any{"driver": map[string]any{"version": "1", "enabled": true}},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"driver": "merge"},
+				"driver":           map[string]any{"version": "2"},
+			},
+			want: map[string]any{"driver": map[string]any{"version": "2", "enabled": true}},
+		},
+		{
+			name: "delete",
+			base: map[string]any{"driver": map[string]any{"version": "1"}, "gds": map[string]any{"enabled": true}},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"gds": "delete"},
+			},
+			want: map[string]any{"driver": map[string]any{"version": "1"}},
+		},
+		{
+			name: "nested annotation",
+			base: map[string]any{"daemonsets": map[string]any{"tolerations": tolerations("a"), "priorityClassName": "high"}},
+			overlay: map[string]any{
+				"daemonsets": map[string]any{
+					MergeStrategiesKey: map[string]any{"tolerations": "replace"},
+					"tolerations":      []any{},
+				},
+			},
+			want: map[string]any{"daemonsets": map[string]any{"tolerations": []any{}, "priorityClassName": "high"}},
+		},
+		{
+			name: "merge-by-key on name",
+			base: map[string]any{"env": []any{
+				map[string]any{"name": "A", "value": "1"},
+				map[string]any{"name": "B", "value": "2", "valueFrom": nil},
+			}},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"env": "merge-by-key"},
+				"env": []any{
+					map[string]any{"name": "B", "value": "3"},
+					map[string]any{"name": "C", "value": "4"},
+				},
+			},
+			want: map[string]any{"env": []any{
+				map[string]any{"name": "A", "value": "1"},
+				map[string]any{"name": "B", "value": "3", "valueFrom": nil},
+				map[string]any{"name": "C", "value": "4"},
+			}},
+		},
+		{
+			name: "merge-by-key on another field",
+			base: map[string]any{"tolerations": tolerations("a", "b")},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"tolerations": "merge-by-key:key"},
+				"tolerations": []any{
+					map[string]any{"key": "b", "effect": "NoSchedule"},
+					map[string]any{"key": "c", "operator": "Exists"},
+				},
+			},
+			want: map[string]any{"tolerations": []any{
+				map[string]any{"key": "a", "operator": "Exists"},
+				map[string]any{"key": "b", "operator": "Exists", "effect": "NoSchedule"},
+				map[string]any{"key": "c", "operator": "Exists"},
+			}},
+		},
+		{
+			name: "merge-by-key onto missing key",
+			base: map[string]any{},
+			overlay: map[string]any{
+				MergeStrategiesKey: map[string]any{"tolerations": "merge-by-key:key"},
+				"tolerations":      tolerations("a"),
+			},
+			want: map[string]any{"tolerations": tolerations("a")},
+		},
+		{
+			name: "annotations in added maps are dropped",
+			base: map[string]any{},
+			overlay: map[string]any{
+				"daemonsets": map[string]any{
+					MergeStrategiesKey: map[string]any{"tolerations": "replace"},
+					"tolerations":      tolerations("a"),
+				},
+			},
+			want: map[string]any{"daemonsets": map[string]any{"tolerations": tolerations("a")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateMergeStrategies(tt.overlay); err != nil {
+				t.Fatalf("ValidateMergeStrategies() error = %v", err)
+			}
+			mergeValues(tt.base, tt.overlay)
+			if !reflect.DeepEqual(tt.base, tt.want) {
+				t.Errorf("mergeValues() = %v, want %v", tt.base, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeValues_CopiesSource(t *testing.T) {
+	src := map[string]any{"driver": map[string]any{"env": []any{"A=1"}}}
+	dst := map[string]any{}
+	mergeValues(dst, src)
+
+	dst["driver"].(map[string]any)["env"].([]any)[0] = "B=2"
+	dst["driver"].(map[string]any)["version"] = "2"
+
+	want := map[string]any{"driver": map[string]any{"env": []any{"A=1"}}}
+	if !reflect.DeepEqual(src, want) {
+		t.Errorf("source modified through merged values: %v", src)
+	}
+}
+
+func TestValidateMergeStrategies(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]any
+		wantErr string
+	}{
+		{name: "no annotations", values: map[string]any{"a": 1}},
+		{name: "nil values"},
+		{
+			name:    "unknown strategy",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "prepend"}, "a": []any{}},
+			wantErr: `a: unknown merge strategy prepend`,
+		},
+		{
+			name:    "annotation not a map",
+			values:  map[string]any{MergeStrategiesKey: "replace"},
+			wantErr: "$merge: must map keys to merge strategies",
+		},
+		{
+			name:    "append-list on map",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "append-list"}, "a": map[string]any{}},
+			wantErr: "requires a list",
+		},
+		{
+			name:    "merge on list",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "merge"}, "a": []any{}},
+			wantErr: "requires a map",
+		},
+		{
+			name:    "replace without value",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "replace"}},
+			wantErr: "requires a value",
+		},
+		{
+			name:    "delete with value",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "delete"}, "a": 1},
+			wantErr: "must not have a value",
+		},
+		{
+			name: "nested error reports path",
+			values: map[string]any{"daemonsets": map[string]any{
+				MergeStrategiesKey: map[string]any{"tolerations": "append-list"},
+				"tolerations":      "none",
+			}},
+			wantErr: "daemonsets.tolerations: merge strategy",
+		},
+		{
+			name: "merge-by-key",
+			values: map[string]any{
+				MergeStrategiesKey: map[string]any{"env": "merge-by-key", "tolerations": "merge-by-key:key"},
+				"env":              []any{map[string]any{"name": "A"}},
+				"tolerations":      []any{map[string]any{"key": "a"}},
+			},
+		},
+		{
+			name:    "merge-by-key on map",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "merge-by-key"}, "a": map[string]any{}},
+			wantErr: "requires a list",
+		},
+		{
+			name:    "merge-by-key item without key field",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "merge-by-key:key"}, "a": []any{map[string]any{"name": "x"}}},
+			wantErr: `a[0]: merge strategy "merge-by-key" requires the key field "key"`,
+		},
+		{
+			name:    "merge-by-key item not a map",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "merge-by-key"}, "a": []any{"x"}},
+			wantErr: "requires list items to be maps",
+		},
+		{
+			name:    "merge-by-key without field",
+			values:  map[string]any{MergeStrategiesKey: map[string]any{"a": "merge-by-key:"}, "a": []any{}},
+			wantErr: "unknown merge strategy",
+		},
+		{
+			name:   "delete with null",
+			values: map[string]any{MergeStrategiesKey: map[string]any{"a": "delete"}, "a": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMergeStrategies(tt.values)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateMergeStrategies() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateMergeStrategies() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMergeComponentRef_OverrideStrategies(t *testing.T) {
+	base := ComponentRef{
+		Name: "gpu-operator",
+		Overrides: map[string]any{
+			"driver":      map[string]any{"version": "1", "enabled": true},
+			"tolerations": []any{"a"},
+		},
+	}
+	overlay := ComponentRef{
+		Name: "gpu-operator",
+		Overrides: map[string]any{
+			MergeStrategiesKey: map[string]any{"tolerations": "append-list"},
+			"driver":           map[string]any{"version": "2"},
+			"tolerations":      []any{"b"},
+		},
+	}
+
+	merged := mergeComponentRef(base, overlay)
+
+	want := map[string]any{
+		MergeStrategiesKey: map[string]any{"tolerations": "append-list"},
+		"driver":           map[string]any{"version": "2", "enabled": true},
+		"tolerations":      []any{"a", "b"},
+	}
+	if !reflect.DeepEqual(merged.Overrides, want) {
+		t.Errorf("Overrides = %v, want %v", merged.Overrides, want)
+	}
+	if _, ok := base.Overrides[MergeStrategiesKey]; ok || len(base.Overrides["tolerations"].([]any)) != 1 {
+		t.Errorf("base overrides modified: %v", base.Overrides)
+	}
+}
+
+func TestGetValuesForComponent_MergeStrategies(t *testing.T) {
+	newRecipe := func(overrides map[string]any) *RecipeResult {
+		return &RecipeResult{
+			ComponentRefs: []ComponentRef{{
+				Name:       "gpu-operator",
+				ValuesFile: "components/gpu-operator/values.yaml",
+				Overrides:  overrides,
+			}},
+		}
+	}
+
+	values, err := newRecipe(map[string]any{
+		MergeStrategiesKey: map[string]any{"migManager": "delete"},
+		"toolkit": map[string]any{
+			MergeStrategiesKey: map[string]any{"env": "append-list"},
+			"env":              []any{map[string]any{"name": "EXTRA", "value": "1"}},
+		},
+		"operator": map[string]any{
+			MergeStrategiesKey: map[string]any{"resources": "replace"},
+			"resources":        map[string]any{"limits": map[string]any{"cpu": "1"}},
+		},
+	}).GetValuesForComponent("gpu-operator")
+	if err != nil {
+		t.Fatalf("GetValuesForComponent() error = %v", err)
+	}
+
+	if _, ok := values["migManager"]; ok {
+		t.Error("migManager not deleted")
+	}
+	if env := values["toolkit"].(map[string]any)["env"].([]any); len(env) != 3 {
+		t.Errorf("toolkit.env has %d entries, want base 2 plus 1 appended", len(env))
+	}
+	operator := values["operator"].(map[string]any)
+	if _, ok := operator["resources"].(map[string]any)["requests"]; ok {
+		t.Error("operator.resources not replaced")
+	}
+	if operator["upgradeCRD"] != true {
+		t.Error("operator.upgradeCRD lost, operator should still be merged")
+	}
+	if _, ok := values["toolkit"].(map[string]any)[MergeStrategiesKey]; ok {
+		t.Error("merge strategy annotations leaked into values")
+	}
+
+	_, err = newRecipe(map[string]any{
+		MergeStrategiesKey: map[string]any{"driver": "append-list"},
+		"driver":           map[string]any{},
+	}).GetValuesForComponent("gpu-operator")
+	if err == nil || !strings.Contains(err.Error(), "invalid merge strategy") {
+		t.Errorf("GetValuesForComponent() error = %v, want invalid merge strategy", err)
+	}
+}
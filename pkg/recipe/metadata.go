@@ -17,7 +17,9 @@ package recipe
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 )
 
 // ComponentType represents the type of component deployment.
@@ -29,6 +31,40 @@ const (
 	ComponentTypeKustomize ComponentType = "Kustomize"
 )
 
+// ParseComponentType parses a string into a ComponentType.
+// Matching is case-insensitive, so "helm" and "Helm" are equivalent.
+func ParseComponentType(s string) (ComponentType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "helm":
+		return ComponentTypeHelm, nil
+	case "kustomize":
+		return ComponentTypeKustomize, nil
+	default:
+		return "", fmt.Errorf("invalid component type: %s", s)
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t ComponentType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It normalizes the
+// casing of known types; an empty value is left unset so registry
+// defaults still apply.
+func (t *ComponentType) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = ""
+		return nil
+	}
+	parsed, err := ParseComponentType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
 // Constraint represents a deployment constraint/assumption.
 type Constraint struct {
 	// Name is the constraint identifier (e.g., "k8s", "worker-os").
@@ -36,6 +72,23 @@ type Constraint struct {
 
 	// Value is the constraint expression (e.g., ">= 1.30", "ubuntu").
 	Value string `json:"value" yaml:"value"`
+
+	// Remediation describes how to fix a cluster failing the constraint.
+	// When unset, the validator derives one from the constraint name.
+	Remediation *Remediation `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+}
+
+// Remediation describes how to fix a cluster failing a constraint.
+type Remediation struct {
+	// Description says what to change (e.g., "Drain the node and reset the GPU").
+	Description string `json:"description" yaml:"description"`
+
+	// Commands are shell commands applying the fix on the affected nodes.
+	// Empty when the fix needs manual action.
+	Commands []string `json:"commands,omitempty" yaml:"commands,omitempty"`
+
+	// RebootRequired is set when the fix takes effect after a node reboot.
+	RebootRequired bool `json:"rebootRequired,omitempty" yaml:"rebootRequired,omitempty"`
 }
 
 // ComponentRef represents a reference to a deployable component.
@@ -43,6 +96,14 @@ type ComponentRef struct {
 	// Name is the unique identifier for this component.
 	Name string `json:"name" yaml:"name"`
 
+	// Component is the registry component this reference deploys. It defaults
+	// to Name; set it to run several instances of one component under
+	// distinct names (e.g. two network-operator instances for separate NIC pools).
+	Component string `json:"component,omitempty" yaml:"component,omitempty"`
+
+	// ReleaseName is the Helm release name. It defaults to Name.
+	ReleaseName string `json:"releaseName,omitempty" yaml:"releaseName,omitempty"`
+
 	// Type is the deployment type (Helm, Kustomize).
 	Type ComponentType `json:"type" yaml:"type"`
 
@@ -60,6 +121,8 @@ type ComponentRef struct {
 
 	// Overrides contains inline values that override those from ValuesFile.
 	// Merge order: base values → ValuesFile → Overrides (highest precedence).
+	// Keys are deep-merged unless a MergeStrategiesKey annotation selects
+	// another strategy.
 	Overrides map[string]any `json:"overrides,omitempty" yaml:"overrides,omitempty"`
 
 	// Patches is a list of patch files to apply (for Kustomize).
@@ -75,6 +138,40 @@ type ComponentRef struct {
 
 	// Path is the path within the repository to the kustomization (for Kustomize).
 	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// Enabled controls whether the component is deployed. A nil value means
+	// enabled, so existing recipes are unaffected. Disabled components stay in
+	// the recipe but are excluded from deployment order and bundle output.
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// DocsURL links the upstream install documentation for the deployed
+	// version. It defaults to the registry's docs URL.
+	DocsURL string `json:"docsURL,omitempty" yaml:"docsURL,omitempty"`
+
+	// SupportMatrixURL links the upstream support matrix for the deployed
+	// version. It defaults to the registry's support matrix.
+	SupportMatrixURL string `json:"supportMatrixURL,omitempty" yaml:"supportMatrixURL,omitempty"`
+}
+
+// ComponentName returns the registry component name for this reference.
+func (ref *ComponentRef) ComponentName() string {
+	if ref.Component != "" {
+		return ref.Component
+	}
+	return ref.Name
+}
+
+// HelmReleaseName returns the Helm release name for this reference.
+func (ref *ComponentRef) HelmReleaseName() string {
+	if ref.ReleaseName != "" {
+		return ref.ReleaseName
+	}
+	return ref.Name
+}
+
+// IsEnabled reports whether the component should be deployed.
+func (ref *ComponentRef) IsEnabled() bool {
+	return ref.Enabled == nil || *ref.Enabled
 }
 
 // ApplyRegistryDefaults fills in ComponentRef fields from ComponentConfig defaults.
@@ -110,6 +207,18 @@ func (ref *ComponentRef) ApplyRegistryDefaults(config *ComponentConfig) {
 			ref.Path = config.Kustomize.DefaultPath
 		}
 	}
+
+	// Link the documentation of the version deployed
+	version := ref.Version
+	if ref.Type == ComponentTypeKustomize {
+		version = ref.Tag
+	}
+	if ref.DocsURL == "" {
+		ref.DocsURL = resolveDocsURL(config.Docs.URL, version)
+	}
+	if ref.SupportMatrixURL == "" {
+		ref.SupportMatrixURL = resolveDocsURL(config.Docs.SupportMatrix, version)
+	}
 }
 
 // RecipeMetadataSpec contains the specification for a recipe.
@@ -140,9 +249,13 @@ type RecipeMetadataHeader struct {
 	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
 
 	// Metadata contains the name and other metadata.
-	Metadata struct {
-		Name string `json:"name" yaml:"name"`
-	} `json:"metadata" yaml:"metadata"`
+	Metadata ObjectMeta `json:"metadata" yaml:"metadata"`
+}
+
+// ObjectMeta is the metadata of Kubernetes-style recipe resources.
+type ObjectMeta struct {
+	// Name is the unique identifier of the resource.
+	Name string `json:"name" yaml:"name"`
 }
 
 // RecipeMetadata represents a recipe definition (base or overlay).
@@ -154,10 +267,15 @@ type RecipeMetadata struct {
 }
 
 // ConstraintWarning represents a warning about an overlay that matched criteria
-// but was excluded due to failing constraint validation against the snapshot.
+// but was excluded due to failing constraint validation against the snapshot,
+// or about a component that is incompatible with the target Kubernetes version.
 type ConstraintWarning struct {
 	// Overlay is the name of the overlay that was excluded.
-	Overlay string `json:"overlay" yaml:"overlay"`
+	Overlay string `json:"overlay,omitempty" yaml:"overlay,omitempty"`
+
+	// Component is the name of the incompatible component, for warnings
+	// produced by the compatibility checker.
+	Component string `json:"component,omitempty" yaml:"component,omitempty"`
 
 	// Constraint is the name of the constraint that failed.
 	Constraint string `json:"constraint" yaml:"constraint"`
@@ -172,6 +290,50 @@ type ConstraintWarning struct {
 	Reason string `json:"reason" yaml:"reason"`
 }
 
+// ComponentWarning explains why a component was changed from what the
+// overlays specified, such as being disabled because the snapshot shows it
+// is already installed on the cluster.
+type ComponentWarning struct {
+	// Component is the name of the affected component.
+	Component string `json:"component" yaml:"component"`
+
+	// Reason explains what was changed and why.
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// RecipeResultMetadata describes how a RecipeResult was produced.
+type RecipeResultMetadata struct {
+	// Version is the recipe version (CLI version that generated this recipe).
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// AppliedOverlays lists the overlay names in order of application.
+	AppliedOverlays []string `json:"appliedOverlays,omitempty" yaml:"appliedOverlays,omitempty"`
+
+	// ExcludedOverlays lists overlays that matched criteria but were excluded
+	// due to failing constraint validation against the snapshot.
+	// Only populated when a snapshot is provided during recipe generation.
+	ExcludedOverlays []string `json:"excludedOverlays,omitempty" yaml:"excludedOverlays,omitempty"`
+
+	// ConstraintWarnings contains details about why specific overlays were excluded.
+	// Helps users understand why certain environment-specific configurations
+	// were not applied and what would need to change to include them.
+	// It also lists components flagged as incompatible with the target
+	// Kubernetes version (see pkg/compat).
+	ConstraintWarnings []ConstraintWarning `json:"constraintWarnings,omitempty" yaml:"constraintWarnings,omitempty"`
+
+	// DisabledComponents lists components marked enabled: false.
+	// They remain in ComponentRefs but are skipped during deployment.
+	DisabledComponents []string `json:"disabledComponents,omitempty" yaml:"disabledComponents,omitempty"`
+
+	// ComponentWarnings lists components that were adjusted based on the
+	// snapshot, e.g. disabled because they are already installed.
+	ComponentWarnings []ComponentWarning `json:"componentWarnings,omitempty" yaml:"componentWarnings,omitempty"`
+
+	// CriteriaDetection records where the criteria came from when they were
+	// extracted from a snapshot by the recipe API.
+	CriteriaDetection *CriteriaDetection `json:"criteriaDetection,omitempty" yaml:"criteriaDetection,omitempty"`
+}
+
 // RecipeResult represents the final merged recipe output.
 type RecipeResult struct {
 	// Kind is always "recipeResult".
@@ -181,23 +343,7 @@ type RecipeResult struct {
 	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
 
 	// Metadata contains result metadata.
-	Metadata struct {
-		// Version is the recipe version (CLI version that generated this recipe).
-		Version string `json:"version,omitempty" yaml:"version,omitempty"`
-
-		// AppliedOverlays lists the overlay names in order of application.
-		AppliedOverlays []string `json:"appliedOverlays,omitempty" yaml:"appliedOverlays,omitempty"`
-
-		// ExcludedOverlays lists overlays that matched criteria but were excluded
-		// due to failing constraint validation against the snapshot.
-		// Only populated when a snapshot is provided during recipe generation.
-		ExcludedOverlays []string `json:"excludedOverlays,omitempty" yaml:"excludedOverlays,omitempty"`
-
-		// ConstraintWarnings contains details about why specific overlays were excluded.
-		// Helps users understand why certain environment-specific configurations
-		// were not applied and what would need to change to include them.
-		ConstraintWarnings []ConstraintWarning `json:"constraintWarnings,omitempty" yaml:"constraintWarnings,omitempty"`
-	} `json:"metadata" yaml:"metadata"`
+	Metadata RecipeResultMetadata `json:"metadata" yaml:"metadata"`
 
 	// Criteria is the input criteria used to generate this result.
 	Criteria *Criteria `json:"criteria" yaml:"criteria"`
@@ -211,6 +357,92 @@ type RecipeResult struct {
 	// DeploymentOrder is the topologically sorted component names for deployment.
 	// Components should be deployed in this order to satisfy dependencies.
 	DeploymentOrder []string `json:"deploymentOrder" yaml:"deploymentOrder"`
+
+	// Explain records which recipe data file set each value. It is only
+	// populated when the recipe is built with explain enabled.
+	Explain *RecipeExplanation `json:"explain,omitempty" yaml:"explain,omitempty"`
+}
+
+// ComponentDocs is the documentation linked for a component in a recipe.
+type ComponentDocs struct {
+	// Name is the component name.
+	Name string `json:"name" yaml:"name"`
+
+	// Version is the deployed version (chart version or Kustomize tag).
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// DocsURL links the install documentation.
+	DocsURL string `json:"docsURL,omitempty" yaml:"docsURL,omitempty"`
+
+	// SupportMatrixURL links the support matrix.
+	SupportMatrixURL string `json:"supportMatrixURL,omitempty" yaml:"supportMatrixURL,omitempty"`
+}
+
+// ComponentDocs returns the documentation links of the components that have
+// any, in deployment order followed by the components not in it.
+func (r *RecipeResult) ComponentDocs() []ComponentDocs {
+	var docs []ComponentDocs
+	add := func(ref *ComponentRef) {
+		if ref == nil || (ref.DocsURL == "" && ref.SupportMatrixURL == "") {
+			return
+		}
+		version := ref.Version
+		if version == "" {
+			version = ref.Tag
+		}
+		docs = append(docs, ComponentDocs{
+			Name:             ref.Name,
+			Version:          version,
+			DocsURL:          ref.DocsURL,
+			SupportMatrixURL: ref.SupportMatrixURL,
+		})
+	}
+
+	for _, name := range r.DeploymentOrder {
+		add(r.GetComponentRef(name))
+	}
+	for i := range r.ComponentRefs {
+		if !slices.Contains(r.DeploymentOrder, r.ComponentRefs[i].Name) {
+			add(&r.ComponentRefs[i])
+		}
+	}
+	return docs
+}
+
+// ComponentConstraintWarnings returns the constraint warnings that concern a
+// component rather than an excluded overlay, such as incompatibilities with
+// the target Kubernetes version.
+func (r *RecipeResult) ComponentConstraintWarnings() []ConstraintWarning {
+	var warnings []ConstraintWarning
+	for _, w := range r.Metadata.ConstraintWarnings {
+		if w.Component != "" {
+			warnings = append(warnings, w)
+		}
+	}
+	return warnings
+}
+
+// WithoutDisabledComponents returns a shallow copy of the result containing only
+// enabled components, with DeploymentOrder filtered to match and
+// Metadata.DisabledComponents listing what was dropped. It fails if an enabled
+// component depends on a disabled one.
+func (r *RecipeResult) WithoutDisabledComponents() (*RecipeResult, error) {
+	spec := RecipeMetadataSpec{ComponentRefs: r.ComponentRefs}
+	if err := spec.ValidateDependencies(); err != nil {
+		return nil, err
+	}
+
+	disabled := spec.DisabledComponentNames()
+	filtered := *r
+	filtered.ComponentRefs = spec.EnabledComponentRefs()
+	filtered.DeploymentOrder = make([]string, 0, len(r.DeploymentOrder))
+	for _, name := range r.DeploymentOrder {
+		if !slices.Contains(disabled, name) {
+			filtered.DeploymentOrder = append(filtered.DeploymentOrder, name)
+		}
+	}
+	filtered.Metadata.DisabledComponents = disabled
+	return &filtered, nil
 }
 
 // Merge merges another RecipeMetadataSpec into this one.
@@ -266,6 +498,16 @@ func (s *RecipeMetadataSpec) Merge(other *RecipeMetadataSpec) {
 func mergeComponentRef(base, overlay ComponentRef) ComponentRef {
 	result := base // Start with base values
 
+	// Component: overlay takes precedence if set
+	if overlay.Component != "" {
+		result.Component = overlay.Component
+	}
+
+	// ReleaseName: overlay takes precedence if set
+	if overlay.ReleaseName != "" {
+		result.ReleaseName = overlay.ReleaseName
+	}
+
 	// Type: overlay takes precedence if set
 	if overlay.Type != "" {
 		result.Type = overlay.Type
@@ -291,14 +533,13 @@ func mergeComponentRef(base, overlay ComponentRef) ComponentRef {
 		result.ValuesFile = overlay.ValuesFile
 	}
 
-	// Overrides: merge maps, overlay takes precedence
+	// Overrides: deep-merge following the overlay's merge strategies. The
+	// annotations are kept so they also apply to the component values.
 	if len(overlay.Overrides) > 0 {
-		if result.Overrides == nil {
-			result.Overrides = make(map[string]any)
-		}
-		for k, v := range overlay.Overrides {
-			result.Overrides[k] = v
-		}
+		merged := make(map[string]any, len(result.Overrides)+len(overlay.Overrides))
+		mergeOverrides(merged, result.Overrides)
+		mergeOverrides(merged, overlay.Overrides)
+		result.Overrides = merged
 	}
 
 	// Patches: overlay replaces if set
@@ -329,24 +570,49 @@ func mergeComponentRef(base, overlay ComponentRef) ComponentRef {
 		result.Path = overlay.Path
 	}
 
+	// Enabled: overlay takes precedence if set, so overlays can toggle components
+	if overlay.Enabled != nil {
+		result.Enabled = overlay.Enabled
+	}
+
+	// DocsURL and SupportMatrixURL: overlay takes precedence if set, so
+	// overlays pinning a version can link its documentation
+	if overlay.DocsURL != "" {
+		result.DocsURL = overlay.DocsURL
+	}
+	if overlay.SupportMatrixURL != "" {
+		result.SupportMatrixURL = overlay.SupportMatrixURL
+	}
+
 	return result
 }
 
 // ValidateDependencies validates that all dependencyRefs reference existing components.
-// Returns an error if any dependency is missing or if there are circular dependencies.
+// Returns an error if component names or Helm release names are not unique,
+// if any dependency is missing, if an enabled component depends on a disabled
+// one, or if there are circular dependencies.
 func (s *RecipeMetadataSpec) ValidateDependencies() error {
-	// Build a set of known component names
-	known := make(map[string]bool)
+	if err := s.validateNames(); err != nil {
+		return err
+	}
+
+	// Build a map of known component names to their enabled state
+	enabled := make(map[string]bool)
 	for _, c := range s.ComponentRefs {
-		known[c.Name] = true
+		enabled[c.Name] = c.IsEnabled()
 	}
 
-	// Check all dependencyRefs point to known components
+	// Check all dependencyRefs point to known components, and that enabled
+	// components only depend on enabled ones
 	for _, c := range s.ComponentRefs {
 		for _, dep := range c.DependencyRefs {
-			if !known[dep] {
+			depEnabled, known := enabled[dep]
+			if !known {
 				return fmt.Errorf("component %q references unknown dependency %q", c.Name, dep)
 			}
+			if c.IsEnabled() && !depEnabled {
+				return fmt.Errorf("component %q depends on disabled component %q", c.Name, dep)
+			}
 		}
 	}
 
@@ -358,6 +624,30 @@ func (s *RecipeMetadataSpec) ValidateDependencies() error {
 	return nil
 }
 
+// validateNames checks that component names and the release names of enabled
+// components are unique. Instances of the same registry component share a
+// namespace, so their release names must differ.
+func (s *RecipeMetadataSpec) validateNames() error {
+	names := make(map[string]bool, len(s.ComponentRefs))
+	releases := make(map[string]string, len(s.ComponentRefs))
+	for _, c := range s.ComponentRefs {
+		if names[c.Name] {
+			return fmt.Errorf("duplicate component name %q", c.Name)
+		}
+		names[c.Name] = true
+
+		if !c.IsEnabled() {
+			continue
+		}
+		release := c.HelmReleaseName()
+		if other, exists := releases[release]; exists {
+			return fmt.Errorf("components %q and %q use the same release name %q", other, c.Name, release)
+		}
+		releases[release] = c.Name
+	}
+	return nil
+}
+
 // detectCycles uses DFS to detect circular dependencies.
 func (s *RecipeMetadataSpec) detectCycles() error {
 	// Build adjacency list
@@ -416,15 +706,40 @@ func (s *RecipeMetadataSpec) detectCycles() error {
 	return nil
 }
 
+// EnabledComponentRefs returns the component refs that are not disabled.
+func (s *RecipeMetadataSpec) EnabledComponentRefs() []ComponentRef {
+	refs := make([]ComponentRef, 0, len(s.ComponentRefs))
+	for _, c := range s.ComponentRefs {
+		if c.IsEnabled() {
+			refs = append(refs, c)
+		}
+	}
+	return refs
+}
+
+// DisabledComponentNames returns the names of components marked enabled: false.
+func (s *RecipeMetadataSpec) DisabledComponentNames() []string {
+	var names []string
+	for _, c := range s.ComponentRefs {
+		if !c.IsEnabled() {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
 // TopologicalSort returns components in dependency order (dependencies first).
 // Components with no dependencies come first, then components that depend only
-// on already-listed components, etc.
+// on already-listed components, etc. Disabled components are omitted.
 func (s *RecipeMetadataSpec) TopologicalSort() ([]string, error) {
+	// Disabled components are not deployed, so they have no place in the order
+	refs := s.EnabledComponentRefs()
+
 	// Build adjacency list and in-degree map
 	deps := make(map[string][]string)
 	inDegree := make(map[string]int)
 
-	for _, c := range s.ComponentRefs {
+	for _, c := range refs {
 		deps[c.Name] = c.DependencyRefs
 		if _, exists := inDegree[c.Name]; !exists {
 			inDegree[c.Name] = 0
@@ -437,7 +752,7 @@ func (s *RecipeMetadataSpec) TopologicalSort() ([]string, error) {
 	}
 
 	// Count incoming edges
-	for _, c := range s.ComponentRefs {
+	for _, c := range refs {
 		for range c.DependencyRefs {
 			// Each dependency adds an edge from dep -> c
 			// So c has inDegree[c]++ for each dependency
@@ -449,7 +764,7 @@ func (s *RecipeMetadataSpec) TopologicalSort() ([]string, error) {
 	// For deployment order, we want components with no dependencies first
 	// So we use reverse: inDegree[X] = number of deps X has
 	inDegree = make(map[string]int)
-	for _, c := range s.ComponentRefs {
+	for _, c := range refs {
 		inDegree[c.Name] = len(c.DependencyRefs)
 	}
 
@@ -466,7 +781,7 @@ func (s *RecipeMetadataSpec) TopologicalSort() ([]string, error) {
 
 	var result []string
 	dependents := make(map[string][]string) // dep -> list of components that depend on it
-	for _, c := range s.ComponentRefs {
+	for _, c := range refs {
 		for _, dep := range c.DependencyRefs {
 			dependents[dep] = append(dependents[dep], c.Name)
 		}
@@ -490,7 +805,7 @@ func (s *RecipeMetadataSpec) TopologicalSort() ([]string, error) {
 	}
 
 	// Check if all nodes were processed (no cycles)
-	if len(result) != len(s.ComponentRefs) {
+	if len(result) != len(refs) {
 		return nil, fmt.Errorf("cannot determine deployment order: circular dependencies exist")
 	}
 
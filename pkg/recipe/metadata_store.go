@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"io/fs"
 	"log/slog"
+	"maps"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -29,7 +31,8 @@ import (
 )
 
 var (
-	metadataStoreOnce   sync.Once
+	metadataStoreMu     sync.Mutex
+	metadataStoreLoaded bool
 	cachedMetadataStore *MetadataStore
 	cachedMetadataErr   error
 )
@@ -44,106 +47,130 @@ type MetadataStore struct {
 
 	// ValuesFiles contains embedded values file contents indexed by filename.
 	ValuesFiles map[string][]byte
+
+	// Sources maps base and overlay names to the data file they were loaded from.
+	Sources map[string]string
 }
 
 // loadMetadataStore loads and caches the metadata store from the data provider.
 func loadMetadataStore(_ context.Context) (*MetadataStore, error) {
-	metadataStoreOnce.Do(func() {
+	metadataStoreMu.Lock()
+	if !metadataStoreLoaded {
 		// Record cache miss on first load
 		recipeCacheMisses.Inc()
 
-		store := &MetadataStore{
-			Overlays:    make(map[string]*RecipeMetadata),
-			ValuesFiles: make(map[string][]byte),
+		cachedMetadataStore, cachedMetadataErr = buildMetadataStore(GetDataProvider())
+		if cachedMetadataErr == nil {
+			initOverlayMetrics(cachedMetadataStore)
 		}
+		metadataStoreLoaded = true
+	}
+	store, err := cachedMetadataStore, cachedMetadataErr
+	metadataStoreMu.Unlock()
 
-		provider := GetDataProvider()
+	// Record cache hit if store was already loaded (not on first load)
+	if store != nil && err == nil {
+		recipeCacheHits.Inc()
+	}
 
-		// Load all YAML files from data directory
-		err := provider.WalkDir("", func(path string, d fs.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
-			if d.IsDir() {
-				return nil
-			}
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInternal, "metadata store not initialized")
+	}
+	return store, nil
+}
 
-			filename := filepath.Base(path)
-
-			// Handle component files (files in the components/ directory)
-			if strings.Contains(path, "components/") {
-				content, readErr := provider.ReadFile(path)
-				if readErr != nil {
-					return fmt.Errorf("failed to read component file %s: %w", path, readErr)
-				}
-				// Store with relative path (e.g., "components/cert-manager/values.yaml")
-				store.ValuesFiles[path] = content
-				return nil
-			}
+// buildMetadataStore loads the base recipe, overlays and component values
+// files from provider and validates the base recipe dependencies.
+func buildMetadataStore(provider DataProvider) (*MetadataStore, error) {
+	store := &MetadataStore{
+		Overlays:    make(map[string]*RecipeMetadata),
+		ValuesFiles: make(map[string][]byte),
+		Sources:     make(map[string]string),
+	}
 
-			// Skip non-YAML files
-			if !strings.HasSuffix(filename, ".yaml") {
-				return nil
-			}
+	// Load all YAML files from data directory
+	err := provider.WalkDir("", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
 
-			// Skip old data-v1.yaml format and registry.yaml (handled separately)
-			if filename == "data-v1.yaml" || filename == "registry.yaml" {
-				return nil
-			}
+		filename := filepath.Base(path)
 
-			// Read and parse metadata file
+		// Handle component files (files in the components/ directory)
+		if strings.Contains(path, "components/") {
 			content, readErr := provider.ReadFile(path)
 			if readErr != nil {
-				return fmt.Errorf("failed to read %s: %w", path, readErr)
+				return fmt.Errorf("failed to read component file %s: %w", path, readErr)
 			}
+			// Store with relative path (e.g., "components/cert-manager/values.yaml")
+			store.ValuesFiles[path] = content
+			return nil
+		}
 
-			var metadata RecipeMetadata
-			if parseErr := yaml.Unmarshal(content, &metadata); parseErr != nil {
-				return fmt.Errorf("failed to parse %s: %w", path, parseErr)
-			}
+		// Bundle profiles are loaded on demand by LoadBundleProfile
+		if strings.HasPrefix(path, profilesDir+"/") {
+			return nil
+		}
 
-			// Categorize as base or overlay
-			// base.yaml is now in overlays/ directory but still identified by filename
-			if filename == "base.yaml" && strings.Contains(path, "overlays/") {
-				store.Base = &metadata
-			} else {
-				store.Overlays[metadata.Metadata.Name] = &metadata
-			}
+		// Skip non-YAML files
+		if !strings.HasSuffix(filename, ".yaml") {
+			return nil
+		}
 
+		// Skip old data-v1.yaml format and registry.yaml (handled separately)
+		if filename == "data-v1.yaml" || filename == "registry.yaml" {
 			return nil
-		})
+		}
 
-		if err != nil {
-			cachedMetadataErr = err
-			return
+		// Read and parse metadata file
+		content, readErr := provider.ReadFile(path)
+		if readErr != nil {
+			return fmt.Errorf("failed to read %s: %w", path, readErr)
 		}
 
-		if store.Base == nil {
-			cachedMetadataErr = eidoserrors.New(eidoserrors.ErrCodeInternal, "base.yaml not found")
-			return
+		var metadata RecipeMetadata
+		if parseErr := yaml.Unmarshal(content, &metadata); parseErr != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, parseErr)
+		}
+		for _, ref := range metadata.Spec.ComponentRefs {
+			if mergeErr := ValidateMergeStrategies(ref.Overrides); mergeErr != nil {
+				return fmt.Errorf("invalid overrides for component %s in %s: %w", ref.Name, path, mergeErr)
+			}
 		}
 
-		// Validate base recipe dependencies
-		if err := store.Base.Spec.ValidateDependencies(); err != nil {
-			cachedMetadataErr = eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "base recipe validation failed", err)
-			return
+		// Categorize as base or overlay
+		// base.yaml is now in overlays/ directory but still identified by filename
+		if filename == "base.yaml" && strings.Contains(path, "overlays/") {
+			store.Base = &metadata
+			store.Sources["base"] = path
+		} else {
+			store.Overlays[metadata.Metadata.Name] = &metadata
+			store.Sources[metadata.Metadata.Name] = path
 		}
 
-		cachedMetadataStore = store
+		return nil
 	})
 
-	// Record cache hit if store was already loaded (not on first load)
-	if cachedMetadataStore != nil && cachedMetadataErr == nil {
-		recipeCacheHits.Inc()
+	if err != nil {
+		return nil, err
 	}
 
-	if cachedMetadataErr != nil {
-		return nil, cachedMetadataErr
+	if store.Base == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInternal, "base.yaml not found")
 	}
-	if cachedMetadataStore == nil {
-		return nil, eidoserrors.New(eidoserrors.ErrCodeInternal, "metadata store not initialized")
+
+	// Validate base recipe dependencies
+	if err := store.Base.Spec.ValidateDependencies(); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "base recipe validation failed", err)
 	}
-	return cachedMetadataStore, nil
+
+	return store, nil
 }
 
 // GetValuesFile returns the content of a values file by filename.
@@ -226,6 +253,65 @@ func (s *MetadataStore) FindMatchingOverlays(criteria *Criteria) []*RecipeMetada
 	return matches
 }
 
+// MatchingOverlayNames returns the names of the overlays BuildRecipeResult
+// applies for the criteria, in merge order, including the overlays they
+// inherit from. Base is not included.
+func (s *MetadataStore) MatchingOverlayNames(criteria *Criteria) ([]string, error) {
+	names := make([]string, 0)
+	processed := make(map[string]bool)
+
+	for _, overlay := range s.FindMatchingOverlays(criteria) {
+		chain, err := s.resolveInheritanceChain(overlay.Metadata.Name)
+		if err != nil {
+			return nil, eidoserrors.WrapWithContext(
+				eidoserrors.ErrCodeInvalidRequest,
+				"failed to resolve inheritance chain",
+				err,
+				map[string]any{
+					"overlay": overlay.Metadata.Name,
+				},
+			)
+		}
+		for i := 1; i < len(chain); i++ {
+			if processed[chain[i].Metadata.Name] {
+				continue
+			}
+			processed[chain[i].Metadata.Name] = true
+			names = append(names, chain[i].Metadata.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// AutoscalingOverlay is the overlay applied when a recipe is requested with
+// autoscaling (see WithAutoscaling). It sets priority classes and Cluster
+// Autoscaler annotations so components do not block scale-down of GPU node
+// pools. It has no criteria and is never matched.
+const AutoscalingOverlay = "autoscaling"
+
+// ApplyOverlay merges the named overlay into the components result already
+// contains and records it in the applied overlays. Components the overlay
+// lists that are not part of the recipe are not added, so the deployment
+// order is unchanged.
+func (s *MetadataStore) ApplyOverlay(result *RecipeResult, name string) error {
+	overlay, exists := s.Overlays[name]
+	if !exists {
+		return eidoserrors.New(eidoserrors.ErrCodeNotFound, fmt.Sprintf("overlay %q not found", name))
+	}
+
+	for _, ref := range overlay.Spec.ComponentRefs {
+		for i := range result.ComponentRefs {
+			if result.ComponentRefs[i].Name == ref.Name {
+				result.ComponentRefs[i] = mergeComponentRef(result.ComponentRefs[i], ref)
+			}
+		}
+	}
+	result.Metadata.AppliedOverlays = append(result.Metadata.AppliedOverlays, name)
+
+	return nil
+}
+
 // BuildRecipeResult builds a RecipeResult by merging base with matching overlays.
 // Each matching overlay is resolved through its inheritance chain before merging.
 // This enables multi-level inheritance: base → intermediate → overlay.
@@ -322,6 +408,7 @@ func (s *MetadataStore) BuildRecipeResult(ctx context.Context, criteria *Criteri
 		DeploymentOrder: deployOrder,
 	}
 	result.Metadata.AppliedOverlays = appliedOverlays
+	result.Metadata.DisabledComponents = mergedSpec.DisabledComponentNames()
 
 	return result, nil
 }
@@ -446,6 +533,13 @@ func (s *MetadataStore) BuildRecipeResultWithEvaluator(ctx context.Context, crit
 		}
 	}
 
+	// Disable components the snapshot shows are already installed
+	componentWarnings := skipInstalledComponents(&mergedSpec, evaluator)
+	componentWarnings = append(componentWarnings, s.checkMIGStrategy(&mergedSpec, evaluator)...)
+	componentWarnings = append(componentWarnings, configureHostDevices(&mergedSpec, evaluator)...)
+	componentWarnings = append(componentWarnings, s.configureDriver(&mergedSpec, evaluator)...)
+	componentWarnings = append(componentWarnings, configureNetworks(&mergedSpec, evaluator)...)
+
 	// Validate merged dependencies
 	if err := mergedSpec.ValidateDependencies(); err != nil {
 		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "merged recipe validation failed", err)
@@ -470,8 +564,10 @@ func (s *MetadataStore) BuildRecipeResultWithEvaluator(ctx context.Context, crit
 		DeploymentOrder: deployOrder,
 	}
 	result.Metadata.AppliedOverlays = appliedOverlays
+	result.Metadata.DisabledComponents = mergedSpec.DisabledComponentNames()
 	result.Metadata.ExcludedOverlays = excludedOverlays
 	result.Metadata.ConstraintWarnings = constraintWarnings
+	result.Metadata.ComponentWarnings = componentWarnings
 
 	return result, nil
 }
@@ -532,6 +628,199 @@ func (s *MetadataStore) evaluateOverlayConstraints(overlay *RecipeMetadata, eval
 	return allPassed, warnings
 }
 
+// skipInstalledComponents disables enabled components whose registry
+// DetectInstalled path evaluates to "true" against the snapshot, so an
+// existing installation is not deployed over. Dependency references to a
+// disabled component are dropped since the cluster already provides it.
+func skipInstalledComponents(spec *RecipeMetadataSpec, evaluator ConstraintEvaluatorFunc) []ComponentWarning {
+	registry, err := GetComponentRegistry()
+	if err != nil {
+		slog.Warn("failed to get component registry for install detection", "error", err)
+		return nil
+	}
+
+	var warnings []ComponentWarning
+	skipped := make(map[string]bool)
+	disabled := false
+	for i := range spec.ComponentRefs {
+		ref := &spec.ComponentRefs[i]
+		if !ref.IsEnabled() {
+			continue
+		}
+		config := registry.Get(ref.ComponentName())
+		if config == nil || config.DetectInstalled == "" {
+			continue
+		}
+
+		result := evaluator(Constraint{Name: config.DetectInstalled, Value: "true"})
+		if result.Error != nil || !result.Passed {
+			continue
+		}
+
+		ref.Enabled = &disabled
+		skipped[ref.Name] = true
+		warnings = append(warnings, ComponentWarning{
+			Component: ref.Name,
+			Reason: fmt.Sprintf("%s is already installed on the cluster (%s=%s); component disabled",
+				config.DisplayName, config.DetectInstalled, result.Actual),
+		})
+		slog.Debug("component already installed, disabling",
+			"component", ref.Name,
+			"detectedBy", config.DetectInstalled)
+	}
+
+	if len(skipped) == 0 {
+		return nil
+	}
+	for i := range spec.ComponentRefs {
+		ref := &spec.ComponentRefs[i]
+		ref.DependencyRefs = slices.DeleteFunc(slices.Clone(ref.DependencyRefs), func(dep string) bool {
+			return skipped[dep]
+		})
+	}
+	return warnings
+}
+
+// migStrategyPath is the snapshot path of the MIG strategy the GPUs need.
+const migStrategyPath = "GPU.mig.strategy"
+
+// checkMIGStrategy warns when a GPU Operator component sets mig.strategy
+// (inline or in its values file) to something the snapshot's MIG layout does
+// not support, e.g. "mixed" on GPUs with MIG disabled.
+func (s *MetadataStore) checkMIGStrategy(spec *RecipeMetadataSpec, evaluator ConstraintEvaluatorFunc) []ComponentWarning {
+	var warnings []ComponentWarning
+	for _, ref := range spec.ComponentRefs {
+		if !ref.IsEnabled() || ref.ComponentName() != "gpu-operator" {
+			continue
+		}
+		strategy := s.migStrategy(ref)
+		if strategy == "" {
+			continue
+		}
+
+		result := evaluator(Constraint{Name: migStrategyPath, Value: strategy})
+		if result.Error != nil {
+			slog.Debug("MIG strategy not found in snapshot", "component", ref.Name, "error", result.Error)
+			continue
+		}
+
+		var reason string
+		switch {
+		case result.Actual == "none" && strategy != "none":
+			reason = fmt.Sprintf("mig.strategy is %s but MIG is disabled on the snapshot GPUs", strategy)
+		case result.Actual != "none" && strategy == "none":
+			reason = fmt.Sprintf("mig.strategy is none but the snapshot GPUs are MIG-partitioned (%s=%s)",
+				migStrategyPath, result.Actual)
+		case result.Actual == "mixed" && strategy == "single":
+			reason = "mig.strategy is single but the snapshot GPUs use several MIG profiles or are only partly partitioned; use mixed"
+		default:
+			continue
+		}
+		warnings = append(warnings, ComponentWarning{Component: ref.Name, Reason: reason})
+	}
+	return warnings
+}
+
+// gpuPCIDeviceIDsPath is the snapshot path of the GPU PCI IDs, as
+// comma-separated "vendor:device" pairs.
+const gpuPCIDeviceIDsPath = "GPU.smi.pci-device-ids"
+
+// configureHostDevices fills in the PCI host devices of KubeVirt components
+// from the GPU PCI IDs in the snapshot, so every GPU model found can be
+// passed through to virtual machines. Components that set hostDevices.pci
+// inline are left alone.
+func configureHostDevices(spec *RecipeMetadataSpec, evaluator ConstraintEvaluatorFunc) []ComponentWarning {
+	var warnings []ComponentWarning
+	for i := range spec.ComponentRefs {
+		ref := &spec.ComponentRefs[i]
+		if !ref.IsEnabled() || ref.ComponentName() != "kubevirt" {
+			continue
+		}
+		if hostDevices, ok := ref.Overrides["hostDevices"].(map[string]any); ok && hostDevices["pci"] != nil {
+			continue
+		}
+
+		// Only Actual is used; the value just needs to be a valid expression.
+		result := evaluator(Constraint{Name: gpuPCIDeviceIDsPath, Value: "!= none"})
+		if result.Error != nil || result.Actual == "" {
+			warnings = append(warnings, ComponentWarning{
+				Component: ref.Name,
+				Reason:    fmt.Sprintf("no GPU PCI IDs in the snapshot (%s); set hostDevices.pci to pass GPUs through", gpuPCIDeviceIDsPath),
+			})
+			continue
+		}
+
+		var devices []any
+		ids := strings.Split(result.Actual, ",")
+		for _, id := range ids {
+			devices = append(devices, map[string]any{
+				"pciVendorSelector": id,
+				"resourceName":      "nvidia.com/gpu-" + strings.ReplaceAll(id, ":", "-"),
+			})
+		}
+
+		ref.Overrides = maps.Clone(ref.Overrides)
+		if ref.Overrides == nil {
+			ref.Overrides = make(map[string]any)
+		}
+		hostDevices, _ := ref.Overrides["hostDevices"].(map[string]any)
+		hostDevices = maps.Clone(hostDevices)
+		if hostDevices == nil {
+			hostDevices = make(map[string]any)
+		}
+		hostDevices["pci"] = devices
+		ref.Overrides["hostDevices"] = hostDevices
+
+		warnings = append(warnings, ComponentWarning{
+			Component: ref.Name,
+			Reason:    fmt.Sprintf("hostDevices.pci set from the snapshot GPU PCI IDs (%s)", strings.Join(ids, ", ")),
+		})
+	}
+	return warnings
+}
+
+// migStrategy returns the mig.strategy value a GPU Operator component sets,
+// preferring inline overrides over its values files, or "" when unset.
+func (s *MetadataStore) migStrategy(ref ComponentRef) string {
+	if strategy := nestedString(ref.Overrides, "mig", "strategy"); strategy != "" {
+		return strategy
+	}
+	return nestedString(s.componentValues(ref), "mig", "strategy")
+}
+
+// componentValues returns the merged values files of a component, without
+// its inline overrides, or nil when it has none or they fail to load.
+func (s *MetadataStore) componentValues(ref ComponentRef) map[string]any {
+	if ref.ValuesFile == "" {
+		return nil
+	}
+	layers, err := valuesLayers(s.GetValuesFile, ref)
+	if err != nil {
+		slog.Debug("failed to load values files", "file", ref.ValuesFile, "error", err)
+		return nil
+	}
+	values, err := mergeValuesLayers(layers)
+	if err != nil {
+		slog.Debug("failed to merge values files", "file", ref.ValuesFile, "error", err)
+		return nil
+	}
+	return values
+}
+
+// nestedString returns the string at path in nested maps, or "".
+func nestedString(values map[string]any, path ...string) string {
+	var current any = values
+	for _, key := range path {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return ""
+		}
+		current = m[key]
+	}
+	str, _ := current.(string)
+	return str
+}
+
 // applyRegistryDefaults fills in ComponentRef fields from ComponentConfig defaults.
 // This allows registry.yaml to specify default values that are applied to components
 // that don't explicitly set them in recipes.
@@ -543,7 +832,7 @@ func applyRegistryDefaults(refs []ComponentRef) {
 	}
 
 	for i := range refs {
-		config := registry.Get(refs[i].Name)
+		config := registry.Get(refs[i].ComponentName())
 		if config != nil {
 			refs[i].ApplyRegistryDefaults(config)
 		}
@@ -18,7 +18,10 @@
 // - RecipeMetadataSpec.ValidateDependencies() - component dependency validation
 // - RecipeMetadataSpec.TopologicalSort() - deployment ordering
 // - RecipeMetadataSpec.Merge() - overlay merging with base recipes
+// - ComponentRef.Enabled - component toggles and their effect on ordering
+// - ComponentRef.Component/ReleaseName - multiple instances of one component
 // - ComponentRef merging - how overlays override/inherit base values
+// - ComponentType / RecipeResult JSON decoding - enum normalization
 // - MetadataStore inheritance chains - multi-level spec.base resolution
 //   (e.g., base → eks → eks-training → gb200-eks-training)
 //
@@ -35,6 +38,8 @@ package recipe
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -572,6 +577,74 @@ func TestInheritanceChainGB200(t *testing.T) {
 	t.Logf("GB200 inheritance chain test passed")
 }
 
+// TestInferenceIntentIncludesNIM verifies that inference recipes deploy NIM
+// with its manifests and that other intents do not.
+func TestInferenceIntentIncludesNIM(t *testing.T) {
+	ctx := context.Background()
+	builder := NewBuilder()
+
+	tests := []struct {
+		name     string
+		criteria func(c *Criteria)
+		wantNIM  bool
+	}{
+		{
+			name:     "inference only",
+			criteria: func(c *Criteria) { c.Intent = CriteriaIntentInference },
+			wantNIM:  true,
+		},
+		{
+			name: "h100 ubuntu inference",
+			criteria: func(c *Criteria) {
+				c.Accelerator = CriteriaAcceleratorH100
+				c.OS = CriteriaOSUbuntu
+				c.Intent = CriteriaIntentInference
+			},
+			wantNIM: true,
+		},
+		{
+			name: "eks training",
+			criteria: func(c *Criteria) {
+				c.Service = CriteriaServiceEKS
+				c.Intent = CriteriaIntentTraining
+			},
+			wantNIM: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria := NewCriteria()
+			tt.criteria(criteria)
+
+			result, err := builder.BuildFromCriteria(ctx, criteria)
+			if err != nil {
+				t.Fatalf("BuildFromCriteria failed: %v", err)
+			}
+
+			nim := result.GetComponentRef("nim")
+			if (nim != nil) != tt.wantNIM {
+				t.Fatalf("nim included = %v, want %v (overlays: %v)", nim != nil, tt.wantNIM, result.Metadata.AppliedOverlays)
+			}
+			if nim == nil {
+				return
+			}
+			if len(nim.ManifestFiles) != 2 {
+				t.Errorf("nim manifestFiles = %v, want model cache PVC and ServiceMonitor", nim.ManifestFiles)
+			}
+
+			values, err := result.GetValuesForComponent("nim")
+			if err != nil {
+				t.Fatalf("GetValuesForComponent(nim) failed: %v", err)
+			}
+			persistence, _ := values["persistence"].(map[string]any)
+			if persistence["existingClaim"] != "nim-model-cache" {
+				t.Errorf("nim persistence = %v, want the eidos model cache claim", persistence)
+			}
+		})
+	}
+}
+
 // TestInheritanceChainDoesNotDuplicateRecipes verifies that recipes in the inheritance
 // chain are only applied once, even if they appear in multiple matching overlays' chains.
 func TestInheritanceChainDoesNotDuplicateRecipes(t *testing.T) {
@@ -766,6 +839,42 @@ func TestComponentRefApplyRegistryDefaults(t *testing.T) {
 		}
 	})
 
+	t.Run("docs links resolved for version", func(t *testing.T) {
+		config := &ComponentConfig{
+			Name: "test-helm",
+			Helm: HelmConfig{
+				DefaultRepository: "https://charts.example.com",
+				DefaultVersion:    "v1.2.3",
+			},
+			Docs: DocsConfig{
+				URL:           "https://docs.example.com/{version}/install.html",
+				SupportMatrix: "https://docs.example.com/support.html",
+			},
+		}
+
+		ref := &ComponentRef{Name: "test-helm"}
+		ref.ApplyRegistryDefaults(config)
+		if ref.DocsURL != "https://docs.example.com/1.2.3/install.html" {
+			t.Errorf("DocsURL = %q", ref.DocsURL)
+		}
+		if ref.SupportMatrixURL != "https://docs.example.com/support.html" {
+			t.Errorf("SupportMatrixURL = %q", ref.SupportMatrixURL)
+		}
+
+		pinned := &ComponentRef{Name: "test-helm", DocsURL: "https://docs.example.com/pinned.html"}
+		pinned.ApplyRegistryDefaults(config)
+		if pinned.DocsURL != "https://docs.example.com/pinned.html" {
+			t.Errorf("DocsURL = %q, want the recipe's link", pinned.DocsURL)
+		}
+
+		config.Helm.DefaultVersion = ""
+		unversioned := &ComponentRef{Name: "test-helm"}
+		unversioned.ApplyRegistryDefaults(config)
+		if unversioned.DocsURL != "" {
+			t.Errorf("DocsURL = %q, want empty without a version", unversioned.DocsURL)
+		}
+	})
+
 	t.Run("explicit type preserved", func(t *testing.T) {
 		// Test that if a ComponentRef already has a type set, it's not changed
 		config := &ComponentConfig{
@@ -871,3 +980,278 @@ func TestComponentRefMergeWithPath(t *testing.T) {
 		}
 	})
 }
+
+func TestComponentRefEnabled(t *testing.T) {
+	enabled, disabled := true, false
+
+	spec := RecipeMetadataSpec{
+		ComponentRefs: []ComponentRef{
+			{Name: "cert-manager", Type: ComponentTypeHelm},
+			{Name: "gpu-operator", Type: ComponentTypeHelm, Enabled: &enabled, DependencyRefs: []string{"cert-manager"}},
+			{Name: "nvsentinel", Type: ComponentTypeHelm, Enabled: &disabled, DependencyRefs: []string{"gpu-operator"}},
+		},
+	}
+
+	t.Run("nil means enabled", func(t *testing.T) {
+		if !spec.ComponentRefs[0].IsEnabled() {
+			t.Error("component without enabled flag should be enabled")
+		}
+		if spec.ComponentRefs[2].IsEnabled() {
+			t.Error("component with enabled: false should be disabled")
+		}
+	})
+
+	t.Run("disabled component may depend on enabled", func(t *testing.T) {
+		if err := spec.ValidateDependencies(); err != nil {
+			t.Errorf("ValidateDependencies() error = %v", err)
+		}
+	})
+
+	t.Run("deployment order omits disabled", func(t *testing.T) {
+		got, err := spec.TopologicalSort()
+		if err != nil {
+			t.Fatalf("TopologicalSort() error = %v", err)
+		}
+		want := []string{"cert-manager", "gpu-operator"}
+		if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+			t.Errorf("TopologicalSort() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("enabled component depending on disabled fails", func(t *testing.T) {
+		bad := RecipeMetadataSpec{
+			ComponentRefs: []ComponentRef{
+				{Name: "cert-manager", Type: ComponentTypeHelm, Enabled: &disabled},
+				{Name: "gpu-operator", Type: ComponentTypeHelm, DependencyRefs: []string{"cert-manager"}},
+			},
+		}
+		err := bad.ValidateDependencies()
+		if err == nil || !strings.Contains(err.Error(), "disabled component") {
+			t.Errorf("expected disabled dependency error, got %v", err)
+		}
+	})
+
+	t.Run("overlay toggles component", func(t *testing.T) {
+		merged := mergeComponentRef(
+			ComponentRef{Name: "gpu-operator", Version: "v25.3.3"},
+			ComponentRef{Name: "gpu-operator", Enabled: &disabled},
+		)
+		if merged.IsEnabled() || merged.Version != "v25.3.3" {
+			t.Errorf("merged = %+v, want disabled with base version", merged)
+		}
+
+		inherited := mergeComponentRef(
+			ComponentRef{Name: "gpu-operator", Enabled: &disabled},
+			ComponentRef{Name: "gpu-operator", Version: "v25.10.0"},
+		)
+		if inherited.IsEnabled() {
+			t.Error("overlay without enabled flag should inherit disabled state")
+		}
+	})
+}
+
+func TestRecipeResultWithoutDisabledComponents(t *testing.T) {
+	disabled := false
+	r := &RecipeResult{
+		ComponentRefs: []ComponentRef{
+			{Name: "cert-manager", Type: ComponentTypeHelm},
+			{Name: "gpu-operator", Type: ComponentTypeHelm, DependencyRefs: []string{"cert-manager"}},
+			{Name: "nvsentinel", Type: ComponentTypeHelm, Enabled: &disabled},
+		},
+		DeploymentOrder: []string{"cert-manager", "gpu-operator", "nvsentinel"},
+	}
+
+	got, err := r.WithoutDisabledComponents()
+	if err != nil {
+		t.Fatalf("WithoutDisabledComponents() error = %v", err)
+	}
+	if len(got.ComponentRefs) != 2 || got.GetComponentRef("nvsentinel") != nil {
+		t.Errorf("ComponentRefs = %+v, want nvsentinel removed", got.ComponentRefs)
+	}
+	if len(got.DeploymentOrder) != 2 || got.DeploymentOrder[1] != "gpu-operator" {
+		t.Errorf("DeploymentOrder = %v", got.DeploymentOrder)
+	}
+	if len(got.Metadata.DisabledComponents) != 1 || got.Metadata.DisabledComponents[0] != "nvsentinel" {
+		t.Errorf("DisabledComponents = %v, want [nvsentinel]", got.Metadata.DisabledComponents)
+	}
+	if len(r.ComponentRefs) != 3 {
+		t.Error("WithoutDisabledComponents() must not modify the receiver")
+	}
+
+	r.ComponentRefs[0].Enabled = &disabled
+	if _, err := r.WithoutDisabledComponents(); err == nil {
+		t.Error("expected error when an enabled component depends on a disabled one")
+	}
+}
+
+func TestComponentRefInstances(t *testing.T) {
+	t.Run("defaults to name", func(t *testing.T) {
+		ref := ComponentRef{Name: "network-operator"}
+		if got := ref.ComponentName(); got != "network-operator" {
+			t.Errorf("ComponentName() = %q, want network-operator", got)
+		}
+		if got := ref.HelmReleaseName(); got != "network-operator" {
+			t.Errorf("HelmReleaseName() = %q, want network-operator", got)
+		}
+	})
+
+	t.Run("explicit component and release", func(t *testing.T) {
+		ref := ComponentRef{Name: "network-operator-pool-b", Component: "network-operator", ReleaseName: "netop-b"}
+		if got := ref.ComponentName(); got != "network-operator" {
+			t.Errorf("ComponentName() = %q, want network-operator", got)
+		}
+		if got := ref.HelmReleaseName(); got != "netop-b" {
+			t.Errorf("HelmReleaseName() = %q, want netop-b", got)
+		}
+	})
+
+	disabled := false
+	tests := []struct {
+		name    string
+		refs    []ComponentRef
+		wantErr string
+	}{
+		{
+			name: "two instances of one component",
+			refs: []ComponentRef{
+				{Name: "network-operator"},
+				{Name: "network-operator-pool-b", Component: "network-operator"},
+			},
+		},
+		{
+			name: "duplicate name",
+			refs: []ComponentRef{
+				{Name: "network-operator"},
+				{Name: "network-operator"},
+			},
+			wantErr: "duplicate component name",
+		},
+		{
+			name: "duplicate release name",
+			refs: []ComponentRef{
+				{Name: "network-operator"},
+				{Name: "network-operator-pool-b", Component: "network-operator", ReleaseName: "network-operator"},
+			},
+			wantErr: "same release name",
+		},
+		{
+			name: "disabled instance may reuse release name",
+			refs: []ComponentRef{
+				{Name: "network-operator"},
+				{Name: "network-operator-pool-b", Component: "network-operator", ReleaseName: "network-operator", Enabled: &disabled},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := RecipeMetadataSpec{ComponentRefs: tt.refs}
+			err := spec.ValidateDependencies()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateDependencies() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateDependencies() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("overlay sets component and release", func(t *testing.T) {
+		base := ComponentRef{Name: "network-operator-pool-b", Version: "v25.4.0"}
+		merged := mergeComponentRef(base, ComponentRef{Name: base.Name, Component: "network-operator", ReleaseName: "netop-b"})
+		if merged.Component != "network-operator" || merged.ReleaseName != "netop-b" || merged.Version != "v25.4.0" {
+			t.Errorf("mergeComponentRef() = %+v", merged)
+		}
+	})
+}
+
+func TestComponentTypeUnmarshalText(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ComponentType
+		wantErr bool
+	}{
+		{"Helm", ComponentTypeHelm, false},
+		{"helm", ComponentTypeHelm, false},
+		{"KUSTOMIZE", ComponentTypeKustomize, false},
+		{"", "", false},
+		{"operator", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got ComponentType
+			err := got.UnmarshalText([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecipeResultJSONDecoding(t *testing.T) {
+	// Payload shape produced before metadata became a named type.
+	data := `{
+		"kind": "RecipeResult",
+		"apiVersion": "eidos.nvidia.com/v1alpha1",
+		"metadata": {"version": "v0.1.0", "appliedOverlays": ["base", "eks"]},
+		"criteria": {"service": "EKS", "accelerator": "h100"},
+		"componentRefs": [{"name": "gpu-operator", "type": "helm", "source": "https://helm.ngc.nvidia.com/nvidia"}],
+		"deploymentOrder": ["gpu-operator"]
+	}`
+
+	var result RecipeResult
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if result.Metadata.Version != "v0.1.0" || len(result.Metadata.AppliedOverlays) != 2 {
+		t.Errorf("Metadata = %+v", result.Metadata)
+	}
+	if result.Criteria.Service != CriteriaServiceEKS {
+		t.Errorf("Criteria.Service = %q, want %q", result.Criteria.Service, CriteriaServiceEKS)
+	}
+	if result.ComponentRefs[0].Type != ComponentTypeHelm {
+		t.Errorf("ComponentRefs[0].Type = %q, want %q", result.ComponentRefs[0].Type, ComponentTypeHelm)
+	}
+
+	out, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"type":"Helm"`) || !strings.Contains(string(out), `"service":"eks"`) {
+		t.Errorf("Marshal() = %s", out)
+	}
+}
+
+func TestRecipeResultComponentDocs(t *testing.T) {
+	r := &RecipeResult{
+		ComponentRefs: []ComponentRef{
+			{Name: "gpu-operator", Version: "v25.3.3", DocsURL: "https://docs.example.com/gpu"},
+			{Name: "cert-manager", Version: "v1.17.2", SupportMatrixURL: "https://docs.example.com/cm"},
+			{Name: "no-docs", Version: "v1"},
+			{Name: "kustomize-app", Tag: "v2", DocsURL: "https://docs.example.com/app"},
+		},
+		DeploymentOrder: []string{"cert-manager", "gpu-operator", "no-docs"},
+	}
+
+	got := r.ComponentDocs()
+	want := []ComponentDocs{
+		{Name: "cert-manager", Version: "v1.17.2", SupportMatrixURL: "https://docs.example.com/cm"},
+		{Name: "gpu-operator", Version: "v25.3.3", DocsURL: "https://docs.example.com/gpu"},
+		{Name: "kustomize-app", Version: "v2", DocsURL: "https://docs.example.com/app"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ComponentDocs() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ComponentDocs()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
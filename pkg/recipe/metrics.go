@@ -42,4 +42,60 @@ var (
 			Help: "Total number of recipe metadata cache misses (initial loads)",
 		},
 	)
+	recipeDataReloads = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_recipe_data_reloads_total",
+			Help: "Total number of recipe data reloads by result",
+		},
+		[]string{"result"},
+	)
+
+	// Recipe request metrics (server mode)
+	recipeRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_recipe_requests_total",
+			Help: "Total number of recipe requests by criteria",
+		},
+		[]string{"service", "accelerator", "intent"},
+	)
+	overlayMatched = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_overlay_matched_total",
+			Help: "Total number of served recipes each overlay was applied to",
+		},
+		[]string{"overlay"},
+	)
 )
+
+// recordRecipeRequest counts a recipe request by its platform criteria.
+// Unset criteria are reported as "any".
+func recordRecipeRequest(c *Criteria) {
+	recipeRequests.WithLabelValues(
+		criteriaLabel(string(c.Service)),
+		criteriaLabel(string(c.Accelerator)),
+		criteriaLabel(string(c.Intent)),
+	).Inc()
+}
+
+// recordOverlayMatches counts each overlay applied to a served recipe.
+func recordOverlayMatches(result *RecipeResult) {
+	for _, name := range result.Metadata.AppliedOverlays {
+		overlayMatched.WithLabelValues(name).Inc()
+	}
+}
+
+// initOverlayMetrics exports a zero-valued series for every known overlay so
+// overlays that are never matched are visible rather than absent.
+func initOverlayMetrics(store *MetadataStore) {
+	overlayMatched.WithLabelValues("base")
+	for name := range store.Overlays {
+		overlayMatched.WithLabelValues(name)
+	}
+}
+
+func criteriaLabel(value string) string {
+	if value == "" {
+		return criteriaAnyValue
+	}
+	return value
+}
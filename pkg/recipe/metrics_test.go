@@ -0,0 +1,77 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestHandleRecipes_Metrics(t *testing.T) {
+	requests := recipeRequests.WithLabelValues("eks", "h100", "training")
+	beforeRequests := testutil.ToFloat64(requests)
+	beforeBase := testutil.ToFloat64(overlayMatched.WithLabelValues("base"))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/recipe?service=eks&accelerator=h100&intent=training", nil)
+	w := httptest.NewRecorder()
+	NewBuilder().HandleRecipes(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	if got := testutil.ToFloat64(requests) - beforeRequests; got != 1 {
+		t.Errorf("recipe requests delta = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(overlayMatched.WithLabelValues("base")) - beforeBase; got != 1 {
+		t.Errorf("base overlay matches delta = %v, want 1", got)
+	}
+
+	var result RecipeResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(result.Metadata.AppliedOverlays) < 2 {
+		t.Fatalf("expected overlays beyond base, got %v", result.Metadata.AppliedOverlays)
+	}
+	if got := testutil.ToFloat64(overlayMatched.WithLabelValues(result.Metadata.AppliedOverlays[1])); got < 1 {
+		t.Errorf("overlay %q matches = %v, want >= 1", result.Metadata.AppliedOverlays[1], got)
+	}
+}
+
+func TestInitOverlayMetrics(t *testing.T) {
+	store, err := loadMetadataStore(t.Context())
+	if err != nil {
+		t.Fatalf("loadMetadataStore() error = %v", err)
+	}
+
+	// Every known overlay should export a series, even if never matched
+	if got, want := testutil.CollectAndCount(overlayMatched), len(store.Overlays)+1; got < want {
+		t.Errorf("overlay series = %d, want at least %d", got, want)
+	}
+}
+
+func TestCriteriaLabel(t *testing.T) {
+	if got := criteriaLabel(""); got != "any" {
+		t.Errorf("criteriaLabel(\"\") = %q, want any", got)
+	}
+	if got := criteriaLabel("eks"); got != "eks" {
+		t.Errorf("criteriaLabel(\"eks\") = %q, want eks", got)
+	}
+}
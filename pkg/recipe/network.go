@@ -0,0 +1,215 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Snapshot paths of the NVIDIA NIC interfaces the Network Operator secondary
+// networks are generated for, as comma-separated interface names.
+const (
+	ibInterfacesPath    = "Network.netdev.ib-interfaces"
+	ethInterfacesPath   = "Network.netdev.eth-interfaces"
+	sriovInterfacesPath = "Network.netdev.sriov-interfaces"
+)
+
+// Device plugin resources the generated secondary networks attach through.
+const (
+	rdmaSharedResourceIB  = "rdma_shared_device_ib"
+	rdmaSharedResourceEth = "rdma_shared_device_eth"
+	sriovResource         = "sriov_vf"
+)
+
+// nicInterfaces are the NVIDIA NIC interfaces found in a snapshot.
+type nicInterfaces struct {
+	// IB are InfiniBand interfaces, attached through IPoIB networks.
+	IB []string
+
+	// Eth are Ethernet interfaces without SR-IOV, attached through macvlan
+	// networks.
+	Eth []string
+
+	// SRIOV are SR-IOV capable interfaces whose VFs are attached through a
+	// host device network.
+	SRIOV []string
+}
+
+// empty reports whether no interfaces were found.
+func (n nicInterfaces) empty() bool {
+	return len(n.IB) == 0 && len(n.Eth) == 0 && len(n.SRIOV) == 0
+}
+
+// snapshotInterfaces reads the NVIDIA NIC interfaces from the snapshot.
+// SR-IOV capable interfaces are left out of the InfiniBand and Ethernet
+// lists, so each interface is attached one way.
+func snapshotInterfaces(evaluator ConstraintEvaluatorFunc) nicInterfaces {
+	list := func(path string) []string {
+		value, ok := snapshotValue(evaluator, path)
+		if !ok {
+			return nil
+		}
+		var names []string
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+
+	var n nicInterfaces
+	n.SRIOV = list(sriovInterfacesPath)
+	isSRIOV := func(name string) bool { return slices.Contains(n.SRIOV, name) }
+	n.IB = slices.DeleteFunc(list(ibInterfacesPath), isSRIOV)
+	n.Eth = slices.DeleteFunc(list(ethInterfacesPath), isSRIOV)
+	return n
+}
+
+// networkOverrides returns the Network Operator values that deploy the
+// device plugins and secondary networks for the interfaces: the RDMA shared
+// device plugin and an IPoIB or macvlan network per interface, and the
+// SR-IOV device plugin and a host device network for the VFs of SR-IOV
+// capable interfaces.
+func networkOverrides(n nicInterfaces) map[string]any {
+	var rdmaResources, ipoib, macvlan, hostDevice []any
+	if len(n.IB) > 0 {
+		rdmaResources = append(rdmaResources, map[string]any{
+			"name":    rdmaSharedResourceIB,
+			"ifNames": stringList(n.IB),
+		})
+		for _, name := range n.IB {
+			ipoib = append(ipoib, map[string]any{
+				"name":      "ipoib-" + name,
+				"interface": name,
+			})
+		}
+	}
+	if len(n.Eth) > 0 {
+		rdmaResources = append(rdmaResources, map[string]any{
+			"name":    rdmaSharedResourceEth,
+			"ifNames": stringList(n.Eth),
+		})
+		for _, name := range n.Eth {
+			macvlan = append(macvlan, map[string]any{
+				"name":      "macvlan-" + name,
+				"interface": name,
+			})
+		}
+	}
+
+	if len(n.SRIOV) > 0 {
+		hostDevice = append(hostDevice, map[string]any{
+			"name":         "hostdev-sriov-vf",
+			"resourceName": sriovResource,
+		})
+	}
+
+	overrides := map[string]any{
+		"networks": map[string]any{
+			"ipoib":      listOrEmpty(ipoib),
+			"macvlan":    listOrEmpty(macvlan),
+			"hostDevice": listOrEmpty(hostDevice),
+		},
+	}
+	if len(rdmaResources) > 0 {
+		overrides["rdmaSharedDevicePlugin"] = map[string]any{
+			"deploy":    true,
+			"resources": rdmaResources,
+		}
+	}
+	if len(n.IB) > 0 {
+		overrides["secondaryNetwork"] = map[string]any{
+			"ipoib": map[string]any{"deploy": true},
+		}
+	}
+	if len(n.SRIOV) > 0 {
+		overrides["sriovDevicePlugin"] = map[string]any{
+			"deploy": true,
+			"resources": []any{map[string]any{
+				"name":    sriovResource,
+				"pfNames": stringList(n.SRIOV),
+			}},
+		}
+	}
+	return overrides
+}
+
+// configureNetworks generates the secondary networks of Network Operator
+// components from the NVIDIA NIC interfaces in the snapshot, instead of the
+// same NicClusterPolicy for every cluster. Components that set networks
+// inline are left alone, and other inline overrides take precedence over the
+// generated values.
+func configureNetworks(spec *RecipeMetadataSpec, evaluator ConstraintEvaluatorFunc) []ComponentWarning {
+	var warnings []ComponentWarning
+	for i := range spec.ComponentRefs {
+		ref := &spec.ComponentRefs[i]
+		if !ref.IsEnabled() || ref.ComponentName() != "network-operator" {
+			continue
+		}
+		if _, ok := ref.Overrides["networks"]; ok {
+			continue
+		}
+
+		n := snapshotInterfaces(evaluator)
+		if n.empty() {
+			warnings = append(warnings, ComponentWarning{
+				Component: ref.Name,
+				Reason: fmt.Sprintf("no NVIDIA NIC interfaces in the snapshot (%s, %s, %s); set networks to create secondary networks",
+					ibInterfacesPath, ethInterfacesPath, sriovInterfacesPath),
+			})
+			continue
+		}
+
+		overrides := networkOverrides(n)
+		mergeOverrides(overrides, ref.Overrides)
+		ref.Overrides = overrides
+
+		var parts []string
+		if len(n.IB) > 0 {
+			parts = append(parts, "IPoIB on "+strings.Join(n.IB, ", "))
+		}
+		if len(n.Eth) > 0 {
+			parts = append(parts, "macvlan on "+strings.Join(n.Eth, ", "))
+		}
+		if len(n.SRIOV) > 0 {
+			parts = append(parts, "SR-IOV VFs of "+strings.Join(n.SRIOV, ", "))
+		}
+		warnings = append(warnings, ComponentWarning{
+			Component: ref.Name,
+			Reason:    "secondary networks set from the snapshot NIC interfaces: " + strings.Join(parts, "; "),
+		})
+	}
+	return warnings
+}
+
+// stringList converts names to a values list.
+func stringList(names []string) []any {
+	list := make([]any, 0, len(names))
+	for _, name := range names {
+		list = append(list, name)
+	}
+	return list
+}
+
+// listOrEmpty returns list, or an empty list when it is nil.
+func listOrEmpty(list []any) []any {
+	if list == nil {
+		return []any{}
+	}
+	return list
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestConfigureNetworks verifies the Network Operator secondary networks are
+// generated from the NVIDIA NIC interfaces in the snapshot.
+func TestConfigureNetworks(t *testing.T) {
+	disabled := false
+	inline := map[string]any{"networks": map[string]any{"ipoib": []any{}}}
+
+	tests := []struct {
+		name         string
+		ref          ComponentRef
+		snapshot     map[string]string
+		wantNetworks map[string][]string
+		wantRDMA     []string
+		wantSRIOV    []string
+		wantWarning  string
+	}{
+		{
+			name: "ipoib, macvlan and sriov",
+			ref:  ComponentRef{Name: "network-operator"},
+			snapshot: map[string]string{
+				ibInterfacesPath:    "ibp24s0,ibp64s0",
+				ethInterfacesPath:   "ens1f0,ens2f0",
+				sriovInterfacesPath: "ens2f0",
+			},
+			wantNetworks: map[string][]string{
+				"ipoib":      {"ipoib-ibp24s0", "ipoib-ibp64s0"},
+				"macvlan":    {"macvlan-ens1f0"},
+				"hostDevice": {"hostdev-sriov-vf"},
+			},
+			wantRDMA:    []string{rdmaSharedResourceIB, rdmaSharedResourceEth},
+			wantSRIOV:   []string{"ens2f0"},
+			wantWarning: "IPoIB on ibp24s0, ibp64s0; macvlan on ens1f0; SR-IOV VFs of ens2f0",
+		},
+		{
+			name:     "infiniband only",
+			ref:      ComponentRef{Name: "network-operator"},
+			snapshot: map[string]string{ibInterfacesPath: "ibp24s0", ethInterfacesPath: "", sriovInterfacesPath: ""},
+			wantNetworks: map[string][]string{
+				"ipoib": {"ipoib-ibp24s0"},
+			},
+			wantRDMA:    []string{rdmaSharedResourceIB},
+			wantWarning: "IPoIB on ibp24s0",
+		},
+		{
+			name:        "snapshot without NICs",
+			ref:         ComponentRef{Name: "network-operator"},
+			snapshot:    map[string]string{},
+			wantWarning: "no NVIDIA NIC interfaces in the snapshot",
+		},
+		{
+			name:     "inline networks kept",
+			ref:      ComponentRef{Name: "network-operator", Overrides: inline},
+			snapshot: map[string]string{ibInterfacesPath: "ibp24s0"},
+		},
+		{
+			name:     "disabled component",
+			ref:      ComponentRef{Name: "network-operator", Enabled: &disabled},
+			snapshot: map[string]string{ibInterfacesPath: "ibp24s0"},
+		},
+		{
+			name:     "other component",
+			ref:      ComponentRef{Name: "gpu-operator"},
+			snapshot: map[string]string{ibInterfacesPath: "ibp24s0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := func(c Constraint) ConstraintEvalResult {
+				value, ok := tt.snapshot[c.Name]
+				if !ok {
+					return ConstraintEvalResult{Error: errors.New("not found")}
+				}
+				return ConstraintEvalResult{Passed: true, Actual: value}
+			}
+
+			spec := &RecipeMetadataSpec{ComponentRefs: []ComponentRef{tt.ref}}
+			warnings := configureNetworks(spec, evaluator)
+			switch {
+			case tt.wantWarning == "" && len(warnings) != 0:
+				t.Fatalf("warnings = %+v, want none", warnings)
+			case tt.wantWarning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0].Reason, tt.wantWarning)):
+				t.Fatalf("warnings = %+v, want one containing %q", warnings, tt.wantWarning)
+			}
+
+			overrides := spec.ComponentRefs[0].Overrides
+			if tt.wantNetworks == nil {
+				if !reflect.DeepEqual(overrides, tt.ref.Overrides) {
+					t.Errorf("overrides changed: %v", overrides)
+				}
+				return
+			}
+
+			networks, _ := overrides["networks"].(map[string]any)
+			for _, kind := range []string{"ipoib", "macvlan", "hostDevice"} {
+				var names []string
+				entries, _ := networks[kind].([]any)
+				for _, e := range entries {
+					names = append(names, e.(map[string]any)["name"].(string))
+				}
+				if !reflect.DeepEqual(names, tt.wantNetworks[kind]) {
+					t.Errorf("networks.%s = %v, want %v", kind, names, tt.wantNetworks[kind])
+				}
+			}
+
+			var rdma []string
+			if plugin, ok := overrides["rdmaSharedDevicePlugin"].(map[string]any); ok {
+				for _, r := range plugin["resources"].([]any) {
+					rdma = append(rdma, r.(map[string]any)["name"].(string))
+				}
+			}
+			if !reflect.DeepEqual(rdma, tt.wantRDMA) {
+				t.Errorf("rdmaSharedDevicePlugin resources = %v, want %v", rdma, tt.wantRDMA)
+			}
+
+			var pfNames []string
+			if plugin, ok := overrides["sriovDevicePlugin"].(map[string]any); ok {
+				for _, pf := range plugin["resources"].([]any)[0].(map[string]any)["pfNames"].([]any) {
+					pfNames = append(pfNames, pf.(string))
+				}
+			}
+			if !reflect.DeepEqual(pfNames, tt.wantSRIOV) {
+				t.Errorf("sriovDevicePlugin pfNames = %v, want %v", pfNames, tt.wantSRIOV)
+			}
+		})
+	}
+}
+
+// TestConfigureNetworks_InlineOverridesWin verifies inline overrides take
+// precedence over the generated values.
+func TestConfigureNetworks_InlineOverridesWin(t *testing.T) {
+	spec := &RecipeMetadataSpec{ComponentRefs: []ComponentRef{{
+		Name:      "network-operator",
+		Overrides: map[string]any{"rdmaSharedDevicePlugin": map[string]any{"deploy": false}},
+	}}}
+	evaluator := func(c Constraint) ConstraintEvalResult {
+		if c.Name == ibInterfacesPath {
+			return ConstraintEvalResult{Passed: true, Actual: "ibp24s0"}
+		}
+		return ConstraintEvalResult{Error: errors.New("not found")}
+	}
+
+	configureNetworks(spec, evaluator)
+
+	plugin := spec.ComponentRefs[0].Overrides["rdmaSharedDevicePlugin"].(map[string]any)
+	if plugin["deploy"] != false {
+		t.Errorf("rdmaSharedDevicePlugin.deploy = %v, want false", plugin["deploy"])
+	}
+	if plugin["resources"] == nil {
+		t.Error("generated rdmaSharedDevicePlugin.resources should be kept")
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	// RecipeSetKind is the kind of a result that holds one recipe per node group.
+	RecipeSetKind = "recipeSet"
+
+	// defaultNodeGroupName is used when a group's criteria has no distinguishing fields.
+	defaultNodeGroupName = "default"
+)
+
+// NodeCriteria associates a single node with the criteria detected on it.
+type NodeCriteria struct {
+	// Node is the Kubernetes node name the criteria was detected on.
+	Node string
+
+	// Criteria is the criteria detected from the node's measurements.
+	Criteria *Criteria
+
+	// Evaluator evaluates overlay constraints against the node's snapshot.
+	// May be nil, in which case constraints are not evaluated.
+	Evaluator ConstraintEvaluatorFunc
+}
+
+// NodeGroup is a set of nodes that share the same recipe criteria,
+// e.g. an H100 training pool or an L40 inference pool.
+type NodeGroup struct {
+	// Name identifies the group, derived from its distinguishing criteria (e.g. "h100-training").
+	Name string `json:"name" yaml:"name"`
+
+	// Nodes lists the node names in the group, sorted alphabetically.
+	Nodes []string `json:"nodes" yaml:"nodes"`
+
+	// Criteria is the criteria shared by all nodes in the group.
+	Criteria *Criteria `json:"criteria" yaml:"criteria"`
+
+	// evaluator is taken from the first node in the group.
+	evaluator ConstraintEvaluatorFunc
+}
+
+// NodeGroupRecipe is the recipe generated for a single node group.
+type NodeGroupRecipe struct {
+	NodeGroup `json:",inline" yaml:",inline"`
+
+	// Recipe is the recipe result built for the group's criteria.
+	Recipe *RecipeResult `json:"recipe" yaml:"recipe"`
+}
+
+// RecipeSet holds per-node-group recipes for clusters with heterogeneous node pools.
+type RecipeSet struct {
+	// Kind is always "recipeSet".
+	Kind string `json:"kind" yaml:"kind"`
+
+	// APIVersion is the API version.
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+
+	// Groups contains one recipe per node group, ordered by group name.
+	Groups []NodeGroupRecipe `json:"groups" yaml:"groups"`
+}
+
+// GroupNodes partitions nodes into groups of identical criteria.
+// Criteria are compared on service, accelerator, intent and OS; the node count
+// is not part of the comparison and is taken from the first node of each group.
+// Groups are returned sorted by name.
+func GroupNodes(nodes []NodeCriteria) []NodeGroup {
+	byKey := make(map[string]*NodeGroup)
+	keys := make([]string, 0)
+
+	for _, n := range nodes {
+		c := n.Criteria
+		if c == nil {
+			c = NewCriteria()
+		}
+
+		key := nodeGroupKey(c)
+		g, ok := byKey[key]
+		if !ok {
+			gc := *c
+			g = &NodeGroup{
+				Criteria:  &gc,
+				evaluator: n.Evaluator,
+			}
+			byKey[key] = g
+			keys = append(keys, key)
+		}
+		g.Nodes = append(g.Nodes, n.Node)
+	}
+
+	groups := make([]NodeGroup, 0, len(keys))
+	names := make(map[string]int)
+	for _, key := range keys {
+		g := byKey[key]
+		sort.Strings(g.Nodes)
+
+		name := nodeGroupName(g.Criteria)
+		names[name]++
+		if names[name] > 1 {
+			name = fmt.Sprintf("%s-%d", name, names[name])
+		}
+		g.Name = name
+
+		groups = append(groups, *g)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+
+	return groups
+}
+
+// MergeNodeGroupCriteria returns criteria covering all given groups.
+// Fields shared by every group are kept; fields that differ between groups
+// are widened to "any". The node count is the largest of the groups: node
+// counts detected from snapshots and given with --nodes describe the whole
+// cluster, so summing them would count the same nodes once per group.
+func MergeNodeGroupCriteria(groups []NodeGroup) *Criteria {
+	merged := NewCriteria()
+	if len(groups) == 0 {
+		return merged
+	}
+
+	first := groups[0].Criteria
+	if first == nil {
+		first = NewCriteria()
+	}
+	*merged = *first
+	merged.Nodes = 0
+
+	for _, g := range groups {
+		c := g.Criteria
+		if c == nil {
+			c = NewCriteria()
+		}
+		if c.Service != merged.Service {
+			merged.Service = CriteriaServiceAny
+		}
+		if c.Accelerator != merged.Accelerator {
+			merged.Accelerator = CriteriaAcceleratorAny
+		}
+		if c.Intent != merged.Intent {
+			merged.Intent = CriteriaIntentAny
+		}
+		if c.OS != merged.OS {
+			merged.OS = CriteriaOSAny
+		}
+		if c.Scale != merged.Scale {
+			merged.Scale = CriteriaScaleAny
+		}
+		if c.Partitioning != merged.Partitioning {
+			merged.Partitioning = CriteriaPartitioningAny
+		}
+		merged.Nodes = max(merged.Nodes, c.Nodes)
+	}
+
+	return merged
+}
+
+// BuildFromNodeGroups creates one recipe per node group.
+// Each group's recipe is built with the constraint evaluator of its first node,
+// so overlays are filtered against the node pool they apply to rather than
+// whichever node the snapshot agent happened to run on.
+func (b *Builder) BuildFromNodeGroups(ctx context.Context, groups []NodeGroup) (*RecipeSet, error) {
+	if len(groups) == 0 {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "at least one node group is required")
+	}
+
+	set := &RecipeSet{
+		Kind:       RecipeSetKind,
+		APIVersion: RecipeCriteriaAPIVersion,
+		Groups:     make([]NodeGroupRecipe, 0, len(groups)),
+	}
+
+	for _, g := range groups {
+		var (
+			result *RecipeResult
+			err    error
+		)
+		if g.evaluator != nil {
+			result, err = b.BuildFromCriteriaWithEvaluator(ctx, g.Criteria, g.evaluator)
+		} else {
+			result, err = b.BuildFromCriteria(ctx, g.Criteria)
+		}
+		if err != nil {
+			return nil, eidoserrors.WrapWithContext(
+				eidoserrors.ErrCodeInternal,
+				"failed to build recipe for node group",
+				err,
+				map[string]any{
+					"group": g.Name,
+				},
+			)
+		}
+
+		set.Groups = append(set.Groups, NodeGroupRecipe{
+			NodeGroup: g,
+			Recipe:    result,
+		})
+	}
+
+	return set, nil
+}
+
+// nodeGroupKey returns the grouping key for criteria, ignoring node count.
+func nodeGroupKey(c *Criteria) string {
+	return strings.Join([]string{
+		string(c.Service),
+		string(c.Accelerator),
+		string(c.Intent),
+		string(c.OS),
+		string(c.Partitioning),
+	}, "/")
+}
+
+// nodeGroupName derives a readable group name from the criteria fields that
+// typically distinguish node pools within one cluster.
+func nodeGroupName(c *Criteria) string {
+	parts := make([]string, 0, 4)
+	if c.Accelerator != CriteriaAcceleratorAny && c.Accelerator != "" {
+		parts = append(parts, string(c.Accelerator))
+	}
+	if c.Intent != CriteriaIntentAny && c.Intent != "" {
+		parts = append(parts, string(c.Intent))
+	}
+	if c.OS != CriteriaOSAny && c.OS != "" {
+		parts = append(parts, string(c.OS))
+	}
+	if c.Partitioning != CriteriaPartitioningAny && c.Partitioning != "" {
+		parts = append(parts, string(c.Partitioning))
+	}
+	if len(parts) == 0 {
+		return defaultNodeGroupName
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGroupNodes(t *testing.T) {
+	h100 := &Criteria{
+		Service:     CriteriaServiceEKS,
+		Accelerator: CriteriaAcceleratorH100,
+		Intent:      CriteriaIntentTraining,
+		OS:          CriteriaOSUbuntu,
+	}
+	l40 := &Criteria{
+		Service:     CriteriaServiceEKS,
+		Accelerator: CriteriaAcceleratorL40,
+		Intent:      CriteriaIntentInference,
+		OS:          CriteriaOSUbuntu,
+	}
+
+	tests := []struct {
+		name      string
+		nodes     []NodeCriteria
+		wantNames []string
+		wantNodes [][]string
+	}{
+		{
+			name:      "empty",
+			nodes:     nil,
+			wantNames: []string{},
+			wantNodes: [][]string{},
+		},
+		{
+			name: "homogeneous",
+			nodes: []NodeCriteria{
+				{Node: "node-b", Criteria: h100},
+				{Node: "node-a", Criteria: h100},
+			},
+			wantNames: []string{"h100-training-ubuntu"},
+			wantNodes: [][]string{{"node-a", "node-b"}},
+		},
+		{
+			name: "heterogeneous",
+			nodes: []NodeCriteria{
+				{Node: "train-1", Criteria: h100},
+				{Node: "infer-1", Criteria: l40},
+				{Node: "train-2", Criteria: h100},
+			},
+			wantNames: []string{"h100-training-ubuntu", "l40-inference-ubuntu"},
+			wantNodes: [][]string{{"train-1", "train-2"}, {"infer-1"}},
+		},
+		{
+			name: "nil criteria grouped as default",
+			nodes: []NodeCriteria{
+				{Node: "unknown", Criteria: nil},
+			},
+			wantNames: []string{"default"},
+			wantNodes: [][]string{{"unknown"}},
+		},
+		{
+			name: "name collision across services",
+			nodes: []NodeCriteria{
+				{Node: "a", Criteria: &Criteria{Service: CriteriaServiceEKS, Accelerator: CriteriaAcceleratorH100}},
+				{Node: "b", Criteria: &Criteria{Service: CriteriaServiceGKE, Accelerator: CriteriaAcceleratorH100}},
+			},
+			wantNames: []string{"h100", "h100-2"},
+			wantNodes: [][]string{{"a"}, {"b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := GroupNodes(tt.nodes)
+			if len(groups) != len(tt.wantNames) {
+				t.Fatalf("got %d groups, want %d", len(groups), len(tt.wantNames))
+			}
+			for i, g := range groups {
+				if g.Name != tt.wantNames[i] {
+					t.Errorf("group[%d].Name = %q, want %q", i, g.Name, tt.wantNames[i])
+				}
+				if len(g.Nodes) != len(tt.wantNodes[i]) {
+					t.Fatalf("group[%d].Nodes = %v, want %v", i, g.Nodes, tt.wantNodes[i])
+				}
+				for j := range g.Nodes {
+					if g.Nodes[j] != tt.wantNodes[i][j] {
+						t.Errorf("group[%d].Nodes = %v, want %v", i, g.Nodes, tt.wantNodes[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGroupNodes_DoesNotAliasCriteria(t *testing.T) {
+	c := &Criteria{Accelerator: CriteriaAcceleratorH100}
+	groups := GroupNodes([]NodeCriteria{{Node: "a", Criteria: c}})
+
+	groups[0].Criteria.Accelerator = CriteriaAcceleratorL40
+	if c.Accelerator != CriteriaAcceleratorH100 {
+		t.Error("GroupNodes should copy node criteria")
+	}
+}
+
+func TestMergeNodeGroupCriteria(t *testing.T) {
+	groups := []NodeGroup{
+		{Name: "h100", Criteria: &Criteria{
+			Service:     CriteriaServiceEKS,
+			Accelerator: CriteriaAcceleratorH100,
+			Intent:      CriteriaIntentTraining,
+			OS:          CriteriaOSUbuntu,
+			Nodes:       8,
+		}},
+		{Name: "l40", Criteria: &Criteria{
+			Service:     CriteriaServiceEKS,
+			Accelerator: CriteriaAcceleratorL40,
+			Intent:      CriteriaIntentInference,
+			OS:          CriteriaOSUbuntu,
+			Nodes:       4,
+		}},
+	}
+
+	merged := MergeNodeGroupCriteria(groups)
+
+	if merged.Service != CriteriaServiceEKS {
+		t.Errorf("Service = %q, want %q", merged.Service, CriteriaServiceEKS)
+	}
+	if merged.OS != CriteriaOSUbuntu {
+		t.Errorf("OS = %q, want %q", merged.OS, CriteriaOSUbuntu)
+	}
+	if merged.Accelerator != CriteriaAcceleratorAny {
+		t.Errorf("Accelerator = %q, want %q", merged.Accelerator, CriteriaAcceleratorAny)
+	}
+	if merged.Intent != CriteriaIntentAny {
+		t.Errorf("Intent = %q, want %q", merged.Intent, CriteriaIntentAny)
+	}
+	if merged.Nodes != 8 {
+		t.Errorf("Nodes = %d, want 8", merged.Nodes)
+	}
+
+	if got := MergeNodeGroupCriteria(nil); got.Specificity() != 0 {
+		t.Errorf("merging no groups should return generic criteria, got %s", got)
+	}
+}
+
+func TestBuilder_BuildFromNodeGroups(t *testing.T) {
+	ctx := context.Background()
+	builder := NewBuilder(WithVersion("v1.2.3"))
+
+	t.Run("no groups", func(t *testing.T) {
+		if _, err := builder.BuildFromNodeGroups(ctx, nil); err == nil {
+			t.Error("expected error for empty node groups")
+		}
+	})
+
+	t.Run("per group recipes", func(t *testing.T) {
+		groups := GroupNodes([]NodeCriteria{
+			{
+				Node:     "train-1",
+				Criteria: &Criteria{Service: CriteriaServiceEKS, Accelerator: CriteriaAcceleratorH100, Intent: CriteriaIntentTraining},
+				Evaluator: func(Constraint) ConstraintEvalResult {
+					return ConstraintEvalResult{Passed: true}
+				},
+			},
+			{
+				Node:     "infer-1",
+				Criteria: &Criteria{Service: CriteriaServiceEKS, Accelerator: CriteriaAcceleratorL40, Intent: CriteriaIntentInference},
+			},
+		})
+
+		set, err := builder.BuildFromNodeGroups(ctx, groups)
+		if err != nil {
+			t.Fatalf("BuildFromNodeGroups() error = %v", err)
+		}
+		if set.Kind != RecipeSetKind {
+			t.Errorf("Kind = %q, want %q", set.Kind, RecipeSetKind)
+		}
+		if len(set.Groups) != 2 {
+			t.Fatalf("got %d groups, want 2", len(set.Groups))
+		}
+		for _, g := range set.Groups {
+			if g.Recipe == nil {
+				t.Fatalf("group %q has no recipe", g.Name)
+			}
+			if g.Recipe.Criteria.Accelerator != g.Criteria.Accelerator {
+				t.Errorf("group %q recipe built for accelerator %q", g.Name, g.Recipe.Criteria.Accelerator)
+			}
+			if g.Recipe.Metadata.Version != "v1.2.3" {
+				t.Errorf("group %q recipe version = %q, want v1.2.3", g.Name, g.Recipe.Metadata.Version)
+			}
+		}
+	})
+}
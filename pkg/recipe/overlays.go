@@ -0,0 +1,144 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"sort"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// OverlayInfo describes an overlay of the recipe data: when it applies and
+// what it changes relative to the recipe it inherits from.
+type OverlayInfo struct {
+	// Name is the overlay name.
+	Name string `json:"name" yaml:"name"`
+
+	// Base is the recipe the overlay inherits from ("base" by default).
+	Base string `json:"base" yaml:"base"`
+
+	// Source is the data file the overlay was loaded from.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+
+	// Criteria is when the overlay applies. Overlays without criteria, such
+	// as AutoscalingOverlay, are never matched and only applied on request.
+	Criteria *Criteria `json:"criteria,omitempty" yaml:"criteria,omitempty"`
+
+	// AddedComponents are the components the overlay adds to its base.
+	// Overlays without criteria never add components.
+	AddedComponents []string `json:"addedComponents,omitempty" yaml:"addedComponents,omitempty"`
+
+	// ModifiedComponents are the components of its base the overlay changes.
+	ModifiedComponents []string `json:"modifiedComponents,omitempty" yaml:"modifiedComponents,omitempty"`
+
+	// Constraints are the deployment constraints the overlay imposes.
+	Constraints []Constraint `json:"constraints,omitempty" yaml:"constraints,omitempty"`
+}
+
+// OverlayCatalog lists the overlays available in the recipe data.
+type OverlayCatalog struct {
+	// Overlays are the overlays sorted by name.
+	Overlays []OverlayInfo `json:"overlays" yaml:"overlays"`
+}
+
+// GetAvailableOverlays returns the overlays of the recipe data in use,
+// sorted by name. When criteria is non-nil, only overlays compatible with it
+// are returned: an overlay is excluded when it requires a different value for
+// a field the criteria sets. Fields left as "any" do not filter, so setting
+// just an accelerator lists every overlay that can apply to it.
+func GetAvailableOverlays(ctx context.Context, criteria *Criteria) ([]OverlayInfo, error) {
+	store, err := loadMetadataStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overlays := make([]OverlayInfo, 0, len(store.Overlays))
+	for name, overlay := range store.Overlays {
+		if criteria != nil && !overlayCompatible(overlay.Spec.Criteria, criteria) {
+			continue
+		}
+
+		info, infoErr := store.overlayInfo(name, overlay)
+		if infoErr != nil {
+			return nil, infoErr
+		}
+		overlays = append(overlays, *info)
+	}
+
+	sort.Slice(overlays, func(i, j int) bool {
+		return overlays[i].Name < overlays[j].Name
+	})
+	return overlays, nil
+}
+
+// overlayInfo describes the named overlay, classifying its components by
+// whether the recipes it inherits from already deploy them.
+func (s *MetadataStore) overlayInfo(name string, overlay *RecipeMetadata) (*OverlayInfo, error) {
+	chain, err := s.resolveInheritanceChain(name)
+	if err != nil {
+		return nil, eidoserrors.WrapWithContext(eidoserrors.ErrCodeInvalidRequest,
+			"failed to resolve inheritance chain", err, map[string]any{"overlay": name})
+	}
+
+	inherited := make(map[string]bool)
+	for _, parent := range chain[:len(chain)-1] {
+		for _, ref := range parent.Spec.ComponentRefs {
+			inherited[ref.Name] = true
+		}
+	}
+
+	info := &OverlayInfo{
+		Name:        name,
+		Base:        overlay.Spec.Base,
+		Source:      s.Sources[name],
+		Criteria:    overlay.Spec.Criteria,
+		Constraints: overlay.Spec.Constraints,
+	}
+	if info.Base == "" {
+		info.Base = "base"
+	}
+	for _, ref := range overlay.Spec.ComponentRefs {
+		// Overlays without criteria are applied with ApplyOverlay, which
+		// only changes components already in the recipe.
+		if inherited[ref.Name] || overlay.Spec.Criteria == nil {
+			info.ModifiedComponents = append(info.ModifiedComponents, ref.Name)
+		} else {
+			info.AddedComponents = append(info.AddedComponents, ref.Name)
+		}
+	}
+	sort.Strings(info.AddedComponents)
+	sort.Strings(info.ModifiedComponents)
+
+	return info, nil
+}
+
+// overlayCompatible reports whether an overlay with the given criteria can
+// apply to a recipe for query. Fields either side leaves as "any" match.
+func overlayCompatible(overlay, query *Criteria) bool {
+	if overlay == nil {
+		return true
+	}
+	compatible := func(a, b string) bool {
+		return a == "" || a == criteriaAnyValue || b == "" || b == criteriaAnyValue || a == b
+	}
+	return compatible(string(overlay.Service), string(query.Service)) &&
+		compatible(string(overlay.Accelerator), string(query.Accelerator)) &&
+		compatible(string(overlay.Intent), string(query.Intent)) &&
+		compatible(string(overlay.OS), string(query.OS)) &&
+		compatible(string(overlay.EffectiveScale()), string(query.EffectiveScale())) &&
+		compatible(string(overlay.Partitioning), string(query.Partitioning)) &&
+		(overlay.Nodes == 0 || query.Nodes == 0 || overlay.Nodes == query.Nodes)
+}
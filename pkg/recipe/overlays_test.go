@@ -0,0 +1,148 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGetAvailableOverlays(t *testing.T) {
+	ctx := context.Background()
+	store, err := loadMetadataStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to load metadata store: %v", err)
+	}
+
+	overlays, err := GetAvailableOverlays(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetAvailableOverlays() error = %v", err)
+	}
+	if len(overlays) != len(store.Overlays) {
+		t.Errorf("got %d overlays, want %d", len(overlays), len(store.Overlays))
+	}
+	if !slices.IsSortedFunc(overlays, func(a, b OverlayInfo) int { return strings.Compare(a.Name, b.Name) }) {
+		t.Error("overlays should be sorted by name")
+	}
+
+	var gb200 *OverlayInfo
+	for i := range overlays {
+		if overlays[i].Name == "gb200-eks-training" {
+			gb200 = &overlays[i]
+		}
+	}
+	if gb200 == nil {
+		t.Fatal("gb200-eks-training overlay not listed")
+	}
+	if gb200.Base != "eks-training" {
+		t.Errorf("Base = %q, want eks-training", gb200.Base)
+	}
+	if gb200.Source != "overlays/gb200-eks-training.yaml" {
+		t.Errorf("Source = %q, want overlays/gb200-eks-training.yaml", gb200.Source)
+	}
+	if !slices.Contains(gb200.AddedComponents, "nvidia-dra-driver-gpu") {
+		t.Errorf("AddedComponents = %v, want nvidia-dra-driver-gpu", gb200.AddedComponents)
+	}
+	if !slices.Contains(gb200.ModifiedComponents, "gpu-operator") {
+		t.Errorf("ModifiedComponents = %v, want gpu-operator", gb200.ModifiedComponents)
+	}
+	if len(gb200.Constraints) == 0 {
+		t.Error("Constraints should list the overlay constraints")
+	}
+}
+
+func TestGetAvailableOverlays_Filtered(t *testing.T) {
+	criteria, err := BuildCriteria(WithCriteriaAccelerator("gb200"))
+	if err != nil {
+		t.Fatalf("BuildCriteria() error = %v", err)
+	}
+
+	overlays, err := GetAvailableOverlays(context.Background(), criteria)
+	if err != nil {
+		t.Fatalf("GetAvailableOverlays() error = %v", err)
+	}
+
+	names := make([]string, 0, len(overlays))
+	for _, o := range overlays {
+		names = append(names, o.Name)
+		if c := o.Criteria; c != nil && c.Accelerator != "" && c.Accelerator != CriteriaAcceleratorAny &&
+			c.Accelerator != CriteriaAcceleratorGB200 {
+			t.Errorf("overlay %s for accelerator %s should be filtered out", o.Name, c.Accelerator)
+		}
+	}
+	for _, want := range []string{"eks", "gb200-eks-training"} {
+		if !slices.Contains(names, want) {
+			t.Errorf("overlays %v should include %s", names, want)
+		}
+	}
+}
+
+func TestOverlayCompatible(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay *Criteria
+		query   *Criteria
+		want    bool
+	}{
+		{"no overlay criteria", nil, &Criteria{Service: CriteriaServiceEKS}, true},
+		{"query any", &Criteria{Service: CriteriaServiceEKS}, NewCriteria(), true},
+		{"same value", &Criteria{Service: CriteriaServiceEKS}, &Criteria{Service: CriteriaServiceEKS}, true},
+		{"different value", &Criteria{Service: CriteriaServiceEKS}, &Criteria{Service: CriteriaServiceGKE}, false},
+		{"different nodes", &Criteria{Nodes: 8}, &Criteria{Nodes: 4}, false},
+		{"scale from nodes", &Criteria{Scale: CriteriaScaleMedium}, &Criteria{Nodes: 32}, true},
+		{"different scale", &Criteria{Scale: CriteriaScaleLarge}, &Criteria{Nodes: 32}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overlayCompatible(tt.overlay, tt.query); got != tt.want {
+				t.Errorf("overlayCompatible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleOverlays(t *testing.T) {
+	b := NewBuilder()
+
+	w := httptest.NewRecorder()
+	b.HandleOverlays(w, httptest.NewRequest(http.MethodGet, "/v1/recipe/overlays?service=eks", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var catalog OverlayCatalog
+	if err := json.Unmarshal(w.Body.Bytes(), &catalog); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(catalog.Overlays) == 0 {
+		t.Error("expected overlays in response")
+	}
+
+	w = httptest.NewRecorder()
+	b.HandleOverlays(w, httptest.NewRequest(http.MethodGet, "/v1/recipe/overlays?service=invalid", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid criteria status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w = httptest.NewRecorder()
+	b.HandleOverlays(w, httptest.NewRequest(http.MethodPost, "/v1/recipe/overlays", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
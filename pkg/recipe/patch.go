@@ -0,0 +1,107 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"gopkg.in/yaml.v3"
+)
+
+// PatchType is the format of a values patch.
+type PatchType string
+
+// Supported values patch formats.
+const (
+	// PatchTypeJSON is an RFC 6902 JSON patch: a list of operations.
+	PatchTypeJSON PatchType = "json"
+
+	// PatchTypeMerge is a values document deep-merged over the values,
+	// following its MergeStrategiesKey annotations.
+	PatchTypeMerge PatchType = "merge"
+)
+
+// ValuesPatch is a patch applied to the final values of a component, for
+// overrides that dot-notation sets cannot express, such as list edits.
+type ValuesPatch struct {
+	// Type is the patch format, detected from the document.
+	Type PatchType
+
+	ops   jsonpatch.Patch
+	merge map[string]any
+}
+
+// ParseValuesPatch parses a YAML or JSON values patch. A list of operations
+// is a JSON patch, whose operations are validated; a map is a merge patch,
+// whose merge strategies are validated. Malformed patches fail here rather
+// than when applied.
+func ParseValuesPatch(data []byte) (*ValuesPatch, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid values patch: %w", err)
+	}
+
+	switch v := doc.(type) {
+	case []any:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON patch: %w", err)
+		}
+		ops, err := jsonpatch.DecodePatch(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON patch: %w", err)
+		}
+		return &ValuesPatch{Type: PatchTypeJSON, ops: ops}, nil
+
+	case map[string]any:
+		if err := ValidateMergeStrategies(v); err != nil {
+			return nil, fmt.Errorf("invalid merge patch: %w", err)
+		}
+		return &ValuesPatch{Type: PatchTypeMerge, merge: v}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid values patch: expected a list of JSON patch operations or a values map, got %T", doc)
+	}
+}
+
+// Apply applies the patch to values and returns the patched values. A JSON
+// patch returns a new map; a merge patch modifies values in place.
+func (p *ValuesPatch) Apply(values map[string]any) (map[string]any, error) {
+	if values == nil {
+		values = make(map[string]any)
+	}
+	if p.Type == PatchTypeMerge {
+		mergeValues(values, p.merge)
+		return values, nil
+	}
+
+	doc, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode values: %w", err)
+	}
+	patched, err := p.ops.Apply(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply JSON patch: %w", err)
+	}
+
+	// Decode as YAML so integers stay integers in the written values
+	result := make(map[string]any)
+	if err := yaml.Unmarshal(patched, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode patched values: %w", err)
+	}
+	return result, nil
+}
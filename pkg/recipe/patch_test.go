@@ -0,0 +1,167 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseValuesPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantType PatchType
+		wantErr  string
+	}{
+		{
+			name:     "json patch",
+			data:     "- op: add\n  path: /tolerations/-\n  value: {key: gpu, operator: Exists}\n- op: remove\n  path: /gds\n",
+			wantType: PatchTypeJSON,
+		},
+		{
+			name:     "json patch as json",
+			data:     `[{"op": "replace", "path": "/driver/version", "value": "580"}]`,
+			wantType: PatchTypeJSON,
+		},
+		{
+			name:     "merge patch",
+			data:     "$merge:\n  tolerations: append-list\ntolerations:\n  - key: gpu\n",
+			wantType: PatchTypeMerge,
+		},
+		{name: "unsupported op", data: "- op: merge\n  path: /a\n", wantErr: "unsupported operation"},
+		{name: "missing value", data: "- op: add\n  path: /a\n", wantErr: "missing value field"},
+		{name: "missing from", data: "- op: move\n  path: /a\n", wantErr: "missing from field"},
+		{name: "missing path", data: "- op: remove\n", wantErr: "missing path field"},
+		{name: "invalid merge strategy", data: "$merge:\n  a: prepend\na: []\n", wantErr: "invalid merge patch"},
+		{name: "scalar document", data: "true\n", wantErr: "expected a list"},
+		{name: "invalid yaml", data: "a: [", wantErr: "invalid values patch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseValuesPatch([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseValuesPatch() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseValuesPatch() error = %v", err)
+			}
+			if p.Type != tt.wantType {
+				t.Errorf("Type = %s, want %s", p.Type, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestValuesPatch_Apply(t *testing.T) {
+	base := func() map[string]any {
+		return map[string]any{
+			"driver":      map[string]any{"version": "570", "enabled": true},
+			"gds":         map[string]any{"enabled": false},
+			"replicas":    2,
+			"tolerations": []any{map[string]any{"key": "a", "operator": "Exists"}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		patch   string
+		values  map[string]any
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name: "json patch",
+			patch: "- op: add\n  path: /tolerations/-\n  value: {key: b, operator: Exists}\n" +
+				"- op: replace\n  path: /driver/version\n  value: \"580\"\n" +
+				"- op: remove\n  path: /gds\n",
+			values: base(),
+			want: map[string]any{
+				"driver":   map[string]any{"version": "580", "enabled": true},
+				"replicas": 2,
+				"tolerations": []any{
+					map[string]any{"key": "a", "operator": "Exists"},
+					map[string]any{"key": "b", "operator": "Exists"},
+				},
+			},
+		},
+		{
+			name:   "json patch onto nil values",
+			patch:  "- op: add\n  path: /replicas\n  value: 3\n",
+			values: nil,
+			want:   map[string]any{"replicas": 3},
+		},
+		{
+			name:    "failed test op",
+			patch:   "- op: test\n  path: /replicas\n  value: 5\n",
+			values:  base(),
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			patch:   "- op: replace\n  path: /operator/tag\n  value: x\n",
+			values:  base(),
+			wantErr: true,
+		},
+		{
+			name:   "merge patch",
+			patch:  "$merge:\n  tolerations: append-list\ntolerations:\n  - {key: b, operator: Exists}\ndriver:\n  version: \"580\"\n",
+			values: base(),
+			want: map[string]any{
+				"driver":   map[string]any{"version": "580", "enabled": true},
+				"gds":      map[string]any{"enabled": false},
+				"replicas": 2,
+				"tolerations": []any{
+					map[string]any{"key": "a", "operator": "Exists"},
+					map[string]any{"key": "b", "operator": "Exists"},
+				},
+			},
+		},
+		{
+			name:   "merge patch delete",
+			patch:  "$merge:\n  gds: delete\n",
+			values: base(),
+			want: map[string]any{
+				"driver":      map[string]any{"version": "570", "enabled": true},
+				"replicas":    2,
+				"tolerations": []any{map[string]any{"key": "a", "operator": "Exists"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseValuesPatch([]byte(tt.patch))
+			if err != nil {
+				t.Fatalf("ParseValuesPatch() error = %v", err)
+			}
+			got, err := p.Apply(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Apply() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+const (
+	// profilesDir is the recipe data directory holding bundle profiles.
+	profilesDir = "profiles"
+
+	// BundleProfileKind is the kind of bundle profile documents.
+	BundleProfileKind = "BundleProfile"
+)
+
+// profileNamePattern matches valid bundle profile names.
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// BundleProfile is a set of curated component value presets, such as dev,
+// staging or production, applied when a bundle is generated. Profiles live in
+// the profiles/ directory of the recipe data, so an external data directory
+// can override or add them.
+type BundleProfile struct {
+	RecipeMetadataHeader `json:",inline" yaml:",inline"`
+
+	// Spec contains the profile presets.
+	Spec BundleProfileSpec `json:"spec" yaml:"spec"`
+}
+
+// BundleProfileSpec contains the presets of a bundle profile.
+type BundleProfileSpec struct {
+	// Description says what the profile is for.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Components maps component names, or their value override keys, to
+	// values merged over the recipe values. Merge strategy annotations are
+	// supported as in recipe overrides.
+	Components map[string]map[string]any `json:"components,omitempty" yaml:"components,omitempty"`
+}
+
+// LoadBundleProfile loads the named bundle profile from the recipe data.
+// It returns an ErrCodeNotFound error listing the available profiles when
+// the profile does not exist.
+func LoadBundleProfile(name string) (*BundleProfile, error) {
+	if !profileNamePattern.MatchString(name) {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("invalid bundle profile name %q", name))
+	}
+
+	file := path.Join(profilesDir, name+".yaml")
+	data, err := GetDataProvider().ReadFile(file)
+	if err != nil {
+		available, _ := GetBundleProfileNames()
+		return nil, eidoserrors.WrapWithContext(eidoserrors.ErrCodeNotFound,
+			fmt.Sprintf("bundle profile %q not found (available: %s)", name, strings.Join(available, ", ")),
+			err, map[string]any{"profile": name, "available": available})
+	}
+
+	var profile BundleProfile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("failed to parse %s", file), err)
+	}
+	if profile.Kind != BundleProfileKind {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("%s: kind must be %s, got %q", file, BundleProfileKind, profile.Kind))
+	}
+	if profile.Metadata.Name != name {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("%s: metadata.name %q does not match the file name", file, profile.Metadata.Name))
+	}
+	for component, values := range profile.Spec.Components {
+		if err := ValidateMergeStrategies(values); err != nil {
+			return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				fmt.Sprintf("%s: invalid values for component %s", file, component), err)
+		}
+	}
+
+	return &profile, nil
+}
+
+// GetBundleProfileNames returns the sorted names of the bundle profiles in
+// the recipe data.
+func GetBundleProfileNames() ([]string, error) {
+	var names []string
+	err := GetDataProvider().WalkDir(profilesDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".yaml" {
+			return nil
+		}
+		names = append(names, strings.TrimSuffix(path.Base(p), ".yaml"))
+		return nil
+	})
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to list bundle profiles", err)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Apply merges the presets the profile has under key over values. It does
+// nothing when the profile has no presets for key.
+func (p *BundleProfile) Apply(key string, values map[string]any) {
+	if p == nil {
+		return
+	}
+	if presets, ok := p.Spec.Components[key]; ok {
+		mergeValues(values, presets)
+	}
+}
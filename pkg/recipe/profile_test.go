@@ -0,0 +1,144 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+func TestLoadBundleProfile_Embedded(t *testing.T) {
+	for _, name := range []string{"dev", "staging", "production"} {
+		t.Run(name, func(t *testing.T) {
+			profile, err := LoadBundleProfile(name)
+			if err != nil {
+				t.Fatalf("LoadBundleProfile(%q) error = %v", name, err)
+			}
+			if profile.Kind != BundleProfileKind {
+				t.Errorf("Kind = %q, want %q", profile.Kind, BundleProfileKind)
+			}
+			if profile.Spec.Description == "" {
+				t.Error("Description should not be empty")
+			}
+			if len(profile.Spec.Components) == 0 {
+				t.Error("Components should not be empty")
+			}
+		})
+	}
+}
+
+func TestLoadBundleProfile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		code    eidoserrors.ErrorCode
+	}{
+		{"not found", "nope", eidoserrors.ErrCodeNotFound},
+		{"invalid name", "../overlays/eks", eidoserrors.ErrCodeInvalidRequest},
+		{"empty name", "", eidoserrors.ErrCodeInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadBundleProfile(tt.profile)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			var structErr *eidoserrors.StructuredError
+			if !stderrors.As(err, &structErr) || structErr.Code != tt.code {
+				t.Errorf("error = %v, want code %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestGetBundleProfileNames(t *testing.T) {
+	names, err := GetBundleProfileNames()
+	if err != nil {
+		t.Fatalf("GetBundleProfileNames() error = %v", err)
+	}
+	want := []string{"dev", "production", "staging"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("GetBundleProfileNames() = %v, want %v", names, want)
+	}
+}
+
+func TestBundleProfile_Apply(t *testing.T) {
+	profile := &BundleProfile{Spec: BundleProfileSpec{
+		Components: map[string]map[string]any{
+			"cert-manager": {"replicaCount": 2, "webhook": map[string]any{"replicaCount": 2}},
+		},
+	}}
+
+	values := map[string]any{"replicaCount": 1, "webhook": map[string]any{"timeoutSeconds": 10}}
+	profile.Apply("cert-manager", values)
+	want := map[string]any{
+		"replicaCount": 2,
+		"webhook":      map[string]any{"replicaCount": 2, "timeoutSeconds": 10},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("Apply() values = %v, want %v", values, want)
+	}
+
+	other := map[string]any{"replicaCount": 1}
+	profile.Apply("gpu-operator", other)
+	if other["replicaCount"] != 1 {
+		t.Errorf("Apply() changed values of a component without presets: %v", other)
+	}
+
+	var nilProfile *BundleProfile
+	nilProfile.Apply("cert-manager", values)
+}
+
+func TestLoadBundleProfile_ExternalData(t *testing.T) {
+	restoreRecipeData(t)
+
+	provider := newReloadTestProvider(t, map[string]string{
+		"profiles/perf.yaml": `kind: BundleProfile
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  name: perf
+spec:
+  description: Performance testing
+  components:
+    gpu-operator:
+      dcgmExporter:
+        enabled: false
+`,
+	})
+	if _, err := ReloadData(context.Background(), provider); err != nil {
+		t.Fatalf("ReloadData() error = %v", err)
+	}
+
+	profile, err := LoadBundleProfile("perf")
+	if err != nil {
+		t.Fatalf("LoadBundleProfile(perf) error = %v", err)
+	}
+	if _, ok := profile.Spec.Components["gpu-operator"]; !ok {
+		t.Error("expected gpu-operator presets")
+	}
+
+	names, err := GetBundleProfileNames()
+	if err != nil {
+		t.Fatalf("GetBundleProfileNames() error = %v", err)
+	}
+	want := []string{"dev", "perf", "production", "staging"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("GetBundleProfileNames() = %v, want %v", names, want)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -441,6 +442,7 @@ func mergeRegistries(embedded, external *ComponentRegistry) *ComponentRegistry {
 
 // Global data provider (defaults to embedded, can be set for layered)
 var (
+	dataProviderMu         sync.RWMutex
 	globalDataProvider     DataProvider
 	dataProviderGeneration int // Incremented when provider changes
 )
@@ -450,14 +452,26 @@ var (
 // Note: This invalidates cached data, so callers should ensure this is called
 // early in the application lifecycle.
 func SetDataProvider(provider DataProvider) {
+	dataProviderMu.Lock()
 	globalDataProvider = provider
 	dataProviderGeneration++
-	slog.Info("data provider set", "generation", dataProviderGeneration)
+	generation := dataProviderGeneration
+	dataProviderMu.Unlock()
+	slog.Info("data provider set", "generation", generation)
 }
 
 // GetDataProvider returns the global data provider.
 // Returns the embedded provider if none was set.
 func GetDataProvider() DataProvider {
+	dataProviderMu.RLock()
+	provider := globalDataProvider
+	dataProviderMu.RUnlock()
+	if provider != nil {
+		return provider
+	}
+
+	dataProviderMu.Lock()
+	defer dataProviderMu.Unlock()
 	if globalDataProvider == nil {
 		slog.Debug("initializing default embedded data provider")
 		globalDataProvider = NewEmbeddedDataProvider(dataFS, "data")
@@ -468,5 +482,7 @@ func GetDataProvider() DataProvider {
 // GetDataProviderGeneration returns the current data provider generation.
 // This is used by caches to detect when they need to reload.
 func GetDataProviderGeneration() int {
+	dataProviderMu.RLock()
+	defer dataProviderMu.RUnlock()
 	return dataProviderGeneration
 }
@@ -0,0 +1,237 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// NamedRecipe is a recipe along with the name it is reported under when
+// recipes are merged, such as the file it was loaded from.
+type NamedRecipe struct {
+	// Name identifies the recipe in conflict messages.
+	Name string
+
+	// Recipe is the recipe result.
+	Recipe *RecipeResult
+}
+
+// MergeRecipes combines recipes for separately maintained stacks, such as a
+// GPU stack, a networking stack and an observability stack, into one recipe
+// deployed together. The merge is conflict-free or fails:
+//   - A component in several recipes must be defined identically in each,
+//     apart from its dependencies, which are the union of all recipes.
+//   - Constraints with the same name must have the same value.
+//   - Criteria fields set to "any" take the value of the recipes that set
+//     them; recipes setting different values conflict.
+//
+// All conflicts are reported at once. The merged dependency graph is
+// validated and DeploymentOrder is recomputed from it, so the result does not
+// depend on the order of the recipes beyond the order of metadata lists.
+func MergeRecipes(recipes []NamedRecipe) (*RecipeResult, error) {
+	if len(recipes) < 2 {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "at least two recipes are required for a merge")
+	}
+
+	merged := &RecipeResult{
+		Kind:       "recipeResult",
+		APIVersion: "eidos.nvidia.com/v1alpha1",
+		Criteria:   NewCriteria(),
+	}
+
+	var conflicts []string
+	components := make(map[string]ComponentRef)
+	componentOwners := make(map[string]string)
+	constraints := make(map[string]Constraint)
+	constraintOwners := make(map[string]string)
+	criteriaOwners := make(map[string]string)
+
+	for _, nr := range recipes {
+		r := nr.Recipe
+		if r == nil {
+			return nil, eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+				"recipe cannot be nil", map[string]any{"recipe": nr.Name})
+		}
+
+		if merged.Metadata.Version == "" {
+			merged.Metadata.Version = r.Metadata.Version
+		}
+		merged.Metadata.AppliedOverlays = appendUnique(merged.Metadata.AppliedOverlays, r.Metadata.AppliedOverlays...)
+		merged.Metadata.ExcludedOverlays = appendUnique(merged.Metadata.ExcludedOverlays, r.Metadata.ExcludedOverlays...)
+		merged.Metadata.ConstraintWarnings = append(merged.Metadata.ConstraintWarnings, r.Metadata.ConstraintWarnings...)
+		merged.Metadata.ComponentWarnings = append(merged.Metadata.ComponentWarnings, r.Metadata.ComponentWarnings...)
+
+		conflicts = append(conflicts, mergeRecipeCriteria(merged.Criteria, r.Criteria, nr.Name, criteriaOwners)...)
+
+		for _, c := range r.Constraints {
+			existing, exists := constraints[c.Name]
+			if !exists {
+				constraints[c.Name] = c
+				constraintOwners[c.Name] = nr.Name
+				continue
+			}
+			if existing.Value != c.Value {
+				conflicts = append(conflicts, fmt.Sprintf("constraint %q is %q in %s but %q in %s",
+					c.Name, existing.Value, constraintOwners[c.Name], c.Value, nr.Name))
+			}
+		}
+
+		for _, ref := range r.ComponentRefs {
+			existing, exists := components[ref.Name]
+			if !exists {
+				ref.DependencyRefs = slices.Clone(ref.DependencyRefs)
+				components[ref.Name] = ref
+				componentOwners[ref.Name] = nr.Name
+				continue
+			}
+			if fields := componentRefDifferences(existing, ref); len(fields) > 0 {
+				conflicts = append(conflicts, fmt.Sprintf("component %q differs between %s and %s: %s",
+					ref.Name, componentOwners[ref.Name], nr.Name, strings.Join(fields, ", ")))
+				continue
+			}
+			existing.DependencyRefs = appendUnique(existing.DependencyRefs, ref.DependencyRefs...)
+			components[ref.Name] = existing
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return nil, eidoserrors.NewWithContext(eidoserrors.ErrCodeInvalidRequest,
+			fmt.Sprintf("recipes conflict: %s", strings.Join(conflicts, "; ")),
+			map[string]any{"conflicts": conflicts})
+	}
+
+	spec := RecipeMetadataSpec{}
+	for _, c := range constraints {
+		spec.Constraints = append(spec.Constraints, c)
+	}
+	sort.Slice(spec.Constraints, func(i, j int) bool {
+		return spec.Constraints[i].Name < spec.Constraints[j].Name
+	})
+	for _, ref := range components {
+		spec.ComponentRefs = append(spec.ComponentRefs, ref)
+	}
+	sort.Slice(spec.ComponentRefs, func(i, j int) bool {
+		return spec.ComponentRefs[i].Name < spec.ComponentRefs[j].Name
+	})
+
+	if err := spec.ValidateDependencies(); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "merged recipe validation failed", err)
+	}
+	deployOrder, err := spec.TopologicalSort()
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to compute deployment order", err)
+	}
+
+	merged.Constraints = spec.Constraints
+	merged.ComponentRefs = spec.ComponentRefs
+	merged.DeploymentOrder = deployOrder
+	merged.Metadata.DisabledComponents = spec.DisabledComponentNames()
+	return merged, nil
+}
+
+// mergeRecipeCriteria narrows merged to the criteria of a recipe named name.
+// A field set to "any" on either side takes the other's value; differing
+// values are returned as conflicts. owners records which recipe set a field.
+func mergeRecipeCriteria(merged, c *Criteria, name string, owners map[string]string) []string {
+	if c == nil {
+		return nil
+	}
+
+	var conflicts []string
+	narrow := func(field, current, value, wildcard string, set func()) {
+		if value == "" || value == wildcard {
+			return
+		}
+		if current == "" || current == wildcard {
+			set()
+			owners[field] = name
+			return
+		}
+		if current != value {
+			conflicts = append(conflicts, fmt.Sprintf("criteria %s is %q in %s but %q in %s",
+				field, current, owners[field], value, name))
+		}
+	}
+
+	narrow("service", string(merged.Service), string(c.Service), string(CriteriaServiceAny),
+		func() { merged.Service = c.Service })
+	narrow("accelerator", string(merged.Accelerator), string(c.Accelerator), string(CriteriaAcceleratorAny),
+		func() { merged.Accelerator = c.Accelerator })
+	narrow("intent", string(merged.Intent), string(c.Intent), string(CriteriaIntentAny),
+		func() { merged.Intent = c.Intent })
+	narrow("os", string(merged.OS), string(c.OS), string(CriteriaOSAny),
+		func() { merged.OS = c.OS })
+	narrow("scale", string(merged.Scale), string(c.Scale), string(CriteriaScaleAny),
+		func() { merged.Scale = c.Scale })
+	narrow("partitioning", string(merged.Partitioning), string(c.Partitioning), string(CriteriaPartitioningAny),
+		func() { merged.Partitioning = c.Partitioning })
+	if c.Nodes != 0 {
+		narrow("nodes", nodesString(merged.Nodes), nodesString(c.Nodes), "",
+			func() { merged.Nodes = c.Nodes })
+	}
+
+	return conflicts
+}
+
+// nodesString formats a node count for criteria conflicts, with 0 (any) as
+// the empty string.
+func nodesString(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// componentRefDifferences returns the fields, other than DependencyRefs, in
+// which two definitions of a component differ.
+func componentRefDifferences(a, b ComponentRef) []string {
+	var fields []string
+	diff := func(field string, differs bool) {
+		if differs {
+			fields = append(fields, field)
+		}
+	}
+
+	diff("component", a.ComponentName() != b.ComponentName())
+	diff("releaseName", a.HelmReleaseName() != b.HelmReleaseName())
+	diff("type", a.Type != b.Type)
+	diff("source", a.Source != b.Source)
+	diff("version", a.Version != b.Version)
+	diff("tag", a.Tag != b.Tag)
+	diff("valuesFile", a.ValuesFile != b.ValuesFile)
+	diff("overrides", (len(a.Overrides) > 0 || len(b.Overrides) > 0) && !reflect.DeepEqual(a.Overrides, b.Overrides))
+	diff("patches", !slices.Equal(a.Patches, b.Patches))
+	diff("manifestFiles", !slices.Equal(a.ManifestFiles, b.ManifestFiles))
+	diff("path", a.Path != b.Path)
+	diff("enabled", a.IsEnabled() != b.IsEnabled())
+
+	return fields
+}
+
+// appendUnique appends the values not already in s.
+func appendUnique(s []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func gpuStackRecipe() *RecipeResult {
+	r := &RecipeResult{
+		Criteria: &Criteria{Service: CriteriaServiceEKS, Accelerator: CriteriaAcceleratorH100, Intent: CriteriaIntentAny, OS: CriteriaOSAny},
+		Constraints: []Constraint{
+			{Name: "K8s.server.version", Value: ">= 1.30"},
+		},
+		ComponentRefs: []ComponentRef{
+			{Name: "cert-manager", Type: ComponentTypeHelm, Source: "https://charts.jetstack.io", Version: "v1.17.2"},
+			{Name: "gpu-operator", Type: ComponentTypeHelm, Source: "https://helm.ngc.nvidia.com/nvidia", Version: "v25.3.3",
+				DependencyRefs: []string{"cert-manager"}},
+		},
+		DeploymentOrder: []string{"cert-manager", "gpu-operator"},
+	}
+	r.Metadata.Version = "v0.9.0"
+	r.Metadata.AppliedOverlays = []string{"base", "eks"}
+	return r
+}
+
+func networkStackRecipe() *RecipeResult {
+	r := &RecipeResult{
+		Criteria: &Criteria{Service: CriteriaServiceEKS, Accelerator: CriteriaAcceleratorAny, Intent: CriteriaIntentTraining, OS: CriteriaOSAny},
+		Constraints: []Constraint{
+			{Name: "K8s.server.version", Value: ">= 1.30"},
+			{Name: "OS.release.ID", Value: "ubuntu"},
+		},
+		ComponentRefs: []ComponentRef{
+			{Name: "cert-manager", Type: ComponentTypeHelm, Source: "https://charts.jetstack.io", Version: "v1.17.2"},
+			{Name: "gpu-operator", Type: ComponentTypeHelm, Source: "https://helm.ngc.nvidia.com/nvidia", Version: "v25.3.3",
+				DependencyRefs: []string{"network-operator"}},
+			{Name: "network-operator", Type: ComponentTypeHelm, Source: "https://helm.ngc.nvidia.com/nvidia", Version: "v25.4.0",
+				DependencyRefs: []string{"cert-manager"}},
+		},
+		DeploymentOrder: []string{"cert-manager", "network-operator", "gpu-operator"},
+	}
+	r.Metadata.Version = "v0.9.0"
+	r.Metadata.AppliedOverlays = []string{"base", "eks-training"}
+	return r
+}
+
+func TestMergeRecipes(t *testing.T) {
+	merged, err := MergeRecipes([]NamedRecipe{
+		{Name: "gpu.yaml", Recipe: gpuStackRecipe()},
+		{Name: "network.yaml", Recipe: networkStackRecipe()},
+	})
+	if err != nil {
+		t.Fatalf("MergeRecipes() error = %v", err)
+	}
+
+	wantOrder := []string{"cert-manager", "network-operator", "gpu-operator"}
+	if !slices.Equal(merged.DeploymentOrder, wantOrder) {
+		t.Errorf("DeploymentOrder = %v, want %v", merged.DeploymentOrder, wantOrder)
+	}
+	if len(merged.ComponentRefs) != 3 {
+		t.Fatalf("got %d components, want 3", len(merged.ComponentRefs))
+	}
+	gpu := merged.GetComponentRef("gpu-operator")
+	if !slices.Equal(gpu.DependencyRefs, []string{"cert-manager", "network-operator"}) {
+		t.Errorf("gpu-operator DependencyRefs = %v", gpu.DependencyRefs)
+	}
+	if len(merged.Constraints) != 2 {
+		t.Errorf("got %d constraints, want 2", len(merged.Constraints))
+	}
+
+	wantCriteria := Criteria{Service: CriteriaServiceEKS, Accelerator: CriteriaAcceleratorH100, Intent: CriteriaIntentTraining, OS: CriteriaOSAny, Scale: CriteriaScaleAny, Partitioning: CriteriaPartitioningAny}
+	if *merged.Criteria != wantCriteria {
+		t.Errorf("Criteria = %+v, want %+v", *merged.Criteria, wantCriteria)
+	}
+	if !slices.Equal(merged.Metadata.AppliedOverlays, []string{"base", "eks", "eks-training"}) {
+		t.Errorf("AppliedOverlays = %v", merged.Metadata.AppliedOverlays)
+	}
+
+	// The inputs are left untouched.
+	if deps := networkStackRecipe().GetComponentRef("gpu-operator").DependencyRefs; len(deps) != 1 {
+		t.Errorf("input DependencyRefs modified: %v", deps)
+	}
+}
+
+func TestMergeRecipesDeterministic(t *testing.T) {
+	a, err := MergeRecipes([]NamedRecipe{{Name: "a", Recipe: gpuStackRecipe()}, {Name: "b", Recipe: networkStackRecipe()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := MergeRecipes([]NamedRecipe{{Name: "b", Recipe: networkStackRecipe()}, {Name: "a", Recipe: gpuStackRecipe()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(a.DeploymentOrder, b.DeploymentOrder) {
+		t.Errorf("DeploymentOrder depends on input order: %v vs %v", a.DeploymentOrder, b.DeploymentOrder)
+	}
+	for i := range a.ComponentRefs {
+		if a.ComponentRefs[i].Name != b.ComponentRefs[i].Name {
+			t.Errorf("ComponentRefs depend on input order")
+		}
+	}
+}
+
+func TestMergeRecipesConflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(r *RecipeResult)
+		wantErr []string
+	}{
+		{
+			name: "component version",
+			modify: func(r *RecipeResult) {
+				r.ComponentRefs[0].Version = "v1.18.0"
+			},
+			wantErr: []string{`component "cert-manager" differs between gpu.yaml and network.yaml: version`},
+		},
+		{
+			name: "component overrides and enabled",
+			modify: func(r *RecipeResult) {
+				disabled := false
+				r.ComponentRefs[0].Overrides = map[string]any{"replicas": 2}
+				r.ComponentRefs[0].Enabled = &disabled
+			},
+			wantErr: []string{"overrides, enabled"},
+		},
+		{
+			name: "constraint and criteria",
+			modify: func(r *RecipeResult) {
+				r.Constraints[0].Value = ">= 1.32"
+				r.Criteria.Service = CriteriaServiceGKE
+			},
+			wantErr: []string{
+				`constraint "K8s.server.version" is ">= 1.30" in gpu.yaml but ">= 1.32" in network.yaml`,
+				`criteria service is "eks" in gpu.yaml but "gke" in network.yaml`,
+			},
+		},
+		{
+			name: "unknown dependency",
+			modify: func(r *RecipeResult) {
+				r.ComponentRefs[2].DependencyRefs = []string{"missing"}
+			},
+			wantErr: []string{`unknown dependency "missing"`},
+		},
+		{
+			name: "cycle",
+			modify: func(r *RecipeResult) {
+				r.ComponentRefs[0].DependencyRefs = []string{"gpu-operator"}
+				r.ComponentRefs[1].DependencyRefs = nil
+			},
+			wantErr: []string{"circular"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			network := networkStackRecipe()
+			tt.modify(network)
+			_, err := MergeRecipes([]NamedRecipe{
+				{Name: "gpu.yaml", Recipe: gpuStackRecipe()},
+				{Name: "network.yaml", Recipe: network},
+			})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeRecipesInvalidInput(t *testing.T) {
+	if _, err := MergeRecipes([]NamedRecipe{{Name: "a", Recipe: gpuStackRecipe()}}); err == nil {
+		t.Error("expected error for a single recipe")
+	}
+	if _, err := MergeRecipes([]NamedRecipe{{Name: "a", Recipe: gpuStackRecipe()}, {Name: "b"}}); err == nil {
+		t.Error("expected error for a nil recipe")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"log/slog"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// ReloadResult summarizes the recipe data loaded by ReloadData.
+type ReloadResult struct {
+	// Generation is the data provider generation now in use.
+	Generation int `json:"generation" yaml:"generation"`
+
+	// Overlays is the number of overlays in the reloaded metadata store.
+	Overlays int `json:"overlays" yaml:"overlays"`
+
+	// Components is the number of components in the reloaded registry.
+	Components int `json:"components" yaml:"components"`
+}
+
+// ReloadData replaces the cached metadata store and component registry with
+// data loaded from provider and makes provider the global data provider.
+// When provider is nil the current data provider is re-read.
+//
+// The new data is loaded and validated before anything is replaced, so if
+// it is broken an error is returned and the previously loaded data stays in
+// use. Recipe and bundle requests in flight keep the data they started with.
+func ReloadData(ctx context.Context, provider DataProvider) (*ReloadResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeTimeout, "recipe data reload canceled", err)
+	}
+	if provider == nil {
+		provider = GetDataProvider()
+	}
+
+	registry, err := loadComponentRegistry(provider)
+	if err != nil {
+		recipeDataReloads.WithLabelValues("error").Inc()
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "failed to reload component registry", err)
+	}
+	store, err := buildMetadataStore(provider)
+	if err != nil {
+		recipeDataReloads.WithLabelValues("error").Inc()
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "failed to reload recipe metadata", err)
+	}
+
+	metadataStoreMu.Lock()
+	globalRegistryMu.Lock()
+	if provider != GetDataProvider() {
+		SetDataProvider(provider)
+	}
+	cachedMetadataStore, cachedMetadataErr, metadataStoreLoaded = store, nil, true
+	globalRegistry, globalRegistryErr, globalRegistryLoaded = registry, nil, true
+	globalRegistryMu.Unlock()
+	metadataStoreMu.Unlock()
+
+	initOverlayMetrics(store)
+	recipeDataReloads.WithLabelValues("success").Inc()
+
+	result := &ReloadResult{
+		Generation: GetDataProviderGeneration(),
+		Overlays:   len(store.Overlays),
+		Components: len(registry.Components),
+	}
+	slog.Info("recipe data reloaded",
+		"generation", result.Generation,
+		"overlays", result.Overlays,
+		"components", result.Components)
+
+	return result, nil
+}
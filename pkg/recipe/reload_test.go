@@ -0,0 +1,150 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// restoreRecipeData restores the global data provider and caches after a
+// test that reloads recipe data.
+func restoreRecipeData(t *testing.T) {
+	t.Helper()
+	provider := GetDataProvider()
+	t.Cleanup(func() {
+		if _, err := ReloadData(context.Background(), provider); err != nil {
+			t.Errorf("failed to restore recipe data: %v", err)
+		}
+	})
+}
+
+// newReloadTestProvider returns a layered provider over a directory holding
+// the given files and an empty registry.
+func newReloadTestProvider(t *testing.T, files map[string]string) DataProvider {
+	t.Helper()
+	dir := t.TempDir()
+	files["registry.yaml"] = testEmptyRegistryContent
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	provider, err := NewLayeredDataProvider(NewEmbeddedDataProvider(dataFS, "data"), LayeredProviderConfig{
+		ExternalDir: dir,
+	})
+	if err != nil {
+		t.Fatalf("failed to create layered provider: %v", err)
+	}
+	return provider
+}
+
+func TestReloadData_AddsOverlay(t *testing.T) {
+	restoreRecipeData(t)
+	ctx := context.Background()
+
+	before, err := loadMetadataStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to load metadata store: %v", err)
+	}
+	if _, ok := before.Overlays["reload-overlay"]; ok {
+		t.Fatal("overlay should not exist before reload")
+	}
+
+	provider := newReloadTestProvider(t, map[string]string{
+		"overlays/reload-overlay.yaml": `kind: RecipeMetadata
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  name: reload-overlay
+spec:
+  criteria:
+    service: eks
+    intent: training
+`,
+	})
+	startGen := GetDataProviderGeneration()
+
+	result, err := ReloadData(ctx, provider)
+	if err != nil {
+		t.Fatalf("ReloadData() error = %v", err)
+	}
+	if result.Generation != startGen+1 {
+		t.Errorf("Generation = %d, want %d", result.Generation, startGen+1)
+	}
+	if result.Overlays != len(before.Overlays)+1 {
+		t.Errorf("Overlays = %d, want %d", result.Overlays, len(before.Overlays)+1)
+	}
+	if GetDataProvider() != provider {
+		t.Error("reloaded provider should become the global data provider")
+	}
+
+	after, err := loadMetadataStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to load metadata store: %v", err)
+	}
+	if _, ok := after.Overlays["reload-overlay"]; !ok {
+		t.Error("reloaded metadata store should contain the new overlay")
+	}
+	registry, err := GetComponentRegistry()
+	if err != nil {
+		t.Fatalf("GetComponentRegistry() error = %v", err)
+	}
+	if registry.Count() != result.Components {
+		t.Errorf("registry has %d components, want %d", registry.Count(), result.Components)
+	}
+}
+
+func TestReloadData_KeepsPreviousDataOnError(t *testing.T) {
+	restoreRecipeData(t)
+	ctx := context.Background()
+
+	before, err := loadMetadataStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to load metadata store: %v", err)
+	}
+	previous := GetDataProvider()
+
+	provider := newReloadTestProvider(t, map[string]string{
+		"overlays/broken.yaml": "kind: RecipeMetadata\nmetadata: [\n",
+	})
+	if _, err := ReloadData(ctx, provider); err == nil {
+		t.Fatal("ReloadData() should fail for invalid recipe data")
+	}
+
+	if GetDataProvider() != previous {
+		t.Error("data provider should not change when reload fails")
+	}
+	after, err := loadMetadataStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to load metadata store: %v", err)
+	}
+	if after != before {
+		t.Error("metadata store should not change when reload fails")
+	}
+}
+
+func TestReloadData_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ReloadData(ctx, nil); err == nil {
+		t.Fatal("ReloadData() should fail for a canceled context")
+	}
+}
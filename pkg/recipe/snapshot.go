@@ -0,0 +1,407 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+// maxSnapshotRequestSize bounds the body of snapshot recipe requests.
+const maxSnapshotRequestSize = 32 << 20
+
+// SnapshotRecipeRequest is the body of POST /v1/recipe/from-snapshot.
+// The snapshot is sent inline: ConfigMap and Secret snapshot URIs are only
+// read by the CLI, since the server would read them with its own
+// credentials on behalf of any caller.
+type SnapshotRecipeRequest struct {
+	// Snapshot is the snapshot document to extract criteria from.
+	Snapshot *snapshotter.Snapshot `json:"snapshot,omitempty" yaml:"snapshot,omitempty"`
+
+	// Intent is the workload intent, which snapshots do not record.
+	Intent string `json:"intent,omitempty" yaml:"intent,omitempty"`
+}
+
+// Criteria fields recorded in CriteriaSource.Field.
+const (
+	CriteriaFieldService      = "service"
+	CriteriaFieldAccelerator  = "accelerator"
+	CriteriaFieldIntent       = "intent"
+	CriteriaFieldOS           = "os"
+	CriteriaFieldNodes        = "nodes"
+	CriteriaFieldPartitioning = "partitioning"
+)
+
+// CriteriaSourceRequest is the CriteriaSource.Source of criteria given with
+// the request rather than detected from the snapshot.
+const CriteriaSourceRequest = "request"
+
+// CriteriaDetection records where the criteria of a recipe built from a
+// snapshot came from, so users can see why a recipe was selected.
+type CriteriaDetection struct {
+	// Sources lists one entry per criteria field that was set.
+	Sources []CriteriaSource `json:"sources,omitempty" yaml:"sources,omitempty"`
+}
+
+// CriteriaSource records how a single criteria field was set.
+type CriteriaSource struct {
+	// Field is the criteria field, e.g. "service".
+	Field string `json:"field" yaml:"field"`
+
+	// Value is the criteria value the field was set to.
+	Value string `json:"value" yaml:"value"`
+
+	// Source is the snapshot reading the value was detected from, as
+	// Type.subtype.key (e.g. "K8s.server.version"), or "request".
+	Source string `json:"source" yaml:"source"`
+
+	// Reading is the raw snapshot reading, unset for request values.
+	Reading string `json:"reading,omitempty" yaml:"reading,omitempty"`
+}
+
+// Set records that field was set to value from source, replacing any
+// earlier entry for the field.
+func (d *CriteriaDetection) Set(field, value, source, reading string) {
+	s := CriteriaSource{Field: field, Value: value, Source: source, Reading: reading}
+	for i := range d.Sources {
+		if d.Sources[i].Field == field {
+			d.Sources[i] = s
+			return
+		}
+	}
+	d.Sources = append(d.Sources, s)
+}
+
+// ParseSnapshotRecipeRequest parses a snapshot recipe request from a JSON or
+// YAML body, as selected by contentType, and validates it.
+func ParseSnapshotRecipeRequest(body io.Reader, contentType string) (*SnapshotRecipeRequest, error) {
+	if body == nil {
+		return nil, fmt.Errorf("request body cannot be nil")
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("request body is empty")
+	}
+
+	var req SnapshotRecipeRequest
+	ct, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(contentType)), ";")
+	switch strings.TrimSpace(ct) {
+	case "application/x-yaml", "application/yaml", "text/yaml":
+		if err := yaml.Unmarshal(data, &req); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML body: %w", err)
+		}
+	default:
+		if err := json.Unmarshal(data, &req); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON body: %w", err)
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// Validate checks that the request holds a snapshot and a valid intent if
+// any.
+func (r *SnapshotRecipeRequest) Validate() error {
+	if r.Snapshot == nil {
+		return fmt.Errorf("snapshot is required; snapshot URIs (%s, %s) are only supported by the CLI",
+			serializer.ConfigMapURIScheme, serializer.SecretURIScheme)
+	}
+	if r.Intent != "" {
+		if _, err := ParseCriteriaIntentType(r.Intent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Criteria extracts the criteria of the request snapshot and applies the
+// requested intent.
+func (r *SnapshotRecipeRequest) Criteria() (*Criteria, *CriteriaDetection, error) {
+	criteria, detection := ExtractCriteriaFromSnapshot(r.Snapshot)
+	if r.Intent != "" {
+		intent, err := ParseCriteriaIntentType(r.Intent)
+		if err != nil {
+			return nil, nil, err
+		}
+		criteria.Intent = intent
+		detection.Set(CriteriaFieldIntent, string(intent), CriteriaSourceRequest, "")
+	}
+	return criteria, detection, nil
+}
+
+// ExtractCriteriaFromSnapshot maps snapshot measurements to criteria: the
+// service from the Kubernetes server, the node count (and so the cluster
+// scale) from the GPU nodes, the accelerator from the GPU model, the OS
+// from the OS release and the GPU partitioning from the ClusterPolicy and
+// the MIG layout. It also returns the readings each field was detected from.
+// A nil snapshot yields empty criteria.
+func ExtractCriteriaFromSnapshot(snap *snapshotter.Snapshot) (*Criteria, *CriteriaDetection) {
+	criteria := NewCriteria()
+	detection := &CriteriaDetection{}
+
+	if snap == nil {
+		return criteria, detection
+	}
+
+	// Partitioning candidates, resolved after all measurements are read
+	var sandbox, migLayout, migPolicy *partitioningReading
+	// Distribution of the nodes, which wins over the server version since
+	// OpenShift and RKE2 also run on the managed services' machines
+	var distribution, distributionRaw string
+
+	for _, m := range snap.Measurements {
+		if m == nil {
+			continue
+		}
+
+		switch m.Type {
+		case measurement.TypeK8s:
+			// Look for service type in server subtype and the GPU node count
+			// in node subtype
+			for _, st := range m.Subtypes {
+				if st.Name == "node" {
+					if d, ok := st.Data["distribution"]; ok {
+						if parsed := serviceFromDistribution(d.String()); parsed != "" {
+							distribution, distributionRaw = string(parsed), d.String()
+						}
+					}
+					if count, ok := st.Data[measurement.KeyGPUNodeCount]; ok {
+						// Parsed from the string form, as the reading decodes
+						// to different integer types from JSON and YAML
+						if n, err := strconv.Atoi(count.String()); err == nil && n > 0 {
+							criteria.Nodes = n
+							detection.Set(CriteriaFieldNodes, strconv.Itoa(n), "K8s.node."+measurement.KeyGPUNodeCount, count.String())
+						}
+					}
+					continue
+				}
+				if st.Name == "policy" {
+					sandbox = sandboxPartitioning(st.Data)
+					migPolicy = policyMIGPartitioning(st.Data)
+					continue
+				}
+				if st.Name != "server" {
+					continue
+				}
+				// Try direct "service" field first
+				if svcType, ok := st.Data["service"]; ok {
+					if parsed, err := ParseCriteriaServiceType(svcType.String()); err == nil {
+						criteria.Service = parsed
+						detection.Set(CriteriaFieldService, string(parsed), "K8s.server.service", svcType.String())
+					}
+				}
+
+				// Extract service from K8s version string (e.g., "v1.33.5-eks-3025e55")
+				if version, ok := st.Data["version"]; ok {
+					if parsed := serviceFromKubernetesVersion(version.String()); parsed != "" {
+						criteria.Service = parsed
+						detection.Set(CriteriaFieldService, string(parsed), "K8s.server.version", version.String())
+					}
+				}
+			}
+
+		case measurement.TypeGPU:
+			// Look for GPU/accelerator type in smi or device subtype
+			for _, st := range m.Subtypes {
+				if st.Name == "mig" {
+					if strategy, ok := st.Data["strategy"]; ok {
+						migLayout = migPartitioning(strategy.String(), "GPU.mig.strategy")
+					}
+					continue
+				}
+				if st.Name != "smi" && st.Name != "device" {
+					continue
+				}
+				// Try "gpu.model" field (from nvidia-smi), then plain "model"
+				for _, key := range []string{"gpu.model", "model"} {
+					model, ok := st.Data[key]
+					if !ok {
+						continue
+					}
+					if parsed := acceleratorFromModel(model.String()); parsed != "" {
+						criteria.Accelerator = parsed
+						detection.Set(CriteriaFieldAccelerator, string(parsed), "GPU."+st.Name+"."+key, model.String())
+					}
+				}
+			}
+
+		case measurement.TypeOS:
+			// Look for OS type in release subtype
+			for _, st := range m.Subtypes {
+				if st.Name != "release" {
+					continue
+				}
+				if osID, ok := st.Data["ID"]; ok {
+					if parsed, err := ParseCriteriaOSType(osID.String()); err == nil {
+						criteria.OS = parsed
+						detection.Set(CriteriaFieldOS, string(parsed), "OS.release.ID", osID.String())
+					}
+				}
+			}
+
+		case measurement.TypeSystemD, measurement.TypeNetwork, measurement.TypeCPU, measurement.TypeRuntime, measurement.TypePlugin:
+			// SystemD, network, CPU, runtime and plugin measurements not used for criteria extraction
+			continue
+		}
+	}
+
+	if distribution != "" {
+		criteria.Service = CriteriaServiceType(distribution)
+		detection.Set(CriteriaFieldService, distribution, "K8s.node.distribution", distributionRaw)
+	}
+
+	// Sandbox workloads replace the container device plugin, so they win
+	// over any MIG setting; the MIG layout of the GPUs wins over the
+	// configuration the MIG manager was asked to apply.
+	for _, p := range []*partitioningReading{sandbox, migLayout, migPolicy} {
+		if p == nil {
+			continue
+		}
+		criteria.Partitioning = p.value
+		detection.Set(CriteriaFieldPartitioning, string(p.value), p.source, p.raw)
+		break
+	}
+
+	return criteria, detection
+}
+
+// partitioningReading is a GPU partitioning detected from one snapshot reading.
+type partitioningReading struct {
+	value  CriteriaPartitioningType
+	source string
+	raw    string
+}
+
+// sandboxPartitioning returns the partitioning of a ClusterPolicy that runs
+// sandbox (VM) workloads by default, or nil for container workloads.
+func sandboxPartitioning(policy map[string]measurement.Reading) *partitioningReading {
+	enabled, ok := policy["sandboxWorkloads.enabled"]
+	if !ok || enabled.String() != "true" {
+		return nil
+	}
+	workload, ok := policy["sandboxWorkloads.defaultWorkload"]
+	if !ok {
+		return nil
+	}
+	switch w := workload.String(); w {
+	case "vm-vgpu", "vm-passthrough":
+		parsed, err := ParseCriteriaPartitioningType(w)
+		if err != nil {
+			return nil
+		}
+		return &partitioningReading{value: parsed, source: "K8s.policy.sandboxWorkloads.defaultWorkload", raw: w}
+	default:
+		return nil
+	}
+}
+
+// policyMIGPartitioning returns the MIG partitioning of a ClusterPolicy whose
+// MIG manager applies a MIG configuration. The ClusterPolicy always carries a
+// mig.strategy, so it is only trusted alongside such a configuration.
+func policyMIGPartitioning(policy map[string]measurement.Reading) *partitioningReading {
+	config, ok := policy["migManager.config.default"]
+	if !ok || config.String() == "" || config.String() == "all-disabled" {
+		return nil
+	}
+	strategy, ok := policy["mig.strategy"]
+	if !ok {
+		return nil
+	}
+	return migPartitioning(strategy.String(), "K8s.policy.mig.strategy")
+}
+
+// migPartitioning maps a MIG strategy (single, mixed) to its partitioning,
+// or nil when MIG is not used.
+func migPartitioning(strategy, source string) *partitioningReading {
+	switch strategy {
+	case "single":
+		return &partitioningReading{value: CriteriaPartitioningMIGSingle, source: source, raw: strategy}
+	case "mixed":
+		return &partitioningReading{value: CriteriaPartitioningMIGMixed, source: source, raw: strategy}
+	default:
+		return nil
+	}
+}
+
+// serviceFromKubernetesVersion returns the managed Kubernetes service named in
+// a server version string, or an empty type if there is none.
+func serviceFromKubernetesVersion(version string) CriteriaServiceType {
+	switch {
+	case strings.Contains(version, "-eks-"):
+		return CriteriaServiceEKS
+	case strings.Contains(version, "-gke"):
+		return CriteriaServiceGKE
+	case strings.Contains(version, "-aks"):
+		return CriteriaServiceAKS
+	case strings.Contains(version, "+rke2"):
+		return CriteriaServiceRKE2
+	default:
+		return ""
+	}
+}
+
+// serviceFromDistribution returns the service of a Kubernetes distribution
+// detected on the nodes, or an empty type for other distributions.
+func serviceFromDistribution(distribution string) CriteriaServiceType {
+	switch strings.ToLower(distribution) {
+	case "openshift":
+		return CriteriaServiceOpenShift
+	case "rke2":
+		return CriteriaServiceRKE2
+	default:
+		return ""
+	}
+}
+
+// acceleratorFromModel maps a GPU model name to an accelerator type, or an
+// empty type if the model is not recognized.
+func acceleratorFromModel(model string) CriteriaAcceleratorType {
+	switch {
+	case containsIgnoreCase(model, "gb200"):
+		return CriteriaAcceleratorGB200
+	case containsIgnoreCase(model, "h100"):
+		return CriteriaAcceleratorH100
+	case containsIgnoreCase(model, "a100"):
+		return CriteriaAcceleratorA100
+	case containsIgnoreCase(model, "l40"):
+		return CriteriaAcceleratorL40
+	default:
+		return ""
+	}
+}
+
+// containsIgnoreCase checks if s contains substr (case-insensitive).
+func containsIgnoreCase(s, substr string) bool {
+	return len(s) >= len(substr) && (s == substr ||
+		len(s) > 0 && len(substr) > 0 &&
+			(s[0]|0x20 == substr[0]|0x20) && containsIgnoreCase(s[1:], substr[1:]) ||
+		len(s) > 0 && containsIgnoreCase(s[1:], substr))
+}
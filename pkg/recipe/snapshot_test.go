@@ -0,0 +1,544 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recipe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+func TestExtractCriteriaFromSnapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot *snapshotter.Snapshot
+		validate func(*testing.T, *Criteria)
+	}{
+		{
+			name:     "nil snapshot",
+			snapshot: nil,
+			validate: func(t *testing.T, c *Criteria) {
+				if c == nil {
+					t.Error("expected non-nil criteria")
+				}
+			},
+		},
+		{
+			name: "empty snapshot",
+			snapshot: &snapshotter.Snapshot{
+				Measurements: nil,
+			},
+			validate: func(t *testing.T, c *Criteria) {
+				if c == nil {
+					t.Error("expected non-nil criteria")
+				}
+			},
+		},
+		{
+			name: "snapshot with K8s service",
+			snapshot: &snapshotter.Snapshot{
+				Measurements: []*measurement.Measurement{
+					{
+						Type: "K8s",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "server",
+								Data: map[string]measurement.Reading{
+									"service": measurement.Str("eks"),
+								},
+							},
+						},
+					},
+				},
+			},
+			validate: func(t *testing.T, c *Criteria) {
+				if c.Service != CriteriaServiceEKS {
+					t.Errorf("Service = %v, want %v", c.Service, CriteriaServiceEKS)
+				}
+			},
+		},
+		{
+			name: "snapshot with GPU H100",
+			snapshot: &snapshotter.Snapshot{
+				Measurements: []*measurement.Measurement{
+					{
+						Type: "GPU",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "device",
+								Data: map[string]measurement.Reading{
+									"model": measurement.Str("NVIDIA H100 80GB HBM3"),
+								},
+							},
+						},
+					},
+				},
+			},
+			validate: func(t *testing.T, c *Criteria) {
+				if c.Accelerator != CriteriaAcceleratorH100 {
+					t.Errorf("Accelerator = %v, want %v", c.Accelerator, CriteriaAcceleratorH100)
+				}
+			},
+		},
+		{
+			name: "snapshot with GB200",
+			snapshot: &snapshotter.Snapshot{
+				Measurements: []*measurement.Measurement{
+					{
+						Type: "GPU",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "device",
+								Data: map[string]measurement.Reading{
+									"model": measurement.Str("NVIDIA GB200"),
+								},
+							},
+						},
+					},
+				},
+			},
+			validate: func(t *testing.T, c *Criteria) {
+				if c.Accelerator != CriteriaAcceleratorGB200 {
+					t.Errorf("Accelerator = %v, want %v", c.Accelerator, CriteriaAcceleratorGB200)
+				}
+			},
+		},
+		{
+			name: "snapshot with OS ubuntu",
+			snapshot: &snapshotter.Snapshot{
+				Measurements: []*measurement.Measurement{
+					{
+						Type: "OS",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "release",
+								Data: map[string]measurement.Reading{
+									"ID": measurement.Str("ubuntu"),
+								},
+							},
+						},
+					},
+				},
+			},
+			validate: func(t *testing.T, c *Criteria) {
+				if c.OS != CriteriaOSUbuntu {
+					t.Errorf("OS = %v, want %v", c.OS, CriteriaOSUbuntu)
+				}
+			},
+		},
+		{
+			name: "complete snapshot",
+			snapshot: &snapshotter.Snapshot{
+				Measurements: []*measurement.Measurement{
+					{
+						Type: "K8s",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "server",
+								Data: map[string]measurement.Reading{
+									"service": measurement.Str("gke"),
+								},
+							},
+						},
+					},
+					{
+						Type: "GPU",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "device",
+								Data: map[string]measurement.Reading{
+									"model": measurement.Str("A100-SXM4-80GB"),
+								},
+							},
+						},
+					},
+					{
+						Type: "OS",
+						Subtypes: []measurement.Subtype{
+							{
+								Name: "release",
+								Data: map[string]measurement.Reading{
+									"ID": measurement.Str("rhel"),
+								},
+							},
+						},
+					},
+				},
+			},
+			validate: func(t *testing.T, c *Criteria) {
+				if c.Service != CriteriaServiceGKE {
+					t.Errorf("Service = %v, want %v", c.Service, CriteriaServiceGKE)
+				}
+				if c.Accelerator != CriteriaAcceleratorA100 {
+					t.Errorf("Accelerator = %v, want %v", c.Accelerator, CriteriaAcceleratorA100)
+				}
+				if c.OS != CriteriaOSRHEL {
+					t.Errorf("OS = %v, want %v", c.OS, CriteriaOSRHEL)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, _ := ExtractCriteriaFromSnapshot(tt.snapshot)
+
+			if tt.validate != nil {
+				tt.validate(t, criteria)
+			}
+		})
+	}
+}
+
+func TestContainsIgnoreCase(t *testing.T) {
+	tests := []struct {
+		s      string
+		substr string
+		want   bool
+	}{
+		{"NVIDIA H100", "h100", true},
+		{"h100", "H100", true},
+		{"GB200", "gb200", true},
+		{"NVIDIA A100-SXM4-80GB", "a100", true},
+		{"L40S", "l40", true},
+		{"H100", "gb200", false},
+		{"", "h100", false},
+		{"h100", "", true}, // empty substr matches anything
+		{"", "", true},     // empty matches empty
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s+"_"+tt.substr, func(t *testing.T) {
+			got := containsIgnoreCase(tt.s, tt.substr)
+			if got != tt.want {
+				t.Errorf("containsIgnoreCase(%q, %q) = %v, want %v", tt.s, tt.substr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractCriteriaFromSnapshot_Detection(t *testing.T) {
+	snap := &snapshotter.Snapshot{
+		Measurements: []*measurement.Measurement{
+			{
+				Type: measurement.TypeK8s,
+				Subtypes: []measurement.Subtype{
+					{
+						Name: "server",
+						Data: map[string]measurement.Reading{
+							"service": measurement.Str("gke"),
+							"version": measurement.Str("v1.33.5-eks-3025e55"),
+						},
+					},
+					{
+						Name: "node",
+						Data: map[string]measurement.Reading{
+							measurement.KeyClusterNodeCount: measurement.Int(132),
+							measurement.KeyGPUNodeCount:     measurement.Int(128),
+						},
+					},
+				},
+			},
+			{
+				Type: measurement.TypeGPU,
+				Subtypes: []measurement.Subtype{
+					{
+						Name: "smi",
+						Data: map[string]measurement.Reading{
+							"gpu.model": measurement.Str("NVIDIA H100 80GB HBM3"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	criteria, detection := ExtractCriteriaFromSnapshot(snap)
+	if criteria.Service != CriteriaServiceEKS {
+		t.Errorf("Service = %v, want %v", criteria.Service, CriteriaServiceEKS)
+	}
+
+	// The version reading wins over the service reading for the same field
+	if criteria.Nodes != 128 || criteria.EffectiveScale() != CriteriaScaleLarge {
+		t.Errorf("Nodes = %d (scale %s), want 128 (large)", criteria.Nodes, criteria.EffectiveScale())
+	}
+
+	want := []CriteriaSource{
+		{Field: CriteriaFieldService, Value: "eks", Source: "K8s.server.version", Reading: "v1.33.5-eks-3025e55"},
+		{Field: CriteriaFieldNodes, Value: "128", Source: "K8s.node.gpu-node-count", Reading: "128"},
+		{Field: CriteriaFieldAccelerator, Value: "h100", Source: "GPU.smi.gpu.model", Reading: "NVIDIA H100 80GB HBM3"},
+	}
+	if !reflect.DeepEqual(detection.Sources, want) {
+		t.Errorf("Sources = %+v, want %+v", detection.Sources, want)
+	}
+}
+
+func TestExtractCriteriaFromSnapshot_Distribution(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		distribution string
+		want         CriteriaServiceType
+		source       string
+	}{
+		{"rke2 version suffix", "v1.31.4+rke2r1", "", CriteriaServiceRKE2, "K8s.server.version"},
+		{"openshift nodes", "v1.31.6", "openshift", CriteriaServiceOpenShift, "K8s.node.distribution"},
+		{"rke2 nodes", "v1.31.6", "rke2", CriteriaServiceRKE2, "K8s.node.distribution"},
+		{"openshift on eks machines", "v1.31.6-eks-3025e55", "openshift", CriteriaServiceOpenShift, "K8s.node.distribution"},
+		{"unknown distribution", "v1.31.6-eks-3025e55", "k3s", CriteriaServiceEKS, "K8s.server.version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := map[string]measurement.Reading{}
+			if tt.distribution != "" {
+				node["distribution"] = measurement.Str(tt.distribution)
+			}
+			snap := &snapshotter.Snapshot{
+				Measurements: []*measurement.Measurement{
+					{
+						Type: measurement.TypeK8s,
+						Subtypes: []measurement.Subtype{
+							{Name: "server", Data: map[string]measurement.Reading{"version": measurement.Str(tt.version)}},
+							{Name: "node", Data: node},
+						},
+					},
+				},
+			}
+
+			criteria, detection := ExtractCriteriaFromSnapshot(snap)
+			if criteria.Service != tt.want {
+				t.Errorf("Service = %v, want %v", criteria.Service, tt.want)
+			}
+			var source string
+			for _, s := range detection.Sources {
+				if s.Field == CriteriaFieldService {
+					source = s.Source
+				}
+			}
+			if source != tt.source {
+				t.Errorf("service source = %q, want %q", source, tt.source)
+			}
+		})
+	}
+}
+
+func TestExtractCriteriaFromSnapshot_Partitioning(t *testing.T) {
+	policy := func(data map[string]measurement.Reading) *measurement.Measurement {
+		return &measurement.Measurement{
+			Type:     measurement.TypeK8s,
+			Subtypes: []measurement.Subtype{{Name: "policy", Data: data}},
+		}
+	}
+	mig := func(strategy string) *measurement.Measurement {
+		return &measurement.Measurement{
+			Type: measurement.TypeGPU,
+			Subtypes: []measurement.Subtype{{Name: "mig", Data: map[string]measurement.Reading{
+				"strategy": measurement.Str(strategy),
+			}}},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		measurements []*measurement.Measurement
+		want         CriteriaPartitioningType
+		wantSource   string
+	}{
+		{
+			name:         "MIG layout",
+			measurements: []*measurement.Measurement{mig("mixed")},
+			want:         CriteriaPartitioningMIGMixed,
+			wantSource:   "GPU.mig.strategy",
+		},
+		{
+			name:         "MIG disabled",
+			measurements: []*measurement.Measurement{mig("none")},
+			want:         CriteriaPartitioningAny,
+		},
+		{
+			name: "policy strategy without MIG configuration",
+			measurements: []*measurement.Measurement{policy(map[string]measurement.Reading{
+				"mig.strategy":              measurement.Str("single"),
+				"migManager.config.default": measurement.Str("all-disabled"),
+			})},
+			want: CriteriaPartitioningAny,
+		},
+		{
+			name: "policy MIG configuration",
+			measurements: []*measurement.Measurement{policy(map[string]measurement.Reading{
+				"mig.strategy":              measurement.Str("single"),
+				"migManager.config.default": measurement.Str("all-1g.10gb"),
+			})},
+			want:       CriteriaPartitioningMIGSingle,
+			wantSource: "K8s.policy.mig.strategy",
+		},
+		{
+			name: "MIG layout wins over policy",
+			measurements: []*measurement.Measurement{
+				policy(map[string]measurement.Reading{
+					"mig.strategy":              measurement.Str("single"),
+					"migManager.config.default": measurement.Str("all-1g.10gb"),
+				}),
+				mig("mixed"),
+			},
+			want:       CriteriaPartitioningMIGMixed,
+			wantSource: "GPU.mig.strategy",
+		},
+		{
+			name: "sandbox workloads win over MIG",
+			measurements: []*measurement.Measurement{
+				policy(map[string]measurement.Reading{
+					"sandboxWorkloads.enabled":         measurement.Str("true"),
+					"sandboxWorkloads.defaultWorkload": measurement.Str("vm-passthrough"),
+				}),
+				mig("single"),
+			},
+			want:       CriteriaPartitioningPassthrough,
+			wantSource: "K8s.policy.sandboxWorkloads.defaultWorkload",
+		},
+		{
+			name: "sandbox workloads disabled",
+			measurements: []*measurement.Measurement{policy(map[string]measurement.Reading{
+				"sandboxWorkloads.enabled":         measurement.Str("false"),
+				"sandboxWorkloads.defaultWorkload": measurement.Str("vm-vgpu"),
+			})},
+			want: CriteriaPartitioningAny,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, detection := ExtractCriteriaFromSnapshot(&snapshotter.Snapshot{Measurements: tt.measurements})
+			if criteria.Partitioning != tt.want {
+				t.Errorf("Partitioning = %v, want %v", criteria.Partitioning, tt.want)
+			}
+			var source string
+			for _, s := range detection.Sources {
+				if s.Field == CriteriaFieldPartitioning {
+					source = s.Source
+				}
+			}
+			if source != tt.wantSource {
+				t.Errorf("partitioning source = %q, want %q", source, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestParseSnapshotRecipeRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		wantErr     string
+	}{
+		{
+			name: "inline snapshot",
+			body: `{"snapshot": {"measurements": []}, "intent": "training"}`,
+		},
+		{
+			name:        "yaml snapshot",
+			body:        "snapshot:\n  measurements: []\n",
+			contentType: "application/yaml",
+		},
+		{
+			name:    "empty body",
+			wantErr: "request body is empty",
+		},
+		{
+			name:    "no snapshot",
+			body:    `{"intent": "training"}`,
+			wantErr: "snapshot is required",
+		},
+		{
+			name:        "config map reference",
+			body:        "snapshotRef: cm://gpu-operator/eidos-snapshot\n",
+			contentType: "application/yaml",
+			wantErr:     "only supported by the CLI",
+		},
+		{
+			name:    "secret reference",
+			body:    `{"snapshotRef": "secret://kube-system/bootstrap-token#token-secret"}`,
+			wantErr: "only supported by the CLI",
+		},
+		{
+			name:    "invalid intent",
+			body:    `{"snapshot": {"measurements": []}, "intent": "gaming"}`,
+			wantErr: "gaming",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSnapshotRecipeRequest(strings.NewReader(tt.body), tt.contentType)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ParseSnapshotRecipeRequest() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseSnapshotRecipeRequest() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandleRecipeFromSnapshot(t *testing.T) {
+	body := `{
+  "intent": "training",
+  "snapshot": {
+    "measurements": [
+      {"type": "K8s", "subtypes": [{"subtype": "server", "data": {"version": "v1.33.5-eks-3025e55"}}]},
+      {"type": "GPU", "subtypes": [{"subtype": "smi", "data": {"gpu.model": "NVIDIA H100 80GB HBM3"}}]}
+    ]
+  }
+}`
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/recipe/from-snapshot", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	NewBuilder().HandleRecipeFromSnapshot(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	var result RecipeResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if result.Criteria.Service != CriteriaServiceEKS || result.Criteria.Accelerator != CriteriaAcceleratorH100 ||
+		result.Criteria.Intent != CriteriaIntentTraining {
+		t.Errorf("Criteria = %+v", result.Criteria)
+	}
+	if result.Metadata.CriteriaDetection == nil || len(result.Metadata.CriteriaDetection.Sources) != 3 {
+		t.Fatalf("CriteriaDetection = %+v, want service, accelerator and intent", result.Metadata.CriteriaDetection)
+	}
+	if got := result.Metadata.CriteriaDetection.Sources[2]; got.Field != CriteriaFieldIntent || got.Source != CriteriaSourceRequest {
+		t.Errorf("intent source = %+v", got)
+	}
+
+	w = httptest.NewRecorder()
+	NewBuilder().HandleRecipeFromSnapshot(w, httptest.NewRequest(http.MethodGet, "/v1/recipe/from-snapshot", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -65,6 +65,10 @@ var validConstraintOperators = []string{">=", "<=", ">", "<", "==", "!="}
 // baseYAMLFile is the base recipe filename (relative to data/).
 const baseYAMLFile = "overlays/base.yaml"
 
+// autoscalingYAMLFile is the opt-in autoscaling overlay (relative to data/).
+// It is applied on request rather than matched, so it has no criteria.
+const autoscalingYAMLFile = "overlays/autoscaling.yaml"
+
 // ============================================================================
 // Schema Conformance Tests
 // ============================================================================
@@ -136,14 +140,15 @@ func TestAllMetadataFilesHaveRequiredFields(t *testing.T) {
 // ============================================================================
 
 // TestAllOverlayCriteriaUseValidEnums verifies that all overlay files use
-// only valid enum values for criteria fields (service, accelerator, os, intent).
+// only valid enum values for criteria fields (service, accelerator, os, intent,
+// partitioning).
 func TestAllOverlayCriteriaUseValidEnums(t *testing.T) {
 	files := collectMetadataFiles(t)
 
 	for _, path := range files {
 		filename := filepath.Base(path)
-		// Skip base.yaml - it doesn't have criteria
-		if filename == filepath.Base(baseYAMLFile) {
+		// Skip base.yaml and the opt-in autoscaling overlay - they don't have criteria
+		if filename == filepath.Base(baseYAMLFile) || filename == filepath.Base(autoscalingYAMLFile) {
 			continue
 		}
 
@@ -191,6 +196,13 @@ func TestAllOverlayCriteriaUseValidEnums(t *testing.T) {
 					t.Errorf("invalid OS type %q: %v", criteria.OS, err)
 				}
 			}
+
+			// Validate partitioning type
+			if criteria.Partitioning != "" && criteria.Partitioning != CriteriaPartitioningAny {
+				if _, err := ParseCriteriaPartitioningType(string(criteria.Partitioning)); err != nil {
+					t.Errorf("invalid partitioning type %q: %v", criteria.Partitioning, err)
+				}
+			}
 		})
 	}
 }
@@ -233,6 +245,31 @@ func TestAllValuesFileReferencesExist(t *testing.T) {
 	}
 }
 
+// TestAllValuesFileExtendsResolve verifies that the $extends chain of every
+// values file names existing files without cycles, and that the merged
+// chain has valid merge strategies.
+func TestAllValuesFileExtendsResolve(t *testing.T) {
+	read := func(path string) ([]byte, error) {
+		return testMetadataFS.ReadFile("data/" + path)
+	}
+
+	for path := range collectValuesFiles(t) {
+		if !strings.HasSuffix(path, ".yaml") || strings.Contains(path, "/manifests/") {
+			continue
+		}
+		t.Run(path, func(t *testing.T) {
+			component := strings.Split(path, "/")[1]
+			layers, err := valuesLayers(read, ComponentRef{Name: component, ValuesFile: path})
+			if err != nil {
+				t.Fatalf("failed to resolve %s: %v", ExtendsKey, err)
+			}
+			if _, err := mergeValuesLayers(layers); err != nil {
+				t.Errorf("failed to merge values files: %v", err)
+			}
+		})
+	}
+}
+
 // TestAllDependencyReferencesExist verifies that all dependencyRefs
 // reference components that are defined in the same file or base.yaml.
 func TestAllDependencyReferencesExist(t *testing.T) {
@@ -564,7 +601,7 @@ func TestIntermediateRecipesHavePartialCriteria(t *testing.T) {
 			// Verify it has at least one criteria field to differentiate it
 			if metadata.Spec.Base != "" && metadata.Spec.Criteria != nil {
 				c := metadata.Spec.Criteria
-				hasSomeCriteria := c.Service != "" || c.Accelerator != "" || c.OS != "" || c.Intent != ""
+				hasSomeCriteria := c.Service != "" || c.Accelerator != "" || c.OS != "" || c.Intent != "" || c.Partitioning != ""
 				if !hasSomeCriteria {
 					t.Errorf("recipe with spec.base should have at least one criteria field set")
 				}
@@ -600,7 +637,7 @@ func TestLeafRecipesHaveCompleteCriteria(t *testing.T) {
 	// Check leaf recipes (not referenced by others) have complete criteria
 	for _, path := range files {
 		filename := filepath.Base(path)
-		if filename == filepath.Base(baseYAMLFile) {
+		if filename == filepath.Base(baseYAMLFile) || filename == filepath.Base(autoscalingYAMLFile) {
 			continue
 		}
 
@@ -633,7 +670,7 @@ func TestLeafRecipesHaveCompleteCriteria(t *testing.T) {
 
 			// Leaf recipes should have at least one criteria field to distinguish them
 			// Empty/missing fields act as wildcards and match everything, which is valid
-			hasSomeCriteria := c.Service != "" || c.Accelerator != "" || c.OS != "" || c.Intent != ""
+			hasSomeCriteria := c.Service != "" || c.Accelerator != "" || c.OS != "" || c.Intent != "" || c.Partitioning != ""
 			if !hasSomeCriteria {
 				t.Error("leaf recipe should have at least one criteria field set")
 			}
@@ -655,8 +692,8 @@ func TestNoDuplicateCriteriaAcrossOverlays(t *testing.T) {
 
 	for _, path := range files {
 		filename := filepath.Base(path)
-		// Skip base.yaml - it doesn't have criteria
-		if filename == filepath.Base(baseYAMLFile) {
+		// Skip base.yaml and the opt-in autoscaling overlay - they don't have criteria
+		if filename == filepath.Base(baseYAMLFile) || filename == filepath.Base(autoscalingYAMLFile) {
 			continue
 		}
 
@@ -672,8 +709,8 @@ func TestNoDuplicateCriteriaAcrossOverlays(t *testing.T) {
 
 		// Create criteria key
 		c := metadata.Spec.Criteria
-		key := fmt.Sprintf("service=%s,accelerator=%s,os=%s,intent=%s",
-			c.Service, c.Accelerator, c.OS, c.Intent)
+		key := fmt.Sprintf("service=%s,accelerator=%s,os=%s,intent=%s,partitioning=%s",
+			c.Service, c.Accelerator, c.OS, c.Intent, c.Partitioning)
 
 		if existing, found := criteriaMap[key]; found {
 			t.Errorf("duplicate criteria found:\n  %s: %s\n  %s: %s",
@@ -0,0 +1,119 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/NVIDIA/eidos/pkg/server"
+)
+
+// healthServicePrefix prefixes the methods of the gRPC health service, which
+// is never authenticated or rate limited, like the HTTP health endpoints.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// Admitter admits calls before they are served. *server.Server admits them
+// with the rate limits and authentication of the HTTP API.
+type Admitter interface {
+	Admit(ctx context.Context, r *http.Request) (context.Context, error)
+}
+
+// UnaryAdmission returns an interceptor rejecting unary calls a does not
+// admit.
+func UnaryAdmission(a Admitter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := admit(ctx, a, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAdmission returns an interceptor rejecting streaming calls a does not
+// admit.
+func StreamAdmission(a Admitter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := admit(ss.Context(), a, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &admittedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// admittedStream serves a stream with the context of its admission.
+type admittedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *admittedStream) Context() context.Context {
+	return s.ctx
+}
+
+// admit admits the call to method, returning its context with the
+// authenticated subject or a status error.
+func admit(ctx context.Context, a Admitter, method string) (context.Context, error) {
+	if strings.HasPrefix(method, healthServicePrefix) {
+		return ctx, nil
+	}
+	admitted, err := a.Admit(ctx, callRequest(ctx, method))
+	if errors.Is(err, server.ErrUnauthenticated) {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return admitted, nil
+}
+
+// callRequest describes a call as the HTTP request an Admitter expects: the
+// authorization metadata becomes the Authorization header, and the peer
+// address and TLS state those of the request.
+func callRequest(ctx context.Context, method string) *http.Request {
+	r := (&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: method},
+		Header: make(http.Header),
+	}).WithContext(ctx)
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("authorization") {
+			r.Header.Add("Authorization", v)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			r.RemoteAddr = p.Addr.String()
+		}
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state := info.State
+			r.TLS = &state
+		}
+	}
+	return r
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rpc implements the Eidos gRPC service defined in
+// api/eidos/v1/eidos.proto.
+//
+// The service mirrors the eidosd HTTP API for platforms that integrate over
+// gRPC, and adds the snapshot and validation steps of the CLI:
+//   - Snapshot captures the configuration of the node the server runs on
+//   - Recipe builds a recipe from criteria or from a snapshot
+//   - Bundle generates a bundle and streams it as a zip or tgz archive
+//   - Validate checks a snapshot against the constraints of a recipe
+//
+// Documents (snapshots, recipes and validation results) are exchanged as
+// JSON bytes, in the same schema as the HTTP API and CLI output. Bundle
+// options follow the POST /v1/bundle query parameters; async bundle jobs are
+// only available over HTTP.
+//
+// Errors carry the gRPC status code matching the eidos error code, e.g.
+// InvalidArgument for INVALID_REQUEST.
+//
+// # Usage
+//
+//	srv, err := rpc.New(
+//	    rpc.WithVersion(version),
+//	    rpc.WithRecipeBuilder(rb),
+//	    rpc.WithBundler(bb),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	gs := grpc.NewServer(
+//	    grpc.ChainUnaryInterceptor(rpc.UnaryLogger()),
+//	    grpc.ChainStreamInterceptor(rpc.StreamLogger()),
+//	)
+//	srv.Register(gs)
+//	return gs.Serve(lis)
+//
+// eidosd serves the gRPC API when GRPC_PORT is set.
+package rpc
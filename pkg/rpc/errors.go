@@ -0,0 +1,90 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details attached to errors.
+const ErrorDomain = "eidos.nvidia.com"
+
+// statusError converts err to a gRPC status error. Eidos error codes map to
+// the matching status code, and their code and context are attached as
+// ErrorInfo details. Errors without a code are Internal.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+
+	var se *eidoserrors.StructuredError
+	if !errors.As(err, &se) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	st := status.New(statusCode(se.Code), se.Error())
+	info := &errdetails.ErrorInfo{
+		Reason:   string(se.Code),
+		Domain:   ErrorDomain,
+		Metadata: make(map[string]string, len(se.Context)),
+	}
+	for key, value := range se.Context {
+		info.Metadata[key] = fmt.Sprint(value)
+	}
+	if withDetails, detailsErr := st.WithDetails(info); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// statusCode maps an eidos error code to a gRPC status code.
+func statusCode(code eidoserrors.ErrorCode) codes.Code {
+	switch code {
+	case eidoserrors.ErrCodeInvalidRequest:
+		return codes.InvalidArgument
+	case eidoserrors.ErrCodeNotFound:
+		return codes.NotFound
+	case eidoserrors.ErrCodeUnauthorized:
+		return codes.PermissionDenied
+	case eidoserrors.ErrCodeTimeout:
+		return codes.DeadlineExceeded
+	case eidoserrors.ErrCodeRateLimitExceeded, eidoserrors.ErrCodeRequestTooLarge:
+		return codes.ResourceExhausted
+	case eidoserrors.ErrCodeMethodNotAllowed:
+		return codes.Unimplemented
+	case eidoserrors.ErrCodeUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
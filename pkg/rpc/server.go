@@ -0,0 +1,343 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	eidosv1 "github.com/NVIDIA/eidos/api/eidos/v1"
+	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/collector"
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+// bundleChunkSize is the largest BundleChunk a bundle archive is streamed in,
+// well below the default 4 MiB gRPC message limit.
+const bundleChunkSize = 64 << 10
+
+// errSnapshotRef rejects snapshot_ref: ConfigMap and Secret snapshot URIs
+// are only read by the CLI, since the server would read them with its own
+// credentials on behalf of any caller.
+var errSnapshotRef = eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+	"snapshot_ref is not supported by the server; send the snapshot document inline")
+
+// Server implements the Eidos gRPC service on top of the recipe builder,
+// bundler and validator used by the HTTP API.
+//
+// Thread-safety: Server is safe for concurrent use.
+type Server struct {
+	eidosv1.UnimplementedEidosServer
+
+	version string
+	recipes *recipe.Builder
+	bundler *bundler.DefaultBundler
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithVersion sets the version recorded in snapshots and validation results.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithRecipeBuilder sets the builder serving Recipe calls, and the
+// allowlists applied to their criteria.
+func WithRecipeBuilder(b *recipe.Builder) Option {
+	return func(s *Server) {
+		s.recipes = b
+	}
+}
+
+// WithBundler sets the bundler serving Bundle calls, and the allowlists and
+// janitor used for them.
+func WithBundler(b *bundler.DefaultBundler) Option {
+	return func(s *Server) {
+		s.bundler = b
+	}
+}
+
+// New creates a Server. Without WithRecipeBuilder or WithBundler, a default
+// recipe builder and bundler without allowlists are used.
+func New(opts ...Option) (*Server, error) {
+	s := &Server{}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.recipes == nil {
+		s.recipes = recipe.NewBuilder(recipe.WithVersion(s.version))
+	}
+	if s.bundler == nil {
+		b, err := bundler.New()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create bundler: %w", err)
+		}
+		s.bundler = b
+	}
+	return s, nil
+}
+
+// Register registers the Eidos service with gs.
+func (s *Server) Register(gs grpc.ServiceRegistrar) {
+	eidosv1.RegisterEidosServer(gs, s)
+}
+
+// Snapshot captures the configuration of the node the server runs on.
+func (s *Server) Snapshot(ctx context.Context, req *eidosv1.SnapshotRequest) (*eidosv1.SnapshotResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaults.SnapshotHandlerTimeout)
+	defer cancel()
+
+	scope, err := snapshotter.ParseScope(req.GetTypes(), req.GetExcludeSubtypes())
+	if err != nil {
+		return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+			"Invalid snapshot scope", err))
+	}
+
+	var buf bytes.Buffer
+	ns := snapshotter.NodeSnapshotter{
+		Version:    s.version,
+		Factory:    collector.NewDefaultFactory(collector.WithVersion(s.version)),
+		Serializer: serializer.NewWriter(serializer.FormatJSON, &buf),
+		Scope:      scope,
+	}
+
+	if req.GetRedact() || len(req.GetRedactPatterns()) > 0 {
+		redactor, redactErr := snapshotter.NewRedactor(req.GetRedactPatterns()...)
+		if redactErr != nil {
+			return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				"Invalid redact pattern", redactErr))
+		}
+		ns.Redactor = redactor
+	}
+
+	if err := ns.Measure(ctx); err != nil {
+		return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			"Failed to capture snapshot", err))
+	}
+	return &eidosv1.SnapshotResponse{Snapshot: buf.Bytes()}, nil
+}
+
+// Recipe builds a recipe from criteria, or from the criteria detected in a
+// snapshot.
+func (s *Server) Recipe(ctx context.Context, req *eidosv1.RecipeRequest) (*eidosv1.RecipeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaults.RecipeHandlerTimeout)
+	defer cancel()
+
+	var criteria *recipe.Criteria
+	var detection *recipe.CriteriaDetection
+	var err error
+
+	switch src := req.GetSource().(type) {
+	case *eidosv1.RecipeRequest_Criteria:
+		if req.GetIntent() != "" {
+			return nil, statusError(eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+				"Intent only applies to snapshot sources, set criteria.intent instead"))
+		}
+		criteria, err = recipe.BuildCriteria(
+			recipe.WithCriteriaService(src.Criteria.GetService()),
+			recipe.WithCriteriaAccelerator(src.Criteria.GetAccelerator()),
+			recipe.WithCriteriaIntent(src.Criteria.GetIntent()),
+			recipe.WithCriteriaOS(src.Criteria.GetOs()),
+			recipe.WithCriteriaNodes(int(src.Criteria.GetNodes())),
+			recipe.WithCriteriaScale(src.Criteria.GetScale()),
+			recipe.WithCriteriaPartitioning(src.Criteria.GetPartitioning()),
+		)
+		if err != nil {
+			return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				"Invalid recipe criteria", err))
+		}
+	case *eidosv1.RecipeRequest_SnapshotRef:
+		return nil, statusError(errSnapshotRef)
+	case *eidosv1.RecipeRequest_Snapshot:
+		snapReq := &recipe.SnapshotRecipeRequest{Intent: req.GetIntent()}
+		if snapReq.Snapshot, err = parseSnapshot(src.Snapshot); err != nil {
+			return nil, statusError(err)
+		}
+		if err = snapReq.Validate(); err != nil {
+			return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				"Invalid snapshot recipe request", err))
+		}
+		criteria, detection, err = snapReq.Criteria()
+		if err != nil {
+			return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest,
+				"Failed to extract criteria from snapshot", err))
+		}
+	default:
+		return nil, statusError(eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"Criteria or snapshot is required"))
+	}
+
+	if s.recipes.AllowLists != nil {
+		if err := s.recipes.AllowLists.ValidateCriteria(criteria); err != nil {
+			return nil, statusError(err)
+		}
+	}
+
+	result, err := s.recipes.BuildFromCriteria(ctx, criteria)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if detection != nil {
+		result.Metadata.CriteriaDetection = detection
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			"Failed to encode recipe", err))
+	}
+	return &eidosv1.RecipeResponse{Recipe: data}, nil
+}
+
+// Bundle generates a bundle from a recipe and streams the archive. The
+// bundle summary is sent in the x-bundle-* trailers. A stream that ends with
+// an error may have sent part of the archive, which must be discarded.
+func (s *Server) Bundle(req *eidosv1.BundleRequest, stream eidosv1.Eidos_BundleServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), defaults.BundleHandlerTimeout)
+	defer cancel()
+
+	var recipeResult recipe.RecipeResult
+	if err := json.Unmarshal(req.GetRecipe(), &recipeResult); err != nil {
+		return statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid recipe", err))
+	}
+
+	w := bufio.NewWriterSize(&chunkWriter{stream: stream}, bundleChunkSize)
+	output, err := s.bundler.MakeArchive(ctx, &recipeResult, bundleQuery(req), w)
+	if err != nil {
+		return statusError(err)
+	}
+	if err := w.Flush(); err != nil {
+		return statusError(err)
+	}
+
+	stream.SetTrailer(metadata.Pairs(
+		"x-bundle-files", strconv.Itoa(output.TotalFiles),
+		"x-bundle-size", strconv.FormatInt(output.TotalSize, 10),
+		"x-bundle-duration", output.TotalDuration.String(),
+	))
+	return nil
+}
+
+// Validate checks a snapshot against the constraints of a recipe.
+func (s *Server) Validate(ctx context.Context, req *eidosv1.ValidateRequest) (*eidosv1.ValidateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaults.RecipeHandlerTimeout)
+	defer cancel()
+
+	var recipeResult recipe.RecipeResult
+	if err := json.Unmarshal(req.GetRecipe(), &recipeResult); err != nil {
+		return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid recipe", err))
+	}
+
+	var snap *snapshotter.Snapshot
+	var err error
+	switch src := req.GetSnapshotSource().(type) {
+	case *eidosv1.ValidateRequest_Snapshot:
+		if snap, err = parseSnapshot(src.Snapshot); err != nil {
+			return nil, statusError(err)
+		}
+	case *eidosv1.ValidateRequest_SnapshotRef:
+		return nil, statusError(errSnapshotRef)
+	default:
+		return nil, statusError(eidoserrors.New(eidoserrors.ErrCodeInvalidRequest,
+			"Snapshot is required"))
+	}
+
+	result, err := validator.New(validator.WithVersion(s.version)).Validate(ctx, &recipeResult, snap)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, statusError(eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+			"Failed to encode validation result", err))
+	}
+	return &eidosv1.ValidateResponse{Result: data, Status: string(result.Summary.Status)}, nil
+}
+
+// parseSnapshot decodes a JSON snapshot document.
+func parseSnapshot(data []byte) (*snapshotter.Snapshot, error) {
+	var snap snapshotter.Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInvalidRequest, "Invalid snapshot", err)
+	}
+	return &snap, nil
+}
+
+// bundleQuery converts the bundle options of req to the POST /v1/bundle
+// query parameters.
+func bundleQuery(req *eidosv1.BundleRequest) url.Values {
+	query := url.Values{
+		"set":                         req.GetSet(),
+		"system-node-selector":        req.GetSystemNodeSelector(),
+		"system-node-toleration":      req.GetSystemNodeToleration(),
+		"accelerated-node-selector":   req.GetAcceleratedNodeSelector(),
+		"accelerated-node-toleration": req.GetAcceleratedNodeToleration(),
+	}
+	for key, value := range map[string]string{
+		"deployer":           req.GetDeployer(),
+		"repo":               req.GetRepo(),
+		"kubernetes-version": req.GetKubernetesVersion(),
+		"capacity-template":  req.GetCapacityTemplate(),
+		"format":             req.GetFormat(),
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if req.GetRunbook() {
+		query.Set("runbook", "true")
+	}
+	if req.GetNodeBootstrap() {
+		query.Set("node-bootstrap", "true")
+	}
+	return query
+}
+
+// chunkWriter sends writes as BundleChunk messages of at most
+// bundleChunkSize bytes.
+type chunkWriter struct {
+	stream eidosv1.Eidos_BundleServer
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), bundleChunkSize)
+		if err := c.stream.Send(&eidosv1.BundleChunk{Data: p[:n]}); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
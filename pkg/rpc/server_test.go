@@ -0,0 +1,418 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	eidosv1 "github.com/NVIDIA/eidos/api/eidos/v1"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/server"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+const testRecipe = `{
+	"apiVersion": "eidos.nvidia.com/v1alpha1",
+	"kind": "Recipe",
+	"componentRefs": [
+		{
+			"name": "gpu-operator",
+			"version": "v25.3.3",
+			"type": "helm",
+			"valuesFile": "components/gpu-operator/values.yaml"
+		}
+	]
+}`
+
+const testSnapshot = `{
+	"apiVersion": "eidos.nvidia.com/v1alpha1",
+	"kind": "Snapshot",
+	"measurements": []
+}`
+
+// newTestClient serves s over an in-memory connection and returns a client.
+func newTestClient(t *testing.T, s *Server, opts ...grpc.ServerOption) eidosv1.EidosClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer(opts...)
+	s.Register(gs)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return eidosv1.NewEidosClient(conn)
+}
+
+func newTestServer(t *testing.T, opts ...Option) eidosv1.EidosClient {
+	t.Helper()
+
+	s, err := New(append([]Option{WithVersion("v1.0.0-test")}, opts...)...)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return newTestClient(t, s)
+}
+
+func TestAdmission(t *testing.T) {
+	t.Setenv("AUTH_MODE", "token")
+	t.Setenv("AUTH_TOKENS", "secret")
+	admitter := server.New()
+
+	s, err := New(WithVersion("v1.0.0-test"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	client := newTestClient(t, s,
+		grpc.ChainUnaryInterceptor(UnaryAdmission(admitter)),
+		grpc.ChainStreamInterceptor(StreamAdmission(admitter)),
+	)
+	req := &eidosv1.RecipeRequest{
+		Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{Service: "eks"}},
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          codes.Code
+	}{
+		{"missing credentials", "", codes.Unauthenticated},
+		{"invalid token", "Bearer wrong", codes.Unauthenticated},
+		{"valid token", "Bearer secret", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", tt.authorization)
+			}
+			if _, err := client.Recipe(ctx, req); status.Code(err) != tt.want {
+				t.Errorf("Recipe() code = %v, want %v (error %v)", status.Code(err), tt.want, err)
+			}
+
+			// Streaming calls are admitted the same way
+			stream, err := client.Bundle(ctx, &eidosv1.BundleRequest{Recipe: []byte(testRecipe)})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			if status.Code(err) != tt.want {
+				t.Errorf("Bundle() code = %v, want %v (error %v)", status.Code(err), tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRecipe(t *testing.T) {
+	client := newTestServer(t)
+	ctx := context.Background()
+
+	t.Run("criteria", func(t *testing.T) {
+		resp, err := client.Recipe(ctx, &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{
+				Service:     "eks",
+				Accelerator: "h100",
+				Intent:      "training",
+			}},
+		})
+		if err != nil {
+			t.Fatalf("Recipe() error = %v", err)
+		}
+
+		var result recipe.RecipeResult
+		if err := json.Unmarshal(resp.GetRecipe(), &result); err != nil {
+			t.Fatalf("failed to decode recipe: %v", err)
+		}
+		if result.Criteria == nil || result.Criteria.Service != recipe.CriteriaServiceEKS {
+			t.Errorf("criteria = %+v, want service eks", result.Criteria)
+		}
+		if len(result.ComponentRefs) == 0 {
+			t.Error("recipe has no component references")
+		}
+	})
+
+	t.Run("scale and partitioning", func(t *testing.T) {
+		resp, err := client.Recipe(ctx, &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{
+				Service:      "eks",
+				Scale:        "large",
+				Partitioning: "mig-single",
+			}},
+		})
+		if err != nil {
+			t.Fatalf("Recipe() error = %v", err)
+		}
+
+		var result recipe.RecipeResult
+		if err := json.Unmarshal(resp.GetRecipe(), &result); err != nil {
+			t.Fatalf("failed to decode recipe: %v", err)
+		}
+		if result.Criteria == nil || result.Criteria.Scale != recipe.CriteriaScaleLarge ||
+			result.Criteria.Partitioning != recipe.CriteriaPartitioningMIGSingle {
+			t.Errorf("criteria = %+v, want scale large and partitioning mig-single", result.Criteria)
+		}
+
+		_, err = client.Recipe(ctx, &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{Scale: "huge"}},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Recipe() with invalid scale code = %v, want InvalidArgument", status.Code(err))
+		}
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		resp, err := client.Recipe(ctx, &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Snapshot{Snapshot: []byte(testSnapshot)},
+			Intent: "inference",
+		})
+		if err != nil {
+			t.Fatalf("Recipe() error = %v", err)
+		}
+
+		var result recipe.RecipeResult
+		if err := json.Unmarshal(resp.GetRecipe(), &result); err != nil {
+			t.Fatalf("failed to decode recipe: %v", err)
+		}
+		if result.Criteria == nil || result.Criteria.Intent != recipe.CriteriaIntentInference {
+			t.Errorf("criteria = %+v, want intent inference", result.Criteria)
+		}
+		if result.Metadata.CriteriaDetection == nil {
+			t.Error("recipe built from a snapshot has no criteria detection")
+		}
+	})
+
+	errorTests := []struct {
+		name string
+		req  *eidosv1.RecipeRequest
+	}{
+		{"no source", &eidosv1.RecipeRequest{}},
+		{"invalid criteria", &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{Accelerator: "tpu"}},
+		}},
+		{"intent with criteria", &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{}},
+			Intent: "training",
+		}},
+		{"invalid snapshot", &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_Snapshot{Snapshot: []byte("{")},
+		}},
+		{"snapshot ref", &eidosv1.RecipeRequest{
+			Source: &eidosv1.RecipeRequest_SnapshotRef{SnapshotRef: "secret://kube-system/bootstrap-token"},
+		}},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Recipe(ctx, tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Recipe() error = %v, want code %s", err, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestRecipe_AllowLists(t *testing.T) {
+	client := newTestServer(t, WithRecipeBuilder(recipe.NewBuilder(
+		recipe.WithAllowLists(&recipe.AllowLists{
+			Accelerators: []recipe.CriteriaAcceleratorType{recipe.CriteriaAcceleratorH100},
+		}),
+	)))
+
+	_, err := client.Recipe(context.Background(), &eidosv1.RecipeRequest{
+		Source: &eidosv1.RecipeRequest_Criteria{Criteria: &eidosv1.Criteria{Accelerator: "gb200"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Recipe() error = %v, want code %s", err, codes.InvalidArgument)
+	}
+}
+
+func TestBundle(t *testing.T) {
+	client := newTestServer(t)
+
+	stream, err := client.Bundle(context.Background(), &eidosv1.BundleRequest{
+		Recipe: []byte(testRecipe),
+		Format: "zip",
+	})
+	if err != nil {
+		t.Fatalf("Bundle() error = %v", err)
+	}
+
+	var archive bytes.Buffer
+	for {
+		chunk, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+		if recvErr != nil {
+			t.Fatalf("Recv() error = %v", recvErr)
+		}
+		if len(chunk.GetData()) > bundleChunkSize {
+			t.Errorf("chunk size = %d, want at most %d", len(chunk.GetData()), bundleChunkSize)
+		}
+		archive.Write(chunk.GetData())
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil {
+		t.Fatalf("bundle is not a valid zip archive: %v", err)
+	}
+	if len(zr.File) == 0 {
+		t.Error("bundle archive is empty")
+	}
+
+	trailer := stream.Trailer()
+	files, err := strconv.Atoi(first(trailer, "x-bundle-files"))
+	if err != nil || files == 0 {
+		t.Errorf("x-bundle-files trailer = %q, want a file count", first(trailer, "x-bundle-files"))
+	}
+	if first(trailer, "x-bundle-size") == "" || first(trailer, "x-bundle-duration") == "" {
+		t.Errorf("missing bundle summary trailers: %v", trailer)
+	}
+}
+
+func TestBundle_Errors(t *testing.T) {
+	client := newTestServer(t)
+
+	tests := []struct {
+		name string
+		req  *eidosv1.BundleRequest
+	}{
+		{"invalid recipe", &eidosv1.BundleRequest{Recipe: []byte("{")}},
+		{"no components", &eidosv1.BundleRequest{Recipe: []byte(`{"kind": "Recipe"}`)}},
+		{"invalid deployer", &eidosv1.BundleRequest{Recipe: []byte(testRecipe), Deployer: "kustomize"}},
+		{"invalid format", &eidosv1.BundleRequest{Recipe: []byte(testRecipe), Format: "rar"}},
+		{"invalid set", &eidosv1.BundleRequest{Recipe: []byte(testRecipe), Set: []string{"no-equals"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.Bundle(context.Background(), tt.req)
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Bundle() error = %v, want code %s", err, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	client := newTestServer(t)
+	ctx := context.Background()
+
+	resp, err := client.Validate(ctx, &eidosv1.ValidateRequest{
+		Recipe:         []byte(testRecipe),
+		SnapshotSource: &eidosv1.ValidateRequest_Snapshot{Snapshot: []byte(testSnapshot)},
+	})
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	var result validator.ValidationResult
+	if err := json.Unmarshal(resp.GetResult(), &result); err != nil {
+		t.Fatalf("failed to decode validation result: %v", err)
+	}
+	if resp.GetStatus() != string(result.Summary.Status) {
+		t.Errorf("status = %q, want %q", resp.GetStatus(), result.Summary.Status)
+	}
+
+	_, err = client.Validate(ctx, &eidosv1.ValidateRequest{Recipe: []byte(testRecipe)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Validate() without snapshot error = %v, want code %s", err, codes.InvalidArgument)
+	}
+
+	_, err = client.Validate(ctx, &eidosv1.ValidateRequest{
+		Recipe:         []byte(testRecipe),
+		SnapshotSource: &eidosv1.ValidateRequest_SnapshotRef{SnapshotRef: "cm://gpu-operator/eidos-snapshot"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Validate() with snapshot_ref error = %v, want code %s", err, codes.InvalidArgument)
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"invalid request", eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "bad"), codes.InvalidArgument},
+		{"not found", eidoserrors.New(eidoserrors.ErrCodeNotFound, "missing"), codes.NotFound},
+		{"timeout", eidoserrors.New(eidoserrors.ErrCodeTimeout, "slow"), codes.DeadlineExceeded},
+		{"unavailable", eidoserrors.New(eidoserrors.ErrCodeUnavailable, "down"), codes.Unavailable},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"plain error", errors.New("boom"), codes.Internal},
+		{"status error", status.Error(codes.Aborted, "aborted"), codes.Aborted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(statusError(tt.err)); got != tt.want {
+				t.Errorf("statusError() code = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("details", func(t *testing.T) {
+		err := statusError(eidoserrors.NewWithContext(eidoserrors.ErrCodeInternal, "Bundle generation failed",
+			map[string]any{"errors": []string{"gpu-operator: failed"}}))
+		details := status.Convert(err).Details()
+		if len(details) != 1 {
+			t.Fatalf("details = %v, want one ErrorInfo", details)
+		}
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		if !ok {
+			t.Fatalf("detail = %T, want *errdetails.ErrorInfo", details[0])
+		}
+		if info.GetReason() != string(eidoserrors.ErrCodeInternal) || info.GetDomain() != ErrorDomain {
+			t.Errorf("ErrorInfo = %v", info)
+		}
+		if info.GetMetadata()["errors"] == "" {
+			t.Errorf("ErrorInfo metadata = %v, want errors", info.GetMetadata())
+		}
+	})
+}
+
+// first returns the first value of key in md.
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -85,71 +85,29 @@ func (w *ConfigMapWriter) Serialize(ctx context.Context, snapshot any) error {
 		"auth_method", authInfo,
 		"format", w.format)
 
-	// Serialize snapshot to bytes using appropriate format
-	var content []byte
-	var extension string
-	switch w.format {
-	case FormatJSON:
-		content, err = serializeJSON(snapshot)
-		extension = "json"
-	case FormatYAML:
-		content, err = serializeYAML(snapshot)
-		extension = "yaml"
-	case FormatTable:
-		content, err = serializeTable(snapshot)
-		extension = "txt"
-	default:
-		return fmt.Errorf("unsupported format for ConfigMap: %s", w.format)
-	}
+	content, extension, err := serializeObject(snapshot, w.format)
 	if err != nil {
-		return fmt.Errorf("failed to serialize snapshot: %w", err)
-	}
-
-	// Extract metadata from snapshot if it has a header
-	var snapshotVersion string
-	var snapshotKind string
-	var snapshotTimestamp string
-
-	// Try to extract header information if snapshot implements it
-	if headerData, ok := snapshot.(interface {
-		GetKind() header.Kind
-		GetMetadata() map[string]string
-	}); ok {
-		snapshotKind = headerData.GetKind().String()
-		metadata := headerData.GetMetadata()
-		if v, exists := metadata["version"]; exists {
-			snapshotVersion = v
-		}
-		if ts, exists := metadata["timestamp"]; exists {
-			snapshotTimestamp = ts
-		}
-	}
-
-	// Use defaults if not available from header
-	if snapshotVersion == "" {
-		snapshotVersion = "unknown"
+		return err
 	}
-	if snapshotKind == "" {
-		snapshotKind = header.KindSnapshot.String()
-	}
-	if snapshotTimestamp == "" {
-		snapshotTimestamp = time.Now().UTC().Format(time.RFC3339)
+	if err := checkObjectDataSize("ConfigMap", len(content)); err != nil {
+		return err
 	}
+	kind, version, timestamp := objectMetadata(snapshot)
 
 	// Create ConfigMap data
 	dataKey := fmt.Sprintf("snapshot.%s", extension)
 	configMapData := map[string]string{
 		dataKey:     string(content),
 		"format":    string(w.format),
-		"timestamp": snapshotTimestamp,
+		"timestamp": timestamp,
 	}
 
 	// Build ConfigMap apply configuration for Server-Side Apply
 	configMap := accorev1.ConfigMap(w.name, w.namespace).
 		WithLabels(map[string]string{
 			"app.kubernetes.io/name":      "eidos",
-			"app.kubernetes.io/component": snapshotKind,
-			"app.kubernetes.io/version":   snapshotVersion,
+			"app.kubernetes.io/component": kind,
+			"app.kubernetes.io/version":   version,
 		}).
 		WithData(configMapData)
 
@@ -176,16 +134,81 @@ func (w *ConfigMapWriter) Serialize(ctx context.Context, snapshot any) error {
 	return nil
 }
 
+// serializeObject serializes v in format for storage in a ConfigMap or Secret
+// and returns the content with the file extension of its data key.
+func serializeObject(v any, format Format) ([]byte, string, error) {
+	var content []byte
+	var extension string
+	var err error
+	switch format {
+	case FormatJSON:
+		content, err = serializeJSON(v)
+		extension = "json"
+	case FormatYAML:
+		content, err = serializeYAML(v)
+		extension = "yaml"
+	case FormatTable:
+		content, err = serializeTable(v)
+		extension = "txt"
+	case FormatPrometheus, FormatOpenMetrics:
+		content, err = serializeMetrics(v, format == FormatOpenMetrics)
+		extension = "prom"
+	default:
+		return nil, "", fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to serialize snapshot: %w", err)
+	}
+	return content, extension, nil
+}
+
+// objectMetadata extracts the kind, version and timestamp labels of v from
+// its header, falling back to a Snapshot kind, "unknown" version and the
+// current time.
+func objectMetadata(v any) (kind, version, timestamp string) {
+	// Try to extract header information if v implements it
+	if headerData, ok := v.(interface {
+		GetKind() header.Kind
+		GetMetadata() map[string]string
+	}); ok {
+		kind = headerData.GetKind().String()
+		metadata := headerData.GetMetadata()
+		version = metadata["version"]
+		timestamp = metadata["timestamp"]
+	}
+
+	// Use defaults if not available from header
+	if version == "" {
+		version = "unknown"
+	}
+	if kind == "" {
+		kind = header.KindSnapshot.String()
+	}
+	if timestamp == "" {
+		timestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+	return kind, version, timestamp
+}
+
+// checkObjectDataSize fails when size exceeds MaxObjectDataSize, which the
+// API server would reject for the given object kind.
+func checkObjectDataSize(kind string, size int) error {
+	if size > MaxObjectDataSize {
+		return fmt.Errorf("serialized data is %d bytes, exceeding the %d byte %s limit", size, MaxObjectDataSize, kind)
+	}
+	return nil
+}
+
 // Close is a no-op for ConfigMapWriter as there are no resources to release.
 // This method exists to satisfy the Closer interface.
 func (w *ConfigMapWriter) Close() error {
 	return nil
 }
 
-// parseConfigMapURI parses a ConfigMap URI in the format cm://namespace/name
+// ParseConfigMapURI parses a ConfigMap URI in the format cm://namespace/name
 // and returns the namespace and name components.
 // Returns an error if the URI is malformed.
-func parseConfigMapURI(uri string) (namespace, name string, err error) {
+func ParseConfigMapURI(uri string) (namespace, name string, err error) {
 	if !strings.HasPrefix(uri, ConfigMapURIScheme) {
 		return "", "", fmt.Errorf("invalid ConfigMap URI: must start with %s", ConfigMapURIScheme)
 	}
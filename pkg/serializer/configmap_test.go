@@ -86,17 +86,17 @@ func TestParseConfigMapURI(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			namespace, name, err := parseConfigMapURI(tt.uri)
+			namespace, name, err := ParseConfigMapURI(tt.uri)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("parseConfigMapURI() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ParseConfigMapURI() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !tt.wantErr {
 				if namespace != tt.wantNamespace {
-					t.Errorf("parseConfigMapURI() namespace = %v, want %v", namespace, tt.wantNamespace)
+					t.Errorf("ParseConfigMapURI() namespace = %v, want %v", namespace, tt.wantNamespace)
 				}
 				if name != tt.wantName {
-					t.Errorf("parseConfigMapURI() name = %v, want %v", name, tt.wantName)
+					t.Errorf("ParseConfigMapURI() name = %v, want %v", name, tt.wantName)
 				}
 			}
 		})
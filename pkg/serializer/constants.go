@@ -20,6 +20,15 @@ const (
 	// Format: cm://namespace/configmap-name
 	ConfigMapURIScheme = "cm://"
 
+	// SecretURIScheme is the URI scheme for Kubernetes Secret destinations.
+	// Format: secret://namespace/secret-name[#key]
+	SecretURIScheme = "secret://"
+
 	// StdoutURI is the special URI indicating output should be written to stdout.
 	StdoutURI = "-"
 )
+
+// MaxObjectDataSize is the largest serialized document written to a
+// ConfigMap or Secret. Kubernetes rejects objects larger than 1 MiB; the
+// margin leaves room for the metadata and other data keys.
+const MaxObjectDataSize = 1000 * 1024
@@ -39,9 +39,15 @@
 //   - Custom tree-style formatting
 //   - Read-only (no deserialization support)
 //
+// Prometheus and OpenMetrics:
+//   - Numeric and boolean snapshot measurements as gauges named
+//     eidos_<type>_<subtype>{key="..."}, e.g. eidos_os_sysctl
+//   - Suitable for the node exporter textfile collector (.prom files)
+//   - Snapshots only; write-only
+//
 // # Core Types
 //
-// Format: Enum representing output formats (JSON, YAML, Table)
+// Format: Enum representing output formats (JSON, YAML, Table, Prometheus, OpenMetrics)
 //
 // Serializer: Interface for encoding data to output
 //
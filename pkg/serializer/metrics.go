@@ -0,0 +1,225 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serializer
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// metricPrefix prefixes the names of all snapshot metrics.
+const metricPrefix = "eidos_"
+
+// measurementSource is implemented by documents whose measurements can be
+// rendered as metrics, such as snapshots.
+type measurementSource interface {
+	GetMeasurements() []*measurement.Measurement
+}
+
+// metricFamily is a gauge with one sample per measurement key.
+type metricFamily struct {
+	name    string
+	help    string
+	samples map[string]float64
+}
+
+// serializeMetrics renders the numeric and boolean readings of v's
+// measurements in the Prometheus text exposition format, or in OpenMetrics
+// when openMetrics is set.
+//
+// Each measurement subtype becomes a gauge named eidos_<type>_<subtype> with
+// the reading key as the "key" label, e.g.
+// eidos_os_sysctl{key="/proc/sys/vm/max_map_count"} 262144. Booleans are 1 or
+// 0; strings are included when they parse as a number or boolean. The
+// document metadata is exposed as labels of eidos_snapshot_info.
+func serializeMetrics(v any, openMetrics bool) ([]byte, error) {
+	source, ok := v.(measurementSource)
+	if !ok {
+		return nil, fmt.Errorf("metrics formats only support snapshots, got %T", v)
+	}
+
+	families := map[string]*metricFamily{}
+	for _, m := range source.GetMeasurements() {
+		if m == nil {
+			continue
+		}
+		for _, st := range m.Subtypes {
+			name := metricPrefix + metricName(m.Type.String())
+			if st.Name != "" {
+				name += "_" + metricName(st.Name)
+			}
+			family, ok := families[name]
+			if !ok {
+				family = &metricFamily{
+					name:    name,
+					help:    fmt.Sprintf("%s %s measurements.", m.Type, st.Name),
+					samples: map[string]float64{},
+				}
+				families[name] = family
+			}
+			for key, reading := range st.Data {
+				if value, ok := metricValue(reading); ok {
+					family.samples[key] = value
+				}
+			}
+		}
+	}
+
+	var b strings.Builder
+	writeSnapshotInfo(&b, v, openMetrics)
+
+	names := make([]string, 0, len(families))
+	for name, family := range families {
+		if len(family.samples) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		family := families[name]
+		fmt.Fprintf(&b, "# HELP %s %s\n", name, escapeHelp(family.help))
+		fmt.Fprintf(&b, "# TYPE %s gauge\n", name)
+		keys := make([]string, 0, len(family.samples))
+		for key := range family.samples {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s{key=\"%s\"} %s\n", name, escapeLabelValue(key), formatMetricValue(family.samples[key]))
+		}
+	}
+
+	if openMetrics {
+		b.WriteString("# EOF\n")
+	}
+	return []byte(b.String()), nil
+}
+
+// writeSnapshotInfo writes eidos_snapshot_info, labeled with the kind and
+// metadata of v's header. The timestamp is left out so repeated snapshots of
+// a node stay one series. OpenMetrics declares it as an info metric.
+func writeSnapshotInfo(b *strings.Builder, v any, openMetrics bool) {
+	labels := map[string]string{}
+	if h, ok := v.(interface {
+		GetMetadata() map[string]string
+	}); ok {
+		for key, value := range h.GetMetadata() {
+			if key != "timestamp" {
+				labels[metricName(key)] = value
+			}
+		}
+	}
+	kind, _, _ := objectMetadata(v)
+	labels["kind"] = kind
+
+	name := metricPrefix + "snapshot"
+	if openMetrics {
+		fmt.Fprintf(b, "# HELP %s Snapshot metadata.\n", name)
+		fmt.Fprintf(b, "# TYPE %s info\n", name)
+	} else {
+		fmt.Fprintf(b, "# HELP %s_info Snapshot metadata.\n", name)
+		fmt.Fprintf(b, "# TYPE %s_info gauge\n", name)
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", key, escapeLabelValue(labels[key])))
+	}
+	fmt.Fprintf(b, "%s_info{%s} 1\n", name, strings.Join(pairs, ","))
+}
+
+// metricValue returns the value of a numeric or boolean reading, or false
+// when the reading has no numeric value.
+func metricValue(r measurement.Reading) (float64, bool) {
+	if r == nil {
+		return 0, false
+	}
+	switch v := r.Any().(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case string:
+		s := strings.TrimSpace(v)
+		if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f, true
+		}
+		if b, err := strconv.ParseBool(s); err == nil && (s == "true" || s == "false") {
+			if b {
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+// metricName lowercases s and replaces characters not allowed in metric and
+// label names with underscores.
+func metricName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	name := b.String()
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// formatMetricValue formats a sample value, writing integers such as memory
+// sizes in full rather than in exponent notation.
+func formatMetricValue(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1e18 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// escapeLabelValue escapes backslashes, double quotes and newlines.
+func escapeLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+// escapeHelp escapes backslashes and newlines.
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
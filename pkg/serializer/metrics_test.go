@@ -0,0 +1,149 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serializer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// testSnapshot is a minimal snapshot; the snapshotter package cannot be
+// imported here since it imports serializer.
+type testSnapshot struct {
+	header.Header
+	Measurements []*measurement.Measurement
+}
+
+func (s *testSnapshot) GetMeasurements() []*measurement.Measurement {
+	return s.Measurements
+}
+
+func newTestSnapshot() *testSnapshot {
+	s := &testSnapshot{
+		Measurements: []*measurement.Measurement{
+			{
+				Type: measurement.TypeOS,
+				Subtypes: []measurement.Subtype{
+					{
+						Name: "sysctl",
+						Data: map[string]measurement.Reading{
+							"/proc/sys/vm/max_map_count":  measurement.Str("262144"),
+							"/proc/sys/net/ipv4/tcp_rmem": measurement.Str("4096 87380 6291456"),
+						},
+					},
+					{
+						Name: "kmod",
+						Data: map[string]measurement.Reading{
+							"nvidia_peermem": measurement.Bool(true),
+						},
+					},
+				},
+			},
+			{
+				Type: measurement.TypeSystemD,
+				Subtypes: []measurement.Subtype{
+					{
+						Name: "containerd.service",
+						Data: map[string]measurement.Reading{
+							"CPUAccounting": measurement.Bool(false),
+							"MemoryHigh":    measurement.Uint64(1 << 30),
+							"Description":   measurement.Str("containerd \"container\" runtime"),
+						},
+					},
+				},
+			},
+		},
+	}
+	s.Init(header.KindSnapshot, "eidos.nvidia.com/v1alpha1", "v1.0.0")
+	s.Metadata["source-node"] = "gpu-node-1"
+	return s
+}
+
+func TestWriter_SerializePrometheus(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewWriter(FormatPrometheus, &buf).Serialize(context.Background(), newTestSnapshot()); err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"# TYPE eidos_os_sysctl gauge\n",
+		`eidos_os_sysctl{key="/proc/sys/vm/max_map_count"} 262144` + "\n",
+		`eidos_os_kmod{key="nvidia_peermem"} 1` + "\n",
+		`eidos_systemd_containerd_service{key="CPUAccounting"} 0` + "\n",
+		`eidos_systemd_containerd_service{key="MemoryHigh"} 1073741824` + "\n",
+		"# TYPE eidos_snapshot_info gauge\n",
+		`source_node="gpu-node-1"`,
+		`kind="Snapshot"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"tcp_rmem", "Description", "timestamp=", "# EOF"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("output contains %q:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestWriter_SerializeOpenMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewWriter(FormatOpenMetrics, &buf).Serialize(context.Background(), newTestSnapshot()); err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasSuffix(out, "# EOF\n") {
+		t.Errorf("output does not end with # EOF:\n%s", out)
+	}
+	if !strings.Contains(out, "# TYPE eidos_snapshot info\n") {
+		t.Errorf("expected info metric family:\n%s", out)
+	}
+}
+
+func TestSerializeMetrics_NotSnapshot(t *testing.T) {
+	if _, err := serializeMetrics(map[string]string{"a": "b"}, false); err == nil {
+		t.Error("expected error for a document without measurements")
+	}
+}
+
+func TestMetricName(t *testing.T) {
+	tests := map[string]string{
+		"sysctl":             "sysctl",
+		"containerd.service": "containerd_service",
+		"cert-manager":       "cert_manager",
+		"K8s":                "k8s",
+		"9p":                 "_9p",
+	}
+	for in, want := range tests {
+		if got := metricName(in); got != want {
+			t.Errorf("metricName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNewReader_MetricsFormatsWriteOnly(t *testing.T) {
+	for _, format := range []Format{FormatPrometheus, FormatOpenMetrics} {
+		if _, err := NewReader(format, strings.NewReader("")); err == nil {
+			t.Errorf("NewReader(%s) expected error", format)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serializer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/NVIDIA/eidos/pkg/objectstore"
+)
+
+// ObjectWriter writes serialized data to an object in S3, GCS or Azure Blob
+// Storage, replacing it if it exists.
+type ObjectWriter struct {
+	uri    string
+	format Format
+}
+
+// NewObjectWriter creates a new ObjectWriter that writes to the s3://,
+// gs:// or az:// URI in the given format.
+func NewObjectWriter(uri string, format Format) *ObjectWriter {
+	if format.IsUnknown() {
+		slog.Warn("unknown format, defaulting to JSON", "format", format)
+		format = FormatJSON
+	}
+	return &ObjectWriter{
+		uri:    uri,
+		format: format,
+	}
+}
+
+// Serialize writes the data to the object. Credentials are resolved from
+// the environment as described in the objectstore package.
+func (w *ObjectWriter) Serialize(ctx context.Context, snapshot any) error {
+	content, extension, err := serializeObject(snapshot, w.format)
+	if err != nil {
+		return err
+	}
+	if err := objectstore.Put(ctx, w.uri, content, objectstore.ContentType("object."+extension)); err != nil {
+		return fmt.Errorf("failed to write %s: %w", w.uri, err)
+	}
+	slog.Debug("object written", "uri", w.uri, "format", w.format, "size", len(content))
+	return nil
+}
+
+// Close is a no-op for ObjectWriter as there are no resources to release.
+func (w *ObjectWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serializer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestObjectWriterAndReader(t *testing.T) {
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path], _ = io.ReadAll(r.Body)
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write(data)
+		}
+	}))
+	defer srv.Close()
+	t.Setenv("AWS_ENDPOINT_URL_S3", srv.URL)
+	t.Setenv("AWS_ACCESS_KEY_ID", "id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	uri := "s3://fleet/snapshots/node-1.yaml"
+	w, err := NewFileWriterOrStdout(FormatYAML, uri)
+	if err != nil {
+		t.Fatalf("NewFileWriterOrStdout() error = %v", err)
+	}
+	if _, ok := w.(*ObjectWriter); !ok {
+		t.Fatalf("NewFileWriterOrStdout() = %T, want *ObjectWriter", w)
+	}
+	if err := w.Serialize(context.Background(), testConfig{Name: testName, Value: 42}); err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	if string(objects["/fleet/snapshots/node-1.yaml"]) != "name: test\nvalue: 42\n" {
+		t.Errorf("object content = %q", objects["/fleet/snapshots/node-1.yaml"])
+	}
+
+	got, err := FromFile[testConfig](uri)
+	if err != nil {
+		t.Fatalf("FromFile() error = %v", err)
+	}
+	if got.Name != testName || got.Value != 42 {
+		t.Errorf("FromFile() = %+v", got)
+	}
+
+	if _, err := FromFile[testConfig]("s3://fleet/missing.yaml"); err == nil {
+		t.Error("FromFile() expected error for missing object")
+	}
+}
+
+func TestNewFileWriterOrStdout_InvalidObjectURI(t *testing.T) {
+	if _, err := NewFileWriterOrStdout(FormatYAML, "az://account"); err == nil {
+		t.Error("expected error for Azure URI without container")
+	}
+}
@@ -15,6 +15,7 @@
 package serializer
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/eidos/pkg/k8s/client"
+	"github.com/NVIDIA/eidos/pkg/objectstore"
 	"gopkg.in/yaml.v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -35,6 +37,7 @@ import (
 //   - .json → FormatJSON
 //   - .yaml, .yml → FormatYAML
 //   - .table, .txt → FormatTable
+//   - .prom → FormatPrometheus
 //
 // Returns FormatJSON as default for unknown extensions.
 // Extension matching is case-insensitive.
@@ -47,6 +50,8 @@ func FormatFromPath(filePath string) Format {
 		return FormatYAML
 	case strings.HasSuffix(lowerPath, ".table"), strings.HasSuffix(lowerPath, ".txt"):
 		return FormatTable
+	case strings.HasSuffix(lowerPath, ".prom"):
+		return FormatPrometheus
 	default:
 		slog.Warn("unknown file extension, defaulting to JSON", "filePath", filePath)
 		return FormatJSON
@@ -76,7 +81,7 @@ type Reader struct {
 //
 // Returns error if:
 //   - format is unknown or unsupported
-//   - format is FormatTable (table format does not support deserialization)
+//   - format is write-only, such as FormatTable
 //
 // Resource Management:
 //   - If input implements io.Closer, it will be stored and closed by Reader.Close()
@@ -93,8 +98,8 @@ func NewReader(format Format, input io.Reader) (*Reader, error) {
 		return nil, fmt.Errorf("unknown format: %s", format)
 	}
 
-	if format == FormatTable {
-		return nil, fmt.Errorf("table format does not support deserialization")
+	if format.IsWriteOnly() {
+		return nil, fmt.Errorf("%s format does not support deserialization", format)
 	}
 
 	r := &Reader{
@@ -123,7 +128,7 @@ func NewReader(format Format, input io.Reader) (*Reader, error) {
 //
 // Returns error if:
 //   - format is unknown or unsupported
-//   - format is FormatTable (table format does not support deserialization)
+//   - format is write-only, such as FormatTable
 //   - file cannot be opened or URL cannot be downloaded
 //
 // Resource Management:
@@ -140,14 +145,25 @@ func NewFileReader(format Format, filePath string) (*Reader, error) {
 		return nil, fmt.Errorf("unknown format: %s", format)
 	}
 
-	if format == FormatTable {
-		return nil, fmt.Errorf("table format does not support deserialization")
+	if format.IsWriteOnly() {
+		return nil, fmt.Errorf("%s format does not support deserialization", format)
 	}
 
 	// If the filePath is a URL or special scheme, handle accordingly
 	var file *os.File
 	var err error
 
+	if objectstore.IsURI(filePath) {
+		data, getErr := objectstore.Get(context.Background(), filePath)
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to download object: %w", getErr)
+		}
+		return &Reader{
+			format: format,
+			input:  bytes.NewReader(data),
+		}, nil
+	}
+
 	if strings.HasPrefix(filePath, "http://") || strings.HasPrefix(filePath, "https://") {
 		name := fmt.Sprintf("eidos-%d.tmp", time.Now().UnixNano())
 		tempFilePath := filepath.Join(os.TempDir(), name)
@@ -204,7 +220,7 @@ func NewFileReaderAuto(filePath string) (*Reader, error) {
 //   - Reader is nil
 //   - Input source is nil
 //   - Data cannot be decoded (invalid format, type mismatch)
-//   - Format is FormatTable (not supported for deserialization)
+//   - Format is write-only, such as FormatTable
 //
 // Example:
 //
@@ -234,8 +250,8 @@ func (r *Reader) Deserialize(v any) error {
 		}
 		return nil
 
-	case FormatTable:
-		return fmt.Errorf("table format is not supported for deserialization")
+	case FormatTable, FormatPrometheus, FormatOpenMetrics:
+		return fmt.Errorf("%s format is not supported for deserialization", r.format)
 
 	default:
 		return fmt.Errorf("unsupported format for deserialization: %s", r.format)
@@ -296,16 +312,18 @@ func (r *Reader) Close() error {
 //
 // Note: This is a higher-level API. Use NewFileReader directly if you need
 // more control over the Reader lifecycle or want to reuse it.
-// FromFile reads and deserializes data from a file path, URL, or ConfigMap URI into type T.
+// FromFile reads and deserializes data from a file path, URL, ConfigMap or Secret URI into type T.
 //
 // Supported input sources:
 //   - Local file paths: /path/to/file.json, ./config.yaml
 //   - HTTP URLs: http://example.com/data.json, https://api.example.com/config.yaml
 //   - ConfigMap URIs: cm://namespace/configmap-name
+//   - Secret URIs: secret://namespace/secret-name[#key]
+//   - Object storage URIs: s3://bucket/key, gs://bucket/object, az://account/container/blob
 //
 // Format detection:
 //   - File paths: Determined by extension (.json, .yaml, .yml)
-//   - URLs: Determined by URL path extension or response Content-Type
+//   - URLs and object storage URIs: Determined by path extension
 //   - ConfigMap: Always YAML format (ConfigMaps store data as YAML)
 //
 // Returns:
@@ -317,6 +335,11 @@ func (r *Reader) Close() error {
 //   - Falls back to "snapshot.yaml" if specific format field not found
 //   - Requires Kubernetes cluster access (kubeconfig)
 //
+// Secret Format:
+//   - Reads the #key data field, or the same fields as ConfigMaps without one
+//   - Format from the key extension, then the "format" field, then YAML
+//   - Values that are themselves base64 encoded are decoded
+//
 // Example:
 //
 //	snap, err := FromFile[Snapshot]("cm://gpu-operator/eidos-snapshot")
@@ -324,14 +347,15 @@ func FromFile[T any](path string) (*T, error) {
 	return FromFileWithKubeconfig[T](path, "")
 }
 
-// FromFileWithKubeconfig reads and deserializes data from a file path, HTTP URL, or ConfigMap URI with custom kubeconfig.
+// FromFileWithKubeconfig reads and deserializes data from a file path, HTTP URL, ConfigMap or Secret URI with custom kubeconfig.
 //
-// This is identical to FromFile but allows specifying a custom kubeconfig path for ConfigMap URIs.
-// The kubeconfig parameter is only used when path is a ConfigMap URI (cm://namespace/name).
+// This is identical to FromFile but allows specifying a custom kubeconfig path for ConfigMap and Secret URIs.
+// The kubeconfig parameter is only used when path is a ConfigMap URI (cm://namespace/name)
+// or Secret URI (secret://namespace/name[#key]).
 //
 // Parameters:
-//   - path: File path, HTTP/HTTPS URL, or ConfigMap URI (cm://namespace/name)
-//   - kubeconfig: Path to kubeconfig file (only used for ConfigMap URIs, empty string uses default discovery)
+//   - path: File path, HTTP/HTTPS URL, ConfigMap URI (cm://namespace/name) or Secret URI (secret://namespace/name[#key])
+//   - kubeconfig: Path to kubeconfig file (only used for ConfigMap and Secret URIs, empty string uses default discovery)
 //
 // Example:
 //
@@ -339,13 +363,22 @@ func FromFile[T any](path string) (*T, error) {
 func FromFileWithKubeconfig[T any](path, kubeconfig string) (*T, error) {
 	// Check for ConfigMap URI
 	if strings.HasPrefix(path, ConfigMapURIScheme) {
-		namespace, name, err := parseConfigMapURI(path)
+		namespace, name, err := ParseConfigMapURI(path)
 		if err != nil {
 			return nil, fmt.Errorf("invalid ConfigMap URI: %w", err)
 		}
 		return fromConfigMapWithKubeconfig[T](namespace, name, kubeconfig)
 	}
 
+	// Check for Secret URI
+	if strings.HasPrefix(path, SecretURIScheme) {
+		namespace, name, key, err := parseSecretURI(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Secret URI: %w", err)
+		}
+		return fromSecretWithKubeconfig[T](namespace, name, key, kubeconfig)
+	}
+
 	fileFormat := FormatFromPath(path)
 	slog.Debug("determined file format",
 		slog.String("path", path),
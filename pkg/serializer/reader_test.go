@@ -74,6 +74,11 @@ func TestFormatFromPath(t *testing.T) {
 			path:     "output.txt",
 			expected: FormatTable,
 		},
+		{
+			name:     "prom extension",
+			path:     "/var/lib/node_exporter/textfile/eidos.prom",
+			expected: FormatPrometheus,
+		},
 		{
 			name:     "unknown extension defaults to json",
 			path:     "file.unknown",
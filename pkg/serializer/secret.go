@@ -0,0 +1,272 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serializer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	accorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+)
+
+// secretKeyPattern matches valid Secret data keys.
+var secretKeyPattern = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+
+// IsKubernetesURI reports whether uri refers to a ConfigMap (cm://) or
+// Secret (secret://).
+func IsKubernetesURI(uri string) bool {
+	return strings.HasPrefix(uri, ConfigMapURIScheme) || strings.HasPrefix(uri, SecretURIScheme)
+}
+
+// SecretWriter writes serialized data to a Kubernetes Secret.
+// The Secret is created if it doesn't exist, or updated if it does.
+type SecretWriter struct {
+	namespace string
+	name      string
+	key       string
+	format    Format
+}
+
+// NewSecretWriter creates a new SecretWriter that writes to the specified
+// namespace and Secret name in the given format. An empty key stores the
+// data under snapshot.{json|yaml|txt}, like ConfigMapWriter.
+func NewSecretWriter(namespace, name, key string, format Format) *SecretWriter {
+	if format.IsUnknown() {
+		slog.Warn("unknown format, defaulting to JSON", "format", format)
+		format = FormatJSON
+	}
+	return &SecretWriter{
+		namespace: namespace,
+		name:      name,
+		key:       key,
+		format:    format,
+	}
+}
+
+// Serialize writes the data to an Opaque Secret.
+// The Secret will have:
+// - data.<key> (default snapshot.{yaml|json}): The serialized content
+// - data.format: The format used (yaml or json)
+// - data.timestamp: ISO 8601 timestamp of when the data was created
+func (w *SecretWriter) Serialize(ctx context.Context, snapshot any) error {
+	writeCtx, cancel := context.WithTimeout(ctx, defaults.ConfigMapWriteTimeout)
+	defer cancel()
+
+	secret, err := w.applyConfiguration(snapshot)
+	if err != nil {
+		return err
+	}
+
+	client, _, err := client.GetKubeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get kubernetes client: %w", err)
+	}
+
+	slog.Info("applying Secret",
+		"namespace", w.namespace,
+		"name", w.name,
+		"format", w.format)
+
+	_, err = client.CoreV1().Secrets(w.namespace).Apply(
+		writeCtx,
+		secret,
+		metav1.ApplyOptions{
+			FieldManager: "eidos",
+			Force:        true,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to apply Secret: %w", err)
+	}
+
+	return nil
+}
+
+// applyConfiguration serializes snapshot and builds the Secret to apply.
+func (w *SecretWriter) applyConfiguration(snapshot any) (*accorev1.SecretApplyConfiguration, error) {
+	content, extension, err := serializeObject(snapshot, w.format)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkObjectDataSize("Secret", len(content)); err != nil {
+		return nil, err
+	}
+	kind, version, timestamp := objectMetadata(snapshot)
+
+	dataKey := w.key
+	if dataKey == "" {
+		dataKey = fmt.Sprintf("snapshot.%s", extension)
+	}
+
+	// The client encodes data values in base64
+	return accorev1.Secret(w.name, w.namespace).
+		WithLabels(map[string]string{
+			"app.kubernetes.io/name":      "eidos",
+			"app.kubernetes.io/component": kind,
+			"app.kubernetes.io/version":   version,
+		}).
+		WithType(corev1.SecretTypeOpaque).
+		WithData(map[string][]byte{
+			dataKey:     content,
+			"format":    []byte(w.format),
+			"timestamp": []byte(timestamp),
+		}), nil
+}
+
+// Close is a no-op for SecretWriter as there are no resources to release.
+func (w *SecretWriter) Close() error {
+	return nil
+}
+
+// parseSecretURI parses a Secret URI in the format secret://namespace/name[#key]
+// and returns its components. The key is empty when not given.
+func parseSecretURI(uri string) (namespace, name, key string, err error) {
+	if !strings.HasPrefix(uri, SecretURIScheme) {
+		return "", "", "", fmt.Errorf("invalid Secret URI: must start with %s", SecretURIScheme)
+	}
+
+	path, key, hasKey := strings.Cut(strings.TrimPrefix(uri, SecretURIScheme), "#")
+
+	parts := strings.SplitN(path, "/", 2)
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf("invalid Secret URI format: expected %snamespace/name[#key], got %s", SecretURIScheme, uri)
+	}
+
+	namespace = strings.TrimSpace(parts[0])
+	name = strings.TrimSpace(parts[1])
+	key = strings.TrimSpace(key)
+
+	switch {
+	case namespace == "":
+		return "", "", "", fmt.Errorf("invalid Secret URI: namespace cannot be empty")
+	case name == "":
+		return "", "", "", fmt.Errorf("invalid Secret URI: name cannot be empty")
+	case hasKey && !secretKeyPattern.MatchString(key):
+		return "", "", "", fmt.Errorf("invalid Secret URI: key %q must consist of alphanumerics, '-', '_' or '.'", key)
+	}
+
+	return namespace, name, key, nil
+}
+
+// fromSecretWithKubeconfig reads and deserializes data from a Kubernetes Secret with custom kubeconfig.
+func fromSecretWithKubeconfig[T any](namespace, name, key, kubeconfig string) (*T, error) {
+	var k8sClient client.Interface
+	var err error
+
+	if kubeconfig != "" {
+		k8sClient, _, err = client.GetKubeClientWithConfig(kubeconfig)
+	} else {
+		k8sClient, _, err = client.GetKubeClient()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes client: %w", err)
+	}
+
+	secret, err := k8sClient.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Secret %s/%s: %w", namespace, name, err)
+	}
+
+	content, format, err := secretContent(secret, key)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Debug("reading from Secret",
+		"namespace", namespace,
+		"name", name,
+		"format", format,
+		"size", len(content))
+
+	reader, err := NewReader(format, bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reader for Secret data: %w", err)
+	}
+
+	var result T
+	if err := reader.Deserialize(&result); err != nil {
+		return nil, fmt.Errorf("failed to deserialize Secret data: %w", err)
+	}
+
+	return &result, nil
+}
+
+// secretContent returns the document stored in secret under key, or under
+// snapshot.{json|yaml|txt} when key is empty, with its format. The format
+// comes from the key extension, then the format data key, then YAML.
+func secretContent(secret *corev1.Secret, key string) ([]byte, Format, error) {
+	format := FormatYAML // default
+	if formatStr, ok := secret.Data["format"]; ok {
+		format = Format(formatStr)
+	}
+
+	var content []byte
+	if key != "" {
+		data, ok := secret.Data[key]
+		if !ok {
+			return nil, "", fmt.Errorf("Secret %s/%s has no key %q", secret.Namespace, secret.Name, key)
+		}
+		content = data
+		switch strings.ToLower(filepath.Ext(key)) {
+		case ".json":
+			format = FormatJSON
+		case ".yaml", ".yml":
+			format = FormatYAML
+		}
+	} else if data, ok := secret.Data[fmt.Sprintf("snapshot.%s", format)]; ok {
+		content = data
+	} else {
+		// Fall back to trying all known extensions
+		for _, ext := range []string{"yaml", "json", "txt"} {
+			if data, ok := secret.Data[fmt.Sprintf("snapshot.%s", ext)]; ok {
+				content = data
+				format = Format(ext)
+				break
+			}
+		}
+		if content == nil {
+			return nil, "", fmt.Errorf("Secret %s/%s has no snapshot data", secret.Namespace, secret.Name)
+		}
+	}
+
+	return decodeBase64Value(content), format, nil
+}
+
+// decodeBase64Value returns the decoded value when data is itself base64,
+// as happens when an already encoded document is put in stringData. JSON and
+// YAML documents always hold characters outside the base64 alphabet, so they
+// are returned unchanged.
+func decodeBase64Value(data []byte) []byte {
+	trimmed := bytes.Join(bytes.Fields(data), nil)
+	if len(trimmed) == 0 || len(trimmed)%4 != 0 {
+		return data
+	}
+	decoded, err := base64.StdEncoding.DecodeString(string(trimmed))
+	if err != nil {
+		return data
+	}
+	return decoded
+}
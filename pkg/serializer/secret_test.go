@@ -0,0 +1,254 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serializer
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseSecretURI(t *testing.T) {
+	tests := []struct {
+		name          string
+		uri           string
+		wantNamespace string
+		wantName      string
+		wantKey       string
+		wantErr       bool
+	}{
+		{
+			name:          "valid URI",
+			uri:           "secret://gpu-operator/eidos-snapshot",
+			wantNamespace: "gpu-operator",
+			wantName:      "eidos-snapshot",
+		},
+		{
+			name:          "valid URI with key",
+			uri:           "secret://gpu-operator/eidos-recipe#recipe.yaml",
+			wantNamespace: "gpu-operator",
+			wantName:      "eidos-recipe",
+			wantKey:       "recipe.yaml",
+		},
+		{
+			name:    "wrong scheme",
+			uri:     "cm://gpu-operator/eidos-snapshot",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			uri:     "secret://gpu-operator",
+			wantErr: true,
+		},
+		{
+			name:    "empty namespace",
+			uri:     "secret:///eidos-snapshot",
+			wantErr: true,
+		},
+		{
+			name:    "empty key",
+			uri:     "secret://gpu-operator/eidos-snapshot#",
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			uri:     "secret://gpu-operator/eidos-snapshot#a/b",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace, name, key, err := parseSecretURI(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSecretURI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if namespace != tt.wantNamespace || name != tt.wantName || key != tt.wantKey {
+				t.Errorf("parseSecretURI() = %q, %q, %q, want %q, %q, %q",
+					namespace, name, key, tt.wantNamespace, tt.wantName, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestIsKubernetesURI(t *testing.T) {
+	tests := map[string]bool{
+		"cm://ns/name":         true,
+		"secret://ns/name":     true,
+		"secret://ns/name#key": true,
+		"/tmp/snapshot.yaml":   false,
+		"https://example.com":  false,
+	}
+	for uri, want := range tests {
+		if got := IsKubernetesURI(uri); got != want {
+			t.Errorf("IsKubernetesURI(%q) = %v, want %v", uri, got, want)
+		}
+	}
+}
+
+func TestNewFileWriterOrStdout_SecretURI(t *testing.T) {
+	writer, err := NewFileWriterOrStdout(FormatYAML, "secret://gpu-operator/eidos-recipe#recipe.yaml")
+	if err != nil {
+		t.Fatalf("NewFileWriterOrStdout() error = %v", err)
+	}
+	sw, ok := writer.(*SecretWriter)
+	if !ok {
+		t.Fatalf("NewFileWriterOrStdout() = %T, want *SecretWriter", writer)
+	}
+	if sw.namespace != "gpu-operator" || sw.name != "eidos-recipe" || sw.key != "recipe.yaml" || sw.format != FormatYAML {
+		t.Errorf("SecretWriter = %+v", sw)
+	}
+
+	if _, err := NewFileWriterOrStdout(FormatJSON, "secret://namespace"); err == nil ||
+		!strings.Contains(err.Error(), "invalid Secret URI") {
+		t.Errorf("NewFileWriterOrStdout() error = %v, want invalid Secret URI", err)
+	}
+}
+
+func TestSecretWriter_ApplyConfiguration(t *testing.T) {
+	data := map[string]string{"hello": "world"}
+
+	t.Run("default key", func(t *testing.T) {
+		secret, err := NewSecretWriter("ns", "snap", "", FormatJSON).applyConfiguration(data)
+		if err != nil {
+			t.Fatalf("applyConfiguration() error = %v", err)
+		}
+		if *secret.Type != corev1.SecretTypeOpaque {
+			t.Errorf("type = %s, want %s", *secret.Type, corev1.SecretTypeOpaque)
+		}
+		if !strings.Contains(string(secret.Data["snapshot.json"]), `"hello": "world"`) {
+			t.Errorf("snapshot.json = %q", secret.Data["snapshot.json"])
+		}
+		if string(secret.Data["format"]) != "json" {
+			t.Errorf("format = %q, want json", secret.Data["format"])
+		}
+	})
+
+	t.Run("custom key", func(t *testing.T) {
+		secret, err := NewSecretWriter("ns", "snap", "recipe.yaml", FormatYAML).applyConfiguration(data)
+		if err != nil {
+			t.Fatalf("applyConfiguration() error = %v", err)
+		}
+		if string(secret.Data["recipe.yaml"]) != "hello: world\n" {
+			t.Errorf("recipe.yaml = %q", secret.Data["recipe.yaml"])
+		}
+		if _, ok := secret.Data["snapshot.yaml"]; ok {
+			t.Error("custom key should replace snapshot.yaml")
+		}
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		large := map[string]string{"data": strings.Repeat("x", MaxObjectDataSize)}
+		_, err := NewSecretWriter("ns", "snap", "", FormatJSON).applyConfiguration(large)
+		if err == nil || !strings.Contains(err.Error(), "Secret limit") {
+			t.Errorf("applyConfiguration() error = %v, want size limit error", err)
+		}
+	})
+}
+
+func TestSecretContent(t *testing.T) {
+	const doc = "hello: world\n"
+	secret := func(data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "snap"},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name       string
+		secret     *corev1.Secret
+		key        string
+		wantFormat Format
+		wantErr    bool
+	}{
+		{
+			name:       "format key",
+			secret:     secret(map[string]string{"snapshot.json": `{"hello": "world"}`, "format": "json"}),
+			wantFormat: FormatJSON,
+		},
+		{
+			name:       "fallback key",
+			secret:     secret(map[string]string{"snapshot.yaml": doc}),
+			wantFormat: FormatYAML,
+		},
+		{
+			name:       "custom key with extension",
+			secret:     secret(map[string]string{"recipe.json": `{"hello": "world"}`, "format": "yaml"}),
+			key:        "recipe.json",
+			wantFormat: FormatJSON,
+		},
+		{
+			name:       "custom key without extension",
+			secret:     secret(map[string]string{"recipe": doc}),
+			key:        "recipe",
+			wantFormat: FormatYAML,
+		},
+		{
+			name:       "base64 encoded value",
+			secret:     secret(map[string]string{"recipe.yaml": base64.StdEncoding.EncodeToString([]byte(doc))}),
+			key:        "recipe.yaml",
+			wantFormat: FormatYAML,
+		},
+		{
+			name:    "missing key",
+			secret:  secret(map[string]string{"snapshot.yaml": doc}),
+			key:     "recipe.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "no snapshot data",
+			secret:  secret(map[string]string{"other": doc}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, format, err := secretContent(tt.secret, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("secretContent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if format != tt.wantFormat {
+				t.Errorf("format = %s, want %s", format, tt.wantFormat)
+			}
+
+			var got map[string]string
+			reader, err := NewReader(format, strings.NewReader(string(content)))
+			if err != nil {
+				t.Fatalf("NewReader() error = %v", err)
+			}
+			if err := reader.Deserialize(&got); err != nil {
+				t.Fatalf("Deserialize() error = %v", err)
+			}
+			if got["hello"] != "world" {
+				t.Errorf("content = %q", content)
+			}
+		})
+	}
+}
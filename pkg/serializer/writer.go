@@ -27,6 +27,8 @@ import (
 	"text/tabwriter"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/objectstore"
 )
 
 // Format represents the output format type
@@ -39,13 +41,18 @@ const (
 	FormatYAML Format = "yaml"
 	// FormatTable outputs data in table format
 	FormatTable Format = "table"
+	// FormatPrometheus outputs snapshot measurements as Prometheus
+	// textfile-collector metrics
+	FormatPrometheus Format = "prometheus"
+	// FormatOpenMetrics outputs snapshot measurements as OpenMetrics
+	FormatOpenMetrics Format = "openmetrics"
 )
 
 const defaultValueKey = "value"
 
 func (f Format) IsUnknown() bool {
 	switch f {
-	case FormatJSON, FormatYAML, FormatTable:
+	case FormatJSON, FormatYAML, FormatTable, FormatPrometheus, FormatOpenMetrics:
 		return false
 	default:
 		return true
@@ -59,6 +66,18 @@ func SupportedFormats() []string {
 		string(FormatJSON),
 		string(FormatYAML),
 		string(FormatTable),
+		string(FormatPrometheus),
+		string(FormatOpenMetrics),
+	}
+}
+
+// IsWriteOnly reports whether data written in f cannot be read back.
+func (f Format) IsWriteOnly() bool {
+	switch f {
+	case FormatTable, FormatPrometheus, FormatOpenMetrics:
+		return true
+	default:
+		return false
 	}
 }
 
@@ -92,7 +111,9 @@ func NewWriter(format Format, output io.Writer) *Writer {
 // Returns an error if the path is invalid or the file cannot be created.
 // Remember to call Close() on the returned Writer to ensure the file is properly closed.
 //
-// Supports ConfigMap URIs in the format cm://namespace/name for Kubernetes ConfigMap output.
+// Supports ConfigMap URIs in the format cm://namespace/name for Kubernetes ConfigMap output,
+// Secret URIs in the format secret://namespace/name[#key] for Kubernetes Secret output,
+// and s3://, gs:// and az:// URIs for object storage output.
 func NewFileWriterOrStdout(format Format, path string) (Serializer, error) {
 	trimmed := strings.TrimSpace(path)
 	if trimmed == "" || trimmed == "-" || trimmed == StdoutURI {
@@ -101,13 +122,30 @@ func NewFileWriterOrStdout(format Format, path string) (Serializer, error) {
 
 	// Check for ConfigMap URI (cm://namespace/name)
 	if strings.HasPrefix(trimmed, ConfigMapURIScheme) {
-		namespace, name, err := parseConfigMapURI(trimmed)
+		namespace, name, err := ParseConfigMapURI(trimmed)
 		if err != nil {
 			return nil, fmt.Errorf("invalid ConfigMap URI %q: %w", trimmed, err)
 		}
 		return NewConfigMapWriter(namespace, name, format), nil
 	}
 
+	// Check for Secret URI (secret://namespace/name[#key])
+	if strings.HasPrefix(trimmed, SecretURIScheme) {
+		namespace, name, key, err := parseSecretURI(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Secret URI %q: %w", trimmed, err)
+		}
+		return NewSecretWriter(namespace, name, key, format), nil
+	}
+
+	// Check for object storage URI (s3://, gs://, az://)
+	if objectstore.IsURI(trimmed) {
+		if _, err := objectstore.ParseURI(trimmed); err != nil {
+			return nil, err
+		}
+		return NewObjectWriter(trimmed, format), nil
+	}
+
 	file, err := os.Create(trimmed)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create output file %q: %w", trimmed, err)
@@ -159,6 +197,13 @@ func (w *Writer) Serialize(ctx context.Context, config any) error {
 		return w.serializeYAML(config)
 	case FormatTable:
 		return w.serializeTable(config)
+	case FormatPrometheus, FormatOpenMetrics:
+		content, err := serializeMetrics(config, w.format == FormatOpenMetrics)
+		if err != nil {
+			return err
+		}
+		_, err = w.output.Write(content)
+		return err
 	default:
 		return fmt.Errorf("unsupported format: %s", w.format)
 	}
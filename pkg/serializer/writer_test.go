@@ -448,7 +448,7 @@ func TestSupportedFormats(t *testing.T) {
 	formats := SupportedFormats()
 
 	// Verify we have expected formats
-	expected := []string{string(FormatJSON), string(FormatYAML), string(FormatTable)}
+	expected := []string{string(FormatJSON), string(FormatYAML), string(FormatTable), string(FormatPrometheus), string(FormatOpenMetrics)}
 	if len(formats) != len(expected) {
 		t.Errorf("SupportedFormats() len = %d, want %d", len(formats), len(expected))
 	}
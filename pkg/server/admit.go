@@ -0,0 +1,94 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// Admit applies the rate limits and authentication of application routes to
+// a call arriving over another transport, such as the gRPC API. The call is
+// described by r: its RemoteAddr identifies the client, and its Authorization
+// header and TLS state carry the credentials. It returns ctx with the
+// authenticated subject, or an error coded ErrCodeRateLimitExceeded or
+// ErrCodeUnauthorized; the latter wraps ErrUnauthenticated when r has no
+// valid credentials.
+func (s *Server) Admit(ctx context.Context, r *http.Request) (context.Context, error) {
+	if s.setupErr != nil {
+		return ctx, eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "invalid server configuration", s.setupErr)
+	}
+
+	if !s.rateLimiter.Allow() {
+		rateLimitRejects.Inc()
+		return ctx, eidoserrors.NewWithContext(eidoserrors.ErrCodeRateLimitExceeded,
+			"Rate limit exceeded", map[string]any{
+				"limit": s.config.RateLimit,
+				"burst": s.config.RateLimitBurst,
+			})
+	}
+	if s.clientLimiters != nil && !s.clientLimiters.allow(clientKey(r)) {
+		clientRateLimitRejects.Inc()
+		return ctx, eidoserrors.NewWithContext(eidoserrors.ErrCodeRateLimitExceeded,
+			"Client rate limit exceeded", map[string]any{
+				"limit": s.config.ClientRateLimit,
+				"burst": s.config.ClientRateLimitBurst,
+			})
+	}
+
+	if s.authenticator == nil {
+		return ctx, nil
+	}
+	subject, err := s.authenticate(r)
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, contextKeySubject, subject), nil
+}
+
+// MaxRequestBodyBytes returns the size limit of request bodies, or 0 when
+// it is disabled.
+func (s *Server) MaxRequestBodyBytes() int64 {
+	return s.config.MaxRequestBodyBytes
+}
+
+// TLSConfig returns the TLS configuration the server serves HTTPS with,
+// including client certificate verification for mTLS authentication, so
+// other listeners can serve with the same certificate. It returns nil when
+// TLS is not configured, and an error when the server configuration is
+// invalid.
+func (s *Server) TLSConfig() (*tls.Config, error) {
+	if s.setupErr != nil {
+		return nil, fmt.Errorf("invalid server configuration: %w", s.setupErr)
+	}
+	if s.config.TLSCertFile == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.config.TLSCertFile, s.config.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.httpServer.TLSConfig != nil {
+		cfg = s.httpServer.TLSConfig.Clone()
+	}
+	cfg.Certificates = []tls.Certificate{cert}
+	return cfg, nil
+}
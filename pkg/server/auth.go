@@ -0,0 +1,285 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+// AuthMode selects how requests to application routes are authenticated.
+type AuthMode string
+
+// Supported authentication modes.
+const (
+	// AuthModeNone accepts all requests.
+	AuthModeNone AuthMode = "none"
+	// AuthModeToken requires one of a set of static bearer tokens.
+	AuthModeToken AuthMode = "token"
+	// AuthModeOIDC requires a bearer JWT signed by an OIDC issuer.
+	AuthModeOIDC AuthMode = "oidc"
+	// AuthModeMTLS requires a TLS client certificate signed by a trusted CA.
+	AuthModeMTLS AuthMode = "mtls"
+)
+
+// ParseAuthMode parses s into an AuthMode; an empty string selects AuthModeNone.
+func ParseAuthMode(s string) (AuthMode, error) {
+	switch m := AuthMode(strings.ToLower(strings.TrimSpace(s))); m {
+	case "":
+		return AuthModeNone, nil
+	case AuthModeNone, AuthModeToken, AuthModeOIDC, AuthModeMTLS:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid auth mode %q: must be one of none, token, oidc, mtls", s)
+	}
+}
+
+// AuthConfig configures authentication of application routes. Health,
+// readiness and metrics endpoints are never authenticated, so probes and
+// scrapers keep working.
+type AuthConfig struct {
+	Mode AuthMode
+
+	// Tokens are the bearer tokens accepted in AuthModeToken.
+	Tokens []string
+	// TokenFile holds additional accepted bearer tokens, one per line.
+	TokenFile string
+
+	// OIDCIssuer is the issuer URL whose discovery document lists the keys
+	// that sign tokens in AuthModeOIDC.
+	OIDCIssuer string
+	// OIDCAudience is the audience tokens must be issued for.
+	OIDCAudience string
+
+	// ClientCAFile holds the PEM CA certificates that sign client
+	// certificates in AuthModeMTLS. It requires TLS to be configured.
+	ClientCAFile string
+
+	// AllowedSubjects authorizes only these subjects (the OIDC "sub" claim,
+	// the client certificate common name, or "token" for static tokens);
+	// empty allows all authenticated requests.
+	AllowedSubjects []string
+}
+
+// Authenticator verifies the credentials of a request and returns the
+// authenticated subject.
+type Authenticator interface {
+	Authenticate(r *http.Request) (string, error)
+}
+
+// AuthenticatorFunc adapts a function to an Authenticator.
+type AuthenticatorFunc func(r *http.Request) (string, error)
+
+// Authenticate calls f(r).
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (string, error) {
+	return f(r)
+}
+
+// ErrMissingCredentials is returned by authenticators when a request carries
+// no credentials at all.
+var ErrMissingCredentials = errors.New("missing credentials")
+
+// ErrUnauthenticated is wrapped by the errors of requests without valid
+// credentials, as opposed to authenticated subjects that are not allowed.
+var ErrUnauthenticated = errors.New("authentication required")
+
+// newAuthenticator returns the authenticator for cfg, or nil when
+// authentication is disabled.
+func newAuthenticator(cfg AuthConfig) (Authenticator, error) {
+	mode, err := ParseAuthMode(string(cfg.Mode))
+	if err != nil {
+		return nil, err
+	}
+
+	switch mode {
+	case AuthModeNone:
+		return nil, nil
+	case AuthModeToken:
+		tokens := slices.Clone(cfg.Tokens)
+		if cfg.TokenFile != "" {
+			data, err := os.ReadFile(cfg.TokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read auth token file: %w", err)
+			}
+			tokens = append(tokens, strings.Split(string(data), "\n")...)
+		}
+		return newTokenAuthenticator(tokens)
+	case AuthModeOIDC:
+		return newOIDCAuthenticator(cfg.OIDCIssuer, cfg.OIDCAudience)
+	case AuthModeMTLS:
+		if cfg.ClientCAFile == "" {
+			return nil, errors.New("mtls auth requires a client CA file")
+		}
+		return AuthenticatorFunc(authenticateClientCert), nil
+	default:
+		return nil, fmt.Errorf("invalid auth mode %q", mode)
+	}
+}
+
+// tokenAuthenticator accepts a fixed set of bearer tokens. It keeps their
+// digests so comparisons take the same time whatever the token length.
+type tokenAuthenticator struct {
+	digests [][sha256.Size]byte
+}
+
+func newTokenAuthenticator(tokens []string) (*tokenAuthenticator, error) {
+	a := &tokenAuthenticator{}
+	for _, t := range tokens {
+		if t = strings.TrimSpace(t); t != "" {
+			a.digests = append(a.digests, sha256.Sum256([]byte(t)))
+		}
+	}
+	if len(a.digests) == 0 {
+		return nil, errors.New("token auth requires at least one token")
+	}
+	return a, nil
+}
+
+func (a *tokenAuthenticator) Authenticate(r *http.Request) (string, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256([]byte(token))
+	match := 0
+	for _, d := range a.digests {
+		match |= subtle.ConstantTimeCompare(digest[:], d[:])
+	}
+	if match != 1 {
+		return "", errors.New("invalid token")
+	}
+	return "token", nil
+}
+
+// bearerToken returns the bearer token of the Authorization header of r.
+func bearerToken(r *http.Request) (string, error) {
+	h := r.Header.Get("Authorization")
+	if h == "" {
+		return "", ErrMissingCredentials
+	}
+	scheme, token, ok := strings.Cut(h, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", errors.New("authorization header is not a bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// authenticateClientCert accepts requests with a client certificate the TLS
+// handshake verified against the client CAs.
+func authenticateClientCert(r *http.Request) (string, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return "", ErrMissingCredentials
+	}
+	return r.TLS.VerifiedChains[0][0].Subject.CommonName, nil
+}
+
+// clientCATLSConfig returns a TLS configuration that verifies client
+// certificates against the CAs in caFile. Certificates are optional in the
+// handshake so unauthenticated endpoints stay reachable; authMiddleware
+// rejects application requests without one.
+func clientCATLSConfig(caFile string) (*tls.Config, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in client CA file %s", caFile)
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientCAs:  pool,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}, nil
+}
+
+// SubjectFromContext returns the subject authenticated for the request, if
+// authentication is enabled.
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(contextKeySubject).(string)
+	return subject, ok
+}
+
+// authMiddleware rejects requests the authenticator does not accept with 401,
+// and authenticated subjects that are not allowed with 403.
+func (s *Server) authMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	if s.authenticator == nil {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		subject, err := s.authenticate(r)
+		if errors.Is(err, ErrUnauthenticated) {
+			if s.config.Auth.Mode != AuthModeMTLS {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+s.config.Name+`"`)
+			}
+			WriteError(w, r, http.StatusUnauthorized, eidoserrors.ErrCodeUnauthorized,
+				"Authentication required", false, nil)
+			return
+		}
+		if err != nil {
+			WriteError(w, r, http.StatusForbidden, eidoserrors.ErrCodeUnauthorized,
+				"Subject is not allowed", false, map[string]any{
+					"subject": subject,
+				})
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), contextKeySubject, subject)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
+
+// authenticate returns the subject authenticated for r. Its error wraps
+// ErrUnauthenticated when r has no valid credentials; when the subject is not
+// allowed, the subject is returned with the error.
+func (s *Server) authenticate(r *http.Request) (string, error) {
+	subject, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		reason := "invalid"
+		if errors.Is(err, ErrMissingCredentials) {
+			reason = "missing"
+		}
+		authFailures.WithLabelValues(reason).Inc()
+		slog.Debug("authentication failed",
+			"requestID", r.Context().Value(contextKeyRequestID),
+			"path", r.URL.Path,
+			"error", err,
+		)
+		return "", eidoserrors.Wrap(eidoserrors.ErrCodeUnauthorized,
+			"Authentication required", errors.Join(ErrUnauthenticated, err))
+	}
+
+	if allowed := s.config.Auth.AllowedSubjects; len(allowed) > 0 && !slices.Contains(allowed, subject) {
+		authFailures.WithLabelValues("forbidden").Inc()
+		return subject, eidoserrors.NewWithContext(eidoserrors.ErrCodeUnauthorized,
+			"Subject is not allowed", map[string]any{
+				"subject": subject,
+			})
+	}
+	return subject, nil
+}
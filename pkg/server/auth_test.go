@@ -0,0 +1,319 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+)
+
+func TestParseAuthMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    AuthMode
+		wantErr bool
+	}{
+		{"", AuthModeNone, false},
+		{"none", AuthModeNone, false},
+		{"Token", AuthModeToken, false},
+		{" oidc ", AuthModeOIDC, false},
+		{"mtls", AuthModeMTLS, false},
+		{"basic", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAuthMode(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseAuthMode(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseAuthMode(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "tokens")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     AuthConfig
+		wantNil bool
+		wantErr bool
+	}{
+		{"disabled", AuthConfig{}, true, false},
+		{"token", AuthConfig{Mode: AuthModeToken, Tokens: []string{"secret"}}, false, false},
+		{"token file", AuthConfig{Mode: AuthModeToken, TokenFile: tokenFile}, false, false},
+		{"token without tokens", AuthConfig{Mode: AuthModeToken, Tokens: []string{" "}}, false, true},
+		{"missing token file", AuthConfig{Mode: AuthModeToken, TokenFile: tokenFile + ".missing"}, false, true},
+		{"oidc", AuthConfig{Mode: AuthModeOIDC, OIDCIssuer: "https://issuer.example.com", OIDCAudience: "eidos"}, false, false},
+		{"oidc without audience", AuthConfig{Mode: AuthModeOIDC, OIDCIssuer: "https://issuer.example.com"}, false, true},
+		{"mtls without CA", AuthConfig{Mode: AuthModeMTLS}, false, true},
+		{"invalid mode", AuthConfig{Mode: "basic"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := newAuthenticator(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newAuthenticator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (a == nil) != tt.wantNil {
+				t.Errorf("newAuthenticator() = %v, wantNil %v", a, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestTokenAuthenticator(t *testing.T) {
+	a, err := newTokenAuthenticator([]string{"first", "second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantErr       bool
+	}{
+		{"first token", "Bearer first", false},
+		{"second token", "bearer second", false},
+		{"wrong token", "Bearer third", true},
+		{"token prefix", "Bearer firs", true},
+		{"basic scheme", "Basic first", true},
+		{"missing", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/recipe", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			subject, err := a.Authenticate(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && subject != "token" {
+				t.Errorf("Authenticate() subject = %q, want token", subject)
+			}
+		})
+	}
+}
+
+func TestAuthenticateClientCert(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/recipe", nil)
+	if _, err := authenticateClientCert(req); err == nil {
+		t.Error("expected error without TLS")
+	}
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ci-pipeline"}}
+	req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	subject, err := authenticateClientCert(req)
+	if err != nil {
+		t.Fatalf("authenticateClientCert() error = %v", err)
+	}
+	if subject != "ci-pipeline" {
+		t.Errorf("subject = %q, want ci-pipeline", subject)
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Auth = AuthConfig{Mode: AuthModeToken, AllowedSubjects: []string{"alice"}}
+	s := &Server{
+		config: cfg,
+		authenticator: AuthenticatorFunc(func(r *http.Request) (string, error) {
+			return bearerToken(r)
+		}),
+	}
+
+	var gotSubject string
+	handler := s.authMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		gotSubject, _ = SubjectFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{"allowed", "Bearer alice", http.StatusOK},
+		{"not allowed", "Bearer bob", http.StatusForbidden},
+		{"missing", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/recipe", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected WWW-Authenticate header")
+			}
+		})
+	}
+	if gotSubject != "alice" {
+		t.Errorf("subject in context = %q, want alice", gotSubject)
+	}
+}
+
+func TestAdmit(t *testing.T) {
+	t.Setenv("AUTH_MODE", "token")
+	t.Setenv("AUTH_TOKENS", "secret")
+	t.Setenv("CLIENT_RATE_LIMIT", "1")
+	t.Setenv("CLIENT_RATE_LIMIT_BURST", "2")
+	s := New()
+
+	call := func(remote, authorization string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/eidos.v1.Eidos/Recipe", nil)
+		req.RemoteAddr = remote
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return req
+	}
+
+	ctx, err := s.Admit(context.Background(), call("10.0.0.1:1234", "Bearer secret"))
+	if err != nil {
+		t.Fatalf("Admit() error = %v", err)
+	}
+	if subject, _ := SubjectFromContext(ctx); subject != "token" {
+		t.Errorf("subject = %q, want token", subject)
+	}
+
+	if _, err := s.Admit(context.Background(), call("10.0.0.2:1234", "")); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("Admit() without credentials error = %v, want ErrUnauthenticated", err)
+	}
+
+	// The first client has used up its burst
+	if _, err := s.Admit(context.Background(), call("10.0.0.1:1234", "Bearer secret")); err != nil {
+		t.Fatalf("Admit() error = %v", err)
+	}
+	_, err = s.Admit(context.Background(), call("10.0.0.1:1234", "Bearer secret"))
+	var se *eidoserrors.StructuredError
+	if !errors.As(err, &se) || se.Code != eidoserrors.ErrCodeRateLimitExceeded {
+		t.Errorf("Admit() over the client limit error = %v, want rate limited", err)
+	}
+}
+
+func TestAuthMiddleware_Disabled(t *testing.T) {
+	s := &Server{config: NewConfig()}
+	handler := s.authMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/v1/recipe", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestNew_AuthSkipsSystemEndpoints(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Auth = AuthConfig{Mode: AuthModeToken, Tokens: []string{"secret"}}
+	s := New(WithConfig(cfg), WithHandler(map[string]http.HandlerFunc{
+		"/v1/test": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	}))
+	if s.setupErr != nil {
+		t.Fatalf("setupErr = %v", s.setupErr)
+	}
+
+	tests := []struct {
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{"/health", "", http.StatusOK},
+		{"/v1/test", "", http.StatusUnauthorized},
+		{"/v1/test", "secret", http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+		rec := httptest.NewRecorder()
+		s.httpServer.Handler.ServeHTTP(rec, req)
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s with token %q status = %d, want %d", tt.path, tt.token, rec.Code, tt.wantStatus)
+		}
+	}
+}
+
+func TestNew_InvalidAuthFailsStart(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  func(*Config)
+	}{
+		{"token without tokens", func(c *Config) { c.Auth = AuthConfig{Mode: AuthModeToken} }},
+		{"mtls without TLS", func(c *Config) {
+			c.Auth = AuthConfig{Mode: AuthModeMTLS, ClientCAFile: "ca.pem"}
+		}},
+		{"cert without key", func(c *Config) { c.TLSCertFile = "tls.crt" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig()
+			tt.cfg(cfg)
+			s := New(WithConfig(cfg))
+			if err := s.Start(t.Context()); err == nil {
+				t.Error("Start() expected error")
+			}
+		})
+	}
+}
+
+func TestParseConfig_Auth(t *testing.T) {
+	t.Setenv("AUTH_MODE", "OIDC")
+	t.Setenv("OIDC_ISSUER", "https://issuer.example.com")
+	t.Setenv("OIDC_AUDIENCE", "eidos")
+	t.Setenv("AUTH_ALLOWED_SUBJECTS", "alice, bob,")
+	t.Setenv("TLS_CERT_FILE", "/tls/tls.crt")
+	t.Setenv("TLS_KEY_FILE", "/tls/tls.key")
+
+	cfg := parseConfig()
+	if cfg.Auth.Mode != AuthModeOIDC {
+		t.Errorf("Auth.Mode = %q, want %q", cfg.Auth.Mode, AuthModeOIDC)
+	}
+	if cfg.Auth.OIDCIssuer != "https://issuer.example.com" || cfg.Auth.OIDCAudience != "eidos" {
+		t.Errorf("OIDC = %q/%q", cfg.Auth.OIDCIssuer, cfg.Auth.OIDCAudience)
+	}
+	if len(cfg.Auth.AllowedSubjects) != 2 || cfg.Auth.AllowedSubjects[1] != "bob" {
+		t.Errorf("AllowedSubjects = %v, want [alice bob]", cfg.Auth.AllowedSubjects)
+	}
+	if cfg.TLSCertFile != "/tls/tls.crt" || cfg.TLSKeyFile != "/tls/tls.key" {
+		t.Errorf("TLS files = %q/%q", cfg.TLSCertFile, cfg.TLSKeyFile)
+	}
+}
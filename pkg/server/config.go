@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/eidos/pkg/defaults"
@@ -41,9 +42,28 @@ type Config struct {
 	RateLimit      rate.Limit // requests per second
 	RateLimitBurst int        // burst size
 
+	// Per-client rate limiting, keyed by client IP (0 disables it)
+	ClientRateLimit      rate.Limit // requests per second per client
+	ClientRateLimitBurst int        // burst size per client
+
 	// Request limits
 	MaxBulkRequests int
 
+	// MaxRequestBodyBytes bounds request bodies; larger requests are
+	// rejected with 413 (0 disables the limit).
+	MaxRequestBodyBytes int64
+
+	// MaxConcurrent bounds the in-flight requests of a route, keyed by the
+	// route path; requests beyond it are rejected with 429.
+	MaxConcurrent map[string]int
+
+	// Auth configures authentication of application routes
+	Auth AuthConfig
+
+	// TLS certificate and key; the server serves HTTPS when both are set
+	TLSCertFile string
+	TLSKeyFile  string
+
 	// Timeouts
 	ReadTimeout     time.Duration
 	WriteTimeout    time.Duration
@@ -60,17 +80,20 @@ func NewConfig() *Config {
 // parseConfig returns sensible defaults
 func parseConfig() *Config {
 	cfg := &Config{
-		Name:            "server",
-		Version:         "undefined",
-		Address:         "",
-		Port:            8080,
-		RateLimit:       100, // 100 req/s
-		RateLimitBurst:  200, // burst of 200
-		MaxBulkRequests: 100,
-		ReadTimeout:     defaults.ServerReadTimeout,
-		WriteTimeout:    defaults.ServerWriteTimeout,
-		IdleTimeout:     defaults.ServerIdleTimeout,
-		ShutdownTimeout: defaults.ServerShutdownTimeout,
+		Name:                 "server",
+		Version:              "undefined",
+		Address:              "",
+		Port:                 8080,
+		RateLimit:            100, // 100 req/s
+		RateLimitBurst:       200, // burst of 200
+		MaxBulkRequests:      100,
+		ClientRateLimit:      20,       // 20 req/s per client
+		ClientRateLimitBurst: 40,       // burst of 40 per client
+		MaxRequestBodyBytes:  32 << 20, // 32 MiB, enough for snapshots of large nodes
+		ReadTimeout:          defaults.ServerReadTimeout,
+		WriteTimeout:         defaults.ServerWriteTimeout,
+		IdleTimeout:          defaults.ServerIdleTimeout,
+		ShutdownTimeout:      defaults.ServerShutdownTimeout,
 	}
 
 	// Override with environment variables if set
@@ -81,6 +104,43 @@ func parseConfig() *Config {
 		}
 	}
 
+	if limitStr := os.Getenv("CLIENT_RATE_LIMIT"); limitStr != "" {
+		var limit float64
+		if _, err := fmt.Sscanf(limitStr, "%g", &limit); err == nil && limit >= 0 {
+			cfg.ClientRateLimit = rate.Limit(limit)
+		}
+	}
+	if burstStr := os.Getenv("CLIENT_RATE_LIMIT_BURST"); burstStr != "" {
+		var burst int
+		if _, err := fmt.Sscanf(burstStr, "%d", &burst); err == nil && burst > 0 {
+			cfg.ClientRateLimitBurst = burst
+		}
+	}
+
+	if sizeStr := os.Getenv("MAX_REQUEST_BODY_BYTES"); sizeStr != "" {
+		var size int64
+		if _, err := fmt.Sscanf(sizeStr, "%d", &size); err == nil && size >= 0 {
+			cfg.MaxRequestBodyBytes = size
+		}
+	}
+
+	// An invalid mode is kept as is and reported when the server starts
+	authMode := AuthMode(os.Getenv("AUTH_MODE"))
+	if mode, err := ParseAuthMode(string(authMode)); err == nil {
+		authMode = mode
+	}
+	cfg.Auth = AuthConfig{
+		Mode:            authMode,
+		Tokens:          splitList(os.Getenv("AUTH_TOKENS")),
+		TokenFile:       os.Getenv("AUTH_TOKEN_FILE"),
+		OIDCIssuer:      os.Getenv("OIDC_ISSUER"),
+		OIDCAudience:    os.Getenv("OIDC_AUDIENCE"),
+		ClientCAFile:    os.Getenv("TLS_CLIENT_CA_FILE"),
+		AllowedSubjects: splitList(os.Getenv("AUTH_ALLOWED_SUBJECTS")),
+	}
+	cfg.TLSCertFile = os.Getenv("TLS_CERT_FILE")
+	cfg.TLSKeyFile = os.Getenv("TLS_KEY_FILE")
+
 	// Allow customization of shutdown timeout to match K8s eviction grace period
 	if shutdownStr := os.Getenv("SHUTDOWN_TIMEOUT_SECONDS"); shutdownStr != "" {
 		var seconds int
@@ -91,3 +151,14 @@ func parseConfig() *Config {
 
 	return cfg
 }
+
+// splitList splits a comma-separated list, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for item := range strings.SplitSeq(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -22,4 +22,6 @@ const (
 	contextKeyRequestID contextKey = "requestID"
 	// contextKeyAPIVersion is the context key for API version
 	contextKeyAPIVersion contextKey = "apiVersion"
+	// contextKeySubject is the context key for the authenticated subject
+	contextKeySubject contextKey = "subject"
 )
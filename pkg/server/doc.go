@@ -99,6 +99,14 @@
 //
 //	When rate limited, returns 429 with Retry-After header.
 //
+// Authentication:
+//
+//	AUTH_MODE selects how application routes authenticate requests: none
+//	(default), token (static bearer tokens), oidc (bearer JWTs verified with
+//	the issuer's published keys) or mtls (verified TLS client certificates).
+//	Health, readiness and metrics endpoints are never authenticated.
+//	WithAuthenticator plugs in a custom Authenticator.
+//
 // Cache Headers:
 //
 //	Recommendation responses include Cache-Control headers for CDN/client caching:
@@ -120,6 +128,7 @@
 // Error codes:
 //   - INVALID_PARAMETER: Invalid request parameter (400)
 //   - INVALID_JSON: Malformed JSON payload (400)
+//   - UNAUTHORIZED: Missing or invalid credentials (401), or subject not allowed (403)
 //   - NO_MATCHING_RULE: No recommendation found (404)
 //   - RATE_LIMIT_EXCEEDED: Too many requests (429)
 //   - INTERNAL_ERROR: Server error (500)
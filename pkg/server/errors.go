@@ -52,6 +52,7 @@ func WriteError(w http.ResponseWriter, r *http.Request, statusCode int,
 		Retryable: retryable,
 	}
 
+	recordError(r, errResp.Code)
 	serializer.RespondJSON(w, statusCode, errResp)
 }
 
@@ -69,6 +70,8 @@ func HTTPStatusFromCode(code eidoserrors.ErrorCode) int {
 		return http.StatusMethodNotAllowed
 	case eidoserrors.ErrCodeRateLimitExceeded:
 		return http.StatusTooManyRequests
+	case eidoserrors.ErrCodeRequestTooLarge:
+		return http.StatusRequestEntityTooLarge
 	case eidoserrors.ErrCodeUnavailable:
 		return http.StatusServiceUnavailable
 	case eidoserrors.ErrCodeTimeout:
@@ -86,7 +89,8 @@ func retryableFromCode(code eidoserrors.ErrorCode) bool {
 	case eidoserrors.ErrCodeInvalidRequest,
 		eidoserrors.ErrCodeUnauthorized,
 		eidoserrors.ErrCodeNotFound,
-		eidoserrors.ErrCodeMethodNotAllowed:
+		eidoserrors.ErrCodeMethodNotAllowed,
+		eidoserrors.ErrCodeRequestTooLarge:
 		return false
 	case eidoserrors.ErrCodeTimeout,
 		eidoserrors.ErrCodeUnavailable,
@@ -113,6 +117,24 @@ func mergeDetails(a, b map[string]any) map[string]any {
 	return out
 }
 
+// WriteRequestBodyError writes the error response for a request body that
+// could not be read or decoded: 413 REQUEST_TOO_LARGE when the body exceeded
+// its size limit, 400 INVALID_REQUEST otherwise.
+func WriteRequestBodyError(w http.ResponseWriter, r *http.Request, err error, message string) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		WriteError(w, r, http.StatusRequestEntityTooLarge, eidoserrors.ErrCodeRequestTooLarge,
+			"Request body too large", false, map[string]any{
+				"limit": tooLarge.Limit,
+			})
+		return
+	}
+	WriteError(w, r, http.StatusBadRequest, eidoserrors.ErrCodeInvalidRequest,
+		message, false, map[string]any{
+			"error": err.Error(),
+		})
+}
+
 // WriteErrorFromErr writes an ErrorResponse based on a canonical structured error.
 // If err is not a *errors.StructuredError, it falls back to INTERNAL.
 func WriteErrorFromErr(w http.ResponseWriter, r *http.Request, err error, fallbackMessage string, extraDetails map[string]any) {
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 func TestHTTPStatusFromCode(t *testing.T) {
@@ -36,6 +37,7 @@ func TestHTTPStatusFromCode(t *testing.T) {
 		{"not found", eidoserrors.ErrCodeNotFound, http.StatusNotFound},
 		{"method not allowed", eidoserrors.ErrCodeMethodNotAllowed, http.StatusMethodNotAllowed},
 		{"rate limit", eidoserrors.ErrCodeRateLimitExceeded, http.StatusTooManyRequests},
+		{"request too large", eidoserrors.ErrCodeRequestTooLarge, http.StatusRequestEntityTooLarge},
 		{"unavailable", eidoserrors.ErrCodeUnavailable, http.StatusServiceUnavailable},
 		{"timeout", eidoserrors.ErrCodeTimeout, http.StatusGatewayTimeout},
 		{"internal", eidoserrors.ErrCodeInternal, http.StatusInternalServerError},
@@ -61,6 +63,7 @@ func TestRetryableFromCode(t *testing.T) {
 		{"unauthorized", eidoserrors.ErrCodeUnauthorized, false},
 		{"not found", eidoserrors.ErrCodeNotFound, false},
 		{"method not allowed", eidoserrors.ErrCodeMethodNotAllowed, false},
+		{"request too large", eidoserrors.ErrCodeRequestTooLarge, false},
 		{"timeout", eidoserrors.ErrCodeTimeout, true},
 		{"unavailable", eidoserrors.ErrCodeUnavailable, true},
 		{"rate limit", eidoserrors.ErrCodeRateLimitExceeded, true},
@@ -140,6 +143,18 @@ func TestWriteError_WritesErrorResponse(t *testing.T) {
 	}
 }
 
+func TestWriteError_CountsErrorCode(t *testing.T) {
+	counter := httpErrors.WithLabelValues("/v1/bundle", string(eidoserrors.ErrCodeTimeout))
+	before := testutil.ToFloat64(counter)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/bundle", nil)
+	WriteError(httptest.NewRecorder(), req, http.StatusGatewayTimeout, eidoserrors.ErrCodeTimeout, "timed out", true, nil)
+
+	if got := testutil.ToFloat64(counter) - before; got != 1 {
+		t.Errorf("error count delta = %v, want 1", got)
+	}
+}
+
 func TestWriteErrorFromErr_StructuredErrorMapsStatusAndDetails(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	w := httptest.NewRecorder()
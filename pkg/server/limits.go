@@ -0,0 +1,164 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// clientIdleTimeout is how long a client's rate limiter is kept after its
+// last request.
+const clientIdleTimeout = 10 * time.Minute
+
+// clientLimiters rate limits requests per client IP.
+type clientLimiters struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastPrune time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newClientLimiters returns per-client rate limiters, or nil when limit is
+// zero and per-client rate limiting is disabled.
+func newClientLimiters(limit rate.Limit, burst int) *clientLimiters {
+	if limit <= 0 {
+		return nil
+	}
+	return &clientLimiters{
+		limit:     limit,
+		burst:     burst,
+		clients:   make(map[string]*clientLimiter),
+		lastPrune: time.Now(),
+	}
+}
+
+// allow reports whether client may make a request now.
+func (c *clientLimiters) allow(client string) bool {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Forget idle clients so the map does not grow with every address seen
+	if now.Sub(c.lastPrune) > clientIdleTimeout {
+		for key, cl := range c.clients {
+			if now.Sub(cl.lastSeen) > clientIdleTimeout {
+				delete(c.clients, key)
+			}
+		}
+		c.lastPrune = now
+	}
+
+	cl, ok := c.clients[client]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(c.limit, c.burst)}
+		c.clients[client] = cl
+	}
+	cl.lastSeen = now
+	return cl.limiter.AllowN(now, 1)
+}
+
+// clientKey identifies the client of r by its IP address. Forwarding headers
+// are ignored, as clients can set them freely.
+func clientKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// clientRateLimitMiddleware rejects requests from clients exceeding their
+// own rate limit, so one client cannot use up the global budget.
+func (s *Server) clientRateLimitMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.clientLimiters != nil && !s.clientLimiters.allow(clientKey(r)) {
+			clientRateLimitRejects.Inc()
+			w.Header().Set("Retry-After", "1")
+			WriteError(w, r, http.StatusTooManyRequests, eidoserrors.ErrCodeRateLimitExceeded,
+				"Client rate limit exceeded", true, map[string]any{
+					"limit": s.config.ClientRateLimit,
+					"burst": s.config.ClientRateLimitBurst,
+				})
+			return
+		}
+		next.ServeHTTP(w, r)
+	}
+}
+
+// concurrencyLimitMiddleware rejects requests to path while its configured
+// number of requests are already in flight.
+func (s *Server) concurrencyLimitMiddleware(path string, next http.HandlerFunc) http.HandlerFunc {
+	limit := s.config.MaxConcurrent[path]
+	if limit <= 0 {
+		return next
+	}
+	slots := make(chan struct{}, limit)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+		default:
+			concurrencyLimitRejects.WithLabelValues(path).Inc()
+			w.Header().Set("Retry-After", "5")
+			WriteError(w, r, http.StatusTooManyRequests, eidoserrors.ErrCodeRateLimitExceeded,
+				"Too many concurrent requests", true, map[string]any{
+					"maxConcurrent": limit,
+				})
+			return
+		}
+		next.ServeHTTP(w, r)
+	}
+}
+
+// bodyLimitMiddleware rejects requests whose declared body exceeds the size
+// limit and caps the body of all others, so handlers reading it fail with an
+// *http.MaxBytesError (see WriteRequestBodyError) instead of buffering it.
+func (s *Server) bodyLimitMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	limit := s.config.MaxRequestBodyBytes
+	if limit <= 0 {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			WriteError(w, r, http.StatusRequestEntityTooLarge, eidoserrors.ErrCodeRequestTooLarge,
+				"Request body too large", false, map[string]any{
+					"limit":         limit,
+					"contentLength": strconv.FormatInt(r.ContentLength, 10),
+				})
+			return
+		}
+		if r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+		}
+		next.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+func TestClientRateLimitMiddleware(t *testing.T) {
+	cfg := NewConfig()
+	cfg.ClientRateLimit = 1
+	cfg.ClientRateLimitBurst = 2
+	s := &Server{
+		config:         cfg,
+		rateLimiter:    rate.NewLimiter(100, 200),
+		clientLimiters: newClientLimiters(cfg.ClientRateLimit, cfg.ClientRateLimitBurst),
+	}
+
+	handler := s.clientRateLimitMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	request := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodPost, "/v1/bundle", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Code
+	}
+
+	// The burst is allowed, then the client is limited
+	for i := range 2 {
+		if got := request("10.0.0.1:1234"); got != http.StatusOK {
+			t.Fatalf("request %d status = %d, want %d", i, got, http.StatusOK)
+		}
+	}
+	if got := request("10.0.0.1:5678"); got != http.StatusTooManyRequests {
+		t.Errorf("over-limit status = %d, want %d", got, http.StatusTooManyRequests)
+	}
+
+	// Other clients keep their own budget
+	if got := request("10.0.0.2:1234"); got != http.StatusOK {
+		t.Errorf("other client status = %d, want %d", got, http.StatusOK)
+	}
+}
+
+func TestNewClientLimiters_Disabled(t *testing.T) {
+	if l := newClientLimiters(0, 10); l != nil {
+		t.Errorf("newClientLimiters(0) = %v, want nil", l)
+	}
+}
+
+func TestClientKey(t *testing.T) {
+	tests := []struct {
+		remoteAddr string
+		want       string
+	}{
+		{"10.0.0.1:1234", "10.0.0.1"},
+		{"[2001:db8::1]:443", "2001:db8::1"},
+		{"unix-socket", "unix-socket"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.remoteAddr
+		req.Header.Set("X-Forwarded-For", "192.0.2.1")
+		if got := clientKey(req); got != tt.want {
+			t.Errorf("clientKey(%q) = %q, want %q", tt.remoteAddr, got, tt.want)
+		}
+	}
+}
+
+func TestConcurrencyLimitMiddleware(t *testing.T) {
+	cfg := NewConfig()
+	cfg.MaxConcurrent = map[string]int{"/v1/bundle": 1}
+	s := &Server{config: cfg, rateLimiter: rate.NewLimiter(100, 200)}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := s.concurrencyLimitMiddleware("/v1/bundle", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var wg sync.WaitGroup
+	first := httptest.NewRecorder()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		handler(first, httptest.NewRequest(http.MethodPost, "/v1/bundle", nil))
+	}()
+	<-started
+
+	second := httptest.NewRecorder()
+	handler(second, httptest.NewRequest(http.MethodPost, "/v1/bundle", nil))
+	if second.Code != http.StatusTooManyRequests {
+		t.Errorf("concurrent request status = %d, want %d", second.Code, http.StatusTooManyRequests)
+	}
+	if second.Header().Get("Retry-After") == "" {
+		t.Error("expected Retry-After header")
+	}
+
+	close(release)
+	wg.Wait()
+	if first.Code != http.StatusOK {
+		t.Errorf("first request status = %d, want %d", first.Code, http.StatusOK)
+	}
+}
+
+func TestConcurrencyLimitMiddleware_Unlimited(t *testing.T) {
+	s := &Server{config: NewConfig(), rateLimiter: rate.NewLimiter(100, 200)}
+	next := func(w http.ResponseWriter, r *http.Request) {}
+
+	// Routes without a limit are not wrapped
+	handler := s.concurrencyLimitMiddleware("/v1/recipe", next)
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/v1/recipe", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	cfg := NewConfig()
+	cfg.MaxRequestBodyBytes = 16
+	s := &Server{config: cfg, rateLimiter: rate.NewLimiter(100, 200)}
+
+	handler := s.bodyLimitMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			WriteRequestBodyError(w, r, err, "Invalid request body")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		want          int
+	}{
+		{"within limit", strings.Repeat("x", 16), 16, http.StatusOK},
+		{"declared too large", strings.Repeat("x", 32), 32, http.StatusRequestEntityTooLarge},
+		{"streamed too large", strings.Repeat("x", 32), -1, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/bundle", strings.NewReader(tt.body))
+			req.ContentLength = tt.contentLength
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want == http.StatusOK {
+				return
+			}
+			var resp ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if resp.Code != string(eidoserrors.ErrCodeRequestTooLarge) {
+				t.Errorf("code = %q, want %q", resp.Code, eidoserrors.ErrCodeRequestTooLarge)
+			}
+		})
+	}
+}
+
+func TestWriteRequestBodyError_InvalidBody(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteRequestBodyError(rec, httptest.NewRequest(http.MethodPost, "/v1/bundle", nil),
+		io.ErrUnexpectedEOF, "Invalid request body")
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
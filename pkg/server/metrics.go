@@ -42,6 +42,14 @@ var (
 		[]string{"method", "path"},
 	)
 
+	httpErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_http_errors_total",
+			Help: "Total number of HTTP error responses by error code",
+		},
+		[]string{"path", "code"},
+	)
+
 	httpRequestsInFlight = promauto.NewGauge(
 		prometheus.GaugeOpts{
 			Name: "eidos_http_requests_in_flight",
@@ -57,6 +65,30 @@ var (
 		},
 	)
 
+	clientRateLimitRejects = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "eidos_client_rate_limit_rejects_total",
+			Help: "Total number of requests rejected due to per-client rate limiting",
+		},
+	)
+
+	concurrencyLimitRejects = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_concurrency_limit_rejects_total",
+			Help: "Total number of requests rejected because a route was at its concurrency limit",
+		},
+		[]string{"path"},
+	)
+
+	// Authentication metrics
+	authFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_auth_failures_total",
+			Help: "Total number of requests rejected by authentication or authorization",
+		},
+		[]string{"reason"},
+	)
+
 	// Panic recovery metrics
 	panicRecoveries = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -80,7 +112,7 @@ func (s *Server) metricsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 		next.ServeHTTP(wrapped, r)
 
 		duration := time.Since(start).Seconds()
-		path := r.URL.Path
+		path := routeLabel(r)
 		method := r.Method
 		status := strconv.Itoa(wrapped.Status())
 
@@ -88,3 +120,18 @@ func (s *Server) metricsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 		httpRequestDuration.WithLabelValues(method, path).Observe(duration)
 	}
 }
+
+// recordError counts an error response with code for the route of r.
+func recordError(r *http.Request, code string) {
+	httpErrors.WithLabelValues(routeLabel(r), code).Inc()
+}
+
+// routeLabel returns the path label for r. Requests are labeled by route
+// pattern so path parameters such as job IDs don't create a new series per
+// request.
+func routeLabel(r *http.Request) string {
+	if r.Pattern != "" {
+		return r.Pattern
+	}
+	return r.URL.Path
+}
@@ -25,14 +25,22 @@ import (
 	"github.com/google/uuid"
 )
 
-// withMiddleware wraps handlers with common middleware
-func (s *Server) withMiddleware(handler http.HandlerFunc) http.HandlerFunc {
+// withMiddleware wraps the handler of the route at path with common middleware
+func (s *Server) withMiddleware(path string, handler http.HandlerFunc) http.HandlerFunc {
 	return s.metricsMiddleware(
 		s.versionMiddleware(
 			s.requestIDMiddleware(
 				s.panicRecoveryMiddleware( // Recover first to prevent token waste on panics
 					s.rateLimitMiddleware(
-						s.loggingMiddleware(handler),
+						s.clientRateLimitMiddleware(
+							s.authMiddleware(
+								s.concurrencyLimitMiddleware(path,
+									s.bodyLimitMiddleware(
+										s.loggingMiddleware(handler),
+									),
+								),
+							),
+						),
 					),
 				),
 			),
@@ -145,8 +153,10 @@ func (s *Server) loggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 		next.ServeHTTP(rw, r)
 
 		duration := time.Since(start)
+		subject, _ := SubjectFromContext(r.Context())
 		slog.Debug("request completed",
 			"requestID", requestID,
+			"subject", subject,
 			"method", r.Method,
 			"path", r.URL.Path,
 			"status", rw.Status(),
@@ -328,7 +328,7 @@ func TestMiddlewareChain_PropagatesContext(t *testing.T) {
 	}
 
 	var hasRequestID, hasAPIVersion bool
-	handler := s.withMiddleware(func(w http.ResponseWriter, r *http.Request) {
+	handler := s.withMiddleware("/test", func(w http.ResponseWriter, r *http.Request) {
 		hasRequestID = r.Context().Value(contextKeyRequestID) != nil
 		hasAPIVersion = r.Context().Value(contextKeyAPIVersion) != nil
 		w.WriteHeader(http.StatusOK)
@@ -353,7 +353,7 @@ func TestMiddlewareChain_SetsAllHeaders(t *testing.T) {
 		rateLimiter: rate.NewLimiter(100, 200),
 	}
 
-	handler := s.withMiddleware(func(w http.ResponseWriter, r *http.Request) {
+	handler := s.withMiddleware("/test", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
 
@@ -0,0 +1,426 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// oidcClockSkew is the leeway allowed when checking token times.
+	oidcClockSkew = time.Minute
+
+	// oidcKeyRefreshInterval limits how often the issuer keys are fetched
+	// again when a token is signed by an unknown key.
+	oidcKeyRefreshInterval = time.Minute
+
+	// oidcFetchTimeout bounds requests to the issuer.
+	oidcFetchTimeout = 10 * time.Second
+)
+
+// oidcAuthenticator accepts bearer JWTs signed by the keys an OIDC issuer
+// publishes. Keys are fetched on first use, so the server starts even when the
+// issuer is briefly unreachable, and again when the issuer rotates them.
+// Fetches run outside mu, so tokens signed by known keys are verified while
+// the keys are refreshed, and concurrent refreshes share one fetch.
+type oidcAuthenticator struct {
+	issuer   string
+	audience string
+	client   *http.Client
+	refresh  singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func newOIDCAuthenticator(issuer, audience string) (*oidcAuthenticator, error) {
+	if issuer == "" || audience == "" {
+		return nil, errors.New("oidc auth requires an issuer and an audience")
+	}
+	return &oidcAuthenticator{
+		issuer:   strings.TrimSuffix(issuer, "/"),
+		audience: audience,
+		client:   &http.Client{Timeout: oidcFetchTimeout},
+	}, nil
+}
+
+// jwtHeader is the JOSE header of a JWT.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// jwtClaims are the registered JWT claims checked by oidcAuthenticator.
+type jwtClaims struct {
+	Issuer    string      `json:"iss"`
+	Subject   string      `json:"sub"`
+	Audience  jwtAudience `json:"aud"`
+	Expiry    *float64    `json:"exp"`
+	NotBefore *float64    `json:"nbf"`
+}
+
+// jwtAudience is the "aud" claim, which is a string or an array of strings.
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	var multi []string
+	if err := json.Unmarshal(data, &multi); err != nil {
+		return fmt.Errorf("invalid aud claim: %w", err)
+	}
+	*a = multi
+	return nil
+}
+
+func (a *oidcAuthenticator) Authenticate(r *http.Request) (string, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return "", err
+	}
+	claims, err := a.verify(r.Context(), token, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+// verify checks the signature and claims of token at time now.
+func (a *oidcAuthenticator) verify(ctx context.Context, token string, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid token header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid token signature: %w", err)
+	}
+
+	key, err := a.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid token claims: %w", err)
+	}
+	if strings.TrimSuffix(claims.Issuer, "/") != a.issuer {
+		return nil, fmt.Errorf("token issuer %q is not trusted", claims.Issuer)
+	}
+	if !slices.Contains(claims.Audience, a.audience) {
+		return nil, errors.New("token is not issued for this audience")
+	}
+	if claims.Expiry == nil {
+		return nil, errors.New("token has no expiry")
+	}
+	if now.After(unixTime(*claims.Expiry).Add(oidcClockSkew)) {
+		return nil, errors.New("token has expired")
+	}
+	if claims.NotBefore != nil && now.Add(oidcClockSkew).Before(unixTime(*claims.NotBefore)) {
+		return nil, errors.New("token is not valid yet")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	return &claims, nil
+}
+
+// key returns the issuer key with ID kid, fetching the issuer keys when they
+// were never fetched or do not include it.
+func (a *oidcAuthenticator) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	a.mu.Lock()
+	key, ok := a.lookup(kid)
+	fresh := a.fresh()
+	a.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	if fresh {
+		return nil, fmt.Errorf("token signing key %q is unknown", kid)
+	}
+
+	// The fetch is shared by every caller waiting on it, so it must not be
+	// cancelled with the request that started it; the client bounds it.
+	_, err, _ := a.refresh.Do("keys", func() (any, error) {
+		// Another caller may have refreshed the keys since they were checked.
+		a.mu.Lock()
+		fresh, keys := a.fresh(), a.keys
+		a.mu.Unlock()
+		if fresh {
+			return keys, nil
+		}
+
+		fetched, err := a.fetchKeys(context.WithoutCancel(ctx))
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		a.fetchedAt = time.Now()
+		if err != nil {
+			return nil, err
+		}
+		a.keys = fetched
+		return fetched, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issuer keys: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if key, ok := a.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("token signing key %q is unknown", kid)
+}
+
+// fresh reports whether the issuer keys were fetched within the refresh
+// interval. a.mu must be held.
+func (a *oidcAuthenticator) fresh() bool {
+	return a.keys != nil && time.Since(a.fetchedAt) < oidcKeyRefreshInterval
+}
+
+// lookup returns the key with ID kid. Tokens without a key ID match the
+// issuer's only key. a.mu must be held.
+func (a *oidcAuthenticator) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, true
+		}
+	}
+	key, ok := a.keys[kid]
+	return key, ok
+}
+
+// fetchKeys fetches the issuer's signing keys from the JWKS URL listed in
+// its discovery document.
+func (a *oidcAuthenticator) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := a.getJSON(ctx, a.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != a.issuer {
+		return nil, fmt.Errorf("discovery document issuer %q does not match %q", discovery.Issuer, a.issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return nil, errors.New("discovery document has no jwks_uri")
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := a.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// Skip keys of unsupported types rather than rejecting all tokens
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (a *oidcAuthenticator) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("GET %s: %w", url, err)
+	}
+	return nil
+}
+
+// jwk is a JSON Web Key as published by OIDC issuers.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey returns the RSA or EC public key of k.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA exponent: %w", err)
+		}
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC y coordinate: %w", err)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New("invalid EC point size")
+		}
+		point := append([]byte{4}, append(x, y...)...)
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// jwtHashes are the hashes of the supported JWT signing algorithms.
+var jwtHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"PS256": crypto.SHA256,
+	"PS384": crypto.SHA384,
+	"PS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+// verifyJWTSignature verifies sig over signed with key for algorithm alg.
+// Only asymmetric algorithms are supported, and the key type must match.
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	hash, ok := jwtHashes[alg]
+	if !ok {
+		return fmt.Errorf("unsupported token algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS", "PS":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("token algorithm %q does not match the signing key", alg)
+		}
+		var err error
+		if alg[0] == 'R' {
+			err = rsa.VerifyPKCS1v15(pub, hash, digest, sig)
+		} else {
+			err = rsa.VerifyPSS(pub, hash, digest, sig, nil)
+		}
+		if err != nil {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	case "ES":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("token algorithm %q does not match the signing key", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid token signature")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported token algorithm %q", alg)
+	}
+}
+
+// decodeJWTPart decodes a base64url encoded JSON part of a JWT into v.
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// unixTime converts a JWT NumericDate to a time.
+func unixTime(seconds float64) time.Time {
+	return time.Unix(int64(seconds), 0)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testIssuer is an OIDC issuer serving a discovery document and JWKS.
+type testIssuer struct {
+	server     *httptest.Server
+	rsaKey     *rsa.PrivateKey
+	ecKey      *ecdsa.PrivateKey
+	keyFetches atomic.Int32
+
+	// block, when set, holds key fetches until it is closed.
+	block atomic.Pointer[chan struct{}]
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := &testIssuer{rsaKey: rsaKey, ecKey: ecKey}
+
+	b64 := base64.RawURLEncoding.EncodeToString
+	ecPoint, err := ecKey.PublicKey.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   iss.server.URL,
+			"jwks_uri": iss.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		iss.keyFetches.Add(1)
+		if block := iss.block.Load(); block != nil {
+			<-*block
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+				{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ecPoint[1:33]), "y": b64(ecPoint[33:])},
+				{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+			},
+		})
+	})
+	iss.server = httptest.NewServer(mux)
+	t.Cleanup(iss.server.Close)
+	return iss
+}
+
+// sign returns a JWT with claims signed with the issuer's key for alg.
+func (iss *testIssuer) sign(t *testing.T, alg, kid string, claims map[string]any) string {
+	t.Helper()
+	b64 := base64.RawURLEncoding.EncodeToString
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	var err error
+	switch alg {
+	case "RS256":
+		sig, err = rsa.SignPKCS1v15(rand.Reader, iss.rsaKey, crypto.SHA256, digest[:])
+	case "ES256":
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, iss.ecKey, digest[:])
+		if err == nil {
+			sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	default:
+		t.Fatalf("unsupported alg %s", alg)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + b64(sig)
+}
+
+func (iss *testIssuer) claims(overrides map[string]any) map[string]any {
+	claims := map[string]any{
+		"iss": iss.server.URL,
+		"sub": "alice",
+		"aud": "eidos",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	iss := newTestIssuer(t)
+	a, err := newOIDCAuthenticator(iss.server.URL+"/", "eidos")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr string
+	}{
+		{"RS256", func() string { return iss.sign(t, "RS256", "rsa", iss.claims(nil)) }, ""},
+		{"ES256", func() string { return iss.sign(t, "ES256", "ec", iss.claims(nil)) }, ""},
+		{"audience list", func() string {
+			return iss.sign(t, "RS256", "rsa", iss.claims(map[string]any{"aud": []string{"other", "eidos"}}))
+		}, ""},
+		{"wrong audience", func() string {
+			return iss.sign(t, "RS256", "rsa", iss.claims(map[string]any{"aud": "other"}))
+		}, "audience"},
+		{"wrong issuer", func() string {
+			return iss.sign(t, "RS256", "rsa", iss.claims(map[string]any{"iss": "https://evil.example.com"}))
+		}, "issuer"},
+		{"expired", func() string {
+			return iss.sign(t, "RS256", "rsa", iss.claims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))
+		}, "expired"},
+		{"no expiry", func() string {
+			return iss.sign(t, "RS256", "rsa", iss.claims(map[string]any{"exp": nil}))
+		}, "expiry"},
+		{"not yet valid", func() string {
+			return iss.sign(t, "RS256", "rsa", iss.claims(map[string]any{"nbf": time.Now().Add(time.Hour).Unix()}))
+		}, "not valid yet"},
+		{"unknown key", func() string { return iss.sign(t, "RS256", "other", iss.claims(nil)) }, "unknown"},
+		{"algorithm does not match key", func() string {
+			return iss.sign(t, "ES256", "rsa", iss.claims(nil))
+		}, "does not match"},
+		{"tampered claims", func() string {
+			token := iss.sign(t, "RS256", "rsa", iss.claims(nil))
+			parts := strings.Split(token, ".")
+			forged, _ := json.Marshal(iss.claims(map[string]any{"sub": "mallory"}))
+			return parts[0] + "." + base64.RawURLEncoding.EncodeToString(forged) + "." + parts[2]
+		}, "signature"},
+		{"alg none", func() string {
+			header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa"}`))
+			payload, _ := json.Marshal(iss.claims(nil))
+			return header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+		}, "unsupported"},
+		{"malformed", func() string { return "not-a-jwt" }, "malformed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/recipe", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token())
+			subject, err := a.Authenticate(req)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if subject != "alice" {
+				t.Errorf("subject = %q, want alice", subject)
+			}
+		})
+	}
+
+	// Unknown keys refetch the issuer keys at most once per refresh interval
+	if got := iss.keyFetches.Load(); got != 1 {
+		t.Errorf("key fetches = %d, want 1", got)
+	}
+}
+
+func TestOIDCAuthenticator_RefreshDoesNotBlockKnownKeys(t *testing.T) {
+	iss := newTestIssuer(t)
+	a, err := newOIDCAuthenticator(iss.server.URL, "eidos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	authenticate := func(kid string) error {
+		req := httptest.NewRequest(http.MethodGet, "/v1/recipe", nil)
+		req.Header.Set("Authorization", "Bearer "+iss.sign(t, "RS256", kid, iss.claims(nil)))
+		_, err := a.Authenticate(req)
+		return err
+	}
+	if err := authenticate("rsa"); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	// Let the keys go stale and hold the refresh an unknown key triggers.
+	a.mu.Lock()
+	a.fetchedAt = time.Now().Add(-2 * oidcKeyRefreshInterval)
+	a.mu.Unlock()
+	block := make(chan struct{})
+	iss.block.Store(&block)
+	release := sync.OnceFunc(func() { close(block) })
+	defer release()
+
+	refreshed := make(chan error, 1)
+	go func() { refreshed <- authenticate("rotated") }()
+	for deadline := time.Now().Add(5 * time.Second); iss.keyFetches.Load() < 2; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("key refresh did not start")
+		}
+	}
+
+	known := make(chan error, 1)
+	go func() { known <- authenticate("rsa") }()
+	select {
+	case err := <-known:
+		if err != nil {
+			t.Errorf("Authenticate(known key) error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Authenticate(known key) blocked on the key refresh")
+	}
+
+	release()
+	if err := <-refreshed; err == nil || !strings.Contains(err.Error(), "unknown") {
+		t.Errorf("Authenticate(rotated key) error = %v, want unknown key", err)
+	}
+	if got := iss.keyFetches.Load(); got != 2 {
+		t.Errorf("key fetches = %d, want 2", got)
+	}
+}
+
+func TestOIDCAuthenticator_IssuerUnavailable(t *testing.T) {
+	iss := newTestIssuer(t)
+	token := iss.sign(t, "RS256", "rsa", iss.claims(nil))
+	iss.server.Close()
+
+	a, err := newOIDCAuthenticator(iss.server.URL, "eidos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/v1/recipe", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if _, err := a.Authenticate(req); err == nil || !strings.Contains(err.Error(), "fetch issuer keys") {
+		t.Errorf("Authenticate() error = %v, want fetch error", err)
+	}
+}
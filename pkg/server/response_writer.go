@@ -58,3 +58,9 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 func (rw *responseWriter) Status() int {
 	return rw.statusCode
 }
+
+// Unwrap returns the wrapped http.ResponseWriter so http.ResponseController
+// can reach optional interfaces such as http.Flusher.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -40,8 +40,14 @@ type Server struct {
 	config      *Config
 	httpServer  *http.Server
 	rateLimiter *rate.Limiter
-	mu          sync.RWMutex
-	ready       bool
+	// clientLimiters is nil when per-client rate limiting is disabled
+	clientLimiters *clientLimiters
+	// authenticator is nil when authentication is disabled
+	authenticator Authenticator
+	// setupErr is an invalid configuration reported when the server starts
+	setupErr error
+	mu       sync.RWMutex
+	ready    bool
 }
 
 // Option is a functional option for configuring Server instances.
@@ -68,6 +74,26 @@ func WithVersion(version string) Option {
 	}
 }
 
+// WithMaxConcurrent returns an Option that limits the number of in-flight
+// requests to the route at path, such as endpoints that generate bundles.
+func WithMaxConcurrent(path string, n int) Option {
+	return func(s *Server) {
+		if s.config.MaxConcurrent == nil {
+			s.config.MaxConcurrent = make(map[string]int)
+		}
+		s.config.MaxConcurrent[path] = n
+	}
+}
+
+// WithAuthenticator returns an Option that authenticates requests to
+// application routes with a, instead of the authenticator selected by the
+// auth configuration.
+func WithAuthenticator(a Authenticator) Option {
+	return func(s *Server) {
+		s.authenticator = a
+	}
+}
+
 // WithHandler returns an Option that adds custom HTTP handlers to the server.
 // The map keys are URL paths and values are the corresponding handler functions.
 func WithHandler(handlers map[string]http.HandlerFunc) Option {
@@ -94,6 +120,10 @@ func New(opts ...Option) *Server {
 
 	// Re-create rate limiter if config was changed
 	s.rateLimiter = rate.NewLimiter(s.config.RateLimit, s.config.RateLimitBurst)
+	s.clientLimiters = newClientLimiters(s.config.ClientRateLimit, s.config.ClientRateLimitBurst)
+	if s.authenticator == nil {
+		s.authenticator, s.setupErr = newAuthenticator(s.config.Auth)
+	}
 
 	// Setup HTTP server
 	mux := http.NewServeMux()
@@ -108,19 +138,23 @@ func New(opts ...Option) *Server {
 
 	// setup application routes
 	for path, handler := range s.config.Handlers {
-		mux.HandleFunc(path, s.withMiddleware(handler))
+		mux.HandleFunc(path, s.withMiddleware(path, handler))
 	}
 
 	s.httpServer = &http.Server{
-		Addr:              fmt.Sprintf("%s:%d", config.Address, config.Port),
+		Addr:              fmt.Sprintf("%s:%d", s.config.Address, s.config.Port),
 		Handler:           mux,
-		ReadTimeout:       config.ReadTimeout,
-		WriteTimeout:      config.WriteTimeout,
-		IdleTimeout:       config.IdleTimeout,
+		ReadTimeout:       s.config.ReadTimeout,
+		WriteTimeout:      s.config.WriteTimeout,
+		IdleTimeout:       s.config.IdleTimeout,
 		MaxHeaderBytes:    1 << 16,         // 64KB limit to prevent header-based attacks
 		ReadHeaderTimeout: 5 * time.Second, // Prevent slow header attacks
 	}
 
+	if s.setupErr == nil {
+		s.setupErr = s.configureTLS()
+	}
+
 	return s
 }
 
@@ -131,8 +165,32 @@ func (s *Server) setReady(ready bool) {
 	s.ready = ready
 }
 
+// configureTLS validates the TLS configuration and, for mTLS
+// authentication, sets up client certificate verification.
+func (s *Server) configureTLS() error {
+	if (s.config.TLSCertFile == "") != (s.config.TLSKeyFile == "") {
+		return errors.New("TLS requires both a certificate and a key file")
+	}
+	if s.config.Auth.Mode != AuthModeMTLS || s.config.Auth.ClientCAFile == "" {
+		return nil
+	}
+	if s.config.TLSCertFile == "" {
+		return errors.New("mtls auth requires TLS to be configured")
+	}
+	tlsConfig, err := clientCATLSConfig(s.config.Auth.ClientCAFile)
+	if err != nil {
+		return err
+	}
+	s.httpServer.TLSConfig = tlsConfig
+	return nil
+}
+
 // Start starts the HTTP server and listens for incoming requests.
 func (s *Server) Start(ctx context.Context) error {
+	if s.setupErr != nil {
+		return fmt.Errorf("invalid server configuration: %w", s.setupErr)
+	}
+
 	s.setReady(true)
 
 	slog.Debug("server start", "port", s.httpServer.Addr)
@@ -140,7 +198,13 @@ func (s *Server) Start(ctx context.Context) error {
 	// Start server in goroutine
 	errChan := make(chan error, 1)
 	go func() {
-		if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		var err error
+		if s.config.TLSCertFile != "" {
+			err = s.httpServer.ListenAndServeTLS(s.config.TLSCertFile, s.config.TLSKeyFile)
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			errChan <- err
 		}
 	}()
@@ -173,12 +237,22 @@ func (s *Server) Run(ctx context.Context) error {
 		slog.Any("rateLimit", s.config.RateLimit),
 		slog.Int("rateLimitBurst", s.config.RateLimitBurst),
 		slog.Int("maxBulkRequests", s.config.MaxBulkRequests),
+		slog.Any("clientRateLimit", s.config.ClientRateLimit),
+		slog.Int("clientRateLimitBurst", s.config.ClientRateLimitBurst),
+		slog.Int64("maxRequestBodyBytes", s.config.MaxRequestBodyBytes),
+		slog.Any("maxConcurrent", s.config.MaxConcurrent),
+		slog.String("authMode", string(s.config.Auth.Mode)),
+		slog.Bool("tls", s.config.TLSCertFile != ""),
 		slog.Duration("readTimeout", s.config.ReadTimeout),
 		slog.Duration("writeTimeout", s.config.WriteTimeout),
 		slog.Duration("idleTimeout", s.config.IdleTimeout),
 		slog.Duration("shutdownTimeout", s.config.ShutdownTimeout),
 	)
 
+	if s.authenticator != nil && s.config.TLSCertFile == "" && s.config.Auth.Mode != AuthModeMTLS {
+		slog.Warn("authentication is enabled without TLS; terminate TLS in front of the server so credentials are not sent in cleartext")
+	}
+
 	// Setup graceful shutdown
 	notifCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
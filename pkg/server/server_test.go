@@ -116,7 +116,7 @@ func TestRateLimiting(t *testing.T) {
 
 	s := New(WithConfig(cfg))
 
-	handler := s.withMiddleware(s.config.Handlers["/test"])
+	handler := s.withMiddleware("/test", s.config.Handlers["/test"])
 
 	// First request should succeed
 	req1 := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -16,6 +16,7 @@ package snapshotter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -58,6 +59,10 @@ type AgentConfig struct {
 	// ServiceAccountName for the agent
 	ServiceAccountName string
 
+	// NodeName pins the agent Job to a single node. The node must exist and
+	// be Ready.
+	NodeName string
+
 	// NodeSelector for targeting specific nodes
 	NodeSelector map[string]string
 
@@ -79,6 +84,73 @@ type AgentConfig struct {
 	// Privileged enables privileged mode (hostPID, hostNetwork, privileged container).
 	// Required for GPU and SystemD collectors. When false, only K8s and OS collectors work.
 	Privileged bool
+
+	// PluginDir is the collector plugin directory inside the agent container.
+	// Plugins must be present in the agent image. Empty disables plugins.
+	PluginDir string
+
+	// PluginTimeout bounds each plugin run in the agent. Zero uses the default.
+	PluginTimeout time.Duration
+
+	// Redact masks sensitive values in the agent before the snapshot is
+	// written to the ConfigMap.
+	Redact bool
+
+	// RedactPatterns are additional regular expressions redacted by the agent.
+	RedactPatterns []string
+
+	// BackoffLimit is the number of pod retries within the agent Job.
+	// Zero fails the Job on the first pod failure.
+	BackoffLimit int32
+
+	// ActiveDeadline bounds the agent Job run time (activeDeadlineSeconds).
+	// Zero uses the default.
+	ActiveDeadline time.Duration
+
+	// PollInterval is how often the Job status is re-checked while waiting.
+	// Zero uses the default.
+	PollInterval time.Duration
+
+	// NodeRetries is how many times a failed snapshot is retried on a
+	// different node, avoiding the nodes where earlier runs failed.
+	NodeRetries int
+
+	// StreamLogs prints the agent logs to stderr while the Job runs.
+	// Failures include the tail of the logs either way.
+	StreamLogs bool
+}
+
+// awaitAgentJob waits for the agent Job to finish, streaming its logs when
+// streamLogs is set. Failures carry the tail of the agent logs.
+func awaitAgentJob(ctx context.Context, deployer *agent.Deployer, jobName string, timeout time.Duration, streamLogs bool) error {
+	slog.Info("waiting for Job completion",
+		slog.String("job", jobName),
+		slog.Duration("timeout", timeout))
+
+	if !streamLogs {
+		return deployer.WaitForCompletion(ctx, timeout)
+	}
+
+	// Wait for Pod to be ready before streaming logs
+	podReadyTimeout := 60 * time.Second
+	logCtx, cancelLogs := context.WithCancel(ctx)
+	defer cancelLogs()
+
+	if podErr := deployer.WaitForPodReady(ctx, podReadyTimeout); podErr != nil {
+		slog.Warn("could not wait for pod ready, skipping log streaming", slog.String("error", podErr.Error()))
+	} else {
+		// Start streaming logs in background
+		go func() {
+			if streamErr := deployer.StreamLogs(logCtx, logWriter(), ""); streamErr != nil {
+				// Only log if not canceled (expected when job completes)
+				if logCtx.Err() == nil {
+					slog.Debug("log streaming ended", slog.String("reason", streamErr.Error()))
+				}
+			}
+		}()
+	}
+
+	return deployer.WaitForCompletion(ctx, timeout)
 }
 
 // ParseNodeSelectors parses node selector strings in format "key=value".
@@ -181,11 +253,22 @@ func (n *NodeSnapshotter) measureWithAgent(ctx context.Context) error {
 		JobName:            n.AgentConfig.JobName,
 		Image:              n.AgentConfig.Image,
 		ImagePullSecrets:   n.AgentConfig.ImagePullSecrets,
+		NodeName:           n.AgentConfig.NodeName,
 		NodeSelector:       n.AgentConfig.NodeSelector,
 		Tolerations:        n.AgentConfig.Tolerations,
 		Output:             output,
 		Debug:              n.AgentConfig.Debug,
 		Privileged:         n.AgentConfig.Privileged,
+		PluginDir:          n.AgentConfig.PluginDir,
+		PluginTimeout:      n.AgentConfig.PluginTimeout,
+		Redact:             n.AgentConfig.Redact,
+		RedactPatterns:     n.AgentConfig.RedactPatterns,
+		Types:              n.Scope.TypeNames(),
+		ExcludeSubtypes:    n.Scope.ExcludedSubtypeNames(),
+		BackoffLimit:       n.AgentConfig.BackoffLimit,
+		ActiveDeadline:     n.AgentConfig.ActiveDeadline,
+		PollInterval:       n.AgentConfig.PollInterval,
+		NodeRetries:        n.AgentConfig.NodeRetries,
 	}
 
 	// Create deployer
@@ -231,37 +314,26 @@ func (n *NodeSnapshotter) measureWithAgent(ctx context.Context) error {
 		timeout = 5 * time.Minute
 	}
 
-	slog.Info("waiting for Job completion",
-		slog.String("job", agentConfig.JobName),
-		slog.Duration("timeout", timeout))
+	for attempt := 1; ; attempt++ {
+		waitErr := awaitAgentJob(ctx, deployer, agentConfig.JobName, timeout, n.AgentConfig.StreamLogs)
+		if waitErr == nil {
+			break
+		}
 
-	// Wait for Pod to be ready before streaming logs
-	podReadyTimeout := 60 * time.Second
-	logCtx, cancelLogs := context.WithCancel(ctx)
-	defer cancelLogs()
+		var failure *agent.JobFailure
+		if attempt > n.AgentConfig.NodeRetries || !errors.As(waitErr, &failure) || !failure.Retryable() {
+			return fmt.Errorf("job failed: %w", waitErr)
+		}
 
-	if podErr := deployer.WaitForPodReady(ctx, podReadyTimeout); podErr != nil {
-		slog.Warn("could not wait for pod ready, skipping log streaming", slog.String("error", podErr.Error()))
-	} else {
-		// Start streaming logs in background
-		go func() {
-			if streamErr := deployer.StreamLogs(logCtx, logWriter(), ""); streamErr != nil {
-				// Only log if not canceled (expected when job completes)
-				if logCtx.Err() == nil {
-					slog.Debug("log streaming ended", slog.String("reason", streamErr.Error()))
-				}
-			}
-		}()
-	}
+		slog.Warn("agent job failed, retrying on a different node",
+			slog.String("reason", string(failure.Reason)),
+			slog.String("node", failure.Node),
+			slog.Int("attempt", attempt),
+			slog.Int("retries", n.AgentConfig.NodeRetries))
 
-	if waitErr := deployer.WaitForCompletion(ctx, timeout); waitErr != nil {
-		// On failure, try to get pod logs to show what went wrong
-		if logs, logErr := deployer.GetPodLogs(ctx); logErr == nil && logs != "" {
-			fmt.Fprintln(logWriter(), "--- agent logs ---")
-			fmt.Fprintln(logWriter(), logs)
-			fmt.Fprintln(logWriter(), "--- end logs ---")
+		if retryErr := deployer.RetryOnOtherNode(ctx, failure); retryErr != nil {
+			return fmt.Errorf("failed to retry agent: %w", retryErr)
 		}
-		return fmt.Errorf("job failed: %w", waitErr)
 	}
 
 	slog.Info("job completed successfully")
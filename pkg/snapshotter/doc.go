@@ -34,6 +34,7 @@
 //	    Version    string               // Snapshotter version
 //	    Factory    collector.Factory    // Collector factory (optional)
 //	    Serializer serializer.Serializer // Output serializer (optional)
+//	    Redactor   Redactor              // Masks sensitive values before output (optional)
 //	}
 //
 // Snapshot: Captured configuration data
@@ -146,6 +147,36 @@
 //
 // This ensures correct node identification in various deployment scenarios.
 //
+// # Schema Versions
+//
+// Snapshots record their document layout in SchemaVersion. Decoding a
+// Snapshot from JSON or YAML (including through the serializer package)
+// migrates older documents to the current SchemaVersion, so consumers such as
+// recipe generation and validation accept any supported version. Migrate
+// does the same for raw documents:
+//
+//	snap, err := snapshotter.Migrate(data)
+//
+// Documents newer than SchemaVersion are rejected. To change the layout,
+// bump SchemaVersion and append a migration from the previous version.
+//
+// # Redaction
+//
+// Set NodeSnapshotter.Redactor to mask sensitive values before the snapshot
+// is serialized, so it can be shared outside the organization:
+//
+//	redactor, err := snapshotter.NewRedactor(`corp\.example\.com`)
+//	if err != nil {
+//	    return err
+//	}
+//	ns := &snapshotter.NodeSnapshotter{Version: "v1.0.0", Redactor: redactor}
+//
+// DefaultRedactor replaces hostnames (the source node and kernel hostname),
+// IPv4 and IPv6 addresses, cloud account IDs (from the node providerID, ECR
+// registries and ARNs) and matches of custom patterns with fixed
+// placeholders such as REDACTED-HOSTNAME, and sets the "redacted" metadata
+// key. In agent mode the Job redacts before writing the ConfigMap.
+//
 // # Error Handling
 //
 // Measure() returns an error when:
@@ -0,0 +1,182 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaVersion is the snapshot schema version written by this release.
+// Documents without a schemaVersion field are treated as version 1.
+//
+// Versions:
+//   - 1: original format; the collecting node is recorded in metadata "source"
+//   - 2: schemaVersion field; metadata "source" renamed to "source-node"
+const SchemaVersion = 2
+
+// schemaVersionKey is the document field holding the schema version.
+const schemaVersionKey = "schemaVersion"
+
+// migration upgrades a decoded snapshot document by one schema version.
+type migration func(doc map[string]any) error
+
+// migrations[i] upgrades a document from version i+1 to i+2.
+var migrations = []migration{
+	migrateV1ToV2,
+}
+
+// Migrate decodes a snapshot document (JSON or YAML) of any supported schema
+// version and upgrades it to the current schema.
+//
+// Loading a Snapshot with the serializer package or encoding/json and yaml.v3
+// migrates transparently; Migrate is for callers holding raw documents.
+func Migrate(data []byte) (*Snapshot, error) {
+	var snap Snapshot
+	if err := yaml.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return &snap, nil
+}
+
+// migrateDocument upgrades a decoded snapshot document in place to
+// SchemaVersion.
+func migrateDocument(doc map[string]any) error {
+	from, err := documentVersion(doc)
+	if err != nil {
+		return err
+	}
+	if from > SchemaVersion {
+		return fmt.Errorf("unsupported snapshot schemaVersion %d (this release supports up to %d)", from, SchemaVersion)
+	}
+
+	for v := from; v < SchemaVersion; v++ {
+		if err := migrations[v-1](doc); err != nil {
+			return fmt.Errorf("failed to migrate snapshot from schemaVersion %d to %d: %w", v, v+1, err)
+		}
+	}
+	if from < SchemaVersion {
+		slog.Debug("migrated snapshot schema",
+			slog.Int("from", from),
+			slog.Int("to", SchemaVersion))
+	}
+
+	doc[schemaVersionKey] = SchemaVersion
+	return nil
+}
+
+// documentVersion returns the schema version of a decoded document.
+func documentVersion(doc map[string]any) (int, error) {
+	raw, ok := doc[schemaVersionKey]
+	if !ok || raw == nil {
+		return 1, nil
+	}
+
+	var v int
+	switch n := raw.(type) {
+	case int:
+		v = n
+	case float64:
+		v = int(n)
+		if float64(v) != n {
+			return 0, fmt.Errorf("invalid snapshot schemaVersion %v", raw)
+		}
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("invalid snapshot schemaVersion %v", raw)
+		}
+		v = int(i)
+	default:
+		return 0, fmt.Errorf("invalid snapshot schemaVersion %v", raw)
+	}
+	if v < 1 {
+		return 0, fmt.Errorf("invalid snapshot schemaVersion %d", v)
+	}
+	return v, nil
+}
+
+// migrateV1ToV2 renames the "source" metadata key to "source-node", matching
+// the key used by the Kubernetes node subtype.
+func migrateV1ToV2(doc map[string]any) error {
+	meta, ok := doc["metadata"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	if source, ok := meta["source"]; ok {
+		if _, exists := meta["source-node"]; !exists {
+			meta["source-node"] = source
+		}
+		delete(meta, "source")
+	}
+	return nil
+}
+
+// snapshotDocument has Snapshot's fields without its unmarshal methods.
+type snapshotDocument Snapshot
+
+// UnmarshalJSON decodes a snapshot of any supported schema version,
+// migrating it to SchemaVersion.
+func (s *Snapshot) UnmarshalJSON(data []byte) error {
+	var peek struct {
+		SchemaVersion int `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(data, &peek); err == nil && peek.SchemaVersion == SchemaVersion {
+		return json.Unmarshal(data, (*snapshotDocument)(s))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+	if err := migrateDocument(doc); err != nil {
+		return err
+	}
+	migrated, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode migrated snapshot: %w", err)
+	}
+	return json.Unmarshal(migrated, (*snapshotDocument)(s))
+}
+
+// UnmarshalYAML decodes a snapshot of any supported schema version,
+// migrating it to SchemaVersion.
+func (s *Snapshot) UnmarshalYAML(node *yaml.Node) error {
+	var peek struct {
+		SchemaVersion int `yaml:"schemaVersion"`
+	}
+	if err := node.Decode(&peek); err == nil && peek.SchemaVersion == SchemaVersion {
+		return node.Decode((*snapshotDocument)(s))
+	}
+
+	var doc map[string]any
+	if err := node.Decode(&doc); err != nil {
+		return err
+	}
+	if err := migrateDocument(doc); err != nil {
+		return err
+	}
+	migrated, err := yaml.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode migrated snapshot: %w", err)
+	}
+	return yaml.Unmarshal(migrated, (*snapshotDocument)(s))
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+const legacySnapshotYAML = `kind: Snapshot
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  timestamp: "2026-01-02T18:01:13Z"
+  version: 0.8.12
+  source: ip-10-0-158-18.ec2.internal
+measurements:
+  - type: GPU
+    subtypes:
+      - subtype: smi
+        data:
+          cuda-version: "12.8"
+          gpu-count: 8
+`
+
+func TestMigrate_Legacy(t *testing.T) {
+	snap, err := Migrate([]byte(legacySnapshotYAML))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	assertMigrated(t, snap)
+
+	gpu := snap.Measurements[0].GetSubtype("smi")
+	if v, _ := gpu.GetString("cuda-version"); v != "12.8" {
+		t.Errorf("cuda-version = %q, want 12.8", v)
+	}
+	if v := gpu.Data[measurement.KeyGPUCount].String(); v != "8" {
+		t.Errorf("gpu-count = %s, want 8", v)
+	}
+}
+
+func TestSnapshot_UnmarshalJSON_Legacy(t *testing.T) {
+	doc := `{"kind":"Snapshot","apiVersion":"eidos.nvidia.com/v1alpha1",` +
+		`"metadata":{"source":"ip-10-0-158-18.ec2.internal"},` +
+		`"measurements":[{"type":"GPU","subtypes":[{"subtype":"smi","data":{"gpu-count":8}}]}]}`
+
+	var snap Snapshot
+	if err := json.Unmarshal([]byte(doc), &snap); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	assertMigrated(t, &snap)
+}
+
+func TestSnapshot_RoundTrip_Current(t *testing.T) {
+	snap := NewSnapshot()
+	snap.Init(header.KindSnapshot, FullAPIVersion, "v1.0.0")
+	snap.Metadata["source-node"] = "node-1"
+	snap.Measurements = append(snap.Measurements, &measurement.Measurement{
+		Type: measurement.TypeOS,
+		Subtypes: []measurement.Subtype{
+			{Name: "release", Data: map[string]measurement.Reading{"ID": measurement.Str("ubuntu")}},
+		},
+	})
+
+	jsonData, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	yamlData, err := yaml.Marshal(snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(yamlData), "schemaVersion: 2") {
+		t.Errorf("expected schemaVersion in output:\n%s", yamlData)
+	}
+
+	var fromJSON Snapshot
+	if err := json.Unmarshal(jsonData, &fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	assertMigrated(t, &fromJSON)
+
+	fromYAML, err := Migrate(yamlData)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	assertMigrated(t, fromYAML)
+}
+
+func TestMigrate_UnsupportedVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{name: "newer", doc: "kind: Snapshot\nschemaVersion: 99\n", want: "unsupported snapshot schemaVersion 99"},
+		{name: "zero", doc: "kind: Snapshot\nschemaVersion: 0\n", want: "invalid snapshot schemaVersion"},
+		{name: "not a number", doc: "kind: Snapshot\nschemaVersion: two\n", want: "invalid snapshot schemaVersion"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Migrate([]byte(tt.doc))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Migrate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrate_ExampleSnapshots(t *testing.T) {
+	for _, name := range []string{"h100.yaml", "gb200.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile("../../examples/snapshots/" + name)
+			if err != nil {
+				t.Skipf("example snapshot not available: %v", err)
+			}
+			snap, err := Migrate(data)
+			if err != nil {
+				t.Fatalf("Migrate() error = %v", err)
+			}
+			if snap.SchemaVersion != SchemaVersion || snap.Metadata["source-node"] == "" {
+				t.Errorf("example not migrated: schemaVersion=%d metadata=%v", snap.SchemaVersion, snap.Metadata)
+			}
+			if len(snap.Measurements) == 0 {
+				t.Error("expected measurements")
+			}
+		})
+	}
+}
+
+func assertMigrated(t *testing.T, snap *Snapshot) {
+	t.Helper()
+	if snap.SchemaVersion != SchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", snap.SchemaVersion, SchemaVersion)
+	}
+	if snap.Kind != header.KindSnapshot {
+		t.Errorf("Kind = %q, want %q", snap.Kind, header.KindSnapshot)
+	}
+	if snap.Metadata["source-node"] == "" {
+		t.Errorf("metadata source-node missing: %v", snap.Metadata)
+	}
+	if _, ok := snap.Metadata["source"]; ok {
+		t.Errorf("legacy metadata source not removed: %v", snap.Metadata)
+	}
+	if len(snap.Measurements) != 1 {
+		t.Fatalf("expected 1 measurement, got %d", len(snap.Measurements))
+	}
+}
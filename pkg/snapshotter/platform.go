@@ -0,0 +1,78 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"log/slog"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// MetadataSkippedTypes is the snapshot metadata key listing the measurement
+// types that were not collected because the host OS does not support them.
+const MetadataSkippedTypes = "skipped-types"
+
+// hostOS is the operating system the collectors run on.
+var hostOS = runtime.GOOS
+
+// linuxTypes are collected from procfs, sysfs, host configuration files and
+// Linux tools such as systemctl and nvidia-smi, so they are only available on
+// Linux hosts. Kubernetes and plugin measurements are collected anywhere.
+var linuxTypes = []measurement.Type{
+	measurement.TypeSystemD,
+	measurement.TypeOS,
+	measurement.TypeGPU,
+	measurement.TypeNetwork,
+	measurement.TypeCPU,
+	measurement.TypeRuntime,
+}
+
+// unsupportedTypes returns the measurement types in scope that cannot be
+// collected on the host OS, logging a warning when there are any.
+func unsupportedTypes(scope *Scope) []measurement.Type {
+	if hostOS == "linux" {
+		return nil
+	}
+	var skipped []measurement.Type
+	for _, t := range linuxTypes {
+		if scope.Includes(t) {
+			skipped = append(skipped, t)
+		}
+	}
+	if len(skipped) > 0 {
+		slog.Warn("skipping node measurements not supported on this OS, run the snapshot on a Linux node or with --deploy-agent",
+			slog.String("os", hostOS),
+			slog.String("types", joinTypes(skipped)))
+	}
+	return skipped
+}
+
+// joinTypes returns the types as a comma-separated list.
+func joinTypes(types []measurement.Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ",")
+}
+
+// collects reports whether measurements of type t are in scope and supported
+// on the host.
+func collects(scope *Scope, skipped []measurement.Type, t measurement.Type) bool {
+	return scope.Includes(t) && !slices.Contains(skipped, t)
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// Placeholders that replace redacted values.
+const (
+	RedactedHostname = "REDACTED-HOSTNAME"
+	RedactedIP       = "REDACTED-IP"
+	RedactedAccount  = "REDACTED-ACCOUNT"
+	RedactedValue    = "REDACTED"
+)
+
+// MetadataRedacted is the snapshot metadata key set to "true" on redacted snapshots.
+const MetadataRedacted = "redacted"
+
+// Redactor masks sensitive values in a snapshot before it is written.
+type Redactor interface {
+	Redact(snap *Snapshot)
+}
+
+var (
+	// ipv4Pattern matches IPv4 candidates; octet ranges and surrounding
+	// characters are checked separately so version strings are left alone.
+	ipv4Pattern = regexp.MustCompile(`\d{1,3}(?:\.\d{1,3}){3}`)
+
+	// ipv6Pattern matches IPv6 candidates, confirmed with net.ParseIP.
+	ipv6Pattern = regexp.MustCompile(`[0-9A-Fa-f]*:[0-9A-Fa-f:.]*:[0-9A-Fa-f.]*`)
+
+	// accountPatterns match cloud account IDs embedded in values. The first
+	// capture group is the account ID.
+	accountPatterns = []*regexp.Regexp{
+		// AWS ECR registries: 123456789012.dkr.ecr.us-west-2.amazonaws.com
+		regexp.MustCompile(`\b(\d{12})\.dkr\.ecr\.`),
+		// AWS ARNs: arn:aws:iam::123456789012:role/name
+		regexp.MustCompile(`\barn:aws[\w-]*:[\w-]*:[\w-]*:(\d{12}):`),
+		// Azure resource IDs: /subscriptions/<id>/resourceGroups/<name>
+		regexp.MustCompile(`(?i)/subscriptions/([^/\s]+)`),
+		regexp.MustCompile(`(?i)/resourceGroups/([^/\s]+)`),
+	}
+)
+
+// hostnameSysctls are OS sysctl readings that hold the node's hostname.
+var hostnameSysctls = []string{
+	"/proc/sys/kernel/hostname",
+	"/proc/sys/kernel/domainname",
+}
+
+// DefaultRedactor masks hostnames, IP addresses, cloud account IDs and values
+// matching custom patterns in snapshot metadata, readings and contexts.
+//
+// Hostnames are taken from the snapshot itself (the source node name and the
+// kernel hostname) and replaced wherever they appear as a whole token. Cloud
+// account IDs are taken from the node providerID (GCE projects, Azure
+// subscriptions and resource groups) and from ECR registries and ARNs.
+// Reading keys are redacted too, since image keys carry registry hosts.
+type DefaultRedactor struct {
+	patterns []*regexp.Regexp
+}
+
+// NewRedactor returns a DefaultRedactor that also replaces matches of the
+// given regular expressions with RedactedValue.
+func NewRedactor(patterns ...string) (*DefaultRedactor, error) {
+	r := &DefaultRedactor{}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", p, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// Redact masks sensitive values in snap in place and marks it as redacted.
+func (r *DefaultRedactor) Redact(snap *Snapshot) {
+	if snap == nil {
+		return
+	}
+
+	hosts, accounts := sensitiveLiterals(snap)
+	redact := func(s string) string {
+		return r.redactString(s, hosts, accounts)
+	}
+
+	for k, v := range snap.Metadata {
+		snap.Metadata[k] = redact(v)
+	}
+
+	for _, m := range snap.Measurements {
+		if m == nil {
+			continue
+		}
+		for i := range m.Subtypes {
+			st := &m.Subtypes[i]
+			data := make(map[string]measurement.Reading, len(st.Data))
+			for k, v := range st.Data {
+				if s, ok := v.Any().(string); ok {
+					v = measurement.Str(redact(s))
+				}
+				data[redact(k)] = v
+			}
+			st.Data = data
+			for k, v := range st.Context {
+				st.Context[k] = redact(v)
+			}
+		}
+	}
+
+	if snap.Metadata == nil {
+		snap.Metadata = make(map[string]string)
+	}
+	snap.Metadata[MetadataRedacted] = "true"
+}
+
+// Text returns a function masking, in free text such as pod logs and events,
+// the values Redact masks in snap, plus the given hostnames (e.g., the
+// cluster's node names). snap may be nil.
+func (r *DefaultRedactor) Text(snap *Snapshot, hosts ...string) func(string) string {
+	var known, accounts []string
+	if snap != nil {
+		known, accounts = sensitiveLiterals(snap)
+	}
+	for _, h := range hosts {
+		known = appendHost(known, h)
+	}
+	slices.SortStableFunc(known, byLength)
+	return func(s string) string {
+		return r.redactString(s, known, accounts)
+	}
+}
+
+// redactString applies every redaction to s. Custom patterns run first so
+// they see the original value.
+func (r *DefaultRedactor) redactString(s string, hosts, accounts []string) string {
+	for _, re := range r.patterns {
+		s = re.ReplaceAllString(s, RedactedValue)
+	}
+	for _, re := range accountPatterns {
+		s = replaceSubmatch(re, s, RedactedAccount)
+	}
+	for _, a := range accounts {
+		s = replaceToken(s, a, RedactedAccount)
+	}
+	for _, h := range hosts {
+		s = replaceToken(s, h, RedactedHostname)
+	}
+	s = redactIPv4(s)
+	return redactIPv6(s)
+}
+
+// sensitiveLiterals collects the hostnames and cloud account IDs recorded in
+// the snapshot, longest first so FQDNs are replaced before their short names.
+func sensitiveLiterals(snap *Snapshot) (hosts, accounts []string) {
+	addHost := func(h string) {
+		hosts = appendHost(hosts, h)
+	}
+
+	addHost(snap.Metadata["source-node"])
+	for _, m := range snap.Measurements {
+		if m == nil {
+			continue
+		}
+		switch m.Type {
+		case measurement.TypeK8s:
+			if st := m.GetSubtype("node"); st != nil {
+				if v, err := st.GetString("source-node"); err == nil {
+					addHost(v)
+				}
+				if v, err := st.GetString("provider-id"); err == nil {
+					for _, a := range providerAccounts(v) {
+						accounts = appendUnique(accounts, a)
+					}
+				}
+			}
+		case measurement.TypeOS:
+			if st := m.GetSubtype("sysctl"); st != nil {
+				for _, key := range hostnameSysctls {
+					if v, err := st.GetString(key); err == nil {
+						addHost(v)
+					}
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(hosts, byLength)
+	slices.SortStableFunc(accounts, byLength)
+	return hosts, accounts
+}
+
+// byLength orders strings longest first.
+func byLength(a, b string) int { return len(b) - len(a) }
+
+// appendHost adds hostname h and, for FQDNs, its short name to hosts.
+func appendHost(hosts []string, h string) []string {
+	h = strings.TrimSpace(h)
+	if h == "" || h == "(none)" || net.ParseIP(h) != nil {
+		return hosts
+	}
+	hosts = appendUnique(hosts, h)
+	if short, _, ok := strings.Cut(h, "."); ok && short != "" {
+		hosts = appendUnique(hosts, short)
+	}
+	return hosts
+}
+
+// providerAccounts extracts account identifiers from a node providerID:
+// the project of gce://<project>/<zone>/<name> and the subscription and
+// resource group of Azure resource IDs. AWS providerIDs carry no account.
+func providerAccounts(providerID string) []string {
+	scheme, rest, ok := strings.Cut(providerID, "://")
+	if !ok {
+		return nil
+	}
+
+	var accounts []string
+	if scheme == "gce" {
+		if project, _, _ := strings.Cut(rest, "/"); project != "" {
+			accounts = append(accounts, project)
+		}
+	}
+	for _, re := range accountPatterns {
+		for _, m := range re.FindAllStringSubmatch(rest, -1) {
+			accounts = append(accounts, m[1])
+		}
+	}
+	return accounts
+}
+
+// replaceSubmatch replaces the first capture group of every match of re in s.
+func replaceSubmatch(re *regexp.Regexp, s, repl string) string {
+	matches := re.FindAllStringSubmatchIndex(s, -1)
+	if matches == nil {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		b.WriteString(s[last:m[2]])
+		b.WriteString(repl)
+		last = m[3]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// replaceToken replaces occurrences of literal in s that are not part of a
+// longer name, so a node called "gpu" does not mangle "gpu-operator".
+func replaceToken(s, literal, repl string) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, literal)
+		if i < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		end := i + len(literal)
+		if isTokenBoundary(s, i-1) && isTokenBoundary(s, end) {
+			b.WriteString(s[:i])
+			b.WriteString(repl)
+		} else {
+			b.WriteString(s[:end])
+		}
+		s = s[end:]
+	}
+}
+
+// redactIPv4 replaces IPv4 addresses that are not part of a longer dotted or
+// dashed value such as "24.10-1.1.4.0".
+func redactIPv4(s string) string {
+	matches := ipv4Pattern.FindAllStringIndex(s, -1)
+	if matches == nil {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		if !isTokenBoundary(s, m[0]-1) || !isTokenBoundary(s, m[1]) || net.ParseIP(s[m[0]:m[1]]) == nil {
+			continue
+		}
+		b.WriteString(s[last:m[0]])
+		b.WriteString(RedactedIP)
+		last = m[1]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// redactIPv6 replaces IPv6 addresses. A bare "::" is left alone since it
+// appears as a separator in ARNs and similar identifiers.
+func redactIPv6(s string) string {
+	return ipv6Pattern.ReplaceAllStringFunc(s, func(candidate string) string {
+		if strings.Count(candidate, ":") < 2 || strings.Trim(candidate, ":") == "" || net.ParseIP(candidate) == nil {
+			return candidate
+		}
+		return RedactedIP
+	})
+}
+
+// isTokenBoundary reports whether position i of s is outside the string or
+// holds a character that cannot be part of a hostname or address.
+func isTokenBoundary(s string, i int) bool {
+	if i < 0 || i >= len(s) {
+		return true
+	}
+	c := s[i]
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return false
+	case c == '-', c == '_', c == '.':
+		return false
+	}
+	return true
+}
+
+func appendUnique(list []string, s string) []string {
+	if slices.Contains(list, s) {
+		return list
+	}
+	return append(list, s)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+func redactionSnapshot(providerID string) *Snapshot {
+	snap := NewSnapshot()
+	snap.Metadata = map[string]string{"source-node": "ip-10-0-1-5.ec2.internal"}
+	snap.Measurements = []*measurement.Measurement{
+		{
+			Type: measurement.TypeK8s,
+			Subtypes: []measurement.Subtype{
+				{
+					Name: "node",
+					Data: map[string]measurement.Reading{
+						"source-node": measurement.Str("ip-10-0-1-5.ec2.internal"),
+						"provider-id": measurement.Str(providerID),
+						"provider":    measurement.Str("aws"),
+					},
+				},
+				{
+					Name: "image",
+					Data: map[string]measurement.Reading{
+						"123456789012.dkr.ecr.us-west-2.amazonaws.com/gpu-operator": measurement.Str("v25.3.3"),
+					},
+				},
+			},
+		},
+		{
+			Type: measurement.TypeOS,
+			Subtypes: []measurement.Subtype{
+				{
+					Name: "sysctl",
+					Data: map[string]measurement.Reading{
+						"/proc/sys/kernel/hostname":     measurement.Str("ip-10-0-1-5"),
+						"/proc/sys/net/ipv4/ip_forward": measurement.Int(1),
+					},
+				},
+			},
+		},
+		{
+			Type: measurement.TypeNetwork,
+			Subtypes: []measurement.Subtype{
+				{
+					Name: "netdev",
+					Data: map[string]measurement.Reading{
+						"eth0.addresses": measurement.Str("10.0.1.5/24,fe80::1c2d:3eff:fe4f:5a6b"),
+						"endpoint":       measurement.Str("https://ip-10-0-1-5.ec2.internal:10250"),
+						"role":           measurement.Str("arn:aws:iam::123456789012:role/node"),
+						"ofed":           measurement.Str("MLNX_OFED_LINUX-24.10-1.1.4.0"),
+						"driver":         measurement.Str("580.82.07"),
+						"operator":       measurement.Str("gpu-operator"),
+						"ticket":         measurement.Str("CORP-4711 on rack-7"),
+					},
+					Context: map[string]string{"source": "/sys/class/net on ip-10-0-1-5"},
+				},
+			},
+		},
+	}
+	return snap
+}
+
+func TestDefaultRedactor_Redact(t *testing.T) {
+	r, err := NewRedactor(`CORP-\d+`)
+	if err != nil {
+		t.Fatalf("NewRedactor() error = %v", err)
+	}
+	snap := redactionSnapshot("aws:///us-west-2a/i-0abc123")
+	r.Redact(snap)
+
+	if got := snap.Metadata["source-node"]; got != RedactedHostname {
+		t.Errorf("metadata source-node = %q, want %q", got, RedactedHostname)
+	}
+	if snap.Metadata[MetadataRedacted] != "true" {
+		t.Error("expected redacted metadata marker")
+	}
+
+	node := snap.Measurements[0].GetSubtype("node")
+	assertReading(t, node, "source-node", RedactedHostname)
+	assertReading(t, node, "provider", "aws")
+
+	image := snap.Measurements[0].GetSubtype("image")
+	assertReading(t, image, RedactedAccount+".dkr.ecr.us-west-2.amazonaws.com/gpu-operator", "v25.3.3")
+
+	sysctl := snap.Measurements[1].GetSubtype("sysctl")
+	assertReading(t, sysctl, "/proc/sys/kernel/hostname", RedactedHostname)
+	if v := sysctl.Data["/proc/sys/net/ipv4/ip_forward"].Any(); v != 1 {
+		t.Errorf("non-string reading changed: %v", v)
+	}
+
+	netdev := snap.Measurements[2].GetSubtype("netdev")
+	assertReading(t, netdev, "eth0.addresses", RedactedIP+"/24,"+RedactedIP)
+	assertReading(t, netdev, "endpoint", "https://"+RedactedHostname+":10250")
+	assertReading(t, netdev, "role", "arn:aws:iam::"+RedactedAccount+":role/node")
+	assertReading(t, netdev, "ofed", "MLNX_OFED_LINUX-24.10-1.1.4.0")
+	assertReading(t, netdev, "driver", "580.82.07")
+	assertReading(t, netdev, "operator", "gpu-operator")
+	assertReading(t, netdev, "ticket", RedactedValue+" on rack-7")
+	if got := netdev.Context["source"]; got != "/sys/class/net on "+RedactedHostname {
+		t.Errorf("context source = %q", got)
+	}
+}
+
+func TestDefaultRedactor_ProviderAccounts(t *testing.T) {
+	tests := []struct {
+		name       string
+		providerID string
+		want       string
+	}{
+		{
+			name:       "gce project",
+			providerID: "gce://my-project/us-central1-a/gpu-node-1",
+			want:       "gce://" + RedactedAccount + "/us-central1-a/gpu-node-1",
+		},
+		{
+			name:       "azure subscription and resource group",
+			providerID: "azure:///subscriptions/0000-1111/resourceGroups/mc_rg/providers/Microsoft.Compute/virtualMachines/vm0",
+			want:       "azure:///subscriptions/" + RedactedAccount + "/resourceGroups/" + RedactedAccount + "/providers/Microsoft.Compute/virtualMachines/vm0",
+		},
+		{
+			name:       "aws",
+			providerID: "aws:///us-west-2a/i-0abc123",
+			want:       "aws:///us-west-2a/i-0abc123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRedactor()
+			if err != nil {
+				t.Fatal(err)
+			}
+			snap := redactionSnapshot(tt.providerID)
+			r.Redact(snap)
+			assertReading(t, snap.Measurements[0].GetSubtype("node"), "provider-id", tt.want)
+		})
+	}
+}
+
+func TestDefaultRedactor_Text(t *testing.T) {
+	r, err := NewRedactor(`CORP-\d+`)
+	if err != nil {
+		t.Fatalf("NewRedactor() error = %v", err)
+	}
+	redact := r.Text(redactionSnapshot("gce://my-project/us-central1-a/gpu-node-1"), "gpu-node-2.corp.example")
+
+	in := "pulled 123456789012.dkr.ecr.us-west-2.amazonaws.com/driver on ip-10-0-1-5 (10.0.1.5) " +
+		"for my-project, gpu-node-2 ready, CORP-4711, gpu-operator v25.3.3"
+	want := "pulled " + RedactedAccount + ".dkr.ecr.us-west-2.amazonaws.com/driver on " + RedactedHostname +
+		" (" + RedactedIP + ") for " + RedactedAccount + ", " + RedactedHostname + " ready, " + RedactedValue +
+		", gpu-operator v25.3.3"
+	if got := redact(in); got != want {
+		t.Errorf("Text()(%q)\n got: %q\nwant: %q", in, got, want)
+	}
+
+	if got := r.Text(nil)("node 10.0.1.5"); got != "node "+RedactedIP {
+		t.Errorf("Text(nil) = %q", got)
+	}
+}
+
+func TestNewRedactor_InvalidPattern(t *testing.T) {
+	if _, err := NewRedactor(`(`); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
+
+func TestReplaceToken(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"gpu", "X"},
+		{"gpu-operator", "gpu-operator"},
+		{"node gpu, gpu.local", "node X, gpu.local"},
+		{"https://gpu:443", "https://X:443"},
+	}
+	for _, tt := range tests {
+		if got := replaceToken(tt.in, "gpu", "X"); got != tt.want {
+			t.Errorf("replaceToken(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func assertReading(t *testing.T, st *measurement.Subtype, key, want string) {
+	t.Helper()
+	if st == nil {
+		t.Fatal("subtype not found")
+	}
+	got, err := st.GetString(key)
+	if err != nil {
+		t.Errorf("reading %q: %v (data: %v)", key, err, st.Data)
+		return
+	}
+	if got != want {
+		t.Errorf("reading %q = %q, want %q", key, got, want)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+// Snapshot metadata keys recording a restricted capture scope.
+const (
+	MetadataScopeTypes            = "scope-types"
+	MetadataScopeExcludedSubtypes = "scope-excluded-subtypes"
+)
+
+// Scope restricts the measurements captured in a snapshot. The zero value
+// captures everything.
+type Scope struct {
+	// Types are the measurement types to collect. Empty collects all types.
+	Types []measurement.Type
+
+	// ExcludeSubtypes are the subtypes dropped from the collected
+	// measurements, by measurement type.
+	ExcludeSubtypes map[measurement.Type][]string
+}
+
+// ParseScope validates measurement type and subtype names against the
+// measurement registry and returns the resulting scope. Type names are
+// case-insensitive. Subtypes are given by name ("sysctl") or qualified with
+// their type ("OS.sysctl"); SystemD and Plugin subtypes are named after
+// services and plugins, so they must be qualified.
+func ParseScope(types, excludeSubtypes []string) (*Scope, error) {
+	s := &Scope{}
+	for _, name := range types {
+		t, ok := parseTypeFold(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown measurement type %q, must be one of %s", name, typeNames())
+		}
+		if !slices.Contains(s.Types, t) {
+			s.Types = append(s.Types, t)
+		}
+	}
+
+	for _, name := range excludeSubtypes {
+		t, subtype, err := parseSubtype(name)
+		if err != nil {
+			return nil, err
+		}
+		if !s.Includes(t) {
+			return nil, fmt.Errorf("excluded subtype %q belongs to %s, which is not a selected type", name, t)
+		}
+		if s.ExcludeSubtypes == nil {
+			s.ExcludeSubtypes = make(map[measurement.Type][]string)
+		}
+		if !slices.Contains(s.ExcludeSubtypes[t], subtype) {
+			s.ExcludeSubtypes[t] = append(s.ExcludeSubtypes[t], subtype)
+		}
+	}
+	return s, nil
+}
+
+// parseSubtype resolves a bare or type-qualified subtype name.
+func parseSubtype(name string) (measurement.Type, string, error) {
+	if prefix, subtype, ok := strings.Cut(name, "."); ok {
+		if t, ok := parseTypeFold(prefix); ok {
+			if subtype == "" {
+				return "", "", fmt.Errorf("invalid subtype %q: missing subtype name", name)
+			}
+			known, registered := measurement.Subtypes[t]
+			if registered && !slices.Contains(known, subtype) {
+				return "", "", fmt.Errorf("unknown %s subtype %q, must be one of %s", t, subtype, strings.Join(known, ", "))
+			}
+			return t, subtype, nil
+		}
+	}
+
+	for _, t := range measurement.Types {
+		if slices.Contains(measurement.Subtypes[t], name) {
+			return t, name, nil
+		}
+	}
+	return "", "", fmt.Errorf("unknown subtype %q, use a built-in subtype name or qualify it with its type (e.g. SystemD.containerd.service)", name)
+}
+
+// parseTypeFold parses a measurement type name, ignoring case.
+func parseTypeFold(name string) (measurement.Type, bool) {
+	for _, t := range measurement.Types {
+		if strings.EqualFold(string(t), name) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// typeNames returns the supported measurement types as a comma-separated list.
+func typeNames() string {
+	names := make([]string, 0, len(measurement.Types))
+	for _, t := range measurement.Types {
+		names = append(names, string(t))
+	}
+	return strings.Join(names, ", ")
+}
+
+// IsEmpty reports whether the scope captures everything.
+func (s *Scope) IsEmpty() bool {
+	return s == nil || (len(s.Types) == 0 && len(s.ExcludeSubtypes) == 0)
+}
+
+// Includes reports whether measurements of type t are collected. A nil
+// scope includes every type.
+func (s *Scope) Includes(t measurement.Type) bool {
+	return s == nil || len(s.Types) == 0 || slices.Contains(s.Types, t)
+}
+
+// TypeNames returns the selected measurement types, empty when all types
+// are collected.
+func (s *Scope) TypeNames() []string {
+	if s == nil {
+		return nil
+	}
+	names := make([]string, 0, len(s.Types))
+	for _, t := range s.Types {
+		names = append(names, string(t))
+	}
+	return names
+}
+
+// ExcludedSubtypeNames returns the excluded subtypes qualified with their
+// type, in measurement type order.
+func (s *Scope) ExcludedSubtypeNames() []string {
+	if s == nil {
+		return nil
+	}
+	var names []string
+	for _, t := range measurement.Types {
+		for _, subtype := range s.ExcludeSubtypes[t] {
+			names = append(names, string(t)+"."+subtype)
+		}
+	}
+	return names
+}
+
+// Apply drops the measurements and subtypes outside the scope from snap
+// and records the scope in its metadata.
+func (s *Scope) Apply(snap *Snapshot) {
+	if s.IsEmpty() {
+		return
+	}
+
+	measurements := snap.Measurements[:0]
+	for _, m := range snap.Measurements {
+		if m == nil || !s.Includes(m.Type) {
+			continue
+		}
+		if excluded := s.ExcludeSubtypes[m.Type]; len(excluded) > 0 {
+			m.Subtypes = slices.DeleteFunc(m.Subtypes, func(st measurement.Subtype) bool {
+				return slices.Contains(excluded, st.Name)
+			})
+		}
+		measurements = append(measurements, m)
+	}
+	snap.Measurements = measurements
+
+	if snap.Metadata == nil {
+		snap.Metadata = make(map[string]string)
+	}
+	if len(s.Types) > 0 {
+		snap.Metadata[MetadataScopeTypes] = strings.Join(s.TypeNames(), ",")
+	}
+	if excluded := s.ExcludedSubtypeNames(); len(excluded) > 0 {
+		snap.Metadata[MetadataScopeExcludedSubtypes] = strings.Join(excluded, ",")
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+)
+
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		name         string
+		types        []string
+		excludes     []string
+		wantTypes    []string
+		wantExcluded []string
+		wantErr      bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:         "types and bare subtype",
+			types:        []string{"OS", "GPU"},
+			excludes:     []string{"sysctl"},
+			wantTypes:    []string{"OS", "GPU"},
+			wantExcluded: []string{"OS.sysctl"},
+		},
+		{
+			name:      "case-insensitive types",
+			types:     []string{"os", "gpu", "OS"},
+			wantTypes: []string{"OS", "GPU"},
+		},
+		{
+			name:         "qualified subtypes",
+			excludes:     []string{"SystemD.containerd.service", "k8s.image", "K8s.image"},
+			wantExcluded: []string{"K8s.image", "SystemD.containerd.service"},
+		},
+		{
+			name:    "unknown type",
+			types:   []string{"Storage"},
+			wantErr: true,
+		},
+		{
+			name:     "unknown subtype",
+			excludes: []string{"containerd.service"},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown qualified subtype",
+			excludes: []string{"OS.sysctls"},
+			wantErr:  true,
+		},
+		{
+			name:     "empty qualified subtype",
+			excludes: []string{"Plugin."},
+			wantErr:  true,
+		},
+		{
+			name:     "subtype outside selected types",
+			types:    []string{"GPU"},
+			excludes: []string{"sysctl"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := ParseScope(tt.types, tt.excludes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := scope.TypeNames(); !slices.Equal(got, tt.wantTypes) {
+				t.Errorf("TypeNames() = %v, want %v", got, tt.wantTypes)
+			}
+			if got := scope.ExcludedSubtypeNames(); !slices.Equal(got, tt.wantExcluded) {
+				t.Errorf("ExcludedSubtypeNames() = %v, want %v", got, tt.wantExcluded)
+			}
+		})
+	}
+}
+
+func TestScope_Apply(t *testing.T) {
+	newSnapshot := func() *Snapshot {
+		snap := NewSnapshot()
+		snap.Metadata = map[string]string{"source-node": "node-1"}
+		snap.Measurements = []*measurement.Measurement{
+			{Type: measurement.TypeK8s, Subtypes: []measurement.Subtype{{Name: "server"}}},
+			{Type: measurement.TypeOS, Subtypes: []measurement.Subtype{{Name: "grub"}, {Name: "sysctl"}}},
+		}
+		return snap
+	}
+
+	t.Run("nil scope keeps everything", func(t *testing.T) {
+		snap := newSnapshot()
+		var scope *Scope
+		scope.Apply(snap)
+		if len(snap.Measurements) != 2 || len(snap.Metadata) != 1 {
+			t.Errorf("snapshot changed: %d measurements, metadata %v", len(snap.Measurements), snap.Metadata)
+		}
+	})
+
+	t.Run("excludes subtypes", func(t *testing.T) {
+		snap := newSnapshot()
+		scope := &Scope{ExcludeSubtypes: map[measurement.Type][]string{measurement.TypeOS: {"sysctl"}}}
+		scope.Apply(snap)
+		if len(snap.Measurements) != 2 {
+			t.Fatalf("got %d measurements, want 2", len(snap.Measurements))
+		}
+		if m := snap.Measurements[1]; len(m.Subtypes) != 1 || m.Subtypes[0].Name != "grub" {
+			t.Errorf("OS subtypes = %v, want [grub]", m.Subtypes)
+		}
+		if _, ok := snap.Metadata[MetadataScopeTypes]; ok {
+			t.Errorf("metadata %s set without type selection", MetadataScopeTypes)
+		}
+		if got := snap.Metadata[MetadataScopeExcludedSubtypes]; got != "OS.sysctl" {
+			t.Errorf("metadata %s = %q, want OS.sysctl", MetadataScopeExcludedSubtypes, got)
+		}
+	})
+
+	t.Run("drops unselected types", func(t *testing.T) {
+		snap := newSnapshot()
+		scope := &Scope{Types: []measurement.Type{measurement.TypeK8s}}
+		scope.Apply(snap)
+		if len(snap.Measurements) != 1 || snap.Measurements[0].Type != measurement.TypeK8s {
+			t.Errorf("measurements = %v, want only K8s", snap.Measurements)
+		}
+		if got := snap.Metadata[MetadataScopeTypes]; got != "K8s" {
+			t.Errorf("metadata %s = %q, want K8s", MetadataScopeTypes, got)
+		}
+	})
+}
@@ -32,7 +32,7 @@ import (
 
 // NodeSnapshotter collects system configuration measurements from the current node.
 // It coordinates multiple collectors in parallel to gather data about Kubernetes,
-// GPU hardware, OS configuration, and systemd services, then serializes the results.
+// GPU hardware, OS configuration, systemd services, and networking, then serializes the results.
 // If AgentConfig is provided with Enabled=true, it deploys a Kubernetes Job instead.
 type NodeSnapshotter struct {
 	// Version is the snapshotter version.
@@ -46,6 +46,12 @@ type NodeSnapshotter struct {
 
 	// AgentConfig contains configuration for agent deployment mode. If nil or Enabled=false, runs locally.
 	AgentConfig *AgentConfig
+
+	// Redactor masks sensitive values before the snapshot is serialized. If nil, nothing is redacted.
+	Redactor Redactor
+
+	// Scope restricts the collected measurement types and subtypes. If nil, everything is collected.
+	Scope *Scope
 }
 
 // Measure collects configuration measurements and serializes the snapshot.
@@ -86,10 +92,14 @@ func (n *NodeSnapshotter) measure(ctx context.Context) error {
 	// operations that use rate-limited K8s clients.
 	g, gctx := errgroup.WithContext(ctx)
 
+	// Node-level collectors need Linux; on other hosts they are skipped with
+	// a warning rather than failing the whole snapshot.
+	skipped := unsupportedTypes(n.Scope)
+
 	// Initialize snapshot structure
 	snap := NewSnapshot()
-	// Pre-allocate measurements slice with capacity for 5 collectors
-	snap.Measurements = make([]*measurement.Measurement, 0, 5)
+	// Pre-allocate measurements slice with capacity for 9 collectors
+	snap.Measurements = make([]*measurement.Measurement, 0, 9)
 
 	// Collect metadata
 	g.Go(func() error {
@@ -108,6 +118,9 @@ func (n *NodeSnapshotter) measure(ctx context.Context) error {
 
 	// Collect Kubernetes configuration
 	g.Go(func() error {
+		if !n.Scope.Includes(measurement.TypeK8s) {
+			return nil
+		}
 		collectorStart := time.Now()
 		defer func() {
 			snapshotCollectorDuration.WithLabelValues("k8s").Observe(time.Since(collectorStart).Seconds())
@@ -127,6 +140,9 @@ func (n *NodeSnapshotter) measure(ctx context.Context) error {
 
 	// Collect SystemD services
 	g.Go(func() error {
+		if !collects(n.Scope, skipped, measurement.TypeSystemD) {
+			return nil
+		}
 		collectorStart := time.Now()
 		defer func() {
 			snapshotCollectorDuration.WithLabelValues("systemd").Observe(time.Since(collectorStart).Seconds())
@@ -146,6 +162,9 @@ func (n *NodeSnapshotter) measure(ctx context.Context) error {
 
 	// Collect OS
 	g.Go(func() error {
+		if !collects(n.Scope, skipped, measurement.TypeOS) {
+			return nil
+		}
 		collectorStart := time.Now()
 		defer func() {
 			snapshotCollectorDuration.WithLabelValues("os").Observe(time.Since(collectorStart).Seconds())
@@ -165,6 +184,9 @@ func (n *NodeSnapshotter) measure(ctx context.Context) error {
 
 	// Collect GPU
 	g.Go(func() error {
+		if !collects(n.Scope, skipped, measurement.TypeGPU) {
+			return nil
+		}
 		collectorStart := time.Now()
 		defer func() {
 			snapshotCollectorDuration.WithLabelValues("gpu").Observe(time.Since(collectorStart).Seconds())
@@ -182,17 +204,118 @@ func (n *NodeSnapshotter) measure(ctx context.Context) error {
 		return nil
 	})
 
+	// Collect network
+	g.Go(func() error {
+		if !collects(n.Scope, skipped, measurement.TypeNetwork) {
+			return nil
+		}
+		collectorStart := time.Now()
+		defer func() {
+			snapshotCollectorDuration.WithLabelValues("network").Observe(time.Since(collectorStart).Seconds())
+		}()
+		slog.Debug("collecting network configuration")
+		nc := n.Factory.CreateNetworkCollector()
+		net, err := nc.Collect(gctx)
+		if err != nil {
+			slog.Error("failed to collect network", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to collect network info: %w", err)
+		}
+		mu.Lock()
+		snap.Measurements = append(snap.Measurements, net)
+		mu.Unlock()
+		return nil
+	})
+
+	// Collect CPU
+	g.Go(func() error {
+		if !collects(n.Scope, skipped, measurement.TypeCPU) {
+			return nil
+		}
+		collectorStart := time.Now()
+		defer func() {
+			snapshotCollectorDuration.WithLabelValues("cpu").Observe(time.Since(collectorStart).Seconds())
+		}()
+		slog.Debug("collecting CPU configuration")
+		cc := n.Factory.CreateCPUCollector()
+		cpu, err := cc.Collect(gctx)
+		if err != nil {
+			slog.Error("failed to collect CPU", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to collect CPU info: %w", err)
+		}
+		mu.Lock()
+		snap.Measurements = append(snap.Measurements, cpu)
+		mu.Unlock()
+		return nil
+	})
+
+	// Collect container runtime
+	g.Go(func() error {
+		if !collects(n.Scope, skipped, measurement.TypeRuntime) {
+			return nil
+		}
+		collectorStart := time.Now()
+		defer func() {
+			snapshotCollectorDuration.WithLabelValues("runtime").Observe(time.Since(collectorStart).Seconds())
+		}()
+		slog.Debug("collecting container runtime configuration")
+		rc := n.Factory.CreateRuntimeCollector()
+		rt, err := rc.Collect(gctx)
+		if err != nil {
+			slog.Error("failed to collect container runtime", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to collect container runtime info: %w", err)
+		}
+		mu.Lock()
+		snap.Measurements = append(snap.Measurements, rt)
+		mu.Unlock()
+		return nil
+	})
+
+	// Collect plugin measurements. Plugin failures are logged by the
+	// collector; it returns no measurement when no plugin produced data.
+	g.Go(func() error {
+		if !n.Scope.Includes(measurement.TypePlugin) {
+			return nil
+		}
+		collectorStart := time.Now()
+		defer func() {
+			snapshotCollectorDuration.WithLabelValues("plugin").Observe(time.Since(collectorStart).Seconds())
+		}()
+		pc := n.Factory.CreatePluginCollector()
+		plugins, err := pc.Collect(gctx)
+		if err != nil {
+			slog.Error("failed to collect plugins", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to collect plugin measurements: %w", err)
+		}
+		if plugins == nil {
+			return nil
+		}
+		mu.Lock()
+		snap.Measurements = append(snap.Measurements, plugins)
+		mu.Unlock()
+		return nil
+	})
+
 	// Wait for all collectors to complete
 	if err := g.Wait(); err != nil {
 		snapshotCollectionTotal.WithLabelValues("error").Inc()
 		return err
 	}
 
+	n.Scope.Apply(snap)
+	if len(skipped) > 0 {
+		snap.Metadata[MetadataSkippedTypes] = joinTypes(skipped)
+	}
+
 	snapshotCollectionTotal.WithLabelValues("success").Inc()
 	snapshotMeasurementCount.Set(float64(len(snap.Measurements)))
 
 	slog.Debug("snapshot collection complete", slog.Int("total_configs", len(snap.Measurements)))
 
+	if n.Redactor != nil {
+		n.Redactor.Redact(snap)
+		slog.Debug("redacted sensitive values from snapshot")
+	}
+
 	// Serialize output
 	if n.Serializer == nil {
 		n.Serializer = serializer.NewStdoutWriter(serializer.FormatJSON)
@@ -17,6 +17,7 @@ package snapshotter
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/NVIDIA/eidos/pkg/collector"
@@ -90,6 +91,125 @@ func TestNodeSnapshotter_Measure(t *testing.T) {
 		if !factory.osCalled {
 			t.Error("OS collector not called")
 		}
+
+		if !factory.pluginCalled {
+			t.Error("plugin collector not called")
+		}
+	})
+
+	t.Run("skips missing plugin measurement", func(t *testing.T) {
+		ser := &mockSerializer{}
+		snapshotter := &NodeSnapshotter{
+			Version:    "1.0.0",
+			Factory:    &mockFactory{noPlugins: true},
+			Serializer: ser,
+		}
+
+		if err := snapshotter.Measure(context.Background()); err != nil {
+			t.Fatalf("Measure() error = %v, want nil", err)
+		}
+
+		snap, ok := ser.data.(*Snapshot)
+		if !ok {
+			t.Fatalf("serialized %T, want *Snapshot", ser.data)
+		}
+		for _, m := range snap.Measurements {
+			if m == nil {
+				t.Fatal("snapshot contains a nil measurement")
+			}
+		}
+		if len(snap.Measurements) != 7 {
+			t.Errorf("got %d measurements, want 7", len(snap.Measurements))
+		}
+	})
+
+	t.Run("restricts collection to scope", func(t *testing.T) {
+		ser := &mockSerializer{}
+		factory := &mockFactory{}
+		snapshotter := &NodeSnapshotter{
+			Version:    "1.0.0",
+			Factory:    factory,
+			Serializer: ser,
+			Scope: &Scope{
+				Types:           []measurement.Type{measurement.TypeOS, measurement.TypeGPU},
+				ExcludeSubtypes: map[measurement.Type][]string{measurement.TypeOS: {"sysctl"}},
+			},
+		}
+
+		if err := snapshotter.Measure(context.Background()); err != nil {
+			t.Fatalf("Measure() error = %v, want nil", err)
+		}
+		if factory.k8sCalled || factory.systemdCalled || factory.networkCalled || factory.cpuCalled || factory.runtimeCalled || factory.pluginCalled {
+			t.Error("collector outside the scope was called")
+		}
+
+		snap := ser.data.(*Snapshot)
+		if len(snap.Measurements) != 2 {
+			t.Fatalf("got %d measurements, want 2", len(snap.Measurements))
+		}
+		for _, m := range snap.Measurements {
+			want := 2
+			if m.Type == measurement.TypeOS {
+				want = 1
+			}
+			if len(m.Subtypes) != want {
+				t.Errorf("%s has %d subtypes, want %d", m.Type, len(m.Subtypes), want)
+			}
+		}
+		if got := snap.Metadata[MetadataScopeTypes]; got != "OS,GPU" {
+			t.Errorf("metadata %s = %q, want OS,GPU", MetadataScopeTypes, got)
+		}
+		if got := snap.Metadata[MetadataScopeExcludedSubtypes]; got != "OS.sysctl" {
+			t.Errorf("metadata %s = %q, want OS.sysctl", MetadataScopeExcludedSubtypes, got)
+		}
+	})
+
+	t.Run("skips node collectors on non-Linux hosts", func(t *testing.T) {
+		orig := hostOS
+		t.Cleanup(func() { hostOS = orig })
+		hostOS = "windows"
+
+		ser := &mockSerializer{}
+		factory := &mockFactory{}
+		snapshotter := &NodeSnapshotter{
+			Version:    "1.0.0",
+			Factory:    factory,
+			Serializer: ser,
+		}
+
+		if err := snapshotter.Measure(context.Background()); err != nil {
+			t.Fatalf("Measure() error = %v, want nil", err)
+		}
+		if factory.systemdCalled || factory.osCalled || factory.gpuCalled || factory.networkCalled || factory.cpuCalled || factory.runtimeCalled {
+			t.Error("Linux-only collector was called")
+		}
+		if !factory.k8sCalled || !factory.pluginCalled {
+			t.Error("Kubernetes and plugin collectors should still run")
+		}
+
+		snap := ser.data.(*Snapshot)
+		if len(snap.Measurements) != 2 {
+			t.Errorf("got %d measurements, want 2", len(snap.Measurements))
+		}
+		if got, want := snap.Metadata[MetadataSkippedTypes], "SystemD,OS,GPU,Network,CPU,Runtime"; got != want {
+			t.Errorf("metadata %s = %q, want %q", MetadataSkippedTypes, got, want)
+		}
+	})
+
+	t.Run("records only skipped types in scope", func(t *testing.T) {
+		orig := hostOS
+		t.Cleanup(func() { hostOS = orig })
+		hostOS = "darwin"
+
+		got := unsupportedTypes(&Scope{Types: []measurement.Type{measurement.TypeK8s, measurement.TypeGPU}})
+		if !slices.Equal(got, []measurement.Type{measurement.TypeGPU}) {
+			t.Errorf("unsupportedTypes() = %v, want [GPU]", got)
+		}
+
+		hostOS = "linux"
+		if got := unsupportedTypes(nil); got != nil {
+			t.Errorf("unsupportedTypes() on linux = %v, want nil", got)
+		}
 	})
 
 	t.Run("handles collector errors", func(t *testing.T) {
@@ -253,43 +373,79 @@ type mockFactory struct {
 	systemdCalled bool
 	osCalled      bool
 	gpuCalled     bool
+	networkCalled bool
+	cpuCalled     bool
+	runtimeCalled bool
+	pluginCalled  bool
 
 	k8sError     error
 	systemdError error
 	osError      error
 	gpuError     error
+	networkError error
+
+	// noPlugins makes the plugin collector return no measurement.
+	noPlugins bool
 }
 
 func (m *mockFactory) CreateKubernetesCollector() collector.Collector {
 	m.k8sCalled = true
-	return &mockCollector{err: m.k8sError}
+	return &mockCollector{typ: measurement.TypeK8s, err: m.k8sError}
 }
 
 func (m *mockFactory) CreateSystemDCollector() collector.Collector {
 	m.systemdCalled = true
-	return &mockCollector{err: m.systemdError}
+	return &mockCollector{typ: measurement.TypeSystemD, err: m.systemdError}
 }
 
 func (m *mockFactory) CreateOSCollector() collector.Collector {
 	m.osCalled = true
-	return &mockCollector{err: m.osError}
+	return &mockCollector{typ: measurement.TypeOS, err: m.osError}
 }
 
 func (m *mockFactory) CreateGPUCollector() collector.Collector {
 	m.gpuCalled = true
-	return &mockCollector{err: m.gpuError}
+	return &mockCollector{typ: measurement.TypeGPU, err: m.gpuError}
+}
+
+func (m *mockFactory) CreateNetworkCollector() collector.Collector {
+	m.networkCalled = true
+	return &mockCollector{typ: measurement.TypeNetwork, err: m.networkError}
+}
+
+func (m *mockFactory) CreateCPUCollector() collector.Collector {
+	m.cpuCalled = true
+	return &mockCollector{typ: measurement.TypeCPU}
+}
+
+func (m *mockFactory) CreateRuntimeCollector() collector.Collector {
+	m.runtimeCalled = true
+	return &mockCollector{typ: measurement.TypeRuntime}
+}
+
+func (m *mockFactory) CreatePluginCollector() collector.Collector {
+	m.pluginCalled = true
+	return &mockCollector{typ: measurement.TypePlugin, empty: m.noPlugins}
 }
 
 type mockCollector struct {
-	err error
+	typ   measurement.Type
+	err   error
+	empty bool
 }
 
 func (m *mockCollector) Collect(ctx context.Context) (*measurement.Measurement, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
+	if m.empty {
+		return nil, nil
+	}
 	return &measurement.Measurement{
-		Type:     measurement.TypeK8s,
-		Subtypes: []measurement.Subtype{},
+		Type: m.typ,
+		Subtypes: []measurement.Subtype{
+			{Name: "sysctl", Data: map[string]measurement.Reading{}},
+			{Name: "grub", Data: map[string]measurement.Reading{}},
+		},
 	}, nil
 }
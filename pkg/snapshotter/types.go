@@ -28,10 +28,12 @@ type Snapshotter interface {
 	Measure(ctx context.Context) error
 }
 
-// NewSnapshot creates a new Snapshot instance with an initialized Measurements slice.
+// NewSnapshot creates a new Snapshot instance at the current SchemaVersion
+// with an initialized Measurements slice.
 func NewSnapshot() *Snapshot {
 	return &Snapshot{
-		Measurements: make([]*measurement.Measurement, 0),
+		SchemaVersion: SchemaVersion,
+		Measurements:  make([]*measurement.Measurement, 0),
 	}
 }
 
@@ -41,6 +43,15 @@ func NewSnapshot() *Snapshot {
 type Snapshot struct {
 	header.Header `json:",inline" yaml:",inline"`
 
+	// SchemaVersion is the version of the snapshot document layout. Older
+	// documents are migrated to SchemaVersion when decoded.
+	SchemaVersion int `json:"schemaVersion,omitempty" yaml:"schemaVersion,omitempty"`
+
 	// Measurements contains the collected measurements from various collectors.
 	Measurements []*measurement.Measurement `json:"measurements" yaml:"measurements"`
 }
+
+// GetMeasurements returns the snapshot's measurements.
+func (s *Snapshot) GetMeasurements() []*measurement.Measurement {
+	return s.Measurements
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/header"
+)
+
+const (
+	// APIVersion is the API version of the support bundle index.
+	APIVersion = "eidos.nvidia.com/v1alpha1"
+
+	// IndexFileName is the archive file listing the bundle contents.
+	IndexFileName = "index.yaml"
+)
+
+// Index lists the files of a support bundle and what could not be collected.
+type Index struct {
+	header.Header `json:",inline" yaml:",inline"`
+
+	// Redacted reports whether the bundle contents were redacted.
+	Redacted bool `json:"redacted" yaml:"redacted"`
+
+	// Files are the bundle files in the order they were added.
+	Files []File `json:"files" yaml:"files"`
+
+	// Errors are the items that could not be collected.
+	Errors []Problem `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// File is a file in a support bundle.
+type File struct {
+	// Path is the file path inside the bundle directory.
+	Path string `json:"path" yaml:"path"`
+
+	// Description says what the file holds.
+	Description string `json:"description" yaml:"description"`
+
+	// Size is the file size in bytes.
+	Size int64 `json:"size" yaml:"size"`
+}
+
+// Problem is an item that could not be collected.
+type Problem struct {
+	// Item names what was being collected (e.g., "snapshot").
+	Item string `json:"item" yaml:"item"`
+
+	// Error is the reason it could not be collected.
+	Error string `json:"error" yaml:"error"`
+}
+
+// Bundle accumulates the files of a support bundle before they are
+// archived. Collection is best effort: items that cannot be collected are
+// recorded in the index with AddError instead of failing the bundle.
+type Bundle struct {
+	index     Index
+	contents  map[string][]byte
+	positions map[string]int
+	redact    func(string) string
+}
+
+// NewBundle creates an empty support bundle.
+func NewBundle(version string) *Bundle {
+	b := &Bundle{
+		contents:  make(map[string][]byte),
+		positions: make(map[string]int),
+	}
+	b.index.Init(header.KindSupportBundle, APIVersion, version)
+	return b
+}
+
+// SetRedactor sets the function every file added afterwards is passed
+// through. Recorded errors are redacted when the bundle is written, so
+// errors recorded before the redactor is known are covered too.
+func (b *Bundle) SetRedactor(redact func(string) string) {
+	b.redact = redact
+	b.index.Redacted = redact != nil
+}
+
+// Add adds a file to the bundle, replacing any file at the same path.
+func (b *Bundle) Add(filePath, description string, content []byte) {
+	if b.redact != nil {
+		content = []byte(b.redact(string(content)))
+	}
+	file := File{Path: filePath, Description: description, Size: int64(len(content))}
+	if i, exists := b.positions[filePath]; exists {
+		b.index.Files[i] = file
+	} else {
+		b.positions[filePath] = len(b.index.Files)
+		b.index.Files = append(b.index.Files, file)
+	}
+	b.contents[filePath] = content
+}
+
+// AddYAML adds v serialized as YAML to the bundle.
+func (b *Bundle) AddYAML(filePath, description string, v any) error {
+	content, err := yaml.Marshal(v)
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, fmt.Sprintf("failed to serialize %s", filePath), err)
+	}
+	b.Add(filePath, description, content)
+	return nil
+}
+
+// AddError records that item could not be collected.
+func (b *Bundle) AddError(item string, err error) {
+	b.index.Errors = append(b.index.Errors, Problem{Item: item, Error: err.Error()})
+}
+
+// Index returns the bundle index.
+func (b *Bundle) Index() *Index {
+	return &b.index
+}
+
+// Write writes the bundle as a gzipped tar archive to archivePath. The
+// files are placed in a directory named after the archive, with index.yaml
+// first.
+func (b *Bundle) Write(archivePath string) error {
+	index := b.index
+	if b.redact != nil {
+		index.Errors = make([]Problem, len(b.index.Errors))
+		for i, p := range b.index.Errors {
+			index.Errors[i] = Problem{Item: b.redact(p.Item), Error: b.redact(p.Error)}
+		}
+	}
+	indexContent, err := yaml.Marshal(&index)
+	if err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to serialize support bundle index", err)
+	}
+
+	root := archiveRoot(archivePath)
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	modTime := time.Now()
+
+	write := func(name string, content []byte) error {
+		hdr := &tar.Header{
+			Name:    path.Join(root, name),
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(content)
+		return err
+	}
+
+	if err := write(IndexFileName, indexContent); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to archive support bundle index", err)
+	}
+	for _, f := range b.index.Files {
+		if err := write(f.Path, b.contents[f.Path]); err != nil {
+			return errors.Wrap(errors.ErrCodeInternal, fmt.Sprintf("failed to archive %s", f.Path), err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to finish support bundle archive", err)
+	}
+	if err := gz.Close(); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, "failed to compress support bundle archive", err)
+	}
+
+	if err := os.WriteFile(archivePath, buf.Bytes(), 0600); err != nil {
+		return errors.Wrap(errors.ErrCodeInternal, fmt.Sprintf("failed to write %s", archivePath), err)
+	}
+	return nil
+}
+
+// archiveRoot returns the directory name the archive contents are placed
+// in: the archive file name without its extension.
+func archiveRoot(archivePath string) string {
+	name := filepath.Base(archivePath)
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar"} {
+		if trimmed, ok := strings.CutSuffix(name, ext); ok {
+			return trimmed
+		}
+	}
+	return name
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/eidos/pkg/header"
+)
+
+// readArchive returns the contents of a support bundle archive by entry name.
+func readArchive(t *testing.T, archivePath string) map[string]string {
+	t.Helper()
+	f, err := os.Open(archivePath)
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("failed to read gzip: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	entries := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries
+		}
+		if err != nil {
+			t.Fatalf("failed to read tar: %v", err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", hdr.Name, err)
+		}
+		entries[hdr.Name] = string(content)
+	}
+}
+
+func TestBundle_Write(t *testing.T) {
+	redact := func(s string) string { return strings.ReplaceAll(s, "secret-host", "REDACTED-HOSTNAME") }
+	b := NewBundle("v1.0.0")
+	b.AddError("snapshot", errors.New("agent on secret-host timed out"))
+	b.SetRedactor(redact)
+	b.Add("logs/gpu-operator/pod/driver.log", "Driver logs", []byte("connected to secret-host\n"))
+	if err := b.AddYAML("recipe.yaml", "Recipe", map[string]string{"kind": "RecipeResult"}); err != nil {
+		t.Fatalf("AddYAML() error = %v", err)
+	}
+	b.Add("logs/gpu-operator/pod/driver.log", "Driver logs", []byte("reconnected\n"))
+
+	archivePath := filepath.Join(t.TempDir(), "eidos-support-20250101.tar.gz")
+	if err := b.Write(archivePath); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	entries := readArchive(t, archivePath)
+	if len(entries) != 3 {
+		t.Errorf("archive has %d entries, want 3: %v", len(entries), entries)
+	}
+	if got := entries["eidos-support-20250101/logs/gpu-operator/pod/driver.log"]; got != "reconnected\n" {
+		t.Errorf("driver.log = %q, want replaced content", got)
+	}
+
+	var index Index
+	if err := yaml.Unmarshal([]byte(entries["eidos-support-20250101/index.yaml"]), &index); err != nil {
+		t.Fatalf("failed to parse index: %v", err)
+	}
+	if index.Kind != header.KindSupportBundle || !index.Redacted {
+		t.Errorf("index kind = %q, redacted = %v", index.Kind, index.Redacted)
+	}
+	if len(index.Files) != 2 || index.Files[0].Path != "logs/gpu-operator/pod/driver.log" || index.Files[0].Size != 12 {
+		t.Errorf("index files = %+v", index.Files)
+	}
+	if len(index.Errors) != 1 || index.Errors[0].Error != "agent on REDACTED-HOSTNAME timed out" {
+		t.Errorf("index errors = %+v, want redacted snapshot error", index.Errors)
+	}
+}
+
+func TestArchiveRoot(t *testing.T) {
+	tests := map[string]string{
+		"/tmp/eidos-support.tar.gz": "eidos-support",
+		"case-123.tgz":              "case-123",
+		"bundle":                    "bundle",
+	}
+	for in, want := range tests {
+		if got := archiveRoot(in); got != want {
+			t.Errorf("archiveRoot(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultTailLines is the number of log lines kept per container.
+	DefaultTailLines int64 = 1000
+
+	// maxLogBytes bounds the logs kept per container.
+	maxLogBytes int64 = 10 << 20
+)
+
+// DefaultNamespaces are the namespaces logs and events are collected from:
+// the Helm umbrella release namespace and the component namespaces of the
+// other deployers.
+var DefaultNamespaces = []string{
+	"eidos-stack",
+	"gpu-operator",
+	"nvidia-network-operator",
+	"cert-manager",
+	"nvidia-system",
+}
+
+// Collector gathers operator pod logs and events from a live cluster into a
+// support bundle.
+type Collector struct {
+	// Namespaces are the namespaces collected from. Missing namespaces are
+	// skipped.
+	Namespaces []string
+
+	// TailLines is the number of log lines kept per container.
+	TailLines int64
+
+	client kubernetes.Interface
+}
+
+// Option is a functional option for configuring Collector instances.
+type Option func(*Collector)
+
+// WithNamespaces returns an Option that sets the namespaces collected from.
+// An empty list keeps DefaultNamespaces.
+func WithNamespaces(namespaces []string) Option {
+	return func(c *Collector) {
+		if len(namespaces) > 0 {
+			c.Namespaces = namespaces
+		}
+	}
+}
+
+// WithTailLines returns an Option that sets the log lines kept per
+// container. Zero or less keeps DefaultTailLines.
+func WithTailLines(lines int64) Option {
+	return func(c *Collector) {
+		if lines > 0 {
+			c.TailLines = lines
+		}
+	}
+}
+
+// NewCollector creates a new Collector using the given client.
+func NewCollector(client kubernetes.Interface, opts ...Option) *Collector {
+	c := &Collector{
+		Namespaces: DefaultNamespaces,
+		TailLines:  DefaultTailLines,
+		client:     client,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NodeNames returns the names of the cluster nodes, so they can be redacted
+// from logs and events.
+func (c *Collector) NodeNames(ctx context.Context) ([]string, error) {
+	nodes, err := c.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	names := make([]string, 0, len(nodes.Items))
+	for _, n := range nodes.Items {
+		names = append(names, n.Name)
+	}
+	return names, nil
+}
+
+// Collect adds the pod logs and events of every namespace to b. Logs are
+// written to logs/<namespace>/<pod>/<container>.log, with the logs of the
+// previous run of restarted containers next to them as
+// <container>.previous.log, and events to events/<namespace>.log. Failures
+// are recorded in the bundle index.
+func (c *Collector) Collect(ctx context.Context, b *Bundle) {
+	for _, ns := range c.Namespaces {
+		if err := ctx.Err(); err != nil {
+			b.AddError("namespace "+ns, err)
+			return
+		}
+		pods, err := c.client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				b.AddError("pods in "+ns, err)
+			}
+			continue
+		}
+		if len(pods.Items) == 0 {
+			slog.Debug("no pods in namespace, skipping", "namespace", ns)
+			continue
+		}
+		for _, pod := range pods.Items {
+			c.collectPodLogs(ctx, b, &pod)
+		}
+		c.collectEvents(ctx, b, ns)
+	}
+}
+
+// collectPodLogs adds the logs of every container of pod to b.
+func (c *Collector) collectPodLogs(ctx context.Context, b *Bundle, pod *corev1.Pod) {
+	restarts := make(map[string]int32)
+	for _, st := range append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...) {
+		restarts[st.Name] = st.RestartCount
+	}
+
+	containers := append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...)
+	for _, container := range containers {
+		dir := path.Join("logs", pod.Namespace, pod.Name)
+		item := fmt.Sprintf("logs of %s/%s container %s", pod.Namespace, pod.Name, container.Name)
+
+		logs, err := c.podLogs(ctx, pod, container.Name, false)
+		if err != nil {
+			b.AddError(item, err)
+		} else {
+			b.Add(path.Join(dir, container.Name+".log"),
+				fmt.Sprintf("Logs of container %s of pod %s/%s", container.Name, pod.Namespace, pod.Name), logs)
+		}
+
+		if restarts[container.Name] == 0 {
+			continue
+		}
+		previous, err := c.podLogs(ctx, pod, container.Name, true)
+		if err != nil {
+			b.AddError("previous "+item, err)
+			continue
+		}
+		b.Add(path.Join(dir, container.Name+".previous.log"),
+			fmt.Sprintf("Logs of the previous run of container %s of pod %s/%s (%d restarts)",
+				container.Name, pod.Namespace, pod.Name, restarts[container.Name]), previous)
+	}
+}
+
+// podLogs returns the last TailLines lines of a container's logs.
+func (c *Collector) podLogs(ctx context.Context, pod *corev1.Pod, container string, previous bool) ([]byte, error) {
+	limit := maxLogBytes
+	stream, err := c.client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:  container,
+		Previous:   previous,
+		TailLines:  &c.TailLines,
+		LimitBytes: &limit,
+		Timestamps: true,
+	}).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	return io.ReadAll(stream)
+}
+
+// collectEvents adds the events of namespace to b, oldest first.
+func (c *Collector) collectEvents(ctx context.Context, b *Bundle, namespace string) {
+	events, err := c.client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.AddError("events in "+namespace, err)
+		return
+	}
+	if len(events.Items) == 0 {
+		return
+	}
+
+	items := events.Items
+	slices.SortStableFunc(items, func(x, y corev1.Event) int {
+		return eventTime(x).Compare(eventTime(y))
+	})
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-20s %-8s %-24s %-48s %s\n", "LAST SEEN", "TYPE", "REASON", "OBJECT", "MESSAGE")
+	for _, e := range items {
+		object := strings.ToLower(e.InvolvedObject.Kind) + "/" + e.InvolvedObject.Name
+		message := strings.TrimSpace(e.Message)
+		if e.Count > 1 {
+			message = fmt.Sprintf("%s (x%d)", message, e.Count)
+		}
+		fmt.Fprintf(&sb, "%-20s %-8s %-24s %-48s %s\n",
+			eventTime(e).UTC().Format(time.RFC3339), e.Type, e.Reason, object, message)
+	}
+	b.Add(path.Join("events", namespace+".log"),
+		fmt.Sprintf("Events in namespace %s, oldest first", namespace), []byte(sb.String()))
+}
+
+// eventTime returns when an event was last seen.
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.FirstTimestamp.Time
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCollector_Collect(t *testing.T) {
+	now := time.Now()
+	clientset := fake.NewClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "gpu-node-1"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "nvidia-driver-daemonset-abc", Namespace: "gpu-operator"},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "k8s-driver-manager"}},
+				Containers:     []corev1.Container{{Name: "nvidia-driver-ctr"}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "nvidia-driver-ctr", RestartCount: 2}},
+			},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "late", Namespace: "gpu-operator"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "nvidia-driver-daemonset-abc"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			Count:          3,
+			LastTimestamp:  metav1.NewTime(now),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "early", Namespace: "gpu-operator"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "nvidia-driver-daemonset-abc"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Scheduled",
+			Message:        "Successfully assigned to gpu-node-1",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+	)
+
+	c := NewCollector(clientset, WithNamespaces([]string{"gpu-operator", "missing"}), WithTailLines(0))
+	if c.TailLines != DefaultTailLines {
+		t.Errorf("TailLines = %d, want default %d", c.TailLines, DefaultTailLines)
+	}
+
+	nodes, err := c.NodeNames(context.Background())
+	if err != nil || len(nodes) != 1 || nodes[0] != "gpu-node-1" {
+		t.Fatalf("NodeNames() = %v, %v", nodes, err)
+	}
+
+	b := NewBundle("v1.0.0")
+	c.Collect(context.Background(), b)
+
+	var paths []string
+	for _, f := range b.Index().Files {
+		paths = append(paths, f.Path)
+	}
+	want := []string{
+		"logs/gpu-operator/nvidia-driver-daemonset-abc/k8s-driver-manager.log",
+		"logs/gpu-operator/nvidia-driver-daemonset-abc/nvidia-driver-ctr.log",
+		"logs/gpu-operator/nvidia-driver-daemonset-abc/nvidia-driver-ctr.previous.log",
+		"events/gpu-operator.log",
+	}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("files = %v, want %v", paths, want)
+	}
+	if len(b.Index().Errors) != 0 {
+		t.Errorf("unexpected errors: %+v", b.Index().Errors)
+	}
+
+	events := string(b.contents["events/gpu-operator.log"])
+	scheduled := strings.Index(events, "Scheduled")
+	backOff := strings.Index(events, "BackOff")
+	if scheduled < 0 || backOff < scheduled {
+		t.Errorf("events not listed oldest first:\n%s", events)
+	}
+	if !strings.Contains(events, "pod/nvidia-driver-daemonset-abc") || !strings.Contains(events, "(x3)") {
+		t.Errorf("events missing object or count:\n%s", events)
+	}
+}
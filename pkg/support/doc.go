@@ -0,0 +1,51 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package support assembles support bundles: a single redacted archive with
+what a support escalation needs to understand a GPU cluster.
+
+A Bundle accumulates files in memory and writes them as a gzipped tar archive
+with an index.yaml listing every file, its size and description, and the
+items that could not be collected. Collection is best effort, so a failing
+agent Job or an unreadable namespace still leaves a useful bundle. Files and
+errors are passed through the redaction function set with SetRedactor,
+typically snapshotter.DefaultRedactor.Text.
+
+A Collector adds operator pod logs (including the previous run of restarted
+containers) and events from a set of namespaces, by default those the
+deployers install components into.
+
+# Usage
+
+	b := support.NewBundle(version)
+	b.SetRedactor(redactor.Text(snap, nodeNames...))
+	if err := b.AddYAML("snapshot.yaml", "Cluster snapshot", snap); err != nil {
+	    return err
+	}
+	support.NewCollector(clientset).Collect(ctx, b)
+	return b.Write("eidos-support.tar.gz")
+
+# Archive Layout
+
+	eidos-support/
+	    index.yaml
+	    snapshot.yaml
+	    recipe.yaml
+	    validation.yaml
+	    logs/<namespace>/<pod>/<container>.log
+	    logs/<namespace>/<pod>/<container>.previous.log
+	    events/<namespace>.log
+*/
+package support
@@ -48,6 +48,18 @@ const (
 	OperatorExact Operator = ""
 )
 
+const (
+	// orSeparator separates alternatives in a compound expression.
+	orSeparator = "||"
+
+	// andSeparator optionally separates terms within an alternative.
+	// Whitespace between operator terms is also treated as AND.
+	andSeparator = "&&"
+)
+
+// operatorsLongestFirst lists operators so that ">=" is matched before ">".
+var operatorsLongestFirst = []Operator{OperatorGTE, OperatorLTE, OperatorNE, OperatorEQ, OperatorGT, OperatorLT}
+
 // ParsedConstraint represents a parsed constraint expression.
 type ParsedConstraint struct {
 	// Operator is the comparison operator (or empty for exact match).
@@ -74,8 +86,7 @@ func ParseConstraintExpression(expr string) (*ParsedConstraint, error) {
 	pc := &ParsedConstraint{}
 
 	// Check for operators (longest first to avoid matching ">" when ">=" is intended)
-	operators := []Operator{OperatorGTE, OperatorLTE, OperatorNE, OperatorEQ, OperatorGT, OperatorLT}
-	for _, op := range operators {
+	for _, op := range operatorsLongestFirst {
 		if strings.HasPrefix(expr, string(op)) {
 			pc.Operator = op
 			pc.Value = strings.TrimSpace(strings.TrimPrefix(expr, string(op)))
@@ -205,3 +216,130 @@ func (pc *ParsedConstraint) String() string {
 	}
 	return fmt.Sprintf("%s %s", pc.Operator, pc.Value)
 }
+
+// ConstraintExpression is a compound constraint in disjunctive normal form.
+// Alternatives are joined by "||"; the terms of each alternative are joined
+// by whitespace or "&&". AND binds tighter than OR, so
+// ">= 1.30 < 1.34 || == 1.28" means "(>= 1.30 AND < 1.34) OR == 1.28".
+type ConstraintExpression struct {
+	// Alternatives are OR'ed together; each holds AND'ed terms.
+	Alternatives [][]*ParsedConstraint
+}
+
+// ParseExpression parses a constraint value that may combine terms.
+// Examples:
+//   - ">=1.30 <1.34" -> one alternative with two terms (a version range)
+//   - "== ubuntu || == rhel" -> two alternatives with one term each
+//   - "ubuntu" -> a single exact-match term
+//
+// Whitespace only starts a new term when the next token begins with an
+// operator, so exact values containing spaces (e.g. "Ubuntu 24.04 LTS")
+// remain a single term.
+func ParseExpression(expr string) (*ConstraintExpression, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, errors.New(errors.ErrCodeInvalidRequest, "constraint expression cannot be empty")
+	}
+
+	ce := &ConstraintExpression{}
+	for _, alternative := range strings.Split(expr, orSeparator) {
+		var terms []*ParsedConstraint
+		for _, group := range strings.Split(alternative, andSeparator) {
+			for _, term := range splitTerms(group) {
+				pc, err := ParseConstraintExpression(term)
+				if err != nil {
+					return nil, errors.WrapWithContext(errors.ErrCodeInvalidRequest,
+						"invalid constraint term", err, map[string]any{"expression": expr, "term": term})
+				}
+				terms = append(terms, pc)
+			}
+		}
+		if len(terms) == 0 {
+			return nil, errors.NewWithContext(errors.ErrCodeInvalidRequest,
+				"constraint expression has an empty alternative", map[string]any{"expression": expr})
+		}
+		ce.Alternatives = append(ce.Alternatives, terms)
+	}
+
+	return ce, nil
+}
+
+// splitTerms splits a conjunction on whitespace where the following token
+// starts with an operator. A bare operator token is joined with its value.
+// An empty group yields a single empty term so the caller reports it.
+func splitTerms(group string) []string {
+	fields := strings.Fields(group)
+	if len(fields) == 0 {
+		return []string{""}
+	}
+
+	var terms []string
+	current := fields[0]
+	for _, field := range fields[1:] {
+		if hasOperatorPrefix(field) && !isBareOperator(current) {
+			terms = append(terms, current)
+			current = field
+			continue
+		}
+		current += " " + field
+	}
+	return append(terms, current)
+}
+
+func hasOperatorPrefix(s string) bool {
+	for _, op := range operatorsLongestFirst {
+		if strings.HasPrefix(s, string(op)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBareOperator(s string) bool {
+	for _, op := range operatorsLongestFirst {
+		if s == string(op) {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate returns true if any alternative has all of its terms satisfied.
+// If no alternative passes and a term could not be evaluated (for example a
+// non-version value compared with ">="), the first such error is returned.
+func (ce *ConstraintExpression) Evaluate(actual string) (bool, error) {
+	var firstErr error
+	for _, terms := range ce.Alternatives {
+		passed := true
+		for _, term := range terms {
+			ok, err := term.Evaluate(actual)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				passed = false
+				break
+			}
+			if !ok {
+				passed = false
+				break
+			}
+		}
+		if passed {
+			return true, nil
+		}
+	}
+	return false, firstErr
+}
+
+// String returns the normalized expression, e.g. ">= 1.30 < 1.34 || == 1.28".
+func (ce *ConstraintExpression) String() string {
+	alternatives := make([]string, 0, len(ce.Alternatives))
+	for _, terms := range ce.Alternatives {
+		parts := make([]string, 0, len(terms))
+		for _, term := range terms {
+			parts = append(parts, term.String())
+		}
+		alternatives = append(alternatives, strings.Join(parts, " "))
+	}
+	return strings.Join(alternatives, " "+orSeparator+" ")
+}
@@ -229,3 +229,100 @@ func TestParsedConstraint_Evaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		want        string
+		wantAlts    int
+		expectError bool
+	}{
+		{name: "single term", input: ">= 1.30", want: ">= 1.30", wantAlts: 1},
+		{name: "exact value with spaces", input: "Ubuntu 24.04 LTS", want: "Ubuntu 24.04 LTS", wantAlts: 1},
+		{name: "range", input: ">=1.30 <1.34", want: ">= 1.30 < 1.34", wantAlts: 1},
+		{name: "range with spaced operators", input: ">= 1.30 < 1.34", want: ">= 1.30 < 1.34", wantAlts: 1},
+		{name: "range with &&", input: ">= 1.30 && < 1.34", want: ">= 1.30 < 1.34", wantAlts: 1},
+		{name: "or", input: "== ubuntu || == rhel", want: "== ubuntu || == rhel", wantAlts: 2},
+		{name: "or without spaces", input: "ubuntu||rhel", want: "ubuntu || rhel", wantAlts: 2},
+		{name: "and binds tighter than or", input: ">= 1.30 < 1.32 || >= 1.33", want: ">= 1.30 < 1.32 || >= 1.33", wantAlts: 2},
+		{name: "empty", input: "  ", expectError: true},
+		{name: "empty alternative", input: "== ubuntu ||", expectError: true},
+		{name: "empty and term", input: ">= 1.30 &&", expectError: true},
+		{name: "operator without value", input: ">= 1.30 <", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExpression(tt.input)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("ParseExpression(%q) expected error, got %v", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got.Alternatives) != tt.wantAlts {
+				t.Errorf("Alternatives = %d, want %d", len(got.Alternatives), tt.wantAlts)
+			}
+			if got.String() != tt.want {
+				t.Errorf("String() = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraintExpression_Evaluate(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		actual      string
+		want        bool
+		expectError bool
+	}{
+		// Ranges
+		{name: "range - inside", expr: ">=1.30 <1.34", actual: "v1.33.5", want: true},
+		{name: "range - lower bound", expr: ">=1.30 <1.34", actual: "1.30", want: true},
+		{name: "range - upper bound excluded", expr: ">=1.30 <1.34", actual: "1.34.0", want: false},
+		{name: "range - below", expr: ">=1.30 <1.34", actual: "1.29", want: false},
+
+		// OR
+		{name: "or - first", expr: "== ubuntu || == rhel", actual: "ubuntu", want: true},
+		{name: "or - second", expr: "== ubuntu || == rhel", actual: "rhel", want: true},
+		{name: "or - neither", expr: "== ubuntu || == rhel", actual: "sles", want: false},
+
+		// Precedence: (>= 1.30 AND < 1.32) OR >= 1.33
+		{name: "precedence - first range", expr: ">= 1.30 < 1.32 || >= 1.33", actual: "1.31", want: true},
+		{name: "precedence - gap", expr: ">= 1.30 < 1.32 || >= 1.33", actual: "1.32.1", want: false},
+		{name: "precedence - second", expr: ">= 1.30 < 1.32 || >= 1.33", actual: "1.35", want: true},
+		{name: "precedence - below", expr: ">= 1.30 < 1.32 || >= 1.33", actual: "1.29", want: false},
+
+		// Errors
+		{name: "version op on non-version fails", expr: ">= 1.30 < 1.34", actual: "unknown", expectError: true},
+		{name: "error ignored when another alternative passes", expr: ">= 1.30 || == unknown", actual: "unknown", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ParseExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseExpression(%q) error: %v", tt.expr, err)
+			}
+			result, err := expr.Evaluate(tt.actual)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.want {
+				t.Errorf("Evaluate(%q) = %v, want %v", tt.actual, result, tt.want)
+			}
+		})
+	}
+}
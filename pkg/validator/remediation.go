@@ -0,0 +1,245 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+)
+
+//go:embed templates/fix.sh.tmpl
+var fixScriptTemplate string
+
+// RemediationType classifies how a failed constraint is fixed.
+type RemediationType string
+
+const (
+	// RemediationSysctl sets a sysctl parameter on the nodes.
+	RemediationSysctl RemediationType = "sysctl"
+
+	// RemediationGRUB adds a kernel parameter to the boot configuration.
+	RemediationGRUB RemediationType = "grub"
+
+	// RemediationKernelModule loads a kernel module on the nodes.
+	RemediationKernelModule RemediationType = "kernel-module"
+
+	// RemediationKernelUpgrade upgrades the node kernel.
+	RemediationKernelUpgrade RemediationType = "kernel-upgrade"
+
+	// RemediationOS replaces or upgrades the node operating system.
+	RemediationOS RemediationType = "os"
+
+	// RemediationKubernetesUpgrade upgrades the Kubernetes cluster.
+	RemediationKubernetesUpgrade RemediationType = "kubernetes-upgrade"
+
+	// RemediationManual needs manual action described by the recipe.
+	RemediationManual RemediationType = "manual"
+)
+
+const (
+	// sysctlKeyPrefix starts the keys of sysctl measurements.
+	sysctlKeyPrefix = "/proc/sys/"
+
+	// kernelVersionKey is the sysctl key holding the kernel version.
+	kernelVersionKey = sysctlKeyPrefix + "kernel/osrelease"
+
+	// sysctlConfFile persists the sysctl parameters set by remediations.
+	sysctlConfFile = "/etc/sysctl.d/90-eidos.conf"
+
+	// modulesConfFile persists the kernel modules loaded by remediations.
+	modulesConfFile = "/etc/modules-load.d/eidos.conf"
+)
+
+// RemediationAction is a machine-readable fix for a failed constraint.
+type RemediationAction struct {
+	// Type classifies the fix.
+	Type RemediationType `json:"type" yaml:"type"`
+
+	// Description says what to change (e.g., "Set sysctl vm.swappiness=0").
+	Description string `json:"description" yaml:"description"`
+
+	// Commands are shell commands applying the fix on the affected nodes.
+	// Empty when the fix needs manual action.
+	Commands []string `json:"commands,omitempty" yaml:"commands,omitempty"`
+
+	// RebootRequired is set when the fix takes effect after a node reboot.
+	RebootRequired bool `json:"rebootRequired,omitempty" yaml:"rebootRequired,omitempty"`
+}
+
+// Remediate returns the fix for a constraint the cluster does not satisfy.
+// The recipe's remediation for the constraint is used when set; otherwise
+// sysctl, GRUB, kernel module, kernel, OS and Kubernetes version constraints
+// get a fix derived from the constraint name and expected value. It returns
+// nil when no fix is known.
+func Remediate(constraint recipe.Constraint) *RemediationAction {
+	path, err := ParseConstraintPath(constraint.Name)
+	if err != nil {
+		return nil
+	}
+
+	action := derivedRemediation(path, constraint.Value)
+	if r := constraint.Remediation; r != nil {
+		if action == nil {
+			action = &RemediationAction{Type: RemediationManual}
+		}
+		action.Description = r.Description
+		action.Commands = r.Commands
+		action.RebootRequired = r.RebootRequired
+	}
+	return action
+}
+
+// derivedRemediation returns the fix known for a constraint path, or nil.
+func derivedRemediation(path *ConstraintPath, expected string) *RemediationAction {
+	target, exact := targetValue(expected)
+
+	switch {
+	case path.Type == measurement.TypeK8s && path.Subtype == "server" && path.Key == "version":
+		return &RemediationAction{
+			Type:        RemediationKubernetesUpgrade,
+			Description: fmt.Sprintf("Upgrade the Kubernetes cluster to %s", expected),
+		}
+
+	case path.Type == measurement.TypeOS && path.Subtype == "release":
+		return &RemediationAction{
+			Type:        RemediationOS,
+			Description: fmt.Sprintf("Run nodes with an operating system whose %s is %s", path.Key, expected),
+		}
+
+	case path.Type == measurement.TypeOS && path.Subtype == "sysctl" && path.Key == kernelVersionKey:
+		return &RemediationAction{
+			Type:           RemediationKernelUpgrade,
+			Description:    fmt.Sprintf("Upgrade the kernel to %s", expected),
+			RebootRequired: true,
+		}
+
+	case path.Type == measurement.TypeOS && path.Subtype == "sysctl" && strings.HasPrefix(path.Key, sysctlKeyPrefix):
+		name := strings.ReplaceAll(strings.TrimPrefix(path.Key, sysctlKeyPrefix), "/", ".")
+		if !exact {
+			return &RemediationAction{
+				Type:        RemediationSysctl,
+				Description: fmt.Sprintf("Set sysctl %s to a value matching %s", name, expected),
+			}
+		}
+		return &RemediationAction{
+			Type:        RemediationSysctl,
+			Description: fmt.Sprintf("Set sysctl %s=%s", name, target),
+			Commands: []string{
+				fmt.Sprintf("sysctl -w %s=%s", name, shellQuote(target)),
+				fmt.Sprintf("echo %s >> %s", shellQuote(name+" = "+target), sysctlConfFile),
+			},
+		}
+
+	case path.Type == measurement.TypeOS && path.Subtype == "grub":
+		param := path.Key
+		if exact && target != "" {
+			param += "=" + target
+		}
+		return &RemediationAction{
+			Type:        RemediationGRUB,
+			Description: fmt.Sprintf("Add kernel parameter %s to GRUB", param),
+			Commands: []string{
+				fmt.Sprintf("grubby --update-kernel=ALL --args=%s", shellQuote(param)),
+			},
+			RebootRequired: true,
+		}
+
+	case path.Type == measurement.TypeOS && path.Subtype == "kmod":
+		return &RemediationAction{
+			Type:        RemediationKernelModule,
+			Description: fmt.Sprintf("Load kernel module %s", path.Key),
+			Commands: []string{
+				fmt.Sprintf("modprobe %s", shellQuote(path.Key)),
+				fmt.Sprintf("echo %s >> %s", shellQuote(path.Key), modulesConfFile),
+			},
+		}
+	}
+	return nil
+}
+
+// targetValue returns the single value an expression asks for, for
+// expressions that are one exact, "==" , ">=" or "<=" term.
+func targetValue(expected string) (string, bool) {
+	expr, err := ParseExpression(expected)
+	if err != nil || len(expr.Alternatives) != 1 || len(expr.Alternatives[0]) != 1 {
+		return "", false
+	}
+	term := expr.Alternatives[0][0]
+	switch term.Operator {
+	case OperatorExact, OperatorEQ, OperatorGTE, OperatorLTE:
+		return term.Value, true
+	default:
+		return "", false
+	}
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// fixScriptData is the data rendered into the fix script template.
+type fixScriptData struct {
+	Version        string
+	RecipeSource   string
+	SnapshotSource string
+	Fixes          []fixStep
+	RebootRequired bool
+}
+
+// fixStep is a failed constraint with a fix, numbered from 1.
+type fixStep struct {
+	ConstraintValidation
+	Step int
+}
+
+// WriteFixScript renders the remediation actions of result into a shell
+// script for review, one step per constraint with a fix. Steps without
+// commands are left as comments describing the manual action. It returns the
+// number of steps written.
+func WriteFixScript(w io.Writer, result *ValidationResult) (int, error) {
+	if result == nil {
+		return 0, errors.New(errors.ErrCodeInvalidRequest, "validation result cannot be nil")
+	}
+
+	data := fixScriptData{
+		Version:        result.Metadata["version"],
+		RecipeSource:   result.RecipeSource,
+		SnapshotSource: result.SnapshotSource,
+	}
+	for _, r := range result.Results {
+		if r.Remediation == nil {
+			continue
+		}
+		data.Fixes = append(data.Fixes, fixStep{ConstraintValidation: r, Step: len(data.Fixes) + 1})
+		data.RebootRequired = data.RebootRequired || r.Remediation.RebootRequired
+	}
+
+	tmpl, err := template.New("fix.sh").Parse(fixScriptTemplate)
+	if err != nil {
+		return 0, errors.Wrap(errors.ErrCodeInternal, "failed to parse fix script template", err)
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		return 0, errors.Wrap(errors.ErrCodeInternal, "failed to render fix script", err)
+	}
+	return len(data.Fixes), nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+)
+
+func TestRemediate(t *testing.T) {
+	tests := []struct {
+		name         string
+		constraint   recipe.Constraint
+		wantType     RemediationType
+		wantDesc     string
+		wantCommands []string
+		wantReboot   bool
+	}{
+		{
+			name:       "sysctl exact value",
+			constraint: recipe.Constraint{Name: "OS.sysctl./proc/sys/vm/swappiness", Value: "0"},
+			wantType:   RemediationSysctl,
+			wantDesc:   "Set sysctl vm.swappiness=0",
+			wantCommands: []string{
+				"sysctl -w vm.swappiness='0'",
+				"echo 'vm.swappiness = 0' >> /etc/sysctl.d/90-eidos.conf",
+			},
+		},
+		{
+			name:       "sysctl range",
+			constraint: recipe.Constraint{Name: "OS.sysctl./proc/sys/vm/max_map_count", Value: "> 65530"},
+			wantType:   RemediationSysctl,
+			wantDesc:   "Set sysctl vm.max_map_count to a value matching > 65530",
+		},
+		{
+			name:       "kernel version",
+			constraint: recipe.Constraint{Name: "OS.sysctl./proc/sys/kernel/osrelease", Value: ">= 6.8"},
+			wantType:   RemediationKernelUpgrade,
+			wantDesc:   "Upgrade the kernel to >= 6.8",
+			wantReboot: true,
+		},
+		{
+			name:         "grub parameter",
+			constraint:   recipe.Constraint{Name: "OS.grub.iommu", Value: "pt"},
+			wantType:     RemediationGRUB,
+			wantDesc:     "Add kernel parameter iommu=pt to GRUB",
+			wantCommands: []string{"grubby --update-kernel=ALL --args='iommu=pt'"},
+			wantReboot:   true,
+		},
+		{
+			name:         "kernel module",
+			constraint:   recipe.Constraint{Name: "OS.kmod.nvidia_peermem", Value: "true"},
+			wantType:     RemediationKernelModule,
+			wantDesc:     "Load kernel module nvidia_peermem",
+			wantCommands: []string{"modprobe 'nvidia_peermem'", "echo 'nvidia_peermem' >> /etc/modules-load.d/eidos.conf"},
+		},
+		{
+			name:       "kubernetes version",
+			constraint: recipe.Constraint{Name: "K8s.server.version", Value: ">= 1.32.4"},
+			wantType:   RemediationKubernetesUpgrade,
+			wantDesc:   "Upgrade the Kubernetes cluster to >= 1.32.4",
+		},
+		{
+			name: "recipe remediation",
+			constraint: recipe.Constraint{Name: "GPU.health.remapped-rows-pending", Value: "false",
+				Remediation: &recipe.Remediation{Description: "Reset the GPUs", Commands: []string{"nvidia-smi --gpu-reset"}}},
+			wantType:     RemediationManual,
+			wantDesc:     "Reset the GPUs",
+			wantCommands: []string{"nvidia-smi --gpu-reset"},
+		},
+		{
+			name: "recipe remediation replaces derived one",
+			constraint: recipe.Constraint{Name: "OS.release.ID", Value: "ubuntu",
+				Remediation: &recipe.Remediation{Description: "Use the Ubuntu AMI"}},
+			wantType: RemediationOS,
+			wantDesc: "Use the Ubuntu AMI",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Remediate(tt.constraint)
+			if got == nil {
+				t.Fatal("Remediate() = nil")
+			}
+			if got.Type != tt.wantType || got.Description != tt.wantDesc || got.RebootRequired != tt.wantReboot {
+				t.Errorf("Remediate() = %+v, want type %s, description %q, reboot %v",
+					got, tt.wantType, tt.wantDesc, tt.wantReboot)
+			}
+			if !slices.Equal(got.Commands, tt.wantCommands) {
+				t.Errorf("Commands = %q, want %q", got.Commands, tt.wantCommands)
+			}
+		})
+	}
+
+	if got := Remediate(recipe.Constraint{Name: "GPU.smi.gpu.model", Value: "H100"}); got != nil {
+		t.Errorf("Remediate() without a known fix = %+v, want nil", got)
+	}
+}
+
+func TestWriteFixScript(t *testing.T) {
+	snap := &snapshotter.Snapshot{
+		Measurements: []*measurement.Measurement{
+			{
+				Type: measurement.TypeOS,
+				Subtypes: []measurement.Subtype{
+					{Name: "sysctl", Data: map[string]measurement.Reading{
+						"/proc/sys/vm/swappiness":    measurement.Str("60"),
+						"/proc/sys/kernel/osrelease": measurement.Str("6.5.0-1024-aws"),
+					}},
+					{Name: "grub", Data: map[string]measurement.Reading{}},
+				},
+			},
+		},
+	}
+	rec := &recipe.RecipeResult{Constraints: []recipe.Constraint{
+		{Name: "OS.sysctl./proc/sys/vm/swappiness", Value: "0"},
+		{Name: "OS.sysctl./proc/sys/kernel/osrelease", Value: ">= 6.8"},
+		{Name: "OS.grub.iommu", Value: "pt"},
+	}}
+
+	result, err := New(WithVersion("v1.0.0")).Validate(context.Background(), rec, snap)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	for _, r := range result.Results {
+		if r.Remediation == nil {
+			t.Errorf("%s has no remediation", r.Name)
+		}
+	}
+
+	var buf strings.Builder
+	steps, err := WriteFixScript(&buf, result)
+	if err != nil {
+		t.Fatalf("WriteFixScript() error = %v", err)
+	}
+	if steps != 3 {
+		t.Errorf("WriteFixScript() = %d steps, want 3", steps)
+	}
+	script := buf.String()
+	for _, want := range []string{
+		"#!/usr/bin/env bash",
+		"# Remediation script generated by eidos v1.0.0.",
+		"# [1] OS.sysctl./proc/sys/vm/swappiness: expected 0, got 60\n# sysctl: Set sysctl vm.swappiness=0\nsysctl -w vm.swappiness='0'\n",
+		"# kernel-upgrade: Upgrade the kernel to >= 6.8 (reboot required)\n# Manual action required.",
+		"# [3] OS.grub.iommu: expected pt\n",
+		`echo "Reboot the node to apply the kernel changes."`,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("script missing %q:\n%s", want, script)
+		}
+	}
+
+	if _, err := WriteFixScript(&buf, nil); err == nil {
+		t.Error("expected error for nil result")
+	}
+}
@@ -102,6 +102,9 @@ type ConstraintValidation struct {
 
 	// Message provides additional context, especially for failures or skipped constraints.
 	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	// Remediation is the fix for a failed constraint, when one is known.
+	Remediation *RemediationAction `json:"remediation,omitempty" yaml:"remediation,omitempty"`
 }
 
 // NewValidationResult creates a new ValidationResult with initialized slices.
@@ -22,6 +22,7 @@ import (
 
 	"github.com/NVIDIA/eidos/pkg/errors"
 	"github.com/NVIDIA/eidos/pkg/header"
+	"github.com/NVIDIA/eidos/pkg/measurement"
 	"github.com/NVIDIA/eidos/pkg/recipe"
 	"github.com/NVIDIA/eidos/pkg/snapshotter"
 )
@@ -66,7 +67,7 @@ func EvaluateConstraint(constraint recipe.Constraint, snap *snapshotter.Snapshot
 	result.Actual = actual
 
 	// Parse the constraint expression
-	parsed, err := ParseConstraintExpression(constraint.Value)
+	parsed, err := ParseExpression(constraint.Value)
 	if err != nil {
 		result.Error = errors.Wrap(errors.ErrCodeInvalidRequest, "invalid constraint expression", err)
 		return result
@@ -192,6 +193,11 @@ func (v *Validator) evaluateConstraint(constraint recipe.Constraint, snap *snaps
 	if err != nil {
 		cv.Status = ConstraintStatusSkipped
 		cv.Message = fmt.Sprintf("value not found in snapshot: %v", err)
+		// A kernel parameter or module missing from the snapshot is unset,
+		// so it can still be fixed
+		if path.Type == measurement.TypeOS && (path.Subtype == "grub" || path.Subtype == "kmod") {
+			cv.Remediation = Remediate(constraint)
+		}
 		slog.Warn("skipping constraint - value not found",
 			"name", constraint.Name,
 			"path", path.String(),
@@ -204,7 +210,7 @@ func (v *Validator) evaluateConstraint(constraint recipe.Constraint, snap *snaps
 	printDetectedCriteria(path.String(), actual)
 
 	// Parse the constraint expression
-	parsed, err := ParseConstraintExpression(constraint.Value)
+	parsed, err := ParseExpression(constraint.Value)
 	if err != nil {
 		cv.Status = ConstraintStatusSkipped
 		cv.Message = fmt.Sprintf("invalid constraint expression: %v", err)
@@ -237,6 +243,7 @@ func (v *Validator) evaluateConstraint(constraint recipe.Constraint, snap *snaps
 	} else {
 		cv.Status = ConstraintStatusFailed
 		cv.Message = fmt.Sprintf("expected %s, got %s", constraint.Value, actual)
+		cv.Remediation = Remediate(constraint)
 		slog.Debug("constraint failed",
 			"name", constraint.Name,
 			"expected", constraint.Value,
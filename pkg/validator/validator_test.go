@@ -19,10 +19,13 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/NVIDIA/eidos/pkg/measurement"
 	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
 	"github.com/NVIDIA/eidos/pkg/snapshotter"
 )
 
@@ -537,3 +540,40 @@ func TestEvaluateConstraint(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_Validate_LegacySnapshot(t *testing.T) {
+	// Snapshot written before schemaVersion existed; decoding migrates it.
+	legacy := `kind: Snapshot
+apiVersion: eidos.nvidia.com/v1alpha1
+metadata:
+  source: gpu-node-1
+measurements:
+  - type: K8s
+    subtypes:
+      - subtype: server
+        data:
+          version: v1.33.5
+`
+	path := filepath.Join(t.TempDir(), "snapshot.yaml")
+	if err := os.WriteFile(path, []byte(legacy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := serializer.FromFile[snapshotter.Snapshot](path)
+	if err != nil {
+		t.Fatalf("failed to load legacy snapshot: %v", err)
+	}
+	if snap.SchemaVersion != snapshotter.SchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", snap.SchemaVersion, snapshotter.SchemaVersion)
+	}
+
+	rec := &recipe.RecipeResult{
+		Constraints: []recipe.Constraint{{Name: "K8s.server.version", Value: ">= 1.32"}},
+	}
+	result, err := New().Validate(context.Background(), rec, snap)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if result.Summary.Status != ValidationStatusPass {
+		t.Errorf("status = %s, want pass: %+v", result.Summary.Status, result.Results)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watcher continuously validates a cluster against a pinned recipe.
+//
+// A one-off `eidos validate` answers whether a cluster met the recipe at the
+// time the snapshot was taken. Drivers get upgraded, kernel parameters get
+// reset and GPUs degrade, so the Watcher repeats the check: on every interval
+// it loads the recipe, captures a fresh snapshot, validates it and publishes
+// the per-constraint outcome as Prometheus metrics.
+//
+// The recipe is loaded again on each check, so updating the pinned recipe
+// (for example the ConfigMap it is stored in) takes effect without a restart.
+//
+//	w, err := watcher.New(
+//	    watcher.WithRecipeLoader("cm://eidos/pinned-recipe", loadRecipe),
+//	    watcher.WithSnapshotter("cm://eidos/snapshot", captureSnapshot),
+//	    watcher.WithInterval(15*time.Minute),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	go w.Run(ctx)
+//
+// # Regressions
+//
+// A constraint that passed on the previous check and fails on the current one
+// is a regression. Regressions are counted and handed to the configured
+// Notifier; EventRecorder reports them as Kubernetes Warning Events on the
+// object holding the pinned recipe. Constraints that fail from the first
+// check are reported by the metrics only.
+//
+// # Metrics
+//
+//   - eidos_validation_status: Per-constraint outcome of the last check, by
+//     constraint (1 passed, 0 failed, -1 skipped)
+//   - eidos_validation_checks_total: Completed checks, by status (pass, fail,
+//     partial, error)
+//   - eidos_validation_regressions_total: Constraints that went from passed
+//     to failed
+//   - eidos_validation_last_check_timestamp_seconds: Unix time of the last
+//     completed check
+package watcher
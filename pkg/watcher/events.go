@@ -0,0 +1,92 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	k8sclient "github.com/NVIDIA/eidos/pkg/k8s/client"
+)
+
+const (
+	// EventReasonRegressed is the reason of Events reporting a regression.
+	EventReasonRegressed = "ConstraintRegressed"
+
+	// EventSource is the component recorded as the source of Events.
+	EventSource = "eidos-watch"
+)
+
+// EventRecorder reports regressions as Kubernetes Warning Events on the
+// object holding the pinned recipe.
+type EventRecorder struct {
+	client k8sclient.Interface
+	object corev1.ObjectReference
+	now    func() time.Time
+}
+
+// NewEventRecorder creates an EventRecorder attaching Events to object.
+func NewEventRecorder(client k8sclient.Interface, object corev1.ObjectReference) *EventRecorder {
+	return &EventRecorder{
+		client: client,
+		object: object,
+		now:    time.Now,
+	}
+}
+
+// Notify creates one Event per regression.
+func (e *EventRecorder) Notify(ctx context.Context, regressions []Regression) error {
+	now := e.now()
+	for i, r := range regressions {
+		// Offset the timestamp in the name so Events of one check don't collide
+		event := e.event(r, now, fmt.Sprintf("%s.%x", e.object.Name, now.UnixNano()+int64(i)))
+		if _, err := e.client.CoreV1().Events(e.object.Namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+			return eidoserrors.Wrap(eidoserrors.ErrCodeInternal,
+				fmt.Sprintf("failed to create event for constraint %s", r.Constraint.Name), err)
+		}
+	}
+	return nil
+}
+
+// event builds the Event for a regression. The name follows the scheme of
+// client-go's event recorder.
+func (e *EventRecorder) event(r Regression, at time.Time, name string) *corev1.Event {
+	now := metav1.NewTime(at)
+	message := fmt.Sprintf("Constraint %s regressed: expected %s, got %s",
+		r.Constraint.Name, r.Constraint.Expected, r.Constraint.Actual)
+	if r.Constraint.Message != "" {
+		message += ": " + r.Constraint.Message
+	}
+
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: e.object.Namespace,
+		},
+		InvolvedObject: e.object,
+		Reason:         EventReasonRegressed,
+		Message:        message,
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: EventSource},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	validationStatus = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "eidos_validation_status",
+			Help: "Outcome of each recipe constraint in the last check (1 passed, 0 failed, -1 skipped)",
+		},
+		[]string{"constraint"},
+	)
+
+	checksTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "eidos_validation_checks_total",
+			Help: "Total number of continuous validation checks",
+		},
+		[]string{"status"}, // pass, fail, partial or error
+	)
+
+	regressionsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "eidos_validation_regressions_total",
+			Help: "Total number of constraints that went from passed to failed",
+		},
+	)
+
+	lastCheckTimestamp = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "eidos_validation_last_check_timestamp_seconds",
+			Help: "Unix time of the last completed validation check",
+		},
+	)
+)
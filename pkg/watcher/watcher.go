@@ -0,0 +1,288 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/defaults"
+	eidoserrors "github.com/NVIDIA/eidos/pkg/errors"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/serializer"
+	"github.com/NVIDIA/eidos/pkg/server"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+// checkStatusError labels checks that could not complete.
+const checkStatusError = "error"
+
+// RecipeLoader loads the pinned recipe to validate against.
+type RecipeLoader func(ctx context.Context) (*recipe.RecipeResult, error)
+
+// Snapshotter captures a fresh snapshot of the cluster.
+type Snapshotter func(ctx context.Context) (*snapshotter.Snapshot, error)
+
+// Regression is a constraint that passed on the previous check and failed
+// on the current one.
+type Regression struct {
+	// Constraint is the failed validation of the constraint.
+	Constraint validator.ConstraintValidation
+
+	// Recipe identifies the recipe the constraint belongs to.
+	Recipe string
+}
+
+// Notifier is told about regressions found by a check.
+type Notifier interface {
+	Notify(ctx context.Context, regressions []Regression) error
+}
+
+// Watcher periodically validates fresh snapshots against a pinned recipe.
+type Watcher struct {
+	version      string
+	interval     time.Duration
+	recipeName   string
+	loadRecipe   RecipeLoader
+	snapshotName string
+	capture      Snapshotter
+	notifier     Notifier
+
+	mu       sync.Mutex
+	previous map[string]validator.ConstraintStatus
+	last     *validator.ValidationResult
+}
+
+// Option configures a Watcher.
+type Option func(*Watcher)
+
+// WithVersion sets the version recorded in validation results.
+func WithVersion(version string) Option {
+	return func(w *Watcher) {
+		w.version = version
+	}
+}
+
+// WithInterval sets how often the cluster is validated.
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithRecipeLoader sets the function loading the pinned recipe. name
+// identifies the recipe in logs and regressions, typically its URI.
+func WithRecipeLoader(name string, load RecipeLoader) Option {
+	return func(w *Watcher) {
+		w.recipeName = name
+		w.loadRecipe = load
+	}
+}
+
+// WithSnapshotter sets the function capturing a fresh snapshot. name
+// identifies where snapshots come from, typically their URI.
+func WithSnapshotter(name string, capture Snapshotter) Option {
+	return func(w *Watcher) {
+		w.snapshotName = name
+		w.capture = capture
+	}
+}
+
+// WithNotifier sets the Notifier told about regressions.
+func WithNotifier(n Notifier) Option {
+	return func(w *Watcher) {
+		w.notifier = n
+	}
+}
+
+// New creates a Watcher. A recipe loader and a snapshotter are required.
+func New(opts ...Option) (*Watcher, error) {
+	w := &Watcher{
+		interval: defaults.ValidationWatchInterval,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	if w.loadRecipe == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "watcher requires a recipe loader")
+	}
+	if w.capture == nil {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "watcher requires a snapshotter")
+	}
+	if w.interval <= 0 {
+		return nil, eidoserrors.New(eidoserrors.ErrCodeInvalidRequest, "watch interval must be positive")
+	}
+
+	return w, nil
+}
+
+// Run checks the cluster immediately and then on every interval until ctx is
+// canceled. A failed check is logged and retried on the next interval.
+func (w *Watcher) Run(ctx context.Context) {
+	slog.Info("continuous validation started",
+		"recipe", w.recipeName,
+		"interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Check(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("validation check failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("continuous validation stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check loads the recipe, captures a snapshot and validates it once,
+// updating the metrics and notifying about regressions.
+func (w *Watcher) Check(ctx context.Context) (*validator.ValidationResult, error) {
+	result, err := w.validate(ctx)
+	if err != nil {
+		checksTotal.WithLabelValues(checkStatusError).Inc()
+		return nil, err
+	}
+
+	checksTotal.WithLabelValues(string(result.Summary.Status)).Inc()
+	lastCheckTimestamp.SetToCurrentTime()
+
+	regressions := w.record(result)
+	regressionsTotal.Add(float64(len(regressions)))
+
+	slog.Info("validation check completed",
+		"status", result.Summary.Status,
+		"passed", result.Summary.Passed,
+		"failed", result.Summary.Failed,
+		"skipped", result.Summary.Skipped,
+		"regressions", len(regressions))
+
+	if len(regressions) > 0 && w.notifier != nil {
+		if err := w.notifier.Notify(ctx, regressions); err != nil {
+			slog.Warn("failed to report regressions", "error", err)
+		}
+	}
+
+	return result, nil
+}
+
+// validate runs a single validation pass.
+func (w *Watcher) validate(ctx context.Context) (*validator.ValidationResult, error) {
+	rec, err := w.loadRecipe(ctx)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "failed to load recipe", err)
+	}
+
+	snap, err := w.capture(ctx)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeUnavailable, "failed to capture snapshot", err)
+	}
+
+	v := validator.New(validator.WithVersion(w.version))
+	result, err := v.Validate(ctx, rec, snap)
+	if err != nil {
+		return nil, eidoserrors.Wrap(eidoserrors.ErrCodeInternal, "failed to validate snapshot", err)
+	}
+	result.RecipeSource = w.recipeName
+	result.SnapshotSource = w.snapshotName
+
+	return result, nil
+}
+
+// record publishes the per-constraint metrics, stores the result and returns
+// the constraints that regressed since the previous check.
+func (w *Watcher) record(result *validator.ValidationResult) []Regression {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Drop series of constraints removed from the pinned recipe
+	current := make(map[string]validator.ConstraintStatus, len(result.Results))
+	for _, r := range result.Results {
+		current[r.Name] = r.Status
+	}
+	for name := range w.previous {
+		if _, ok := current[name]; !ok {
+			validationStatus.DeleteLabelValues(name)
+		}
+	}
+
+	var regressions []Regression
+	for _, r := range result.Results {
+		validationStatus.WithLabelValues(r.Name).Set(statusValue(r.Status))
+		if w.previous[r.Name] == validator.ConstraintStatusPassed && r.Status == validator.ConstraintStatusFailed {
+			regressions = append(regressions, Regression{Constraint: r, Recipe: w.recipeName})
+		}
+	}
+
+	w.previous = current
+	w.last = result
+	return regressions
+}
+
+// statusValue maps a constraint status to its eidos_validation_status value.
+func statusValue(status validator.ConstraintStatus) float64 {
+	switch status {
+	case validator.ConstraintStatusPassed:
+		return 1
+	case validator.ConstraintStatusFailed:
+		return 0
+	default:
+		return -1
+	}
+}
+
+// LastResult returns the result of the last completed check, or nil before
+// the first one.
+func (w *Watcher) LastResult() *validator.ValidationResult {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.last
+}
+
+// HandleStatus serves the result of the last completed check.
+//
+// Example:
+//
+//	GET /v1/validation
+func (w *Watcher) HandleStatus(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.Header().Set("Allow", http.MethodGet)
+		server.WriteError(rw, r, http.StatusMethodNotAllowed, eidoserrors.ErrCodeMethodNotAllowed,
+			"Method not allowed", false, map[string]any{
+				"method": r.Method,
+			})
+		return
+	}
+
+	result := w.LastResult()
+	if result == nil {
+		server.WriteError(rw, r, http.StatusServiceUnavailable, eidoserrors.ErrCodeUnavailable,
+			"No validation check has completed yet", true, nil)
+		return
+	}
+
+	serializer.RespondJSON(rw, http.StatusOK, result)
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/NVIDIA/eidos/pkg/measurement"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/pkg/snapshotter"
+	"github.com/NVIDIA/eidos/pkg/validator"
+)
+
+func testSnapshot(k8sVersion string) *snapshotter.Snapshot {
+	return &snapshotter.Snapshot{
+		Measurements: []*measurement.Measurement{
+			{
+				Type: measurement.TypeK8s,
+				Subtypes: []measurement.Subtype{
+					{
+						Name: "server",
+						Data: map[string]measurement.Reading{
+							"version": measurement.Str(k8sVersion),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type recordingNotifier struct {
+	regressions []Regression
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, regressions []Regression) error {
+	n.regressions = append(n.regressions, regressions...)
+	return nil
+}
+
+func TestNew_Validation(t *testing.T) {
+	load := func(context.Context) (*recipe.RecipeResult, error) { return &recipe.RecipeResult{}, nil }
+	capture := func(context.Context) (*snapshotter.Snapshot, error) { return testSnapshot("v1.33.0"), nil }
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{"valid", []Option{WithRecipeLoader("recipe.yaml", load), WithSnapshotter("snapshot.yaml", capture)}, false},
+		{"missing recipe loader", []Option{WithSnapshotter("snapshot.yaml", capture)}, true},
+		{"missing snapshotter", []Option{WithRecipeLoader("recipe.yaml", load)}, true},
+		{"zero interval", []Option{WithRecipeLoader("recipe.yaml", load), WithSnapshotter("snapshot.yaml", capture), WithInterval(0)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWatcher_Check(t *testing.T) {
+	rec := &recipe.RecipeResult{
+		Constraints: []recipe.Constraint{
+			{Name: "K8s.server.version", Value: ">= 1.32"},
+			{Name: "OS.release.ID", Value: "ubuntu"},
+		},
+	}
+	version := "v1.33.0"
+	notifier := &recordingNotifier{}
+
+	w, err := New(
+		WithRecipeLoader("cm://eidos/pinned-recipe", func(context.Context) (*recipe.RecipeResult, error) {
+			return rec, nil
+		}),
+		WithSnapshotter("cm://eidos/snapshot", func(context.Context) (*snapshotter.Snapshot, error) {
+			return testSnapshot(version), nil
+		}),
+		WithNotifier(notifier),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	regressionsBefore := testutil.ToFloat64(regressionsTotal)
+
+	result, err := w.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if result.RecipeSource != "cm://eidos/pinned-recipe" {
+		t.Errorf("RecipeSource = %q", result.RecipeSource)
+	}
+	if got := testutil.ToFloat64(validationStatus.WithLabelValues("K8s.server.version")); got != 1 {
+		t.Errorf("status of passed constraint = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(validationStatus.WithLabelValues("OS.release.ID")); got != -1 {
+		t.Errorf("status of skipped constraint = %v, want -1", got)
+	}
+
+	// The cluster is downgraded: the passing constraint regresses
+	version = "v1.31.0"
+	if _, err := w.Check(context.Background()); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if got := testutil.ToFloat64(validationStatus.WithLabelValues("K8s.server.version")); got != 0 {
+		t.Errorf("status of failed constraint = %v, want 0", got)
+	}
+	if len(notifier.regressions) != 1 || notifier.regressions[0].Constraint.Name != "K8s.server.version" {
+		t.Fatalf("regressions = %+v, want K8s.server.version", notifier.regressions)
+	}
+	if got := testutil.ToFloat64(regressionsTotal) - regressionsBefore; got != 1 {
+		t.Errorf("regressions counted = %v, want 1", got)
+	}
+
+	// A constraint that keeps failing is not reported again
+	if _, err := w.Check(context.Background()); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(notifier.regressions) != 1 {
+		t.Errorf("regressions = %d after repeated failure, want 1", len(notifier.regressions))
+	}
+
+	// Constraints removed from the pinned recipe drop their series
+	rec.Constraints = rec.Constraints[:1]
+	if _, err := w.Check(context.Background()); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if got := testutil.CollectAndCount(validationStatus, "eidos_validation_status"); got != 1 {
+		t.Errorf("status series = %d, want 1", got)
+	}
+}
+
+func TestWatcher_CheckError(t *testing.T) {
+	w, err := New(
+		WithRecipeLoader("recipe.yaml", func(context.Context) (*recipe.RecipeResult, error) {
+			return &recipe.RecipeResult{}, nil
+		}),
+		WithSnapshotter("cm://eidos/snapshot", func(context.Context) (*snapshotter.Snapshot, error) {
+			return nil, errors.New("agent job failed")
+		}),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	before := testutil.ToFloat64(checksTotal.WithLabelValues(checkStatusError))
+	if _, err := w.Check(context.Background()); err == nil {
+		t.Fatal("Check() expected error")
+	}
+	if got := testutil.ToFloat64(checksTotal.WithLabelValues(checkStatusError)) - before; got != 1 {
+		t.Errorf("error checks counted = %v, want 1", got)
+	}
+	if w.LastResult() != nil {
+		t.Error("LastResult() should be nil after a failed check")
+	}
+}
+
+func TestWatcher_HandleStatus(t *testing.T) {
+	w, err := New(
+		WithRecipeLoader("recipe.yaml", func(context.Context) (*recipe.RecipeResult, error) {
+			return &recipe.RecipeResult{
+				Constraints: []recipe.Constraint{{Name: "K8s.server.version", Value: ">= 1.32"}},
+			}, nil
+		}),
+		WithSnapshotter("cm://eidos/snapshot", func(context.Context) (*snapshotter.Snapshot, error) {
+			return testSnapshot("v1.33.0"), nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	w.HandleStatus(rr, httptest.NewRequest(http.MethodGet, "/v1/validation", nil))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("status before first check = %d, want %d", rr.Code, http.StatusServiceUnavailable)
+	}
+
+	if _, err := w.Check(context.Background()); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	rr = httptest.NewRecorder()
+	w.HandleStatus(rr, httptest.NewRequest(http.MethodGet, "/v1/validation", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var result validator.ValidationResult
+	if err := json.Unmarshal(rr.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	if result.Summary.Status != validator.ValidationStatusPass {
+		t.Errorf("summary status = %q, want pass", result.Summary.Status)
+	}
+
+	rr = httptest.NewRecorder()
+	w.HandleStatus(rr, httptest.NewRequest(http.MethodPost, "/v1/validation", nil))
+	if rr.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want %d", rr.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestEventRecorder_Notify(t *testing.T) {
+	client := fake.NewClientset()
+	recorder := NewEventRecorder(client, corev1.ObjectReference{
+		Kind:       "ConfigMap",
+		APIVersion: "v1",
+		Namespace:  "eidos",
+		Name:       "pinned-recipe",
+	})
+	recorder.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	regressions := []Regression{
+		{Constraint: validator.ConstraintValidation{Name: "K8s.server.version", Expected: ">= 1.32", Actual: "v1.31.0"}},
+		{Constraint: validator.ConstraintValidation{Name: "OS.sysctl./proc/sys/vm/swappiness", Expected: "1", Actual: "60"}},
+	}
+	if err := recorder.Notify(context.Background(), regressions); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	events, err := client.CoreV1().Events("eidos").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list events: %v", err)
+	}
+	if len(events.Items) != 2 {
+		t.Fatalf("events = %d, want 2", len(events.Items))
+	}
+	for _, e := range events.Items {
+		if e.Type != corev1.EventTypeWarning || e.Reason != EventReasonRegressed {
+			t.Errorf("event %s: type %q reason %q", e.Name, e.Type, e.Reason)
+		}
+		if e.InvolvedObject.Name != "pinned-recipe" || e.InvolvedObject.Kind != "ConfigMap" {
+			t.Errorf("event %s: involved object %+v", e.Name, e.InvolvedObject)
+		}
+	}
+}
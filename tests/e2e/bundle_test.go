@@ -0,0 +1,238 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/eidos/pkg/bundler"
+	"github.com/NVIDIA/eidos/pkg/bundler/checksum"
+	"github.com/NVIDIA/eidos/pkg/bundler/config"
+	"github.com/NVIDIA/eidos/pkg/bundler/deployer/helm"
+	"github.com/NVIDIA/eidos/pkg/deployer/helmlive"
+	"github.com/NVIDIA/eidos/pkg/healthcheck"
+	"github.com/NVIDIA/eidos/pkg/recipe"
+	"github.com/NVIDIA/eidos/tests/e2e/framework"
+)
+
+const (
+	deployTimeout = 15 * time.Minute
+	healthTimeout = 5 * time.Minute
+	pollInterval  = 10 * time.Second
+)
+
+// bundleComponents are the recipe components the tests deploy.
+var bundleComponents = []string{"cert-manager", "gpu-operator"}
+
+// gpuOperatorStubValues disable the GPU operator operands that need real
+// GPUs, leaving the operator and node feature discovery.
+var gpuOperatorStubValues = map[string]string{
+	"driver.enabled":                      "false",
+	"toolkit.enabled":                     "false",
+	"dcgmExporter.serviceMonitor.enabled": "false",
+}
+
+var cluster *framework.Cluster
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	var err error
+	cluster, err = framework.Setup(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "e2e cluster setup failed: %v\n", err)
+		if cluster != nil {
+			_ = cluster.Teardown(ctx)
+		}
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	if err := cluster.Teardown(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "e2e cluster teardown failed: %v\n", err)
+	}
+	os.Exit(code)
+}
+
+// TestBundle generates a bundle with cert-manager and a stubbed GPU operator,
+// installs the umbrella chart, and checks the health checks track the
+// cluster state.
+func TestBundle(t *testing.T) {
+	ctx := context.Background()
+	rec := buildRecipe(t)
+	dir := t.TempDir()
+
+	b, err := bundler.New(bundler.WithConfig(config.NewConfig(
+		config.WithValueOverrides(map[string]map[string]string{"gpu-operator": gpuOperatorStubValues}),
+	)))
+	if err != nil {
+		t.Fatalf("bundler.New() error = %v", err)
+	}
+
+	checker := healthcheck.New(cluster.Client, cluster.Dynamic,
+		healthcheck.WithNamespace(helm.ReleaseNamespace))
+
+	t.Run("generate", func(t *testing.T) {
+		out, err := b.Make(ctx, rec, dir)
+		if err != nil {
+			t.Fatalf("Make() error = %v", err)
+		}
+		if !slices.Equal(out.SkippedComponents, skippedComponents(rec)) {
+			t.Errorf("skipped components = %v", out.SkippedComponents)
+		}
+		if err := checksum.VerifyChecksums(ctx, dir); err != nil {
+			t.Fatalf("VerifyChecksums() error = %v", err)
+		}
+		chart, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range bundleComponents {
+			if !strings.Contains(string(chart), "name: "+name) {
+				t.Errorf("Chart.yaml missing dependency %s:\n%s", name, chart)
+			}
+		}
+	})
+
+	t.Run("unhealthy before deploy", func(t *testing.T) {
+		res, err := checker.Check(ctx, rec)
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		if res.Summary.Status != healthcheck.StatusFail {
+			t.Errorf("status = %s, want %s", res.Summary.Status, healthcheck.StatusFail)
+		}
+	})
+
+	t.Run("deploy", func(t *testing.T) {
+		d := helmlive.New(
+			helmlive.WithKubeconfig(cluster.Kubeconfig),
+			helmlive.WithTimeout(deployTimeout),
+			helmlive.WithMode(helmlive.ModeUmbrella),
+		)
+		res, err := d.DeployChart(ctx, dir, nil)
+		if err != nil {
+			t.Fatalf("DeployChart() error = %v", err)
+		}
+		if len(res.Releases) != 1 || res.Releases[0].Action != helmlive.ActionInstall {
+			t.Errorf("unexpected releases: %+v", res.Releases)
+		}
+	})
+
+	t.Run("gpus missing", func(t *testing.T) {
+		waitForCheck(t, checker, rec, "cert-manager", "webhook.available", healthcheck.CheckStatusPassed)
+		waitForCheck(t, checker, rec, "gpu-operator", "gpu.allocatable", healthcheck.CheckStatusFailed)
+	})
+
+	t.Run("healthy with stubbed gpus", func(t *testing.T) {
+		if err := cluster.StubGPUs(ctx, 8); err != nil {
+			t.Fatalf("StubGPUs() error = %v", err)
+		}
+
+		var last *healthcheck.Result
+		err := framework.WaitFor(ctx, pollInterval, healthTimeout, func(ctx context.Context) (bool, error) {
+			res, err := checker.Check(ctx, rec)
+			if err != nil {
+				return false, err
+			}
+			last = res
+			return res.Summary.Status == healthcheck.StatusPass, nil
+		})
+		if err != nil {
+			t.Fatalf("cluster not healthy: %v\n%s", err, describe(last))
+		}
+	})
+}
+
+// buildRecipe builds the base recipe with every component except
+// bundleComponents disabled.
+func buildRecipe(t *testing.T) *recipe.RecipeResult {
+	t.Helper()
+
+	rec, err := recipe.NewBuilder().BuildFromCriteria(context.Background(), recipe.NewCriteria())
+	if err != nil {
+		t.Fatalf("BuildFromCriteria() error = %v", err)
+	}
+
+	disabled := false
+	for i := range rec.ComponentRefs {
+		if !slices.Contains(bundleComponents, rec.ComponentRefs[i].Name) {
+			rec.ComponentRefs[i].Enabled = &disabled
+		}
+	}
+	for _, name := range bundleComponents {
+		if rec.GetComponentRef(name) == nil {
+			t.Fatalf("base recipe has no %s component", name)
+		}
+	}
+	return rec
+}
+
+// skippedComponents returns the names of the disabled components of rec.
+func skippedComponents(rec *recipe.RecipeResult) []string {
+	var names []string
+	for _, ref := range rec.ComponentRefs {
+		if !ref.IsEnabled() {
+			names = append(names, ref.Name)
+		}
+	}
+	return names
+}
+
+// waitForCheck polls the health checks until the named check of component
+// reports want.
+func waitForCheck(t *testing.T, checker *healthcheck.Checker, rec *recipe.RecipeResult, component, name string, want healthcheck.CheckStatus) {
+	t.Helper()
+
+	var last *healthcheck.Result
+	err := framework.WaitFor(context.Background(), pollInterval, healthTimeout, func(ctx context.Context) (bool, error) {
+		res, err := checker.Check(ctx, rec)
+		if err != nil {
+			return false, err
+		}
+		last = res
+		for _, cr := range res.Results {
+			if cr.Component == component && strings.HasSuffix(cr.Name, "."+name) {
+				return cr.Status == want, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("%s check %s not %s: %v\n%s", component, name, want, err, describe(last))
+	}
+}
+
+// describe formats health check results for failure messages.
+func describe(res *healthcheck.Result) string {
+	if res == nil {
+		return "no health check results"
+	}
+	var b strings.Builder
+	for _, cr := range res.Results {
+		fmt.Fprintf(&b, "  %s [%s] %s %s\n", cr.Name, cr.Status, cr.Actual, cr.Message)
+	}
+	return b.String()
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/NVIDIA/eidos/pkg/k8s/client"
+)
+
+// Provider is the tool used to create the test cluster.
+type Provider string
+
+// Supported cluster providers.
+const (
+	// ProviderKind creates the cluster with kind (default).
+	ProviderKind Provider = "kind"
+	// ProviderK3d creates the cluster with k3d.
+	ProviderK3d Provider = "k3d"
+)
+
+// Environment variables that configure the test cluster.
+const (
+	// EnvKubeconfig points at an existing cluster; no cluster is created or deleted.
+	EnvKubeconfig = "E2E_KUBECONFIG"
+	// EnvProvider selects the cluster provider (kind or k3d).
+	EnvProvider = "E2E_CLUSTER_PROVIDER"
+	// EnvClusterName sets the name of the created cluster.
+	EnvClusterName = "E2E_CLUSTER_NAME"
+	// EnvKeepCluster keeps the created cluster after the tests when true.
+	EnvKeepCluster = "E2E_KEEP_CLUSTER"
+)
+
+// DefaultClusterName is the name of the created cluster.
+const DefaultClusterName = "eidos-e2e"
+
+// Cluster is a Kubernetes cluster the e2e tests run against.
+type Cluster struct {
+	// Name is the cluster name, empty for an existing cluster.
+	Name string
+
+	// Provider created the cluster, empty for an existing cluster.
+	Provider Provider
+
+	// Kubeconfig is the path of the cluster's kubeconfig file.
+	Kubeconfig string
+
+	// Client and Dynamic are clients for the cluster.
+	Client  kubernetes.Interface
+	Dynamic dynamic.Interface
+
+	// created is true when Setup created the cluster and Teardown deletes it.
+	created bool
+	workDir string
+}
+
+// Setup returns the cluster configured by the environment: the cluster in
+// E2E_KUBECONFIG when set, otherwise a new kind or k3d cluster.
+func Setup(ctx context.Context) (*Cluster, error) {
+	if kubeconfig := os.Getenv(EnvKubeconfig); kubeconfig != "" {
+		c := &Cluster{Kubeconfig: kubeconfig}
+		return c, c.connect()
+	}
+
+	provider := Provider(strings.ToLower(os.Getenv(EnvProvider)))
+	if provider == "" {
+		provider = ProviderKind
+	}
+	if provider != ProviderKind && provider != ProviderK3d {
+		return nil, fmt.Errorf("unsupported %s %q: must be %s or %s", EnvProvider, provider, ProviderKind, ProviderK3d)
+	}
+	if _, err := exec.LookPath(string(provider)); err != nil {
+		return nil, fmt.Errorf("%s is not installed: %w", provider, err)
+	}
+
+	name := os.Getenv(EnvClusterName)
+	if name == "" {
+		name = DefaultClusterName
+	}
+
+	workDir, err := os.MkdirTemp("", "eidos-e2e-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create work directory: %w", err)
+	}
+
+	c := &Cluster{
+		Name:       name,
+		Provider:   provider,
+		Kubeconfig: filepath.Join(workDir, "kubeconfig"),
+		workDir:    workDir,
+	}
+
+	slog.Info("creating e2e cluster", "provider", provider, "name", name)
+	if err := c.create(ctx); err != nil {
+		os.RemoveAll(workDir)
+		return nil, err
+	}
+	c.created = true
+
+	if err := c.connect(); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// Teardown deletes the cluster if Setup created it, unless E2E_KEEP_CLUSTER is set.
+func (c *Cluster) Teardown(ctx context.Context) error {
+	if !c.created {
+		return nil
+	}
+	defer os.RemoveAll(c.workDir)
+
+	if keep, _ := strconv.ParseBool(os.Getenv(EnvKeepCluster)); keep {
+		slog.Info("keeping e2e cluster", "name", c.Name, "provider", c.Provider)
+		return nil
+	}
+
+	slog.Info("deleting e2e cluster", "name", c.Name, "provider", c.Provider)
+	switch c.Provider {
+	case ProviderK3d:
+		_, err := run(ctx, "k3d", "cluster", "delete", c.Name)
+		return err
+	default:
+		_, err := run(ctx, "kind", "delete", "cluster", "--name", c.Name)
+		return err
+	}
+}
+
+// create creates the cluster and writes its kubeconfig.
+func (c *Cluster) create(ctx context.Context) error {
+	switch c.Provider {
+	case ProviderK3d:
+		if _, err := run(ctx, "k3d", "cluster", "create", c.Name,
+			"--agents", "1", "--wait", "--kubeconfig-update-default=false"); err != nil {
+			return err
+		}
+		_, err := run(ctx, "k3d", "kubeconfig", "write", c.Name, "--output", c.Kubeconfig)
+		return err
+	default:
+		_, err := run(ctx, "kind", "create", "cluster", "--name", c.Name,
+			"--kubeconfig", c.Kubeconfig, "--wait", "180s")
+		return err
+	}
+}
+
+// connect builds the cluster clients from the kubeconfig.
+func (c *Cluster) connect() error {
+	clientset, config, err := client.BuildKubeClient(c.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to connect to cluster: %w", err)
+	}
+	dyn, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	c.Client = clientset
+	c.Dynamic = dyn
+	return nil
+}
+
+// Kubectl runs kubectl against the cluster and returns its combined output.
+func (c *Cluster) Kubectl(ctx context.Context, args ...string) (string, error) {
+	return run(ctx, "kubectl", append([]string{"--kubeconfig", c.Kubeconfig}, args...)...)
+}
+
+// run executes a command and returns its combined output, including it in
+// the error when the command fails.
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return out.String(), fmt.Errorf("%s %s failed: %w\n%s", name, strings.Join(args, " "), err, out.String())
+	}
+	return out.String(), nil
+}
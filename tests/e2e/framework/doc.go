@@ -0,0 +1,39 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package framework provides the cluster harness for the Go e2e tests.
+
+The harness is compiled only with the e2e build tag, so unit test runs never
+need a cluster:
+
+	go test -tags e2e -timeout 45m ./tests/e2e/...
+
+Setup creates a kind (default) or k3d cluster, or connects to an existing one
+when E2E_KUBECONFIG is set. Teardown deletes clusters it created unless
+E2E_KEEP_CLUSTER is true.
+
+Clusters have no GPUs. StubGPUs advertises nvidia.com/gpu capacity on the
+nodes the way the device plugin would, so GPU health checks and scheduling
+behave as on GPU nodes, and InjectFakeNvidiaSMI installs tools/fake-nvidia-smi
+into kind nodes for collectors.
+
+# Environment
+
+  - E2E_KUBECONFIG: use an existing cluster instead of creating one
+  - E2E_CLUSTER_PROVIDER: kind (default) or k3d
+  - E2E_CLUSTER_NAME: name of the created cluster (default eidos-e2e)
+  - E2E_KEEP_CLUSTER: keep the created cluster after the tests
+*/
+package framework
@@ -0,0 +1,98 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build e2e
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// GPUResourceName is the extended resource advertised for stubbed GPUs.
+const GPUResourceName = "nvidia.com/gpu"
+
+// StubGPUs advertises perNode GPUs on every node, the way the device plugin
+// would, so scheduling and health checks see GPU capacity on clusters
+// without GPUs. The GPU operator operands are not scheduled, since the nodes
+// carry no NVIDIA PCI labels.
+func (c *Cluster) StubGPUs(ctx context.Context, perNode int) error {
+	nodes, err := c.Client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"capacity":    map[string]string{GPUResourceName: fmt.Sprint(perNode)},
+			"allocatable": map[string]string{GPUResourceName: fmt.Sprint(perNode)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes.Items {
+		if _, err := c.Client.CoreV1().Nodes().Patch(ctx, node.Name, types.MergePatchType,
+			patch, metav1.PatchOptions{}, "status"); err != nil {
+			return fmt.Errorf("failed to stub GPUs on node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// InjectFakeNvidiaSMI copies tools/fake-nvidia-smi into every node container
+// of a kind cluster, so GPU collectors running on the nodes see 8 GPUs.
+func (c *Cluster) InjectFakeNvidiaSMI(ctx context.Context) error {
+	if c.Provider != ProviderKind {
+		return fmt.Errorf("fake nvidia-smi injection requires a kind cluster")
+	}
+
+	out, err := run(ctx, "kind", "get", "nodes", "--name", c.Name)
+	if err != nil {
+		return err
+	}
+	script := filepath.Join(RepoRoot(), "tools", "fake-nvidia-smi")
+	for _, node := range strings.Fields(out) {
+		if _, err := run(ctx, "docker", "cp", script, node+":/usr/local/bin/nvidia-smi"); err != nil {
+			return err
+		}
+		if _, err := run(ctx, "docker", "exec", node, "chmod", "+x", "/usr/local/bin/nvidia-smi"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitFor polls condition every interval until it returns true, an error, or
+// timeout expires.
+func WaitFor(ctx context.Context, interval, timeout time.Duration, condition func(ctx context.Context) (bool, error)) error {
+	return wait.PollUntilContextTimeout(ctx, interval, timeout, true, condition)
+}
+
+// RepoRoot returns the root of the repository the tests are built from.
+func RepoRoot() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..")
+}